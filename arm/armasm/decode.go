@@ -44,20 +44,34 @@ var (
 var decoderCover []bool
 
 // Decode decodes the leading bytes in src as a single instruction.
+// In ModeThumb, the instruction is decoded as if outside any IT block;
+// use DecodeThumb to decode a sequence of Thumb instructions.
 func Decode(src []byte, mode Mode) (inst Inst, err error) {
-	if mode != ModeARM {
+	switch mode {
+	case ModeARM:
+	case ModeThumb:
+		var it ITState
+		return DecodeThumb(src, &it)
+	default:
 		return Inst{}, errMode
 	}
 	if len(src) < 4 {
 		return Inst{}, errShort
 	}
 
+	x := binary.LittleEndian.Uint32(src)
+	if inst, ok := decodeARM(x); ok {
+		return inst, nil
+	}
+	return Inst{}, errUnknown
+}
+
+// decodeARM decodes the 32-bit ARM instruction x.
+func decodeARM(x uint32) (inst Inst, ok bool) {
 	if decoderCover == nil {
 		decoderCover = make([]bool, len(instFormats))
 	}
 
-	x := binary.LittleEndian.Uint32(src)
-
 	// The instFormat table contains both conditional and unconditional instructions.
 	// Considering only the top 4 bits, the conditional instructions use mask=0, value=0,
 	// while the unconditional instructions use mask=f, value=f.
@@ -109,14 +123,12 @@ Search:
 			Args: args,
 			Enc:  x,
 			Len:  4,
+			Mode: ModeARM,
 		}
 		priority = f.priority
 		continue Search
 	}
-	if inst.Op != 0 {
-		return inst, nil
-	}
-	return Inst{}, errUnknown
+	return inst, inst.Op != 0
 }
 
 // An instArg describes the encoding of a single argument.
@@ -126,6 +138,12 @@ Search:
 // The _offset and _postindex suffixes force the given addressing mode.
 // The rest should be somewhat self-explanatory, at least given
 // the decodeArg function.
+//
+// The Thumb arguments are decoded by decodeThumbArg.
+// In their names, Rlow is a 3-bit register number (R0-R7),
+// x2 and x4 mark an immediate scaled by 2 or 4,
+// and the _T suffix marks a Thumb-2 encoding of an argument
+// that has an ARM counterpart without the suffix.
 type instArg uint8
 
 const (
@@ -193,6 +211,63 @@ const (
 	arg_satimm4m1
 	arg_satimm5m1
 	arg_widthm1
+
+	// Thumb
+	arg_PC
+	arg_R_3
+	arg_R_dn
+	arg_Rlow_0
+	arg_Rlow_3
+	arg_Rlow_6
+	arg_Rlow_8
+	arg_Rlow_8_WB
+	arg_Rlow_8_WB_LDM
+	arg_R_16_shift_T
+	arg_R_rotate_T
+	arg_R_shift_imm_T
+	arg_const_T
+	arg_endian_3
+	arg_firstcond
+	arg_iflags
+	arg_imm_1at26_3at12_8at0
+	arg_imm_3at12_2at6
+	arg_imm_3at12_2at6_32
+	arg_imm_3at6
+	arg_imm_4at16_1at26_3at12_8at0
+	arg_imm_5at6
+	arg_imm_5at6_32
+	arg_imm_7at0x4
+	arg_imm_8at0
+	arg_imm_8at0x4
+	arg_label_cb
+	arg_label_T1
+	arg_label_T2
+	arg_label_T3
+	arg_label_T4
+	arg_label_T4_4
+	arg_label_p_8x4
+	arg_lsb_width_T
+	arg_mem_R_R_T
+	arg_mem_R_R_lsl1_T
+	arg_mem_R_R_shift_T
+	arg_mem_R_imm8_T
+	arg_mem_R_imm8x4_T
+	arg_mem_R_pm_imm8_W_T
+	arg_mem_R_pm_imm8x4_W_T
+	arg_mem_Rlow_Rlow
+	arg_mem_Rlow_imm5
+	arg_mem_Rlow_imm5x2
+	arg_mem_Rlow_imm5x4
+	arg_mem_SP_imm8x4
+	arg_registers8
+	arg_registers8_LR
+	arg_registers8_PC
+	arg_satimm4_T
+	arg_satimm4m1_T
+	arg_satimm5_T
+	arg_satimm5m1_T
+	arg_sysm
+	arg_widthm1_T
 )
 
 // decodeArg decodes the arg described by aop from the instruction bits x.
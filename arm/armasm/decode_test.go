@@ -67,3 +67,50 @@ func TestDecode(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeThumbIT(t *testing.T) {
+	// A sequence of Thumb instructions, including IT blocks.
+	tests := []struct {
+		hex string
+		gnu string
+	}{
+		{"06bf", "itte eq"},
+		{"0846", "moveq r0, r1"},
+		{"8818", "addeq r0, r1, r2"},
+		{"0120", "movne r0, #1"},
+		{"0120", "movs r0, #1"},
+		{"b6bf", "itet lt"},
+		{"0844", "addlt r0, r1"},
+		{"0130", "addge r0, #1"},
+		{"11f10100", "addslt.w r0, r1, #1"},
+		{"18bf", "it ne"},
+		{"0868", "ldrne r0, [r1]"},
+		{"8cbf", "ite hi"},
+		{"0028", "cmphi r0, #0"},
+		{"1a46", "movls r2, r3"},
+		{"47bf", "ittee mi"},
+		{"30ee810a", "vaddmi.f32 s0, s1, s2"},
+		{"00bf", "nopmi"},
+		{"aff30080", "noppl.w"},
+		{"a1f10100", "subpl.w r0, r1, #1"},
+		{"8818", "adds r0, r1, r2"},
+	}
+	var it ITState
+	for _, tt := range tests {
+		code, err := hex.DecodeString(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := DecodeThumb(code, &it)
+		if err != nil {
+			t.Errorf("DecodeThumb(%s): %v", tt.hex, err)
+			continue
+		}
+		if out := GNUSyntax(inst); out != tt.gnu || inst.Len != len(code) {
+			t.Errorf("DecodeThumb(%s) = %s, %d, want %s, %d", tt.hex, out, inst.Len, tt.gnu, len(code))
+		}
+	}
+	if it.InBlock() {
+		t.Errorf("IT block still in progress after sequence")
+	}
+}
@@ -31,8 +31,24 @@ func GNUSyntax(inst Inst) string {
 	op = strings.Replace(op, "_dot_", ".", -1)
 	op = strings.ToLower(op)
 	buf.WriteString(op)
+	args := inst.Args
+	if inst.Mode == ModeThumb {
+		buf.WriteString(thumbWidth(inst))
+		if inst.Len == 2 && args[2] == nil {
+			// The 16-bit MUL and ADD (SP plus register) encode the
+			// destination once; objdump prints it again as the last operand.
+			switch inst.Op &^ 15 {
+			case MUL_EQ, MUL_S_EQ:
+				args[2] = args[0]
+			case ADD_EQ:
+				if args[1] == SP {
+					args[2] = args[0]
+				}
+			}
+		}
+	}
 	sep := " "
-	for i, arg := range inst.Args {
+	for i, arg := range args {
 		if arg == nil {
 			break
 		}
@@ -47,15 +63,71 @@ func GNUSyntax(inst Inst) string {
 	return buf.String()
 }
 
+// thumbNarrow lists the mnemonics whose 32-bit Thumb encodings are
+// printed with .w because they also have a 16-bit encoding. TEQ has
+// none but is printed like TST, and MUL is left out because its 16-bit
+// encoding sets the flags outside an IT block. RSB only has a 16-bit
+// immediate form, so it is in thumbNarrowImm instead.
+var thumbNarrow = map[string]bool{
+	"ADC": true, "ADD": true, "AND": true, "ASR": true, "B": true, "BIC": true,
+	"CMN": true, "CMP": true, "EOR": true, "LDM": true, "LDR": true, "LDRB": true,
+	"LDRH": true, "LDRSB": true, "LDRSH": true, "LSL": true, "LSR": true, "MOV": true,
+	"MVN": true, "NOP": true, "ORR": true, "POP": true, "PUSH": true, "REV": true,
+	"REV16": true, "REVSH": true, "ROR": true, "SBC": true, "SEV": true,
+	"STM": true, "STR": true, "STRB": true, "STRH": true, "SUB": true, "SXTB": true,
+	"SXTH": true, "TEQ": true, "TST": true, "UDF": true, "UXTB": true, "UXTH": true,
+	"WFE": true, "WFI": true, "YIELD": true,
+}
+
+// thumbNarrowImm lists the data-processing mnemonics whose modified
+// immediate forms get a width qualifier.
+var thumbNarrowImm = map[string]bool{
+	"ADD": true, "CMN": true, "CMP": true, "MOV": true, "RSB": true, "SUB": true,
+	"TEQ": true, "TST": true,
+}
+
+// thumbWidth returns the width qualifier that objdump adds to the Thumb
+// instruction inst: .w for a 32-bit encoding of a mnemonic that also has
+// a 16-bit encoding, and .n for a 16-bit branch.
+func thumbWidth(inst Inst) string {
+	name, _, _ := strings.Cut(inst.Op.String(), ".")
+	if inst.Len == 2 {
+		if name == "B" {
+			return ".n"
+		}
+		return ""
+	}
+	x := inst.Enc
+	switch {
+	case x>>27 == 0x1e && x>>25&1 == 0 && x>>15&1 == 0:
+		// Data processing with a modified immediate.
+		if !thumbNarrowImm[name] {
+			return ""
+		}
+	case x>>25 == 0x7c && x>>23&1 == 0 && x>>11&1 == 1 && x>>16&15 != 15 && name != "PUSH" && name != "POP":
+		// Loads and stores with an 8-bit offset, which may be negative
+		// or write back, have no 16-bit encoding.
+		return ""
+	case name == "SUB" && inst.Args[0] == PC:
+		// SUBS PC, LR, #imm8 has no 16-bit encoding.
+		return ""
+	case !thumbNarrow[name]:
+		return ""
+	}
+	return ".w"
+}
+
 func gnuArg(inst *Inst, argIndex int, arg Arg) string {
+	// In ARM mode the second register of a pair is always the next one
+	// and is not printed. Thumb encodes both registers.
 	switch inst.Op &^ 15 {
 	case LDRD_EQ, LDREXD_EQ, STRD_EQ:
-		if argIndex == 1 {
+		if argIndex == 1 && inst.Mode != ModeThumb {
 			// second argument in consecutive pair not printed
 			return ""
 		}
 	case STREXD_EQ:
-		if argIndex == 2 {
+		if argIndex == 2 && inst.Mode != ModeThumb {
 			// second argument in consecutive pair not printed
 			return ""
 		}
@@ -67,8 +139,15 @@ func gnuArg(inst *Inst, argIndex int, arg Arg) string {
 		case BKPT_EQ:
 			return fmt.Sprintf("%#04x", uint32(arg))
 		case SVC_EQ:
+			if inst.Mode == ModeThumb {
+				return fmt.Sprintf("%d", uint32(arg))
+			}
 			return fmt.Sprintf("%#08x", uint32(arg))
 		}
+		if inst.Mode == ModeThumb {
+			// Thumb-2 modified immediates are printed unsigned.
+			return fmt.Sprintf("#%d", uint32(arg))
+		}
 		return fmt.Sprintf("#%d", int32(arg))
 
 	case ImmAlt:
@@ -116,7 +195,9 @@ func gnuArg(inst *Inst, argIndex int, arg Arg) string {
 		return fmt.Sprintf("[%s Mode(%d) %s]", R, int(arg.Mode), X)
 
 	case PCRel:
-		return fmt.Sprintf(".%+#x", int32(arg)+4)
+		// PCRel is relative to the instruction address plus 8;
+		// the printed offset is relative to the end of the instruction.
+		return fmt.Sprintf(".%+#x", int32(arg)+8-int32(inst.Len))
 
 	case Reg:
 		switch inst.Op &^ 15 {
@@ -147,6 +228,9 @@ func gnuArg(inst *Inst, argIndex int, arg Arg) string {
 		fmt.Fprintf(&buf, "}")
 		return buf.String()
 
+	case SysReg:
+		return arg.String()
+
 	case RegShift:
 		if arg.Shift == ShiftLeft && arg.Count == 0 {
 			return gnuArg(inst, -1, arg.Reg)
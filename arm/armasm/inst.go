@@ -37,6 +37,12 @@ type Op uint16
 // density is high, probably at least 90%.
 
 func (op Op) String() string {
+	if op >= thumbOpBase {
+		if i := op - thumbOpBase; int(i) < len(thumbOpstr) && thumbOpstr[i] != "" {
+			return thumbOpstr[i]
+		}
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
 	if op >= Op(len(opstr)) || opstr[op] == "" {
 		return fmt.Sprintf("Op(%d)", int(op))
 	}
@@ -46,8 +52,9 @@ func (op Op) String() string {
 // An Inst is a single instruction.
 type Inst struct {
 	Op   Op     // Opcode mnemonic
-	Enc  uint32 // Raw encoding bits.
+	Enc  uint32 // Raw encoding bits; a 32-bit Thumb instruction has its first halfword in the high bits.
 	Len  int    // Length of encoding in bytes.
+	Mode Mode   // Execution mode the instruction was decoded in.
 	Args Args   // Instruction arguments, in ARM manual order.
 }
 
@@ -74,7 +81,7 @@ func (i Inst) String() string {
 type Args [4]Arg
 
 // An Arg is a single instruction argument, one of these types:
-// Endian, Imm, Mem, PCRel, Reg, RegList, RegShift, RegShiftReg.
+// Cond, Endian, IFlags, Imm, Mem, PCRel, Reg, RegList, RegShift, RegShiftReg, SysReg.
 type Arg interface {
 	IsArg()
 	String() string
@@ -312,6 +319,94 @@ func (e Endian) String() string {
 	return "LE"
 }
 
+// A Cond is the first condition of a Thumb IT block, the argument to the IT instruction.
+type Cond uint8
+
+var condName = [...]string{
+	"EQ", "NE", "CS", "CC", "MI", "PL", "VS", "VC",
+	"HI", "LS", "GE", "LT", "GT", "LE", "AL",
+}
+
+func (Cond) IsArg() {}
+
+func (c Cond) String() string {
+	if int(c) < len(condName) {
+		return condName[c]
+	}
+	return fmt.Sprintf("Cond(%d)", int(c))
+}
+
+// An IFlags is the set of interrupt masks changed by a CPS instruction.
+type IFlags uint8
+
+const (
+	IFlagF IFlags = 1 << iota // FIQ mask
+	IFlagI                    // IRQ mask
+	IFlagA                    // asynchronous abort mask
+)
+
+func (IFlags) IsArg() {}
+
+func (f IFlags) String() string {
+	s := ""
+	if f&IFlagA != 0 {
+		s += "A"
+	}
+	if f&IFlagI != 0 {
+		s += "I"
+	}
+	if f&IFlagF != 0 {
+		s += "F"
+	}
+	return s
+}
+
+// A SysReg is an M-profile special register accessed by MRS and MSR.
+type SysReg uint8
+
+const (
+	SysAPSR        SysReg = 0
+	SysIAPSR       SysReg = 1
+	SysEAPSR       SysReg = 2
+	SysXPSR        SysReg = 3
+	SysIPSR        SysReg = 5
+	SysEPSR        SysReg = 6
+	SysIEPSR       SysReg = 7
+	SysMSP         SysReg = 8
+	SysPSP         SysReg = 9
+	SysPRIMASK     SysReg = 16
+	SysBASEPRI     SysReg = 17
+	SysBASEPRI_MAX SysReg = 18
+	SysFAULTMASK   SysReg = 19
+	SysCONTROL     SysReg = 20
+)
+
+var sysRegName = map[SysReg]string{
+	SysAPSR:        "APSR",
+	SysIAPSR:       "IAPSR",
+	SysEAPSR:       "EAPSR",
+	SysXPSR:        "XPSR",
+	SysIPSR:        "IPSR",
+	SysEPSR:        "EPSR",
+	SysIEPSR:       "IEPSR",
+	SysMSP:         "MSP",
+	SysPSP:         "PSP",
+	SysPRIMASK:     "PRIMASK",
+	SysBASEPRI:     "BASEPRI",
+	SysBASEPRI_MAX: "BASEPRI_MAX",
+	SysFAULTMASK:   "FAULTMASK",
+	SysCONTROL:     "CONTROL",
+}
+
+func (SysReg) IsArg() {}
+
+func (r SysReg) String() string {
+	if s, ok := sysRegName[r]; ok {
+		return s
+	}
+	return fmt.Sprintf("SysReg(%d)", int(r))
+}
+
 // A Shift describes an ARM shift operation.
 type Shift uint8
 
@@ -362,7 +457,9 @@ func (r RegShiftReg) String() string {
 
 // A PCRel describes a memory address (usually a code label)
 // as a distance relative to the program counter.
-// TODO(rsc): Define which program counter (PC+4? PC+8? PC?).
+// The distance is measured from the address of the instruction plus 8,
+// the value of PC as read by an ARM instruction,
+// in both ARM and Thumb mode.
 type PCRel int32
 
 func (PCRel) IsArg() {}
@@ -49,6 +49,10 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		// Check for PC-relative load.
 		if mem.Base == PC && mem.Sign == 0 && mem.Mode == AddrOffset && text != nil {
 			addr := uint32(pc) + 8 + uint32(mem.Offset)
+			if inst.Mode == ModeThumb {
+				// Thumb reads PC as the instruction address plus 4, word-aligned.
+				addr = uint32(pc+4)&^3 + uint32(mem.Offset)
+			}
 			buf := make([]byte, 8)
 			switch inst.Op &^ 15 {
 			case LDRB_EQ, LDRSB_EQ:
@@ -96,7 +100,7 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 	// Move addressing mode into opcode suffix.
 	suffix := ""
 	switch inst.Op &^ 15 {
-	case PLD, PLI, PLD_W:
+	case PLD, PLI, PLD_W, TBB_EQ, TBH_EQ:
 		if mem, ok := inst.Args[0].(Mem); ok {
 			args[0], suffix = memOpTrans(mem)
 		} else {
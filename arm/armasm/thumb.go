@@ -0,0 +1,476 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// An ITState is the IT block state of Thumb execution (ITSTATE in the ARM manual).
+// An IT instruction makes up to four following instructions conditional;
+// the ITState holds the condition of the next instruction in the high 4 bits
+// and the pattern of the rest of the block in the low 4 bits.
+// The zero ITState means that no IT block is in progress.
+type ITState uint8
+
+// InBlock reports whether the next instruction is inside an IT block.
+func (s ITState) InBlock() bool {
+	return s&0xf != 0
+}
+
+// cond returns the condition of the next instruction: AL (14) outside an IT block.
+func (s ITState) cond() Op {
+	if !s.InBlock() {
+		return 14
+	}
+	return Op(s >> 4)
+}
+
+// advance moves s past one instruction.
+func (s *ITState) advance() {
+	if *s&7 == 0 {
+		*s = 0
+		return
+	}
+	*s = *s&0xe0 | *s<<1&0x1f
+}
+
+// A thumbFormat describes the format of a Thumb instruction encoding.
+// The instruction value x is the halfword of a 16-bit instruction,
+// or the first halfword shifted left 16 and or'ed with the second
+// halfword of a 32-bit instruction. The value x matches the format
+// if x&mask == value. The first matching format whose arguments
+// decode successfully wins, so more specific formats come first.
+type thumbFormat struct {
+	mask  uint32
+	value uint32
+	op    Op
+	flags thumbFlags
+	args  instArgs
+}
+
+// thumbFlags describe how to compute the opcode of a thumbFormat.
+// Unless thumbUncond is set, op is the EQ form of a group of 16
+// conditional opcodes, and the condition comes from the IT block.
+type thumbFlags uint8
+
+const (
+	thumbUncond     thumbFlags = 1 << iota // op is not conditional
+	thumbCond8                             // condition in bits 11:8, as in B<c> T1
+	thumbCond22                            // condition in bits 25:22, as in B<c> T3
+	thumbS20                               // flag-setting form (op+16) when bit 20 is set
+	thumbSOutsideIT                        // flag-setting form (op+16) when outside an IT block
+)
+
+// DecodeThumb decodes the leading bytes in src as a single Thumb instruction
+// executing in the IT block state *it, and then updates *it for the
+// instruction that follows. Starting from the zero ITState and calling
+// DecodeThumb for each instruction in a sequence applies the conditions
+// set by IT instructions to the instructions in their blocks.
+func DecodeThumb(src []byte, it *ITState) (Inst, error) {
+	if len(src) < 2 {
+		return Inst{}, errShort
+	}
+	x := uint32(binary.LittleEndian.Uint16(src))
+	size := 2
+	formats := thumb16Formats[:]
+	if x>>11 >= 0x1d {
+		if len(src) < 4 {
+			return Inst{}, errShort
+		}
+		x = x<<16 | uint32(binary.LittleEndian.Uint16(src[2:]))
+		size = 4
+		formats = thumb32Formats[:]
+	}
+
+	// Coprocessor and floating-point instructions with a first halfword
+	// of 1110 110x or 1110 1110 have the same layout as the ARM encoding
+	// with condition AL, so decode them using the ARM tables.
+	// Advanced SIMD data-processing instructions (111x 1111) and
+	// unconditional coprocessor instructions (1111 11xx) are not supported.
+	if size == 4 && x>>24 >= 0xec && x>>24 <= 0xee {
+		inst, ok := decodeARM(x)
+		if !ok || inst.Op&15 != 14 {
+			return Inst{}, errUnknown
+		}
+		inst.Op += it.cond() - 14
+		inst.Mode = ModeThumb
+		it.advance()
+		return inst, nil
+	}
+
+Search:
+	for i := range formats {
+		f := &formats[i]
+		if x&f.mask != f.value {
+			continue
+		}
+		op := f.op
+		switch {
+		case f.flags&thumbUncond != 0:
+		case f.flags&thumbCond8 != 0:
+			c := x >> 8 & 15
+			if c >= 14 {
+				continue
+			}
+			op += Op(c)
+		case f.flags&thumbCond22 != 0:
+			c := x >> 22 & 15
+			if c >= 14 {
+				continue
+			}
+			op += Op(c)
+		default:
+			op += it.cond()
+		}
+		if (f.flags&thumbS20 != 0 && x>>20&1 != 0) || (f.flags&thumbSOutsideIT != 0 && !it.InBlock()) {
+			op += 16
+		}
+
+		var args Args
+		for j, aop := range f.args {
+			if aop == 0 {
+				break
+			}
+			arg := decodeThumbArg(aop, x)
+			if arg == nil { // cannot decode argument
+				continue Search
+			}
+			args[j] = arg
+		}
+
+		if op == IT {
+			op = itOp(x)
+			*it = ITState(x & 0xff)
+		} else {
+			it.advance()
+		}
+		return Inst{
+			Op:   op,
+			Args: args,
+			Enc:  x,
+			Len:  size,
+			Mode: ModeThumb,
+		}, nil
+	}
+	return Inst{}, errUnknown
+}
+
+// itOp returns the IT opcode (IT, ITT, ITE, ...) for the IT instruction x.
+// The IT opcodes are ordered by block length and then by the pattern
+// of the block, counting T as 0 and E as 1.
+func itOp(x uint32) Op {
+	firstcond0 := x >> 4 & 1
+	mask := x & 0xf
+	n := 3 - bits.TrailingZeros32(mask)
+	pattern := Op(0)
+	for i := 0; i < n; i++ {
+		pattern = pattern<<1 | Op(mask>>uint(3-i)&1^firstcond0)
+	}
+	return IT + Op(1<<uint(n)-1) + pattern
+}
+
+// thumbExpandImm returns the constant encoded by the 12-bit modified
+// immediate imm12 of a Thumb-2 data-processing instruction, or ok=false
+// if the encoding is unpredictable.
+func thumbExpandImm(imm12 uint32) (v uint32, ok bool) {
+	imm8 := imm12 & 0xff
+	if imm12>>10 == 0 {
+		switch imm12 >> 8 & 3 {
+		case 0:
+			return imm8, true
+		case 1:
+			return imm8<<16 | imm8, imm8 != 0
+		case 2:
+			return imm8<<24 | imm8<<8, imm8 != 0
+		case 3:
+			return imm8 * 0x01010101, imm8 != 0
+		}
+	}
+	return bits.RotateLeft32(0x80|imm8&0x7f, -int(imm12>>7)), true
+}
+
+// decodeThumbArg decodes the arg described by aop from the Thumb instruction bits x.
+// It returns nil if x cannot be decoded according to aop.
+// Arguments shared with the ARM encodings are decoded by decodeArg.
+func decodeThumbArg(aop instArg, x uint32) Arg {
+	switch aop {
+	default:
+		return decodeArg(aop, x)
+
+	case arg_PC:
+		return PC
+
+	case arg_R_3:
+		return Reg((x >> 3) & (1<<4 - 1))
+
+	case arg_R_dn:
+		return Reg((x>>7)&1<<3 | x&(1<<3-1))
+
+	case arg_Rlow_0:
+		return Reg(x & (1<<3 - 1))
+	case arg_Rlow_3:
+		return Reg((x >> 3) & (1<<3 - 1))
+	case arg_Rlow_6:
+		return Reg((x >> 6) & (1<<3 - 1))
+	case arg_Rlow_8:
+		return Reg((x >> 8) & (1<<3 - 1))
+
+	case arg_Rlow_8_WB:
+		return Mem{Base: Reg((x >> 8) & (1<<3 - 1)), Mode: AddrLDM_WB}
+
+	case arg_Rlow_8_WB_LDM:
+		// LDM writes back the base register unless it is in the register list.
+		Rn := (x >> 8) & (1<<3 - 1)
+		mode := AddrLDM_WB
+		if x>>Rn&1 != 0 {
+			mode = AddrLDM
+		}
+		return Mem{Base: Reg(Rn), Mode: mode}
+
+	case arg_R_16_shift_T:
+		Rn := Reg((x >> 16) & (1<<4 - 1))
+		count := (x>>12)&(1<<3-1)<<2 | (x>>6)&(1<<2-1)
+		if (x>>21)&1 == 0 {
+			if count == 0 {
+				return Rn
+			}
+			return RegShift{Rn, ShiftLeft, uint8(count)}
+		}
+		if count == 0 {
+			return nil
+		}
+		return RegShift{Rn, ShiftRightSigned, uint8(count)}
+
+	case arg_R_rotate_T:
+		Rm := Reg(x & (1<<4 - 1))
+		rot := (x >> 4) & (1<<2 - 1)
+		if rot == 0 {
+			return Rm
+		}
+		return RegShift{Rm, RotateRight, uint8(rot * 8)}
+
+	case arg_R_shift_imm_T:
+		// Rearrange imm3:imm2 and type into the ARM positions for decodeShift.
+		Rm := Reg(x & (1<<4 - 1))
+		count := (x>>12)&(1<<3-1)<<2 | (x>>6)&(1<<2-1)
+		typ, n := decodeShift(count<<7 | (x>>4)&(1<<2-1)<<5)
+		if typ == ShiftLeft && n == 0 {
+			return Rm
+		}
+		return RegShift{Rm, typ, n}
+
+	case arg_const_T:
+		v, ok := thumbExpandImm((x>>26)&1<<11 | (x>>12)&(1<<3-1)<<8 | x&(1<<8-1))
+		if !ok {
+			return nil
+		}
+		return Imm(v)
+
+	case arg_endian_3:
+		return Endian((x >> 3) & 1)
+
+	case arg_firstcond:
+		cond := (x >> 4) & (1<<4 - 1)
+		if x&(1<<4-1) == 0 || cond == 15 {
+			return nil
+		}
+		return Cond(cond)
+
+	case arg_iflags:
+		if x&(1<<3-1) == 0 {
+			return nil
+		}
+		return IFlags(x & (1<<3 - 1))
+
+	case arg_imm_1at26_3at12_8at0:
+		return Imm((x>>26)&1<<11 | (x>>12)&(1<<3-1)<<8 | x&(1<<8-1))
+
+	case arg_imm_4at16_1at26_3at12_8at0:
+		return Imm((x>>16)&(1<<4-1)<<12 | (x>>26)&1<<11 | (x>>12)&(1<<3-1)<<8 | x&(1<<8-1))
+
+	case arg_imm_3at12_2at6:
+		return Imm((x>>12)&(1<<3-1)<<2 | (x>>6)&(1<<2-1))
+
+	case arg_imm_3at12_2at6_32:
+		x = (x>>12)&(1<<3-1)<<2 | (x>>6)&(1<<2-1)
+		if x == 0 {
+			x = 32
+		}
+		return Imm(x)
+
+	case arg_imm_3at6:
+		return Imm((x >> 6) & (1<<3 - 1))
+
+	case arg_imm_5at6:
+		return Imm((x >> 6) & (1<<5 - 1))
+
+	case arg_imm_5at6_32:
+		x = (x >> 6) & (1<<5 - 1)
+		if x == 0 {
+			x = 32
+		}
+		return Imm(x)
+
+	case arg_imm_7at0x4:
+		return Imm(x & (1<<7 - 1) << 2)
+
+	case arg_imm_8at0:
+		return Imm(x & (1<<8 - 1))
+
+	case arg_imm_8at0x4:
+		return Imm(x & (1<<8 - 1) << 2)
+
+	// The Thumb branch offsets are relative to the instruction address plus 4,
+	// while PCRel is relative to the instruction address plus 8.
+
+	case arg_label_cb:
+		imm := (x>>9)&1<<6 | (x>>3)&(1<<5-1)<<1
+		return PCRel(int32(imm) - 4)
+
+	case arg_label_T1:
+		imm := x & (1<<8 - 1) << 1
+		return PCRel(int32(imm<<23)>>23 - 4)
+
+	case arg_label_T2:
+		imm := x & (1<<11 - 1) << 1
+		return PCRel(int32(imm<<20)>>20 - 4)
+
+	case arg_label_T3:
+		s := (x >> 26) & 1
+		j1 := (x >> 13) & 1
+		j2 := (x >> 11) & 1
+		imm := s<<20 | j2<<19 | j1<<18 | (x>>16)&(1<<6-1)<<12 | x&(1<<11-1)<<1
+		return PCRel(int32(imm<<11)>>11 - 4)
+
+	case arg_label_T4, arg_label_T4_4:
+		s := (x >> 26) & 1
+		i1 := ^((x >> 13) ^ s) & 1
+		i2 := ^((x >> 11) ^ s) & 1
+		imm := s<<24 | i1<<23 | i2<<22 | (x>>16)&(1<<10-1)<<12 | x&(1<<11-1)<<1
+		if aop == arg_label_T4_4 {
+			// BLX to ARM code: the target is relative to the
+			// instruction address plus 4 rounded down to a multiple of 4,
+			// which assumes here that the instruction address is a multiple of 4.
+			if x&1 != 0 {
+				return nil
+			}
+		}
+		return PCRel(int32(imm<<7)>>7 - 4)
+
+	case arg_label_p_8x4:
+		return Mem{Base: PC, Mode: AddrOffset, Offset: int16(x & (1<<8 - 1) << 2)}
+
+	case arg_lsb_width_T:
+		lsb := (x>>12)&(1<<3-1)<<2 | (x>>6)&(1<<2-1)
+		msb := x & (1<<5 - 1)
+		if msb < lsb {
+			return nil
+		}
+		return Imm(msb + 1 - lsb)
+
+	case arg_mem_R_R_T, arg_mem_R_R_lsl1_T, arg_mem_R_R_shift_T:
+		var count uint8
+		switch aop {
+		case arg_mem_R_R_lsl1_T:
+			count = 1
+		case arg_mem_R_R_shift_T:
+			count = uint8((x >> 4) & (1<<2 - 1))
+		}
+		Rn := Reg((x >> 16) & (1<<4 - 1))
+		Rm := Reg(x & (1<<4 - 1))
+		return Mem{Base: Rn, Mode: AddrOffset, Sign: +1, Index: Rm, Shift: ShiftLeft, Count: count}
+
+	case arg_mem_R_imm8_T:
+		Rn := Reg((x >> 16) & (1<<4 - 1))
+		return Mem{Base: Rn, Mode: AddrOffset, Offset: int16(x & (1<<8 - 1))}
+
+	case arg_mem_R_imm8x4_T:
+		Rn := Reg((x >> 16) & (1<<4 - 1))
+		return Mem{Base: Rn, Mode: AddrOffset, Offset: int16(x & (1<<8 - 1) << 2)}
+
+	case arg_mem_R_pm_imm8_W_T, arg_mem_R_pm_imm8x4_W_T:
+		// Unlike ARM, Thumb encodes post-indexing as P=0, W=1.
+		Rn := Reg((x >> 16) & (1<<4 - 1))
+		imm := int16(x & (1<<8 - 1))
+		var p, u, w uint32
+		if aop == arg_mem_R_pm_imm8_W_T {
+			p, u, w = (x>>10)&1, (x>>9)&1, (x>>8)&1
+		} else {
+			p, u, w = (x>>24)&1, (x>>23)&1, (x>>21)&1
+			imm <<= 2
+		}
+		if u == 0 {
+			imm = -imm
+		}
+		var mode AddrMode
+		switch {
+		case p == 1 && w == 0:
+			mode = AddrOffset
+		case p == 1 && w == 1:
+			mode = AddrPreIndex
+		case p == 0 && w == 1:
+			mode = AddrPostIndex
+		default:
+			return nil
+		}
+		return Mem{Base: Rn, Mode: mode, Offset: imm}
+
+	case arg_mem_Rlow_Rlow:
+		Rn := Reg((x >> 3) & (1<<3 - 1))
+		Rm := Reg((x >> 6) & (1<<3 - 1))
+		return Mem{Base: Rn, Mode: AddrOffset, Sign: +1, Index: Rm}
+
+	case arg_mem_Rlow_imm5, arg_mem_Rlow_imm5x2, arg_mem_Rlow_imm5x4:
+		Rn := Reg((x >> 3) & (1<<3 - 1))
+		imm := int16((x >> 6) & (1<<5 - 1))
+		switch aop {
+		case arg_mem_Rlow_imm5x2:
+			imm <<= 1
+		case arg_mem_Rlow_imm5x4:
+			imm <<= 2
+		}
+		return Mem{Base: Rn, Mode: AddrOffset, Offset: imm}
+
+	case arg_mem_SP_imm8x4:
+		return Mem{Base: SP, Mode: AddrOffset, Offset: int16(x & (1<<8 - 1) << 2)}
+
+	case arg_registers8, arg_registers8_LR, arg_registers8_PC:
+		list := x & (1<<8 - 1)
+		switch aop {
+		case arg_registers8_LR:
+			list |= (x >> 8) & 1 << 14
+		case arg_registers8_PC:
+			list |= (x >> 8) & 1 << 15
+		}
+		if list == 0 {
+			return nil
+		}
+		return RegList(list)
+
+	case arg_satimm4_T:
+		return Imm(x & (1<<4 - 1))
+
+	case arg_satimm4m1_T:
+		return Imm(x&(1<<4-1) + 1)
+
+	case arg_satimm5_T:
+		return Imm(x & (1<<5 - 1))
+
+	case arg_satimm5m1_T:
+		return Imm(x&(1<<5-1) + 1)
+
+	case arg_sysm:
+		r := SysReg(x & (1<<8 - 1))
+		if _, ok := sysRegName[r]; !ok {
+			return nil
+		}
+		return r
+
+	case arg_widthm1_T:
+		return Imm(x&(1<<5-1) + 1)
+	}
+}
@@ -0,0 +1,586 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+// Thumb-only opcodes, numbered after the ARM opcodes in tables.go.
+// Like those, the conditional opcodes come in groups of 16 in condition order.
+const thumbOpBase = (Op(len(opstr)) + 15) &^ 15
+
+const (
+	ADDW_EQ Op = thumbOpBase + iota
+	ADDW_NE
+	ADDW_CS
+	ADDW_CC
+	ADDW_MI
+	ADDW_PL
+	ADDW_VS
+	ADDW_VC
+	ADDW_HI
+	ADDW_LS
+	ADDW_GE
+	ADDW_LT
+	ADDW_GT
+	ADDW_LE
+	ADDW
+	ADDW_ZZ
+	ORN_EQ
+	ORN_NE
+	ORN_CS
+	ORN_CC
+	ORN_MI
+	ORN_PL
+	ORN_VS
+	ORN_VC
+	ORN_HI
+	ORN_LS
+	ORN_GE
+	ORN_LT
+	ORN_GT
+	ORN_LE
+	ORN
+	ORN_ZZ
+	ORN_S_EQ
+	ORN_S_NE
+	ORN_S_CS
+	ORN_S_CC
+	ORN_S_MI
+	ORN_S_PL
+	ORN_S_VS
+	ORN_S_VC
+	ORN_S_HI
+	ORN_S_LS
+	ORN_S_GE
+	ORN_S_LT
+	ORN_S_GT
+	ORN_S_LE
+	ORN_S
+	ORN_S_ZZ
+	SUBW_EQ
+	SUBW_NE
+	SUBW_CS
+	SUBW_CC
+	SUBW_MI
+	SUBW_PL
+	SUBW_VS
+	SUBW_VC
+	SUBW_HI
+	SUBW_LS
+	SUBW_GE
+	SUBW_LT
+	SUBW_GT
+	SUBW_LE
+	SUBW
+	SUBW_ZZ
+	TBB_EQ
+	TBB_NE
+	TBB_CS
+	TBB_CC
+	TBB_MI
+	TBB_PL
+	TBB_VS
+	TBB_VC
+	TBB_HI
+	TBB_LS
+	TBB_GE
+	TBB_LT
+	TBB_GT
+	TBB_LE
+	TBB
+	TBB_ZZ
+	TBH_EQ
+	TBH_NE
+	TBH_CS
+	TBH_CC
+	TBH_MI
+	TBH_PL
+	TBH_VS
+	TBH_VC
+	TBH_HI
+	TBH_LS
+	TBH_GE
+	TBH_LT
+	TBH_GT
+	TBH_LE
+	TBH
+	TBH_ZZ
+	CBNZ
+	CBZ
+	CPSID
+	CPSIE
+	IT
+	ITT
+	ITE
+	ITTT
+	ITTE
+	ITET
+	ITEE
+	ITTTT
+	ITTTE
+	ITTET
+	ITTEE
+	ITETT
+	ITETE
+	ITEET
+	ITEEE
+	UDF
+)
+
+var thumbOpstr = [...]string{
+	ADDW_EQ - thumbOpBase:  "ADDW.EQ",
+	ADDW_NE - thumbOpBase:  "ADDW.NE",
+	ADDW_CS - thumbOpBase:  "ADDW.CS",
+	ADDW_CC - thumbOpBase:  "ADDW.CC",
+	ADDW_MI - thumbOpBase:  "ADDW.MI",
+	ADDW_PL - thumbOpBase:  "ADDW.PL",
+	ADDW_VS - thumbOpBase:  "ADDW.VS",
+	ADDW_VC - thumbOpBase:  "ADDW.VC",
+	ADDW_HI - thumbOpBase:  "ADDW.HI",
+	ADDW_LS - thumbOpBase:  "ADDW.LS",
+	ADDW_GE - thumbOpBase:  "ADDW.GE",
+	ADDW_LT - thumbOpBase:  "ADDW.LT",
+	ADDW_GT - thumbOpBase:  "ADDW.GT",
+	ADDW_LE - thumbOpBase:  "ADDW.LE",
+	ADDW - thumbOpBase:     "ADDW",
+	ADDW_ZZ - thumbOpBase:  "ADDW.ZZ",
+	ORN_EQ - thumbOpBase:   "ORN.EQ",
+	ORN_NE - thumbOpBase:   "ORN.NE",
+	ORN_CS - thumbOpBase:   "ORN.CS",
+	ORN_CC - thumbOpBase:   "ORN.CC",
+	ORN_MI - thumbOpBase:   "ORN.MI",
+	ORN_PL - thumbOpBase:   "ORN.PL",
+	ORN_VS - thumbOpBase:   "ORN.VS",
+	ORN_VC - thumbOpBase:   "ORN.VC",
+	ORN_HI - thumbOpBase:   "ORN.HI",
+	ORN_LS - thumbOpBase:   "ORN.LS",
+	ORN_GE - thumbOpBase:   "ORN.GE",
+	ORN_LT - thumbOpBase:   "ORN.LT",
+	ORN_GT - thumbOpBase:   "ORN.GT",
+	ORN_LE - thumbOpBase:   "ORN.LE",
+	ORN - thumbOpBase:      "ORN",
+	ORN_ZZ - thumbOpBase:   "ORN.ZZ",
+	ORN_S_EQ - thumbOpBase: "ORN.S.EQ",
+	ORN_S_NE - thumbOpBase: "ORN.S.NE",
+	ORN_S_CS - thumbOpBase: "ORN.S.CS",
+	ORN_S_CC - thumbOpBase: "ORN.S.CC",
+	ORN_S_MI - thumbOpBase: "ORN.S.MI",
+	ORN_S_PL - thumbOpBase: "ORN.S.PL",
+	ORN_S_VS - thumbOpBase: "ORN.S.VS",
+	ORN_S_VC - thumbOpBase: "ORN.S.VC",
+	ORN_S_HI - thumbOpBase: "ORN.S.HI",
+	ORN_S_LS - thumbOpBase: "ORN.S.LS",
+	ORN_S_GE - thumbOpBase: "ORN.S.GE",
+	ORN_S_LT - thumbOpBase: "ORN.S.LT",
+	ORN_S_GT - thumbOpBase: "ORN.S.GT",
+	ORN_S_LE - thumbOpBase: "ORN.S.LE",
+	ORN_S - thumbOpBase:    "ORN.S",
+	ORN_S_ZZ - thumbOpBase: "ORN.S.ZZ",
+	SUBW_EQ - thumbOpBase:  "SUBW.EQ",
+	SUBW_NE - thumbOpBase:  "SUBW.NE",
+	SUBW_CS - thumbOpBase:  "SUBW.CS",
+	SUBW_CC - thumbOpBase:  "SUBW.CC",
+	SUBW_MI - thumbOpBase:  "SUBW.MI",
+	SUBW_PL - thumbOpBase:  "SUBW.PL",
+	SUBW_VS - thumbOpBase:  "SUBW.VS",
+	SUBW_VC - thumbOpBase:  "SUBW.VC",
+	SUBW_HI - thumbOpBase:  "SUBW.HI",
+	SUBW_LS - thumbOpBase:  "SUBW.LS",
+	SUBW_GE - thumbOpBase:  "SUBW.GE",
+	SUBW_LT - thumbOpBase:  "SUBW.LT",
+	SUBW_GT - thumbOpBase:  "SUBW.GT",
+	SUBW_LE - thumbOpBase:  "SUBW.LE",
+	SUBW - thumbOpBase:     "SUBW",
+	SUBW_ZZ - thumbOpBase:  "SUBW.ZZ",
+	TBB_EQ - thumbOpBase:   "TBB.EQ",
+	TBB_NE - thumbOpBase:   "TBB.NE",
+	TBB_CS - thumbOpBase:   "TBB.CS",
+	TBB_CC - thumbOpBase:   "TBB.CC",
+	TBB_MI - thumbOpBase:   "TBB.MI",
+	TBB_PL - thumbOpBase:   "TBB.PL",
+	TBB_VS - thumbOpBase:   "TBB.VS",
+	TBB_VC - thumbOpBase:   "TBB.VC",
+	TBB_HI - thumbOpBase:   "TBB.HI",
+	TBB_LS - thumbOpBase:   "TBB.LS",
+	TBB_GE - thumbOpBase:   "TBB.GE",
+	TBB_LT - thumbOpBase:   "TBB.LT",
+	TBB_GT - thumbOpBase:   "TBB.GT",
+	TBB_LE - thumbOpBase:   "TBB.LE",
+	TBB - thumbOpBase:      "TBB",
+	TBB_ZZ - thumbOpBase:   "TBB.ZZ",
+	TBH_EQ - thumbOpBase:   "TBH.EQ",
+	TBH_NE - thumbOpBase:   "TBH.NE",
+	TBH_CS - thumbOpBase:   "TBH.CS",
+	TBH_CC - thumbOpBase:   "TBH.CC",
+	TBH_MI - thumbOpBase:   "TBH.MI",
+	TBH_PL - thumbOpBase:   "TBH.PL",
+	TBH_VS - thumbOpBase:   "TBH.VS",
+	TBH_VC - thumbOpBase:   "TBH.VC",
+	TBH_HI - thumbOpBase:   "TBH.HI",
+	TBH_LS - thumbOpBase:   "TBH.LS",
+	TBH_GE - thumbOpBase:   "TBH.GE",
+	TBH_LT - thumbOpBase:   "TBH.LT",
+	TBH_GT - thumbOpBase:   "TBH.GT",
+	TBH_LE - thumbOpBase:   "TBH.LE",
+	TBH - thumbOpBase:      "TBH",
+	TBH_ZZ - thumbOpBase:   "TBH.ZZ",
+	CBNZ - thumbOpBase:     "CBNZ",
+	CBZ - thumbOpBase:      "CBZ",
+	CPSID - thumbOpBase:    "CPSID",
+	CPSIE - thumbOpBase:    "CPSIE",
+	IT - thumbOpBase:       "IT",
+	ITT - thumbOpBase:      "ITT",
+	ITE - thumbOpBase:      "ITE",
+	ITTT - thumbOpBase:     "ITTT",
+	ITTE - thumbOpBase:     "ITTE",
+	ITET - thumbOpBase:     "ITET",
+	ITEE - thumbOpBase:     "ITEE",
+	ITTTT - thumbOpBase:    "ITTTT",
+	ITTTE - thumbOpBase:    "ITTTE",
+	ITTET - thumbOpBase:    "ITTET",
+	ITTEE - thumbOpBase:    "ITTEE",
+	ITETT - thumbOpBase:    "ITETT",
+	ITETE - thumbOpBase:    "ITETE",
+	ITEET - thumbOpBase:    "ITEET",
+	ITEEE - thumbOpBase:    "ITEEE",
+	UDF - thumbOpBase:      "UDF",
+}
+
+// thumb16Formats describes the 16-bit Thumb instruction encodings.
+// The comments give the assembler syntax and encoding name
+// from the ARM Architecture Reference Manual.
+var thumb16Formats = [...]thumbFormat{
+	{0xffc0, 0x0000, MOV_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // MOVS <Rd>,<Rm> T2
+	{0xf800, 0x0000, LSL_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_imm_5at6}},    // LSL{S}<c> <Rd>,<Rm>,#<imm5> T1
+	{0xf800, 0x0800, LSR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_imm_5at6_32}}, // LSR{S}<c> <Rd>,<Rm>,#<imm5> T1
+	{0xf800, 0x1000, ASR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_imm_5at6_32}}, // ASR{S}<c> <Rd>,<Rm>,#<imm5> T1
+	{0xfe00, 0x1800, ADD_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_Rlow_6}},      // ADD{S}<c> <Rd>,<Rn>,<Rm> T1
+	{0xfe00, 0x1a00, SUB_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_Rlow_6}},      // SUB{S}<c> <Rd>,<Rn>,<Rm> T1
+	{0xfe00, 0x1c00, ADD_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_imm_3at6}},    // ADD{S}<c> <Rd>,<Rn>,#<imm3> T1
+	{0xfe00, 0x1e00, SUB_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_imm_3at6}},    // SUB{S}<c> <Rd>,<Rn>,#<imm3> T1
+	{0xf800, 0x2000, MOV_EQ, thumbSOutsideIT, instArgs{arg_Rlow_8, arg_imm_8at0}},                // MOV{S}<c> <Rd>,#<imm8> T1
+	{0xf800, 0x2800, CMP_EQ, 0, instArgs{arg_Rlow_8, arg_imm_8at0}},                              // CMP<c> <Rn>,#<imm8> T1
+	{0xf800, 0x3000, ADD_EQ, thumbSOutsideIT, instArgs{arg_Rlow_8, arg_imm_8at0}},                // ADD{S}<c> <Rdn>,#<imm8> T2
+	{0xf800, 0x3800, SUB_EQ, thumbSOutsideIT, instArgs{arg_Rlow_8, arg_imm_8at0}},                // SUB{S}<c> <Rdn>,#<imm8> T2
+	{0xffc0, 0x4000, AND_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // AND{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4040, EOR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // EOR{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4080, LSL_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // LSL{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x40c0, LSR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // LSR{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4100, ASR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // ASR{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4140, ADC_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // ADC{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4180, SBC_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // SBC{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x41c0, ROR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // ROR{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4200, TST_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                                // TST<c> <Rn>,<Rm> T1
+	{0xffc0, 0x4240, RSB_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3, arg_fp_0}},        // RSB{S}<c> <Rd>,<Rn>,#0 T1
+	{0xffc0, 0x4280, CMP_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                                // CMP<c> <Rn>,<Rm> T1
+	{0xffc0, 0x42c0, CMN_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                                // CMN<c> <Rn>,<Rm> T1
+	{0xffc0, 0x4300, ORR_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // ORR{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x4340, MUL_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // MUL{S}<c> <Rdm>,<Rn> T1
+	{0xffc0, 0x4380, BIC_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // BIC{S}<c> <Rdn>,<Rm> T1
+	{0xffc0, 0x43c0, MVN_EQ, thumbSOutsideIT, instArgs{arg_Rlow_0, arg_Rlow_3}},                  // MVN{S}<c> <Rdn>,<Rm> T1
+	{0xff00, 0x4400, ADD_EQ, 0, instArgs{arg_R_dn, arg_R_3}},                                     // ADD<c> <Rdn>,<Rm> T2
+	{0xff00, 0x4500, CMP_EQ, 0, instArgs{arg_R_dn, arg_R_3}},                                     // CMP<c> <Rn>,<Rm> T2
+	{0xff00, 0x4600, MOV_EQ, 0, instArgs{arg_R_dn, arg_R_3}},                                     // MOV<c> <Rd>,<Rm> T1
+	{0xff87, 0x4700, BX_EQ, 0, instArgs{arg_R_3}},                                                // BX<c> <Rm> T1
+	{0xff87, 0x4780, BLX_EQ, 0, instArgs{arg_R_3}},                                               // BLX<c> <Rm> T1
+	{0xf800, 0x4800, LDR_EQ, 0, instArgs{arg_Rlow_8, arg_label_p_8x4}},                           // LDR<c> <Rt>,<label> T1
+	{0xfe00, 0x5000, STR_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                         // STR<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5200, STRH_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                        // STRH<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5400, STRB_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                        // STRB<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5600, LDRSB_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                       // LDRSB<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5800, LDR_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                         // LDR<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5a00, LDRH_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                        // LDRH<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5c00, LDRB_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                        // LDRB<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xfe00, 0x5e00, LDRSH_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_Rlow}},                       // LDRSH<c> <Rt>,[<Rn>,<Rm>] T1
+	{0xf800, 0x6000, STR_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_imm5x4}},                       // STR<c> <Rt>,[<Rn>{,#<imm5>}] T1
+	{0xf800, 0x6800, LDR_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_imm5x4}},                       // LDR<c> <Rt>,[<Rn>{,#<imm5>}] T1
+	{0xf800, 0x7000, STRB_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_imm5}},                        // STRB<c> <Rt>,[<Rn>{,#<imm5>}] T1
+	{0xf800, 0x7800, LDRB_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_imm5}},                        // LDRB<c> <Rt>,[<Rn>{,#<imm5>}] T1
+	{0xf800, 0x8000, STRH_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_imm5x2}},                      // STRH<c> <Rt>,[<Rn>{,#<imm5>}] T1
+	{0xf800, 0x8800, LDRH_EQ, 0, instArgs{arg_Rlow_0, arg_mem_Rlow_imm5x2}},                      // LDRH<c> <Rt>,[<Rn>{,#<imm5>}] T1
+	{0xf800, 0x9000, STR_EQ, 0, instArgs{arg_Rlow_8, arg_mem_SP_imm8x4}},                         // STR<c> <Rt>,[SP,#<imm8>] T2
+	{0xf800, 0x9800, LDR_EQ, 0, instArgs{arg_Rlow_8, arg_mem_SP_imm8x4}},                         // LDR<c> <Rt>,[SP,#<imm8>] T2
+	{0xf800, 0xa000, ADD_EQ, 0, instArgs{arg_Rlow_8, arg_PC, arg_imm_8at0x4}},                    // ADR<c> <Rd>,<label> T1
+	{0xf800, 0xa800, ADD_EQ, 0, instArgs{arg_Rlow_8, arg_SP, arg_imm_8at0x4}},                    // ADD<c> <Rd>,SP,#<imm8> T1
+	{0xff80, 0xb000, ADD_EQ, 0, instArgs{arg_SP, arg_imm_7at0x4}},                                // ADD<c> SP,SP,#<imm7> T2
+	{0xff80, 0xb080, SUB_EQ, 0, instArgs{arg_SP, arg_imm_7at0x4}},                                // SUB<c> SP,SP,#<imm7> T1
+	{0xfd00, 0xb100, CBZ, thumbUncond, instArgs{arg_Rlow_0, arg_label_cb}},                       // CBZ <Rn>,<label> T1
+	{0xffc0, 0xb200, SXTH_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                               // SXTH<c> <Rd>,<Rm> T1
+	{0xffc0, 0xb240, SXTB_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                               // SXTB<c> <Rd>,<Rm> T1
+	{0xffc0, 0xb280, UXTH_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                               // UXTH<c> <Rd>,<Rm> T1
+	{0xffc0, 0xb2c0, UXTB_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                               // UXTB<c> <Rd>,<Rm> T1
+	{0xfe00, 0xb400, PUSH_EQ, 0, instArgs{arg_registers8_LR}},                                    // PUSH<c> <registers> T1
+	{0xfff7, 0xb650, SETEND, thumbUncond, instArgs{arg_endian_3}},                                // SETEND <endian_specifier> T1
+	{0xfff8, 0xb660, CPSIE, thumbUncond, instArgs{arg_iflags}},                                   // CPSIE <iflags> T1
+	{0xfff8, 0xb670, CPSID, thumbUncond, instArgs{arg_iflags}},                                   // CPSID <iflags> T1
+	{0xfd00, 0xb900, CBNZ, thumbUncond, instArgs{arg_Rlow_0, arg_label_cb}},                      // CBNZ <Rn>,<label> T1
+	{0xffc0, 0xba00, REV_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                                // REV<c> <Rd>,<Rm> T1
+	{0xffc0, 0xba40, REV16_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                              // REV16<c> <Rd>,<Rm> T1
+	{0xffc0, 0xbac0, REVSH_EQ, 0, instArgs{arg_Rlow_0, arg_Rlow_3}},                              // REVSH<c> <Rd>,<Rm> T1
+	{0xfe00, 0xbc00, POP_EQ, 0, instArgs{arg_registers8_PC}},                                     // POP<c> <registers> T1
+	{0xff00, 0xbe00, BKPT, thumbUncond, instArgs{arg_imm_8at0}},                                  // BKPT #<imm8> T1
+	{0xffff, 0xbf00, NOP_EQ, 0, instArgs{}},                                                      // NOP<c> T1
+	{0xffff, 0xbf10, YIELD_EQ, 0, instArgs{}},                                                    // YIELD<c> T1
+	{0xffff, 0xbf20, WFE_EQ, 0, instArgs{}},                                                      // WFE<c> T1
+	{0xffff, 0xbf30, WFI_EQ, 0, instArgs{}},                                                      // WFI<c> T1
+	{0xffff, 0xbf40, SEV_EQ, 0, instArgs{}},                                                      // SEV<c> T1
+	{0xff00, 0xbf00, IT, thumbUncond, instArgs{arg_firstcond}},                                   // IT{<x>{<y>{<z>}}} <firstcond> T1
+	{0xf800, 0xc000, STM_EQ, 0, instArgs{arg_Rlow_8_WB, arg_registers8}},                         // STM<c> <Rn>!,<registers> T1
+	{0xf800, 0xc800, LDM_EQ, 0, instArgs{arg_Rlow_8_WB_LDM, arg_registers8}},                     // LDM<c> <Rn>{!},<registers> T1
+	{0xff00, 0xde00, UDF, thumbUncond, instArgs{arg_imm_8at0}},                                   // UDF #<imm8> T1
+	{0xff00, 0xdf00, SVC_EQ, 0, instArgs{arg_imm_8at0}},                                          // SVC<c> #<imm8> T1
+	{0xf000, 0xd000, B_EQ, thumbCond8, instArgs{arg_label_T1}},                                   // B<c> <label> T1
+	{0xf800, 0xe000, B_EQ, 0, instArgs{arg_label_T2}},                                            // B<c> <label> T2
+}
+
+// thumb32Formats describes the 32-bit Thumb instruction encodings,
+// other than the coprocessor, floating-point and Advanced SIMD instructions.
+var thumb32Formats = [...]thumbFormat{
+	{0xffff0000, 0xe8bd0000, POP_EQ, 0, instArgs{arg_registers2}},                                         // POP<c>.W <registers> T2
+	{0xffd00000, 0xe8800000, STM_EQ, 0, instArgs{arg_R_16_WB, arg_registers2}},                            // STM<c>.W <Rn>{!},<registers> T2
+	{0xffd00000, 0xe8900000, LDM_EQ, 0, instArgs{arg_R_16_WB, arg_registers2}},                            // LDM<c>.W <Rn>{!},<registers> T2
+	{0xffff0000, 0xe92d0000, PUSH_EQ, 0, instArgs{arg_registers2}},                                        // PUSH<c>.W <registers> T2
+	{0xffd00000, 0xe9000000, STMDB_EQ, 0, instArgs{arg_R_16_WB, arg_registers2}},                          // STMDB<c> <Rn>{!},<registers> T1
+	{0xffd00000, 0xe9100000, LDMDB_EQ, 0, instArgs{arg_R_16_WB, arg_registers2}},                          // LDMDB<c> <Rn>{!},<registers> T1
+	{0xfff00000, 0xe8400000, STREX_EQ, 0, instArgs{arg_R_8, arg_R_12, arg_mem_R_imm8x4_T}},                // STREX<c> <Rd>,<Rt>,[<Rn>{,#<imm>}] T1
+	{0xfff00f00, 0xe8500f00, LDREX_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8x4_T}},                         // LDREX<c> <Rt>,[<Rn>{,#<imm>}] T1
+	{0xfff00ff0, 0xe8c00f40, STREXB_EQ, 0, instArgs{arg_R_0, arg_R_12, arg_mem_R}},                        // STREXB<c> <Rd>,<Rt>,[<Rn>] T1
+	{0xfff00ff0, 0xe8c00f50, STREXH_EQ, 0, instArgs{arg_R_0, arg_R_12, arg_mem_R}},                        // STREXH<c> <Rd>,<Rt>,[<Rn>] T1
+	{0xfff000f0, 0xe8c00070, STREXD_EQ, 0, instArgs{arg_R_0, arg_R_12, arg_R_8, arg_mem_R}},               // STREXD<c> <Rd>,<Rt>,<Rt2>,[<Rn>] T1
+	{0xfff0fff0, 0xe8d0f000, TBB_EQ, 0, instArgs{arg_mem_R_R_T}},                                          // TBB<c> [<Rn>,<Rm>] T1
+	{0xfff0fff0, 0xe8d0f010, TBH_EQ, 0, instArgs{arg_mem_R_R_lsl1_T}},                                     // TBH<c> [<Rn>,<Rm>,LSL #1] T1
+	{0xfff00fff, 0xe8d00f4f, LDREXB_EQ, 0, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXB<c> <Rt>,[<Rn>] T1
+	{0xfff00fff, 0xe8d00f5f, LDREXH_EQ, 0, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXH<c> <Rt>,[<Rn>] T1
+	{0xfff000ff, 0xe8d0007f, LDREXD_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_mem_R}},                        // LDREXD<c> <Rt>,<Rt2>,[<Rn>] T1
+	{0xfe500000, 0xe8400000, STRD_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_mem_R_pm_imm8x4_W_T}},            // STRD<c> <Rt>,<Rt2>,[<Rn>{,#+/-<imm>}]{!} T1
+	{0xfe500000, 0xe8500000, LDRD_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_mem_R_pm_imm8x4_W_T}},            // LDRD<c> <Rt>,<Rt2>,[<Rn>{,#+/-<imm>}]{!} T1
+	{0xfff08f00, 0xea100f00, TST_EQ, 0, instArgs{arg_R_16, arg_R_shift_imm_T}},                            // TST<c>.W <Rn>,<Rm>{,<shift>} T2
+	{0xffe08000, 0xea000000, AND_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // AND{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xffe08000, 0xea200000, BIC_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // BIC{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xffeff0f0, 0xea4f0000, MOV_EQ, thumbS20, instArgs{arg_R_8, arg_R_0}},                                // MOV{S}<c>.W <Rd>,<Rm> T3
+	{0xffeff0f0, 0xea4f0030, RRX_EQ, thumbS20, instArgs{arg_R_8, arg_R_0}},                                // RRX{S}<c> <Rd>,<Rm> T1
+	{0xffef8030, 0xea4f0000, LSL_EQ, thumbS20, instArgs{arg_R_8, arg_R_0, arg_imm_3at12_2at6}},            // LSL{S}<c>.W <Rd>,<Rm>,#<imm5> T2
+	{0xffef8030, 0xea4f0010, LSR_EQ, thumbS20, instArgs{arg_R_8, arg_R_0, arg_imm_3at12_2at6_32}},         // LSR{S}<c>.W <Rd>,<Rm>,#<imm5> T2
+	{0xffef8030, 0xea4f0020, ASR_EQ, thumbS20, instArgs{arg_R_8, arg_R_0, arg_imm_3at12_2at6_32}},         // ASR{S}<c>.W <Rd>,<Rm>,#<imm5> T2
+	{0xffef8030, 0xea4f0030, ROR_EQ, thumbS20, instArgs{arg_R_8, arg_R_0, arg_imm_3at12_2at6}},            // ROR{S}<c> <Rd>,<Rm>,#<imm5> T1
+	{0xffe08000, 0xea400000, ORR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // ORR{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xffef8000, 0xea6f0000, MVN_EQ, thumbS20, instArgs{arg_R_8, arg_R_shift_imm_T}},                      // MVN{S}<c>.W <Rd>,<Rm>{,<shift>} T2
+	{0xffe08000, 0xea600000, ORN_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // ORN{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} T1
+	{0xfff08f00, 0xea900f00, TEQ_EQ, 0, instArgs{arg_R_16, arg_R_shift_imm_T}},                            // TEQ<c> <Rn>,<Rm>{,<shift>} T1
+	{0xffe08000, 0xea800000, EOR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // EOR{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xfff08030, 0xeac00000, PKHBT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},                 // PKHBT<c> <Rd>,<Rn>,<Rm>{,LSL #<imm>} T1
+	{0xfff08030, 0xeac00020, PKHTB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},                 // PKHTB<c> <Rd>,<Rn>,<Rm>{,ASR #<imm>} T1
+	{0xfff08f00, 0xeb100f00, CMN_EQ, 0, instArgs{arg_R_16, arg_R_shift_imm_T}},                            // CMN<c>.W <Rn>,<Rm>{,<shift>} T2
+	{0xffe08000, 0xeb000000, ADD_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // ADD{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T3
+	{0xffe08000, 0xeb400000, ADC_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // ADC{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xffe08000, 0xeb600000, SBC_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // SBC{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xfff08f00, 0xebb00f00, CMP_EQ, 0, instArgs{arg_R_16, arg_R_shift_imm_T}},                            // CMP<c>.W <Rn>,<Rm>{,<shift>} T3
+	{0xffe08000, 0xeba00000, SUB_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // SUB{S}<c>.W <Rd>,<Rn>,<Rm>{,<shift>} T2
+	{0xffe08000, 0xebc00000, RSB_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_shift_imm_T}},            // RSB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} T1
+	{0xfbf08f00, 0xf0100f00, TST_EQ, 0, instArgs{arg_R_16, arg_const_T}},                                  // TST<c> <Rn>,#<const> T1
+	{0xfbe08000, 0xf0000000, AND_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // AND{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbe08000, 0xf0200000, BIC_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // BIC{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbef8000, 0xf04f0000, MOV_EQ, thumbS20, instArgs{arg_R_8, arg_const_T}},                            // MOV{S}<c>.W <Rd>,#<const> T2
+	{0xfbe08000, 0xf0400000, ORR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // ORR{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbef8000, 0xf06f0000, MVN_EQ, thumbS20, instArgs{arg_R_8, arg_const_T}},                            // MVN{S}<c> <Rd>,#<const> T1
+	{0xfbe08000, 0xf0600000, ORN_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // ORN{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbf08f00, 0xf0900f00, TEQ_EQ, 0, instArgs{arg_R_16, arg_const_T}},                                  // TEQ<c> <Rn>,#<const> T1
+	{0xfbe08000, 0xf0800000, EOR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // EOR{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbf08f00, 0xf1100f00, CMN_EQ, 0, instArgs{arg_R_16, arg_const_T}},                                  // CMN<c> <Rn>,#<const> T1
+	{0xfbe08000, 0xf1000000, ADD_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // ADD{S}<c>.W <Rd>,<Rn>,#<const> T3
+	{0xfbe08000, 0xf1400000, ADC_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // ADC{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbe08000, 0xf1600000, SBC_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // SBC{S}<c> <Rd>,<Rn>,#<const> T1
+	{0xfbf08f00, 0xf1b00f00, CMP_EQ, 0, instArgs{arg_R_16, arg_const_T}},                                  // CMP<c>.W <Rn>,#<const> T2
+	{0xfbe08000, 0xf1a00000, SUB_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // SUB{S}<c>.W <Rd>,<Rn>,#<const> T3
+	{0xfbe08000, 0xf1c00000, RSB_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_const_T}},                  // RSB{S}<c>.W <Rd>,<Rn>,#<const> T2
+	{0xfbf08000, 0xf2000000, ADDW_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_imm_1at26_3at12_8at0}},           // ADDW<c> <Rd>,<Rn>,#<imm12> T4
+	{0xfbf08000, 0xf2400000, MOVW_EQ, 0, instArgs{arg_R_8, arg_imm_4at16_1at26_3at12_8at0}},               // MOVW<c> <Rd>,#<imm16> T3
+	{0xfbf08000, 0xf2a00000, SUBW_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_imm_1at26_3at12_8at0}},           // SUBW<c> <Rd>,<Rn>,#<imm12> T4
+	{0xfbf08000, 0xf2c00000, MOVT_EQ, 0, instArgs{arg_R_8, arg_imm_4at16_1at26_3at12_8at0}},               // MOVT<c> <Rd>,#<imm16> T1
+	{0xfff0f0f0, 0xf3200000, SSAT16_EQ, 0, instArgs{arg_R_8, arg_satimm4m1_T, arg_R_16}},                  // SSAT16<c> <Rd>,#<imm>,<Rn> T1
+	{0xffd08020, 0xf3000000, SSAT_EQ, 0, instArgs{arg_R_8, arg_satimm5m1_T, arg_R_16_shift_T}},            // SSAT<c> <Rd>,#<imm>,<Rn>{,<shift>} T1
+	{0xfff08020, 0xf3400000, SBFX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_imm_3at12_2at6, arg_widthm1_T}},  // SBFX<c> <Rd>,<Rn>,#<lsb>,#<width> T1
+	{0xffff8020, 0xf36f0000, BFC_EQ, 0, instArgs{arg_R_8, arg_imm_3at12_2at6, arg_lsb_width_T}},           // BFC<c> <Rd>,#<lsb>,#<width> T1
+	{0xfff08020, 0xf3600000, BFI_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_imm_3at12_2at6, arg_lsb_width_T}}, // BFI<c> <Rd>,<Rn>,#<lsb>,#<width> T1
+	{0xfff0f0f0, 0xf3a00000, USAT16_EQ, 0, instArgs{arg_R_8, arg_satimm4_T, arg_R_16}},                    // USAT16<c> <Rd>,#<imm4>,<Rn> T1
+	{0xffd08020, 0xf3800000, USAT_EQ, 0, instArgs{arg_R_8, arg_satimm5_T, arg_R_16_shift_T}},              // USAT<c> <Rd>,#<imm5>,<Rn>{,<shift>} T1
+	{0xfff08020, 0xf3c00000, UBFX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_imm_3at12_2at6, arg_widthm1_T}},  // UBFX<c> <Rd>,<Rn>,#<lsb>,#<width> T1
+	{0xfff0ffff, 0xf3808c00, MSR_EQ, 0, instArgs{arg_APSR, arg_R_16}},                                     // MSR<c> APSR_nzcvqg,<Rn> T1
+	{0xfff0ff00, 0xf3808800, MSR_EQ, 0, instArgs{arg_sysm, arg_R_16}},                                     // MSR<c> <spec_reg>,<Rn> T1 (M profile)
+	{0xffffffff, 0xf3af8000, NOP_EQ, 0, instArgs{}},                                                       // NOP<c>.W T2
+	{0xffffffff, 0xf3af8001, YIELD_EQ, 0, instArgs{}},                                                     // YIELD<c>.W T2
+	{0xffffffff, 0xf3af8002, WFE_EQ, 0, instArgs{}},                                                       // WFE<c>.W T2
+	{0xffffffff, 0xf3af8003, WFI_EQ, 0, instArgs{}},                                                       // WFI<c>.W T2
+	{0xffffffff, 0xf3af8004, SEV_EQ, 0, instArgs{}},                                                       // SEV<c>.W T2
+	{0xfffffff0, 0xf3af80f0, DBG_EQ, 0, instArgs{arg_option}},                                             // DBG<c> #<option> T1
+	{0xffffffff, 0xf3bf8f2f, CLREX, thumbUncond, instArgs{}},                                              // CLREX<c> T1
+	{0xfffffff0, 0xf3bf8f40, DSB, thumbUncond, instArgs{arg_option}},                                      // DSB<c> <option> T1
+	{0xfffffff0, 0xf3bf8f50, DMB, thumbUncond, instArgs{arg_option}},                                      // DMB<c> <option> T1
+	{0xfffffff0, 0xf3bf8f60, ISB, thumbUncond, instArgs{arg_option}},                                      // ISB<c> <option> T1
+	{0xffffff00, 0xf3de8f00, SUB_S_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_imm_8at0}},                      // SUBS<c> PC,LR,#<imm8> T1
+	{0xfffff0ff, 0xf3ef8000, MRS_EQ, 0, instArgs{arg_R_8, arg_APSR}},                                      // MRS<c> <Rd>,APSR T1
+	{0xfffff000, 0xf3ef8000, MRS_EQ, 0, instArgs{arg_R_8, arg_sysm}},                                      // MRS<c> <Rd>,<spec_reg> T1 (M profile)
+	{0xfff0f000, 0xf7f0a000, UDF, thumbUncond, instArgs{arg_imm_4at16_12at0}},                             // UDF.W #<imm16> T2
+	{0xf800d000, 0xf0008000, B_EQ, thumbCond22, instArgs{arg_label_T3}},                                   // B<c>.W <label> T3
+	{0xf800d000, 0xf0009000, B_EQ, 0, instArgs{arg_label_T4}},                                             // B<c>.W <label> T4
+	{0xf800d001, 0xf000c000, BLX, thumbUncond, instArgs{arg_label_T4_4}},                                  // BLX<c> <label> T2
+	{0xf800d000, 0xf000d000, BL_EQ, 0, instArgs{arg_label_T4}},                                            // BL<c> <label> T1
+	{0xff7ff000, 0xf81ff000, PLD, thumbUncond, instArgs{arg_label_pm_12}},                                 // PLD <label> T1
+	{0xfff0f000, 0xf890f000, PLD, thumbUncond, instArgs{arg_mem_R_pm_imm12_offset}},                       // PLD [<Rn>,#<imm12>] T1
+	{0xfff0ff00, 0xf810fc00, PLD, thumbUncond, instArgs{arg_mem_R_pm_imm8_W_T}},                           // PLD [<Rn>,#-<imm8>] T2
+	{0xfff0ffc0, 0xf810f000, PLD, thumbUncond, instArgs{arg_mem_R_R_shift_T}},                             // PLD [<Rn>,<Rm>{,LSL #<imm2>}] T1
+	{0xfff0f000, 0xf8b0f000, PLD_W, thumbUncond, instArgs{arg_mem_R_pm_imm12_offset}},                     // PLDW [<Rn>,#<imm12>] T1
+	{0xfff0ff00, 0xf830fc00, PLD_W, thumbUncond, instArgs{arg_mem_R_pm_imm8_W_T}},                         // PLDW [<Rn>,#-<imm8>] T2
+	{0xfff0ffc0, 0xf830f000, PLD_W, thumbUncond, instArgs{arg_mem_R_R_shift_T}},                           // PLDW [<Rn>,<Rm>{,LSL #<imm2>}] T1
+	{0xff7ff000, 0xf91ff000, PLI, thumbUncond, instArgs{arg_label_pm_12}},                                 // PLI <label> T1
+	{0xfff0f000, 0xf990f000, PLI, thumbUncond, instArgs{arg_mem_R_pm_imm12_offset}},                       // PLI [<Rn>,#<imm12>] T1
+	{0xfff0ff00, 0xf910fc00, PLI, thumbUncond, instArgs{arg_mem_R_pm_imm8_W_T}},                           // PLI [<Rn>,#-<imm8>] T2
+	{0xfff0ffc0, 0xf910f000, PLI, thumbUncond, instArgs{arg_mem_R_R_shift_T}},                             // PLI [<Rn>,<Rm>{,LSL #<imm2>}] T1
+	{0xffff0fff, 0xf84d0d04, PUSH_EQ, 0, instArgs{arg_registers1}},                                        // PUSH<c>.W <registers> T3
+	{0xffff0fff, 0xf85d0b04, POP_EQ, 0, instArgs{arg_registers1}},                                         // POP<c>.W <registers> T3
+	{0xfff00000, 0xf8800000, STRB_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                   // STRB<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf8000e00, STRBT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                           // STRBT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf8000800, STRB_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                       // STRB<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf8000000, STRB_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                         // STRB<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xfff00000, 0xf8a00000, STRH_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                   // STRH<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf8200e00, STRHT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                           // STRHT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf8200800, STRH_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                       // STRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf8200000, STRH_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                         // STRH<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xfff00000, 0xf8c00000, STR_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                    // STR<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf8400e00, STRT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                            // STRT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf8400800, STR_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                        // STR<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf8400000, STR_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                          // STR<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xff7f0000, 0xf81f0000, LDRB_EQ, 0, instArgs{arg_R_12, arg_label_pm_12}},                             // LDRB<c>.W <Rt>,<label> T2
+	{0xfff00000, 0xf8900000, LDRB_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                   // LDRB<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf8100e00, LDRBT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                           // LDRBT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf8100800, LDRB_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                       // LDRB<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf8100000, LDRB_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                         // LDRB<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xff7f0000, 0xf83f0000, LDRH_EQ, 0, instArgs{arg_R_12, arg_label_pm_12}},                             // LDRH<c>.W <Rt>,<label> T2
+	{0xfff00000, 0xf8b00000, LDRH_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                   // LDRH<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf8300e00, LDRHT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                           // LDRHT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf8300800, LDRH_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                       // LDRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf8300000, LDRH_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                         // LDRH<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xff7f0000, 0xf85f0000, LDR_EQ, 0, instArgs{arg_R_12, arg_label_pm_12}},                              // LDR<c>.W <Rt>,<label> T2
+	{0xfff00000, 0xf8d00000, LDR_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                    // LDR<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf8500e00, LDRT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                            // LDRT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf8500800, LDR_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                        // LDR<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf8500000, LDR_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                          // LDR<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xff7f0000, 0xf91f0000, LDRSB_EQ, 0, instArgs{arg_R_12, arg_label_pm_12}},                            // LDRSB<c>.W <Rt>,<label> T2
+	{0xfff00000, 0xf9900000, LDRSB_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                  // LDRSB<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf9100e00, LDRSBT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                          // LDRSBT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf9100800, LDRSB_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                      // LDRSB<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf9100000, LDRSB_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                        // LDRSB<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xff7f0000, 0xf93f0000, LDRSH_EQ, 0, instArgs{arg_R_12, arg_label_pm_12}},                            // LDRSH<c>.W <Rt>,<label> T2
+	{0xfff00000, 0xf9b00000, LDRSH_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm12_offset}},                  // LDRSH<c>.W <Rt>,[<Rn>{,#<imm12>}] T2
+	{0xfff00f00, 0xf9300e00, LDRSHT_EQ, 0, instArgs{arg_R_12, arg_mem_R_imm8_T}},                          // LDRSHT<c> <Rt>,[<Rn>{,#<imm8>}] T1
+	{0xfff00800, 0xf9300800, LDRSH_EQ, 0, instArgs{arg_R_12, arg_mem_R_pm_imm8_W_T}},                      // LDRSH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} T3
+	{0xfff00fc0, 0xf9300000, LDRSH_EQ, 0, instArgs{arg_R_12, arg_mem_R_R_shift_T}},                        // LDRSH<c>.W <Rt>,[<Rn>,<Rm>{,LSL #<imm2>}] T2
+	{0xffe0f0f0, 0xfa00f000, LSL_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_0}},                      // LSL{S}<c>.W <Rd>,<Rn>,<Rm> T2
+	{0xffe0f0f0, 0xfa20f000, LSR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_0}},                      // LSR{S}<c>.W <Rd>,<Rn>,<Rm> T2
+	{0xffe0f0f0, 0xfa40f000, ASR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_0}},                      // ASR{S}<c>.W <Rd>,<Rn>,<Rm> T2
+	{0xffe0f0f0, 0xfa60f000, ROR_EQ, thumbS20, instArgs{arg_R_8, arg_R_16, arg_R_0}},                      // ROR{S}<c>.W <Rd>,<Rn>,<Rm> T2
+	{0xfffff0c0, 0xfa0ff080, SXTH_EQ, 0, instArgs{arg_R_8, arg_R_rotate_T}},                               // SXTH<c>.W <Rd>,<Rm>{,<rotation>} T2
+	{0xfff0f0c0, 0xfa00f080, SXTAH_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_rotate_T}},                    // SXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>} T1
+	{0xfffff0c0, 0xfa1ff080, UXTH_EQ, 0, instArgs{arg_R_8, arg_R_rotate_T}},                               // UXTH<c>.W <Rd>,<Rm>{,<rotation>} T2
+	{0xfff0f0c0, 0xfa10f080, UXTAH_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_rotate_T}},                    // UXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>} T1
+	{0xfffff0c0, 0xfa2ff080, SXTB16_EQ, 0, instArgs{arg_R_8, arg_R_rotate_T}},                             // SXTB16<c> <Rd>,<Rm>{,<rotation>} T1
+	{0xfff0f0c0, 0xfa20f080, SXTAB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_rotate_T}},                  // SXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>} T1
+	{0xfffff0c0, 0xfa3ff080, UXTB16_EQ, 0, instArgs{arg_R_8, arg_R_rotate_T}},                             // UXTB16<c> <Rd>,<Rm>{,<rotation>} T1
+	{0xfff0f0c0, 0xfa30f080, UXTAB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_rotate_T}},                  // UXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>} T1
+	{0xfffff0c0, 0xfa4ff080, SXTB_EQ, 0, instArgs{arg_R_8, arg_R_rotate_T}},                               // SXTB<c>.W <Rd>,<Rm>{,<rotation>} T2
+	{0xfff0f0c0, 0xfa40f080, SXTAB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_rotate_T}},                    // SXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>} T1
+	{0xfffff0c0, 0xfa5ff080, UXTB_EQ, 0, instArgs{arg_R_8, arg_R_rotate_T}},                               // UXTB<c>.W <Rd>,<Rm>{,<rotation>} T2
+	{0xfff0f0c0, 0xfa50f080, UXTAB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_rotate_T}},                    // UXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>} T1
+	{0xfff0f0f0, 0xfa90f000, SADD16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SADD16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa90f010, QADD16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // QADD16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa90f020, SHADD16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // SHADD16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa90f040, UADD16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // UADD16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa90f050, UQADD16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // UQADD16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa90f060, UHADD16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // UHADD16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfaa0f000, SASX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // SASX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfaa0f010, QASX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // QASX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfaa0f020, SHASX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SHASX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfaa0f040, UASX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // UASX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfaa0f050, UQASX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // UQASX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfaa0f060, UHASX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // UHASX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfae0f000, SSAX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // SSAX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfae0f010, QSAX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // QSAX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfae0f020, SHSAX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SHSAX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfae0f040, USAX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // USAX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfae0f050, UQSAX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // UQSAX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfae0f060, UHSAX_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // UHSAX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfad0f000, SSUB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SSUB16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfad0f010, QSUB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // QSUB16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfad0f020, SHSUB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // SHSUB16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfad0f040, USUB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // USUB16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfad0f050, UQSUB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // UQSUB16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfad0f060, UHSUB16_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // UHSUB16<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f000, SADD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SADD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f010, QADD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // QADD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f020, SHADD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SHADD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f040, UADD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // UADD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f050, UQADD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // UQADD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f060, UHADD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // UHADD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfac0f000, SSUB8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SSUB8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfac0f010, QSUB8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // QSUB8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfac0f020, SHSUB8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SHSUB8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfac0f040, USUB8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // USUB8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfac0f050, UQSUB8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // UQSUB8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfac0f060, UHSUB8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // UHSUB8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfa80f080, QADD_EQ, 0, instArgs{arg_R_8, arg_R_0, arg_R_16}},                            // QADD<c> <Rd>,<Rm>,<Rn> T1
+	{0xfff0f0f0, 0xfa80f090, QDADD_EQ, 0, instArgs{arg_R_8, arg_R_0, arg_R_16}},                           // QDADD<c> <Rd>,<Rm>,<Rn> T1
+	{0xfff0f0f0, 0xfa80f0a0, QSUB_EQ, 0, instArgs{arg_R_8, arg_R_0, arg_R_16}},                            // QSUB<c> <Rd>,<Rm>,<Rn> T1
+	{0xfff0f0f0, 0xfa80f0b0, QDSUB_EQ, 0, instArgs{arg_R_8, arg_R_0, arg_R_16}},                           // QDSUB<c> <Rd>,<Rm>,<Rn> T1
+	{0xfff0f0f0, 0xfa90f080, REV_EQ, 0, instArgs{arg_R_8, arg_R_0}},                                       // REV<c>.W <Rd>,<Rm> T2
+	{0xfff0f0f0, 0xfa90f090, REV16_EQ, 0, instArgs{arg_R_8, arg_R_0}},                                     // REV16<c>.W <Rd>,<Rm> T2
+	{0xfff0f0f0, 0xfa90f0a0, RBIT_EQ, 0, instArgs{arg_R_8, arg_R_0}},                                      // RBIT<c> <Rd>,<Rm> T1
+	{0xfff0f0f0, 0xfa90f0b0, REVSH_EQ, 0, instArgs{arg_R_8, arg_R_0}},                                     // REVSH<c>.W <Rd>,<Rm> T2
+	{0xfff0f0f0, 0xfaa0f080, SEL_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                             // SEL<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfab0f080, CLZ_EQ, 0, instArgs{arg_R_8, arg_R_0}},                                       // CLZ<c> <Rd>,<Rm> T1
+	{0xfff0f0f0, 0xfb00f000, MUL_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                             // MUL<c> <Rd>,<Rn>,<Rm> T2
+	{0xfff000f0, 0xfb000000, MLA_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                   // MLA<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff000f0, 0xfb000010, MLS_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                   // MLS<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb10f000, SMULBB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SMULBB<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb100000, SMLABB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // SMLABB<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb10f010, SMULBT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SMULBT<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb100010, SMLABT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // SMLABT<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb10f020, SMULTB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SMULTB<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb100020, SMLATB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // SMLATB<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb10f030, SMULTT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SMULTT<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb100030, SMLATT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // SMLATT<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb20f000, SMUAD_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SMUAD<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb200000, SMLAD_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                 // SMLAD<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb20f010, SMUAD_X_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // SMUADX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb200010, SMLAD_X_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},               // SMLADX<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb30f000, SMULWB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SMULWB<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb300000, SMLAWB_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // SMLAWB<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb30f010, SMULWT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                          // SMULWT<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb300010, SMLAWT_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // SMLAWT<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb40f000, SMUSD_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SMUSD<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb400000, SMLSD_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                 // SMLSD<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb40f010, SMUSD_X_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // SMUSDX<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb400010, SMLSD_X_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},               // SMLSDX<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb50f000, SMMUL_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // SMMUL<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb500000, SMMLA_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                 // SMMLA<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb50f010, SMMUL_R_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                         // SMMULR<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb500010, SMMLA_R_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},               // SMMLAR<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff0f0f0, 0xfb70f000, USAD8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                           // USAD8<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfb700000, USADA8_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                // USADA8<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff000f0, 0xfb600000, SMMLS_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},                 // SMMLS<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff000f0, 0xfb600010, SMMLS_R_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0, arg_R_12}},               // SMMLSR<c> <Rd>,<Rn>,<Rm>,<Ra> T1
+	{0xfff000f0, 0xfb800000, SMULL_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                 // SMULL<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfb90f0f0, SDIV_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // SDIV<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfba00000, UMULL_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                 // UMULL<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff0f0f0, 0xfbb0f0f0, UDIV_EQ, 0, instArgs{arg_R_8, arg_R_16, arg_R_0}},                            // UDIV<c> <Rd>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc00000, SMLAL_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                 // SMLAL<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc00080, SMLALBB_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},               // SMLALBB<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc00090, SMLALBT_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},               // SMLALBT<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc000a0, SMLALTB_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},               // SMLALTB<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc000b0, SMLALTT_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},               // SMLALTT<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc000c0, SMLALD_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                // SMLALD<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbc000d0, SMLALD_X_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},              // SMLALDX<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbd000c0, SMLSLD_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                // SMLSLD<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbd000d0, SMLSLD_X_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},              // SMLSLDX<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbe00000, UMLAL_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                 // UMLAL<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+	{0xfff000f0, 0xfbe00060, UMAAL_EQ, 0, instArgs{arg_R_12, arg_R_8, arg_R_16, arg_R_0}},                 // UMAAL<c> <RdLo>,<RdHi>,<Rn>,<Rm> T1
+}
@@ -17,8 +17,19 @@ package arm64asm
 //
 // - arg_Xds: a X register encoded in the Rd[4:0] field (31 is sp)
 //
+// - arg_Xts: a X register encoded in the Rt[4:0] field (31 is sp)
+//
+// - arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops: the written-back address register of a
+//     memory copy or set instruction encoded in Rd[4:0] or Rs[20:16], like [<Xd>]!
+//
+// - arg_Xn_wb_mops: the written-back size register of a memory copy or set
+//     instruction encoded in Rn[9:5], like <Xn>!
+//
 // - arg_Wn: encoded in Rn[9:5]
 //
+// - arg_Ws_plus_1: the W register following <Ws> in a register pair that
+//     starts at an even-numbered register, like <W(s+1)> in CASP
+//
 // - arg_Wm: encoded in Rm[20:16]
 //
 // - arg_Wm_extend__UXTB_0__UXTH_1__LSL_UXTW_2__UXTX_3__SXTB_4__SXTH_5__SXTW_6__SXTX_7__0_4:
@@ -60,6 +71,14 @@ package arm64asm
 //     (<Wm>|<Xm>) with an extend encoded in option[15:13] and a shift amount encoded in
 //     S[12:12] in the range [0,3] (S=0:0, S=1:3).
 //
+// - arg_Xns_mem_optional_S_imm9_8_signed:
+//     addressing mode of an optional signed offset encoded in the "S:imm9" field
+//     times 8, as in LDRAA and LDRAB
+//
+// - arg_Xns_mem_optional_imm9_16_signed:
+//     addressing mode of an optional signed offset encoded in the "imm9" field
+//     times 16, as in the MTE tag loads and stores
+//
 // - arg_Xns_mem_optional_imm12_4_unsigned:
 //     addressing mode of unsigned offset with a base register: Xns and an optional unsigned
 //     offset encoded in the "imm12" field times 4
@@ -78,6 +97,9 @@ package arm64asm
 // - arg_immediate_0_127_CRm_op2:
 //     an immediate encoded in "CRm:op2" in the range 0 to 127
 //
+// - arg_immediate_0_1008_uimm6_16:
+//     an immediate encoded in "uimm6" times 16 in the range 0 to 1008
+//
 // - arg_immediate_bitmask_64_N_imms_immr:
 //     a bitmask immediate for 64-bit variant and encoded in "N:imms:immr"
 //
@@ -147,6 +169,12 @@ package arm64asm
 // - arg_sysreg_o0_op1_CRn_CRm_op2:
 //     system register name encoded in the "o0:op1:CRn:CRm:op2"
 //
+// - arg_targets_op2:
+//     branch target type of BTI encoded in the "op2<2:1>" field
+//
+// - arg_option_CRm_2_1:
+//     PSTATE.SM or PSTATE.ZA option of SMSTART and SMSTOP encoded in the "CRm<2:1>" field
+//
 // - arg_pstatefield_op1_op2__SPSel_05__DAIFSet_36__DAIFClr_37:
 //     PSTATE field name encoded in the "op1:op2" field
 //
@@ -196,8 +224,10 @@ const (
 	arg_Ht
 	arg_IAddSub
 	arg_immediate_0_127_CRm_op2
+	arg_immediate_0_1008_uimm6_16
 	arg_immediate_0_15_CRm
 	arg_immediate_0_15_nzcv
+	arg_immediate_0_15_uimm4
 	arg_immediate_0_31_imm5
 	arg_immediate_0_31_immr
 	arg_immediate_0_31_imms
@@ -299,6 +329,8 @@ const (
 	arg_sysop_SYS_CR_system
 	arg_sysop_TLBI_SYS_CR_system
 	arg_sysreg_o0_op1_CRn_CRm_op2
+	arg_targets_op2
+	arg_option_CRm_2_1
 	arg_Vd_16_5__B_1__H_2__S_4__D_8
 	arg_Vd_19_4__B_1__H_2__S_4
 	arg_Vd_19_4__B_1__H_2__S_4__D_8
@@ -435,15 +467,20 @@ const (
 	arg_Wn
 	arg_Wns
 	arg_Ws
+	arg_Ws_plus_1
 	arg_Wt
+	arg_Wt_plus_1
 	arg_Wt2
 	arg_Xa
 	arg_Xd
+	arg_Xd_mem_wb_mops
 	arg_Xds
 	arg_Xm
 	arg_Xm_shift__LSL_0__LSR_1__ASR_2__0_63
 	arg_Xm_shift__LSL_0__LSR_1__ASR_2__ROR_3__0_63
+	arg_Xms
 	arg_Xn
+	arg_Xn_wb_mops
 	arg_Xns
 	arg_Xns_mem
 	arg_Xns_mem_extend_m__UXTW_2__LSL_3__SXTW_6__SXTX_7__0_0__1_1
@@ -461,6 +498,8 @@ const (
 	arg_Xns_mem_optional_imm7_4_signed
 	arg_Xns_mem_optional_imm7_8_signed
 	arg_Xns_mem_optional_imm9_1_signed
+	arg_Xns_mem_optional_imm9_16_signed
+	arg_Xns_mem_optional_S_imm9_8_signed
 	arg_Xns_mem_post_fixedimm_1
 	arg_Xns_mem_post_fixedimm_12
 	arg_Xns_mem_post_fixedimm_16
@@ -475,6 +514,7 @@ const (
 	arg_Xns_mem_post_imm7_4_signed
 	arg_Xns_mem_post_imm7_8_signed
 	arg_Xns_mem_post_imm9_1_signed
+	arg_Xns_mem_post_imm9_16_signed
 	arg_Xns_mem_post_Q__16_0__32_1
 	arg_Xns_mem_post_Q__24_0__48_1
 	arg_Xns_mem_post_Q__32_0__64_1
@@ -488,7 +528,13 @@ const (
 	arg_Xns_mem_wb_imm7_4_signed
 	arg_Xns_mem_wb_imm7_8_signed
 	arg_Xns_mem_wb_imm9_1_signed
+	arg_Xns_mem_wb_imm9_16_signed
+	arg_Xns_mem_wb_S_imm9_8_signed
 	arg_Xs
+	arg_Xs_mem_wb_mops
+	arg_Xs_plus_1
 	arg_Xt
+	arg_Xts
+	arg_Xt_plus_1
 	arg_Xt2
 )
@@ -54,6 +54,11 @@ func cneg_csneg_64_condsel_cond(instr uint32) bool {
 	return instr&0xe000 != 0xe000 && (instr>>5)&0x1f == (instr>>16)&0x1f
 }
 
+func cpy_memcms_cond(instr uint32) bool {
+	d, n, s := instr&0x1f, instr>>5&0x1f, instr>>16&0x1f
+	return d != s && d != n && s != n && d != 31 && n != 31 && s != 31
+}
+
 func csinc_general_cond(instr uint32) bool {
 	return instr&0xe000 != 0xe000
 }
@@ -132,6 +137,11 @@ func sbfx_sbfm_64m_bitfield_cond(instr uint32) bool {
 	return bfxpreferred_4((instr>>31)&0x1, extract_bit((instr>>29)&0x3, 1), (instr>>10)&0x3f, (instr>>16)&0x3f)
 }
 
+func set_memcms_cond(instr uint32) bool {
+	d, n, s := instr&0x1f, instr>>5&0x1f, instr>>16&0x1f
+	return d != s && d != n && s != n && d != 31 && n != 31
+}
+
 func tlbi_sys_cr_system_cond(instr uint32) bool {
 	return sys_op_4((instr>>16)&0x7, 0x8, (instr>>8)&0xf, (instr>>5)&0x7) == sys_TLBI
 }
@@ -45,6 +45,10 @@ func Decode(src []byte) (inst Inst, err error) {
 
 	x := binary.LittleEndian.Uint32(src)
 
+	if isSVE(x) {
+		return decodeSVE(x)
+	}
+
 Search:
 	for i := range instFormats {
 		f := &instFormats[i]
@@ -153,12 +157,18 @@ func decodeArg(aop instArg, x uint32) Arg {
 	case arg_Xd:
 		return X0 + Reg(x&(1<<5-1))
 
+	case arg_Xd_mem_wb_mops:
+		return MOPSReg{X0 + Reg(x&(1<<5-1)), true}
+
 	case arg_Xds:
 		return RegSP(X0) + RegSP(x&(1<<5-1))
 
 	case arg_Xm:
 		return X0 + Reg((x>>16)&(1<<5-1))
 
+	case arg_Xms:
+		return RegSP(X0) + RegSP((x>>16)&(1<<5-1))
+
 	case arg_Wm_shift__LSL_0__LSR_1__ASR_2__0_31:
 		return handle_ImmediateShiftedRegister(x, 31, true, false)
 
@@ -174,6 +184,9 @@ func decodeArg(aop instArg, x uint32) Arg {
 	case arg_Xn:
 		return X0 + Reg((x>>5)&(1<<5-1))
 
+	case arg_Xn_wb_mops:
+		return MOPSReg{X0 + Reg((x>>5)&(1<<5-1)), false}
+
 	case arg_Xns:
 		return RegSP(X0) + RegSP((x>>5)&(1<<5-1))
 
@@ -247,11 +260,21 @@ func decodeArg(aop instArg, x uint32) Arg {
 		imm7 := (x >> 15) & (1<<7 - 1)
 		return MemImmediate{Rn, AddrOffset, ((int32(imm7 << 3)) << 22) >> 22}
 
+	case arg_Xns_mem_optional_S_imm9_8_signed:
+		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
+		simm := (x>>22&1)<<9 | (x>>12)&(1<<9-1)
+		return MemImmediate{Rn, AddrOffset, (int32(simm) << 22) >> 19}
+
 	case arg_Xns_mem_optional_imm9_1_signed:
 		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
 		imm9 := (x >> 12) & (1<<9 - 1)
 		return MemImmediate{Rn, AddrOffset, (int32(imm9) << 23) >> 23}
 
+	case arg_Xns_mem_optional_imm9_16_signed:
+		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
+		imm9 := (x >> 12) & (1<<9 - 1)
+		return MemImmediate{Rn, AddrOffset, (int32(imm9) << 23) >> 19}
+
 	case arg_Xns_mem_post_imm7_4_signed:
 		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
 		imm7 := (x >> 15) & (1<<7 - 1)
@@ -267,6 +290,11 @@ func decodeArg(aop instArg, x uint32) Arg {
 		imm9 := (x >> 12) & (1<<9 - 1)
 		return MemImmediate{Rn, AddrPostIndex, ((int32(imm9)) << 23) >> 23}
 
+	case arg_Xns_mem_post_imm9_16_signed:
+		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
+		imm9 := (x >> 12) & (1<<9 - 1)
+		return MemImmediate{Rn, AddrPostIndex, (int32(imm9) << 23) >> 19}
+
 	case arg_Xns_mem_wb_imm7_4_signed:
 		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
 		imm7 := (x >> 15) & (1<<7 - 1)
@@ -277,26 +305,71 @@ func decodeArg(aop instArg, x uint32) Arg {
 		imm7 := (x >> 15) & (1<<7 - 1)
 		return MemImmediate{Rn, AddrPreIndex, ((int32(imm7 << 3)) << 22) >> 22}
 
+	case arg_Xns_mem_wb_S_imm9_8_signed:
+		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
+		simm := (x>>22&1)<<9 | (x>>12)&(1<<9-1)
+		return MemImmediate{Rn, AddrPreIndex, (int32(simm) << 22) >> 19}
+
 	case arg_Xns_mem_wb_imm9_1_signed:
 		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
 		imm9 := (x >> 12) & (1<<9 - 1)
 		return MemImmediate{Rn, AddrPreIndex, ((int32(imm9)) << 23) >> 23}
 
+	case arg_Xns_mem_wb_imm9_16_signed:
+		Rn := RegSP(X0) + RegSP(x>>5&(1<<5-1))
+		imm9 := (x >> 12) & (1<<9 - 1)
+		return MemImmediate{Rn, AddrPreIndex, (int32(imm9) << 23) >> 19}
+
 	case arg_Ws:
 		return W0 + Reg((x>>16)&(1<<5-1))
 
+	case arg_Ws_plus_1:
+		// The register pairs of CASP must start at an even-numbered register.
+		rs := (x >> 16) & (1<<5 - 1)
+		if rs&1 != 0 {
+			return nil
+		}
+		return W0 + Reg(rs+1)
+
 	case arg_Wt:
 		return W0 + Reg(x&(1<<5-1))
 
+	case arg_Wt_plus_1:
+		rt := x & (1<<5 - 1)
+		if rt&1 != 0 {
+			return nil
+		}
+		return W0 + Reg(rt+1)
+
 	case arg_Wt2:
 		return W0 + Reg((x>>10)&(1<<5-1))
 
 	case arg_Xs:
 		return X0 + Reg((x>>16)&(1<<5-1))
 
+	case arg_Xs_mem_wb_mops:
+		return MOPSReg{X0 + Reg((x>>16)&(1<<5-1)), true}
+
+	case arg_Xs_plus_1:
+		rs := (x >> 16) & (1<<5 - 1)
+		if rs&1 != 0 {
+			return nil
+		}
+		return X0 + Reg(rs+1)
+
 	case arg_Xt:
 		return X0 + Reg(x&(1<<5-1))
 
+	case arg_Xts:
+		return RegSP(X0) + RegSP(x&(1<<5-1))
+
+	case arg_Xt_plus_1:
+		rt := x & (1<<5 - 1)
+		if rt&1 != 0 {
+			return nil
+		}
+		return X0 + Reg(rt+1)
+
 	case arg_Xt2:
 		return X0 + Reg((x>>10)&(1<<5-1))
 
@@ -308,6 +381,14 @@ func decodeArg(aop instArg, x uint32) Arg {
 		crm := (x >> 8) & (1<<4 - 1)
 		return Imm{crm, false}
 
+	case arg_immediate_0_1008_uimm6_16:
+		uimm6 := (x >> 16) & (1<<6 - 1)
+		return Imm{uimm6 << 4, true}
+
+	case arg_immediate_0_15_uimm4:
+		uimm4 := (x >> 10) & (1<<4 - 1)
+		return Imm{uimm4, true}
+
 	case arg_immediate_0_15_nzcv:
 		nzcv := x & (1<<4 - 1)
 		return Imm{nzcv, false}
@@ -656,6 +737,17 @@ func decodeArg(aop instArg, x uint32) Arg {
 		}
 		return Imm{CRm, false}
 
+	case arg_targets_op2:
+		op2 := (x >> 5) & (1<<3 - 1)
+		return Imm_bti(op2 >> 1)
+
+	case arg_option_CRm_2_1:
+		CRm := (x >> 8) & (1<<4 - 1)
+		if CRm>>1 == 3 || CRm>>1 == 0 {
+			return nil
+		}
+		return Imm_svcr(CRm >> 1)
+
 	case arg_prfop_Rt:
 		Rt := x & (1<<5 - 1)
 		return Imm_prfop(Rt)
@@ -84,3 +84,27 @@ func TestDecodeGNUSyntax(t *testing.T) {
 func TestDecodeGoSyntax(t *testing.T) {
 	testDecode(t, "plan9")
 }
+
+func TestMOPSSequence(t *testing.T) {
+	tests := []struct {
+		op    Op
+		seq   [3]Op
+		stage MOPSStage
+	}{
+		{CPYFP, [3]Op{CPYFP, CPYFM, CPYFE}, MOPSPrologue},
+		{CPYMWTRN, [3]Op{CPYPWTRN, CPYMWTRN, CPYEWTRN}, MOPSMain},
+		{SETE, [3]Op{SETP, SETM, SETE}, MOPSEpilogue},
+		{SETGMTN, [3]Op{SETGPTN, SETGMTN, SETGETN}, MOPSMain},
+	}
+	for _, tt := range tests {
+		seq, stage, ok := MOPSSequence(tt.op)
+		if !ok || seq != tt.seq || stage != tt.stage {
+			t.Errorf("MOPSSequence(%v) = %v, %v, %v, want %v, %v, true", tt.op, seq, stage, ok, tt.seq, tt.stage)
+		}
+	}
+	for _, op := range []Op{ADD, LDG, STG, CPY} {
+		if _, _, ok := MOPSSequence(op); ok {
+			t.Errorf("MOPSSequence(%v) reported a memory copy or set instruction", op)
+		}
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// This file contains the encoder, the inverse of Decode.
+//
+// The encoder uses the same instruction formats as the decoder:
+// instFormats for the base instructions and the sveFormats and
+// smeFormats tables, generated by arm64/instgen from the ARM XML
+// specification, for SVE and SME. Rather than a second description
+// of where each operand lives in the instruction, the encoder runs
+// the operand decoders in reverse. The first time a format is used,
+// it works out which bits of the instruction each operand depends on,
+// grouped by the part of the operand they determine, like the register
+// or the offset of a MemImmediate. To encode an operand, it then searches
+// each group for the bits that decode to the wanted part. Groups are
+// small, except for the wide immediates and PC-relative offsets, whose
+// value is a linear function of the bits and is solved for directly.
+// Every encoding is checked by decoding it.
+
+// Encode returns the encoding of inst, a 32-bit instruction word
+// that Decode decodes to an instruction with the same Op and Args.
+//
+// If inst.Enc is such an encoding, as it is for an instruction returned
+// by Decode, Encode returns it. Otherwise, the bits of inst.Enc are used
+// for the parts of the encoding that Op and Args leave open, like the
+// fields that the architecture ignores, so that modifying an operand of
+// a decoded instruction and encoding it again changes only the bits of
+// that operand.
+func Encode(inst Inst) (uint32, error) {
+	if sameInst(inst, inst.Enc) {
+		return inst.Enc, nil
+	}
+	for _, f := range encFormats()[inst.Op] {
+		x, ok := f.encode(&inst.Args, inst.Enc)
+		if ok && sameInst(inst, x) {
+			return x, nil
+		}
+	}
+	return 0, fmt.Errorf("cannot encode %v", inst)
+}
+
+// sameInst reports whether x decodes to inst.
+func sameInst(inst Inst, x uint32) bool {
+	dec, err := Decode(binary.LittleEndian.AppendUint32(nil, x))
+	return err == nil && dec.Op == inst.Op && dec.Args == inst.Args
+}
+
+// An encFormat is an instruction format prepared for encoding.
+type encFormat struct {
+	mask      uint32
+	value     uint32
+	args      []func(x uint32) Arg // the decoders of the arguments
+	canDecode func(x uint32) bool  // the extra condition of the format, if any
+
+	once   sync.Once
+	groups [][]bitGroup     // for each argument, the groups of bits it depends on
+	types  [][]reflect.Type // for each argument, the types it decodes to
+	free   uint32           // the bits that no argument depends on
+}
+
+// A bitGroup is a set of instruction bits that together determine
+// some parts of an argument.
+type bitGroup struct {
+	bits  uint32 // the instruction bits
+	own   uint32 // the bits that the arguments before it do not depend on
+	parts uint64 // the parts of the argument, as a bit set (see argDiff)
+}
+
+// encFormats returns the instruction formats of each Op.
+var encFormats = sync.OnceValue(func() map[Op][]*encFormat {
+	formats := make(map[Op][]*encFormat)
+	for i := range instFormats {
+		f := &instFormats[i]
+		ef := &encFormat{mask: f.mask, value: f.value, canDecode: f.canDecode}
+		for _, aop := range f.args {
+			if aop == 0 {
+				break
+			}
+			ef.args = append(ef.args, func(x uint32) Arg { return decodeArg(aop, x) })
+		}
+		formats[f.op] = append(formats[f.op], ef)
+	}
+	for _, table := range [][]sveFormat{sveFormats[:], smeFormats[:]} {
+		for i := range table {
+			f := &table[i]
+			ef := &encFormat{mask: f.mask, value: f.value}
+			for _, o := range f.args {
+				if o == nil {
+					break
+				}
+				ef.args = append(ef.args, o.decode)
+			}
+			formats[f.op] = append(formats[f.op], ef)
+		}
+	}
+	return formats
+})
+
+// analyze finds the groups of bits each argument of f depends on.
+// It flips each bit that the format does not fix in a fixed series
+// of pseudo-random instructions of the format, and records the parts
+// of the argument that change. Bits that change a common part are
+// in the same group.
+//
+// Some bits only make an argument valid or invalid, like the middle
+// bit of the option field of a MemExtend, which turns every valid
+// extension into an invalid one. Such a bit joins the group of
+// a neighboring bit, which is usually part of the same field.
+// Otherwise, like the size field of a register that only allows two
+// of its four values, it may determine any part of the argument,
+// so all the bits of the argument form a single group.
+func (f *encFormat) analyze() {
+	f.groups = make([][]bitGroup, len(f.args))
+	f.types = make([][]reflect.Type, len(f.args))
+	f.free = ^f.mask
+	var before uint32 // the bits of the arguments before j
+	for j, dec := range f.args {
+		var changes [32]uint64
+		var valid uint32 // the bits that change whether the argument is valid
+		seed := uint32(0x9e3779b9)
+		for range 64 {
+			seed ^= seed << 13
+			seed ^= seed >> 17
+			seed ^= seed << 5
+			x := seed&^f.mask | f.value
+			a := dec(x)
+			if a == nil {
+				continue
+			}
+			if t := reflect.TypeOf(a); !slices.Contains(f.types[j], t) {
+				f.types[j] = append(f.types[j], t)
+			}
+			for b := range 32 {
+				if f.mask>>b&1 != 0 {
+					continue
+				}
+				if c := dec(x ^ 1<<b); c != nil {
+					changes[b] |= argDiff(a, c)
+				} else {
+					valid |= 1 << b
+				}
+			}
+		}
+		var groups []bitGroup
+		for b, parts := range changes {
+			if parts == 0 {
+				continue
+			}
+			g := bitGroup{bits: 1 << b, parts: parts}
+			groups = slices.DeleteFunc(groups, func(h bitGroup) bool {
+				if h.parts&g.parts == 0 {
+					return false
+				}
+				g.bits |= h.bits
+				g.parts |= h.parts
+				return true
+			})
+			groups = append(groups, g)
+		}
+		for _, g := range groups {
+			valid &^= g.bits
+		}
+		for changed := true; changed; {
+			changed = false
+			for i := range groups {
+				near := (groups[i].bits<<1 | groups[i].bits>>1) & valid
+				if near != 0 {
+					groups[i].bits |= near
+					valid &^= near
+					changed = true
+				}
+			}
+		}
+		if valid != 0 {
+			g := bitGroup{bits: valid, parts: ^uint64(0)}
+			for _, h := range groups {
+				g.bits |= h.bits
+			}
+			groups = []bitGroup{g}
+		}
+		for i := range groups {
+			groups[i].own = groups[i].bits &^ before
+		}
+		for _, g := range groups {
+			f.free &^= g.bits
+			before |= g.bits
+		}
+		f.groups[j] = groups
+	}
+}
+
+// argDiff returns the set of the parts of two arguments that differ.
+// The parts of a struct are its fields, and an argument of any other
+// type has one part. If the arguments have different types,
+// all their parts differ.
+func argDiff(a, b Arg) uint64 {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() {
+		return ^uint64(0)
+	}
+	if va.Kind() != reflect.Struct {
+		if a != b {
+			return 1
+		}
+		return 0
+	}
+	var diff uint64
+	for i := range va.NumField() {
+		if !va.Field(i).Equal(vb.Field(i)) {
+			diff |= 1 << min(i, 63)
+		}
+	}
+	return diff
+}
+
+// argPart returns the value of part i of the argument a, if it is an integer.
+func argPart(a Arg, i int) (int64, bool) {
+	v := reflect.ValueOf(a)
+	if v.Kind() == reflect.Struct {
+		if i >= v.NumField() {
+			return 0, false
+		}
+		v = v.Field(i)
+	} else if i != 0 {
+		return 0, false
+	}
+	switch {
+	case v.CanInt():
+		return v.Int(), true
+	case v.CanUint():
+		return int64(v.Uint()), true
+	}
+	return 0, false
+}
+
+// encode returns an instruction word of format f with the arguments args,
+// taking the bits that are not determined by f and args from x.
+// It reports whether it found one.
+func (f *encFormat) encode(args *Args, x uint32) (uint32, bool) {
+	n := len(f.args)
+	if n < len(args) && args[n] != nil || n > 0 && args[n-1] == nil {
+		return 0, false
+	}
+	f.once.Do(f.analyze)
+	for j, a := range args[:n] {
+		if !slices.Contains(f.types[j], reflect.TypeOf(a)) {
+			return 0, false
+		}
+	}
+	x = x&^f.mask | f.value
+	for j := range f.args {
+		// A group may only be solvable after another, like the base
+		// register of a MemExtend, which is invalid until the option
+		// field is. Try again in that case.
+		for try := 0; f.args[j](x) != args[j]; try++ {
+			if try == 3 {
+				return 0, false
+			}
+			for i, g := range f.groups[j] {
+				last := j == len(f.args)-1 && i == len(f.groups[j])-1
+				x = f.solve(args, j, g, last, x)
+			}
+		}
+	}
+	return f.solveCond(x)
+}
+
+// solveCond returns x with the bits that no argument depends on set
+// to satisfy the extra condition of the format, like the relation
+// between the immr and imms fields of an LSL, which is an alias of UBFM.
+func (f *encFormat) solveCond(x uint32) (uint32, bool) {
+	if f.canDecode == nil || f.canDecode(x) {
+		return x, true
+	}
+	return search(x, f.free, f.canDecode)
+}
+
+// matches reports whether the first n arguments of x are args.
+func (f *encFormat) matches(args *Args, n int, x uint32) bool {
+	for j := range n {
+		if f.args[j](x) != args[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// solve returns x with the bits of the group g of argument j set to
+// encode the parts of args[j] they determine, without changing the
+// arguments before j. If there are no such bits, it returns x.
+// If g is the last group, the bits must also allow the extra condition
+// of the format to be satisfied, since it may depend on them, like
+// the condition that a MOV of a bitmask immediate is not a MOV of
+// a wide immediate.
+func (f *encFormat) solve(args *Args, j int, g bitGroup, last bool, x uint32) uint32 {
+	want := args[j]
+	ok := func(y uint32) bool {
+		a := f.args[j](y)
+		if a == nil || a != want && argDiff(a, want)&g.parts != 0 {
+			return false
+		}
+		if !f.matches(args, j, y) {
+			return false
+		}
+		if last {
+			_, found := f.solveCond(y)
+			return found
+		}
+		return true
+	}
+	if ok(x) {
+		return x
+	}
+	n := bits.OnesCount32(g.bits)
+	if n > 8 {
+		if y, found := f.solveLinear(want, j, g, x, ok); found {
+			return y
+		}
+	}
+	// The bits of the arguments before j are usually already right.
+	if g.own != g.bits {
+		if y, found := search(x, g.own, ok); found {
+			return y
+		}
+	}
+	if n > 16 {
+		return x
+	}
+	if y, found := search(x, g.bits, ok); found {
+		return y
+	}
+	return x
+}
+
+// search returns x with the bits in set changed so that ok holds,
+// trying every combination of them. It reports whether it found one.
+func search(x, set uint32, ok func(uint32) bool) (uint32, bool) {
+	if bits.OnesCount32(set) > 16 {
+		return 0, false
+	}
+	for sub := uint32(0); ; {
+		if y := x&^set | sub; ok(y) {
+			return y, true
+		}
+		sub = (sub - set) & set
+		if sub == 0 {
+			return 0, false
+		}
+	}
+}
+
+// solveLinear solves for the bits of a group that determines a single
+// integer part of argument j, like an immediate or a PC-relative offset,
+// as a sum of the values of the bits. The top two bits of the group are
+// tried in all combinations, since they often select a shift or a
+// scale, like the hw field of MOVZ, rather than add to the value.
+func (f *encFormat) solveLinear(want Arg, j int, g bitGroup, x uint32, ok func(uint32) bool) (uint32, bool) {
+	if bits.OnesCount64(g.parts) != 1 {
+		return 0, false
+	}
+	part := bits.TrailingZeros64(g.parts)
+	target, isInt := argPart(want, part)
+	if !isInt {
+		return 0, false
+	}
+	top := uint32(1) << (31 - bits.LeadingZeros32(g.bits))
+	top |= uint32(1) << (31 - bits.LeadingZeros32(g.bits&^top))
+	type weight struct {
+		bit uint32
+		w   int64
+	}
+	for sub := uint32(0); ; {
+		base := x&^g.bits | sub
+		if a := f.args[j](base); a != nil {
+			v0, _ := argPart(a, part)
+			var ws []weight
+			for rest := g.bits &^ top; rest != 0; rest &= rest - 1 {
+				bit := rest & -rest
+				if a := f.args[j](base | bit); a != nil {
+					v, _ := argPart(a, part)
+					ws = append(ws, weight{bit, v - v0})
+				}
+			}
+			slices.SortFunc(ws, func(a, b weight) int {
+				return bits.Len64(absInt64(a.w)) - bits.Len64(absInt64(b.w))
+			})
+			y, r := base, target-v0
+			for _, w := range ws {
+				if r == 0 {
+					break
+				}
+				if w.w == 0 {
+					continue
+				}
+				// The arithmetic is modulo 2**64, like that of an Imm64.
+				if m := 2 * absInt64(w.w); m != 0 && uint64(r)%m == 0 {
+					continue
+				}
+				y |= w.bit
+				r -= w.w
+			}
+			if r == 0 && ok(y) {
+				return y, true
+			}
+		}
+		sub = (sub - top) & top
+		if sub == 0 {
+			break
+		}
+	}
+	return 0, false
+}
+
+func absInt64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-v)
+	}
+	return uint64(v)
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestEncode checks that every instruction in the test cases encodes
+// back to its original bits, and, without the original bits, to bits
+// that decode to the same instruction.
+func TestEncode(t *testing.T) {
+	n := 0
+	for _, syntax := range []string{"gnu", "plan9"} {
+		data, err := os.ReadFile(filepath.Join("testdata", syntax+"cases.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			f := strings.SplitN(line, "|", 2)
+			code, err := hex.DecodeString(f[0])
+			if err != nil {
+				t.Errorf("parsing %q: %v", f[0], err)
+				continue
+			}
+			inst, err := Decode(code)
+			if err != nil {
+				continue
+			}
+			n++
+			x := binary.LittleEndian.Uint32(code)
+			if enc, err := Encode(inst); err != nil || enc != x {
+				t.Errorf("Encode(%v) = %#08x, %v, want %#08x", inst, enc, err, x)
+			}
+			inst.Enc = 0
+			enc, err := Encode(inst)
+			if err != nil {
+				t.Errorf("Encode(%v) without the original bits: %v", inst, err)
+				continue
+			}
+			dec, err := Decode(binary.LittleEndian.AppendUint32(nil, enc))
+			if err != nil || dec.Op != inst.Op || dec.Args != inst.Args {
+				t.Errorf("Encode(%v) without the original bits = %#08x, which decodes to %v", inst, enc, dec)
+			}
+		}
+	}
+	if n == 0 {
+		t.Fatal("no test cases")
+	}
+}
+
+// TestEncodeLogicalImm checks the bitmask immediates of the logical
+// instructions, which can only encode a rotated run of ones replicated
+// across the register in elements of 2, 4, 8, 16, 32 or 64 bits.
+func TestEncodeLogicalImm(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want uint32 // 0 if the immediate cannot be encoded
+	}{
+		{Inst{Op: AND, Args: Args{RegSP(X0), X1, Imm64{Imm: 0x5555555555555555}}}, 0x9200f020},
+		{Inst{Op: AND, Args: Args{RegSP(X0), X1, Imm64{Imm: 0xff00ff00ff00ff00}}}, 0x92089c20},
+		{Inst{Op: EOR, Args: Args{RegSP(X0), X1, Imm64{Imm: 0x8000000000000000}}}, 0xd2410020},
+		{Inst{Op: ORR, Args: Args{RegSP(W0), W1, Imm64{Imm: 0x0f0f0f0f}}}, 0x3200cc20},
+		{Inst{Op: AND, Args: Args{RegSP(W0), W1, Imm64{Imm: 0xfffffffe}}}, 0x121f7820},
+		{Inst{Op: TST, Args: Args{X0, Imm64{Imm: 3}}}, 0xf240041f},
+
+		// MOV of a bitmask immediate is ORR with XZR.
+		{Inst{Op: MOV, Args: Args{RegSP(X0), Imm64{Imm: 0x00ff00ff00ff00ff}}}, 0xb2009fe0},
+		{Inst{Op: MOV, Args: Args{RegSP(W0), Imm64{Imm: 0xaaaaaaaa}}}, 0x3201f3e0},
+
+		// The SVE forms share the encoding of the immediate.
+		{Inst{Op: AND, Args: Args{zReg(Z0, ArrangementS), zReg(Z0, ArrangementS), Imm64{Imm: 0xff}}}, 0x058000e0},
+		{Inst{Op: ORR, Args: Args{zReg(Z0, ArrangementB), zReg(Z0, ArrangementB), Imm64{Imm: 0x55}}}, 0x05000780},
+
+		{Inst{Op: AND, Args: Args{RegSP(X0), X1, Imm64{Imm: 0}}}, 0},
+		{Inst{Op: AND, Args: Args{RegSP(X0), X1, Imm64{Imm: 0x1234}}}, 0},
+		{Inst{Op: ORR, Args: Args{RegSP(X0), X1, Imm64{Imm: 5}}}, 0},
+		{Inst{Op: AND, Args: Args{RegSP(W0), W1, Imm64{Imm: 0x100000000}}}, 0},
+		{Inst{Op: AND, Args: Args{zReg(Z0, ArrangementS), zReg(Z0, ArrangementS), Imm64{Imm: 0x1234}}}, 0},
+	}
+	for _, tt := range tests {
+		checkEncode(t, tt.inst, tt.want)
+	}
+}
+
+// TestEncodeSVEPredicate checks the governing predicates of SVE
+// instructions. Most have a 3-bit field that only holds P0-P7, and
+// each form takes either merging or zeroing predication, not both.
+func TestEncodeSVEPredicate(t *testing.T) {
+	merging := func(p Reg) PredicateWithQualifier { return PredicateWithQualifier{p, PredicateMerging} }
+	zeroing := func(p Reg) PredicateWithQualifier { return PredicateWithQualifier{p, PredicateZeroing} }
+	zs := func(z Reg) RegisterWithArrangement { return zReg(z, ArrangementS) }
+	tests := []struct {
+		inst Inst
+		want uint32 // 0 if the instruction cannot be encoded
+	}{
+		{Inst{Op: ADD, Args: Args{zs(Z0), merging(P7), zs(Z0), zs(Z1)}}, 0x04801c20},
+		{Inst{Op: CMPEQ, Args: Args{zReg(P15, ArrangementS), zeroing(P1), zs(Z0), ImmSigned{Imm: 3}}}, 0x2583840f},
+		{Inst{Op: CPY, Args: Args{zs(Z0), zeroing(P1), ImmSigned{Imm: 1}}}, 0x05910020},
+		{Inst{Op: CPY, Args: Args{zs(Z0), merging(P1), ImmSigned{Imm: 1}}}, 0x05914020},
+		{Inst{Op: LD1W, Args: Args{RegisterList{First: Z0, Arrangement: ArrangementS, Count: 1}, zeroing(P3), MemSVE{Base: X0, MulVL: true}}}, 0xa540ac00},
+
+		// A few instructions have a 4-bit predicate field.
+		{Inst{Op: SEL, Args: Args{zReg(Z0, ArrangementD), P15, zReg(Z1, ArrangementD), zReg(Z2, ArrangementD)}}, 0x05e2fc20},
+		{Inst{Op: AND, Args: Args{zReg(P0, ArrangementB), zeroing(P15), zReg(P1, ArrangementB), zReg(P2, ArrangementB)}}, 0x25027c20},
+
+		{Inst{Op: ADD, Args: Args{zs(Z0), merging(P8), zs(Z0), zs(Z1)}}, 0},
+		{Inst{Op: LD1W, Args: Args{RegisterList{First: Z0, Arrangement: ArrangementS, Count: 1}, zeroing(P8), MemSVE{Base: X0, MulVL: true}}}, 0},
+		{Inst{Op: ADD, Args: Args{zs(Z0), zeroing(P0), zs(Z0), zs(Z1)}}, 0},
+		{Inst{Op: CMPEQ, Args: Args{zReg(P15, ArrangementS), merging(P1), zs(Z0), ImmSigned{Imm: 3}}}, 0},
+
+		// The predicated ADD is destructive: its destination is its first source.
+		{Inst{Op: ADD, Args: Args{zs(Z0), merging(P0), zs(Z1), zs(Z2)}}, 0},
+
+		// The immediate of CMPEQ is a signed 5-bit value.
+		{Inst{Op: CMPEQ, Args: Args{zReg(P0, ArrangementS), zeroing(P7), zs(Z0), ImmSigned{Imm: 16}}}, 0},
+	}
+	for _, tt := range tests {
+		checkEncode(t, tt.inst, tt.want)
+	}
+}
+
+func zReg(r Reg, a Arrangement) RegisterWithArrangement {
+	return RegisterWithArrangement{r: r, a: a}
+}
+
+// checkEncode checks that inst encodes to want, or fails to encode if want is 0.
+func checkEncode(t *testing.T, inst Inst, want uint32) {
+	t.Helper()
+	enc, err := Encode(inst)
+	switch {
+	case want == 0 && err == nil:
+		t.Errorf("Encode(%v) = %#08x, want error", inst, enc)
+	case want != 0 && err != nil:
+		t.Errorf("Encode(%v): %v", inst, err)
+	case want != 0 && enc != want:
+		t.Errorf("Encode(%v) = %#08x, want %#08x", inst, enc, want)
+	}
+}
+
+// TestEncodeEnc checks that the bits of Enc that Op and Args leave open
+// are kept, and the others are replaced.
+func TestEncodeEnc(t *testing.T) {
+	inst, err := Decode([]byte{0x20, 0x04, 0x40, 0xf9}) // LDR X0, [X1,#8]
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst.Args[0] = X2
+	if enc, err := Encode(inst); err != nil || enc != 0xf9400422 {
+		t.Errorf("Encode(%v) = %#08x, %v, want 0xf9400422", inst, enc, err)
+	}
+}
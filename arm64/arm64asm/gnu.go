@@ -11,6 +11,9 @@ import (
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This form typically matches the syntax defined in the ARM Reference Manual.
 func GNUSyntax(inst Inst) string {
+	if isSVE(inst.Enc) {
+		return sveGNUSyntax(inst)
+	}
 	switch inst.Op {
 	case RET:
 		if r, ok := inst.Args[0].(Reg); ok && r == X30 {
@@ -50,8 +50,11 @@ type Args [5]Arg
 // An Arg is a single instruction argument, one of these types:
 // Reg, RegSP, ImmShift, RegExtshiftAmount, PCRel, MemImmediate,
 // MemExtend, Imm, Imm64, Imm_hint, Imm_clrex, Imm_dcps, Cond,
-// Imm_c, Imm_option, Imm_prfop, Pstatefield, Systemreg, Imm_fp
-// RegisterWithArrangement, RegisterWithArrangementAndIndex.
+// Imm_c, Imm_option, Imm_prfop, Imm_bti, Imm_svcr, Pstatefield, Systemreg, Imm_fp
+// RegisterWithArrangement, RegisterWithArrangementAndIndex,
+// PredicateWithQualifier, PredicateElement, RegisterList, MemSVE,
+// ImmSigned, ImmFloat, Imm_sveprfop, Imm_vl,
+// ZATile, ZATileSlice, ZAArrayVector, ZATileList.
 type Arg interface {
 	isArg()
 	String() string
@@ -326,6 +329,75 @@ const (
 	V30
 	V31
 
+	Z0
+	Z1
+	Z2
+	Z3
+	Z4
+	Z5
+	Z6
+	Z7
+	Z8
+	Z9
+	Z10
+	Z11
+	Z12
+	Z13
+	Z14
+	Z15
+	Z16
+	Z17
+	Z18
+	Z19
+	Z20
+	Z21
+	Z22
+	Z23
+	Z24
+	Z25
+	Z26
+	Z27
+	Z28
+	Z29
+	Z30
+	Z31
+
+	P0
+	P1
+	P2
+	P3
+	P4
+	P5
+	P6
+	P7
+	P8
+	P9
+	P10
+	P11
+	P12
+	P13
+	P14
+	P15
+
+	PN0
+	PN1
+	PN2
+	PN3
+	PN4
+	PN5
+	PN6
+	PN7
+	PN8
+	PN9
+	PN10
+	PN11
+	PN12
+	PN13
+	PN14
+	PN15
+
+	ZT0
+
 	WSP = WZR // These are different registers with the same encoding.
 	SP  = XZR // These are different registers with the same encoding.
 )
@@ -356,6 +428,15 @@ func (r Reg) String() string {
 
 	case V0 <= r && r <= V31:
 		return fmt.Sprintf("V%d", int(r-V0))
+
+	case Z0 <= r && r <= Z31:
+		return fmt.Sprintf("Z%d", int(r-Z0))
+	case P0 <= r && r <= P15:
+		return fmt.Sprintf("P%d", int(r-P0))
+	case PN0 <= r && r <= PN15:
+		return fmt.Sprintf("PN%d", int(r-PN0))
+	case r == ZT0:
+		return "ZT0"
 	default:
 		return fmt.Sprintf("Reg(%d)", int(r))
 	}
@@ -530,6 +611,23 @@ func (m MemImmediate) String() string {
 	return "unimplemented!"
 }
 
+// A MOPSReg is a register operand of a memory copy or set instruction.
+// The instruction always writes the register back; Mem reports whether
+// the register holds an address, written [Xn]! rather than Xn!.
+type MOPSReg struct {
+	Reg Reg
+	Mem bool
+}
+
+func (MOPSReg) isArg() {}
+
+func (m MOPSReg) String() string {
+	if m.Mem {
+		return fmt.Sprintf("[%s]!", m.Reg)
+	}
+	return m.Reg.String() + "!"
+}
+
 // A MemExtend is a memory reference made up of a base R and index expression X.
 // The effective memory address is R or R+X depending on Index, Extend and Amount.
 type MemExtend struct {
@@ -783,6 +881,39 @@ func (i Imm_prfop) String() string {
 	return result
 }
 
+// An Imm_bti is the branch target type of a BTI instruction.
+type Imm_bti uint8
+
+func (Imm_bti) isArg() {}
+
+func (i Imm_bti) String() string {
+	switch i {
+	case 1:
+		return "C"
+	case 2:
+		return "J"
+	case 3:
+		return "JC"
+	}
+	return ""
+}
+
+// An Imm_svcr is the PSTATE field operand of SMSTART and SMSTOP:
+// SM for the streaming mode, ZA for the ZA storage.
+type Imm_svcr uint8
+
+func (Imm_svcr) isArg() {}
+
+func (i Imm_svcr) String() string {
+	switch i {
+	case 1:
+		return "SM"
+	case 2:
+		return "ZA"
+	}
+	return ""
+}
+
 type Pstatefield uint8
 
 const (
@@ -867,6 +998,7 @@ const (
 	Arrangement1D
 	Arrangement2D
 	Arrangement1Q
+	ArrangementQ
 )
 
 func (a Arrangement) String() (result string) {
@@ -897,6 +1029,8 @@ func (a Arrangement) String() (result string) {
 		result = ".2D"
 	case Arrangement1Q:
 		result = ".1Q"
+	case ArrangementQ:
+		result = ".Q"
 	}
 	return
 }
@@ -0,0 +1,88 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+// A MOPSStage is the position of a memory copy or set instruction
+// (FEAT_MOPS) within its prologue, main, epilogue sequence.
+type MOPSStage uint8
+
+const (
+	MOPSPrologue MOPSStage = iota // CPYFP, CPYP, SETP, SETGP and their variants
+	MOPSMain                      // CPYFM, CPYM, SETM, SETGM and their variants
+	MOPSEpilogue                  // CPYFE, CPYE, SETE, SETGE and their variants
+)
+
+func (s MOPSStage) String() string {
+	switch s {
+	case MOPSPrologue:
+		return "prologue"
+	case MOPSMain:
+		return "main"
+	case MOPSEpilogue:
+		return "epilogue"
+	}
+	return ""
+}
+
+// MOPSSequence reports whether op is a memory copy or set instruction.
+// If so, it returns the three instructions of the sequence op belongs to,
+// indexed by MOPSStage, and the stage of op itself.
+// The instructions of a sequence must be executed consecutively with
+// the same operands, prologue first.
+func MOPSSequence(op Op) (seq [3]Op, stage MOPSStage, ok bool) {
+	for _, seq := range mopsSequences {
+		for i, o := range seq {
+			if o == op {
+				return seq, MOPSStage(i), true
+			}
+		}
+	}
+	return [3]Op{}, 0, false
+}
+
+// mopsSequences lists the prologue, main and epilogue instructions of
+// each memory copy and set sequence.
+var mopsSequences = [...][3]Op{
+	{CPYFP, CPYFM, CPYFE},
+	{CPYFPWN, CPYFMWN, CPYFEWN},
+	{CPYFPRN, CPYFMRN, CPYFERN},
+	{CPYFPN, CPYFMN, CPYFEN},
+	{CPYFPWT, CPYFMWT, CPYFEWT},
+	{CPYFPWTWN, CPYFMWTWN, CPYFEWTWN},
+	{CPYFPWTRN, CPYFMWTRN, CPYFEWTRN},
+	{CPYFPWTN, CPYFMWTN, CPYFEWTN},
+	{CPYFPRT, CPYFMRT, CPYFERT},
+	{CPYFPRTWN, CPYFMRTWN, CPYFERTWN},
+	{CPYFPRTRN, CPYFMRTRN, CPYFERTRN},
+	{CPYFPRTN, CPYFMRTN, CPYFERTN},
+	{CPYFPT, CPYFMT, CPYFET},
+	{CPYFPTWN, CPYFMTWN, CPYFETWN},
+	{CPYFPTRN, CPYFMTRN, CPYFETRN},
+	{CPYFPTN, CPYFMTN, CPYFETN},
+	{CPYP, CPYM, CPYE},
+	{CPYPWN, CPYMWN, CPYEWN},
+	{CPYPRN, CPYMRN, CPYERN},
+	{CPYPN, CPYMN, CPYEN},
+	{CPYPWT, CPYMWT, CPYEWT},
+	{CPYPWTWN, CPYMWTWN, CPYEWTWN},
+	{CPYPWTRN, CPYMWTRN, CPYEWTRN},
+	{CPYPWTN, CPYMWTN, CPYEWTN},
+	{CPYPRT, CPYMRT, CPYERT},
+	{CPYPRTWN, CPYMRTWN, CPYERTWN},
+	{CPYPRTRN, CPYMRTRN, CPYERTRN},
+	{CPYPRTN, CPYMRTN, CPYERTN},
+	{CPYPT, CPYMT, CPYET},
+	{CPYPTWN, CPYMTWN, CPYETWN},
+	{CPYPTRN, CPYMTRN, CPYETRN},
+	{CPYPTN, CPYMTN, CPYETN},
+	{SETP, SETM, SETE},
+	{SETPT, SETMT, SETET},
+	{SETPN, SETMN, SETEN},
+	{SETPTN, SETMTN, SETETN},
+	{SETGP, SETGM, SETGE},
+	{SETGPT, SETGMT, SETGET},
+	{SETGPN, SETGMN, SETGEN},
+	{SETGPTN, SETGMTN, SETGETN},
+}
@@ -26,6 +26,10 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
 
+	if isSVE(inst.Enc) {
+		return sveGoSyntax(inst)
+	}
+
 	var args []string
 	for _, a := range inst.Args {
 		if a == nil {
@@ -92,6 +96,10 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		regno := uint16(r) & 31
 		return fmt.Sprintf("JMP (R%d)", regno)
 
+	case BRAA, BRAAZ, BRAB, BRABZ, BLRAA, BLRAAZ, BLRAB, BLRABZ:
+		// The target register is written like that of JMP and CALL.
+		args[0] = "(" + args[0] + ")"
+
 	case MOV:
 		rno := -1
 		switch a := inst.Args[0].(type) {
@@ -205,6 +213,13 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		op = "MOVH" + suffix
 		args[0], args[1] = args[1], args[0]
 
+	case ST2G, STG, STGM, STZ2G, STZG, STZGM:
+		op += suffix
+		args[0], args[1] = args[1], args[0]
+
+	case STGP:
+		return fmt.Sprintf("%s%s (%s, %s), %s", op, suffix, args[0], args[1], args[2])
+
 	case TBNZ, TBZ:
 		args[0], args[1], args[2] = args[2], args[0], args[1]
 
@@ -295,6 +310,39 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 		args[2] = args[3]
 		return op + " " + args[1] + ", " + args[2] + ", " + args[0]
 
+	case CAS, CASA, CASAL, CASL, CASB, CASAB, CASALB, CASLB, CASH, CASAH,
+		CASALH, CASLH, LDADD, LDADDA, LDADDAL, LDADDL, LDADDB, LDADDAB,
+		LDADDALB, LDADDLB, LDADDH, LDADDAH, LDADDALH, LDADDLH, STADD,
+		STADDL, STADDB, STADDLB, STADDH, STADDLH, LDCLR, LDCLRA, LDCLRAL,
+		LDCLRL, LDCLRB, LDCLRAB, LDCLRALB, LDCLRLB, LDCLRH, LDCLRAH,
+		LDCLRALH, LDCLRLH, STCLR, STCLRL, STCLRB, STCLRLB, STCLRH, STCLRLH,
+		LDEOR, LDEORA, LDEORAL, LDEORL, LDEORB, LDEORAB, LDEORALB, LDEORLB,
+		LDEORH, LDEORAH, LDEORALH, LDEORLH, STEOR, STEORL, STEORB, STEORLB,
+		STEORH, STEORLH, LDSET, LDSETA, LDSETAL, LDSETL, LDSETB, LDSETAB,
+		LDSETALB, LDSETLB, LDSETH, LDSETAH, LDSETALH, LDSETLH, STSET,
+		STSETL, STSETB, STSETLB, STSETH, STSETLH, LDSMAX, LDSMAXA,
+		LDSMAXAL, LDSMAXL, LDSMAXB, LDSMAXAB, LDSMAXALB, LDSMAXLB, LDSMAXH,
+		LDSMAXAH, LDSMAXALH, LDSMAXLH, STSMAX, STSMAXL, STSMAXB, STSMAXLB,
+		STSMAXH, STSMAXLH, LDSMIN, LDSMINA, LDSMINAL, LDSMINL, LDSMINB,
+		LDSMINAB, LDSMINALB, LDSMINLB, LDSMINH, LDSMINAH, LDSMINALH,
+		LDSMINLH, STSMIN, STSMINL, STSMINB, STSMINLB, STSMINH, STSMINLH,
+		LDUMAX, LDUMAXA, LDUMAXAL, LDUMAXL, LDUMAXB, LDUMAXAB, LDUMAXALB,
+		LDUMAXLB, LDUMAXH, LDUMAXAH, LDUMAXALH, LDUMAXLH, STUMAX, STUMAXL,
+		STUMAXB, STUMAXLB, STUMAXH, STUMAXLH, LDUMIN, LDUMINA, LDUMINAL,
+		LDUMINL, LDUMINB, LDUMINAB, LDUMINALB, LDUMINLB, LDUMINH, LDUMINAH,
+		LDUMINALH, LDUMINLH, STUMIN, STUMINL, STUMINB, STUMINLB, STUMINH,
+		STUMINLH, SWP, SWPA, SWPAL, SWPL, SWPB, SWPAB, SWPALB, SWPLB, SWPH,
+		SWPAH, SWPALH, SWPLH:
+		return plan9Atomic(&inst, args)
+
+	case CASP, CASPA, CASPAL, CASPL:
+		if r, ok := inst.Args[0].(Reg); ok && r <= WZR {
+			op += "W"
+		} else {
+			op += "D"
+		}
+		return fmt.Sprintf("%s (%s, %s), %s, (%s, %s)", op, args[0], args[1], args[4], args[2], args[3])
+
 	case FCCMP, FCCMPE:
 		args[0], args[1] = args[1], args[0]
 		fallthrough
@@ -453,6 +501,30 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text
 	return op
 }
 
+// plan9Atomic returns the Go assembler syntax for an LSE atomic memory
+// operation, which has the operand size as a suffix like the other loads
+// and stores, W or D unless the mnemonic already ends in B or H.
+// Go spells LDSET as LDOR and has no store-only aliases like STADD:
+// they are written as the loads with ZR as the destination.
+func plan9Atomic(inst *Inst, args []string) string {
+	op := inst.Op.String()
+	if strings.HasPrefix(op, "ST") {
+		op = "LD" + op[len("ST"):]
+		args = []string{args[0], "ZR", args[1]}
+	}
+	if strings.HasPrefix(op, "LDSET") {
+		op = "LDOR" + op[len("LDSET"):]
+	}
+	if !strings.HasSuffix(op, "B") && !strings.HasSuffix(op, "H") {
+		if r, ok := inst.Args[0].(Reg); ok && r <= WZR {
+			op += "W"
+		} else {
+			op += "D"
+		}
+	}
+	return op + " " + args[0] + ", " + args[2] + ", " + args[1]
+}
+
 // No need add "W" to opcode suffix.
 // Opcode must be inserted in ascending order.
 var noSuffixOpSet = strings.Fields(`
@@ -626,6 +698,12 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 
 		return base + index
 
+	case MOPSReg:
+		if a.Mem {
+			return "(" + plan9gpr(a.Reg) + ")!"
+		}
+		return plan9gpr(a.Reg) + "!"
+
 	case Cond:
 		switch arg.String() {
 		case "CS":
@@ -0,0 +1,390 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file contains the decoding of the Scalable Matrix Extension
+// instructions (SME and SME2). They operate on the ZA array, a matrix of
+// vectors that is accessed as a whole, by vector, as tiles of a given
+// element size, or as horizontal and vertical slices of a tile.
+//
+// The SME instructions use the same format description as the SVE
+// instructions, but the Go assembler does not support them, so unlike
+// the SVE tables, the table below is not generated by arm64/instgen.
+// The Go syntax follows the SVE instructions: the operands are reversed
+// except for stores, and the Go mnemonics are the ARM mnemonics.
+// SMSTART and SMSTOP, which are aliases of MSR, are decoded by the
+// main decoder.
+
+// Elements of the SME operands.
+var (
+	smeElem_None   = &sveElem{kind: sveElemNone}
+	smeElem_ArngB  = &sveElem{kind: sveElemArng, arng: []Arrangement{ArrangementB}}
+	smeElem_ArngH  = &sveElem{kind: sveElemArng, arng: []Arrangement{ArrangementH}}
+	smeElem_ArngS  = &sveElem{kind: sveElemArng, arng: []Arrangement{ArrangementS}}
+	smeElem_ArngD  = &sveElem{kind: sveElemArng, arng: []Arrangement{ArrangementD}}
+	smeElem_ArngQ  = &sveElem{kind: sveElemArng, arng: []Arrangement{ArrangementQ}}
+	smeElem_Merge  = &sveElem{kind: sveElemTable, tab: []int16{int16(PredicateMerging)}}
+	smeElem_Zero   = &sveElem{kind: sveElemTable, tab: []int16{int16(PredicateZeroing)}}
+	smeElem_LSL    = &sveElem{kind: sveElemTable, tab: []int16{sveModLSL}}
+	smeElem_Pg1013 = &sveElem{kind: sveElemReg, f: [3]sveField{{10, 13}}, base: P0}
+	smeElem_Pm1316 = &sveElem{kind: sveElemReg, f: [3]sveField{{13, 16}}, base: P0}
+	smeElem_Zd05   = &sveElem{kind: sveElemReg, f: [3]sveField{{0, 5}}, base: Z0}
+	smeElem_Zn510  = &sveElem{kind: sveElemReg, f: [3]sveField{{5, 10}}, base: Z0}
+	smeElem_Zm1621 = &sveElem{kind: sveElemReg, f: [3]sveField{{16, 21}}, base: Z0}
+	smeElem_Rd05   = &sveElem{kind: sveElemReg, f: [3]sveField{{0, 5}}, base: X0}
+	smeElem_Rd05SP = &sveElem{kind: sveElemReg, f: [3]sveField{{0, 5}}, base: X0, sp: true}
+	smeElem_Rn510  = &sveElem{kind: sveElemReg, f: [3]sveField{{5, 10}}, base: X0, sp: true}
+	smeElem_Rn1621 = &sveElem{kind: sveElemReg, f: [3]sveField{{16, 21}}, base: X0, sp: true}
+	smeElem_Rm1621 = &sveElem{kind: sveElemReg, f: [3]sveField{{16, 21}}, base: X0}
+	smeElem_Ws1315 = &sveElem{kind: sveElemReg, f: [3]sveField{{13, 15}}, base: W12}
+	smeElem_Wv1618 = &sveElem{kind: sveElemReg, f: [3]sveField{{16, 18}}, base: W12}
+	smeElem_HV15   = &sveElem{kind: sveElemUint, f: [3]sveField{{15, 16}}}
+	smeElem_Imm510 = &sveElem{kind: sveElemSint, f: [3]sveField{{5, 11}}}
+	smeElem_Shift1 = &sveElem{kind: sveElemUint, add: 1}
+	smeElem_Shift2 = &sveElem{kind: sveElemUint, add: 2}
+	smeElem_Shift3 = &sveElem{kind: sveElemUint, add: 3}
+	smeElem_Shift4 = &sveElem{kind: sveElemUint, add: 4}
+	smeElem_Mask08 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 8}}}
+	smeElem_ZT0    = &sveElem{kind: sveElemReg, base: ZT0}
+
+	// The tile number and the slice offset share a field,
+	// the tile number taking the high bits, ZA<n>:<offs>.
+	smeElem_Tile02 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 2}}}
+	smeElem_Tile03 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 3}}}
+	smeElem_Tile04 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 4}}}
+	smeElem_Tile14 = &sveElem{kind: sveElemUint, f: [3]sveField{{1, 4}}}
+	smeElem_Tile24 = &sveElem{kind: sveElemUint, f: [3]sveField{{2, 4}}}
+	smeElem_Tile34 = &sveElem{kind: sveElemUint, f: [3]sveField{{3, 4}}}
+	smeElem_Tile59 = &sveElem{kind: sveElemUint, f: [3]sveField{{5, 9}}}
+	smeElem_Tile69 = &sveElem{kind: sveElemUint, f: [3]sveField{{6, 9}}}
+	smeElem_Tile79 = &sveElem{kind: sveElemUint, f: [3]sveField{{7, 9}}}
+	smeElem_Tile89 = &sveElem{kind: sveElemUint, f: [3]sveField{{8, 9}}}
+	smeElem_Offs01 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 1}}}
+	smeElem_Offs02 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 2}}}
+	smeElem_Offs03 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 3}}}
+	smeElem_Offs04 = &sveElem{kind: sveElemUint, f: [3]sveField{{0, 4}}}
+	smeElem_Offs56 = &sveElem{kind: sveElemUint, f: [3]sveField{{5, 6}}}
+	smeElem_Offs57 = &sveElem{kind: sveElemUint, f: [3]sveField{{5, 7}}}
+	smeElem_Offs58 = &sveElem{kind: sveElemUint, f: [3]sveField{{5, 8}}}
+	smeElem_Offs59 = &sveElem{kind: sveElemUint, f: [3]sveField{{5, 9}}}
+
+	// The element size and index of PSEL are encoded as i1:tszh:tszl.
+	smeElem_PselArng = &sveElem{kind: sveElemArng, f: [3]sveField{{22, 23}, {18, 21}}, arng: []Arrangement{
+		0, ArrangementB, ArrangementH, ArrangementB, ArrangementS, ArrangementB, ArrangementH, ArrangementB,
+		ArrangementD, ArrangementB, ArrangementH, ArrangementB, ArrangementS, ArrangementB, ArrangementH, ArrangementB,
+	}}
+	smeElem_PselIndex = &sveElem{kind: sveElemTszIndex, f: [3]sveField{{23, 24}, {22, 23}, {18, 21}}}
+)
+
+// Operands of the SME instructions.
+var (
+	smeArg_Pn_M = &sveOperand{sveClassPred, []*sveElem{smeElem_Pg1013, smeElem_Merge}}
+	smeArg_Pm_M = &sveOperand{sveClassPred, []*sveElem{smeElem_Pm1316, smeElem_Merge}}
+	smeArg_Pg_M = &sveOperand{sveClassPred, []*sveElem{smeElem_Pg1013, smeElem_Merge}}
+	smeArg_Pg_Z = &sveOperand{sveClassPred, []*sveElem{smeElem_Pg1013, smeElem_Zero}}
+	smeArg_Pg   = &sveOperand{sveClassReg, []*sveElem{smeElem_Pg1013}}
+
+	smeArg_Zn_B = &sveOperand{sveClassArng, []*sveElem{smeElem_Zn510, smeElem_ArngB}}
+	smeArg_Zn_H = &sveOperand{sveClassArng, []*sveElem{smeElem_Zn510, smeElem_ArngH}}
+	smeArg_Zn_S = &sveOperand{sveClassArng, []*sveElem{smeElem_Zn510, smeElem_ArngS}}
+	smeArg_Zn_D = &sveOperand{sveClassArng, []*sveElem{smeElem_Zn510, smeElem_ArngD}}
+	smeArg_Zn_Q = &sveOperand{sveClassArng, []*sveElem{smeElem_Zn510, smeElem_ArngQ}}
+	smeArg_Zm_B = &sveOperand{sveClassArng, []*sveElem{smeElem_Zm1621, smeElem_ArngB}}
+	smeArg_Zm_H = &sveOperand{sveClassArng, []*sveElem{smeElem_Zm1621, smeElem_ArngH}}
+	smeArg_Zm_S = &sveOperand{sveClassArng, []*sveElem{smeElem_Zm1621, smeElem_ArngS}}
+	smeArg_Zm_D = &sveOperand{sveClassArng, []*sveElem{smeElem_Zm1621, smeElem_ArngD}}
+	smeArg_Zd_B = &sveOperand{sveClassArng, []*sveElem{smeElem_Zd05, smeElem_ArngB}}
+	smeArg_Zd_H = &sveOperand{sveClassArng, []*sveElem{smeElem_Zd05, smeElem_ArngH}}
+	smeArg_Zd_S = &sveOperand{sveClassArng, []*sveElem{smeElem_Zd05, smeElem_ArngS}}
+	smeArg_Zd_D = &sveOperand{sveClassArng, []*sveElem{smeElem_Zd05, smeElem_ArngD}}
+	smeArg_Zd_Q = &sveOperand{sveClassArng, []*sveElem{smeElem_Zd05, smeElem_ArngQ}}
+
+	smeArg_ZAda_S = &sveOperand{sveClassZATile, []*sveElem{smeElem_Tile02, smeElem_ArngS}}
+	smeArg_ZAda_D = &sveOperand{sveClassZATile, []*sveElem{smeElem_Tile03, smeElem_ArngD}}
+
+	// ZA<n><HV>.<T>[<Ws>, <offs>] in bits 0-3, as the destination of MOVA
+	// and the operand of LD1 and ST1.
+	smeArg_ZAd_B = &sveOperand{sveClassZASlice, []*sveElem{smeElem_None, smeElem_ArngB, smeElem_HV15, smeElem_Ws1315, smeElem_Offs04}}
+	smeArg_ZAd_H = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile34, smeElem_ArngH, smeElem_HV15, smeElem_Ws1315, smeElem_Offs03}}
+	smeArg_ZAd_S = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile24, smeElem_ArngS, smeElem_HV15, smeElem_Ws1315, smeElem_Offs02}}
+	smeArg_ZAd_D = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile14, smeElem_ArngD, smeElem_HV15, smeElem_Ws1315, smeElem_Offs01}}
+	smeArg_ZAd_Q = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile04, smeElem_ArngQ, smeElem_HV15, smeElem_Ws1315, smeElem_None}}
+	smeArg_ZAt_B = &sveOperand{sveClassZASliceList, smeArg_ZAd_B.elems}
+	smeArg_ZAt_H = &sveOperand{sveClassZASliceList, smeArg_ZAd_H.elems}
+	smeArg_ZAt_S = &sveOperand{sveClassZASliceList, smeArg_ZAd_S.elems}
+	smeArg_ZAt_D = &sveOperand{sveClassZASliceList, smeArg_ZAd_D.elems}
+	smeArg_ZAt_Q = &sveOperand{sveClassZASliceList, smeArg_ZAd_Q.elems}
+
+	// ZA<n><HV>.<T>[<Ws>, <offs>] in bits 5-8, as the source of MOVA.
+	smeArg_ZAn_B = &sveOperand{sveClassZASlice, []*sveElem{smeElem_None, smeElem_ArngB, smeElem_HV15, smeElem_Ws1315, smeElem_Offs59}}
+	smeArg_ZAn_H = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile89, smeElem_ArngH, smeElem_HV15, smeElem_Ws1315, smeElem_Offs58}}
+	smeArg_ZAn_S = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile79, smeElem_ArngS, smeElem_HV15, smeElem_Ws1315, smeElem_Offs57}}
+	smeArg_ZAn_D = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile69, smeElem_ArngD, smeElem_HV15, smeElem_Ws1315, smeElem_Offs56}}
+	smeArg_ZAn_Q = &sveOperand{sveClassZASlice, []*sveElem{smeElem_Tile59, smeElem_ArngQ, smeElem_HV15, smeElem_Ws1315, smeElem_None}}
+
+	smeArg_ZA_Wv = &sveOperand{sveClassZAArray, []*sveElem{smeElem_Ws1315, smeElem_Offs04}}
+	smeArg_Mask  = &sveOperand{sveClassZAMask, []*sveElem{smeElem_Mask08}}
+	smeArg_ZT0   = &sveOperand{sveClassReg, []*sveElem{smeElem_ZT0}}
+	smeArg_ZT0L  = &sveOperand{sveClassList, []*sveElem{smeElem_ZT0, smeElem_None}}
+
+	// [<Xn|SP>{, <Xm>, LSL #<amount>}]
+	smeArg_Mem0 = &sveOperand{sveClassMemOptExt, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_Rm1621, smeElem_None, smeElem_None, smeElem_None}}
+	smeArg_Mem1 = &sveOperand{sveClassMemOptExt, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_Rm1621, smeElem_None, smeElem_LSL, smeElem_Shift1}}
+	smeArg_Mem2 = &sveOperand{sveClassMemOptExt, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_Rm1621, smeElem_None, smeElem_LSL, smeElem_Shift2}}
+	smeArg_Mem3 = &sveOperand{sveClassMemOptExt, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_Rm1621, smeElem_None, smeElem_LSL, smeElem_Shift3}}
+	smeArg_Mem4 = &sveOperand{sveClassMemOptExt, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_Rm1621, smeElem_None, smeElem_LSL, smeElem_Shift4}}
+	// [<Xn|SP>{, #<offs>, MUL VL}]
+	smeArg_MemVL = &sveOperand{sveClassMemOffVL, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_Offs04}}
+	// [<Xn|SP>]
+	smeArg_Mem = &sveOperand{sveClassMemOff, []*sveElem{smeElem_Rn510, smeElem_None, smeElem_None}}
+
+	smeArg_Xd     = &sveOperand{sveClassReg, []*sveElem{smeElem_Rd05}}
+	smeArg_XdSP   = &sveOperand{sveClassScalar, []*sveElem{smeElem_ArngD, smeElem_Rd05SP}}
+	smeArg_XnSP   = &sveOperand{sveClassScalar, []*sveElem{smeElem_ArngD, smeElem_Rn1621}}
+	smeArg_Imm6   = &sveOperand{sveClassImm, []*sveElem{smeElem_Imm510}}
+	smeArg_Pd     = &sveOperand{sveClassReg, []*sveElem{&sveElem{kind: sveElemReg, f: [3]sveField{{0, 4}}, base: P0}}}
+	smeArg_Pn     = &sveOperand{sveClassReg, []*sveElem{&sveElem{kind: sveElemReg, f: [3]sveField{{10, 14}}, base: P0}}}
+	smeArg_PselPm = &sveOperand{sveClassPredElem, []*sveElem{&sveElem{kind: sveElemReg, f: [3]sveField{{5, 9}}, base: P0}, smeElem_PselArng, smeElem_Wv1618, smeElem_PselIndex}}
+)
+
+var smeFormats = [...]sveFormat{
+	// ZERO { ZT0 }
+	{0xffffffff, 0xc0480001, ZERO, "ZERO", [5]*sveOperand{smeArg_ZT0L}},
+	// ZERO { <mask> }
+	{0xffffff00, 0xc0080000, ZERO, "ZERO", [5]*sveOperand{smeArg_Mask}},
+	// LDR ZT0, [<Xn|SP>]
+	{0xfffffc1f, 0xe11f8000, LDR, "LDR", [5]*sveOperand{smeArg_ZT0, smeArg_Mem}},
+	// STR ZT0, [<Xn|SP>]
+	{0xfffffc1f, 0xe13f8000, STR, "STR", [5]*sveOperand{smeArg_ZT0, smeArg_Mem}},
+	// LDR ZA[<Wv>, <offs>], [<Xn|SP>{, #<offs>, MUL VL}]
+	{0xffff9c10, 0xe1000000, LDR, "LDR", [5]*sveOperand{smeArg_ZA_Wv, smeArg_MemVL}},
+	// STR ZA[<Wv>, <offs>], [<Xn|SP>{, #<offs>, MUL VL}]
+	{0xffff9c10, 0xe1200000, STR, "STR", [5]*sveOperand{smeArg_ZA_Wv, smeArg_MemVL}},
+
+	// ADDHA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.S
+	{0xffff001c, 0xc0900000, ADDHA, "ADDHA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_S}},
+	// ADDHA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.D
+	{0xffff0018, 0xc0d00000, ADDHA, "ADDHA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_D}},
+	// ADDVA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.S
+	{0xffff001c, 0xc0910000, ADDVA, "ADDVA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_S}},
+	// ADDVA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.D
+	{0xffff0018, 0xc0d10000, ADDVA, "ADDVA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_D}},
+
+	// BFMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe0001c, 0x81800000, BFMOPA, "BFMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// BFMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe0001c, 0x81800010, BFMOPS, "BFMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// FMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe0001c, 0x81a00000, FMOPA, "FMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// FMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe0001c, 0x81a00010, FMOPS, "FMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// FMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.S, <Zm>.S
+	{0xffe0001c, 0x80800000, FMOPA, "FMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_S, smeArg_Zm_S}},
+	// FMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.S, <Zm>.S
+	{0xffe0001c, 0x80800010, FMOPS, "FMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_S, smeArg_Zm_S}},
+	// FMOPA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.D, <Zm>.D
+	{0xffe00018, 0x80c00000, FMOPA, "FMOPA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_D, smeArg_Zm_D}},
+	// FMOPS <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.D, <Zm>.D
+	{0xffe00018, 0x80c00010, FMOPS, "FMOPS", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_D, smeArg_Zm_D}},
+
+	// SMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa0800000, SMOPA, "SMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// SMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa0800010, SMOPS, "SMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// SUMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa0a00000, SUMOPA, "SUMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// SUMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa0a00010, SUMOPS, "SUMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// USMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa1800000, USMOPA, "USMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// USMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa1800010, USMOPS, "USMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// UMOPA <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa1a00000, UMOPA, "UMOPA", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// UMOPS <ZAda>.S, <Pn>/M, <Pm>/M, <Zn>.B, <Zm>.B
+	{0xffe0001c, 0xa1a00010, UMOPS, "UMOPS", [5]*sveOperand{smeArg_ZAda_S, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_B, smeArg_Zm_B}},
+	// SMOPA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa0c00000, SMOPA, "SMOPA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// SMOPS <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa0c00010, SMOPS, "SMOPS", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// SUMOPA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa0e00000, SUMOPA, "SUMOPA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// SUMOPS <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa0e00010, SUMOPS, "SUMOPS", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// USMOPA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa1c00000, USMOPA, "USMOPA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// USMOPS <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa1c00010, USMOPS, "USMOPS", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// UMOPA <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa1e00000, UMOPA, "UMOPA", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+	// UMOPS <ZAda>.D, <Pn>/M, <Pm>/M, <Zn>.H, <Zm>.H
+	{0xffe00018, 0xa1e00010, UMOPS, "UMOPS", [5]*sveOperand{smeArg_ZAda_D, smeArg_Pn_M, smeArg_Pm_M, smeArg_Zn_H, smeArg_Zm_H}},
+
+	// LD1B { <ZAt><HV>.B[<Ws>, <offs>] }, <Pg>/Z, [<Xn|SP>{, <Xm>}]
+	{0xffe00010, 0xe0000000, LD1B, "LD1B", [5]*sveOperand{smeArg_ZAt_B, smeArg_Pg_Z, smeArg_Mem0}},
+	// LD1H { <ZAt><HV>.H[<Ws>, <offs>] }, <Pg>/Z, [<Xn|SP>{, <Xm>, LSL #1}]
+	{0xffe00010, 0xe0400000, LD1H, "LD1H", [5]*sveOperand{smeArg_ZAt_H, smeArg_Pg_Z, smeArg_Mem1}},
+	// LD1W { <ZAt><HV>.S[<Ws>, <offs>] }, <Pg>/Z, [<Xn|SP>{, <Xm>, LSL #2}]
+	{0xffe00010, 0xe0800000, LD1W, "LD1W", [5]*sveOperand{smeArg_ZAt_S, smeArg_Pg_Z, smeArg_Mem2}},
+	// LD1D { <ZAt><HV>.D[<Ws>, <offs>] }, <Pg>/Z, [<Xn|SP>{, <Xm>, LSL #3}]
+	{0xffe00010, 0xe0c00000, LD1D, "LD1D", [5]*sveOperand{smeArg_ZAt_D, smeArg_Pg_Z, smeArg_Mem3}},
+	// LD1Q { <ZAt><HV>.Q[<Ws>, <offs>] }, <Pg>/Z, [<Xn|SP>{, <Xm>, LSL #4}]
+	{0xffe00010, 0xe1c00000, LD1Q, "LD1Q", [5]*sveOperand{smeArg_ZAt_Q, smeArg_Pg_Z, smeArg_Mem4}},
+	// ST1B { <ZAt><HV>.B[<Ws>, <offs>] }, <Pg>, [<Xn|SP>{, <Xm>}]
+	{0xffe00010, 0xe0200000, ST1B, "ST1B", [5]*sveOperand{smeArg_ZAt_B, smeArg_Pg, smeArg_Mem0}},
+	// ST1H { <ZAt><HV>.H[<Ws>, <offs>] }, <Pg>, [<Xn|SP>{, <Xm>, LSL #1}]
+	{0xffe00010, 0xe0600000, ST1H, "ST1H", [5]*sveOperand{smeArg_ZAt_H, smeArg_Pg, smeArg_Mem1}},
+	// ST1W { <ZAt><HV>.S[<Ws>, <offs>] }, <Pg>, [<Xn|SP>{, <Xm>, LSL #2}]
+	{0xffe00010, 0xe0a00000, ST1W, "ST1W", [5]*sveOperand{smeArg_ZAt_S, smeArg_Pg, smeArg_Mem2}},
+	// ST1D { <ZAt><HV>.D[<Ws>, <offs>] }, <Pg>, [<Xn|SP>{, <Xm>, LSL #3}]
+	{0xffe00010, 0xe0e00000, ST1D, "ST1D", [5]*sveOperand{smeArg_ZAt_D, smeArg_Pg, smeArg_Mem3}},
+	// ST1Q { <ZAt><HV>.Q[<Ws>, <offs>] }, <Pg>, [<Xn|SP>{, <Xm>, LSL #4}]
+	{0xffe00010, 0xe1e00000, ST1Q, "ST1Q", [5]*sveOperand{smeArg_ZAt_Q, smeArg_Pg, smeArg_Mem4}},
+
+	// MOV <Zd>.B, <Pg>/M, <ZAn><HV>.B[<Ws>, <offs>]
+	{0xffff0200, 0xc0020000, MOV, "MOVA", [5]*sveOperand{smeArg_Zd_B, smeArg_Pg_M, smeArg_ZAn_B}},
+	// MOV <Zd>.H, <Pg>/M, <ZAn><HV>.H[<Ws>, <offs>]
+	{0xffff0200, 0xc0420000, MOV, "MOVA", [5]*sveOperand{smeArg_Zd_H, smeArg_Pg_M, smeArg_ZAn_H}},
+	// MOV <Zd>.S, <Pg>/M, <ZAn><HV>.S[<Ws>, <offs>]
+	{0xffff0200, 0xc0820000, MOV, "MOVA", [5]*sveOperand{smeArg_Zd_S, smeArg_Pg_M, smeArg_ZAn_S}},
+	// MOV <Zd>.D, <Pg>/M, <ZAn><HV>.D[<Ws>, <offs>]
+	{0xffff0200, 0xc0c20000, MOV, "MOVA", [5]*sveOperand{smeArg_Zd_D, smeArg_Pg_M, smeArg_ZAn_D}},
+	// MOV <Zd>.Q, <Pg>/M, <ZAn><HV>.Q[<Ws>, <offs>]
+	{0xffff0200, 0xc0c30000, MOV, "MOVA", [5]*sveOperand{smeArg_Zd_Q, smeArg_Pg_M, smeArg_ZAn_Q}},
+	// MOV <ZAd><HV>.B[<Ws>, <offs>], <Pg>/M, <Zn>.B
+	{0xffff0010, 0xc0000000, MOV, "MOVA", [5]*sveOperand{smeArg_ZAd_B, smeArg_Pg_M, smeArg_Zn_B}},
+	// MOV <ZAd><HV>.H[<Ws>, <offs>], <Pg>/M, <Zn>.H
+	{0xffff0010, 0xc0400000, MOV, "MOVA", [5]*sveOperand{smeArg_ZAd_H, smeArg_Pg_M, smeArg_Zn_H}},
+	// MOV <ZAd><HV>.S[<Ws>, <offs>], <Pg>/M, <Zn>.S
+	{0xffff0010, 0xc0800000, MOV, "MOVA", [5]*sveOperand{smeArg_ZAd_S, smeArg_Pg_M, smeArg_Zn_S}},
+	// MOV <ZAd><HV>.D[<Ws>, <offs>], <Pg>/M, <Zn>.D
+	{0xffff0010, 0xc0c00000, MOV, "MOVA", [5]*sveOperand{smeArg_ZAd_D, smeArg_Pg_M, smeArg_Zn_D}},
+	// MOV <ZAd><HV>.Q[<Ws>, <offs>], <Pg>/M, <Zn>.Q
+	{0xffff0010, 0xc0c10000, MOV, "MOVA", [5]*sveOperand{smeArg_ZAd_Q, smeArg_Pg_M, smeArg_Zn_Q}},
+
+	// RDSVL <Xd>, #<imm>
+	{0xfffff800, 0x04bf5800, RDSVL, "RDSVL", [5]*sveOperand{smeArg_Xd, smeArg_Imm6}},
+	// ADDSVL <Xd|SP>, <Xn|SP>, #<imm>
+	{0xffe0f800, 0x04205800, ADDSVL, "ADDSVL", [5]*sveOperand{smeArg_XdSP, smeArg_XnSP, smeArg_Imm6}},
+	// ADDSPL <Xd|SP>, <Xn|SP>, #<imm>
+	{0xffe0f800, 0x04605800, ADDSPL, "ADDSPL", [5]*sveOperand{smeArg_XdSP, smeArg_XnSP, smeArg_Imm6}},
+	// PSEL <Pd>, <Pn>, <Pm>.<T>[<Wv>, <imm>]
+	{0xff20c210, 0x25204000, PSEL, "PPSEL", [5]*sveOperand{smeArg_Pd, smeArg_Pn, smeArg_PselPm}},
+}
+
+// A ZATile is a tile of the SME ZA array, like ZA0.S.
+type ZATile struct {
+	Tile        uint8
+	Arrangement Arrangement
+}
+
+func (ZATile) isArg() {}
+
+func (t ZATile) String() string {
+	return fmt.Sprintf("ZA%d%s", t.Tile, t.Arrangement)
+}
+
+// A ZATileSlice is a horizontal or vertical slice of a ZA tile,
+// like ZA0H.S[W12, 3]. The number of the slice is the sum of
+// the index register and the offset. The slice is written in braces,
+// as a list of one vector, if List is set.
+type ZATileSlice struct {
+	Tile        uint8
+	Arrangement Arrangement
+	Vertical    bool
+	Index       Reg
+	Offset      uint8
+	List        bool
+}
+
+func (ZATileSlice) isArg() {}
+
+func (s ZATileSlice) String() string {
+	if s.List {
+		return "{" + s.format(s.Index.String()) + "}"
+	}
+	return s.format(s.Index.String())
+}
+
+// format formats the slice with the given name of the index register.
+func (s ZATileSlice) format(index string) string {
+	hv := "H"
+	if s.Vertical {
+		hv = "V"
+	}
+	return fmt.Sprintf("ZA%d%s%s[%s, %d]", s.Tile, hv, s.Arrangement, index, s.Offset)
+}
+
+// A ZAArrayVector is a vector of the ZA array, like ZA[W12, 0],
+// selected by the sum of the index register and the offset.
+type ZAArrayVector struct {
+	Index  Reg
+	Offset uint8
+}
+
+func (ZAArrayVector) isArg() {}
+
+func (v ZAArrayVector) String() string {
+	return fmt.Sprintf("ZA[%s, %d]", v.Index, v.Offset)
+}
+
+// A ZATileList is the list of ZA tiles cleared by ZERO, encoded as
+// a mask of the 64-bit tiles ZA0.D to ZA7.D. It is written with the
+// largest tiles that cover exactly the tiles of the mask.
+type ZATileList uint8
+
+func (ZATileList) isArg() {}
+
+func (l ZATileList) String() string {
+	return "{" + strings.Join(l.tiles(), ", ") + "}"
+}
+
+// tiles returns the names of the tiles in the list.
+func (l ZATileList) tiles() []string {
+	switch l {
+	case 0:
+		return nil
+	case 0xff:
+		return []string{"ZA"}
+	case 0x55:
+		return []string{"ZA0.H"}
+	case 0xaa:
+		return []string{"ZA1.H"}
+	}
+	size, n := "S", 4
+	for i := 0; i < 4; i++ {
+		if t := l >> i & 0x11; t != 0 && t != 0x11 {
+			size, n = "D", 8
+			break
+		}
+	}
+	var tiles []string
+	for i := 0; i < n; i++ {
+		if l>>i&1 != 0 {
+			tiles = append(tiles, fmt.Sprintf("ZA%d.%s", i, size))
+		}
+	}
+	return tiles
+}
+
+// A PredicateElement is an element of a predicate register, like P2.B[W12, 15],
+// selected by the sum of the index register and the offset.
+type PredicateElement struct {
+	Reg         Reg
+	Arrangement Arrangement
+	Index       Reg
+	Offset      uint8
+}
+
+func (PredicateElement) isArg() {}
+
+func (p PredicateElement) String() string {
+	return fmt.Sprintf("%s%s[%s, %d]", p.Reg, p.Arrangement, p.Index, p.Offset)
+}
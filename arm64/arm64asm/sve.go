@@ -0,0 +1,890 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// This file contains the decoding of the Scalable Vector Extension
+// instructions (SVE, SVE2 and SVE2.1), which occupy the op1 = 0010
+// space of the A64 instruction set, along with the SVE2.1 multi-vector
+// loads and stores in the SME space. The instruction formats are described
+// by the tables in sve_tables.go, generated by arm64/instgen from the ARM
+// XML specification, the same specification from which the Go assembler's
+// SVE instruction table is generated.
+//
+// Each format lists its operands in ARM order. An operand is made up of
+// elements, like the register and the arrangement of <Zd>.<T>, each of
+// which is decoded from the instruction bits independently.
+
+//go:generate go run ../instgen -d=.
+
+// isSVE reports whether the instruction x is in the SVE encoding space
+// or in the SME encoding space, which also holds the SVE2.1 multi-vector
+// contiguous loads and stores. The instructions of both are described
+// by sveFormats, and those of the SME space by smeFormats.
+func isSVE(x uint32) bool {
+	return x>>25&0xf == 0x2 || x>>25&0xf == 0 && x>>31 == 1
+}
+
+// An sveFormat describes the format of an SVE instruction encoding.
+// An instruction with 32-bit value x matches the format if x&mask == value
+// and all the elements of its operands can be decoded.
+type sveFormat struct {
+	mask  uint32
+	value uint32
+	op    Op
+	goOp  string // the Go assembler mnemonic
+	args  [5]*sveOperand
+}
+
+// An sveClass is the class of an SVE operand.
+// It determines the elements of the operand and how they are combined.
+type sveClass uint8
+
+const (
+	_                   sveClass = iota
+	sveClassReg                  // <Zd>, <Pg>: register
+	sveClassArng                 // <Zd>.<T>: register, arrangement
+	sveClassIndex                // <Zm>.<T>[<imm>], <Zn>[<imm>]: register, arrangement, index
+	sveClassPred                 // <Pg>/<ZM>: register, predication qualifier
+	sveClassScalar               // <R><n>, <V><d>: width, register
+	sveClassImm                  // #<imm>: immediate
+	sveClassList                 // { <Zt1>.<T>, <Zt2>.<T> }: register, arrangement, for each register
+	sveClassRange                // { <Zt1>.<T>-<Zt2>.<T> }: register, arrangement, for the first and last register
+	sveClassSpecial              // <prfop>, <vl>: special operand
+	sveClassMemOff               // [<Xn|SP>{, #<imm>}]: base, arrangement, offset
+	sveClassMemOffVL             // [<Xn|SP>{, #<imm>, MUL VL}]: base, arrangement, offset
+	sveClassMemExt               // [<Xn|SP>, <Zm>.<T>{, <mod> <amount>}]: base, arrangement, index, arrangement, mod, amount
+	sveClassMemOptExt            // [<Xn|SP>{, <Xm>, LSL <amount>}]: as sveClassMemExt, XZR is no index
+	sveClassPredElem             // <Pm>.<T>[<Wv>, <imm>]: register, arrangement, index, offset
+	sveClassZATile               // ZA<n>.<T>: tile, arrangement
+	sveClassZASlice              // ZA<n><HV>.<T>[<Ws>, <offs>]: tile, arrangement, direction, index, offset
+	sveClassZASliceList          // { ZA<n><HV>.<T>[<Ws>, <offs>] }: as sveClassZASlice
+	sveClassZAArray              // ZA[<Wv>, <offs>]: index, offset
+	sveClassZAMask               // { <mask> }: mask of 64-bit tiles
+)
+
+// An sveOperand describes how to decode an operand of an SVE instruction.
+type sveOperand struct {
+	class sveClass
+	elems []*sveElem
+}
+
+// An sveField is the bit range [lo, hi) of an instruction encoding.
+type sveField struct {
+	lo, hi uint8
+}
+
+// An sveElemKind says how an element of an SVE operand is decoded.
+type sveElemKind uint8
+
+const (
+	sveElemNone           sveElemKind = iota // not encoded, the value is 0
+	sveElemReg                               // register base + (field*mul + add), modulo the size of the register file
+	sveElemRegNum                            // general-purpose register number, the width is given by another element
+	sveElemUint                              // field*mul + add
+	sveElemSint                              // sign-extended field, times mul
+	sveElemArng                              // arng[field], 0 is reserved
+	sveElemTable                             // tab[field], sveReserved is reserved
+	sveElemFP                                // fp[field]
+	sveElemFPImm8                            // 8-bit floating-point immediate
+	sveElemImm8Shift                         // imm8:sh, unsigned imm8 shifted left by 8 bits if sh is set
+	sveElemSimm8Shift                        // imm8:sh, signed imm8 shifted left by 8 bits if sh is set
+	sveElemTszIndex                          // element index, encoded as imm:tsz
+	sveElemPrfop                             // prefetch operation
+	sveElemTszShiftLeft                      // left shift amount, encoded as tsz:imm3
+	sveElemTszShiftRight                     // right shift amount, encoded as tsz:imm3
+	sveElemLogicalImm                        // bitmask immediate, encoded as N:immr:imms
+	sveElemLogicalImmArng                    // element size of a bitmask immediate
+)
+
+// sveReserved marks the reserved entries of an sveElemTable.
+const sveReserved = -1
+
+// The values of the extend and shift elements of an SVE memory operand.
+const (
+	sveModNone = 0
+	sveModUXTW = int16(uxtw)
+	sveModSXTW = int16(sxtw)
+	sveModLSL  = int16(lsl)
+)
+
+// An sveElem describes how to decode an element of an SVE operand.
+// The field is the concatenation of the bit ranges in f,
+// from the most significant.
+type sveElem struct {
+	kind sveElemKind
+	f    [3]sveField
+	base Reg   // sveElemReg: first register of the register file
+	sp   bool  // sveElemReg, sveElemRegNum: register 31 is SP, not ZR
+	mul  int64 // sveElemReg, sveElemUint, sveElemSint: multiplier, 0 means 1
+	add  int64 // sveElemReg, sveElemUint: addend
+	arng []Arrangement
+	tab  []int16
+	fp   []float64
+}
+
+// field returns the field of the element and its width in bits.
+func (e *sveElem) field(x uint32) (v uint64, n uint) {
+	for _, f := range e.f {
+		if f.hi == 0 {
+			break
+		}
+		w := uint(f.hi - f.lo)
+		v = v<<w | uint64(x>>f.lo)&(1<<w-1)
+		n += w
+	}
+	return v, n
+}
+
+// decode decodes the element from the instruction bits x.
+// Registers are returned as Reg values, arrangements as Arrangement
+// values and floating-point values as their IEEE 754 binary representation.
+// The fields of 8-bit floating-point and shifted immediates and of
+// prefetch operations are returned as they are.
+func (e *sveElem) decode(x uint32) (uint64, bool) {
+	v, n := e.field(x)
+	mul := e.mul
+	if mul == 0 {
+		mul = 1
+	}
+	switch e.kind {
+	case sveElemNone:
+		return 0, true
+	case sveElemReg:
+		size := int64(32)
+		if e.base == P0 || e.base == PN0 {
+			size = 16
+		}
+		return uint64(e.base) + uint64((int64(v)*mul+e.add)%size), true
+	case sveElemRegNum:
+		return v, true
+	case sveElemUint:
+		return uint64(int64(v)*mul + e.add), true
+	case sveElemSint:
+		return uint64(int64(v<<(64-n))>>(64-n)*mul + e.add), true
+	case sveElemArng:
+		if v >= uint64(len(e.arng)) || e.arng[v] == 0 {
+			return 0, false
+		}
+		return uint64(e.arng[v]), true
+	case sveElemTable:
+		if v >= uint64(len(e.tab)) || e.tab[v] == sveReserved {
+			return 0, false
+		}
+		return uint64(e.tab[v]), true
+	case sveElemFP:
+		return math.Float64bits(e.fp[v]), true
+	case sveElemFPImm8:
+		return v, true
+	case sveElemImm8Shift, sveElemSimm8Shift, sveElemPrfop:
+		return v, true
+	case sveElemTszIndex:
+		// The first field is the high part of the index,
+		// the others make up tsz.
+		w := n - uint(e.f[0].hi-e.f[0].lo)
+		tsz := v & (1<<w - 1)
+		if tsz == 0 {
+			return 0, false
+		}
+		return v >> (bits.TrailingZeros64(tsz) + 1), true
+	case sveElemTszShiftLeft, sveElemTszShiftRight:
+		tsz := v >> 3
+		if tsz == 0 {
+			return 0, false
+		}
+		esize := uint64(8) << (bits.Len64(tsz) - 1)
+		if e.kind == sveElemTszShiftLeft {
+			return v - esize, true
+		}
+		return 2*esize - v, true
+	case sveElemLogicalImm:
+		imm, ok := handle_bitmasks(uint32(v)<<10, 64).(Imm64)
+		if !ok {
+			return 0, false
+		}
+		return imm.Imm & (1<<logicalImmSize(v) - 1), true
+	case sveElemLogicalImmArng:
+		switch logicalImmSize(v) {
+		case 8:
+			if v&0x3e == 0x3e {
+				return 0, false
+			}
+			return uint64(ArrangementB), true
+		case 16:
+			return uint64(ArrangementH), true
+		case 32:
+			return uint64(ArrangementS), true
+		}
+		return uint64(ArrangementD), true
+	}
+	return 0, false
+}
+
+// logicalImmSize returns the size in bits of the elements of the bitmask
+// immediate imm13 of an SVE instruction.
+func logicalImmSize(imm13 uint64) uint {
+	imms := imm13 & 0x3f
+	switch {
+	case imm13>>12 != 0:
+		return 64
+	case imms&0x20 == 0:
+		return 32
+	case imms&0x10 == 0:
+		return 16
+	}
+	return 8
+}
+
+// decodeSVE decodes the SVE instruction x.
+func decodeSVE(x uint32) (Inst, error) {
+	f, args := sveLookup(x)
+	if f == nil {
+		return Inst{}, errUnknown
+	}
+	return Inst{Op: f.op, Enc: x, Args: args}, nil
+}
+
+// sveLookup returns the format of the SVE or SME instruction x
+// and its decoded arguments.
+func sveLookup(x uint32) (*sveFormat, Args) {
+	if f, args := sveSearch(sveFormats[:], x); f != nil {
+		return f, args
+	}
+	return sveSearch(smeFormats[:], x)
+}
+
+// sveSearch returns the first format of formats that matches x
+// and the arguments of x decoded according to that format.
+func sveSearch(formats []sveFormat, x uint32) (*sveFormat, Args) {
+Search:
+	for i := range formats {
+		f := &formats[i]
+		if x&f.mask != f.value {
+			continue
+		}
+		var args Args
+		for j, a := range f.args {
+			if a == nil {
+				break
+			}
+			arg := a.decode(x)
+			if arg == nil {
+				continue Search
+			}
+			args[j] = arg
+		}
+		return f, args
+	}
+	return nil, Args{}
+}
+
+// decode decodes the operand from the instruction bits x.
+// It returns nil if x cannot be decoded according to o.
+func (o *sveOperand) decode(x uint32) Arg {
+	var vals [8]uint64
+	for i, e := range o.elems {
+		v, ok := e.decode(x)
+		if !ok {
+			return nil
+		}
+		vals[i] = v
+	}
+	switch o.class {
+	case sveClassReg:
+		return Reg(vals[0])
+
+	case sveClassArng:
+		return RegisterWithArrangement{Reg(vals[0]), Arrangement(vals[1]), 0}
+
+	case sveClassIndex:
+		return RegisterWithArrangementAndIndex{Reg(vals[0]), Arrangement(vals[1]), uint8(vals[2]), 0}
+
+	case sveClassPred:
+		return PredicateWithQualifier{Reg(vals[0]), PredicateQualifier(vals[1])}
+
+	case sveClassScalar:
+		return sveScalar(o.elems[1], Arrangement(vals[0]), vals[1])
+
+	case sveClassImm:
+		return sveImm(o.elems[0], vals[0])
+
+	case sveClassList, sveClassRange:
+		n := len(o.elems) / 2
+		count := n
+		if o.class == sveClassRange {
+			count = int(sveRegNum(Reg(vals[2*n-2]))-sveRegNum(Reg(vals[0]))) + 1
+		}
+		return RegisterList{Reg(vals[0]), Arrangement(vals[1]), uint8(count), o.class == sveClassRange}
+
+	case sveClassSpecial:
+		if o.elems[0].kind == sveElemPrfop {
+			return Imm_sveprfop(vals[0])
+		}
+		return Imm_vl(vals[0])
+
+	case sveClassMemOff, sveClassMemOffVL:
+		m := MemSVE{Base: sveBase(o.elems[0], vals[0]), BaseArrangement: Arrangement(vals[1])}
+		m.Offset = int64(vals[2])
+		m.MulVL = o.class == sveClassMemOffVL
+		return m
+
+	case sveClassMemExt, sveClassMemOptExt:
+		m := MemSVE{Base: sveBase(o.elems[0], vals[0]), BaseArrangement: Arrangement(vals[1])}
+		m.Index = Reg(vals[2])
+		m.IndexArrangement = Arrangement(vals[3])
+		m.HasIndex = o.class == sveClassMemExt || m.Index != XZR
+		if m.HasIndex {
+			m.Extend = ExtShift(vals[4])
+			m.Amount = uint8(vals[5])
+		}
+		return m
+
+	case sveClassPredElem:
+		return PredicateElement{Reg(vals[0]), Arrangement(vals[1]), Reg(vals[2]), uint8(vals[3])}
+
+	case sveClassZATile:
+		return ZATile{uint8(vals[0]), Arrangement(vals[1])}
+
+	case sveClassZASlice, sveClassZASliceList:
+		return ZATileSlice{uint8(vals[0]), Arrangement(vals[1]), vals[2] != 0, Reg(vals[3]), uint8(vals[4]), o.class == sveClassZASliceList}
+
+	case sveClassZAArray:
+		return ZAArrayVector{Reg(vals[0]), uint8(vals[1])}
+
+	case sveClassZAMask:
+		return ZATileList(vals[0])
+	}
+	return nil
+}
+
+// sveRegNum returns the number of the register r in its register file.
+func sveRegNum(r Reg) uint16 {
+	switch {
+	case P0 <= r && r <= P15:
+		return uint16(r - P0)
+	case PN0 <= r && r <= PN15:
+		return uint16(r - PN0)
+	}
+	return uint16(r) & 31
+}
+
+// sveScalar returns the scalar register of width w decoded by e as v.
+func sveScalar(e *sveElem, w Arrangement, v uint64) Arg {
+	r := Reg(v)
+	if e.kind == sveElemRegNum {
+		switch w {
+		case ArrangementS:
+			r = W0 + Reg(v)
+		case ArrangementD:
+			r = X0 + Reg(v)
+		}
+	} else if V0 <= r && r <= V31 {
+		switch w {
+		case ArrangementB:
+			r = B0 + (r - V0)
+		case ArrangementH:
+			r = H0 + (r - V0)
+		case ArrangementS:
+			r = S0 + (r - V0)
+		case ArrangementD:
+			r = D0 + (r - V0)
+		}
+	}
+	if e.sp {
+		return RegSP(r)
+	}
+	return r
+}
+
+// sveBase returns the base register of a memory operand decoded by e as v.
+func sveBase(e *sveElem, v uint64) Reg {
+	if e.kind == sveElemRegNum {
+		return X0 + Reg(v)
+	}
+	return Reg(v)
+}
+
+// sveImm returns the immediate decoded by e as v.
+func sveImm(e *sveElem, v uint64) Arg {
+	switch e.kind {
+	case sveElemSint:
+		return ImmSigned{int64(v), 0}
+	case sveElemImm8Shift:
+		return ImmSigned{int64(v >> 1), uint8(8 * (v & 1))}
+	case sveElemSimm8Shift:
+		return ImmSigned{int64(int8(v >> 1)), uint8(8 * (v & 1))}
+	case sveElemFP:
+		return ImmFloat(math.Float64frombits(v))
+	case sveElemFPImm8:
+		pre := v & 0xf
+		exp := (1-(v>>6)&1)<<2 | (v>>4)&3
+		return Imm_fp{uint8(v >> 7), int8(exp) - 3, uint8(pre)}
+	case sveElemLogicalImm:
+		return Imm64{v, false}
+	}
+	return Imm{uint32(v), true}
+}
+
+// A PredicateQualifier is the qualifier of an SVE governing predicate.
+type PredicateQualifier uint8
+
+const (
+	_                PredicateQualifier = iota
+	PredicateZeroing                    // /Z: inactive elements are set to zero
+	PredicateMerging                    // /M: inactive elements keep their value
+)
+
+func (q PredicateQualifier) String() string {
+	switch q {
+	case PredicateZeroing:
+		return "Z"
+	case PredicateMerging:
+		return "M"
+	}
+	return ""
+}
+
+// A PredicateWithQualifier is a governing predicate register
+// with a predication qualifier: <Pg>/Z or <Pg>/M.
+type PredicateWithQualifier struct {
+	Reg       Reg
+	Qualifier PredicateQualifier
+}
+
+func (PredicateWithQualifier) isArg() {}
+
+func (p PredicateWithQualifier) String() string {
+	return p.Reg.String() + "/" + p.Qualifier.String()
+}
+
+// A RegisterList is a list of consecutive SVE vector or predicate
+// registers with the same arrangement. The list is written as a range,
+// like { Z0.S-Z3.S }, if Range is set, and element by element, like
+// { Z31.S, Z0.S }, otherwise. The register numbers wrap around.
+type RegisterList struct {
+	First       Reg
+	Arrangement Arrangement
+	Count       uint8
+	Range       bool
+}
+
+func (RegisterList) isArg() {}
+
+// reg returns the i'th register of the list.
+func (l RegisterList) reg(i int) Reg {
+	if i == 0 {
+		return l.First
+	}
+	base, size := Z0, 32
+	switch {
+	case P0 <= l.First && l.First <= P15:
+		base, size = P0, 16
+	case PN0 <= l.First && l.First <= PN15:
+		base, size = PN0, 16
+	}
+	return base + Reg((int(l.First-base)+i)%size)
+}
+
+func (l RegisterList) String() string {
+	return "{ " + l.format(func(r Reg) string { return r.String() + l.Arrangement.String() }, ", ") + " }"
+}
+
+// format formats the registers of the list with reg,
+// separated by sep unless the list is written as a range.
+func (l RegisterList) format(reg func(Reg) string, sep string) string {
+	if l.Range {
+		return reg(l.First) + "-" + reg(l.reg(int(l.Count)-1))
+	}
+	var regs []string
+	for i := 0; i < int(l.Count); i++ {
+		regs = append(regs, reg(l.reg(i)))
+	}
+	return strings.Join(regs, sep)
+}
+
+// A MemSVE is a memory reference of an SVE load, store or prefetch
+// instruction, or the vector address of ADR. The base is a general-purpose
+// register or SP (XZR), or a vector register with an arrangement.
+// The effective address is base plus either the index, extended and
+// shifted as given by Extend and Amount, or the offset, multiplied by
+// the vector length if MulVL is set.
+type MemSVE struct {
+	Base             Reg
+	BaseArrangement  Arrangement
+	HasIndex         bool
+	Index            Reg
+	IndexArrangement Arrangement
+	Extend           ExtShift
+	Amount           uint8
+	Offset           int64
+	MulVL            bool
+}
+
+func (MemSVE) isArg() {}
+
+func (m MemSVE) String() string {
+	base := m.Base.String() + m.BaseArrangement.String()
+	if m.Base == SP {
+		base = "SP"
+	}
+	if m.HasIndex {
+		if m.Index == XZR && m.BaseArrangement != 0 {
+			// The optional offset register of a vector base defaults to XZR.
+			return "[" + base + "]"
+		}
+		s := "[" + base + ", " + m.Index.String() + m.IndexArrangement.String()
+		if m.Extend != 0 {
+			s += ", " + m.Extend.String()
+			if m.Amount != 0 {
+				s += fmt.Sprintf(" #%d", m.Amount)
+			}
+		}
+		return s + "]"
+	}
+	if m.Offset == 0 {
+		return "[" + base + "]"
+	}
+	if m.MulVL {
+		return fmt.Sprintf("[%s, #%d, MUL VL]", base, m.Offset)
+	}
+	return fmt.Sprintf("[%s, #%d]", base, m.Offset)
+}
+
+// An ImmSigned is a signed integer constant, shifted left by Shift bits:
+// #<imm>{, LSL #<shift>}.
+type ImmSigned struct {
+	Imm   int64
+	Shift uint8
+}
+
+func (ImmSigned) isArg() {}
+
+func (i ImmSigned) String() string {
+	if i.Shift != 0 {
+		return fmt.Sprintf("#%d, LSL #%d", i.Imm, i.Shift)
+	}
+	return fmt.Sprintf("#%d", i.Imm)
+}
+
+// An ImmFloat is a floating-point constant that is not encoded
+// as an 8-bit floating-point immediate, like the #0.5 or #1.0 of FADD.
+type ImmFloat float64
+
+func (ImmFloat) isArg() {}
+
+func (f ImmFloat) String() string {
+	return "#" + formatFloat(float64(f))
+}
+
+// formatFloat formats f with at least one decimal place.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// An Imm_sveprfop is the prefetch operation of an SVE prefetch instruction.
+type Imm_sveprfop uint8
+
+func (Imm_sveprfop) isArg() {}
+
+func (i Imm_sveprfop) String() string {
+	if i&6 == 6 {
+		return fmt.Sprintf("#%d", uint8(i))
+	}
+	return Imm_prfop(i>>3<<4 | i&7).String()
+}
+
+// An Imm_vl is the vector length multiplier of the predicate-as-counter
+// instructions: VLx2 or VLx4.
+type Imm_vl uint8
+
+func (Imm_vl) isArg() {}
+
+func (i Imm_vl) String() string {
+	return fmt.Sprintf("VLx%d", uint8(i))
+}
+
+// sveGNUSyntax returns the GNU assembler syntax for the SVE instruction
+// inst. Like objdump, it prints the preferred aliases of the ARM
+// specification: mov and fmov for cpy, dup and their floating-point
+// forms, mov for dupm when dup cannot encode the immediate, and mov
+// and not for the predicated logical operations that copy or invert
+// a register.
+func sveGNUSyntax(inst Inst) string {
+	op, args := strings.ToLower(inst.Op.String()), inst.Args[:]
+	d, _ := inst.Args[0].(RegisterWithArrangement)
+	isZ := Z0 <= d.r && d.r <= Z31
+	isP := P0 <= d.r && d.r <= P15
+	switch inst.Op {
+	case CPY, DUP:
+		if !isZ {
+			break
+		}
+		op = "mov"
+		if x, ok := args[1].(RegisterWithArrangementAndIndex); ok && x.index == 0 {
+			// dup <Zd>.<T>, <Zn>.<T>[0] is mov <Zd>.<T>, <V><n>.
+			args = []Arg{d, sveScalarReg(x.r, x.a)}
+		}
+	case FCPY, FDUP:
+		op = "fmov"
+	case DUPM:
+		if imm, ok := args[1].(Imm64); ok && sveMoveMaskPreferred(imm.Imm, d.a) {
+			op = "mov"
+		}
+	case ORR, ORRS, AND, ANDS, EOR, EORS:
+		if isZ {
+			// orr <Zd>.D, <Zn>.D, <Zn>.D is mov <Zd>.D, <Zn>.D.
+			if inst.Op == ORR && args[3] == nil && args[1] == args[2] {
+				op, args = "mov", args[:2]
+			}
+			break
+		}
+		pg, ok := args[1].(PredicateWithQualifier)
+		pn, okn := args[2].(RegisterWithArrangement)
+		pm, okm := args[3].(RegisterWithArrangement)
+		if !isP || !ok || !okn || !okm {
+			break
+		}
+		s := ""
+		if inst.Op == ORRS || inst.Op == ANDS || inst.Op == EORS {
+			s = "s"
+		}
+		switch inst.Op {
+		case ORR, ORRS:
+			if pn.r == pg.Reg && pm.r == pg.Reg {
+				op, args = "mov"+s, []Arg{d, pn}
+			}
+		case AND, ANDS:
+			if pn.r == pm.r {
+				op, args = "mov"+s, args[:3]
+			}
+		case EOR, EORS:
+			if pm.r == pg.Reg {
+				op, args = "not"+s, args[:3]
+			}
+		}
+	case SEL:
+		// sel <Zd>, <Pg>, <Zn>, <Zd> is mov <Zd>, <Pg>/M, <Zn>,
+		// and likewise for predicate registers.
+		pg, ok := args[1].(Reg)
+		if (isZ || isP) && ok && args[3] == args[0] {
+			op, args = "mov", []Arg{d, PredicateWithQualifier{pg, PredicateMerging}, args[2]}
+		}
+	}
+	var s []string
+	for _, a := range args {
+		if a == nil {
+			break
+		}
+		s = append(s, strings.ToLower(a.String()))
+	}
+	return op + " " + strings.Join(s, ", ")
+}
+
+// sveScalarReg returns the SIMD&FP scalar register that holds element 0
+// of the vector register z with arrangement a.
+func sveScalarReg(z Reg, a Arrangement) Reg {
+	n := Reg(sveRegNum(z))
+	switch a {
+	case ArrangementB:
+		return B0 + n
+	case ArrangementH:
+		return H0 + n
+	case ArrangementS:
+		return S0 + n
+	case ArrangementD:
+		return D0 + n
+	}
+	return Q0 + n
+}
+
+// sveMoveMaskPreferred reports whether dupm with the immediate imm,
+// which fills elements of arrangement a, is printed as mov, that is
+// whether dup cannot encode imm. It is SVEMoveMaskPreferred of the
+// ARM specification.
+func sveMoveMaskPreferred(imm uint64, a Arrangement) bool {
+	switch a {
+	case ArrangementB:
+		imm &= 0xff
+		imm |= imm << 8
+		fallthrough
+	case ArrangementH:
+		imm &= 0xffff
+		imm |= imm << 16
+		fallthrough
+	case ArrangementS:
+		imm &= 0xffffffff
+		imm |= imm << 32
+	}
+	// allSame reports whether bits hi to lo of imm are all zeros or all ones.
+	allSame := func(hi, lo uint) bool {
+		v := imm << (63 - hi) >> (63 - hi + lo)
+		return v == 0 || v == 1<<(hi-lo+1)-1
+	}
+	rep32 := imm>>32 == imm&0xffffffff
+	rep16 := rep32 && imm>>16&0xffff == imm&0xffff
+	if imm&0xff != 0 {
+		// Signed 8-bit immediates in any element size.
+		if allSame(63, 7) || rep32 && allSame(31, 7) || rep16 && allSame(15, 7) {
+			return false
+		}
+		if rep16 && imm>>8&0xff == imm&0xff {
+			return false
+		}
+	} else {
+		// Signed 8-bit immediates shifted left by 8.
+		if allSame(63, 15) || rep32 && allSame(31, 15) || rep16 {
+			return false
+		}
+	}
+	return true
+}
+
+// sveGoSyntax returns the Go assembler syntax for the SVE instruction inst.
+func sveGoSyntax(inst Inst) string {
+	f, _ := sveLookup(inst.Enc)
+	if f == nil {
+		return "?"
+	}
+	var args []string
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		args = append(args, plan9SVEArg(&inst, a))
+	}
+	// The Go assembler puts the destination last,
+	// except for stores, whose operands are in ARM order.
+	if !strings.HasPrefix(inst.Op.String(), "ST") {
+		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
+			args[i], args[j] = args[j], args[i]
+		}
+	}
+	if len(args) == 0 {
+		return f.goOp
+	}
+	return f.goOp + " " + strings.Join(args, ", ")
+}
+
+// plan9SVEArg returns the Go assembler syntax for the argument of an SVE instruction.
+func plan9SVEArg(inst *Inst, arg Arg) string {
+	switch a := arg.(type) {
+	case Reg:
+		if B0 <= a && a <= Q31 {
+			return fmt.Sprintf("V%d", a&31)
+		}
+		if a < B0 {
+			return plan9gpr(a)
+		}
+		return a.String()
+
+	case RegSP:
+		if Reg(a) == SP || Reg(a) == WSP {
+			return "RSP"
+		}
+		return plan9gpr(Reg(a))
+
+	case RegisterWithArrangement:
+		if V0 <= a.r && a.r <= V31 {
+			return plan9Arg(inst, 0, nil, a)
+		}
+		return a.r.String() + a.a.String()
+
+	case RegisterWithArrangementAndIndex:
+		return fmt.Sprintf("%s%s[%d]", a.r, a.a, a.index)
+
+	case PredicateWithQualifier:
+		return a.Reg.String() + "." + a.Qualifier.String()
+
+	case RegisterList:
+		return "[" + a.format(func(r Reg) string { return r.String() + a.Arrangement.String() }, ", ") + "]"
+
+	case MemSVE:
+		var base string
+		switch {
+		case a.BaseArrangement != 0:
+			base = "(" + a.Base.String() + a.BaseArrangement.String() + ")"
+		case a.Base == SP:
+			base = "(RSP)"
+		default:
+			base = "(" + plan9gpr(a.Base) + ")"
+		}
+		if a.HasIndex {
+			var index string
+			if a.IndexArrangement != 0 {
+				index = a.Index.String() + a.IndexArrangement.String()
+			} else {
+				index = plan9gpr(a.Index)
+			}
+			switch a.Extend {
+			case uxtw, sxtw:
+				index += "." + a.Extend.String()
+			}
+			if a.Amount != 0 {
+				index += fmt.Sprintf("<<%d", a.Amount)
+			}
+			return "(" + index + ")" + base
+		}
+		switch {
+		case a.Offset == 0:
+			return base
+		case a.MulVL && a.Offset < 0:
+			return fmt.Sprintf("(-VL*%d)%s", -a.Offset, base)
+		case a.MulVL:
+			return fmt.Sprintf("(VL*%d)%s", a.Offset, base)
+		}
+		return fmt.Sprintf("%d%s", a.Offset, base)
+
+	case Imm:
+		return fmt.Sprintf("$%d", a.Imm)
+
+	case Imm64:
+		return fmt.Sprintf("$%d", int64(a.Imm))
+
+	case ImmSigned:
+		return fmt.Sprintf("$%d", a.Imm<<a.Shift)
+
+	case ImmFloat:
+		return "$(" + formatFloat(float64(a)) + ")"
+
+	case Imm_fp:
+		f, _ := strconv.ParseFloat(strings.TrimPrefix(a.String(), "#"), 64)
+		return "$(" + formatFloat(f) + ")"
+
+	case Imm_sveprfop:
+		if a&6 == 6 {
+			return fmt.Sprintf("$%d", uint8(a))
+		}
+
+	case Imm_vl:
+		return a.String()
+
+	case PredicateElement:
+		return fmt.Sprintf("%s%s[%s, %d]", a.Reg, a.Arrangement, plan9gpr(a.Index), a.Offset)
+
+	case ZATileSlice:
+		if a.List {
+			return "[" + a.format(plan9gpr(a.Index)) + "]"
+		}
+		return a.format(plan9gpr(a.Index))
+
+	case ZAArrayVector:
+		return fmt.Sprintf("ZA[%s, %d]", plan9gpr(a.Index), a.Offset)
+
+	case ZATileList:
+		return "[" + strings.Join(a.tiles(), ", ") + "]"
+	}
+	return strings.ToUpper(arg.String())
+}
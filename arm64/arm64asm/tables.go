@@ -11,43 +11,149 @@ const (
 	_ Op = iota
 	ABS
 	ADC
+	ADCLB
+	ADCLT
 	ADCS
 	ADD
+	ADDG
+	ADDHA
 	ADDHN
 	ADDHN2
+	ADDHNB
+	ADDHNT
 	ADDP
+	ADDPL
+	ADDPT
+	ADDQP
+	ADDQV
 	ADDS
+	ADDSPL
+	ADDSUBP
+	ADDSVL
 	ADDV
+	ADDVA
+	ADDVL
 	ADR
 	ADRP
 	AESD
+	AESDIMC
 	AESE
+	AESEMC
 	AESIMC
 	AESMC
 	AND
+	ANDQV
 	ANDS
+	ANDV
 	ASR
+	ASRD
+	ASRR
 	ASRV
 	AT
+	AUTDA
+	AUTDB
+	AUTDZA
+	AUTDZB
+	AUTIA
+	AUTIA1716
+	AUTIASP
+	AUTIAZ
+	AUTIB
+	AUTIB1716
+	AUTIBSP
+	AUTIBZ
+	AUTIZA
+	AUTIZB
 	B
+	BCAX
+	BDEP
+	BEXT
+	BF1CVT
+	BF1CVTLT
+	BF2CVT
+	BF2CVTLT
+	BFADD
+	BFCLAMP
+	BFCVT
+	BFCVTN
+	BFCVTNT
+	BFDOT
 	BFI
 	BFM
+	BFMAX
+	BFMAXNM
+	BFMIN
+	BFMINNM
+	BFMLA
+	BFMLALB
+	BFMLALT
+	BFMLS
+	BFMLSLB
+	BFMLSLT
+	BFMMLA
+	BFMOPA
+	BFMOPS
+	BFMUL
+	BFSCALE
+	BFSUB
 	BFXIL
+	BGRP
 	BIC
 	BICS
 	BIF
 	BIT
 	BL
 	BLR
+	BLRAA
+	BLRAAZ
+	BLRAB
+	BLRABZ
 	BR
+	BRAA
+	BRAAZ
+	BRAB
+	BRABZ
 	BRK
+	BRKA
+	BRKAS
+	BRKB
+	BRKBS
+	BRKN
+	BRKNS
+	BRKPA
+	BRKPAS
+	BRKPB
+	BRKPBS
 	BSL
+	BSL1N
+	BSL2N
+	BTI
+	CADD
+	CAS
+	CASA
+	CASAB
+	CASAH
+	CASAL
+	CASALB
+	CASALH
+	CASB
+	CASH
+	CASL
+	CASLB
+	CASLH
+	CASP
+	CASPA
+	CASPAL
+	CASPL
 	CBNZ
 	CBZ
 	CCMN
 	CCMP
+	CDOT
 	CINC
 	CINV
+	CLASTA
+	CLASTB
 	CLREX
 	CLS
 	CLZ
@@ -56,13 +162,125 @@ const (
 	CMGT
 	CMHI
 	CMHS
+	CMLA
 	CMLE
 	CMLT
 	CMN
 	CMP
+	CMPEQ
+	CMPGE
+	CMPGT
+	CMPHI
+	CMPHS
+	CMPLE
+	CMPLO
+	CMPLS
+	CMPLT
+	CMPNE
+	CMPP
 	CMTST
 	CNEG
+	CNOT
 	CNT
+	CNTP
+	COMPACT
+	CPY
+	CPYE
+	CPYEN
+	CPYERN
+	CPYERT
+	CPYERTN
+	CPYERTRN
+	CPYERTWN
+	CPYET
+	CPYETN
+	CPYETRN
+	CPYETWN
+	CPYEWN
+	CPYEWT
+	CPYEWTN
+	CPYEWTRN
+	CPYEWTWN
+	CPYFE
+	CPYFEN
+	CPYFERN
+	CPYFERT
+	CPYFERTN
+	CPYFERTRN
+	CPYFERTWN
+	CPYFET
+	CPYFETN
+	CPYFETRN
+	CPYFETWN
+	CPYFEWN
+	CPYFEWT
+	CPYFEWTN
+	CPYFEWTRN
+	CPYFEWTWN
+	CPYFM
+	CPYFMN
+	CPYFMRN
+	CPYFMRT
+	CPYFMRTN
+	CPYFMRTRN
+	CPYFMRTWN
+	CPYFMT
+	CPYFMTN
+	CPYFMTRN
+	CPYFMTWN
+	CPYFMWN
+	CPYFMWT
+	CPYFMWTN
+	CPYFMWTRN
+	CPYFMWTWN
+	CPYFP
+	CPYFPN
+	CPYFPRN
+	CPYFPRT
+	CPYFPRTN
+	CPYFPRTRN
+	CPYFPRTWN
+	CPYFPT
+	CPYFPTN
+	CPYFPTRN
+	CPYFPTWN
+	CPYFPWN
+	CPYFPWT
+	CPYFPWTN
+	CPYFPWTRN
+	CPYFPWTWN
+	CPYM
+	CPYMN
+	CPYMRN
+	CPYMRT
+	CPYMRTN
+	CPYMRTRN
+	CPYMRTWN
+	CPYMT
+	CPYMTN
+	CPYMTRN
+	CPYMTWN
+	CPYMWN
+	CPYMWT
+	CPYMWTN
+	CPYMWTRN
+	CPYMWTWN
+	CPYP
+	CPYPN
+	CPYPRN
+	CPYPRT
+	CPYPRTN
+	CPYPRTRN
+	CPYPRTWN
+	CPYPT
+	CPYPTN
+	CPYPTRN
+	CPYPTWN
+	CPYPWN
+	CPYPWT
+	CPYPWTN
+	CPYPWTRN
+	CPYPWTWN
 	CRC32B
 	CRC32CB
 	CRC32CH
@@ -77,79 +295,147 @@ const (
 	CSINC
 	CSINV
 	CSNEG
+	CTERMEQ
+	CTERMNE
 	DC
 	DCPS1
 	DCPS2
 	DCPS3
+	DECP
 	DMB
 	DRPS
 	DSB
 	DUP
+	DUPM
+	DUPQ
 	EON
 	EOR
+	EOR3
+	EORBT
+	EORQV
+	EORS
+	EORTB
+	EORV
 	ERET
+	ERETAA
+	ERETAB
+	EXPAND
 	EXT
+	EXTQ
 	EXTR
+	F1CVT
+	F1CVTLT
+	F2CVT
+	F2CVTLT
 	FABD
 	FABS
 	FACGE
 	FACGT
 	FADD
+	FADDA
 	FADDP
+	FADDQV
+	FADDV
+	FAMAX
+	FAMIN
+	FCADD
 	FCCMP
 	FCCMPE
+	FCLAMP
 	FCMEQ
 	FCMGE
 	FCMGT
+	FCMLA
 	FCMLE
 	FCMLT
+	FCMNE
 	FCMP
 	FCMPE
+	FCMUO
+	FCPY
 	FCSEL
 	FCVT
 	FCVTAS
 	FCVTAU
 	FCVTL
 	FCVTL2
+	FCVTLT
 	FCVTMS
 	FCVTMU
 	FCVTN
 	FCVTN2
+	FCVTNB
 	FCVTNS
+	FCVTNT
 	FCVTNU
 	FCVTPS
 	FCVTPU
+	FCVTX
 	FCVTXN
 	FCVTXN2
+	FCVTXNT
 	FCVTZS
+	FCVTZSN
 	FCVTZU
+	FCVTZUN
 	FDIV
+	FDIVR
+	FDOT
+	FDUP
+	FEXPA
+	FIRSTP
+	FLOGB
+	FMAD
 	FMADD
 	FMAX
 	FMAXNM
 	FMAXNMP
+	FMAXNMQV
 	FMAXNMV
 	FMAXP
+	FMAXQV
 	FMAXV
 	FMIN
 	FMINNM
 	FMINNMP
+	FMINNMQV
 	FMINNMV
 	FMINP
+	FMINQV
 	FMINV
 	FMLA
+	FMLALB
+	FMLALLBB
+	FMLALLBT
+	FMLALLTB
+	FMLALLTT
+	FMLALT
 	FMLS
+	FMLSLB
+	FMLSLT
+	FMMLA
+	FMOPA
+	FMOPS
 	FMOV
+	FMSB
 	FMSUB
 	FMUL
 	FMULX
 	FNEG
+	FNMAD
 	FNMADD
+	FNMLA
+	FNMLS
+	FNMSB
 	FNMSUB
 	FNMUL
 	FRECPE
 	FRECPS
 	FRECPX
+	FRINT32X
+	FRINT32Z
+	FRINT64X
+	FRINT64Z
 	FRINTA
 	FRINTI
 	FRINTM
@@ -159,22 +445,87 @@ const (
 	FRINTZ
 	FRSQRTE
 	FRSQRTS
+	FSCALE
 	FSQRT
 	FSUB
+	FSUBR
+	FTMAD
+	FTSMUL
+	FTSSEL
+	GMI
 	HINT
+	HISTCNT
+	HISTSEG
 	HLT
 	HVC
 	IC
+	INCP
+	INDEX
 	INS
+	INSR
+	IRG
 	ISB
+	LASTA
+	LASTB
+	LASTP
 	LD1
+	LD1B
+	LD1D
+	LD1H
+	LD1Q
 	LD1R
+	LD1RB
+	LD1RD
+	LD1RH
+	LD1ROB
+	LD1ROD
+	LD1ROH
+	LD1ROW
+	LD1RQB
+	LD1RQD
+	LD1RQH
+	LD1RQW
+	LD1RSB
+	LD1RSH
+	LD1RSW
+	LD1RW
+	LD1SB
+	LD1SH
+	LD1SW
+	LD1W
 	LD2
+	LD2B
+	LD2D
+	LD2H
+	LD2Q
 	LD2R
+	LD2W
 	LD3
+	LD3B
+	LD3D
+	LD3H
+	LD3Q
 	LD3R
+	LD3W
 	LD4
+	LD4B
+	LD4D
+	LD4H
+	LD4Q
 	LD4R
+	LD4W
+	LDADD
+	LDADDA
+	LDADDAB
+	LDADDAH
+	LDADDAL
+	LDADDALB
+	LDADDALH
+	LDADDB
+	LDADDH
+	LDADDL
+	LDADDLB
+	LDADDLH
 	LDAR
 	LDARB
 	LDARH
@@ -182,21 +533,130 @@ const (
 	LDAXR
 	LDAXRB
 	LDAXRH
+	LDCLR
+	LDCLRA
+	LDCLRAB
+	LDCLRAH
+	LDCLRAL
+	LDCLRALB
+	LDCLRALH
+	LDCLRB
+	LDCLRH
+	LDCLRL
+	LDCLRLB
+	LDCLRLH
+	LDEOR
+	LDEORA
+	LDEORAB
+	LDEORAH
+	LDEORAL
+	LDEORALB
+	LDEORALH
+	LDEORB
+	LDEORH
+	LDEORL
+	LDEORLB
+	LDEORLH
+	LDFF1B
+	LDFF1D
+	LDFF1H
+	LDFF1SB
+	LDFF1SH
+	LDFF1SW
+	LDFF1W
+	LDG
+	LDGM
+	LDNF1B
+	LDNF1D
+	LDNF1H
+	LDNF1SB
+	LDNF1SH
+	LDNF1SW
+	LDNF1W
 	LDNP
+	LDNT1B
+	LDNT1D
+	LDNT1H
+	LDNT1SB
+	LDNT1SH
+	LDNT1SW
+	LDNT1W
 	LDP
 	LDPSW
 	LDR
+	LDRAA
+	LDRAB
 	LDRB
 	LDRH
 	LDRSB
 	LDRSH
 	LDRSW
+	LDSET
+	LDSETA
+	LDSETAB
+	LDSETAH
+	LDSETAL
+	LDSETALB
+	LDSETALH
+	LDSETB
+	LDSETH
+	LDSETL
+	LDSETLB
+	LDSETLH
+	LDSMAX
+	LDSMAXA
+	LDSMAXAB
+	LDSMAXAH
+	LDSMAXAL
+	LDSMAXALB
+	LDSMAXALH
+	LDSMAXB
+	LDSMAXH
+	LDSMAXL
+	LDSMAXLB
+	LDSMAXLH
+	LDSMIN
+	LDSMINA
+	LDSMINAB
+	LDSMINAH
+	LDSMINAL
+	LDSMINALB
+	LDSMINALH
+	LDSMINB
+	LDSMINH
+	LDSMINL
+	LDSMINLB
+	LDSMINLH
 	LDTR
 	LDTRB
 	LDTRH
 	LDTRSB
 	LDTRSH
 	LDTRSW
+	LDUMAX
+	LDUMAXA
+	LDUMAXAB
+	LDUMAXAH
+	LDUMAXAL
+	LDUMAXALB
+	LDUMAXALH
+	LDUMAXB
+	LDUMAXH
+	LDUMAXL
+	LDUMAXLB
+	LDUMAXLH
+	LDUMIN
+	LDUMINA
+	LDUMINAB
+	LDUMINAH
+	LDUMINAL
+	LDUMINALB
+	LDUMINALH
+	LDUMINB
+	LDUMINH
+	LDUMINL
+	LDUMINLB
+	LDUMINLH
 	LDUR
 	LDURB
 	LDURH
@@ -208,71 +668,183 @@ const (
 	LDXRB
 	LDXRH
 	LSL
+	LSLR
 	LSLV
 	LSR
+	LSRR
 	LSRV
+	LUTI2
+	LUTI4
+	LUTI6
+	MAD
 	MADD
+	MADPT
+	MATCH
 	MLA
+	MLAPT
 	MLS
 	MNEG
 	MOV
 	MOVI
 	MOVK
 	MOVN
+	MOVPRFX
 	MOVZ
 	MRS
+	MSB
 	MSR
 	MSUB
 	MUL
 	MVN
 	MVNI
+	NAND
+	NANDS
+	NBSL
 	NEG
 	NEGS
 	NGC
 	NGCS
+	NMATCH
 	NOP
+	NOR
+	NORS
 	NOT
 	ORN
+	ORNS
+	ORQV
 	ORR
+	ORRS
+	ORV
+	PACDA
+	PACDB
+	PACDZA
+	PACDZB
+	PACGA
+	PACIA
+	PACIA1716
+	PACIASP
+	PACIAZ
+	PACIB
+	PACIB1716
+	PACIBSP
+	PACIBZ
+	PACIZA
+	PACIZB
+	PEXT
+	PFALSE
+	PFIRST
+	PMLAL
+	PMOV
 	PMUL
 	PMULL
 	PMULL2
+	PMULLB
+	PMULLT
+	PNEXT
+	PRFB
+	PRFD
+	PRFH
 	PRFM
 	PRFUM
+	PRFW
+	PSEL
+	PTEST
+	PTRUE
+	PUNPKHI
+	PUNPKLO
 	RADDHN
 	RADDHN2
+	RADDHNB
+	RADDHNT
+	RAX1
 	RBIT
+	RDFFR
+	RDFFRS
+	RDSVL
+	RDVL
 	RET
+	RETAA
+	RETAB
 	REV
 	REV16
 	REV32
 	REV64
+	REVB
+	REVD
+	REVH
+	REVW
 	ROR
 	RORV
 	RSHRN
 	RSHRN2
+	RSHRNB
+	RSHRNT
 	RSUBHN
 	RSUBHN2
+	RSUBHNB
+	RSUBHNT
 	SABA
 	SABAL
 	SABAL2
+	SABALB
+	SABALT
 	SABD
 	SABDL
 	SABDL2
+	SABDLB
+	SABDLT
 	SADALP
 	SADDL
 	SADDL2
+	SADDLB
+	SADDLBT
 	SADDLP
+	SADDLT
 	SADDLV
+	SADDV
 	SADDW
 	SADDW2
+	SADDWB
+	SADDWT
 	SBC
+	SBCLB
+	SBCLT
 	SBCS
 	SBFIZ
 	SBFM
 	SBFX
+	SCLAMP
 	SCVTF
+	SCVTFLT
 	SDIV
+	SDIVR
+	SDOT
+	SEL
+	SETE
+	SETEN
+	SETET
+	SETETN
+	SETFFR
+	SETGE
+	SETGEN
+	SETGET
+	SETGETN
+	SETGM
+	SETGMN
+	SETGMT
+	SETGMTN
+	SETGP
+	SETGPN
+	SETGPT
+	SETGPTN
+	SETM
+	SETMN
+	SETMT
+	SETMTN
+	SETP
+	SETPN
+	SETPT
+	SETPTN
 	SEV
 	SEVL
 	SHA1C
@@ -291,71 +863,172 @@ const (
 	SHLL2
 	SHRN
 	SHRN2
+	SHRNB
+	SHRNT
 	SHSUB
+	SHSUBR
 	SLI
+	SM4E
+	SM4EKEY
 	SMADDL
 	SMAX
 	SMAXP
+	SMAXQV
 	SMAXV
 	SMC
 	SMIN
 	SMINP
+	SMINQV
 	SMINV
 	SMLAL
 	SMLAL2
+	SMLALB
+	SMLALT
 	SMLSL
 	SMLSL2
+	SMLSLB
+	SMLSLT
+	SMMLA
 	SMNEGL
+	SMOPA
+	SMOPS
 	SMOV
+	SMSTART
+	SMSTOP
 	SMSUBL
 	SMULH
 	SMULL
 	SMULL2
+	SMULLB
+	SMULLT
+	SPLICE
 	SQABS
 	SQADD
+	SQCADD
+	SQCVTN
+	SQCVTUN
+	SQDECP
 	SQDMLAL
 	SQDMLAL2
+	SQDMLALB
+	SQDMLALBT
+	SQDMLALT
 	SQDMLSL
 	SQDMLSL2
+	SQDMLSLB
+	SQDMLSLBT
+	SQDMLSLT
 	SQDMULH
 	SQDMULL
 	SQDMULL2
+	SQDMULLB
+	SQDMULLT
+	SQINCP
 	SQNEG
+	SQRDCMLAH
+	SQRDMLAH
+	SQRDMLSH
 	SQRDMULH
 	SQRSHL
+	SQRSHLR
 	SQRSHRN
 	SQRSHRN2
+	SQRSHRNB
+	SQRSHRNT
 	SQRSHRUN
 	SQRSHRUN2
+	SQRSHRUNB
+	SQRSHRUNT
 	SQSHL
+	SQSHLR
 	SQSHLU
 	SQSHRN
 	SQSHRN2
+	SQSHRNB
+	SQSHRNT
 	SQSHRUN
 	SQSHRUN2
+	SQSHRUNB
+	SQSHRUNT
 	SQSUB
+	SQSUBR
 	SQXTN
 	SQXTN2
+	SQXTNB
+	SQXTNT
 	SQXTUN
 	SQXTUN2
+	SQXTUNB
+	SQXTUNT
 	SRHADD
 	SRI
 	SRSHL
+	SRSHLR
 	SRSHR
 	SRSRA
 	SSHL
 	SSHLL
 	SSHLL2
+	SSHLLB
+	SSHLLT
 	SSHR
 	SSRA
 	SSUBL
 	SSUBL2
+	SSUBLB
+	SSUBLBT
+	SSUBLT
+	SSUBLTB
 	SSUBW
 	SSUBW2
+	SSUBWB
+	SSUBWT
 	ST1
+	ST1B
+	ST1D
+	ST1H
+	ST1Q
+	ST1W
 	ST2
+	ST2B
+	ST2D
+	ST2G
+	ST2H
+	ST2Q
+	ST2W
 	ST3
+	ST3B
+	ST3D
+	ST3H
+	ST3Q
+	ST3W
 	ST4
+	ST4B
+	ST4D
+	ST4H
+	ST4Q
+	ST4W
+	STADD
+	STADDB
+	STADDH
+	STADDL
+	STADDLB
+	STADDLH
+	STCLR
+	STCLRB
+	STCLRH
+	STCLRL
+	STCLRLB
+	STCLRLH
+	STEOR
+	STEORB
+	STEORH
+	STEORL
+	STEORLB
+	STEORLH
+	STG
+	STGM
+	STGP
 	STLR
 	STLRB
 	STLRH
@@ -364,13 +1037,47 @@ const (
 	STLXRB
 	STLXRH
 	STNP
+	STNT1B
+	STNT1D
+	STNT1H
+	STNT1W
 	STP
 	STR
 	STRB
 	STRH
+	STSET
+	STSETB
+	STSETH
+	STSETL
+	STSETLB
+	STSETLH
+	STSMAX
+	STSMAXB
+	STSMAXH
+	STSMAXL
+	STSMAXLB
+	STSMAXLH
+	STSMIN
+	STSMINB
+	STSMINH
+	STSMINL
+	STSMINLB
+	STSMINLH
 	STTR
 	STTRB
 	STTRH
+	STUMAX
+	STUMAXB
+	STUMAXH
+	STUMAXL
+	STUMAXLB
+	STUMAXLH
+	STUMIN
+	STUMINB
+	STUMINH
+	STUMINL
+	STUMINLB
+	STUMINLH
 	STUR
 	STURB
 	STURH
@@ -378,12 +1085,39 @@ const (
 	STXR
 	STXRB
 	STXRH
+	STZ2G
+	STZG
+	STZGM
 	SUB
+	SUBG
 	SUBHN
 	SUBHN2
+	SUBHNB
+	SUBHNT
+	SUBP
+	SUBPS
+	SUBPT
+	SUBR
 	SUBS
+	SUDOT
+	SUMOPA
+	SUMOPS
+	SUNPKHI
+	SUNPKLO
 	SUQADD
 	SVC
+	SWP
+	SWPA
+	SWPAB
+	SWPAH
+	SWPAL
+	SWPALB
+	SWPALH
+	SWPB
+	SWPH
+	SWPL
+	SWPLB
+	SWPLH
 	SXTB
 	SXTH
 	SXTL
@@ -392,8 +1126,10 @@ const (
 	SYS
 	SYSL
 	TBL
+	TBLQ
 	TBNZ
 	TBX
+	TBXQ
 	TBZ
 	TLBI
 	TRN1
@@ -402,121 +1138,298 @@ const (
 	UABA
 	UABAL
 	UABAL2
+	UABALB
+	UABALT
 	UABD
 	UABDL
 	UABDL2
+	UABDLB
+	UABDLT
 	UADALP
 	UADDL
 	UADDL2
+	UADDLB
 	UADDLP
+	UADDLT
 	UADDLV
+	UADDV
 	UADDW
 	UADDW2
+	UADDWB
+	UADDWT
 	UBFIZ
 	UBFM
 	UBFX
+	UCLAMP
 	UCVTF
+	UCVTFLT
 	UDIV
+	UDIVR
+	UDOT
 	UHADD
 	UHSUB
+	UHSUBR
 	UMADDL
 	UMAX
 	UMAXP
+	UMAXQV
 	UMAXV
 	UMIN
 	UMINP
+	UMINQV
 	UMINV
 	UMLAL
 	UMLAL2
+	UMLALB
+	UMLALT
 	UMLSL
 	UMLSL2
+	UMLSLB
+	UMLSLT
+	UMMLA
 	UMNEGL
+	UMOPA
+	UMOPS
 	UMOV
 	UMSUBL
 	UMULH
 	UMULL
 	UMULL2
+	UMULLB
+	UMULLT
 	UQADD
+	UQCVTN
+	UQDECP
+	UQINCP
 	UQRSHL
+	UQRSHLR
 	UQRSHRN
 	UQRSHRN2
+	UQRSHRNB
+	UQRSHRNT
 	UQSHL
+	UQSHLR
 	UQSHRN
 	UQSHRN2
+	UQSHRNB
+	UQSHRNT
 	UQSUB
+	UQSUBR
 	UQXTN
 	UQXTN2
+	UQXTNB
+	UQXTNT
 	URECPE
 	URHADD
 	URSHL
+	URSHLR
 	URSHR
 	URSQRTE
 	URSRA
+	USDOT
 	USHL
 	USHLL
 	USHLL2
+	USHLLB
+	USHLLT
 	USHR
+	USMMLA
+	USMOPA
+	USMOPS
 	USQADD
 	USRA
 	USUBL
 	USUBL2
+	USUBLB
+	USUBLT
 	USUBW
 	USUBW2
+	USUBWB
+	USUBWT
+	UUNPKHI
+	UUNPKLO
 	UXTB
 	UXTH
 	UXTL
 	UXTL2
+	UXTW
 	UZP1
 	UZP2
+	UZPQ1
+	UZPQ2
 	WFE
 	WFI
+	WHILEGE
+	WHILEGT
+	WHILEHI
+	WHILEHS
+	WHILELE
+	WHILELO
+	WHILELS
+	WHILELT
+	WHILERW
+	WHILEWR
+	WRFFR
+	XAR
+	XPACD
+	XPACI
+	XPACLRI
 	XTN
 	XTN2
 	YIELD
+	ZERO
 	ZIP1
 	ZIP2
+	ZIPQ1
+	ZIPQ2
 )
 
 var opstr = [...]string{
 	ABS:       "ABS",
 	ADC:       "ADC",
+	ADCLB:     "ADCLB",
+	ADCLT:     "ADCLT",
 	ADCS:      "ADCS",
 	ADD:       "ADD",
+	ADDG:      "ADDG",
+	ADDHA:     "ADDHA",
 	ADDHN:     "ADDHN",
 	ADDHN2:    "ADDHN2",
+	ADDHNB:    "ADDHNB",
+	ADDHNT:    "ADDHNT",
 	ADDP:      "ADDP",
+	ADDPL:     "ADDPL",
+	ADDPT:     "ADDPT",
+	ADDQP:     "ADDQP",
+	ADDQV:     "ADDQV",
 	ADDS:      "ADDS",
+	ADDSPL:    "ADDSPL",
+	ADDSUBP:   "ADDSUBP",
+	ADDSVL:    "ADDSVL",
 	ADDV:      "ADDV",
+	ADDVA:     "ADDVA",
+	ADDVL:     "ADDVL",
 	ADR:       "ADR",
 	ADRP:      "ADRP",
 	AESD:      "AESD",
+	AESDIMC:   "AESDIMC",
 	AESE:      "AESE",
+	AESEMC:    "AESEMC",
 	AESIMC:    "AESIMC",
 	AESMC:     "AESMC",
 	AND:       "AND",
+	ANDQV:     "ANDQV",
 	ANDS:      "ANDS",
+	ANDV:      "ANDV",
 	ASR:       "ASR",
+	ASRD:      "ASRD",
+	ASRR:      "ASRR",
 	ASRV:      "ASRV",
 	AT:        "AT",
+	AUTDA:     "AUTDA",
+	AUTDB:     "AUTDB",
+	AUTDZA:    "AUTDZA",
+	AUTDZB:    "AUTDZB",
+	AUTIA:     "AUTIA",
+	AUTIA1716: "AUTIA1716",
+	AUTIASP:   "AUTIASP",
+	AUTIAZ:    "AUTIAZ",
+	AUTIB:     "AUTIB",
+	AUTIB1716: "AUTIB1716",
+	AUTIBSP:   "AUTIBSP",
+	AUTIBZ:    "AUTIBZ",
+	AUTIZA:    "AUTIZA",
+	AUTIZB:    "AUTIZB",
 	B:         "B",
+	BCAX:      "BCAX",
+	BDEP:      "BDEP",
+	BEXT:      "BEXT",
+	BF1CVT:    "BF1CVT",
+	BF1CVTLT:  "BF1CVTLT",
+	BF2CVT:    "BF2CVT",
+	BF2CVTLT:  "BF2CVTLT",
+	BFADD:     "BFADD",
+	BFCLAMP:   "BFCLAMP",
+	BFCVT:     "BFCVT",
+	BFCVTN:    "BFCVTN",
+	BFCVTNT:   "BFCVTNT",
+	BFDOT:     "BFDOT",
 	BFI:       "BFI",
 	BFM:       "BFM",
+	BFMAX:     "BFMAX",
+	BFMAXNM:   "BFMAXNM",
+	BFMIN:     "BFMIN",
+	BFMINNM:   "BFMINNM",
+	BFMLA:     "BFMLA",
+	BFMLALB:   "BFMLALB",
+	BFMLALT:   "BFMLALT",
+	BFMLS:     "BFMLS",
+	BFMLSLB:   "BFMLSLB",
+	BFMLSLT:   "BFMLSLT",
+	BFMMLA:    "BFMMLA",
+	BFMOPA:    "BFMOPA",
+	BFMOPS:    "BFMOPS",
+	BFMUL:     "BFMUL",
+	BFSCALE:   "BFSCALE",
+	BFSUB:     "BFSUB",
 	BFXIL:     "BFXIL",
+	BGRP:      "BGRP",
 	BIC:       "BIC",
 	BICS:      "BICS",
 	BIF:       "BIF",
 	BIT:       "BIT",
 	BL:        "BL",
 	BLR:       "BLR",
+	BLRAA:     "BLRAA",
+	BLRAAZ:    "BLRAAZ",
+	BLRAB:     "BLRAB",
+	BLRABZ:    "BLRABZ",
 	BR:        "BR",
+	BRAA:      "BRAA",
+	BRAAZ:     "BRAAZ",
+	BRAB:      "BRAB",
+	BRABZ:     "BRABZ",
 	BRK:       "BRK",
+	BRKA:      "BRKA",
+	BRKAS:     "BRKAS",
+	BRKB:      "BRKB",
+	BRKBS:     "BRKBS",
+	BRKN:      "BRKN",
+	BRKNS:     "BRKNS",
+	BRKPA:     "BRKPA",
+	BRKPAS:    "BRKPAS",
+	BRKPB:     "BRKPB",
+	BRKPBS:    "BRKPBS",
 	BSL:       "BSL",
+	BSL1N:     "BSL1N",
+	BSL2N:     "BSL2N",
+	BTI:       "BTI",
+	CADD:      "CADD",
+	CAS:       "CAS",
+	CASA:      "CASA",
+	CASAB:     "CASAB",
+	CASAH:     "CASAH",
+	CASAL:     "CASAL",
+	CASALB:    "CASALB",
+	CASALH:    "CASALH",
+	CASB:      "CASB",
+	CASH:      "CASH",
+	CASL:      "CASL",
+	CASLB:     "CASLB",
+	CASLH:     "CASLH",
+	CASP:      "CASP",
+	CASPA:     "CASPA",
+	CASPAL:    "CASPAL",
+	CASPL:     "CASPL",
 	CBNZ:      "CBNZ",
 	CBZ:       "CBZ",
 	CCMN:      "CCMN",
 	CCMP:      "CCMP",
+	CDOT:      "CDOT",
 	CINC:      "CINC",
 	CINV:      "CINV",
+	CLASTA:    "CLASTA",
+	CLASTB:    "CLASTB",
 	CLREX:     "CLREX",
 	CLS:       "CLS",
 	CLZ:       "CLZ",
@@ -525,13 +1438,125 @@ var opstr = [...]string{
 	CMGT:      "CMGT",
 	CMHI:      "CMHI",
 	CMHS:      "CMHS",
+	CMLA:      "CMLA",
 	CMLE:      "CMLE",
 	CMLT:      "CMLT",
 	CMN:       "CMN",
 	CMP:       "CMP",
+	CMPEQ:     "CMPEQ",
+	CMPGE:     "CMPGE",
+	CMPGT:     "CMPGT",
+	CMPHI:     "CMPHI",
+	CMPHS:     "CMPHS",
+	CMPLE:     "CMPLE",
+	CMPLO:     "CMPLO",
+	CMPLS:     "CMPLS",
+	CMPLT:     "CMPLT",
+	CMPNE:     "CMPNE",
+	CMPP:      "CMPP",
 	CMTST:     "CMTST",
 	CNEG:      "CNEG",
+	CNOT:      "CNOT",
 	CNT:       "CNT",
+	CNTP:      "CNTP",
+	COMPACT:   "COMPACT",
+	CPY:       "CPY",
+	CPYE:      "CPYE",
+	CPYEN:     "CPYEN",
+	CPYERN:    "CPYERN",
+	CPYERT:    "CPYERT",
+	CPYERTN:   "CPYERTN",
+	CPYERTRN:  "CPYERTRN",
+	CPYERTWN:  "CPYERTWN",
+	CPYET:     "CPYET",
+	CPYETN:    "CPYETN",
+	CPYETRN:   "CPYETRN",
+	CPYETWN:   "CPYETWN",
+	CPYEWN:    "CPYEWN",
+	CPYEWT:    "CPYEWT",
+	CPYEWTN:   "CPYEWTN",
+	CPYEWTRN:  "CPYEWTRN",
+	CPYEWTWN:  "CPYEWTWN",
+	CPYFE:     "CPYFE",
+	CPYFEN:    "CPYFEN",
+	CPYFERN:   "CPYFERN",
+	CPYFERT:   "CPYFERT",
+	CPYFERTN:  "CPYFERTN",
+	CPYFERTRN: "CPYFERTRN",
+	CPYFERTWN: "CPYFERTWN",
+	CPYFET:    "CPYFET",
+	CPYFETN:   "CPYFETN",
+	CPYFETRN:  "CPYFETRN",
+	CPYFETWN:  "CPYFETWN",
+	CPYFEWN:   "CPYFEWN",
+	CPYFEWT:   "CPYFEWT",
+	CPYFEWTN:  "CPYFEWTN",
+	CPYFEWTRN: "CPYFEWTRN",
+	CPYFEWTWN: "CPYFEWTWN",
+	CPYFM:     "CPYFM",
+	CPYFMN:    "CPYFMN",
+	CPYFMRN:   "CPYFMRN",
+	CPYFMRT:   "CPYFMRT",
+	CPYFMRTN:  "CPYFMRTN",
+	CPYFMRTRN: "CPYFMRTRN",
+	CPYFMRTWN: "CPYFMRTWN",
+	CPYFMT:    "CPYFMT",
+	CPYFMTN:   "CPYFMTN",
+	CPYFMTRN:  "CPYFMTRN",
+	CPYFMTWN:  "CPYFMTWN",
+	CPYFMWN:   "CPYFMWN",
+	CPYFMWT:   "CPYFMWT",
+	CPYFMWTN:  "CPYFMWTN",
+	CPYFMWTRN: "CPYFMWTRN",
+	CPYFMWTWN: "CPYFMWTWN",
+	CPYFP:     "CPYFP",
+	CPYFPN:    "CPYFPN",
+	CPYFPRN:   "CPYFPRN",
+	CPYFPRT:   "CPYFPRT",
+	CPYFPRTN:  "CPYFPRTN",
+	CPYFPRTRN: "CPYFPRTRN",
+	CPYFPRTWN: "CPYFPRTWN",
+	CPYFPT:    "CPYFPT",
+	CPYFPTN:   "CPYFPTN",
+	CPYFPTRN:  "CPYFPTRN",
+	CPYFPTWN:  "CPYFPTWN",
+	CPYFPWN:   "CPYFPWN",
+	CPYFPWT:   "CPYFPWT",
+	CPYFPWTN:  "CPYFPWTN",
+	CPYFPWTRN: "CPYFPWTRN",
+	CPYFPWTWN: "CPYFPWTWN",
+	CPYM:      "CPYM",
+	CPYMN:     "CPYMN",
+	CPYMRN:    "CPYMRN",
+	CPYMRT:    "CPYMRT",
+	CPYMRTN:   "CPYMRTN",
+	CPYMRTRN:  "CPYMRTRN",
+	CPYMRTWN:  "CPYMRTWN",
+	CPYMT:     "CPYMT",
+	CPYMTN:    "CPYMTN",
+	CPYMTRN:   "CPYMTRN",
+	CPYMTWN:   "CPYMTWN",
+	CPYMWN:    "CPYMWN",
+	CPYMWT:    "CPYMWT",
+	CPYMWTN:   "CPYMWTN",
+	CPYMWTRN:  "CPYMWTRN",
+	CPYMWTWN:  "CPYMWTWN",
+	CPYP:      "CPYP",
+	CPYPN:     "CPYPN",
+	CPYPRN:    "CPYPRN",
+	CPYPRT:    "CPYPRT",
+	CPYPRTN:   "CPYPRTN",
+	CPYPRTRN:  "CPYPRTRN",
+	CPYPRTWN:  "CPYPRTWN",
+	CPYPT:     "CPYPT",
+	CPYPTN:    "CPYPTN",
+	CPYPTRN:   "CPYPTRN",
+	CPYPTWN:   "CPYPTWN",
+	CPYPWN:    "CPYPWN",
+	CPYPWT:    "CPYPWT",
+	CPYPWTN:   "CPYPWTN",
+	CPYPWTRN:  "CPYPWTRN",
+	CPYPWTWN:  "CPYPWTWN",
 	CRC32B:    "CRC32B",
 	CRC32CB:   "CRC32CB",
 	CRC32CH:   "CRC32CH",
@@ -546,79 +1571,147 @@ var opstr = [...]string{
 	CSINC:     "CSINC",
 	CSINV:     "CSINV",
 	CSNEG:     "CSNEG",
+	CTERMEQ:   "CTERMEQ",
+	CTERMNE:   "CTERMNE",
 	DC:        "DC",
 	DCPS1:     "DCPS1",
 	DCPS2:     "DCPS2",
 	DCPS3:     "DCPS3",
+	DECP:      "DECP",
 	DMB:       "DMB",
 	DRPS:      "DRPS",
 	DSB:       "DSB",
 	DUP:       "DUP",
+	DUPM:      "DUPM",
+	DUPQ:      "DUPQ",
 	EON:       "EON",
 	EOR:       "EOR",
+	EOR3:      "EOR3",
+	EORBT:     "EORBT",
+	EORQV:     "EORQV",
+	EORS:      "EORS",
+	EORTB:     "EORTB",
+	EORV:      "EORV",
 	ERET:      "ERET",
+	ERETAA:    "ERETAA",
+	ERETAB:    "ERETAB",
+	EXPAND:    "EXPAND",
 	EXT:       "EXT",
+	EXTQ:      "EXTQ",
 	EXTR:      "EXTR",
+	F1CVT:     "F1CVT",
+	F1CVTLT:   "F1CVTLT",
+	F2CVT:     "F2CVT",
+	F2CVTLT:   "F2CVTLT",
 	FABD:      "FABD",
 	FABS:      "FABS",
 	FACGE:     "FACGE",
 	FACGT:     "FACGT",
 	FADD:      "FADD",
+	FADDA:     "FADDA",
 	FADDP:     "FADDP",
+	FADDQV:    "FADDQV",
+	FADDV:     "FADDV",
+	FAMAX:     "FAMAX",
+	FAMIN:     "FAMIN",
+	FCADD:     "FCADD",
 	FCCMP:     "FCCMP",
 	FCCMPE:    "FCCMPE",
+	FCLAMP:    "FCLAMP",
 	FCMEQ:     "FCMEQ",
 	FCMGE:     "FCMGE",
 	FCMGT:     "FCMGT",
+	FCMLA:     "FCMLA",
 	FCMLE:     "FCMLE",
 	FCMLT:     "FCMLT",
+	FCMNE:     "FCMNE",
 	FCMP:      "FCMP",
 	FCMPE:     "FCMPE",
+	FCMUO:     "FCMUO",
+	FCPY:      "FCPY",
 	FCSEL:     "FCSEL",
 	FCVT:      "FCVT",
 	FCVTAS:    "FCVTAS",
 	FCVTAU:    "FCVTAU",
 	FCVTL:     "FCVTL",
 	FCVTL2:    "FCVTL2",
+	FCVTLT:    "FCVTLT",
 	FCVTMS:    "FCVTMS",
 	FCVTMU:    "FCVTMU",
 	FCVTN:     "FCVTN",
 	FCVTN2:    "FCVTN2",
+	FCVTNB:    "FCVTNB",
 	FCVTNS:    "FCVTNS",
+	FCVTNT:    "FCVTNT",
 	FCVTNU:    "FCVTNU",
 	FCVTPS:    "FCVTPS",
 	FCVTPU:    "FCVTPU",
+	FCVTX:     "FCVTX",
 	FCVTXN:    "FCVTXN",
 	FCVTXN2:   "FCVTXN2",
+	FCVTXNT:   "FCVTXNT",
 	FCVTZS:    "FCVTZS",
+	FCVTZSN:   "FCVTZSN",
 	FCVTZU:    "FCVTZU",
+	FCVTZUN:   "FCVTZUN",
 	FDIV:      "FDIV",
+	FDIVR:     "FDIVR",
+	FDOT:      "FDOT",
+	FDUP:      "FDUP",
+	FEXPA:     "FEXPA",
+	FIRSTP:    "FIRSTP",
+	FLOGB:     "FLOGB",
+	FMAD:      "FMAD",
 	FMADD:     "FMADD",
 	FMAX:      "FMAX",
 	FMAXNM:    "FMAXNM",
 	FMAXNMP:   "FMAXNMP",
+	FMAXNMQV:  "FMAXNMQV",
 	FMAXNMV:   "FMAXNMV",
 	FMAXP:     "FMAXP",
+	FMAXQV:    "FMAXQV",
 	FMAXV:     "FMAXV",
 	FMIN:      "FMIN",
 	FMINNM:    "FMINNM",
 	FMINNMP:   "FMINNMP",
+	FMINNMQV:  "FMINNMQV",
 	FMINNMV:   "FMINNMV",
 	FMINP:     "FMINP",
+	FMINQV:    "FMINQV",
 	FMINV:     "FMINV",
 	FMLA:      "FMLA",
+	FMLALB:    "FMLALB",
+	FMLALLBB:  "FMLALLBB",
+	FMLALLBT:  "FMLALLBT",
+	FMLALLTB:  "FMLALLTB",
+	FMLALLTT:  "FMLALLTT",
+	FMLALT:    "FMLALT",
 	FMLS:      "FMLS",
+	FMLSLB:    "FMLSLB",
+	FMLSLT:    "FMLSLT",
+	FMMLA:     "FMMLA",
+	FMOPA:     "FMOPA",
+	FMOPS:     "FMOPS",
 	FMOV:      "FMOV",
+	FMSB:      "FMSB",
 	FMSUB:     "FMSUB",
 	FMUL:      "FMUL",
 	FMULX:     "FMULX",
 	FNEG:      "FNEG",
+	FNMAD:     "FNMAD",
 	FNMADD:    "FNMADD",
+	FNMLA:     "FNMLA",
+	FNMLS:     "FNMLS",
+	FNMSB:     "FNMSB",
 	FNMSUB:    "FNMSUB",
 	FNMUL:     "FNMUL",
 	FRECPE:    "FRECPE",
 	FRECPS:    "FRECPS",
 	FRECPX:    "FRECPX",
+	FRINT32X:  "FRINT32X",
+	FRINT32Z:  "FRINT32Z",
+	FRINT64X:  "FRINT64X",
+	FRINT64Z:  "FRINT64Z",
 	FRINTA:    "FRINTA",
 	FRINTI:    "FRINTI",
 	FRINTM:    "FRINTM",
@@ -628,22 +1721,87 @@ var opstr = [...]string{
 	FRINTZ:    "FRINTZ",
 	FRSQRTE:   "FRSQRTE",
 	FRSQRTS:   "FRSQRTS",
+	FSCALE:    "FSCALE",
 	FSQRT:     "FSQRT",
 	FSUB:      "FSUB",
+	FSUBR:     "FSUBR",
+	FTMAD:     "FTMAD",
+	FTSMUL:    "FTSMUL",
+	FTSSEL:    "FTSSEL",
+	GMI:       "GMI",
 	HINT:      "HINT",
+	HISTCNT:   "HISTCNT",
+	HISTSEG:   "HISTSEG",
 	HLT:       "HLT",
 	HVC:       "HVC",
 	IC:        "IC",
+	INCP:      "INCP",
+	INDEX:     "INDEX",
 	INS:       "INS",
+	INSR:      "INSR",
+	IRG:       "IRG",
 	ISB:       "ISB",
+	LASTA:     "LASTA",
+	LASTB:     "LASTB",
+	LASTP:     "LASTP",
 	LD1:       "LD1",
+	LD1B:      "LD1B",
+	LD1D:      "LD1D",
+	LD1H:      "LD1H",
+	LD1Q:      "LD1Q",
 	LD1R:      "LD1R",
+	LD1RB:     "LD1RB",
+	LD1RD:     "LD1RD",
+	LD1RH:     "LD1RH",
+	LD1ROB:    "LD1ROB",
+	LD1ROD:    "LD1ROD",
+	LD1ROH:    "LD1ROH",
+	LD1ROW:    "LD1ROW",
+	LD1RQB:    "LD1RQB",
+	LD1RQD:    "LD1RQD",
+	LD1RQH:    "LD1RQH",
+	LD1RQW:    "LD1RQW",
+	LD1RSB:    "LD1RSB",
+	LD1RSH:    "LD1RSH",
+	LD1RSW:    "LD1RSW",
+	LD1RW:     "LD1RW",
+	LD1SB:     "LD1SB",
+	LD1SH:     "LD1SH",
+	LD1SW:     "LD1SW",
+	LD1W:      "LD1W",
 	LD2:       "LD2",
+	LD2B:      "LD2B",
+	LD2D:      "LD2D",
+	LD2H:      "LD2H",
+	LD2Q:      "LD2Q",
 	LD2R:      "LD2R",
+	LD2W:      "LD2W",
 	LD3:       "LD3",
+	LD3B:      "LD3B",
+	LD3D:      "LD3D",
+	LD3H:      "LD3H",
+	LD3Q:      "LD3Q",
 	LD3R:      "LD3R",
+	LD3W:      "LD3W",
 	LD4:       "LD4",
+	LD4B:      "LD4B",
+	LD4D:      "LD4D",
+	LD4H:      "LD4H",
+	LD4Q:      "LD4Q",
 	LD4R:      "LD4R",
+	LD4W:      "LD4W",
+	LDADD:     "LDADD",
+	LDADDA:    "LDADDA",
+	LDADDAB:   "LDADDAB",
+	LDADDAH:   "LDADDAH",
+	LDADDAL:   "LDADDAL",
+	LDADDALB:  "LDADDALB",
+	LDADDALH:  "LDADDALH",
+	LDADDB:    "LDADDB",
+	LDADDH:    "LDADDH",
+	LDADDL:    "LDADDL",
+	LDADDLB:   "LDADDLB",
+	LDADDLH:   "LDADDLH",
 	LDAR:      "LDAR",
 	LDARB:     "LDARB",
 	LDARH:     "LDARH",
@@ -651,21 +1809,130 @@ var opstr = [...]string{
 	LDAXR:     "LDAXR",
 	LDAXRB:    "LDAXRB",
 	LDAXRH:    "LDAXRH",
+	LDCLR:     "LDCLR",
+	LDCLRA:    "LDCLRA",
+	LDCLRAB:   "LDCLRAB",
+	LDCLRAH:   "LDCLRAH",
+	LDCLRAL:   "LDCLRAL",
+	LDCLRALB:  "LDCLRALB",
+	LDCLRALH:  "LDCLRALH",
+	LDCLRB:    "LDCLRB",
+	LDCLRH:    "LDCLRH",
+	LDCLRL:    "LDCLRL",
+	LDCLRLB:   "LDCLRLB",
+	LDCLRLH:   "LDCLRLH",
+	LDEOR:     "LDEOR",
+	LDEORA:    "LDEORA",
+	LDEORAB:   "LDEORAB",
+	LDEORAH:   "LDEORAH",
+	LDEORAL:   "LDEORAL",
+	LDEORALB:  "LDEORALB",
+	LDEORALH:  "LDEORALH",
+	LDEORB:    "LDEORB",
+	LDEORH:    "LDEORH",
+	LDEORL:    "LDEORL",
+	LDEORLB:   "LDEORLB",
+	LDEORLH:   "LDEORLH",
+	LDFF1B:    "LDFF1B",
+	LDFF1D:    "LDFF1D",
+	LDFF1H:    "LDFF1H",
+	LDFF1SB:   "LDFF1SB",
+	LDFF1SH:   "LDFF1SH",
+	LDFF1SW:   "LDFF1SW",
+	LDFF1W:    "LDFF1W",
+	LDG:       "LDG",
+	LDGM:      "LDGM",
+	LDNF1B:    "LDNF1B",
+	LDNF1D:    "LDNF1D",
+	LDNF1H:    "LDNF1H",
+	LDNF1SB:   "LDNF1SB",
+	LDNF1SH:   "LDNF1SH",
+	LDNF1SW:   "LDNF1SW",
+	LDNF1W:    "LDNF1W",
 	LDNP:      "LDNP",
+	LDNT1B:    "LDNT1B",
+	LDNT1D:    "LDNT1D",
+	LDNT1H:    "LDNT1H",
+	LDNT1SB:   "LDNT1SB",
+	LDNT1SH:   "LDNT1SH",
+	LDNT1SW:   "LDNT1SW",
+	LDNT1W:    "LDNT1W",
 	LDP:       "LDP",
 	LDPSW:     "LDPSW",
 	LDR:       "LDR",
+	LDRAA:     "LDRAA",
+	LDRAB:     "LDRAB",
 	LDRB:      "LDRB",
 	LDRH:      "LDRH",
 	LDRSB:     "LDRSB",
 	LDRSH:     "LDRSH",
 	LDRSW:     "LDRSW",
+	LDSET:     "LDSET",
+	LDSETA:    "LDSETA",
+	LDSETAB:   "LDSETAB",
+	LDSETAH:   "LDSETAH",
+	LDSETAL:   "LDSETAL",
+	LDSETALB:  "LDSETALB",
+	LDSETALH:  "LDSETALH",
+	LDSETB:    "LDSETB",
+	LDSETH:    "LDSETH",
+	LDSETL:    "LDSETL",
+	LDSETLB:   "LDSETLB",
+	LDSETLH:   "LDSETLH",
+	LDSMAX:    "LDSMAX",
+	LDSMAXA:   "LDSMAXA",
+	LDSMAXAB:  "LDSMAXAB",
+	LDSMAXAH:  "LDSMAXAH",
+	LDSMAXAL:  "LDSMAXAL",
+	LDSMAXALB: "LDSMAXALB",
+	LDSMAXALH: "LDSMAXALH",
+	LDSMAXB:   "LDSMAXB",
+	LDSMAXH:   "LDSMAXH",
+	LDSMAXL:   "LDSMAXL",
+	LDSMAXLB:  "LDSMAXLB",
+	LDSMAXLH:  "LDSMAXLH",
+	LDSMIN:    "LDSMIN",
+	LDSMINA:   "LDSMINA",
+	LDSMINAB:  "LDSMINAB",
+	LDSMINAH:  "LDSMINAH",
+	LDSMINAL:  "LDSMINAL",
+	LDSMINALB: "LDSMINALB",
+	LDSMINALH: "LDSMINALH",
+	LDSMINB:   "LDSMINB",
+	LDSMINH:   "LDSMINH",
+	LDSMINL:   "LDSMINL",
+	LDSMINLB:  "LDSMINLB",
+	LDSMINLH:  "LDSMINLH",
 	LDTR:      "LDTR",
 	LDTRB:     "LDTRB",
 	LDTRH:     "LDTRH",
 	LDTRSB:    "LDTRSB",
 	LDTRSH:    "LDTRSH",
 	LDTRSW:    "LDTRSW",
+	LDUMAX:    "LDUMAX",
+	LDUMAXA:   "LDUMAXA",
+	LDUMAXAB:  "LDUMAXAB",
+	LDUMAXAH:  "LDUMAXAH",
+	LDUMAXAL:  "LDUMAXAL",
+	LDUMAXALB: "LDUMAXALB",
+	LDUMAXALH: "LDUMAXALH",
+	LDUMAXB:   "LDUMAXB",
+	LDUMAXH:   "LDUMAXH",
+	LDUMAXL:   "LDUMAXL",
+	LDUMAXLB:  "LDUMAXLB",
+	LDUMAXLH:  "LDUMAXLH",
+	LDUMIN:    "LDUMIN",
+	LDUMINA:   "LDUMINA",
+	LDUMINAB:  "LDUMINAB",
+	LDUMINAH:  "LDUMINAH",
+	LDUMINAL:  "LDUMINAL",
+	LDUMINALB: "LDUMINALB",
+	LDUMINALH: "LDUMINALH",
+	LDUMINB:   "LDUMINB",
+	LDUMINH:   "LDUMINH",
+	LDUMINL:   "LDUMINL",
+	LDUMINLB:  "LDUMINLB",
+	LDUMINLH:  "LDUMINLH",
 	LDUR:      "LDUR",
 	LDURB:     "LDURB",
 	LDURH:     "LDURH",
@@ -677,71 +1944,183 @@ var opstr = [...]string{
 	LDXRB:     "LDXRB",
 	LDXRH:     "LDXRH",
 	LSL:       "LSL",
+	LSLR:      "LSLR",
 	LSLV:      "LSLV",
 	LSR:       "LSR",
+	LSRR:      "LSRR",
 	LSRV:      "LSRV",
+	LUTI2:     "LUTI2",
+	LUTI4:     "LUTI4",
+	LUTI6:     "LUTI6",
+	MAD:       "MAD",
 	MADD:      "MADD",
+	MADPT:     "MADPT",
+	MATCH:     "MATCH",
 	MLA:       "MLA",
+	MLAPT:     "MLAPT",
 	MLS:       "MLS",
 	MNEG:      "MNEG",
 	MOV:       "MOV",
 	MOVI:      "MOVI",
 	MOVK:      "MOVK",
 	MOVN:      "MOVN",
+	MOVPRFX:   "MOVPRFX",
 	MOVZ:      "MOVZ",
 	MRS:       "MRS",
+	MSB:       "MSB",
 	MSR:       "MSR",
 	MSUB:      "MSUB",
 	MUL:       "MUL",
 	MVN:       "MVN",
 	MVNI:      "MVNI",
+	NAND:      "NAND",
+	NANDS:     "NANDS",
+	NBSL:      "NBSL",
 	NEG:       "NEG",
 	NEGS:      "NEGS",
 	NGC:       "NGC",
 	NGCS:      "NGCS",
+	NMATCH:    "NMATCH",
 	NOP:       "NOP",
+	NOR:       "NOR",
+	NORS:      "NORS",
 	NOT:       "NOT",
 	ORN:       "ORN",
+	ORNS:      "ORNS",
+	ORQV:      "ORQV",
 	ORR:       "ORR",
+	ORRS:      "ORRS",
+	ORV:       "ORV",
+	PACDA:     "PACDA",
+	PACDB:     "PACDB",
+	PACDZA:    "PACDZA",
+	PACDZB:    "PACDZB",
+	PACGA:     "PACGA",
+	PACIA:     "PACIA",
+	PACIA1716: "PACIA1716",
+	PACIASP:   "PACIASP",
+	PACIAZ:    "PACIAZ",
+	PACIB:     "PACIB",
+	PACIB1716: "PACIB1716",
+	PACIBSP:   "PACIBSP",
+	PACIBZ:    "PACIBZ",
+	PACIZA:    "PACIZA",
+	PACIZB:    "PACIZB",
+	PEXT:      "PEXT",
+	PFALSE:    "PFALSE",
+	PFIRST:    "PFIRST",
+	PMLAL:     "PMLAL",
+	PMOV:      "PMOV",
 	PMUL:      "PMUL",
 	PMULL:     "PMULL",
 	PMULL2:    "PMULL2",
+	PMULLB:    "PMULLB",
+	PMULLT:    "PMULLT",
+	PNEXT:     "PNEXT",
+	PRFB:      "PRFB",
+	PRFD:      "PRFD",
+	PRFH:      "PRFH",
 	PRFM:      "PRFM",
 	PRFUM:     "PRFUM",
+	PRFW:      "PRFW",
+	PSEL:      "PSEL",
+	PTEST:     "PTEST",
+	PTRUE:     "PTRUE",
+	PUNPKHI:   "PUNPKHI",
+	PUNPKLO:   "PUNPKLO",
 	RADDHN:    "RADDHN",
 	RADDHN2:   "RADDHN2",
+	RADDHNB:   "RADDHNB",
+	RADDHNT:   "RADDHNT",
+	RAX1:      "RAX1",
 	RBIT:      "RBIT",
+	RDFFR:     "RDFFR",
+	RDFFRS:    "RDFFRS",
+	RDSVL:     "RDSVL",
+	RDVL:      "RDVL",
 	RET:       "RET",
+	RETAA:     "RETAA",
+	RETAB:     "RETAB",
 	REV:       "REV",
 	REV16:     "REV16",
 	REV32:     "REV32",
 	REV64:     "REV64",
+	REVB:      "REVB",
+	REVD:      "REVD",
+	REVH:      "REVH",
+	REVW:      "REVW",
 	ROR:       "ROR",
 	RORV:      "RORV",
 	RSHRN:     "RSHRN",
 	RSHRN2:    "RSHRN2",
+	RSHRNB:    "RSHRNB",
+	RSHRNT:    "RSHRNT",
 	RSUBHN:    "RSUBHN",
 	RSUBHN2:   "RSUBHN2",
+	RSUBHNB:   "RSUBHNB",
+	RSUBHNT:   "RSUBHNT",
 	SABA:      "SABA",
 	SABAL:     "SABAL",
 	SABAL2:    "SABAL2",
+	SABALB:    "SABALB",
+	SABALT:    "SABALT",
 	SABD:      "SABD",
 	SABDL:     "SABDL",
 	SABDL2:    "SABDL2",
+	SABDLB:    "SABDLB",
+	SABDLT:    "SABDLT",
 	SADALP:    "SADALP",
 	SADDL:     "SADDL",
 	SADDL2:    "SADDL2",
+	SADDLB:    "SADDLB",
+	SADDLBT:   "SADDLBT",
 	SADDLP:    "SADDLP",
+	SADDLT:    "SADDLT",
 	SADDLV:    "SADDLV",
+	SADDV:     "SADDV",
 	SADDW:     "SADDW",
 	SADDW2:    "SADDW2",
+	SADDWB:    "SADDWB",
+	SADDWT:    "SADDWT",
 	SBC:       "SBC",
+	SBCLB:     "SBCLB",
+	SBCLT:     "SBCLT",
 	SBCS:      "SBCS",
 	SBFIZ:     "SBFIZ",
 	SBFM:      "SBFM",
 	SBFX:      "SBFX",
+	SCLAMP:    "SCLAMP",
 	SCVTF:     "SCVTF",
+	SCVTFLT:   "SCVTFLT",
 	SDIV:      "SDIV",
+	SDIVR:     "SDIVR",
+	SDOT:      "SDOT",
+	SEL:       "SEL",
+	SETE:      "SETE",
+	SETEN:     "SETEN",
+	SETET:     "SETET",
+	SETETN:    "SETETN",
+	SETFFR:    "SETFFR",
+	SETGE:     "SETGE",
+	SETGEN:    "SETGEN",
+	SETGET:    "SETGET",
+	SETGETN:   "SETGETN",
+	SETGM:     "SETGM",
+	SETGMN:    "SETGMN",
+	SETGMT:    "SETGMT",
+	SETGMTN:   "SETGMTN",
+	SETGP:     "SETGP",
+	SETGPN:    "SETGPN",
+	SETGPT:    "SETGPT",
+	SETGPTN:   "SETGPTN",
+	SETM:      "SETM",
+	SETMN:     "SETMN",
+	SETMT:     "SETMT",
+	SETMTN:    "SETMTN",
+	SETP:      "SETP",
+	SETPN:     "SETPN",
+	SETPT:     "SETPT",
+	SETPTN:    "SETPTN",
 	SEV:       "SEV",
 	SEVL:      "SEVL",
 	SHA1C:     "SHA1C",
@@ -760,71 +2139,172 @@ var opstr = [...]string{
 	SHLL2:     "SHLL2",
 	SHRN:      "SHRN",
 	SHRN2:     "SHRN2",
+	SHRNB:     "SHRNB",
+	SHRNT:     "SHRNT",
 	SHSUB:     "SHSUB",
+	SHSUBR:    "SHSUBR",
 	SLI:       "SLI",
+	SM4E:      "SM4E",
+	SM4EKEY:   "SM4EKEY",
 	SMADDL:    "SMADDL",
 	SMAX:      "SMAX",
 	SMAXP:     "SMAXP",
+	SMAXQV:    "SMAXQV",
 	SMAXV:     "SMAXV",
 	SMC:       "SMC",
 	SMIN:      "SMIN",
 	SMINP:     "SMINP",
+	SMINQV:    "SMINQV",
 	SMINV:     "SMINV",
 	SMLAL:     "SMLAL",
 	SMLAL2:    "SMLAL2",
+	SMLALB:    "SMLALB",
+	SMLALT:    "SMLALT",
 	SMLSL:     "SMLSL",
 	SMLSL2:    "SMLSL2",
+	SMLSLB:    "SMLSLB",
+	SMLSLT:    "SMLSLT",
+	SMMLA:     "SMMLA",
 	SMNEGL:    "SMNEGL",
+	SMOPA:     "SMOPA",
+	SMOPS:     "SMOPS",
 	SMOV:      "SMOV",
+	SMSTART:   "SMSTART",
+	SMSTOP:    "SMSTOP",
 	SMSUBL:    "SMSUBL",
 	SMULH:     "SMULH",
 	SMULL:     "SMULL",
 	SMULL2:    "SMULL2",
+	SMULLB:    "SMULLB",
+	SMULLT:    "SMULLT",
+	SPLICE:    "SPLICE",
 	SQABS:     "SQABS",
 	SQADD:     "SQADD",
+	SQCADD:    "SQCADD",
+	SQCVTN:    "SQCVTN",
+	SQCVTUN:   "SQCVTUN",
+	SQDECP:    "SQDECP",
 	SQDMLAL:   "SQDMLAL",
 	SQDMLAL2:  "SQDMLAL2",
+	SQDMLALB:  "SQDMLALB",
+	SQDMLALBT: "SQDMLALBT",
+	SQDMLALT:  "SQDMLALT",
 	SQDMLSL:   "SQDMLSL",
 	SQDMLSL2:  "SQDMLSL2",
+	SQDMLSLB:  "SQDMLSLB",
+	SQDMLSLBT: "SQDMLSLBT",
+	SQDMLSLT:  "SQDMLSLT",
 	SQDMULH:   "SQDMULH",
 	SQDMULL:   "SQDMULL",
 	SQDMULL2:  "SQDMULL2",
+	SQDMULLB:  "SQDMULLB",
+	SQDMULLT:  "SQDMULLT",
+	SQINCP:    "SQINCP",
 	SQNEG:     "SQNEG",
+	SQRDCMLAH: "SQRDCMLAH",
+	SQRDMLAH:  "SQRDMLAH",
+	SQRDMLSH:  "SQRDMLSH",
 	SQRDMULH:  "SQRDMULH",
 	SQRSHL:    "SQRSHL",
+	SQRSHLR:   "SQRSHLR",
 	SQRSHRN:   "SQRSHRN",
 	SQRSHRN2:  "SQRSHRN2",
+	SQRSHRNB:  "SQRSHRNB",
+	SQRSHRNT:  "SQRSHRNT",
 	SQRSHRUN:  "SQRSHRUN",
 	SQRSHRUN2: "SQRSHRUN2",
+	SQRSHRUNB: "SQRSHRUNB",
+	SQRSHRUNT: "SQRSHRUNT",
 	SQSHL:     "SQSHL",
+	SQSHLR:    "SQSHLR",
 	SQSHLU:    "SQSHLU",
 	SQSHRN:    "SQSHRN",
 	SQSHRN2:   "SQSHRN2",
+	SQSHRNB:   "SQSHRNB",
+	SQSHRNT:   "SQSHRNT",
 	SQSHRUN:   "SQSHRUN",
 	SQSHRUN2:  "SQSHRUN2",
+	SQSHRUNB:  "SQSHRUNB",
+	SQSHRUNT:  "SQSHRUNT",
 	SQSUB:     "SQSUB",
+	SQSUBR:    "SQSUBR",
 	SQXTN:     "SQXTN",
 	SQXTN2:    "SQXTN2",
+	SQXTNB:    "SQXTNB",
+	SQXTNT:    "SQXTNT",
 	SQXTUN:    "SQXTUN",
 	SQXTUN2:   "SQXTUN2",
+	SQXTUNB:   "SQXTUNB",
+	SQXTUNT:   "SQXTUNT",
 	SRHADD:    "SRHADD",
 	SRI:       "SRI",
 	SRSHL:     "SRSHL",
+	SRSHLR:    "SRSHLR",
 	SRSHR:     "SRSHR",
 	SRSRA:     "SRSRA",
 	SSHL:      "SSHL",
 	SSHLL:     "SSHLL",
 	SSHLL2:    "SSHLL2",
+	SSHLLB:    "SSHLLB",
+	SSHLLT:    "SSHLLT",
 	SSHR:      "SSHR",
 	SSRA:      "SSRA",
 	SSUBL:     "SSUBL",
 	SSUBL2:    "SSUBL2",
+	SSUBLB:    "SSUBLB",
+	SSUBLBT:   "SSUBLBT",
+	SSUBLT:    "SSUBLT",
+	SSUBLTB:   "SSUBLTB",
 	SSUBW:     "SSUBW",
 	SSUBW2:    "SSUBW2",
+	SSUBWB:    "SSUBWB",
+	SSUBWT:    "SSUBWT",
 	ST1:       "ST1",
+	ST1B:      "ST1B",
+	ST1D:      "ST1D",
+	ST1H:      "ST1H",
+	ST1Q:      "ST1Q",
+	ST1W:      "ST1W",
 	ST2:       "ST2",
+	ST2B:      "ST2B",
+	ST2D:      "ST2D",
+	ST2G:      "ST2G",
+	ST2H:      "ST2H",
+	ST2Q:      "ST2Q",
+	ST2W:      "ST2W",
 	ST3:       "ST3",
+	ST3B:      "ST3B",
+	ST3D:      "ST3D",
+	ST3H:      "ST3H",
+	ST3Q:      "ST3Q",
+	ST3W:      "ST3W",
 	ST4:       "ST4",
+	ST4B:      "ST4B",
+	ST4D:      "ST4D",
+	ST4H:      "ST4H",
+	ST4Q:      "ST4Q",
+	ST4W:      "ST4W",
+	STADD:     "STADD",
+	STADDB:    "STADDB",
+	STADDH:    "STADDH",
+	STADDL:    "STADDL",
+	STADDLB:   "STADDLB",
+	STADDLH:   "STADDLH",
+	STCLR:     "STCLR",
+	STCLRB:    "STCLRB",
+	STCLRH:    "STCLRH",
+	STCLRL:    "STCLRL",
+	STCLRLB:   "STCLRLB",
+	STCLRLH:   "STCLRLH",
+	STEOR:     "STEOR",
+	STEORB:    "STEORB",
+	STEORH:    "STEORH",
+	STEORL:    "STEORL",
+	STEORLB:   "STEORLB",
+	STEORLH:   "STEORLH",
+	STG:       "STG",
+	STGM:      "STGM",
+	STGP:      "STGP",
 	STLR:      "STLR",
 	STLRB:     "STLRB",
 	STLRH:     "STLRH",
@@ -833,13 +2313,47 @@ var opstr = [...]string{
 	STLXRB:    "STLXRB",
 	STLXRH:    "STLXRH",
 	STNP:      "STNP",
+	STNT1B:    "STNT1B",
+	STNT1D:    "STNT1D",
+	STNT1H:    "STNT1H",
+	STNT1W:    "STNT1W",
 	STP:       "STP",
 	STR:       "STR",
 	STRB:      "STRB",
 	STRH:      "STRH",
+	STSET:     "STSET",
+	STSETB:    "STSETB",
+	STSETH:    "STSETH",
+	STSETL:    "STSETL",
+	STSETLB:   "STSETLB",
+	STSETLH:   "STSETLH",
+	STSMAX:    "STSMAX",
+	STSMAXB:   "STSMAXB",
+	STSMAXH:   "STSMAXH",
+	STSMAXL:   "STSMAXL",
+	STSMAXLB:  "STSMAXLB",
+	STSMAXLH:  "STSMAXLH",
+	STSMIN:    "STSMIN",
+	STSMINB:   "STSMINB",
+	STSMINH:   "STSMINH",
+	STSMINL:   "STSMINL",
+	STSMINLB:  "STSMINLB",
+	STSMINLH:  "STSMINLH",
 	STTR:      "STTR",
 	STTRB:     "STTRB",
 	STTRH:     "STTRH",
+	STUMAX:    "STUMAX",
+	STUMAXB:   "STUMAXB",
+	STUMAXH:   "STUMAXH",
+	STUMAXL:   "STUMAXL",
+	STUMAXLB:  "STUMAXLB",
+	STUMAXLH:  "STUMAXLH",
+	STUMIN:    "STUMIN",
+	STUMINB:   "STUMINB",
+	STUMINH:   "STUMINH",
+	STUMINL:   "STUMINL",
+	STUMINLB:  "STUMINLB",
+	STUMINLH:  "STUMINLH",
 	STUR:      "STUR",
 	STURB:     "STURB",
 	STURH:     "STURH",
@@ -847,12 +2361,39 @@ var opstr = [...]string{
 	STXR:      "STXR",
 	STXRB:     "STXRB",
 	STXRH:     "STXRH",
+	STZ2G:     "STZ2G",
+	STZG:      "STZG",
+	STZGM:     "STZGM",
 	SUB:       "SUB",
+	SUBG:      "SUBG",
 	SUBHN:     "SUBHN",
 	SUBHN2:    "SUBHN2",
+	SUBHNB:    "SUBHNB",
+	SUBHNT:    "SUBHNT",
+	SUBP:      "SUBP",
+	SUBPS:     "SUBPS",
+	SUBPT:     "SUBPT",
+	SUBR:      "SUBR",
 	SUBS:      "SUBS",
+	SUDOT:     "SUDOT",
+	SUMOPA:    "SUMOPA",
+	SUMOPS:    "SUMOPS",
+	SUNPKHI:   "SUNPKHI",
+	SUNPKLO:   "SUNPKLO",
 	SUQADD:    "SUQADD",
 	SVC:       "SVC",
+	SWP:       "SWP",
+	SWPA:      "SWPA",
+	SWPAB:     "SWPAB",
+	SWPAH:     "SWPAH",
+	SWPAL:     "SWPAL",
+	SWPALB:    "SWPALB",
+	SWPALH:    "SWPALH",
+	SWPB:      "SWPB",
+	SWPH:      "SWPH",
+	SWPL:      "SWPL",
+	SWPLB:     "SWPLB",
+	SWPLH:     "SWPLH",
 	SXTB:      "SXTB",
 	SXTH:      "SXTH",
 	SXTL:      "SXTL",
@@ -861,8 +2402,10 @@ var opstr = [...]string{
 	SYS:       "SYS",
 	SYSL:      "SYSL",
 	TBL:       "TBL",
+	TBLQ:      "TBLQ",
 	TBNZ:      "TBNZ",
 	TBX:       "TBX",
+	TBXQ:      "TBXQ",
 	TBZ:       "TBZ",
 	TLBI:      "TLBI",
 	TRN1:      "TRN1",
@@ -871,79 +2414,150 @@ var opstr = [...]string{
 	UABA:      "UABA",
 	UABAL:     "UABAL",
 	UABAL2:    "UABAL2",
+	UABALB:    "UABALB",
+	UABALT:    "UABALT",
 	UABD:      "UABD",
 	UABDL:     "UABDL",
 	UABDL2:    "UABDL2",
+	UABDLB:    "UABDLB",
+	UABDLT:    "UABDLT",
 	UADALP:    "UADALP",
 	UADDL:     "UADDL",
 	UADDL2:    "UADDL2",
+	UADDLB:    "UADDLB",
 	UADDLP:    "UADDLP",
+	UADDLT:    "UADDLT",
 	UADDLV:    "UADDLV",
+	UADDV:     "UADDV",
 	UADDW:     "UADDW",
 	UADDW2:    "UADDW2",
+	UADDWB:    "UADDWB",
+	UADDWT:    "UADDWT",
 	UBFIZ:     "UBFIZ",
 	UBFM:      "UBFM",
 	UBFX:      "UBFX",
+	UCLAMP:    "UCLAMP",
 	UCVTF:     "UCVTF",
+	UCVTFLT:   "UCVTFLT",
 	UDIV:      "UDIV",
+	UDIVR:     "UDIVR",
+	UDOT:      "UDOT",
 	UHADD:     "UHADD",
 	UHSUB:     "UHSUB",
+	UHSUBR:    "UHSUBR",
 	UMADDL:    "UMADDL",
 	UMAX:      "UMAX",
 	UMAXP:     "UMAXP",
+	UMAXQV:    "UMAXQV",
 	UMAXV:     "UMAXV",
 	UMIN:      "UMIN",
 	UMINP:     "UMINP",
+	UMINQV:    "UMINQV",
 	UMINV:     "UMINV",
 	UMLAL:     "UMLAL",
 	UMLAL2:    "UMLAL2",
+	UMLALB:    "UMLALB",
+	UMLALT:    "UMLALT",
 	UMLSL:     "UMLSL",
 	UMLSL2:    "UMLSL2",
+	UMLSLB:    "UMLSLB",
+	UMLSLT:    "UMLSLT",
+	UMMLA:     "UMMLA",
 	UMNEGL:    "UMNEGL",
+	UMOPA:     "UMOPA",
+	UMOPS:     "UMOPS",
 	UMOV:      "UMOV",
 	UMSUBL:    "UMSUBL",
 	UMULH:     "UMULH",
 	UMULL:     "UMULL",
 	UMULL2:    "UMULL2",
+	UMULLB:    "UMULLB",
+	UMULLT:    "UMULLT",
 	UQADD:     "UQADD",
+	UQCVTN:    "UQCVTN",
+	UQDECP:    "UQDECP",
+	UQINCP:    "UQINCP",
 	UQRSHL:    "UQRSHL",
+	UQRSHLR:   "UQRSHLR",
 	UQRSHRN:   "UQRSHRN",
 	UQRSHRN2:  "UQRSHRN2",
+	UQRSHRNB:  "UQRSHRNB",
+	UQRSHRNT:  "UQRSHRNT",
 	UQSHL:     "UQSHL",
+	UQSHLR:    "UQSHLR",
 	UQSHRN:    "UQSHRN",
 	UQSHRN2:   "UQSHRN2",
+	UQSHRNB:   "UQSHRNB",
+	UQSHRNT:   "UQSHRNT",
 	UQSUB:     "UQSUB",
+	UQSUBR:    "UQSUBR",
 	UQXTN:     "UQXTN",
 	UQXTN2:    "UQXTN2",
+	UQXTNB:    "UQXTNB",
+	UQXTNT:    "UQXTNT",
 	URECPE:    "URECPE",
 	URHADD:    "URHADD",
 	URSHL:     "URSHL",
+	URSHLR:    "URSHLR",
 	URSHR:     "URSHR",
 	URSQRTE:   "URSQRTE",
 	URSRA:     "URSRA",
+	USDOT:     "USDOT",
 	USHL:      "USHL",
 	USHLL:     "USHLL",
 	USHLL2:    "USHLL2",
+	USHLLB:    "USHLLB",
+	USHLLT:    "USHLLT",
 	USHR:      "USHR",
+	USMMLA:    "USMMLA",
+	USMOPA:    "USMOPA",
+	USMOPS:    "USMOPS",
 	USQADD:    "USQADD",
 	USRA:      "USRA",
 	USUBL:     "USUBL",
 	USUBL2:    "USUBL2",
+	USUBLB:    "USUBLB",
+	USUBLT:    "USUBLT",
 	USUBW:     "USUBW",
 	USUBW2:    "USUBW2",
+	USUBWB:    "USUBWB",
+	USUBWT:    "USUBWT",
+	UUNPKHI:   "UUNPKHI",
+	UUNPKLO:   "UUNPKLO",
 	UXTB:      "UXTB",
 	UXTH:      "UXTH",
 	UXTL:      "UXTL",
 	UXTL2:     "UXTL2",
+	UXTW:      "UXTW",
 	UZP1:      "UZP1",
 	UZP2:      "UZP2",
+	UZPQ1:     "UZPQ1",
+	UZPQ2:     "UZPQ2",
 	WFE:       "WFE",
 	WFI:       "WFI",
+	WHILEGE:   "WHILEGE",
+	WHILEGT:   "WHILEGT",
+	WHILEHI:   "WHILEHI",
+	WHILEHS:   "WHILEHS",
+	WHILELE:   "WHILELE",
+	WHILELO:   "WHILELO",
+	WHILELS:   "WHILELS",
+	WHILELT:   "WHILELT",
+	WHILERW:   "WHILERW",
+	WHILEWR:   "WHILEWR",
+	WRFFR:     "WRFFR",
+	XAR:       "XAR",
+	XPACD:     "XPACD",
+	XPACI:     "XPACI",
+	XPACLRI:   "XPACLRI",
 	XTN:       "XTN",
 	XTN2:      "XTN2",
 	YIELD:     "YIELD",
+	ZERO:      "ZERO",
 	ZIP1:      "ZIP1",
 	ZIP2:      "ZIP2",
+	ZIPQ1:     "ZIPQ1",
+	ZIPQ2:     "ZIPQ2",
 }
 
 var instFormats = [...]instFormat{
@@ -971,6 +2585,8 @@ var instFormats = [...]instFormat{
 	{0xff208000, 0x0b000000, ADD, instArgs{arg_Wd, arg_Wn, arg_Wm_shift__LSL_0__LSR_1__ASR_2__0_31}, nil},
 	// ADD <Xd>, <Xn>, <Xm> {, <shift> #<amount> }
 	{0xff200000, 0x8b000000, ADD, instArgs{arg_Xd, arg_Xn, arg_Xm_shift__LSL_0__LSR_1__ASR_2__0_63}, nil},
+	// ADDG <Xd|SP>, <Xn|SP>, #<uimm6>, #<uimm4>
+	{0xffc0c000, 0x91800000, ADDG, instArgs{arg_Xds, arg_Xns, arg_immediate_0_1008_uimm6_16, arg_immediate_0_15_uimm4}, nil},
 	// CMN <Wn|WSP>, <Wm>{, <extend> {#<amount>}}
 	{0xffe0001f, 0x2b20001f, CMN, instArgs{arg_Wns, arg_Wm_extend__UXTB_0__UXTH_1__LSL_UXTW_2__UXTX_3__SXTB_4__SXTH_5__SXTW_6__SXTX_7__0_4}, nil},
 	// ADDS <Wd>, <Wn|WSP>, <Wm>{, <extend> {#<amount>}}
@@ -1067,6 +2683,22 @@ var instFormats = [...]instFormat{
 	{0xfff8f000, 0xd5088000, TLBI, instArgs{arg_sysop_TLBI_SYS_CR_system}, tlbi_sys_cr_system_cond},
 	// SYS #<op1>, <Cn>, <Cm>, <op>, {<Xt>}
 	{0xfff80000, 0xd5080000, SYS, instArgs{arg_immediate_0_7_op1, arg_Cn, arg_Cm, arg_sysop_SYS_CR_system}, nil},
+	// AUTIA <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac11000, AUTIA, instArgs{arg_Xd, arg_Xns}, nil},
+	// AUTIZA <Xd>
+	{0xffffffe0, 0xdac133e0, AUTIZA, instArgs{arg_Xd}, nil},
+	// AUTIB <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac11400, AUTIB, instArgs{arg_Xd, arg_Xns}, nil},
+	// AUTIZB <Xd>
+	{0xffffffe0, 0xdac137e0, AUTIZB, instArgs{arg_Xd}, nil},
+	// AUTDA <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac11800, AUTDA, instArgs{arg_Xd, arg_Xns}, nil},
+	// AUTDZA <Xd>
+	{0xffffffe0, 0xdac13be0, AUTDZA, instArgs{arg_Xd}, nil},
+	// AUTDB <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac11c00, AUTDB, instArgs{arg_Xd, arg_Xns}, nil},
+	// AUTDZB <Xd>
+	{0xffffffe0, 0xdac13fe0, AUTDZB, instArgs{arg_Xd}, nil},
 	// B <label>
 	{0xfc000000, 0x14000000, B, instArgs{arg_slabel_imm26_2}, nil},
 	// B<c> <label>
@@ -1095,10 +2727,74 @@ var instFormats = [...]instFormat{
 	{0xfc000000, 0x94000000, BL, instArgs{arg_slabel_imm26_2}, nil},
 	// BLR <Xn>
 	{0xfffffc1f, 0xd63f0000, BLR, instArgs{arg_Xn}, nil},
+	// BLRAA <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd73f0800, BLRAA, instArgs{arg_Xn, arg_Xds}, nil},
+	// BLRAAZ <Xn>
+	{0xfffffc1f, 0xd63f081f, BLRAAZ, instArgs{arg_Xn}, nil},
+	// BLRAB <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd73f0c00, BLRAB, instArgs{arg_Xn, arg_Xds}, nil},
+	// BLRABZ <Xn>
+	{0xfffffc1f, 0xd63f0c1f, BLRABZ, instArgs{arg_Xn}, nil},
 	// BR <Xn>
 	{0xfffffc1f, 0xd61f0000, BR, instArgs{arg_Xn}, nil},
+	// BRAA <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd71f0800, BRAA, instArgs{arg_Xn, arg_Xds}, nil},
+	// BRAAZ <Xn>
+	{0xfffffc1f, 0xd61f081f, BRAAZ, instArgs{arg_Xn}, nil},
+	// BRAB <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd71f0c00, BRAB, instArgs{arg_Xn, arg_Xds}, nil},
+	// BRABZ <Xn>
+	{0xfffffc1f, 0xd61f0c1f, BRABZ, instArgs{arg_Xn}, nil},
 	// BRK #<imm>
 	{0xffe0001f, 0xd4200000, BRK, instArgs{arg_immediate_0_65535_imm16}, nil},
+	// CAS <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x88a00000, CAS, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CAS <Xs>, <Xt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0xc8a00000, CAS, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// CASA <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x88e00000, CASA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASA <Xs>, <Xt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0xc8e00000, CASA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// CASAL <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x88e08000, CASAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASAL <Xs>, <Xt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0xc8e08000, CASAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// CASL <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x88a08000, CASL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASL <Xs>, <Xt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0xc8a08000, CASL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// CASB <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08a00000, CASB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASAB <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08e00000, CASAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASALB <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08e08000, CASALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASLB <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08a08000, CASLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASH <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48a00000, CASH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASAH <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48e00000, CASAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASALH <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48e08000, CASALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASLH <Ws>, <Wt>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48a08000, CASLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// CASP <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08200000, CASP, instArgs{arg_Ws, arg_Ws_plus_1, arg_Wt, arg_Wt_plus_1, arg_Xns_mem}, nil},
+	// CASP <Xs>, <X(s+1)>, <Xt>, <X(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48200000, CASP, instArgs{arg_Xs, arg_Xs_plus_1, arg_Xt, arg_Xt_plus_1, arg_Xns_mem}, nil},
+	// CASPA <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08600000, CASPA, instArgs{arg_Ws, arg_Ws_plus_1, arg_Wt, arg_Wt_plus_1, arg_Xns_mem}, nil},
+	// CASPA <Xs>, <X(s+1)>, <Xt>, <X(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48600000, CASPA, instArgs{arg_Xs, arg_Xs_plus_1, arg_Xt, arg_Xt_plus_1, arg_Xns_mem}, nil},
+	// CASPAL <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08608000, CASPAL, instArgs{arg_Ws, arg_Ws_plus_1, arg_Wt, arg_Wt_plus_1, arg_Xns_mem}, nil},
+	// CASPAL <Xs>, <X(s+1)>, <Xt>, <X(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48608000, CASPAL, instArgs{arg_Xs, arg_Xs_plus_1, arg_Xt, arg_Xt_plus_1, arg_Xns_mem}, nil},
+	// CASPL <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x08208000, CASPL, instArgs{arg_Ws, arg_Ws_plus_1, arg_Wt, arg_Wt_plus_1, arg_Xns_mem}, nil},
+	// CASPL <Xs>, <X(s+1)>, <Xt>, <X(t+1)>, [<Xn|SP>{, #0}]
+	{0xffe08000, 0x48208000, CASPL, instArgs{arg_Xs, arg_Xs_plus_1, arg_Xt, arg_Xt_plus_1, arg_Xns_mem}, nil},
 	// CBNZ <Wt>, <label>
 	{0xff000000, 0x35000000, CBNZ, instArgs{arg_Wt, arg_slabel_imm19_2}, nil},
 	// CBNZ <Xt>, <label>
@@ -1193,6 +2889,198 @@ var instFormats = [...]instFormat{
 	{0xffe00c00, 0xda800400, CNEG, instArgs{arg_Xd, arg_Xn, arg_cond_NotAllowALNV_Invert}, cneg_csneg_64_condsel_cond},
 	// CSNEG <Xd>, <Xn>, <Xm>, <cond>
 	{0xffe00c00, 0xda800400, CSNEG, instArgs{arg_Xd, arg_Xn, arg_Xm, arg_cond_AllowALNV_Normal}, nil},
+	// CPYFP [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19000400, CPYFP, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPWT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19001400, CPYFPWT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPRT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19002400, CPYFPRT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19003400, CPYFPT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19004400, CPYFPWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPWTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19005400, CPYFPWTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPRTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19006400, CPYFPRTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19007400, CPYFPTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19008400, CPYFPRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPWTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19009400, CPYFPWTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPRTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1900a400, CPYFPRTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1900b400, CPYFPTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1900c400, CPYFPN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPWTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1900d400, CPYFPWTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPRTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1900e400, CPYFPRTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFPTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1900f400, CPYFPTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFM [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19400400, CPYFM, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMWT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19401400, CPYFMWT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMRT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19402400, CPYFMRT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19403400, CPYFMT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19404400, CPYFMWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMWTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19405400, CPYFMWTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMRTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19406400, CPYFMRTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19407400, CPYFMTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19408400, CPYFMRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMWTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19409400, CPYFMWTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMRTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1940a400, CPYFMRTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1940b400, CPYFMTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1940c400, CPYFMN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMWTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1940d400, CPYFMWTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMRTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1940e400, CPYFMRTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFMTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1940f400, CPYFMTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFE [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19800400, CPYFE, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFEWT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19801400, CPYFEWT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFERT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19802400, CPYFERT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFET [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19803400, CPYFET, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFEWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19804400, CPYFEWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFEWTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19805400, CPYFEWTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFERTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19806400, CPYFERTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFETWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19807400, CPYFETWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFERN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19808400, CPYFERN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFEWTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x19809400, CPYFEWTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFERTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1980a400, CPYFERTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFETRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1980b400, CPYFETRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFEN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1980c400, CPYFEN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFEWTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1980d400, CPYFEWTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFERTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1980e400, CPYFERTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYFETN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1980f400, CPYFETN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYP [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d000400, CPYP, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPWT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d001400, CPYPWT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPRT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d002400, CPYPRT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d003400, CPYPT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d004400, CPYPWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPWTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d005400, CPYPWTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPRTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d006400, CPYPRTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d007400, CPYPTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d008400, CPYPRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPWTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d009400, CPYPWTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPRTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d00a400, CPYPRTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d00b400, CPYPTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d00c400, CPYPN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPWTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d00d400, CPYPWTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPRTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d00e400, CPYPRTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYPTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d00f400, CPYPTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYM [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d400400, CPYM, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMWT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d401400, CPYMWT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMRT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d402400, CPYMRT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d403400, CPYMT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d404400, CPYMWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMWTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d405400, CPYMWTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMRTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d406400, CPYMRTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d407400, CPYMTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d408400, CPYMRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMWTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d409400, CPYMWTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMRTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d40a400, CPYMRTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d40b400, CPYMTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d40c400, CPYMN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMWTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d40d400, CPYMWTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMRTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d40e400, CPYMRTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYMTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d40f400, CPYMTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYE [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d800400, CPYE, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYEWT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d801400, CPYEWT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYERT [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d802400, CPYERT, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYET [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d803400, CPYET, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYEWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d804400, CPYEWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYEWTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d805400, CPYEWTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYERTWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d806400, CPYERTWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYETWN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d807400, CPYETWN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYERN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d808400, CPYERN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYEWTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d809400, CPYEWTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYERTRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d80a400, CPYERTRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYETRN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d80b400, CPYETRN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYEN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d80c400, CPYEN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYEWTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d80d400, CPYEWTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYERTN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d80e400, CPYERTN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
+	// CPYETN [<Xd>]!, [<Xs>]!, <Xn>!
+	{0xffe0fc00, 0x1d80f400, CPYETN, instArgs{arg_Xd_mem_wb_mops, arg_Xs_mem_wb_mops, arg_Xn_wb_mops}, cpy_memcms_cond},
 	// CRC32B <Wd>, <Wn>, <Wm>
 	{0xffe0fc00, 0x1ac04000, CRC32B, instArgs{arg_Wd, arg_Wn, arg_Wm}, nil},
 	// CRC32H <Wd>, <Wn>, <Wm>
@@ -1239,6 +3127,10 @@ var instFormats = [...]instFormat{
 	{0xff200000, 0xca000000, EOR, instArgs{arg_Xd, arg_Xn, arg_Xm_shift__LSL_0__LSR_1__ASR_2__ROR_3__0_63}, nil},
 	// ERET
 	{0xffffffff, 0xd69f03e0, ERET, instArgs{}, nil},
+	// ERETAA
+	{0xffffffff, 0xd69f0bff, ERETAA, instArgs{}, nil},
+	// ERETAB
+	{0xffffffff, 0xd69f0fff, ERETAB, instArgs{}, nil},
 	// ROR <Wd>, <Ws>, #<shift>
 	{0xffe08000, 0x13800000, ROR, instArgs{arg_Wd, arg_Ws, arg_immediate_0_31_imms}, ror_extr_32_extract_cond},
 	// EXTR <Wd>, <Wn>, <Wm>, #<lsb>
@@ -1259,12 +3151,96 @@ var instFormats = [...]instFormat{
 	{0xffffffff, 0xd503207f, WFI, instArgs{}, nil},
 	// YIELD
 	{0xffffffff, 0xd503203f, YIELD, instArgs{}, nil},
+	// AUTIA1716
+	{0xffffffff, 0xd503219f, AUTIA1716, instArgs{}, nil},
+	// AUTIASP
+	{0xffffffff, 0xd50323bf, AUTIASP, instArgs{}, nil},
+	// AUTIAZ
+	{0xffffffff, 0xd503239f, AUTIAZ, instArgs{}, nil},
+	// AUTIB1716
+	{0xffffffff, 0xd50321df, AUTIB1716, instArgs{}, nil},
+	// AUTIBSP
+	{0xffffffff, 0xd50323ff, AUTIBSP, instArgs{}, nil},
+	// AUTIBZ
+	{0xffffffff, 0xd50323df, AUTIBZ, instArgs{}, nil},
+	// BTI
+	{0xffffffff, 0xd503241f, BTI, instArgs{}, nil},
+	// BTI <targets>
+	{0xffffff3f, 0xd503241f, BTI, instArgs{arg_targets_op2}, nil},
+	// PACIA1716
+	{0xffffffff, 0xd503211f, PACIA1716, instArgs{}, nil},
+	// PACIASP
+	{0xffffffff, 0xd503233f, PACIASP, instArgs{}, nil},
+	// PACIAZ
+	{0xffffffff, 0xd503231f, PACIAZ, instArgs{}, nil},
+	// PACIB1716
+	{0xffffffff, 0xd503215f, PACIB1716, instArgs{}, nil},
+	// PACIBSP
+	{0xffffffff, 0xd503237f, PACIBSP, instArgs{}, nil},
+	// PACIBZ
+	{0xffffffff, 0xd503235f, PACIBZ, instArgs{}, nil},
+	// XPACLRI
+	{0xffffffff, 0xd50320ff, XPACLRI, instArgs{}, nil},
 	// HINT #<imm>
 	{0xfffff01f, 0xd503201f, HINT, instArgs{arg_immediate_0_127_CRm_op2}, nil},
+	// GMI <Xd>, <Xn|SP>, <Xm>
+	{0xffe0fc00, 0x9ac01400, GMI, instArgs{arg_Xd, arg_Xns, arg_Xm}, nil},
 	// HLT #<imm>
 	{0xffe0001f, 0xd4400000, HLT, instArgs{arg_immediate_0_65535_imm16}, nil},
+	// IRG <Xd|SP>, <Xn|SP>
+	{0xfffffc00, 0x9adf1000, IRG, instArgs{arg_Xds, arg_Xns}, nil},
+	// IRG <Xd|SP>, <Xn|SP>{, <Xm>}
+	{0xffe0fc00, 0x9ac01000, IRG, instArgs{arg_Xds, arg_Xns, arg_Xm}, nil},
 	// ISB {<option>|<imm>}
 	{0xfffff0ff, 0xd50330df, ISB, instArgs{arg_option_ISB_BI_system_CRm}, nil},
+	// STADD <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820001f, STADD, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STADD <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820001f, STADD, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDADD <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8200000, LDADD, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADD <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8200000, LDADD, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDADDA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a00000, LDADDA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a00000, LDADDA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDADDAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e00000, LDADDAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e00000, LDADDAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STADDL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860001f, STADDL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STADDL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860001f, STADDL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDADDL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8600000, LDADDL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8600000, LDADDL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STADDB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820001f, STADDB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDADDB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38200000, LDADDB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a00000, LDADDAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e00000, LDADDALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STADDLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860001f, STADDLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDADDLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38600000, LDADDLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STADDH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820001f, STADDH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDADDH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78200000, LDADDH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a00000, LDADDAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDADDALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e00000, LDADDALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STADDLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860001f, STADDLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDADDLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78600000, LDADDLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
 	// LDAR <Wt>, [<Xn|SP>{, #0}]
 	{0xffe08000, 0x88c08000, LDAR, instArgs{arg_Wt, arg_Xns_mem}, nil},
 	// LDAR <Xt>, [<Xn|SP>{, #0}]
@@ -1285,6 +3261,106 @@ var instFormats = [...]instFormat{
 	{0xffe08000, 0x08408000, LDAXRB, instArgs{arg_Wt, arg_Xns_mem}, nil},
 	// LDAXRH <Wt>, [<Xn|SP>{, #0}]
 	{0xffe08000, 0x48408000, LDAXRH, instArgs{arg_Wt, arg_Xns_mem}, nil},
+	// STCLR <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820101f, STCLR, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STCLR <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820101f, STCLR, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDCLR <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8201000, LDCLR, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLR <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8201000, LDCLR, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDCLRA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a01000, LDCLRA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a01000, LDCLRA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDCLRAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e01000, LDCLRAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e01000, LDCLRAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STCLRL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860101f, STCLRL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STCLRL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860101f, STCLRL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDCLRL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8601000, LDCLRL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8601000, LDCLRL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STCLRB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820101f, STCLRB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDCLRB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38201000, LDCLRB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a01000, LDCLRAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e01000, LDCLRALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STCLRLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860101f, STCLRLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDCLRLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38601000, LDCLRLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STCLRH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820101f, STCLRH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDCLRH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78201000, LDCLRH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a01000, LDCLRAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDCLRALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e01000, LDCLRALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STCLRLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860101f, STCLRLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDCLRLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78601000, LDCLRLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STEOR <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820201f, STEOR, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STEOR <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820201f, STEOR, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDEOR <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8202000, LDEOR, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEOR <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8202000, LDEOR, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDEORA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a02000, LDEORA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a02000, LDEORA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDEORAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e02000, LDEORAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e02000, LDEORAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STEORL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860201f, STEORL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STEORL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860201f, STEORL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDEORL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8602000, LDEORL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8602000, LDEORL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STEORB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820201f, STEORB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDEORB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38202000, LDEORB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a02000, LDEORAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e02000, LDEORALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STEORLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860201f, STEORLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDEORLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38602000, LDEORLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STEORH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820201f, STEORH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDEORH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78202000, LDEORH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a02000, LDEORAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDEORALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e02000, LDEORALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STEORLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860201f, STEORLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDEORLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78602000, LDEORLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDG <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xd9600000, LDG, instArgs{arg_Xt, arg_Xns_mem_optional_imm9_16_signed}, nil},
+	// LDGM <Xt>, [<Xn|SP>]
+	{0xfffffc00, 0xd9e00000, LDGM, instArgs{arg_Xt, arg_Xns_mem}, nil},
 	// LDNP <Wt>, <Wt2>, [<Xn|SP>{, #<imm>}]
 	{0xffc00000, 0x28400000, LDNP, instArgs{arg_Wt, arg_Wt2, arg_Xns_mem_optional_imm7_4_signed}, nil},
 	// LDNP <Xt>, <Xt2>, [<Xn|SP>{, #<imm_1>}]
@@ -1327,6 +3403,14 @@ var instFormats = [...]instFormat{
 	{0xffe00c00, 0xb8600800, LDR, instArgs{arg_Wt, arg_Xns_mem_extend_m__UXTW_2__LSL_3__SXTW_6__SXTX_7__0_0__2_1}, nil},
 	// LDR <Xt>, [<Xn|SP>, (<Wm>|<Xm>) {, <extend> {<amount>}}]
 	{0xffe00c00, 0xf8600800, LDR, instArgs{arg_Xt, arg_Xns_mem_extend_m__UXTW_2__LSL_3__SXTW_6__SXTX_7__0_0__3_1}, nil},
+	// LDRAA <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffa00c00, 0xf8200400, LDRAA, instArgs{arg_Xt, arg_Xns_mem_optional_S_imm9_8_signed}, nil},
+	// LDRAA <Xt>, [<Xn|SP>{, #<simm>}]!
+	{0xffa00c00, 0xf8200c00, LDRAA, instArgs{arg_Xt, arg_Xns_mem_wb_S_imm9_8_signed}, nil},
+	// LDRAB <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffa00c00, 0xf8a00400, LDRAB, instArgs{arg_Xt, arg_Xns_mem_optional_S_imm9_8_signed}, nil},
+	// LDRAB <Xt>, [<Xn|SP>{, #<simm>}]!
+	{0xffa00c00, 0xf8a00c00, LDRAB, instArgs{arg_Xt, arg_Xns_mem_wb_S_imm9_8_signed}, nil},
 	// LDRB <Wt>, [<Xn|SP>], #<simm>
 	{0xffe00c00, 0x38400400, LDRB, instArgs{arg_Wt, arg_Xns_mem_post_imm9_1_signed}, nil},
 	// LDRB <Wt>, [<Xn|SP>{, #<simm>}]!
@@ -1385,6 +3469,150 @@ var instFormats = [...]instFormat{
 	{0xff000000, 0x98000000, LDRSW, instArgs{arg_Xt, arg_slabel_imm19_2}, nil},
 	// LDRSW <Xt>, [<Xn|SP>, (<Wm>|<Xm>) {, <extend> {<amount>}}]
 	{0xffe00c00, 0xb8a00800, LDRSW, instArgs{arg_Xt, arg_Xns_mem_extend_m__UXTW_2__LSL_3__SXTW_6__SXTX_7__0_0__2_1}, nil},
+	// STSET <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820301f, STSET, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STSET <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820301f, STSET, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDSET <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8203000, LDSET, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSET <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8203000, LDSET, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDSETA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a03000, LDSETA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a03000, LDSETA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDSETAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e03000, LDSETAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e03000, LDSETAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STSETL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860301f, STSETL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STSETL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860301f, STSETL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDSETL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8603000, LDSETL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8603000, LDSETL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STSETB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820301f, STSETB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSETB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38203000, LDSETB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a03000, LDSETAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e03000, LDSETALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSETLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860301f, STSETLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSETLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38603000, LDSETLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSETH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820301f, STSETH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSETH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78203000, LDSETH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a03000, LDSETAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSETALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e03000, LDSETALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSETLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860301f, STSETLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSETLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78603000, LDSETLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMAX <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820401f, STSMAX, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STSMAX <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820401f, STSMAX, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDSMAX <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8204000, LDSMAX, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAX <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8204000, LDSMAX, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDSMAXA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a04000, LDSMAXA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a04000, LDSMAXA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDSMAXAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e04000, LDSMAXAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e04000, LDSMAXAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STSMAXL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860401f, STSMAXL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STSMAXL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860401f, STSMAXL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDSMAXL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8604000, LDSMAXL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8604000, LDSMAXL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STSMAXB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820401f, STSMAXB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMAXB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38204000, LDSMAXB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a04000, LDSMAXAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e04000, LDSMAXALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMAXLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860401f, STSMAXLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMAXLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38604000, LDSMAXLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMAXH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820401f, STSMAXH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMAXH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78204000, LDSMAXH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a04000, LDSMAXAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMAXALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e04000, LDSMAXALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMAXLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860401f, STSMAXLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMAXLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78604000, LDSMAXLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMIN <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820501f, STSMIN, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STSMIN <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820501f, STSMIN, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDSMIN <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8205000, LDSMIN, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMIN <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8205000, LDSMIN, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDSMINA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a05000, LDSMINA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a05000, LDSMINA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDSMINAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e05000, LDSMINAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e05000, LDSMINAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STSMINL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860501f, STSMINL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STSMINL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860501f, STSMINL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDSMINL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8605000, LDSMINL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8605000, LDSMINL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STSMINB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820501f, STSMINB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMINB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38205000, LDSMINB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a05000, LDSMINAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e05000, LDSMINALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMINLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860501f, STSMINLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMINLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38605000, LDSMINLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMINH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820501f, STSMINH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMINH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78205000, LDSMINH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a05000, LDSMINAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDSMINALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e05000, LDSMINALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STSMINLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860501f, STSMINLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDSMINLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78605000, LDSMINLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
 	// LDTR <Wt>, [<Xn|SP>{, #<simm>}]
 	{0xffe00c00, 0xb8400800, LDTR, instArgs{arg_Wt, arg_Xns_mem_optional_imm9_1_signed}, nil},
 	// LDTR <Xt>, [<Xn|SP>{, #<simm>}]
@@ -1403,6 +3631,102 @@ var instFormats = [...]instFormat{
 	{0xffe00c00, 0x78800800, LDTRSH, instArgs{arg_Xt, arg_Xns_mem_optional_imm9_1_signed}, nil},
 	// LDTRSW <Xt>, [<Xn|SP>{, #<simm>}]
 	{0xffe00c00, 0xb8800800, LDTRSW, instArgs{arg_Xt, arg_Xns_mem_optional_imm9_1_signed}, nil},
+	// STUMAX <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820601f, STUMAX, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STUMAX <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820601f, STUMAX, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDUMAX <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8206000, LDUMAX, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAX <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8206000, LDUMAX, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDUMAXA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a06000, LDUMAXA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a06000, LDUMAXA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDUMAXAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e06000, LDUMAXAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e06000, LDUMAXAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STUMAXL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860601f, STUMAXL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STUMAXL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860601f, STUMAXL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDUMAXL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8606000, LDUMAXL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8606000, LDUMAXL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STUMAXB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820601f, STUMAXB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMAXB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38206000, LDUMAXB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a06000, LDUMAXAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e06000, LDUMAXALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMAXLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860601f, STUMAXLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMAXLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38606000, LDUMAXLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMAXH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820601f, STUMAXH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMAXH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78206000, LDUMAXH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a06000, LDUMAXAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMAXALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e06000, LDUMAXALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMAXLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860601f, STUMAXLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMAXLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78606000, LDUMAXLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMIN <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb820701f, STUMIN, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STUMIN <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf820701f, STUMIN, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDUMIN <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8207000, LDUMIN, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMIN <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8207000, LDUMIN, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDUMINA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a07000, LDUMINA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a07000, LDUMINA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// LDUMINAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e07000, LDUMINAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e07000, LDUMINAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STUMINL <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0xb860701f, STUMINL, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// STUMINL <Xs>, [<Xn|SP>]
+	{0xffe0fc1f, 0xf860701f, STUMINL, instArgs{arg_Xs, arg_Xns_mem}, nil},
+	// LDUMINL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8607000, LDUMINL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8607000, LDUMINL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// STUMINB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820701f, STUMINB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMINB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38207000, LDUMINB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a07000, LDUMINAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e07000, LDUMINALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMINLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860701f, STUMINLB, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMINLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38607000, LDUMINLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMINH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820701f, STUMINH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMINH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78207000, LDUMINH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a07000, LDUMINAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// LDUMINALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e07000, LDUMINALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// STUMINLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860701f, STUMINLH, instArgs{arg_Ws, arg_Xns_mem}, nil},
+	// LDUMINLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78607000, LDUMINLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
 	// LDUR <Wt>, [<Xn|SP>{, #<simm>}]
 	{0xffe00c00, 0xb8400000, LDUR, instArgs{arg_Wt, arg_Xns_mem_optional_imm9_1_signed}, nil},
 	// LDUR <Xt>, [<Xn|SP>{, #<simm>}]
@@ -1527,6 +3851,14 @@ var instFormats = [...]instFormat{
 	{0xff800000, 0xf2800000, MOVK, instArgs{arg_Xd, arg_immediate_OptLSL_amount_16_0_48}, nil},
 	// MRS <Xt>, <systemreg>
 	{0xfff00000, 0xd5300000, MRS, instArgs{arg_Xt, arg_sysreg_o0_op1_CRn_CRm_op2}, nil},
+	// SMSTART
+	{0xffffffff, 0xd503477f, SMSTART, instArgs{}, nil},
+	// SMSTART <option>
+	{0xfffff9ff, 0xd503417f, SMSTART, instArgs{arg_option_CRm_2_1}, nil},
+	// SMSTOP
+	{0xffffffff, 0xd503467f, SMSTOP, instArgs{}, nil},
+	// SMSTOP <option>
+	{0xfffff9ff, 0xd503407f, SMSTOP, instArgs{arg_option_CRm_2_1}, nil},
 	// MSR <pstatefield>, #<imm>
 	{0xfff8f01f, 0xd500401f, MSR, instArgs{arg_pstatefield_op1_op2__SPSel_05__DAIFSet_36__DAIFClr_37, arg_immediate_0_15_CRm}, nil},
 	// MSR <systemreg>, <Xt>
@@ -1563,6 +3895,24 @@ var instFormats = [...]instFormat{
 	{0xffe0ffe0, 0xfa0003e0, NGCS, instArgs{arg_Xd, arg_Xm}, nil},
 	// SBCS <Xd>, <Xn>, <Xm>
 	{0xffe0fc00, 0xfa000000, SBCS, instArgs{arg_Xd, arg_Xn, arg_Xm}, nil},
+	// PACDA <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac10800, PACDA, instArgs{arg_Xd, arg_Xns}, nil},
+	// PACDZA <Xd>
+	{0xffffffe0, 0xdac12be0, PACDZA, instArgs{arg_Xd}, nil},
+	// PACDB <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac10c00, PACDB, instArgs{arg_Xd, arg_Xns}, nil},
+	// PACDZB <Xd>
+	{0xffffffe0, 0xdac12fe0, PACDZB, instArgs{arg_Xd}, nil},
+	// PACGA <Xd>, <Xn>, <Xm|SP>
+	{0xffe0fc00, 0x9ac03000, PACGA, instArgs{arg_Xd, arg_Xn, arg_Xms}, nil},
+	// PACIA <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac10000, PACIA, instArgs{arg_Xd, arg_Xns}, nil},
+	// PACIZA <Xd>
+	{0xffffffe0, 0xdac123e0, PACIZA, instArgs{arg_Xd}, nil},
+	// PACIB <Xd>, <Xn|SP>
+	{0xfffffc00, 0xdac10400, PACIB, instArgs{arg_Xd, arg_Xns}, nil},
+	// PACIZB <Xd>
+	{0xffffffe0, 0xdac127e0, PACIZB, instArgs{arg_Xd}, nil},
 	// PRFM <prfop>, [<Xn|SP>{, #<pimm>}]
 	{0xffc00000, 0xf9800000, PRFM, instArgs{arg_prfop_Rt, arg_Xns_mem_optional_imm12_8_unsigned}, nil},
 	// PRFM <prfop>, <label>
@@ -1577,6 +3927,10 @@ var instFormats = [...]instFormat{
 	{0xfffffc00, 0xdac00000, RBIT, instArgs{arg_Xd, arg_Xn}, nil},
 	// RET {<Xn>}
 	{0xfffffc1f, 0xd65f0000, RET, instArgs{arg_Xn}, nil},
+	// RETAA
+	{0xffffffff, 0xd65f0bff, RETAA, instArgs{}, nil},
+	// RETAB
+	{0xffffffff, 0xd65f0fff, RETAB, instArgs{}, nil},
 	// REV <Wd>, <Wn>
 	{0xfffffc00, 0x5ac00800, REV, instArgs{arg_Wd, arg_Wn}, nil},
 	// REV <Xd>, <Xn>
@@ -1599,6 +3953,54 @@ var instFormats = [...]instFormat{
 	{0xffe0fc00, 0x1ac00c00, SDIV, instArgs{arg_Wd, arg_Wn, arg_Wm}, nil},
 	// SDIV <Xd>, <Xn>, <Xm>
 	{0xffe0fc00, 0x9ac00c00, SDIV, instArgs{arg_Xd, arg_Xn, arg_Xm}, nil},
+	// SETP [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c00400, SETP, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETPT [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c01400, SETPT, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETPN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c02400, SETPN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETPTN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c03400, SETPTN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETM [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c04400, SETM, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETMT [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c05400, SETMT, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETMN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c06400, SETMN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETMTN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c07400, SETMTN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETE [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c08400, SETE, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETET [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c09400, SETET, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETEN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c0a400, SETEN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETETN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x19c0b400, SETETN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGP [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc00400, SETGP, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGPT [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc01400, SETGPT, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGPN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc02400, SETGPN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGPTN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc03400, SETGPTN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGM [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc04400, SETGM, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGMT [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc05400, SETGMT, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGMN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc06400, SETGMN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGMTN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc07400, SETGMTN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGE [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc08400, SETGE, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGET [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc09400, SETGET, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGEN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc0a400, SETGEN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
+	// SETGETN [<Xd>]!, <Xn>!, <Xs>
+	{0xffe0fc00, 0x1dc0b400, SETGETN, instArgs{arg_Xd_mem_wb_mops, arg_Xn_wb_mops, arg_Xs}, set_memcms_cond},
 	// SMULL <Xd>, <Wn>, <Wm>
 	{0xffe0fc00, 0x9b207c00, SMULL, instArgs{arg_Xd, arg_Wn, arg_Wm}, nil},
 	// SMADDL <Xd>, <Wn>, <Wm>, <Xa>
@@ -1609,6 +4011,40 @@ var instFormats = [...]instFormat{
 	{0xffe08000, 0x9b208000, SMSUBL, instArgs{arg_Xd, arg_Wn, arg_Wm, arg_Xa}, nil},
 	// SMULH <Xd>, <Xn>, <Xm>
 	{0xffe08000, 0x9b400000, SMULH, instArgs{arg_Xd, arg_Xn, arg_Xm}, nil},
+	// ST2G <Xt|SP>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xd9a00400, ST2G, instArgs{arg_Xts, arg_Xns_mem_post_imm9_16_signed}, nil},
+	// ST2G <Xt|SP>, [<Xn|SP>, #<simm>]!
+	{0xffe00c00, 0xd9a00c00, ST2G, instArgs{arg_Xts, arg_Xns_mem_wb_imm9_16_signed}, nil},
+	// ST2G <Xt|SP>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xd9a00800, ST2G, instArgs{arg_Xts, arg_Xns_mem_optional_imm9_16_signed}, nil},
+	// STG <Xt|SP>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xd9200400, STG, instArgs{arg_Xts, arg_Xns_mem_post_imm9_16_signed}, nil},
+	// STG <Xt|SP>, [<Xn|SP>, #<simm>]!
+	{0xffe00c00, 0xd9200c00, STG, instArgs{arg_Xts, arg_Xns_mem_wb_imm9_16_signed}, nil},
+	// STG <Xt|SP>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xd9200800, STG, instArgs{arg_Xts, arg_Xns_mem_optional_imm9_16_signed}, nil},
+	// STZ2G <Xt|SP>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xd9e00400, STZ2G, instArgs{arg_Xts, arg_Xns_mem_post_imm9_16_signed}, nil},
+	// STZ2G <Xt|SP>, [<Xn|SP>, #<simm>]!
+	{0xffe00c00, 0xd9e00c00, STZ2G, instArgs{arg_Xts, arg_Xns_mem_wb_imm9_16_signed}, nil},
+	// STZ2G <Xt|SP>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xd9e00800, STZ2G, instArgs{arg_Xts, arg_Xns_mem_optional_imm9_16_signed}, nil},
+	// STZG <Xt|SP>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xd9600400, STZG, instArgs{arg_Xts, arg_Xns_mem_post_imm9_16_signed}, nil},
+	// STZG <Xt|SP>, [<Xn|SP>, #<simm>]!
+	{0xffe00c00, 0xd9600c00, STZG, instArgs{arg_Xts, arg_Xns_mem_wb_imm9_16_signed}, nil},
+	// STZG <Xt|SP>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xd9600800, STZG, instArgs{arg_Xts, arg_Xns_mem_optional_imm9_16_signed}, nil},
+	// STGM <Xt>, [<Xn|SP>]
+	{0xfffffc00, 0xd9a00000, STGM, instArgs{arg_Xt, arg_Xns_mem}, nil},
+	// STGP <Xt1>, <Xt2>, [<Xn|SP>], #<imm>
+	{0xffc00000, 0x68800000, STGP, instArgs{arg_Xt, arg_Xt2, arg_Xns_mem_post_imm7_16_signed}, nil},
+	// STGP <Xt1>, <Xt2>, [<Xn|SP>, #<imm>]!
+	{0xffc00000, 0x69800000, STGP, instArgs{arg_Xt, arg_Xt2, arg_Xns_mem_wb_imm7_16_signed}, nil},
+	// STGP <Xt1>, <Xt2>, [<Xn|SP>{, #<imm>}]
+	{0xffc00000, 0x69000000, STGP, instArgs{arg_Xt, arg_Xt2, arg_Xns_mem_optional_imm7_16_signed}, nil},
+	// STZGM <Xt>, [<Xn|SP>]
+	{0xfffffc00, 0xd9200000, STZGM, instArgs{arg_Xt, arg_Xns_mem}, nil},
 	// STLR <Wt>, [<Xn|SP>{, #0}]
 	{0xffe08000, 0x88808000, STLR, instArgs{arg_Wt, arg_Xns_mem}, nil},
 	// STLR <Xt>, [<Xn|SP>{, #0}]
@@ -1713,8 +4149,48 @@ var instFormats = [...]instFormat{
 	{0xff000000, 0x51000000, SUB, instArgs{arg_Wds, arg_Wns, arg_IAddSub}, nil},
 	// SUB <Xd|SP>, <Xn|SP>, #<imm>{, <shift>}
 	{0xff000000, 0xd1000000, SUB, instArgs{arg_Xds, arg_Xns, arg_IAddSub}, nil},
+	// SUBG <Xd|SP>, <Xn|SP>, #<uimm6>, #<uimm4>
+	{0xffc0c000, 0xd1800000, SUBG, instArgs{arg_Xds, arg_Xns, arg_immediate_0_1008_uimm6_16, arg_immediate_0_15_uimm4}, nil},
+	// SUBP <Xd>, <Xn|SP>, <Xm|SP>
+	{0xffe0fc00, 0x9ac00000, SUBP, instArgs{arg_Xd, arg_Xns, arg_Xms}, nil},
+	// CMPP <Xn|SP>, <Xm|SP>
+	{0xffe0fc1f, 0xbac0001f, CMPP, instArgs{arg_Xns, arg_Xms}, nil},
+	// SUBPS <Xd>, <Xn|SP>, <Xm|SP>
+	{0xffe0fc00, 0xbac00000, SUBPS, instArgs{arg_Xd, arg_Xns, arg_Xms}, nil},
 	// SVC #<imm>
 	{0xffe0001f, 0xd4000001, SVC, instArgs{arg_immediate_0_65535_imm16}, nil},
+	// SWP <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8208000, SWP, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWP <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8208000, SWP, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// SWPA <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8a08000, SWPA, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPA <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8a08000, SWPA, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// SWPAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8e08000, SWPAL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPAL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8e08000, SWPAL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// SWPL <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0xb8608000, SWPL, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPL <Xs>, <Xt>, [<Xn|SP>]
+	{0xffe0fc00, 0xf8608000, SWPL, instArgs{arg_Xs, arg_Xt, arg_Xns_mem}, nil},
+	// SWPB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38208000, SWPB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a08000, SWPAB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e08000, SWPALB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38608000, SWPLB, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78208000, SWPH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a08000, SWPAH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e08000, SWPALH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
+	// SWPLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78608000, SWPLH, instArgs{arg_Ws, arg_Wt, arg_Xns_mem}, nil},
 	// SYSL <Xt>, #<op1>, <Cn>, <Cm>, #<op2>
 	{0xfff80000, 0xd5280000, SYSL, instArgs{arg_Xt, arg_immediate_0_7_op1, arg_Cn, arg_Cm, arg_immediate_0_7_op2}, nil},
 	// TBNZ <R><t>, #<imm>, <label>
@@ -1735,6 +4211,10 @@ var instFormats = [...]instFormat{
 	{0xffe08000, 0x9ba08000, UMSUBL, instArgs{arg_Xd, arg_Wn, arg_Wm, arg_Xa}, nil},
 	// UMULH <Xd>, <Xn>, <Xm>
 	{0xffe08000, 0x9bc00000, UMULH, instArgs{arg_Xd, arg_Xn, arg_Xm}, nil},
+	// XPACD <Xd>
+	{0xffffffe0, 0xdac147e0, XPACD, instArgs{arg_Xd}, nil},
+	// XPACI <Xd>
+	{0xffffffe0, 0xdac143e0, XPACI, instArgs{arg_Xd}, nil},
 	// ABS <V><d>, <V><n>
 	{0xff3ffc00, 0x5e20b800, ABS, instArgs{arg_Vd_22_2__D_3, arg_Vn_22_2__D_3}, nil},
 	// ABS <Vd>.<t>, <Vn>.<t>
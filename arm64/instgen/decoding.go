@@ -0,0 +1,733 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/arch/arm64/instgen/xmlspec"
+)
+
+// This file generates the SVE decoder tables of golang.org/x/arch/arm64/arm64asm
+// from the same encodings as the assembler's instruction table. An SVE instruction
+// format is decoded by the sveElem decoders of its operand elements, which are the
+// inverse of the encoding functions in encodings.go.
+
+// decodingImpls are the known decoders of the operand elements, key is the name of
+// the corresponding encoding function, value is the arm64asm sveElem literal without
+// the bit fields, which are taken from the element description unless they are given.
+// The elements whose description has a table of values, like the size specifiers,
+// are decoded by that table and need no entry here.
+var decodingImpls = map[string]string{
+	// Checks of fixed values.
+	"encodeNoModCheck":     `kind: sveElemNone`,
+	"encodeNoAmtCheck":     `kind: sveElemNone`,
+	"encodeNoop":           `kind: sveElemNone`,
+	"encodeXCheck":         `kind: sveElemArng, arng: []Arrangement{ArrangementD}`,
+	"encodeArngBCheck":     `kind: sveElemArng, arng: []Arrangement{ArrangementB}`,
+	"encodeArngDCheck":     `kind: sveElemArng, arng: []Arrangement{ArrangementD}`,
+	"encodeArngHCheck":     `kind: sveElemArng, arng: []Arrangement{ArrangementH}`,
+	"encodeArngQCheck":     `kind: sveElemArng, arng: []Arrangement{ArrangementQ}`,
+	"encodeArngSCheck":     `kind: sveElemArng, arng: []Arrangement{ArrangementS}`,
+	"encodeMergePredCheck": `kind: sveElemTable, tab: []int16{int16(PredicateMerging)}`,
+	"encodeZeroPredCheck":  `kind: sveElemTable, tab: []int16{int16(PredicateZeroing)}`,
+	"encodeFimm0_0_56":     `kind: sveElemFP, fp: []float64{0.0}`,
+	"encodeModAmt1Check":   `kind: sveElemTable, tab: []int16{1}`,
+	"encodeModAmt2Check":   `kind: sveElemTable, tab: []int16{2}`,
+	"encodeModAmt3Check":   `kind: sveElemTable, tab: []int16{3}`,
+	"encodeModAmt4Check":   `kind: sveElemTable, tab: []int16{4}`,
+	"encodeModLSLCheck":    `kind: sveElemTable, tab: []int16{sveModLSL}`,
+	"encodeModSXTWCheck":   `kind: sveElemTable, tab: []int16{sveModSXTW}`,
+	"encodeModUXTWCheck":   `kind: sveElemTable, tab: []int16{sveModUXTW}`,
+
+	// Scalable vector registers.
+	"encodeZd":                         `kind: sveElemReg, base: Z0`,
+	"encodeZdaDest":                    `kind: sveElemReg, base: Z0`,
+	"encodeZda3RdSrcDst":               `kind: sveElemReg, base: Z0`,
+	"encodeZdnDest":                    `kind: sveElemReg, base: Z0`,
+	"encodeZdnSrcDst":                  `kind: sveElemReg, base: Z0`,
+	"encodeZa16213Rd":                  `kind: sveElemReg, base: Z0`,
+	"encodeZa5103Rd":                   `kind: sveElemReg, base: Z0`,
+	"encodeZk5103Rd":                   `kind: sveElemReg, base: Z0`,
+	"encodeZm1619_16Bit32Bit":          `kind: sveElemReg, base: Z0`,
+	"encodeZm1619_32Bit":               `kind: sveElemReg, base: Z0`,
+	"encodeZm1619_8To32Bit":            `kind: sveElemReg, base: Z0`,
+	"encodeZm1619_HalfSinglePrecision": `kind: sveElemReg, base: Z0`,
+	"encodeZm1620_16To64Bit":           `kind: sveElemReg, base: Z0`,
+	"encodeZm1620_64Bit":               `kind: sveElemReg, base: Z0`,
+	"encodeZm1620_DoublePrecision":     `kind: sveElemReg, base: Z0`,
+	"encodeZm1621V1":                   `kind: sveElemReg, base: Z0`,
+	"encodeZm1621V2":                   `kind: sveElemReg, base: Z0`,
+	"encodeZm1621V3":                   `kind: sveElemReg, base: Z0`,
+	"encodeZm510V1":                    `kind: sveElemReg, base: Z0`,
+	"encodeZm510V2":                    `kind: sveElemReg, base: Z0`,
+	"encodeZm_1619_Half":               `kind: sveElemReg, base: Z0`,
+	"encodeZm_1619_Range0_7V1":         `kind: sveElemReg, base: Z0`,
+	"encodeZm_1619_Range0_7V2":         `kind: sveElemReg, base: Z0`,
+	"encodeZm_1620_Range0_15":          `kind: sveElemReg, base: Z0`,
+	"encodeZm_1620_Single":             `kind: sveElemReg, base: Z0`,
+	"encodeZn510MultiSrc1":             `kind: sveElemReg, base: Z0`,
+	"encodeZn510MultiSrc2":             `kind: sveElemReg, base: Z0, add: 1`,
+	"encodeZn510Src":                   `kind: sveElemReg, base: Z0`,
+	"encodeZn510Table1":                `kind: sveElemReg, base: Z0`,
+	"encodeZn510Table2":                `kind: sveElemReg, base: Z0, add: 1`,
+	"encodeZn510Table3":                `kind: sveElemReg, base: Z0`,
+	"encodeZn510V1":                    `kind: sveElemReg, base: Z0`,
+	"encodeZn510V2":                    `kind: sveElemReg, base: Z0`,
+	"encodeGenZn510V1":                 `kind: sveElemReg, base: Z0`,
+	"encodeGenZn510V2":                 `kind: sveElemReg, base: Z0, add: 1`,
+	"encodeZt05":                       `kind: sveElemReg, base: Z0`,
+	"encodeZt051":                      `kind: sveElemReg, base: Z0`,
+	"encodeZt052":                      `kind: sveElemReg, base: Z0, add: 1`,
+	"encodeZt053":                      `kind: sveElemReg, base: Z0, add: 2`,
+	"encodeZt054":                      `kind: sveElemReg, base: Z0, add: 3`,
+	"encodeZd15V1":                     `kind: sveElemReg, base: Z0, mul: 2`,
+	"encodeZd15V2":                     `kind: sveElemReg, base: Z0, mul: 2, add: 1`,
+	"encodeZda15V1":                    `kind: sveElemReg, base: Z0, mul: 2`,
+	"encodeZda15V2":                    `kind: sveElemReg, base: Z0, mul: 2, add: 1`,
+	"encodeZdn15V1":                    `kind: sveElemReg, base: Z0, mul: 2`,
+	"encodeZdn15V2":                    `kind: sveElemReg, base: Z0, mul: 2, add: 1`,
+	"encodeZn610V1":                    `kind: sveElemReg, base: Z0, mul: 2`,
+	"encodeZn610V2":                    `kind: sveElemReg, base: Z0, mul: 2, add: 1`,
+	"encodeZt15V1":                     `kind: sveElemReg, base: Z0, mul: 2`,
+	"encodeZt15V2":                     `kind: sveElemReg, base: Z0, mul: 2, add: 1`,
+	"encodeZdn25V1":                    `kind: sveElemReg, base: Z0, mul: 4`,
+	"encodeZdn25V2":                    `kind: sveElemReg, base: Z0, mul: 4, add: 3`,
+	"encodeZt25V1":                     `kind: sveElemReg, base: Z0, mul: 4`,
+	"encodeZt25V2":                     `kind: sveElemReg, base: Z0, mul: 4, add: 3`,
+
+	// Scalable predicate registers.
+	"encodePd":        `kind: sveElemReg, base: P0`,
+	"encodePd04":      `kind: sveElemReg, base: P0`,
+	"encodePd04Plus1": `kind: sveElemReg, base: P0, add: 1`,
+	"encodePd14":      `kind: sveElemReg, base: P0, mul: 2`,
+	"encodePd14Plus1": `kind: sveElemReg, base: P0, mul: 2, add: 1`,
+	"encodePdmDest":   `kind: sveElemReg, base: P0`,
+	"encodePdnDest":   `kind: sveElemReg, base: P0`,
+	"encodePdnSrcDst": `kind: sveElemReg, base: P0`,
+	"encodePg1013":    `kind: sveElemReg, base: P0`,
+	"encodePg1014":    `kind: sveElemReg, base: P0`,
+	"encodePg1620":    `kind: sveElemReg, base: P0`,
+	"encodePg59":      `kind: sveElemReg, base: P0`,
+	"encodePm1620":    `kind: sveElemReg, base: P0`,
+	"encodePm59V1":    `kind: sveElemReg, base: P0`,
+	"encodePm59V2":    `kind: sveElemReg, base: P0`,
+	"encodePn1014":    `kind: sveElemReg, base: P0`,
+	"encodePn59":      `kind: sveElemReg, base: P0`,
+	"encodePn59V2":    `kind: sveElemReg, base: P0`,
+	"encodePt04V1":    `kind: sveElemReg, base: P0`,
+	"encodePt04V2":    `kind: sveElemReg, base: P0`,
+	"encodePv1013":    `kind: sveElemReg, base: P0`,
+	"encodePv1014":    `kind: sveElemReg, base: P0`,
+	"encodePv59":      `kind: sveElemReg, base: P0`,
+	"encodePNn59":     `kind: sveElemReg, base: PN0`,
+	"encodePNd":       `kind: sveElemReg, base: PN0, add: 8`,
+	"encodePNg1013":   `kind: sveElemReg, base: PN0, add: 8`,
+	"encodePnN_58":    `kind: sveElemReg, base: PN0, add: 8`,
+
+	// General-purpose registers. The registers given by number
+	// take their width from the width specifier of the operand.
+	"encodeRd05":             `kind: sveElemReg, base: X0`,
+	"encodeRd05_SPAllowed":   `kind: sveElemReg, base: X0, sp: true`,
+	"encodeRm1621V1":         `kind: sveElemReg, base: X0`,
+	"encodeRm1621V2":         `kind: sveElemReg, base: X0`,
+	"encodeRm1621XZR":        `kind: sveElemReg, base: X0`,
+	"encodeRn1621_SPAllowed": `kind: sveElemReg, base: X0, sp: true`,
+	"encodeRn510":            `kind: sveElemReg, base: X0`,
+	"encodeRn510SPV2":        `kind: sveElemReg, base: X0, sp: true`,
+	"encodeXdn05":            `kind: sveElemReg, base: X0`,
+	"encodeWdn05":            `kind: sveElemReg, base: W0`,
+	"encodeRv1618":           `kind: sveElemReg, base: W0, add: 12`,
+	"encodeRd05ZR":           `kind: sveElemRegNum`,
+	"encodeRdn05ZR":          `kind: sveElemRegNum`,
+	"encodeRm1621ZR":         `kind: sveElemRegNum`,
+	"encodeRm510ZR":          `kind: sveElemRegNum`,
+	"encodeRn510SPV1":        `kind: sveElemRegNum, sp: true`,
+	"encodeRn510ZR":          `kind: sveElemRegNum`,
+
+	// SIMD&FP registers. The scalar registers take their width
+	// from the width specifier of the operand.
+	"encodeVd":     `kind: sveElemReg, base: V0`,
+	"encodeVd0564": `kind: sveElemReg, base: D0`,
+	"encodeVd05":   `kind: sveElemReg, base: V0`,
+	"encodeVdn05":  `kind: sveElemReg, base: V0`,
+	"encodeVm510":  `kind: sveElemReg, base: V0`,
+	"encodeVn510":  `kind: sveElemReg, base: V0`,
+
+	// Element and portion indices.
+	"encodeI12324":                    `kind: sveElemUint`,
+	"encodeI12324B":                   `kind: sveElemUint`,
+	"encodeI189":                      `kind: sveElemUint`,
+	"encodeI1_1718_Halfword":          `kind: sveElemUint`,
+	"encodeI1_2021_16To64Bit":         `kind: sveElemUint`,
+	"encodeI1_2021_32bit":             `kind: sveElemUint`,
+	"encodeI1_2021_64Bit":             `kind: sveElemUint`,
+	"encodeI1_2021_DoublePrecision":   `kind: sveElemUint`,
+	"encodeI1_2021_Single":            `kind: sveElemUint`,
+	"encodeI21921":                    `kind: sveElemUint`,
+	"encodeI22224":                    `kind: sveElemUint`,
+	"encodeI22224HW":                  `kind: sveElemUint`,
+	"encodeI2_1719_Word":              `kind: sveElemUint`,
+	"encodeI2_1921_16To32Bit":         `kind: sveElemUint`,
+	"encodeI2_1921_16bit":             `kind: sveElemUint`,
+	"encodeI2_1921_32Bit":             `kind: sveElemUint`,
+	"encodeI2_1921_8BitGroup":         `kind: sveElemUint`,
+	"encodeI2_1921_8To32Bit":          `kind: sveElemUint`,
+	"encodeI2_1921_Half":              `kind: sveElemUint`,
+	"encodeI2_1921_Pair16Bit":         `kind: sveElemUint`,
+	"encodeI2_1921_SinglePrecision":   `kind: sveElemUint`,
+	"encodeI2hI2l_1120_64Bit":         `kind: sveElemUint`,
+	"encodeI3224I31213":               `kind: sveElemUint`,
+	"encodeI3hI3l_1119":               `kind: sveElemUint`,
+	"encodeI3hI3l_1119_32Bit":         `kind: sveElemUint`,
+	"encodeI3hI3l_1119_Pair8Bit":      `kind: sveElemUint`,
+	"encodeI3hI3l_1722_Doubleword":    `kind: sveElemUint`,
+	"encodeI3hI3l_1922":               `kind: sveElemUint`,
+	"encodeI3hI3l_1923_16Bit":         `kind: sveElemUint`,
+	"encodeI3hI3l_1923_8To16Bit":      `kind: sveElemUint`,
+	"encodeI3hI3l_1923_HalfPrecision": `kind: sveElemUint`,
+	"encodeI4hI4l_1019":               `kind: sveElemUint`,
+	"encodeImm2_810":                  `kind: sveElemUint`,
+	"encodeI1Tsz_Delegate":            `kind: sveElemTszIndex`,
+	"encodeImm2Tsz_Delegate":          `kind: sveElemTszIndex`,
+	"encodeI1TszhTszl1824":            `kind: sveElemTszIndex`,
+
+	// Immediates.
+	"encodeImm3Unsigned_1619":    `kind: sveElemUint`,
+	"encodeImm4Unsigned_1620":    `kind: sveElemUint`,
+	"encodeImm7Unsigned_1421":    `kind: sveElemUint`,
+	"encodeImm8Unsigned_513":     `kind: sveElemUint`,
+	"encodeImm8hImm8l_Unsigned":  `kind: sveElemUint`,
+	"encodeImm5Signed510Unique":  `kind: sveElemSint`,
+	"encodeImm5Signed_1621V1":    `kind: sveElemSint`,
+	"encodeImm5Signed_1621V2":    `kind: sveElemSint`,
+	"encodeImm5Signed_510":       `kind: sveElemSint`,
+	"encodeImm5bSigned_1621":     `kind: sveElemSint`,
+	"encodeImm6Signed_511":       `kind: sveElemSint`,
+	"encodeImm8Signed_513":       `kind: sveElemSint`,
+	"encodeImm8SignedLsl8":       `kind: sveElemSimm8Shift`,
+	"encodeImm8UnsignedLsl8":     `kind: sveElemImm8Shift`,
+	"encodeImm8_513_Fimm":        `kind: sveElemFPImm8`,
+	"encodeImm13_518":            `kind: sveElemLogicalImm`,
+	"encodeSizeImm13NoOp":        `kind: sveElemLogicalImmArng`,
+	"encodeImm31619":             `kind: sveElemUint, mul: -1, add: 8`,
+	"encodeImm41620V7":           `kind: sveElemUint, mul: -1, add: 16`,
+	"encodeShiftTsz1619Range0V1": `kind: sveElemTszShiftLeft, f: [3]sveField{{22, 23}, {19, 21}, {16, 19}}`,
+	"encodeShiftTsz1619Range0V2": `kind: sveElemTszShiftLeft, f: [3]sveField{{22, 24}, {19, 21}, {16, 19}}`,
+	"encodeShiftTsz58Range0":     `kind: sveElemTszShiftLeft, f: [3]sveField{{22, 24}, {8, 10}, {5, 8}}`,
+	"encodeShiftTsz1619Range1V1": `kind: sveElemTszShiftRight, f: [3]sveField{{22, 23}, {19, 21}, {16, 19}}`,
+	"encodeShiftTsz1619Range1V2": `kind: sveElemTszShiftRight, f: [3]sveField{{22, 24}, {19, 21}, {16, 19}}`,
+	"encodeShiftTsz58Range1":     `kind: sveElemTszShiftRight, f: [3]sveField{{22, 24}, {8, 10}, {5, 8}}`,
+	"encodeImm3Tsize1621Stub":    `kind: sveElemTszShiftRight, f: [3]sveField{{19, 21}, {16, 19}}`,
+	"encodePrfop04":              `kind: sveElemPrfop`,
+
+	// Memory offsets.
+	"encodeImm41620V1":     `kind: sveElemSint, mul: 16`,
+	"encodeImm41620V2":     `kind: sveElemSint, mul: 32`,
+	"encodeImm41620V3":     `kind: sveElemSint, mul: 3`,
+	"encodeImm41620V4":     `kind: sveElemSint, mul: 4`,
+	"encodeImm41620V5":     `kind: sveElemSint`,
+	"encodeImm41620V6":     `kind: sveElemSint, mul: 2`,
+	"encodeImm41620V8":     `kind: sveElemSint, mul: 4`,
+	"encodeImm41620V9":     `kind: sveElemSint, mul: 2`,
+	"encodeImm61622V5":     `kind: sveElemSint`,
+	"encodeImm9h1622L1013": `kind: sveElemSint`,
+	"encodeImm51621V1":     `kind: sveElemUint, mul: 2`,
+	"encodeImm51621V2":     `kind: sveElemUint, mul: 4`,
+	"encodeImm51621V3":     `kind: sveElemUint, mul: 8`,
+	"encodeImm51621V4":     `kind: sveElemUint`,
+	"encodeImm61622V1":     `kind: sveElemUint, mul: 2`,
+	"encodeImm61622V2":     `kind: sveElemUint, mul: 4`,
+	"encodeImm61622V3":     `kind: sveElemUint, mul: 8`,
+	"encodeImm61622V4":     `kind: sveElemUint`,
+}
+
+// decoderClasses maps the operand types to the arm64asm operand classes.
+var decoderClasses = map[string]string{
+	"AC_ARNG":          "sveClassArng",
+	"AC_ARNGIDX":       "sveClassIndex",
+	"AC_IMM":           "sveClassImm",
+	"AC_MEMEXT":        "sveClassMemExt",
+	"AC_MEMOFF":        "sveClassMemOff",
+	"AC_MEMOFFMULVL":   "sveClassMemOffVL",
+	"AC_PREG":          "sveClassReg",
+	"AC_PREGIDX":       "sveClassIndex",
+	"AC_PREGZM":        "sveClassPred",
+	"AC_REGLIST1":      "sveClassList",
+	"AC_REGLIST2":      "sveClassList",
+	"AC_REGLIST3":      "sveClassList",
+	"AC_REGLIST4":      "sveClassList",
+	"AC_REGLIST_RANGE": "sveClassRange",
+	"AC_SPECIAL":       "sveClassSpecial",
+	"AC_SPZGREG":       "sveClassScalar",
+	"AC_VREG":          "sveClassScalar",
+	"AC_ZREG":          "sveClassReg",
+	"AC_ZREGIDX":       "sveClassIndex",
+}
+
+// A decoderRow is an instruction encoding to be decoded by arm64asm.
+type decoderRow struct {
+	Op     string // the ARM mnemonic
+	GoOp   string // the Go opcode
+	Binary uint32
+	Args   []decoderArg // in ARM order
+}
+
+// A decoderArg is an operand of a decoderRow.
+type decoderArg struct {
+	Asm   string
+	Class string
+	Elems []decoderElem
+}
+
+// A decoderElem is an element of a decoderArg.
+// Name is the name of its encoding function, and Desc the description
+// of the element. Width elements of instructions that are split by
+// register width have no Name and the width in Width instead.
+type decoderElem struct {
+	Name  string
+	Desc  string
+	Width string
+}
+
+type decoderTmplData struct {
+	Formats  []decoderFormat
+	Operands []decoderVar
+	Elems    []decoderVar
+}
+
+type decoderFormat struct {
+	Asm   string
+	Mask  uint32
+	Value uint32
+	Op    string
+	GoOp  string
+	Args  []string
+}
+
+type decoderVar struct {
+	Name  string
+	Value string
+}
+
+const decoderTmpl = `// Code generated by 'instgen -d=.'. DO NOT EDIT.
+
+// The following tables are generated from the XML specification.
+
+package arm64asm
+
+// sveFormats are the formats of the SVE instructions,
+// ordered by the number of fixed bits.
+var sveFormats = [...]sveFormat{
+{{- range .Formats}}
+	// {{.Asm}}
+	{ {{- printf "0x%08x" .Mask}}, {{printf "0x%08x" .Value}}, {{.Op}}, "{{.GoOp}}", [5]*sveOperand{ {{- range $i, $a := .Args}}{{if $i}}, {{end}}{{$a}}{{end}}}},
+{{- end}}
+}
+
+var (
+{{- range .Operands}}
+	{{.Name}} = {{.Value}}
+{{- end}}
+)
+
+var (
+{{- range .Elems}}
+	{{.Name}} = {{.Value}}
+{{- end}}
+)
+`
+
+// GenerateDecoder generates the SVE decoder tables sve_tables.go
+// to the arm64asm directory dir.
+func GenerateDecoder(insts []*xmlspec.InstructionParsed, dir string) {
+	for k, v := range encodingImpls {
+		encodingImpls[strings.Join(strings.Fields(k), " ")] = v
+	}
+	var rows []decoderRow
+	for _, inst := range insts {
+		if inst == nil {
+			continue
+		}
+		isAlias := false
+		for _, doc := range inst.DocVars {
+			if doc.Key == "alias_mnemonic" {
+				isAlias = true
+				break
+			}
+		}
+		if isAlias {
+			continue
+		}
+		for _, iclass := range inst.Classes.Iclass {
+			if !iclass.RegDiagram.Parsed {
+				continue
+			}
+			for _, raw := range iclass.Encodings {
+				if !raw.Parsed || raw.Alias {
+					continue
+				}
+				// The width specifiers are dropped when the encoding is split,
+				// the decoder needs them to tell the split encodings apart.
+				// Splitting modifies the operands in place, work on a copy.
+				raw.Operands = slices.Clone(raw.Operands)
+				var widthDesc string
+				for i, op := range raw.Operands {
+					raw.Operands[i].Elems = slices.Clone(op.Elems)
+					if widthDesc == "" && i > 0 && (op.Typ == "AC_SPZGREG" || op.Typ == "AC_VREG") {
+						widthDesc = op.Elems[0].TextExpWithRanges
+					}
+				}
+				encodings := xmlspec.SplitInstByRegWidth(raw)
+				for _, enc := range encodings {
+					row, ok := decoderRowOf(enc)
+					if !ok {
+						continue
+					}
+					if suffix := strings.TrimPrefix(enc.GoOp, raw.GoOp); len(encodings) > 1 || suffix != "" {
+						if suffix == "" {
+							suffix = "X"
+						}
+						for _, arg := range row.Args {
+							if arg.Class == "AC_SPZGREG" || arg.Class == "AC_VREG" {
+								arg.Elems[0] = decoderElem{Desc: widthDesc, Width: suffix}
+							}
+						}
+					}
+					rows = append(rows, row)
+				}
+			}
+		}
+	}
+	writeDecoder(rows, dir)
+}
+
+// decoderRowOf returns the decoderRow of the encoding enc.
+// It reports false if arm64asm cannot decode enc.
+func decoderRowOf(enc xmlspec.EncodingParsed) (decoderRow, bool) {
+	row := decoderRow{
+		Op:     enc.Operands[0].Name,
+		GoOp:   enc.GoOp,
+		Binary: enc.Binary,
+	}
+	for _, op := range enc.Operands[1:] {
+		if _, ok := decoderClasses[op.Typ]; !ok {
+			return decoderRow{}, false
+		}
+		arg := decoderArg{Asm: op.Name, Class: op.Typ}
+		for _, elm := range op.Elems {
+			desc := strings.Join(strings.Fields(elm.TextExpWithRanges), " ")
+			impl, ok := encodingImpls[desc]
+			if !ok {
+				log.Printf("Warning: encoding func not found for %s", elm.TextExpWithRanges)
+				return decoderRow{}, false
+			}
+			arg.Elems = append(arg.Elems, decoderElem{Name: impl.name, Desc: elm.TextExpWithRanges})
+		}
+		row.Args = append(row.Args, arg)
+	}
+	// The operands are in Go order, see sortOperands.
+	if !strings.HasPrefix(row.Op, "ST") {
+		slices.Reverse(row.Args)
+	}
+	return row, true
+}
+
+// writeDecoder writes the decoder tables of rows to dir/sve_tables.go.
+func writeDecoder(rows []decoderRow, dir string) {
+	if dir == "" {
+		return
+	}
+	known := readArm64asmOps(filepath.Join(dir, "tables.go"))
+	var data decoderTmplData
+	operands := make(map[string]bool)
+	elems := make(map[string]bool)
+	missing := make(map[string]bool)
+	for _, row := range rows {
+		if !known[row.Op] && !missing[row.Op] {
+			missing[row.Op] = true
+			log.Printf("Warning: op %s is missing from %s/tables.go", row.Op, dir)
+		}
+		f := decoderFormat{
+			Op:   row.Op,
+			GoOp: strings.TrimPrefix(row.GoOp, "A"),
+		}
+		var asms []string
+		var fields uint32
+		for _, arg := range row.Args {
+			asms = append(asms, arg.Asm)
+			name := "sveArg_" + strings.TrimPrefix(arg.Class, "AC_")
+			var elemNames []string
+			for _, elm := range arg.Elems {
+				elemName, value, mask, err := decoderElemOf(elm)
+				if err != nil {
+					log.Fatalf("%s %s: %v", row.Op, arg.Asm, err)
+				}
+				fields |= mask
+				name += strings.TrimPrefix(elemName, "sveElem")
+				elemNames = append(elemNames, elemName)
+				if !elems[elemName] {
+					elems[elemName] = true
+					data.Elems = append(data.Elems, decoderVar{elemName, value})
+				}
+			}
+			if !operands[name] {
+				operands[name] = true
+				value := fmt.Sprintf("&sveOperand{%s, []*sveElem{%s}}", decoderClasses[arg.Class], strings.Join(elemNames, ", "))
+				data.Operands = append(data.Operands, decoderVar{name, value})
+			}
+			f.Args = append(f.Args, name)
+		}
+		f.Asm = row.Op
+		if len(asms) > 0 {
+			f.Asm += " " + strings.Join(asms, ", ")
+		}
+		f.Mask = ^fields
+		f.Value = row.Binary & f.Mask
+		data.Formats = append(data.Formats, f)
+	}
+	// The formats are tried in order, the first one that matches
+	// and whose elements can be decoded is used.
+	slices.SortStableFunc(data.Formats, func(a, b decoderFormat) int {
+		if c := bits.OnesCount32(b.Mask) - bits.OnesCount32(a.Mask); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Op, b.Op); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.GoOp, b.GoOp); c != 0 {
+			return c
+		}
+		return int(a.Value>>1) - int(b.Value>>1)
+	})
+	slices.SortFunc(data.Operands, func(a, b decoderVar) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(data.Elems, func(a, b decoderVar) int { return strings.Compare(a.Name, b.Name) })
+	formatAndFlush(dir, "sve_tables.go", data, decoderTmpl)
+}
+
+// readArm64asmOps returns the ops defined in the arm64asm tables.go file.
+func readArm64asmOps(path string) map[string]bool {
+	ops := make(map[string]bool)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("Warning: %v", err)
+		return ops
+	}
+	re := regexp.MustCompile(`(?m)^\t([A-Z0-9_]+):\s+"`)
+	for _, m := range re.FindAllStringSubmatch(string(content), -1) {
+		ops[m[1]] = true
+	}
+	return ops
+}
+
+var reBitRange = regexp.MustCompile(`(?m)^\s*[\w\[\]]+: \[(\d+):(\d+)\)\s*$`)
+
+// decoderElemOf returns the name and the sveElem literal of the element elm,
+// and the mask of the instruction bits that encode it.
+func decoderElemOf(elm decoderElem) (name, value string, mask uint32, err error) {
+	var fields [][2]int
+	for _, m := range reBitRange.FindAllStringSubmatch(elm.Desc, -1) {
+		lo, _ := strconv.Atoi(m[1])
+		hi, _ := strconv.Atoi(m[2])
+		fields = append(fields, [2]int{lo, hi})
+	}
+	var spec string
+	if elm.Width != "" {
+		name = "sveElem_Width" + elm.Width
+		spec, err = decoderWidth(elm.Desc, fields, elm.Width)
+	} else {
+		name = "sveElem_" + strings.TrimPrefix(elm.Name, "encode")
+		var ok bool
+		if spec, ok = decodingImpls[elm.Name]; !ok {
+			spec, err = decoderTable(elm.Desc, fields)
+		}
+	}
+	if err != nil {
+		return "", "", 0, err
+	}
+	if i := strings.Index(spec, "f: "); i >= 0 {
+		// The fields are given explicitly.
+		fields = nil
+		for _, m := range reField.FindAllStringSubmatch(spec[i:strings.Index(spec[i:], "}}")+i+1], -1) {
+			lo, _ := strconv.Atoi(m[1])
+			hi, _ := strconv.Atoi(m[2])
+			fields = append(fields, [2]int{lo, hi})
+		}
+	} else if len(fields) > 0 {
+		kind, rest, _ := strings.Cut(spec, ", ")
+		spec = kind + ", " + formatFields(fields)
+		if rest != "" {
+			spec += ", " + rest
+		}
+	}
+	for _, f := range fields {
+		if elm.Width != "" {
+			name += fmt.Sprintf("_%d_%d", f[0], f[1])
+		}
+		mask |= 1<<f[1] - 1<<f[0]
+	}
+	return name, "&sveElem{" + spec + "}", mask, nil
+}
+
+var reField = regexp.MustCompile(`\{(\d+), (\d+)\}`)
+
+// formatFields returns the field f of an sveElem literal with the bit ranges fields.
+func formatFields(fields [][2]int) string {
+	var s []string
+	for _, f := range fields {
+		s = append(s, fmt.Sprintf("{%d, %d}", f[0], f[1]))
+	}
+	return "f: [3]sveField{" + strings.Join(s, ", ") + "}"
+}
+
+// A decoderTableRow is a row of the table of values in an element description,
+// like "01	H" or "0	1x	S".
+type decoderTableRow struct {
+	pattern string
+	value   string
+}
+
+// parseDecoderTable returns the table of values in the element description desc.
+func parseDecoderTable(desc string) []decoderTableRow {
+	var rows []decoderTableRow
+	for _, line := range strings.Split(desc, "\n") {
+		fs := strings.Fields(line)
+		k := 0
+		for k < len(fs) && strings.Trim(fs[k], "01x") == "" {
+			k++
+		}
+		if k == 0 || k == len(fs) {
+			continue
+		}
+		rows = append(rows, decoderTableRow{strings.Join(fs[:k], ""), strings.Join(fs[k:], " ")})
+	}
+	return rows
+}
+
+// expandPattern calls f for each value matching the bit pattern p, like "1x".
+func expandPattern(p string, f func(v int)) {
+	i := strings.IndexByte(p, 'x')
+	if i < 0 {
+		v, _ := strconv.ParseUint(p, 2, 32)
+		f(int(v))
+		return
+	}
+	expandPattern(p[:i]+"0"+p[i+1:], f)
+	expandPattern(p[:i]+"1"+p[i+1:], f)
+}
+
+var decoderArrangements = map[string]string{
+	"B":   "ArrangementB",
+	"H":   "ArrangementH",
+	"S":   "ArrangementS",
+	"D":   "ArrangementD",
+	"Q":   "ArrangementQ",
+	"W":   "ArrangementS",
+	"X":   "ArrangementD",
+	"16B": "Arrangement16B",
+	"8H":  "Arrangement8H",
+	"4S":  "Arrangement4S",
+	"2D":  "Arrangement2D",
+}
+
+var decoderValues = map[string]string{
+	"[absent]": "0",
+	"LSL":      "sveModLSL",
+	"SXTW":     "sveModSXTW",
+	"UXTW":     "sveModUXTW",
+	"Z":        "int16(PredicateZeroing)",
+	"M":        "int16(PredicateMerging)",
+	"VLx2":     "2",
+	"VLx4":     "4",
+}
+
+// decoderTable returns the sveElem literal of an element that is decoded by
+// the table of values in its description desc.
+func decoderTable(desc string, fields [][2]int) (string, error) {
+	rows := parseDecoderTable(desc)
+	if len(rows) == 0 {
+		return "", fmt.Errorf("no decoder for %q", desc)
+	}
+	n := 0
+	for _, f := range fields {
+		n += f[1] - f[0]
+	}
+	values := make([]string, 1<<n)
+	kind := "arng"
+	for _, row := range rows {
+		if row.value == "RESERVED" {
+			continue
+		}
+		v := row.value
+		switch {
+		case decoderArrangements[v] != "":
+			v = decoderArrangements[v]
+		case decoderValues[v] != "":
+			v, kind = decoderValues[v], "tab"
+		case strings.HasPrefix(v, "#") && strings.Contains(v, "."):
+			v, kind = v[1:], "fp"
+		case strings.HasPrefix(v, "#"):
+			if _, err := strconv.Atoi(v[1:]); err != nil {
+				return "", fmt.Errorf("unknown value %s in %q", v, desc)
+			}
+			v, kind = v[1:], "tab"
+		default:
+			return "", fmt.Errorf("unknown value %s in %q", v, desc)
+		}
+		expandPattern(row.pattern, func(i int) { values[i] = v })
+	}
+	for i, v := range values {
+		if v == "" {
+			switch kind {
+			case "arng":
+				values[i] = "0"
+			case "tab":
+				values[i] = "sveReserved"
+			default:
+				return "", fmt.Errorf("incomplete table in %q", desc)
+			}
+		}
+	}
+	switch kind {
+	case "arng":
+		return "kind: sveElemArng, arng: []Arrangement{" + strings.Join(values, ", ") + "}", nil
+	case "tab":
+		return "kind: sveElemTable, tab: []int16{" + strings.Join(values, ", ") + "}", nil
+	}
+	return "kind: sveElemFP, fp: []float64{" + strings.Join(values, ", ") + "}", nil
+}
+
+// decoderWidth returns the sveElem literal of the width specifier with
+// description desc in an encoding that is split by register width,
+// restricted to the width of the split encoding.
+func decoderWidth(desc string, fields [][2]int, width string) (string, error) {
+	rows := parseDecoderTable(desc)
+	if len(rows) == 0 {
+		// A check of a fixed width.
+		return "kind: sveElemArng, arng: []Arrangement{" + decoderArrangements[width] + "}", nil
+	}
+	var kept []decoderTableRow
+	for _, row := range rows {
+		if row.value == width {
+			kept = append(kept, row)
+		}
+	}
+	if len(kept) == 0 {
+		return "", fmt.Errorf("no width %s in %q", width, desc)
+	}
+	var lines []string
+	for _, row := range kept {
+		lines = append(lines, row.pattern+"\t"+row.value)
+	}
+	return decoderTable(strings.Join(lines, "\n"), fields)
+}
@@ -5,7 +5,7 @@
 // instgen is used to generate the ARM64 instruction table for encoding and decoding.
 // Usage:
 //
-//	instgen [-i=inputDir] [-o=outputDir] [-url=url] [-version=version]
+//	instgen [-i=inputDir] [-o=outputDir] [-d=arm64asmDir] [-url=url] [-version=version]
 //
 // Users can download the ARM64 XML instruction manual from
 // https://developer.arm.com/downloads/-/exploration-tools,
@@ -27,6 +27,11 @@
 //
 // If -o option is not specified, no output files will be generated.
 //
+// With the -d option, the program also generates the SVE decoder tables
+// sve_tables.go of golang.org/x/arch/arm64/arm64asm to the given directory,
+// from the same encodings as the assembler's instruction table. The ops of
+// the decoded instructions must be listed in the tables.go file of that directory.
+//
 // Since the format of the ARM64 instruction specification document may update,
 // this parser may not work for some versions of the XML document.
 // Due to differences in documents between different versions, the generated instruction
@@ -51,6 +56,7 @@ import (
 var input = flag.String("i", "", "the input directory of the xml files, this is an optional argument")
 var output = flag.String("o", "", "the output directory of the generated files, this is an optional argument")
 var genE2E = flag.Bool("e2e", false, "generate end-to-end test data")
+var decoderDir = flag.String("d", "", "the arm64asm directory of the generated decoder tables, this is an optional argument")
 
 var url = flag.String("url", xmlspec.ExpectedURL, "the url of the xml files")
 var version = flag.String("version", xmlspec.ExpectedVersion, "the version of the xml files")
@@ -83,6 +89,9 @@ func main() {
 	if *output != "" {
 		Generate(insts, *output, *genE2E)
 	}
+	if *decoderDir != "" {
+		GenerateDecoder(insts, *decoderDir)
+	}
 	errCnt := 0
 	for _, inst := range insts {
 		if inst == nil {
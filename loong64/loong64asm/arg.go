@@ -20,6 +20,22 @@ package loong64asm
 //
 // - arg_rk: a general-purpose register rk encoded in the rk[14:10] field
 //
+// - arg_vd: a 128-bit LSX vector register vd encoded in the vd[4:0] field
+//
+// - arg_vj: a 128-bit LSX vector register vj encoded in the vj[9:5] field
+//
+// - arg_vk: a 128-bit LSX vector register vk encoded in the vk[14:10] field
+//
+// - arg_va: a 128-bit LSX vector register va encoded in the va[19:15] field
+//
+// - arg_xd: a 256-bit LASX vector register xd encoded in the xd[4:0] field
+//
+// - arg_xj: a 256-bit LASX vector register xj encoded in the xj[9:5] field
+//
+// - arg_xk: a 256-bit LASX vector register xk encoded in the xk[14:10] field
+//
+// - arg_xa: a 256-bit LASX vector register xa encoded in the xa[19:15] field
+//
 // - arg_fcsr_4_0: float control status register encoded in [4:0] field
 //
 // - arg_cd_2_0: condition flag register encoded in [2:0] field
@@ -30,6 +46,10 @@ package loong64asm
 //
 // - arg_ui5_14_10: 5bits unsigned immediate
 //
+// - arg_ui1_10_10, arg_ui2_11_10, arg_ui3_12_10, arg_ui4_13_10, arg_ui8_17_10:
+//		unsigned immediates used by vector instructions, either as a shift amount,
+//		a bit mask or as the index of a vector element
+//
 // - arg_lsbw: For details, please refer to chapter 2.2.3.8 of instruction manual
 //
 // - arg_msbw: For details, please refer to chapter 2.2.3.9 of instruction manual
@@ -40,6 +60,17 @@ package loong64asm
 //		other: no define
 //
 // - arg_si12_21_10: 12bits signed immediate
+//
+// - arg_si5_14_10: 5bits signed immediate used by vector compare instructions
+//
+// - arg_si9_18_10, arg_si10_19_10, arg_si11_20_10: signed offsets of
+//		[X]VLDREPL.{D,W,H}, scaled by the size of the loaded element
+//
+// - arg_sd, arg_sj: a binary translation scratch register encoded in the
+//		sd[1:0] or sj[6:5] field
+//
+// - arg_ui4_3_0, arg_ui3_7_5, arg_ui5_9_5: unsigned immediates used by the
+//		binary translation instructions, such as the ARM condition in [3:0]
 
 type instArg uint16
 
@@ -87,7 +118,33 @@ const (
 	arg_si16_25_10
 	arg_si20_24_5
 	arg_offset_20_0
-	// 36~
+	// 36-40
 	arg_offset_25_0
 	arg_offset_15_0
+	arg_vd
+	arg_vj
+	arg_vk
+	// 41-45
+	arg_va
+	arg_xd
+	arg_xj
+	arg_xk
+	arg_xa
+	// 46-50
+	arg_ui1_10_10
+	arg_ui2_11_10
+	arg_ui3_12_10
+	arg_ui4_13_10
+	arg_ui8_17_10
+	// 51~
+	arg_si5_14_10
+	arg_si9_18_10
+	arg_si10_19_10
+	arg_si11_20_10
+	arg_sd
+	// 56~
+	arg_sj
+	arg_ui4_3_0
+	arg_ui3_7_5
+	arg_ui5_9_5
 )
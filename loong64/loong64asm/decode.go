@@ -103,6 +103,30 @@ func decodeArg(aop instArg, x uint32, index int) Arg {
 	case arg_rk:
 		return R0 + Reg((x>>10)&((1<<5)-1))
 
+	case arg_vd:
+		return V0 + Reg(x&((1<<5)-1))
+
+	case arg_vj:
+		return V0 + Reg((x>>5)&((1<<5)-1))
+
+	case arg_vk:
+		return V0 + Reg((x>>10)&((1<<5)-1))
+
+	case arg_va:
+		return V0 + Reg((x>>15)&((1<<5)-1))
+
+	case arg_xd:
+		return X0 + Reg(x&((1<<5)-1))
+
+	case arg_xj:
+		return X0 + Reg((x>>5)&((1<<5)-1))
+
+	case arg_xk:
+		return X0 + Reg((x>>10)&((1<<5)-1))
+
+	case arg_xa:
+		return X0 + Reg((x>>15)&((1<<5)-1))
+
 	case arg_fcsr_4_0:
 		return FCSR0 + Fcsr(x&((1<<5)-1))
 
@@ -118,12 +142,22 @@ func decodeArg(aop instArg, x uint32, index int) Arg {
 	case arg_ca:
 		return FCC0 + Fcc((x>>15)&((1<<3)-1))
 
+	case arg_sd:
+		return SCR0 + Scr(x&((1<<2)-1))
+
+	case arg_sj:
+		return SCR0 + Scr((x>>5)&((1<<2)-1))
+
 	case arg_op_4_0:
 		tmp := x & ((1 << 5) - 1)
 		return Uimm{tmp, false}
 
 	case arg_csr_23_10:
+		f := &instFormats[index]
 		tmp := (x >> 10) & ((1 << 14) - 1)
+		if (f.op == GCSRRD) || (f.op == GCSRWR) || (f.op == GCSRXCHG) {
+			return Csr(tmp)
+		}
 		return Uimm{tmp, false}
 
 	case arg_sa2_16_15:
@@ -144,6 +178,34 @@ func decodeArg(aop instArg, x uint32, index int) Arg {
 	case arg_code_14_0:
 		return CodeSimm(x & ((1 << 15) - 1))
 
+	case arg_ui4_3_0:
+		tmp := x & ((1 << 4) - 1)
+		return Uimm{tmp, false}
+
+	case arg_ui3_7_5:
+		tmp := (x >> 5) & ((1 << 3) - 1)
+		return Uimm{tmp, false}
+
+	case arg_ui5_9_5:
+		tmp := (x >> 5) & ((1 << 5) - 1)
+		return Uimm{tmp, false}
+
+	case arg_ui1_10_10:
+		tmp := (x >> 10) & 1
+		return Uimm{tmp, false}
+
+	case arg_ui2_11_10:
+		tmp := (x >> 10) & ((1 << 2) - 1)
+		return Uimm{tmp, false}
+
+	case arg_ui3_12_10:
+		tmp := (x >> 10) & ((1 << 3) - 1)
+		return Uimm{tmp, false}
+
+	case arg_ui4_13_10:
+		tmp := (x >> 10) & ((1 << 4) - 1)
+		return Uimm{tmp, false}
+
 	case arg_ui5_14_10:
 		tmp := (x >> 10) & ((1 << 5) - 1)
 		return Uimm{tmp, false}
@@ -152,6 +214,10 @@ func decodeArg(aop instArg, x uint32, index int) Arg {
 		tmp := (x >> 10) & ((1 << 6) - 1)
 		return Uimm{tmp, false}
 
+	case arg_ui8_17_10:
+		tmp := (x >> 10) & ((1 << 8) - 1)
+		return Uimm{tmp, false}
+
 	case arg_ui12_21_10:
 		tmp := ((x >> 10) & ((1 << 12) - 1) & 0xfff)
 		return Uimm{tmp, false}
@@ -192,6 +258,44 @@ func decodeArg(aop instArg, x uint32, index int) Arg {
 		tmp := (x >> 10) & ((1 << 8) - 1)
 		return Uimm{tmp, false}
 
+	case arg_si5_14_10:
+		var tmp int16
+
+		// sign-extend a 5-bit signed to 16-bit signed
+		if (x & 0x4000) == 0x4000 {
+			tmp = int16(((x >> 10) & ((1 << 5) - 1)) | 0xffe0)
+		} else {
+			tmp = int16(((x >> 10) & ((1 << 5) - 1)) | 0x0000)
+		}
+		return Simm16{tmp, 5}
+
+	case arg_si9_18_10:
+		var tmp int32
+		if (x & 0x40000) == 0x40000 {
+			tmp = int32((((x >> 10) & ((1 << 9) - 1)) << 3) | 0xfffff000)
+		} else {
+			tmp = int32((((x >> 10) & ((1 << 9) - 1)) << 3) | 0x00000000)
+		}
+		return Simm32{tmp, 9}
+
+	case arg_si10_19_10:
+		var tmp int32
+		if (x & 0x80000) == 0x80000 {
+			tmp = int32((((x >> 10) & ((1 << 10) - 1)) << 2) | 0xfffff000)
+		} else {
+			tmp = int32((((x >> 10) & ((1 << 10) - 1)) << 2) | 0x00000000)
+		}
+		return Simm32{tmp, 10}
+
+	case arg_si11_20_10:
+		var tmp int32
+		if (x & 0x100000) == 0x100000 {
+			tmp = int32((((x >> 10) & ((1 << 11) - 1)) << 1) | 0xfffff000)
+		} else {
+			tmp = int32((((x >> 10) & ((1 << 11) - 1)) << 1) | 0x00000000)
+		}
+		return Simm32{tmp, 11}
+
 	case arg_si12_21_10:
 		var tmp int16
 
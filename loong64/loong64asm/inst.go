@@ -189,6 +189,74 @@ const (
 	F29
 	F30
 	F31
+
+	// LSX vector register
+	V0
+	V1
+	V2
+	V3
+	V4
+	V5
+	V6
+	V7
+	V8
+	V9
+	V10
+	V11
+	V12
+	V13
+	V14
+	V15
+	V16
+	V17
+	V18
+	V19
+	V20
+	V21
+	V22
+	V23
+	V24
+	V25
+	V26
+	V27
+	V28
+	V29
+	V30
+	V31
+
+	// LASX vector register
+	X0
+	X1
+	X2
+	X3
+	X4
+	X5
+	X6
+	X7
+	X8
+	X9
+	X10
+	X11
+	X12
+	X13
+	X14
+	X15
+	X16
+	X17
+	X18
+	X19
+	X20
+	X21
+	X22
+	X23
+	X24
+	X25
+	X26
+	X27
+	X28
+	X29
+	X30
+	X31
 )
 
 func (r Reg) String() string {
@@ -229,6 +297,12 @@ func (r Reg) String() string {
 	case (r >= F24) && (r <= F31):
 		return fmt.Sprintf("$fs%d", int(r-F24))
 
+	case (r >= V0) && (r <= V31):
+		return fmt.Sprintf("$vr%d", int(r-V0))
+
+	case (r >= X0) && (r <= X31):
+		return fmt.Sprintf("$xr%d", int(r-X0))
+
 	default:
 		return fmt.Sprintf("Unknown(%d)", int(r))
 	}
@@ -266,6 +340,115 @@ func (f Fcc) String() string {
 	return fmt.Sprintf("$fcc%d", uint8(f))
 }
 
+// binary translation scratch register
+type Scr uint8
+
+const (
+	SCR0 Scr = iota
+	SCR1
+	SCR2
+	SCR3
+)
+
+func (s Scr) String() string {
+	return fmt.Sprintf("$scr%d", uint8(s))
+}
+
+// A Csr is a control and status register number, as accessed by the
+// GCSRRD, GCSRWR and GCSRXCHG instructions of the virtualization extension.
+type Csr uint16
+
+func (c Csr) String() string {
+	if name, ok := csrNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("%#x", uint16(c))
+}
+
+var csrNames = map[Csr]string{
+	0x0:   "CRMD",
+	0x1:   "PRMD",
+	0x2:   "EUEN",
+	0x3:   "MISC",
+	0x4:   "ECFG",
+	0x5:   "ESTAT",
+	0x6:   "ERA",
+	0x7:   "BADV",
+	0x8:   "BADI",
+	0xc:   "EENTRY",
+	0x10:  "TLBIDX",
+	0x11:  "TLBEHI",
+	0x12:  "TLBELO0",
+	0x13:  "TLBELO1",
+	0x18:  "ASID",
+	0x19:  "PGDL",
+	0x1a:  "PGDH",
+	0x1b:  "PGD",
+	0x1c:  "PWCL",
+	0x1d:  "PWCH",
+	0x1e:  "STLBPS",
+	0x1f:  "RVACFG",
+	0x20:  "CPUID",
+	0x21:  "PRCFG1",
+	0x22:  "PRCFG2",
+	0x23:  "PRCFG3",
+	0x30:  "SAVE0",
+	0x31:  "SAVE1",
+	0x32:  "SAVE2",
+	0x33:  "SAVE3",
+	0x34:  "SAVE4",
+	0x35:  "SAVE5",
+	0x36:  "SAVE6",
+	0x37:  "SAVE7",
+	0x38:  "SAVE8",
+	0x39:  "SAVE9",
+	0x3a:  "SAVE10",
+	0x3b:  "SAVE11",
+	0x3c:  "SAVE12",
+	0x3d:  "SAVE13",
+	0x3e:  "SAVE14",
+	0x3f:  "SAVE15",
+	0x40:  "TID",
+	0x41:  "TCFG",
+	0x42:  "TVAL",
+	0x43:  "CNTC",
+	0x44:  "TICLR",
+	0x50:  "GSTAT",
+	0x51:  "GCFG",
+	0x52:  "GINTC",
+	0x53:  "GCNTC",
+	0x60:  "LLBCTL",
+	0x80:  "IMPCTL1",
+	0x81:  "IMPCTL2",
+	0x88:  "TLBRENTRY",
+	0x89:  "TLBRBADV",
+	0x8a:  "TLBRERA",
+	0x8b:  "TLBRSAVE",
+	0x8c:  "TLBRELO0",
+	0x8d:  "TLBRELO1",
+	0x8e:  "TLBREHI",
+	0x8f:  "TLBRPRMD",
+	0x90:  "MERRCTL",
+	0x91:  "MERRINFO1",
+	0x92:  "MERRINFO2",
+	0x93:  "MERRENTRY",
+	0x94:  "MERRERA",
+	0x95:  "MERRSAVE",
+	0x98:  "CTAG",
+	0x180: "DMW0",
+	0x181: "DMW1",
+	0x182: "DMW2",
+	0x183: "DMW3",
+	0x200: "PMCFG0",
+	0x201: "PMCNT0",
+	0x202: "PMCFG1",
+	0x203: "PMCNT1",
+	0x204: "PMCFG2",
+	0x205: "PMCNT2",
+	0x206: "PMCFG3",
+	0x207: "PMCNT3",
+}
+
 // An Imm is an integer constant.
 type Uimm struct {
 	Imm     uint32
@@ -106,8 +106,13 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 		}
 		return fmt.Sprintf("CALL (R%d)", regno)
 
+	case JISCR0, JISCR1:
+		off := inst.Args[0].(OffsetSimm).Imm
+		return fmt.Sprintf("JMP %d(SCR%d)", off, inst.Op-JISCR0)
+
 	case LD_B, LD_H, LD_W, LD_D, LD_BU, LD_HU, LD_WU, LL_W, LL_D,
-		ST_B, ST_H, ST_W, ST_D, SC_W, SC_D, FLD_S, FLD_D, FST_S, FST_D:
+		ST_B, ST_H, ST_W, ST_D, SC_W, SC_D, FLD_S, FLD_D, FST_S, FST_D,
+		LDL_W, LDL_D, LDR_W, LDR_D, STL_W, STL_D, STR_W, STR_D:
 		var off int32
 		switch a := inst.Args[2].(type) {
 		case Simm16:
@@ -129,6 +134,48 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 		}
 		return fmt.Sprintf("%s %s, (%s)(%s)", op, args[0], args[1], args[2])
 
+	case VLD, XVLD, VST, XVST:
+		off := inst.Args[2].(Simm16).Imm
+		if inst.Op == VLD || inst.Op == XVLD {
+			return fmt.Sprintf("%s %d(%s), %s", op, off, args[1], args[0])
+		}
+		return fmt.Sprintf("%s %s, %d(%s)", op, args[0], off, args[1])
+
+	case VLDX, XVLDX, VSTX, XVSTX:
+		if inst.Op == VLDX || inst.Op == XVLDX {
+			return fmt.Sprintf("%s (%s)(%s), %s", op, args[1], args[2], args[0])
+		}
+		return fmt.Sprintf("%s %s, (%s)(%s)", op, args[0], args[1], args[2])
+
+	case VLDREPL_B, VLDREPL_H, VLDREPL_W, VLDREPL_D,
+		XVLDREPL_B, XVLDREPL_H, XVLDREPL_W, XVLDREPL_D:
+		var off int32
+		switch a := inst.Args[2].(type) {
+		case Simm16:
+			off = int32(a.Imm)
+		case Simm32:
+			off = a.Imm
+		}
+		return fmt.Sprintf("%s %d(%s), %s", op, off, args[1], plan9VecArng(inst.Op, args[0]))
+
+	case VINSGR2VR_B, VINSGR2VR_H, VINSGR2VR_W, VINSGR2VR_D,
+		XVINSGR2VR_W, XVINSGR2VR_D, XVINSVE0_W, XVINSVE0_D:
+		return fmt.Sprintf("%s %s, %s", op, args[1], plan9VecElem(inst.Op, args[0], inst.Args[2]))
+
+	case VPICKVE2GR_B, VPICKVE2GR_H, VPICKVE2GR_W, VPICKVE2GR_D,
+		VPICKVE2GR_BU, VPICKVE2GR_HU, VPICKVE2GR_WU, VPICKVE2GR_DU,
+		XVPICKVE2GR_W, XVPICKVE2GR_D, XVPICKVE2GR_WU, XVPICKVE2GR_DU,
+		XVPICKVE_W, XVPICKVE_D:
+		return fmt.Sprintf("%s %s, %s", op, plan9VecElem(inst.Op, args[1], inst.Args[2]), args[0])
+
+	case VREPLGR2VR_B, VREPLGR2VR_H, VREPLGR2VR_W, VREPLGR2VR_D,
+		XVREPLGR2VR_B, XVREPLGR2VR_H, XVREPLGR2VR_W, XVREPLGR2VR_D,
+		XVREPLVE0_B, XVREPLVE0_H, XVREPLVE0_W, XVREPLVE0_D, XVREPLVE0_Q:
+		return fmt.Sprintf("%s %s, %s", op, args[1], plan9VecArng(inst.Op, args[0]))
+
+	case VREPLVEI_B, VREPLVEI_H, VREPLVEI_W, VREPLVEI_D:
+		return fmt.Sprintf("%s %s, %s", op, plan9VecElem(inst.Op, args[1], inst.Args[2]), plan9VecArng(inst.Op, args[0]))
+
 	case AMADD_B, AMADD_D, AMADD_DB_B, AMADD_DB_D, AMADD_DB_H, AMADD_DB_W, AMADD_H,
 		AMADD_W, AMAND_D, AMAND_DB_D, AMAND_DB_W, AMAND_W, AMCAS_B, AMCAS_D, AMCAS_DB_B,
 		AMCAS_DB_D, AMCAS_DB_H, AMCAS_DB_W, AMCAS_H, AMCAS_W, AMMAX_D, AMMAX_DB_D,
@@ -138,6 +185,19 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 		AMSWAP_DB_H, AMSWAP_DB_W, AMSWAP_H, AMSWAP_W, AMXOR_D, AMXOR_DB_D, AMXOR_DB_W, AMXOR_W:
 		return fmt.Sprintf("%s %s, (%s), %s", op, args[1], args[2], args[0])
 
+	case X86INC_B, X86INC_H, X86INC_W, X86INC_D, X86DEC_B, X86DEC_H, X86DEC_W, X86DEC_D,
+		X86MFTOP, X86MTTOP:
+		return op + " " + args[0]
+
+	case ARMADD_W, ARMSUB_W, ARMADC_W, ARMSBC_W, ARMAND_W, ARMOR_W, ARMXOR_W,
+		ARMSLL_W, ARMSRL_W, ARMSRA_W, ARMROTR_W, ARMSLLI_W, ARMSRLI_W, ARMSRAI_W,
+		ARMROTRI_W, X86SETTAG:
+		// Only reverse the arguments: these have no destination register,
+		// or an immediate where the default case expects a source register.
+		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
+			args[i], args[j] = args[j], args[i]
+		}
+
 	default:
 		// Reverse args, placing dest last
 		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
@@ -180,6 +240,18 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 					return "NOOP"
 				}
 
+			case VSLLI_D, XVSLLI_D:
+				// VMOVQ Vj, Vd is assembled as VSLLV $0, Vj, Vd.
+				if inst.Args[2].(Uimm).Imm == 0 {
+					op = "VMOVQ"
+					if inst.Op == XVSLLI_D {
+						op = "XVMOVQ"
+					}
+					vd := plan9Arg(&inst, pc, symname, inst.Args[0])
+					vj := plan9Arg(&inst, pc, symname, inst.Args[1])
+					args = []string{vj, vd}
+				}
+
 			case SLL_W, OR:
 				rk := inst.Args[2].(Reg)
 				if rk == R0 {
@@ -201,9 +273,11 @@ func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) strin
 }
 
 func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
-	// Reg:			gpr[0, 31] and fpr[0, 31]
+	// Reg:			gpr[0, 31], fpr[0, 31], vr[0, 31] and xr[0, 31]
 	// Fcsr:		fcsr[0, 3]
 	// Fcc:			fcc[0, 7]
+	// Scr:			scr[0, 3]
+	// Csr:			guest csr[0, 16383]
 	// Uimm:		unsigned integer constant
 	// Simm16:		si16
 	// Simm32:		si32
@@ -212,10 +286,14 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 	case Reg:
 		regenum := uint16(a)
 		regno := uint16(a) & 0x1f
-		// General-purpose register
-		if regenum >= uint16(R0) && regenum <= uint16(R31) {
+		switch {
+		case regenum >= uint16(R0) && regenum <= uint16(R31): // General-purpose register
 			return fmt.Sprintf("R%d", regno)
-		} else { // Float point register
+		case regenum >= uint16(V0) && regenum <= uint16(V31): // LSX vector register
+			return fmt.Sprintf("V%d", regno)
+		case regenum >= uint16(X0) && regenum <= uint16(X31): // LASX vector register
+			return fmt.Sprintf("X%d", regno)
+		default: // Float point register
 			return fmt.Sprintf("F%d", regno)
 		}
 
@@ -227,6 +305,15 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 		regno := uint8(a) & 0x1f
 		return fmt.Sprintf("FCC%d", regno)
 
+	case Scr:
+		return fmt.Sprintf("SCR%d", uint8(a))
+
+	case Csr:
+		if name, ok := csrNames[a]; ok {
+			return name
+		}
+		return fmt.Sprintf("$%d", uint16(a))
+
 	case Uimm:
 		return fmt.Sprintf("$%d", a.Imm)
 
@@ -258,6 +345,35 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 	return strings.ToUpper(arg.String())
 }
 
+// plan9VecElem returns the Go assembler form reg.T[index] of a single vector
+// element, where T is the element type implied by the suffix of op.
+func plan9VecElem(op Op, reg string, index Arg) string {
+	suffix := op.String()[strings.LastIndex(op.String(), ".")+1:]
+	elem := strings.Replace(suffix, "D", "V", 1)
+	return fmt.Sprintf("%s.%s[%d]", reg, elem, index.(Uimm).Imm)
+}
+
+// plan9VecArng returns the Go assembler form reg.TN of a vector arrangement
+// with N elements of type T, as implied by the suffix of op.
+func plan9VecArng(op Op, reg string) string {
+	bits := 128
+	if strings.HasPrefix(op.String(), "XV") {
+		bits = 256
+	}
+	switch op.String()[strings.LastIndex(op.String(), ".")+1:] {
+	case "B":
+		return fmt.Sprintf("%s.B%d", reg, bits/8)
+	case "H":
+		return fmt.Sprintf("%s.H%d", reg, bits/16)
+	case "W":
+		return fmt.Sprintf("%s.W%d", reg, bits/32)
+	case "D":
+		return fmt.Sprintf("%s.V%d", reg, bits/64)
+	default:
+		return fmt.Sprintf("%s.Q%d", reg, bits/128)
+	}
+}
+
 func signumConvInt32(imm int32, width uint8) int32 {
 	active := uint32(1<<width) - 1
 	signum := uint32(imm) & active
@@ -538,4 +654,853 @@ var plan9OpMap = map[Op]string{
 	FLDX_D:       "MOVD",
 	FSTX_S:       "MOVF",
 	FSTX_D:       "MOVD",
+
+	// LSX instructions
+	VSHUF_B:         "VSHUFB",
+	VLDX:            "VMOVQ",
+	VSTX:            "VMOVQ",
+	VSEQ_B:          "VSEQB",
+	VSEQ_H:          "VSEQH",
+	VSEQ_W:          "VSEQW",
+	VSEQ_D:          "VSEQV",
+	VSLT_B:          "VSLTB",
+	VSLT_H:          "VSLTH",
+	VSLT_W:          "VSLTW",
+	VSLT_D:          "VSLTV",
+	VSLT_BU:         "VSLTBU",
+	VSLT_HU:         "VSLTHU",
+	VSLT_WU:         "VSLTWU",
+	VSLT_DU:         "VSLTVU",
+	VAND_V:          "VANDV",
+	VOR_V:           "VORV",
+	VXOR_V:          "VXORV",
+	VNOR_V:          "VNORV",
+	VANDN_V:         "VANDNV",
+	VORN_V:          "VORNV",
+	VDIV_B:          "VDIVB",
+	VDIV_H:          "VDIVH",
+	VDIV_W:          "VDIVW",
+	VDIV_D:          "VDIVV",
+	VMOD_B:          "VMODB",
+	VMOD_H:          "VMODH",
+	VMOD_W:          "VMODW",
+	VMOD_D:          "VMODV",
+	VDIV_BU:         "VDIVBU",
+	VDIV_HU:         "VDIVHU",
+	VDIV_WU:         "VDIVWU",
+	VDIV_DU:         "VDIVVU",
+	VMOD_BU:         "VMODBU",
+	VMOD_HU:         "VMODHU",
+	VMOD_WU:         "VMODWU",
+	VMOD_DU:         "VMODVU",
+	VMULWEV_H_B:     "VMULWEVHB",
+	VMULWEV_W_H:     "VMULWEVWH",
+	VMULWEV_D_W:     "VMULWEVVW",
+	VMULWEV_Q_D:     "VMULWEVQV",
+	VMULWOD_H_B:     "VMULWODHB",
+	VMULWOD_W_H:     "VMULWODWH",
+	VMULWOD_D_W:     "VMULWODVW",
+	VMULWOD_Q_D:     "VMULWODQV",
+	VMULWEV_H_BU:    "VMULWEVHBU",
+	VMULWEV_W_HU:    "VMULWEVWHU",
+	VMULWEV_D_WU:    "VMULWEVVWU",
+	VMULWEV_Q_DU:    "VMULWEVQVU",
+	VMULWOD_H_BU:    "VMULWODHBU",
+	VMULWOD_W_HU:    "VMULWODWHU",
+	VMULWOD_D_WU:    "VMULWODVWU",
+	VMULWOD_Q_DU:    "VMULWODQVU",
+	VMULWEV_H_BU_B:  "VMULWEVHBUB",
+	VMULWEV_W_HU_H:  "VMULWEVWHUH",
+	VMULWEV_D_WU_W:  "VMULWEVVWUW",
+	VMULWEV_Q_DU_D:  "VMULWEVQVUV",
+	VMULWOD_H_BU_B:  "VMULWODHBUB",
+	VMULWOD_W_HU_H:  "VMULWODWHUH",
+	VMULWOD_D_WU_W:  "VMULWODVWUW",
+	VMULWOD_Q_DU_D:  "VMULWODQVUV",
+	VADDWEV_H_B:     "VADDWEVHB",
+	VADDWEV_W_H:     "VADDWEVWH",
+	VADDWEV_D_W:     "VADDWEVVW",
+	VADDWEV_Q_D:     "VADDWEVQV",
+	VSUBWEV_H_B:     "VSUBWEVHB",
+	VSUBWEV_W_H:     "VSUBWEVWH",
+	VSUBWEV_D_W:     "VSUBWEVVW",
+	VSUBWEV_Q_D:     "VSUBWEVQV",
+	VADDWOD_H_B:     "VADDWODHB",
+	VADDWOD_W_H:     "VADDWODWH",
+	VADDWOD_D_W:     "VADDWODVW",
+	VADDWOD_Q_D:     "VADDWODQV",
+	VSUBWOD_H_B:     "VSUBWODHB",
+	VSUBWOD_W_H:     "VSUBWODWH",
+	VSUBWOD_D_W:     "VSUBWODVW",
+	VSUBWOD_Q_D:     "VSUBWODQV",
+	VADDWEV_H_BU:    "VADDWEVHBU",
+	VADDWEV_W_HU:    "VADDWEVWHU",
+	VADDWEV_D_WU:    "VADDWEVVWU",
+	VADDWEV_Q_DU:    "VADDWEVQVU",
+	VSUBWEV_H_BU:    "VSUBWEVHBU",
+	VSUBWEV_W_HU:    "VSUBWEVWHU",
+	VSUBWEV_D_WU:    "VSUBWEVVWU",
+	VSUBWEV_Q_DU:    "VSUBWEVQVU",
+	VADDWOD_H_BU:    "VADDWODHBU",
+	VADDWOD_W_HU:    "VADDWODWHU",
+	VADDWOD_D_WU:    "VADDWODVWU",
+	VADDWOD_Q_DU:    "VADDWODQVU",
+	VSUBWOD_H_BU:    "VSUBWODHBU",
+	VSUBWOD_W_HU:    "VSUBWODWHU",
+	VSUBWOD_D_WU:    "VSUBWODVWU",
+	VSUBWOD_Q_DU:    "VSUBWODQVU",
+	VMADD_B:         "VMADDB",
+	VMADD_H:         "VMADDH",
+	VMADD_W:         "VMADDW",
+	VMADD_D:         "VMADDV",
+	VMSUB_B:         "VMSUBB",
+	VMSUB_H:         "VMSUBH",
+	VMSUB_W:         "VMSUBW",
+	VMSUB_D:         "VMSUBV",
+	VMADDWEV_H_B:    "VMADDWEVHB",
+	VMADDWEV_W_H:    "VMADDWEVWH",
+	VMADDWEV_D_W:    "VMADDWEVVW",
+	VMADDWEV_Q_D:    "VMADDWEVQV",
+	VMADDWOD_H_B:    "VMADDWODHB",
+	VMADDWOD_W_H:    "VMADDWODWH",
+	VMADDWOD_D_W:    "VMADDWODVW",
+	VMADDWOD_Q_D:    "VMADDWODQV",
+	VMADDWEV_H_BU:   "VMADDWEVHBU",
+	VMADDWEV_W_HU:   "VMADDWEVWHU",
+	VMADDWEV_D_WU:   "VMADDWEVVWU",
+	VMADDWEV_Q_DU:   "VMADDWEVQVU",
+	VMADDWOD_H_BU:   "VMADDWODHBU",
+	VMADDWOD_W_HU:   "VMADDWODWHU",
+	VMADDWOD_D_WU:   "VMADDWODVWU",
+	VMADDWOD_Q_DU:   "VMADDWODQVU",
+	VMADDWEV_H_BU_B: "VMADDWEVHBUB",
+	VMADDWEV_W_HU_H: "VMADDWEVWHUH",
+	VMADDWEV_D_WU_W: "VMADDWEVVWUW",
+	VMADDWEV_Q_DU_D: "VMADDWEVQVUV",
+	VMADDWOD_H_BU_B: "VMADDWODHBUB",
+	VMADDWOD_W_HU_H: "VMADDWODWHUH",
+	VMADDWOD_D_WU_W: "VMADDWODVWUW",
+	VMADDWOD_Q_DU_D: "VMADDWODQVUV",
+	VSLL_B:          "VSLLB",
+	VSLL_H:          "VSLLH",
+	VSLL_W:          "VSLLW",
+	VSLL_D:          "VSLLV",
+	VSRL_B:          "VSRLB",
+	VSRL_H:          "VSRLH",
+	VSRL_W:          "VSRLW",
+	VSRL_D:          "VSRLV",
+	VSRA_B:          "VSRAB",
+	VSRA_H:          "VSRAH",
+	VSRA_W:          "VSRAW",
+	VSRA_D:          "VSRAV",
+	VROTR_B:         "VROTRB",
+	VROTR_H:         "VROTRH",
+	VROTR_W:         "VROTRW",
+	VROTR_D:         "VROTRV",
+	VADD_B:          "VADDB",
+	VADD_H:          "VADDH",
+	VADD_W:          "VADDW",
+	VADD_D:          "VADDV",
+	VADD_Q:          "VADDQ",
+	VSUB_B:          "VSUBB",
+	VSUB_H:          "VSUBH",
+	VSUB_W:          "VSUBW",
+	VSUB_D:          "VSUBV",
+	VSUB_Q:          "VSUBQ",
+	VSADD_B:         "VSADDB",
+	VSADD_H:         "VSADDH",
+	VSADD_W:         "VSADDW",
+	VSADD_D:         "VSADDV",
+	VSSUB_B:         "VSSUBB",
+	VSSUB_H:         "VSSUBH",
+	VSSUB_W:         "VSSUBW",
+	VSSUB_D:         "VSSUBV",
+	VSADD_BU:        "VSADDBU",
+	VSADD_HU:        "VSADDHU",
+	VSADD_WU:        "VSADDWU",
+	VSADD_DU:        "VSADDVU",
+	VSSUB_BU:        "VSSUBBU",
+	VSSUB_HU:        "VSSUBHU",
+	VSSUB_WU:        "VSSUBWU",
+	VSSUB_DU:        "VSSUBVU",
+	VILVL_B:         "VILVLB",
+	VILVL_H:         "VILVLH",
+	VILVL_W:         "VILVLW",
+	VILVL_D:         "VILVLV",
+	VILVH_B:         "VILVHB",
+	VILVH_H:         "VILVHH",
+	VILVH_W:         "VILVHW",
+	VILVH_D:         "VILVHV",
+	VMUL_B:          "VMULB",
+	VMUL_H:          "VMULH",
+	VMUL_W:          "VMULW",
+	VMUL_D:          "VMULV",
+	VMUH_B:          "VMUHB",
+	VMUH_H:          "VMUHH",
+	VMUH_W:          "VMUHW",
+	VMUH_D:          "VMUHV",
+	VMUH_BU:         "VMUHBU",
+	VMUH_HU:         "VMUHHU",
+	VMUH_WU:         "VMUHWU",
+	VMUH_DU:         "VMUHVU",
+	VFADD_S:         "VADDF",
+	VFADD_D:         "VADDD",
+	VFSUB_S:         "VSUBF",
+	VFSUB_D:         "VSUBD",
+	VFMUL_S:         "VMULF",
+	VFMUL_D:         "VMULD",
+	VFDIV_S:         "VDIVF",
+	VFDIV_D:         "VDIVD",
+	VBITCLR_B:       "VBITCLRB",
+	VBITCLR_H:       "VBITCLRH",
+	VBITCLR_W:       "VBITCLRW",
+	VBITCLR_D:       "VBITCLRV",
+	VBITSET_B:       "VBITSETB",
+	VBITSET_H:       "VBITSETH",
+	VBITSET_W:       "VBITSETW",
+	VBITSET_D:       "VBITSETV",
+	VBITREV_B:       "VBITREVB",
+	VBITREV_H:       "VBITREVH",
+	VBITREV_W:       "VBITREVW",
+	VBITREV_D:       "VBITREVV",
+	VSHUF_H:         "VSHUFH",
+	VSHUF_W:         "VSHUFW",
+	VSHUF_D:         "VSHUFV",
+	VPCNT_B:         "VPCNTB",
+	VPCNT_H:         "VPCNTH",
+	VPCNT_W:         "VPCNTW",
+	VPCNT_D:         "VPCNTV",
+	VFSQRT_S:        "VFSQRTF",
+	VFSQRT_D:        "VFSQRTD",
+	VFRECIP_S:       "VFRECIPF",
+	VFRECIP_D:       "VFRECIPD",
+	VFRSQRT_S:       "VFRSQRTF",
+	VFRSQRT_D:       "VFRSQRTD",
+	VNEG_B:          "VNEGB",
+	VNEG_H:          "VNEGH",
+	VNEG_W:          "VNEGW",
+	VNEG_D:          "VNEGV",
+	VFRINTRNE_S:     "VFRINTRNEF",
+	VFRINTRNE_D:     "VFRINTRNED",
+	VFRINTRZ_S:      "VFRINTRZF",
+	VFRINTRZ_D:      "VFRINTRZD",
+	VFRINTRP_S:      "VFRINTRPF",
+	VFRINTRP_D:      "VFRINTRPD",
+	VFRINTRM_S:      "VFRINTRMF",
+	VFRINTRM_D:      "VFRINTRMD",
+	VFRINT_S:        "VFRINTF",
+	VFRINT_D:        "VFRINTD",
+	VFCLASS_S:       "VFCLASSF",
+	VFCLASS_D:       "VFCLASSD",
+	VSETEQZ_V:       "VSETEQV",
+	VSETNEZ_V:       "VSETNEV",
+	VSETANYEQZ_B:    "VSETANYEQB",
+	VSETANYEQZ_H:    "VSETANYEQH",
+	VSETANYEQZ_W:    "VSETANYEQW",
+	VSETANYEQZ_D:    "VSETANYEQV",
+	VSETALLNEZ_B:    "VSETALLNEB",
+	VSETALLNEZ_H:    "VSETALLNEH",
+	VSETALLNEZ_W:    "VSETALLNEW",
+	VSETALLNEZ_D:    "VSETALLNEV",
+	VLD:             "VMOVQ",
+	VST:             "VMOVQ",
+	VANDI_B:         "VANDB",
+	VORI_B:          "VORB",
+	VSEQI_B:         "VSEQB",
+	VSEQI_H:         "VSEQH",
+	VSEQI_W:         "VSEQW",
+	VSEQI_D:         "VSEQV",
+	VSLTI_B:         "VSLTB",
+	VSLTI_H:         "VSLTH",
+	VSLTI_W:         "VSLTW",
+	VSLTI_D:         "VSLTV",
+	VSLTI_BU:        "VSLTBU",
+	VSLTI_HU:        "VSLTHU",
+	VSLTI_WU:        "VSLTWU",
+	VSLTI_DU:        "VSLTVU",
+	VROTRI_B:        "VROTRB",
+	VROTRI_H:        "VROTRH",
+	VROTRI_W:        "VROTRW",
+	VROTRI_D:        "VROTRV",
+	VSLLI_B:         "VSLLB",
+	VSLLI_H:         "VSLLH",
+	VSLLI_W:         "VSLLW",
+	VSLLI_D:         "VSLLV",
+	VSRLI_B:         "VSRLB",
+	VSRLI_H:         "VSRLH",
+	VSRLI_W:         "VSRLW",
+	VSRLI_D:         "VSRLV",
+	VSRAI_B:         "VSRAB",
+	VSRAI_H:         "VSRAH",
+	VSRAI_W:         "VSRAW",
+	VSRAI_D:         "VSRAV",
+	VADDI_BU:        "VADDBU",
+	VADDI_HU:        "VADDHU",
+	VADDI_WU:        "VADDWU",
+	VADDI_DU:        "VADDVU",
+	VSUBI_BU:        "VSUBBU",
+	VSUBI_HU:        "VSUBHU",
+	VSUBI_WU:        "VSUBWU",
+	VSUBI_DU:        "VSUBVU",
+	VSHUF4I_B:       "VSHUF4IB",
+	VSHUF4I_H:       "VSHUF4IH",
+	VSHUF4I_W:       "VSHUF4IW",
+	VSHUF4I_D:       "VSHUF4IV",
+	VPERMI_W:        "VPERMIW",
+	VEXTRINS_B:      "VEXTRINSB",
+	VEXTRINS_H:      "VEXTRINSH",
+	VEXTRINS_W:      "VEXTRINSW",
+	VEXTRINS_D:      "VEXTRINSV",
+	VBITCLRI_B:      "VBITCLRB",
+	VBITCLRI_H:      "VBITCLRH",
+	VBITCLRI_W:      "VBITCLRW",
+	VBITCLRI_D:      "VBITCLRV",
+	VBITSETI_B:      "VBITSETB",
+	VBITSETI_H:      "VBITSETH",
+	VBITSETI_W:      "VBITSETW",
+	VBITSETI_D:      "VBITSETV",
+	VBITREVI_B:      "VBITREVB",
+	VBITREVI_H:      "VBITREVH",
+	VBITREVI_W:      "VBITREVW",
+	VBITREVI_D:      "VBITREVV",
+	VINSGR2VR_B:     "VMOVQ",
+	VINSGR2VR_H:     "VMOVQ",
+	VINSGR2VR_W:     "VMOVQ",
+	VINSGR2VR_D:     "VMOVQ",
+	VPICKVE2GR_B:    "VMOVQ",
+	VPICKVE2GR_H:    "VMOVQ",
+	VPICKVE2GR_W:    "VMOVQ",
+	VPICKVE2GR_D:    "VMOVQ",
+	VPICKVE2GR_BU:   "VMOVQ",
+	VPICKVE2GR_HU:   "VMOVQ",
+	VPICKVE2GR_WU:   "VMOVQ",
+	VPICKVE2GR_DU:   "VMOVQ",
+	VLDREPL_B:       "VMOVQ",
+	VLDREPL_H:       "VMOVQ",
+	VLDREPL_W:       "VMOVQ",
+	VLDREPL_D:       "VMOVQ",
+	VREPLGR2VR_B:    "VMOVQ",
+	VREPLGR2VR_H:    "VMOVQ",
+	VREPLGR2VR_W:    "VMOVQ",
+	VREPLGR2VR_D:    "VMOVQ",
+	VREPLVEI_B:      "VMOVQ",
+	VREPLVEI_H:      "VMOVQ",
+	VREPLVEI_W:      "VMOVQ",
+	VREPLVEI_D:      "VMOVQ",
+	VXORI_B:         "VXORB",
+	VNORI_B:         "VNORB",
+
+	// LASX instructions
+	XVSHUF_B:         "XVSHUFB",
+	XVLDX:            "XVMOVQ",
+	XVSTX:            "XVMOVQ",
+	XVSEQ_B:          "XVSEQB",
+	XVSEQ_H:          "XVSEQH",
+	XVSEQ_W:          "XVSEQW",
+	XVSEQ_D:          "XVSEQV",
+	XVSLT_B:          "XVSLTB",
+	XVSLT_H:          "XVSLTH",
+	XVSLT_W:          "XVSLTW",
+	XVSLT_D:          "XVSLTV",
+	XVSLT_BU:         "XVSLTBU",
+	XVSLT_HU:         "XVSLTHU",
+	XVSLT_WU:         "XVSLTWU",
+	XVSLT_DU:         "XVSLTVU",
+	XVAND_V:          "XVANDV",
+	XVOR_V:           "XVORV",
+	XVXOR_V:          "XVXORV",
+	XVNOR_V:          "XVNORV",
+	XVANDN_V:         "XVANDNV",
+	XVORN_V:          "XVORNV",
+	XVDIV_B:          "XVDIVB",
+	XVDIV_H:          "XVDIVH",
+	XVDIV_W:          "XVDIVW",
+	XVDIV_D:          "XVDIVV",
+	XVMOD_B:          "XVMODB",
+	XVMOD_H:          "XVMODH",
+	XVMOD_W:          "XVMODW",
+	XVMOD_D:          "XVMODV",
+	XVDIV_BU:         "XVDIVBU",
+	XVDIV_HU:         "XVDIVHU",
+	XVDIV_WU:         "XVDIVWU",
+	XVDIV_DU:         "XVDIVVU",
+	XVMOD_BU:         "XVMODBU",
+	XVMOD_HU:         "XVMODHU",
+	XVMOD_WU:         "XVMODWU",
+	XVMOD_DU:         "XVMODVU",
+	XVMULWEV_H_B:     "XVMULWEVHB",
+	XVMULWEV_W_H:     "XVMULWEVWH",
+	XVMULWEV_D_W:     "XVMULWEVVW",
+	XVMULWEV_Q_D:     "XVMULWEVQV",
+	XVMULWOD_H_B:     "XVMULWODHB",
+	XVMULWOD_W_H:     "XVMULWODWH",
+	XVMULWOD_D_W:     "XVMULWODVW",
+	XVMULWOD_Q_D:     "XVMULWODQV",
+	XVMULWEV_H_BU:    "XVMULWEVHBU",
+	XVMULWEV_W_HU:    "XVMULWEVWHU",
+	XVMULWEV_D_WU:    "XVMULWEVVWU",
+	XVMULWEV_Q_DU:    "XVMULWEVQVU",
+	XVMULWOD_H_BU:    "XVMULWODHBU",
+	XVMULWOD_W_HU:    "XVMULWODWHU",
+	XVMULWOD_D_WU:    "XVMULWODVWU",
+	XVMULWOD_Q_DU:    "XVMULWODQVU",
+	XVMULWEV_H_BU_B:  "XVMULWEVHBUB",
+	XVMULWEV_W_HU_H:  "XVMULWEVWHUH",
+	XVMULWEV_D_WU_W:  "XVMULWEVVWUW",
+	XVMULWEV_Q_DU_D:  "XVMULWEVQVUV",
+	XVMULWOD_H_BU_B:  "XVMULWODHBUB",
+	XVMULWOD_W_HU_H:  "XVMULWODWHUH",
+	XVMULWOD_D_WU_W:  "XVMULWODVWUW",
+	XVMULWOD_Q_DU_D:  "XVMULWODQVUV",
+	XVADDWEV_H_B:     "XVADDWEVHB",
+	XVADDWEV_W_H:     "XVADDWEVWH",
+	XVADDWEV_D_W:     "XVADDWEVVW",
+	XVADDWEV_Q_D:     "XVADDWEVQV",
+	XVSUBWEV_H_B:     "XVSUBWEVHB",
+	XVSUBWEV_W_H:     "XVSUBWEVWH",
+	XVSUBWEV_D_W:     "XVSUBWEVVW",
+	XVSUBWEV_Q_D:     "XVSUBWEVQV",
+	XVADDWOD_H_B:     "XVADDWODHB",
+	XVADDWOD_W_H:     "XVADDWODWH",
+	XVADDWOD_D_W:     "XVADDWODVW",
+	XVADDWOD_Q_D:     "XVADDWODQV",
+	XVSUBWOD_H_B:     "XVSUBWODHB",
+	XVSUBWOD_W_H:     "XVSUBWODWH",
+	XVSUBWOD_D_W:     "XVSUBWODVW",
+	XVSUBWOD_Q_D:     "XVSUBWODQV",
+	XVADDWEV_H_BU:    "XVADDWEVHBU",
+	XVADDWEV_W_HU:    "XVADDWEVWHU",
+	XVADDWEV_D_WU:    "XVADDWEVVWU",
+	XVADDWEV_Q_DU:    "XVADDWEVQVU",
+	XVSUBWEV_H_BU:    "XVSUBWEVHBU",
+	XVSUBWEV_W_HU:    "XVSUBWEVWHU",
+	XVSUBWEV_D_WU:    "XVSUBWEVVWU",
+	XVSUBWEV_Q_DU:    "XVSUBWEVQVU",
+	XVADDWOD_H_BU:    "XVADDWODHBU",
+	XVADDWOD_W_HU:    "XVADDWODWHU",
+	XVADDWOD_D_WU:    "XVADDWODVWU",
+	XVADDWOD_Q_DU:    "XVADDWODQVU",
+	XVSUBWOD_H_BU:    "XVSUBWODHBU",
+	XVSUBWOD_W_HU:    "XVSUBWODWHU",
+	XVSUBWOD_D_WU:    "XVSUBWODVWU",
+	XVSUBWOD_Q_DU:    "XVSUBWODQVU",
+	XVMADD_B:         "XVMADDB",
+	XVMADD_H:         "XVMADDH",
+	XVMADD_W:         "XVMADDW",
+	XVMADD_D:         "XVMADDV",
+	XVMSUB_B:         "XVMSUBB",
+	XVMSUB_H:         "XVMSUBH",
+	XVMSUB_W:         "XVMSUBW",
+	XVMSUB_D:         "XVMSUBV",
+	XVMADDWEV_H_B:    "XVMADDWEVHB",
+	XVMADDWEV_W_H:    "XVMADDWEVWH",
+	XVMADDWEV_D_W:    "XVMADDWEVVW",
+	XVMADDWEV_Q_D:    "XVMADDWEVQV",
+	XVMADDWOD_H_B:    "XVMADDWODHB",
+	XVMADDWOD_W_H:    "XVMADDWODWH",
+	XVMADDWOD_D_W:    "XVMADDWODVW",
+	XVMADDWOD_Q_D:    "XVMADDWODQV",
+	XVMADDWEV_H_BU:   "XVMADDWEVHBU",
+	XVMADDWEV_W_HU:   "XVMADDWEVWHU",
+	XVMADDWEV_D_WU:   "XVMADDWEVVWU",
+	XVMADDWEV_Q_DU:   "XVMADDWEVQVU",
+	XVMADDWOD_H_BU:   "XVMADDWODHBU",
+	XVMADDWOD_W_HU:   "XVMADDWODWHU",
+	XVMADDWOD_D_WU:   "XVMADDWODVWU",
+	XVMADDWOD_Q_DU:   "XVMADDWODQVU",
+	XVMADDWEV_H_BU_B: "XVMADDWEVHBUB",
+	XVMADDWEV_W_HU_H: "XVMADDWEVWHUH",
+	XVMADDWEV_D_WU_W: "XVMADDWEVVWUW",
+	XVMADDWEV_Q_DU_D: "XVMADDWEVQVUV",
+	XVMADDWOD_H_BU_B: "XVMADDWODHBUB",
+	XVMADDWOD_W_HU_H: "XVMADDWODWHUH",
+	XVMADDWOD_D_WU_W: "XVMADDWODVWUW",
+	XVMADDWOD_Q_DU_D: "XVMADDWODQVUV",
+	XVSLL_B:          "XVSLLB",
+	XVSLL_H:          "XVSLLH",
+	XVSLL_W:          "XVSLLW",
+	XVSLL_D:          "XVSLLV",
+	XVSRL_B:          "XVSRLB",
+	XVSRL_H:          "XVSRLH",
+	XVSRL_W:          "XVSRLW",
+	XVSRL_D:          "XVSRLV",
+	XVSRA_B:          "XVSRAB",
+	XVSRA_H:          "XVSRAH",
+	XVSRA_W:          "XVSRAW",
+	XVSRA_D:          "XVSRAV",
+	XVROTR_B:         "XVROTRB",
+	XVROTR_H:         "XVROTRH",
+	XVROTR_W:         "XVROTRW",
+	XVROTR_D:         "XVROTRV",
+	XVADD_B:          "XVADDB",
+	XVADD_H:          "XVADDH",
+	XVADD_W:          "XVADDW",
+	XVADD_D:          "XVADDV",
+	XVADD_Q:          "XVADDQ",
+	XVSUB_B:          "XVSUBB",
+	XVSUB_H:          "XVSUBH",
+	XVSUB_W:          "XVSUBW",
+	XVSUB_D:          "XVSUBV",
+	XVSUB_Q:          "XVSUBQ",
+	XVSADD_B:         "XVSADDB",
+	XVSADD_H:         "XVSADDH",
+	XVSADD_W:         "XVSADDW",
+	XVSADD_D:         "XVSADDV",
+	XVSSUB_B:         "XVSSUBB",
+	XVSSUB_H:         "XVSSUBH",
+	XVSSUB_W:         "XVSSUBW",
+	XVSSUB_D:         "XVSSUBV",
+	XVSADD_BU:        "XVSADDBU",
+	XVSADD_HU:        "XVSADDHU",
+	XVSADD_WU:        "XVSADDWU",
+	XVSADD_DU:        "XVSADDVU",
+	XVSSUB_BU:        "XVSSUBBU",
+	XVSSUB_HU:        "XVSSUBHU",
+	XVSSUB_WU:        "XVSSUBWU",
+	XVSSUB_DU:        "XVSSUBVU",
+	XVILVL_B:         "XVILVLB",
+	XVILVL_H:         "XVILVLH",
+	XVILVL_W:         "XVILVLW",
+	XVILVL_D:         "XVILVLV",
+	XVILVH_B:         "XVILVHB",
+	XVILVH_H:         "XVILVHH",
+	XVILVH_W:         "XVILVHW",
+	XVILVH_D:         "XVILVHV",
+	XVMUL_B:          "XVMULB",
+	XVMUL_H:          "XVMULH",
+	XVMUL_W:          "XVMULW",
+	XVMUL_D:          "XVMULV",
+	XVMUH_B:          "XVMUHB",
+	XVMUH_H:          "XVMUHH",
+	XVMUH_W:          "XVMUHW",
+	XVMUH_D:          "XVMUHV",
+	XVMUH_BU:         "XVMUHBU",
+	XVMUH_HU:         "XVMUHHU",
+	XVMUH_WU:         "XVMUHWU",
+	XVMUH_DU:         "XVMUHVU",
+	XVFADD_S:         "XVADDF",
+	XVFADD_D:         "XVADDD",
+	XVFSUB_S:         "XVSUBF",
+	XVFSUB_D:         "XVSUBD",
+	XVFMUL_S:         "XVMULF",
+	XVFMUL_D:         "XVMULD",
+	XVFDIV_S:         "XVDIVF",
+	XVFDIV_D:         "XVDIVD",
+	XVBITCLR_B:       "XVBITCLRB",
+	XVBITCLR_H:       "XVBITCLRH",
+	XVBITCLR_W:       "XVBITCLRW",
+	XVBITCLR_D:       "XVBITCLRV",
+	XVBITSET_B:       "XVBITSETB",
+	XVBITSET_H:       "XVBITSETH",
+	XVBITSET_W:       "XVBITSETW",
+	XVBITSET_D:       "XVBITSETV",
+	XVBITREV_B:       "XVBITREVB",
+	XVBITREV_H:       "XVBITREVH",
+	XVBITREV_W:       "XVBITREVW",
+	XVBITREV_D:       "XVBITREVV",
+	XVSHUF_H:         "XVSHUFH",
+	XVSHUF_W:         "XVSHUFW",
+	XVSHUF_D:         "XVSHUFV",
+	XVPCNT_B:         "XVPCNTB",
+	XVPCNT_H:         "XVPCNTH",
+	XVPCNT_W:         "XVPCNTW",
+	XVPCNT_D:         "XVPCNTV",
+	XVFSQRT_S:        "XVFSQRTF",
+	XVFSQRT_D:        "XVFSQRTD",
+	XVFRECIP_S:       "XVFRECIPF",
+	XVFRECIP_D:       "XVFRECIPD",
+	XVFRSQRT_S:       "XVFRSQRTF",
+	XVFRSQRT_D:       "XVFRSQRTD",
+	XVNEG_B:          "XVNEGB",
+	XVNEG_H:          "XVNEGH",
+	XVNEG_W:          "XVNEGW",
+	XVNEG_D:          "XVNEGV",
+	XVFRINTRNE_S:     "XVFRINTRNEF",
+	XVFRINTRNE_D:     "XVFRINTRNED",
+	XVFRINTRZ_S:      "XVFRINTRZF",
+	XVFRINTRZ_D:      "XVFRINTRZD",
+	XVFRINTRP_S:      "XVFRINTRPF",
+	XVFRINTRP_D:      "XVFRINTRPD",
+	XVFRINTRM_S:      "XVFRINTRMF",
+	XVFRINTRM_D:      "XVFRINTRMD",
+	XVFRINT_S:        "XVFRINTF",
+	XVFRINT_D:        "XVFRINTD",
+	XVFCLASS_S:       "XVFCLASSF",
+	XVFCLASS_D:       "XVFCLASSD",
+	XVSETEQZ_V:       "XVSETEQV",
+	XVSETNEZ_V:       "XVSETNEV",
+	XVSETANYEQZ_B:    "XVSETANYEQB",
+	XVSETANYEQZ_H:    "XVSETANYEQH",
+	XVSETANYEQZ_W:    "XVSETANYEQW",
+	XVSETANYEQZ_D:    "XVSETANYEQV",
+	XVSETALLNEZ_B:    "XVSETALLNEB",
+	XVSETALLNEZ_H:    "XVSETALLNEH",
+	XVSETALLNEZ_W:    "XVSETALLNEW",
+	XVSETALLNEZ_D:    "XVSETALLNEV",
+	XVLD:             "XVMOVQ",
+	XVST:             "XVMOVQ",
+	XVANDI_B:         "XVANDB",
+	XVORI_B:          "XVORB",
+	XVXORI_B:         "XVXORB",
+	XVNORI_B:         "XVNORB",
+	XVSEQI_B:         "XVSEQB",
+	XVSEQI_H:         "XVSEQH",
+	XVSEQI_W:         "XVSEQW",
+	XVSEQI_D:         "XVSEQV",
+	XVSLTI_B:         "XVSLTB",
+	XVSLTI_H:         "XVSLTH",
+	XVSLTI_W:         "XVSLTW",
+	XVSLTI_D:         "XVSLTV",
+	XVSLTI_BU:        "XVSLTBU",
+	XVSLTI_HU:        "XVSLTHU",
+	XVSLTI_WU:        "XVSLTWU",
+	XVSLTI_DU:        "XVSLTVU",
+	XVROTRI_B:        "XVROTRB",
+	XVROTRI_H:        "XVROTRH",
+	XVROTRI_W:        "XVROTRW",
+	XVROTRI_D:        "XVROTRV",
+	XVSLLI_B:         "XVSLLB",
+	XVSLLI_H:         "XVSLLH",
+	XVSLLI_W:         "XVSLLW",
+	XVSLLI_D:         "XVSLLV",
+	XVSRLI_B:         "XVSRLB",
+	XVSRLI_H:         "XVSRLH",
+	XVSRLI_W:         "XVSRLW",
+	XVSRLI_D:         "XVSRLV",
+	XVSRAI_B:         "XVSRAB",
+	XVSRAI_H:         "XVSRAH",
+	XVSRAI_W:         "XVSRAW",
+	XVSRAI_D:         "XVSRAV",
+	XVADDI_BU:        "XVADDBU",
+	XVADDI_HU:        "XVADDHU",
+	XVADDI_WU:        "XVADDWU",
+	XVADDI_DU:        "XVADDVU",
+	XVSUBI_BU:        "XVSUBBU",
+	XVSUBI_HU:        "XVSUBHU",
+	XVSUBI_WU:        "XVSUBWU",
+	XVSUBI_DU:        "XVSUBVU",
+	XVSHUF4I_B:       "XVSHUF4IB",
+	XVSHUF4I_H:       "XVSHUF4IH",
+	XVSHUF4I_W:       "XVSHUF4IW",
+	XVSHUF4I_D:       "XVSHUF4IV",
+	XVPERMI_W:        "XVPERMIW",
+	XVPERMI_D:        "XVPERMIV",
+	XVPERMI_Q:        "XVPERMIQ",
+	XVEXTRINS_B:      "XVEXTRINSB",
+	XVEXTRINS_H:      "XVEXTRINSH",
+	XVEXTRINS_W:      "XVEXTRINSW",
+	XVEXTRINS_D:      "XVEXTRINSV",
+	XVBITCLRI_B:      "XVBITCLRB",
+	XVBITCLRI_H:      "XVBITCLRH",
+	XVBITCLRI_W:      "XVBITCLRW",
+	XVBITCLRI_D:      "XVBITCLRV",
+	XVBITSETI_B:      "XVBITSETB",
+	XVBITSETI_H:      "XVBITSETH",
+	XVBITSETI_W:      "XVBITSETW",
+	XVBITSETI_D:      "XVBITSETV",
+	XVBITREVI_B:      "XVBITREVB",
+	XVBITREVI_H:      "XVBITREVH",
+	XVBITREVI_W:      "XVBITREVW",
+	XVBITREVI_D:      "XVBITREVV",
+	XVINSGR2VR_W:     "XVMOVQ",
+	XVINSGR2VR_D:     "XVMOVQ",
+	XVPICKVE2GR_W:    "XVMOVQ",
+	XVPICKVE2GR_D:    "XVMOVQ",
+	XVPICKVE2GR_WU:   "XVMOVQ",
+	XVPICKVE2GR_DU:   "XVMOVQ",
+	XVLDREPL_B:       "XVMOVQ",
+	XVLDREPL_H:       "XVMOVQ",
+	XVLDREPL_W:       "XVMOVQ",
+	XVLDREPL_D:       "XVMOVQ",
+	XVREPLGR2VR_B:    "XVMOVQ",
+	XVREPLGR2VR_H:    "XVMOVQ",
+	XVREPLGR2VR_W:    "XVMOVQ",
+	XVREPLGR2VR_D:    "XVMOVQ",
+	XVREPLVE0_B:      "XVMOVQ",
+	XVREPLVE0_H:      "XVMOVQ",
+	XVREPLVE0_W:      "XVMOVQ",
+	XVREPLVE0_D:      "XVMOVQ",
+	XVREPLVE0_Q:      "XVMOVQ",
+	XVINSVE0_W:       "XVMOVQ",
+	XVINSVE0_D:       "XVMOVQ",
+	XVPICKVE_W:       "XVMOVQ",
+	XVPICKVE_D:       "XVMOVQ",
+
+	// LBT instructions
+	X86ADC_B:     "X86ADCB",
+	X86ADC_H:     "X86ADCH",
+	X86ADC_W:     "X86ADCW",
+	X86ADC_D:     "X86ADCV",
+	X86ADD_B:     "X86ADDB",
+	X86ADD_H:     "X86ADDH",
+	X86ADD_W:     "X86ADDW",
+	X86ADD_D:     "X86ADDV",
+	X86SBC_B:     "X86SBCB",
+	X86SBC_H:     "X86SBCH",
+	X86SBC_W:     "X86SBCW",
+	X86SBC_D:     "X86SBCV",
+	X86SUB_B:     "X86SUBB",
+	X86SUB_H:     "X86SUBH",
+	X86SUB_W:     "X86SUBW",
+	X86SUB_D:     "X86SUBV",
+	X86AND_B:     "X86ANDB",
+	X86AND_H:     "X86ANDH",
+	X86AND_W:     "X86ANDW",
+	X86AND_D:     "X86ANDV",
+	X86OR_B:      "X86ORB",
+	X86OR_H:      "X86ORH",
+	X86OR_W:      "X86ORW",
+	X86OR_D:      "X86ORV",
+	X86XOR_B:     "X86XORB",
+	X86XOR_H:     "X86XORH",
+	X86XOR_W:     "X86XORW",
+	X86XOR_D:     "X86XORV",
+	X86SLL_B:     "X86SLLB",
+	X86SLL_H:     "X86SLLH",
+	X86SLL_W:     "X86SLLW",
+	X86SLL_D:     "X86SLLV",
+	X86SRL_B:     "X86SRLB",
+	X86SRL_H:     "X86SRLH",
+	X86SRL_W:     "X86SRLW",
+	X86SRL_D:     "X86SRLV",
+	X86SRA_B:     "X86SRAB",
+	X86SRA_H:     "X86SRAH",
+	X86SRA_W:     "X86SRAW",
+	X86SRA_D:     "X86SRAV",
+	X86ROTR_B:    "X86ROTRB",
+	X86ROTR_H:    "X86ROTRH",
+	X86ROTR_W:    "X86ROTRW",
+	X86ROTR_D:    "X86ROTRV",
+	X86ROTL_B:    "X86ROTLB",
+	X86ROTL_H:    "X86ROTLH",
+	X86ROTL_W:    "X86ROTLW",
+	X86ROTL_D:    "X86ROTLV",
+	X86RCR_B:     "X86RCRB",
+	X86RCR_H:     "X86RCRH",
+	X86RCR_W:     "X86RCRW",
+	X86RCR_D:     "X86RCRV",
+	X86RCL_B:     "X86RCLB",
+	X86RCL_H:     "X86RCLH",
+	X86RCL_W:     "X86RCLW",
+	X86RCL_D:     "X86RCLV",
+	X86ADD_WU:    "X86ADDWU",
+	X86SUB_WU:    "X86SUBWU",
+	X86ADD_DU:    "X86ADDVU",
+	X86SUB_DU:    "X86SUBVU",
+	X86MUL_B:     "X86MULB",
+	X86MUL_H:     "X86MULH",
+	X86MUL_W:     "X86MULW",
+	X86MUL_D:     "X86MULV",
+	X86MUL_BU:    "X86MULBU",
+	X86MUL_HU:    "X86MULHU",
+	X86MUL_WU:    "X86MULWU",
+	X86MUL_DU:    "X86MULVU",
+	X86INC_B:     "X86INCB",
+	X86INC_H:     "X86INCH",
+	X86INC_W:     "X86INCW",
+	X86INC_D:     "X86INCV",
+	X86DEC_B:     "X86DECB",
+	X86DEC_H:     "X86DECH",
+	X86DEC_W:     "X86DECW",
+	X86DEC_D:     "X86DECV",
+	X86SLLI_B:    "X86SLLB",
+	X86SLLI_H:    "X86SLLH",
+	X86SLLI_W:    "X86SLLW",
+	X86SLLI_D:    "X86SLLV",
+	X86SRLI_B:    "X86SRLB",
+	X86SRLI_H:    "X86SRLH",
+	X86SRLI_W:    "X86SRLW",
+	X86SRLI_D:    "X86SRLV",
+	X86SRAI_B:    "X86SRAB",
+	X86SRAI_H:    "X86SRAH",
+	X86SRAI_W:    "X86SRAW",
+	X86SRAI_D:    "X86SRAV",
+	X86ROTRI_B:   "X86ROTRB",
+	X86ROTRI_H:   "X86ROTRH",
+	X86ROTRI_W:   "X86ROTRW",
+	X86ROTRI_D:   "X86ROTRV",
+	X86ROTLI_B:   "X86ROTLB",
+	X86ROTLI_H:   "X86ROTLH",
+	X86ROTLI_W:   "X86ROTLW",
+	X86ROTLI_D:   "X86ROTLV",
+	X86RCRI_B:    "X86RCRB",
+	X86RCRI_H:    "X86RCRH",
+	X86RCRI_W:    "X86RCRW",
+	X86RCRI_D:    "X86RCRV",
+	X86RCLI_B:    "X86RCLB",
+	X86RCLI_H:    "X86RCLH",
+	X86RCLI_W:    "X86RCLW",
+	X86RCLI_D:    "X86RCLV",
+	X86SETTAG:    "X86SETTAG",
+	X86MFFLAG:    "X86MFFLAG",
+	X86MTFLAG:    "X86MTFLAG",
+	X86MFTOP:     "X86MFTOP",
+	X86MTTOP:     "X86MTTOP",
+	X86INCTOP:    "X86INCTOP",
+	X86DECTOP:    "X86DECTOP",
+	X86SETTM:     "X86SETTM",
+	X86CLRTM:     "X86CLRTM",
+	ARMADD_W:     "ARMADDW",
+	ARMSUB_W:     "ARMSUBW",
+	ARMADC_W:     "ARMADCW",
+	ARMSBC_W:     "ARMSBCW",
+	ARMAND_W:     "ARMANDW",
+	ARMOR_W:      "ARMORW",
+	ARMXOR_W:     "ARMXORW",
+	ARMSLL_W:     "ARMSLLW",
+	ARMSRL_W:     "ARMSRLW",
+	ARMSRA_W:     "ARMSRAW",
+	ARMROTR_W:    "ARMROTRW",
+	ARMSLLI_W:    "ARMSLLW",
+	ARMSRLI_W:    "ARMSRLW",
+	ARMSRAI_W:    "ARMSRAW",
+	ARMROTRI_W:   "ARMROTRW",
+	ARMRRX_W:     "ARMRRXW",
+	ARMMOVE:      "ARMMOVE",
+	ARMMOV_W:     "ARMMOVW",
+	ARMMOV_D:     "ARMMOVV",
+	ARMNOT_W:     "ARMNOTW",
+	ARMMFFLAG:    "ARMMFFLAG",
+	ARMMTFLAG:    "ARMMTFLAG",
+	SETX86J:      "SETX86J",
+	SETARMJ:      "SETARMJ",
+	SETX86LOOPE:  "SETX86LOOPE",
+	SETX86LOOPNE: "SETX86LOOPNE",
+	MOVGR2SCR:    "MOVV",
+	MOVSCR2GR:    "MOVV",
+	JISCR0:       "JMP",
+	JISCR1:       "JMP",
+	ADC_B:        "ADCB",
+	SBC_B:        "SBCB",
+	ADC_H:        "ADCH",
+	SBC_H:        "SBCH",
+	ADC_W:        "ADC",
+	SBC_W:        "SBC",
+	ADC_D:        "ADCV",
+	SBC_D:        "SBCV",
+	RCR_B:        "RCRB",
+	RCR_H:        "RCRH",
+	RCR_W:        "RCR",
+	RCR_D:        "RCRV",
+	ROTR_B:       "ROTRB",
+	ROTR_H:       "ROTRH",
+	ROTRI_B:      "ROTRB",
+	ROTRI_H:      "ROTRH",
+	RCRI_B:       "RCRB",
+	RCRI_H:       "RCRH",
+	RCRI_W:       "RCR",
+	RCRI_D:       "RCRV",
+	ADDU12I_W:    "ADDU12IW",
+	ADDU12I_D:    "ADDU12IV",
+	LDL_W:        "MOVWL",
+	LDL_D:        "MOVVL",
+	LDR_W:        "MOVWR",
+	LDR_D:        "MOVVR",
+	STL_W:        "MOVWL",
+	STL_D:        "MOVVL",
+	STR_W:        "MOVWR",
+	STR_D:        "MOVVR",
+	FCVT_UD_D:    "FCVTUDD",
+	FCVT_LD_D:    "FCVTLDD",
+	FCVT_D_LD:    "FCVTDLD",
 }
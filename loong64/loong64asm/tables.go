@@ -8,8 +8,14 @@ package loong64asm
 
 const (
 	_ Op = iota
+	ADC_B
+	ADC_D
+	ADC_H
+	ADC_W
 	ADDI_D
 	ADDI_W
+	ADDU12I_D
+	ADDU12I_W
 	ADDU16I_D
 	ADD_D
 	ADD_W
@@ -71,6 +77,28 @@ const (
 	AND
 	ANDI
 	ANDN
+	ARMADC_W
+	ARMADD_W
+	ARMAND_W
+	ARMMFFLAG
+	ARMMOVE
+	ARMMOV_D
+	ARMMOV_W
+	ARMMTFLAG
+	ARMNOT_W
+	ARMOR_W
+	ARMROTRI_W
+	ARMROTR_W
+	ARMRRX_W
+	ARMSBC_W
+	ARMSLLI_W
+	ARMSLL_W
+	ARMSRAI_W
+	ARMSRA_W
+	ARMSRLI_W
+	ARMSRL_W
+	ARMSUB_W
+	ARMXOR_W
 	ASRTGT_D
 	ASRTLE_D
 	B
@@ -178,8 +206,11 @@ const (
 	FCMP_SUN_S
 	FCOPYSIGN_D
 	FCOPYSIGN_S
+	FCVT_D_LD
 	FCVT_D_S
+	FCVT_LD_D
 	FCVT_S_D
+	FCVT_UD_D
 	FDIV_D
 	FDIV_S
 	FFINT_D_L
@@ -263,6 +294,11 @@ const (
 	FTINT_L_S
 	FTINT_W_D
 	FTINT_W_S
+	GCSRRD
+	GCSRWR
+	GCSRXCHG
+	GTLBFLUSH
+	HVCL
 	IBAR
 	IDLE
 	INVTLB
@@ -275,6 +311,8 @@ const (
 	IOCSRWR_H
 	IOCSRWR_W
 	JIRL
+	JISCR0
+	JISCR1
 	LDDIR
 	LDGT_B
 	LDGT_D
@@ -284,9 +322,13 @@ const (
 	LDLE_D
 	LDLE_H
 	LDLE_W
+	LDL_D
+	LDL_W
 	LDPTE
 	LDPTR_D
 	LDPTR_W
+	LDR_D
+	LDR_W
 	LDX_B
 	LDX_BU
 	LDX_D
@@ -326,6 +368,8 @@ const (
 	MOVGR2FRH_W
 	MOVGR2FR_D
 	MOVGR2FR_W
+	MOVGR2SCR
+	MOVSCR2GR
 	MULH_D
 	MULH_DU
 	MULH_W
@@ -344,6 +388,14 @@ const (
 	PCALAU12I
 	PRELD
 	PRELDX
+	RCRI_B
+	RCRI_D
+	RCRI_H
+	RCRI_W
+	RCR_B
+	RCR_D
+	RCR_H
+	RCR_W
 	RDTIMEH_W
 	RDTIMEL_W
 	RDTIME_D
@@ -353,15 +405,27 @@ const (
 	REVB_D
 	REVH_2W
 	REVH_D
+	ROTRI_B
 	ROTRI_D
+	ROTRI_H
 	ROTRI_W
+	ROTR_B
 	ROTR_D
+	ROTR_H
 	ROTR_W
+	SBC_B
+	SBC_D
+	SBC_H
+	SBC_W
 	SCREL_D
 	SCREL_W
 	SC_D
 	SC_Q
 	SC_W
+	SETARMJ
+	SETX86J
+	SETX86LOOPE
+	SETX86LOOPNE
 	SLLI_D
 	SLLI_W
 	SLL_D
@@ -386,8 +450,12 @@ const (
 	STLE_D
 	STLE_H
 	STLE_W
+	STL_D
+	STL_W
 	STPTR_D
 	STPTR_W
+	STR_D
+	STR_W
 	STX_B
 	STX_D
 	STX_H
@@ -405,417 +473,2057 @@ const (
 	TLBRD
 	TLBSRCH
 	TLBWR
+	VADDI_BU
+	VADDI_DU
+	VADDI_HU
+	VADDI_WU
+	VADDWEV_D_W
+	VADDWEV_D_WU
+	VADDWEV_H_B
+	VADDWEV_H_BU
+	VADDWEV_Q_D
+	VADDWEV_Q_DU
+	VADDWEV_W_H
+	VADDWEV_W_HU
+	VADDWOD_D_W
+	VADDWOD_D_WU
+	VADDWOD_H_B
+	VADDWOD_H_BU
+	VADDWOD_Q_D
+	VADDWOD_Q_DU
+	VADDWOD_W_H
+	VADDWOD_W_HU
+	VADD_B
+	VADD_D
+	VADD_H
+	VADD_Q
+	VADD_W
+	VANDI_B
+	VANDN_V
+	VAND_V
+	VBITCLRI_B
+	VBITCLRI_D
+	VBITCLRI_H
+	VBITCLRI_W
+	VBITCLR_B
+	VBITCLR_D
+	VBITCLR_H
+	VBITCLR_W
+	VBITREVI_B
+	VBITREVI_D
+	VBITREVI_H
+	VBITREVI_W
+	VBITREV_B
+	VBITREV_D
+	VBITREV_H
+	VBITREV_W
+	VBITSETI_B
+	VBITSETI_D
+	VBITSETI_H
+	VBITSETI_W
+	VBITSET_B
+	VBITSET_D
+	VBITSET_H
+	VBITSET_W
+	VDIV_B
+	VDIV_BU
+	VDIV_D
+	VDIV_DU
+	VDIV_H
+	VDIV_HU
+	VDIV_W
+	VDIV_WU
+	VEXTRINS_B
+	VEXTRINS_D
+	VEXTRINS_H
+	VEXTRINS_W
+	VFADD_D
+	VFADD_S
+	VFCLASS_D
+	VFCLASS_S
+	VFDIV_D
+	VFDIV_S
+	VFMUL_D
+	VFMUL_S
+	VFRECIP_D
+	VFRECIP_S
+	VFRINTRM_D
+	VFRINTRM_S
+	VFRINTRNE_D
+	VFRINTRNE_S
+	VFRINTRP_D
+	VFRINTRP_S
+	VFRINTRZ_D
+	VFRINTRZ_S
+	VFRINT_D
+	VFRINT_S
+	VFRSQRT_D
+	VFRSQRT_S
+	VFSQRT_D
+	VFSQRT_S
+	VFSUB_D
+	VFSUB_S
+	VILVH_B
+	VILVH_D
+	VILVH_H
+	VILVH_W
+	VILVL_B
+	VILVL_D
+	VILVL_H
+	VILVL_W
+	VINSGR2VR_B
+	VINSGR2VR_D
+	VINSGR2VR_H
+	VINSGR2VR_W
+	VLD
+	VLDREPL_B
+	VLDREPL_D
+	VLDREPL_H
+	VLDREPL_W
+	VLDX
+	VMADDWEV_D_W
+	VMADDWEV_D_WU
+	VMADDWEV_D_WU_W
+	VMADDWEV_H_B
+	VMADDWEV_H_BU
+	VMADDWEV_H_BU_B
+	VMADDWEV_Q_D
+	VMADDWEV_Q_DU
+	VMADDWEV_Q_DU_D
+	VMADDWEV_W_H
+	VMADDWEV_W_HU
+	VMADDWEV_W_HU_H
+	VMADDWOD_D_W
+	VMADDWOD_D_WU
+	VMADDWOD_D_WU_W
+	VMADDWOD_H_B
+	VMADDWOD_H_BU
+	VMADDWOD_H_BU_B
+	VMADDWOD_Q_D
+	VMADDWOD_Q_DU
+	VMADDWOD_Q_DU_D
+	VMADDWOD_W_H
+	VMADDWOD_W_HU
+	VMADDWOD_W_HU_H
+	VMADD_B
+	VMADD_D
+	VMADD_H
+	VMADD_W
+	VMOD_B
+	VMOD_BU
+	VMOD_D
+	VMOD_DU
+	VMOD_H
+	VMOD_HU
+	VMOD_W
+	VMOD_WU
+	VMSUB_B
+	VMSUB_D
+	VMSUB_H
+	VMSUB_W
+	VMUH_B
+	VMUH_BU
+	VMUH_D
+	VMUH_DU
+	VMUH_H
+	VMUH_HU
+	VMUH_W
+	VMUH_WU
+	VMULWEV_D_W
+	VMULWEV_D_WU
+	VMULWEV_D_WU_W
+	VMULWEV_H_B
+	VMULWEV_H_BU
+	VMULWEV_H_BU_B
+	VMULWEV_Q_D
+	VMULWEV_Q_DU
+	VMULWEV_Q_DU_D
+	VMULWEV_W_H
+	VMULWEV_W_HU
+	VMULWEV_W_HU_H
+	VMULWOD_D_W
+	VMULWOD_D_WU
+	VMULWOD_D_WU_W
+	VMULWOD_H_B
+	VMULWOD_H_BU
+	VMULWOD_H_BU_B
+	VMULWOD_Q_D
+	VMULWOD_Q_DU
+	VMULWOD_Q_DU_D
+	VMULWOD_W_H
+	VMULWOD_W_HU
+	VMULWOD_W_HU_H
+	VMUL_B
+	VMUL_D
+	VMUL_H
+	VMUL_W
+	VNEG_B
+	VNEG_D
+	VNEG_H
+	VNEG_W
+	VNORI_B
+	VNOR_V
+	VORI_B
+	VORN_V
+	VOR_V
+	VPCNT_B
+	VPCNT_D
+	VPCNT_H
+	VPCNT_W
+	VPERMI_W
+	VPICKVE2GR_B
+	VPICKVE2GR_BU
+	VPICKVE2GR_D
+	VPICKVE2GR_DU
+	VPICKVE2GR_H
+	VPICKVE2GR_HU
+	VPICKVE2GR_W
+	VPICKVE2GR_WU
+	VREPLGR2VR_B
+	VREPLGR2VR_D
+	VREPLGR2VR_H
+	VREPLGR2VR_W
+	VREPLVEI_B
+	VREPLVEI_D
+	VREPLVEI_H
+	VREPLVEI_W
+	VROTRI_B
+	VROTRI_D
+	VROTRI_H
+	VROTRI_W
+	VROTR_B
+	VROTR_D
+	VROTR_H
+	VROTR_W
+	VSADD_B
+	VSADD_BU
+	VSADD_D
+	VSADD_DU
+	VSADD_H
+	VSADD_HU
+	VSADD_W
+	VSADD_WU
+	VSEQI_B
+	VSEQI_D
+	VSEQI_H
+	VSEQI_W
+	VSEQ_B
+	VSEQ_D
+	VSEQ_H
+	VSEQ_W
+	VSETALLNEZ_B
+	VSETALLNEZ_D
+	VSETALLNEZ_H
+	VSETALLNEZ_W
+	VSETANYEQZ_B
+	VSETANYEQZ_D
+	VSETANYEQZ_H
+	VSETANYEQZ_W
+	VSETEQZ_V
+	VSETNEZ_V
+	VSHUF4I_B
+	VSHUF4I_D
+	VSHUF4I_H
+	VSHUF4I_W
+	VSHUF_B
+	VSHUF_D
+	VSHUF_H
+	VSHUF_W
+	VSLLI_B
+	VSLLI_D
+	VSLLI_H
+	VSLLI_W
+	VSLL_B
+	VSLL_D
+	VSLL_H
+	VSLL_W
+	VSLTI_B
+	VSLTI_BU
+	VSLTI_D
+	VSLTI_DU
+	VSLTI_H
+	VSLTI_HU
+	VSLTI_W
+	VSLTI_WU
+	VSLT_B
+	VSLT_BU
+	VSLT_D
+	VSLT_DU
+	VSLT_H
+	VSLT_HU
+	VSLT_W
+	VSLT_WU
+	VSRAI_B
+	VSRAI_D
+	VSRAI_H
+	VSRAI_W
+	VSRA_B
+	VSRA_D
+	VSRA_H
+	VSRA_W
+	VSRLI_B
+	VSRLI_D
+	VSRLI_H
+	VSRLI_W
+	VSRL_B
+	VSRL_D
+	VSRL_H
+	VSRL_W
+	VSSUB_B
+	VSSUB_BU
+	VSSUB_D
+	VSSUB_DU
+	VSSUB_H
+	VSSUB_HU
+	VSSUB_W
+	VSSUB_WU
+	VST
+	VSTX
+	VSUBI_BU
+	VSUBI_DU
+	VSUBI_HU
+	VSUBI_WU
+	VSUBWEV_D_W
+	VSUBWEV_D_WU
+	VSUBWEV_H_B
+	VSUBWEV_H_BU
+	VSUBWEV_Q_D
+	VSUBWEV_Q_DU
+	VSUBWEV_W_H
+	VSUBWEV_W_HU
+	VSUBWOD_D_W
+	VSUBWOD_D_WU
+	VSUBWOD_H_B
+	VSUBWOD_H_BU
+	VSUBWOD_Q_D
+	VSUBWOD_Q_DU
+	VSUBWOD_W_H
+	VSUBWOD_W_HU
+	VSUB_B
+	VSUB_D
+	VSUB_H
+	VSUB_Q
+	VSUB_W
+	VXORI_B
+	VXOR_V
+	X86ADC_B
+	X86ADC_D
+	X86ADC_H
+	X86ADC_W
+	X86ADD_B
+	X86ADD_D
+	X86ADD_DU
+	X86ADD_H
+	X86ADD_W
+	X86ADD_WU
+	X86AND_B
+	X86AND_D
+	X86AND_H
+	X86AND_W
+	X86CLRTM
+	X86DECTOP
+	X86DEC_B
+	X86DEC_D
+	X86DEC_H
+	X86DEC_W
+	X86INCTOP
+	X86INC_B
+	X86INC_D
+	X86INC_H
+	X86INC_W
+	X86MFFLAG
+	X86MFTOP
+	X86MTFLAG
+	X86MTTOP
+	X86MUL_B
+	X86MUL_BU
+	X86MUL_D
+	X86MUL_DU
+	X86MUL_H
+	X86MUL_HU
+	X86MUL_W
+	X86MUL_WU
+	X86OR_B
+	X86OR_D
+	X86OR_H
+	X86OR_W
+	X86RCLI_B
+	X86RCLI_D
+	X86RCLI_H
+	X86RCLI_W
+	X86RCL_B
+	X86RCL_D
+	X86RCL_H
+	X86RCL_W
+	X86RCRI_B
+	X86RCRI_D
+	X86RCRI_H
+	X86RCRI_W
+	X86RCR_B
+	X86RCR_D
+	X86RCR_H
+	X86RCR_W
+	X86ROTLI_B
+	X86ROTLI_D
+	X86ROTLI_H
+	X86ROTLI_W
+	X86ROTL_B
+	X86ROTL_D
+	X86ROTL_H
+	X86ROTL_W
+	X86ROTRI_B
+	X86ROTRI_D
+	X86ROTRI_H
+	X86ROTRI_W
+	X86ROTR_B
+	X86ROTR_D
+	X86ROTR_H
+	X86ROTR_W
+	X86SBC_B
+	X86SBC_D
+	X86SBC_H
+	X86SBC_W
+	X86SETTAG
+	X86SETTM
+	X86SLLI_B
+	X86SLLI_D
+	X86SLLI_H
+	X86SLLI_W
+	X86SLL_B
+	X86SLL_D
+	X86SLL_H
+	X86SLL_W
+	X86SRAI_B
+	X86SRAI_D
+	X86SRAI_H
+	X86SRAI_W
+	X86SRA_B
+	X86SRA_D
+	X86SRA_H
+	X86SRA_W
+	X86SRLI_B
+	X86SRLI_D
+	X86SRLI_H
+	X86SRLI_W
+	X86SRL_B
+	X86SRL_D
+	X86SRL_H
+	X86SRL_W
+	X86SUB_B
+	X86SUB_D
+	X86SUB_DU
+	X86SUB_H
+	X86SUB_W
+	X86SUB_WU
+	X86XOR_B
+	X86XOR_D
+	X86XOR_H
+	X86XOR_W
 	XOR
 	XORI
+	XVADDI_BU
+	XVADDI_DU
+	XVADDI_HU
+	XVADDI_WU
+	XVADDWEV_D_W
+	XVADDWEV_D_WU
+	XVADDWEV_H_B
+	XVADDWEV_H_BU
+	XVADDWEV_Q_D
+	XVADDWEV_Q_DU
+	XVADDWEV_W_H
+	XVADDWEV_W_HU
+	XVADDWOD_D_W
+	XVADDWOD_D_WU
+	XVADDWOD_H_B
+	XVADDWOD_H_BU
+	XVADDWOD_Q_D
+	XVADDWOD_Q_DU
+	XVADDWOD_W_H
+	XVADDWOD_W_HU
+	XVADD_B
+	XVADD_D
+	XVADD_H
+	XVADD_Q
+	XVADD_W
+	XVANDI_B
+	XVANDN_V
+	XVAND_V
+	XVBITCLRI_B
+	XVBITCLRI_D
+	XVBITCLRI_H
+	XVBITCLRI_W
+	XVBITCLR_B
+	XVBITCLR_D
+	XVBITCLR_H
+	XVBITCLR_W
+	XVBITREVI_B
+	XVBITREVI_D
+	XVBITREVI_H
+	XVBITREVI_W
+	XVBITREV_B
+	XVBITREV_D
+	XVBITREV_H
+	XVBITREV_W
+	XVBITSETI_B
+	XVBITSETI_D
+	XVBITSETI_H
+	XVBITSETI_W
+	XVBITSET_B
+	XVBITSET_D
+	XVBITSET_H
+	XVBITSET_W
+	XVDIV_B
+	XVDIV_BU
+	XVDIV_D
+	XVDIV_DU
+	XVDIV_H
+	XVDIV_HU
+	XVDIV_W
+	XVDIV_WU
+	XVEXTRINS_B
+	XVEXTRINS_D
+	XVEXTRINS_H
+	XVEXTRINS_W
+	XVFADD_D
+	XVFADD_S
+	XVFCLASS_D
+	XVFCLASS_S
+	XVFDIV_D
+	XVFDIV_S
+	XVFMUL_D
+	XVFMUL_S
+	XVFRECIP_D
+	XVFRECIP_S
+	XVFRINTRM_D
+	XVFRINTRM_S
+	XVFRINTRNE_D
+	XVFRINTRNE_S
+	XVFRINTRP_D
+	XVFRINTRP_S
+	XVFRINTRZ_D
+	XVFRINTRZ_S
+	XVFRINT_D
+	XVFRINT_S
+	XVFRSQRT_D
+	XVFRSQRT_S
+	XVFSQRT_D
+	XVFSQRT_S
+	XVFSUB_D
+	XVFSUB_S
+	XVILVH_B
+	XVILVH_D
+	XVILVH_H
+	XVILVH_W
+	XVILVL_B
+	XVILVL_D
+	XVILVL_H
+	XVILVL_W
+	XVINSGR2VR_D
+	XVINSGR2VR_W
+	XVINSVE0_D
+	XVINSVE0_W
+	XVLD
+	XVLDREPL_B
+	XVLDREPL_D
+	XVLDREPL_H
+	XVLDREPL_W
+	XVLDX
+	XVMADDWEV_D_W
+	XVMADDWEV_D_WU
+	XVMADDWEV_D_WU_W
+	XVMADDWEV_H_B
+	XVMADDWEV_H_BU
+	XVMADDWEV_H_BU_B
+	XVMADDWEV_Q_D
+	XVMADDWEV_Q_DU
+	XVMADDWEV_Q_DU_D
+	XVMADDWEV_W_H
+	XVMADDWEV_W_HU
+	XVMADDWEV_W_HU_H
+	XVMADDWOD_D_W
+	XVMADDWOD_D_WU
+	XVMADDWOD_D_WU_W
+	XVMADDWOD_H_B
+	XVMADDWOD_H_BU
+	XVMADDWOD_H_BU_B
+	XVMADDWOD_Q_D
+	XVMADDWOD_Q_DU
+	XVMADDWOD_Q_DU_D
+	XVMADDWOD_W_H
+	XVMADDWOD_W_HU
+	XVMADDWOD_W_HU_H
+	XVMADD_B
+	XVMADD_D
+	XVMADD_H
+	XVMADD_W
+	XVMOD_B
+	XVMOD_BU
+	XVMOD_D
+	XVMOD_DU
+	XVMOD_H
+	XVMOD_HU
+	XVMOD_W
+	XVMOD_WU
+	XVMSUB_B
+	XVMSUB_D
+	XVMSUB_H
+	XVMSUB_W
+	XVMUH_B
+	XVMUH_BU
+	XVMUH_D
+	XVMUH_DU
+	XVMUH_H
+	XVMUH_HU
+	XVMUH_W
+	XVMUH_WU
+	XVMULWEV_D_W
+	XVMULWEV_D_WU
+	XVMULWEV_D_WU_W
+	XVMULWEV_H_B
+	XVMULWEV_H_BU
+	XVMULWEV_H_BU_B
+	XVMULWEV_Q_D
+	XVMULWEV_Q_DU
+	XVMULWEV_Q_DU_D
+	XVMULWEV_W_H
+	XVMULWEV_W_HU
+	XVMULWEV_W_HU_H
+	XVMULWOD_D_W
+	XVMULWOD_D_WU
+	XVMULWOD_D_WU_W
+	XVMULWOD_H_B
+	XVMULWOD_H_BU
+	XVMULWOD_H_BU_B
+	XVMULWOD_Q_D
+	XVMULWOD_Q_DU
+	XVMULWOD_Q_DU_D
+	XVMULWOD_W_H
+	XVMULWOD_W_HU
+	XVMULWOD_W_HU_H
+	XVMUL_B
+	XVMUL_D
+	XVMUL_H
+	XVMUL_W
+	XVNEG_B
+	XVNEG_D
+	XVNEG_H
+	XVNEG_W
+	XVNORI_B
+	XVNOR_V
+	XVORI_B
+	XVORN_V
+	XVOR_V
+	XVPCNT_B
+	XVPCNT_D
+	XVPCNT_H
+	XVPCNT_W
+	XVPERMI_D
+	XVPERMI_Q
+	XVPERMI_W
+	XVPICKVE2GR_D
+	XVPICKVE2GR_DU
+	XVPICKVE2GR_W
+	XVPICKVE2GR_WU
+	XVPICKVE_D
+	XVPICKVE_W
+	XVREPLGR2VR_B
+	XVREPLGR2VR_D
+	XVREPLGR2VR_H
+	XVREPLGR2VR_W
+	XVREPLVE0_B
+	XVREPLVE0_D
+	XVREPLVE0_H
+	XVREPLVE0_Q
+	XVREPLVE0_W
+	XVROTRI_B
+	XVROTRI_D
+	XVROTRI_H
+	XVROTRI_W
+	XVROTR_B
+	XVROTR_D
+	XVROTR_H
+	XVROTR_W
+	XVSADD_B
+	XVSADD_BU
+	XVSADD_D
+	XVSADD_DU
+	XVSADD_H
+	XVSADD_HU
+	XVSADD_W
+	XVSADD_WU
+	XVSEQI_B
+	XVSEQI_D
+	XVSEQI_H
+	XVSEQI_W
+	XVSEQ_B
+	XVSEQ_D
+	XVSEQ_H
+	XVSEQ_W
+	XVSETALLNEZ_B
+	XVSETALLNEZ_D
+	XVSETALLNEZ_H
+	XVSETALLNEZ_W
+	XVSETANYEQZ_B
+	XVSETANYEQZ_D
+	XVSETANYEQZ_H
+	XVSETANYEQZ_W
+	XVSETEQZ_V
+	XVSETNEZ_V
+	XVSHUF4I_B
+	XVSHUF4I_D
+	XVSHUF4I_H
+	XVSHUF4I_W
+	XVSHUF_B
+	XVSHUF_D
+	XVSHUF_H
+	XVSHUF_W
+	XVSLLI_B
+	XVSLLI_D
+	XVSLLI_H
+	XVSLLI_W
+	XVSLL_B
+	XVSLL_D
+	XVSLL_H
+	XVSLL_W
+	XVSLTI_B
+	XVSLTI_BU
+	XVSLTI_D
+	XVSLTI_DU
+	XVSLTI_H
+	XVSLTI_HU
+	XVSLTI_W
+	XVSLTI_WU
+	XVSLT_B
+	XVSLT_BU
+	XVSLT_D
+	XVSLT_DU
+	XVSLT_H
+	XVSLT_HU
+	XVSLT_W
+	XVSLT_WU
+	XVSRAI_B
+	XVSRAI_D
+	XVSRAI_H
+	XVSRAI_W
+	XVSRA_B
+	XVSRA_D
+	XVSRA_H
+	XVSRA_W
+	XVSRLI_B
+	XVSRLI_D
+	XVSRLI_H
+	XVSRLI_W
+	XVSRL_B
+	XVSRL_D
+	XVSRL_H
+	XVSRL_W
+	XVSSUB_B
+	XVSSUB_BU
+	XVSSUB_D
+	XVSSUB_DU
+	XVSSUB_H
+	XVSSUB_HU
+	XVSSUB_W
+	XVSSUB_WU
+	XVST
+	XVSTX
+	XVSUBI_BU
+	XVSUBI_DU
+	XVSUBI_HU
+	XVSUBI_WU
+	XVSUBWEV_D_W
+	XVSUBWEV_D_WU
+	XVSUBWEV_H_B
+	XVSUBWEV_H_BU
+	XVSUBWEV_Q_D
+	XVSUBWEV_Q_DU
+	XVSUBWEV_W_H
+	XVSUBWEV_W_HU
+	XVSUBWOD_D_W
+	XVSUBWOD_D_WU
+	XVSUBWOD_H_B
+	XVSUBWOD_H_BU
+	XVSUBWOD_Q_D
+	XVSUBWOD_Q_DU
+	XVSUBWOD_W_H
+	XVSUBWOD_W_HU
+	XVSUB_B
+	XVSUB_D
+	XVSUB_H
+	XVSUB_Q
+	XVSUB_W
+	XVXORI_B
+	XVXOR_V
 )
 
 var opstr = [...]string{
-	ADDI_D:       "ADDI.D",
-	ADDI_W:       "ADDI.W",
-	ADDU16I_D:    "ADDU16I.D",
-	ADD_D:        "ADD.D",
-	ADD_W:        "ADD.W",
-	ALSL_D:       "ALSL.D",
-	ALSL_W:       "ALSL.W",
-	ALSL_WU:      "ALSL.WU",
-	AMADD_B:      "AMADD.B",
-	AMADD_D:      "AMADD.D",
-	AMADD_DB_B:   "AMADD_DB.B",
-	AMADD_DB_D:   "AMADD_DB.D",
-	AMADD_DB_H:   "AMADD_DB.H",
-	AMADD_DB_W:   "AMADD_DB.W",
-	AMADD_H:      "AMADD.H",
-	AMADD_W:      "AMADD.W",
-	AMAND_D:      "AMAND.D",
-	AMAND_DB_D:   "AMAND_DB.D",
-	AMAND_DB_W:   "AMAND_DB.W",
-	AMAND_W:      "AMAND.W",
-	AMCAS_B:      "AMCAS.B",
-	AMCAS_D:      "AMCAS.D",
-	AMCAS_DB_B:   "AMCAS_DB.B",
-	AMCAS_DB_D:   "AMCAS_DB.D",
-	AMCAS_DB_H:   "AMCAS_DB.H",
-	AMCAS_DB_W:   "AMCAS_DB.W",
-	AMCAS_H:      "AMCAS.H",
-	AMCAS_W:      "AMCAS.W",
-	AMMAX_D:      "AMMAX.D",
-	AMMAX_DB_D:   "AMMAX_DB.D",
-	AMMAX_DB_DU:  "AMMAX_DB.DU",
-	AMMAX_DB_W:   "AMMAX_DB.W",
-	AMMAX_DB_WU:  "AMMAX_DB.WU",
-	AMMAX_DU:     "AMMAX.DU",
-	AMMAX_W:      "AMMAX.W",
-	AMMAX_WU:     "AMMAX.WU",
-	AMMIN_D:      "AMMIN.D",
-	AMMIN_DB_D:   "AMMIN_DB.D",
-	AMMIN_DB_DU:  "AMMIN_DB.DU",
-	AMMIN_DB_W:   "AMMIN_DB.W",
-	AMMIN_DB_WU:  "AMMIN_DB.WU",
-	AMMIN_DU:     "AMMIN.DU",
-	AMMIN_W:      "AMMIN.W",
-	AMMIN_WU:     "AMMIN.WU",
-	AMOR_D:       "AMOR.D",
-	AMOR_DB_D:    "AMOR_DB.D",
-	AMOR_DB_W:    "AMOR_DB.W",
-	AMOR_W:       "AMOR.W",
-	AMSWAP_B:     "AMSWAP.B",
-	AMSWAP_D:     "AMSWAP.D",
-	AMSWAP_DB_B:  "AMSWAP_DB.B",
-	AMSWAP_DB_D:  "AMSWAP_DB.D",
-	AMSWAP_DB_H:  "AMSWAP_DB.H",
-	AMSWAP_DB_W:  "AMSWAP_DB.W",
-	AMSWAP_H:     "AMSWAP.H",
-	AMSWAP_W:     "AMSWAP.W",
-	AMXOR_D:      "AMXOR.D",
-	AMXOR_DB_D:   "AMXOR_DB.D",
-	AMXOR_DB_W:   "AMXOR_DB.W",
-	AMXOR_W:      "AMXOR.W",
-	AND:          "AND",
-	ANDI:         "ANDI",
-	ANDN:         "ANDN",
-	ASRTGT_D:     "ASRTGT.D",
-	ASRTLE_D:     "ASRTLE.D",
-	B:            "B",
-	BCEQZ:        "BCEQZ",
-	BCNEZ:        "BCNEZ",
-	BEQ:          "BEQ",
-	BEQZ:         "BEQZ",
-	BGE:          "BGE",
-	BGEU:         "BGEU",
-	BITREV_4B:    "BITREV.4B",
-	BITREV_8B:    "BITREV.8B",
-	BITREV_D:     "BITREV.D",
-	BITREV_W:     "BITREV.W",
-	BL:           "BL",
-	BLT:          "BLT",
-	BLTU:         "BLTU",
-	BNE:          "BNE",
-	BNEZ:         "BNEZ",
-	BREAK:        "BREAK",
-	BSTRINS_D:    "BSTRINS.D",
-	BSTRINS_W:    "BSTRINS.W",
-	BSTRPICK_D:   "BSTRPICK.D",
-	BSTRPICK_W:   "BSTRPICK.W",
-	BYTEPICK_D:   "BYTEPICK.D",
-	BYTEPICK_W:   "BYTEPICK.W",
-	CACOP:        "CACOP",
-	CLO_D:        "CLO.D",
-	CLO_W:        "CLO.W",
-	CLZ_D:        "CLZ.D",
-	CLZ_W:        "CLZ.W",
-	CPUCFG:       "CPUCFG",
-	CRCC_W_B_W:   "CRCC.W.B.W",
-	CRCC_W_D_W:   "CRCC.W.D.W",
-	CRCC_W_H_W:   "CRCC.W.H.W",
-	CRCC_W_W_W:   "CRCC.W.W.W",
-	CRC_W_B_W:    "CRC.W.B.W",
-	CRC_W_D_W:    "CRC.W.D.W",
-	CRC_W_H_W:    "CRC.W.H.W",
-	CRC_W_W_W:    "CRC.W.W.W",
-	CSRRD:        "CSRRD",
-	CSRWR:        "CSRWR",
-	CSRXCHG:      "CSRXCHG",
-	CTO_D:        "CTO.D",
-	CTO_W:        "CTO.W",
-	CTZ_D:        "CTZ.D",
-	CTZ_W:        "CTZ.W",
-	DBAR:         "DBAR",
-	DBCL:         "DBCL",
-	DIV_D:        "DIV.D",
-	DIV_DU:       "DIV.DU",
-	DIV_W:        "DIV.W",
-	DIV_WU:       "DIV.WU",
-	ERTN:         "ERTN",
-	EXT_W_B:      "EXT.W.B",
-	EXT_W_H:      "EXT.W.H",
-	FABS_D:       "FABS.D",
-	FABS_S:       "FABS.S",
-	FADD_D:       "FADD.D",
-	FADD_S:       "FADD.S",
-	FCLASS_D:     "FCLASS.D",
-	FCLASS_S:     "FCLASS.S",
-	FCMP_CAF_D:   "FCMP.CAF.D",
-	FCMP_CAF_S:   "FCMP.CAF.S",
-	FCMP_CEQ_D:   "FCMP.CEQ.D",
-	FCMP_CEQ_S:   "FCMP.CEQ.S",
-	FCMP_CLE_D:   "FCMP.CLE.D",
-	FCMP_CLE_S:   "FCMP.CLE.S",
-	FCMP_CLT_D:   "FCMP.CLT.D",
-	FCMP_CLT_S:   "FCMP.CLT.S",
-	FCMP_CNE_D:   "FCMP.CNE.D",
-	FCMP_CNE_S:   "FCMP.CNE.S",
-	FCMP_COR_D:   "FCMP.COR.D",
-	FCMP_COR_S:   "FCMP.COR.S",
-	FCMP_CUEQ_D:  "FCMP.CUEQ.D",
-	FCMP_CUEQ_S:  "FCMP.CUEQ.S",
-	FCMP_CULE_D:  "FCMP.CULE.D",
-	FCMP_CULE_S:  "FCMP.CULE.S",
-	FCMP_CULT_D:  "FCMP.CULT.D",
-	FCMP_CULT_S:  "FCMP.CULT.S",
-	FCMP_CUNE_D:  "FCMP.CUNE.D",
-	FCMP_CUNE_S:  "FCMP.CUNE.S",
-	FCMP_CUN_D:   "FCMP.CUN.D",
-	FCMP_CUN_S:   "FCMP.CUN.S",
-	FCMP_SAF_D:   "FCMP.SAF.D",
-	FCMP_SAF_S:   "FCMP.SAF.S",
-	FCMP_SEQ_D:   "FCMP.SEQ.D",
-	FCMP_SEQ_S:   "FCMP.SEQ.S",
-	FCMP_SLE_D:   "FCMP.SLE.D",
-	FCMP_SLE_S:   "FCMP.SLE.S",
-	FCMP_SLT_D:   "FCMP.SLT.D",
-	FCMP_SLT_S:   "FCMP.SLT.S",
-	FCMP_SNE_D:   "FCMP.SNE.D",
-	FCMP_SNE_S:   "FCMP.SNE.S",
-	FCMP_SOR_D:   "FCMP.SOR.D",
-	FCMP_SOR_S:   "FCMP.SOR.S",
-	FCMP_SUEQ_D:  "FCMP.SUEQ.D",
-	FCMP_SUEQ_S:  "FCMP.SUEQ.S",
-	FCMP_SULE_D:  "FCMP.SULE.D",
-	FCMP_SULE_S:  "FCMP.SULE.S",
-	FCMP_SULT_D:  "FCMP.SULT.D",
-	FCMP_SULT_S:  "FCMP.SULT.S",
-	FCMP_SUNE_D:  "FCMP.SUNE.D",
-	FCMP_SUNE_S:  "FCMP.SUNE.S",
-	FCMP_SUN_D:   "FCMP.SUN.D",
-	FCMP_SUN_S:   "FCMP.SUN.S",
-	FCOPYSIGN_D:  "FCOPYSIGN.D",
-	FCOPYSIGN_S:  "FCOPYSIGN.S",
-	FCVT_D_S:     "FCVT.D.S",
-	FCVT_S_D:     "FCVT.S.D",
-	FDIV_D:       "FDIV.D",
-	FDIV_S:       "FDIV.S",
-	FFINT_D_L:    "FFINT.D.L",
-	FFINT_D_W:    "FFINT.D.W",
-	FFINT_S_L:    "FFINT.S.L",
-	FFINT_S_W:    "FFINT.S.W",
-	FLDGT_D:      "FLDGT.D",
-	FLDGT_S:      "FLDGT.S",
-	FLDLE_D:      "FLDLE.D",
-	FLDLE_S:      "FLDLE.S",
-	FLDX_D:       "FLDX.D",
-	FLDX_S:       "FLDX.S",
-	FLD_D:        "FLD.D",
-	FLD_S:        "FLD.S",
-	FLOGB_D:      "FLOGB.D",
-	FLOGB_S:      "FLOGB.S",
-	FMADD_D:      "FMADD.D",
-	FMADD_S:      "FMADD.S",
-	FMAXA_D:      "FMAXA.D",
-	FMAXA_S:      "FMAXA.S",
-	FMAX_D:       "FMAX.D",
-	FMAX_S:       "FMAX.S",
-	FMINA_D:      "FMINA.D",
-	FMINA_S:      "FMINA.S",
-	FMIN_D:       "FMIN.D",
-	FMIN_S:       "FMIN.S",
-	FMOV_D:       "FMOV.D",
-	FMOV_S:       "FMOV.S",
-	FMSUB_D:      "FMSUB.D",
-	FMSUB_S:      "FMSUB.S",
-	FMUL_D:       "FMUL.D",
-	FMUL_S:       "FMUL.S",
-	FNEG_D:       "FNEG.D",
-	FNEG_S:       "FNEG.S",
-	FNMADD_D:     "FNMADD.D",
-	FNMADD_S:     "FNMADD.S",
-	FNMSUB_D:     "FNMSUB.D",
-	FNMSUB_S:     "FNMSUB.S",
-	FRECIPE_D:    "FRECIPE.D",
-	FRECIPE_S:    "FRECIPE.S",
-	FRECIP_D:     "FRECIP.D",
-	FRECIP_S:     "FRECIP.S",
-	FRINT_D:      "FRINT.D",
-	FRINT_S:      "FRINT.S",
-	FRSQRTE_D:    "FRSQRTE.D",
-	FRSQRTE_S:    "FRSQRTE.S",
-	FRSQRT_D:     "FRSQRT.D",
-	FRSQRT_S:     "FRSQRT.S",
-	FSCALEB_D:    "FSCALEB.D",
-	FSCALEB_S:    "FSCALEB.S",
-	FSEL:         "FSEL",
-	FSQRT_D:      "FSQRT.D",
-	FSQRT_S:      "FSQRT.S",
-	FSTGT_D:      "FSTGT.D",
-	FSTGT_S:      "FSTGT.S",
-	FSTLE_D:      "FSTLE.D",
-	FSTLE_S:      "FSTLE.S",
-	FSTX_D:       "FSTX.D",
-	FSTX_S:       "FSTX.S",
-	FST_D:        "FST.D",
-	FST_S:        "FST.S",
-	FSUB_D:       "FSUB.D",
-	FSUB_S:       "FSUB.S",
-	FTINTRM_L_D:  "FTINTRM.L.D",
-	FTINTRM_L_S:  "FTINTRM.L.S",
-	FTINTRM_W_D:  "FTINTRM.W.D",
-	FTINTRM_W_S:  "FTINTRM.W.S",
-	FTINTRNE_L_D: "FTINTRNE.L.D",
-	FTINTRNE_L_S: "FTINTRNE.L.S",
-	FTINTRNE_W_D: "FTINTRNE.W.D",
-	FTINTRNE_W_S: "FTINTRNE.W.S",
-	FTINTRP_L_D:  "FTINTRP.L.D",
-	FTINTRP_L_S:  "FTINTRP.L.S",
-	FTINTRP_W_D:  "FTINTRP.W.D",
-	FTINTRP_W_S:  "FTINTRP.W.S",
-	FTINTRZ_L_D:  "FTINTRZ.L.D",
-	FTINTRZ_L_S:  "FTINTRZ.L.S",
-	FTINTRZ_W_D:  "FTINTRZ.W.D",
-	FTINTRZ_W_S:  "FTINTRZ.W.S",
-	FTINT_L_D:    "FTINT.L.D",
-	FTINT_L_S:    "FTINT.L.S",
-	FTINT_W_D:    "FTINT.W.D",
-	FTINT_W_S:    "FTINT.W.S",
-	IBAR:         "IBAR",
-	IDLE:         "IDLE",
-	INVTLB:       "INVTLB",
-	IOCSRRD_B:    "IOCSRRD.B",
-	IOCSRRD_D:    "IOCSRRD.D",
-	IOCSRRD_H:    "IOCSRRD.H",
-	IOCSRRD_W:    "IOCSRRD.W",
-	IOCSRWR_B:    "IOCSRWR.B",
-	IOCSRWR_D:    "IOCSRWR.D",
-	IOCSRWR_H:    "IOCSRWR.H",
-	IOCSRWR_W:    "IOCSRWR.W",
-	JIRL:         "JIRL",
-	LDDIR:        "LDDIR",
-	LDGT_B:       "LDGT.B",
-	LDGT_D:       "LDGT.D",
-	LDGT_H:       "LDGT.H",
-	LDGT_W:       "LDGT.W",
-	LDLE_B:       "LDLE.B",
-	LDLE_D:       "LDLE.D",
-	LDLE_H:       "LDLE.H",
-	LDLE_W:       "LDLE.W",
-	LDPTE:        "LDPTE",
-	LDPTR_D:      "LDPTR.D",
-	LDPTR_W:      "LDPTR.W",
-	LDX_B:        "LDX.B",
-	LDX_BU:       "LDX.BU",
-	LDX_D:        "LDX.D",
-	LDX_H:        "LDX.H",
-	LDX_HU:       "LDX.HU",
-	LDX_W:        "LDX.W",
-	LDX_WU:       "LDX.WU",
-	LD_B:         "LD.B",
-	LD_BU:        "LD.BU",
-	LD_D:         "LD.D",
-	LD_H:         "LD.H",
-	LD_HU:        "LD.HU",
-	LD_W:         "LD.W",
-	LD_WU:        "LD.WU",
-	LLACQ_D:      "LLACQ.D",
-	LLACQ_W:      "LLACQ.W",
-	LL_D:         "LL.D",
-	LL_W:         "LL.W",
-	LU12I_W:      "LU12I.W",
-	LU32I_D:      "LU32I.D",
-	LU52I_D:      "LU52I.D",
-	MASKEQZ:      "MASKEQZ",
-	MASKNEZ:      "MASKNEZ",
-	MOD_D:        "MOD.D",
-	MOD_DU:       "MOD.DU",
-	MOD_W:        "MOD.W",
-	MOD_WU:       "MOD.WU",
-	MOVCF2FR:     "MOVCF2FR",
-	MOVCF2GR:     "MOVCF2GR",
-	MOVFCSR2GR:   "MOVFCSR2GR",
-	MOVFR2CF:     "MOVFR2CF",
-	MOVFR2GR_D:   "MOVFR2GR.D",
-	MOVFR2GR_S:   "MOVFR2GR.S",
-	MOVFRH2GR_S:  "MOVFRH2GR.S",
-	MOVGR2CF:     "MOVGR2CF",
-	MOVGR2FCSR:   "MOVGR2FCSR",
-	MOVGR2FRH_W:  "MOVGR2FRH.W",
-	MOVGR2FR_D:   "MOVGR2FR.D",
-	MOVGR2FR_W:   "MOVGR2FR.W",
-	MULH_D:       "MULH.D",
-	MULH_DU:      "MULH.DU",
-	MULH_W:       "MULH.W",
-	MULH_WU:      "MULH.WU",
-	MULW_D_W:     "MULW.D.W",
-	MULW_D_WU:    "MULW.D.WU",
-	MUL_D:        "MUL.D",
-	MUL_W:        "MUL.W",
-	NOR:          "NOR",
-	OR:           "OR",
-	ORI:          "ORI",
-	ORN:          "ORN",
-	PCADDI:       "PCADDI",
-	PCADDU12I:    "PCADDU12I",
-	PCADDU18I:    "PCADDU18I",
-	PCALAU12I:    "PCALAU12I",
-	PRELD:        "PRELD",
-	PRELDX:       "PRELDX",
-	RDTIMEH_W:    "RDTIMEH.W",
-	RDTIMEL_W:    "RDTIMEL.W",
-	RDTIME_D:     "RDTIME.D",
-	REVB_2H:      "REVB.2H",
-	REVB_2W:      "REVB.2W",
-	REVB_4H:      "REVB.4H",
-	REVB_D:       "REVB.D",
-	REVH_2W:      "REVH.2W",
-	REVH_D:       "REVH.D",
-	ROTRI_D:      "ROTRI.D",
-	ROTRI_W:      "ROTRI.W",
-	ROTR_D:       "ROTR.D",
-	ROTR_W:       "ROTR.W",
-	SCREL_D:      "SCREL.D",
-	SCREL_W:      "SCREL.W",
-	SC_D:         "SC.D",
-	SC_Q:         "SC.Q",
-	SC_W:         "SC.W",
-	SLLI_D:       "SLLI.D",
-	SLLI_W:       "SLLI.W",
-	SLL_D:        "SLL.D",
-	SLL_W:        "SLL.W",
-	SLT:          "SLT",
-	SLTI:         "SLTI",
-	SLTU:         "SLTU",
-	SLTUI:        "SLTUI",
-	SRAI_D:       "SRAI.D",
-	SRAI_W:       "SRAI.W",
-	SRA_D:        "SRA.D",
-	SRA_W:        "SRA.W",
-	SRLI_D:       "SRLI.D",
-	SRLI_W:       "SRLI.W",
-	SRL_D:        "SRL.D",
-	SRL_W:        "SRL.W",
-	STGT_B:       "STGT.B",
-	STGT_D:       "STGT.D",
-	STGT_H:       "STGT.H",
-	STGT_W:       "STGT.W",
-	STLE_B:       "STLE.B",
-	STLE_D:       "STLE.D",
-	STLE_H:       "STLE.H",
-	STLE_W:       "STLE.W",
-	STPTR_D:      "STPTR.D",
-	STPTR_W:      "STPTR.W",
-	STX_B:        "STX.B",
-	STX_D:        "STX.D",
-	STX_H:        "STX.H",
-	STX_W:        "STX.W",
-	ST_B:         "ST.B",
-	ST_D:         "ST.D",
-	ST_H:         "ST.H",
-	ST_W:         "ST.W",
-	SUB_D:        "SUB.D",
-	SUB_W:        "SUB.W",
-	SYSCALL:      "SYSCALL",
-	TLBCLR:       "TLBCLR",
-	TLBFILL:      "TLBFILL",
-	TLBFLUSH:     "TLBFLUSH",
-	TLBRD:        "TLBRD",
-	TLBSRCH:      "TLBSRCH",
-	TLBWR:        "TLBWR",
-	XOR:          "XOR",
-	XORI:         "XORI",
+	ADC_B:            "ADC.B",
+	ADC_D:            "ADC.D",
+	ADC_H:            "ADC.H",
+	ADC_W:            "ADC.W",
+	ADDI_D:           "ADDI.D",
+	ADDI_W:           "ADDI.W",
+	ADDU12I_D:        "ADDU12I.D",
+	ADDU12I_W:        "ADDU12I.W",
+	ADDU16I_D:        "ADDU16I.D",
+	ADD_D:            "ADD.D",
+	ADD_W:            "ADD.W",
+	ALSL_D:           "ALSL.D",
+	ALSL_W:           "ALSL.W",
+	ALSL_WU:          "ALSL.WU",
+	AMADD_B:          "AMADD.B",
+	AMADD_D:          "AMADD.D",
+	AMADD_DB_B:       "AMADD_DB.B",
+	AMADD_DB_D:       "AMADD_DB.D",
+	AMADD_DB_H:       "AMADD_DB.H",
+	AMADD_DB_W:       "AMADD_DB.W",
+	AMADD_H:          "AMADD.H",
+	AMADD_W:          "AMADD.W",
+	AMAND_D:          "AMAND.D",
+	AMAND_DB_D:       "AMAND_DB.D",
+	AMAND_DB_W:       "AMAND_DB.W",
+	AMAND_W:          "AMAND.W",
+	AMCAS_B:          "AMCAS.B",
+	AMCAS_D:          "AMCAS.D",
+	AMCAS_DB_B:       "AMCAS_DB.B",
+	AMCAS_DB_D:       "AMCAS_DB.D",
+	AMCAS_DB_H:       "AMCAS_DB.H",
+	AMCAS_DB_W:       "AMCAS_DB.W",
+	AMCAS_H:          "AMCAS.H",
+	AMCAS_W:          "AMCAS.W",
+	AMMAX_D:          "AMMAX.D",
+	AMMAX_DB_D:       "AMMAX_DB.D",
+	AMMAX_DB_DU:      "AMMAX_DB.DU",
+	AMMAX_DB_W:       "AMMAX_DB.W",
+	AMMAX_DB_WU:      "AMMAX_DB.WU",
+	AMMAX_DU:         "AMMAX.DU",
+	AMMAX_W:          "AMMAX.W",
+	AMMAX_WU:         "AMMAX.WU",
+	AMMIN_D:          "AMMIN.D",
+	AMMIN_DB_D:       "AMMIN_DB.D",
+	AMMIN_DB_DU:      "AMMIN_DB.DU",
+	AMMIN_DB_W:       "AMMIN_DB.W",
+	AMMIN_DB_WU:      "AMMIN_DB.WU",
+	AMMIN_DU:         "AMMIN.DU",
+	AMMIN_W:          "AMMIN.W",
+	AMMIN_WU:         "AMMIN.WU",
+	AMOR_D:           "AMOR.D",
+	AMOR_DB_D:        "AMOR_DB.D",
+	AMOR_DB_W:        "AMOR_DB.W",
+	AMOR_W:           "AMOR.W",
+	AMSWAP_B:         "AMSWAP.B",
+	AMSWAP_D:         "AMSWAP.D",
+	AMSWAP_DB_B:      "AMSWAP_DB.B",
+	AMSWAP_DB_D:      "AMSWAP_DB.D",
+	AMSWAP_DB_H:      "AMSWAP_DB.H",
+	AMSWAP_DB_W:      "AMSWAP_DB.W",
+	AMSWAP_H:         "AMSWAP.H",
+	AMSWAP_W:         "AMSWAP.W",
+	AMXOR_D:          "AMXOR.D",
+	AMXOR_DB_D:       "AMXOR_DB.D",
+	AMXOR_DB_W:       "AMXOR_DB.W",
+	AMXOR_W:          "AMXOR.W",
+	AND:              "AND",
+	ANDI:             "ANDI",
+	ANDN:             "ANDN",
+	ARMADC_W:         "ARMADC.W",
+	ARMADD_W:         "ARMADD.W",
+	ARMAND_W:         "ARMAND.W",
+	ARMMFFLAG:        "ARMMFFLAG",
+	ARMMOVE:          "ARMMOVE",
+	ARMMOV_D:         "ARMMOV.D",
+	ARMMOV_W:         "ARMMOV.W",
+	ARMMTFLAG:        "ARMMTFLAG",
+	ARMNOT_W:         "ARMNOT.W",
+	ARMOR_W:          "ARMOR.W",
+	ARMROTRI_W:       "ARMROTRI.W",
+	ARMROTR_W:        "ARMROTR.W",
+	ARMRRX_W:         "ARMRRX.W",
+	ARMSBC_W:         "ARMSBC.W",
+	ARMSLLI_W:        "ARMSLLI.W",
+	ARMSLL_W:         "ARMSLL.W",
+	ARMSRAI_W:        "ARMSRAI.W",
+	ARMSRA_W:         "ARMSRA.W",
+	ARMSRLI_W:        "ARMSRLI.W",
+	ARMSRL_W:         "ARMSRL.W",
+	ARMSUB_W:         "ARMSUB.W",
+	ARMXOR_W:         "ARMXOR.W",
+	ASRTGT_D:         "ASRTGT.D",
+	ASRTLE_D:         "ASRTLE.D",
+	B:                "B",
+	BCEQZ:            "BCEQZ",
+	BCNEZ:            "BCNEZ",
+	BEQ:              "BEQ",
+	BEQZ:             "BEQZ",
+	BGE:              "BGE",
+	BGEU:             "BGEU",
+	BITREV_4B:        "BITREV.4B",
+	BITREV_8B:        "BITREV.8B",
+	BITREV_D:         "BITREV.D",
+	BITREV_W:         "BITREV.W",
+	BL:               "BL",
+	BLT:              "BLT",
+	BLTU:             "BLTU",
+	BNE:              "BNE",
+	BNEZ:             "BNEZ",
+	BREAK:            "BREAK",
+	BSTRINS_D:        "BSTRINS.D",
+	BSTRINS_W:        "BSTRINS.W",
+	BSTRPICK_D:       "BSTRPICK.D",
+	BSTRPICK_W:       "BSTRPICK.W",
+	BYTEPICK_D:       "BYTEPICK.D",
+	BYTEPICK_W:       "BYTEPICK.W",
+	CACOP:            "CACOP",
+	CLO_D:            "CLO.D",
+	CLO_W:            "CLO.W",
+	CLZ_D:            "CLZ.D",
+	CLZ_W:            "CLZ.W",
+	CPUCFG:           "CPUCFG",
+	CRCC_W_B_W:       "CRCC.W.B.W",
+	CRCC_W_D_W:       "CRCC.W.D.W",
+	CRCC_W_H_W:       "CRCC.W.H.W",
+	CRCC_W_W_W:       "CRCC.W.W.W",
+	CRC_W_B_W:        "CRC.W.B.W",
+	CRC_W_D_W:        "CRC.W.D.W",
+	CRC_W_H_W:        "CRC.W.H.W",
+	CRC_W_W_W:        "CRC.W.W.W",
+	CSRRD:            "CSRRD",
+	CSRWR:            "CSRWR",
+	CSRXCHG:          "CSRXCHG",
+	CTO_D:            "CTO.D",
+	CTO_W:            "CTO.W",
+	CTZ_D:            "CTZ.D",
+	CTZ_W:            "CTZ.W",
+	DBAR:             "DBAR",
+	DBCL:             "DBCL",
+	DIV_D:            "DIV.D",
+	DIV_DU:           "DIV.DU",
+	DIV_W:            "DIV.W",
+	DIV_WU:           "DIV.WU",
+	ERTN:             "ERTN",
+	EXT_W_B:          "EXT.W.B",
+	EXT_W_H:          "EXT.W.H",
+	FABS_D:           "FABS.D",
+	FABS_S:           "FABS.S",
+	FADD_D:           "FADD.D",
+	FADD_S:           "FADD.S",
+	FCLASS_D:         "FCLASS.D",
+	FCLASS_S:         "FCLASS.S",
+	FCMP_CAF_D:       "FCMP.CAF.D",
+	FCMP_CAF_S:       "FCMP.CAF.S",
+	FCMP_CEQ_D:       "FCMP.CEQ.D",
+	FCMP_CEQ_S:       "FCMP.CEQ.S",
+	FCMP_CLE_D:       "FCMP.CLE.D",
+	FCMP_CLE_S:       "FCMP.CLE.S",
+	FCMP_CLT_D:       "FCMP.CLT.D",
+	FCMP_CLT_S:       "FCMP.CLT.S",
+	FCMP_CNE_D:       "FCMP.CNE.D",
+	FCMP_CNE_S:       "FCMP.CNE.S",
+	FCMP_COR_D:       "FCMP.COR.D",
+	FCMP_COR_S:       "FCMP.COR.S",
+	FCMP_CUEQ_D:      "FCMP.CUEQ.D",
+	FCMP_CUEQ_S:      "FCMP.CUEQ.S",
+	FCMP_CULE_D:      "FCMP.CULE.D",
+	FCMP_CULE_S:      "FCMP.CULE.S",
+	FCMP_CULT_D:      "FCMP.CULT.D",
+	FCMP_CULT_S:      "FCMP.CULT.S",
+	FCMP_CUNE_D:      "FCMP.CUNE.D",
+	FCMP_CUNE_S:      "FCMP.CUNE.S",
+	FCMP_CUN_D:       "FCMP.CUN.D",
+	FCMP_CUN_S:       "FCMP.CUN.S",
+	FCMP_SAF_D:       "FCMP.SAF.D",
+	FCMP_SAF_S:       "FCMP.SAF.S",
+	FCMP_SEQ_D:       "FCMP.SEQ.D",
+	FCMP_SEQ_S:       "FCMP.SEQ.S",
+	FCMP_SLE_D:       "FCMP.SLE.D",
+	FCMP_SLE_S:       "FCMP.SLE.S",
+	FCMP_SLT_D:       "FCMP.SLT.D",
+	FCMP_SLT_S:       "FCMP.SLT.S",
+	FCMP_SNE_D:       "FCMP.SNE.D",
+	FCMP_SNE_S:       "FCMP.SNE.S",
+	FCMP_SOR_D:       "FCMP.SOR.D",
+	FCMP_SOR_S:       "FCMP.SOR.S",
+	FCMP_SUEQ_D:      "FCMP.SUEQ.D",
+	FCMP_SUEQ_S:      "FCMP.SUEQ.S",
+	FCMP_SULE_D:      "FCMP.SULE.D",
+	FCMP_SULE_S:      "FCMP.SULE.S",
+	FCMP_SULT_D:      "FCMP.SULT.D",
+	FCMP_SULT_S:      "FCMP.SULT.S",
+	FCMP_SUNE_D:      "FCMP.SUNE.D",
+	FCMP_SUNE_S:      "FCMP.SUNE.S",
+	FCMP_SUN_D:       "FCMP.SUN.D",
+	FCMP_SUN_S:       "FCMP.SUN.S",
+	FCOPYSIGN_D:      "FCOPYSIGN.D",
+	FCOPYSIGN_S:      "FCOPYSIGN.S",
+	FCVT_D_LD:        "FCVT.D.LD",
+	FCVT_D_S:         "FCVT.D.S",
+	FCVT_LD_D:        "FCVT.LD.D",
+	FCVT_S_D:         "FCVT.S.D",
+	FCVT_UD_D:        "FCVT.UD.D",
+	FDIV_D:           "FDIV.D",
+	FDIV_S:           "FDIV.S",
+	FFINT_D_L:        "FFINT.D.L",
+	FFINT_D_W:        "FFINT.D.W",
+	FFINT_S_L:        "FFINT.S.L",
+	FFINT_S_W:        "FFINT.S.W",
+	FLDGT_D:          "FLDGT.D",
+	FLDGT_S:          "FLDGT.S",
+	FLDLE_D:          "FLDLE.D",
+	FLDLE_S:          "FLDLE.S",
+	FLDX_D:           "FLDX.D",
+	FLDX_S:           "FLDX.S",
+	FLD_D:            "FLD.D",
+	FLD_S:            "FLD.S",
+	FLOGB_D:          "FLOGB.D",
+	FLOGB_S:          "FLOGB.S",
+	FMADD_D:          "FMADD.D",
+	FMADD_S:          "FMADD.S",
+	FMAXA_D:          "FMAXA.D",
+	FMAXA_S:          "FMAXA.S",
+	FMAX_D:           "FMAX.D",
+	FMAX_S:           "FMAX.S",
+	FMINA_D:          "FMINA.D",
+	FMINA_S:          "FMINA.S",
+	FMIN_D:           "FMIN.D",
+	FMIN_S:           "FMIN.S",
+	FMOV_D:           "FMOV.D",
+	FMOV_S:           "FMOV.S",
+	FMSUB_D:          "FMSUB.D",
+	FMSUB_S:          "FMSUB.S",
+	FMUL_D:           "FMUL.D",
+	FMUL_S:           "FMUL.S",
+	FNEG_D:           "FNEG.D",
+	FNEG_S:           "FNEG.S",
+	FNMADD_D:         "FNMADD.D",
+	FNMADD_S:         "FNMADD.S",
+	FNMSUB_D:         "FNMSUB.D",
+	FNMSUB_S:         "FNMSUB.S",
+	FRECIPE_D:        "FRECIPE.D",
+	FRECIPE_S:        "FRECIPE.S",
+	FRECIP_D:         "FRECIP.D",
+	FRECIP_S:         "FRECIP.S",
+	FRINT_D:          "FRINT.D",
+	FRINT_S:          "FRINT.S",
+	FRSQRTE_D:        "FRSQRTE.D",
+	FRSQRTE_S:        "FRSQRTE.S",
+	FRSQRT_D:         "FRSQRT.D",
+	FRSQRT_S:         "FRSQRT.S",
+	FSCALEB_D:        "FSCALEB.D",
+	FSCALEB_S:        "FSCALEB.S",
+	FSEL:             "FSEL",
+	FSQRT_D:          "FSQRT.D",
+	FSQRT_S:          "FSQRT.S",
+	FSTGT_D:          "FSTGT.D",
+	FSTGT_S:          "FSTGT.S",
+	FSTLE_D:          "FSTLE.D",
+	FSTLE_S:          "FSTLE.S",
+	FSTX_D:           "FSTX.D",
+	FSTX_S:           "FSTX.S",
+	FST_D:            "FST.D",
+	FST_S:            "FST.S",
+	FSUB_D:           "FSUB.D",
+	FSUB_S:           "FSUB.S",
+	FTINTRM_L_D:      "FTINTRM.L.D",
+	FTINTRM_L_S:      "FTINTRM.L.S",
+	FTINTRM_W_D:      "FTINTRM.W.D",
+	FTINTRM_W_S:      "FTINTRM.W.S",
+	FTINTRNE_L_D:     "FTINTRNE.L.D",
+	FTINTRNE_L_S:     "FTINTRNE.L.S",
+	FTINTRNE_W_D:     "FTINTRNE.W.D",
+	FTINTRNE_W_S:     "FTINTRNE.W.S",
+	FTINTRP_L_D:      "FTINTRP.L.D",
+	FTINTRP_L_S:      "FTINTRP.L.S",
+	FTINTRP_W_D:      "FTINTRP.W.D",
+	FTINTRP_W_S:      "FTINTRP.W.S",
+	FTINTRZ_L_D:      "FTINTRZ.L.D",
+	FTINTRZ_L_S:      "FTINTRZ.L.S",
+	FTINTRZ_W_D:      "FTINTRZ.W.D",
+	FTINTRZ_W_S:      "FTINTRZ.W.S",
+	FTINT_L_D:        "FTINT.L.D",
+	FTINT_L_S:        "FTINT.L.S",
+	FTINT_W_D:        "FTINT.W.D",
+	FTINT_W_S:        "FTINT.W.S",
+	GCSRRD:           "GCSRRD",
+	GCSRWR:           "GCSRWR",
+	GCSRXCHG:         "GCSRXCHG",
+	GTLBFLUSH:        "GTLBFLUSH",
+	HVCL:             "HVCL",
+	IBAR:             "IBAR",
+	IDLE:             "IDLE",
+	INVTLB:           "INVTLB",
+	IOCSRRD_B:        "IOCSRRD.B",
+	IOCSRRD_D:        "IOCSRRD.D",
+	IOCSRRD_H:        "IOCSRRD.H",
+	IOCSRRD_W:        "IOCSRRD.W",
+	IOCSRWR_B:        "IOCSRWR.B",
+	IOCSRWR_D:        "IOCSRWR.D",
+	IOCSRWR_H:        "IOCSRWR.H",
+	IOCSRWR_W:        "IOCSRWR.W",
+	JIRL:             "JIRL",
+	JISCR0:           "JISCR0",
+	JISCR1:           "JISCR1",
+	LDDIR:            "LDDIR",
+	LDGT_B:           "LDGT.B",
+	LDGT_D:           "LDGT.D",
+	LDGT_H:           "LDGT.H",
+	LDGT_W:           "LDGT.W",
+	LDLE_B:           "LDLE.B",
+	LDLE_D:           "LDLE.D",
+	LDLE_H:           "LDLE.H",
+	LDLE_W:           "LDLE.W",
+	LDL_D:            "LDL.D",
+	LDL_W:            "LDL.W",
+	LDPTE:            "LDPTE",
+	LDPTR_D:          "LDPTR.D",
+	LDPTR_W:          "LDPTR.W",
+	LDR_D:            "LDR.D",
+	LDR_W:            "LDR.W",
+	LDX_B:            "LDX.B",
+	LDX_BU:           "LDX.BU",
+	LDX_D:            "LDX.D",
+	LDX_H:            "LDX.H",
+	LDX_HU:           "LDX.HU",
+	LDX_W:            "LDX.W",
+	LDX_WU:           "LDX.WU",
+	LD_B:             "LD.B",
+	LD_BU:            "LD.BU",
+	LD_D:             "LD.D",
+	LD_H:             "LD.H",
+	LD_HU:            "LD.HU",
+	LD_W:             "LD.W",
+	LD_WU:            "LD.WU",
+	LLACQ_D:          "LLACQ.D",
+	LLACQ_W:          "LLACQ.W",
+	LL_D:             "LL.D",
+	LL_W:             "LL.W",
+	LU12I_W:          "LU12I.W",
+	LU32I_D:          "LU32I.D",
+	LU52I_D:          "LU52I.D",
+	MASKEQZ:          "MASKEQZ",
+	MASKNEZ:          "MASKNEZ",
+	MOD_D:            "MOD.D",
+	MOD_DU:           "MOD.DU",
+	MOD_W:            "MOD.W",
+	MOD_WU:           "MOD.WU",
+	MOVCF2FR:         "MOVCF2FR",
+	MOVCF2GR:         "MOVCF2GR",
+	MOVFCSR2GR:       "MOVFCSR2GR",
+	MOVFR2CF:         "MOVFR2CF",
+	MOVFR2GR_D:       "MOVFR2GR.D",
+	MOVFR2GR_S:       "MOVFR2GR.S",
+	MOVFRH2GR_S:      "MOVFRH2GR.S",
+	MOVGR2CF:         "MOVGR2CF",
+	MOVGR2FCSR:       "MOVGR2FCSR",
+	MOVGR2FRH_W:      "MOVGR2FRH.W",
+	MOVGR2FR_D:       "MOVGR2FR.D",
+	MOVGR2FR_W:       "MOVGR2FR.W",
+	MOVGR2SCR:        "MOVGR2SCR",
+	MOVSCR2GR:        "MOVSCR2GR",
+	MULH_D:           "MULH.D",
+	MULH_DU:          "MULH.DU",
+	MULH_W:           "MULH.W",
+	MULH_WU:          "MULH.WU",
+	MULW_D_W:         "MULW.D.W",
+	MULW_D_WU:        "MULW.D.WU",
+	MUL_D:            "MUL.D",
+	MUL_W:            "MUL.W",
+	NOR:              "NOR",
+	OR:               "OR",
+	ORI:              "ORI",
+	ORN:              "ORN",
+	PCADDI:           "PCADDI",
+	PCADDU12I:        "PCADDU12I",
+	PCADDU18I:        "PCADDU18I",
+	PCALAU12I:        "PCALAU12I",
+	PRELD:            "PRELD",
+	PRELDX:           "PRELDX",
+	RCRI_B:           "RCRI.B",
+	RCRI_D:           "RCRI.D",
+	RCRI_H:           "RCRI.H",
+	RCRI_W:           "RCRI.W",
+	RCR_B:            "RCR.B",
+	RCR_D:            "RCR.D",
+	RCR_H:            "RCR.H",
+	RCR_W:            "RCR.W",
+	RDTIMEH_W:        "RDTIMEH.W",
+	RDTIMEL_W:        "RDTIMEL.W",
+	RDTIME_D:         "RDTIME.D",
+	REVB_2H:          "REVB.2H",
+	REVB_2W:          "REVB.2W",
+	REVB_4H:          "REVB.4H",
+	REVB_D:           "REVB.D",
+	REVH_2W:          "REVH.2W",
+	REVH_D:           "REVH.D",
+	ROTRI_B:          "ROTRI.B",
+	ROTRI_D:          "ROTRI.D",
+	ROTRI_H:          "ROTRI.H",
+	ROTRI_W:          "ROTRI.W",
+	ROTR_B:           "ROTR.B",
+	ROTR_D:           "ROTR.D",
+	ROTR_H:           "ROTR.H",
+	ROTR_W:           "ROTR.W",
+	SBC_B:            "SBC.B",
+	SBC_D:            "SBC.D",
+	SBC_H:            "SBC.H",
+	SBC_W:            "SBC.W",
+	SCREL_D:          "SCREL.D",
+	SCREL_W:          "SCREL.W",
+	SC_D:             "SC.D",
+	SC_Q:             "SC.Q",
+	SC_W:             "SC.W",
+	SETARMJ:          "SETARMJ",
+	SETX86J:          "SETX86J",
+	SETX86LOOPE:      "SETX86LOOPE",
+	SETX86LOOPNE:     "SETX86LOOPNE",
+	SLLI_D:           "SLLI.D",
+	SLLI_W:           "SLLI.W",
+	SLL_D:            "SLL.D",
+	SLL_W:            "SLL.W",
+	SLT:              "SLT",
+	SLTI:             "SLTI",
+	SLTU:             "SLTU",
+	SLTUI:            "SLTUI",
+	SRAI_D:           "SRAI.D",
+	SRAI_W:           "SRAI.W",
+	SRA_D:            "SRA.D",
+	SRA_W:            "SRA.W",
+	SRLI_D:           "SRLI.D",
+	SRLI_W:           "SRLI.W",
+	SRL_D:            "SRL.D",
+	SRL_W:            "SRL.W",
+	STGT_B:           "STGT.B",
+	STGT_D:           "STGT.D",
+	STGT_H:           "STGT.H",
+	STGT_W:           "STGT.W",
+	STLE_B:           "STLE.B",
+	STLE_D:           "STLE.D",
+	STLE_H:           "STLE.H",
+	STLE_W:           "STLE.W",
+	STL_D:            "STL.D",
+	STL_W:            "STL.W",
+	STPTR_D:          "STPTR.D",
+	STPTR_W:          "STPTR.W",
+	STR_D:            "STR.D",
+	STR_W:            "STR.W",
+	STX_B:            "STX.B",
+	STX_D:            "STX.D",
+	STX_H:            "STX.H",
+	STX_W:            "STX.W",
+	ST_B:             "ST.B",
+	ST_D:             "ST.D",
+	ST_H:             "ST.H",
+	ST_W:             "ST.W",
+	SUB_D:            "SUB.D",
+	SUB_W:            "SUB.W",
+	SYSCALL:          "SYSCALL",
+	TLBCLR:           "TLBCLR",
+	TLBFILL:          "TLBFILL",
+	TLBFLUSH:         "TLBFLUSH",
+	TLBRD:            "TLBRD",
+	TLBSRCH:          "TLBSRCH",
+	TLBWR:            "TLBWR",
+	VADDI_BU:         "VADDI.BU",
+	VADDI_DU:         "VADDI.DU",
+	VADDI_HU:         "VADDI.HU",
+	VADDI_WU:         "VADDI.WU",
+	VADDWEV_D_W:      "VADDWEV.D.W",
+	VADDWEV_D_WU:     "VADDWEV.D.WU",
+	VADDWEV_H_B:      "VADDWEV.H.B",
+	VADDWEV_H_BU:     "VADDWEV.H.BU",
+	VADDWEV_Q_D:      "VADDWEV.Q.D",
+	VADDWEV_Q_DU:     "VADDWEV.Q.DU",
+	VADDWEV_W_H:      "VADDWEV.W.H",
+	VADDWEV_W_HU:     "VADDWEV.W.HU",
+	VADDWOD_D_W:      "VADDWOD.D.W",
+	VADDWOD_D_WU:     "VADDWOD.D.WU",
+	VADDWOD_H_B:      "VADDWOD.H.B",
+	VADDWOD_H_BU:     "VADDWOD.H.BU",
+	VADDWOD_Q_D:      "VADDWOD.Q.D",
+	VADDWOD_Q_DU:     "VADDWOD.Q.DU",
+	VADDWOD_W_H:      "VADDWOD.W.H",
+	VADDWOD_W_HU:     "VADDWOD.W.HU",
+	VADD_B:           "VADD.B",
+	VADD_D:           "VADD.D",
+	VADD_H:           "VADD.H",
+	VADD_Q:           "VADD.Q",
+	VADD_W:           "VADD.W",
+	VANDI_B:          "VANDI.B",
+	VANDN_V:          "VANDN.V",
+	VAND_V:           "VAND.V",
+	VBITCLRI_B:       "VBITCLRI.B",
+	VBITCLRI_D:       "VBITCLRI.D",
+	VBITCLRI_H:       "VBITCLRI.H",
+	VBITCLRI_W:       "VBITCLRI.W",
+	VBITCLR_B:        "VBITCLR.B",
+	VBITCLR_D:        "VBITCLR.D",
+	VBITCLR_H:        "VBITCLR.H",
+	VBITCLR_W:        "VBITCLR.W",
+	VBITREVI_B:       "VBITREVI.B",
+	VBITREVI_D:       "VBITREVI.D",
+	VBITREVI_H:       "VBITREVI.H",
+	VBITREVI_W:       "VBITREVI.W",
+	VBITREV_B:        "VBITREV.B",
+	VBITREV_D:        "VBITREV.D",
+	VBITREV_H:        "VBITREV.H",
+	VBITREV_W:        "VBITREV.W",
+	VBITSETI_B:       "VBITSETI.B",
+	VBITSETI_D:       "VBITSETI.D",
+	VBITSETI_H:       "VBITSETI.H",
+	VBITSETI_W:       "VBITSETI.W",
+	VBITSET_B:        "VBITSET.B",
+	VBITSET_D:        "VBITSET.D",
+	VBITSET_H:        "VBITSET.H",
+	VBITSET_W:        "VBITSET.W",
+	VDIV_B:           "VDIV.B",
+	VDIV_BU:          "VDIV.BU",
+	VDIV_D:           "VDIV.D",
+	VDIV_DU:          "VDIV.DU",
+	VDIV_H:           "VDIV.H",
+	VDIV_HU:          "VDIV.HU",
+	VDIV_W:           "VDIV.W",
+	VDIV_WU:          "VDIV.WU",
+	VEXTRINS_B:       "VEXTRINS.B",
+	VEXTRINS_D:       "VEXTRINS.D",
+	VEXTRINS_H:       "VEXTRINS.H",
+	VEXTRINS_W:       "VEXTRINS.W",
+	VFADD_D:          "VFADD.D",
+	VFADD_S:          "VFADD.S",
+	VFCLASS_D:        "VFCLASS.D",
+	VFCLASS_S:        "VFCLASS.S",
+	VFDIV_D:          "VFDIV.D",
+	VFDIV_S:          "VFDIV.S",
+	VFMUL_D:          "VFMUL.D",
+	VFMUL_S:          "VFMUL.S",
+	VFRECIP_D:        "VFRECIP.D",
+	VFRECIP_S:        "VFRECIP.S",
+	VFRINTRM_D:       "VFRINTRM.D",
+	VFRINTRM_S:       "VFRINTRM.S",
+	VFRINTRNE_D:      "VFRINTRNE.D",
+	VFRINTRNE_S:      "VFRINTRNE.S",
+	VFRINTRP_D:       "VFRINTRP.D",
+	VFRINTRP_S:       "VFRINTRP.S",
+	VFRINTRZ_D:       "VFRINTRZ.D",
+	VFRINTRZ_S:       "VFRINTRZ.S",
+	VFRINT_D:         "VFRINT.D",
+	VFRINT_S:         "VFRINT.S",
+	VFRSQRT_D:        "VFRSQRT.D",
+	VFRSQRT_S:        "VFRSQRT.S",
+	VFSQRT_D:         "VFSQRT.D",
+	VFSQRT_S:         "VFSQRT.S",
+	VFSUB_D:          "VFSUB.D",
+	VFSUB_S:          "VFSUB.S",
+	VILVH_B:          "VILVH.B",
+	VILVH_D:          "VILVH.D",
+	VILVH_H:          "VILVH.H",
+	VILVH_W:          "VILVH.W",
+	VILVL_B:          "VILVL.B",
+	VILVL_D:          "VILVL.D",
+	VILVL_H:          "VILVL.H",
+	VILVL_W:          "VILVL.W",
+	VINSGR2VR_B:      "VINSGR2VR.B",
+	VINSGR2VR_D:      "VINSGR2VR.D",
+	VINSGR2VR_H:      "VINSGR2VR.H",
+	VINSGR2VR_W:      "VINSGR2VR.W",
+	VLD:              "VLD",
+	VLDREPL_B:        "VLDREPL.B",
+	VLDREPL_D:        "VLDREPL.D",
+	VLDREPL_H:        "VLDREPL.H",
+	VLDREPL_W:        "VLDREPL.W",
+	VLDX:             "VLDX",
+	VMADDWEV_D_W:     "VMADDWEV.D.W",
+	VMADDWEV_D_WU:    "VMADDWEV.D.WU",
+	VMADDWEV_D_WU_W:  "VMADDWEV.D.WU.W",
+	VMADDWEV_H_B:     "VMADDWEV.H.B",
+	VMADDWEV_H_BU:    "VMADDWEV.H.BU",
+	VMADDWEV_H_BU_B:  "VMADDWEV.H.BU.B",
+	VMADDWEV_Q_D:     "VMADDWEV.Q.D",
+	VMADDWEV_Q_DU:    "VMADDWEV.Q.DU",
+	VMADDWEV_Q_DU_D:  "VMADDWEV.Q.DU.D",
+	VMADDWEV_W_H:     "VMADDWEV.W.H",
+	VMADDWEV_W_HU:    "VMADDWEV.W.HU",
+	VMADDWEV_W_HU_H:  "VMADDWEV.W.HU.H",
+	VMADDWOD_D_W:     "VMADDWOD.D.W",
+	VMADDWOD_D_WU:    "VMADDWOD.D.WU",
+	VMADDWOD_D_WU_W:  "VMADDWOD.D.WU.W",
+	VMADDWOD_H_B:     "VMADDWOD.H.B",
+	VMADDWOD_H_BU:    "VMADDWOD.H.BU",
+	VMADDWOD_H_BU_B:  "VMADDWOD.H.BU.B",
+	VMADDWOD_Q_D:     "VMADDWOD.Q.D",
+	VMADDWOD_Q_DU:    "VMADDWOD.Q.DU",
+	VMADDWOD_Q_DU_D:  "VMADDWOD.Q.DU.D",
+	VMADDWOD_W_H:     "VMADDWOD.W.H",
+	VMADDWOD_W_HU:    "VMADDWOD.W.HU",
+	VMADDWOD_W_HU_H:  "VMADDWOD.W.HU.H",
+	VMADD_B:          "VMADD.B",
+	VMADD_D:          "VMADD.D",
+	VMADD_H:          "VMADD.H",
+	VMADD_W:          "VMADD.W",
+	VMOD_B:           "VMOD.B",
+	VMOD_BU:          "VMOD.BU",
+	VMOD_D:           "VMOD.D",
+	VMOD_DU:          "VMOD.DU",
+	VMOD_H:           "VMOD.H",
+	VMOD_HU:          "VMOD.HU",
+	VMOD_W:           "VMOD.W",
+	VMOD_WU:          "VMOD.WU",
+	VMSUB_B:          "VMSUB.B",
+	VMSUB_D:          "VMSUB.D",
+	VMSUB_H:          "VMSUB.H",
+	VMSUB_W:          "VMSUB.W",
+	VMUH_B:           "VMUH.B",
+	VMUH_BU:          "VMUH.BU",
+	VMUH_D:           "VMUH.D",
+	VMUH_DU:          "VMUH.DU",
+	VMUH_H:           "VMUH.H",
+	VMUH_HU:          "VMUH.HU",
+	VMUH_W:           "VMUH.W",
+	VMUH_WU:          "VMUH.WU",
+	VMULWEV_D_W:      "VMULWEV.D.W",
+	VMULWEV_D_WU:     "VMULWEV.D.WU",
+	VMULWEV_D_WU_W:   "VMULWEV.D.WU.W",
+	VMULWEV_H_B:      "VMULWEV.H.B",
+	VMULWEV_H_BU:     "VMULWEV.H.BU",
+	VMULWEV_H_BU_B:   "VMULWEV.H.BU.B",
+	VMULWEV_Q_D:      "VMULWEV.Q.D",
+	VMULWEV_Q_DU:     "VMULWEV.Q.DU",
+	VMULWEV_Q_DU_D:   "VMULWEV.Q.DU.D",
+	VMULWEV_W_H:      "VMULWEV.W.H",
+	VMULWEV_W_HU:     "VMULWEV.W.HU",
+	VMULWEV_W_HU_H:   "VMULWEV.W.HU.H",
+	VMULWOD_D_W:      "VMULWOD.D.W",
+	VMULWOD_D_WU:     "VMULWOD.D.WU",
+	VMULWOD_D_WU_W:   "VMULWOD.D.WU.W",
+	VMULWOD_H_B:      "VMULWOD.H.B",
+	VMULWOD_H_BU:     "VMULWOD.H.BU",
+	VMULWOD_H_BU_B:   "VMULWOD.H.BU.B",
+	VMULWOD_Q_D:      "VMULWOD.Q.D",
+	VMULWOD_Q_DU:     "VMULWOD.Q.DU",
+	VMULWOD_Q_DU_D:   "VMULWOD.Q.DU.D",
+	VMULWOD_W_H:      "VMULWOD.W.H",
+	VMULWOD_W_HU:     "VMULWOD.W.HU",
+	VMULWOD_W_HU_H:   "VMULWOD.W.HU.H",
+	VMUL_B:           "VMUL.B",
+	VMUL_D:           "VMUL.D",
+	VMUL_H:           "VMUL.H",
+	VMUL_W:           "VMUL.W",
+	VNEG_B:           "VNEG.B",
+	VNEG_D:           "VNEG.D",
+	VNEG_H:           "VNEG.H",
+	VNEG_W:           "VNEG.W",
+	VNORI_B:          "VNORI.B",
+	VNOR_V:           "VNOR.V",
+	VORI_B:           "VORI.B",
+	VORN_V:           "VORN.V",
+	VOR_V:            "VOR.V",
+	VPCNT_B:          "VPCNT.B",
+	VPCNT_D:          "VPCNT.D",
+	VPCNT_H:          "VPCNT.H",
+	VPCNT_W:          "VPCNT.W",
+	VPERMI_W:         "VPERMI.W",
+	VPICKVE2GR_B:     "VPICKVE2GR.B",
+	VPICKVE2GR_BU:    "VPICKVE2GR.BU",
+	VPICKVE2GR_D:     "VPICKVE2GR.D",
+	VPICKVE2GR_DU:    "VPICKVE2GR.DU",
+	VPICKVE2GR_H:     "VPICKVE2GR.H",
+	VPICKVE2GR_HU:    "VPICKVE2GR.HU",
+	VPICKVE2GR_W:     "VPICKVE2GR.W",
+	VPICKVE2GR_WU:    "VPICKVE2GR.WU",
+	VREPLGR2VR_B:     "VREPLGR2VR.B",
+	VREPLGR2VR_D:     "VREPLGR2VR.D",
+	VREPLGR2VR_H:     "VREPLGR2VR.H",
+	VREPLGR2VR_W:     "VREPLGR2VR.W",
+	VREPLVEI_B:       "VREPLVEI.B",
+	VREPLVEI_D:       "VREPLVEI.D",
+	VREPLVEI_H:       "VREPLVEI.H",
+	VREPLVEI_W:       "VREPLVEI.W",
+	VROTRI_B:         "VROTRI.B",
+	VROTRI_D:         "VROTRI.D",
+	VROTRI_H:         "VROTRI.H",
+	VROTRI_W:         "VROTRI.W",
+	VROTR_B:          "VROTR.B",
+	VROTR_D:          "VROTR.D",
+	VROTR_H:          "VROTR.H",
+	VROTR_W:          "VROTR.W",
+	VSADD_B:          "VSADD.B",
+	VSADD_BU:         "VSADD.BU",
+	VSADD_D:          "VSADD.D",
+	VSADD_DU:         "VSADD.DU",
+	VSADD_H:          "VSADD.H",
+	VSADD_HU:         "VSADD.HU",
+	VSADD_W:          "VSADD.W",
+	VSADD_WU:         "VSADD.WU",
+	VSEQI_B:          "VSEQI.B",
+	VSEQI_D:          "VSEQI.D",
+	VSEQI_H:          "VSEQI.H",
+	VSEQI_W:          "VSEQI.W",
+	VSEQ_B:           "VSEQ.B",
+	VSEQ_D:           "VSEQ.D",
+	VSEQ_H:           "VSEQ.H",
+	VSEQ_W:           "VSEQ.W",
+	VSETALLNEZ_B:     "VSETALLNEZ.B",
+	VSETALLNEZ_D:     "VSETALLNEZ.D",
+	VSETALLNEZ_H:     "VSETALLNEZ.H",
+	VSETALLNEZ_W:     "VSETALLNEZ.W",
+	VSETANYEQZ_B:     "VSETANYEQZ.B",
+	VSETANYEQZ_D:     "VSETANYEQZ.D",
+	VSETANYEQZ_H:     "VSETANYEQZ.H",
+	VSETANYEQZ_W:     "VSETANYEQZ.W",
+	VSETEQZ_V:        "VSETEQZ.V",
+	VSETNEZ_V:        "VSETNEZ.V",
+	VSHUF4I_B:        "VSHUF4I.B",
+	VSHUF4I_D:        "VSHUF4I.D",
+	VSHUF4I_H:        "VSHUF4I.H",
+	VSHUF4I_W:        "VSHUF4I.W",
+	VSHUF_B:          "VSHUF.B",
+	VSHUF_D:          "VSHUF.D",
+	VSHUF_H:          "VSHUF.H",
+	VSHUF_W:          "VSHUF.W",
+	VSLLI_B:          "VSLLI.B",
+	VSLLI_D:          "VSLLI.D",
+	VSLLI_H:          "VSLLI.H",
+	VSLLI_W:          "VSLLI.W",
+	VSLL_B:           "VSLL.B",
+	VSLL_D:           "VSLL.D",
+	VSLL_H:           "VSLL.H",
+	VSLL_W:           "VSLL.W",
+	VSLTI_B:          "VSLTI.B",
+	VSLTI_BU:         "VSLTI.BU",
+	VSLTI_D:          "VSLTI.D",
+	VSLTI_DU:         "VSLTI.DU",
+	VSLTI_H:          "VSLTI.H",
+	VSLTI_HU:         "VSLTI.HU",
+	VSLTI_W:          "VSLTI.W",
+	VSLTI_WU:         "VSLTI.WU",
+	VSLT_B:           "VSLT.B",
+	VSLT_BU:          "VSLT.BU",
+	VSLT_D:           "VSLT.D",
+	VSLT_DU:          "VSLT.DU",
+	VSLT_H:           "VSLT.H",
+	VSLT_HU:          "VSLT.HU",
+	VSLT_W:           "VSLT.W",
+	VSLT_WU:          "VSLT.WU",
+	VSRAI_B:          "VSRAI.B",
+	VSRAI_D:          "VSRAI.D",
+	VSRAI_H:          "VSRAI.H",
+	VSRAI_W:          "VSRAI.W",
+	VSRA_B:           "VSRA.B",
+	VSRA_D:           "VSRA.D",
+	VSRA_H:           "VSRA.H",
+	VSRA_W:           "VSRA.W",
+	VSRLI_B:          "VSRLI.B",
+	VSRLI_D:          "VSRLI.D",
+	VSRLI_H:          "VSRLI.H",
+	VSRLI_W:          "VSRLI.W",
+	VSRL_B:           "VSRL.B",
+	VSRL_D:           "VSRL.D",
+	VSRL_H:           "VSRL.H",
+	VSRL_W:           "VSRL.W",
+	VSSUB_B:          "VSSUB.B",
+	VSSUB_BU:         "VSSUB.BU",
+	VSSUB_D:          "VSSUB.D",
+	VSSUB_DU:         "VSSUB.DU",
+	VSSUB_H:          "VSSUB.H",
+	VSSUB_HU:         "VSSUB.HU",
+	VSSUB_W:          "VSSUB.W",
+	VSSUB_WU:         "VSSUB.WU",
+	VST:              "VST",
+	VSTX:             "VSTX",
+	VSUBI_BU:         "VSUBI.BU",
+	VSUBI_DU:         "VSUBI.DU",
+	VSUBI_HU:         "VSUBI.HU",
+	VSUBI_WU:         "VSUBI.WU",
+	VSUBWEV_D_W:      "VSUBWEV.D.W",
+	VSUBWEV_D_WU:     "VSUBWEV.D.WU",
+	VSUBWEV_H_B:      "VSUBWEV.H.B",
+	VSUBWEV_H_BU:     "VSUBWEV.H.BU",
+	VSUBWEV_Q_D:      "VSUBWEV.Q.D",
+	VSUBWEV_Q_DU:     "VSUBWEV.Q.DU",
+	VSUBWEV_W_H:      "VSUBWEV.W.H",
+	VSUBWEV_W_HU:     "VSUBWEV.W.HU",
+	VSUBWOD_D_W:      "VSUBWOD.D.W",
+	VSUBWOD_D_WU:     "VSUBWOD.D.WU",
+	VSUBWOD_H_B:      "VSUBWOD.H.B",
+	VSUBWOD_H_BU:     "VSUBWOD.H.BU",
+	VSUBWOD_Q_D:      "VSUBWOD.Q.D",
+	VSUBWOD_Q_DU:     "VSUBWOD.Q.DU",
+	VSUBWOD_W_H:      "VSUBWOD.W.H",
+	VSUBWOD_W_HU:     "VSUBWOD.W.HU",
+	VSUB_B:           "VSUB.B",
+	VSUB_D:           "VSUB.D",
+	VSUB_H:           "VSUB.H",
+	VSUB_Q:           "VSUB.Q",
+	VSUB_W:           "VSUB.W",
+	VXORI_B:          "VXORI.B",
+	VXOR_V:           "VXOR.V",
+	X86ADC_B:         "X86ADC.B",
+	X86ADC_D:         "X86ADC.D",
+	X86ADC_H:         "X86ADC.H",
+	X86ADC_W:         "X86ADC.W",
+	X86ADD_B:         "X86ADD.B",
+	X86ADD_D:         "X86ADD.D",
+	X86ADD_DU:        "X86ADD.DU",
+	X86ADD_H:         "X86ADD.H",
+	X86ADD_W:         "X86ADD.W",
+	X86ADD_WU:        "X86ADD.WU",
+	X86AND_B:         "X86AND.B",
+	X86AND_D:         "X86AND.D",
+	X86AND_H:         "X86AND.H",
+	X86AND_W:         "X86AND.W",
+	X86CLRTM:         "X86CLRTM",
+	X86DECTOP:        "X86DECTOP",
+	X86DEC_B:         "X86DEC.B",
+	X86DEC_D:         "X86DEC.D",
+	X86DEC_H:         "X86DEC.H",
+	X86DEC_W:         "X86DEC.W",
+	X86INCTOP:        "X86INCTOP",
+	X86INC_B:         "X86INC.B",
+	X86INC_D:         "X86INC.D",
+	X86INC_H:         "X86INC.H",
+	X86INC_W:         "X86INC.W",
+	X86MFFLAG:        "X86MFFLAG",
+	X86MFTOP:         "X86MFTOP",
+	X86MTFLAG:        "X86MTFLAG",
+	X86MTTOP:         "X86MTTOP",
+	X86MUL_B:         "X86MUL.B",
+	X86MUL_BU:        "X86MUL.BU",
+	X86MUL_D:         "X86MUL.D",
+	X86MUL_DU:        "X86MUL.DU",
+	X86MUL_H:         "X86MUL.H",
+	X86MUL_HU:        "X86MUL.HU",
+	X86MUL_W:         "X86MUL.W",
+	X86MUL_WU:        "X86MUL.WU",
+	X86OR_B:          "X86OR.B",
+	X86OR_D:          "X86OR.D",
+	X86OR_H:          "X86OR.H",
+	X86OR_W:          "X86OR.W",
+	X86RCLI_B:        "X86RCLI.B",
+	X86RCLI_D:        "X86RCLI.D",
+	X86RCLI_H:        "X86RCLI.H",
+	X86RCLI_W:        "X86RCLI.W",
+	X86RCL_B:         "X86RCL.B",
+	X86RCL_D:         "X86RCL.D",
+	X86RCL_H:         "X86RCL.H",
+	X86RCL_W:         "X86RCL.W",
+	X86RCRI_B:        "X86RCRI.B",
+	X86RCRI_D:        "X86RCRI.D",
+	X86RCRI_H:        "X86RCRI.H",
+	X86RCRI_W:        "X86RCRI.W",
+	X86RCR_B:         "X86RCR.B",
+	X86RCR_D:         "X86RCR.D",
+	X86RCR_H:         "X86RCR.H",
+	X86RCR_W:         "X86RCR.W",
+	X86ROTLI_B:       "X86ROTLI.B",
+	X86ROTLI_D:       "X86ROTLI.D",
+	X86ROTLI_H:       "X86ROTLI.H",
+	X86ROTLI_W:       "X86ROTLI.W",
+	X86ROTL_B:        "X86ROTL.B",
+	X86ROTL_D:        "X86ROTL.D",
+	X86ROTL_H:        "X86ROTL.H",
+	X86ROTL_W:        "X86ROTL.W",
+	X86ROTRI_B:       "X86ROTRI.B",
+	X86ROTRI_D:       "X86ROTRI.D",
+	X86ROTRI_H:       "X86ROTRI.H",
+	X86ROTRI_W:       "X86ROTRI.W",
+	X86ROTR_B:        "X86ROTR.B",
+	X86ROTR_D:        "X86ROTR.D",
+	X86ROTR_H:        "X86ROTR.H",
+	X86ROTR_W:        "X86ROTR.W",
+	X86SBC_B:         "X86SBC.B",
+	X86SBC_D:         "X86SBC.D",
+	X86SBC_H:         "X86SBC.H",
+	X86SBC_W:         "X86SBC.W",
+	X86SETTAG:        "X86SETTAG",
+	X86SETTM:         "X86SETTM",
+	X86SLLI_B:        "X86SLLI.B",
+	X86SLLI_D:        "X86SLLI.D",
+	X86SLLI_H:        "X86SLLI.H",
+	X86SLLI_W:        "X86SLLI.W",
+	X86SLL_B:         "X86SLL.B",
+	X86SLL_D:         "X86SLL.D",
+	X86SLL_H:         "X86SLL.H",
+	X86SLL_W:         "X86SLL.W",
+	X86SRAI_B:        "X86SRAI.B",
+	X86SRAI_D:        "X86SRAI.D",
+	X86SRAI_H:        "X86SRAI.H",
+	X86SRAI_W:        "X86SRAI.W",
+	X86SRA_B:         "X86SRA.B",
+	X86SRA_D:         "X86SRA.D",
+	X86SRA_H:         "X86SRA.H",
+	X86SRA_W:         "X86SRA.W",
+	X86SRLI_B:        "X86SRLI.B",
+	X86SRLI_D:        "X86SRLI.D",
+	X86SRLI_H:        "X86SRLI.H",
+	X86SRLI_W:        "X86SRLI.W",
+	X86SRL_B:         "X86SRL.B",
+	X86SRL_D:         "X86SRL.D",
+	X86SRL_H:         "X86SRL.H",
+	X86SRL_W:         "X86SRL.W",
+	X86SUB_B:         "X86SUB.B",
+	X86SUB_D:         "X86SUB.D",
+	X86SUB_DU:        "X86SUB.DU",
+	X86SUB_H:         "X86SUB.H",
+	X86SUB_W:         "X86SUB.W",
+	X86SUB_WU:        "X86SUB.WU",
+	X86XOR_B:         "X86XOR.B",
+	X86XOR_D:         "X86XOR.D",
+	X86XOR_H:         "X86XOR.H",
+	X86XOR_W:         "X86XOR.W",
+	XOR:              "XOR",
+	XORI:             "XORI",
+	XVADDI_BU:        "XVADDI.BU",
+	XVADDI_DU:        "XVADDI.DU",
+	XVADDI_HU:        "XVADDI.HU",
+	XVADDI_WU:        "XVADDI.WU",
+	XVADDWEV_D_W:     "XVADDWEV.D.W",
+	XVADDWEV_D_WU:    "XVADDWEV.D.WU",
+	XVADDWEV_H_B:     "XVADDWEV.H.B",
+	XVADDWEV_H_BU:    "XVADDWEV.H.BU",
+	XVADDWEV_Q_D:     "XVADDWEV.Q.D",
+	XVADDWEV_Q_DU:    "XVADDWEV.Q.DU",
+	XVADDWEV_W_H:     "XVADDWEV.W.H",
+	XVADDWEV_W_HU:    "XVADDWEV.W.HU",
+	XVADDWOD_D_W:     "XVADDWOD.D.W",
+	XVADDWOD_D_WU:    "XVADDWOD.D.WU",
+	XVADDWOD_H_B:     "XVADDWOD.H.B",
+	XVADDWOD_H_BU:    "XVADDWOD.H.BU",
+	XVADDWOD_Q_D:     "XVADDWOD.Q.D",
+	XVADDWOD_Q_DU:    "XVADDWOD.Q.DU",
+	XVADDWOD_W_H:     "XVADDWOD.W.H",
+	XVADDWOD_W_HU:    "XVADDWOD.W.HU",
+	XVADD_B:          "XVADD.B",
+	XVADD_D:          "XVADD.D",
+	XVADD_H:          "XVADD.H",
+	XVADD_Q:          "XVADD.Q",
+	XVADD_W:          "XVADD.W",
+	XVANDI_B:         "XVANDI.B",
+	XVANDN_V:         "XVANDN.V",
+	XVAND_V:          "XVAND.V",
+	XVBITCLRI_B:      "XVBITCLRI.B",
+	XVBITCLRI_D:      "XVBITCLRI.D",
+	XVBITCLRI_H:      "XVBITCLRI.H",
+	XVBITCLRI_W:      "XVBITCLRI.W",
+	XVBITCLR_B:       "XVBITCLR.B",
+	XVBITCLR_D:       "XVBITCLR.D",
+	XVBITCLR_H:       "XVBITCLR.H",
+	XVBITCLR_W:       "XVBITCLR.W",
+	XVBITREVI_B:      "XVBITREVI.B",
+	XVBITREVI_D:      "XVBITREVI.D",
+	XVBITREVI_H:      "XVBITREVI.H",
+	XVBITREVI_W:      "XVBITREVI.W",
+	XVBITREV_B:       "XVBITREV.B",
+	XVBITREV_D:       "XVBITREV.D",
+	XVBITREV_H:       "XVBITREV.H",
+	XVBITREV_W:       "XVBITREV.W",
+	XVBITSETI_B:      "XVBITSETI.B",
+	XVBITSETI_D:      "XVBITSETI.D",
+	XVBITSETI_H:      "XVBITSETI.H",
+	XVBITSETI_W:      "XVBITSETI.W",
+	XVBITSET_B:       "XVBITSET.B",
+	XVBITSET_D:       "XVBITSET.D",
+	XVBITSET_H:       "XVBITSET.H",
+	XVBITSET_W:       "XVBITSET.W",
+	XVDIV_B:          "XVDIV.B",
+	XVDIV_BU:         "XVDIV.BU",
+	XVDIV_D:          "XVDIV.D",
+	XVDIV_DU:         "XVDIV.DU",
+	XVDIV_H:          "XVDIV.H",
+	XVDIV_HU:         "XVDIV.HU",
+	XVDIV_W:          "XVDIV.W",
+	XVDIV_WU:         "XVDIV.WU",
+	XVEXTRINS_B:      "XVEXTRINS.B",
+	XVEXTRINS_D:      "XVEXTRINS.D",
+	XVEXTRINS_H:      "XVEXTRINS.H",
+	XVEXTRINS_W:      "XVEXTRINS.W",
+	XVFADD_D:         "XVFADD.D",
+	XVFADD_S:         "XVFADD.S",
+	XVFCLASS_D:       "XVFCLASS.D",
+	XVFCLASS_S:       "XVFCLASS.S",
+	XVFDIV_D:         "XVFDIV.D",
+	XVFDIV_S:         "XVFDIV.S",
+	XVFMUL_D:         "XVFMUL.D",
+	XVFMUL_S:         "XVFMUL.S",
+	XVFRECIP_D:       "XVFRECIP.D",
+	XVFRECIP_S:       "XVFRECIP.S",
+	XVFRINTRM_D:      "XVFRINTRM.D",
+	XVFRINTRM_S:      "XVFRINTRM.S",
+	XVFRINTRNE_D:     "XVFRINTRNE.D",
+	XVFRINTRNE_S:     "XVFRINTRNE.S",
+	XVFRINTRP_D:      "XVFRINTRP.D",
+	XVFRINTRP_S:      "XVFRINTRP.S",
+	XVFRINTRZ_D:      "XVFRINTRZ.D",
+	XVFRINTRZ_S:      "XVFRINTRZ.S",
+	XVFRINT_D:        "XVFRINT.D",
+	XVFRINT_S:        "XVFRINT.S",
+	XVFRSQRT_D:       "XVFRSQRT.D",
+	XVFRSQRT_S:       "XVFRSQRT.S",
+	XVFSQRT_D:        "XVFSQRT.D",
+	XVFSQRT_S:        "XVFSQRT.S",
+	XVFSUB_D:         "XVFSUB.D",
+	XVFSUB_S:         "XVFSUB.S",
+	XVILVH_B:         "XVILVH.B",
+	XVILVH_D:         "XVILVH.D",
+	XVILVH_H:         "XVILVH.H",
+	XVILVH_W:         "XVILVH.W",
+	XVILVL_B:         "XVILVL.B",
+	XVILVL_D:         "XVILVL.D",
+	XVILVL_H:         "XVILVL.H",
+	XVILVL_W:         "XVILVL.W",
+	XVINSGR2VR_D:     "XVINSGR2VR.D",
+	XVINSGR2VR_W:     "XVINSGR2VR.W",
+	XVINSVE0_D:       "XVINSVE0.D",
+	XVINSVE0_W:       "XVINSVE0.W",
+	XVLD:             "XVLD",
+	XVLDREPL_B:       "XVLDREPL.B",
+	XVLDREPL_D:       "XVLDREPL.D",
+	XVLDREPL_H:       "XVLDREPL.H",
+	XVLDREPL_W:       "XVLDREPL.W",
+	XVLDX:            "XVLDX",
+	XVMADDWEV_D_W:    "XVMADDWEV.D.W",
+	XVMADDWEV_D_WU:   "XVMADDWEV.D.WU",
+	XVMADDWEV_D_WU_W: "XVMADDWEV.D.WU.W",
+	XVMADDWEV_H_B:    "XVMADDWEV.H.B",
+	XVMADDWEV_H_BU:   "XVMADDWEV.H.BU",
+	XVMADDWEV_H_BU_B: "XVMADDWEV.H.BU.B",
+	XVMADDWEV_Q_D:    "XVMADDWEV.Q.D",
+	XVMADDWEV_Q_DU:   "XVMADDWEV.Q.DU",
+	XVMADDWEV_Q_DU_D: "XVMADDWEV.Q.DU.D",
+	XVMADDWEV_W_H:    "XVMADDWEV.W.H",
+	XVMADDWEV_W_HU:   "XVMADDWEV.W.HU",
+	XVMADDWEV_W_HU_H: "XVMADDWEV.W.HU.H",
+	XVMADDWOD_D_W:    "XVMADDWOD.D.W",
+	XVMADDWOD_D_WU:   "XVMADDWOD.D.WU",
+	XVMADDWOD_D_WU_W: "XVMADDWOD.D.WU.W",
+	XVMADDWOD_H_B:    "XVMADDWOD.H.B",
+	XVMADDWOD_H_BU:   "XVMADDWOD.H.BU",
+	XVMADDWOD_H_BU_B: "XVMADDWOD.H.BU.B",
+	XVMADDWOD_Q_D:    "XVMADDWOD.Q.D",
+	XVMADDWOD_Q_DU:   "XVMADDWOD.Q.DU",
+	XVMADDWOD_Q_DU_D: "XVMADDWOD.Q.DU.D",
+	XVMADDWOD_W_H:    "XVMADDWOD.W.H",
+	XVMADDWOD_W_HU:   "XVMADDWOD.W.HU",
+	XVMADDWOD_W_HU_H: "XVMADDWOD.W.HU.H",
+	XVMADD_B:         "XVMADD.B",
+	XVMADD_D:         "XVMADD.D",
+	XVMADD_H:         "XVMADD.H",
+	XVMADD_W:         "XVMADD.W",
+	XVMOD_B:          "XVMOD.B",
+	XVMOD_BU:         "XVMOD.BU",
+	XVMOD_D:          "XVMOD.D",
+	XVMOD_DU:         "XVMOD.DU",
+	XVMOD_H:          "XVMOD.H",
+	XVMOD_HU:         "XVMOD.HU",
+	XVMOD_W:          "XVMOD.W",
+	XVMOD_WU:         "XVMOD.WU",
+	XVMSUB_B:         "XVMSUB.B",
+	XVMSUB_D:         "XVMSUB.D",
+	XVMSUB_H:         "XVMSUB.H",
+	XVMSUB_W:         "XVMSUB.W",
+	XVMUH_B:          "XVMUH.B",
+	XVMUH_BU:         "XVMUH.BU",
+	XVMUH_D:          "XVMUH.D",
+	XVMUH_DU:         "XVMUH.DU",
+	XVMUH_H:          "XVMUH.H",
+	XVMUH_HU:         "XVMUH.HU",
+	XVMUH_W:          "XVMUH.W",
+	XVMUH_WU:         "XVMUH.WU",
+	XVMULWEV_D_W:     "XVMULWEV.D.W",
+	XVMULWEV_D_WU:    "XVMULWEV.D.WU",
+	XVMULWEV_D_WU_W:  "XVMULWEV.D.WU.W",
+	XVMULWEV_H_B:     "XVMULWEV.H.B",
+	XVMULWEV_H_BU:    "XVMULWEV.H.BU",
+	XVMULWEV_H_BU_B:  "XVMULWEV.H.BU.B",
+	XVMULWEV_Q_D:     "XVMULWEV.Q.D",
+	XVMULWEV_Q_DU:    "XVMULWEV.Q.DU",
+	XVMULWEV_Q_DU_D:  "XVMULWEV.Q.DU.D",
+	XVMULWEV_W_H:     "XVMULWEV.W.H",
+	XVMULWEV_W_HU:    "XVMULWEV.W.HU",
+	XVMULWEV_W_HU_H:  "XVMULWEV.W.HU.H",
+	XVMULWOD_D_W:     "XVMULWOD.D.W",
+	XVMULWOD_D_WU:    "XVMULWOD.D.WU",
+	XVMULWOD_D_WU_W:  "XVMULWOD.D.WU.W",
+	XVMULWOD_H_B:     "XVMULWOD.H.B",
+	XVMULWOD_H_BU:    "XVMULWOD.H.BU",
+	XVMULWOD_H_BU_B:  "XVMULWOD.H.BU.B",
+	XVMULWOD_Q_D:     "XVMULWOD.Q.D",
+	XVMULWOD_Q_DU:    "XVMULWOD.Q.DU",
+	XVMULWOD_Q_DU_D:  "XVMULWOD.Q.DU.D",
+	XVMULWOD_W_H:     "XVMULWOD.W.H",
+	XVMULWOD_W_HU:    "XVMULWOD.W.HU",
+	XVMULWOD_W_HU_H:  "XVMULWOD.W.HU.H",
+	XVMUL_B:          "XVMUL.B",
+	XVMUL_D:          "XVMUL.D",
+	XVMUL_H:          "XVMUL.H",
+	XVMUL_W:          "XVMUL.W",
+	XVNEG_B:          "XVNEG.B",
+	XVNEG_D:          "XVNEG.D",
+	XVNEG_H:          "XVNEG.H",
+	XVNEG_W:          "XVNEG.W",
+	XVNORI_B:         "XVNORI.B",
+	XVNOR_V:          "XVNOR.V",
+	XVORI_B:          "XVORI.B",
+	XVORN_V:          "XVORN.V",
+	XVOR_V:           "XVOR.V",
+	XVPCNT_B:         "XVPCNT.B",
+	XVPCNT_D:         "XVPCNT.D",
+	XVPCNT_H:         "XVPCNT.H",
+	XVPCNT_W:         "XVPCNT.W",
+	XVPERMI_D:        "XVPERMI.D",
+	XVPERMI_Q:        "XVPERMI.Q",
+	XVPERMI_W:        "XVPERMI.W",
+	XVPICKVE2GR_D:    "XVPICKVE2GR.D",
+	XVPICKVE2GR_DU:   "XVPICKVE2GR.DU",
+	XVPICKVE2GR_W:    "XVPICKVE2GR.W",
+	XVPICKVE2GR_WU:   "XVPICKVE2GR.WU",
+	XVPICKVE_D:       "XVPICKVE.D",
+	XVPICKVE_W:       "XVPICKVE.W",
+	XVREPLGR2VR_B:    "XVREPLGR2VR.B",
+	XVREPLGR2VR_D:    "XVREPLGR2VR.D",
+	XVREPLGR2VR_H:    "XVREPLGR2VR.H",
+	XVREPLGR2VR_W:    "XVREPLGR2VR.W",
+	XVREPLVE0_B:      "XVREPLVE0.B",
+	XVREPLVE0_D:      "XVREPLVE0.D",
+	XVREPLVE0_H:      "XVREPLVE0.H",
+	XVREPLVE0_Q:      "XVREPLVE0.Q",
+	XVREPLVE0_W:      "XVREPLVE0.W",
+	XVROTRI_B:        "XVROTRI.B",
+	XVROTRI_D:        "XVROTRI.D",
+	XVROTRI_H:        "XVROTRI.H",
+	XVROTRI_W:        "XVROTRI.W",
+	XVROTR_B:         "XVROTR.B",
+	XVROTR_D:         "XVROTR.D",
+	XVROTR_H:         "XVROTR.H",
+	XVROTR_W:         "XVROTR.W",
+	XVSADD_B:         "XVSADD.B",
+	XVSADD_BU:        "XVSADD.BU",
+	XVSADD_D:         "XVSADD.D",
+	XVSADD_DU:        "XVSADD.DU",
+	XVSADD_H:         "XVSADD.H",
+	XVSADD_HU:        "XVSADD.HU",
+	XVSADD_W:         "XVSADD.W",
+	XVSADD_WU:        "XVSADD.WU",
+	XVSEQI_B:         "XVSEQI.B",
+	XVSEQI_D:         "XVSEQI.D",
+	XVSEQI_H:         "XVSEQI.H",
+	XVSEQI_W:         "XVSEQI.W",
+	XVSEQ_B:          "XVSEQ.B",
+	XVSEQ_D:          "XVSEQ.D",
+	XVSEQ_H:          "XVSEQ.H",
+	XVSEQ_W:          "XVSEQ.W",
+	XVSETALLNEZ_B:    "XVSETALLNEZ.B",
+	XVSETALLNEZ_D:    "XVSETALLNEZ.D",
+	XVSETALLNEZ_H:    "XVSETALLNEZ.H",
+	XVSETALLNEZ_W:    "XVSETALLNEZ.W",
+	XVSETANYEQZ_B:    "XVSETANYEQZ.B",
+	XVSETANYEQZ_D:    "XVSETANYEQZ.D",
+	XVSETANYEQZ_H:    "XVSETANYEQZ.H",
+	XVSETANYEQZ_W:    "XVSETANYEQZ.W",
+	XVSETEQZ_V:       "XVSETEQZ.V",
+	XVSETNEZ_V:       "XVSETNEZ.V",
+	XVSHUF4I_B:       "XVSHUF4I.B",
+	XVSHUF4I_D:       "XVSHUF4I.D",
+	XVSHUF4I_H:       "XVSHUF4I.H",
+	XVSHUF4I_W:       "XVSHUF4I.W",
+	XVSHUF_B:         "XVSHUF.B",
+	XVSHUF_D:         "XVSHUF.D",
+	XVSHUF_H:         "XVSHUF.H",
+	XVSHUF_W:         "XVSHUF.W",
+	XVSLLI_B:         "XVSLLI.B",
+	XVSLLI_D:         "XVSLLI.D",
+	XVSLLI_H:         "XVSLLI.H",
+	XVSLLI_W:         "XVSLLI.W",
+	XVSLL_B:          "XVSLL.B",
+	XVSLL_D:          "XVSLL.D",
+	XVSLL_H:          "XVSLL.H",
+	XVSLL_W:          "XVSLL.W",
+	XVSLTI_B:         "XVSLTI.B",
+	XVSLTI_BU:        "XVSLTI.BU",
+	XVSLTI_D:         "XVSLTI.D",
+	XVSLTI_DU:        "XVSLTI.DU",
+	XVSLTI_H:         "XVSLTI.H",
+	XVSLTI_HU:        "XVSLTI.HU",
+	XVSLTI_W:         "XVSLTI.W",
+	XVSLTI_WU:        "XVSLTI.WU",
+	XVSLT_B:          "XVSLT.B",
+	XVSLT_BU:         "XVSLT.BU",
+	XVSLT_D:          "XVSLT.D",
+	XVSLT_DU:         "XVSLT.DU",
+	XVSLT_H:          "XVSLT.H",
+	XVSLT_HU:         "XVSLT.HU",
+	XVSLT_W:          "XVSLT.W",
+	XVSLT_WU:         "XVSLT.WU",
+	XVSRAI_B:         "XVSRAI.B",
+	XVSRAI_D:         "XVSRAI.D",
+	XVSRAI_H:         "XVSRAI.H",
+	XVSRAI_W:         "XVSRAI.W",
+	XVSRA_B:          "XVSRA.B",
+	XVSRA_D:          "XVSRA.D",
+	XVSRA_H:          "XVSRA.H",
+	XVSRA_W:          "XVSRA.W",
+	XVSRLI_B:         "XVSRLI.B",
+	XVSRLI_D:         "XVSRLI.D",
+	XVSRLI_H:         "XVSRLI.H",
+	XVSRLI_W:         "XVSRLI.W",
+	XVSRL_B:          "XVSRL.B",
+	XVSRL_D:          "XVSRL.D",
+	XVSRL_H:          "XVSRL.H",
+	XVSRL_W:          "XVSRL.W",
+	XVSSUB_B:         "XVSSUB.B",
+	XVSSUB_BU:        "XVSSUB.BU",
+	XVSSUB_D:         "XVSSUB.D",
+	XVSSUB_DU:        "XVSSUB.DU",
+	XVSSUB_H:         "XVSSUB.H",
+	XVSSUB_HU:        "XVSSUB.HU",
+	XVSSUB_W:         "XVSSUB.W",
+	XVSSUB_WU:        "XVSSUB.WU",
+	XVST:             "XVST",
+	XVSTX:            "XVSTX",
+	XVSUBI_BU:        "XVSUBI.BU",
+	XVSUBI_DU:        "XVSUBI.DU",
+	XVSUBI_HU:        "XVSUBI.HU",
+	XVSUBI_WU:        "XVSUBI.WU",
+	XVSUBWEV_D_W:     "XVSUBWEV.D.W",
+	XVSUBWEV_D_WU:    "XVSUBWEV.D.WU",
+	XVSUBWEV_H_B:     "XVSUBWEV.H.B",
+	XVSUBWEV_H_BU:    "XVSUBWEV.H.BU",
+	XVSUBWEV_Q_D:     "XVSUBWEV.Q.D",
+	XVSUBWEV_Q_DU:    "XVSUBWEV.Q.DU",
+	XVSUBWEV_W_H:     "XVSUBWEV.W.H",
+	XVSUBWEV_W_HU:    "XVSUBWEV.W.HU",
+	XVSUBWOD_D_W:     "XVSUBWOD.D.W",
+	XVSUBWOD_D_WU:    "XVSUBWOD.D.WU",
+	XVSUBWOD_H_B:     "XVSUBWOD.H.B",
+	XVSUBWOD_H_BU:    "XVSUBWOD.H.BU",
+	XVSUBWOD_Q_D:     "XVSUBWOD.Q.D",
+	XVSUBWOD_Q_DU:    "XVSUBWOD.Q.DU",
+	XVSUBWOD_W_H:     "XVSUBWOD.W.H",
+	XVSUBWOD_W_HU:    "XVSUBWOD.W.HU",
+	XVSUB_B:          "XVSUB.B",
+	XVSUB_D:          "XVSUB.D",
+	XVSUB_H:          "XVSUB.H",
+	XVSUB_Q:          "XVSUB.Q",
+	XVSUB_W:          "XVSUB.W",
+	XVXORI_B:         "XVXORI.B",
+	XVXOR_V:          "XVXOR.V",
 }
 
 var instFormats = [...]instFormat{
+	// ADC.B rd, rj, rk
+	{mask: 0xffff8000, value: 0x00300000, op: ADC_B, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// ADC.D rd, rj, rk
+	{mask: 0xffff8000, value: 0x00318000, op: ADC_D, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// ADC.H rd, rj, rk
+	{mask: 0xffff8000, value: 0x00308000, op: ADC_H, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// ADC.W rd, rj, rk
+	{mask: 0xffff8000, value: 0x00310000, op: ADC_W, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// ADDI.D rd, rj, si12
 	{mask: 0xffc00000, value: 0x02c00000, op: ADDI_D, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
 	// ADDI.W rd, rj, si12
 	{mask: 0xffc00000, value: 0x02800000, op: ADDI_W, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
+	// ADDU12I.D rd, rj, si5
+	{mask: 0xffff8000, value: 0x00298000, op: ADDU12I_D, args: instArgs{arg_rd, arg_rj, arg_si5_14_10}},
+	// ADDU12I.W rd, rj, si5
+	{mask: 0xffff8000, value: 0x00290000, op: ADDU12I_W, args: instArgs{arg_rd, arg_rj, arg_si5_14_10}},
 	// ADDU16I.D rd, rj, si16
 	{mask: 0xfc000000, value: 0x10000000, op: ADDU16I_D, args: instArgs{arg_rd, arg_rj, arg_si16_25_10}},
 	// ADD.D rd, rj, rk
@@ -938,6 +2646,50 @@ var instFormats = [...]instFormat{
 	{mask: 0xffc00000, value: 0x03400000, op: ANDI, args: instArgs{arg_rd, arg_rj, arg_ui12_21_10}},
 	// ANDN rd, rj, rk
 	{mask: 0xffff8000, value: 0x00168000, op: ANDN, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// ARMADC.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x00380010, op: ARMADC_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMADD.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x00370010, op: ARMADD_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMAND.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x00390010, op: ARMAND_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMMFFLAG rd, ui8
+	{mask: 0xfffc03e0, value: 0x005c0040, op: ARMMFFLAG, args: instArgs{arg_rd, arg_ui8_17_10}},
+	// ARMMOVE rd, rj, ui4
+	{mask: 0xffffc000, value: 0x00364000, op: ARMMOVE, args: instArgs{arg_rd, arg_rj, arg_ui4_13_10}},
+	// ARMMOV.D rj, ui4
+	{mask: 0xffffc01f, value: 0x003fc01e, op: ARMMOV_D, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// ARMMOV.W rj, ui4
+	{mask: 0xffffc01f, value: 0x003fc01d, op: ARMMOV_W, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// ARMMTFLAG rd, ui8
+	{mask: 0xfffc03e0, value: 0x005c0060, op: ARMMTFLAG, args: instArgs{arg_rd, arg_ui8_17_10}},
+	// ARMNOT.W rj, ui4
+	{mask: 0xffffc01f, value: 0x003fc01c, op: ARMNOT_W, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// ARMOR.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x00398010, op: ARMOR_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMROTRI.W rj, ui5, ui4
+	{mask: 0xffff8010, value: 0x003e0010, op: ARMROTRI_W, args: instArgs{arg_rj, arg_ui5_14_10, arg_ui4_3_0}},
+	// ARMROTR.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x003c0010, op: ARMROTR_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMRRX.W rj, ui4
+	{mask: 0xffffc01f, value: 0x003fc01f, op: ARMRRX_W, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// ARMSBC.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x00388010, op: ARMSBC_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMSLLI.W rj, ui5, ui4
+	{mask: 0xffff8010, value: 0x003c8010, op: ARMSLLI_W, args: instArgs{arg_rj, arg_ui5_14_10, arg_ui4_3_0}},
+	// ARMSLL.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x003a8010, op: ARMSLL_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMSRAI.W rj, ui5, ui4
+	{mask: 0xffff8010, value: 0x003d8010, op: ARMSRAI_W, args: instArgs{arg_rj, arg_ui5_14_10, arg_ui4_3_0}},
+	// ARMSRA.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x003b8010, op: ARMSRA_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMSRLI.W rj, ui5, ui4
+	{mask: 0xffff8010, value: 0x003d0010, op: ARMSRLI_W, args: instArgs{arg_rj, arg_ui5_14_10, arg_ui4_3_0}},
+	// ARMSRL.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x003b0010, op: ARMSRL_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMSUB.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x00378010, op: ARMSUB_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
+	// ARMXOR.W rj, rk, ui4
+	{mask: 0xffff8010, value: 0x003a0010, op: ARMXOR_W, args: instArgs{arg_rj, arg_rk, arg_ui4_3_0}},
 	// ASRTGT.D rj, rk
 	{mask: 0xffff801f, value: 0x00018000, op: ASRTGT_D, args: instArgs{arg_rj, arg_rk}},
 	// ASRTLE.D rj, rk
@@ -1152,10 +2904,16 @@ var instFormats = [...]instFormat{
 	{mask: 0xffff8000, value: 0x01130000, op: FCOPYSIGN_D, args: instArgs{arg_fd, arg_fj, arg_fk}},
 	// FCOPYSIGN.S fd, fj, fk
 	{mask: 0xffff8000, value: 0x01128000, op: FCOPYSIGN_S, args: instArgs{arg_fd, arg_fj, arg_fk}},
+	// FCVT.D.LD fd, fj, fk
+	{mask: 0xffff8000, value: 0x01150000, op: FCVT_D_LD, args: instArgs{arg_fd, arg_fj, arg_fk}},
 	// FCVT.D.S fd, fj
 	{mask: 0xfffffc00, value: 0x01192400, op: FCVT_D_S, args: instArgs{arg_fd, arg_fj}},
+	// FCVT.LD.D fd, fj
+	{mask: 0xfffffc00, value: 0x0114e000, op: FCVT_LD_D, args: instArgs{arg_fd, arg_fj}},
 	// FCVT.S.D fd, fj
 	{mask: 0xfffffc00, value: 0x01191800, op: FCVT_S_D, args: instArgs{arg_fd, arg_fj}},
+	// FCVT.UD.D fd, fj
+	{mask: 0xfffffc00, value: 0x0114e400, op: FCVT_UD_D, args: instArgs{arg_fd, arg_fj}},
 	// FDIV.D fd, fj, fk
 	{mask: 0xffff8000, value: 0x01070000, op: FDIV_D, args: instArgs{arg_fd, arg_fj, arg_fk}},
 	// FDIV.S fd, fj, fk
@@ -1322,6 +3080,16 @@ var instFormats = [...]instFormat{
 	{mask: 0xfffffc00, value: 0x011b0800, op: FTINT_W_D, args: instArgs{arg_fd, arg_fj}},
 	// FTINT.W.S fd, fj
 	{mask: 0xfffffc00, value: 0x011b0400, op: FTINT_W_S, args: instArgs{arg_fd, arg_fj}},
+	// GCSRRD rd, csr
+	{mask: 0xff0003e0, value: 0x05000000, op: GCSRRD, args: instArgs{arg_rd, arg_csr_23_10}},
+	// GCSRWR rd, csr
+	{mask: 0xff0003e0, value: 0x05000020, op: GCSRWR, args: instArgs{arg_rd, arg_csr_23_10}},
+	// GCSRXCHG rd, rj, csr
+	{mask: 0xff000000, value: 0x05000000, op: GCSRXCHG, args: instArgs{arg_rd, arg_rj, arg_csr_23_10}},
+	// GTLBFLUSH
+	{mask: 0xffffffff, value: 0x06482401, op: GTLBFLUSH, args: instArgs{}},
+	// HVCL code
+	{mask: 0xffff8000, value: 0x002b8000, op: HVCL, args: instArgs{arg_code_14_0}},
 	// IBAR hint
 	{mask: 0xffff8000, value: 0x38728000, op: IBAR, args: instArgs{arg_hint_14_0}},
 	// IDLE level
@@ -1346,6 +3114,10 @@ var instFormats = [...]instFormat{
 	{mask: 0xfffffc00, value: 0x06481800, op: IOCSRWR_W, args: instArgs{arg_rd, arg_rj}},
 	// JIRL rd, rj, offs
 	{mask: 0xfc000000, value: 0x4c000000, op: JIRL, args: instArgs{arg_rd, arg_rj, arg_offset_15_0}},
+	// JISCR0 offs21
+	{mask: 0xfc0003e0, value: 0x48000200, op: JISCR0, args: instArgs{arg_offset_20_0}},
+	// JISCR1 offs21
+	{mask: 0xfc0003e0, value: 0x48000300, op: JISCR1, args: instArgs{arg_offset_20_0}},
 	// LDDIR rd, rj, level
 	{mask: 0xfffc0000, value: 0x06400000, op: LDDIR, args: instArgs{arg_rd, arg_rj, arg_level_17_10}},
 	// LDGT.B rd, rj, rk
@@ -1364,12 +3136,20 @@ var instFormats = [...]instFormat{
 	{mask: 0xffff8000, value: 0x387a8000, op: LDLE_H, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// LDLE.W rd, rj, rk
 	{mask: 0xffff8000, value: 0x387b0000, op: LDLE_W, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// LDL.D rd, rj, si12
+	{mask: 0xffc00000, value: 0x2e800000, op: LDL_D, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
+	// LDL.W rd, rj, si12
+	{mask: 0xffc00000, value: 0x2e000000, op: LDL_W, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
 	// LDPTE rj, seq
 	{mask: 0xfffc001f, value: 0x06440000, op: LDPTE, args: instArgs{arg_rj, arg_seq_17_10}},
 	// LDPTR.D rd, rj, si14
 	{mask: 0xff000000, value: 0x26000000, op: LDPTR_D, args: instArgs{arg_rd, arg_rj, arg_si14_23_10}},
 	// LDPTR.W rd, rj, si14
 	{mask: 0xff000000, value: 0x24000000, op: LDPTR_W, args: instArgs{arg_rd, arg_rj, arg_si14_23_10}},
+	// LDR.D rd, rj, si12
+	{mask: 0xffc00000, value: 0x2ec00000, op: LDR_D, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
+	// LDR.W rd, rj, si12
+	{mask: 0xffc00000, value: 0x2e400000, op: LDR_W, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
 	// LDX.B rd, rj, rk
 	{mask: 0xffff8000, value: 0x38000000, op: LDX_B, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// LDX.BU rd, rj, rk
@@ -1448,6 +3228,10 @@ var instFormats = [...]instFormat{
 	{mask: 0xfffffc00, value: 0x0114a800, op: MOVGR2FR_D, args: instArgs{arg_fd, arg_rj}},
 	// MOVGR2FR.W fd, rj
 	{mask: 0xfffffc00, value: 0x0114a400, op: MOVGR2FR_W, args: instArgs{arg_fd, arg_rj}},
+	// MOVGR2SCR sd, rj
+	{mask: 0xfffffc1c, value: 0x00000800, op: MOVGR2SCR, args: instArgs{arg_sd, arg_rj}},
+	// MOVSCR2GR rd, sj
+	{mask: 0xffffff80, value: 0x00000c00, op: MOVSCR2GR, args: instArgs{arg_rd, arg_sj}},
 	// MULH.D rd, rj, rk
 	{mask: 0xffff8000, value: 0x001e0000, op: MULH_D, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// MULH.DU rd, rj, rk
@@ -1484,6 +3268,22 @@ var instFormats = [...]instFormat{
 	{mask: 0xffc00000, value: 0x2ac00000, op: PRELD, args: instArgs{arg_hint_4_0, arg_rj, arg_si12_21_10}},
 	// PRELDX hint, rj, rk
 	{mask: 0xffff8000, value: 0x382c0000, op: PRELDX, args: instArgs{arg_hint_4_0, arg_rj, arg_rk}},
+	// RCRI.B rd, rj, ui3
+	{mask: 0xffffe000, value: 0x00502000, op: RCRI_B, args: instArgs{arg_rd, arg_rj, arg_ui3_12_10}},
+	// RCRI.D rd, rj, ui6
+	{mask: 0xffff0000, value: 0x00510000, op: RCRI_D, args: instArgs{arg_rd, arg_rj, arg_ui6_15_10}},
+	// RCRI.H rd, rj, ui4
+	{mask: 0xffffc000, value: 0x00504000, op: RCRI_H, args: instArgs{arg_rd, arg_rj, arg_ui4_13_10}},
+	// RCRI.W rd, rj, ui5
+	{mask: 0xffff8000, value: 0x00508000, op: RCRI_W, args: instArgs{arg_rd, arg_rj, arg_ui5_14_10}},
+	// RCR.B rd, rj, rk
+	{mask: 0xffff8000, value: 0x00340000, op: RCR_B, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// RCR.D rd, rj, rk
+	{mask: 0xffff8000, value: 0x00358000, op: RCR_D, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// RCR.H rd, rj, rk
+	{mask: 0xffff8000, value: 0x00348000, op: RCR_H, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// RCR.W rd, rj, rk
+	{mask: 0xffff8000, value: 0x00350000, op: RCR_W, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// RDTIMEH.W rd, rj
 	{mask: 0xfffffc00, value: 0x00006400, op: RDTIMEH_W, args: instArgs{arg_rd, arg_rj}},
 	// RDTIMEL.W rd, rj
@@ -1502,14 +3302,30 @@ var instFormats = [...]instFormat{
 	{mask: 0xfffffc00, value: 0x00004000, op: REVH_2W, args: instArgs{arg_rd, arg_rj}},
 	// REVH.D rd, rj
 	{mask: 0xfffffc00, value: 0x00004400, op: REVH_D, args: instArgs{arg_rd, arg_rj}},
+	// ROTRI.B rd, rj, ui3
+	{mask: 0xffffe000, value: 0x004c2000, op: ROTRI_B, args: instArgs{arg_rd, arg_rj, arg_ui3_12_10}},
 	// ROTRI.D rd, rj, ui6
 	{mask: 0xffff0000, value: 0x004d0000, op: ROTRI_D, args: instArgs{arg_rd, arg_rj, arg_ui6_15_10}},
+	// ROTRI.H rd, rj, ui4
+	{mask: 0xffffc000, value: 0x004c4000, op: ROTRI_H, args: instArgs{arg_rd, arg_rj, arg_ui4_13_10}},
 	// ROTRI.W rd, rj, ui5
 	{mask: 0xffff8000, value: 0x004c8000, op: ROTRI_W, args: instArgs{arg_rd, arg_rj, arg_ui5_14_10}},
+	// ROTR.B rd, rj, rk
+	{mask: 0xffff8000, value: 0x001a0000, op: ROTR_B, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// ROTR.D rd, rj, rk
 	{mask: 0xffff8000, value: 0x001b8000, op: ROTR_D, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// ROTR.H rd, rj, rk
+	{mask: 0xffff8000, value: 0x001a8000, op: ROTR_H, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// ROTR.W rd, rj, rk
 	{mask: 0xffff8000, value: 0x001b0000, op: ROTR_W, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// SBC.B rd, rj, rk
+	{mask: 0xffff8000, value: 0x00320000, op: SBC_B, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// SBC.D rd, rj, rk
+	{mask: 0xffff8000, value: 0x00338000, op: SBC_D, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// SBC.H rd, rj, rk
+	{mask: 0xffff8000, value: 0x00328000, op: SBC_H, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// SBC.W rd, rj, rk
+	{mask: 0xffff8000, value: 0x00330000, op: SBC_W, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// SCREL.D rd, rj
 	{mask: 0xfffffc00, value: 0x38578c00, op: SCREL_D, args: instArgs{arg_rd, arg_rj}},
 	// SCREL.W rd, rj
@@ -1520,6 +3336,14 @@ var instFormats = [...]instFormat{
 	{mask: 0xffff8000, value: 0x38570000, op: SC_Q, args: instArgs{arg_rd, arg_rk, arg_rj}},
 	// SC.W rd, rj, si14
 	{mask: 0xff000000, value: 0x21000000, op: SC_W, args: instArgs{arg_rd, arg_rj, arg_si14_23_10}},
+	// SETARMJ rd, ui4
+	{mask: 0xffffc3e0, value: 0x0036c000, op: SETARMJ, args: instArgs{arg_rd, arg_ui4_13_10}},
+	// SETX86J rd, ui4
+	{mask: 0xffffc3e0, value: 0x00368000, op: SETX86J, args: instArgs{arg_rd, arg_ui4_13_10}},
+	// SETX86LOOPE rd, rj
+	{mask: 0xfffffc00, value: 0x00007800, op: SETX86LOOPE, args: instArgs{arg_rd, arg_rj}},
+	// SETX86LOOPNE rd, rj
+	{mask: 0xfffffc00, value: 0x00007c00, op: SETX86LOOPNE, args: instArgs{arg_rd, arg_rj}},
 	// SLLI.D rd, rj, ui6
 	{mask: 0xffff0000, value: 0x00410000, op: SLLI_D, args: instArgs{arg_rd, arg_rj, arg_ui6_15_10}},
 	// SLLI.W rd, rj, ui5
@@ -1568,10 +3392,18 @@ var instFormats = [...]instFormat{
 	{mask: 0xffff8000, value: 0x387e8000, op: STLE_H, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// STLE.W rd, rj, rk
 	{mask: 0xffff8000, value: 0x387f0000, op: STLE_W, args: instArgs{arg_rd, arg_rj, arg_rk}},
+	// STL.D rd, rj, si12
+	{mask: 0xffc00000, value: 0x2f800000, op: STL_D, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
+	// STL.W rd, rj, si12
+	{mask: 0xffc00000, value: 0x2f000000, op: STL_W, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
 	// STPTR.D rd, rj, si14
 	{mask: 0xff000000, value: 0x27000000, op: STPTR_D, args: instArgs{arg_rd, arg_rj, arg_si14_23_10}},
 	// STPTR.W rd, rj, si14
 	{mask: 0xff000000, value: 0x25000000, op: STPTR_W, args: instArgs{arg_rd, arg_rj, arg_si14_23_10}},
+	// STR.D rd, rj, si12
+	{mask: 0xffc00000, value: 0x2fc00000, op: STR_D, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
+	// STR.W rd, rj, si12
+	{mask: 0xffc00000, value: 0x2f400000, op: STR_W, args: instArgs{arg_rd, arg_rj, arg_si12_21_10}},
 	// STX.B rd, rj, rk
 	{mask: 0xffff8000, value: 0x38100000, op: STX_B, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// STX.D rd, rj, rk
@@ -1606,8 +3438,1568 @@ var instFormats = [...]instFormat{
 	{mask: 0xffffffff, value: 0x06482800, op: TLBSRCH, args: instArgs{}},
 	// TLBWR
 	{mask: 0xffffffff, value: 0x06483000, op: TLBWR, args: instArgs{}},
+	// VADDI.BU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728a0000, op: VADDI_BU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VADDI.DU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728b8000, op: VADDI_DU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VADDI.HU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728a8000, op: VADDI_HU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VADDI.WU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728b0000, op: VADDI_WU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VADDWEV.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x701f0000, op: VADDWEV_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x702f0000, op: VADDWEV_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x701e0000, op: VADDWEV_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x702e0000, op: VADDWEV_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x701f8000, op: VADDWEV_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x702f8000, op: VADDWEV_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x701e8000, op: VADDWEV_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWEV.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x702e8000, op: VADDWEV_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70230000, op: VADDWOD_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70330000, op: VADDWOD_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70220000, op: VADDWOD_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70320000, op: VADDWOD_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70238000, op: VADDWOD_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70338000, op: VADDWOD_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70228000, op: VADDWOD_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADDWOD.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70328000, op: VADDWOD_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADD.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x700a0000, op: VADD_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADD.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x700b8000, op: VADD_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADD.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x700a8000, op: VADD_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADD.Q vd, vj, vk
+	{mask: 0xffff8000, value: 0x712d0000, op: VADD_Q, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VADD.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x700b0000, op: VADD_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VANDI.B vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73d00000, op: VANDI_B, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VANDN.V vd, vj, vk
+	{mask: 0xffff8000, value: 0x71280000, op: VANDN_V, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VAND.V vd, vj, vk
+	{mask: 0xffff8000, value: 0x71260000, op: VAND_V, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITCLRI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x73102000, op: VBITCLRI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VBITCLRI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x73110000, op: VBITCLRI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VBITCLRI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x73104000, op: VBITCLRI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VBITCLRI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x73108000, op: VBITCLRI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VBITCLR.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x710c0000, op: VBITCLR_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITCLR.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x710d8000, op: VBITCLR_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITCLR.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x710c8000, op: VBITCLR_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITCLR.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x710d0000, op: VBITCLR_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITREVI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x73182000, op: VBITREVI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VBITREVI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x73190000, op: VBITREVI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VBITREVI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x73184000, op: VBITREVI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VBITREVI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x73188000, op: VBITREVI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VBITREV.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x71100000, op: VBITREV_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITREV.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x71118000, op: VBITREV_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITREV.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x71108000, op: VBITREV_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITREV.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x71110000, op: VBITREV_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITSETI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x73142000, op: VBITSETI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VBITSETI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x73150000, op: VBITSETI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VBITSETI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x73144000, op: VBITSETI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VBITSETI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x73148000, op: VBITSETI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VBITSET.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x710e0000, op: VBITSET_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITSET.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x710f8000, op: VBITSET_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITSET.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x710e8000, op: VBITSET_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VBITSET.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x710f0000, op: VBITSET_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e00000, op: VDIV_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e40000, op: VDIV_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e18000, op: VDIV_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e58000, op: VDIV_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e08000, op: VDIV_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e48000, op: VDIV_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e10000, op: VDIV_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VDIV.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e50000, op: VDIV_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VEXTRINS.B vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x738c0000, op: VEXTRINS_B, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VEXTRINS.D vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73800000, op: VEXTRINS_D, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VEXTRINS.H vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73880000, op: VEXTRINS_H, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VEXTRINS.W vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73840000, op: VEXTRINS_W, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VFADD.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x71310000, op: VFADD_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFADD.S vd, vj, vk
+	{mask: 0xffff8000, value: 0x71308000, op: VFADD_S, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFCLASS.D vd, vj
+	{mask: 0xfffffc00, value: 0x729cd800, op: VFCLASS_D, args: instArgs{arg_vd, arg_vj}},
+	// VFCLASS.S vd, vj
+	{mask: 0xfffffc00, value: 0x729cd400, op: VFCLASS_S, args: instArgs{arg_vd, arg_vj}},
+	// VFDIV.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x713b0000, op: VFDIV_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFDIV.S vd, vj, vk
+	{mask: 0xffff8000, value: 0x713a8000, op: VFDIV_S, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFMUL.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x71390000, op: VFMUL_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFMUL.S vd, vj, vk
+	{mask: 0xffff8000, value: 0x71388000, op: VFMUL_S, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFRECIP.D vd, vj
+	{mask: 0xfffffc00, value: 0x729cf800, op: VFRECIP_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRECIP.S vd, vj
+	{mask: 0xfffffc00, value: 0x729cf400, op: VFRECIP_S, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRM.D vd, vj
+	{mask: 0xfffffc00, value: 0x729d4800, op: VFRINTRM_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRM.S vd, vj
+	{mask: 0xfffffc00, value: 0x729d4400, op: VFRINTRM_S, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRNE.D vd, vj
+	{mask: 0xfffffc00, value: 0x729d7800, op: VFRINTRNE_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRNE.S vd, vj
+	{mask: 0xfffffc00, value: 0x729d7400, op: VFRINTRNE_S, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRP.D vd, vj
+	{mask: 0xfffffc00, value: 0x729d5800, op: VFRINTRP_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRP.S vd, vj
+	{mask: 0xfffffc00, value: 0x729d5400, op: VFRINTRP_S, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRZ.D vd, vj
+	{mask: 0xfffffc00, value: 0x729d6800, op: VFRINTRZ_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRINTRZ.S vd, vj
+	{mask: 0xfffffc00, value: 0x729d6400, op: VFRINTRZ_S, args: instArgs{arg_vd, arg_vj}},
+	// VFRINT.D vd, vj
+	{mask: 0xfffffc00, value: 0x729d3800, op: VFRINT_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRINT.S vd, vj
+	{mask: 0xfffffc00, value: 0x729d3400, op: VFRINT_S, args: instArgs{arg_vd, arg_vj}},
+	// VFRSQRT.D vd, vj
+	{mask: 0xfffffc00, value: 0x729d0800, op: VFRSQRT_D, args: instArgs{arg_vd, arg_vj}},
+	// VFRSQRT.S vd, vj
+	{mask: 0xfffffc00, value: 0x729d0400, op: VFRSQRT_S, args: instArgs{arg_vd, arg_vj}},
+	// VFSQRT.D vd, vj
+	{mask: 0xfffffc00, value: 0x729ce800, op: VFSQRT_D, args: instArgs{arg_vd, arg_vj}},
+	// VFSQRT.S vd, vj
+	{mask: 0xfffffc00, value: 0x729ce400, op: VFSQRT_S, args: instArgs{arg_vd, arg_vj}},
+	// VFSUB.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x71330000, op: VFSUB_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VFSUB.S vd, vj, vk
+	{mask: 0xffff8000, value: 0x71328000, op: VFSUB_S, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVH.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x711c0000, op: VILVH_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVH.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x711d8000, op: VILVH_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVH.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x711c8000, op: VILVH_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVH.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x711d0000, op: VILVH_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVL.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x711a0000, op: VILVL_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVL.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x711b8000, op: VILVL_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVL.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x711a8000, op: VILVL_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VILVL.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x711b0000, op: VILVL_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VINSGR2VR.B vd, rj, ui4
+	{mask: 0xffffc000, value: 0x72eb8000, op: VINSGR2VR_B, args: instArgs{arg_vd, arg_rj, arg_ui4_13_10}},
+	// VINSGR2VR.D vd, rj, ui1
+	{mask: 0xfffff800, value: 0x72ebf000, op: VINSGR2VR_D, args: instArgs{arg_vd, arg_rj, arg_ui1_10_10}},
+	// VINSGR2VR.H vd, rj, ui3
+	{mask: 0xffffe000, value: 0x72ebc000, op: VINSGR2VR_H, args: instArgs{arg_vd, arg_rj, arg_ui3_12_10}},
+	// VINSGR2VR.W vd, rj, ui2
+	{mask: 0xfffff000, value: 0x72ebe000, op: VINSGR2VR_W, args: instArgs{arg_vd, arg_rj, arg_ui2_11_10}},
+	// VLD vd, rj, si12
+	{mask: 0xffc00000, value: 0x2c000000, op: VLD, args: instArgs{arg_vd, arg_rj, arg_si12_21_10}},
+	// VLDREPL.B vd, rj, si12
+	{mask: 0xffc00000, value: 0x30800000, op: VLDREPL_B, args: instArgs{arg_vd, arg_rj, arg_si12_21_10}},
+	// VLDREPL.D vd, rj, si9
+	{mask: 0xfff80000, value: 0x30100000, op: VLDREPL_D, args: instArgs{arg_vd, arg_rj, arg_si9_18_10}},
+	// VLDREPL.H vd, rj, si11
+	{mask: 0xffe00000, value: 0x30400000, op: VLDREPL_H, args: instArgs{arg_vd, arg_rj, arg_si11_20_10}},
+	// VLDREPL.W vd, rj, si10
+	{mask: 0xfff00000, value: 0x30200000, op: VLDREPL_W, args: instArgs{arg_vd, arg_rj, arg_si10_19_10}},
+	// VLDX vd, rj, rk
+	{mask: 0xffff8000, value: 0x38400000, op: VLDX, args: instArgs{arg_vd, arg_rj, arg_rk}},
+	// VMADDWEV.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ad0000, op: VMADDWEV_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b50000, op: VMADDWEV_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.D.WU.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70bd0000, op: VMADDWEV_D_WU_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ac0000, op: VMADDWEV_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b40000, op: VMADDWEV_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.H.BU.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70bc0000, op: VMADDWEV_H_BU_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ad8000, op: VMADDWEV_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b58000, op: VMADDWEV_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.Q.DU.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70bd8000, op: VMADDWEV_Q_DU_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ac8000, op: VMADDWEV_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b48000, op: VMADDWEV_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWEV.W.HU.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70bc8000, op: VMADDWEV_W_HU_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70af0000, op: VMADDWOD_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b70000, op: VMADDWOD_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.D.WU.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70bf0000, op: VMADDWOD_D_WU_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ae0000, op: VMADDWOD_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b60000, op: VMADDWOD_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.H.BU.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70be0000, op: VMADDWOD_H_BU_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70af8000, op: VMADDWOD_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b78000, op: VMADDWOD_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.Q.DU.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70bf8000, op: VMADDWOD_Q_DU_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ae8000, op: VMADDWOD_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70b68000, op: VMADDWOD_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADDWOD.W.HU.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70be8000, op: VMADDWOD_W_HU_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADD.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a80000, op: VMADD_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADD.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a98000, op: VMADD_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADD.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a88000, op: VMADD_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMADD.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a90000, op: VMADD_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e20000, op: VMOD_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e60000, op: VMOD_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e38000, op: VMOD_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e78000, op: VMOD_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e28000, op: VMOD_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e68000, op: VMOD_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e30000, op: VMOD_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMOD.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e70000, op: VMOD_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMSUB.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70aa0000, op: VMSUB_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMSUB.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ab8000, op: VMSUB_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMSUB.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70aa8000, op: VMSUB_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMSUB.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ab0000, op: VMSUB_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70860000, op: VMUH_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70880000, op: VMUH_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70878000, op: VMUH_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70898000, op: VMUH_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70868000, op: VMUH_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70888000, op: VMUH_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70870000, op: VMUH_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUH.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70890000, op: VMUH_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70910000, op: VMULWEV_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70990000, op: VMULWEV_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.D.WU.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a10000, op: VMULWEV_D_WU_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70900000, op: VMULWEV_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70980000, op: VMULWEV_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.H.BU.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a00000, op: VMULWEV_H_BU_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70918000, op: VMULWEV_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70998000, op: VMULWEV_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.Q.DU.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a18000, op: VMULWEV_Q_DU_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70908000, op: VMULWEV_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70988000, op: VMULWEV_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWEV.W.HU.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a08000, op: VMULWEV_W_HU_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70930000, op: VMULWOD_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x709b0000, op: VMULWOD_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.D.WU.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a30000, op: VMULWOD_D_WU_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70920000, op: VMULWOD_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x709a0000, op: VMULWOD_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.H.BU.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a20000, op: VMULWOD_H_BU_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70938000, op: VMULWOD_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x709b8000, op: VMULWOD_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.Q.DU.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a38000, op: VMULWOD_Q_DU_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70928000, op: VMULWOD_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x709a8000, op: VMULWOD_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMULWOD.W.HU.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70a28000, op: VMULWOD_W_HU_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUL.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70840000, op: VMUL_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUL.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70858000, op: VMUL_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUL.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70848000, op: VMUL_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VMUL.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70850000, op: VMUL_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VNEG.B vd, vj
+	{mask: 0xfffffc00, value: 0x729c3000, op: VNEG_B, args: instArgs{arg_vd, arg_vj}},
+	// VNEG.D vd, vj
+	{mask: 0xfffffc00, value: 0x729c3c00, op: VNEG_D, args: instArgs{arg_vd, arg_vj}},
+	// VNEG.H vd, vj
+	{mask: 0xfffffc00, value: 0x729c3400, op: VNEG_H, args: instArgs{arg_vd, arg_vj}},
+	// VNEG.W vd, vj
+	{mask: 0xfffffc00, value: 0x729c3800, op: VNEG_W, args: instArgs{arg_vd, arg_vj}},
+	// VNORI.B vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73dc0000, op: VNORI_B, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VNOR.V vd, vj, vk
+	{mask: 0xffff8000, value: 0x71278000, op: VNOR_V, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VORI.B vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73d40000, op: VORI_B, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VORN.V vd, vj, vk
+	{mask: 0xffff8000, value: 0x71288000, op: VORN_V, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VOR.V vd, vj, vk
+	{mask: 0xffff8000, value: 0x71268000, op: VOR_V, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VPCNT.B vd, vj
+	{mask: 0xfffffc00, value: 0x729c2000, op: VPCNT_B, args: instArgs{arg_vd, arg_vj}},
+	// VPCNT.D vd, vj
+	{mask: 0xfffffc00, value: 0x729c2c00, op: VPCNT_D, args: instArgs{arg_vd, arg_vj}},
+	// VPCNT.H vd, vj
+	{mask: 0xfffffc00, value: 0x729c2400, op: VPCNT_H, args: instArgs{arg_vd, arg_vj}},
+	// VPCNT.W vd, vj
+	{mask: 0xfffffc00, value: 0x729c2800, op: VPCNT_W, args: instArgs{arg_vd, arg_vj}},
+	// VPERMI.W vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73e40000, op: VPERMI_W, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VPICKVE2GR.B rd, vj, ui4
+	{mask: 0xffffc000, value: 0x72ef8000, op: VPICKVE2GR_B, args: instArgs{arg_rd, arg_vj, arg_ui4_13_10}},
+	// VPICKVE2GR.BU rd, vj, ui4
+	{mask: 0xffffc000, value: 0x72f38000, op: VPICKVE2GR_BU, args: instArgs{arg_rd, arg_vj, arg_ui4_13_10}},
+	// VPICKVE2GR.D rd, vj, ui1
+	{mask: 0xfffff800, value: 0x72eff000, op: VPICKVE2GR_D, args: instArgs{arg_rd, arg_vj, arg_ui1_10_10}},
+	// VPICKVE2GR.DU rd, vj, ui1
+	{mask: 0xfffff800, value: 0x72f3f000, op: VPICKVE2GR_DU, args: instArgs{arg_rd, arg_vj, arg_ui1_10_10}},
+	// VPICKVE2GR.H rd, vj, ui3
+	{mask: 0xffffe000, value: 0x72efc000, op: VPICKVE2GR_H, args: instArgs{arg_rd, arg_vj, arg_ui3_12_10}},
+	// VPICKVE2GR.HU rd, vj, ui3
+	{mask: 0xffffe000, value: 0x72f3c000, op: VPICKVE2GR_HU, args: instArgs{arg_rd, arg_vj, arg_ui3_12_10}},
+	// VPICKVE2GR.W rd, vj, ui2
+	{mask: 0xfffff000, value: 0x72efe000, op: VPICKVE2GR_W, args: instArgs{arg_rd, arg_vj, arg_ui2_11_10}},
+	// VPICKVE2GR.WU rd, vj, ui2
+	{mask: 0xfffff000, value: 0x72f3e000, op: VPICKVE2GR_WU, args: instArgs{arg_rd, arg_vj, arg_ui2_11_10}},
+	// VREPLGR2VR.B vd, rj
+	{mask: 0xfffffc00, value: 0x729f0000, op: VREPLGR2VR_B, args: instArgs{arg_vd, arg_rj}},
+	// VREPLGR2VR.D vd, rj
+	{mask: 0xfffffc00, value: 0x729f0c00, op: VREPLGR2VR_D, args: instArgs{arg_vd, arg_rj}},
+	// VREPLGR2VR.H vd, rj
+	{mask: 0xfffffc00, value: 0x729f0400, op: VREPLGR2VR_H, args: instArgs{arg_vd, arg_rj}},
+	// VREPLGR2VR.W vd, rj
+	{mask: 0xfffffc00, value: 0x729f0800, op: VREPLGR2VR_W, args: instArgs{arg_vd, arg_rj}},
+	// VREPLVEI.B vd, vj, ui4
+	{mask: 0xffffc000, value: 0x72f78000, op: VREPLVEI_B, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VREPLVEI.D vd, vj, ui1
+	{mask: 0xfffff800, value: 0x72f7f000, op: VREPLVEI_D, args: instArgs{arg_vd, arg_vj, arg_ui1_10_10}},
+	// VREPLVEI.H vd, vj, ui3
+	{mask: 0xffffe000, value: 0x72f7c000, op: VREPLVEI_H, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VREPLVEI.W vd, vj, ui2
+	{mask: 0xfffff000, value: 0x72f7e000, op: VREPLVEI_W, args: instArgs{arg_vd, arg_vj, arg_ui2_11_10}},
+	// VROTRI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x72a02000, op: VROTRI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VROTRI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x72a10000, op: VROTRI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VROTRI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x72a04000, op: VROTRI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VROTRI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x72a08000, op: VROTRI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VROTR.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ee0000, op: VROTR_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VROTR.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ef8000, op: VROTR_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VROTR.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ee8000, op: VROTR_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VROTR.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ef0000, op: VROTR_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70460000, op: VSADD_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704a0000, op: VSADD_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70478000, op: VSADD_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704b8000, op: VSADD_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70468000, op: VSADD_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704a8000, op: VSADD_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70470000, op: VSADD_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSADD.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704b0000, op: VSADD_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSEQI.B vd, vj, si5
+	{mask: 0xffff8000, value: 0x72800000, op: VSEQI_B, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSEQI.D vd, vj, si5
+	{mask: 0xffff8000, value: 0x72818000, op: VSEQI_D, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSEQI.H vd, vj, si5
+	{mask: 0xffff8000, value: 0x72808000, op: VSEQI_H, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSEQI.W vd, vj, si5
+	{mask: 0xffff8000, value: 0x72810000, op: VSEQI_W, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSEQ.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70000000, op: VSEQ_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSEQ.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70018000, op: VSEQ_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSEQ.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70008000, op: VSEQ_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSEQ.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70010000, op: VSEQ_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSETALLNEZ.B cd, vj
+	{mask: 0xfffffc18, value: 0x729cb000, op: VSETALLNEZ_B, args: instArgs{arg_cd, arg_vj}},
+	// VSETALLNEZ.D cd, vj
+	{mask: 0xfffffc18, value: 0x729cbc00, op: VSETALLNEZ_D, args: instArgs{arg_cd, arg_vj}},
+	// VSETALLNEZ.H cd, vj
+	{mask: 0xfffffc18, value: 0x729cb400, op: VSETALLNEZ_H, args: instArgs{arg_cd, arg_vj}},
+	// VSETALLNEZ.W cd, vj
+	{mask: 0xfffffc18, value: 0x729cb800, op: VSETALLNEZ_W, args: instArgs{arg_cd, arg_vj}},
+	// VSETANYEQZ.B cd, vj
+	{mask: 0xfffffc18, value: 0x729ca000, op: VSETANYEQZ_B, args: instArgs{arg_cd, arg_vj}},
+	// VSETANYEQZ.D cd, vj
+	{mask: 0xfffffc18, value: 0x729cac00, op: VSETANYEQZ_D, args: instArgs{arg_cd, arg_vj}},
+	// VSETANYEQZ.H cd, vj
+	{mask: 0xfffffc18, value: 0x729ca400, op: VSETANYEQZ_H, args: instArgs{arg_cd, arg_vj}},
+	// VSETANYEQZ.W cd, vj
+	{mask: 0xfffffc18, value: 0x729ca800, op: VSETANYEQZ_W, args: instArgs{arg_cd, arg_vj}},
+	// VSETEQZ.V cd, vj
+	{mask: 0xfffffc18, value: 0x729c9800, op: VSETEQZ_V, args: instArgs{arg_cd, arg_vj}},
+	// VSETNEZ.V cd, vj
+	{mask: 0xfffffc18, value: 0x729c9c00, op: VSETNEZ_V, args: instArgs{arg_cd, arg_vj}},
+	// VSHUF4I.B vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73900000, op: VSHUF4I_B, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VSHUF4I.D vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x739c0000, op: VSHUF4I_D, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VSHUF4I.H vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73940000, op: VSHUF4I_H, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VSHUF4I.W vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73980000, op: VSHUF4I_W, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VSHUF.B vd, vj, vk, va
+	{mask: 0xfff00000, value: 0x0d500000, op: VSHUF_B, args: instArgs{arg_vd, arg_vj, arg_vk, arg_va}},
+	// VSHUF.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x717b8000, op: VSHUF_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSHUF.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x717a8000, op: VSHUF_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSHUF.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x717b0000, op: VSHUF_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLLI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x732c2000, op: VSLLI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VSLLI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x732d0000, op: VSLLI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VSLLI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x732c4000, op: VSLLI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VSLLI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x732c8000, op: VSLLI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSLL.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e80000, op: VSLL_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLL.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e98000, op: VSLL_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLL.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e88000, op: VSLL_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLL.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70e90000, op: VSLL_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLTI.B vd, vj, si5
+	{mask: 0xffff8000, value: 0x72860000, op: VSLTI_B, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSLTI.BU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x72880000, op: VSLTI_BU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSLTI.D vd, vj, si5
+	{mask: 0xffff8000, value: 0x72878000, op: VSLTI_D, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSLTI.DU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x72898000, op: VSLTI_DU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSLTI.H vd, vj, si5
+	{mask: 0xffff8000, value: 0x72868000, op: VSLTI_H, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSLTI.HU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x72888000, op: VSLTI_HU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSLTI.W vd, vj, si5
+	{mask: 0xffff8000, value: 0x72870000, op: VSLTI_W, args: instArgs{arg_vd, arg_vj, arg_si5_14_10}},
+	// VSLTI.WU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x72890000, op: VSLTI_WU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSLT.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70060000, op: VSLT_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70080000, op: VSLT_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70078000, op: VSLT_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70098000, op: VSLT_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70068000, op: VSLT_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70088000, op: VSLT_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70070000, op: VSLT_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSLT.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70090000, op: VSLT_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRAI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x73342000, op: VSRAI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VSRAI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x73350000, op: VSRAI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VSRAI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x73344000, op: VSRAI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VSRAI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x73348000, op: VSRAI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSRA.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ec0000, op: VSRA_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRA.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ed8000, op: VSRA_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRA.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ec8000, op: VSRA_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRA.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ed0000, op: VSRA_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRLI.B vd, vj, ui3
+	{mask: 0xffffe000, value: 0x73302000, op: VSRLI_B, args: instArgs{arg_vd, arg_vj, arg_ui3_12_10}},
+	// VSRLI.D vd, vj, ui6
+	{mask: 0xffff0000, value: 0x73310000, op: VSRLI_D, args: instArgs{arg_vd, arg_vj, arg_ui6_15_10}},
+	// VSRLI.H vd, vj, ui4
+	{mask: 0xffffc000, value: 0x73304000, op: VSRLI_H, args: instArgs{arg_vd, arg_vj, arg_ui4_13_10}},
+	// VSRLI.W vd, vj, ui5
+	{mask: 0xffff8000, value: 0x73308000, op: VSRLI_W, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSRL.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ea0000, op: VSRL_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRL.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70eb8000, op: VSRL_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRL.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70ea8000, op: VSRL_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSRL.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70eb0000, op: VSRL_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70480000, op: VSSUB_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704c0000, op: VSSUB_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70498000, op: VSSUB_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704d8000, op: VSSUB_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70488000, op: VSSUB_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704c8000, op: VSSUB_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70490000, op: VSSUB_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSSUB.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x704d0000, op: VSSUB_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VST vd, rj, si12
+	{mask: 0xffc00000, value: 0x2c400000, op: VST, args: instArgs{arg_vd, arg_rj, arg_si12_21_10}},
+	// VSTX vd, rj, rk
+	{mask: 0xffff8000, value: 0x38440000, op: VSTX, args: instArgs{arg_vd, arg_rj, arg_rk}},
+	// VSUBI.BU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728c0000, op: VSUBI_BU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSUBI.DU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728d8000, op: VSUBI_DU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSUBI.HU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728c8000, op: VSUBI_HU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSUBI.WU vd, vj, ui5
+	{mask: 0xffff8000, value: 0x728d0000, op: VSUBI_WU, args: instArgs{arg_vd, arg_vj, arg_ui5_14_10}},
+	// VSUBWEV.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70210000, op: VSUBWEV_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70310000, op: VSUBWEV_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70200000, op: VSUBWEV_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70300000, op: VSUBWEV_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70218000, op: VSUBWEV_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70318000, op: VSUBWEV_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70208000, op: VSUBWEV_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWEV.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70308000, op: VSUBWEV_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.D.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x70250000, op: VSUBWOD_D_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.D.WU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70350000, op: VSUBWOD_D_WU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.H.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x70240000, op: VSUBWOD_H_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.H.BU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70340000, op: VSUBWOD_H_BU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.Q.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x70258000, op: VSUBWOD_Q_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.Q.DU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70358000, op: VSUBWOD_Q_DU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.W.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x70248000, op: VSUBWOD_W_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUBWOD.W.HU vd, vj, vk
+	{mask: 0xffff8000, value: 0x70348000, op: VSUBWOD_W_HU, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUB.B vd, vj, vk
+	{mask: 0xffff8000, value: 0x700c0000, op: VSUB_B, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUB.D vd, vj, vk
+	{mask: 0xffff8000, value: 0x700d8000, op: VSUB_D, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUB.H vd, vj, vk
+	{mask: 0xffff8000, value: 0x700c8000, op: VSUB_H, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUB.Q vd, vj, vk
+	{mask: 0xffff8000, value: 0x712d8000, op: VSUB_Q, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VSUB.W vd, vj, vk
+	{mask: 0xffff8000, value: 0x700d0000, op: VSUB_W, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// VXORI.B vd, vj, ui8
+	{mask: 0xfffc0000, value: 0x73d80000, op: VXORI_B, args: instArgs{arg_vd, arg_vj, arg_ui8_17_10}},
+	// VXOR.V vd, vj, vk
+	{mask: 0xffff8000, value: 0x71270000, op: VXOR_V, args: instArgs{arg_vd, arg_vj, arg_vk}},
+	// X86ADC.B rj, rk
+	{mask: 0xffff801f, value: 0x003f000c, op: X86ADC_B, args: instArgs{arg_rj, arg_rk}},
+	// X86ADC.D rj, rk
+	{mask: 0xffff801f, value: 0x003f000f, op: X86ADC_D, args: instArgs{arg_rj, arg_rk}},
+	// X86ADC.H rj, rk
+	{mask: 0xffff801f, value: 0x003f000d, op: X86ADC_H, args: instArgs{arg_rj, arg_rk}},
+	// X86ADC.W rj, rk
+	{mask: 0xffff801f, value: 0x003f000e, op: X86ADC_W, args: instArgs{arg_rj, arg_rk}},
+	// X86ADD.B rj, rk
+	{mask: 0xffff801f, value: 0x003f0004, op: X86ADD_B, args: instArgs{arg_rj, arg_rk}},
+	// X86ADD.D rj, rk
+	{mask: 0xffff801f, value: 0x003f0007, op: X86ADD_D, args: instArgs{arg_rj, arg_rk}},
+	// X86ADD.DU rj, rk
+	{mask: 0xffff801f, value: 0x003f0001, op: X86ADD_DU, args: instArgs{arg_rj, arg_rk}},
+	// X86ADD.H rj, rk
+	{mask: 0xffff801f, value: 0x003f0005, op: X86ADD_H, args: instArgs{arg_rj, arg_rk}},
+	// X86ADD.W rj, rk
+	{mask: 0xffff801f, value: 0x003f0006, op: X86ADD_W, args: instArgs{arg_rj, arg_rk}},
+	// X86ADD.WU rj, rk
+	{mask: 0xffff801f, value: 0x003f0000, op: X86ADD_WU, args: instArgs{arg_rj, arg_rk}},
+	// X86AND.B rj, rk
+	{mask: 0xffff801f, value: 0x003f8010, op: X86AND_B, args: instArgs{arg_rj, arg_rk}},
+	// X86AND.D rj, rk
+	{mask: 0xffff801f, value: 0x003f8013, op: X86AND_D, args: instArgs{arg_rj, arg_rk}},
+	// X86AND.H rj, rk
+	{mask: 0xffff801f, value: 0x003f8011, op: X86AND_H, args: instArgs{arg_rj, arg_rk}},
+	// X86AND.W rj, rk
+	{mask: 0xffff801f, value: 0x003f8012, op: X86AND_W, args: instArgs{arg_rj, arg_rk}},
+	// X86CLRTM
+	{mask: 0xffffffff, value: 0x00008028, op: X86CLRTM, args: instArgs{}},
+	// X86DECTOP
+	{mask: 0xffffffff, value: 0x00008029, op: X86DECTOP, args: instArgs{}},
+	// X86DEC.B rj
+	{mask: 0xfffffc1f, value: 0x00008004, op: X86DEC_B, args: instArgs{arg_rj}},
+	// X86DEC.D rj
+	{mask: 0xfffffc1f, value: 0x00008007, op: X86DEC_D, args: instArgs{arg_rj}},
+	// X86DEC.H rj
+	{mask: 0xfffffc1f, value: 0x00008005, op: X86DEC_H, args: instArgs{arg_rj}},
+	// X86DEC.W rj
+	{mask: 0xfffffc1f, value: 0x00008006, op: X86DEC_W, args: instArgs{arg_rj}},
+	// X86INCTOP
+	{mask: 0xffffffff, value: 0x00008009, op: X86INCTOP, args: instArgs{}},
+	// X86INC.B rj
+	{mask: 0xfffffc1f, value: 0x00008000, op: X86INC_B, args: instArgs{arg_rj}},
+	// X86INC.D rj
+	{mask: 0xfffffc1f, value: 0x00008003, op: X86INC_D, args: instArgs{arg_rj}},
+	// X86INC.H rj
+	{mask: 0xfffffc1f, value: 0x00008001, op: X86INC_H, args: instArgs{arg_rj}},
+	// X86INC.W rj
+	{mask: 0xfffffc1f, value: 0x00008002, op: X86INC_W, args: instArgs{arg_rj}},
+	// X86MFFLAG rd, ui8
+	{mask: 0xfffc03e0, value: 0x005c0000, op: X86MFFLAG, args: instArgs{arg_rd, arg_ui8_17_10}},
+	// X86MFTOP rd
+	{mask: 0xffffffe0, value: 0x00007400, op: X86MFTOP, args: instArgs{arg_rd}},
+	// X86MTFLAG rd, ui8
+	{mask: 0xfffc03e0, value: 0x005c0020, op: X86MTFLAG, args: instArgs{arg_rd, arg_ui8_17_10}},
+	// X86MTTOP ui3
+	{mask: 0xffffff1f, value: 0x00007000, op: X86MTTOP, args: instArgs{arg_ui3_7_5}},
+	// X86MUL.B rj, rk
+	{mask: 0xffff801f, value: 0x003e8000, op: X86MUL_B, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.BU rj, rk
+	{mask: 0xffff801f, value: 0x003e8004, op: X86MUL_BU, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.D rj, rk
+	{mask: 0xffff801f, value: 0x003e8003, op: X86MUL_D, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.DU rj, rk
+	{mask: 0xffff801f, value: 0x003e8007, op: X86MUL_DU, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.H rj, rk
+	{mask: 0xffff801f, value: 0x003e8001, op: X86MUL_H, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.HU rj, rk
+	{mask: 0xffff801f, value: 0x003e8005, op: X86MUL_HU, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.W rj, rk
+	{mask: 0xffff801f, value: 0x003e8002, op: X86MUL_W, args: instArgs{arg_rj, arg_rk}},
+	// X86MUL.WU rj, rk
+	{mask: 0xffff801f, value: 0x003e8006, op: X86MUL_WU, args: instArgs{arg_rj, arg_rk}},
+	// X86OR.B rj, rk
+	{mask: 0xffff801f, value: 0x003f8014, op: X86OR_B, args: instArgs{arg_rj, arg_rk}},
+	// X86OR.D rj, rk
+	{mask: 0xffff801f, value: 0x003f8017, op: X86OR_D, args: instArgs{arg_rj, arg_rk}},
+	// X86OR.H rj, rk
+	{mask: 0xffff801f, value: 0x003f8015, op: X86OR_H, args: instArgs{arg_rj, arg_rk}},
+	// X86OR.W rj, rk
+	{mask: 0xffff801f, value: 0x003f8016, op: X86OR_W, args: instArgs{arg_rj, arg_rk}},
+	// X86RCLI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x00542018, op: X86RCLI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86RCLI.D rj, ui6
+	{mask: 0xffff001f, value: 0x0055001b, op: X86RCLI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86RCLI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x00544019, op: X86RCLI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86RCLI.W rj, ui5
+	{mask: 0xffff801f, value: 0x0054801a, op: X86RCLI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86RCL.B rj, rk
+	{mask: 0xffff801f, value: 0x003f800c, op: X86RCL_B, args: instArgs{arg_rj, arg_rk}},
+	// X86RCL.D rj, rk
+	{mask: 0xffff801f, value: 0x003f800f, op: X86RCL_D, args: instArgs{arg_rj, arg_rk}},
+	// X86RCL.H rj, rk
+	{mask: 0xffff801f, value: 0x003f800d, op: X86RCL_H, args: instArgs{arg_rj, arg_rk}},
+	// X86RCL.W rj, rk
+	{mask: 0xffff801f, value: 0x003f800e, op: X86RCL_W, args: instArgs{arg_rj, arg_rk}},
+	// X86RCRI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x00542010, op: X86RCRI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86RCRI.D rj, ui6
+	{mask: 0xffff001f, value: 0x00550013, op: X86RCRI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86RCRI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x00544011, op: X86RCRI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86RCRI.W rj, ui5
+	{mask: 0xffff801f, value: 0x00548012, op: X86RCRI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86RCR.B rj, rk
+	{mask: 0xffff801f, value: 0x003f8008, op: X86RCR_B, args: instArgs{arg_rj, arg_rk}},
+	// X86RCR.D rj, rk
+	{mask: 0xffff801f, value: 0x003f800b, op: X86RCR_D, args: instArgs{arg_rj, arg_rk}},
+	// X86RCR.H rj, rk
+	{mask: 0xffff801f, value: 0x003f8009, op: X86RCR_H, args: instArgs{arg_rj, arg_rk}},
+	// X86RCR.W rj, rk
+	{mask: 0xffff801f, value: 0x003f800a, op: X86RCR_W, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTLI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x00542014, op: X86ROTLI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86ROTLI.D rj, ui6
+	{mask: 0xffff001f, value: 0x00550017, op: X86ROTLI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86ROTLI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x00544015, op: X86ROTLI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86ROTLI.W rj, ui5
+	{mask: 0xffff801f, value: 0x00548016, op: X86ROTLI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86ROTL.B rj, rk
+	{mask: 0xffff801f, value: 0x003f8004, op: X86ROTL_B, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTL.D rj, rk
+	{mask: 0xffff801f, value: 0x003f8007, op: X86ROTL_D, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTL.H rj, rk
+	{mask: 0xffff801f, value: 0x003f8005, op: X86ROTL_H, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTL.W rj, rk
+	{mask: 0xffff801f, value: 0x003f8006, op: X86ROTL_W, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTRI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x0054200c, op: X86ROTRI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86ROTRI.D rj, ui6
+	{mask: 0xffff001f, value: 0x0055000f, op: X86ROTRI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86ROTRI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x0054400d, op: X86ROTRI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86ROTRI.W rj, ui5
+	{mask: 0xffff801f, value: 0x0054800e, op: X86ROTRI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86ROTR.B rj, rk
+	{mask: 0xffff801f, value: 0x003f8000, op: X86ROTR_B, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTR.D rj, rk
+	{mask: 0xffff801f, value: 0x003f8002, op: X86ROTR_D, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTR.H rj, rk
+	{mask: 0xffff801f, value: 0x003f8001, op: X86ROTR_H, args: instArgs{arg_rj, arg_rk}},
+	// X86ROTR.W rj, rk
+	{mask: 0xffff801f, value: 0x003f8003, op: X86ROTR_W, args: instArgs{arg_rj, arg_rk}},
+	// X86SBC.B rj, rk
+	{mask: 0xffff801f, value: 0x003f0010, op: X86SBC_B, args: instArgs{arg_rj, arg_rk}},
+	// X86SBC.D rj, rk
+	{mask: 0xffff801f, value: 0x003f0013, op: X86SBC_D, args: instArgs{arg_rj, arg_rk}},
+	// X86SBC.H rj, rk
+	{mask: 0xffff801f, value: 0x003f0011, op: X86SBC_H, args: instArgs{arg_rj, arg_rk}},
+	// X86SBC.W rj, rk
+	{mask: 0xffff801f, value: 0x003f0012, op: X86SBC_W, args: instArgs{arg_rj, arg_rk}},
+	// X86SETTAG rd, ui5, ui8
+	{mask: 0xfffc0000, value: 0x00580000, op: X86SETTAG, args: instArgs{arg_rd, arg_ui5_9_5, arg_ui8_17_10}},
+	// X86SETTM
+	{mask: 0xffffffff, value: 0x00008008, op: X86SETTM, args: instArgs{}},
+	// X86SLLI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x00542000, op: X86SLLI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86SLLI.D rj, ui6
+	{mask: 0xffff001f, value: 0x00550003, op: X86SLLI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86SLLI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x00544001, op: X86SLLI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86SLLI.W rj, ui5
+	{mask: 0xffff801f, value: 0x00548002, op: X86SLLI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86SLL.B rj, rk
+	{mask: 0xffff801f, value: 0x003f0014, op: X86SLL_B, args: instArgs{arg_rj, arg_rk}},
+	// X86SLL.D rj, rk
+	{mask: 0xffff801f, value: 0x003f0017, op: X86SLL_D, args: instArgs{arg_rj, arg_rk}},
+	// X86SLL.H rj, rk
+	{mask: 0xffff801f, value: 0x003f0015, op: X86SLL_H, args: instArgs{arg_rj, arg_rk}},
+	// X86SLL.W rj, rk
+	{mask: 0xffff801f, value: 0x003f0016, op: X86SLL_W, args: instArgs{arg_rj, arg_rk}},
+	// X86SRAI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x00542008, op: X86SRAI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86SRAI.D rj, ui6
+	{mask: 0xffff001f, value: 0x0055000b, op: X86SRAI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86SRAI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x00544009, op: X86SRAI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86SRAI.W rj, ui5
+	{mask: 0xffff801f, value: 0x0054800a, op: X86SRAI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86SRA.B rj, rk
+	{mask: 0xffff801f, value: 0x003f001c, op: X86SRA_B, args: instArgs{arg_rj, arg_rk}},
+	// X86SRA.D rj, rk
+	{mask: 0xffff801f, value: 0x003f001f, op: X86SRA_D, args: instArgs{arg_rj, arg_rk}},
+	// X86SRA.H rj, rk
+	{mask: 0xffff801f, value: 0x003f001d, op: X86SRA_H, args: instArgs{arg_rj, arg_rk}},
+	// X86SRA.W rj, rk
+	{mask: 0xffff801f, value: 0x003f001e, op: X86SRA_W, args: instArgs{arg_rj, arg_rk}},
+	// X86SRLI.B rj, ui3
+	{mask: 0xffffe01f, value: 0x00542004, op: X86SRLI_B, args: instArgs{arg_rj, arg_ui3_12_10}},
+	// X86SRLI.D rj, ui6
+	{mask: 0xffff001f, value: 0x00550007, op: X86SRLI_D, args: instArgs{arg_rj, arg_ui6_15_10}},
+	// X86SRLI.H rj, ui4
+	{mask: 0xffffc01f, value: 0x00544005, op: X86SRLI_H, args: instArgs{arg_rj, arg_ui4_13_10}},
+	// X86SRLI.W rj, ui5
+	{mask: 0xffff801f, value: 0x00548006, op: X86SRLI_W, args: instArgs{arg_rj, arg_ui5_14_10}},
+	// X86SRL.B rj, rk
+	{mask: 0xffff801f, value: 0x003f0018, op: X86SRL_B, args: instArgs{arg_rj, arg_rk}},
+	// X86SRL.D rj, rk
+	{mask: 0xffff801f, value: 0x003f001b, op: X86SRL_D, args: instArgs{arg_rj, arg_rk}},
+	// X86SRL.H rj, rk
+	{mask: 0xffff801f, value: 0x003f0019, op: X86SRL_H, args: instArgs{arg_rj, arg_rk}},
+	// X86SRL.W rj, rk
+	{mask: 0xffff801f, value: 0x003f001a, op: X86SRL_W, args: instArgs{arg_rj, arg_rk}},
+	// X86SUB.B rj, rk
+	{mask: 0xffff801f, value: 0x003f0008, op: X86SUB_B, args: instArgs{arg_rj, arg_rk}},
+	// X86SUB.D rj, rk
+	{mask: 0xffff801f, value: 0x003f000b, op: X86SUB_D, args: instArgs{arg_rj, arg_rk}},
+	// X86SUB.DU rj, rk
+	{mask: 0xffff801f, value: 0x003f0003, op: X86SUB_DU, args: instArgs{arg_rj, arg_rk}},
+	// X86SUB.H rj, rk
+	{mask: 0xffff801f, value: 0x003f0009, op: X86SUB_H, args: instArgs{arg_rj, arg_rk}},
+	// X86SUB.W rj, rk
+	{mask: 0xffff801f, value: 0x003f000a, op: X86SUB_W, args: instArgs{arg_rj, arg_rk}},
+	// X86SUB.WU rj, rk
+	{mask: 0xffff801f, value: 0x003f0002, op: X86SUB_WU, args: instArgs{arg_rj, arg_rk}},
+	// X86XOR.B rj, rk
+	{mask: 0xffff801f, value: 0x003f8018, op: X86XOR_B, args: instArgs{arg_rj, arg_rk}},
+	// X86XOR.D rj, rk
+	{mask: 0xffff801f, value: 0x003f801b, op: X86XOR_D, args: instArgs{arg_rj, arg_rk}},
+	// X86XOR.H rj, rk
+	{mask: 0xffff801f, value: 0x003f8019, op: X86XOR_H, args: instArgs{arg_rj, arg_rk}},
+	// X86XOR.W rj, rk
+	{mask: 0xffff801f, value: 0x003f801a, op: X86XOR_W, args: instArgs{arg_rj, arg_rk}},
 	// XOR rd, rj, rk
 	{mask: 0xffff8000, value: 0x00158000, op: XOR, args: instArgs{arg_rd, arg_rj, arg_rk}},
 	// XORI rd, rj, ui12
 	{mask: 0xffc00000, value: 0x03c00000, op: XORI, args: instArgs{arg_rd, arg_rj, arg_ui12_21_10}},
+	// XVADDI.BU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768a0000, op: XVADDI_BU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVADDI.DU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768b8000, op: XVADDI_DU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVADDI.HU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768a8000, op: XVADDI_HU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVADDI.WU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768b0000, op: XVADDI_WU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVADDWEV.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x741f0000, op: XVADDWEV_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x742f0000, op: XVADDWEV_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x741e0000, op: XVADDWEV_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x742e0000, op: XVADDWEV_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x741f8000, op: XVADDWEV_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x742f8000, op: XVADDWEV_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x741e8000, op: XVADDWEV_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWEV.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x742e8000, op: XVADDWEV_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74230000, op: XVADDWOD_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74330000, op: XVADDWOD_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74220000, op: XVADDWOD_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74320000, op: XVADDWOD_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74238000, op: XVADDWOD_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74338000, op: XVADDWOD_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74228000, op: XVADDWOD_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADDWOD.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74328000, op: XVADDWOD_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADD.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x740a0000, op: XVADD_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADD.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x740b8000, op: XVADD_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADD.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x740a8000, op: XVADD_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADD.Q xd, xj, xk
+	{mask: 0xffff8000, value: 0x752d0000, op: XVADD_Q, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVADD.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x740b0000, op: XVADD_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVANDI.B xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77d00000, op: XVANDI_B, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVANDN.V xd, xj, xk
+	{mask: 0xffff8000, value: 0x75280000, op: XVANDN_V, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVAND.V xd, xj, xk
+	{mask: 0xffff8000, value: 0x75260000, op: XVAND_V, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITCLRI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x77102000, op: XVBITCLRI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVBITCLRI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x77110000, op: XVBITCLRI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVBITCLRI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x77104000, op: XVBITCLRI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVBITCLRI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x77108000, op: XVBITCLRI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVBITCLR.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x750c0000, op: XVBITCLR_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITCLR.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x750d8000, op: XVBITCLR_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITCLR.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x750c8000, op: XVBITCLR_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITCLR.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x750d0000, op: XVBITCLR_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITREVI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x77182000, op: XVBITREVI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVBITREVI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x77190000, op: XVBITREVI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVBITREVI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x77184000, op: XVBITREVI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVBITREVI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x77188000, op: XVBITREVI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVBITREV.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x75100000, op: XVBITREV_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITREV.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x75118000, op: XVBITREV_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITREV.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x75108000, op: XVBITREV_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITREV.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x75110000, op: XVBITREV_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITSETI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x77142000, op: XVBITSETI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVBITSETI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x77150000, op: XVBITSETI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVBITSETI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x77144000, op: XVBITSETI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVBITSETI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x77148000, op: XVBITSETI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVBITSET.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x750e0000, op: XVBITSET_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITSET.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x750f8000, op: XVBITSET_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITSET.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x750e8000, op: XVBITSET_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVBITSET.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x750f0000, op: XVBITSET_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e00000, op: XVDIV_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e40000, op: XVDIV_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e18000, op: XVDIV_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e58000, op: XVDIV_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e08000, op: XVDIV_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e48000, op: XVDIV_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e10000, op: XVDIV_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVDIV.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e50000, op: XVDIV_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVEXTRINS.B xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x778c0000, op: XVEXTRINS_B, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVEXTRINS.D xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77800000, op: XVEXTRINS_D, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVEXTRINS.H xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77880000, op: XVEXTRINS_H, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVEXTRINS.W xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77840000, op: XVEXTRINS_W, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVFADD.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x75310000, op: XVFADD_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFADD.S xd, xj, xk
+	{mask: 0xffff8000, value: 0x75308000, op: XVFADD_S, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFCLASS.D xd, xj
+	{mask: 0xfffffc00, value: 0x769cd800, op: XVFCLASS_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFCLASS.S xd, xj
+	{mask: 0xfffffc00, value: 0x769cd400, op: XVFCLASS_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFDIV.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x753b0000, op: XVFDIV_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFDIV.S xd, xj, xk
+	{mask: 0xffff8000, value: 0x753a8000, op: XVFDIV_S, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFMUL.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x75390000, op: XVFMUL_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFMUL.S xd, xj, xk
+	{mask: 0xffff8000, value: 0x75388000, op: XVFMUL_S, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFRECIP.D xd, xj
+	{mask: 0xfffffc00, value: 0x769cf800, op: XVFRECIP_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRECIP.S xd, xj
+	{mask: 0xfffffc00, value: 0x769cf400, op: XVFRECIP_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRM.D xd, xj
+	{mask: 0xfffffc00, value: 0x769d4800, op: XVFRINTRM_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRM.S xd, xj
+	{mask: 0xfffffc00, value: 0x769d4400, op: XVFRINTRM_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRNE.D xd, xj
+	{mask: 0xfffffc00, value: 0x769d7800, op: XVFRINTRNE_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRNE.S xd, xj
+	{mask: 0xfffffc00, value: 0x769d7400, op: XVFRINTRNE_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRP.D xd, xj
+	{mask: 0xfffffc00, value: 0x769d5800, op: XVFRINTRP_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRP.S xd, xj
+	{mask: 0xfffffc00, value: 0x769d5400, op: XVFRINTRP_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRZ.D xd, xj
+	{mask: 0xfffffc00, value: 0x769d6800, op: XVFRINTRZ_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINTRZ.S xd, xj
+	{mask: 0xfffffc00, value: 0x769d6400, op: XVFRINTRZ_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINT.D xd, xj
+	{mask: 0xfffffc00, value: 0x769d3800, op: XVFRINT_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRINT.S xd, xj
+	{mask: 0xfffffc00, value: 0x769d3400, op: XVFRINT_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFRSQRT.D xd, xj
+	{mask: 0xfffffc00, value: 0x769d0800, op: XVFRSQRT_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFRSQRT.S xd, xj
+	{mask: 0xfffffc00, value: 0x769d0400, op: XVFRSQRT_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFSQRT.D xd, xj
+	{mask: 0xfffffc00, value: 0x769ce800, op: XVFSQRT_D, args: instArgs{arg_xd, arg_xj}},
+	// XVFSQRT.S xd, xj
+	{mask: 0xfffffc00, value: 0x769ce400, op: XVFSQRT_S, args: instArgs{arg_xd, arg_xj}},
+	// XVFSUB.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x75330000, op: XVFSUB_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVFSUB.S xd, xj, xk
+	{mask: 0xffff8000, value: 0x75328000, op: XVFSUB_S, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVH.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x751c0000, op: XVILVH_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVH.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x751d8000, op: XVILVH_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVH.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x751c8000, op: XVILVH_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVH.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x751d0000, op: XVILVH_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVL.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x751a0000, op: XVILVL_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVL.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x751b8000, op: XVILVL_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVL.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x751a8000, op: XVILVL_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVILVL.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x751b0000, op: XVILVL_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVINSGR2VR.D xd, rj, ui2
+	{mask: 0xfffff000, value: 0x76ebe000, op: XVINSGR2VR_D, args: instArgs{arg_xd, arg_rj, arg_ui2_11_10}},
+	// XVINSGR2VR.W xd, rj, ui3
+	{mask: 0xffffe000, value: 0x76ebc000, op: XVINSGR2VR_W, args: instArgs{arg_xd, arg_rj, arg_ui3_12_10}},
+	// XVINSVE0.D xd, xj, ui2
+	{mask: 0xfffff000, value: 0x76ffe000, op: XVINSVE0_D, args: instArgs{arg_xd, arg_xj, arg_ui2_11_10}},
+	// XVINSVE0.W xd, xj, ui3
+	{mask: 0xffffe000, value: 0x76ffc000, op: XVINSVE0_W, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVLD xd, rj, si12
+	{mask: 0xffc00000, value: 0x2c800000, op: XVLD, args: instArgs{arg_xd, arg_rj, arg_si12_21_10}},
+	// XVLDREPL.B xd, rj, si12
+	{mask: 0xffc00000, value: 0x32800000, op: XVLDREPL_B, args: instArgs{arg_xd, arg_rj, arg_si12_21_10}},
+	// XVLDREPL.D xd, rj, si9
+	{mask: 0xfff80000, value: 0x32100000, op: XVLDREPL_D, args: instArgs{arg_xd, arg_rj, arg_si9_18_10}},
+	// XVLDREPL.H xd, rj, si11
+	{mask: 0xffe00000, value: 0x32400000, op: XVLDREPL_H, args: instArgs{arg_xd, arg_rj, arg_si11_20_10}},
+	// XVLDREPL.W xd, rj, si10
+	{mask: 0xfff00000, value: 0x32200000, op: XVLDREPL_W, args: instArgs{arg_xd, arg_rj, arg_si10_19_10}},
+	// XVLDX xd, rj, rk
+	{mask: 0xffff8000, value: 0x38480000, op: XVLDX, args: instArgs{arg_xd, arg_rj, arg_rk}},
+	// XVMADDWEV.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ad0000, op: XVMADDWEV_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b50000, op: XVMADDWEV_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.D.WU.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74bd0000, op: XVMADDWEV_D_WU_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ac0000, op: XVMADDWEV_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b40000, op: XVMADDWEV_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.H.BU.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74bc0000, op: XVMADDWEV_H_BU_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ad8000, op: XVMADDWEV_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b58000, op: XVMADDWEV_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.Q.DU.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74bd8000, op: XVMADDWEV_Q_DU_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ac8000, op: XVMADDWEV_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b48000, op: XVMADDWEV_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWEV.W.HU.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74bc8000, op: XVMADDWEV_W_HU_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74af0000, op: XVMADDWOD_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b70000, op: XVMADDWOD_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.D.WU.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74bf0000, op: XVMADDWOD_D_WU_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ae0000, op: XVMADDWOD_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b60000, op: XVMADDWOD_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.H.BU.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74be0000, op: XVMADDWOD_H_BU_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74af8000, op: XVMADDWOD_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b78000, op: XVMADDWOD_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.Q.DU.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74bf8000, op: XVMADDWOD_Q_DU_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ae8000, op: XVMADDWOD_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74b68000, op: XVMADDWOD_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADDWOD.W.HU.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74be8000, op: XVMADDWOD_W_HU_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADD.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a80000, op: XVMADD_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADD.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a98000, op: XVMADD_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADD.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a88000, op: XVMADD_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMADD.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a90000, op: XVMADD_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e20000, op: XVMOD_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e60000, op: XVMOD_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e38000, op: XVMOD_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e78000, op: XVMOD_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e28000, op: XVMOD_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e68000, op: XVMOD_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e30000, op: XVMOD_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMOD.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e70000, op: XVMOD_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMSUB.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74aa0000, op: XVMSUB_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMSUB.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ab8000, op: XVMSUB_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMSUB.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74aa8000, op: XVMSUB_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMSUB.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ab0000, op: XVMSUB_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74860000, op: XVMUH_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74880000, op: XVMUH_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74878000, op: XVMUH_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74898000, op: XVMUH_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74868000, op: XVMUH_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74888000, op: XVMUH_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74870000, op: XVMUH_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUH.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74890000, op: XVMUH_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74910000, op: XVMULWEV_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74990000, op: XVMULWEV_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.D.WU.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a10000, op: XVMULWEV_D_WU_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74900000, op: XVMULWEV_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74980000, op: XVMULWEV_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.H.BU.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a00000, op: XVMULWEV_H_BU_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74918000, op: XVMULWEV_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74998000, op: XVMULWEV_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.Q.DU.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a18000, op: XVMULWEV_Q_DU_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74908000, op: XVMULWEV_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74988000, op: XVMULWEV_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWEV.W.HU.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a08000, op: XVMULWEV_W_HU_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74930000, op: XVMULWOD_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x749b0000, op: XVMULWOD_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.D.WU.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a30000, op: XVMULWOD_D_WU_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74920000, op: XVMULWOD_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x749a0000, op: XVMULWOD_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.H.BU.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a20000, op: XVMULWOD_H_BU_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74938000, op: XVMULWOD_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x749b8000, op: XVMULWOD_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.Q.DU.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a38000, op: XVMULWOD_Q_DU_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74928000, op: XVMULWOD_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x749a8000, op: XVMULWOD_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMULWOD.W.HU.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74a28000, op: XVMULWOD_W_HU_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUL.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74840000, op: XVMUL_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUL.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74858000, op: XVMUL_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUL.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74848000, op: XVMUL_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVMUL.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74850000, op: XVMUL_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVNEG.B xd, xj
+	{mask: 0xfffffc00, value: 0x769c3000, op: XVNEG_B, args: instArgs{arg_xd, arg_xj}},
+	// XVNEG.D xd, xj
+	{mask: 0xfffffc00, value: 0x769c3c00, op: XVNEG_D, args: instArgs{arg_xd, arg_xj}},
+	// XVNEG.H xd, xj
+	{mask: 0xfffffc00, value: 0x769c3400, op: XVNEG_H, args: instArgs{arg_xd, arg_xj}},
+	// XVNEG.W xd, xj
+	{mask: 0xfffffc00, value: 0x769c3800, op: XVNEG_W, args: instArgs{arg_xd, arg_xj}},
+	// XVNORI.B xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77dc0000, op: XVNORI_B, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVNOR.V xd, xj, xk
+	{mask: 0xffff8000, value: 0x75278000, op: XVNOR_V, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVORI.B xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77d40000, op: XVORI_B, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVORN.V xd, xj, xk
+	{mask: 0xffff8000, value: 0x75288000, op: XVORN_V, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVOR.V xd, xj, xk
+	{mask: 0xffff8000, value: 0x75268000, op: XVOR_V, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVPCNT.B xd, xj
+	{mask: 0xfffffc00, value: 0x769c2000, op: XVPCNT_B, args: instArgs{arg_xd, arg_xj}},
+	// XVPCNT.D xd, xj
+	{mask: 0xfffffc00, value: 0x769c2c00, op: XVPCNT_D, args: instArgs{arg_xd, arg_xj}},
+	// XVPCNT.H xd, xj
+	{mask: 0xfffffc00, value: 0x769c2400, op: XVPCNT_H, args: instArgs{arg_xd, arg_xj}},
+	// XVPCNT.W xd, xj
+	{mask: 0xfffffc00, value: 0x769c2800, op: XVPCNT_W, args: instArgs{arg_xd, arg_xj}},
+	// XVPERMI.D xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77e80000, op: XVPERMI_D, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVPERMI.Q xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77ec0000, op: XVPERMI_Q, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVPERMI.W xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77e40000, op: XVPERMI_W, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVPICKVE2GR.D rd, xj, ui2
+	{mask: 0xfffff000, value: 0x76efe000, op: XVPICKVE2GR_D, args: instArgs{arg_rd, arg_xj, arg_ui2_11_10}},
+	// XVPICKVE2GR.DU rd, xj, ui2
+	{mask: 0xfffff000, value: 0x76f3e000, op: XVPICKVE2GR_DU, args: instArgs{arg_rd, arg_xj, arg_ui2_11_10}},
+	// XVPICKVE2GR.W rd, xj, ui3
+	{mask: 0xffffe000, value: 0x76efc000, op: XVPICKVE2GR_W, args: instArgs{arg_rd, arg_xj, arg_ui3_12_10}},
+	// XVPICKVE2GR.WU rd, xj, ui3
+	{mask: 0xffffe000, value: 0x76f3c000, op: XVPICKVE2GR_WU, args: instArgs{arg_rd, arg_xj, arg_ui3_12_10}},
+	// XVPICKVE.D xd, xj, ui2
+	{mask: 0xfffff000, value: 0x7703e000, op: XVPICKVE_D, args: instArgs{arg_xd, arg_xj, arg_ui2_11_10}},
+	// XVPICKVE.W xd, xj, ui3
+	{mask: 0xffffe000, value: 0x7703c000, op: XVPICKVE_W, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVREPLGR2VR.B xd, rj
+	{mask: 0xfffffc00, value: 0x769f0000, op: XVREPLGR2VR_B, args: instArgs{arg_xd, arg_rj}},
+	// XVREPLGR2VR.D xd, rj
+	{mask: 0xfffffc00, value: 0x769f0c00, op: XVREPLGR2VR_D, args: instArgs{arg_xd, arg_rj}},
+	// XVREPLGR2VR.H xd, rj
+	{mask: 0xfffffc00, value: 0x769f0400, op: XVREPLGR2VR_H, args: instArgs{arg_xd, arg_rj}},
+	// XVREPLGR2VR.W xd, rj
+	{mask: 0xfffffc00, value: 0x769f0800, op: XVREPLGR2VR_W, args: instArgs{arg_xd, arg_rj}},
+	// XVREPLVE0.B xd, xj
+	{mask: 0xfffffc00, value: 0x77070000, op: XVREPLVE0_B, args: instArgs{arg_xd, arg_xj}},
+	// XVREPLVE0.D xd, xj
+	{mask: 0xfffffc00, value: 0x7707e000, op: XVREPLVE0_D, args: instArgs{arg_xd, arg_xj}},
+	// XVREPLVE0.H xd, xj
+	{mask: 0xfffffc00, value: 0x77078000, op: XVREPLVE0_H, args: instArgs{arg_xd, arg_xj}},
+	// XVREPLVE0.Q xd, xj
+	{mask: 0xfffffc00, value: 0x7707f000, op: XVREPLVE0_Q, args: instArgs{arg_xd, arg_xj}},
+	// XVREPLVE0.W xd, xj
+	{mask: 0xfffffc00, value: 0x7707c000, op: XVREPLVE0_W, args: instArgs{arg_xd, arg_xj}},
+	// XVROTRI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x76a02000, op: XVROTRI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVROTRI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x76a10000, op: XVROTRI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVROTRI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x76a04000, op: XVROTRI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVROTRI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x76a08000, op: XVROTRI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVROTR.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ee0000, op: XVROTR_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVROTR.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ef8000, op: XVROTR_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVROTR.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ee8000, op: XVROTR_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVROTR.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ef0000, op: XVROTR_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74460000, op: XVSADD_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744a0000, op: XVSADD_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74478000, op: XVSADD_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744b8000, op: XVSADD_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74468000, op: XVSADD_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744a8000, op: XVSADD_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74470000, op: XVSADD_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSADD.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744b0000, op: XVSADD_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSEQI.B xd, xj, si5
+	{mask: 0xffff8000, value: 0x76800000, op: XVSEQI_B, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSEQI.D xd, xj, si5
+	{mask: 0xffff8000, value: 0x76818000, op: XVSEQI_D, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSEQI.H xd, xj, si5
+	{mask: 0xffff8000, value: 0x76808000, op: XVSEQI_H, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSEQI.W xd, xj, si5
+	{mask: 0xffff8000, value: 0x76810000, op: XVSEQI_W, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSEQ.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74000000, op: XVSEQ_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSEQ.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74018000, op: XVSEQ_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSEQ.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74008000, op: XVSEQ_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSEQ.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74010000, op: XVSEQ_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSETALLNEZ.B cd, xj
+	{mask: 0xfffffc18, value: 0x769cb000, op: XVSETALLNEZ_B, args: instArgs{arg_cd, arg_xj}},
+	// XVSETALLNEZ.D cd, xj
+	{mask: 0xfffffc18, value: 0x769cbc00, op: XVSETALLNEZ_D, args: instArgs{arg_cd, arg_xj}},
+	// XVSETALLNEZ.H cd, xj
+	{mask: 0xfffffc18, value: 0x769cb400, op: XVSETALLNEZ_H, args: instArgs{arg_cd, arg_xj}},
+	// XVSETALLNEZ.W cd, xj
+	{mask: 0xfffffc18, value: 0x769cb800, op: XVSETALLNEZ_W, args: instArgs{arg_cd, arg_xj}},
+	// XVSETANYEQZ.B cd, xj
+	{mask: 0xfffffc18, value: 0x769ca000, op: XVSETANYEQZ_B, args: instArgs{arg_cd, arg_xj}},
+	// XVSETANYEQZ.D cd, xj
+	{mask: 0xfffffc18, value: 0x769cac00, op: XVSETANYEQZ_D, args: instArgs{arg_cd, arg_xj}},
+	// XVSETANYEQZ.H cd, xj
+	{mask: 0xfffffc18, value: 0x769ca400, op: XVSETANYEQZ_H, args: instArgs{arg_cd, arg_xj}},
+	// XVSETANYEQZ.W cd, xj
+	{mask: 0xfffffc18, value: 0x769ca800, op: XVSETANYEQZ_W, args: instArgs{arg_cd, arg_xj}},
+	// XVSETEQZ.V cd, xj
+	{mask: 0xfffffc18, value: 0x769c9800, op: XVSETEQZ_V, args: instArgs{arg_cd, arg_xj}},
+	// XVSETNEZ.V cd, xj
+	{mask: 0xfffffc18, value: 0x769c9c00, op: XVSETNEZ_V, args: instArgs{arg_cd, arg_xj}},
+	// XVSHUF4I.B xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77900000, op: XVSHUF4I_B, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVSHUF4I.D xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x779c0000, op: XVSHUF4I_D, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVSHUF4I.H xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77940000, op: XVSHUF4I_H, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVSHUF4I.W xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77980000, op: XVSHUF4I_W, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVSHUF.B xd, xj, xk, xa
+	{mask: 0xfff00000, value: 0x0d600000, op: XVSHUF_B, args: instArgs{arg_xd, arg_xj, arg_xk, arg_xa}},
+	// XVSHUF.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x757b8000, op: XVSHUF_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSHUF.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x757a8000, op: XVSHUF_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSHUF.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x757b0000, op: XVSHUF_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLLI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x772c2000, op: XVSLLI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVSLLI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x772d0000, op: XVSLLI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVSLLI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x772c4000, op: XVSLLI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVSLLI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x772c8000, op: XVSLLI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSLL.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e80000, op: XVSLL_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLL.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e98000, op: XVSLL_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLL.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e88000, op: XVSLL_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLL.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74e90000, op: XVSLL_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLTI.B xd, xj, si5
+	{mask: 0xffff8000, value: 0x76860000, op: XVSLTI_B, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSLTI.BU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x76880000, op: XVSLTI_BU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSLTI.D xd, xj, si5
+	{mask: 0xffff8000, value: 0x76878000, op: XVSLTI_D, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSLTI.DU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x76898000, op: XVSLTI_DU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSLTI.H xd, xj, si5
+	{mask: 0xffff8000, value: 0x76868000, op: XVSLTI_H, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSLTI.HU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x76888000, op: XVSLTI_HU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSLTI.W xd, xj, si5
+	{mask: 0xffff8000, value: 0x76870000, op: XVSLTI_W, args: instArgs{arg_xd, arg_xj, arg_si5_14_10}},
+	// XVSLTI.WU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x76890000, op: XVSLTI_WU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSLT.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74060000, op: XVSLT_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74080000, op: XVSLT_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74078000, op: XVSLT_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74098000, op: XVSLT_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74068000, op: XVSLT_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74088000, op: XVSLT_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74070000, op: XVSLT_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSLT.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74090000, op: XVSLT_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRAI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x77342000, op: XVSRAI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVSRAI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x77350000, op: XVSRAI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVSRAI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x77344000, op: XVSRAI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVSRAI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x77348000, op: XVSRAI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSRA.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ec0000, op: XVSRA_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRA.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ed8000, op: XVSRA_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRA.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ec8000, op: XVSRA_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRA.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ed0000, op: XVSRA_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRLI.B xd, xj, ui3
+	{mask: 0xffffe000, value: 0x77302000, op: XVSRLI_B, args: instArgs{arg_xd, arg_xj, arg_ui3_12_10}},
+	// XVSRLI.D xd, xj, ui6
+	{mask: 0xffff0000, value: 0x77310000, op: XVSRLI_D, args: instArgs{arg_xd, arg_xj, arg_ui6_15_10}},
+	// XVSRLI.H xd, xj, ui4
+	{mask: 0xffffc000, value: 0x77304000, op: XVSRLI_H, args: instArgs{arg_xd, arg_xj, arg_ui4_13_10}},
+	// XVSRLI.W xd, xj, ui5
+	{mask: 0xffff8000, value: 0x77308000, op: XVSRLI_W, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSRL.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ea0000, op: XVSRL_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRL.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74eb8000, op: XVSRL_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRL.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74ea8000, op: XVSRL_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSRL.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74eb0000, op: XVSRL_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74480000, op: XVSSUB_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744c0000, op: XVSSUB_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74498000, op: XVSSUB_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744d8000, op: XVSSUB_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74488000, op: XVSSUB_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744c8000, op: XVSSUB_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74490000, op: XVSSUB_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSSUB.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x744d0000, op: XVSSUB_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVST xd, rj, si12
+	{mask: 0xffc00000, value: 0x2cc00000, op: XVST, args: instArgs{arg_xd, arg_rj, arg_si12_21_10}},
+	// XVSTX xd, rj, rk
+	{mask: 0xffff8000, value: 0x384c0000, op: XVSTX, args: instArgs{arg_xd, arg_rj, arg_rk}},
+	// XVSUBI.BU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768c0000, op: XVSUBI_BU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSUBI.DU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768d8000, op: XVSUBI_DU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSUBI.HU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768c8000, op: XVSUBI_HU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSUBI.WU xd, xj, ui5
+	{mask: 0xffff8000, value: 0x768d0000, op: XVSUBI_WU, args: instArgs{arg_xd, arg_xj, arg_ui5_14_10}},
+	// XVSUBWEV.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74210000, op: XVSUBWEV_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74310000, op: XVSUBWEV_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74200000, op: XVSUBWEV_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74300000, op: XVSUBWEV_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74218000, op: XVSUBWEV_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74318000, op: XVSUBWEV_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74208000, op: XVSUBWEV_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWEV.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74308000, op: XVSUBWEV_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.D.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x74250000, op: XVSUBWOD_D_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.D.WU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74350000, op: XVSUBWOD_D_WU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.H.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x74240000, op: XVSUBWOD_H_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.H.BU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74340000, op: XVSUBWOD_H_BU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.Q.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x74258000, op: XVSUBWOD_Q_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.Q.DU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74358000, op: XVSUBWOD_Q_DU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.W.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x74248000, op: XVSUBWOD_W_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUBWOD.W.HU xd, xj, xk
+	{mask: 0xffff8000, value: 0x74348000, op: XVSUBWOD_W_HU, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUB.B xd, xj, xk
+	{mask: 0xffff8000, value: 0x740c0000, op: XVSUB_B, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUB.D xd, xj, xk
+	{mask: 0xffff8000, value: 0x740d8000, op: XVSUB_D, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUB.H xd, xj, xk
+	{mask: 0xffff8000, value: 0x740c8000, op: XVSUB_H, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUB.Q xd, xj, xk
+	{mask: 0xffff8000, value: 0x752d8000, op: XVSUB_Q, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVSUB.W xd, xj, xk
+	{mask: 0xffff8000, value: 0x740d0000, op: XVSUB_W, args: instArgs{arg_xd, arg_xj, arg_xk}},
+	// XVXORI.B xd, xj, ui8
+	{mask: 0xfffc0000, value: 0x77d80000, op: XVXORI_B, args: instArgs{arg_xd, arg_xj, arg_ui8_17_10}},
+	// XVXOR.V xd, xj, xk
+	{mask: 0xffff8000, value: 0x75270000, op: XVXOR_V, args: instArgs{arg_xd, arg_xj, arg_xk}},
 }
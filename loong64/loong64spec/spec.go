@@ -7,6 +7,10 @@
 //
 // usage: go run spec.go LoongArch-Vol1-EN.pdf
 //
+// The operand fields of the LSX and LASX vector instructions (vd, vj, vk,
+// va, their 256-bit counterparts xd, xj, xk, xa, and the vector
+// immediates) are recognized as well.
+//
 // [1]: https://loongson.github.io/LoongArch-Documentation/LoongArch-Vol1-EN.pdf
 
 package main
@@ -77,6 +81,22 @@ func main() {
 		mergeMap(instFormats, cPageInstFormats)
 	}
 
+	// The virtualization extension is not part of Volume 1.
+	for op, inst := range lvzInsts() {
+		ops = append(ops, op)
+		opstrs[op] = inst["opstr"]
+		instFormatComments[op] = inst["instFormatComment"]
+		instFormats[op] = inst["instFormat"]
+	}
+
+	// Neither is the binary translation extension.
+	for op, inst := range lbtInsts() {
+		ops = append(ops, op)
+		opstrs[op] = inst["opstr"]
+		instFormatComments[op] = inst["instFormatComment"]
+		instFormats[op] = inst["instFormat"]
+	}
+
 	sort.Strings(ops)
 
 	for _, op := range ops {
@@ -137,6 +157,22 @@ func getArg(name string) (length int, argName string) {
 		return 5, "arg_rj"
 	case strings.Contains("arg_rk", name):
 		return 5, "arg_rk"
+	case strings.Contains("arg_vd", name):
+		return 5, "arg_vd"
+	case strings.Contains("arg_vj", name):
+		return 5, "arg_vj"
+	case strings.Contains("arg_vk", name):
+		return 5, "arg_vk"
+	case strings.Contains("arg_va", name):
+		return 5, "arg_va"
+	case strings.Contains("arg_xd", name):
+		return 5, "arg_xd"
+	case strings.Contains("arg_xj", name):
+		return 5, "arg_xj"
+	case strings.Contains("arg_xk", name):
+		return 5, "arg_xk"
+	case strings.Contains("arg_xa", name):
+		return 5, "arg_xa"
 	case name == "csr":
 		return 14, "arg_csr_23_10"
 	case strings.Contains("arg_cd", name):
@@ -158,15 +194,10 @@ func getArg(name string) (length int, argName string) {
 	case strings.Contains("arg_op_4_0", name):
 		return 5, "arg_op_4_0"
 	case strings.Contains(name, "ui"):
+		// Unsigned immediates, including the vector shift amounts
+		// and element indexes, always start at bit 10.
 		length, _ := strconv.Atoi(strings.Split(name, "ui")[1])
-		if length == 5 {
-			argName = "arg_ui5_14_10"
-		} else if length == 6 {
-			argName = "arg_ui6_15_10"
-		} else {
-			argName = "arg_ui12_21_10"
-		}
-		return length, argName
+		return length, fmt.Sprintf("arg_ui%d_%d_10", length, length+9)
 	case strings.Contains("arg_lsbw", name):
 		return 5, "arg_lsbw"
 	case strings.Contains("arg_msbw", name):
@@ -177,14 +208,10 @@ func getArg(name string) (length int, argName string) {
 		return 6, "arg_msbd"
 	case strings.Contains(name, "si"):
 		length, _ := strconv.Atoi(strings.Split(name, "si")[1])
-		if length == 12 {
-			argName = "arg_si12_21_10"
-		} else if length == 14 {
-			argName = "arg_si14_23_10"
-		} else if length == 16 {
-			argName = "arg_si16_25_10"
-		} else {
+		if length == 20 {
 			argName = "arg_si20_24_5"
+		} else {
+			argName = fmt.Sprintf("arg_si%d_%d_10", length, length+9)
 		}
 		return length, argName
 	case strings.Contains(name, "offs"):
@@ -311,6 +338,256 @@ func dealWithFcmp(ds string) (fcmpConditions map[string]map[string]string) {
 	return
 }
 
+/*
+The LoongArch Virtualization extension (LVZ) adds the guest CSR access
+instructions and the hypervisor call. They are not listed in Appendix B of
+Volume 1, so their encodings are described here, in the same format that
+parsePage produces. The GCSR* instructions share the layout of their CSR*
+counterparts:
+
+	| 31 - 24 | 23 - 10 | 9 - 5 | 4 - 0 |
+	|---------|---------|-------|-------|
+	|    op   |   csr   |   rj  |   rd  |
+
+with rj == 0 selecting GCSRRD and rj == 1 selecting GCSRWR.
+*/
+func lvzInsts() (insts map[string]map[string]string) {
+	lvz := []struct {
+		op, args, mask, value, instArgs string
+	}{
+		{"GCSRRD", "rd, csr", "0xff0003e0", "0x05000000", "arg_rd, arg_csr_23_10"},
+		{"GCSRWR", "rd, csr", "0xff0003e0", "0x05000020", "arg_rd, arg_csr_23_10"},
+		{"GCSRXCHG", "rd, rj, csr", "0xff000000", "0x05000000", "arg_rd, arg_rj, arg_csr_23_10"},
+		{"GTLBFLUSH", "", "0xffffffff", "0x06482401", ""},
+		{"HVCL", "code", "0xffff8000", "0x002b8000", "arg_code_14_0"},
+	}
+	insts = make(map[string]map[string]string)
+	for _, i := range lvz {
+		comment := "// " + i.op
+		if i.args != "" {
+			comment += " " + i.args
+		}
+		insts[i.op] = map[string]string{
+			"opstr":             fmt.Sprintf("%s:\t\"%s\",", i.op, i.op),
+			"instFormatComment": comment,
+			"instFormat":        fmt.Sprintf("{mask: %s, value: %s, op: %s, args: instArgs{%s}},", i.mask, i.value, i.op, i.instArgs),
+		}
+	}
+	return
+}
+
+/*
+The LoongArch Binary Translation extension (LBT) adds the instructions
+that emulate the x86 and ARM flags and the x87 register stack, the scratch
+registers SCR0-SCR3 and some general-purpose and floating point helpers.
+Like LVZ, they are not listed in Appendix B of Volume 1. Besides the usual
+fields, they use:
+
+	| field | bits    | meaning                                  |
+	|-------|---------|------------------------------------------|
+	|  ui4  | 3 - 0   | ARM condition of the ARM* instructions   |
+	|  ui3  | 7 - 5   | x87 stack top of X86MTTOP                |
+	|  ui5  | 9 - 5   | second operand of X86SETTAG              |
+	|  sd   | 1 - 0   | scratch register written by MOVGR2SCR    |
+	|  sj   | 6 - 5   | scratch register read by MOVSCR2GR       |
+*/
+func lbtInsts() (insts map[string]map[string]string) {
+	lbt := []struct {
+		op, args, mask, value, instArgs string
+	}{
+		{"X86ADC_B", "rj, rk", "0xffff801f", "0x003f000c", "arg_rj, arg_rk"},
+		{"X86ADC_H", "rj, rk", "0xffff801f", "0x003f000d", "arg_rj, arg_rk"},
+		{"X86ADC_W", "rj, rk", "0xffff801f", "0x003f000e", "arg_rj, arg_rk"},
+		{"X86ADC_D", "rj, rk", "0xffff801f", "0x003f000f", "arg_rj, arg_rk"},
+		{"X86ADD_B", "rj, rk", "0xffff801f", "0x003f0004", "arg_rj, arg_rk"},
+		{"X86ADD_H", "rj, rk", "0xffff801f", "0x003f0005", "arg_rj, arg_rk"},
+		{"X86ADD_W", "rj, rk", "0xffff801f", "0x003f0006", "arg_rj, arg_rk"},
+		{"X86ADD_D", "rj, rk", "0xffff801f", "0x003f0007", "arg_rj, arg_rk"},
+		{"X86SBC_B", "rj, rk", "0xffff801f", "0x003f0010", "arg_rj, arg_rk"},
+		{"X86SBC_H", "rj, rk", "0xffff801f", "0x003f0011", "arg_rj, arg_rk"},
+		{"X86SBC_W", "rj, rk", "0xffff801f", "0x003f0012", "arg_rj, arg_rk"},
+		{"X86SBC_D", "rj, rk", "0xffff801f", "0x003f0013", "arg_rj, arg_rk"},
+		{"X86SUB_B", "rj, rk", "0xffff801f", "0x003f0008", "arg_rj, arg_rk"},
+		{"X86SUB_H", "rj, rk", "0xffff801f", "0x003f0009", "arg_rj, arg_rk"},
+		{"X86SUB_W", "rj, rk", "0xffff801f", "0x003f000a", "arg_rj, arg_rk"},
+		{"X86SUB_D", "rj, rk", "0xffff801f", "0x003f000b", "arg_rj, arg_rk"},
+		{"X86AND_B", "rj, rk", "0xffff801f", "0x003f8010", "arg_rj, arg_rk"},
+		{"X86AND_H", "rj, rk", "0xffff801f", "0x003f8011", "arg_rj, arg_rk"},
+		{"X86AND_W", "rj, rk", "0xffff801f", "0x003f8012", "arg_rj, arg_rk"},
+		{"X86AND_D", "rj, rk", "0xffff801f", "0x003f8013", "arg_rj, arg_rk"},
+		{"X86OR_B", "rj, rk", "0xffff801f", "0x003f8014", "arg_rj, arg_rk"},
+		{"X86OR_H", "rj, rk", "0xffff801f", "0x003f8015", "arg_rj, arg_rk"},
+		{"X86OR_W", "rj, rk", "0xffff801f", "0x003f8016", "arg_rj, arg_rk"},
+		{"X86OR_D", "rj, rk", "0xffff801f", "0x003f8017", "arg_rj, arg_rk"},
+		{"X86XOR_B", "rj, rk", "0xffff801f", "0x003f8018", "arg_rj, arg_rk"},
+		{"X86XOR_H", "rj, rk", "0xffff801f", "0x003f8019", "arg_rj, arg_rk"},
+		{"X86XOR_W", "rj, rk", "0xffff801f", "0x003f801a", "arg_rj, arg_rk"},
+		{"X86XOR_D", "rj, rk", "0xffff801f", "0x003f801b", "arg_rj, arg_rk"},
+		{"X86SLL_B", "rj, rk", "0xffff801f", "0x003f0014", "arg_rj, arg_rk"},
+		{"X86SLL_H", "rj, rk", "0xffff801f", "0x003f0015", "arg_rj, arg_rk"},
+		{"X86SLL_W", "rj, rk", "0xffff801f", "0x003f0016", "arg_rj, arg_rk"},
+		{"X86SLL_D", "rj, rk", "0xffff801f", "0x003f0017", "arg_rj, arg_rk"},
+		{"X86SRL_B", "rj, rk", "0xffff801f", "0x003f0018", "arg_rj, arg_rk"},
+		{"X86SRL_H", "rj, rk", "0xffff801f", "0x003f0019", "arg_rj, arg_rk"},
+		{"X86SRL_W", "rj, rk", "0xffff801f", "0x003f001a", "arg_rj, arg_rk"},
+		{"X86SRL_D", "rj, rk", "0xffff801f", "0x003f001b", "arg_rj, arg_rk"},
+		{"X86SRA_B", "rj, rk", "0xffff801f", "0x003f001c", "arg_rj, arg_rk"},
+		{"X86SRA_H", "rj, rk", "0xffff801f", "0x003f001d", "arg_rj, arg_rk"},
+		{"X86SRA_W", "rj, rk", "0xffff801f", "0x003f001e", "arg_rj, arg_rk"},
+		{"X86SRA_D", "rj, rk", "0xffff801f", "0x003f001f", "arg_rj, arg_rk"},
+		{"X86ROTR_B", "rj, rk", "0xffff801f", "0x003f8000", "arg_rj, arg_rk"},
+		{"X86ROTR_H", "rj, rk", "0xffff801f", "0x003f8001", "arg_rj, arg_rk"},
+		{"X86ROTR_W", "rj, rk", "0xffff801f", "0x003f8003", "arg_rj, arg_rk"},
+		{"X86ROTR_D", "rj, rk", "0xffff801f", "0x003f8002", "arg_rj, arg_rk"},
+		{"X86ROTL_B", "rj, rk", "0xffff801f", "0x003f8004", "arg_rj, arg_rk"},
+		{"X86ROTL_H", "rj, rk", "0xffff801f", "0x003f8005", "arg_rj, arg_rk"},
+		{"X86ROTL_W", "rj, rk", "0xffff801f", "0x003f8006", "arg_rj, arg_rk"},
+		{"X86ROTL_D", "rj, rk", "0xffff801f", "0x003f8007", "arg_rj, arg_rk"},
+		{"X86RCR_B", "rj, rk", "0xffff801f", "0x003f8008", "arg_rj, arg_rk"},
+		{"X86RCR_H", "rj, rk", "0xffff801f", "0x003f8009", "arg_rj, arg_rk"},
+		{"X86RCR_W", "rj, rk", "0xffff801f", "0x003f800a", "arg_rj, arg_rk"},
+		{"X86RCR_D", "rj, rk", "0xffff801f", "0x003f800b", "arg_rj, arg_rk"},
+		{"X86RCL_B", "rj, rk", "0xffff801f", "0x003f800c", "arg_rj, arg_rk"},
+		{"X86RCL_H", "rj, rk", "0xffff801f", "0x003f800d", "arg_rj, arg_rk"},
+		{"X86RCL_W", "rj, rk", "0xffff801f", "0x003f800e", "arg_rj, arg_rk"},
+		{"X86RCL_D", "rj, rk", "0xffff801f", "0x003f800f", "arg_rj, arg_rk"},
+		{"X86ADD_WU", "rj, rk", "0xffff801f", "0x003f0000", "arg_rj, arg_rk"},
+		{"X86SUB_WU", "rj, rk", "0xffff801f", "0x003f0002", "arg_rj, arg_rk"},
+		{"X86ADD_DU", "rj, rk", "0xffff801f", "0x003f0001", "arg_rj, arg_rk"},
+		{"X86SUB_DU", "rj, rk", "0xffff801f", "0x003f0003", "arg_rj, arg_rk"},
+		{"X86MUL_B", "rj, rk", "0xffff801f", "0x003e8000", "arg_rj, arg_rk"},
+		{"X86MUL_H", "rj, rk", "0xffff801f", "0x003e8001", "arg_rj, arg_rk"},
+		{"X86MUL_W", "rj, rk", "0xffff801f", "0x003e8002", "arg_rj, arg_rk"},
+		{"X86MUL_D", "rj, rk", "0xffff801f", "0x003e8003", "arg_rj, arg_rk"},
+		{"X86MUL_BU", "rj, rk", "0xffff801f", "0x003e8004", "arg_rj, arg_rk"},
+		{"X86MUL_HU", "rj, rk", "0xffff801f", "0x003e8005", "arg_rj, arg_rk"},
+		{"X86MUL_WU", "rj, rk", "0xffff801f", "0x003e8006", "arg_rj, arg_rk"},
+		{"X86MUL_DU", "rj, rk", "0xffff801f", "0x003e8007", "arg_rj, arg_rk"},
+		{"X86INC_B", "rj", "0xfffffc1f", "0x00008000", "arg_rj"},
+		{"X86INC_H", "rj", "0xfffffc1f", "0x00008001", "arg_rj"},
+		{"X86INC_W", "rj", "0xfffffc1f", "0x00008002", "arg_rj"},
+		{"X86INC_D", "rj", "0xfffffc1f", "0x00008003", "arg_rj"},
+		{"X86DEC_B", "rj", "0xfffffc1f", "0x00008004", "arg_rj"},
+		{"X86DEC_H", "rj", "0xfffffc1f", "0x00008005", "arg_rj"},
+		{"X86DEC_W", "rj", "0xfffffc1f", "0x00008006", "arg_rj"},
+		{"X86DEC_D", "rj", "0xfffffc1f", "0x00008007", "arg_rj"},
+		{"X86SLLI_B", "rj, ui3", "0xffffe01f", "0x00542000", "arg_rj, arg_ui3_12_10"},
+		{"X86SLLI_H", "rj, ui4", "0xffffc01f", "0x00544001", "arg_rj, arg_ui4_13_10"},
+		{"X86SLLI_W", "rj, ui5", "0xffff801f", "0x00548002", "arg_rj, arg_ui5_14_10"},
+		{"X86SLLI_D", "rj, ui6", "0xffff001f", "0x00550003", "arg_rj, arg_ui6_15_10"},
+		{"X86SRLI_B", "rj, ui3", "0xffffe01f", "0x00542004", "arg_rj, arg_ui3_12_10"},
+		{"X86SRLI_H", "rj, ui4", "0xffffc01f", "0x00544005", "arg_rj, arg_ui4_13_10"},
+		{"X86SRLI_W", "rj, ui5", "0xffff801f", "0x00548006", "arg_rj, arg_ui5_14_10"},
+		{"X86SRLI_D", "rj, ui6", "0xffff001f", "0x00550007", "arg_rj, arg_ui6_15_10"},
+		{"X86SRAI_B", "rj, ui3", "0xffffe01f", "0x00542008", "arg_rj, arg_ui3_12_10"},
+		{"X86SRAI_H", "rj, ui4", "0xffffc01f", "0x00544009", "arg_rj, arg_ui4_13_10"},
+		{"X86SRAI_W", "rj, ui5", "0xffff801f", "0x0054800a", "arg_rj, arg_ui5_14_10"},
+		{"X86SRAI_D", "rj, ui6", "0xffff001f", "0x0055000b", "arg_rj, arg_ui6_15_10"},
+		{"X86ROTRI_B", "rj, ui3", "0xffffe01f", "0x0054200c", "arg_rj, arg_ui3_12_10"},
+		{"X86ROTRI_H", "rj, ui4", "0xffffc01f", "0x0054400d", "arg_rj, arg_ui4_13_10"},
+		{"X86ROTRI_W", "rj, ui5", "0xffff801f", "0x0054800e", "arg_rj, arg_ui5_14_10"},
+		{"X86ROTRI_D", "rj, ui6", "0xffff001f", "0x0055000f", "arg_rj, arg_ui6_15_10"},
+		{"X86ROTLI_B", "rj, ui3", "0xffffe01f", "0x00542014", "arg_rj, arg_ui3_12_10"},
+		{"X86ROTLI_H", "rj, ui4", "0xffffc01f", "0x00544015", "arg_rj, arg_ui4_13_10"},
+		{"X86ROTLI_W", "rj, ui5", "0xffff801f", "0x00548016", "arg_rj, arg_ui5_14_10"},
+		{"X86ROTLI_D", "rj, ui6", "0xffff001f", "0x00550017", "arg_rj, arg_ui6_15_10"},
+		{"X86RCRI_B", "rj, ui3", "0xffffe01f", "0x00542010", "arg_rj, arg_ui3_12_10"},
+		{"X86RCRI_H", "rj, ui4", "0xffffc01f", "0x00544011", "arg_rj, arg_ui4_13_10"},
+		{"X86RCRI_W", "rj, ui5", "0xffff801f", "0x00548012", "arg_rj, arg_ui5_14_10"},
+		{"X86RCRI_D", "rj, ui6", "0xffff001f", "0x00550013", "arg_rj, arg_ui6_15_10"},
+		{"X86RCLI_B", "rj, ui3", "0xffffe01f", "0x00542018", "arg_rj, arg_ui3_12_10"},
+		{"X86RCLI_H", "rj, ui4", "0xffffc01f", "0x00544019", "arg_rj, arg_ui4_13_10"},
+		{"X86RCLI_W", "rj, ui5", "0xffff801f", "0x0054801a", "arg_rj, arg_ui5_14_10"},
+		{"X86RCLI_D", "rj, ui6", "0xffff001f", "0x0055001b", "arg_rj, arg_ui6_15_10"},
+		{"X86SETTAG", "rd, ui5, ui8", "0xfffc0000", "0x00580000", "arg_rd, arg_ui5_9_5, arg_ui8_17_10"},
+		{"X86MFFLAG", "rd, ui8", "0xfffc03e0", "0x005c0000", "arg_rd, arg_ui8_17_10"},
+		{"X86MTFLAG", "rd, ui8", "0xfffc03e0", "0x005c0020", "arg_rd, arg_ui8_17_10"},
+		{"X86MFTOP", "rd", "0xffffffe0", "0x00007400", "arg_rd"},
+		{"X86MTTOP", "ui3", "0xffffff1f", "0x00007000", "arg_ui3_7_5"},
+		{"X86INCTOP", "", "0xffffffff", "0x00008009", ""},
+		{"X86DECTOP", "", "0xffffffff", "0x00008029", ""},
+		{"X86SETTM", "", "0xffffffff", "0x00008008", ""},
+		{"X86CLRTM", "", "0xffffffff", "0x00008028", ""},
+		{"ARMADD_W", "rj, rk, ui4", "0xffff8010", "0x00370010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMSUB_W", "rj, rk, ui4", "0xffff8010", "0x00378010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMADC_W", "rj, rk, ui4", "0xffff8010", "0x00380010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMSBC_W", "rj, rk, ui4", "0xffff8010", "0x00388010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMAND_W", "rj, rk, ui4", "0xffff8010", "0x00390010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMOR_W", "rj, rk, ui4", "0xffff8010", "0x00398010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMXOR_W", "rj, rk, ui4", "0xffff8010", "0x003a0010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMSLL_W", "rj, rk, ui4", "0xffff8010", "0x003a8010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMSRL_W", "rj, rk, ui4", "0xffff8010", "0x003b0010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMSRA_W", "rj, rk, ui4", "0xffff8010", "0x003b8010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMROTR_W", "rj, rk, ui4", "0xffff8010", "0x003c0010", "arg_rj, arg_rk, arg_ui4_3_0"},
+		{"ARMSLLI_W", "rj, ui5, ui4", "0xffff8010", "0x003c8010", "arg_rj, arg_ui5_14_10, arg_ui4_3_0"},
+		{"ARMSRLI_W", "rj, ui5, ui4", "0xffff8010", "0x003d0010", "arg_rj, arg_ui5_14_10, arg_ui4_3_0"},
+		{"ARMSRAI_W", "rj, ui5, ui4", "0xffff8010", "0x003d8010", "arg_rj, arg_ui5_14_10, arg_ui4_3_0"},
+		{"ARMROTRI_W", "rj, ui5, ui4", "0xffff8010", "0x003e0010", "arg_rj, arg_ui5_14_10, arg_ui4_3_0"},
+		{"ARMRRX_W", "rj, ui4", "0xffffc01f", "0x003fc01f", "arg_rj, arg_ui4_13_10"},
+		{"ARMMOVE", "rd, rj, ui4", "0xffffc000", "0x00364000", "arg_rd, arg_rj, arg_ui4_13_10"},
+		{"ARMMOV_W", "rj, ui4", "0xffffc01f", "0x003fc01d", "arg_rj, arg_ui4_13_10"},
+		{"ARMMOV_D", "rj, ui4", "0xffffc01f", "0x003fc01e", "arg_rj, arg_ui4_13_10"},
+		{"ARMNOT_W", "rj, ui4", "0xffffc01f", "0x003fc01c", "arg_rj, arg_ui4_13_10"},
+		{"ARMMFFLAG", "rd, ui8", "0xfffc03e0", "0x005c0040", "arg_rd, arg_ui8_17_10"},
+		{"ARMMTFLAG", "rd, ui8", "0xfffc03e0", "0x005c0060", "arg_rd, arg_ui8_17_10"},
+		{"SETX86J", "rd, ui4", "0xffffc3e0", "0x00368000", "arg_rd, arg_ui4_13_10"},
+		{"SETARMJ", "rd, ui4", "0xffffc3e0", "0x0036c000", "arg_rd, arg_ui4_13_10"},
+		{"SETX86LOOPE", "rd, rj", "0xfffffc00", "0x00007800", "arg_rd, arg_rj"},
+		{"SETX86LOOPNE", "rd, rj", "0xfffffc00", "0x00007c00", "arg_rd, arg_rj"},
+		{"MOVGR2SCR", "sd, rj", "0xfffffc1c", "0x00000800", "arg_sd, arg_rj"},
+		{"MOVSCR2GR", "rd, sj", "0xffffff80", "0x00000c00", "arg_rd, arg_sj"},
+		{"JISCR0", "offs21", "0xfc0003e0", "0x48000200", "arg_offset_20_0"},
+		{"JISCR1", "offs21", "0xfc0003e0", "0x48000300", "arg_offset_20_0"},
+		{"ADC_B", "rd, rj, rk", "0xffff8000", "0x00300000", "arg_rd, arg_rj, arg_rk"},
+		{"SBC_B", "rd, rj, rk", "0xffff8000", "0x00320000", "arg_rd, arg_rj, arg_rk"},
+		{"ADC_H", "rd, rj, rk", "0xffff8000", "0x00308000", "arg_rd, arg_rj, arg_rk"},
+		{"SBC_H", "rd, rj, rk", "0xffff8000", "0x00328000", "arg_rd, arg_rj, arg_rk"},
+		{"ADC_W", "rd, rj, rk", "0xffff8000", "0x00310000", "arg_rd, arg_rj, arg_rk"},
+		{"SBC_W", "rd, rj, rk", "0xffff8000", "0x00330000", "arg_rd, arg_rj, arg_rk"},
+		{"ADC_D", "rd, rj, rk", "0xffff8000", "0x00318000", "arg_rd, arg_rj, arg_rk"},
+		{"SBC_D", "rd, rj, rk", "0xffff8000", "0x00338000", "arg_rd, arg_rj, arg_rk"},
+		{"RCR_B", "rd, rj, rk", "0xffff8000", "0x00340000", "arg_rd, arg_rj, arg_rk"},
+		{"RCR_H", "rd, rj, rk", "0xffff8000", "0x00348000", "arg_rd, arg_rj, arg_rk"},
+		{"RCR_W", "rd, rj, rk", "0xffff8000", "0x00350000", "arg_rd, arg_rj, arg_rk"},
+		{"RCR_D", "rd, rj, rk", "0xffff8000", "0x00358000", "arg_rd, arg_rj, arg_rk"},
+		{"ROTR_B", "rd, rj, rk", "0xffff8000", "0x001a0000", "arg_rd, arg_rj, arg_rk"},
+		{"ROTR_H", "rd, rj, rk", "0xffff8000", "0x001a8000", "arg_rd, arg_rj, arg_rk"},
+		{"ROTRI_B", "rd, rj, ui3", "0xffffe000", "0x004c2000", "arg_rd, arg_rj, arg_ui3_12_10"},
+		{"ROTRI_H", "rd, rj, ui4", "0xffffc000", "0x004c4000", "arg_rd, arg_rj, arg_ui4_13_10"},
+		{"RCRI_B", "rd, rj, ui3", "0xffffe000", "0x00502000", "arg_rd, arg_rj, arg_ui3_12_10"},
+		{"RCRI_H", "rd, rj, ui4", "0xffffc000", "0x00504000", "arg_rd, arg_rj, arg_ui4_13_10"},
+		{"RCRI_W", "rd, rj, ui5", "0xffff8000", "0x00508000", "arg_rd, arg_rj, arg_ui5_14_10"},
+		{"RCRI_D", "rd, rj, ui6", "0xffff0000", "0x00510000", "arg_rd, arg_rj, arg_ui6_15_10"},
+		{"ADDU12I_W", "rd, rj, si5", "0xffff8000", "0x00290000", "arg_rd, arg_rj, arg_si5_14_10"},
+		{"ADDU12I_D", "rd, rj, si5", "0xffff8000", "0x00298000", "arg_rd, arg_rj, arg_si5_14_10"},
+		{"LDL_W", "rd, rj, si12", "0xffc00000", "0x2e000000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"LDL_D", "rd, rj, si12", "0xffc00000", "0x2e800000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"LDR_W", "rd, rj, si12", "0xffc00000", "0x2e400000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"LDR_D", "rd, rj, si12", "0xffc00000", "0x2ec00000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"STL_W", "rd, rj, si12", "0xffc00000", "0x2f000000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"STL_D", "rd, rj, si12", "0xffc00000", "0x2f800000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"STR_W", "rd, rj, si12", "0xffc00000", "0x2f400000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"STR_D", "rd, rj, si12", "0xffc00000", "0x2fc00000", "arg_rd, arg_rj, arg_si12_21_10"},
+		{"FCVT_UD_D", "fd, fj", "0xfffffc00", "0x0114e400", "arg_fd, arg_fj"},
+		{"FCVT_LD_D", "fd, fj", "0xfffffc00", "0x0114e000", "arg_fd, arg_fj"},
+		{"FCVT_D_LD", "fd, fj, fk", "0xffff8000", "0x01150000", "arg_fd, arg_fj, arg_fk"},
+	}
+	insts = make(map[string]map[string]string)
+	for _, i := range lbt {
+		name := strings.ReplaceAll(i.op, "_", ".")
+		comment := "// " + name
+		if i.args != "" {
+			comment += " " + i.args
+		}
+		insts[i.op] = map[string]string{
+			"opstr":             fmt.Sprintf("%s:\t\"%s\",", i.op, name),
+			"instFormatComment": comment,
+			"instFormat":        fmt.Sprintf("{mask: %s, value: %s, op: %s, args: instArgs{%s}},", i.mask, i.value, i.op, i.instArgs),
+		}
+	}
+	return
+}
+
 func findWords(chars []pdf.Text) (words []pdf.Text) {
 	for i := 0; i < len(chars); {
 		xRange := []float64{chars[i].X, chars[i].X}
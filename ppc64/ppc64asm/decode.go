@@ -70,6 +70,8 @@ func (a argField) Parse(i [2]uint32) Arg {
 		return VS0 + Reg(a.BitFields.Parse(i))*2
 	case TypeMMAReg:
 		return A0 + Reg(a.BitFields.Parse(i))
+	case TypeDMReg:
+		return DMR0 + Reg(a.BitFields.Parse(i))
 	case TypeSpReg:
 		return SpReg(a.BitFields.Parse(i))
 	case TypeImmSigned:
@@ -110,6 +112,7 @@ const (
 	TypeImmUnsigned          // unsigned immediate/flag/mask, this is the catch-all type
 	TypeOffset               // signed offset in load/store
 	TypeNegOffset            // A negative 16 bit value 0b1111111xxxxx000 encoded as 0bxxxxx (e.g in the hashchk instruction)
+	TypeDMReg                // dense math register
 	TypeLast                 // must be the last one
 )
 
@@ -190,6 +193,8 @@ func (t ArgType) String() string {
 		return "Offset"
 	case TypeNegOffset:
 		return "NegOffset"
+	case TypeDMReg:
+		return "DMReg"
 	}
 }
 
@@ -254,6 +259,7 @@ func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
 			inst.Args[i] = argfield.Parse(ui_extn)
 		}
 		inst.Op = iform.Op
+		inst.ISA = inst.Op.ISA()
 		if debugDecode {
 			log.Printf("%#x: search entry %d", ui, i)
 			continue
@@ -96,3 +96,64 @@ func decode(data []byte, t *testing.T, filename string) {
 		}
 	}
 }
+
+func TestDecodeISA(t *testing.T) {
+	tests := []struct {
+		enc string
+		isa ISA
+	}{
+		{"38600001", ISAPower1},        // li r3,1
+		{"18e10000", ISAV3_1},          // lxvp vs38,0(r1)
+		{"7e0115e5", ISAV3_1B},         // hashchk r2,-128(r1)
+		{"7d86a162", ISAV3_1C},         // dmmr dm3,dm5
+		{"f0821712", ISAV3_1C},         // dmxxextfdmr512 vs2,vs34,dm1,0
+		{"f2801f92", ISAV3_1C},         // dmxxextfdmr256 vs34,dm5,2
+		{"ee846a56", ISAV3_1C},         // dmxvbf16gerx2pp dm5,vs36,vs45
+		{"0790afffef8a0b12", ISAV3_1C}, // pmdmxvi8gerx4spp dm7,vs10,vs33,255,15,10
+		{"7daec162", ISAV3_1C},         // dmsha2hash dm3,dm6,1
+		{"7d800162", ISAV3_1},          // xxmfacc a3, which is also dmxxmfacc dm3
+	}
+	for _, tt := range tests {
+		code, err := hex.DecodeString(tt.enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := Decode(code, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%s): %v", tt.enc, err)
+			continue
+		}
+		if inst.ISA != tt.isa {
+			t.Errorf("Decode(%s).ISA = %v, want %v", tt.enc, inst.ISA, tt.isa)
+		}
+	}
+}
+
+func TestOpISA(t *testing.T) {
+	tests := []struct {
+		op  Op
+		isa ISA
+	}{
+		{ADD, ISAPower1},
+		{LXVD2X, ISAV2_06},
+		{VADDUDM, ISAV2_07},
+		{MFVSRLD, ISAV3_0},
+		{LXVP, ISAV3_1},
+		{HASHCHK, ISAV3_1B},
+		{DMMR, ISAV3_1C},
+		{DMXXMFACC, ISAV3_1C},
+		{PMDMXVF16GERX2NN, ISAV3_1C},
+		{0, 0},
+		{Op(len(opstr)), 0},
+	}
+	for _, tt := range tests {
+		if isa := tt.op.ISA(); isa != tt.isa {
+			t.Errorf("%v.ISA() = %v, want %v", tt.op, isa, tt.isa)
+		}
+	}
+	for _, f := range instFormats {
+		if f.Op.ISA() == 0 {
+			t.Errorf("%v.ISA() is not set", f.Op)
+		}
+	}
+}
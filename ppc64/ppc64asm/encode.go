@@ -0,0 +1,218 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// Encode returns the encoding of inst using byte order ord:
+// 4 bytes, or 8 bytes for a prefixed instruction, whose prefix word
+// comes first. inst.Op and inst.Args select the encoding. The bits
+// of inst.Enc and inst.SuffixEnc that the instruction ignores are
+// kept, so an instruction returned by Decode encodes to the bytes it
+// was decoded from.
+//
+// An instruction that has an extended mnemonic, like ORI R0,R0,$0,
+// which is NOP, encodes to the same bits as the extended mnemonic,
+// although Decode returns the extended mnemonic for them.
+func Encode(inst Inst, ord binary.ByteOrder) ([]byte, error) {
+	enc, _, err := encode(inst, ord)
+	return enc, err
+}
+
+// EncodeAt is like Encode for an instruction at address pc.
+// A prefixed instruction must not cross a 64-byte boundary, so if
+// pc is 4 bytes before one, EncodeAt returns a NOP followed by the
+// instruction, as an assembler lays it out. The displacement of a
+// PC-relative prefixed instruction, one with R=1, is relative to pc
+// and is adjusted for the NOP, so the instruction still addresses
+// the same location.
+func EncodeAt(inst Inst, ord binary.ByteOrder, pc uint64) ([]byte, error) {
+	if pc%4 != 0 {
+		return nil, fmt.Errorf("cannot encode %v at misaligned address %#x", inst, pc)
+	}
+	enc, f, err := encode(inst, ord)
+	if err != nil || len(enc) != 8 || pc%64 != 64-4 {
+		return enc, err
+	}
+	if i := f.pcRelDisp(&inst); i >= 0 {
+		switch d := inst.Args[i].(type) {
+		case Offset:
+			inst.Args[i] = d - 4
+		case Imm:
+			inst.Args[i] = d - 4
+		}
+		if enc, _, err = encode(inst, ord); err != nil {
+			return nil, err
+		}
+	}
+	nop := make([]byte, 4, 4+len(enc))
+	ord.PutUint32(nop, 0x60000000) // ori r0,r0,0
+	return append(nop, enc...), nil
+}
+
+// encode is Encode, also returning the format of the encoding.
+func encode(inst Inst, ord binary.ByteOrder) ([]byte, *instFormat, error) {
+	for _, f := range getOpFormats()[inst.Op] {
+		w, ok := f.encode(&inst)
+		if !ok {
+			continue
+		}
+		if f.Value>>32>>26 != prefixOpcode {
+			enc := make([]byte, 4)
+			ord.PutUint32(enc, w[0])
+			return enc, f, nil
+		}
+		enc := make([]byte, 8)
+		ord.PutUint32(enc, w[0])
+		ord.PutUint32(enc[4:], w[1])
+		return enc, f, nil
+	}
+	return nil, nil, fmt.Errorf("cannot encode %v", inst)
+}
+
+// pcRelDisp returns the index in inst.Args of the 34-bit displacement
+// of a prefixed instruction in the format f that has R=1, making the
+// displacement relative to the address of the instruction, or -1.
+func (f *instFormat) pcRelDisp(inst *Inst) int {
+	disp, r := -1, false
+	for i, a := range f.Args {
+		switch a {
+		case ap_Offset_14_31_48_63, ap_ImmSigned_14_31_48_63:
+			disp = i
+		case ap_ImmUnsigned_11_11:
+			r = inst.Args[i] == Imm(1)
+		}
+	}
+	if !r {
+		return -1
+	}
+	return disp
+}
+
+// getOpFormats returns the instruction formats of each Op.
+var getOpFormats = sync.OnceValue(func() map[Op][]*instFormat {
+	formats := make(map[Op][]*instFormat)
+	for i := range instFormats {
+		f := &instFormats[i]
+		formats[f.Op] = append(formats[f.Op], f)
+	}
+	return formats
+})
+
+// encode returns the instruction words of inst in the format f,
+// the prefix and the suffix, or the instruction and 0 if f is not
+// prefixed. It reports whether the arguments of inst fit f.
+func (f *instFormat) encode(inst *Inst) ([2]uint32, bool) {
+	w := [2]uint32{
+		uint32(f.Value>>32) | inst.Enc&uint32(f.DontCare>>32),
+		uint32(f.Value) | inst.SuffixEnc&uint32(f.DontCare),
+	}
+	n := 0
+	for n < len(f.Args) && f.Args[n] != nil {
+		if !f.Args[n].encode(&w, inst.Args[n]) {
+			return w, false
+		}
+		n++
+	}
+	if n < len(inst.Args) && inst.Args[n] != nil {
+		return w, false
+	}
+	// An argument that does not fit its bitfields parses differently.
+	for i, a := range f.Args[:n] {
+		if a.Parse(w) != inst.Args[i] {
+			return w, false
+		}
+	}
+	return w, true
+}
+
+// encode sets the bitfields of the argument a in the instruction
+// words w to the value of arg, the inverse of Parse. It reports
+// whether arg may have the type of a. The value may not fit the
+// bitfields, and a register may be of another kind.
+func (a argField) encode(w *[2]uint32, arg Arg) bool {
+	var u int64
+	switch a.Type {
+	default:
+		return false
+	case TypeReg:
+		u = regNum(arg, R0)
+	case TypeCondRegBit:
+		c, ok := arg.(CondReg)
+		if !ok {
+			return false
+		}
+		u = int64(c - Cond0LT)
+	case TypeCondRegField:
+		c, ok := arg.(CondReg)
+		if !ok {
+			return false
+		}
+		u = int64(c - CR0)
+	case TypeFPReg:
+		u = regNum(arg, F0)
+	case TypeVecReg:
+		u = regNum(arg, V0)
+	case TypeVecSReg:
+		u = regNum(arg, VS0)
+	case TypeVecSpReg:
+		// Only even registers can be encoded.
+		u = regNum(arg, VS0)
+		if u&1 != 0 {
+			return false
+		}
+		u >>= 1
+	case TypeMMAReg:
+		u = regNum(arg, A0)
+	case TypeDMReg:
+		u = regNum(arg, DMR0)
+	case TypeSpReg:
+		s, ok := arg.(SpReg)
+		if !ok {
+			return false
+		}
+		u = int64(s)
+	case TypeImmSigned, TypeImmUnsigned:
+		imm, ok := arg.(Imm)
+		if !ok {
+			return false
+		}
+		u = int64(imm) >> a.Shift
+	case TypePCRel:
+		rel, ok := arg.(PCRel)
+		if !ok {
+			return false
+		}
+		u = int64(rel) >> a.Shift
+	case TypeLabel:
+		l, ok := arg.(Label)
+		if !ok {
+			return false
+		}
+		u = int64(l) >> a.Shift
+	case TypeOffset, TypeNegOffset:
+		off, ok := arg.(Offset)
+		if !ok {
+			return false
+		}
+		u = int64(off) >> a.Shift
+	}
+	a.BitFields.insert(w, uint64(u))
+	return true
+}
+
+// regNum returns the number of the register arg in the register file
+// that starts at base, or -1 if arg is not a register in it.
+func regNum(arg Arg, base Reg) int64 {
+	r, ok := arg.(Reg)
+	if !ok || r < base {
+		return -1
+	}
+	return int64(r - base)
+}
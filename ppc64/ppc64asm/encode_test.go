@@ -0,0 +1,203 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ppc64asm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestEncode checks that every instruction in the decode test cases
+// encodes back to its original bytes in both byte orders, and, without
+// the original bits, to bytes that decode to the same instruction.
+func TestEncode(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "decode*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			f := strings.Fields(line)
+			code, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+			if err != nil {
+				t.Errorf("%s: parsing %q: %v", file, f[0], err)
+				continue
+			}
+			for _, ord := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+				if ord == binary.LittleEndian {
+					code = swapWords(code)
+				}
+				inst, err := Decode(code, ord)
+				if err != nil || inst.Op == 0 {
+					continue
+				}
+				n++
+				code := code[:inst.Len]
+				if enc, err := Encode(inst, ord); err != nil || !bytes.Equal(enc, code) {
+					t.Errorf("%s: Encode(%v, %v) = %x, %v, want %x", file, inst, ord, enc, err, code)
+				}
+				inst.Enc, inst.SuffixEnc = 0, 0
+				enc, err := Encode(inst, ord)
+				if err != nil {
+					t.Errorf("%s: Encode(%v, %v) without the original bits: %v", file, inst, ord, err)
+					continue
+				}
+				dec, err := Decode(enc, ord)
+				if err != nil || dec.Op != inst.Op || dec.Args != inst.Args {
+					t.Errorf("%s: Encode(%v, %v) without the original bits = %x, which decodes to %v", file, inst, ord, enc, dec)
+				}
+			}
+		}
+	}
+	if n == 0 {
+		t.Fatal("no test cases")
+	}
+}
+
+// swapWords reverses the bytes of each 32-bit word of code.
+func swapWords(code []byte) []byte {
+	swapped := make([]byte, len(code))
+	for i := 0; i+4 <= len(code); i += 4 {
+		binary.LittleEndian.PutUint32(swapped[i:], binary.BigEndian.Uint32(code[i:]))
+	}
+	return swapped
+}
+
+func TestEncodeInst(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		ord  binary.ByteOrder
+		want string
+	}{
+		{Inst{Op: ADDI, Args: Args{R3, R4, Imm(-1)}}, binary.BigEndian, "3864ffff"},
+		{Inst{Op: ADDI, Args: Args{R3, R4, Imm(-1)}}, binary.LittleEndian, "ffff6438"},
+		{Inst{Op: LD, Args: Args{R3, Offset(8), R1}}, binary.BigEndian, "e8610008"},
+		{Inst{Op: ORI, Args: Args{R0, R0, Imm(0)}}, binary.BigEndian, "60000000"},
+		{Inst{Op: NOP}, binary.BigEndian, "60000000"},
+		{Inst{Op: B, Args: Args{PCRel(-4)}}, binary.BigEndian, "4bfffffc"},
+		{Inst{Op: BC, Args: Args{Imm(12), Cond0EQ, PCRel(0x10)}}, binary.BigEndian, "41820010"},
+
+		// DMXXMFACC shares the encoding of XXMFACC.
+		{Inst{Op: DMXXMFACC, Args: Args{DMR3}}, binary.BigEndian, "7d800162"},
+
+		// Prefixed instructions, prefix word first.
+		{Inst{Op: PADDI, Args: Args{R3, R4, Imm(0x12345678), Imm(0)}}, binary.BigEndian, "06001234 38645678"},
+		{Inst{Op: PADDI, Args: Args{R3, R4, Imm(0x12345678), Imm(0)}}, binary.LittleEndian, "34120006 78566438"},
+	}
+	for _, tt := range tests {
+		enc, err := Encode(tt.inst, tt.ord)
+		if err != nil {
+			t.Errorf("Encode(%v, %v): %v", tt.inst, tt.ord, err)
+			continue
+		}
+		if got, want := hex.EncodeToString(enc), strings.ReplaceAll(tt.want, " ", ""); got != want {
+			t.Errorf("Encode(%v, %v) = %s, want %s", tt.inst, tt.ord, got, want)
+		}
+	}
+
+	for _, inst := range []Inst{
+		{Op: ADDI, Args: Args{R3, R4, Imm(0x8000)}},    // immediate out of range
+		{Op: LD, Args: Args{R3, Offset(6), R1}},        // misaligned offset
+		{Op: B, Args: Args{PCRel(2)}},                  // misaligned target
+		{Op: ADDI, Args: Args{F3, R4, Imm(1)}},         // wrong register kind
+		{Op: ADDI, Args: Args{R3, R4, Imm(1), Imm(1)}}, // extra argument
+		{Op: NOP, Args: Args{R0}},
+		{},
+	} {
+		if enc, err := Encode(inst, binary.BigEndian); err == nil {
+			t.Errorf("Encode(%v) = %x, want error", inst, enc)
+		}
+	}
+}
+
+// TestEncodeAt checks that a prefixed instruction is never placed
+// across a 64-byte boundary, and that a PC-relative one still
+// addresses the same location when a NOP moves it past the boundary.
+func TestEncodeAt(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		pc   uint64
+		want string // BigEndian
+	}{
+		// A prefixed instruction ending at or starting on the boundary
+		// needs no NOP, and an unprefixed one never does.
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R4, Imm(0)}}, 0x38, "04000000 e4640008"},
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R4, Imm(0)}}, 0x40, "04000000 e4640008"},
+		{Inst{Op: LD, Args: Args{R3, Offset(8), R4}}, 0x3c, "e8640008"},
+
+		// The prefix would be at the last word before the boundary.
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R4, Imm(0)}}, 0x3c, "60000000 04000000 e4640008"},
+		{Inst{Op: PMXVF32GERPP, Args: Args{A1, VS2, VS3, Imm(4), Imm(5)}}, 0x7c, "60000000 07900045 ec8218d0"},
+
+		// With R=1, the displacement is relative to the prefix and
+		// loses the 4 bytes of the NOP.
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R0, Imm(1)}}, 0x38, "04100000 e4600008"},
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R0, Imm(1)}}, 0x3c, "60000000 04100000 e4600004"},
+		{Inst{Op: PSTD, Args: Args{R5, Offset(-8), R0, Imm(1)}}, 0xfc, "60000000 0413ffff f4a0fff4"},
+		{Inst{Op: PADDI, Args: Args{R3, R0, Imm(16), Imm(1)}}, 0x1000003c, "60000000 06100000 3860000c"},
+		{Inst{Op: PLD, Args: Args{R3, Offset(-1<<33 + 8), R0, Imm(1)}}, 0x3c, "60000000 04120000 e4600004"},
+	}
+	for _, tt := range tests {
+		for _, ord := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			want, err := hex.DecodeString(strings.ReplaceAll(tt.want, " ", ""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ord == binary.LittleEndian {
+				want = swapWords(want)
+			}
+			enc, err := EncodeAt(tt.inst, ord, tt.pc)
+			if err != nil || !bytes.Equal(enc, want) {
+				t.Errorf("EncodeAt(%v, %v, %#x) = %x, %v, want %x", tt.inst, ord, tt.pc, enc, err, want)
+				continue
+			}
+			for pc, code := tt.pc, enc; len(code) > 0; {
+				inst, err := Decode(code, ord)
+				if err != nil {
+					t.Errorf("EncodeAt(%v, %v, %#x) = %x: decoding at %#x: %v", tt.inst, ord, tt.pc, enc, pc, err)
+					break
+				}
+				if inst.Len == 8 && pc%64 == 60 {
+					t.Errorf("EncodeAt(%v, %v, %#x) = %x: prefixed instruction at %#x crosses a 64-byte boundary", tt.inst, ord, tt.pc, enc, pc)
+				}
+				pc += uint64(inst.Len)
+				code = code[inst.Len:]
+			}
+		}
+	}
+
+	// The prefix alone, up to the boundary, is a truncated instruction.
+	if inst, err := Decode([]byte{0x04, 0x10, 0x00, 0x00}, binary.BigEndian); err != errShort {
+		t.Errorf("Decode(prefix) = %v, %v, want %v", inst, err, errShort)
+	}
+
+	for _, tt := range []struct {
+		inst Inst
+		pc   uint64
+	}{
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R4, Imm(0)}}, 0x3e},        // misaligned address
+		{Inst{Op: PLD, Args: Args{R3, Offset(-1 << 33), R0, Imm(1)}}, 0x3c}, // displacement out of range after the NOP
+		{Inst{Op: PADDI, Args: Args{R3, R0, Imm(-1 << 33), Imm(1)}}, 0x7c},  // likewise
+		{Inst{Op: PLD, Args: Args{R3, Offset(1 << 33), R0, Imm(1)}}, 0x38},  // displacement out of range
+	} {
+		if enc, err := EncodeAt(tt.inst, binary.BigEndian, tt.pc); err == nil {
+			t.Errorf("EncodeAt(%v, %#x) = %x, want error", tt.inst, tt.pc, enc)
+		}
+	}
+}
@@ -46,6 +46,17 @@ func (b BitField) ParseSigned(i [2]uint32) int32 {
 	return u << (32 - b.Bits) >> (32 - b.Bits)
 }
 
+// insert sets the bitfield b of i to the low b.Bits bits of u,
+// the inverse of Parse. insert will panic if b is invalid.
+func (b BitField) insert(i *[2]uint32, u uint32) {
+	if b.Bits > 32 || b.Bits == 0 || b.Offs > 31 || b.Offs+b.Bits > 32 {
+		panic(fmt.Sprintf("invalid bitfiled %v", b))
+	}
+	shift := 32 - b.Offs - b.Bits
+	mask := uint32(1<<b.Bits-1) << shift
+	i[b.Word] = i[b.Word]&^mask | u<<shift&mask
+}
+
 // BitFields is a series of BitFields representing a single number.
 type BitFields []BitField
 
@@ -87,6 +98,15 @@ func (bs BitFields) ParseSigned(i [2]uint32) int64 {
 	return int64(u) << (64 - l) >> (64 - l)
 }
 
+// insert splits the low bits of u into the bitfields of i, the inverse
+// of Parse. insert will panic if any bitfield in b is invalid.
+func (bs BitFields) insert(i *[2]uint32, u uint64) {
+	for j := len(bs) - 1; j >= 0; j-- {
+		bs[j].insert(i, uint32(u))
+		u >>= bs[j].Bits
+	}
+}
+
 // Count the number of bits in the aggregate BitFields
 func (bs BitFields) NumBits() int {
 	num := 0
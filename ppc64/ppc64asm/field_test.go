@@ -105,5 +105,13 @@ func TestBitFields(t *testing.T) {
 		if onb != tst.nb {
 			t.Errorf("case %d: %v.NumBits() returned %d, expected %d", i, tst.b, onb, tst.nb)
 		}
+		if tst.fail {
+			continue
+		}
+		var oi [2]uint32
+		tst.b.insert(&oi, tst.u)
+		if oi != tst.i {
+			t.Errorf("case %d: %v.insert(%d) returned %v, expected %v", i, tst.b, tst.u, oi, tst.i)
+		}
 	}
 }
@@ -7,6 +7,7 @@ package ppc64asm
 import (
 	"bytes"
 	"fmt"
+	"strings"
 )
 
 type Inst struct {
@@ -15,6 +16,7 @@ type Inst struct {
 	Len       int    // Length of encoding in bytes.
 	SuffixEnc uint32 // Raw encoding bits of second word (if Len == 8)
 	Args      Args   // Instruction arguments, in Power ISA manual order.
+	ISA       ISA    // Earliest ISA version which defines the instruction.
 }
 
 func (i Inst) String() string {
@@ -44,6 +46,84 @@ func (o Op) String() string {
 	return opstr[o]
 }
 
+// ISA returns the earliest ISA version which defines the operation.
+func (o Op) ISA() ISA {
+	if int(o) >= len(opisa) {
+		return 0
+	}
+	return opisa[o]
+}
+
+// An ISA is a version of the Power ISA, or of the POWER and PowerPC
+// architectures which preceded it. Each version is a superset of the
+// previous ones, with a few exceptions.
+type ISA uint8
+
+const (
+	_          ISA = iota
+	ISAPower1      // POWER
+	ISAPower2      // POWER2
+	ISAPowerPC     // PowerPC
+	ISAV2_00
+	ISAV2_01
+	ISAV2_02
+	ISAV2_03
+	ISAV2_05
+	ISAV2_06
+	ISAV2_07 // POWER8
+	ISAV3_0
+	ISAV3_0B // POWER9
+	ISAV3_0C
+	ISAV3_1 // POWER10
+	ISAV3_1B
+	ISAV3_1C // POWER11
+)
+
+var isastr = [...]string{
+	ISAPower1:  "P1",
+	ISAPower2:  "P2",
+	ISAPowerPC: "PPC",
+	ISAV2_00:   "v2.00",
+	ISAV2_01:   "v2.01",
+	ISAV2_02:   "v2.02",
+	ISAV2_03:   "v2.03",
+	ISAV2_05:   "v2.05",
+	ISAV2_06:   "v2.06",
+	ISAV2_07:   "v2.07",
+	ISAV3_0:    "v3.0",
+	ISAV3_0B:   "v3.0B",
+	ISAV3_0C:   "v3.0C",
+	ISAV3_1:    "v3.1",
+	ISAV3_1B:   "v3.1B",
+	ISAV3_1C:   "v3.1C",
+}
+
+// String returns the name of the ISA version as used in the Power ISA
+// manual, e.g. "v3.1B".
+func (v ISA) String() string {
+	if int(v) >= len(isastr) || isastr[v] == "" {
+		return fmt.Sprintf("ISA(%d)", int(v))
+	}
+	return isastr[v]
+}
+
+// GoString returns the name of the ISA constant, e.g. "ISAV3_1B".
+func (v ISA) GoString() string {
+	switch v {
+	case ISAPower1:
+		return "ISAPower1"
+	case ISAPower2:
+		return "ISAPower2"
+	case ISAPowerPC:
+		return "ISAPowerPC"
+	}
+	s := v.String()
+	if strings.HasPrefix(s, "v") {
+		return "ISAV" + strings.Replace(s[1:], ".", "_", 1)
+	}
+	return s
+}
+
 // An Arg is a single instruction argument, one of these types: Reg, CondReg, SpReg, Imm, PCRel, Label, or Offset.
 type Arg interface {
 	IsArg()
@@ -229,6 +309,14 @@ const (
 	A5
 	A6
 	A7
+	DMR0 // Dense math registers, 1024 bits each.
+	DMR1
+	DMR2
+	DMR3
+	DMR4
+	DMR5
+	DMR6
+	DMR7
 )
 
 func (Reg) IsArg() {}
@@ -244,6 +332,8 @@ func (r Reg) String() string {
 		return fmt.Sprintf("vs%d", int(r-VS0))
 	case A0 <= r && r <= A7:
 		return fmt.Sprintf("a%d", int(r-A0))
+	case DMR0 <= r && r <= DMR7:
+		return fmt.Sprintf("dm%d", int(r-DMR0))
 	default:
 		return fmt.Sprintf("Reg(%d)", int(r))
 	}
@@ -4,6 +4,42 @@ package ppc64asm
 
 const (
 	_ Op = iota
+	DMXXEXTFDMR512
+	DMXXINSTDMR512
+	DMMR
+	DMXOR
+	DMSETDMRZ
+	DMXXEXTFDMR256
+	DMXXINSTDMR256
+	DMXVBF16GERX2
+	PMDMXVBF16GERX2
+	DMXVBF16GERX2PP
+	PMDMXVBF16GERX2PP
+	DMXVBF16GERX2PN
+	PMDMXVBF16GERX2PN
+	DMXVBF16GERX2NP
+	PMDMXVBF16GERX2NP
+	DMXVBF16GERX2NN
+	PMDMXVBF16GERX2NN
+	DMXVF16GERX2
+	PMDMXVF16GERX2
+	DMXVF16GERX2PP
+	PMDMXVF16GERX2PP
+	DMXVF16GERX2PN
+	PMDMXVF16GERX2PN
+	DMXVF16GERX2NP
+	PMDMXVF16GERX2NP
+	DMXVF16GERX2NN
+	PMDMXVF16GERX2NN
+	DMXVI8GERX4
+	PMDMXVI8GERX4
+	DMXVI8GERX4PP
+	PMDMXVI8GERX4PP
+	DMXVI8GERX4SPP
+	PMDMXVI8GERX4SPP
+	DMSHA2HASH
+	DMSHA3HASH
+	DMXXSHAPAD
 	HASHCHK
 	HASHCHKP
 	HASHST
@@ -245,6 +281,7 @@ const (
 	XXGENPCVDM
 	XXGENPCVHM
 	XXGENPCVWM
+	DMXXMFACC
 	XXMFACC
 	XXMTACC
 	XXPERMX
@@ -1424,1426 +1461,2940 @@ const (
 )
 
 var opstr = [...]string{
-	HASHCHK:        "hashchk",
-	HASHCHKP:       "hashchkp",
-	HASHST:         "hashst",
-	HASHSTP:        "hashstp",
-	BRD:            "brd",
-	BRH:            "brh",
-	BRW:            "brw",
-	CFUGED:         "cfuged",
-	CNTLZDM:        "cntlzdm",
-	CNTTZDM:        "cnttzdm",
-	DCFFIXQQ:       "dcffixqq",
-	DCTFIXQQ:       "dctfixqq",
-	LXVKQ:          "lxvkq",
-	LXVP:           "lxvp",
-	LXVPX:          "lxvpx",
-	LXVRBX:         "lxvrbx",
-	LXVRDX:         "lxvrdx",
-	LXVRHX:         "lxvrhx",
-	LXVRWX:         "lxvrwx",
-	MTVSRBM:        "mtvsrbm",
-	MTVSRBMI:       "mtvsrbmi",
-	MTVSRDM:        "mtvsrdm",
-	MTVSRHM:        "mtvsrhm",
-	MTVSRQM:        "mtvsrqm",
-	MTVSRWM:        "mtvsrwm",
-	PADDI:          "paddi",
-	PDEPD:          "pdepd",
-	PEXTD:          "pextd",
-	PLBZ:           "plbz",
-	PLD:            "pld",
-	PLFD:           "plfd",
-	PLFS:           "plfs",
-	PLHA:           "plha",
-	PLHZ:           "plhz",
-	PLQ:            "plq",
-	PLWA:           "plwa",
-	PLWZ:           "plwz",
-	PLXSD:          "plxsd",
-	PLXSSP:         "plxssp",
-	PLXV:           "plxv",
-	PLXVP:          "plxvp",
-	PMXVBF16GER2:   "pmxvbf16ger2",
-	PMXVBF16GER2NN: "pmxvbf16ger2nn",
-	PMXVBF16GER2NP: "pmxvbf16ger2np",
-	PMXVBF16GER2PN: "pmxvbf16ger2pn",
-	PMXVBF16GER2PP: "pmxvbf16ger2pp",
-	PMXVF16GER2:    "pmxvf16ger2",
-	PMXVF16GER2NN:  "pmxvf16ger2nn",
-	PMXVF16GER2NP:  "pmxvf16ger2np",
-	PMXVF16GER2PN:  "pmxvf16ger2pn",
-	PMXVF16GER2PP:  "pmxvf16ger2pp",
-	PMXVF32GER:     "pmxvf32ger",
-	PMXVF32GERNN:   "pmxvf32gernn",
-	PMXVF32GERNP:   "pmxvf32gernp",
-	PMXVF32GERPN:   "pmxvf32gerpn",
-	PMXVF32GERPP:   "pmxvf32gerpp",
-	PMXVF64GER:     "pmxvf64ger",
-	PMXVF64GERNN:   "pmxvf64gernn",
-	PMXVF64GERNP:   "pmxvf64gernp",
-	PMXVF64GERPN:   "pmxvf64gerpn",
-	PMXVF64GERPP:   "pmxvf64gerpp",
-	PMXVI16GER2:    "pmxvi16ger2",
-	PMXVI16GER2PP:  "pmxvi16ger2pp",
-	PMXVI16GER2S:   "pmxvi16ger2s",
-	PMXVI16GER2SPP: "pmxvi16ger2spp",
-	PMXVI4GER8:     "pmxvi4ger8",
-	PMXVI4GER8PP:   "pmxvi4ger8pp",
-	PMXVI8GER4:     "pmxvi8ger4",
-	PMXVI8GER4PP:   "pmxvi8ger4pp",
-	PMXVI8GER4SPP:  "pmxvi8ger4spp",
-	PNOP:           "pnop",
-	PSTB:           "pstb",
-	PSTD:           "pstd",
-	PSTFD:          "pstfd",
-	PSTFS:          "pstfs",
-	PSTH:           "psth",
-	PSTQ:           "pstq",
-	PSTW:           "pstw",
-	PSTXSD:         "pstxsd",
-	PSTXSSP:        "pstxssp",
-	PSTXV:          "pstxv",
-	PSTXVP:         "pstxvp",
-	SETBC:          "setbc",
-	SETBCR:         "setbcr",
-	SETNBC:         "setnbc",
-	SETNBCR:        "setnbcr",
-	STXVP:          "stxvp",
-	STXVPX:         "stxvpx",
-	STXVRBX:        "stxvrbx",
-	STXVRDX:        "stxvrdx",
-	STXVRHX:        "stxvrhx",
-	STXVRWX:        "stxvrwx",
-	VCFUGED:        "vcfuged",
-	VCLRLB:         "vclrlb",
-	VCLRRB:         "vclrrb",
-	VCLZDM:         "vclzdm",
-	VCMPEQUQ:       "vcmpequq",
-	VCMPEQUQCC:     "vcmpequq.",
-	VCMPGTSQ:       "vcmpgtsq",
-	VCMPGTSQCC:     "vcmpgtsq.",
-	VCMPGTUQ:       "vcmpgtuq",
-	VCMPGTUQCC:     "vcmpgtuq.",
-	VCMPSQ:         "vcmpsq",
-	VCMPUQ:         "vcmpuq",
-	VCNTMBB:        "vcntmbb",
-	VCNTMBD:        "vcntmbd",
-	VCNTMBH:        "vcntmbh",
-	VCNTMBW:        "vcntmbw",
-	VCTZDM:         "vctzdm",
-	VDIVESD:        "vdivesd",
-	VDIVESQ:        "vdivesq",
-	VDIVESW:        "vdivesw",
-	VDIVEUD:        "vdiveud",
-	VDIVEUQ:        "vdiveuq",
-	VDIVEUW:        "vdiveuw",
-	VDIVSD:         "vdivsd",
-	VDIVSQ:         "vdivsq",
-	VDIVSW:         "vdivsw",
-	VDIVUD:         "vdivud",
-	VDIVUQ:         "vdivuq",
-	VDIVUW:         "vdivuw",
-	VEXPANDBM:      "vexpandbm",
-	VEXPANDDM:      "vexpanddm",
-	VEXPANDHM:      "vexpandhm",
-	VEXPANDQM:      "vexpandqm",
-	VEXPANDWM:      "vexpandwm",
-	VEXTDDVLX:      "vextddvlx",
-	VEXTDDVRX:      "vextddvrx",
-	VEXTDUBVLX:     "vextdubvlx",
-	VEXTDUBVRX:     "vextdubvrx",
-	VEXTDUHVLX:     "vextduhvlx",
-	VEXTDUHVRX:     "vextduhvrx",
-	VEXTDUWVLX:     "vextduwvlx",
-	VEXTDUWVRX:     "vextduwvrx",
-	VEXTRACTBM:     "vextractbm",
-	VEXTRACTDM:     "vextractdm",
-	VEXTRACTHM:     "vextracthm",
-	VEXTRACTQM:     "vextractqm",
-	VEXTRACTWM:     "vextractwm",
-	VEXTSD2Q:       "vextsd2q",
-	VGNB:           "vgnb",
-	VINSBLX:        "vinsblx",
-	VINSBRX:        "vinsbrx",
-	VINSBVLX:       "vinsbvlx",
-	VINSBVRX:       "vinsbvrx",
-	VINSD:          "vinsd",
-	VINSDLX:        "vinsdlx",
-	VINSDRX:        "vinsdrx",
-	VINSHLX:        "vinshlx",
-	VINSHRX:        "vinshrx",
-	VINSHVLX:       "vinshvlx",
-	VINSHVRX:       "vinshvrx",
-	VINSW:          "vinsw",
-	VINSWLX:        "vinswlx",
-	VINSWRX:        "vinswrx",
-	VINSWVLX:       "vinswvlx",
-	VINSWVRX:       "vinswvrx",
-	VMODSD:         "vmodsd",
-	VMODSQ:         "vmodsq",
-	VMODSW:         "vmodsw",
-	VMODUD:         "vmodud",
-	VMODUQ:         "vmoduq",
-	VMODUW:         "vmoduw",
-	VMSUMCUD:       "vmsumcud",
-	VMULESD:        "vmulesd",
-	VMULEUD:        "vmuleud",
-	VMULHSD:        "vmulhsd",
-	VMULHSW:        "vmulhsw",
-	VMULHUD:        "vmulhud",
-	VMULHUW:        "vmulhuw",
-	VMULLD:         "vmulld",
-	VMULOSD:        "vmulosd",
-	VMULOUD:        "vmuloud",
-	VPDEPD:         "vpdepd",
-	VPEXTD:         "vpextd",
-	VRLQ:           "vrlq",
-	VRLQMI:         "vrlqmi",
-	VRLQNM:         "vrlqnm",
-	VSLDBI:         "vsldbi",
-	VSLQ:           "vslq",
-	VSRAQ:          "vsraq",
-	VSRDBI:         "vsrdbi",
-	VSRQ:           "vsrq",
-	VSTRIBL:        "vstribl",
-	VSTRIBLCC:      "vstribl.",
-	VSTRIBR:        "vstribr",
-	VSTRIBRCC:      "vstribr.",
-	VSTRIHL:        "vstrihl",
-	VSTRIHLCC:      "vstrihl.",
-	VSTRIHR:        "vstrihr",
-	VSTRIHRCC:      "vstrihr.",
-	XSCMPEQQP:      "xscmpeqqp",
-	XSCMPGEQP:      "xscmpgeqp",
-	XSCMPGTQP:      "xscmpgtqp",
-	XSCVQPSQZ:      "xscvqpsqz",
-	XSCVQPUQZ:      "xscvqpuqz",
-	XSCVSQQP:       "xscvsqqp",
-	XSCVUQQP:       "xscvuqqp",
-	XSMAXCQP:       "xsmaxcqp",
-	XSMINCQP:       "xsmincqp",
-	XVBF16GER2:     "xvbf16ger2",
-	XVBF16GER2NN:   "xvbf16ger2nn",
-	XVBF16GER2NP:   "xvbf16ger2np",
-	XVBF16GER2PN:   "xvbf16ger2pn",
-	XVBF16GER2PP:   "xvbf16ger2pp",
-	XVCVBF16SPN:    "xvcvbf16spn",
-	XVCVSPBF16:     "xvcvspbf16",
-	XVF16GER2:      "xvf16ger2",
-	XVF16GER2NN:    "xvf16ger2nn",
-	XVF16GER2NP:    "xvf16ger2np",
-	XVF16GER2PN:    "xvf16ger2pn",
-	XVF16GER2PP:    "xvf16ger2pp",
-	XVF32GER:       "xvf32ger",
-	XVF32GERNN:     "xvf32gernn",
-	XVF32GERNP:     "xvf32gernp",
-	XVF32GERPN:     "xvf32gerpn",
-	XVF32GERPP:     "xvf32gerpp",
-	XVF64GER:       "xvf64ger",
-	XVF64GERNN:     "xvf64gernn",
-	XVF64GERNP:     "xvf64gernp",
-	XVF64GERPN:     "xvf64gerpn",
-	XVF64GERPP:     "xvf64gerpp",
-	XVI16GER2:      "xvi16ger2",
-	XVI16GER2PP:    "xvi16ger2pp",
-	XVI16GER2S:     "xvi16ger2s",
-	XVI16GER2SPP:   "xvi16ger2spp",
-	XVI4GER8:       "xvi4ger8",
-	XVI4GER8PP:     "xvi4ger8pp",
-	XVI8GER4:       "xvi8ger4",
-	XVI8GER4PP:     "xvi8ger4pp",
-	XVI8GER4SPP:    "xvi8ger4spp",
-	XVTLSBB:        "xvtlsbb",
-	XXBLENDVB:      "xxblendvb",
-	XXBLENDVD:      "xxblendvd",
-	XXBLENDVH:      "xxblendvh",
-	XXBLENDVW:      "xxblendvw",
-	XXEVAL:         "xxeval",
-	XXGENPCVBM:     "xxgenpcvbm",
-	XXGENPCVDM:     "xxgenpcvdm",
-	XXGENPCVHM:     "xxgenpcvhm",
-	XXGENPCVWM:     "xxgenpcvwm",
-	XXMFACC:        "xxmfacc",
-	XXMTACC:        "xxmtacc",
-	XXPERMX:        "xxpermx",
-	XXSETACCZ:      "xxsetaccz",
-	XXSPLTI32DX:    "xxsplti32dx",
-	XXSPLTIDP:      "xxspltidp",
-	XXSPLTIW:       "xxspltiw",
-	MSGCLRU:        "msgclru",
-	MSGSNDU:        "msgsndu",
-	URFID:          "urfid",
-	ADDEX:          "addex",
-	MFFSCDRN:       "mffscdrn",
-	MFFSCDRNI:      "mffscdrni",
-	MFFSCE:         "mffsce",
-	MFFSCRN:        "mffscrn",
-	MFFSCRNI:       "mffscrni",
-	MFFSL:          "mffsl",
-	SLBIAG:         "slbiag",
-	VMSUMUDM:       "vmsumudm",
-	ADDPCIS:        "addpcis",
-	BCDCFNCC:       "bcdcfn.",
-	BCDCFSQCC:      "bcdcfsq.",
-	BCDCFZCC:       "bcdcfz.",
-	BCDCPSGNCC:     "bcdcpsgn.",
-	BCDCTNCC:       "bcdctn.",
-	BCDCTSQCC:      "bcdctsq.",
-	BCDCTZCC:       "bcdctz.",
-	BCDSCC:         "bcds.",
-	BCDSETSGNCC:    "bcdsetsgn.",
-	BCDSRCC:        "bcdsr.",
-	BCDTRUNCCC:     "bcdtrunc.",
-	BCDUSCC:        "bcdus.",
-	BCDUTRUNCCC:    "bcdutrunc.",
-	CMPEQB:         "cmpeqb",
-	CMPRB:          "cmprb",
-	CNTTZD:         "cnttzd",
-	CNTTZDCC:       "cnttzd.",
-	CNTTZW:         "cnttzw",
-	CNTTZWCC:       "cnttzw.",
-	COPY:           "copy",
-	CPABORT:        "cpabort",
-	DARN:           "darn",
-	DTSTSFI:        "dtstsfi",
-	DTSTSFIQ:       "dtstsfiq",
-	EXTSWSLI:       "extswsli",
-	EXTSWSLICC:     "extswsli.",
-	LDAT:           "ldat",
-	LWAT:           "lwat",
-	LXSD:           "lxsd",
-	LXSIBZX:        "lxsibzx",
-	LXSIHZX:        "lxsihzx",
-	LXSSP:          "lxssp",
-	LXV:            "lxv",
-	LXVB16X:        "lxvb16x",
-	LXVH8X:         "lxvh8x",
-	LXVL:           "lxvl",
-	LXVLL:          "lxvll",
-	LXVWSX:         "lxvwsx",
-	LXVX:           "lxvx",
-	MADDHD:         "maddhd",
-	MADDHDU:        "maddhdu",
-	MADDLD:         "maddld",
-	MCRXRX:         "mcrxrx",
-	MFVSRLD:        "mfvsrld",
-	MODSD:          "modsd",
-	MODSW:          "modsw",
-	MODUD:          "modud",
-	MODUW:          "moduw",
-	MSGSYNC:        "msgsync",
-	MTVSRDD:        "mtvsrdd",
-	MTVSRWS:        "mtvsrws",
-	PASTECC:        "paste.",
-	SETB:           "setb",
-	SLBIEG:         "slbieg",
-	SLBSYNC:        "slbsync",
-	STDAT:          "stdat",
-	STOP:           "stop",
-	STWAT:          "stwat",
-	STXSD:          "stxsd",
-	STXSIBX:        "stxsibx",
-	STXSIHX:        "stxsihx",
-	STXSSP:         "stxssp",
-	STXV:           "stxv",
-	STXVB16X:       "stxvb16x",
-	STXVH8X:        "stxvh8x",
-	STXVL:          "stxvl",
-	STXVLL:         "stxvll",
-	STXVX:          "stxvx",
-	VABSDUB:        "vabsdub",
-	VABSDUH:        "vabsduh",
-	VABSDUW:        "vabsduw",
-	VBPERMD:        "vbpermd",
-	VCLZLSBB:       "vclzlsbb",
-	VCMPNEB:        "vcmpneb",
-	VCMPNEBCC:      "vcmpneb.",
-	VCMPNEH:        "vcmpneh",
-	VCMPNEHCC:      "vcmpneh.",
-	VCMPNEW:        "vcmpnew",
-	VCMPNEWCC:      "vcmpnew.",
-	VCMPNEZB:       "vcmpnezb",
-	VCMPNEZBCC:     "vcmpnezb.",
-	VCMPNEZH:       "vcmpnezh",
-	VCMPNEZHCC:     "vcmpnezh.",
-	VCMPNEZW:       "vcmpnezw",
-	VCMPNEZWCC:     "vcmpnezw.",
-	VCTZB:          "vctzb",
-	VCTZD:          "vctzd",
-	VCTZH:          "vctzh",
-	VCTZLSBB:       "vctzlsbb",
-	VCTZW:          "vctzw",
-	VEXTRACTD:      "vextractd",
-	VEXTRACTUB:     "vextractub",
-	VEXTRACTUH:     "vextractuh",
-	VEXTRACTUW:     "vextractuw",
-	VEXTSB2D:       "vextsb2d",
-	VEXTSB2W:       "vextsb2w",
-	VEXTSH2D:       "vextsh2d",
-	VEXTSH2W:       "vextsh2w",
-	VEXTSW2D:       "vextsw2d",
-	VEXTUBLX:       "vextublx",
-	VEXTUBRX:       "vextubrx",
-	VEXTUHLX:       "vextuhlx",
-	VEXTUHRX:       "vextuhrx",
-	VEXTUWLX:       "vextuwlx",
-	VEXTUWRX:       "vextuwrx",
-	VINSERTB:       "vinsertb",
-	VINSERTD:       "vinsertd",
-	VINSERTH:       "vinserth",
-	VINSERTW:       "vinsertw",
-	VMUL10CUQ:      "vmul10cuq",
-	VMUL10ECUQ:     "vmul10ecuq",
-	VMUL10EUQ:      "vmul10euq",
-	VMUL10UQ:       "vmul10uq",
-	VNEGD:          "vnegd",
-	VNEGW:          "vnegw",
-	VPERMR:         "vpermr",
-	VPRTYBD:        "vprtybd",
-	VPRTYBQ:        "vprtybq",
-	VPRTYBW:        "vprtybw",
-	VRLDMI:         "vrldmi",
-	VRLDNM:         "vrldnm",
-	VRLWMI:         "vrlwmi",
-	VRLWNM:         "vrlwnm",
-	VSLV:           "vslv",
-	VSRV:           "vsrv",
-	WAIT:           "wait",
-	XSABSQP:        "xsabsqp",
-	XSADDQP:        "xsaddqp",
-	XSADDQPO:       "xsaddqpo",
-	XSCMPEQDP:      "xscmpeqdp",
-	XSCMPEXPDP:     "xscmpexpdp",
-	XSCMPEXPQP:     "xscmpexpqp",
-	XSCMPGEDP:      "xscmpgedp",
-	XSCMPGTDP:      "xscmpgtdp",
-	XSCMPOQP:       "xscmpoqp",
-	XSCMPUQP:       "xscmpuqp",
-	XSCPSGNQP:      "xscpsgnqp",
-	XSCVDPHP:       "xscvdphp",
-	XSCVDPQP:       "xscvdpqp",
-	XSCVHPDP:       "xscvhpdp",
-	XSCVQPDP:       "xscvqpdp",
-	XSCVQPDPO:      "xscvqpdpo",
-	XSCVQPSDZ:      "xscvqpsdz",
-	XSCVQPSWZ:      "xscvqpswz",
-	XSCVQPUDZ:      "xscvqpudz",
-	XSCVQPUWZ:      "xscvqpuwz",
-	XSCVSDQP:       "xscvsdqp",
-	XSCVUDQP:       "xscvudqp",
-	XSDIVQP:        "xsdivqp",
-	XSDIVQPO:       "xsdivqpo",
-	XSIEXPDP:       "xsiexpdp",
-	XSIEXPQP:       "xsiexpqp",
-	XSMADDQP:       "xsmaddqp",
-	XSMADDQPO:      "xsmaddqpo",
-	XSMAXCDP:       "xsmaxcdp",
-	XSMAXJDP:       "xsmaxjdp",
-	XSMINCDP:       "xsmincdp",
-	XSMINJDP:       "xsminjdp",
-	XSMSUBQP:       "xsmsubqp",
-	XSMSUBQPO:      "xsmsubqpo",
-	XSMULQP:        "xsmulqp",
-	XSMULQPO:       "xsmulqpo",
-	XSNABSQP:       "xsnabsqp",
-	XSNEGQP:        "xsnegqp",
-	XSNMADDQP:      "xsnmaddqp",
-	XSNMADDQPO:     "xsnmaddqpo",
-	XSNMSUBQP:      "xsnmsubqp",
-	XSNMSUBQPO:     "xsnmsubqpo",
-	XSRQPI:         "xsrqpi",
-	XSRQPIX:        "xsrqpix",
-	XSRQPXP:        "xsrqpxp",
-	XSSQRTQP:       "xssqrtqp",
-	XSSQRTQPO:      "xssqrtqpo",
-	XSSUBQP:        "xssubqp",
-	XSSUBQPO:       "xssubqpo",
-	XSTSTDCDP:      "xststdcdp",
-	XSTSTDCQP:      "xststdcqp",
-	XSTSTDCSP:      "xststdcsp",
-	XSXEXPDP:       "xsxexpdp",
-	XSXEXPQP:       "xsxexpqp",
-	XSXSIGDP:       "xsxsigdp",
-	XSXSIGQP:       "xsxsigqp",
-	XVCVHPSP:       "xvcvhpsp",
-	XVCVSPHP:       "xvcvsphp",
-	XVIEXPDP:       "xviexpdp",
-	XVIEXPSP:       "xviexpsp",
-	XVTSTDCDP:      "xvtstdcdp",
-	XVTSTDCSP:      "xvtstdcsp",
-	XVXEXPDP:       "xvxexpdp",
-	XVXEXPSP:       "xvxexpsp",
-	XVXSIGDP:       "xvxsigdp",
-	XVXSIGSP:       "xvxsigsp",
-	XXBRD:          "xxbrd",
-	XXBRH:          "xxbrh",
-	XXBRQ:          "xxbrq",
-	XXBRW:          "xxbrw",
-	XXEXTRACTUW:    "xxextractuw",
-	XXINSERTW:      "xxinsertw",
-	XXPERM:         "xxperm",
-	XXPERMR:        "xxpermr",
-	XXSPLTIB:       "xxspltib",
-	BCDADDCC:       "bcdadd.",
-	BCDSUBCC:       "bcdsub.",
-	BCTAR:          "bctar",
-	BCTARL:         "bctarl",
-	CLRBHRB:        "clrbhrb",
-	FMRGEW:         "fmrgew",
-	FMRGOW:         "fmrgow",
-	ICBT:           "icbt",
-	LQARX:          "lqarx",
-	LXSIWAX:        "lxsiwax",
-	LXSIWZX:        "lxsiwzx",
-	LXSSPX:         "lxsspx",
-	MFBHRBE:        "mfbhrbe",
-	MFVSRD:         "mfvsrd",
-	MFVSRWZ:        "mfvsrwz",
-	MSGCLR:         "msgclr",
-	MSGCLRP:        "msgclrp",
-	MSGSND:         "msgsnd",
-	MSGSNDP:        "msgsndp",
-	MTVSRD:         "mtvsrd",
-	MTVSRWA:        "mtvsrwa",
-	MTVSRWZ:        "mtvsrwz",
-	RFEBB:          "rfebb",
-	STQCXCC:        "stqcx.",
-	STXSIWX:        "stxsiwx",
-	STXSSPX:        "stxsspx",
-	VADDCUQ:        "vaddcuq",
-	VADDECUQ:       "vaddecuq",
-	VADDEUQM:       "vaddeuqm",
-	VADDUDM:        "vaddudm",
-	VADDUQM:        "vadduqm",
-	VBPERMQ:        "vbpermq",
-	VCIPHER:        "vcipher",
-	VCIPHERLAST:    "vcipherlast",
-	VCLZB:          "vclzb",
-	VCLZD:          "vclzd",
-	VCLZH:          "vclzh",
-	VCLZW:          "vclzw",
-	VCMPEQUD:       "vcmpequd",
-	VCMPEQUDCC:     "vcmpequd.",
-	VCMPGTSD:       "vcmpgtsd",
-	VCMPGTSDCC:     "vcmpgtsd.",
-	VCMPGTUD:       "vcmpgtud",
-	VCMPGTUDCC:     "vcmpgtud.",
-	VEQV:           "veqv",
-	VGBBD:          "vgbbd",
-	VMAXSD:         "vmaxsd",
-	VMAXUD:         "vmaxud",
-	VMINSD:         "vminsd",
-	VMINUD:         "vminud",
-	VMRGEW:         "vmrgew",
-	VMRGOW:         "vmrgow",
-	VMULESW:        "vmulesw",
-	VMULEUW:        "vmuleuw",
-	VMULOSW:        "vmulosw",
-	VMULOUW:        "vmulouw",
-	VMULUWM:        "vmuluwm",
-	VNAND:          "vnand",
-	VNCIPHER:       "vncipher",
-	VNCIPHERLAST:   "vncipherlast",
-	VORC:           "vorc",
-	VPERMXOR:       "vpermxor",
-	VPKSDSS:        "vpksdss",
-	VPKSDUS:        "vpksdus",
-	VPKUDUM:        "vpkudum",
-	VPKUDUS:        "vpkudus",
-	VPMSUMB:        "vpmsumb",
-	VPMSUMD:        "vpmsumd",
-	VPMSUMH:        "vpmsumh",
-	VPMSUMW:        "vpmsumw",
-	VPOPCNTB:       "vpopcntb",
-	VPOPCNTD:       "vpopcntd",
-	VPOPCNTH:       "vpopcnth",
-	VPOPCNTW:       "vpopcntw",
-	VRLD:           "vrld",
-	VSBOX:          "vsbox",
-	VSHASIGMAD:     "vshasigmad",
-	VSHASIGMAW:     "vshasigmaw",
-	VSLD:           "vsld",
-	VSRAD:          "vsrad",
-	VSRD:           "vsrd",
-	VSUBCUQ:        "vsubcuq",
-	VSUBECUQ:       "vsubecuq",
-	VSUBEUQM:       "vsubeuqm",
-	VSUBUDM:        "vsubudm",
-	VSUBUQM:        "vsubuqm",
-	VUPKHSW:        "vupkhsw",
-	VUPKLSW:        "vupklsw",
-	XSADDSP:        "xsaddsp",
-	XSCVDPSPN:      "xscvdpspn",
-	XSCVSPDPN:      "xscvspdpn",
-	XSCVSXDSP:      "xscvsxdsp",
-	XSCVUXDSP:      "xscvuxdsp",
-	XSDIVSP:        "xsdivsp",
-	XSMADDASP:      "xsmaddasp",
-	XSMADDMSP:      "xsmaddmsp",
-	XSMSUBASP:      "xsmsubasp",
-	XSMSUBMSP:      "xsmsubmsp",
-	XSMULSP:        "xsmulsp",
-	XSNMADDASP:     "xsnmaddasp",
-	XSNMADDMSP:     "xsnmaddmsp",
-	XSNMSUBASP:     "xsnmsubasp",
-	XSNMSUBMSP:     "xsnmsubmsp",
-	XSRESP:         "xsresp",
-	XSRSP:          "xsrsp",
-	XSRSQRTESP:     "xsrsqrtesp",
-	XSSQRTSP:       "xssqrtsp",
-	XSSUBSP:        "xssubsp",
-	XXLEQV:         "xxleqv",
-	XXLNAND:        "xxlnand",
-	XXLORC:         "xxlorc",
-	ADDG6S:         "addg6s",
-	BPERMD:         "bpermd",
-	CBCDTD:         "cbcdtd",
-	CDTBCD:         "cdtbcd",
-	DCFFIX:         "dcffix",
-	DCFFIXCC:       "dcffix.",
-	DIVDE:          "divde",
-	DIVDECC:        "divde.",
-	DIVDEO:         "divdeo",
-	DIVDEOCC:       "divdeo.",
-	DIVDEU:         "divdeu",
-	DIVDEUCC:       "divdeu.",
-	DIVDEUO:        "divdeuo",
-	DIVDEUOCC:      "divdeuo.",
-	DIVWE:          "divwe",
-	DIVWECC:        "divwe.",
-	DIVWEO:         "divweo",
-	DIVWEOCC:       "divweo.",
-	DIVWEU:         "divweu",
-	DIVWEUCC:       "divweu.",
-	DIVWEUO:        "divweuo",
-	DIVWEUOCC:      "divweuo.",
-	FCFIDS:         "fcfids",
-	FCFIDSCC:       "fcfids.",
-	FCFIDU:         "fcfidu",
-	FCFIDUCC:       "fcfidu.",
-	FCFIDUS:        "fcfidus",
-	FCFIDUSCC:      "fcfidus.",
-	FCTIDU:         "fctidu",
-	FCTIDUCC:       "fctidu.",
-	FCTIDUZ:        "fctiduz",
-	FCTIDUZCC:      "fctiduz.",
-	FCTIWU:         "fctiwu",
-	FCTIWUCC:       "fctiwu.",
-	FCTIWUZ:        "fctiwuz",
-	FCTIWUZCC:      "fctiwuz.",
-	FTDIV:          "ftdiv",
-	FTSQRT:         "ftsqrt",
-	LBARX:          "lbarx",
-	LDBRX:          "ldbrx",
-	LFIWZX:         "lfiwzx",
-	LHARX:          "lharx",
-	LXSDX:          "lxsdx",
-	LXVD2X:         "lxvd2x",
-	LXVDSX:         "lxvdsx",
-	LXVW4X:         "lxvw4x",
-	POPCNTD:        "popcntd",
-	POPCNTW:        "popcntw",
-	STBCXCC:        "stbcx.",
-	STDBRX:         "stdbrx",
-	STHCXCC:        "sthcx.",
-	STXSDX:         "stxsdx",
-	STXVD2X:        "stxvd2x",
-	STXVW4X:        "stxvw4x",
-	XSABSDP:        "xsabsdp",
-	XSADDDP:        "xsadddp",
-	XSCMPODP:       "xscmpodp",
-	XSCMPUDP:       "xscmpudp",
-	XSCPSGNDP:      "xscpsgndp",
-	XSCVDPSP:       "xscvdpsp",
-	XSCVDPSXDS:     "xscvdpsxds",
-	XSCVDPSXWS:     "xscvdpsxws",
-	XSCVDPUXDS:     "xscvdpuxds",
-	XSCVDPUXWS:     "xscvdpuxws",
-	XSCVSPDP:       "xscvspdp",
-	XSCVSXDDP:      "xscvsxddp",
-	XSCVUXDDP:      "xscvuxddp",
-	XSDIVDP:        "xsdivdp",
-	XSMADDADP:      "xsmaddadp",
-	XSMADDMDP:      "xsmaddmdp",
-	XSMAXDP:        "xsmaxdp",
-	XSMINDP:        "xsmindp",
-	XSMSUBADP:      "xsmsubadp",
-	XSMSUBMDP:      "xsmsubmdp",
-	XSMULDP:        "xsmuldp",
-	XSNABSDP:       "xsnabsdp",
-	XSNEGDP:        "xsnegdp",
-	XSNMADDADP:     "xsnmaddadp",
-	XSNMADDMDP:     "xsnmaddmdp",
-	XSNMSUBADP:     "xsnmsubadp",
-	XSNMSUBMDP:     "xsnmsubmdp",
-	XSRDPI:         "xsrdpi",
-	XSRDPIC:        "xsrdpic",
-	XSRDPIM:        "xsrdpim",
-	XSRDPIP:        "xsrdpip",
-	XSRDPIZ:        "xsrdpiz",
-	XSREDP:         "xsredp",
-	XSRSQRTEDP:     "xsrsqrtedp",
-	XSSQRTDP:       "xssqrtdp",
-	XSSUBDP:        "xssubdp",
-	XSTDIVDP:       "xstdivdp",
-	XSTSQRTDP:      "xstsqrtdp",
-	XVABSDP:        "xvabsdp",
-	XVABSSP:        "xvabssp",
-	XVADDDP:        "xvadddp",
-	XVADDSP:        "xvaddsp",
-	XVCMPEQDP:      "xvcmpeqdp",
-	XVCMPEQDPCC:    "xvcmpeqdp.",
-	XVCMPEQSP:      "xvcmpeqsp",
-	XVCMPEQSPCC:    "xvcmpeqsp.",
-	XVCMPGEDP:      "xvcmpgedp",
-	XVCMPGEDPCC:    "xvcmpgedp.",
-	XVCMPGESP:      "xvcmpgesp",
-	XVCMPGESPCC:    "xvcmpgesp.",
-	XVCMPGTDP:      "xvcmpgtdp",
-	XVCMPGTDPCC:    "xvcmpgtdp.",
-	XVCMPGTSP:      "xvcmpgtsp",
-	XVCMPGTSPCC:    "xvcmpgtsp.",
-	XVCPSGNDP:      "xvcpsgndp",
-	XVCPSGNSP:      "xvcpsgnsp",
-	XVCVDPSP:       "xvcvdpsp",
-	XVCVDPSXDS:     "xvcvdpsxds",
-	XVCVDPSXWS:     "xvcvdpsxws",
-	XVCVDPUXDS:     "xvcvdpuxds",
-	XVCVDPUXWS:     "xvcvdpuxws",
-	XVCVSPDP:       "xvcvspdp",
-	XVCVSPSXDS:     "xvcvspsxds",
-	XVCVSPSXWS:     "xvcvspsxws",
-	XVCVSPUXDS:     "xvcvspuxds",
-	XVCVSPUXWS:     "xvcvspuxws",
-	XVCVSXDDP:      "xvcvsxddp",
-	XVCVSXDSP:      "xvcvsxdsp",
-	XVCVSXWDP:      "xvcvsxwdp",
-	XVCVSXWSP:      "xvcvsxwsp",
-	XVCVUXDDP:      "xvcvuxddp",
-	XVCVUXDSP:      "xvcvuxdsp",
-	XVCVUXWDP:      "xvcvuxwdp",
-	XVCVUXWSP:      "xvcvuxwsp",
-	XVDIVDP:        "xvdivdp",
-	XVDIVSP:        "xvdivsp",
-	XVMADDADP:      "xvmaddadp",
-	XVMADDASP:      "xvmaddasp",
-	XVMADDMDP:      "xvmaddmdp",
-	XVMADDMSP:      "xvmaddmsp",
-	XVMAXDP:        "xvmaxdp",
-	XVMAXSP:        "xvmaxsp",
-	XVMINDP:        "xvmindp",
-	XVMINSP:        "xvminsp",
-	XVMSUBADP:      "xvmsubadp",
-	XVMSUBASP:      "xvmsubasp",
-	XVMSUBMDP:      "xvmsubmdp",
-	XVMSUBMSP:      "xvmsubmsp",
-	XVMULDP:        "xvmuldp",
-	XVMULSP:        "xvmulsp",
-	XVNABSDP:       "xvnabsdp",
-	XVNABSSP:       "xvnabssp",
-	XVNEGDP:        "xvnegdp",
-	XVNEGSP:        "xvnegsp",
-	XVNMADDADP:     "xvnmaddadp",
-	XVNMADDASP:     "xvnmaddasp",
-	XVNMADDMDP:     "xvnmaddmdp",
-	XVNMADDMSP:     "xvnmaddmsp",
-	XVNMSUBADP:     "xvnmsubadp",
-	XVNMSUBASP:     "xvnmsubasp",
-	XVNMSUBMDP:     "xvnmsubmdp",
-	XVNMSUBMSP:     "xvnmsubmsp",
-	XVRDPI:         "xvrdpi",
-	XVRDPIC:        "xvrdpic",
-	XVRDPIM:        "xvrdpim",
-	XVRDPIP:        "xvrdpip",
-	XVRDPIZ:        "xvrdpiz",
-	XVREDP:         "xvredp",
-	XVRESP:         "xvresp",
-	XVRSPI:         "xvrspi",
-	XVRSPIC:        "xvrspic",
-	XVRSPIM:        "xvrspim",
-	XVRSPIP:        "xvrspip",
-	XVRSPIZ:        "xvrspiz",
-	XVRSQRTEDP:     "xvrsqrtedp",
-	XVRSQRTESP:     "xvrsqrtesp",
-	XVSQRTDP:       "xvsqrtdp",
-	XVSQRTSP:       "xvsqrtsp",
-	XVSUBDP:        "xvsubdp",
-	XVSUBSP:        "xvsubsp",
-	XVTDIVDP:       "xvtdivdp",
-	XVTDIVSP:       "xvtdivsp",
-	XVTSQRTDP:      "xvtsqrtdp",
-	XVTSQRTSP:      "xvtsqrtsp",
-	XXLAND:         "xxland",
-	XXLANDC:        "xxlandc",
-	XXLNOR:         "xxlnor",
-	XXLOR:          "xxlor",
-	XXLXOR:         "xxlxor",
-	XXMRGHW:        "xxmrghw",
-	XXMRGLW:        "xxmrglw",
-	XXPERMDI:       "xxpermdi",
-	XXSEL:          "xxsel",
-	XXSLDWI:        "xxsldwi",
-	XXSPLTW:        "xxspltw",
-	CMPB:           "cmpb",
-	DADD:           "dadd",
-	DADDCC:         "dadd.",
-	DADDQ:          "daddq",
-	DADDQCC:        "daddq.",
-	DCFFIXQ:        "dcffixq",
-	DCFFIXQCC:      "dcffixq.",
-	DCMPO:          "dcmpo",
-	DCMPOQ:         "dcmpoq",
-	DCMPU:          "dcmpu",
-	DCMPUQ:         "dcmpuq",
-	DCTDP:          "dctdp",
-	DCTDPCC:        "dctdp.",
-	DCTFIX:         "dctfix",
-	DCTFIXCC:       "dctfix.",
-	DCTFIXQ:        "dctfixq",
-	DCTFIXQCC:      "dctfixq.",
-	DCTQPQ:         "dctqpq",
-	DCTQPQCC:       "dctqpq.",
-	DDEDPD:         "ddedpd",
-	DDEDPDCC:       "ddedpd.",
-	DDEDPDQ:        "ddedpdq",
-	DDEDPDQCC:      "ddedpdq.",
-	DDIV:           "ddiv",
-	DDIVCC:         "ddiv.",
-	DDIVQ:          "ddivq",
-	DDIVQCC:        "ddivq.",
-	DENBCD:         "denbcd",
-	DENBCDCC:       "denbcd.",
-	DENBCDQ:        "denbcdq",
-	DENBCDQCC:      "denbcdq.",
-	DIEX:           "diex",
-	DIEXCC:         "diex.",
-	DIEXQCC:        "diexq.",
-	DIEXQ:          "diexq",
-	DMUL:           "dmul",
-	DMULCC:         "dmul.",
-	DMULQ:          "dmulq",
-	DMULQCC:        "dmulq.",
-	DQUA:           "dqua",
-	DQUACC:         "dqua.",
-	DQUAI:          "dquai",
-	DQUAICC:        "dquai.",
-	DQUAIQ:         "dquaiq",
-	DQUAIQCC:       "dquaiq.",
-	DQUAQ:          "dquaq",
-	DQUAQCC:        "dquaq.",
-	DRDPQ:          "drdpq",
-	DRDPQCC:        "drdpq.",
-	DRINTN:         "drintn",
-	DRINTNCC:       "drintn.",
-	DRINTNQ:        "drintnq",
-	DRINTNQCC:      "drintnq.",
-	DRINTX:         "drintx",
-	DRINTXCC:       "drintx.",
-	DRINTXQ:        "drintxq",
-	DRINTXQCC:      "drintxq.",
-	DRRND:          "drrnd",
-	DRRNDCC:        "drrnd.",
-	DRRNDQ:         "drrndq",
-	DRRNDQCC:       "drrndq.",
-	DRSP:           "drsp",
-	DRSPCC:         "drsp.",
-	DSCLI:          "dscli",
-	DSCLICC:        "dscli.",
-	DSCLIQ:         "dscliq",
-	DSCLIQCC:       "dscliq.",
-	DSCRI:          "dscri",
-	DSCRICC:        "dscri.",
-	DSCRIQ:         "dscriq",
-	DSCRIQCC:       "dscriq.",
-	DSUB:           "dsub",
-	DSUBCC:         "dsub.",
-	DSUBQ:          "dsubq",
-	DSUBQCC:        "dsubq.",
-	DTSTDC:         "dtstdc",
-	DTSTDCQ:        "dtstdcq",
-	DTSTDG:         "dtstdg",
-	DTSTDGQ:        "dtstdgq",
-	DTSTEX:         "dtstex",
-	DTSTEXQ:        "dtstexq",
-	DTSTSF:         "dtstsf",
-	DTSTSFQ:        "dtstsfq",
-	DXEX:           "dxex",
-	DXEXCC:         "dxex.",
-	DXEXQ:          "dxexq",
-	DXEXQCC:        "dxexq.",
-	FCPSGN:         "fcpsgn",
-	FCPSGNCC:       "fcpsgn.",
-	LBZCIX:         "lbzcix",
-	LDCIX:          "ldcix",
-	LFDP:           "lfdp",
-	LFDPX:          "lfdpx",
-	LFIWAX:         "lfiwax",
-	LHZCIX:         "lhzcix",
-	LWZCIX:         "lwzcix",
-	PRTYD:          "prtyd",
-	PRTYW:          "prtyw",
-	SLBFEECC:       "slbfee.",
-	STBCIX:         "stbcix",
-	STDCIX:         "stdcix",
-	STFDP:          "stfdp",
-	STFDPX:         "stfdpx",
-	STHCIX:         "sthcix",
-	STWCIX:         "stwcix",
-	ISEL:           "isel",
-	LVEBX:          "lvebx",
-	LVEHX:          "lvehx",
-	LVEWX:          "lvewx",
-	LVSL:           "lvsl",
-	LVSR:           "lvsr",
-	LVX:            "lvx",
-	LVXL:           "lvxl",
-	MFVSCR:         "mfvscr",
-	MTVSCR:         "mtvscr",
-	STVEBX:         "stvebx",
-	STVEHX:         "stvehx",
-	STVEWX:         "stvewx",
-	STVX:           "stvx",
-	STVXL:          "stvxl",
-	TLBIEL:         "tlbiel",
-	VADDCUW:        "vaddcuw",
-	VADDFP:         "vaddfp",
-	VADDSBS:        "vaddsbs",
-	VADDSHS:        "vaddshs",
-	VADDSWS:        "vaddsws",
-	VADDUBM:        "vaddubm",
-	VADDUBS:        "vaddubs",
-	VADDUHM:        "vadduhm",
-	VADDUHS:        "vadduhs",
-	VADDUWM:        "vadduwm",
-	VADDUWS:        "vadduws",
-	VAND:           "vand",
-	VANDC:          "vandc",
-	VAVGSB:         "vavgsb",
-	VAVGSH:         "vavgsh",
-	VAVGSW:         "vavgsw",
-	VAVGUB:         "vavgub",
-	VAVGUH:         "vavguh",
-	VAVGUW:         "vavguw",
-	VCFSX:          "vcfsx",
-	VCFUX:          "vcfux",
-	VCMPBFP:        "vcmpbfp",
-	VCMPBFPCC:      "vcmpbfp.",
-	VCMPEQFP:       "vcmpeqfp",
-	VCMPEQFPCC:     "vcmpeqfp.",
-	VCMPEQUB:       "vcmpequb",
-	VCMPEQUBCC:     "vcmpequb.",
-	VCMPEQUH:       "vcmpequh",
-	VCMPEQUHCC:     "vcmpequh.",
-	VCMPEQUW:       "vcmpequw",
-	VCMPEQUWCC:     "vcmpequw.",
-	VCMPGEFP:       "vcmpgefp",
-	VCMPGEFPCC:     "vcmpgefp.",
-	VCMPGTFP:       "vcmpgtfp",
-	VCMPGTFPCC:     "vcmpgtfp.",
-	VCMPGTSB:       "vcmpgtsb",
-	VCMPGTSBCC:     "vcmpgtsb.",
-	VCMPGTSH:       "vcmpgtsh",
-	VCMPGTSHCC:     "vcmpgtsh.",
-	VCMPGTSW:       "vcmpgtsw",
-	VCMPGTSWCC:     "vcmpgtsw.",
-	VCMPGTUB:       "vcmpgtub",
-	VCMPGTUBCC:     "vcmpgtub.",
-	VCMPGTUH:       "vcmpgtuh",
-	VCMPGTUHCC:     "vcmpgtuh.",
-	VCMPGTUW:       "vcmpgtuw",
-	VCMPGTUWCC:     "vcmpgtuw.",
-	VCTSXS:         "vctsxs",
-	VCTUXS:         "vctuxs",
-	VEXPTEFP:       "vexptefp",
-	VLOGEFP:        "vlogefp",
-	VMADDFP:        "vmaddfp",
-	VMAXFP:         "vmaxfp",
-	VMAXSB:         "vmaxsb",
-	VMAXSH:         "vmaxsh",
-	VMAXSW:         "vmaxsw",
-	VMAXUB:         "vmaxub",
-	VMAXUH:         "vmaxuh",
-	VMAXUW:         "vmaxuw",
-	VMHADDSHS:      "vmhaddshs",
-	VMHRADDSHS:     "vmhraddshs",
-	VMINFP:         "vminfp",
-	VMINSB:         "vminsb",
-	VMINSH:         "vminsh",
-	VMINSW:         "vminsw",
-	VMINUB:         "vminub",
-	VMINUH:         "vminuh",
-	VMINUW:         "vminuw",
-	VMLADDUHM:      "vmladduhm",
-	VMRGHB:         "vmrghb",
-	VMRGHH:         "vmrghh",
-	VMRGHW:         "vmrghw",
-	VMRGLB:         "vmrglb",
-	VMRGLH:         "vmrglh",
-	VMRGLW:         "vmrglw",
-	VMSUMMBM:       "vmsummbm",
-	VMSUMSHM:       "vmsumshm",
-	VMSUMSHS:       "vmsumshs",
-	VMSUMUBM:       "vmsumubm",
-	VMSUMUHM:       "vmsumuhm",
-	VMSUMUHS:       "vmsumuhs",
-	VMULESB:        "vmulesb",
-	VMULESH:        "vmulesh",
-	VMULEUB:        "vmuleub",
-	VMULEUH:        "vmuleuh",
-	VMULOSB:        "vmulosb",
-	VMULOSH:        "vmulosh",
-	VMULOUB:        "vmuloub",
-	VMULOUH:        "vmulouh",
-	VNMSUBFP:       "vnmsubfp",
-	VNOR:           "vnor",
-	VOR:            "vor",
-	VPERM:          "vperm",
-	VPKPX:          "vpkpx",
-	VPKSHSS:        "vpkshss",
-	VPKSHUS:        "vpkshus",
-	VPKSWSS:        "vpkswss",
-	VPKSWUS:        "vpkswus",
-	VPKUHUM:        "vpkuhum",
-	VPKUHUS:        "vpkuhus",
-	VPKUWUM:        "vpkuwum",
-	VPKUWUS:        "vpkuwus",
-	VREFP:          "vrefp",
-	VRFIM:          "vrfim",
-	VRFIN:          "vrfin",
-	VRFIP:          "vrfip",
-	VRFIZ:          "vrfiz",
-	VRLB:           "vrlb",
-	VRLH:           "vrlh",
-	VRLW:           "vrlw",
-	VRSQRTEFP:      "vrsqrtefp",
-	VSEL:           "vsel",
-	VSL:            "vsl",
-	VSLB:           "vslb",
-	VSLDOI:         "vsldoi",
-	VSLH:           "vslh",
-	VSLO:           "vslo",
-	VSLW:           "vslw",
-	VSPLTB:         "vspltb",
-	VSPLTH:         "vsplth",
-	VSPLTISB:       "vspltisb",
-	VSPLTISH:       "vspltish",
-	VSPLTISW:       "vspltisw",
-	VSPLTW:         "vspltw",
-	VSR:            "vsr",
-	VSRAB:          "vsrab",
-	VSRAH:          "vsrah",
-	VSRAW:          "vsraw",
-	VSRB:           "vsrb",
-	VSRH:           "vsrh",
-	VSRO:           "vsro",
-	VSRW:           "vsrw",
-	VSUBCUW:        "vsubcuw",
-	VSUBFP:         "vsubfp",
-	VSUBSBS:        "vsubsbs",
-	VSUBSHS:        "vsubshs",
-	VSUBSWS:        "vsubsws",
-	VSUBUBM:        "vsububm",
-	VSUBUBS:        "vsububs",
-	VSUBUHM:        "vsubuhm",
-	VSUBUHS:        "vsubuhs",
-	VSUBUWM:        "vsubuwm",
-	VSUBUWS:        "vsubuws",
-	VSUM2SWS:       "vsum2sws",
-	VSUM4SBS:       "vsum4sbs",
-	VSUM4SHS:       "vsum4shs",
-	VSUM4UBS:       "vsum4ubs",
-	VSUMSWS:        "vsumsws",
-	VUPKHPX:        "vupkhpx",
-	VUPKHSB:        "vupkhsb",
-	VUPKHSH:        "vupkhsh",
-	VUPKLPX:        "vupklpx",
-	VUPKLSB:        "vupklsb",
-	VUPKLSH:        "vupklsh",
-	VXOR:           "vxor",
-	FRE:            "fre",
-	FRECC:          "fre.",
-	FRIM:           "frim",
-	FRIMCC:         "frim.",
-	FRIN:           "frin",
-	FRINCC:         "frin.",
-	FRIP:           "frip",
-	FRIPCC:         "frip.",
-	FRIZ:           "friz",
-	FRIZCC:         "friz.",
-	FRSQRTES:       "frsqrtes",
-	FRSQRTESCC:     "frsqrtes.",
-	HRFID:          "hrfid",
-	POPCNTB:        "popcntb",
-	MFOCRF:         "mfocrf",
-	MTOCRF:         "mtocrf",
-	SLBMFEE:        "slbmfee",
-	SLBMFEV:        "slbmfev",
-	SLBMTE:         "slbmte",
-	RFSCV:          "rfscv",
-	SCV:            "scv",
-	LQ:             "lq",
-	STQ:            "stq",
-	CNTLZD:         "cntlzd",
-	CNTLZDCC:       "cntlzd.",
-	DCBF:           "dcbf",
-	DCBST:          "dcbst",
-	DCBT:           "dcbt",
-	DCBTST:         "dcbtst",
-	DIVD:           "divd",
-	DIVDCC:         "divd.",
-	DIVDO:          "divdo",
-	DIVDOCC:        "divdo.",
-	DIVDU:          "divdu",
-	DIVDUCC:        "divdu.",
-	DIVDUO:         "divduo",
-	DIVDUOCC:       "divduo.",
-	DIVW:           "divw",
-	DIVWCC:         "divw.",
-	DIVWO:          "divwo",
-	DIVWOCC:        "divwo.",
-	DIVWU:          "divwu",
-	DIVWUCC:        "divwu.",
-	DIVWUO:         "divwuo",
-	DIVWUOCC:       "divwuo.",
-	EIEIO:          "eieio",
-	EXTSB:          "extsb",
-	EXTSBCC:        "extsb.",
-	EXTSW:          "extsw",
-	EXTSWCC:        "extsw.",
-	FADDS:          "fadds",
-	FADDSCC:        "fadds.",
-	FCFID:          "fcfid",
-	FCFIDCC:        "fcfid.",
-	FCTID:          "fctid",
-	FCTIDCC:        "fctid.",
-	FCTIDZ:         "fctidz",
-	FCTIDZCC:       "fctidz.",
-	FDIVS:          "fdivs",
-	FDIVSCC:        "fdivs.",
-	FMADDS:         "fmadds",
-	FMADDSCC:       "fmadds.",
-	FMSUBS:         "fmsubs",
-	FMSUBSCC:       "fmsubs.",
-	FMULS:          "fmuls",
-	FMULSCC:        "fmuls.",
-	FNMADDS:        "fnmadds",
-	FNMADDSCC:      "fnmadds.",
-	FNMSUBS:        "fnmsubs",
-	FNMSUBSCC:      "fnmsubs.",
-	FRES:           "fres",
-	FRESCC:         "fres.",
-	FRSQRTE:        "frsqrte",
-	FRSQRTECC:      "frsqrte.",
-	FSEL:           "fsel",
-	FSELCC:         "fsel.",
-	FSQRTS:         "fsqrts",
-	FSQRTSCC:       "fsqrts.",
-	FSUBS:          "fsubs",
-	FSUBSCC:        "fsubs.",
-	ICBI:           "icbi",
-	LD:             "ld",
-	LDARX:          "ldarx",
-	LDU:            "ldu",
-	LDUX:           "ldux",
-	LDX:            "ldx",
-	LWA:            "lwa",
-	LWARX:          "lwarx",
-	LWAUX:          "lwaux",
-	LWAX:           "lwax",
-	MFTB:           "mftb",
-	MTMSRD:         "mtmsrd",
-	MULHD:          "mulhd",
-	MULHDCC:        "mulhd.",
-	MULHDU:         "mulhdu",
-	MULHDUCC:       "mulhdu.",
-	MULHW:          "mulhw",
-	MULHWCC:        "mulhw.",
-	MULHWU:         "mulhwu",
-	MULHWUCC:       "mulhwu.",
-	MULLD:          "mulld",
-	MULLDCC:        "mulld.",
-	MULLDO:         "mulldo",
-	MULLDOCC:       "mulldo.",
-	RFID:           "rfid",
-	RLDCL:          "rldcl",
-	RLDCLCC:        "rldcl.",
-	RLDCR:          "rldcr",
-	RLDCRCC:        "rldcr.",
-	RLDIC:          "rldic",
-	RLDICCC:        "rldic.",
-	RLDICL:         "rldicl",
-	RLDICLCC:       "rldicl.",
-	RLDICR:         "rldicr",
-	RLDICRCC:       "rldicr.",
-	RLDIMI:         "rldimi",
-	RLDIMICC:       "rldimi.",
-	SC:             "sc",
-	SLBIA:          "slbia",
-	SLBIE:          "slbie",
-	SLD:            "sld",
-	SLDCC:          "sld.",
-	SRAD:           "srad",
-	SRADCC:         "srad.",
-	SRADI:          "sradi",
-	SRADICC:        "sradi.",
-	SRD:            "srd",
-	SRDCC:          "srd.",
-	STD:            "std",
-	STDCXCC:        "stdcx.",
-	STDU:           "stdu",
-	STDUX:          "stdux",
-	STDX:           "stdx",
-	STFIWX:         "stfiwx",
-	STWCXCC:        "stwcx.",
-	SUBF:           "subf",
-	SUBFCC:         "subf.",
-	SUBFO:          "subfo",
-	SUBFOCC:        "subfo.",
-	TD:             "td",
-	TDI:            "tdi",
-	TLBSYNC:        "tlbsync",
-	FCTIW:          "fctiw",
-	FCTIWCC:        "fctiw.",
-	FCTIWZ:         "fctiwz",
-	FCTIWZCC:       "fctiwz.",
-	FSQRT:          "fsqrt",
-	FSQRTCC:        "fsqrt.",
-	ADD:            "add",
-	ADDCC:          "add.",
-	ADDO:           "addo",
-	ADDOCC:         "addo.",
-	ADDC:           "addc",
-	ADDCCC:         "addc.",
-	ADDCO:          "addco",
-	ADDCOCC:        "addco.",
-	ADDE:           "adde",
-	ADDECC:         "adde.",
-	ADDEO:          "addeo",
-	ADDEOCC:        "addeo.",
-	LI:             "li",
-	ADDI:           "addi",
-	ADDIC:          "addic",
-	ADDICCC:        "addic.",
-	LIS:            "lis",
-	ADDIS:          "addis",
-	ADDME:          "addme",
-	ADDMECC:        "addme.",
-	ADDMEO:         "addmeo",
-	ADDMEOCC:       "addmeo.",
-	ADDZE:          "addze",
-	ADDZECC:        "addze.",
-	ADDZEO:         "addzeo",
-	ADDZEOCC:       "addzeo.",
-	AND:            "and",
-	ANDCC:          "and.",
-	ANDC:           "andc",
-	ANDCCC:         "andc.",
-	ANDICC:         "andi.",
-	ANDISCC:        "andis.",
-	B:              "b",
-	BA:             "ba",
-	BL:             "bl",
-	BLA:            "bla",
-	BC:             "bc",
-	BCA:            "bca",
-	BCL:            "bcl",
-	BCLA:           "bcla",
-	BCCTR:          "bcctr",
-	BCCTRL:         "bcctrl",
-	BCLR:           "bclr",
-	BCLRL:          "bclrl",
-	CMPW:           "cmpw",
-	CMPD:           "cmpd",
-	CMP:            "cmp",
-	CMPWI:          "cmpwi",
-	CMPDI:          "cmpdi",
-	CMPI:           "cmpi",
-	CMPLW:          "cmplw",
-	CMPLD:          "cmpld",
-	CMPL:           "cmpl",
-	CMPLWI:         "cmplwi",
-	CMPLDI:         "cmpldi",
-	CMPLI:          "cmpli",
-	CNTLZW:         "cntlzw",
-	CNTLZWCC:       "cntlzw.",
-	CRAND:          "crand",
-	CRANDC:         "crandc",
-	CREQV:          "creqv",
-	CRNAND:         "crnand",
-	CRNOR:          "crnor",
-	CROR:           "cror",
-	CRORC:          "crorc",
-	CRXOR:          "crxor",
-	DCBZ:           "dcbz",
-	EQV:            "eqv",
-	EQVCC:          "eqv.",
-	EXTSH:          "extsh",
-	EXTSHCC:        "extsh.",
-	FABS:           "fabs",
-	FABSCC:         "fabs.",
-	FADD:           "fadd",
-	FADDCC:         "fadd.",
-	FCMPO:          "fcmpo",
-	FCMPU:          "fcmpu",
-	FDIV:           "fdiv",
-	FDIVCC:         "fdiv.",
-	FMADD:          "fmadd",
-	FMADDCC:        "fmadd.",
-	FMR:            "fmr",
-	FMRCC:          "fmr.",
-	FMSUB:          "fmsub",
-	FMSUBCC:        "fmsub.",
-	FMUL:           "fmul",
-	FMULCC:         "fmul.",
-	FNABS:          "fnabs",
-	FNABSCC:        "fnabs.",
-	FNEG:           "fneg",
-	FNEGCC:         "fneg.",
-	FNMADD:         "fnmadd",
-	FNMADDCC:       "fnmadd.",
-	FNMSUB:         "fnmsub",
-	FNMSUBCC:       "fnmsub.",
-	FRSP:           "frsp",
-	FRSPCC:         "frsp.",
-	FSUB:           "fsub",
-	FSUBCC:         "fsub.",
-	ISYNC:          "isync",
-	LBZ:            "lbz",
-	LBZU:           "lbzu",
-	LBZUX:          "lbzux",
-	LBZX:           "lbzx",
-	LFD:            "lfd",
-	LFDU:           "lfdu",
-	LFDUX:          "lfdux",
-	LFDX:           "lfdx",
-	LFS:            "lfs",
-	LFSU:           "lfsu",
-	LFSUX:          "lfsux",
-	LFSX:           "lfsx",
-	LHA:            "lha",
-	LHAU:           "lhau",
-	LHAUX:          "lhaux",
-	LHAX:           "lhax",
-	LHBRX:          "lhbrx",
-	LHZ:            "lhz",
-	LHZU:           "lhzu",
-	LHZUX:          "lhzux",
-	LHZX:           "lhzx",
-	LMW:            "lmw",
-	LSWI:           "lswi",
-	LSWX:           "lswx",
-	LWBRX:          "lwbrx",
-	LWZ:            "lwz",
-	LWZU:           "lwzu",
-	LWZUX:          "lwzux",
-	LWZX:           "lwzx",
-	MCRF:           "mcrf",
-	MCRFS:          "mcrfs",
-	MFCR:           "mfcr",
-	MFFS:           "mffs",
-	MFFSCC:         "mffs.",
-	MFMSR:          "mfmsr",
-	MFSPR:          "mfspr",
-	MTCRF:          "mtcrf",
-	MTFSB0:         "mtfsb0",
-	MTFSB0CC:       "mtfsb0.",
-	MTFSB1:         "mtfsb1",
-	MTFSB1CC:       "mtfsb1.",
-	MTFSF:          "mtfsf",
-	MTFSFCC:        "mtfsf.",
-	MTFSFI:         "mtfsfi",
-	MTFSFICC:       "mtfsfi.",
-	MTMSR:          "mtmsr",
-	MTSPR:          "mtspr",
-	MULLI:          "mulli",
-	MULLW:          "mullw",
-	MULLWCC:        "mullw.",
-	MULLWO:         "mullwo",
-	MULLWOCC:       "mullwo.",
-	NAND:           "nand",
-	NANDCC:         "nand.",
-	NEG:            "neg",
-	NEGCC:          "neg.",
-	NEGO:           "nego",
-	NEGOCC:         "nego.",
-	NOR:            "nor",
-	NORCC:          "nor.",
-	OR:             "or",
-	ORCC:           "or.",
-	ORC:            "orc",
-	ORCCC:          "orc.",
-	NOP:            "nop",
-	ORI:            "ori",
-	ORIS:           "oris",
-	RLWIMI:         "rlwimi",
-	RLWIMICC:       "rlwimi.",
-	RLWINM:         "rlwinm",
-	RLWINMCC:       "rlwinm.",
-	RLWNM:          "rlwnm",
-	RLWNMCC:        "rlwnm.",
-	SLW:            "slw",
-	SLWCC:          "slw.",
-	SRAW:           "sraw",
-	SRAWCC:         "sraw.",
-	SRAWI:          "srawi",
-	SRAWICC:        "srawi.",
-	SRW:            "srw",
-	SRWCC:          "srw.",
-	STB:            "stb",
-	STBU:           "stbu",
-	STBUX:          "stbux",
-	STBX:           "stbx",
-	STFD:           "stfd",
-	STFDU:          "stfdu",
-	STFDUX:         "stfdux",
-	STFDX:          "stfdx",
-	STFS:           "stfs",
-	STFSU:          "stfsu",
-	STFSUX:         "stfsux",
-	STFSX:          "stfsx",
-	STH:            "sth",
-	STHBRX:         "sthbrx",
-	STHU:           "sthu",
-	STHUX:          "sthux",
-	STHX:           "sthx",
-	STMW:           "stmw",
-	STSWI:          "stswi",
-	STSWX:          "stswx",
-	STW:            "stw",
-	STWBRX:         "stwbrx",
-	STWU:           "stwu",
-	STWUX:          "stwux",
-	STWX:           "stwx",
-	SUBFC:          "subfc",
-	SUBFCCC:        "subfc.",
-	SUBFCO:         "subfco",
-	SUBFCOCC:       "subfco.",
-	SUBFE:          "subfe",
-	SUBFECC:        "subfe.",
-	SUBFEO:         "subfeo",
-	SUBFEOCC:       "subfeo.",
-	SUBFIC:         "subfic",
-	SUBFME:         "subfme",
-	SUBFMECC:       "subfme.",
-	SUBFMEO:        "subfmeo",
-	SUBFMEOCC:      "subfmeo.",
-	SUBFZE:         "subfze",
-	SUBFZECC:       "subfze.",
-	SUBFZEO:        "subfzeo",
-	SUBFZEOCC:      "subfzeo.",
-	SYNC:           "sync",
-	TLBIE:          "tlbie",
-	TW:             "tw",
-	TWI:            "twi",
-	XOR:            "xor",
-	XORCC:          "xor.",
-	XORI:           "xori",
-	XORIS:          "xoris",
+	DMXXEXTFDMR512:    "dmxxextfdmr512",
+	DMXXINSTDMR512:    "dmxxinstdmr512",
+	DMMR:              "dmmr",
+	DMXOR:             "dmxor",
+	DMSETDMRZ:         "dmsetdmrz",
+	DMXXEXTFDMR256:    "dmxxextfdmr256",
+	DMXXINSTDMR256:    "dmxxinstdmr256",
+	DMXVBF16GERX2:     "dmxvbf16gerx2",
+	PMDMXVBF16GERX2:   "pmdmxvbf16gerx2",
+	DMXVBF16GERX2PP:   "dmxvbf16gerx2pp",
+	PMDMXVBF16GERX2PP: "pmdmxvbf16gerx2pp",
+	DMXVBF16GERX2PN:   "dmxvbf16gerx2pn",
+	PMDMXVBF16GERX2PN: "pmdmxvbf16gerx2pn",
+	DMXVBF16GERX2NP:   "dmxvbf16gerx2np",
+	PMDMXVBF16GERX2NP: "pmdmxvbf16gerx2np",
+	DMXVBF16GERX2NN:   "dmxvbf16gerx2nn",
+	PMDMXVBF16GERX2NN: "pmdmxvbf16gerx2nn",
+	DMXVF16GERX2:      "dmxvf16gerx2",
+	PMDMXVF16GERX2:    "pmdmxvf16gerx2",
+	DMXVF16GERX2PP:    "dmxvf16gerx2pp",
+	PMDMXVF16GERX2PP:  "pmdmxvf16gerx2pp",
+	DMXVF16GERX2PN:    "dmxvf16gerx2pn",
+	PMDMXVF16GERX2PN:  "pmdmxvf16gerx2pn",
+	DMXVF16GERX2NP:    "dmxvf16gerx2np",
+	PMDMXVF16GERX2NP:  "pmdmxvf16gerx2np",
+	DMXVF16GERX2NN:    "dmxvf16gerx2nn",
+	PMDMXVF16GERX2NN:  "pmdmxvf16gerx2nn",
+	DMXVI8GERX4:       "dmxvi8gerx4",
+	PMDMXVI8GERX4:     "pmdmxvi8gerx4",
+	DMXVI8GERX4PP:     "dmxvi8gerx4pp",
+	PMDMXVI8GERX4PP:   "pmdmxvi8gerx4pp",
+	DMXVI8GERX4SPP:    "dmxvi8gerx4spp",
+	PMDMXVI8GERX4SPP:  "pmdmxvi8gerx4spp",
+	DMSHA2HASH:        "dmsha2hash",
+	DMSHA3HASH:        "dmsha3hash",
+	DMXXSHAPAD:        "dmxxshapad",
+	HASHCHK:           "hashchk",
+	HASHCHKP:          "hashchkp",
+	HASHST:            "hashst",
+	HASHSTP:           "hashstp",
+	BRD:               "brd",
+	BRH:               "brh",
+	BRW:               "brw",
+	CFUGED:            "cfuged",
+	CNTLZDM:           "cntlzdm",
+	CNTTZDM:           "cnttzdm",
+	DCFFIXQQ:          "dcffixqq",
+	DCTFIXQQ:          "dctfixqq",
+	LXVKQ:             "lxvkq",
+	LXVP:              "lxvp",
+	LXVPX:             "lxvpx",
+	LXVRBX:            "lxvrbx",
+	LXVRDX:            "lxvrdx",
+	LXVRHX:            "lxvrhx",
+	LXVRWX:            "lxvrwx",
+	MTVSRBM:           "mtvsrbm",
+	MTVSRBMI:          "mtvsrbmi",
+	MTVSRDM:           "mtvsrdm",
+	MTVSRHM:           "mtvsrhm",
+	MTVSRQM:           "mtvsrqm",
+	MTVSRWM:           "mtvsrwm",
+	PADDI:             "paddi",
+	PDEPD:             "pdepd",
+	PEXTD:             "pextd",
+	PLBZ:              "plbz",
+	PLD:               "pld",
+	PLFD:              "plfd",
+	PLFS:              "plfs",
+	PLHA:              "plha",
+	PLHZ:              "plhz",
+	PLQ:               "plq",
+	PLWA:              "plwa",
+	PLWZ:              "plwz",
+	PLXSD:             "plxsd",
+	PLXSSP:            "plxssp",
+	PLXV:              "plxv",
+	PLXVP:             "plxvp",
+	PMXVBF16GER2:      "pmxvbf16ger2",
+	PMXVBF16GER2NN:    "pmxvbf16ger2nn",
+	PMXVBF16GER2NP:    "pmxvbf16ger2np",
+	PMXVBF16GER2PN:    "pmxvbf16ger2pn",
+	PMXVBF16GER2PP:    "pmxvbf16ger2pp",
+	PMXVF16GER2:       "pmxvf16ger2",
+	PMXVF16GER2NN:     "pmxvf16ger2nn",
+	PMXVF16GER2NP:     "pmxvf16ger2np",
+	PMXVF16GER2PN:     "pmxvf16ger2pn",
+	PMXVF16GER2PP:     "pmxvf16ger2pp",
+	PMXVF32GER:        "pmxvf32ger",
+	PMXVF32GERNN:      "pmxvf32gernn",
+	PMXVF32GERNP:      "pmxvf32gernp",
+	PMXVF32GERPN:      "pmxvf32gerpn",
+	PMXVF32GERPP:      "pmxvf32gerpp",
+	PMXVF64GER:        "pmxvf64ger",
+	PMXVF64GERNN:      "pmxvf64gernn",
+	PMXVF64GERNP:      "pmxvf64gernp",
+	PMXVF64GERPN:      "pmxvf64gerpn",
+	PMXVF64GERPP:      "pmxvf64gerpp",
+	PMXVI16GER2:       "pmxvi16ger2",
+	PMXVI16GER2PP:     "pmxvi16ger2pp",
+	PMXVI16GER2S:      "pmxvi16ger2s",
+	PMXVI16GER2SPP:    "pmxvi16ger2spp",
+	PMXVI4GER8:        "pmxvi4ger8",
+	PMXVI4GER8PP:      "pmxvi4ger8pp",
+	PMXVI8GER4:        "pmxvi8ger4",
+	PMXVI8GER4PP:      "pmxvi8ger4pp",
+	PMXVI8GER4SPP:     "pmxvi8ger4spp",
+	PNOP:              "pnop",
+	PSTB:              "pstb",
+	PSTD:              "pstd",
+	PSTFD:             "pstfd",
+	PSTFS:             "pstfs",
+	PSTH:              "psth",
+	PSTQ:              "pstq",
+	PSTW:              "pstw",
+	PSTXSD:            "pstxsd",
+	PSTXSSP:           "pstxssp",
+	PSTXV:             "pstxv",
+	PSTXVP:            "pstxvp",
+	SETBC:             "setbc",
+	SETBCR:            "setbcr",
+	SETNBC:            "setnbc",
+	SETNBCR:           "setnbcr",
+	STXVP:             "stxvp",
+	STXVPX:            "stxvpx",
+	STXVRBX:           "stxvrbx",
+	STXVRDX:           "stxvrdx",
+	STXVRHX:           "stxvrhx",
+	STXVRWX:           "stxvrwx",
+	VCFUGED:           "vcfuged",
+	VCLRLB:            "vclrlb",
+	VCLRRB:            "vclrrb",
+	VCLZDM:            "vclzdm",
+	VCMPEQUQ:          "vcmpequq",
+	VCMPEQUQCC:        "vcmpequq.",
+	VCMPGTSQ:          "vcmpgtsq",
+	VCMPGTSQCC:        "vcmpgtsq.",
+	VCMPGTUQ:          "vcmpgtuq",
+	VCMPGTUQCC:        "vcmpgtuq.",
+	VCMPSQ:            "vcmpsq",
+	VCMPUQ:            "vcmpuq",
+	VCNTMBB:           "vcntmbb",
+	VCNTMBD:           "vcntmbd",
+	VCNTMBH:           "vcntmbh",
+	VCNTMBW:           "vcntmbw",
+	VCTZDM:            "vctzdm",
+	VDIVESD:           "vdivesd",
+	VDIVESQ:           "vdivesq",
+	VDIVESW:           "vdivesw",
+	VDIVEUD:           "vdiveud",
+	VDIVEUQ:           "vdiveuq",
+	VDIVEUW:           "vdiveuw",
+	VDIVSD:            "vdivsd",
+	VDIVSQ:            "vdivsq",
+	VDIVSW:            "vdivsw",
+	VDIVUD:            "vdivud",
+	VDIVUQ:            "vdivuq",
+	VDIVUW:            "vdivuw",
+	VEXPANDBM:         "vexpandbm",
+	VEXPANDDM:         "vexpanddm",
+	VEXPANDHM:         "vexpandhm",
+	VEXPANDQM:         "vexpandqm",
+	VEXPANDWM:         "vexpandwm",
+	VEXTDDVLX:         "vextddvlx",
+	VEXTDDVRX:         "vextddvrx",
+	VEXTDUBVLX:        "vextdubvlx",
+	VEXTDUBVRX:        "vextdubvrx",
+	VEXTDUHVLX:        "vextduhvlx",
+	VEXTDUHVRX:        "vextduhvrx",
+	VEXTDUWVLX:        "vextduwvlx",
+	VEXTDUWVRX:        "vextduwvrx",
+	VEXTRACTBM:        "vextractbm",
+	VEXTRACTDM:        "vextractdm",
+	VEXTRACTHM:        "vextracthm",
+	VEXTRACTQM:        "vextractqm",
+	VEXTRACTWM:        "vextractwm",
+	VEXTSD2Q:          "vextsd2q",
+	VGNB:              "vgnb",
+	VINSBLX:           "vinsblx",
+	VINSBRX:           "vinsbrx",
+	VINSBVLX:          "vinsbvlx",
+	VINSBVRX:          "vinsbvrx",
+	VINSD:             "vinsd",
+	VINSDLX:           "vinsdlx",
+	VINSDRX:           "vinsdrx",
+	VINSHLX:           "vinshlx",
+	VINSHRX:           "vinshrx",
+	VINSHVLX:          "vinshvlx",
+	VINSHVRX:          "vinshvrx",
+	VINSW:             "vinsw",
+	VINSWLX:           "vinswlx",
+	VINSWRX:           "vinswrx",
+	VINSWVLX:          "vinswvlx",
+	VINSWVRX:          "vinswvrx",
+	VMODSD:            "vmodsd",
+	VMODSQ:            "vmodsq",
+	VMODSW:            "vmodsw",
+	VMODUD:            "vmodud",
+	VMODUQ:            "vmoduq",
+	VMODUW:            "vmoduw",
+	VMSUMCUD:          "vmsumcud",
+	VMULESD:           "vmulesd",
+	VMULEUD:           "vmuleud",
+	VMULHSD:           "vmulhsd",
+	VMULHSW:           "vmulhsw",
+	VMULHUD:           "vmulhud",
+	VMULHUW:           "vmulhuw",
+	VMULLD:            "vmulld",
+	VMULOSD:           "vmulosd",
+	VMULOUD:           "vmuloud",
+	VPDEPD:            "vpdepd",
+	VPEXTD:            "vpextd",
+	VRLQ:              "vrlq",
+	VRLQMI:            "vrlqmi",
+	VRLQNM:            "vrlqnm",
+	VSLDBI:            "vsldbi",
+	VSLQ:              "vslq",
+	VSRAQ:             "vsraq",
+	VSRDBI:            "vsrdbi",
+	VSRQ:              "vsrq",
+	VSTRIBL:           "vstribl",
+	VSTRIBLCC:         "vstribl.",
+	VSTRIBR:           "vstribr",
+	VSTRIBRCC:         "vstribr.",
+	VSTRIHL:           "vstrihl",
+	VSTRIHLCC:         "vstrihl.",
+	VSTRIHR:           "vstrihr",
+	VSTRIHRCC:         "vstrihr.",
+	XSCMPEQQP:         "xscmpeqqp",
+	XSCMPGEQP:         "xscmpgeqp",
+	XSCMPGTQP:         "xscmpgtqp",
+	XSCVQPSQZ:         "xscvqpsqz",
+	XSCVQPUQZ:         "xscvqpuqz",
+	XSCVSQQP:          "xscvsqqp",
+	XSCVUQQP:          "xscvuqqp",
+	XSMAXCQP:          "xsmaxcqp",
+	XSMINCQP:          "xsmincqp",
+	XVBF16GER2:        "xvbf16ger2",
+	XVBF16GER2NN:      "xvbf16ger2nn",
+	XVBF16GER2NP:      "xvbf16ger2np",
+	XVBF16GER2PN:      "xvbf16ger2pn",
+	XVBF16GER2PP:      "xvbf16ger2pp",
+	XVCVBF16SPN:       "xvcvbf16spn",
+	XVCVSPBF16:        "xvcvspbf16",
+	XVF16GER2:         "xvf16ger2",
+	XVF16GER2NN:       "xvf16ger2nn",
+	XVF16GER2NP:       "xvf16ger2np",
+	XVF16GER2PN:       "xvf16ger2pn",
+	XVF16GER2PP:       "xvf16ger2pp",
+	XVF32GER:          "xvf32ger",
+	XVF32GERNN:        "xvf32gernn",
+	XVF32GERNP:        "xvf32gernp",
+	XVF32GERPN:        "xvf32gerpn",
+	XVF32GERPP:        "xvf32gerpp",
+	XVF64GER:          "xvf64ger",
+	XVF64GERNN:        "xvf64gernn",
+	XVF64GERNP:        "xvf64gernp",
+	XVF64GERPN:        "xvf64gerpn",
+	XVF64GERPP:        "xvf64gerpp",
+	XVI16GER2:         "xvi16ger2",
+	XVI16GER2PP:       "xvi16ger2pp",
+	XVI16GER2S:        "xvi16ger2s",
+	XVI16GER2SPP:      "xvi16ger2spp",
+	XVI4GER8:          "xvi4ger8",
+	XVI4GER8PP:        "xvi4ger8pp",
+	XVI8GER4:          "xvi8ger4",
+	XVI8GER4PP:        "xvi8ger4pp",
+	XVI8GER4SPP:       "xvi8ger4spp",
+	XVTLSBB:           "xvtlsbb",
+	XXBLENDVB:         "xxblendvb",
+	XXBLENDVD:         "xxblendvd",
+	XXBLENDVH:         "xxblendvh",
+	XXBLENDVW:         "xxblendvw",
+	XXEVAL:            "xxeval",
+	XXGENPCVBM:        "xxgenpcvbm",
+	XXGENPCVDM:        "xxgenpcvdm",
+	XXGENPCVHM:        "xxgenpcvhm",
+	XXGENPCVWM:        "xxgenpcvwm",
+	DMXXMFACC:         "dmxxmfacc",
+	XXMFACC:           "xxmfacc",
+	XXMTACC:           "xxmtacc",
+	XXPERMX:           "xxpermx",
+	XXSETACCZ:         "xxsetaccz",
+	XXSPLTI32DX:       "xxsplti32dx",
+	XXSPLTIDP:         "xxspltidp",
+	XXSPLTIW:          "xxspltiw",
+	MSGCLRU:           "msgclru",
+	MSGSNDU:           "msgsndu",
+	URFID:             "urfid",
+	ADDEX:             "addex",
+	MFFSCDRN:          "mffscdrn",
+	MFFSCDRNI:         "mffscdrni",
+	MFFSCE:            "mffsce",
+	MFFSCRN:           "mffscrn",
+	MFFSCRNI:          "mffscrni",
+	MFFSL:             "mffsl",
+	SLBIAG:            "slbiag",
+	VMSUMUDM:          "vmsumudm",
+	ADDPCIS:           "addpcis",
+	BCDCFNCC:          "bcdcfn.",
+	BCDCFSQCC:         "bcdcfsq.",
+	BCDCFZCC:          "bcdcfz.",
+	BCDCPSGNCC:        "bcdcpsgn.",
+	BCDCTNCC:          "bcdctn.",
+	BCDCTSQCC:         "bcdctsq.",
+	BCDCTZCC:          "bcdctz.",
+	BCDSCC:            "bcds.",
+	BCDSETSGNCC:       "bcdsetsgn.",
+	BCDSRCC:           "bcdsr.",
+	BCDTRUNCCC:        "bcdtrunc.",
+	BCDUSCC:           "bcdus.",
+	BCDUTRUNCCC:       "bcdutrunc.",
+	CMPEQB:            "cmpeqb",
+	CMPRB:             "cmprb",
+	CNTTZD:            "cnttzd",
+	CNTTZDCC:          "cnttzd.",
+	CNTTZW:            "cnttzw",
+	CNTTZWCC:          "cnttzw.",
+	COPY:              "copy",
+	CPABORT:           "cpabort",
+	DARN:              "darn",
+	DTSTSFI:           "dtstsfi",
+	DTSTSFIQ:          "dtstsfiq",
+	EXTSWSLI:          "extswsli",
+	EXTSWSLICC:        "extswsli.",
+	LDAT:              "ldat",
+	LWAT:              "lwat",
+	LXSD:              "lxsd",
+	LXSIBZX:           "lxsibzx",
+	LXSIHZX:           "lxsihzx",
+	LXSSP:             "lxssp",
+	LXV:               "lxv",
+	LXVB16X:           "lxvb16x",
+	LXVH8X:            "lxvh8x",
+	LXVL:              "lxvl",
+	LXVLL:             "lxvll",
+	LXVWSX:            "lxvwsx",
+	LXVX:              "lxvx",
+	MADDHD:            "maddhd",
+	MADDHDU:           "maddhdu",
+	MADDLD:            "maddld",
+	MCRXRX:            "mcrxrx",
+	MFVSRLD:           "mfvsrld",
+	MODSD:             "modsd",
+	MODSW:             "modsw",
+	MODUD:             "modud",
+	MODUW:             "moduw",
+	MSGSYNC:           "msgsync",
+	MTVSRDD:           "mtvsrdd",
+	MTVSRWS:           "mtvsrws",
+	PASTECC:           "paste.",
+	SETB:              "setb",
+	SLBIEG:            "slbieg",
+	SLBSYNC:           "slbsync",
+	STDAT:             "stdat",
+	STOP:              "stop",
+	STWAT:             "stwat",
+	STXSD:             "stxsd",
+	STXSIBX:           "stxsibx",
+	STXSIHX:           "stxsihx",
+	STXSSP:            "stxssp",
+	STXV:              "stxv",
+	STXVB16X:          "stxvb16x",
+	STXVH8X:           "stxvh8x",
+	STXVL:             "stxvl",
+	STXVLL:            "stxvll",
+	STXVX:             "stxvx",
+	VABSDUB:           "vabsdub",
+	VABSDUH:           "vabsduh",
+	VABSDUW:           "vabsduw",
+	VBPERMD:           "vbpermd",
+	VCLZLSBB:          "vclzlsbb",
+	VCMPNEB:           "vcmpneb",
+	VCMPNEBCC:         "vcmpneb.",
+	VCMPNEH:           "vcmpneh",
+	VCMPNEHCC:         "vcmpneh.",
+	VCMPNEW:           "vcmpnew",
+	VCMPNEWCC:         "vcmpnew.",
+	VCMPNEZB:          "vcmpnezb",
+	VCMPNEZBCC:        "vcmpnezb.",
+	VCMPNEZH:          "vcmpnezh",
+	VCMPNEZHCC:        "vcmpnezh.",
+	VCMPNEZW:          "vcmpnezw",
+	VCMPNEZWCC:        "vcmpnezw.",
+	VCTZB:             "vctzb",
+	VCTZD:             "vctzd",
+	VCTZH:             "vctzh",
+	VCTZLSBB:          "vctzlsbb",
+	VCTZW:             "vctzw",
+	VEXTRACTD:         "vextractd",
+	VEXTRACTUB:        "vextractub",
+	VEXTRACTUH:        "vextractuh",
+	VEXTRACTUW:        "vextractuw",
+	VEXTSB2D:          "vextsb2d",
+	VEXTSB2W:          "vextsb2w",
+	VEXTSH2D:          "vextsh2d",
+	VEXTSH2W:          "vextsh2w",
+	VEXTSW2D:          "vextsw2d",
+	VEXTUBLX:          "vextublx",
+	VEXTUBRX:          "vextubrx",
+	VEXTUHLX:          "vextuhlx",
+	VEXTUHRX:          "vextuhrx",
+	VEXTUWLX:          "vextuwlx",
+	VEXTUWRX:          "vextuwrx",
+	VINSERTB:          "vinsertb",
+	VINSERTD:          "vinsertd",
+	VINSERTH:          "vinserth",
+	VINSERTW:          "vinsertw",
+	VMUL10CUQ:         "vmul10cuq",
+	VMUL10ECUQ:        "vmul10ecuq",
+	VMUL10EUQ:         "vmul10euq",
+	VMUL10UQ:          "vmul10uq",
+	VNEGD:             "vnegd",
+	VNEGW:             "vnegw",
+	VPERMR:            "vpermr",
+	VPRTYBD:           "vprtybd",
+	VPRTYBQ:           "vprtybq",
+	VPRTYBW:           "vprtybw",
+	VRLDMI:            "vrldmi",
+	VRLDNM:            "vrldnm",
+	VRLWMI:            "vrlwmi",
+	VRLWNM:            "vrlwnm",
+	VSLV:              "vslv",
+	VSRV:              "vsrv",
+	WAIT:              "wait",
+	XSABSQP:           "xsabsqp",
+	XSADDQP:           "xsaddqp",
+	XSADDQPO:          "xsaddqpo",
+	XSCMPEQDP:         "xscmpeqdp",
+	XSCMPEXPDP:        "xscmpexpdp",
+	XSCMPEXPQP:        "xscmpexpqp",
+	XSCMPGEDP:         "xscmpgedp",
+	XSCMPGTDP:         "xscmpgtdp",
+	XSCMPOQP:          "xscmpoqp",
+	XSCMPUQP:          "xscmpuqp",
+	XSCPSGNQP:         "xscpsgnqp",
+	XSCVDPHP:          "xscvdphp",
+	XSCVDPQP:          "xscvdpqp",
+	XSCVHPDP:          "xscvhpdp",
+	XSCVQPDP:          "xscvqpdp",
+	XSCVQPDPO:         "xscvqpdpo",
+	XSCVQPSDZ:         "xscvqpsdz",
+	XSCVQPSWZ:         "xscvqpswz",
+	XSCVQPUDZ:         "xscvqpudz",
+	XSCVQPUWZ:         "xscvqpuwz",
+	XSCVSDQP:          "xscvsdqp",
+	XSCVUDQP:          "xscvudqp",
+	XSDIVQP:           "xsdivqp",
+	XSDIVQPO:          "xsdivqpo",
+	XSIEXPDP:          "xsiexpdp",
+	XSIEXPQP:          "xsiexpqp",
+	XSMADDQP:          "xsmaddqp",
+	XSMADDQPO:         "xsmaddqpo",
+	XSMAXCDP:          "xsmaxcdp",
+	XSMAXJDP:          "xsmaxjdp",
+	XSMINCDP:          "xsmincdp",
+	XSMINJDP:          "xsminjdp",
+	XSMSUBQP:          "xsmsubqp",
+	XSMSUBQPO:         "xsmsubqpo",
+	XSMULQP:           "xsmulqp",
+	XSMULQPO:          "xsmulqpo",
+	XSNABSQP:          "xsnabsqp",
+	XSNEGQP:           "xsnegqp",
+	XSNMADDQP:         "xsnmaddqp",
+	XSNMADDQPO:        "xsnmaddqpo",
+	XSNMSUBQP:         "xsnmsubqp",
+	XSNMSUBQPO:        "xsnmsubqpo",
+	XSRQPI:            "xsrqpi",
+	XSRQPIX:           "xsrqpix",
+	XSRQPXP:           "xsrqpxp",
+	XSSQRTQP:          "xssqrtqp",
+	XSSQRTQPO:         "xssqrtqpo",
+	XSSUBQP:           "xssubqp",
+	XSSUBQPO:          "xssubqpo",
+	XSTSTDCDP:         "xststdcdp",
+	XSTSTDCQP:         "xststdcqp",
+	XSTSTDCSP:         "xststdcsp",
+	XSXEXPDP:          "xsxexpdp",
+	XSXEXPQP:          "xsxexpqp",
+	XSXSIGDP:          "xsxsigdp",
+	XSXSIGQP:          "xsxsigqp",
+	XVCVHPSP:          "xvcvhpsp",
+	XVCVSPHP:          "xvcvsphp",
+	XVIEXPDP:          "xviexpdp",
+	XVIEXPSP:          "xviexpsp",
+	XVTSTDCDP:         "xvtstdcdp",
+	XVTSTDCSP:         "xvtstdcsp",
+	XVXEXPDP:          "xvxexpdp",
+	XVXEXPSP:          "xvxexpsp",
+	XVXSIGDP:          "xvxsigdp",
+	XVXSIGSP:          "xvxsigsp",
+	XXBRD:             "xxbrd",
+	XXBRH:             "xxbrh",
+	XXBRQ:             "xxbrq",
+	XXBRW:             "xxbrw",
+	XXEXTRACTUW:       "xxextractuw",
+	XXINSERTW:         "xxinsertw",
+	XXPERM:            "xxperm",
+	XXPERMR:           "xxpermr",
+	XXSPLTIB:          "xxspltib",
+	BCDADDCC:          "bcdadd.",
+	BCDSUBCC:          "bcdsub.",
+	BCTAR:             "bctar",
+	BCTARL:            "bctarl",
+	CLRBHRB:           "clrbhrb",
+	FMRGEW:            "fmrgew",
+	FMRGOW:            "fmrgow",
+	ICBT:              "icbt",
+	LQARX:             "lqarx",
+	LXSIWAX:           "lxsiwax",
+	LXSIWZX:           "lxsiwzx",
+	LXSSPX:            "lxsspx",
+	MFBHRBE:           "mfbhrbe",
+	MFVSRD:            "mfvsrd",
+	MFVSRWZ:           "mfvsrwz",
+	MSGCLR:            "msgclr",
+	MSGCLRP:           "msgclrp",
+	MSGSND:            "msgsnd",
+	MSGSNDP:           "msgsndp",
+	MTVSRD:            "mtvsrd",
+	MTVSRWA:           "mtvsrwa",
+	MTVSRWZ:           "mtvsrwz",
+	RFEBB:             "rfebb",
+	STQCXCC:           "stqcx.",
+	STXSIWX:           "stxsiwx",
+	STXSSPX:           "stxsspx",
+	VADDCUQ:           "vaddcuq",
+	VADDECUQ:          "vaddecuq",
+	VADDEUQM:          "vaddeuqm",
+	VADDUDM:           "vaddudm",
+	VADDUQM:           "vadduqm",
+	VBPERMQ:           "vbpermq",
+	VCIPHER:           "vcipher",
+	VCIPHERLAST:       "vcipherlast",
+	VCLZB:             "vclzb",
+	VCLZD:             "vclzd",
+	VCLZH:             "vclzh",
+	VCLZW:             "vclzw",
+	VCMPEQUD:          "vcmpequd",
+	VCMPEQUDCC:        "vcmpequd.",
+	VCMPGTSD:          "vcmpgtsd",
+	VCMPGTSDCC:        "vcmpgtsd.",
+	VCMPGTUD:          "vcmpgtud",
+	VCMPGTUDCC:        "vcmpgtud.",
+	VEQV:              "veqv",
+	VGBBD:             "vgbbd",
+	VMAXSD:            "vmaxsd",
+	VMAXUD:            "vmaxud",
+	VMINSD:            "vminsd",
+	VMINUD:            "vminud",
+	VMRGEW:            "vmrgew",
+	VMRGOW:            "vmrgow",
+	VMULESW:           "vmulesw",
+	VMULEUW:           "vmuleuw",
+	VMULOSW:           "vmulosw",
+	VMULOUW:           "vmulouw",
+	VMULUWM:           "vmuluwm",
+	VNAND:             "vnand",
+	VNCIPHER:          "vncipher",
+	VNCIPHERLAST:      "vncipherlast",
+	VORC:              "vorc",
+	VPERMXOR:          "vpermxor",
+	VPKSDSS:           "vpksdss",
+	VPKSDUS:           "vpksdus",
+	VPKUDUM:           "vpkudum",
+	VPKUDUS:           "vpkudus",
+	VPMSUMB:           "vpmsumb",
+	VPMSUMD:           "vpmsumd",
+	VPMSUMH:           "vpmsumh",
+	VPMSUMW:           "vpmsumw",
+	VPOPCNTB:          "vpopcntb",
+	VPOPCNTD:          "vpopcntd",
+	VPOPCNTH:          "vpopcnth",
+	VPOPCNTW:          "vpopcntw",
+	VRLD:              "vrld",
+	VSBOX:             "vsbox",
+	VSHASIGMAD:        "vshasigmad",
+	VSHASIGMAW:        "vshasigmaw",
+	VSLD:              "vsld",
+	VSRAD:             "vsrad",
+	VSRD:              "vsrd",
+	VSUBCUQ:           "vsubcuq",
+	VSUBECUQ:          "vsubecuq",
+	VSUBEUQM:          "vsubeuqm",
+	VSUBUDM:           "vsubudm",
+	VSUBUQM:           "vsubuqm",
+	VUPKHSW:           "vupkhsw",
+	VUPKLSW:           "vupklsw",
+	XSADDSP:           "xsaddsp",
+	XSCVDPSPN:         "xscvdpspn",
+	XSCVSPDPN:         "xscvspdpn",
+	XSCVSXDSP:         "xscvsxdsp",
+	XSCVUXDSP:         "xscvuxdsp",
+	XSDIVSP:           "xsdivsp",
+	XSMADDASP:         "xsmaddasp",
+	XSMADDMSP:         "xsmaddmsp",
+	XSMSUBASP:         "xsmsubasp",
+	XSMSUBMSP:         "xsmsubmsp",
+	XSMULSP:           "xsmulsp",
+	XSNMADDASP:        "xsnmaddasp",
+	XSNMADDMSP:        "xsnmaddmsp",
+	XSNMSUBASP:        "xsnmsubasp",
+	XSNMSUBMSP:        "xsnmsubmsp",
+	XSRESP:            "xsresp",
+	XSRSP:             "xsrsp",
+	XSRSQRTESP:        "xsrsqrtesp",
+	XSSQRTSP:          "xssqrtsp",
+	XSSUBSP:           "xssubsp",
+	XXLEQV:            "xxleqv",
+	XXLNAND:           "xxlnand",
+	XXLORC:            "xxlorc",
+	ADDG6S:            "addg6s",
+	BPERMD:            "bpermd",
+	CBCDTD:            "cbcdtd",
+	CDTBCD:            "cdtbcd",
+	DCFFIX:            "dcffix",
+	DCFFIXCC:          "dcffix.",
+	DIVDE:             "divde",
+	DIVDECC:           "divde.",
+	DIVDEO:            "divdeo",
+	DIVDEOCC:          "divdeo.",
+	DIVDEU:            "divdeu",
+	DIVDEUCC:          "divdeu.",
+	DIVDEUO:           "divdeuo",
+	DIVDEUOCC:         "divdeuo.",
+	DIVWE:             "divwe",
+	DIVWECC:           "divwe.",
+	DIVWEO:            "divweo",
+	DIVWEOCC:          "divweo.",
+	DIVWEU:            "divweu",
+	DIVWEUCC:          "divweu.",
+	DIVWEUO:           "divweuo",
+	DIVWEUOCC:         "divweuo.",
+	FCFIDS:            "fcfids",
+	FCFIDSCC:          "fcfids.",
+	FCFIDU:            "fcfidu",
+	FCFIDUCC:          "fcfidu.",
+	FCFIDUS:           "fcfidus",
+	FCFIDUSCC:         "fcfidus.",
+	FCTIDU:            "fctidu",
+	FCTIDUCC:          "fctidu.",
+	FCTIDUZ:           "fctiduz",
+	FCTIDUZCC:         "fctiduz.",
+	FCTIWU:            "fctiwu",
+	FCTIWUCC:          "fctiwu.",
+	FCTIWUZ:           "fctiwuz",
+	FCTIWUZCC:         "fctiwuz.",
+	FTDIV:             "ftdiv",
+	FTSQRT:            "ftsqrt",
+	LBARX:             "lbarx",
+	LDBRX:             "ldbrx",
+	LFIWZX:            "lfiwzx",
+	LHARX:             "lharx",
+	LXSDX:             "lxsdx",
+	LXVD2X:            "lxvd2x",
+	LXVDSX:            "lxvdsx",
+	LXVW4X:            "lxvw4x",
+	POPCNTD:           "popcntd",
+	POPCNTW:           "popcntw",
+	STBCXCC:           "stbcx.",
+	STDBRX:            "stdbrx",
+	STHCXCC:           "sthcx.",
+	STXSDX:            "stxsdx",
+	STXVD2X:           "stxvd2x",
+	STXVW4X:           "stxvw4x",
+	XSABSDP:           "xsabsdp",
+	XSADDDP:           "xsadddp",
+	XSCMPODP:          "xscmpodp",
+	XSCMPUDP:          "xscmpudp",
+	XSCPSGNDP:         "xscpsgndp",
+	XSCVDPSP:          "xscvdpsp",
+	XSCVDPSXDS:        "xscvdpsxds",
+	XSCVDPSXWS:        "xscvdpsxws",
+	XSCVDPUXDS:        "xscvdpuxds",
+	XSCVDPUXWS:        "xscvdpuxws",
+	XSCVSPDP:          "xscvspdp",
+	XSCVSXDDP:         "xscvsxddp",
+	XSCVUXDDP:         "xscvuxddp",
+	XSDIVDP:           "xsdivdp",
+	XSMADDADP:         "xsmaddadp",
+	XSMADDMDP:         "xsmaddmdp",
+	XSMAXDP:           "xsmaxdp",
+	XSMINDP:           "xsmindp",
+	XSMSUBADP:         "xsmsubadp",
+	XSMSUBMDP:         "xsmsubmdp",
+	XSMULDP:           "xsmuldp",
+	XSNABSDP:          "xsnabsdp",
+	XSNEGDP:           "xsnegdp",
+	XSNMADDADP:        "xsnmaddadp",
+	XSNMADDMDP:        "xsnmaddmdp",
+	XSNMSUBADP:        "xsnmsubadp",
+	XSNMSUBMDP:        "xsnmsubmdp",
+	XSRDPI:            "xsrdpi",
+	XSRDPIC:           "xsrdpic",
+	XSRDPIM:           "xsrdpim",
+	XSRDPIP:           "xsrdpip",
+	XSRDPIZ:           "xsrdpiz",
+	XSREDP:            "xsredp",
+	XSRSQRTEDP:        "xsrsqrtedp",
+	XSSQRTDP:          "xssqrtdp",
+	XSSUBDP:           "xssubdp",
+	XSTDIVDP:          "xstdivdp",
+	XSTSQRTDP:         "xstsqrtdp",
+	XVABSDP:           "xvabsdp",
+	XVABSSP:           "xvabssp",
+	XVADDDP:           "xvadddp",
+	XVADDSP:           "xvaddsp",
+	XVCMPEQDP:         "xvcmpeqdp",
+	XVCMPEQDPCC:       "xvcmpeqdp.",
+	XVCMPEQSP:         "xvcmpeqsp",
+	XVCMPEQSPCC:       "xvcmpeqsp.",
+	XVCMPGEDP:         "xvcmpgedp",
+	XVCMPGEDPCC:       "xvcmpgedp.",
+	XVCMPGESP:         "xvcmpgesp",
+	XVCMPGESPCC:       "xvcmpgesp.",
+	XVCMPGTDP:         "xvcmpgtdp",
+	XVCMPGTDPCC:       "xvcmpgtdp.",
+	XVCMPGTSP:         "xvcmpgtsp",
+	XVCMPGTSPCC:       "xvcmpgtsp.",
+	XVCPSGNDP:         "xvcpsgndp",
+	XVCPSGNSP:         "xvcpsgnsp",
+	XVCVDPSP:          "xvcvdpsp",
+	XVCVDPSXDS:        "xvcvdpsxds",
+	XVCVDPSXWS:        "xvcvdpsxws",
+	XVCVDPUXDS:        "xvcvdpuxds",
+	XVCVDPUXWS:        "xvcvdpuxws",
+	XVCVSPDP:          "xvcvspdp",
+	XVCVSPSXDS:        "xvcvspsxds",
+	XVCVSPSXWS:        "xvcvspsxws",
+	XVCVSPUXDS:        "xvcvspuxds",
+	XVCVSPUXWS:        "xvcvspuxws",
+	XVCVSXDDP:         "xvcvsxddp",
+	XVCVSXDSP:         "xvcvsxdsp",
+	XVCVSXWDP:         "xvcvsxwdp",
+	XVCVSXWSP:         "xvcvsxwsp",
+	XVCVUXDDP:         "xvcvuxddp",
+	XVCVUXDSP:         "xvcvuxdsp",
+	XVCVUXWDP:         "xvcvuxwdp",
+	XVCVUXWSP:         "xvcvuxwsp",
+	XVDIVDP:           "xvdivdp",
+	XVDIVSP:           "xvdivsp",
+	XVMADDADP:         "xvmaddadp",
+	XVMADDASP:         "xvmaddasp",
+	XVMADDMDP:         "xvmaddmdp",
+	XVMADDMSP:         "xvmaddmsp",
+	XVMAXDP:           "xvmaxdp",
+	XVMAXSP:           "xvmaxsp",
+	XVMINDP:           "xvmindp",
+	XVMINSP:           "xvminsp",
+	XVMSUBADP:         "xvmsubadp",
+	XVMSUBASP:         "xvmsubasp",
+	XVMSUBMDP:         "xvmsubmdp",
+	XVMSUBMSP:         "xvmsubmsp",
+	XVMULDP:           "xvmuldp",
+	XVMULSP:           "xvmulsp",
+	XVNABSDP:          "xvnabsdp",
+	XVNABSSP:          "xvnabssp",
+	XVNEGDP:           "xvnegdp",
+	XVNEGSP:           "xvnegsp",
+	XVNMADDADP:        "xvnmaddadp",
+	XVNMADDASP:        "xvnmaddasp",
+	XVNMADDMDP:        "xvnmaddmdp",
+	XVNMADDMSP:        "xvnmaddmsp",
+	XVNMSUBADP:        "xvnmsubadp",
+	XVNMSUBASP:        "xvnmsubasp",
+	XVNMSUBMDP:        "xvnmsubmdp",
+	XVNMSUBMSP:        "xvnmsubmsp",
+	XVRDPI:            "xvrdpi",
+	XVRDPIC:           "xvrdpic",
+	XVRDPIM:           "xvrdpim",
+	XVRDPIP:           "xvrdpip",
+	XVRDPIZ:           "xvrdpiz",
+	XVREDP:            "xvredp",
+	XVRESP:            "xvresp",
+	XVRSPI:            "xvrspi",
+	XVRSPIC:           "xvrspic",
+	XVRSPIM:           "xvrspim",
+	XVRSPIP:           "xvrspip",
+	XVRSPIZ:           "xvrspiz",
+	XVRSQRTEDP:        "xvrsqrtedp",
+	XVRSQRTESP:        "xvrsqrtesp",
+	XVSQRTDP:          "xvsqrtdp",
+	XVSQRTSP:          "xvsqrtsp",
+	XVSUBDP:           "xvsubdp",
+	XVSUBSP:           "xvsubsp",
+	XVTDIVDP:          "xvtdivdp",
+	XVTDIVSP:          "xvtdivsp",
+	XVTSQRTDP:         "xvtsqrtdp",
+	XVTSQRTSP:         "xvtsqrtsp",
+	XXLAND:            "xxland",
+	XXLANDC:           "xxlandc",
+	XXLNOR:            "xxlnor",
+	XXLOR:             "xxlor",
+	XXLXOR:            "xxlxor",
+	XXMRGHW:           "xxmrghw",
+	XXMRGLW:           "xxmrglw",
+	XXPERMDI:          "xxpermdi",
+	XXSEL:             "xxsel",
+	XXSLDWI:           "xxsldwi",
+	XXSPLTW:           "xxspltw",
+	CMPB:              "cmpb",
+	DADD:              "dadd",
+	DADDCC:            "dadd.",
+	DADDQ:             "daddq",
+	DADDQCC:           "daddq.",
+	DCFFIXQ:           "dcffixq",
+	DCFFIXQCC:         "dcffixq.",
+	DCMPO:             "dcmpo",
+	DCMPOQ:            "dcmpoq",
+	DCMPU:             "dcmpu",
+	DCMPUQ:            "dcmpuq",
+	DCTDP:             "dctdp",
+	DCTDPCC:           "dctdp.",
+	DCTFIX:            "dctfix",
+	DCTFIXCC:          "dctfix.",
+	DCTFIXQ:           "dctfixq",
+	DCTFIXQCC:         "dctfixq.",
+	DCTQPQ:            "dctqpq",
+	DCTQPQCC:          "dctqpq.",
+	DDEDPD:            "ddedpd",
+	DDEDPDCC:          "ddedpd.",
+	DDEDPDQ:           "ddedpdq",
+	DDEDPDQCC:         "ddedpdq.",
+	DDIV:              "ddiv",
+	DDIVCC:            "ddiv.",
+	DDIVQ:             "ddivq",
+	DDIVQCC:           "ddivq.",
+	DENBCD:            "denbcd",
+	DENBCDCC:          "denbcd.",
+	DENBCDQ:           "denbcdq",
+	DENBCDQCC:         "denbcdq.",
+	DIEX:              "diex",
+	DIEXCC:            "diex.",
+	DIEXQCC:           "diexq.",
+	DIEXQ:             "diexq",
+	DMUL:              "dmul",
+	DMULCC:            "dmul.",
+	DMULQ:             "dmulq",
+	DMULQCC:           "dmulq.",
+	DQUA:              "dqua",
+	DQUACC:            "dqua.",
+	DQUAI:             "dquai",
+	DQUAICC:           "dquai.",
+	DQUAIQ:            "dquaiq",
+	DQUAIQCC:          "dquaiq.",
+	DQUAQ:             "dquaq",
+	DQUAQCC:           "dquaq.",
+	DRDPQ:             "drdpq",
+	DRDPQCC:           "drdpq.",
+	DRINTN:            "drintn",
+	DRINTNCC:          "drintn.",
+	DRINTNQ:           "drintnq",
+	DRINTNQCC:         "drintnq.",
+	DRINTX:            "drintx",
+	DRINTXCC:          "drintx.",
+	DRINTXQ:           "drintxq",
+	DRINTXQCC:         "drintxq.",
+	DRRND:             "drrnd",
+	DRRNDCC:           "drrnd.",
+	DRRNDQ:            "drrndq",
+	DRRNDQCC:          "drrndq.",
+	DRSP:              "drsp",
+	DRSPCC:            "drsp.",
+	DSCLI:             "dscli",
+	DSCLICC:           "dscli.",
+	DSCLIQ:            "dscliq",
+	DSCLIQCC:          "dscliq.",
+	DSCRI:             "dscri",
+	DSCRICC:           "dscri.",
+	DSCRIQ:            "dscriq",
+	DSCRIQCC:          "dscriq.",
+	DSUB:              "dsub",
+	DSUBCC:            "dsub.",
+	DSUBQ:             "dsubq",
+	DSUBQCC:           "dsubq.",
+	DTSTDC:            "dtstdc",
+	DTSTDCQ:           "dtstdcq",
+	DTSTDG:            "dtstdg",
+	DTSTDGQ:           "dtstdgq",
+	DTSTEX:            "dtstex",
+	DTSTEXQ:           "dtstexq",
+	DTSTSF:            "dtstsf",
+	DTSTSFQ:           "dtstsfq",
+	DXEX:              "dxex",
+	DXEXCC:            "dxex.",
+	DXEXQ:             "dxexq",
+	DXEXQCC:           "dxexq.",
+	FCPSGN:            "fcpsgn",
+	FCPSGNCC:          "fcpsgn.",
+	LBZCIX:            "lbzcix",
+	LDCIX:             "ldcix",
+	LFDP:              "lfdp",
+	LFDPX:             "lfdpx",
+	LFIWAX:            "lfiwax",
+	LHZCIX:            "lhzcix",
+	LWZCIX:            "lwzcix",
+	PRTYD:             "prtyd",
+	PRTYW:             "prtyw",
+	SLBFEECC:          "slbfee.",
+	STBCIX:            "stbcix",
+	STDCIX:            "stdcix",
+	STFDP:             "stfdp",
+	STFDPX:            "stfdpx",
+	STHCIX:            "sthcix",
+	STWCIX:            "stwcix",
+	ISEL:              "isel",
+	LVEBX:             "lvebx",
+	LVEHX:             "lvehx",
+	LVEWX:             "lvewx",
+	LVSL:              "lvsl",
+	LVSR:              "lvsr",
+	LVX:               "lvx",
+	LVXL:              "lvxl",
+	MFVSCR:            "mfvscr",
+	MTVSCR:            "mtvscr",
+	STVEBX:            "stvebx",
+	STVEHX:            "stvehx",
+	STVEWX:            "stvewx",
+	STVX:              "stvx",
+	STVXL:             "stvxl",
+	TLBIEL:            "tlbiel",
+	VADDCUW:           "vaddcuw",
+	VADDFP:            "vaddfp",
+	VADDSBS:           "vaddsbs",
+	VADDSHS:           "vaddshs",
+	VADDSWS:           "vaddsws",
+	VADDUBM:           "vaddubm",
+	VADDUBS:           "vaddubs",
+	VADDUHM:           "vadduhm",
+	VADDUHS:           "vadduhs",
+	VADDUWM:           "vadduwm",
+	VADDUWS:           "vadduws",
+	VAND:              "vand",
+	VANDC:             "vandc",
+	VAVGSB:            "vavgsb",
+	VAVGSH:            "vavgsh",
+	VAVGSW:            "vavgsw",
+	VAVGUB:            "vavgub",
+	VAVGUH:            "vavguh",
+	VAVGUW:            "vavguw",
+	VCFSX:             "vcfsx",
+	VCFUX:             "vcfux",
+	VCMPBFP:           "vcmpbfp",
+	VCMPBFPCC:         "vcmpbfp.",
+	VCMPEQFP:          "vcmpeqfp",
+	VCMPEQFPCC:        "vcmpeqfp.",
+	VCMPEQUB:          "vcmpequb",
+	VCMPEQUBCC:        "vcmpequb.",
+	VCMPEQUH:          "vcmpequh",
+	VCMPEQUHCC:        "vcmpequh.",
+	VCMPEQUW:          "vcmpequw",
+	VCMPEQUWCC:        "vcmpequw.",
+	VCMPGEFP:          "vcmpgefp",
+	VCMPGEFPCC:        "vcmpgefp.",
+	VCMPGTFP:          "vcmpgtfp",
+	VCMPGTFPCC:        "vcmpgtfp.",
+	VCMPGTSB:          "vcmpgtsb",
+	VCMPGTSBCC:        "vcmpgtsb.",
+	VCMPGTSH:          "vcmpgtsh",
+	VCMPGTSHCC:        "vcmpgtsh.",
+	VCMPGTSW:          "vcmpgtsw",
+	VCMPGTSWCC:        "vcmpgtsw.",
+	VCMPGTUB:          "vcmpgtub",
+	VCMPGTUBCC:        "vcmpgtub.",
+	VCMPGTUH:          "vcmpgtuh",
+	VCMPGTUHCC:        "vcmpgtuh.",
+	VCMPGTUW:          "vcmpgtuw",
+	VCMPGTUWCC:        "vcmpgtuw.",
+	VCTSXS:            "vctsxs",
+	VCTUXS:            "vctuxs",
+	VEXPTEFP:          "vexptefp",
+	VLOGEFP:           "vlogefp",
+	VMADDFP:           "vmaddfp",
+	VMAXFP:            "vmaxfp",
+	VMAXSB:            "vmaxsb",
+	VMAXSH:            "vmaxsh",
+	VMAXSW:            "vmaxsw",
+	VMAXUB:            "vmaxub",
+	VMAXUH:            "vmaxuh",
+	VMAXUW:            "vmaxuw",
+	VMHADDSHS:         "vmhaddshs",
+	VMHRADDSHS:        "vmhraddshs",
+	VMINFP:            "vminfp",
+	VMINSB:            "vminsb",
+	VMINSH:            "vminsh",
+	VMINSW:            "vminsw",
+	VMINUB:            "vminub",
+	VMINUH:            "vminuh",
+	VMINUW:            "vminuw",
+	VMLADDUHM:         "vmladduhm",
+	VMRGHB:            "vmrghb",
+	VMRGHH:            "vmrghh",
+	VMRGHW:            "vmrghw",
+	VMRGLB:            "vmrglb",
+	VMRGLH:            "vmrglh",
+	VMRGLW:            "vmrglw",
+	VMSUMMBM:          "vmsummbm",
+	VMSUMSHM:          "vmsumshm",
+	VMSUMSHS:          "vmsumshs",
+	VMSUMUBM:          "vmsumubm",
+	VMSUMUHM:          "vmsumuhm",
+	VMSUMUHS:          "vmsumuhs",
+	VMULESB:           "vmulesb",
+	VMULESH:           "vmulesh",
+	VMULEUB:           "vmuleub",
+	VMULEUH:           "vmuleuh",
+	VMULOSB:           "vmulosb",
+	VMULOSH:           "vmulosh",
+	VMULOUB:           "vmuloub",
+	VMULOUH:           "vmulouh",
+	VNMSUBFP:          "vnmsubfp",
+	VNOR:              "vnor",
+	VOR:               "vor",
+	VPERM:             "vperm",
+	VPKPX:             "vpkpx",
+	VPKSHSS:           "vpkshss",
+	VPKSHUS:           "vpkshus",
+	VPKSWSS:           "vpkswss",
+	VPKSWUS:           "vpkswus",
+	VPKUHUM:           "vpkuhum",
+	VPKUHUS:           "vpkuhus",
+	VPKUWUM:           "vpkuwum",
+	VPKUWUS:           "vpkuwus",
+	VREFP:             "vrefp",
+	VRFIM:             "vrfim",
+	VRFIN:             "vrfin",
+	VRFIP:             "vrfip",
+	VRFIZ:             "vrfiz",
+	VRLB:              "vrlb",
+	VRLH:              "vrlh",
+	VRLW:              "vrlw",
+	VRSQRTEFP:         "vrsqrtefp",
+	VSEL:              "vsel",
+	VSL:               "vsl",
+	VSLB:              "vslb",
+	VSLDOI:            "vsldoi",
+	VSLH:              "vslh",
+	VSLO:              "vslo",
+	VSLW:              "vslw",
+	VSPLTB:            "vspltb",
+	VSPLTH:            "vsplth",
+	VSPLTISB:          "vspltisb",
+	VSPLTISH:          "vspltish",
+	VSPLTISW:          "vspltisw",
+	VSPLTW:            "vspltw",
+	VSR:               "vsr",
+	VSRAB:             "vsrab",
+	VSRAH:             "vsrah",
+	VSRAW:             "vsraw",
+	VSRB:              "vsrb",
+	VSRH:              "vsrh",
+	VSRO:              "vsro",
+	VSRW:              "vsrw",
+	VSUBCUW:           "vsubcuw",
+	VSUBFP:            "vsubfp",
+	VSUBSBS:           "vsubsbs",
+	VSUBSHS:           "vsubshs",
+	VSUBSWS:           "vsubsws",
+	VSUBUBM:           "vsububm",
+	VSUBUBS:           "vsububs",
+	VSUBUHM:           "vsubuhm",
+	VSUBUHS:           "vsubuhs",
+	VSUBUWM:           "vsubuwm",
+	VSUBUWS:           "vsubuws",
+	VSUM2SWS:          "vsum2sws",
+	VSUM4SBS:          "vsum4sbs",
+	VSUM4SHS:          "vsum4shs",
+	VSUM4UBS:          "vsum4ubs",
+	VSUMSWS:           "vsumsws",
+	VUPKHPX:           "vupkhpx",
+	VUPKHSB:           "vupkhsb",
+	VUPKHSH:           "vupkhsh",
+	VUPKLPX:           "vupklpx",
+	VUPKLSB:           "vupklsb",
+	VUPKLSH:           "vupklsh",
+	VXOR:              "vxor",
+	FRE:               "fre",
+	FRECC:             "fre.",
+	FRIM:              "frim",
+	FRIMCC:            "frim.",
+	FRIN:              "frin",
+	FRINCC:            "frin.",
+	FRIP:              "frip",
+	FRIPCC:            "frip.",
+	FRIZ:              "friz",
+	FRIZCC:            "friz.",
+	FRSQRTES:          "frsqrtes",
+	FRSQRTESCC:        "frsqrtes.",
+	HRFID:             "hrfid",
+	POPCNTB:           "popcntb",
+	MFOCRF:            "mfocrf",
+	MTOCRF:            "mtocrf",
+	SLBMFEE:           "slbmfee",
+	SLBMFEV:           "slbmfev",
+	SLBMTE:            "slbmte",
+	RFSCV:             "rfscv",
+	SCV:               "scv",
+	LQ:                "lq",
+	STQ:               "stq",
+	CNTLZD:            "cntlzd",
+	CNTLZDCC:          "cntlzd.",
+	DCBF:              "dcbf",
+	DCBST:             "dcbst",
+	DCBT:              "dcbt",
+	DCBTST:            "dcbtst",
+	DIVD:              "divd",
+	DIVDCC:            "divd.",
+	DIVDO:             "divdo",
+	DIVDOCC:           "divdo.",
+	DIVDU:             "divdu",
+	DIVDUCC:           "divdu.",
+	DIVDUO:            "divduo",
+	DIVDUOCC:          "divduo.",
+	DIVW:              "divw",
+	DIVWCC:            "divw.",
+	DIVWO:             "divwo",
+	DIVWOCC:           "divwo.",
+	DIVWU:             "divwu",
+	DIVWUCC:           "divwu.",
+	DIVWUO:            "divwuo",
+	DIVWUOCC:          "divwuo.",
+	EIEIO:             "eieio",
+	EXTSB:             "extsb",
+	EXTSBCC:           "extsb.",
+	EXTSW:             "extsw",
+	EXTSWCC:           "extsw.",
+	FADDS:             "fadds",
+	FADDSCC:           "fadds.",
+	FCFID:             "fcfid",
+	FCFIDCC:           "fcfid.",
+	FCTID:             "fctid",
+	FCTIDCC:           "fctid.",
+	FCTIDZ:            "fctidz",
+	FCTIDZCC:          "fctidz.",
+	FDIVS:             "fdivs",
+	FDIVSCC:           "fdivs.",
+	FMADDS:            "fmadds",
+	FMADDSCC:          "fmadds.",
+	FMSUBS:            "fmsubs",
+	FMSUBSCC:          "fmsubs.",
+	FMULS:             "fmuls",
+	FMULSCC:           "fmuls.",
+	FNMADDS:           "fnmadds",
+	FNMADDSCC:         "fnmadds.",
+	FNMSUBS:           "fnmsubs",
+	FNMSUBSCC:         "fnmsubs.",
+	FRES:              "fres",
+	FRESCC:            "fres.",
+	FRSQRTE:           "frsqrte",
+	FRSQRTECC:         "frsqrte.",
+	FSEL:              "fsel",
+	FSELCC:            "fsel.",
+	FSQRTS:            "fsqrts",
+	FSQRTSCC:          "fsqrts.",
+	FSUBS:             "fsubs",
+	FSUBSCC:           "fsubs.",
+	ICBI:              "icbi",
+	LD:                "ld",
+	LDARX:             "ldarx",
+	LDU:               "ldu",
+	LDUX:              "ldux",
+	LDX:               "ldx",
+	LWA:               "lwa",
+	LWARX:             "lwarx",
+	LWAUX:             "lwaux",
+	LWAX:              "lwax",
+	MFTB:              "mftb",
+	MTMSRD:            "mtmsrd",
+	MULHD:             "mulhd",
+	MULHDCC:           "mulhd.",
+	MULHDU:            "mulhdu",
+	MULHDUCC:          "mulhdu.",
+	MULHW:             "mulhw",
+	MULHWCC:           "mulhw.",
+	MULHWU:            "mulhwu",
+	MULHWUCC:          "mulhwu.",
+	MULLD:             "mulld",
+	MULLDCC:           "mulld.",
+	MULLDO:            "mulldo",
+	MULLDOCC:          "mulldo.",
+	RFID:              "rfid",
+	RLDCL:             "rldcl",
+	RLDCLCC:           "rldcl.",
+	RLDCR:             "rldcr",
+	RLDCRCC:           "rldcr.",
+	RLDIC:             "rldic",
+	RLDICCC:           "rldic.",
+	RLDICL:            "rldicl",
+	RLDICLCC:          "rldicl.",
+	RLDICR:            "rldicr",
+	RLDICRCC:          "rldicr.",
+	RLDIMI:            "rldimi",
+	RLDIMICC:          "rldimi.",
+	SC:                "sc",
+	SLBIA:             "slbia",
+	SLBIE:             "slbie",
+	SLD:               "sld",
+	SLDCC:             "sld.",
+	SRAD:              "srad",
+	SRADCC:            "srad.",
+	SRADI:             "sradi",
+	SRADICC:           "sradi.",
+	SRD:               "srd",
+	SRDCC:             "srd.",
+	STD:               "std",
+	STDCXCC:           "stdcx.",
+	STDU:              "stdu",
+	STDUX:             "stdux",
+	STDX:              "stdx",
+	STFIWX:            "stfiwx",
+	STWCXCC:           "stwcx.",
+	SUBF:              "subf",
+	SUBFCC:            "subf.",
+	SUBFO:             "subfo",
+	SUBFOCC:           "subfo.",
+	TD:                "td",
+	TDI:               "tdi",
+	TLBSYNC:           "tlbsync",
+	FCTIW:             "fctiw",
+	FCTIWCC:           "fctiw.",
+	FCTIWZ:            "fctiwz",
+	FCTIWZCC:          "fctiwz.",
+	FSQRT:             "fsqrt",
+	FSQRTCC:           "fsqrt.",
+	ADD:               "add",
+	ADDCC:             "add.",
+	ADDO:              "addo",
+	ADDOCC:            "addo.",
+	ADDC:              "addc",
+	ADDCCC:            "addc.",
+	ADDCO:             "addco",
+	ADDCOCC:           "addco.",
+	ADDE:              "adde",
+	ADDECC:            "adde.",
+	ADDEO:             "addeo",
+	ADDEOCC:           "addeo.",
+	LI:                "li",
+	ADDI:              "addi",
+	ADDIC:             "addic",
+	ADDICCC:           "addic.",
+	LIS:               "lis",
+	ADDIS:             "addis",
+	ADDME:             "addme",
+	ADDMECC:           "addme.",
+	ADDMEO:            "addmeo",
+	ADDMEOCC:          "addmeo.",
+	ADDZE:             "addze",
+	ADDZECC:           "addze.",
+	ADDZEO:            "addzeo",
+	ADDZEOCC:          "addzeo.",
+	AND:               "and",
+	ANDCC:             "and.",
+	ANDC:              "andc",
+	ANDCCC:            "andc.",
+	ANDICC:            "andi.",
+	ANDISCC:           "andis.",
+	B:                 "b",
+	BA:                "ba",
+	BL:                "bl",
+	BLA:               "bla",
+	BC:                "bc",
+	BCA:               "bca",
+	BCL:               "bcl",
+	BCLA:              "bcla",
+	BCCTR:             "bcctr",
+	BCCTRL:            "bcctrl",
+	BCLR:              "bclr",
+	BCLRL:             "bclrl",
+	CMPW:              "cmpw",
+	CMPD:              "cmpd",
+	CMP:               "cmp",
+	CMPWI:             "cmpwi",
+	CMPDI:             "cmpdi",
+	CMPI:              "cmpi",
+	CMPLW:             "cmplw",
+	CMPLD:             "cmpld",
+	CMPL:              "cmpl",
+	CMPLWI:            "cmplwi",
+	CMPLDI:            "cmpldi",
+	CMPLI:             "cmpli",
+	CNTLZW:            "cntlzw",
+	CNTLZWCC:          "cntlzw.",
+	CRAND:             "crand",
+	CRANDC:            "crandc",
+	CREQV:             "creqv",
+	CRNAND:            "crnand",
+	CRNOR:             "crnor",
+	CROR:              "cror",
+	CRORC:             "crorc",
+	CRXOR:             "crxor",
+	DCBZ:              "dcbz",
+	EQV:               "eqv",
+	EQVCC:             "eqv.",
+	EXTSH:             "extsh",
+	EXTSHCC:           "extsh.",
+	FABS:              "fabs",
+	FABSCC:            "fabs.",
+	FADD:              "fadd",
+	FADDCC:            "fadd.",
+	FCMPO:             "fcmpo",
+	FCMPU:             "fcmpu",
+	FDIV:              "fdiv",
+	FDIVCC:            "fdiv.",
+	FMADD:             "fmadd",
+	FMADDCC:           "fmadd.",
+	FMR:               "fmr",
+	FMRCC:             "fmr.",
+	FMSUB:             "fmsub",
+	FMSUBCC:           "fmsub.",
+	FMUL:              "fmul",
+	FMULCC:            "fmul.",
+	FNABS:             "fnabs",
+	FNABSCC:           "fnabs.",
+	FNEG:              "fneg",
+	FNEGCC:            "fneg.",
+	FNMADD:            "fnmadd",
+	FNMADDCC:          "fnmadd.",
+	FNMSUB:            "fnmsub",
+	FNMSUBCC:          "fnmsub.",
+	FRSP:              "frsp",
+	FRSPCC:            "frsp.",
+	FSUB:              "fsub",
+	FSUBCC:            "fsub.",
+	ISYNC:             "isync",
+	LBZ:               "lbz",
+	LBZU:              "lbzu",
+	LBZUX:             "lbzux",
+	LBZX:              "lbzx",
+	LFD:               "lfd",
+	LFDU:              "lfdu",
+	LFDUX:             "lfdux",
+	LFDX:              "lfdx",
+	LFS:               "lfs",
+	LFSU:              "lfsu",
+	LFSUX:             "lfsux",
+	LFSX:              "lfsx",
+	LHA:               "lha",
+	LHAU:              "lhau",
+	LHAUX:             "lhaux",
+	LHAX:              "lhax",
+	LHBRX:             "lhbrx",
+	LHZ:               "lhz",
+	LHZU:              "lhzu",
+	LHZUX:             "lhzux",
+	LHZX:              "lhzx",
+	LMW:               "lmw",
+	LSWI:              "lswi",
+	LSWX:              "lswx",
+	LWBRX:             "lwbrx",
+	LWZ:               "lwz",
+	LWZU:              "lwzu",
+	LWZUX:             "lwzux",
+	LWZX:              "lwzx",
+	MCRF:              "mcrf",
+	MCRFS:             "mcrfs",
+	MFCR:              "mfcr",
+	MFFS:              "mffs",
+	MFFSCC:            "mffs.",
+	MFMSR:             "mfmsr",
+	MFSPR:             "mfspr",
+	MTCRF:             "mtcrf",
+	MTFSB0:            "mtfsb0",
+	MTFSB0CC:          "mtfsb0.",
+	MTFSB1:            "mtfsb1",
+	MTFSB1CC:          "mtfsb1.",
+	MTFSF:             "mtfsf",
+	MTFSFCC:           "mtfsf.",
+	MTFSFI:            "mtfsfi",
+	MTFSFICC:          "mtfsfi.",
+	MTMSR:             "mtmsr",
+	MTSPR:             "mtspr",
+	MULLI:             "mulli",
+	MULLW:             "mullw",
+	MULLWCC:           "mullw.",
+	MULLWO:            "mullwo",
+	MULLWOCC:          "mullwo.",
+	NAND:              "nand",
+	NANDCC:            "nand.",
+	NEG:               "neg",
+	NEGCC:             "neg.",
+	NEGO:              "nego",
+	NEGOCC:            "nego.",
+	NOR:               "nor",
+	NORCC:             "nor.",
+	OR:                "or",
+	ORCC:              "or.",
+	ORC:               "orc",
+	ORCCC:             "orc.",
+	NOP:               "nop",
+	ORI:               "ori",
+	ORIS:              "oris",
+	RLWIMI:            "rlwimi",
+	RLWIMICC:          "rlwimi.",
+	RLWINM:            "rlwinm",
+	RLWINMCC:          "rlwinm.",
+	RLWNM:             "rlwnm",
+	RLWNMCC:           "rlwnm.",
+	SLW:               "slw",
+	SLWCC:             "slw.",
+	SRAW:              "sraw",
+	SRAWCC:            "sraw.",
+	SRAWI:             "srawi",
+	SRAWICC:           "srawi.",
+	SRW:               "srw",
+	SRWCC:             "srw.",
+	STB:               "stb",
+	STBU:              "stbu",
+	STBUX:             "stbux",
+	STBX:              "stbx",
+	STFD:              "stfd",
+	STFDU:             "stfdu",
+	STFDUX:            "stfdux",
+	STFDX:             "stfdx",
+	STFS:              "stfs",
+	STFSU:             "stfsu",
+	STFSUX:            "stfsux",
+	STFSX:             "stfsx",
+	STH:               "sth",
+	STHBRX:            "sthbrx",
+	STHU:              "sthu",
+	STHUX:             "sthux",
+	STHX:              "sthx",
+	STMW:              "stmw",
+	STSWI:             "stswi",
+	STSWX:             "stswx",
+	STW:               "stw",
+	STWBRX:            "stwbrx",
+	STWU:              "stwu",
+	STWUX:             "stwux",
+	STWX:              "stwx",
+	SUBFC:             "subfc",
+	SUBFCCC:           "subfc.",
+	SUBFCO:            "subfco",
+	SUBFCOCC:          "subfco.",
+	SUBFE:             "subfe",
+	SUBFECC:           "subfe.",
+	SUBFEO:            "subfeo",
+	SUBFEOCC:          "subfeo.",
+	SUBFIC:            "subfic",
+	SUBFME:            "subfme",
+	SUBFMECC:          "subfme.",
+	SUBFMEO:           "subfmeo",
+	SUBFMEOCC:         "subfmeo.",
+	SUBFZE:            "subfze",
+	SUBFZECC:          "subfze.",
+	SUBFZEO:           "subfzeo",
+	SUBFZEOCC:         "subfzeo.",
+	SYNC:              "sync",
+	TLBIE:             "tlbie",
+	TW:                "tw",
+	TWI:               "twi",
+	XOR:               "xor",
+	XORCC:             "xor.",
+	XORI:              "xori",
+	XORIS:             "xoris",
+}
+
+var opisa = [...]ISA{
+	DMXXEXTFDMR512:    ISAV3_1C,
+	DMXXINSTDMR512:    ISAV3_1C,
+	DMMR:              ISAV3_1C,
+	DMXOR:             ISAV3_1C,
+	DMSETDMRZ:         ISAV3_1C,
+	DMXXEXTFDMR256:    ISAV3_1C,
+	DMXXINSTDMR256:    ISAV3_1C,
+	DMXVBF16GERX2:     ISAV3_1C,
+	PMDMXVBF16GERX2:   ISAV3_1C,
+	DMXVBF16GERX2PP:   ISAV3_1C,
+	PMDMXVBF16GERX2PP: ISAV3_1C,
+	DMXVBF16GERX2PN:   ISAV3_1C,
+	PMDMXVBF16GERX2PN: ISAV3_1C,
+	DMXVBF16GERX2NP:   ISAV3_1C,
+	PMDMXVBF16GERX2NP: ISAV3_1C,
+	DMXVBF16GERX2NN:   ISAV3_1C,
+	PMDMXVBF16GERX2NN: ISAV3_1C,
+	DMXVF16GERX2:      ISAV3_1C,
+	PMDMXVF16GERX2:    ISAV3_1C,
+	DMXVF16GERX2PP:    ISAV3_1C,
+	PMDMXVF16GERX2PP:  ISAV3_1C,
+	DMXVF16GERX2PN:    ISAV3_1C,
+	PMDMXVF16GERX2PN:  ISAV3_1C,
+	DMXVF16GERX2NP:    ISAV3_1C,
+	PMDMXVF16GERX2NP:  ISAV3_1C,
+	DMXVF16GERX2NN:    ISAV3_1C,
+	PMDMXVF16GERX2NN:  ISAV3_1C,
+	DMXVI8GERX4:       ISAV3_1C,
+	PMDMXVI8GERX4:     ISAV3_1C,
+	DMXVI8GERX4PP:     ISAV3_1C,
+	PMDMXVI8GERX4PP:   ISAV3_1C,
+	DMXVI8GERX4SPP:    ISAV3_1C,
+	PMDMXVI8GERX4SPP:  ISAV3_1C,
+	DMSHA2HASH:        ISAV3_1C,
+	DMSHA3HASH:        ISAV3_1C,
+	DMXXSHAPAD:        ISAV3_1C,
+	HASHCHK:           ISAV3_1B,
+	HASHCHKP:          ISAV3_1B,
+	HASHST:            ISAV3_1B,
+	HASHSTP:           ISAV3_1B,
+	BRD:               ISAV3_1,
+	BRH:               ISAV3_1,
+	BRW:               ISAV3_1,
+	CFUGED:            ISAV3_1,
+	CNTLZDM:           ISAV3_1,
+	CNTTZDM:           ISAV3_1,
+	DCFFIXQQ:          ISAV3_1,
+	DCTFIXQQ:          ISAV3_1,
+	LXVKQ:             ISAV3_1,
+	LXVP:              ISAV3_1,
+	LXVPX:             ISAV3_1,
+	LXVRBX:            ISAV3_1,
+	LXVRDX:            ISAV3_1,
+	LXVRHX:            ISAV3_1,
+	LXVRWX:            ISAV3_1,
+	MTVSRBM:           ISAV3_1,
+	MTVSRBMI:          ISAV3_1,
+	MTVSRDM:           ISAV3_1,
+	MTVSRHM:           ISAV3_1,
+	MTVSRQM:           ISAV3_1,
+	MTVSRWM:           ISAV3_1,
+	PADDI:             ISAV3_1,
+	PDEPD:             ISAV3_1,
+	PEXTD:             ISAV3_1,
+	PLBZ:              ISAV3_1,
+	PLD:               ISAV3_1,
+	PLFD:              ISAV3_1,
+	PLFS:              ISAV3_1,
+	PLHA:              ISAV3_1,
+	PLHZ:              ISAV3_1,
+	PLQ:               ISAV3_1,
+	PLWA:              ISAV3_1,
+	PLWZ:              ISAV3_1,
+	PLXSD:             ISAV3_1,
+	PLXSSP:            ISAV3_1,
+	PLXV:              ISAV3_1,
+	PLXVP:             ISAV3_1,
+	PMXVBF16GER2:      ISAV3_1,
+	PMXVBF16GER2NN:    ISAV3_1,
+	PMXVBF16GER2NP:    ISAV3_1,
+	PMXVBF16GER2PN:    ISAV3_1,
+	PMXVBF16GER2PP:    ISAV3_1,
+	PMXVF16GER2:       ISAV3_1,
+	PMXVF16GER2NN:     ISAV3_1,
+	PMXVF16GER2NP:     ISAV3_1,
+	PMXVF16GER2PN:     ISAV3_1,
+	PMXVF16GER2PP:     ISAV3_1,
+	PMXVF32GER:        ISAV3_1,
+	PMXVF32GERNN:      ISAV3_1,
+	PMXVF32GERNP:      ISAV3_1,
+	PMXVF32GERPN:      ISAV3_1,
+	PMXVF32GERPP:      ISAV3_1,
+	PMXVF64GER:        ISAV3_1,
+	PMXVF64GERNN:      ISAV3_1,
+	PMXVF64GERNP:      ISAV3_1,
+	PMXVF64GERPN:      ISAV3_1,
+	PMXVF64GERPP:      ISAV3_1,
+	PMXVI16GER2:       ISAV3_1,
+	PMXVI16GER2PP:     ISAV3_1,
+	PMXVI16GER2S:      ISAV3_1,
+	PMXVI16GER2SPP:    ISAV3_1,
+	PMXVI4GER8:        ISAV3_1,
+	PMXVI4GER8PP:      ISAV3_1,
+	PMXVI8GER4:        ISAV3_1,
+	PMXVI8GER4PP:      ISAV3_1,
+	PMXVI8GER4SPP:     ISAV3_1,
+	PNOP:              ISAV3_1,
+	PSTB:              ISAV3_1,
+	PSTD:              ISAV3_1,
+	PSTFD:             ISAV3_1,
+	PSTFS:             ISAV3_1,
+	PSTH:              ISAV3_1,
+	PSTQ:              ISAV3_1,
+	PSTW:              ISAV3_1,
+	PSTXSD:            ISAV3_1,
+	PSTXSSP:           ISAV3_1,
+	PSTXV:             ISAV3_1,
+	PSTXVP:            ISAV3_1,
+	SETBC:             ISAV3_1,
+	SETBCR:            ISAV3_1,
+	SETNBC:            ISAV3_1,
+	SETNBCR:           ISAV3_1,
+	STXVP:             ISAV3_1,
+	STXVPX:            ISAV3_1,
+	STXVRBX:           ISAV3_1,
+	STXVRDX:           ISAV3_1,
+	STXVRHX:           ISAV3_1,
+	STXVRWX:           ISAV3_1,
+	VCFUGED:           ISAV3_1,
+	VCLRLB:            ISAV3_1,
+	VCLRRB:            ISAV3_1,
+	VCLZDM:            ISAV3_1,
+	VCMPEQUQ:          ISAV3_1,
+	VCMPEQUQCC:        ISAV3_1,
+	VCMPGTSQ:          ISAV3_1,
+	VCMPGTSQCC:        ISAV3_1,
+	VCMPGTUQ:          ISAV3_1,
+	VCMPGTUQCC:        ISAV3_1,
+	VCMPSQ:            ISAV3_1,
+	VCMPUQ:            ISAV3_1,
+	VCNTMBB:           ISAV3_1,
+	VCNTMBD:           ISAV3_1,
+	VCNTMBH:           ISAV3_1,
+	VCNTMBW:           ISAV3_1,
+	VCTZDM:            ISAV3_1,
+	VDIVESD:           ISAV3_1,
+	VDIVESQ:           ISAV3_1,
+	VDIVESW:           ISAV3_1,
+	VDIVEUD:           ISAV3_1,
+	VDIVEUQ:           ISAV3_1,
+	VDIVEUW:           ISAV3_1,
+	VDIVSD:            ISAV3_1,
+	VDIVSQ:            ISAV3_1,
+	VDIVSW:            ISAV3_1,
+	VDIVUD:            ISAV3_1,
+	VDIVUQ:            ISAV3_1,
+	VDIVUW:            ISAV3_1,
+	VEXPANDBM:         ISAV3_1,
+	VEXPANDDM:         ISAV3_1,
+	VEXPANDHM:         ISAV3_1,
+	VEXPANDQM:         ISAV3_1,
+	VEXPANDWM:         ISAV3_1,
+	VEXTDDVLX:         ISAV3_1,
+	VEXTDDVRX:         ISAV3_1,
+	VEXTDUBVLX:        ISAV3_1,
+	VEXTDUBVRX:        ISAV3_1,
+	VEXTDUHVLX:        ISAV3_1,
+	VEXTDUHVRX:        ISAV3_1,
+	VEXTDUWVLX:        ISAV3_1,
+	VEXTDUWVRX:        ISAV3_1,
+	VEXTRACTBM:        ISAV3_1,
+	VEXTRACTDM:        ISAV3_1,
+	VEXTRACTHM:        ISAV3_1,
+	VEXTRACTQM:        ISAV3_1,
+	VEXTRACTWM:        ISAV3_1,
+	VEXTSD2Q:          ISAV3_1,
+	VGNB:              ISAV3_1,
+	VINSBLX:           ISAV3_1,
+	VINSBRX:           ISAV3_1,
+	VINSBVLX:          ISAV3_1,
+	VINSBVRX:          ISAV3_1,
+	VINSD:             ISAV3_1,
+	VINSDLX:           ISAV3_1,
+	VINSDRX:           ISAV3_1,
+	VINSHLX:           ISAV3_1,
+	VINSHRX:           ISAV3_1,
+	VINSHVLX:          ISAV3_1,
+	VINSHVRX:          ISAV3_1,
+	VINSW:             ISAV3_1,
+	VINSWLX:           ISAV3_1,
+	VINSWRX:           ISAV3_1,
+	VINSWVLX:          ISAV3_1,
+	VINSWVRX:          ISAV3_1,
+	VMODSD:            ISAV3_1,
+	VMODSQ:            ISAV3_1,
+	VMODSW:            ISAV3_1,
+	VMODUD:            ISAV3_1,
+	VMODUQ:            ISAV3_1,
+	VMODUW:            ISAV3_1,
+	VMSUMCUD:          ISAV3_1,
+	VMULESD:           ISAV3_1,
+	VMULEUD:           ISAV3_1,
+	VMULHSD:           ISAV3_1,
+	VMULHSW:           ISAV3_1,
+	VMULHUD:           ISAV3_1,
+	VMULHUW:           ISAV3_1,
+	VMULLD:            ISAV3_1,
+	VMULOSD:           ISAV3_1,
+	VMULOUD:           ISAV3_1,
+	VPDEPD:            ISAV3_1,
+	VPEXTD:            ISAV3_1,
+	VRLQ:              ISAV3_1,
+	VRLQMI:            ISAV3_1,
+	VRLQNM:            ISAV3_1,
+	VSLDBI:            ISAV3_1,
+	VSLQ:              ISAV3_1,
+	VSRAQ:             ISAV3_1,
+	VSRDBI:            ISAV3_1,
+	VSRQ:              ISAV3_1,
+	VSTRIBL:           ISAV3_1,
+	VSTRIBLCC:         ISAV3_1,
+	VSTRIBR:           ISAV3_1,
+	VSTRIBRCC:         ISAV3_1,
+	VSTRIHL:           ISAV3_1,
+	VSTRIHLCC:         ISAV3_1,
+	VSTRIHR:           ISAV3_1,
+	VSTRIHRCC:         ISAV3_1,
+	XSCMPEQQP:         ISAV3_1,
+	XSCMPGEQP:         ISAV3_1,
+	XSCMPGTQP:         ISAV3_1,
+	XSCVQPSQZ:         ISAV3_1,
+	XSCVQPUQZ:         ISAV3_1,
+	XSCVSQQP:          ISAV3_1,
+	XSCVUQQP:          ISAV3_1,
+	XSMAXCQP:          ISAV3_1,
+	XSMINCQP:          ISAV3_1,
+	XVBF16GER2:        ISAV3_1,
+	XVBF16GER2NN:      ISAV3_1,
+	XVBF16GER2NP:      ISAV3_1,
+	XVBF16GER2PN:      ISAV3_1,
+	XVBF16GER2PP:      ISAV3_1,
+	XVCVBF16SPN:       ISAV3_1,
+	XVCVSPBF16:        ISAV3_1,
+	XVF16GER2:         ISAV3_1,
+	XVF16GER2NN:       ISAV3_1,
+	XVF16GER2NP:       ISAV3_1,
+	XVF16GER2PN:       ISAV3_1,
+	XVF16GER2PP:       ISAV3_1,
+	XVF32GER:          ISAV3_1,
+	XVF32GERNN:        ISAV3_1,
+	XVF32GERNP:        ISAV3_1,
+	XVF32GERPN:        ISAV3_1,
+	XVF32GERPP:        ISAV3_1,
+	XVF64GER:          ISAV3_1,
+	XVF64GERNN:        ISAV3_1,
+	XVF64GERNP:        ISAV3_1,
+	XVF64GERPN:        ISAV3_1,
+	XVF64GERPP:        ISAV3_1,
+	XVI16GER2:         ISAV3_1,
+	XVI16GER2PP:       ISAV3_1,
+	XVI16GER2S:        ISAV3_1,
+	XVI16GER2SPP:      ISAV3_1,
+	XVI4GER8:          ISAV3_1,
+	XVI4GER8PP:        ISAV3_1,
+	XVI8GER4:          ISAV3_1,
+	XVI8GER4PP:        ISAV3_1,
+	XVI8GER4SPP:       ISAV3_1,
+	XVTLSBB:           ISAV3_1,
+	XXBLENDVB:         ISAV3_1,
+	XXBLENDVD:         ISAV3_1,
+	XXBLENDVH:         ISAV3_1,
+	XXBLENDVW:         ISAV3_1,
+	XXEVAL:            ISAV3_1,
+	XXGENPCVBM:        ISAV3_1,
+	XXGENPCVDM:        ISAV3_1,
+	XXGENPCVHM:        ISAV3_1,
+	XXGENPCVWM:        ISAV3_1,
+	DMXXMFACC:         ISAV3_1C,
+	XXMFACC:           ISAV3_1,
+	XXMTACC:           ISAV3_1,
+	XXPERMX:           ISAV3_1,
+	XXSETACCZ:         ISAV3_1,
+	XXSPLTI32DX:       ISAV3_1,
+	XXSPLTIDP:         ISAV3_1,
+	XXSPLTIW:          ISAV3_1,
+	MSGCLRU:           ISAV3_0C,
+	MSGSNDU:           ISAV3_0C,
+	URFID:             ISAV3_0C,
+	ADDEX:             ISAV3_0B,
+	MFFSCDRN:          ISAV3_0B,
+	MFFSCDRNI:         ISAV3_0B,
+	MFFSCE:            ISAV3_0B,
+	MFFSCRN:           ISAV3_0B,
+	MFFSCRNI:          ISAV3_0B,
+	MFFSL:             ISAV3_0B,
+	SLBIAG:            ISAV3_0B,
+	VMSUMUDM:          ISAV3_0B,
+	ADDPCIS:           ISAV3_0,
+	BCDCFNCC:          ISAV3_0,
+	BCDCFSQCC:         ISAV3_0,
+	BCDCFZCC:          ISAV3_0,
+	BCDCPSGNCC:        ISAV3_0,
+	BCDCTNCC:          ISAV3_0,
+	BCDCTSQCC:         ISAV3_0,
+	BCDCTZCC:          ISAV3_0,
+	BCDSCC:            ISAV3_0,
+	BCDSETSGNCC:       ISAV3_0,
+	BCDSRCC:           ISAV3_0,
+	BCDTRUNCCC:        ISAV3_0,
+	BCDUSCC:           ISAV3_0,
+	BCDUTRUNCCC:       ISAV3_0,
+	CMPEQB:            ISAV3_0,
+	CMPRB:             ISAV3_0,
+	CNTTZD:            ISAV3_0,
+	CNTTZDCC:          ISAV3_0,
+	CNTTZW:            ISAV3_0,
+	CNTTZWCC:          ISAV3_0,
+	COPY:              ISAV3_0,
+	CPABORT:           ISAV3_0,
+	DARN:              ISAV3_0,
+	DTSTSFI:           ISAV3_0,
+	DTSTSFIQ:          ISAV3_0,
+	EXTSWSLI:          ISAV3_0,
+	EXTSWSLICC:        ISAV3_0,
+	LDAT:              ISAV3_0,
+	LWAT:              ISAV3_0,
+	LXSD:              ISAV3_0,
+	LXSIBZX:           ISAV3_0,
+	LXSIHZX:           ISAV3_0,
+	LXSSP:             ISAV3_0,
+	LXV:               ISAV3_0,
+	LXVB16X:           ISAV3_0,
+	LXVH8X:            ISAV3_0,
+	LXVL:              ISAV3_0,
+	LXVLL:             ISAV3_0,
+	LXVWSX:            ISAV3_0,
+	LXVX:              ISAV3_0,
+	MADDHD:            ISAV3_0,
+	MADDHDU:           ISAV3_0,
+	MADDLD:            ISAV3_0,
+	MCRXRX:            ISAV3_0,
+	MFVSRLD:           ISAV3_0,
+	MODSD:             ISAV3_0,
+	MODSW:             ISAV3_0,
+	MODUD:             ISAV3_0,
+	MODUW:             ISAV3_0,
+	MSGSYNC:           ISAV3_0,
+	MTVSRDD:           ISAV3_0,
+	MTVSRWS:           ISAV3_0,
+	PASTECC:           ISAV3_0,
+	SETB:              ISAV3_0,
+	SLBIEG:            ISAV3_0,
+	SLBSYNC:           ISAV3_0,
+	STDAT:             ISAV3_0,
+	STOP:              ISAV3_0,
+	STWAT:             ISAV3_0,
+	STXSD:             ISAV3_0,
+	STXSIBX:           ISAV3_0,
+	STXSIHX:           ISAV3_0,
+	STXSSP:            ISAV3_0,
+	STXV:              ISAV3_0,
+	STXVB16X:          ISAV3_0,
+	STXVH8X:           ISAV3_0,
+	STXVL:             ISAV3_0,
+	STXVLL:            ISAV3_0,
+	STXVX:             ISAV3_0,
+	VABSDUB:           ISAV3_0,
+	VABSDUH:           ISAV3_0,
+	VABSDUW:           ISAV3_0,
+	VBPERMD:           ISAV3_0,
+	VCLZLSBB:          ISAV3_0,
+	VCMPNEB:           ISAV3_0,
+	VCMPNEBCC:         ISAV3_0,
+	VCMPNEH:           ISAV3_0,
+	VCMPNEHCC:         ISAV3_0,
+	VCMPNEW:           ISAV3_0,
+	VCMPNEWCC:         ISAV3_0,
+	VCMPNEZB:          ISAV3_0,
+	VCMPNEZBCC:        ISAV3_0,
+	VCMPNEZH:          ISAV3_0,
+	VCMPNEZHCC:        ISAV3_0,
+	VCMPNEZW:          ISAV3_0,
+	VCMPNEZWCC:        ISAV3_0,
+	VCTZB:             ISAV3_0,
+	VCTZD:             ISAV3_0,
+	VCTZH:             ISAV3_0,
+	VCTZLSBB:          ISAV3_0,
+	VCTZW:             ISAV3_0,
+	VEXTRACTD:         ISAV3_0,
+	VEXTRACTUB:        ISAV3_0,
+	VEXTRACTUH:        ISAV3_0,
+	VEXTRACTUW:        ISAV3_0,
+	VEXTSB2D:          ISAV3_0,
+	VEXTSB2W:          ISAV3_0,
+	VEXTSH2D:          ISAV3_0,
+	VEXTSH2W:          ISAV3_0,
+	VEXTSW2D:          ISAV3_0,
+	VEXTUBLX:          ISAV3_0,
+	VEXTUBRX:          ISAV3_0,
+	VEXTUHLX:          ISAV3_0,
+	VEXTUHRX:          ISAV3_0,
+	VEXTUWLX:          ISAV3_0,
+	VEXTUWRX:          ISAV3_0,
+	VINSERTB:          ISAV3_0,
+	VINSERTD:          ISAV3_0,
+	VINSERTH:          ISAV3_0,
+	VINSERTW:          ISAV3_0,
+	VMUL10CUQ:         ISAV3_0,
+	VMUL10ECUQ:        ISAV3_0,
+	VMUL10EUQ:         ISAV3_0,
+	VMUL10UQ:          ISAV3_0,
+	VNEGD:             ISAV3_0,
+	VNEGW:             ISAV3_0,
+	VPERMR:            ISAV3_0,
+	VPRTYBD:           ISAV3_0,
+	VPRTYBQ:           ISAV3_0,
+	VPRTYBW:           ISAV3_0,
+	VRLDMI:            ISAV3_0,
+	VRLDNM:            ISAV3_0,
+	VRLWMI:            ISAV3_0,
+	VRLWNM:            ISAV3_0,
+	VSLV:              ISAV3_0,
+	VSRV:              ISAV3_0,
+	WAIT:              ISAV3_0,
+	XSABSQP:           ISAV3_0,
+	XSADDQP:           ISAV3_0,
+	XSADDQPO:          ISAV3_0,
+	XSCMPEQDP:         ISAV3_0,
+	XSCMPEXPDP:        ISAV3_0,
+	XSCMPEXPQP:        ISAV3_0,
+	XSCMPGEDP:         ISAV3_0,
+	XSCMPGTDP:         ISAV3_0,
+	XSCMPOQP:          ISAV3_0,
+	XSCMPUQP:          ISAV3_0,
+	XSCPSGNQP:         ISAV3_0,
+	XSCVDPHP:          ISAV3_0,
+	XSCVDPQP:          ISAV3_0,
+	XSCVHPDP:          ISAV3_0,
+	XSCVQPDP:          ISAV3_0,
+	XSCVQPDPO:         ISAV3_0,
+	XSCVQPSDZ:         ISAV3_0,
+	XSCVQPSWZ:         ISAV3_0,
+	XSCVQPUDZ:         ISAV3_0,
+	XSCVQPUWZ:         ISAV3_0,
+	XSCVSDQP:          ISAV3_0,
+	XSCVUDQP:          ISAV3_0,
+	XSDIVQP:           ISAV3_0,
+	XSDIVQPO:          ISAV3_0,
+	XSIEXPDP:          ISAV3_0,
+	XSIEXPQP:          ISAV3_0,
+	XSMADDQP:          ISAV3_0,
+	XSMADDQPO:         ISAV3_0,
+	XSMAXCDP:          ISAV3_0,
+	XSMAXJDP:          ISAV3_0,
+	XSMINCDP:          ISAV3_0,
+	XSMINJDP:          ISAV3_0,
+	XSMSUBQP:          ISAV3_0,
+	XSMSUBQPO:         ISAV3_0,
+	XSMULQP:           ISAV3_0,
+	XSMULQPO:          ISAV3_0,
+	XSNABSQP:          ISAV3_0,
+	XSNEGQP:           ISAV3_0,
+	XSNMADDQP:         ISAV3_0,
+	XSNMADDQPO:        ISAV3_0,
+	XSNMSUBQP:         ISAV3_0,
+	XSNMSUBQPO:        ISAV3_0,
+	XSRQPI:            ISAV3_0,
+	XSRQPIX:           ISAV3_0,
+	XSRQPXP:           ISAV3_0,
+	XSSQRTQP:          ISAV3_0,
+	XSSQRTQPO:         ISAV3_0,
+	XSSUBQP:           ISAV3_0,
+	XSSUBQPO:          ISAV3_0,
+	XSTSTDCDP:         ISAV3_0,
+	XSTSTDCQP:         ISAV3_0,
+	XSTSTDCSP:         ISAV3_0,
+	XSXEXPDP:          ISAV3_0,
+	XSXEXPQP:          ISAV3_0,
+	XSXSIGDP:          ISAV3_0,
+	XSXSIGQP:          ISAV3_0,
+	XVCVHPSP:          ISAV3_0,
+	XVCVSPHP:          ISAV3_0,
+	XVIEXPDP:          ISAV3_0,
+	XVIEXPSP:          ISAV3_0,
+	XVTSTDCDP:         ISAV3_0,
+	XVTSTDCSP:         ISAV3_0,
+	XVXEXPDP:          ISAV3_0,
+	XVXEXPSP:          ISAV3_0,
+	XVXSIGDP:          ISAV3_0,
+	XVXSIGSP:          ISAV3_0,
+	XXBRD:             ISAV3_0,
+	XXBRH:             ISAV3_0,
+	XXBRQ:             ISAV3_0,
+	XXBRW:             ISAV3_0,
+	XXEXTRACTUW:       ISAV3_0,
+	XXINSERTW:         ISAV3_0,
+	XXPERM:            ISAV3_0,
+	XXPERMR:           ISAV3_0,
+	XXSPLTIB:          ISAV3_0,
+	BCDADDCC:          ISAV2_07,
+	BCDSUBCC:          ISAV2_07,
+	BCTAR:             ISAV2_07,
+	BCTARL:            ISAV2_07,
+	CLRBHRB:           ISAV2_07,
+	FMRGEW:            ISAV2_07,
+	FMRGOW:            ISAV2_07,
+	ICBT:              ISAV2_07,
+	LQARX:             ISAV2_07,
+	LXSIWAX:           ISAV2_07,
+	LXSIWZX:           ISAV2_07,
+	LXSSPX:            ISAV2_07,
+	MFBHRBE:           ISAV2_07,
+	MFVSRD:            ISAV2_07,
+	MFVSRWZ:           ISAV2_07,
+	MSGCLR:            ISAV2_07,
+	MSGCLRP:           ISAV2_07,
+	MSGSND:            ISAV2_07,
+	MSGSNDP:           ISAV2_07,
+	MTVSRD:            ISAV2_07,
+	MTVSRWA:           ISAV2_07,
+	MTVSRWZ:           ISAV2_07,
+	RFEBB:             ISAV2_07,
+	STQCXCC:           ISAV2_07,
+	STXSIWX:           ISAV2_07,
+	STXSSPX:           ISAV2_07,
+	VADDCUQ:           ISAV2_07,
+	VADDECUQ:          ISAV2_07,
+	VADDEUQM:          ISAV2_07,
+	VADDUDM:           ISAV2_07,
+	VADDUQM:           ISAV2_07,
+	VBPERMQ:           ISAV2_07,
+	VCIPHER:           ISAV2_07,
+	VCIPHERLAST:       ISAV2_07,
+	VCLZB:             ISAV2_07,
+	VCLZD:             ISAV2_07,
+	VCLZH:             ISAV2_07,
+	VCLZW:             ISAV2_07,
+	VCMPEQUD:          ISAV2_07,
+	VCMPEQUDCC:        ISAV2_07,
+	VCMPGTSD:          ISAV2_07,
+	VCMPGTSDCC:        ISAV2_07,
+	VCMPGTUD:          ISAV2_07,
+	VCMPGTUDCC:        ISAV2_07,
+	VEQV:              ISAV2_07,
+	VGBBD:             ISAV2_07,
+	VMAXSD:            ISAV2_07,
+	VMAXUD:            ISAV2_07,
+	VMINSD:            ISAV2_07,
+	VMINUD:            ISAV2_07,
+	VMRGEW:            ISAV2_07,
+	VMRGOW:            ISAV2_07,
+	VMULESW:           ISAV2_07,
+	VMULEUW:           ISAV2_07,
+	VMULOSW:           ISAV2_07,
+	VMULOUW:           ISAV2_07,
+	VMULUWM:           ISAV2_07,
+	VNAND:             ISAV2_07,
+	VNCIPHER:          ISAV2_07,
+	VNCIPHERLAST:      ISAV2_07,
+	VORC:              ISAV2_07,
+	VPERMXOR:          ISAV2_07,
+	VPKSDSS:           ISAV2_07,
+	VPKSDUS:           ISAV2_07,
+	VPKUDUM:           ISAV2_07,
+	VPKUDUS:           ISAV2_07,
+	VPMSUMB:           ISAV2_07,
+	VPMSUMD:           ISAV2_07,
+	VPMSUMH:           ISAV2_07,
+	VPMSUMW:           ISAV2_07,
+	VPOPCNTB:          ISAV2_07,
+	VPOPCNTD:          ISAV2_07,
+	VPOPCNTH:          ISAV2_07,
+	VPOPCNTW:          ISAV2_07,
+	VRLD:              ISAV2_07,
+	VSBOX:             ISAV2_07,
+	VSHASIGMAD:        ISAV2_07,
+	VSHASIGMAW:        ISAV2_07,
+	VSLD:              ISAV2_07,
+	VSRAD:             ISAV2_07,
+	VSRD:              ISAV2_07,
+	VSUBCUQ:           ISAV2_07,
+	VSUBECUQ:          ISAV2_07,
+	VSUBEUQM:          ISAV2_07,
+	VSUBUDM:           ISAV2_07,
+	VSUBUQM:           ISAV2_07,
+	VUPKHSW:           ISAV2_07,
+	VUPKLSW:           ISAV2_07,
+	XSADDSP:           ISAV2_07,
+	XSCVDPSPN:         ISAV2_07,
+	XSCVSPDPN:         ISAV2_07,
+	XSCVSXDSP:         ISAV2_07,
+	XSCVUXDSP:         ISAV2_07,
+	XSDIVSP:           ISAV2_07,
+	XSMADDASP:         ISAV2_07,
+	XSMADDMSP:         ISAV2_07,
+	XSMSUBASP:         ISAV2_07,
+	XSMSUBMSP:         ISAV2_07,
+	XSMULSP:           ISAV2_07,
+	XSNMADDASP:        ISAV2_07,
+	XSNMADDMSP:        ISAV2_07,
+	XSNMSUBASP:        ISAV2_07,
+	XSNMSUBMSP:        ISAV2_07,
+	XSRESP:            ISAV2_07,
+	XSRSP:             ISAV2_07,
+	XSRSQRTESP:        ISAV2_07,
+	XSSQRTSP:          ISAV2_07,
+	XSSUBSP:           ISAV2_07,
+	XXLEQV:            ISAV2_07,
+	XXLNAND:           ISAV2_07,
+	XXLORC:            ISAV2_07,
+	ADDG6S:            ISAV2_06,
+	BPERMD:            ISAV2_06,
+	CBCDTD:            ISAV2_06,
+	CDTBCD:            ISAV2_06,
+	DCFFIX:            ISAV2_06,
+	DCFFIXCC:          ISAV2_06,
+	DIVDE:             ISAV2_06,
+	DIVDECC:           ISAV2_06,
+	DIVDEO:            ISAV2_06,
+	DIVDEOCC:          ISAV2_06,
+	DIVDEU:            ISAV2_06,
+	DIVDEUCC:          ISAV2_06,
+	DIVDEUO:           ISAV2_06,
+	DIVDEUOCC:         ISAV2_06,
+	DIVWE:             ISAV2_06,
+	DIVWECC:           ISAV2_06,
+	DIVWEO:            ISAV2_06,
+	DIVWEOCC:          ISAV2_06,
+	DIVWEU:            ISAV2_06,
+	DIVWEUCC:          ISAV2_06,
+	DIVWEUO:           ISAV2_06,
+	DIVWEUOCC:         ISAV2_06,
+	FCFIDS:            ISAV2_06,
+	FCFIDSCC:          ISAV2_06,
+	FCFIDU:            ISAV2_06,
+	FCFIDUCC:          ISAV2_06,
+	FCFIDUS:           ISAV2_06,
+	FCFIDUSCC:         ISAV2_06,
+	FCTIDU:            ISAV2_06,
+	FCTIDUCC:          ISAV2_06,
+	FCTIDUZ:           ISAV2_06,
+	FCTIDUZCC:         ISAV2_06,
+	FCTIWU:            ISAV2_06,
+	FCTIWUCC:          ISAV2_06,
+	FCTIWUZ:           ISAV2_06,
+	FCTIWUZCC:         ISAV2_06,
+	FTDIV:             ISAV2_06,
+	FTSQRT:            ISAV2_06,
+	LBARX:             ISAV2_06,
+	LDBRX:             ISAV2_06,
+	LFIWZX:            ISAV2_06,
+	LHARX:             ISAV2_06,
+	LXSDX:             ISAV2_06,
+	LXVD2X:            ISAV2_06,
+	LXVDSX:            ISAV2_06,
+	LXVW4X:            ISAV2_06,
+	POPCNTD:           ISAV2_06,
+	POPCNTW:           ISAV2_06,
+	STBCXCC:           ISAV2_06,
+	STDBRX:            ISAV2_06,
+	STHCXCC:           ISAV2_06,
+	STXSDX:            ISAV2_06,
+	STXVD2X:           ISAV2_06,
+	STXVW4X:           ISAV2_06,
+	XSABSDP:           ISAV2_06,
+	XSADDDP:           ISAV2_06,
+	XSCMPODP:          ISAV2_06,
+	XSCMPUDP:          ISAV2_06,
+	XSCPSGNDP:         ISAV2_06,
+	XSCVDPSP:          ISAV2_06,
+	XSCVDPSXDS:        ISAV2_06,
+	XSCVDPSXWS:        ISAV2_06,
+	XSCVDPUXDS:        ISAV2_06,
+	XSCVDPUXWS:        ISAV2_06,
+	XSCVSPDP:          ISAV2_06,
+	XSCVSXDDP:         ISAV2_06,
+	XSCVUXDDP:         ISAV2_06,
+	XSDIVDP:           ISAV2_06,
+	XSMADDADP:         ISAV2_06,
+	XSMADDMDP:         ISAV2_06,
+	XSMAXDP:           ISAV2_06,
+	XSMINDP:           ISAV2_06,
+	XSMSUBADP:         ISAV2_06,
+	XSMSUBMDP:         ISAV2_06,
+	XSMULDP:           ISAV2_06,
+	XSNABSDP:          ISAV2_06,
+	XSNEGDP:           ISAV2_06,
+	XSNMADDADP:        ISAV2_06,
+	XSNMADDMDP:        ISAV2_06,
+	XSNMSUBADP:        ISAV2_06,
+	XSNMSUBMDP:        ISAV2_06,
+	XSRDPI:            ISAV2_06,
+	XSRDPIC:           ISAV2_06,
+	XSRDPIM:           ISAV2_06,
+	XSRDPIP:           ISAV2_06,
+	XSRDPIZ:           ISAV2_06,
+	XSREDP:            ISAV2_06,
+	XSRSQRTEDP:        ISAV2_06,
+	XSSQRTDP:          ISAV2_06,
+	XSSUBDP:           ISAV2_06,
+	XSTDIVDP:          ISAV2_06,
+	XSTSQRTDP:         ISAV2_06,
+	XVABSDP:           ISAV2_06,
+	XVABSSP:           ISAV2_06,
+	XVADDDP:           ISAV2_06,
+	XVADDSP:           ISAV2_06,
+	XVCMPEQDP:         ISAV2_06,
+	XVCMPEQDPCC:       ISAV2_06,
+	XVCMPEQSP:         ISAV2_06,
+	XVCMPEQSPCC:       ISAV2_06,
+	XVCMPGEDP:         ISAV2_06,
+	XVCMPGEDPCC:       ISAV2_06,
+	XVCMPGESP:         ISAV2_06,
+	XVCMPGESPCC:       ISAV2_06,
+	XVCMPGTDP:         ISAV2_06,
+	XVCMPGTDPCC:       ISAV2_06,
+	XVCMPGTSP:         ISAV2_06,
+	XVCMPGTSPCC:       ISAV2_06,
+	XVCPSGNDP:         ISAV2_06,
+	XVCPSGNSP:         ISAV2_06,
+	XVCVDPSP:          ISAV2_06,
+	XVCVDPSXDS:        ISAV2_06,
+	XVCVDPSXWS:        ISAV2_06,
+	XVCVDPUXDS:        ISAV2_06,
+	XVCVDPUXWS:        ISAV2_06,
+	XVCVSPDP:          ISAV2_06,
+	XVCVSPSXDS:        ISAV2_06,
+	XVCVSPSXWS:        ISAV2_06,
+	XVCVSPUXDS:        ISAV2_06,
+	XVCVSPUXWS:        ISAV2_06,
+	XVCVSXDDP:         ISAV2_06,
+	XVCVSXDSP:         ISAV2_06,
+	XVCVSXWDP:         ISAV2_06,
+	XVCVSXWSP:         ISAV2_06,
+	XVCVUXDDP:         ISAV2_06,
+	XVCVUXDSP:         ISAV2_06,
+	XVCVUXWDP:         ISAV2_06,
+	XVCVUXWSP:         ISAV2_06,
+	XVDIVDP:           ISAV2_06,
+	XVDIVSP:           ISAV2_06,
+	XVMADDADP:         ISAV2_06,
+	XVMADDASP:         ISAV2_06,
+	XVMADDMDP:         ISAV2_06,
+	XVMADDMSP:         ISAV2_06,
+	XVMAXDP:           ISAV2_06,
+	XVMAXSP:           ISAV2_06,
+	XVMINDP:           ISAV2_06,
+	XVMINSP:           ISAV2_06,
+	XVMSUBADP:         ISAV2_06,
+	XVMSUBASP:         ISAV2_06,
+	XVMSUBMDP:         ISAV2_06,
+	XVMSUBMSP:         ISAV2_06,
+	XVMULDP:           ISAV2_06,
+	XVMULSP:           ISAV2_06,
+	XVNABSDP:          ISAV2_06,
+	XVNABSSP:          ISAV2_06,
+	XVNEGDP:           ISAV2_06,
+	XVNEGSP:           ISAV2_06,
+	XVNMADDADP:        ISAV2_06,
+	XVNMADDASP:        ISAV2_06,
+	XVNMADDMDP:        ISAV2_06,
+	XVNMADDMSP:        ISAV2_06,
+	XVNMSUBADP:        ISAV2_06,
+	XVNMSUBASP:        ISAV2_06,
+	XVNMSUBMDP:        ISAV2_06,
+	XVNMSUBMSP:        ISAV2_06,
+	XVRDPI:            ISAV2_06,
+	XVRDPIC:           ISAV2_06,
+	XVRDPIM:           ISAV2_06,
+	XVRDPIP:           ISAV2_06,
+	XVRDPIZ:           ISAV2_06,
+	XVREDP:            ISAV2_06,
+	XVRESP:            ISAV2_06,
+	XVRSPI:            ISAV2_06,
+	XVRSPIC:           ISAV2_06,
+	XVRSPIM:           ISAV2_06,
+	XVRSPIP:           ISAV2_06,
+	XVRSPIZ:           ISAV2_06,
+	XVRSQRTEDP:        ISAV2_06,
+	XVRSQRTESP:        ISAV2_06,
+	XVSQRTDP:          ISAV2_06,
+	XVSQRTSP:          ISAV2_06,
+	XVSUBDP:           ISAV2_06,
+	XVSUBSP:           ISAV2_06,
+	XVTDIVDP:          ISAV2_06,
+	XVTDIVSP:          ISAV2_06,
+	XVTSQRTDP:         ISAV2_06,
+	XVTSQRTSP:         ISAV2_06,
+	XXLAND:            ISAV2_06,
+	XXLANDC:           ISAV2_06,
+	XXLNOR:            ISAV2_06,
+	XXLOR:             ISAV2_06,
+	XXLXOR:            ISAV2_06,
+	XXMRGHW:           ISAV2_06,
+	XXMRGLW:           ISAV2_06,
+	XXPERMDI:          ISAV2_06,
+	XXSEL:             ISAV2_06,
+	XXSLDWI:           ISAV2_06,
+	XXSPLTW:           ISAV2_06,
+	CMPB:              ISAV2_05,
+	DADD:              ISAV2_05,
+	DADDCC:            ISAV2_05,
+	DADDQ:             ISAV2_05,
+	DADDQCC:           ISAV2_05,
+	DCFFIXQ:           ISAV2_05,
+	DCFFIXQCC:         ISAV2_05,
+	DCMPO:             ISAV2_05,
+	DCMPOQ:            ISAV2_05,
+	DCMPU:             ISAV2_05,
+	DCMPUQ:            ISAV2_05,
+	DCTDP:             ISAV2_05,
+	DCTDPCC:           ISAV2_05,
+	DCTFIX:            ISAV2_05,
+	DCTFIXCC:          ISAV2_05,
+	DCTFIXQ:           ISAV2_05,
+	DCTFIXQCC:         ISAV2_05,
+	DCTQPQ:            ISAV2_05,
+	DCTQPQCC:          ISAV2_05,
+	DDEDPD:            ISAV2_05,
+	DDEDPDCC:          ISAV2_05,
+	DDEDPDQ:           ISAV2_05,
+	DDEDPDQCC:         ISAV2_05,
+	DDIV:              ISAV2_05,
+	DDIVCC:            ISAV2_05,
+	DDIVQ:             ISAV2_05,
+	DDIVQCC:           ISAV2_05,
+	DENBCD:            ISAV2_05,
+	DENBCDCC:          ISAV2_05,
+	DENBCDQ:           ISAV2_05,
+	DENBCDQCC:         ISAV2_05,
+	DIEX:              ISAV2_05,
+	DIEXCC:            ISAV2_05,
+	DIEXQCC:           ISAV2_05,
+	DIEXQ:             ISAV2_05,
+	DMUL:              ISAV2_05,
+	DMULCC:            ISAV2_05,
+	DMULQ:             ISAV2_05,
+	DMULQCC:           ISAV2_05,
+	DQUA:              ISAV2_05,
+	DQUACC:            ISAV2_05,
+	DQUAI:             ISAV2_05,
+	DQUAICC:           ISAV2_05,
+	DQUAIQ:            ISAV2_05,
+	DQUAIQCC:          ISAV2_05,
+	DQUAQ:             ISAV2_05,
+	DQUAQCC:           ISAV2_05,
+	DRDPQ:             ISAV2_05,
+	DRDPQCC:           ISAV2_05,
+	DRINTN:            ISAV2_05,
+	DRINTNCC:          ISAV2_05,
+	DRINTNQ:           ISAV2_05,
+	DRINTNQCC:         ISAV2_05,
+	DRINTX:            ISAV2_05,
+	DRINTXCC:          ISAV2_05,
+	DRINTXQ:           ISAV2_05,
+	DRINTXQCC:         ISAV2_05,
+	DRRND:             ISAV2_05,
+	DRRNDCC:           ISAV2_05,
+	DRRNDQ:            ISAV2_05,
+	DRRNDQCC:          ISAV2_05,
+	DRSP:              ISAV2_05,
+	DRSPCC:            ISAV2_05,
+	DSCLI:             ISAV2_05,
+	DSCLICC:           ISAV2_05,
+	DSCLIQ:            ISAV2_05,
+	DSCLIQCC:          ISAV2_05,
+	DSCRI:             ISAV2_05,
+	DSCRICC:           ISAV2_05,
+	DSCRIQ:            ISAV2_05,
+	DSCRIQCC:          ISAV2_05,
+	DSUB:              ISAV2_05,
+	DSUBCC:            ISAV2_05,
+	DSUBQ:             ISAV2_05,
+	DSUBQCC:           ISAV2_05,
+	DTSTDC:            ISAV2_05,
+	DTSTDCQ:           ISAV2_05,
+	DTSTDG:            ISAV2_05,
+	DTSTDGQ:           ISAV2_05,
+	DTSTEX:            ISAV2_05,
+	DTSTEXQ:           ISAV2_05,
+	DTSTSF:            ISAV2_05,
+	DTSTSFQ:           ISAV2_05,
+	DXEX:              ISAV2_05,
+	DXEXCC:            ISAV2_05,
+	DXEXQ:             ISAV2_05,
+	DXEXQCC:           ISAV2_05,
+	FCPSGN:            ISAV2_05,
+	FCPSGNCC:          ISAV2_05,
+	LBZCIX:            ISAV2_05,
+	LDCIX:             ISAV2_05,
+	LFDP:              ISAV2_05,
+	LFDPX:             ISAV2_05,
+	LFIWAX:            ISAV2_05,
+	LHZCIX:            ISAV2_05,
+	LWZCIX:            ISAV2_05,
+	PRTYD:             ISAV2_05,
+	PRTYW:             ISAV2_05,
+	SLBFEECC:          ISAV2_05,
+	STBCIX:            ISAV2_05,
+	STDCIX:            ISAV2_05,
+	STFDP:             ISAV2_05,
+	STFDPX:            ISAV2_05,
+	STHCIX:            ISAV2_05,
+	STWCIX:            ISAV2_05,
+	ISEL:              ISAV2_03,
+	LVEBX:             ISAV2_03,
+	LVEHX:             ISAV2_03,
+	LVEWX:             ISAV2_03,
+	LVSL:              ISAV2_03,
+	LVSR:              ISAV2_03,
+	LVX:               ISAV2_03,
+	LVXL:              ISAV2_03,
+	MFVSCR:            ISAV2_03,
+	MTVSCR:            ISAV2_03,
+	STVEBX:            ISAV2_03,
+	STVEHX:            ISAV2_03,
+	STVEWX:            ISAV2_03,
+	STVX:              ISAV2_03,
+	STVXL:             ISAV2_03,
+	TLBIEL:            ISAV2_03,
+	VADDCUW:           ISAV2_03,
+	VADDFP:            ISAV2_03,
+	VADDSBS:           ISAV2_03,
+	VADDSHS:           ISAV2_03,
+	VADDSWS:           ISAV2_03,
+	VADDUBM:           ISAV2_03,
+	VADDUBS:           ISAV2_03,
+	VADDUHM:           ISAV2_03,
+	VADDUHS:           ISAV2_03,
+	VADDUWM:           ISAV2_03,
+	VADDUWS:           ISAV2_03,
+	VAND:              ISAV2_03,
+	VANDC:             ISAV2_03,
+	VAVGSB:            ISAV2_03,
+	VAVGSH:            ISAV2_03,
+	VAVGSW:            ISAV2_03,
+	VAVGUB:            ISAV2_03,
+	VAVGUH:            ISAV2_03,
+	VAVGUW:            ISAV2_03,
+	VCFSX:             ISAV2_03,
+	VCFUX:             ISAV2_03,
+	VCMPBFP:           ISAV2_03,
+	VCMPBFPCC:         ISAV2_03,
+	VCMPEQFP:          ISAV2_03,
+	VCMPEQFPCC:        ISAV2_03,
+	VCMPEQUB:          ISAV2_03,
+	VCMPEQUBCC:        ISAV2_03,
+	VCMPEQUH:          ISAV2_03,
+	VCMPEQUHCC:        ISAV2_03,
+	VCMPEQUW:          ISAV2_03,
+	VCMPEQUWCC:        ISAV2_03,
+	VCMPGEFP:          ISAV2_03,
+	VCMPGEFPCC:        ISAV2_03,
+	VCMPGTFP:          ISAV2_03,
+	VCMPGTFPCC:        ISAV2_03,
+	VCMPGTSB:          ISAV2_03,
+	VCMPGTSBCC:        ISAV2_03,
+	VCMPGTSH:          ISAV2_03,
+	VCMPGTSHCC:        ISAV2_03,
+	VCMPGTSW:          ISAV2_03,
+	VCMPGTSWCC:        ISAV2_03,
+	VCMPGTUB:          ISAV2_03,
+	VCMPGTUBCC:        ISAV2_03,
+	VCMPGTUH:          ISAV2_03,
+	VCMPGTUHCC:        ISAV2_03,
+	VCMPGTUW:          ISAV2_03,
+	VCMPGTUWCC:        ISAV2_03,
+	VCTSXS:            ISAV2_03,
+	VCTUXS:            ISAV2_03,
+	VEXPTEFP:          ISAV2_03,
+	VLOGEFP:           ISAV2_03,
+	VMADDFP:           ISAV2_03,
+	VMAXFP:            ISAV2_03,
+	VMAXSB:            ISAV2_03,
+	VMAXSH:            ISAV2_03,
+	VMAXSW:            ISAV2_03,
+	VMAXUB:            ISAV2_03,
+	VMAXUH:            ISAV2_03,
+	VMAXUW:            ISAV2_03,
+	VMHADDSHS:         ISAV2_03,
+	VMHRADDSHS:        ISAV2_03,
+	VMINFP:            ISAV2_03,
+	VMINSB:            ISAV2_03,
+	VMINSH:            ISAV2_03,
+	VMINSW:            ISAV2_03,
+	VMINUB:            ISAV2_03,
+	VMINUH:            ISAV2_03,
+	VMINUW:            ISAV2_03,
+	VMLADDUHM:         ISAV2_03,
+	VMRGHB:            ISAV2_03,
+	VMRGHH:            ISAV2_03,
+	VMRGHW:            ISAV2_03,
+	VMRGLB:            ISAV2_03,
+	VMRGLH:            ISAV2_03,
+	VMRGLW:            ISAV2_03,
+	VMSUMMBM:          ISAV2_03,
+	VMSUMSHM:          ISAV2_03,
+	VMSUMSHS:          ISAV2_03,
+	VMSUMUBM:          ISAV2_03,
+	VMSUMUHM:          ISAV2_03,
+	VMSUMUHS:          ISAV2_03,
+	VMULESB:           ISAV2_03,
+	VMULESH:           ISAV2_03,
+	VMULEUB:           ISAV2_03,
+	VMULEUH:           ISAV2_03,
+	VMULOSB:           ISAV2_03,
+	VMULOSH:           ISAV2_03,
+	VMULOUB:           ISAV2_03,
+	VMULOUH:           ISAV2_03,
+	VNMSUBFP:          ISAV2_03,
+	VNOR:              ISAV2_03,
+	VOR:               ISAV2_03,
+	VPERM:             ISAV2_03,
+	VPKPX:             ISAV2_03,
+	VPKSHSS:           ISAV2_03,
+	VPKSHUS:           ISAV2_03,
+	VPKSWSS:           ISAV2_03,
+	VPKSWUS:           ISAV2_03,
+	VPKUHUM:           ISAV2_03,
+	VPKUHUS:           ISAV2_03,
+	VPKUWUM:           ISAV2_03,
+	VPKUWUS:           ISAV2_03,
+	VREFP:             ISAV2_03,
+	VRFIM:             ISAV2_03,
+	VRFIN:             ISAV2_03,
+	VRFIP:             ISAV2_03,
+	VRFIZ:             ISAV2_03,
+	VRLB:              ISAV2_03,
+	VRLH:              ISAV2_03,
+	VRLW:              ISAV2_03,
+	VRSQRTEFP:         ISAV2_03,
+	VSEL:              ISAV2_03,
+	VSL:               ISAV2_03,
+	VSLB:              ISAV2_03,
+	VSLDOI:            ISAV2_03,
+	VSLH:              ISAV2_03,
+	VSLO:              ISAV2_03,
+	VSLW:              ISAV2_03,
+	VSPLTB:            ISAV2_03,
+	VSPLTH:            ISAV2_03,
+	VSPLTISB:          ISAV2_03,
+	VSPLTISH:          ISAV2_03,
+	VSPLTISW:          ISAV2_03,
+	VSPLTW:            ISAV2_03,
+	VSR:               ISAV2_03,
+	VSRAB:             ISAV2_03,
+	VSRAH:             ISAV2_03,
+	VSRAW:             ISAV2_03,
+	VSRB:              ISAV2_03,
+	VSRH:              ISAV2_03,
+	VSRO:              ISAV2_03,
+	VSRW:              ISAV2_03,
+	VSUBCUW:           ISAV2_03,
+	VSUBFP:            ISAV2_03,
+	VSUBSBS:           ISAV2_03,
+	VSUBSHS:           ISAV2_03,
+	VSUBSWS:           ISAV2_03,
+	VSUBUBM:           ISAV2_03,
+	VSUBUBS:           ISAV2_03,
+	VSUBUHM:           ISAV2_03,
+	VSUBUHS:           ISAV2_03,
+	VSUBUWM:           ISAV2_03,
+	VSUBUWS:           ISAV2_03,
+	VSUM2SWS:          ISAV2_03,
+	VSUM4SBS:          ISAV2_03,
+	VSUM4SHS:          ISAV2_03,
+	VSUM4UBS:          ISAV2_03,
+	VSUMSWS:           ISAV2_03,
+	VUPKHPX:           ISAV2_03,
+	VUPKHSB:           ISAV2_03,
+	VUPKHSH:           ISAV2_03,
+	VUPKLPX:           ISAV2_03,
+	VUPKLSB:           ISAV2_03,
+	VUPKLSH:           ISAV2_03,
+	VXOR:              ISAV2_03,
+	FRE:               ISAV2_02,
+	FRECC:             ISAV2_02,
+	FRIM:              ISAV2_02,
+	FRIMCC:            ISAV2_02,
+	FRIN:              ISAV2_02,
+	FRINCC:            ISAV2_02,
+	FRIP:              ISAV2_02,
+	FRIPCC:            ISAV2_02,
+	FRIZ:              ISAV2_02,
+	FRIZCC:            ISAV2_02,
+	FRSQRTES:          ISAV2_02,
+	FRSQRTESCC:        ISAV2_02,
+	HRFID:             ISAV2_02,
+	POPCNTB:           ISAV2_02,
+	MFOCRF:            ISAV2_01,
+	MTOCRF:            ISAV2_01,
+	SLBMFEE:           ISAV2_00,
+	SLBMFEV:           ISAV2_00,
+	SLBMTE:            ISAV2_00,
+	RFSCV:             ISAV3_0,
+	SCV:               ISAV3_0,
+	LQ:                ISAV2_03,
+	STQ:               ISAV2_03,
+	CNTLZD:            ISAPowerPC,
+	CNTLZDCC:          ISAPowerPC,
+	DCBF:              ISAPowerPC,
+	DCBST:             ISAPowerPC,
+	DCBT:              ISAPowerPC,
+	DCBTST:            ISAPowerPC,
+	DIVD:              ISAPowerPC,
+	DIVDCC:            ISAPowerPC,
+	DIVDO:             ISAPowerPC,
+	DIVDOCC:           ISAPowerPC,
+	DIVDU:             ISAPowerPC,
+	DIVDUCC:           ISAPowerPC,
+	DIVDUO:            ISAPowerPC,
+	DIVDUOCC:          ISAPowerPC,
+	DIVW:              ISAPowerPC,
+	DIVWCC:            ISAPowerPC,
+	DIVWO:             ISAPowerPC,
+	DIVWOCC:           ISAPowerPC,
+	DIVWU:             ISAPowerPC,
+	DIVWUCC:           ISAPowerPC,
+	DIVWUO:            ISAPowerPC,
+	DIVWUOCC:          ISAPowerPC,
+	EIEIO:             ISAPowerPC,
+	EXTSB:             ISAPowerPC,
+	EXTSBCC:           ISAPowerPC,
+	EXTSW:             ISAPowerPC,
+	EXTSWCC:           ISAPowerPC,
+	FADDS:             ISAPowerPC,
+	FADDSCC:           ISAPowerPC,
+	FCFID:             ISAPowerPC,
+	FCFIDCC:           ISAPowerPC,
+	FCTID:             ISAPowerPC,
+	FCTIDCC:           ISAPowerPC,
+	FCTIDZ:            ISAPowerPC,
+	FCTIDZCC:          ISAPowerPC,
+	FDIVS:             ISAPowerPC,
+	FDIVSCC:           ISAPowerPC,
+	FMADDS:            ISAPowerPC,
+	FMADDSCC:          ISAPowerPC,
+	FMSUBS:            ISAPowerPC,
+	FMSUBSCC:          ISAPowerPC,
+	FMULS:             ISAPowerPC,
+	FMULSCC:           ISAPowerPC,
+	FNMADDS:           ISAPowerPC,
+	FNMADDSCC:         ISAPowerPC,
+	FNMSUBS:           ISAPowerPC,
+	FNMSUBSCC:         ISAPowerPC,
+	FRES:              ISAPowerPC,
+	FRESCC:            ISAPowerPC,
+	FRSQRTE:           ISAPowerPC,
+	FRSQRTECC:         ISAPowerPC,
+	FSEL:              ISAPowerPC,
+	FSELCC:            ISAPowerPC,
+	FSQRTS:            ISAPowerPC,
+	FSQRTSCC:          ISAPowerPC,
+	FSUBS:             ISAPowerPC,
+	FSUBSCC:           ISAPowerPC,
+	ICBI:              ISAPowerPC,
+	LD:                ISAPowerPC,
+	LDARX:             ISAPowerPC,
+	LDU:               ISAPowerPC,
+	LDUX:              ISAPowerPC,
+	LDX:               ISAPowerPC,
+	LWA:               ISAPowerPC,
+	LWARX:             ISAPowerPC,
+	LWAUX:             ISAPowerPC,
+	LWAX:              ISAPowerPC,
+	MFTB:              ISAPowerPC,
+	MTMSRD:            ISAPowerPC,
+	MULHD:             ISAPowerPC,
+	MULHDCC:           ISAPowerPC,
+	MULHDU:            ISAPowerPC,
+	MULHDUCC:          ISAPowerPC,
+	MULHW:             ISAPowerPC,
+	MULHWCC:           ISAPowerPC,
+	MULHWU:            ISAPowerPC,
+	MULHWUCC:          ISAPowerPC,
+	MULLD:             ISAPowerPC,
+	MULLDCC:           ISAPowerPC,
+	MULLDO:            ISAPowerPC,
+	MULLDOCC:          ISAPowerPC,
+	RFID:              ISAPowerPC,
+	RLDCL:             ISAPowerPC,
+	RLDCLCC:           ISAPowerPC,
+	RLDCR:             ISAPowerPC,
+	RLDCRCC:           ISAPowerPC,
+	RLDIC:             ISAPowerPC,
+	RLDICCC:           ISAPowerPC,
+	RLDICL:            ISAPowerPC,
+	RLDICLCC:          ISAPowerPC,
+	RLDICR:            ISAPowerPC,
+	RLDICRCC:          ISAPowerPC,
+	RLDIMI:            ISAPowerPC,
+	RLDIMICC:          ISAPowerPC,
+	SC:                ISAPowerPC,
+	SLBIA:             ISAPowerPC,
+	SLBIE:             ISAPowerPC,
+	SLD:               ISAPowerPC,
+	SLDCC:             ISAPowerPC,
+	SRAD:              ISAPowerPC,
+	SRADCC:            ISAPowerPC,
+	SRADI:             ISAPowerPC,
+	SRADICC:           ISAPowerPC,
+	SRD:               ISAPowerPC,
+	SRDCC:             ISAPowerPC,
+	STD:               ISAPowerPC,
+	STDCXCC:           ISAPowerPC,
+	STDU:              ISAPowerPC,
+	STDUX:             ISAPowerPC,
+	STDX:              ISAPowerPC,
+	STFIWX:            ISAPowerPC,
+	STWCXCC:           ISAPowerPC,
+	SUBF:              ISAPowerPC,
+	SUBFCC:            ISAPowerPC,
+	SUBFO:             ISAPowerPC,
+	SUBFOCC:           ISAPowerPC,
+	TD:                ISAPowerPC,
+	TDI:               ISAPowerPC,
+	TLBSYNC:           ISAPowerPC,
+	FCTIW:             ISAPower2,
+	FCTIWCC:           ISAPower2,
+	FCTIWZ:            ISAPower2,
+	FCTIWZCC:          ISAPower2,
+	FSQRT:             ISAPower2,
+	FSQRTCC:           ISAPower2,
+	ADD:               ISAPower1,
+	ADDCC:             ISAPower1,
+	ADDO:              ISAPower1,
+	ADDOCC:            ISAPower1,
+	ADDC:              ISAPower1,
+	ADDCCC:            ISAPower1,
+	ADDCO:             ISAPower1,
+	ADDCOCC:           ISAPower1,
+	ADDE:              ISAPower1,
+	ADDECC:            ISAPower1,
+	ADDEO:             ISAPower1,
+	ADDEOCC:           ISAPower1,
+	LI:                ISAPower1,
+	ADDI:              ISAPower1,
+	ADDIC:             ISAPower1,
+	ADDICCC:           ISAPower1,
+	LIS:               ISAPower1,
+	ADDIS:             ISAPower1,
+	ADDME:             ISAPower1,
+	ADDMECC:           ISAPower1,
+	ADDMEO:            ISAPower1,
+	ADDMEOCC:          ISAPower1,
+	ADDZE:             ISAPower1,
+	ADDZECC:           ISAPower1,
+	ADDZEO:            ISAPower1,
+	ADDZEOCC:          ISAPower1,
+	AND:               ISAPower1,
+	ANDCC:             ISAPower1,
+	ANDC:              ISAPower1,
+	ANDCCC:            ISAPower1,
+	ANDICC:            ISAPower1,
+	ANDISCC:           ISAPower1,
+	B:                 ISAPower1,
+	BA:                ISAPower1,
+	BL:                ISAPower1,
+	BLA:               ISAPower1,
+	BC:                ISAPower1,
+	BCA:               ISAPower1,
+	BCL:               ISAPower1,
+	BCLA:              ISAPower1,
+	BCCTR:             ISAPower1,
+	BCCTRL:            ISAPower1,
+	BCLR:              ISAPower1,
+	BCLRL:             ISAPower1,
+	CMPW:              ISAPower1,
+	CMPD:              ISAPower1,
+	CMP:               ISAPower1,
+	CMPWI:             ISAPower1,
+	CMPDI:             ISAPower1,
+	CMPI:              ISAPower1,
+	CMPLW:             ISAPower1,
+	CMPLD:             ISAPower1,
+	CMPL:              ISAPower1,
+	CMPLWI:            ISAPower1,
+	CMPLDI:            ISAPower1,
+	CMPLI:             ISAPower1,
+	CNTLZW:            ISAPower1,
+	CNTLZWCC:          ISAPower1,
+	CRAND:             ISAPower1,
+	CRANDC:            ISAPower1,
+	CREQV:             ISAPower1,
+	CRNAND:            ISAPower1,
+	CRNOR:             ISAPower1,
+	CROR:              ISAPower1,
+	CRORC:             ISAPower1,
+	CRXOR:             ISAPower1,
+	DCBZ:              ISAPower1,
+	EQV:               ISAPower1,
+	EQVCC:             ISAPower1,
+	EXTSH:             ISAPower1,
+	EXTSHCC:           ISAPower1,
+	FABS:              ISAPower1,
+	FABSCC:            ISAPower1,
+	FADD:              ISAPower1,
+	FADDCC:            ISAPower1,
+	FCMPO:             ISAPower1,
+	FCMPU:             ISAPower1,
+	FDIV:              ISAPower1,
+	FDIVCC:            ISAPower1,
+	FMADD:             ISAPower1,
+	FMADDCC:           ISAPower1,
+	FMR:               ISAPower1,
+	FMRCC:             ISAPower1,
+	FMSUB:             ISAPower1,
+	FMSUBCC:           ISAPower1,
+	FMUL:              ISAPower1,
+	FMULCC:            ISAPower1,
+	FNABS:             ISAPower1,
+	FNABSCC:           ISAPower1,
+	FNEG:              ISAPower1,
+	FNEGCC:            ISAPower1,
+	FNMADD:            ISAPower1,
+	FNMADDCC:          ISAPower1,
+	FNMSUB:            ISAPower1,
+	FNMSUBCC:          ISAPower1,
+	FRSP:              ISAPower1,
+	FRSPCC:            ISAPower1,
+	FSUB:              ISAPower1,
+	FSUBCC:            ISAPower1,
+	ISYNC:             ISAPower1,
+	LBZ:               ISAPower1,
+	LBZU:              ISAPower1,
+	LBZUX:             ISAPower1,
+	LBZX:              ISAPower1,
+	LFD:               ISAPower1,
+	LFDU:              ISAPower1,
+	LFDUX:             ISAPower1,
+	LFDX:              ISAPower1,
+	LFS:               ISAPower1,
+	LFSU:              ISAPower1,
+	LFSUX:             ISAPower1,
+	LFSX:              ISAPower1,
+	LHA:               ISAPower1,
+	LHAU:              ISAPower1,
+	LHAUX:             ISAPower1,
+	LHAX:              ISAPower1,
+	LHBRX:             ISAPower1,
+	LHZ:               ISAPower1,
+	LHZU:              ISAPower1,
+	LHZUX:             ISAPower1,
+	LHZX:              ISAPower1,
+	LMW:               ISAPower1,
+	LSWI:              ISAPower1,
+	LSWX:              ISAPower1,
+	LWBRX:             ISAPower1,
+	LWZ:               ISAPower1,
+	LWZU:              ISAPower1,
+	LWZUX:             ISAPower1,
+	LWZX:              ISAPower1,
+	MCRF:              ISAPower1,
+	MCRFS:             ISAPower1,
+	MFCR:              ISAPower1,
+	MFFS:              ISAPower1,
+	MFFSCC:            ISAPower1,
+	MFMSR:             ISAPower1,
+	MFSPR:             ISAPower1,
+	MTCRF:             ISAPower1,
+	MTFSB0:            ISAPower1,
+	MTFSB0CC:          ISAPower1,
+	MTFSB1:            ISAPower1,
+	MTFSB1CC:          ISAPower1,
+	MTFSF:             ISAPower1,
+	MTFSFCC:           ISAPower1,
+	MTFSFI:            ISAPower1,
+	MTFSFICC:          ISAPower1,
+	MTMSR:             ISAPower1,
+	MTSPR:             ISAPower1,
+	MULLI:             ISAPower1,
+	MULLW:             ISAPower1,
+	MULLWCC:           ISAPower1,
+	MULLWO:            ISAPower1,
+	MULLWOCC:          ISAPower1,
+	NAND:              ISAPower1,
+	NANDCC:            ISAPower1,
+	NEG:               ISAPower1,
+	NEGCC:             ISAPower1,
+	NEGO:              ISAPower1,
+	NEGOCC:            ISAPower1,
+	NOR:               ISAPower1,
+	NORCC:             ISAPower1,
+	OR:                ISAPower1,
+	ORCC:              ISAPower1,
+	ORC:               ISAPower1,
+	ORCCC:             ISAPower1,
+	NOP:               ISAPower1,
+	ORI:               ISAPower1,
+	ORIS:              ISAPower1,
+	RLWIMI:            ISAPower1,
+	RLWIMICC:          ISAPower1,
+	RLWINM:            ISAPower1,
+	RLWINMCC:          ISAPower1,
+	RLWNM:             ISAPower1,
+	RLWNMCC:           ISAPower1,
+	SLW:               ISAPower1,
+	SLWCC:             ISAPower1,
+	SRAW:              ISAPower1,
+	SRAWCC:            ISAPower1,
+	SRAWI:             ISAPower1,
+	SRAWICC:           ISAPower1,
+	SRW:               ISAPower1,
+	SRWCC:             ISAPower1,
+	STB:               ISAPower1,
+	STBU:              ISAPower1,
+	STBUX:             ISAPower1,
+	STBX:              ISAPower1,
+	STFD:              ISAPower1,
+	STFDU:             ISAPower1,
+	STFDUX:            ISAPower1,
+	STFDX:             ISAPower1,
+	STFS:              ISAPower1,
+	STFSU:             ISAPower1,
+	STFSUX:            ISAPower1,
+	STFSX:             ISAPower1,
+	STH:               ISAPower1,
+	STHBRX:            ISAPower1,
+	STHU:              ISAPower1,
+	STHUX:             ISAPower1,
+	STHX:              ISAPower1,
+	STMW:              ISAPower1,
+	STSWI:             ISAPower1,
+	STSWX:             ISAPower1,
+	STW:               ISAPower1,
+	STWBRX:            ISAPower1,
+	STWU:              ISAPower1,
+	STWUX:             ISAPower1,
+	STWX:              ISAPower1,
+	SUBFC:             ISAPower1,
+	SUBFCCC:           ISAPower1,
+	SUBFCO:            ISAPower1,
+	SUBFCOCC:          ISAPower1,
+	SUBFE:             ISAPower1,
+	SUBFECC:           ISAPower1,
+	SUBFEO:            ISAPower1,
+	SUBFEOCC:          ISAPower1,
+	SUBFIC:            ISAPower1,
+	SUBFME:            ISAPower1,
+	SUBFMECC:          ISAPower1,
+	SUBFMEO:           ISAPower1,
+	SUBFMEOCC:         ISAPower1,
+	SUBFZE:            ISAPower1,
+	SUBFZECC:          ISAPower1,
+	SUBFZEO:           ISAPower1,
+	SUBFZEOCC:         ISAPower1,
+	SYNC:              ISAPower1,
+	TLBIE:             ISAPower1,
+	TW:                ISAPower1,
+	TWI:               ISAPower1,
+	XOR:               ISAPower1,
+	XORCC:             ISAPower1,
+	XORI:              ISAPower1,
+	XORIS:             ISAPower1,
 }
 
 var (
+	ap_VecSpReg_29_29_11_14          = &argField{Type: TypeVecSpReg, Shift: 0, BitFields: BitFields{{29, 1, 0}, {11, 4, 0}}}
+	ap_VecSpReg_30_30_16_19          = &argField{Type: TypeVecSpReg, Shift: 0, BitFields: BitFields{{30, 1, 0}, {16, 4, 0}}}
+	ap_DMReg_6_8                     = &argField{Type: TypeDMReg, Shift: 0, BitFields: BitFields{{6, 3, 0}}}
+	ap_ImmUnsigned_15_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{15, 1, 0}}}
+	ap_DMReg_16_18                   = &argField{Type: TypeDMReg, Shift: 0, BitFields: BitFields{{16, 3, 0}}}
+	ap_ImmUnsigned_20_20_15_15       = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{20, 1, 0}, {15, 1, 0}}}
+	ap_VecSReg_30_30_16_20           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{30, 1, 0}, {16, 5, 0}}}
+	ap_DMReg_38_40                   = &argField{Type: TypeDMReg, Shift: 0, BitFields: BitFields{{6, 3, 1}}}
+	ap_VecSpReg_61_61_43_46          = &argField{Type: TypeVecSpReg, Shift: 0, BitFields: BitFields{{29, 1, 1}, {11, 4, 1}}}
+	ap_VecSReg_62_62_48_52           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{30, 1, 1}, {16, 5, 1}}}
+	ap_ImmUnsigned_20_27             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{20, 8, 0}}}
+	ap_ImmUnsigned_28_31             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{28, 4, 0}}}
+	ap_ImmUnsigned_16_17             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 2, 0}}}
+	ap_ImmUnsigned_16_19             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 4, 0}}}
+	ap_ImmUnsigned_10_10             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{10, 1, 0}}}
+	ap_DMReg_6_7                     = &argField{Type: TypeDMReg, Shift: 0, BitFields: BitFields{{6, 2, 0}}}
+	ap_ImmUnsigned_16_20             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 5, 0}}}
+	ap_ImmUnsigned_11_12             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{11, 2, 0}}}
+	ap_ImmUnsigned_13_13             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{13, 1, 0}}}
+	ap_ImmUnsigned_14_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{14, 2, 0}}}
 	ap_Reg_16_20                     = &argField{Type: TypeReg, Shift: 0, BitFields: BitFields{{16, 5, 0}}}
 	ap_NegOffset_31_31_6_10_shift3   = &argField{Type: TypeNegOffset, Shift: 3, BitFields: BitFields{{31, 1, 0}, {6, 5, 0}}}
 	ap_Reg_11_15                     = &argField{Type: TypeReg, Shift: 0, BitFields: BitFields{{11, 5, 0}}}
@@ -2853,7 +4404,6 @@ var (
 	ap_VecReg_6_10                   = &argField{Type: TypeVecReg, Shift: 0, BitFields: BitFields{{6, 5, 0}}}
 	ap_FPReg_16_20                   = &argField{Type: TypeFPReg, Shift: 0, BitFields: BitFields{{16, 5, 0}}}
 	ap_VecSReg_31_31_6_10            = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{31, 1, 0}, {6, 5, 0}}}
-	ap_ImmUnsigned_16_20             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 5, 0}}}
 	ap_VecSpReg_10_10_6_9            = &argField{Type: TypeVecSpReg, Shift: 0, BitFields: BitFields{{10, 1, 0}, {6, 4, 0}}}
 	ap_Offset_16_27_shift4           = &argField{Type: TypeOffset, Shift: 4, BitFields: BitFields{{16, 12, 0}}}
 	ap_ImmUnsigned_16_25_11_15_31_31 = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 10, 0}, {11, 5, 0}, {31, 1, 0}}}
@@ -2868,17 +4418,12 @@ var (
 	ap_VecSpReg_42_42_38_41          = &argField{Type: TypeVecSpReg, Shift: 0, BitFields: BitFields{{10, 1, 1}, {6, 4, 1}}}
 	ap_MMAReg_38_40                  = &argField{Type: TypeMMAReg, Shift: 0, BitFields: BitFields{{6, 3, 1}}}
 	ap_VecSReg_61_61_43_47           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{29, 1, 1}, {11, 5, 1}}}
-	ap_VecSReg_62_62_48_52           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{30, 1, 1}, {16, 5, 1}}}
 	ap_ImmUnsigned_24_27             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{24, 4, 0}}}
-	ap_ImmUnsigned_28_31             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{28, 4, 0}}}
-	ap_ImmUnsigned_16_17             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 2, 0}}}
 	ap_ImmUnsigned_28_29             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{28, 2, 0}}}
 	ap_ImmUnsigned_16_23             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 8, 0}}}
-	ap_ImmUnsigned_16_19             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 4, 0}}}
 	ap_CondRegBit_11_15              = &argField{Type: TypeCondRegBit, Shift: 0, BitFields: BitFields{{11, 5, 0}}}
 	ap_VecReg_11_15                  = &argField{Type: TypeVecReg, Shift: 0, BitFields: BitFields{{11, 5, 0}}}
 	ap_CondRegField_6_8              = &argField{Type: TypeCondRegField, Shift: 0, BitFields: BitFields{{6, 3, 0}}}
-	ap_ImmUnsigned_15_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{15, 1, 0}}}
 	ap_Reg_21_25                     = &argField{Type: TypeReg, Shift: 0, BitFields: BitFields{{21, 5, 0}}}
 	ap_ImmUnsigned_13_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{13, 3, 0}}}
 	ap_ImmUnsigned_12_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{12, 4, 0}}}
@@ -2886,7 +4431,6 @@ var (
 	ap_ImmUnsigned_23_25             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{23, 3, 0}}}
 	ap_MMAReg_6_8                    = &argField{Type: TypeMMAReg, Shift: 0, BitFields: BitFields{{6, 3, 0}}}
 	ap_VecSReg_29_29_11_15           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{29, 1, 0}, {11, 5, 0}}}
-	ap_VecSReg_30_30_16_20           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{30, 1, 0}, {16, 5, 0}}}
 	ap_VecSReg_63_63_38_42           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{31, 1, 1}, {6, 5, 1}}}
 	ap_VecSReg_60_60_53_57           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{28, 1, 1}, {21, 5, 1}}}
 	ap_ImmUnsigned_24_31             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{24, 8, 0}}}
@@ -2900,8 +4444,6 @@ var (
 	ap_ImmUnsigned_19_20             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{19, 2, 0}}}
 	ap_ImmSigned_16_25_11_15_31_31   = &argField{Type: TypeImmSigned, Shift: 0, BitFields: BitFields{{16, 10, 0}, {11, 5, 0}, {31, 1, 0}}}
 	ap_ImmUnsigned_22_22             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{22, 1, 0}}}
-	ap_ImmUnsigned_10_10             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{10, 1, 0}}}
-	ap_ImmUnsigned_14_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{14, 2, 0}}}
 	ap_ImmUnsigned_10_15             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{10, 6, 0}}}
 	ap_ImmUnsigned_30_30_16_20       = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{30, 1, 0}, {16, 5, 0}}}
 	ap_Offset_16_29_shift2           = &argField{Type: TypeOffset, Shift: 2, BitFields: BitFields{{16, 14, 0}}}
@@ -2921,7 +4463,6 @@ var (
 	ap_ImmUnsigned_17_20             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{17, 4, 0}}}
 	ap_ImmUnsigned_22_23             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{22, 2, 0}}}
 	ap_VecSReg_28_28_21_25           = &argField{Type: TypeVecSReg, Shift: 0, BitFields: BitFields{{28, 1, 0}, {21, 5, 0}}}
-	ap_ImmUnsigned_11_12             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{11, 2, 0}}}
 	ap_ImmSigned_11_15               = &argField{Type: TypeImmSigned, Shift: 0, BitFields: BitFields{{11, 5, 0}}}
 	ap_ImmUnsigned_16_21             = &argField{Type: TypeImmUnsigned, Shift: 0, BitFields: BitFields{{16, 6, 0}}}
 	ap_CondRegBit_21_25              = &argField{Type: TypeCondRegBit, Shift: 0, BitFields: BitFields{{21, 5, 0}}}
@@ -2951,6 +4492,78 @@ var (
 )
 
 var instFormats = [...]instFormat{
+	{DMXXEXTFDMR512, 0xfc0007f800000000, 0xf000071000000000, 0x60080100000000, // DMR Extract to VSX 512 XX3-form (dmxxextfdmr512 XAp,XBp,AT,P)
+		[6]*argField{ap_VecSpReg_29_29_11_14, ap_VecSpReg_30_30_16_19, ap_DMReg_6_8, ap_ImmUnsigned_15_15}},
+	{DMXXINSTDMR512, 0xfc0007f800000000, 0xf000075000000000, 0x60080100000000, // DMR Insert from VSX 512 XX3-form (dmxxinstdmr512 AT,XAp,XBp,P)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSpReg_30_30_16_19, ap_ImmUnsigned_15_15}},
+	{DMMR, 0xfc1f07fe00000000, 0x7c06016200000000, 0x60180100000000, // DMR Move X-form (dmmr AT,AB)
+		[6]*argField{ap_DMReg_6_8, ap_DMReg_16_18}},
+	{DMXOR, 0xfc1f07fe00000000, 0x7c07016200000000, 0x60180100000000, // DMR XOR X-form (dmxor AT,AB)
+		[6]*argField{ap_DMReg_6_8, ap_DMReg_16_18}},
+	{DMSETDMRZ, 0xfc1f07fe00000000, 0x7c02016200000000, 0x60f80100000000, // DMR Set to Zero X-form (dmsetdmrz AT)
+		[6]*argField{ap_DMReg_6_8}},
+	{DMXXEXTFDMR256, 0xfc0007fc00000000, 0xf000079000000000, 0x7e000100000000, // DMR Extract to VSX 256 XX3-form (dmxxextfdmr256 XBp,AS,P)
+		[6]*argField{ap_VecSpReg_30_30_16_19, ap_DMReg_6_8, ap_ImmUnsigned_20_20_15_15}},
+	{DMXXINSTDMR256, 0xfc0007fc00000000, 0xf000079400000000, 0x7e000100000000, // DMR Insert from VSX 256 XX3-form (dmxxinstdmr256 AT,XBp,P)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_30_30_16_19, ap_ImmUnsigned_20_20_15_15}},
+	{DMXVBF16GERX2, 0xfc0007f800000000, 0xec0002d800000000, 0x61000100000000, // DMR VSX Vector bfloat16 GER (Rank-2 Update) XX3-form (dmxvbf16gerx2 AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVBF16GERX2, 0xfff00000fc0007f8, 0x7900000ec0002d8, 0xf300000000000, // Prefixed Masked DMR VSX Vector bfloat16 GER (Rank-2 Update) MMIRR:XX3-form (pmdmxvbf16gerx2 AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVBF16GERX2PP, 0xfc0007f800000000, 0xec00025000000000, 0x61000100000000, // DMR VSX Vector bfloat16 GER (Rank-2 Update), Positive multiply, Positive accumulate XX3-form (dmxvbf16gerx2pp AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVBF16GERX2PP, 0xfff00000fc0007f8, 0x7900000ec000250, 0xf300000000000, // Prefixed Masked DMR VSX Vector bfloat16 GER (Rank-2 Update), Positive multiply, Positive accumulate MMIRR:XX3-form (pmdmxvbf16gerx2pp AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVBF16GERX2PN, 0xfc0007f800000000, 0xec00059800000000, 0x61000100000000, // DMR VSX Vector bfloat16 GER (Rank-2 Update), Positive multiply, Negative accumulate XX3-form (dmxvbf16gerx2pn AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVBF16GERX2PN, 0xfff00000fc0007f8, 0x7900000ec000598, 0xf300000000000, // Prefixed Masked DMR VSX Vector bfloat16 GER (Rank-2 Update), Positive multiply, Negative accumulate MMIRR:XX3-form (pmdmxvbf16gerx2pn AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVBF16GERX2NP, 0xfc0007f800000000, 0xec00039800000000, 0x61000100000000, // DMR VSX Vector bfloat16 GER (Rank-2 Update), Negative multiply, Positive accumulate XX3-form (dmxvbf16gerx2np AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVBF16GERX2NP, 0xfff00000fc0007f8, 0x7900000ec000398, 0xf300000000000, // Prefixed Masked DMR VSX Vector bfloat16 GER (Rank-2 Update), Negative multiply, Positive accumulate MMIRR:XX3-form (pmdmxvbf16gerx2np AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVBF16GERX2NN, 0xfc0007f800000000, 0xec00075000000000, 0x61000100000000, // DMR VSX Vector bfloat16 GER (Rank-2 Update), Negative multiply, Negative accumulate XX3-form (dmxvbf16gerx2nn AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVBF16GERX2NN, 0xfff00000fc0007f8, 0x7900000ec000750, 0xf300000000000, // Prefixed Masked DMR VSX Vector bfloat16 GER (Rank-2 Update), Negative multiply, Negative accumulate MMIRR:XX3-form (pmdmxvbf16gerx2nn AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVF16GERX2, 0xfc0007f800000000, 0xec00021800000000, 0x61000100000000, // DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update) XX3-form (dmxvf16gerx2 AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVF16GERX2, 0xfff00000fc0007f8, 0x7900000ec000218, 0xf300000000000, // Prefixed Masked DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update) MMIRR:XX3-form (pmdmxvf16gerx2 AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVF16GERX2PP, 0xfc0007f800000000, 0xec00021000000000, 0x61000100000000, // DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Positive multiply, Positive accumulate XX3-form (dmxvf16gerx2pp AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVF16GERX2PP, 0xfff00000fc0007f8, 0x7900000ec000210, 0xf300000000000, // Prefixed Masked DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Positive multiply, Positive accumulate MMIRR:XX3-form (pmdmxvf16gerx2pp AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVF16GERX2PN, 0xfc0007f800000000, 0xec00049800000000, 0x61000100000000, // DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Positive multiply, Negative accumulate XX3-form (dmxvf16gerx2pn AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVF16GERX2PN, 0xfff00000fc0007f8, 0x7900000ec000498, 0xf300000000000, // Prefixed Masked DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Positive multiply, Negative accumulate MMIRR:XX3-form (pmdmxvf16gerx2pn AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVF16GERX2NP, 0xfc0007f800000000, 0xec00029800000000, 0x61000100000000, // DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Negative multiply, Positive accumulate XX3-form (dmxvf16gerx2np AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVF16GERX2NP, 0xfff00000fc0007f8, 0x7900000ec000298, 0xf300000000000, // Prefixed Masked DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Negative multiply, Positive accumulate MMIRR:XX3-form (pmdmxvf16gerx2np AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVF16GERX2NN, 0xfc0007f800000000, 0xec00065000000000, 0x61000100000000, // DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Negative multiply, Negative accumulate XX3-form (dmxvf16gerx2nn AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVF16GERX2NN, 0xfff00000fc0007f8, 0x7900000ec000650, 0xf300000000000, // Prefixed Masked DMR VSX Vector 16-bit Floating-Point GER (Rank-2 Update), Negative multiply, Negative accumulate MMIRR:XX3-form (pmdmxvf16gerx2nn AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_17}},
+	{DMXVI8GERX4, 0xfc0007f800000000, 0xec00005800000000, 0x61000100000000, // DMR VSX Vector 8-bit Signed/Unsigned Integer GER (Rank-4 Update) XX3-form (dmxvi8gerx4 AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVI8GERX4, 0xfff00000fc0007f8, 0x7900000ec000058, 0xf000000000000, // Prefixed Masked DMR VSX Vector 8-bit Signed/Unsigned Integer GER (Rank-4 Update) MMIRR:XX3-form (pmdmxvi8gerx4 AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_19}},
+	{DMXVI8GERX4PP, 0xfc0007f800000000, 0xec00005000000000, 0x61000100000000, // DMR VSX Vector 8-bit Signed/Unsigned Integer GER (Rank-4 Update), Positive multiply, Positive accumulate XX3-form (dmxvi8gerx4pp AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVI8GERX4PP, 0xfff00000fc0007f8, 0x7900000ec000050, 0xf000000000000, // Prefixed Masked DMR VSX Vector 8-bit Signed/Unsigned Integer GER (Rank-4 Update), Positive multiply, Positive accumulate MMIRR:XX3-form (pmdmxvi8gerx4pp AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_19}},
+	{DMXVI8GERX4SPP, 0xfc0007f800000000, 0xec00031000000000, 0x61000100000000, // DMR VSX Vector 8-bit Signed/Unsigned Integer GER (Rank-4 Update) with Saturate Positive multiply, Positive accumulate XX3-form (dmxvi8gerx4spp AT,XAp,XB)
+		[6]*argField{ap_DMReg_6_8, ap_VecSpReg_29_29_11_14, ap_VecSReg_30_30_16_20}},
+	{PMDMXVI8GERX4SPP, 0xfff00000fc0007f8, 0x7900000ec000310, 0xf000000000000, // Prefixed Masked DMR VSX Vector 8-bit Signed/Unsigned Integer GER (Rank-4 Update) with Saturate Positive multiply, Positive accumulate MMIRR:XX3-form (pmdmxvi8gerx4spp AT,XAp,XB,XMSK,YMSK,PMSK)
+		[6]*argField{ap_DMReg_38_40, ap_VecSpReg_61_61_43_46, ap_VecSReg_62_62_48_52, ap_ImmUnsigned_20_27, ap_ImmUnsigned_28_31, ap_ImmUnsigned_16_19}},
+	{DMSHA2HASH, 0xfc1f07fe00000000, 0x7c0e016200000000, 0x40180100000000, // DMR SHA-2 Hash X-form (dmsha2hash AT,AB,T)
+		[6]*argField{ap_DMReg_6_8, ap_DMReg_16_18, ap_ImmUnsigned_10_10}},
+	{DMSHA3HASH, 0xfc1f07fe00000000, 0x7c0f016200000000, 0xe0000100000000, // DMR SHA-3 Hash X-form (dmsha3hash AT,SR)
+		[6]*argField{ap_DMReg_6_7, ap_ImmUnsigned_16_20}},
+	{DMXXSHAPAD, 0xfc0007fc00000000, 0xf000069400000000, 0x60000100000000, // DMR SHA Padding XX2-form (dmxxshapad AT,XB,ID,E,BL)
+		[6]*argField{ap_DMReg_6_8, ap_VecSReg_30_30_16_20, ap_ImmUnsigned_11_12, ap_ImmUnsigned_13_13, ap_ImmUnsigned_14_15}},
 	{HASHCHK, 0xfc0007fe00000000, 0x7c0005e400000000, 0x0, // Hash Check X-form (hashchk RB,offset(RA))
 		[6]*argField{ap_Reg_16_20, ap_NegOffset_31_31_6_10_shift3, ap_Reg_11_15}},
 	{HASHCHKP, 0xfc0007fe00000000, 0x7c00056400000000, 0x0, // Hash Check Privileged X-form (hashchkp RB,offset(RA))
@@ -3433,6 +5046,8 @@ var instFormats = [...]instFormat{
 		[6]*argField{ap_VecSReg_31_31_6_10, ap_VecReg_16_20, ap_ImmUnsigned_11_15}},
 	{XXGENPCVWM, 0xfc0007fe00000000, 0xf000076800000000, 0x0, // VSX Vector Generate PCV from Word Mask X-form (xxgenpcvwm XT,VRB,IMM)
 		[6]*argField{ap_VecSReg_31_31_6_10, ap_VecReg_16_20, ap_ImmUnsigned_11_15}},
+	{DMXXMFACC, 0xfc1f07fe00000000, 0x7c00016200000000, 0x60f80100000000, // DMR Move From Accumulator X-form (dmxxmfacc AS)
+		[6]*argField{ap_DMReg_6_8}},
 	{XXMFACC, 0xfc1f07fe00000000, 0x7c00016200000000, 0x60f80100000000, // VSX Move From Accumulator X-form (xxmfacc AS)
 		[6]*argField{ap_MMAReg_6_8}},
 	{XXMTACC, 0xfc1f07fe00000000, 0x7c01016200000000, 0x60f80100000000, // VSX Move To Accumulator X-form (xxmtacc AT)
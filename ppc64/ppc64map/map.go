@@ -41,45 +41,23 @@ var debug = flag.Bool("debug", false, "enable debugging output")
 
 var inputFile string
 
-type isaversion uint32
-
-const (
-	// Sort as supersets of each other. Generally speaking, each newer ISA
-	// supports a superset of the previous instructions with a few exceptions
-	// throughout.
-	ISA_P1 isaversion = iota
-	ISA_P2
-	ISA_PPC
-	ISA_V200
-	ISA_V201
-	ISA_V202
-	ISA_V203
-	ISA_V205
-	ISA_V206
-	ISA_V207
-	ISA_V30
-	ISA_V30B
-	ISA_V30C
-	ISA_V31
-	ISA_V31B
-)
-
-var isaToISA = map[string]isaversion{
-	"P1":    ISA_P1,
-	"P2":    ISA_P2,
-	"PPC":   ISA_PPC,
-	"v2.00": ISA_V200,
-	"v2.01": ISA_V201,
-	"v2.02": ISA_V202,
-	"v2.03": ISA_V203,
-	"v2.05": ISA_V205,
-	"v2.06": ISA_V206,
-	"v2.07": ISA_V207,
-	"v3.0":  ISA_V30,
-	"v3.0B": ISA_V30B,
-	"v3.0C": ISA_V30C,
-	"v3.1":  ISA_V31,
-	"v3.1B": ISA_V31B,
+var isaToISA = map[string]asm.ISA{
+	"P1":    asm.ISAPower1,
+	"P2":    asm.ISAPower2,
+	"PPC":   asm.ISAPowerPC,
+	"v2.00": asm.ISAV2_00,
+	"v2.01": asm.ISAV2_01,
+	"v2.02": asm.ISAV2_02,
+	"v2.03": asm.ISAV2_03,
+	"v2.05": asm.ISAV2_05,
+	"v2.06": asm.ISAV2_06,
+	"v2.07": asm.ISAV2_07,
+	"v3.0":  asm.ISAV3_0,
+	"v3.0B": asm.ISAV3_0B,
+	"v3.0C": asm.ISAV3_0C,
+	"v3.1":  asm.ISAV3_1,
+	"v3.1B": asm.ISAV3_1B,
+	"v3.1C": asm.ISAV3_1C,
 }
 
 func usage() {
@@ -180,7 +158,7 @@ type Inst struct {
 	SDontCare uint32 // Likewise for the DontCare bits
 	Fields    []Field
 	Words     int // Number of words instruction encodes to.
-	Isa       isaversion
+	Isa       asm.ISA
 	memOp     bool // Is this a memory operation?
 	memOpX    bool // Is this an x-form memory operation?
 	memOpSt   bool // Is this a store memory operations?
@@ -513,12 +491,19 @@ func add(p *Prog, text, mnemonics, encoding, isa string) {
 				}
 				fallthrough
 
-			case "UI", "BO", "BH", "TH", "LEV", "NB", "L", "TO", "FXM", "FC", "U", "W", "FLM", "IMM8", "RIC", "PRS", "SHB", "SHW", "ST", "SIX", "PS", "DCM", "DGM", "RMC", "SP", "S", "DM", "CT", "EH", "E", "MO", "WC", "A", "IH", "OC", "DUI", "DUIS", "CY", "SC", "PL", "MP", "N", "DRM", "RM":
+			case "UI", "BO", "BH", "TH", "LEV", "NB", "L", "TO", "FXM", "FC", "U", "W", "FLM", "IMM8", "RIC", "PRS", "SHB", "SHW", "ST", "SIX", "PS", "DCM", "DGM", "RMC", "SP", "S", "DM", "CT", "EH", "E", "MO", "WC", "A", "IH", "OC", "DUI", "DUIS", "CY", "SC", "PL", "MP", "N", "DRM", "RM", "T", "SR", "ID", "BL":
 				typ = asm.TypeImmUnsigned
 				if i := args.Find(opr); i < 0 {
 					log.Printf("coerce to D: %s: couldn't find extended field %s in %s", text, opr, args)
 					opr = "D"
 				}
+			case "P":
+				typ = asm.TypeImmUnsigned
+				// The 256-bit dense math transfers split P in two.
+				if i := args.Find(opr); i < 0 {
+					opr = "P0"
+					opr2 = "P1"
+				}
 			case "bm":
 				opr = "b0"
 				opr2 = "b1"
@@ -619,15 +604,25 @@ func add(p *Prog, text, mnemonics, encoding, isa string) {
 				opr2 = opr[1:2] + "p"
 				opr = opr[1:2] + "X"
 
-			case "XAp":
+			case "XAp", "XBp":
 				// XAp in MMA encodes a regular VSR, but is only valid
 				// if it is even, and does not overlap the accumulator.
+				// The dense math instructions encode a VSR pair instead.
 				typ = asm.TypeVecSReg
 				opr2 = opr[1:2] + "p"
 				opr = opr[1:2] + "X"
+				if i := args.Find(opr2); i >= 0 && args[i].Bits == 4 {
+					typ = asm.TypeVecSpReg
+				}
 
 			case "AT", "AS":
 				typ = asm.TypeMMAReg
+				if strings.HasPrefix(strings.TrimPrefix(inst.Op, "pm"), "dm") {
+					typ = asm.TypeDMReg
+				}
+
+			case "AB":
+				typ = asm.TypeDMReg
 
 			case "VRA", "VRB", "VRC", "VRS", "VRT":
 				typ = asm.TypeVecReg
@@ -1059,9 +1054,10 @@ func genOptabEntry(ta *AggInfo, typeMap map[string]*Inst) string {
 // assembler routines.
 //
 // For now it is restricted to generating code for ISA 3.1 and newer, but it could
-// support older ISA versions with some work, and integration effort.
+// support older ISA versions with some work, and integration effort. Instructions
+// added after ISA 3.1B are skipped, as the assembler has no dense math registers.
 func printEncoder(p *Prog) {
-	const minISA = ISA_V31
+	const minISA, maxISA = asm.ISAV3_1, asm.ISAV3_1B
 
 	// The type map separates based on obj.Addr to a bit field.  Register types
 	// for GPR, FPR, VR pack identically, but are classified differently.
@@ -1083,7 +1079,7 @@ func printEncoder(p *Prog) {
 
 	// Classify each opcode and it's arguments, and generate opcode name/enum values.
 	for i, insn := range p.Insts {
-		if insn.Isa < minISA {
+		if insn.Isa < minISA || insn.Isa > maxISA {
 			continue
 		}
 		extra := ""
@@ -1116,14 +1112,15 @@ func printEncoder(p *Prog) {
 	targ := struct {
 		InputFile   string
 		Insts       []Inst
-		MinISA      isaversion
+		MinISA      asm.ISA
+		MaxISA      asm.ISA
 		TypeAggList []*AggInfo
 		TypeList    []*Inst
 		FirstInsn   [2]string
 		TypeMap     map[string]*Inst
 		Oplist      string
 		Opnames     string
-	}{InputFile: inputFile, Insts: p.Insts, MinISA: minISA, FirstInsn: firstInsn, TypeMap: typeMap, Oplist: oplistBuf.String(), Opnames: opnameBuf.String()}
+	}{InputFile: inputFile, Insts: p.Insts, MinISA: minISA, MaxISA: maxISA, FirstInsn: firstInsn, TypeMap: typeMap, Oplist: oplistBuf.String(), Opnames: opnameBuf.String()}
 	for _, v := range typeAggMap {
 		targ.TypeAggList = append(targ.TypeAggList, v)
 	}
@@ -1158,14 +1155,14 @@ func printEncoder(p *Prog) {
 		}
 
 		var GenOpcodes = [...]uint32 {
-			{{range $v := .Insts}}{{if ge $v.Isa $.MinISA -}}
+			{{range $v := .Insts}}{{if and (ge $v.Isa $.MinISA) (le $v.Isa $.MaxISA) -}}
 			{{if (eq $v.Words 1)}}{{printf "0x%08x, // A%s" $v.Value  (opname $v.Op)}}
 			{{else}}              {{printf "0x%08x, // A%s" $v.SValue (opname $v.Op)}}
 			{{end}}{{end}}{{end -}}
 		}
 
 		var GenPfxOpcodes = [...]uint32 {
-			{{range $v := .Insts}}{{if and (ge $v.Isa $.MinISA) (eq $v.Words 2) -}}
+			{{range $v := .Insts}}{{if and (ge $v.Isa $.MinISA) (le $v.Isa $.MaxISA) (eq $v.Words 2) -}}
 			{{printf "0x%08x, // A%s" $v.Value (opname $v.Op)}}
 			{{end}}{{end -}}
 		}
@@ -1292,6 +1289,19 @@ func printDecoder(p *Prog) {
 	}
 	fmt.Fprint(&buf, "}\n\n\n")
 
+	// Emit slice mapping opcode number to the ISA version which introduced it.
+	m = map[string]bool{}
+	fmt.Fprintf(&buf, "var opisa = [...]ISA{\n")
+	for _, inst := range p.Insts {
+		name := opName(inst.Op)
+		if ok := m[name]; ok {
+			continue
+		}
+		m[name] = true
+		fmt.Fprintf(&buf, "\t%s: %#v,\n", name, inst.Isa)
+	}
+	fmt.Fprint(&buf, "}\n\n\n")
+
 	// print out argFields
 	fmt.Fprintf(&buf, "var (\n")
 	m = map[string]bool{}
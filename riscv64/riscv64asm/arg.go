@@ -60,6 +60,8 @@ package riscv64asm
 //
 // - arg_zimm5: a 5 bit unsigned immediate encoded in imm[19:15] field
 //
+// - arg_zimm6: a 6 bit unsigned immediate encoded in imm[26|19:15] field
+//
 // - arg_vtype_zimm10: a 10 bit unsigned immediate encoded in vtypei[29:20] field
 //
 // - arg_vtype_zimm11: an 11 bit unsigned immediate encoded in vtypei[30:20] field
@@ -70,6 +72,22 @@ package riscv64asm
 //
 // - arg_shamt6: a shift amount encoded in shamt6[25:20] field
 //
+// - arg_fli: an index into the FLI constant table encoded in rs1[19:15] field
+//
+// - arg_c_uimm1: a halfword offset encoded in uimm[1] at bit 5 of Zcb loads and stores
+//
+// - arg_c_uimm2: a byte offset encoded in uimm[0|1] at bits [6:5] of Zcb loads and stores
+//
+// - arg_c_rlist: a Zcmp register list encoded in rlist[7:4] field
+//
+// - arg_c_spimm: a Zcmp stack adjustment encoded in spimm[3:2] field, scaled by the register list
+//
+// - arg_c_sreg1: a saved register s0-s7 encoded in r1s'[9:7] field
+//
+// - arg_c_sreg2: a saved register s0-s7 encoded in r2s'[4:2] field
+//
+// - arg_c_index: a Zcmt jump table index encoded in index[9:2] field
+//
 
 type argType uint16
 
@@ -102,6 +120,7 @@ const (
 	arg_simm12
 	arg_simm5
 	arg_zimm5
+	arg_zimm6
 	arg_vtype_zimm10
 	arg_vtype_zimm11
 	arg_bimm12
@@ -109,6 +128,7 @@ const (
 	arg_jimm20
 	arg_shamt5
 	arg_shamt6
+	arg_fli
 
 	// RISC-V Compressed Extension Args
 	arg_rd_p
@@ -140,4 +160,13 @@ const (
 	arg_c_nzuimm10
 	arg_c_imm12
 	arg_c_nzimm18
+
+	// RISC-V Code Size Reduction Extension Args
+	arg_c_uimm1
+	arg_c_uimm2
+	arg_c_rlist
+	arg_c_spimm
+	arg_c_sreg1
+	arg_c_sreg2
+	arg_c_index
 )
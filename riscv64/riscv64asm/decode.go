@@ -21,6 +21,9 @@ type instFormat struct {
 	// if there are fewer than len(args) arguments, args[i] == 0 marks
 	// the end of the argument list.
 	args argTypeList
+	// ext is the set of extensions that all must be present
+	// for the instruction to be valid.
+	ext Ext
 }
 
 var (
@@ -34,8 +37,35 @@ func init() {
 	decoderCover = make([]bool, len(instFormats))
 }
 
+// DecodeOptions selects optional decoder behavior for DecodeWithOptions.
+type DecodeOptions struct {
+	// Compressed keeps 16-bit instructions in their compressed form,
+	// so that Op is C_ADDI rather than ADDI and GNUSyntax and GoSyntax
+	// print the compressed mnemonic. By default a compressed instruction
+	// is expanded to the equivalent 32-bit instruction.
+	Compressed bool
+
+	// Zcmp enables the Zcmp and Zcmt instructions (CM.PUSH, CM.JT, ...).
+	// They reuse the encoding space of C.FSDSP, which is not decoded
+	// when Zcmp is set. Including ExtZcmp or ExtZcmt in Extensions
+	// has the same effect.
+	Zcmp bool
+
+	// Extensions restricts decoding to the instructions of the given
+	// extensions, such as RVA22U64 or ExtI|ExtM|ExtA|ExtC. Extensions
+	// that are subsets of the listed ones are included as well.
+	// The zero value decodes every extension known to this package.
+	Extensions Ext
+}
+
 // Decode decodes the 4 bytes in src as a single instruction.
 func Decode(src []byte) (Inst, error) {
+	return DecodeWithOptions(src, DecodeOptions{})
+}
+
+// DecodeWithOptions is like Decode but lets the caller choose how
+// compressed instructions are decoded and which extensions are accepted.
+func DecodeWithOptions(src []byte, opts DecodeOptions) (Inst, error) {
 	length := len(src)
 	if length < 2 {
 		return Inst{}, errShort
@@ -55,11 +85,21 @@ func Decode(src []byte) (Inst, error) {
 		x = uint32(binary.LittleEndian.Uint16(src))
 	}
 
+	exts := opts.Extensions.implied()
+	zcmp := opts.Zcmp || opts.Extensions&(ExtZcmp|ExtZcmt) != 0
+
 Search:
 	for i, f := range instFormats {
 		if (x & f.mask) != f.value {
 			continue
 		}
+		if exts != 0 && f.ext&^exts != 0 {
+			continue
+		}
+		// Zcmp and Zcmt reuse the C.FSDSP encodings.
+		if zcmp && f.op == C_FSDSP || !zcmp && isZcmp(f.op) {
+			continue
+		}
 
 		// Decode args.
 		var args Args
@@ -83,7 +123,11 @@ Search:
 		}
 
 		if length == 2 {
-			args = convertCompressedIns(&f, args)
+			if opts.Compressed {
+				args = compressedArgs(f.op, args)
+			} else {
+				args = convertCompressedIns(&f, args)
+			}
 		}
 
 		decoderCover[i] = true
@@ -103,13 +147,23 @@ Search:
 func decodeArg(aop argType, x uint32, index int) Arg {
 	switch aop {
 	case arg_rd:
-		return X0 + Reg((x>>7)&((1<<5)-1))
+		r := (x >> 7) & ((1 << 5) - 1)
+		// AMOCAS.Q uses even/odd register pairs: an odd rd is reserved.
+		if r&1 != 0 && isAMOCASQ(instFormats[index].op) {
+			return nil
+		}
+		return X0 + Reg(r)
 
 	case arg_rs1:
 		return X0 + Reg((x>>15)&((1<<5)-1))
 
 	case arg_rs2:
-		return X0 + Reg((x>>20)&((1<<5)-1))
+		r := (x >> 20) & ((1 << 5) - 1)
+		// Likewise for rs2.
+		if r&1 != 0 && isAMOCASQ(instFormats[index].op) {
+			return nil
+		}
+		return X0 + Reg(r)
 
 	case arg_rs3:
 		return X0 + Reg((x>>27)&((1<<5)-1))
@@ -188,6 +242,9 @@ func decodeArg(aop argType, x uint32, index int) Arg {
 		imm := x << 6 >> 26
 		return Uimm{imm, false}
 
+	case arg_fli:
+		return FLIImm((x >> 15) & ((1 << 5) - 1))
+
 	case arg_imm12:
 		imm := x >> 20
 		// Sign-extend
@@ -236,6 +293,10 @@ func decodeArg(aop argType, x uint32, index int) Arg {
 		imm := x << 12 >> 27
 		return Uimm{imm, true}
 
+	case arg_zimm6:
+		imm := x<<12>>27 | (x>>26&1)<<5
+		return Uimm{imm, true}
+
 	case arg_vtype_zimm10:
 		imm := x << 2 >> 22
 		return VType(imm)
@@ -354,7 +415,7 @@ func decodeArg(aop argType, x uint32, index int) Arg {
 		return Uimm{imm, false}
 
 	case arg_c_imm12:
-		imm := (x<<29)>>31<<5 | (x<<26)>>28<<1 | (x<<25)>>31<<7 | (x<<24)>>31<<6 | (x<<23)>>31<<10 | (x<<21)>>30<<8 | (x<<20)>>31<<4 | (x<<19)>>31<<11
+		imm := (x<<29)>>31<<5 | (x<<26)>>29<<1 | (x<<25)>>31<<7 | (x<<24)>>31<<6 | (x<<23)>>31<<10 | (x<<21)>>30<<8 | (x<<20)>>31<<4 | (x<<19)>>31<<11
 		// Sign-extend
 		if imm>>uint32(12-1) == 1 {
 			imm |= 0xfffff << 12
@@ -372,11 +433,85 @@ func decodeArg(aop argType, x uint32, index int) Arg {
 		}
 		return Simm{int32(imm), true, 18}
 
+	case arg_c_uimm1:
+		imm := (x << 26) >> 31 << 1
+		return Uimm{imm, false}
+
+	case arg_c_uimm2:
+		imm := (x<<25)>>31 | (x<<26)>>31<<1
+		return Uimm{imm, false}
+
+	case arg_c_rlist:
+		rlist := (x >> 4) & ((1 << 4) - 1)
+		if rlist < 4 {
+			return nil
+		}
+		return RegList(rlist)
+
+	case arg_c_spimm:
+		rlist := RegList((x >> 4) & ((1 << 4) - 1))
+		if rlist < 4 {
+			return nil
+		}
+		// The stack adjustment is the size of the saved registers,
+		// rounded up to 16 bytes, plus spimm 16-byte units.
+		imm := int32(rlist.numRegs()*8+15)&^15 + int32((x>>2)&((1<<2)-1))*16
+		if instFormats[index].op == CM_PUSH {
+			imm = -imm
+		}
+		return Simm{imm, true, 9}
+
+	case arg_c_sreg1:
+		return sreg((x >> 7) & ((1 << 3) - 1))
+
+	case arg_c_sreg2:
+		// CM.MVSA01 with r1s' == r2s' is reserved.
+		if instFormats[index].op == CM_MVSA01 && (x>>7)&((1<<3)-1) == (x>>2)&((1<<3)-1) {
+			return nil
+		}
+		return sreg((x >> 2) & ((1 << 3) - 1))
+
+	case arg_c_index:
+		// CM.JT and CM.JALT share an encoding: indexes below 32 are CM.JT.
+		imm := (x >> 2) & ((1 << 8) - 1)
+		if (imm < 32) != (instFormats[index].op == CM_JT) {
+			return nil
+		}
+		return Uimm{imm, true}
+
 	default:
 		return nil
 	}
 }
 
+// sreg returns the saved register encoded as r in the r1s' and r2s'
+// fields: s0-s1 are x8-x9 and s2-s7 are x18-x23.
+func sreg(r uint32) Reg {
+	if r < 2 {
+		return X8 + Reg(r)
+	}
+	return X16 + Reg(r)
+}
+
+// isAMOCASQ reports whether op is one of the AMOCAS.Q instructions,
+// whose rd and rs2 name even/odd register pairs.
+func isAMOCASQ(op Op) bool {
+	switch op {
+	case AMOCAS_Q, AMOCAS_Q_AQ, AMOCAS_Q_RL, AMOCAS_Q_AQRL:
+		return true
+	}
+	return false
+}
+
+// isZcmp reports whether op belongs to the Zcmp or Zcmt extension.
+func isZcmp(op Op) bool {
+	switch op {
+	case CM_PUSH, CM_POP, CM_POPRET, CM_POPRETZ, CM_MVSA01, CM_MVA01S, CM_JT, CM_JALT:
+		return true
+	}
+	return false
+}
+
 // convertCompressedIns rewrites the RVC Instruction to regular Instructions
 func convertCompressedIns(f *instFormat, args Args) Args {
 	var newargs Args
@@ -424,7 +559,7 @@ func convertCompressedIns(f *instFormat, args Args) Args {
 	case C_LUI:
 		f.op = LUI
 		newargs[0] = args[0]
-		newargs[1] = Uimm{uint32(args[1].(Simm).Imm >> 12), false}
+		newargs[1] = Uimm{uint32(args[1].(Simm).Imm>>12) & 0xfffff, false}
 
 	case C_ANDI:
 		f.op = ANDI
@@ -591,6 +726,122 @@ func convertCompressedIns(f *instFormat, args Args) Args {
 		newargs[0] = Reg(X0)
 		newargs[1] = CSR(CYCLE)
 		newargs[2] = Reg(X0)
+
+	// riscv code size reduction compressed instructions
+	case C_LBU:
+		f.op = LBU
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_LHU:
+		f.op = LHU
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_LH:
+		f.op = LH
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_SB:
+		f.op = SB
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_SH:
+		f.op = SH
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_ZEXT_B:
+		f.op = ANDI
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+		newargs[2] = Simm{255, true, 12}
+
+	case C_SEXT_B:
+		f.op = SEXT_B
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+
+	case C_ZEXT_H:
+		f.op = ZEXT_H
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+
+	case C_SEXT_H:
+		f.op = SEXT_H
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+
+	case C_ZEXT_W:
+		f.op = ADD_UW
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+		newargs[2] = Reg(X0)
+
+	case C_NOT:
+		f.op = XORI
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+		newargs[2] = Simm{-1, true, 12}
+
+	case C_MUL:
+		f.op = MUL
+		newargs[0] = args[0]
+		newargs[1] = args[0]
+		newargs[2] = args[1]
+
+	// Zcmp and Zcmt instructions have no 32-bit equivalent.
+	case CM_PUSH, CM_POP, CM_POPRET, CM_POPRETZ, CM_MVSA01, CM_MVA01S, CM_JT, CM_JALT:
+		newargs = args
+	}
+	return newargs
+}
+
+// compressedArgs rewrites the arguments of an RVC instruction into
+// assembler operand order, keeping the compressed op.
+func compressedArgs(op Op, args Args) Args {
+	var newargs Args
+	switch op {
+	case C_LW, C_LD, C_FLD, C_LBU, C_LHU, C_LH:
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_SW, C_SD, C_FSD:
+		newargs[0] = args[1]
+		newargs[1] = RegOffset{args[0].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_SB, C_SH:
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{args[1].(Reg), Simm{int32(args[2].(Uimm).Imm), true, 12}}
+
+	case C_LWSP, C_LDSP, C_FLDSP, C_SWSP, C_SDSP, C_FSDSP:
+		newargs[0] = args[0]
+		newargs[1] = RegOffset{Reg(X2), Simm{int32(args[1].(Uimm).Imm), true, 12}}
+
+	case C_ADDI4SPN:
+		newargs[0] = args[0]
+		newargs[1] = Reg(X2)
+		newargs[2] = Simm{int32(args[1].(Uimm).Imm), true, 12}
+
+	case C_ADDI16SP:
+		newargs[0] = Reg(X2)
+		newargs[1] = args[0]
+
+	case C_LUI:
+		newargs[0] = args[0]
+		newargs[1] = Uimm{uint32(args[1].(Simm).Imm>>12) & 0xfffff, false}
+
+	case C_J:
+		newargs[0] = Simm{args[0].(Simm).Imm, true, 21}
+
+	case C_BEQZ, C_BNEZ:
+		newargs[0] = args[0]
+		newargs[1] = Simm{args[1].(Simm).Imm, true, 13}
+
+	default:
+		newargs = args
 	}
 	return newargs
 }
@@ -13,8 +13,8 @@ import (
 	"testing"
 )
 
-func testDecode(t *testing.T, syntax string) {
-	input := filepath.Join("testdata", syntax+"cases.txt")
+func testDecode(t *testing.T, syntax, kind string, opts DecodeOptions) {
+	input := filepath.Join("testdata", syntax+kind+"cases.txt")
 	f, err := os.Open(input)
 	if err != nil {
 		t.Fatal(err)
@@ -43,7 +43,10 @@ func testDecode(t *testing.T, syntax string) {
 		}
 		asm0 := strings.Replace(f[1], "	", " ", -1)
 		asm := strings.TrimSpace(asm0)
-		inst, decodeErr := Decode(code)
+		inst, decodeErr := DecodeWithOptions(code, opts)
+		if asm == "illegalins" && decodeErr == errUnknown {
+			continue
+		}
 		if decodeErr != nil && decodeErr != errUnknown {
 			if asm == "illegalins" && decodeErr == errShort {
 				continue
@@ -72,9 +75,65 @@ func testDecode(t *testing.T, syntax string) {
 }
 
 func TestDecodeGNUSyntax(t *testing.T) {
-	testDecode(t, "gnu")
+	testDecode(t, "gnu", "", DecodeOptions{})
 }
 
 func TestDecodeGoSyntax(t *testing.T) {
-	testDecode(t, "plan9")
+	testDecode(t, "plan9", "", DecodeOptions{})
+}
+
+func TestDecodeCompressedGNUSyntax(t *testing.T) {
+	testDecode(t, "gnu", "compressed", DecodeOptions{Compressed: true, Zcmp: true})
+}
+
+func TestDecodeCompressedGoSyntax(t *testing.T) {
+	testDecode(t, "plan9", "compressed", DecodeOptions{Compressed: true, Zcmp: true})
+}
+
+func TestDecodeExtensions(t *testing.T) {
+	tests := []struct {
+		hex  string
+		exts Ext
+		op   Op // 0 if the instruction must be rejected
+	}{
+		{"b3003100", RVA20U64, ADD},
+		{"b3203120", RVA20U64, 0},
+		{"b3203120", RVA22U64, SH1ADD},
+		{"d7812006", RV64GC, 0},
+		{"d7812006", ExtI | ExtV, 0},
+		{"d7812006", RVA23U64, VANDN_VV},
+		{"d3702140", RVA20U64, 0},
+		{"d3702140", RVA22U64, FCVT_S_H},
+		{"d3702140", ExtF | ExtZfh, FCVT_S_H},
+		{"d3703104", RVA22U64, 0},
+		{"d3703104", ExtF | ExtZfh, FADD_H},
+		{"0f100000", RVA20U64, 0},
+		{"0f100000", RV64G, FENCE_I},
+		{"42b8", RV64GC, C_FSDSP},
+		{"42b8", RV64GC | ExtZcmp, CM_PUSH},
+		{"42b8", ExtI | ExtZcmp, CM_PUSH},
+	}
+	for _, tt := range tests {
+		code, err := hex.DecodeString(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := DecodeWithOptions(code, DecodeOptions{Compressed: true, Extensions: tt.exts})
+		switch {
+		case tt.op == 0 && err == nil:
+			t.Errorf("Decode(%s) with %#x = %v, want error", tt.hex, tt.exts, inst.Op)
+		case tt.op != 0 && err != nil:
+			t.Errorf("Decode(%s) with %#x: %v", tt.hex, tt.exts, err)
+		case tt.op != 0 && inst.Op != tt.op:
+			t.Errorf("Decode(%s) with %#x = %v, want %v", tt.hex, tt.exts, inst.Op, tt.op)
+		}
+	}
+}
+
+func TestInstFormatExt(t *testing.T) {
+	for _, f := range instFormats {
+		if f.ext == 0 {
+			t.Errorf("%v has no extension", f.op)
+		}
+	}
 }
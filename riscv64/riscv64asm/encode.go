@@ -0,0 +1,669 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// EncodeOptions selects optional encoder behavior for EncodeWithOptions.
+type EncodeOptions struct {
+	// Compress selects the 16-bit encoding of an instruction, like
+	// C.ADDI for ADDI, when the instruction has one. By default, only
+	// an instruction whose Op is a compressed op, like C_ADDI as decoded
+	// with DecodeOptions.Compressed, is encoded in 16 bits.
+	Compress bool
+
+	// Extensions restricts encoding to the instructions of the given
+	// extensions, as for DecodeOptions. With Compress, it selects the
+	// compressed instructions that may be used: ExtC does not include
+	// the Zcb instructions, like C.ZEXT.B, for example.
+	Extensions Ext
+}
+
+// Encode returns the encoding of inst, 4 bytes or, for a compressed
+// instruction, 2 bytes. Decoding the result gives back inst.
+//
+// Encode ignores inst.Enc and inst.Len, and only the values of
+// immediates matter, not how they are printed.
+func Encode(inst Inst) ([]byte, error) {
+	return EncodeWithOptions(inst, EncodeOptions{})
+}
+
+// EncodeWithOptions is like Encode but lets the caller choose whether
+// to compress instructions and which extensions may be used.
+func EncodeWithOptions(inst Inst, opts EncodeOptions) ([]byte, error) {
+	dopts := DecodeOptions{Extensions: opts.Extensions}
+	if opts.Compress {
+		// A compressed instruction decodes to the 32-bit instruction
+		// it expands to, unless DecodeOptions.Compressed is set.
+		for _, c := range compressIns(inst.Op, inst.Args) {
+			if enc := encodeInst(c.Op, c.Args, opts.Extensions, func(enc []byte) bool {
+				return decodesTo(enc, inst, dopts)
+			}); enc != nil {
+				return enc, nil
+			}
+		}
+	}
+	dopts.Compressed = true
+	dopts.Zcmp = isZcmp(inst.Op)
+	if enc := encodeInst(inst.Op, uncompressedArgs(inst.Op, inst.Args), opts.Extensions, func(enc []byte) bool {
+		return decodesTo(enc, inst, dopts)
+	}); enc != nil {
+		return enc, nil
+	}
+	return nil, fmt.Errorf("cannot encode %v", inst)
+}
+
+// encodeInst returns the first encoding of op with the arguments args,
+// given in the order of the instruction format, that check accepts.
+// It returns nil if there is none.
+func encodeInst(op Op, args Args, exts Ext, check func([]byte) bool) []byte {
+	exts = exts.implied()
+	for i := range instFormats {
+		f := &instFormats[i]
+		if f.op != op || exts != 0 && f.ext&^exts != 0 {
+			continue
+		}
+		x, ok := encodeArgs(f, i, args)
+		if !ok {
+			continue
+		}
+		var enc []byte
+		if f.value&3 == 3 {
+			enc = binary.LittleEndian.AppendUint32(nil, x)
+		} else {
+			enc = binary.LittleEndian.AppendUint16(nil, uint16(x))
+		}
+		if check(enc) {
+			return enc
+		}
+	}
+	return nil
+}
+
+// encodeArgs returns the instruction bits of the format f, the index-th
+// entry of instFormats, with the arguments args. It reports whether
+// args fit the format.
+func encodeArgs(f *instFormat, index int, args Args) (uint32, bool) {
+	n, nf := 0, 0
+	for n < len(args) && args[n] != nil {
+		n++
+	}
+	for nf < len(f.args) && f.args[nf] != 0 {
+		nf++
+	}
+	x := f.value
+	k := 0
+	for _, aop := range f.args[:nf] {
+		// An unmasked vector instruction has no v0.t argument.
+		if aop == arg_vm && n < nf {
+			x |= 1 << 25
+			continue
+		}
+		// C.NOP has no argument, unless it is a HINT with an immediate.
+		if f.op == C_NOP && args[k] == nil {
+			k++
+			continue
+		}
+		bits, ok := encodeArg(aop, args[k], x, index)
+		if !ok {
+			return 0, false
+		}
+		x |= bits
+		k++
+	}
+	if k < len(args) && args[k] != nil {
+		return 0, false
+	}
+	return x, true
+}
+
+// decodesTo reports whether enc decodes to inst with the options opts.
+func decodesTo(enc []byte, inst Inst, opts DecodeOptions) bool {
+	dec, err := DecodeWithOptions(enc, opts)
+	if err != nil || dec.Op != inst.Op {
+		return false
+	}
+	for i := range dec.Args {
+		if immValueOnly(dec.Args[i]) != immValueOnly(inst.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// immValueOnly returns arg with the printing fields of its immediate cleared.
+func immValueOnly(arg Arg) Arg {
+	switch a := arg.(type) {
+	case Simm:
+		return Simm{Imm: a.Imm}
+	case Uimm:
+		return Uimm{Imm: a.Imm}
+	case RegOffset:
+		return RegOffset{a.OfsReg, Simm{Imm: a.Ofs.Imm}}
+	}
+	return arg
+}
+
+// encodeArg returns the instruction bits of the argument arg described
+// by aop. The bits x of the arguments before it are set already.
+// encodeArg reports whether arg fits aop.
+func encodeArg(aop argType, arg Arg, x uint32, index int) (uint32, bool) {
+	switch aop {
+	case arg_rd:
+		return reg(arg, X0, 7)
+
+	case arg_rs1:
+		return reg(arg, X0, 15)
+
+	case arg_rs2:
+		return reg(arg, X0, 20)
+
+	case arg_rs3:
+		return reg(arg, X0, 27)
+
+	case arg_fd:
+		return reg(arg, F0, 7)
+
+	case arg_fs1:
+		return reg(arg, F0, 15)
+
+	case arg_fs2:
+		return reg(arg, F0, 20)
+
+	case arg_fs3:
+		return reg(arg, F0, 27)
+
+	case arg_vd, arg_vs3:
+		return reg(arg, V0, 7)
+
+	case arg_vm:
+		// The mask bit is 0 for a masked instruction.
+		return 0, arg == V0
+
+	case arg_vs1:
+		return reg(arg, V0, 15)
+
+	case arg_vs2:
+		return reg(arg, V0, 20)
+
+	case arg_rs1_ptr:
+		ptr, ok := arg.(RegPtr)
+		if !ok {
+			return 0, false
+		}
+		return reg(ptr.reg, X0, 15)
+
+	case arg_rs1_mem:
+		mem, ok := arg.(RegOffset)
+		imm := int64(mem.Ofs.Imm)
+		if !ok || !signedFits(imm, 12) {
+			return 0, false
+		}
+		r, ok := reg(mem.OfsReg, X0, 15)
+		return r | uint32(imm)&0xfff<<20, ok
+
+	case arg_rs1_store:
+		mem, ok := arg.(RegOffset)
+		imm := int64(mem.Ofs.Imm)
+		if !ok || !signedFits(imm, 12) {
+			return 0, false
+		}
+		r, ok := reg(mem.OfsReg, X0, 15)
+		return r | storeImm(uint32(imm)), ok
+
+	case arg_pred, arg_succ:
+		order, ok := arg.(MemOrder)
+		if !ok || order > 0xf {
+			return 0, false
+		}
+		if aop == arg_pred {
+			return uint32(order) << 24, true
+		}
+		return uint32(order) << 20, true
+
+	case arg_csr:
+		csr, ok := arg.(CSR)
+		return uint32(csr) << 20, ok && csr < 1<<12
+
+	case arg_zimm, arg_zimm5:
+		return uimm(arg, 0x1f, 15)
+
+	case arg_shamt5:
+		return uimm(arg, 0x1f, 20)
+
+	case arg_shamt6:
+		return uimm(arg, 0x3f, 20)
+
+	case arg_fli:
+		imm, ok := arg.(FLIImm)
+		return uint32(imm) << 15, ok && imm < 32
+
+	case arg_imm12:
+		imm, ok := simm(arg, 12, 1)
+		return imm & 0xfff << 20, ok
+
+	case arg_imm20:
+		return uimm(arg, 0xfffff, 12)
+
+	case arg_jimm20:
+		imm, ok := simm(arg, 21, 2)
+		return imm>>20&1<<31 | imm>>1&0x3ff<<21 | imm>>11&1<<20 | imm>>12&0xff<<12, ok
+
+	case arg_simm12:
+		imm, ok := simm(arg, 12, 1)
+		return storeImm(imm), ok
+
+	case arg_bimm12:
+		imm, ok := simm(arg, 13, 2)
+		return imm>>12&1<<31 | imm>>5&0x3f<<25 | imm>>1&0xf<<8 | imm>>11&1<<7, ok
+
+	case arg_simm5:
+		imm, ok := simm(arg, 5, 1)
+		return imm & 0x1f << 15, ok
+
+	case arg_zimm6:
+		imm, ok := uimm(arg, 0x3f, 0)
+		return imm&0x1f<<15 | imm>>5&1<<26, ok
+
+	case arg_vtype_zimm10:
+		vtype, ok := arg.(VType)
+		return uint32(vtype) << 20, ok && vtype < 1<<10
+
+	case arg_vtype_zimm11:
+		vtype, ok := arg.(VType)
+		return uint32(vtype) << 20, ok && vtype < 1<<11
+
+	case arg_rd_p, arg_rs2_p:
+		return cReg(arg, X8, 2)
+
+	case arg_fd_p, arg_fs2_p:
+		return cReg(arg, F8, 2)
+
+	case arg_rs1_p, arg_rd_rs1_p:
+		return cReg(arg, X8, 7)
+
+	case arg_rd_n0, arg_rs1_n0, arg_rd_rs1_n0, arg_c_rs1_n0:
+		r, ok := reg(arg, X0, 7)
+		return r, ok && r != 0
+
+	case arg_c_rs2_n0:
+		r, ok := reg(arg, X0, 2)
+		return r, ok && r != 0
+
+	case arg_c_fs2:
+		return reg(arg, F0, 2)
+
+	case arg_c_rs2:
+		return reg(arg, X0, 2)
+
+	case arg_rd_n2:
+		r, ok := reg(arg, X0, 7)
+		return r, ok && r != 0 && r != 2<<7
+
+	case arg_c_imm6, arg_c_nzimm6:
+		imm, ok := simm(arg, 6, 1)
+		if aop == arg_c_nzimm6 && imm == 0 {
+			return 0, false
+		}
+		return imm&0x1f<<2 | imm>>5&1<<12, ok
+
+	case arg_c_nzuimm6:
+		imm, ok := uimm(arg, 0x3f, 0)
+		return imm&0x1f<<2 | imm>>5&1<<12, ok && imm != 0
+
+	case arg_c_uimm7:
+		imm, ok := uimm(arg, 0x7c, 0)
+		return imm>>6&1<<5 | imm>>2&1<<6 | imm>>3&7<<10, ok
+
+	case arg_c_uimm8:
+		imm, ok := uimm(arg, 0xf8, 0)
+		return imm>>6&3<<5 | imm>>3&7<<10, ok
+
+	case arg_c_uimm8sp_s:
+		imm, ok := uimm(arg, 0xfc, 0)
+		return imm>>6&3<<7 | imm>>2&0xf<<9, ok
+
+	case arg_c_uimm8sp:
+		imm, ok := uimm(arg, 0xfc, 0)
+		return imm>>2&7<<4 | imm>>5&1<<12 | imm>>6&3<<2, ok
+
+	case arg_c_uimm9sp_s:
+		imm, ok := uimm(arg, 0x1f8, 0)
+		return imm>>6&7<<7 | imm>>3&7<<10, ok
+
+	case arg_c_uimm9sp:
+		imm, ok := uimm(arg, 0x1f8, 0)
+		return imm>>3&3<<5 | imm>>5&1<<12 | imm>>6&7<<2, ok
+
+	case arg_c_bimm9:
+		imm, ok := simm(arg, 9, 2)
+		return imm>>5&1<<2 | imm>>1&3<<3 | imm>>6&3<<5 | imm>>8&1<<12 | imm>>3&3<<10, ok
+
+	case arg_c_nzimm10:
+		imm, ok := simm(arg, 10, 16)
+		return imm>>5&1<<2 | imm>>7&3<<3 | imm>>6&1<<5 | imm>>4&1<<6 | imm>>9&1<<12, ok && imm != 0
+
+	case arg_c_nzuimm10:
+		imm, ok := uimm(arg, 0x3fc, 0)
+		return imm>>3&1<<5 | imm>>2&1<<6 | imm>>6&0xf<<7 | imm>>4&3<<11, ok && imm != 0
+
+	case arg_c_imm12:
+		imm, ok := simm(arg, 12, 2)
+		return imm>>5&1<<2 | imm>>1&7<<3 | imm>>7&1<<6 | imm>>6&1<<7 |
+			imm>>10&1<<8 | imm>>8&3<<9 | imm>>4&1<<11 | imm>>11&1<<12, ok
+
+	case arg_c_nzimm18:
+		imm, ok := simm(arg, 18, 1<<12)
+		return imm>>12&0x1f<<2 | imm>>17&1<<12, ok && imm != 0
+
+	case arg_c_uimm1:
+		imm, ok := uimm(arg, 2, 0)
+		return imm >> 1 << 5, ok
+
+	case arg_c_uimm2:
+		imm, ok := uimm(arg, 3, 0)
+		return imm&1<<6 | imm>>1<<5, ok
+
+	case arg_c_rlist:
+		rlist, ok := arg.(RegList)
+		return uint32(rlist) << 4, ok && 4 <= rlist && rlist <= 15
+
+	case arg_c_spimm:
+		// The stack adjustment depends on the register list, which
+		// is encoded already.
+		imm, ok := arg.(Simm)
+		rlist := RegList((x >> 4) & ((1 << 4) - 1))
+		if !ok || rlist < 4 {
+			return 0, false
+		}
+		adj := int64(imm.Imm)
+		if instFormats[index].op == CM_PUSH {
+			adj = -adj
+		}
+		adj -= int64(rlist.numRegs()*8+15) &^ 15
+		if adj < 0 || adj > 3*16 || adj%16 != 0 {
+			return 0, false
+		}
+		return uint32(adj/16) << 2, true
+
+	case arg_c_sreg1, arg_c_sreg2:
+		r, ok := arg.(Reg)
+		var s uint32
+		switch {
+		case X8 <= r && r <= X9:
+			s = uint32(r - X8)
+		case X18 <= r && r <= X23:
+			s = uint32(r - X16)
+		default:
+			ok = false
+		}
+		if aop == arg_c_sreg1 {
+			return s << 7, ok
+		}
+		return s << 2, ok
+
+	case arg_c_index:
+		return uimm(arg, 0xff, 2)
+
+	default:
+		return 0, false
+	}
+}
+
+// reg returns the number of the register arg in the register file
+// that starts at base, shifted left by shift.
+func reg(arg Arg, base Reg, shift int) (uint32, bool) {
+	r, ok := arg.(Reg)
+	if !ok || r < base || r >= base+32 {
+		return 0, false
+	}
+	return uint32(r-base) << shift, true
+}
+
+// cReg is like reg for the registers x8-x15 and f8-f15 of the
+// 3-bit register fields of compressed instructions.
+func cReg(arg Arg, base Reg, shift int) (uint32, bool) {
+	r, ok := arg.(Reg)
+	if !ok || r < base || r >= base+8 {
+		return 0, false
+	}
+	return uint32(r-base) << shift, true
+}
+
+// uimm returns the value of the immediate arg shifted left by shift.
+// The value must not have bits outside of mask.
+func uimm(arg Arg, mask uint32, shift int) (uint32, bool) {
+	imm, ok := immValue(arg)
+	if !ok || imm < 0 || imm&^int64(mask) != 0 {
+		return 0, false
+	}
+	return uint32(imm) << shift, true
+}
+
+// simm returns the value of the immediate arg, which must be a signed
+// integer of the given width and a multiple of align.
+func simm(arg Arg, width int, align int64) (uint32, bool) {
+	imm, ok := immValue(arg)
+	if !ok || !signedFits(imm, width) || imm%align != 0 {
+		return 0, false
+	}
+	return uint32(imm), true
+}
+
+// immValue returns the value of the immediate arg.
+func immValue(arg Arg) (int64, bool) {
+	switch a := arg.(type) {
+	case Simm:
+		return int64(a.Imm), true
+	case Uimm:
+		return int64(a.Imm), true
+	}
+	return 0, false
+}
+
+// signedFits reports whether v is a signed integer of the given width.
+func signedFits(v int64, width int) bool {
+	return -1<<(width-1) <= v && v < 1<<(width-1)
+}
+
+// storeImm returns the bits of the 12-bit immediate of an S-type instruction.
+func storeImm(imm uint32) uint32 {
+	return imm&0x1f<<7 | imm>>5&0x7f<<25
+}
+
+// uncompressedArgs is the inverse of compressedArgs: it returns the
+// arguments of an RVC instruction in the order of its format.
+// The arguments of other instructions are returned unchanged.
+func uncompressedArgs(op Op, args Args) Args {
+	var newargs Args
+	mem, _ := args[1].(RegOffset)
+	switch op {
+	case C_LW, C_LD, C_FLD, C_LBU, C_LHU, C_LH, C_SB, C_SH:
+		newargs[0] = args[0]
+		newargs[1] = mem.OfsReg
+		newargs[2] = mem.Ofs
+
+	case C_SW, C_SD, C_FSD:
+		newargs[0] = mem.OfsReg
+		newargs[1] = args[0]
+		newargs[2] = mem.Ofs
+
+	case C_LWSP, C_LDSP, C_FLDSP, C_SWSP, C_SDSP, C_FSDSP:
+		newargs[0] = args[0]
+		newargs[1] = mem.Ofs
+
+	case C_ADDI4SPN:
+		newargs[0] = args[0]
+		newargs[1] = args[2]
+
+	case C_ADDI16SP:
+		newargs[0] = args[1]
+
+	case C_LUI:
+		imm, _ := args[1].(Uimm)
+		newargs[0] = args[0]
+		newargs[1] = Simm{Imm: int32(imm.Imm << 12)}
+
+	default:
+		newargs = args
+	}
+	return newargs
+}
+
+// compressedOps maps an op to the op of its RVC form, for the ops
+// that have a single RVC form apart from the stack pointer relative
+// loads and stores of compressedSPOps.
+var compressedOps = map[Op]Op{
+	SLLI: C_SLLI, SRLI: C_SRLI, SRAI: C_SRAI,
+	SUB: C_SUB, XOR: C_XOR, OR: C_OR, AND: C_AND, SUBW: C_SUBW, ADDW: C_ADDW, MUL: C_MUL,
+	SEXT_B: C_SEXT_B, ZEXT_H: C_ZEXT_H, SEXT_H: C_SEXT_H,
+	BEQ: C_BEQZ, BNE: C_BNEZ,
+	LW: C_LW, LD: C_LD, FLD: C_FLD, SW: C_SW, SD: C_SD, FSD: C_FSD,
+	LBU: C_LBU, LHU: C_LHU, LH: C_LH, SB: C_SB, SH: C_SH,
+}
+
+// compressedSPOps maps a load or store op to the op of its RVC form
+// with the stack pointer as the base register.
+var compressedSPOps = map[Op]Op{
+	LW: C_LWSP, LD: C_LDSP, FLD: C_FLDSP, SW: C_SWSP, SD: C_SDSP, FSD: C_FSDSP,
+}
+
+// compressIns is the inverse of convertCompressedIns: it returns the
+// RVC instructions that expand to the instruction op with the arguments
+// args, with their arguments in the order of their formats. The arguments
+// may not fit the RVC instructions.
+func compressIns(op Op, args Args) []Inst {
+	var insts []Inst
+	add := func(op Op, args ...Arg) {
+		inst := Inst{Op: op}
+		copy(inst.Args[:], args)
+		insts = append(insts, inst)
+	}
+	rd, rs1, rs2 := args[0], args[1], args[2]
+	mem, isMem := args[1].(RegOffset)
+	switch op {
+	case ADDI:
+		imm, _ := immValue(args[2])
+		switch {
+		case rd == X0 && rs1 == X0 && imm == 0:
+			add(C_NOP)
+		case rd == X2 && rs1 == X2:
+			add(C_ADDI16SP, rs2)
+		case rs1 == X2:
+			add(C_ADDI4SPN, rd, rs2)
+		case rs1 == X0:
+			add(C_LI, rd, rs2)
+		}
+		if rd == rs1 {
+			add(C_ADDI, rd, rs2)
+		}
+
+	case ADDIW:
+		if rd == rs1 {
+			add(C_ADDIW, rd, rs2)
+		}
+
+	case LUI:
+		if imm, ok := args[1].(Uimm); ok {
+			add(C_LUI, rd, Simm{Imm: int32(imm.Imm << 12)})
+		}
+
+	case ANDI:
+		imm, _ := immValue(args[2])
+		if rd == rs1 && imm == 255 {
+			add(C_ZEXT_B, rd)
+		}
+		if rd == rs1 {
+			add(C_ANDI, rd, rs2)
+		}
+
+	case XORI:
+		imm, _ := immValue(args[2])
+		if rd == rs1 && imm == -1 {
+			add(C_NOT, rd)
+		}
+
+	case SLLI, SRLI, SRAI:
+		if rd == rs1 {
+			add(compressedOps[op], rd, rs2)
+		}
+
+	case ADD:
+		if rs1 == X0 {
+			add(C_MV, rd, rs2)
+		}
+		if rd == rs1 {
+			add(C_ADD, rd, rs2)
+		}
+
+	case SUB, XOR, OR, AND, SUBW, ADDW, MUL:
+		if rd == rs1 {
+			add(compressedOps[op], rd, rs2)
+		}
+
+	case ADD_UW:
+		if rd == rs1 && rs2 == X0 {
+			add(C_ZEXT_W, rd)
+		}
+
+	case SEXT_B, ZEXT_H, SEXT_H:
+		if rd == rs1 {
+			add(compressedOps[op], rd)
+		}
+
+	case JAL:
+		if rd == X0 {
+			add(C_J, args[1])
+		}
+
+	case JALR:
+		if isMem && mem.Ofs.Imm == 0 {
+			switch rd {
+			case X0:
+				add(C_JR, mem.OfsReg)
+			case X1:
+				add(C_JALR, mem.OfsReg)
+			}
+		}
+
+	case BEQ, BNE:
+		if args[1] == X0 {
+			add(compressedOps[op], args[0], args[2])
+		}
+
+	case EBREAK:
+		add(C_EBREAK)
+
+	case CSRRW:
+		if rd == X0 && rs1 == CYCLE && rs2 == X0 {
+			add(C_UNIMP)
+		}
+
+	case LW, LD, FLD:
+		if isMem && mem.OfsReg == X2 {
+			add(compressedSPOps[op], rd, mem.Ofs)
+		}
+		if isMem {
+			add(compressedOps[op], rd, mem.OfsReg, mem.Ofs)
+		}
+
+	case SW, SD, FSD:
+		if isMem && mem.OfsReg == X2 {
+			add(compressedSPOps[op], args[0], mem.Ofs)
+		}
+		if isMem {
+			add(compressedOps[op], mem.OfsReg, args[0], mem.Ofs)
+		}
+
+	case LBU, LHU, LH, SB, SH:
+		if isMem {
+			add(compressedOps[op], args[0], mem.OfsReg, mem.Ofs)
+		}
+	}
+	return insts
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testEncode(t *testing.T, kind string, opts DecodeOptions) {
+	input := filepath.Join("testdata", "gnu"+kind+"cases.txt")
+	f, err := os.Open(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	n := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		code, err := hex.DecodeString(strings.Replace(strings.Fields(line)[0], "|", "", 1))
+		if err != nil {
+			t.Errorf("parsing %q: %v", line, err)
+			continue
+		}
+		inst, err := DecodeWithOptions(code, opts)
+		if err != nil {
+			continue
+		}
+		n++
+		code = code[:inst.Len]
+
+		// Without Compress, only compressed ops are encoded in 16 bits.
+		enc, err := EncodeWithOptions(inst, EncodeOptions{Extensions: opts.Extensions})
+		if err != nil {
+			t.Errorf("Encode(%v) [%x]: %v", inst, code, err)
+			continue
+		}
+		if opts.Compressed && !bytes.Equal(enc, code) {
+			t.Errorf("Encode(%v) = %x, want %x", inst, enc, code)
+		}
+		if !opts.Compressed && len(enc) != 4 {
+			t.Errorf("Encode(%v) [%x] = %x, want 4 bytes", inst, code, enc)
+		}
+		if dec, err := DecodeWithOptions(enc, opts); err != nil || dec.Op != inst.Op || dec.Args != inst.Args {
+			t.Errorf("Encode(%v) [%x] = %x, which decodes to %v", inst, code, enc, dec)
+		}
+
+		// With Compress, an instruction that was compressed stays compressed.
+		enc, err = EncodeWithOptions(inst, EncodeOptions{Compress: true, Extensions: opts.Extensions})
+		if err != nil {
+			t.Errorf("Encode(%v) [%x] with Compress: %v", inst, code, err)
+			continue
+		}
+		if len(enc) > len(code) {
+			t.Errorf("Encode(%v) [%x] with Compress = %x, longer than the original", inst, code, enc)
+		}
+		if dec, err := DecodeWithOptions(enc, opts); err != nil || dec.Op != inst.Op || dec.Args != inst.Args {
+			t.Errorf("Encode(%v) [%x] with Compress = %x, which decodes to %v", inst, code, enc, dec)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Fatal("no test cases")
+	}
+}
+
+func TestEncode(t *testing.T) {
+	testEncode(t, "", DecodeOptions{})
+}
+
+func TestEncodeCompressed(t *testing.T) {
+	testEncode(t, "compressed", DecodeOptions{Compressed: true, Zcmp: true})
+}
+
+// TestEncodeCompress checks which instructions Compress encodes in
+// 16 bits. A compressed form often only holds x8-x15, a smaller or
+// scaled immediate, or one register for both the destination and
+// a source, and some of its encodings are hints or reserved.
+func TestEncodeCompress(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want string
+	}{
+		// C.NOP, C.ADDI and C.LI. C.ADDI with a zero immediate is a hint.
+		{Inst{Op: ADDI, Args: Args{X0, X0, Simm{Imm: 0}}}, "0100"},
+		{Inst{Op: ADDI, Args: Args{X10, X10, Simm{Imm: 31}}}, "7d05"},
+		{Inst{Op: ADDI, Args: Args{X10, X10, Simm{Imm: -32}}}, "0115"},
+		{Inst{Op: ADDI, Args: Args{X10, X10, Simm{Imm: 32}}}, "13050502"},
+		{Inst{Op: ADDI, Args: Args{X10, X10, Simm{Imm: 0}}}, "13050500"},
+		{Inst{Op: ADDI, Args: Args{X10, X0, Simm{Imm: -32}}}, "0155"},
+
+		// C.ADDI16SP and C.ADDI4SPN scale their immediates.
+		{Inst{Op: ADDI, Args: Args{X2, X2, Simm{Imm: 496}}}, "7d61"},
+		{Inst{Op: ADDI, Args: Args{X2, X2, Simm{Imm: 512}}}, "13010120"},
+		{Inst{Op: ADDI, Args: Args{X8, X2, Simm{Imm: 1020}}}, "e01f"},
+		{Inst{Op: ADDI, Args: Args{X8, X2, Simm{Imm: 2}}}, "13042100"},
+		{Inst{Op: ADDI, Args: Args{X16, X2, Simm{Imm: 4}}}, "13084100"},
+
+		// C.LUI cannot load zero or write x2, whose encoding is C.ADDI16SP.
+		{Inst{Op: LUI, Args: Args{X10, Uimm{Imm: 0xfffff}}}, "7d75"},
+		{Inst{Op: LUI, Args: Args{X10, Uimm{Imm: 0x20}}}, "37050200"},
+		{Inst{Op: LUI, Args: Args{X10, Uimm{Imm: 0}}}, "37050000"},
+		{Inst{Op: LUI, Args: Args{X2, Uimm{Imm: 1}}}, "37110000"},
+
+		// C.MV, C.ADD and C.ADDW, with two registers.
+		{Inst{Op: ADD, Args: Args{X10, X0, X11}}, "2e85"},
+		{Inst{Op: ADD, Args: Args{X10, X10, X11}}, "2e95"},
+		{Inst{Op: ADD, Args: Args{X10, X11, X12}}, "3385c500"},
+		{Inst{Op: ADDW, Args: Args{X8, X8, X9}}, "259c"},
+
+		// C.ADDIW allows a zero immediate but not x0.
+		{Inst{Op: ADDIW, Args: Args{X10, X10, Simm{Imm: 0}}}, "0125"},
+		{Inst{Op: ADDIW, Args: Args{X0, X0, Simm{Imm: 1}}}, "1b001000"},
+
+		// C.SLLI and C.SRAI take 6-bit shifts on RV64, but not zero.
+		{Inst{Op: SLLI, Args: Args{X10, X10, Uimm{Imm: 63}}}, "7e15"},
+		{Inst{Op: SLLI, Args: Args{X10, X10, Uimm{Imm: 0}}}, "13150500"},
+		{Inst{Op: SRAI, Args: Args{X8, X8, Uimm{Imm: 63}}}, "7d94"},
+
+		// C.J reaches 2 KiB. C.JAL is RV32 only.
+		{Inst{Op: JAL, Args: Args{X0, Simm{Imm: 2046}}}, "fdaf"},
+		{Inst{Op: JAL, Args: Args{X0, Simm{Imm: -2048}}}, "01b0"},
+		{Inst{Op: JAL, Args: Args{X0, Simm{Imm: 2048}}}, "6f001000"},
+		{Inst{Op: JAL, Args: Args{X1, Simm{Imm: 16}}}, "ef000001"},
+		{Inst{Op: JALR, Args: Args{X0, RegOffset{X1, Simm{Imm: 0}}}}, "8280"},
+		{Inst{Op: JALR, Args: Args{X1, RegOffset{X10, Simm{Imm: 0}}}}, "0295"},
+		{Inst{Op: JALR, Args: Args{X1, RegOffset{X10, Simm{Imm: 4}}}}, "e7004500"},
+
+		// C.BEQZ reaches 256 bytes, compares with zero and needs x8-x15.
+		{Inst{Op: BEQ, Args: Args{X8, X0, Simm{Imm: 254}}}, "7dcc"},
+		{Inst{Op: BEQ, Args: Args{X8, X0, Simm{Imm: -256}}}, "01d0"},
+		{Inst{Op: BEQ, Args: Args{X8, X0, Simm{Imm: 256}}}, "63000410"},
+		{Inst{Op: BEQ, Args: Args{X16, X0, Simm{Imm: 16}}}, "63080800"},
+		{Inst{Op: BNE, Args: Args{X8, X9, Simm{Imm: 16}}}, "63189400"},
+
+		// The loads and stores scale their offsets by the access size.
+		// C.LDSP cannot load x0.
+		{Inst{Op: LD, Args: Args{X1, RegOffset{X2, Simm{Imm: 504}}}}, "fe70"},
+		{Inst{Op: LD, Args: Args{X1, RegOffset{X2, Simm{Imm: 512}}}}, "83300120"},
+		{Inst{Op: LD, Args: Args{X1, RegOffset{X2, Simm{Imm: 4}}}}, "83304100"},
+		{Inst{Op: LD, Args: Args{X0, RegOffset{X2, Simm{Imm: 8}}}}, "03308100"},
+		{Inst{Op: LW, Args: Args{X8, RegOffset{X9, Simm{Imm: 124}}}}, "e05c"},
+		{Inst{Op: LW, Args: Args{X8, RegOffset{X9, Simm{Imm: 128}}}}, "03a40408"},
+		{Inst{Op: SD, Args: Args{X1, RegOffset{X2, Simm{Imm: 8}}}}, "06e4"},
+		{Inst{Op: FLD, Args: Args{F8, RegOffset{X9, Simm{Imm: 8}}}}, "8024"},
+		{Inst{Op: FLD, Args: Args{F1, RegOffset{X2, Simm{Imm: 8}}}}, "a220"},
+	}
+	for _, tt := range tests {
+		enc, err := EncodeWithOptions(tt.inst, EncodeOptions{Compress: true})
+		if err != nil {
+			t.Errorf("Encode(%v): %v", tt.inst, err)
+			continue
+		}
+		if got := hex.EncodeToString(enc); got != tt.want {
+			t.Errorf("Encode(%v) = %s, want %s", tt.inst, got, tt.want)
+		}
+	}
+}
+
+// TestEncodeExtensions checks that Compress only uses the compressed
+// instructions of the selected extensions: the Zcb ones, like C.MUL
+// and C.NOT, are not part of C.
+func TestEncodeExtensions(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		exts Ext
+		want string
+	}{
+		{Inst{Op: ANDI, Args: Args{X8, X8, Simm{Imm: 255}}}, 0, "619c"},
+		{Inst{Op: ANDI, Args: Args{X8, X8, Simm{Imm: 255}}}, RV64GC, "1374f40f"},
+		{Inst{Op: MUL, Args: Args{X8, X8, X9}}, 0, "459c"},
+		{Inst{Op: MUL, Args: Args{X8, X8, X9}}, RV64GC, "33049402"},
+		{Inst{Op: XORI, Args: Args{X8, X8, Simm{Imm: -1}}}, 0, "759c"},
+		{Inst{Op: XORI, Args: Args{X8, X8, Simm{Imm: -1}}}, RV64GC, "1344f4ff"},
+		{Inst{Op: ANDI, Args: Args{X8, X8, Simm{Imm: -1}}}, RV64GC, "7d98"},
+	}
+	for _, tt := range tests {
+		enc, err := EncodeWithOptions(tt.inst, EncodeOptions{Compress: true, Extensions: tt.exts})
+		if err != nil {
+			t.Errorf("Encode(%v) in %v: %v", tt.inst, tt.exts, err)
+			continue
+		}
+		if got := hex.EncodeToString(enc); got != tt.want {
+			t.Errorf("Encode(%v) in %v = %s, want %s", tt.inst, tt.exts, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeInst(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want string
+	}{
+		// A compressed op is encoded in 16 bits without Compress.
+		{Inst{Op: C_ADDI, Args: Args{X10, Simm{Imm: 1}}}, "0505"},
+		{Inst{Op: CM_PUSH, Args: Args{RegList(5), Simm{Imm: -16}}}, "52b8"},
+
+		// Vector instructions with and without a mask.
+		{Inst{Op: VADD_VV, Args: Args{V2, V3, V1}}, "d7802102"},
+		{Inst{Op: VADD_VV, Args: Args{V0, V2, V3, V1}}, "d7802100"},
+	}
+	for _, tt := range tests {
+		enc, err := Encode(tt.inst)
+		if err != nil {
+			t.Errorf("Encode(%v): %v", tt.inst, err)
+			continue
+		}
+		if got := hex.EncodeToString(enc); got != tt.want {
+			t.Errorf("Encode(%v) = %s, want %s", tt.inst, got, tt.want)
+		}
+	}
+
+	for _, inst := range []Inst{
+		{Op: ADDI, Args: Args{X10, X0, Simm{Imm: 4096}}},
+		{Op: BEQ, Args: Args{X8, X0, Simm{Imm: 3}}},
+		{Op: C_ADDI, Args: Args{X10, Simm{Imm: 100}}},
+		{Op: C_ADDI, Args: Args{X10, Simm{Imm: 0}}},
+		{Op: LD, Args: Args{F8, RegOffset{X9, Simm{Imm: 8}}}},
+	} {
+		if enc, err := EncodeWithOptions(inst, EncodeOptions{Compress: true}); err == nil {
+			t.Errorf("Encode(%v) = %x, want error", inst, enc)
+		}
+	}
+}
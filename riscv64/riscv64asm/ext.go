@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+// An Ext is a set of RISC-V ISA extensions.
+type Ext uint64
+
+const (
+	ExtI Ext = 1 << iota
+	ExtM
+	ExtA
+	ExtF
+	ExtD
+	ExtQ
+	ExtC
+	ExtV
+	ExtZabha
+	ExtZacas
+	ExtZba
+	ExtZbb
+	ExtZbc
+	ExtZbs
+	ExtZcb
+	ExtZcmp
+	ExtZcmt
+	ExtZfa
+	ExtZfbfmin
+	ExtZfh
+	ExtZfhmin
+	ExtZicbom
+	ExtZicboz
+	ExtZicond
+	ExtZicsr
+	ExtZifencei
+	ExtZvbb
+	ExtZvbc
+	ExtZvfbfmin
+	ExtZvfbfwma
+	ExtZvkb
+	ExtZvkg
+	ExtZvkned
+	ExtZvknha
+	ExtZvknhb
+	ExtZvksed
+	ExtZvksh
+)
+
+// Named extension sets. The RVA profiles only include the extensions
+// that add instructions known to this package.
+const (
+	RV64G    = ExtI | ExtM | ExtA | ExtF | ExtD | ExtZicsr | ExtZifencei
+	RV64GC   = RV64G | ExtC
+	RVA20U64 = ExtI | ExtM | ExtA | ExtF | ExtD | ExtC | ExtZicsr
+	RVA22U64 = RVA20U64 | ExtZba | ExtZbb | ExtZbs | ExtZfhmin | ExtZicbom | ExtZicboz
+	RVA23U64 = RVA22U64 | ExtV | ExtZvbb | ExtZicond | ExtZcb | ExtZfa
+)
+
+// implied adds to e the extensions that are subsets of those in e,
+// so that a table entry only has to name the smallest extension that
+// provides the instruction.
+func (e Ext) implied() Ext {
+	if e&ExtQ != 0 {
+		e |= ExtD
+	}
+	if e&ExtD != 0 {
+		e |= ExtF
+	}
+	if e&ExtZfh != 0 {
+		e |= ExtZfhmin
+	}
+	if e&ExtZvbb != 0 {
+		e |= ExtZvkb
+	}
+	if e&ExtZvfbfwma != 0 {
+		e |= ExtZvfbfmin
+	}
+	if e&ExtZvknhb != 0 {
+		e |= ExtZvknha
+	}
+	return e
+}
@@ -92,6 +92,12 @@ gnuSyntaxSwitch:
 			args = args[:len(args)-1]
 		}
 
+	case ADD_UW:
+		if inst.Args[2].(Reg) == X0 {
+			op = "zext.w"
+			args = args[:len(args)-1]
+		}
+
 	case BEQ:
 		if inst.Args[1].(Reg) == X0 {
 			op = "beqz"
@@ -241,6 +247,10 @@ gnuSyntaxSwitch:
 			args = args[:len(args)-1]
 		}
 
+	case FCVTMOD_W_D:
+		// The rounding mode is always RTZ but is part of the syntax.
+		args = append(args, "rtz")
+
 	case FENCE:
 		fm := inst.Enc >> 28
 		pred := inst.Args[0].(MemOrder).String()
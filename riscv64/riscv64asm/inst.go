@@ -6,6 +6,8 @@ package riscv64asm
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 )
 
@@ -531,6 +533,91 @@ func (memOrder MemOrder) String() string {
 	return str
 }
 
+// An FLIImm is the immediate of a Zfa FLI instruction, an index into
+// a fixed table of floating-point constants.
+type FLIImm uint8
+
+// fliValues holds the FLI constants. Entry 1 is the minimum positive
+// normal number of the destination format, which is handled separately.
+var fliValues = [32]float64{
+	-1, 0, 0x1p-16, 0x1p-15, 0x1p-8, 0x1p-7, 0x1p-4, 0x1p-3,
+	0x1p-2, 0x1.4p-2, 0x1.8p-2, 0x1.cp-2, 0x1p-1, 0x1.4p-1, 0x1.8p-1, 0x1.cp-1,
+	0x1p+0, 0x1.4p+0, 0x1.8p+0, 0x1.cp+0, 0x1p+1, 0x1.4p+1, 0x1.8p+1, 0x1p+2,
+	0x1p+3, 0x1p+4, 0x1p+7, 0x1p+8, 0x1p+15, 0x1p+16, math.Inf(1), math.NaN(),
+}
+
+// String returns the constant in the form printed by GNU objdump:
+// min, inf and nan by name and every other value as a C hexadecimal
+// floating-point literal.
+func (fi FLIImm) String() string {
+	switch fi {
+	case 1:
+		return "min"
+	case 30:
+		return "inf"
+	case 31:
+		return "nan"
+	}
+	str := strconv.FormatFloat(fliValues[fi], 'x', -1, 64)
+	// Go pads the exponent to two digits, C does not.
+	i := strings.IndexByte(str, 'p') + 2
+	if str[i] == '0' {
+		str = str[:i] + str[i+1:]
+	}
+	return str
+}
+
+// goString returns the constant in Go floating-point syntax. The minimum
+// positive normal number has 2^minExp as its value.
+func (fi FLIImm) goString(minExp int) string {
+	if fi == 1 {
+		if v := math.Ldexp(1, minExp); v != 0 {
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		// The quad-precision minimum does not fit in a float64.
+		return fmt.Sprintf("0x1p%d", minExp)
+	}
+	str := strconv.FormatFloat(fliValues[fi], 'g', -1, 64)
+	if !strings.ContainsAny(str, ".eIN") {
+		str += ".0"
+	}
+	return str
+}
+
+// A RegList is the register list of a Zcmp push or pop instruction,
+// as encoded in its rlist field: 4 is {ra}, 5 is {ra, s0}, each further
+// value adds the next saved register and 15 is {ra, s0-s11}.
+type RegList uint8
+
+// numRegs returns the number of registers in the list.
+func (rl RegList) numRegs() int {
+	if rl == 15 {
+		return 13
+	}
+	return int(rl) - 3
+}
+
+func (rl RegList) String() string {
+	// s0-s1 are x8-x9 and s2-s11 are x18-x27, so the numeric form
+	// needs a separate range for each.
+	str := "{x1"
+	switch {
+	case rl == 5:
+		str += ",x8"
+	case rl > 5:
+		str += ",x8-x9"
+	}
+	switch {
+	case rl == 7:
+		str += ",x18"
+	case rl == 15:
+		str += ",x18-x27"
+	case rl > 7:
+		str += fmt.Sprintf(",x18-x%d", rl+11)
+	}
+	return str + "}"
+}
+
 // A VType represents the vtype field of VSETIVLI and VSETVLI instructions
 type VType uint32
 
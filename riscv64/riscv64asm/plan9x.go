@@ -59,7 +59,22 @@ goSyntaxSwitch:
 		AMOSWAP_D_AQ, AMOSWAP_D_RL, AMOSWAP_D_AQRL, AMOSWAP_W, AMOSWAP_W_AQ, AMOSWAP_W_RL,
 		AMOSWAP_W_AQRL, AMOXOR_D, AMOXOR_D_AQ, AMOXOR_D_RL, AMOXOR_D_AQRL, AMOXOR_W,
 		AMOXOR_W_AQ, AMOXOR_W_RL, AMOXOR_W_AQRL, SC_D, SC_D_AQ, SC_D_RL, SC_D_AQRL,
-		SC_W, SC_W_AQ, SC_W_RL, SC_W_AQRL:
+		SC_W, SC_W_AQ, SC_W_RL, SC_W_AQRL,
+		AMOADD_B, AMOADD_B_AQ, AMOADD_B_RL, AMOADD_B_AQRL, AMOADD_H, AMOADD_H_AQ, AMOADD_H_RL,
+		AMOADD_H_AQRL, AMOAND_B, AMOAND_B_AQ, AMOAND_B_RL, AMOAND_B_AQRL, AMOAND_H,
+		AMOAND_H_AQ, AMOAND_H_RL, AMOAND_H_AQRL, AMOCAS_B, AMOCAS_B_AQ, AMOCAS_B_RL,
+		AMOCAS_B_AQRL, AMOCAS_H, AMOCAS_H_AQ, AMOCAS_H_RL, AMOCAS_H_AQRL, AMOCAS_D,
+		AMOCAS_D_AQ, AMOCAS_D_RL, AMOCAS_D_AQRL, AMOCAS_Q, AMOCAS_Q_AQ, AMOCAS_Q_RL,
+		AMOCAS_Q_AQRL, AMOCAS_W, AMOCAS_W_AQ, AMOCAS_W_RL, AMOCAS_W_AQRL, AMOMAXU_B,
+		AMOMAXU_B_AQ, AMOMAXU_B_RL, AMOMAXU_B_AQRL, AMOMAXU_H, AMOMAXU_H_AQ, AMOMAXU_H_RL,
+		AMOMAXU_H_AQRL, AMOMAX_B, AMOMAX_B_AQ, AMOMAX_B_RL, AMOMAX_B_AQRL, AMOMAX_H,
+		AMOMAX_H_AQ, AMOMAX_H_RL, AMOMAX_H_AQRL, AMOMINU_B, AMOMINU_B_AQ, AMOMINU_B_RL,
+		AMOMINU_B_AQRL, AMOMINU_H, AMOMINU_H_AQ, AMOMINU_H_RL, AMOMINU_H_AQRL, AMOMIN_B,
+		AMOMIN_B_AQ, AMOMIN_B_RL, AMOMIN_B_AQRL, AMOMIN_H, AMOMIN_H_AQ, AMOMIN_H_RL,
+		AMOMIN_H_AQRL, AMOOR_B, AMOOR_B_AQ, AMOOR_B_RL, AMOOR_B_AQRL, AMOOR_H, AMOOR_H_AQ,
+		AMOOR_H_RL, AMOOR_H_AQRL, AMOSWAP_B, AMOSWAP_B_AQ, AMOSWAP_B_RL, AMOSWAP_B_AQRL,
+		AMOSWAP_H, AMOSWAP_H_AQ, AMOSWAP_H_RL, AMOSWAP_H_AQRL, AMOXOR_B, AMOXOR_B_AQ,
+		AMOXOR_B_RL, AMOXOR_B_AQRL, AMOXOR_H, AMOXOR_H_AQ, AMOXOR_H_RL, AMOXOR_H_AQRL:
 		// Atomic instructions have special operand order.
 		args[2], args[1] = args[1], args[2]
 
@@ -100,6 +115,12 @@ goSyntaxSwitch:
 			args = args[:len(args)-1]
 		}
 
+	case ADD_UW:
+		if inst.Args[2].(Reg) == X0 {
+			op = "MOVWU"
+			args = args[:len(args)-1]
+		}
+
 	case BEQ:
 		if inst.Args[1].(Reg) == X0 {
 			op = "BEQZ"
@@ -140,7 +161,7 @@ goSyntaxSwitch:
 			args[i], args[j] = args[j], args[i]
 		}
 
-	case BLTU, BGEU:
+	case BLTU, BGEU, C_BEQZ, C_BNEZ:
 		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
 			args[i], args[j] = args[j], args[i]
 		}
@@ -301,6 +322,9 @@ goSyntaxSwitch:
 			args[0], args[1] = args[1], args[0]
 		}
 
+	case C_SW, C_SD, C_FSD, C_SB, C_SH, C_SWSP, C_SDSP, C_FSDSP:
+		args[0], args[1] = args[1], args[0]
+
 	case LBU:
 		op = "MOVBU"
 
@@ -431,6 +455,22 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 	case RegPtr:
 		return fmt.Sprintf("(X%d)", a.reg)
 
+	case FLIImm:
+		minExp := -126
+		switch inst.Op {
+		case FLI_H:
+			minExp = -14
+		case FLI_D:
+			minExp = -1022
+		case FLI_Q:
+			minExp = -16382
+		}
+		return fmt.Sprintf("$(%s)", a.goString(minExp))
+
+	case RegList:
+		str := strings.ToUpper(a.String())
+		return "[" + str[1:len(str)-1] + "]"
+
 	default:
 		return strings.ToUpper(arg.String())
 	}
@@ -13,74 +13,166 @@ const (
 	ADDIW
 	ADDW
 	ADD_UW
+	AMOADD_B
+	AMOADD_B_AQ
+	AMOADD_B_AQRL
+	AMOADD_B_RL
 	AMOADD_D
 	AMOADD_D_AQ
 	AMOADD_D_AQRL
 	AMOADD_D_RL
+	AMOADD_H
+	AMOADD_H_AQ
+	AMOADD_H_AQRL
+	AMOADD_H_RL
 	AMOADD_W
 	AMOADD_W_AQ
 	AMOADD_W_AQRL
 	AMOADD_W_RL
+	AMOAND_B
+	AMOAND_B_AQ
+	AMOAND_B_AQRL
+	AMOAND_B_RL
 	AMOAND_D
 	AMOAND_D_AQ
 	AMOAND_D_AQRL
 	AMOAND_D_RL
+	AMOAND_H
+	AMOAND_H_AQ
+	AMOAND_H_AQRL
+	AMOAND_H_RL
 	AMOAND_W
 	AMOAND_W_AQ
 	AMOAND_W_AQRL
 	AMOAND_W_RL
+	AMOCAS_B
+	AMOCAS_B_AQ
+	AMOCAS_B_AQRL
+	AMOCAS_B_RL
+	AMOCAS_D
+	AMOCAS_D_AQ
+	AMOCAS_D_AQRL
+	AMOCAS_D_RL
+	AMOCAS_H
+	AMOCAS_H_AQ
+	AMOCAS_H_AQRL
+	AMOCAS_H_RL
+	AMOCAS_Q
+	AMOCAS_Q_AQ
+	AMOCAS_Q_AQRL
+	AMOCAS_Q_RL
+	AMOCAS_W
+	AMOCAS_W_AQ
+	AMOCAS_W_AQRL
+	AMOCAS_W_RL
+	AMOMAXU_B
+	AMOMAXU_B_AQ
+	AMOMAXU_B_AQRL
+	AMOMAXU_B_RL
 	AMOMAXU_D
 	AMOMAXU_D_AQ
 	AMOMAXU_D_AQRL
 	AMOMAXU_D_RL
+	AMOMAXU_H
+	AMOMAXU_H_AQ
+	AMOMAXU_H_AQRL
+	AMOMAXU_H_RL
 	AMOMAXU_W
 	AMOMAXU_W_AQ
 	AMOMAXU_W_AQRL
 	AMOMAXU_W_RL
+	AMOMAX_B
+	AMOMAX_B_AQ
+	AMOMAX_B_AQRL
+	AMOMAX_B_RL
 	AMOMAX_D
 	AMOMAX_D_AQ
 	AMOMAX_D_AQRL
 	AMOMAX_D_RL
+	AMOMAX_H
+	AMOMAX_H_AQ
+	AMOMAX_H_AQRL
+	AMOMAX_H_RL
 	AMOMAX_W
 	AMOMAX_W_AQ
 	AMOMAX_W_AQRL
 	AMOMAX_W_RL
+	AMOMINU_B
+	AMOMINU_B_AQ
+	AMOMINU_B_AQRL
+	AMOMINU_B_RL
 	AMOMINU_D
 	AMOMINU_D_AQ
 	AMOMINU_D_AQRL
 	AMOMINU_D_RL
+	AMOMINU_H
+	AMOMINU_H_AQ
+	AMOMINU_H_AQRL
+	AMOMINU_H_RL
 	AMOMINU_W
 	AMOMINU_W_AQ
 	AMOMINU_W_AQRL
 	AMOMINU_W_RL
+	AMOMIN_B
+	AMOMIN_B_AQ
+	AMOMIN_B_AQRL
+	AMOMIN_B_RL
 	AMOMIN_D
 	AMOMIN_D_AQ
 	AMOMIN_D_AQRL
 	AMOMIN_D_RL
+	AMOMIN_H
+	AMOMIN_H_AQ
+	AMOMIN_H_AQRL
+	AMOMIN_H_RL
 	AMOMIN_W
 	AMOMIN_W_AQ
 	AMOMIN_W_AQRL
 	AMOMIN_W_RL
+	AMOOR_B
+	AMOOR_B_AQ
+	AMOOR_B_AQRL
+	AMOOR_B_RL
 	AMOOR_D
 	AMOOR_D_AQ
 	AMOOR_D_AQRL
 	AMOOR_D_RL
+	AMOOR_H
+	AMOOR_H_AQ
+	AMOOR_H_AQRL
+	AMOOR_H_RL
 	AMOOR_W
 	AMOOR_W_AQ
 	AMOOR_W_AQRL
 	AMOOR_W_RL
+	AMOSWAP_B
+	AMOSWAP_B_AQ
+	AMOSWAP_B_AQRL
+	AMOSWAP_B_RL
 	AMOSWAP_D
 	AMOSWAP_D_AQ
 	AMOSWAP_D_AQRL
 	AMOSWAP_D_RL
+	AMOSWAP_H
+	AMOSWAP_H_AQ
+	AMOSWAP_H_AQRL
+	AMOSWAP_H_RL
 	AMOSWAP_W
 	AMOSWAP_W_AQ
 	AMOSWAP_W_AQRL
 	AMOSWAP_W_RL
+	AMOXOR_B
+	AMOXOR_B_AQ
+	AMOXOR_B_AQRL
+	AMOXOR_B_RL
 	AMOXOR_D
 	AMOXOR_D_AQ
 	AMOXOR_D_AQRL
 	AMOXOR_D_RL
+	AMOXOR_H
+	AMOXOR_H_AQ
+	AMOXOR_H_AQRL
+	AMOXOR_H_RL
 	AMOXOR_W
 	AMOXOR_W_AQ
 	AMOXOR_W_AQRL
@@ -112,6 +204,14 @@ const (
 	CLMULR
 	CLZ
 	CLZW
+	CM_JALT
+	CM_JT
+	CM_MVA01S
+	CM_MVSA01
+	CM_POP
+	CM_POPRET
+	CM_POPRETZ
+	CM_PUSH
 	CPOP
 	CPOPW
 	CSRRC
@@ -142,17 +242,26 @@ const (
 	C_J
 	C_JALR
 	C_JR
+	C_LBU
 	C_LD
 	C_LDSP
+	C_LH
+	C_LHU
 	C_LI
 	C_LUI
 	C_LW
 	C_LWSP
+	C_MUL
 	C_MV
 	C_NOP
+	C_NOT
 	C_OR
+	C_SB
 	C_SD
 	C_SDSP
+	C_SEXT_B
+	C_SEXT_H
+	C_SH
 	C_SLLI
 	C_SRAI
 	C_SRLI
@@ -162,6 +271,9 @@ const (
 	C_SWSP
 	C_UNIMP
 	C_XOR
+	C_ZEXT_B
+	C_ZEXT_H
+	C_ZEXT_W
 	DIV
 	DIVU
 	DIVUW
@@ -176,14 +288,19 @@ const (
 	FCLASS_H
 	FCLASS_Q
 	FCLASS_S
+	FCVTMOD_W_D
+	FCVT_BF16_S
+	FCVT_D_H
 	FCVT_D_L
 	FCVT_D_LU
 	FCVT_D_Q
 	FCVT_D_S
 	FCVT_D_W
 	FCVT_D_WU
+	FCVT_H_D
 	FCVT_H_L
 	FCVT_H_LU
+	FCVT_H_Q
 	FCVT_H_S
 	FCVT_H_W
 	FCVT_H_WU
@@ -196,11 +313,13 @@ const (
 	FCVT_L_Q
 	FCVT_L_S
 	FCVT_Q_D
+	FCVT_Q_H
 	FCVT_Q_L
 	FCVT_Q_LU
 	FCVT_Q_S
 	FCVT_Q_W
 	FCVT_Q_WU
+	FCVT_S_BF16
 	FCVT_S_D
 	FCVT_S_H
 	FCVT_S_L
@@ -227,12 +346,24 @@ const (
 	FEQ_Q
 	FEQ_S
 	FLD
+	FLEQ_D
+	FLEQ_H
+	FLEQ_Q
+	FLEQ_S
 	FLE_D
 	FLE_H
 	FLE_Q
 	FLE_S
 	FLH
+	FLI_D
+	FLI_H
+	FLI_Q
+	FLI_S
 	FLQ
+	FLTQ_D
+	FLTQ_H
+	FLTQ_Q
+	FLTQ_S
 	FLT_D
 	FLT_H
 	FLT_Q
@@ -242,10 +373,18 @@ const (
 	FMADD_H
 	FMADD_Q
 	FMADD_S
+	FMAXM_D
+	FMAXM_H
+	FMAXM_Q
+	FMAXM_S
 	FMAX_D
 	FMAX_H
 	FMAX_Q
 	FMAX_S
+	FMINM_D
+	FMINM_H
+	FMINM_Q
+	FMINM_S
 	FMIN_D
 	FMIN_H
 	FMIN_Q
@@ -258,6 +397,8 @@ const (
 	FMUL_H
 	FMUL_Q
 	FMUL_S
+	FMVH_X_Q
+	FMVP_Q_X
 	FMV_D_X
 	FMV_H_X
 	FMV_W_X
@@ -272,6 +413,14 @@ const (
 	FNMSUB_H
 	FNMSUB_Q
 	FNMSUB_S
+	FROUNDNX_D
+	FROUNDNX_H
+	FROUNDNX_Q
+	FROUNDNX_S
+	FROUND_D
+	FROUND_H
+	FROUND_Q
+	FROUND_S
 	FSD
 	FSGNJN_D
 	FSGNJN_H
@@ -392,6 +541,8 @@ const (
 	VAESKF1_VI
 	VAESKF2_VI
 	VAESZ_VS
+	VANDN_VV
+	VANDN_VX
 	VGHSH_VV
 	VGMUL_VV
 	VSHA2CH_VV
@@ -415,8 +566,17 @@ const (
 	VASUBU_VX
 	VASUB_VV
 	VASUB_VX
+	VBREV8_V
+	VBREV_V
+	VCLMULH_VV
+	VCLMULH_VX
+	VCLMUL_VV
+	VCLMUL_VX
+	VCLZ_V
 	VCOMPRESS_VM
 	VCPOP_M
+	VCPOP_V
+	VCTZ_V
 	VDIVU_VV
 	VDIVU_VX
 	VDIV_VV
@@ -451,6 +611,7 @@ const (
 	VFMV_F_S
 	VFMV_S_F
 	VFMV_V_F
+	VFNCVTBF16_F_F_W
 	VFNCVT_F_F_W
 	VFNCVT_F_XU_W
 	VFNCVT_F_X_W
@@ -490,6 +651,7 @@ const (
 	VFWADD_VV
 	VFWADD_WF
 	VFWADD_WV
+	VFWCVTBF16_F_F_V
 	VFWCVT_F_F_V
 	VFWCVT_F_XU_V
 	VFWCVT_F_X_V
@@ -497,6 +659,8 @@ const (
 	VFWCVT_RTZ_X_F_V
 	VFWCVT_XU_F_V
 	VFWCVT_X_F_V
+	VFWMACCBF16_VF
+	VFWMACCBF16_VV
 	VFWMACC_VF
 	VFWMACC_VV
 	VFWMSAC_VF
@@ -806,10 +970,16 @@ const (
 	VREMU_VX
 	VREM_VV
 	VREM_VX
+	VREV8_V
 	VRGATHEREI16_VV
 	VRGATHER_VI
 	VRGATHER_VV
 	VRGATHER_VX
+	VROL_VV
+	VROL_VX
+	VROR_VI
+	VROR_VV
+	VROR_VX
 	VRSUB_VI
 	VRSUB_VX
 	VS1R_V
@@ -1011,6 +1181,9 @@ const (
 	VWMUL_VX
 	VWREDSUMU_VS
 	VWREDSUM_VS
+	VWSLL_VI
+	VWSLL_VV
+	VWSLL_VX
 	VWSUBU_VV
 	VWSUBU_VX
 	VWSUBU_WV
@@ -1037,74 +1210,166 @@ var opstr = [...]string{
 	ADDIW:             "ADDIW",
 	ADDW:              "ADDW",
 	ADD_UW:            "ADD.UW",
+	AMOADD_B:          "AMOADD.B",
+	AMOADD_B_AQ:       "AMOADD.B.AQ",
+	AMOADD_B_AQRL:     "AMOADD.B.AQRL",
+	AMOADD_B_RL:       "AMOADD.B.RL",
 	AMOADD_D:          "AMOADD.D",
 	AMOADD_D_AQ:       "AMOADD.D.AQ",
 	AMOADD_D_AQRL:     "AMOADD.D.AQRL",
 	AMOADD_D_RL:       "AMOADD.D.RL",
+	AMOADD_H:          "AMOADD.H",
+	AMOADD_H_AQ:       "AMOADD.H.AQ",
+	AMOADD_H_AQRL:     "AMOADD.H.AQRL",
+	AMOADD_H_RL:       "AMOADD.H.RL",
 	AMOADD_W:          "AMOADD.W",
 	AMOADD_W_AQ:       "AMOADD.W.AQ",
 	AMOADD_W_AQRL:     "AMOADD.W.AQRL",
 	AMOADD_W_RL:       "AMOADD.W.RL",
+	AMOAND_B:          "AMOAND.B",
+	AMOAND_B_AQ:       "AMOAND.B.AQ",
+	AMOAND_B_AQRL:     "AMOAND.B.AQRL",
+	AMOAND_B_RL:       "AMOAND.B.RL",
 	AMOAND_D:          "AMOAND.D",
 	AMOAND_D_AQ:       "AMOAND.D.AQ",
 	AMOAND_D_AQRL:     "AMOAND.D.AQRL",
 	AMOAND_D_RL:       "AMOAND.D.RL",
+	AMOAND_H:          "AMOAND.H",
+	AMOAND_H_AQ:       "AMOAND.H.AQ",
+	AMOAND_H_AQRL:     "AMOAND.H.AQRL",
+	AMOAND_H_RL:       "AMOAND.H.RL",
 	AMOAND_W:          "AMOAND.W",
 	AMOAND_W_AQ:       "AMOAND.W.AQ",
 	AMOAND_W_AQRL:     "AMOAND.W.AQRL",
 	AMOAND_W_RL:       "AMOAND.W.RL",
+	AMOCAS_B:          "AMOCAS.B",
+	AMOCAS_B_AQ:       "AMOCAS.B.AQ",
+	AMOCAS_B_AQRL:     "AMOCAS.B.AQRL",
+	AMOCAS_B_RL:       "AMOCAS.B.RL",
+	AMOCAS_D:          "AMOCAS.D",
+	AMOCAS_D_AQ:       "AMOCAS.D.AQ",
+	AMOCAS_D_AQRL:     "AMOCAS.D.AQRL",
+	AMOCAS_D_RL:       "AMOCAS.D.RL",
+	AMOCAS_H:          "AMOCAS.H",
+	AMOCAS_H_AQ:       "AMOCAS.H.AQ",
+	AMOCAS_H_AQRL:     "AMOCAS.H.AQRL",
+	AMOCAS_H_RL:       "AMOCAS.H.RL",
+	AMOCAS_Q:          "AMOCAS.Q",
+	AMOCAS_Q_AQ:       "AMOCAS.Q.AQ",
+	AMOCAS_Q_AQRL:     "AMOCAS.Q.AQRL",
+	AMOCAS_Q_RL:       "AMOCAS.Q.RL",
+	AMOCAS_W:          "AMOCAS.W",
+	AMOCAS_W_AQ:       "AMOCAS.W.AQ",
+	AMOCAS_W_AQRL:     "AMOCAS.W.AQRL",
+	AMOCAS_W_RL:       "AMOCAS.W.RL",
+	AMOMAXU_B:         "AMOMAXU.B",
+	AMOMAXU_B_AQ:      "AMOMAXU.B.AQ",
+	AMOMAXU_B_AQRL:    "AMOMAXU.B.AQRL",
+	AMOMAXU_B_RL:      "AMOMAXU.B.RL",
 	AMOMAXU_D:         "AMOMAXU.D",
 	AMOMAXU_D_AQ:      "AMOMAXU.D.AQ",
 	AMOMAXU_D_AQRL:    "AMOMAXU.D.AQRL",
 	AMOMAXU_D_RL:      "AMOMAXU.D.RL",
+	AMOMAXU_H:         "AMOMAXU.H",
+	AMOMAXU_H_AQ:      "AMOMAXU.H.AQ",
+	AMOMAXU_H_AQRL:    "AMOMAXU.H.AQRL",
+	AMOMAXU_H_RL:      "AMOMAXU.H.RL",
 	AMOMAXU_W:         "AMOMAXU.W",
 	AMOMAXU_W_AQ:      "AMOMAXU.W.AQ",
 	AMOMAXU_W_AQRL:    "AMOMAXU.W.AQRL",
 	AMOMAXU_W_RL:      "AMOMAXU.W.RL",
+	AMOMAX_B:          "AMOMAX.B",
+	AMOMAX_B_AQ:       "AMOMAX.B.AQ",
+	AMOMAX_B_AQRL:     "AMOMAX.B.AQRL",
+	AMOMAX_B_RL:       "AMOMAX.B.RL",
 	AMOMAX_D:          "AMOMAX.D",
 	AMOMAX_D_AQ:       "AMOMAX.D.AQ",
 	AMOMAX_D_AQRL:     "AMOMAX.D.AQRL",
 	AMOMAX_D_RL:       "AMOMAX.D.RL",
+	AMOMAX_H:          "AMOMAX.H",
+	AMOMAX_H_AQ:       "AMOMAX.H.AQ",
+	AMOMAX_H_AQRL:     "AMOMAX.H.AQRL",
+	AMOMAX_H_RL:       "AMOMAX.H.RL",
 	AMOMAX_W:          "AMOMAX.W",
 	AMOMAX_W_AQ:       "AMOMAX.W.AQ",
 	AMOMAX_W_AQRL:     "AMOMAX.W.AQRL",
 	AMOMAX_W_RL:       "AMOMAX.W.RL",
+	AMOMINU_B:         "AMOMINU.B",
+	AMOMINU_B_AQ:      "AMOMINU.B.AQ",
+	AMOMINU_B_AQRL:    "AMOMINU.B.AQRL",
+	AMOMINU_B_RL:      "AMOMINU.B.RL",
 	AMOMINU_D:         "AMOMINU.D",
 	AMOMINU_D_AQ:      "AMOMINU.D.AQ",
 	AMOMINU_D_AQRL:    "AMOMINU.D.AQRL",
 	AMOMINU_D_RL:      "AMOMINU.D.RL",
+	AMOMINU_H:         "AMOMINU.H",
+	AMOMINU_H_AQ:      "AMOMINU.H.AQ",
+	AMOMINU_H_AQRL:    "AMOMINU.H.AQRL",
+	AMOMINU_H_RL:      "AMOMINU.H.RL",
 	AMOMINU_W:         "AMOMINU.W",
 	AMOMINU_W_AQ:      "AMOMINU.W.AQ",
 	AMOMINU_W_AQRL:    "AMOMINU.W.AQRL",
 	AMOMINU_W_RL:      "AMOMINU.W.RL",
+	AMOMIN_B:          "AMOMIN.B",
+	AMOMIN_B_AQ:       "AMOMIN.B.AQ",
+	AMOMIN_B_AQRL:     "AMOMIN.B.AQRL",
+	AMOMIN_B_RL:       "AMOMIN.B.RL",
 	AMOMIN_D:          "AMOMIN.D",
 	AMOMIN_D_AQ:       "AMOMIN.D.AQ",
 	AMOMIN_D_AQRL:     "AMOMIN.D.AQRL",
 	AMOMIN_D_RL:       "AMOMIN.D.RL",
+	AMOMIN_H:          "AMOMIN.H",
+	AMOMIN_H_AQ:       "AMOMIN.H.AQ",
+	AMOMIN_H_AQRL:     "AMOMIN.H.AQRL",
+	AMOMIN_H_RL:       "AMOMIN.H.RL",
 	AMOMIN_W:          "AMOMIN.W",
 	AMOMIN_W_AQ:       "AMOMIN.W.AQ",
 	AMOMIN_W_AQRL:     "AMOMIN.W.AQRL",
 	AMOMIN_W_RL:       "AMOMIN.W.RL",
+	AMOOR_B:           "AMOOR.B",
+	AMOOR_B_AQ:        "AMOOR.B.AQ",
+	AMOOR_B_AQRL:      "AMOOR.B.AQRL",
+	AMOOR_B_RL:        "AMOOR.B.RL",
 	AMOOR_D:           "AMOOR.D",
 	AMOOR_D_AQ:        "AMOOR.D.AQ",
 	AMOOR_D_AQRL:      "AMOOR.D.AQRL",
 	AMOOR_D_RL:        "AMOOR.D.RL",
+	AMOOR_H:           "AMOOR.H",
+	AMOOR_H_AQ:        "AMOOR.H.AQ",
+	AMOOR_H_AQRL:      "AMOOR.H.AQRL",
+	AMOOR_H_RL:        "AMOOR.H.RL",
 	AMOOR_W:           "AMOOR.W",
 	AMOOR_W_AQ:        "AMOOR.W.AQ",
 	AMOOR_W_AQRL:      "AMOOR.W.AQRL",
 	AMOOR_W_RL:        "AMOOR.W.RL",
+	AMOSWAP_B:         "AMOSWAP.B",
+	AMOSWAP_B_AQ:      "AMOSWAP.B.AQ",
+	AMOSWAP_B_AQRL:    "AMOSWAP.B.AQRL",
+	AMOSWAP_B_RL:      "AMOSWAP.B.RL",
 	AMOSWAP_D:         "AMOSWAP.D",
 	AMOSWAP_D_AQ:      "AMOSWAP.D.AQ",
 	AMOSWAP_D_AQRL:    "AMOSWAP.D.AQRL",
 	AMOSWAP_D_RL:      "AMOSWAP.D.RL",
+	AMOSWAP_H:         "AMOSWAP.H",
+	AMOSWAP_H_AQ:      "AMOSWAP.H.AQ",
+	AMOSWAP_H_AQRL:    "AMOSWAP.H.AQRL",
+	AMOSWAP_H_RL:      "AMOSWAP.H.RL",
 	AMOSWAP_W:         "AMOSWAP.W",
 	AMOSWAP_W_AQ:      "AMOSWAP.W.AQ",
 	AMOSWAP_W_AQRL:    "AMOSWAP.W.AQRL",
 	AMOSWAP_W_RL:      "AMOSWAP.W.RL",
+	AMOXOR_B:          "AMOXOR.B",
+	AMOXOR_B_AQ:       "AMOXOR.B.AQ",
+	AMOXOR_B_AQRL:     "AMOXOR.B.AQRL",
+	AMOXOR_B_RL:       "AMOXOR.B.RL",
 	AMOXOR_D:          "AMOXOR.D",
 	AMOXOR_D_AQ:       "AMOXOR.D.AQ",
 	AMOXOR_D_AQRL:     "AMOXOR.D.AQRL",
 	AMOXOR_D_RL:       "AMOXOR.D.RL",
+	AMOXOR_H:          "AMOXOR.H",
+	AMOXOR_H_AQ:       "AMOXOR.H.AQ",
+	AMOXOR_H_AQRL:     "AMOXOR.H.AQRL",
+	AMOXOR_H_RL:       "AMOXOR.H.RL",
 	AMOXOR_W:          "AMOXOR.W",
 	AMOXOR_W_AQ:       "AMOXOR.W.AQ",
 	AMOXOR_W_AQRL:     "AMOXOR.W.AQRL",
@@ -1136,6 +1401,14 @@ var opstr = [...]string{
 	CLMULR:            "CLMULR",
 	CLZ:               "CLZ",
 	CLZW:              "CLZW",
+	CM_JALT:           "CM.JALT",
+	CM_JT:             "CM.JT",
+	CM_MVA01S:         "CM.MVA01S",
+	CM_MVSA01:         "CM.MVSA01",
+	CM_POP:            "CM.POP",
+	CM_POPRET:         "CM.POPRET",
+	CM_POPRETZ:        "CM.POPRETZ",
+	CM_PUSH:           "CM.PUSH",
 	CPOP:              "CPOP",
 	CPOPW:             "CPOPW",
 	CSRRC:             "CSRRC",
@@ -1166,17 +1439,26 @@ var opstr = [...]string{
 	C_J:               "C.J",
 	C_JALR:            "C.JALR",
 	C_JR:              "C.JR",
+	C_LBU:             "C.LBU",
 	C_LD:              "C.LD",
 	C_LDSP:            "C.LDSP",
+	C_LH:              "C.LH",
+	C_LHU:             "C.LHU",
 	C_LI:              "C.LI",
 	C_LUI:             "C.LUI",
 	C_LW:              "C.LW",
 	C_LWSP:            "C.LWSP",
+	C_MUL:             "C.MUL",
 	C_MV:              "C.MV",
 	C_NOP:             "C.NOP",
+	C_NOT:             "C.NOT",
 	C_OR:              "C.OR",
+	C_SB:              "C.SB",
 	C_SD:              "C.SD",
 	C_SDSP:            "C.SDSP",
+	C_SEXT_B:          "C.SEXT.B",
+	C_SEXT_H:          "C.SEXT.H",
+	C_SH:              "C.SH",
 	C_SLLI:            "C.SLLI",
 	C_SRAI:            "C.SRAI",
 	C_SRLI:            "C.SRLI",
@@ -1186,6 +1468,9 @@ var opstr = [...]string{
 	C_SWSP:            "C.SWSP",
 	C_UNIMP:           "C.UNIMP",
 	C_XOR:             "C.XOR",
+	C_ZEXT_B:          "C.ZEXT.B",
+	C_ZEXT_H:          "C.ZEXT.H",
+	C_ZEXT_W:          "C.ZEXT.W",
 	DIV:               "DIV",
 	DIVU:              "DIVU",
 	DIVUW:             "DIVUW",
@@ -1200,14 +1485,19 @@ var opstr = [...]string{
 	FCLASS_H:          "FCLASS.H",
 	FCLASS_Q:          "FCLASS.Q",
 	FCLASS_S:          "FCLASS.S",
+	FCVTMOD_W_D:       "FCVTMOD.W.D",
+	FCVT_BF16_S:       "FCVT.BF16.S",
+	FCVT_D_H:          "FCVT.D.H",
 	FCVT_D_L:          "FCVT.D.L",
 	FCVT_D_LU:         "FCVT.D.LU",
 	FCVT_D_Q:          "FCVT.D.Q",
 	FCVT_D_S:          "FCVT.D.S",
 	FCVT_D_W:          "FCVT.D.W",
 	FCVT_D_WU:         "FCVT.D.WU",
+	FCVT_H_D:          "FCVT.H.D",
 	FCVT_H_L:          "FCVT.H.L",
 	FCVT_H_LU:         "FCVT.H.LU",
+	FCVT_H_Q:          "FCVT.H.Q",
 	FCVT_H_S:          "FCVT.H.S",
 	FCVT_H_W:          "FCVT.H.W",
 	FCVT_H_WU:         "FCVT.H.WU",
@@ -1220,11 +1510,13 @@ var opstr = [...]string{
 	FCVT_L_Q:          "FCVT.L.Q",
 	FCVT_L_S:          "FCVT.L.S",
 	FCVT_Q_D:          "FCVT.Q.D",
+	FCVT_Q_H:          "FCVT.Q.H",
 	FCVT_Q_L:          "FCVT.Q.L",
 	FCVT_Q_LU:         "FCVT.Q.LU",
 	FCVT_Q_S:          "FCVT.Q.S",
 	FCVT_Q_W:          "FCVT.Q.W",
 	FCVT_Q_WU:         "FCVT.Q.WU",
+	FCVT_S_BF16:       "FCVT.S.BF16",
 	FCVT_S_D:          "FCVT.S.D",
 	FCVT_S_H:          "FCVT.S.H",
 	FCVT_S_L:          "FCVT.S.L",
@@ -1251,12 +1543,24 @@ var opstr = [...]string{
 	FEQ_Q:             "FEQ.Q",
 	FEQ_S:             "FEQ.S",
 	FLD:               "FLD",
+	FLEQ_D:            "FLEQ.D",
+	FLEQ_H:            "FLEQ.H",
+	FLEQ_Q:            "FLEQ.Q",
+	FLEQ_S:            "FLEQ.S",
 	FLE_D:             "FLE.D",
 	FLE_H:             "FLE.H",
 	FLE_Q:             "FLE.Q",
 	FLE_S:             "FLE.S",
 	FLH:               "FLH",
+	FLI_D:             "FLI.D",
+	FLI_H:             "FLI.H",
+	FLI_Q:             "FLI.Q",
+	FLI_S:             "FLI.S",
 	FLQ:               "FLQ",
+	FLTQ_D:            "FLTQ.D",
+	FLTQ_H:            "FLTQ.H",
+	FLTQ_Q:            "FLTQ.Q",
+	FLTQ_S:            "FLTQ.S",
 	FLT_D:             "FLT.D",
 	FLT_H:             "FLT.H",
 	FLT_Q:             "FLT.Q",
@@ -1266,10 +1570,18 @@ var opstr = [...]string{
 	FMADD_H:           "FMADD.H",
 	FMADD_Q:           "FMADD.Q",
 	FMADD_S:           "FMADD.S",
+	FMAXM_D:           "FMAXM.D",
+	FMAXM_H:           "FMAXM.H",
+	FMAXM_Q:           "FMAXM.Q",
+	FMAXM_S:           "FMAXM.S",
 	FMAX_D:            "FMAX.D",
 	FMAX_H:            "FMAX.H",
 	FMAX_Q:            "FMAX.Q",
 	FMAX_S:            "FMAX.S",
+	FMINM_D:           "FMINM.D",
+	FMINM_H:           "FMINM.H",
+	FMINM_Q:           "FMINM.Q",
+	FMINM_S:           "FMINM.S",
 	FMIN_D:            "FMIN.D",
 	FMIN_H:            "FMIN.H",
 	FMIN_Q:            "FMIN.Q",
@@ -1282,6 +1594,8 @@ var opstr = [...]string{
 	FMUL_H:            "FMUL.H",
 	FMUL_Q:            "FMUL.Q",
 	FMUL_S:            "FMUL.S",
+	FMVH_X_Q:          "FMVH.X.Q",
+	FMVP_Q_X:          "FMVP.Q.X",
 	FMV_D_X:           "FMV.D.X",
 	FMV_H_X:           "FMV.H.X",
 	FMV_W_X:           "FMV.W.X",
@@ -1296,6 +1610,14 @@ var opstr = [...]string{
 	FNMSUB_H:          "FNMSUB.H",
 	FNMSUB_Q:          "FNMSUB.Q",
 	FNMSUB_S:          "FNMSUB.S",
+	FROUNDNX_D:        "FROUNDNX.D",
+	FROUNDNX_H:        "FROUNDNX.H",
+	FROUNDNX_Q:        "FROUNDNX.Q",
+	FROUNDNX_S:        "FROUNDNX.S",
+	FROUND_D:          "FROUND.D",
+	FROUND_H:          "FROUND.H",
+	FROUND_Q:          "FROUND.Q",
+	FROUND_S:          "FROUND.S",
 	FSD:               "FSD",
 	FSGNJN_D:          "FSGNJN.D",
 	FSGNJN_H:          "FSGNJN.H",
@@ -1416,6 +1738,8 @@ var opstr = [...]string{
 	VAESKF1_VI:        "VAESKF1.VI",
 	VAESKF2_VI:        "VAESKF2.VI",
 	VAESZ_VS:          "VAESZ.VS",
+	VANDN_VV:          "VANDN.VV",
+	VANDN_VX:          "VANDN.VX",
 	VGHSH_VV:          "VGHSH.VV",
 	VGMUL_VV:          "VGMUL.VV",
 	VSHA2CH_VV:        "VSHA2CH.VV",
@@ -1439,8 +1763,17 @@ var opstr = [...]string{
 	VASUBU_VX:         "VASUBU.VX",
 	VASUB_VV:          "VASUB.VV",
 	VASUB_VX:          "VASUB.VX",
+	VBREV8_V:          "VBREV8.V",
+	VBREV_V:           "VBREV.V",
+	VCLMULH_VV:        "VCLMULH.VV",
+	VCLMULH_VX:        "VCLMULH.VX",
+	VCLMUL_VV:         "VCLMUL.VV",
+	VCLMUL_VX:         "VCLMUL.VX",
+	VCLZ_V:            "VCLZ.V",
 	VCOMPRESS_VM:      "VCOMPRESS.VM",
 	VCPOP_M:           "VCPOP.M",
+	VCPOP_V:           "VCPOP.V",
+	VCTZ_V:            "VCTZ.V",
 	VDIVU_VV:          "VDIVU.VV",
 	VDIVU_VX:          "VDIVU.VX",
 	VDIV_VV:           "VDIV.VV",
@@ -1475,6 +1808,7 @@ var opstr = [...]string{
 	VFMV_F_S:          "VFMV.F.S",
 	VFMV_S_F:          "VFMV.S.F",
 	VFMV_V_F:          "VFMV.V.F",
+	VFNCVTBF16_F_F_W:  "VFNCVTBF16.F.F.W",
 	VFNCVT_F_F_W:      "VFNCVT.F.F.W",
 	VFNCVT_F_XU_W:     "VFNCVT.F.XU.W",
 	VFNCVT_F_X_W:      "VFNCVT.F.X.W",
@@ -1514,6 +1848,7 @@ var opstr = [...]string{
 	VFWADD_VV:         "VFWADD.VV",
 	VFWADD_WF:         "VFWADD.WF",
 	VFWADD_WV:         "VFWADD.WV",
+	VFWCVTBF16_F_F_V:  "VFWCVTBF16.F.F.V",
 	VFWCVT_F_F_V:      "VFWCVT.F.F.V",
 	VFWCVT_F_XU_V:     "VFWCVT.F.XU.V",
 	VFWCVT_F_X_V:      "VFWCVT.F.X.V",
@@ -1521,6 +1856,8 @@ var opstr = [...]string{
 	VFWCVT_RTZ_X_F_V:  "VFWCVT.RTZ.X.F.V",
 	VFWCVT_XU_F_V:     "VFWCVT.XU.F.V",
 	VFWCVT_X_F_V:      "VFWCVT.X.F.V",
+	VFWMACCBF16_VF:    "VFWMACCBF16.VF",
+	VFWMACCBF16_VV:    "VFWMACCBF16.VV",
 	VFWMACC_VF:        "VFWMACC.VF",
 	VFWMACC_VV:        "VFWMACC.VV",
 	VFWMSAC_VF:        "VFWMSAC.VF",
@@ -1830,10 +2167,16 @@ var opstr = [...]string{
 	VREMU_VX:          "VREMU.VX",
 	VREM_VV:           "VREM.VV",
 	VREM_VX:           "VREM.VX",
+	VREV8_V:           "VREV8.V",
 	VRGATHEREI16_VV:   "VRGATHEREI16.VV",
 	VRGATHER_VI:       "VRGATHER.VI",
 	VRGATHER_VV:       "VRGATHER.VV",
 	VRGATHER_VX:       "VRGATHER.VX",
+	VROL_VV:           "VROL.VV",
+	VROL_VX:           "VROL.VX",
+	VROR_VI:           "VROR.VI",
+	VROR_VV:           "VROR.VV",
+	VROR_VX:           "VROR.VX",
 	VRSUB_VI:          "VRSUB.VI",
 	VRSUB_VX:          "VRSUB.VX",
 	VS1R_V:            "VS1R.V",
@@ -2035,6 +2378,9 @@ var opstr = [...]string{
 	VWMUL_VX:          "VWMUL.VX",
 	VWREDSUMU_VS:      "VWREDSUMU.VS",
 	VWREDSUM_VS:       "VWREDSUM.VS",
+	VWSLL_VI:          "VWSLL.VI",
+	VWSLL_VV:          "VWSLL.VV",
+	VWSLL_VX:          "VWSLL.VX",
 	VWSUBU_VV:         "VWSUBU.VV",
 	VWSUBU_VX:         "VWSUBU.VX",
 	VWSUBU_WV:         "VWSUBU.WV",
@@ -2057,2045 +2403,2391 @@ var opstr = [...]string{
 
 var instFormats = [...]instFormat{
 	// ADD rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00000033, op: ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00000033, op: ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// ADDI rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x00000013, op: ADDI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x00000013, op: ADDI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// ADDIW rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x0000001b, op: ADDIW, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x0000001b, op: ADDIW, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// ADDW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0000003b, op: ADDW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0000003b, op: ADDW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// ADD.UW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0800003b, op: ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0800003b, op: ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
+	// AMOADD.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0000002f, op: AMOADD_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOADD.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0400002f, op: AMOADD_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOADD.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0600002f, op: AMOADD_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOADD.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0200002f, op: AMOADD_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOADD.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0000302f, op: AMOADD_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0000302f, op: AMOADD_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOADD.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0400302f, op: AMOADD_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0400302f, op: AMOADD_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOADD.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0600302f, op: AMOADD_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0600302f, op: AMOADD_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOADD.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0200302f, op: AMOADD_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0200302f, op: AMOADD_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOADD.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0000102f, op: AMOADD_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOADD.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0400102f, op: AMOADD_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOADD.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0600102f, op: AMOADD_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOADD.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0200102f, op: AMOADD_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOADD.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0000202f, op: AMOADD_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0000202f, op: AMOADD_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOADD.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0400202f, op: AMOADD_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0400202f, op: AMOADD_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOADD.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0600202f, op: AMOADD_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0600202f, op: AMOADD_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOADD.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0200202f, op: AMOADD_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0200202f, op: AMOADD_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOAND.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6000002f, op: AMOAND_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOAND.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6400002f, op: AMOAND_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOAND.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6600002f, op: AMOAND_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOAND.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6200002f, op: AMOAND_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOAND.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6000302f, op: AMOAND_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6000302f, op: AMOAND_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOAND.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6400302f, op: AMOAND_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6400302f, op: AMOAND_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOAND.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6600302f, op: AMOAND_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6600302f, op: AMOAND_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOAND.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6200302f, op: AMOAND_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6200302f, op: AMOAND_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOAND.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6000102f, op: AMOAND_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOAND.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6400102f, op: AMOAND_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOAND.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6600102f, op: AMOAND_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOAND.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x6200102f, op: AMOAND_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOAND.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6000202f, op: AMOAND_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6000202f, op: AMOAND_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOAND.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6400202f, op: AMOAND_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6400202f, op: AMOAND_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOAND.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6600202f, op: AMOAND_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6600202f, op: AMOAND_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOAND.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x6200202f, op: AMOAND_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x6200202f, op: AMOAND_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOCAS.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2800002f, op: AMOCAS_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2c00002f, op: AMOCAS_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2e00002f, op: AMOCAS_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2a00002f, op: AMOCAS_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.D rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2800302f, op: AMOCAS_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.D.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2c00302f, op: AMOCAS_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.D.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2e00302f, op: AMOCAS_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.D.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2a00302f, op: AMOCAS_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2800102f, op: AMOCAS_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2c00102f, op: AMOCAS_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2e00102f, op: AMOCAS_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2a00102f, op: AMOCAS_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOCAS.Q rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2800402f, op: AMOCAS_Q, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.Q.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2c00402f, op: AMOCAS_Q_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.Q.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2e00402f, op: AMOCAS_Q_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.Q.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2a00402f, op: AMOCAS_Q_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.W rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2800202f, op: AMOCAS_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.W.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2c00202f, op: AMOCAS_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.W.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2e00202f, op: AMOCAS_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOCAS.W.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2a00202f, op: AMOCAS_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZacas},
+	// AMOMAXU.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe000002f, op: AMOMAXU_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAXU.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe400002f, op: AMOMAXU_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAXU.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe600002f, op: AMOMAXU_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAXU.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe200002f, op: AMOMAXU_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMAXU.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe000302f, op: AMOMAXU_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe000302f, op: AMOMAXU_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAXU.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe400302f, op: AMOMAXU_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe400302f, op: AMOMAXU_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAXU.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe600302f, op: AMOMAXU_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe600302f, op: AMOMAXU_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAXU.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe200302f, op: AMOMAXU_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe200302f, op: AMOMAXU_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMAXU.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe000102f, op: AMOMAXU_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAXU.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe400102f, op: AMOMAXU_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAXU.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe600102f, op: AMOMAXU_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAXU.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xe200102f, op: AMOMAXU_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMAXU.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe000202f, op: AMOMAXU_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe000202f, op: AMOMAXU_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAXU.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe400202f, op: AMOMAXU_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe400202f, op: AMOMAXU_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAXU.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe600202f, op: AMOMAXU_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe600202f, op: AMOMAXU_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAXU.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xe200202f, op: AMOMAXU_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xe200202f, op: AMOMAXU_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMAX.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa000002f, op: AMOMAX_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAX.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa400002f, op: AMOMAX_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAX.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa600002f, op: AMOMAX_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAX.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa200002f, op: AMOMAX_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMAX.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa000302f, op: AMOMAX_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa000302f, op: AMOMAX_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAX.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa400302f, op: AMOMAX_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa400302f, op: AMOMAX_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAX.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa600302f, op: AMOMAX_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa600302f, op: AMOMAX_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAX.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa200302f, op: AMOMAX_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa200302f, op: AMOMAX_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMAX.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa000102f, op: AMOMAX_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAX.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa400102f, op: AMOMAX_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAX.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa600102f, op: AMOMAX_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMAX.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xa200102f, op: AMOMAX_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMAX.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa000202f, op: AMOMAX_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa000202f, op: AMOMAX_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAX.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa400202f, op: AMOMAX_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa400202f, op: AMOMAX_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAX.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa600202f, op: AMOMAX_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa600202f, op: AMOMAX_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMAX.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xa200202f, op: AMOMAX_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xa200202f, op: AMOMAX_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMINU.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc000002f, op: AMOMINU_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMINU.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc400002f, op: AMOMINU_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMINU.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc600002f, op: AMOMINU_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMINU.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc200002f, op: AMOMINU_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMINU.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc000302f, op: AMOMINU_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc000302f, op: AMOMINU_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMINU.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc400302f, op: AMOMINU_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc400302f, op: AMOMINU_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMINU.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc600302f, op: AMOMINU_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc600302f, op: AMOMINU_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMINU.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc200302f, op: AMOMINU_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc200302f, op: AMOMINU_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMINU.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc000102f, op: AMOMINU_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMINU.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc400102f, op: AMOMINU_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMINU.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc600102f, op: AMOMINU_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMINU.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0xc200102f, op: AMOMINU_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMINU.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc000202f, op: AMOMINU_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc000202f, op: AMOMINU_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMINU.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc400202f, op: AMOMINU_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc400202f, op: AMOMINU_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMINU.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc600202f, op: AMOMINU_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc600202f, op: AMOMINU_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMINU.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0xc200202f, op: AMOMINU_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0xc200202f, op: AMOMINU_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMIN.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8000002f, op: AMOMIN_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMIN.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8400002f, op: AMOMIN_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMIN.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8600002f, op: AMOMIN_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMIN.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8200002f, op: AMOMIN_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMIN.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8000302f, op: AMOMIN_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8000302f, op: AMOMIN_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMIN.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8400302f, op: AMOMIN_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8400302f, op: AMOMIN_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMIN.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8600302f, op: AMOMIN_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8600302f, op: AMOMIN_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMIN.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8200302f, op: AMOMIN_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8200302f, op: AMOMIN_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOMIN.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8000102f, op: AMOMIN_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMIN.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8400102f, op: AMOMIN_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMIN.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8600102f, op: AMOMIN_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOMIN.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x8200102f, op: AMOMIN_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOMIN.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8000202f, op: AMOMIN_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8000202f, op: AMOMIN_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMIN.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8400202f, op: AMOMIN_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8400202f, op: AMOMIN_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMIN.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8600202f, op: AMOMIN_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8600202f, op: AMOMIN_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOMIN.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x8200202f, op: AMOMIN_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x8200202f, op: AMOMIN_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOOR.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4000002f, op: AMOOR_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOOR.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4400002f, op: AMOOR_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOOR.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4600002f, op: AMOOR_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOOR.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4200002f, op: AMOOR_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOOR.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4000302f, op: AMOOR_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4000302f, op: AMOOR_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOOR.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4400302f, op: AMOOR_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4400302f, op: AMOOR_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOOR.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4600302f, op: AMOOR_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4600302f, op: AMOOR_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOOR.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4200302f, op: AMOOR_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4200302f, op: AMOOR_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOOR.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4000102f, op: AMOOR_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOOR.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4400102f, op: AMOOR_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOOR.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4600102f, op: AMOOR_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOOR.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x4200102f, op: AMOOR_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOOR.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4000202f, op: AMOOR_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4000202f, op: AMOOR_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOOR.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4400202f, op: AMOOR_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4400202f, op: AMOOR_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOOR.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4600202f, op: AMOOR_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4600202f, op: AMOOR_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOOR.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x4200202f, op: AMOOR_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x4200202f, op: AMOOR_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOSWAP.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0800002f, op: AMOSWAP_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOSWAP.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0c00002f, op: AMOSWAP_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOSWAP.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0e00002f, op: AMOSWAP_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOSWAP.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0a00002f, op: AMOSWAP_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOSWAP.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0800302f, op: AMOSWAP_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0800302f, op: AMOSWAP_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOSWAP.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0c00302f, op: AMOSWAP_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0c00302f, op: AMOSWAP_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOSWAP.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0e00302f, op: AMOSWAP_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0e00302f, op: AMOSWAP_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOSWAP.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0a00302f, op: AMOSWAP_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0a00302f, op: AMOSWAP_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOSWAP.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0800102f, op: AMOSWAP_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOSWAP.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0c00102f, op: AMOSWAP_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOSWAP.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0e00102f, op: AMOSWAP_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOSWAP.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x0a00102f, op: AMOSWAP_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOSWAP.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0800202f, op: AMOSWAP_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0800202f, op: AMOSWAP_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOSWAP.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0c00202f, op: AMOSWAP_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0c00202f, op: AMOSWAP_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOSWAP.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0e00202f, op: AMOSWAP_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0e00202f, op: AMOSWAP_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOSWAP.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x0a00202f, op: AMOSWAP_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x0a00202f, op: AMOSWAP_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOXOR.B rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2000002f, op: AMOXOR_B, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOXOR.B.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2400002f, op: AMOXOR_B_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOXOR.B.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2600002f, op: AMOXOR_B_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOXOR.B.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2200002f, op: AMOXOR_B_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOXOR.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2000302f, op: AMOXOR_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2000302f, op: AMOXOR_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOXOR.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2400302f, op: AMOXOR_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2400302f, op: AMOXOR_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOXOR.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2600302f, op: AMOXOR_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2600302f, op: AMOXOR_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOXOR.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2200302f, op: AMOXOR_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2200302f, op: AMOXOR_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
+	// AMOXOR.H rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2000102f, op: AMOXOR_H, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOXOR.H.AQ rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2400102f, op: AMOXOR_H_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOXOR.H.AQRL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2600102f, op: AMOXOR_H_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
+	// AMOXOR.H.RL rd, rs2, rs1_ptr
+	{mask: 0xfe00707f, value: 0x2200102f, op: AMOXOR_H_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtZabha},
 	// AMOXOR.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2000202f, op: AMOXOR_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2000202f, op: AMOXOR_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOXOR.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2400202f, op: AMOXOR_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2400202f, op: AMOXOR_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOXOR.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2600202f, op: AMOXOR_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2600202f, op: AMOXOR_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AMOXOR.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x2200202f, op: AMOXOR_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x2200202f, op: AMOXOR_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// AND rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00007033, op: AND, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00007033, op: AND, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// ANDI rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x00007013, op: ANDI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x00007013, op: ANDI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// ANDN rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x40007033, op: ANDN, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x40007033, op: ANDN, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// AUIPC rd, imm20
-	{mask: 0x0000007f, value: 0x00000017, op: AUIPC, args: argTypeList{arg_rd, arg_imm20}},
+	{mask: 0x0000007f, value: 0x00000017, op: AUIPC, args: argTypeList{arg_rd, arg_imm20}, ext: ExtI},
 	// BCLR rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x48001033, op: BCLR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x48001033, op: BCLR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbs},
 	// BCLRI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x48001013, op: BCLRI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x48001013, op: BCLRI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtZbs},
 	// BEQ rs1, rs2, bimm12
-	{mask: 0x0000707f, value: 0x00000063, op: BEQ, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}},
+	{mask: 0x0000707f, value: 0x00000063, op: BEQ, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}, ext: ExtI},
 	// BEXT rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x48005033, op: BEXT, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x48005033, op: BEXT, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbs},
 	// BEXTI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x48005013, op: BEXTI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x48005013, op: BEXTI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtZbs},
 	// BGE rs1, rs2, bimm12
-	{mask: 0x0000707f, value: 0x00005063, op: BGE, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}},
+	{mask: 0x0000707f, value: 0x00005063, op: BGE, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}, ext: ExtI},
 	// BGEU rs1, rs2, bimm12
-	{mask: 0x0000707f, value: 0x00007063, op: BGEU, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}},
+	{mask: 0x0000707f, value: 0x00007063, op: BGEU, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}, ext: ExtI},
 	// BINV rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x68001033, op: BINV, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x68001033, op: BINV, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbs},
 	// BINVI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x68001013, op: BINVI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x68001013, op: BINVI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtZbs},
 	// BLT rs1, rs2, bimm12
-	{mask: 0x0000707f, value: 0x00004063, op: BLT, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}},
+	{mask: 0x0000707f, value: 0x00004063, op: BLT, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}, ext: ExtI},
 	// BLTU rs1, rs2, bimm12
-	{mask: 0x0000707f, value: 0x00006063, op: BLTU, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}},
+	{mask: 0x0000707f, value: 0x00006063, op: BLTU, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}, ext: ExtI},
 	// BNE rs1, rs2, bimm12
-	{mask: 0x0000707f, value: 0x00001063, op: BNE, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}},
+	{mask: 0x0000707f, value: 0x00001063, op: BNE, args: argTypeList{arg_rs1, arg_rs2, arg_bimm12}, ext: ExtI},
 	// BSET rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x28001033, op: BSET, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x28001033, op: BSET, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbs},
 	// BSETI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x28001013, op: BSETI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x28001013, op: BSETI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtZbs},
 	// CBO.CLEAN rs1_ptr
-	{mask: 0xfff07fff, value: 0x0010200f, op: CBO_CLEAN, args: argTypeList{arg_rs1_ptr}},
+	{mask: 0xfff07fff, value: 0x0010200f, op: CBO_CLEAN, args: argTypeList{arg_rs1_ptr}, ext: ExtZicbom},
 	// CBO.FLUSH rs1_ptr
-	{mask: 0xfff07fff, value: 0x0020200f, op: CBO_FLUSH, args: argTypeList{arg_rs1_ptr}},
+	{mask: 0xfff07fff, value: 0x0020200f, op: CBO_FLUSH, args: argTypeList{arg_rs1_ptr}, ext: ExtZicbom},
 	// CBO.INVAL rs1_ptr
-	{mask: 0xfff07fff, value: 0x0000200f, op: CBO_INVAL, args: argTypeList{arg_rs1_ptr}},
+	{mask: 0xfff07fff, value: 0x0000200f, op: CBO_INVAL, args: argTypeList{arg_rs1_ptr}, ext: ExtZicbom},
 	// CBO.ZERO rs1_ptr
-	{mask: 0xfff07fff, value: 0x0040200f, op: CBO_ZERO, args: argTypeList{arg_rs1_ptr}},
+	{mask: 0xfff07fff, value: 0x0040200f, op: CBO_ZERO, args: argTypeList{arg_rs1_ptr}, ext: ExtZicboz},
 	// CLMUL rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a001033, op: CLMUL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a001033, op: CLMUL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbc},
 	// CLMULH rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a003033, op: CLMULH, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a003033, op: CLMULH, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbc},
 	// CLMULR rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a002033, op: CLMULR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a002033, op: CLMULR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbc},
 	// CLZ rd, rs1
-	{mask: 0xfff0707f, value: 0x60001013, op: CLZ, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x60001013, op: CLZ, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// CLZW rd, rs1
-	{mask: 0xfff0707f, value: 0x6000101b, op: CLZW, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x6000101b, op: CLZW, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
+	// CM.JALT c_index
+	{mask: 0x0000fc03, value: 0x0000a002, op: CM_JALT, args: argTypeList{arg_c_index}, ext: ExtZcmt},
+	// CM.JT c_index
+	{mask: 0x0000fc03, value: 0x0000a002, op: CM_JT, args: argTypeList{arg_c_index}, ext: ExtZcmt},
+	// CM.MVA01S c_sreg1, c_sreg2
+	{mask: 0x0000fc63, value: 0x0000ac62, op: CM_MVA01S, args: argTypeList{arg_c_sreg1, arg_c_sreg2}, ext: ExtZcmp},
+	// CM.MVSA01 c_sreg1, c_sreg2
+	{mask: 0x0000fc63, value: 0x0000ac22, op: CM_MVSA01, args: argTypeList{arg_c_sreg1, arg_c_sreg2}, ext: ExtZcmp},
+	// CM.POP c_rlist, c_spimm
+	{mask: 0x0000ff03, value: 0x0000ba02, op: CM_POP, args: argTypeList{arg_c_rlist, arg_c_spimm}, ext: ExtZcmp},
+	// CM.POPRET c_rlist, c_spimm
+	{mask: 0x0000ff03, value: 0x0000be02, op: CM_POPRET, args: argTypeList{arg_c_rlist, arg_c_spimm}, ext: ExtZcmp},
+	// CM.POPRETZ c_rlist, c_spimm
+	{mask: 0x0000ff03, value: 0x0000bc02, op: CM_POPRETZ, args: argTypeList{arg_c_rlist, arg_c_spimm}, ext: ExtZcmp},
+	// CM.PUSH c_rlist, c_spimm
+	{mask: 0x0000ff03, value: 0x0000b802, op: CM_PUSH, args: argTypeList{arg_c_rlist, arg_c_spimm}, ext: ExtZcmp},
 	// CPOP rd, rs1
-	{mask: 0xfff0707f, value: 0x60201013, op: CPOP, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x60201013, op: CPOP, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// CPOPW rd, rs1
-	{mask: 0xfff0707f, value: 0x6020101b, op: CPOPW, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x6020101b, op: CPOPW, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// CSRRC rd, csr, rs1
-	{mask: 0x0000707f, value: 0x00003073, op: CSRRC, args: argTypeList{arg_rd, arg_csr, arg_rs1}},
+	{mask: 0x0000707f, value: 0x00003073, op: CSRRC, args: argTypeList{arg_rd, arg_csr, arg_rs1}, ext: ExtZicsr},
 	// CSRRCI rd, csr, zimm
-	{mask: 0x0000707f, value: 0x00007073, op: CSRRCI, args: argTypeList{arg_rd, arg_csr, arg_zimm}},
+	{mask: 0x0000707f, value: 0x00007073, op: CSRRCI, args: argTypeList{arg_rd, arg_csr, arg_zimm}, ext: ExtZicsr},
 	// CSRRS rd, csr, rs1
-	{mask: 0x0000707f, value: 0x00002073, op: CSRRS, args: argTypeList{arg_rd, arg_csr, arg_rs1}},
+	{mask: 0x0000707f, value: 0x00002073, op: CSRRS, args: argTypeList{arg_rd, arg_csr, arg_rs1}, ext: ExtZicsr},
 	// CSRRSI rd, csr, zimm
-	{mask: 0x0000707f, value: 0x00006073, op: CSRRSI, args: argTypeList{arg_rd, arg_csr, arg_zimm}},
+	{mask: 0x0000707f, value: 0x00006073, op: CSRRSI, args: argTypeList{arg_rd, arg_csr, arg_zimm}, ext: ExtZicsr},
 	// CSRRW rd, csr, rs1
-	{mask: 0x0000707f, value: 0x00001073, op: CSRRW, args: argTypeList{arg_rd, arg_csr, arg_rs1}},
+	{mask: 0x0000707f, value: 0x00001073, op: CSRRW, args: argTypeList{arg_rd, arg_csr, arg_rs1}, ext: ExtZicsr},
 	// CSRRWI rd, csr, zimm
-	{mask: 0x0000707f, value: 0x00005073, op: CSRRWI, args: argTypeList{arg_rd, arg_csr, arg_zimm}},
+	{mask: 0x0000707f, value: 0x00005073, op: CSRRWI, args: argTypeList{arg_rd, arg_csr, arg_zimm}, ext: ExtZicsr},
 	// CTZ rd, rs1
-	{mask: 0xfff0707f, value: 0x60101013, op: CTZ, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x60101013, op: CTZ, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// CTZW rd, rs1
-	{mask: 0xfff0707f, value: 0x6010101b, op: CTZW, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x6010101b, op: CTZW, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// CZERO.EQZ rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0e005033, op: CZERO_EQZ, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0e005033, op: CZERO_EQZ, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZicond},
 	// CZERO.NEZ rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0e007033, op: CZERO_NEZ, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0e007033, op: CZERO_NEZ, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZicond},
 	// C.ADD rd_rs1_n0, c_rs2_n0
-	{mask: 0x0000f003, value: 0x00009002, op: C_ADD, args: argTypeList{arg_rd_rs1_n0, arg_c_rs2_n0}},
+	{mask: 0x0000f003, value: 0x00009002, op: C_ADD, args: argTypeList{arg_rd_rs1_n0, arg_c_rs2_n0}, ext: ExtC},
 	// C.ADDI rd_rs1_n0, c_nzimm6
-	{mask: 0x0000e003, value: 0x00000001, op: C_ADDI, args: argTypeList{arg_rd_rs1_n0, arg_c_nzimm6}},
+	{mask: 0x0000e003, value: 0x00000001, op: C_ADDI, args: argTypeList{arg_rd_rs1_n0, arg_c_nzimm6}, ext: ExtC},
 	// C.ADDI16SP c_nzimm10
-	{mask: 0x0000ef83, value: 0x00006101, op: C_ADDI16SP, args: argTypeList{arg_c_nzimm10}},
+	{mask: 0x0000ef83, value: 0x00006101, op: C_ADDI16SP, args: argTypeList{arg_c_nzimm10}, ext: ExtC},
 	// C.ADDI4SPN rd_p, c_nzuimm10
-	{mask: 0x0000e003, value: 0x00000000, op: C_ADDI4SPN, args: argTypeList{arg_rd_p, arg_c_nzuimm10}},
+	{mask: 0x0000e003, value: 0x00000000, op: C_ADDI4SPN, args: argTypeList{arg_rd_p, arg_c_nzuimm10}, ext: ExtC},
 	// C.ADDIW rd_rs1_n0, c_imm6
-	{mask: 0x0000e003, value: 0x00002001, op: C_ADDIW, args: argTypeList{arg_rd_rs1_n0, arg_c_imm6}},
+	{mask: 0x0000e003, value: 0x00002001, op: C_ADDIW, args: argTypeList{arg_rd_rs1_n0, arg_c_imm6}, ext: ExtC},
 	// C.ADDW rd_rs1_p, rs2_p
-	{mask: 0x0000fc63, value: 0x00009c21, op: C_ADDW, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}},
+	{mask: 0x0000fc63, value: 0x00009c21, op: C_ADDW, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtC},
 	// C.AND rd_rs1_p, rs2_p
-	{mask: 0x0000fc63, value: 0x00008c61, op: C_AND, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}},
+	{mask: 0x0000fc63, value: 0x00008c61, op: C_AND, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtC},
 	// C.ANDI rd_rs1_p, c_imm6
-	{mask: 0x0000ec03, value: 0x00008801, op: C_ANDI, args: argTypeList{arg_rd_rs1_p, arg_c_imm6}},
+	{mask: 0x0000ec03, value: 0x00008801, op: C_ANDI, args: argTypeList{arg_rd_rs1_p, arg_c_imm6}, ext: ExtC},
 	// C.BEQZ rs1_p, c_bimm9
-	{mask: 0x0000e003, value: 0x0000c001, op: C_BEQZ, args: argTypeList{arg_rs1_p, arg_c_bimm9}},
+	{mask: 0x0000e003, value: 0x0000c001, op: C_BEQZ, args: argTypeList{arg_rs1_p, arg_c_bimm9}, ext: ExtC},
 	// C.BNEZ rs1_p, c_bimm9
-	{mask: 0x0000e003, value: 0x0000e001, op: C_BNEZ, args: argTypeList{arg_rs1_p, arg_c_bimm9}},
+	{mask: 0x0000e003, value: 0x0000e001, op: C_BNEZ, args: argTypeList{arg_rs1_p, arg_c_bimm9}, ext: ExtC},
 	// C.EBREAK
-	{mask: 0x0000ffff, value: 0x00009002, op: C_EBREAK, args: argTypeList{}},
+	{mask: 0x0000ffff, value: 0x00009002, op: C_EBREAK, args: argTypeList{}, ext: ExtC},
 	// C.FLD fd_p, rs1_p, c_uimm8
-	{mask: 0x0000e003, value: 0x00002000, op: C_FLD, args: argTypeList{arg_fd_p, arg_rs1_p, arg_c_uimm8}},
+	{mask: 0x0000e003, value: 0x00002000, op: C_FLD, args: argTypeList{arg_fd_p, arg_rs1_p, arg_c_uimm8}, ext: ExtC | ExtD},
 	// C.FLDSP fd, c_uimm9sp
-	{mask: 0x0000e003, value: 0x00002002, op: C_FLDSP, args: argTypeList{arg_fd, arg_c_uimm9sp}},
+	{mask: 0x0000e003, value: 0x00002002, op: C_FLDSP, args: argTypeList{arg_fd, arg_c_uimm9sp}, ext: ExtC | ExtD},
 	// C.FSD rs1_p, fs2_p, c_uimm8
-	{mask: 0x0000e003, value: 0x0000a000, op: C_FSD, args: argTypeList{arg_rs1_p, arg_fs2_p, arg_c_uimm8}},
+	{mask: 0x0000e003, value: 0x0000a000, op: C_FSD, args: argTypeList{arg_rs1_p, arg_fs2_p, arg_c_uimm8}, ext: ExtC | ExtD},
 	// C.FSDSP c_fs2, c_uimm9sp_s
-	{mask: 0x0000e003, value: 0x0000a002, op: C_FSDSP, args: argTypeList{arg_c_fs2, arg_c_uimm9sp_s}},
+	{mask: 0x0000e003, value: 0x0000a002, op: C_FSDSP, args: argTypeList{arg_c_fs2, arg_c_uimm9sp_s}, ext: ExtC | ExtD},
 	// C.J c_imm12
-	{mask: 0x0000e003, value: 0x0000a001, op: C_J, args: argTypeList{arg_c_imm12}},
+	{mask: 0x0000e003, value: 0x0000a001, op: C_J, args: argTypeList{arg_c_imm12}, ext: ExtC},
 	// C.JALR c_rs1_n0
-	{mask: 0x0000f07f, value: 0x00009002, op: C_JALR, args: argTypeList{arg_c_rs1_n0}},
+	{mask: 0x0000f07f, value: 0x00009002, op: C_JALR, args: argTypeList{arg_c_rs1_n0}, ext: ExtC},
 	// C.JR rs1_n0
-	{mask: 0x0000f07f, value: 0x00008002, op: C_JR, args: argTypeList{arg_rs1_n0}},
+	{mask: 0x0000f07f, value: 0x00008002, op: C_JR, args: argTypeList{arg_rs1_n0}, ext: ExtC},
+	// C.LBU rd_p, rs1_p, c_uimm2
+	{mask: 0x0000fc03, value: 0x00008000, op: C_LBU, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm2}, ext: ExtZcb},
 	// C.LD rd_p, rs1_p, c_uimm8
-	{mask: 0x0000e003, value: 0x00006000, op: C_LD, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm8}},
+	{mask: 0x0000e003, value: 0x00006000, op: C_LD, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm8}, ext: ExtC},
 	// C.LDSP rd_n0, c_uimm9sp
-	{mask: 0x0000e003, value: 0x00006002, op: C_LDSP, args: argTypeList{arg_rd_n0, arg_c_uimm9sp}},
+	{mask: 0x0000e003, value: 0x00006002, op: C_LDSP, args: argTypeList{arg_rd_n0, arg_c_uimm9sp}, ext: ExtC},
+	// C.LH rd_p, rs1_p, c_uimm1
+	{mask: 0x0000fc43, value: 0x00008440, op: C_LH, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm1}, ext: ExtZcb},
+	// C.LHU rd_p, rs1_p, c_uimm1
+	{mask: 0x0000fc43, value: 0x00008400, op: C_LHU, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm1}, ext: ExtZcb},
 	// C.LI rd_n0, c_imm6
-	{mask: 0x0000e003, value: 0x00004001, op: C_LI, args: argTypeList{arg_rd_n0, arg_c_imm6}},
+	{mask: 0x0000e003, value: 0x00004001, op: C_LI, args: argTypeList{arg_rd_n0, arg_c_imm6}, ext: ExtC},
 	// C.LUI rd_n2, c_nzimm18
-	{mask: 0x0000e003, value: 0x00006001, op: C_LUI, args: argTypeList{arg_rd_n2, arg_c_nzimm18}},
+	{mask: 0x0000e003, value: 0x00006001, op: C_LUI, args: argTypeList{arg_rd_n2, arg_c_nzimm18}, ext: ExtC},
 	// C.LW rd_p, rs1_p, c_uimm7
-	{mask: 0x0000e003, value: 0x00004000, op: C_LW, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm7}},
+	{mask: 0x0000e003, value: 0x00004000, op: C_LW, args: argTypeList{arg_rd_p, arg_rs1_p, arg_c_uimm7}, ext: ExtC},
 	// C.LWSP rd_n0, c_uimm8sp
-	{mask: 0x0000e003, value: 0x00004002, op: C_LWSP, args: argTypeList{arg_rd_n0, arg_c_uimm8sp}},
+	{mask: 0x0000e003, value: 0x00004002, op: C_LWSP, args: argTypeList{arg_rd_n0, arg_c_uimm8sp}, ext: ExtC},
+	// C.MUL rd_rs1_p, rs2_p
+	{mask: 0x0000fc63, value: 0x00009c41, op: C_MUL, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtZcb},
 	// C.MV rd_n0, c_rs2_n0
-	{mask: 0x0000f003, value: 0x00008002, op: C_MV, args: argTypeList{arg_rd_n0, arg_c_rs2_n0}},
+	{mask: 0x0000f003, value: 0x00008002, op: C_MV, args: argTypeList{arg_rd_n0, arg_c_rs2_n0}, ext: ExtC},
 	// C.NOP c_nzimm6
-	{mask: 0x0000ef83, value: 0x00000001, op: C_NOP, args: argTypeList{arg_c_nzimm6}},
+	{mask: 0x0000ef83, value: 0x00000001, op: C_NOP, args: argTypeList{arg_c_nzimm6}, ext: ExtC},
+	// C.NOT rd_rs1_p
+	{mask: 0x0000fc7f, value: 0x00009c75, op: C_NOT, args: argTypeList{arg_rd_rs1_p}, ext: ExtZcb},
 	// C.OR rd_rs1_p, rs2_p
-	{mask: 0x0000fc63, value: 0x00008c41, op: C_OR, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}},
+	{mask: 0x0000fc63, value: 0x00008c41, op: C_OR, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtC},
+	// C.SB rs2_p, rs1_p, c_uimm2
+	{mask: 0x0000fc03, value: 0x00008800, op: C_SB, args: argTypeList{arg_rs2_p, arg_rs1_p, arg_c_uimm2}, ext: ExtZcb},
 	// C.SD rs1_p, rs2_p, c_uimm8
-	{mask: 0x0000e003, value: 0x0000e000, op: C_SD, args: argTypeList{arg_rs1_p, arg_rs2_p, arg_c_uimm8}},
+	{mask: 0x0000e003, value: 0x0000e000, op: C_SD, args: argTypeList{arg_rs1_p, arg_rs2_p, arg_c_uimm8}, ext: ExtC},
 	// C.SDSP c_rs2, c_uimm9sp_s
-	{mask: 0x0000e003, value: 0x0000e002, op: C_SDSP, args: argTypeList{arg_c_rs2, arg_c_uimm9sp_s}},
+	{mask: 0x0000e003, value: 0x0000e002, op: C_SDSP, args: argTypeList{arg_c_rs2, arg_c_uimm9sp_s}, ext: ExtC},
+	// C.SEXT.B rd_rs1_p
+	{mask: 0x0000fc7f, value: 0x00009c65, op: C_SEXT_B, args: argTypeList{arg_rd_rs1_p}, ext: ExtZcb},
+	// C.SEXT.H rd_rs1_p
+	{mask: 0x0000fc7f, value: 0x00009c6d, op: C_SEXT_H, args: argTypeList{arg_rd_rs1_p}, ext: ExtZcb},
+	// C.SH rs2_p, rs1_p, c_uimm1
+	{mask: 0x0000fc43, value: 0x00008c00, op: C_SH, args: argTypeList{arg_rs2_p, arg_rs1_p, arg_c_uimm1}, ext: ExtZcb},
 	// C.SLLI rd_rs1_n0, c_nzuimm6
-	{mask: 0x0000e003, value: 0x00000002, op: C_SLLI, args: argTypeList{arg_rd_rs1_n0, arg_c_nzuimm6}},
+	{mask: 0x0000e003, value: 0x00000002, op: C_SLLI, args: argTypeList{arg_rd_rs1_n0, arg_c_nzuimm6}, ext: ExtC},
 	// C.SRAI rd_rs1_p, c_nzuimm6
-	{mask: 0x0000ec03, value: 0x00008401, op: C_SRAI, args: argTypeList{arg_rd_rs1_p, arg_c_nzuimm6}},
+	{mask: 0x0000ec03, value: 0x00008401, op: C_SRAI, args: argTypeList{arg_rd_rs1_p, arg_c_nzuimm6}, ext: ExtC},
 	// C.SRLI rd_rs1_p, c_nzuimm6
-	{mask: 0x0000ec03, value: 0x00008001, op: C_SRLI, args: argTypeList{arg_rd_rs1_p, arg_c_nzuimm6}},
+	{mask: 0x0000ec03, value: 0x00008001, op: C_SRLI, args: argTypeList{arg_rd_rs1_p, arg_c_nzuimm6}, ext: ExtC},
 	// C.SUB rd_rs1_p, rs2_p
-	{mask: 0x0000fc63, value: 0x00008c01, op: C_SUB, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}},
+	{mask: 0x0000fc63, value: 0x00008c01, op: C_SUB, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtC},
 	// C.SUBW rd_rs1_p, rs2_p
-	{mask: 0x0000fc63, value: 0x00009c01, op: C_SUBW, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}},
+	{mask: 0x0000fc63, value: 0x00009c01, op: C_SUBW, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtC},
 	// C.SW rs1_p, rs2_p, c_uimm7
-	{mask: 0x0000e003, value: 0x0000c000, op: C_SW, args: argTypeList{arg_rs1_p, arg_rs2_p, arg_c_uimm7}},
+	{mask: 0x0000e003, value: 0x0000c000, op: C_SW, args: argTypeList{arg_rs1_p, arg_rs2_p, arg_c_uimm7}, ext: ExtC},
 	// C.SWSP c_rs2, c_uimm8sp_s
-	{mask: 0x0000e003, value: 0x0000c002, op: C_SWSP, args: argTypeList{arg_c_rs2, arg_c_uimm8sp_s}},
+	{mask: 0x0000e003, value: 0x0000c002, op: C_SWSP, args: argTypeList{arg_c_rs2, arg_c_uimm8sp_s}, ext: ExtC},
 	// C.UNIMP
-	{mask: 0x0000ffff, value: 0x00000000, op: C_UNIMP, args: argTypeList{}},
+	{mask: 0x0000ffff, value: 0x00000000, op: C_UNIMP, args: argTypeList{}, ext: ExtC},
 	// C.XOR rd_rs1_p, rs2_p
-	{mask: 0x0000fc63, value: 0x00008c21, op: C_XOR, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}},
+	{mask: 0x0000fc63, value: 0x00008c21, op: C_XOR, args: argTypeList{arg_rd_rs1_p, arg_rs2_p}, ext: ExtC},
+	// C.ZEXT.B rd_rs1_p
+	{mask: 0x0000fc7f, value: 0x00009c61, op: C_ZEXT_B, args: argTypeList{arg_rd_rs1_p}, ext: ExtZcb},
+	// C.ZEXT.H rd_rs1_p
+	{mask: 0x0000fc7f, value: 0x00009c69, op: C_ZEXT_H, args: argTypeList{arg_rd_rs1_p}, ext: ExtZcb},
+	// C.ZEXT.W rd_rs1_p
+	{mask: 0x0000fc7f, value: 0x00009c71, op: C_ZEXT_W, args: argTypeList{arg_rd_rs1_p}, ext: ExtZcb},
 	// DIV rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02004033, op: DIV, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02004033, op: DIV, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// DIVU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02005033, op: DIVU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02005033, op: DIVU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// DIVUW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0200503b, op: DIVUW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0200503b, op: DIVUW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// DIVW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0200403b, op: DIVW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0200403b, op: DIVW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// EBREAK
-	{mask: 0xffffffff, value: 0x00100073, op: EBREAK, args: argTypeList{}},
+	{mask: 0xffffffff, value: 0x00100073, op: EBREAK, args: argTypeList{}, ext: ExtI},
 	// ECALL
-	{mask: 0xffffffff, value: 0x00000073, op: ECALL, args: argTypeList{}},
+	{mask: 0xffffffff, value: 0x00000073, op: ECALL, args: argTypeList{}, ext: ExtI},
 	// FADD.D fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x02000053, op: FADD_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x02000053, op: FADD_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FADD.H fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x04000053, op: FADD_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x04000053, op: FADD_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FADD.Q fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x06000053, op: FADD_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x06000053, op: FADD_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FADD.S fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x00000053, op: FADD_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x00000053, op: FADD_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FCLASS.D rd, fs1
-	{mask: 0xfff0707f, value: 0xe2001053, op: FCLASS_D, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe2001053, op: FCLASS_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD},
 	// FCLASS.H rd, fs1
-	{mask: 0xfff0707f, value: 0xe4001053, op: FCLASS_H, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe4001053, op: FCLASS_H, args: argTypeList{arg_rd, arg_fs1}, ext: ExtZfh},
 	// FCLASS.Q rd, fs1
-	{mask: 0xfff0707f, value: 0xe6001053, op: FCLASS_Q, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe6001053, op: FCLASS_Q, args: argTypeList{arg_rd, arg_fs1}, ext: ExtQ},
 	// FCLASS.S rd, fs1
-	{mask: 0xfff0707f, value: 0xe0001053, op: FCLASS_S, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe0001053, op: FCLASS_S, args: argTypeList{arg_rd, arg_fs1}, ext: ExtF},
+	// FCVTMOD.W.D rd, fs1
+	{mask: 0xfff0707f, value: 0xc2801053, op: FCVTMOD_W_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD | ExtZfa},
+	// FCVT.BF16.S fd, fs1
+	{mask: 0xfff0007f, value: 0x44800053, op: FCVT_BF16_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfbfmin},
+	// FCVT.D.H fd, fs1
+	{mask: 0xfff0007f, value: 0x42200053, op: FCVT_D_H, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD | ExtZfhmin},
 	// FCVT.D.L fd, rs1
-	{mask: 0xfff0007f, value: 0xd2200053, op: FCVT_D_L, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd2200053, op: FCVT_D_L, args: argTypeList{arg_fd, arg_rs1}, ext: ExtD},
 	// FCVT.D.LU fd, rs1
-	{mask: 0xfff0007f, value: 0xd2300053, op: FCVT_D_LU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd2300053, op: FCVT_D_LU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtD},
 	// FCVT.D.Q fd, fs1
-	{mask: 0xfff0007f, value: 0x42300053, op: FCVT_D_Q, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x42300053, op: FCVT_D_Q, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ},
 	// FCVT.D.S fd, fs1
-	{mask: 0xfff0007f, value: 0x42000053, op: FCVT_D_S, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x42000053, op: FCVT_D_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD},
 	// FCVT.D.W fd, rs1
-	{mask: 0xfff0007f, value: 0xd2000053, op: FCVT_D_W, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd2000053, op: FCVT_D_W, args: argTypeList{arg_fd, arg_rs1}, ext: ExtD},
 	// FCVT.D.WU fd, rs1
-	{mask: 0xfff0007f, value: 0xd2100053, op: FCVT_D_WU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd2100053, op: FCVT_D_WU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtD},
+	// FCVT.H.D fd, fs1
+	{mask: 0xfff0007f, value: 0x44100053, op: FCVT_H_D, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD | ExtZfhmin},
 	// FCVT.H.L fd, rs1
-	{mask: 0xfff0007f, value: 0xd4200053, op: FCVT_H_L, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd4200053, op: FCVT_H_L, args: argTypeList{arg_fd, arg_rs1}, ext: ExtZfh},
 	// FCVT.H.LU fd, rs1
-	{mask: 0xfff0007f, value: 0xd4300053, op: FCVT_H_LU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd4300053, op: FCVT_H_LU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtZfh},
+	// FCVT.H.Q fd, fs1
+	{mask: 0xfff0007f, value: 0x44300053, op: FCVT_H_Q, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ | ExtZfhmin},
 	// FCVT.H.S fd, fs1
-	{mask: 0xfff0007f, value: 0x44000053, op: FCVT_H_S, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x44000053, op: FCVT_H_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfhmin},
 	// FCVT.H.W fd, rs1
-	{mask: 0xfff0007f, value: 0xd4000053, op: FCVT_H_W, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd4000053, op: FCVT_H_W, args: argTypeList{arg_fd, arg_rs1}, ext: ExtZfh},
 	// FCVT.H.WU fd, rs1
-	{mask: 0xfff0007f, value: 0xd4100053, op: FCVT_H_WU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd4100053, op: FCVT_H_WU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtZfh},
 	// FCVT.LU.D rd, fs1
-	{mask: 0xfff0007f, value: 0xc2300053, op: FCVT_LU_D, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc2300053, op: FCVT_LU_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD},
 	// FCVT.LU.H rd, fs1
-	{mask: 0xfff0007f, value: 0xc4300053, op: FCVT_LU_H, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc4300053, op: FCVT_LU_H, args: argTypeList{arg_rd, arg_fs1}, ext: ExtZfh},
 	// FCVT.LU.Q rd, fs1
-	{mask: 0xfff0007f, value: 0xc6300053, op: FCVT_LU_Q, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc6300053, op: FCVT_LU_Q, args: argTypeList{arg_rd, arg_fs1}, ext: ExtQ},
 	// FCVT.LU.S rd, fs1
-	{mask: 0xfff0007f, value: 0xc0300053, op: FCVT_LU_S, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc0300053, op: FCVT_LU_S, args: argTypeList{arg_rd, arg_fs1}, ext: ExtF},
 	// FCVT.L.D rd, fs1
-	{mask: 0xfff0007f, value: 0xc2200053, op: FCVT_L_D, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc2200053, op: FCVT_L_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD},
 	// FCVT.L.H rd, fs1
-	{mask: 0xfff0007f, value: 0xc4200053, op: FCVT_L_H, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc4200053, op: FCVT_L_H, args: argTypeList{arg_rd, arg_fs1}, ext: ExtZfh},
 	// FCVT.L.Q rd, fs1
-	{mask: 0xfff0007f, value: 0xc6200053, op: FCVT_L_Q, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc6200053, op: FCVT_L_Q, args: argTypeList{arg_rd, arg_fs1}, ext: ExtQ},
 	// FCVT.L.S rd, fs1
-	{mask: 0xfff0007f, value: 0xc0200053, op: FCVT_L_S, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc0200053, op: FCVT_L_S, args: argTypeList{arg_rd, arg_fs1}, ext: ExtF},
 	// FCVT.Q.D fd, fs1
-	{mask: 0xfff0007f, value: 0x46100053, op: FCVT_Q_D, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x46100053, op: FCVT_Q_D, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ},
+	// FCVT.Q.H fd, fs1
+	{mask: 0xfff0007f, value: 0x46200053, op: FCVT_Q_H, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ | ExtZfhmin},
 	// FCVT.Q.L fd, rs1
-	{mask: 0xfff0007f, value: 0xd6200053, op: FCVT_Q_L, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd6200053, op: FCVT_Q_L, args: argTypeList{arg_fd, arg_rs1}, ext: ExtQ},
 	// FCVT.Q.LU fd, rs1
-	{mask: 0xfff0007f, value: 0xd6300053, op: FCVT_Q_LU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd6300053, op: FCVT_Q_LU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtQ},
 	// FCVT.Q.S fd, fs1
-	{mask: 0xfff0007f, value: 0x46000053, op: FCVT_Q_S, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x46000053, op: FCVT_Q_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ},
 	// FCVT.Q.W fd, rs1
-	{mask: 0xfff0007f, value: 0xd6000053, op: FCVT_Q_W, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd6000053, op: FCVT_Q_W, args: argTypeList{arg_fd, arg_rs1}, ext: ExtQ},
 	// FCVT.Q.WU fd, rs1
-	{mask: 0xfff0007f, value: 0xd6100053, op: FCVT_Q_WU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd6100053, op: FCVT_Q_WU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtQ},
+	// FCVT.S.BF16 fd, fs1
+	{mask: 0xfff0007f, value: 0x40600053, op: FCVT_S_BF16, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfbfmin},
 	// FCVT.S.D fd, fs1
-	{mask: 0xfff0007f, value: 0x40100053, op: FCVT_S_D, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x40100053, op: FCVT_S_D, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD},
 	// FCVT.S.H fd, fs1
-	{mask: 0xfff0007f, value: 0x40200053, op: FCVT_S_H, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x40200053, op: FCVT_S_H, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfhmin},
 	// FCVT.S.L fd, rs1
-	{mask: 0xfff0007f, value: 0xd0200053, op: FCVT_S_L, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd0200053, op: FCVT_S_L, args: argTypeList{arg_fd, arg_rs1}, ext: ExtF},
 	// FCVT.S.LU fd, rs1
-	{mask: 0xfff0007f, value: 0xd0300053, op: FCVT_S_LU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd0300053, op: FCVT_S_LU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtF},
 	// FCVT.S.Q fd, fs1
-	{mask: 0xfff0007f, value: 0x40300053, op: FCVT_S_Q, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x40300053, op: FCVT_S_Q, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ},
 	// FCVT.S.W fd, rs1
-	{mask: 0xfff0007f, value: 0xd0000053, op: FCVT_S_W, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd0000053, op: FCVT_S_W, args: argTypeList{arg_fd, arg_rs1}, ext: ExtF},
 	// FCVT.S.WU fd, rs1
-	{mask: 0xfff0007f, value: 0xd0100053, op: FCVT_S_WU, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0007f, value: 0xd0100053, op: FCVT_S_WU, args: argTypeList{arg_fd, arg_rs1}, ext: ExtF},
 	// FCVT.WU.D rd, fs1
-	{mask: 0xfff0007f, value: 0xc2100053, op: FCVT_WU_D, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc2100053, op: FCVT_WU_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD},
 	// FCVT.WU.H rd, fs1
-	{mask: 0xfff0007f, value: 0xc4100053, op: FCVT_WU_H, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc4100053, op: FCVT_WU_H, args: argTypeList{arg_rd, arg_fs1}, ext: ExtZfh},
 	// FCVT.WU.Q rd, fs1
-	{mask: 0xfff0007f, value: 0xc6100053, op: FCVT_WU_Q, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc6100053, op: FCVT_WU_Q, args: argTypeList{arg_rd, arg_fs1}, ext: ExtQ},
 	// FCVT.WU.S rd, fs1
-	{mask: 0xfff0007f, value: 0xc0100053, op: FCVT_WU_S, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc0100053, op: FCVT_WU_S, args: argTypeList{arg_rd, arg_fs1}, ext: ExtF},
 	// FCVT.W.D rd, fs1
-	{mask: 0xfff0007f, value: 0xc2000053, op: FCVT_W_D, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc2000053, op: FCVT_W_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD},
 	// FCVT.W.H rd, fs1
-	{mask: 0xfff0007f, value: 0xc4000053, op: FCVT_W_H, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc4000053, op: FCVT_W_H, args: argTypeList{arg_rd, arg_fs1}, ext: ExtZfh},
 	// FCVT.W.Q rd, fs1
-	{mask: 0xfff0007f, value: 0xc6000053, op: FCVT_W_Q, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc6000053, op: FCVT_W_Q, args: argTypeList{arg_rd, arg_fs1}, ext: ExtQ},
 	// FCVT.W.S rd, fs1
-	{mask: 0xfff0007f, value: 0xc0000053, op: FCVT_W_S, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0xc0000053, op: FCVT_W_S, args: argTypeList{arg_rd, arg_fs1}, ext: ExtF},
 	// FDIV.D fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x1a000053, op: FDIV_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x1a000053, op: FDIV_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FDIV.H fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x1c000053, op: FDIV_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x1c000053, op: FDIV_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FDIV.Q fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x1e000053, op: FDIV_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x1e000053, op: FDIV_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FDIV.S fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x18000053, op: FDIV_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x18000053, op: FDIV_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FENCE pred, succ
-	{mask: 0x0000707f, value: 0x0000000f, op: FENCE, args: argTypeList{arg_pred, arg_succ}},
+	{mask: 0x0000707f, value: 0x0000000f, op: FENCE, args: argTypeList{arg_pred, arg_succ}, ext: ExtI},
 	// FENCE.I
-	{mask: 0x0000707f, value: 0x0000100f, op: FENCE_I, args: argTypeList{}},
+	{mask: 0x0000707f, value: 0x0000100f, op: FENCE_I, args: argTypeList{}, ext: ExtZifencei},
 	// FEQ.D rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa2002053, op: FEQ_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa2002053, op: FEQ_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FEQ.H rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa4002053, op: FEQ_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa4002053, op: FEQ_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FEQ.Q rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa6002053, op: FEQ_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa6002053, op: FEQ_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FEQ.S rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa0002053, op: FEQ_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa0002053, op: FEQ_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FLD fd, rs1_mem
-	{mask: 0x0000707f, value: 0x00003007, op: FLD, args: argTypeList{arg_fd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00003007, op: FLD, args: argTypeList{arg_fd, arg_rs1_mem}, ext: ExtD},
+	// FLEQ.D rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa2004053, op: FLEQ_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtD | ExtZfa},
+	// FLEQ.H rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa4004053, op: FLEQ_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfh | ExtZfa},
+	// FLEQ.Q rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa6004053, op: FLEQ_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtQ | ExtZfa},
+	// FLEQ.S rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa0004053, op: FLEQ_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfa},
 	// FLE.D rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa2000053, op: FLE_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa2000053, op: FLE_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FLE.H rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa4000053, op: FLE_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa4000053, op: FLE_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FLE.Q rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa6000053, op: FLE_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa6000053, op: FLE_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FLE.S rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa0000053, op: FLE_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa0000053, op: FLE_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FLH fd, rs1_mem
-	{mask: 0x0000707f, value: 0x00001007, op: FLH, args: argTypeList{arg_fd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00001007, op: FLH, args: argTypeList{arg_fd, arg_rs1_mem}, ext: ExtZfhmin},
+	// FLI.D fd, fli
+	{mask: 0xfff0707f, value: 0xf2100053, op: FLI_D, args: argTypeList{arg_fd, arg_fli}, ext: ExtD | ExtZfa},
+	// FLI.H fd, fli
+	{mask: 0xfff0707f, value: 0xf4100053, op: FLI_H, args: argTypeList{arg_fd, arg_fli}, ext: ExtZfh | ExtZfa},
+	// FLI.Q fd, fli
+	{mask: 0xfff0707f, value: 0xf6100053, op: FLI_Q, args: argTypeList{arg_fd, arg_fli}, ext: ExtQ | ExtZfa},
+	// FLI.S fd, fli
+	{mask: 0xfff0707f, value: 0xf0100053, op: FLI_S, args: argTypeList{arg_fd, arg_fli}, ext: ExtZfa},
 	// FLQ fd, rs1_mem
-	{mask: 0x0000707f, value: 0x00004007, op: FLQ, args: argTypeList{arg_fd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00004007, op: FLQ, args: argTypeList{arg_fd, arg_rs1_mem}, ext: ExtQ},
+	// FLTQ.D rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa2005053, op: FLTQ_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtD | ExtZfa},
+	// FLTQ.H rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa4005053, op: FLTQ_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfh | ExtZfa},
+	// FLTQ.Q rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa6005053, op: FLTQ_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtQ | ExtZfa},
+	// FLTQ.S rd, fs1, fs2
+	{mask: 0xfe00707f, value: 0xa0005053, op: FLTQ_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfa},
 	// FLT.D rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa2001053, op: FLT_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa2001053, op: FLT_D, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FLT.H rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa4001053, op: FLT_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa4001053, op: FLT_H, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FLT.Q rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa6001053, op: FLT_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa6001053, op: FLT_Q, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FLT.S rd, fs1, fs2
-	{mask: 0xfe00707f, value: 0xa0001053, op: FLT_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0xa0001053, op: FLT_S, args: argTypeList{arg_rd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FLW fd, rs1_mem
-	{mask: 0x0000707f, value: 0x00002007, op: FLW, args: argTypeList{arg_fd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00002007, op: FLW, args: argTypeList{arg_fd, arg_rs1_mem}, ext: ExtF},
 	// FMADD.D fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x02000043, op: FMADD_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x02000043, op: FMADD_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtD},
 	// FMADD.H fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x04000043, op: FMADD_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x04000043, op: FMADD_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtZfh},
 	// FMADD.Q fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x06000043, op: FMADD_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x06000043, op: FMADD_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtQ},
 	// FMADD.S fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x00000043, op: FMADD_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x00000043, op: FMADD_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtF},
+	// FMAXM.D fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x2a003053, op: FMAXM_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD | ExtZfa},
+	// FMAXM.H fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x2c003053, op: FMAXM_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh | ExtZfa},
+	// FMAXM.Q fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x2e003053, op: FMAXM_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ | ExtZfa},
+	// FMAXM.S fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x28003053, op: FMAXM_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfa},
 	// FMAX.D fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x2a001053, op: FMAX_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x2a001053, op: FMAX_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FMAX.H fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x2c001053, op: FMAX_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x2c001053, op: FMAX_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FMAX.Q fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x2e001053, op: FMAX_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x2e001053, op: FMAX_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FMAX.S fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x28001053, op: FMAX_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x28001053, op: FMAX_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
+	// FMINM.D fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x2a002053, op: FMINM_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD | ExtZfa},
+	// FMINM.H fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x2c002053, op: FMINM_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh | ExtZfa},
+	// FMINM.Q fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x2e002053, op: FMINM_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ | ExtZfa},
+	// FMINM.S fd, fs1, fs2
+	{mask: 0xfe00707f, value: 0x28002053, op: FMINM_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfa},
 	// FMIN.D fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x2a000053, op: FMIN_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x2a000053, op: FMIN_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FMIN.H fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x2c000053, op: FMIN_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x2c000053, op: FMIN_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FMIN.Q fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x2e000053, op: FMIN_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x2e000053, op: FMIN_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FMIN.S fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x28000053, op: FMIN_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x28000053, op: FMIN_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FMSUB.D fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x02000047, op: FMSUB_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x02000047, op: FMSUB_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtD},
 	// FMSUB.H fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x04000047, op: FMSUB_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x04000047, op: FMSUB_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtZfh},
 	// FMSUB.Q fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x06000047, op: FMSUB_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x06000047, op: FMSUB_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtQ},
 	// FMSUB.S fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x00000047, op: FMSUB_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x00000047, op: FMSUB_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtF},
 	// FMUL.D fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x12000053, op: FMUL_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x12000053, op: FMUL_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FMUL.H fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x14000053, op: FMUL_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x14000053, op: FMUL_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FMUL.Q fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x16000053, op: FMUL_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x16000053, op: FMUL_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FMUL.S fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x10000053, op: FMUL_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x10000053, op: FMUL_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
+	// FMVH.X.Q rd, fs1
+	{mask: 0xfff0707f, value: 0xe6100053, op: FMVH_X_Q, args: argTypeList{arg_rd, arg_fs1}, ext: ExtQ | ExtZfa},
+	// FMVP.Q.X fd, rs1, rs2
+	{mask: 0xfe00707f, value: 0xb6000053, op: FMVP_Q_X, args: argTypeList{arg_fd, arg_rs1, arg_rs2}, ext: ExtQ | ExtZfa},
 	// FMV.D.X fd, rs1
-	{mask: 0xfff0707f, value: 0xf2000053, op: FMV_D_X, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0xf2000053, op: FMV_D_X, args: argTypeList{arg_fd, arg_rs1}, ext: ExtD},
 	// FMV.H.X fd, rs1
-	{mask: 0xfff0707f, value: 0xf4000053, op: FMV_H_X, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0xf4000053, op: FMV_H_X, args: argTypeList{arg_fd, arg_rs1}, ext: ExtZfhmin},
 	// FMV.W.X fd, rs1
-	{mask: 0xfff0707f, value: 0xf0000053, op: FMV_W_X, args: argTypeList{arg_fd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0xf0000053, op: FMV_W_X, args: argTypeList{arg_fd, arg_rs1}, ext: ExtF},
 	// FMV.X.D rd, fs1
-	{mask: 0xfff0707f, value: 0xe2000053, op: FMV_X_D, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe2000053, op: FMV_X_D, args: argTypeList{arg_rd, arg_fs1}, ext: ExtD},
 	// FMV.X.H rd, fs1
-	{mask: 0xfff0707f, value: 0xe4000053, op: FMV_X_H, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe4000053, op: FMV_X_H, args: argTypeList{arg_rd, arg_fs1}, ext: ExtZfhmin},
 	// FMV.X.W rd, fs1
-	{mask: 0xfff0707f, value: 0xe0000053, op: FMV_X_W, args: argTypeList{arg_rd, arg_fs1}},
+	{mask: 0xfff0707f, value: 0xe0000053, op: FMV_X_W, args: argTypeList{arg_rd, arg_fs1}, ext: ExtF},
 	// FNMADD.D fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0200004f, op: FNMADD_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0200004f, op: FNMADD_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtD},
 	// FNMADD.H fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0400004f, op: FNMADD_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0400004f, op: FNMADD_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtZfh},
 	// FNMADD.Q fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0600004f, op: FNMADD_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0600004f, op: FNMADD_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtQ},
 	// FNMADD.S fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0000004f, op: FNMADD_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0000004f, op: FNMADD_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtF},
 	// FNMSUB.D fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0200004b, op: FNMSUB_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0200004b, op: FNMSUB_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtD},
 	// FNMSUB.H fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0400004b, op: FNMSUB_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0400004b, op: FNMSUB_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtZfh},
 	// FNMSUB.Q fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0600004b, op: FNMSUB_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0600004b, op: FNMSUB_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtQ},
 	// FNMSUB.S fd, fs1, fs2, fs3
-	{mask: 0x0600007f, value: 0x0000004b, op: FNMSUB_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}},
+	{mask: 0x0600007f, value: 0x0000004b, op: FNMSUB_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2, arg_fs3}, ext: ExtF},
+	// FROUNDNX.D fd, fs1
+	{mask: 0xfff0007f, value: 0x42500053, op: FROUNDNX_D, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD | ExtZfa},
+	// FROUNDNX.H fd, fs1
+	{mask: 0xfff0007f, value: 0x44500053, op: FROUNDNX_H, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfh | ExtZfa},
+	// FROUNDNX.Q fd, fs1
+	{mask: 0xfff0007f, value: 0x46500053, op: FROUNDNX_Q, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ | ExtZfa},
+	// FROUNDNX.S fd, fs1
+	{mask: 0xfff0007f, value: 0x40500053, op: FROUNDNX_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfa},
+	// FROUND.D fd, fs1
+	{mask: 0xfff0007f, value: 0x42400053, op: FROUND_D, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD | ExtZfa},
+	// FROUND.H fd, fs1
+	{mask: 0xfff0007f, value: 0x44400053, op: FROUND_H, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfh | ExtZfa},
+	// FROUND.Q fd, fs1
+	{mask: 0xfff0007f, value: 0x46400053, op: FROUND_Q, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ | ExtZfa},
+	// FROUND.S fd, fs1
+	{mask: 0xfff0007f, value: 0x40400053, op: FROUND_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfa},
 	// FSD fs2, rs1_store
-	{mask: 0x0000707f, value: 0x00003027, op: FSD, args: argTypeList{arg_fs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00003027, op: FSD, args: argTypeList{arg_fs2, arg_rs1_store}, ext: ExtD},
 	// FSGNJN.D fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x22001053, op: FSGNJN_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x22001053, op: FSGNJN_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FSGNJN.H fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x24001053, op: FSGNJN_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x24001053, op: FSGNJN_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FSGNJN.Q fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x26001053, op: FSGNJN_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x26001053, op: FSGNJN_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FSGNJN.S fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x20001053, op: FSGNJN_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x20001053, op: FSGNJN_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FSGNJX.D fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x22002053, op: FSGNJX_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x22002053, op: FSGNJX_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FSGNJX.H fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x24002053, op: FSGNJX_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x24002053, op: FSGNJX_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FSGNJX.Q fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x26002053, op: FSGNJX_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x26002053, op: FSGNJX_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FSGNJX.S fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x20002053, op: FSGNJX_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x20002053, op: FSGNJX_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FSGNJ.D fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x22000053, op: FSGNJ_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x22000053, op: FSGNJ_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FSGNJ.H fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x24000053, op: FSGNJ_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x24000053, op: FSGNJ_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FSGNJ.Q fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x26000053, op: FSGNJ_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x26000053, op: FSGNJ_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FSGNJ.S fd, fs1, fs2
-	{mask: 0xfe00707f, value: 0x20000053, op: FSGNJ_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00707f, value: 0x20000053, op: FSGNJ_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FSH fs2, rs1_store
-	{mask: 0x0000707f, value: 0x00001027, op: FSH, args: argTypeList{arg_fs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00001027, op: FSH, args: argTypeList{arg_fs2, arg_rs1_store}, ext: ExtZfhmin},
 	// FSQ fs2, rs1_store
-	{mask: 0x0000707f, value: 0x00004027, op: FSQ, args: argTypeList{arg_fs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00004027, op: FSQ, args: argTypeList{arg_fs2, arg_rs1_store}, ext: ExtQ},
 	// FSQRT.D fd, fs1
-	{mask: 0xfff0007f, value: 0x5a000053, op: FSQRT_D, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x5a000053, op: FSQRT_D, args: argTypeList{arg_fd, arg_fs1}, ext: ExtD},
 	// FSQRT.H fd, fs1
-	{mask: 0xfff0007f, value: 0x5c000053, op: FSQRT_H, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x5c000053, op: FSQRT_H, args: argTypeList{arg_fd, arg_fs1}, ext: ExtZfh},
 	// FSQRT.Q fd, fs1
-	{mask: 0xfff0007f, value: 0x5e000053, op: FSQRT_Q, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x5e000053, op: FSQRT_Q, args: argTypeList{arg_fd, arg_fs1}, ext: ExtQ},
 	// FSQRT.S fd, fs1
-	{mask: 0xfff0007f, value: 0x58000053, op: FSQRT_S, args: argTypeList{arg_fd, arg_fs1}},
+	{mask: 0xfff0007f, value: 0x58000053, op: FSQRT_S, args: argTypeList{arg_fd, arg_fs1}, ext: ExtF},
 	// FSUB.D fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x0a000053, op: FSUB_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x0a000053, op: FSUB_D, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtD},
 	// FSUB.H fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x0c000053, op: FSUB_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x0c000053, op: FSUB_H, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtZfh},
 	// FSUB.Q fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x0e000053, op: FSUB_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x0e000053, op: FSUB_Q, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtQ},
 	// FSUB.S fd, fs1, fs2
-	{mask: 0xfe00007f, value: 0x08000053, op: FSUB_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}},
+	{mask: 0xfe00007f, value: 0x08000053, op: FSUB_S, args: argTypeList{arg_fd, arg_fs1, arg_fs2}, ext: ExtF},
 	// FSW fs2, rs1_store
-	{mask: 0x0000707f, value: 0x00002027, op: FSW, args: argTypeList{arg_fs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00002027, op: FSW, args: argTypeList{arg_fs2, arg_rs1_store}, ext: ExtF},
 	// JAL rd, jimm20
-	{mask: 0x0000007f, value: 0x0000006f, op: JAL, args: argTypeList{arg_rd, arg_jimm20}},
+	{mask: 0x0000007f, value: 0x0000006f, op: JAL, args: argTypeList{arg_rd, arg_jimm20}, ext: ExtI},
 	// JALR rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00000067, op: JALR, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00000067, op: JALR, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LB rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00000003, op: LB, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00000003, op: LB, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LBU rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00004003, op: LBU, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00004003, op: LBU, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LD rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00003003, op: LD, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00003003, op: LD, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LH rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00001003, op: LH, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00001003, op: LH, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LHU rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00005003, op: LHU, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00005003, op: LHU, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LR.D rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1000302f, op: LR_D, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1000302f, op: LR_D, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.D.AQ rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1400302f, op: LR_D_AQ, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1400302f, op: LR_D_AQ, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.D.AQRL rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1600302f, op: LR_D_AQRL, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1600302f, op: LR_D_AQRL, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.D.RL rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1200302f, op: LR_D_RL, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1200302f, op: LR_D_RL, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.W rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1000202f, op: LR_W, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1000202f, op: LR_W, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.W.AQ rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1400202f, op: LR_W_AQ, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1400202f, op: LR_W_AQ, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.W.AQRL rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1600202f, op: LR_W_AQRL, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1600202f, op: LR_W_AQRL, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LR.W.RL rd, rs1_ptr
-	{mask: 0xfff0707f, value: 0x1200202f, op: LR_W_RL, args: argTypeList{arg_rd, arg_rs1_ptr}},
+	{mask: 0xfff0707f, value: 0x1200202f, op: LR_W_RL, args: argTypeList{arg_rd, arg_rs1_ptr}, ext: ExtA},
 	// LUI rd, imm20
-	{mask: 0x0000007f, value: 0x00000037, op: LUI, args: argTypeList{arg_rd, arg_imm20}},
+	{mask: 0x0000007f, value: 0x00000037, op: LUI, args: argTypeList{arg_rd, arg_imm20}, ext: ExtI},
 	// LW rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00002003, op: LW, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00002003, op: LW, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// LWU rd, rs1_mem
-	{mask: 0x0000707f, value: 0x00006003, op: LWU, args: argTypeList{arg_rd, arg_rs1_mem}},
+	{mask: 0x0000707f, value: 0x00006003, op: LWU, args: argTypeList{arg_rd, arg_rs1_mem}, ext: ExtI},
 	// MAX rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a006033, op: MAX, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a006033, op: MAX, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// MAXU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a007033, op: MAXU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a007033, op: MAXU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// MIN rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a004033, op: MIN, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a004033, op: MIN, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// MINU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0a005033, op: MINU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0a005033, op: MINU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// MUL rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02000033, op: MUL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02000033, op: MUL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// MULH rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02001033, op: MULH, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02001033, op: MULH, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// MULHSU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02002033, op: MULHSU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02002033, op: MULHSU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// MULHU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02003033, op: MULHU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02003033, op: MULHU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// MULW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0200003b, op: MULW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0200003b, op: MULW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// OR rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00006033, op: OR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00006033, op: OR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// ORC.B rd, rs1
-	{mask: 0xfff0707f, value: 0x28705013, op: ORC_B, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x28705013, op: ORC_B, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// ORI rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x00006013, op: ORI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x00006013, op: ORI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// ORN rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x40006033, op: ORN, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x40006033, op: ORN, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// REM rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02006033, op: REM, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02006033, op: REM, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// REMU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x02007033, op: REMU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x02007033, op: REMU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// REMUW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0200703b, op: REMUW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0200703b, op: REMUW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// REMW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0200603b, op: REMW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0200603b, op: REMW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtM},
 	// REV8 rd, rs1
-	{mask: 0xfff0707f, value: 0x6b805013, op: REV8, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x6b805013, op: REV8, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// ROL rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x60001033, op: ROL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x60001033, op: ROL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// ROLW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x6000103b, op: ROLW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x6000103b, op: ROLW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// ROR rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x60005033, op: ROR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x60005033, op: ROR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// RORI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x60005013, op: RORI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x60005013, op: RORI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtZbb},
 	// RORIW rd, rs1, shamt5
-	{mask: 0xfe00707f, value: 0x6000501b, op: RORIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}},
+	{mask: 0xfe00707f, value: 0x6000501b, op: RORIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}, ext: ExtZbb},
 	// RORW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x6000503b, op: RORW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x6000503b, op: RORW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// SB rs2, rs1_store
-	{mask: 0x0000707f, value: 0x00000023, op: SB, args: argTypeList{arg_rs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00000023, op: SB, args: argTypeList{arg_rs2, arg_rs1_store}, ext: ExtI},
 	// SC.D rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1800302f, op: SC_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1800302f, op: SC_D, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.D.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1c00302f, op: SC_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1c00302f, op: SC_D_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.D.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1e00302f, op: SC_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1e00302f, op: SC_D_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.D.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1a00302f, op: SC_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1a00302f, op: SC_D_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.W rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1800202f, op: SC_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1800202f, op: SC_W, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.W.AQ rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1c00202f, op: SC_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1c00202f, op: SC_W_AQ, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.W.AQRL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1e00202f, op: SC_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1e00202f, op: SC_W_AQRL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SC.W.RL rd, rs2, rs1_ptr
-	{mask: 0xfe00707f, value: 0x1a00202f, op: SC_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}},
+	{mask: 0xfe00707f, value: 0x1a00202f, op: SC_W_RL, args: argTypeList{arg_rd, arg_rs2, arg_rs1_ptr}, ext: ExtA},
 	// SD rs2, rs1_store
-	{mask: 0x0000707f, value: 0x00003023, op: SD, args: argTypeList{arg_rs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00003023, op: SD, args: argTypeList{arg_rs2, arg_rs1_store}, ext: ExtI},
 	// SEXT.B rd, rs1
-	{mask: 0xfff0707f, value: 0x60401013, op: SEXT_B, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x60401013, op: SEXT_B, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// SEXT.H rd, rs1
-	{mask: 0xfff0707f, value: 0x60501013, op: SEXT_H, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x60501013, op: SEXT_H, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 	// SH rs2, rs1_store
-	{mask: 0x0000707f, value: 0x00001023, op: SH, args: argTypeList{arg_rs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00001023, op: SH, args: argTypeList{arg_rs2, arg_rs1_store}, ext: ExtI},
 	// SH1ADD rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x20002033, op: SH1ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x20002033, op: SH1ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
 	// SH1ADD.UW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x2000203b, op: SH1ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x2000203b, op: SH1ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
 	// SH2ADD rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x20004033, op: SH2ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x20004033, op: SH2ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
 	// SH2ADD.UW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x2000403b, op: SH2ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x2000403b, op: SH2ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
 	// SH3ADD rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x20006033, op: SH3ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x20006033, op: SH3ADD, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
 	// SH3ADD.UW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x2000603b, op: SH3ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x2000603b, op: SH3ADD_UW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZba},
 	// SLL rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00001033, op: SLL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00001033, op: SLL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SLLI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x00001013, op: SLLI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x00001013, op: SLLI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtI},
 	// SLLIW rd, rs1, shamt5
-	{mask: 0xfe00707f, value: 0x0000101b, op: SLLIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}},
+	{mask: 0xfe00707f, value: 0x0000101b, op: SLLIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}, ext: ExtI},
 	// SLLI.UW rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x0800101b, op: SLLI_UW, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x0800101b, op: SLLI_UW, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtZba},
 	// SLLW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0000103b, op: SLLW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0000103b, op: SLLW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SLT rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00002033, op: SLT, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00002033, op: SLT, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SLTI rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x00002013, op: SLTI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x00002013, op: SLTI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// SLTIU rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x00003013, op: SLTIU, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x00003013, op: SLTIU, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// SLTU rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00003033, op: SLTU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00003033, op: SLTU, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SRA rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x40005033, op: SRA, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x40005033, op: SRA, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SRAI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x40005013, op: SRAI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x40005013, op: SRAI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtI},
 	// SRAIW rd, rs1, shamt5
-	{mask: 0xfe00707f, value: 0x4000501b, op: SRAIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}},
+	{mask: 0xfe00707f, value: 0x4000501b, op: SRAIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}, ext: ExtI},
 	// SRAW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x4000503b, op: SRAW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x4000503b, op: SRAW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SRL rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00005033, op: SRL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00005033, op: SRL, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SRLI rd, rs1, shamt6
-	{mask: 0xfc00707f, value: 0x00005013, op: SRLI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}},
+	{mask: 0xfc00707f, value: 0x00005013, op: SRLI, args: argTypeList{arg_rd, arg_rs1, arg_shamt6}, ext: ExtI},
 	// SRLIW rd, rs1, shamt5
-	{mask: 0xfe00707f, value: 0x0000501b, op: SRLIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}},
+	{mask: 0xfe00707f, value: 0x0000501b, op: SRLIW, args: argTypeList{arg_rd, arg_rs1, arg_shamt5}, ext: ExtI},
 	// SRLW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x0000503b, op: SRLW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x0000503b, op: SRLW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SUB rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x40000033, op: SUB, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x40000033, op: SUB, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SUBW rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x4000003b, op: SUBW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x4000003b, op: SUBW, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// SW rs2, rs1_store
-	{mask: 0x0000707f, value: 0x00002023, op: SW, args: argTypeList{arg_rs2, arg_rs1_store}},
+	{mask: 0x0000707f, value: 0x00002023, op: SW, args: argTypeList{arg_rs2, arg_rs1_store}, ext: ExtI},
 	// VAADDU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x20002057, op: VAADDU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x20002057, op: VAADDU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VAADDU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x20006057, op: VAADDU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x20006057, op: VAADDU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VAADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x24002057, op: VAADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24002057, op: VAADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VAADD.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x24006057, op: VAADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24006057, op: VAADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VAESDF.VS vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa600a077, op: VAESDF_VS, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa600a077, op: VAESDF_VS, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESDF.VV vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa200a077, op: VAESDF_VV, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa200a077, op: VAESDF_VV, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESDM.VS vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa6002077, op: VAESDM_VS, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa6002077, op: VAESDM_VS, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESDM.VV vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa2002077, op: VAESDM_VV, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa2002077, op: VAESDM_VV, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESEF.VS vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa601a077, op: VAESEF_VS, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa601a077, op: VAESEF_VS, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESEF.VV vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa201a077, op: VAESEF_VV, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa201a077, op: VAESEF_VV, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESEM.VS vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa6012077, op: VAESEM_VS, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa6012077, op: VAESEM_VS, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESEM.VV vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa2012077, op: VAESEM_VV, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa2012077, op: VAESEM_VV, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
 	// VAESKF1.VI vs2, zimm, vd
-	{mask: 0xfe00707f, value: 0x8a002077, op: VAESKF1_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}},
+	{mask: 0xfe00707f, value: 0x8a002077, op: VAESKF1_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}, ext: ExtZvkned},
 	// VAESKF2.VI vs2, zimm, vd
-	{mask: 0xfe00707f, value: 0xaa002077, op: VAESKF2_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}},
+	{mask: 0xfe00707f, value: 0xaa002077, op: VAESKF2_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}, ext: ExtZvkned},
 	// VAESZ.VS vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa603a077, op: VAESZ_VS, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa603a077, op: VAESZ_VS, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkned},
+	// VANDN.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0x04000057, op: VANDN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvkb},
+	// VANDN.VX vm, vs2, rs1, vd
+	{mask: 0xfc00707f, value: 0x04004057, op: VANDN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtZvkb},
 	// VGHSH.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0xb2002077, op: VGHSH_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0xb2002077, op: VGHSH_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtZvkg},
 	// VGMUL.VV vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa208a077, op: VGMUL_VV, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa208a077, op: VGMUL_VV, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvkg},
 	// VSHA2CH.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0xba002077, op: VSHA2CH_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0xba002077, op: VSHA2CH_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtZvknha},
 	// VSHA2CL.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0xbe002077, op: VSHA2CL_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0xbe002077, op: VSHA2CL_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtZvknha},
 	// VSHA2MS.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0xb6002077, op: VSHA2MS_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0xb6002077, op: VSHA2MS_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtZvknha},
 	// VSM3C.VI vs2, zimm, vd
-	{mask: 0xfe00707f, value: 0xae002077, op: VSM3C_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}},
+	{mask: 0xfe00707f, value: 0xae002077, op: VSM3C_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}, ext: ExtZvksh},
 	// VSM3ME.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x82002077, op: VSM3ME_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x82002077, op: VSM3ME_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtZvksh},
 	// VSM4K.VI vs2, zimm, vd
-	{mask: 0xfe00707f, value: 0x86002077, op: VSM4K_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}},
+	{mask: 0xfe00707f, value: 0x86002077, op: VSM4K_VI, args: argTypeList{arg_vs2, arg_zimm, arg_vd}, ext: ExtZvksed},
 	// VSM4R.VS vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa6082077, op: VSM4R_VS, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa6082077, op: VSM4R_VS, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvksed},
 	// VSM4R.VV vs2, vd
-	{mask: 0xfe0ff07f, value: 0xa2082077, op: VSM4R_VV, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0xa2082077, op: VSM4R_VV, args: argTypeList{arg_vs2, arg_vd}, ext: ExtZvksed},
 	// VADC.VIM vs2, simm5, vd
-	{mask: 0xfe00707f, value: 0x40003057, op: VADC_VIM, args: argTypeList{arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfe00707f, value: 0x40003057, op: VADC_VIM, args: argTypeList{arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VADC.VVM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x40000057, op: VADC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x40000057, op: VADC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VADC.VXM vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x40004057, op: VADC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x40004057, op: VADC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VADD.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x00003057, op: VADD_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x00003057, op: VADD_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x00000057, op: VADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x00000057, op: VADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VADD.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x00004057, op: VADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x00004057, op: VADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VAND.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x24003057, op: VAND_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24003057, op: VAND_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VAND.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x24000057, op: VAND_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24000057, op: VAND_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VAND.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x24004057, op: VAND_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24004057, op: VAND_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VASUBU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x28002057, op: VASUBU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28002057, op: VASUBU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VASUBU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x28006057, op: VASUBU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28006057, op: VASUBU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VASUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x2c002057, op: VASUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c002057, op: VASUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VASUB.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x2c006057, op: VASUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c006057, op: VASUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
+	// VBREV8.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x48042057, op: VBREV8_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvkb},
+	// VBREV.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x48052057, op: VBREV_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvbb},
+	// VCLMULH.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0x34002057, op: VCLMULH_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvbc},
+	// VCLMULH.VX vm, vs2, rs1, vd
+	{mask: 0xfc00707f, value: 0x34006057, op: VCLMULH_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtZvbc},
+	// VCLMUL.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0x30002057, op: VCLMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvbc},
+	// VCLMUL.VX vm, vs2, rs1, vd
+	{mask: 0xfc00707f, value: 0x30006057, op: VCLMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtZvbc},
+	// VCLZ.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x48062057, op: VCLZ_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvbb},
 	// VCOMPRESS.VM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x5e002057, op: VCOMPRESS_VM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x5e002057, op: VCOMPRESS_VM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VCPOP.M vm, vs2, rd
-	{mask: 0xfc0ff07f, value: 0x40082057, op: VCPOP_M, args: argTypeList{arg_vm, arg_vs2, arg_rd}},
+	{mask: 0xfc0ff07f, value: 0x40082057, op: VCPOP_M, args: argTypeList{arg_vm, arg_vs2, arg_rd}, ext: ExtV},
+	// VCPOP.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x48072057, op: VCPOP_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvbb},
+	// VCTZ.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x4806a057, op: VCTZ_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvbb},
 	// VDIVU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x80002057, op: VDIVU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80002057, op: VDIVU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VDIVU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x80006057, op: VDIVU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80006057, op: VDIVU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VDIV.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x84002057, op: VDIV_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84002057, op: VDIV_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VDIV.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x84006057, op: VDIV_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84006057, op: VDIV_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VFADD.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x00005057, op: VFADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x00005057, op: VFADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x00001057, op: VFADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x00001057, op: VFADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFCLASS.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4c081057, op: VFCLASS_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4c081057, op: VFCLASS_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFCVT.F.XU.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48011057, op: VFCVT_F_XU_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48011057, op: VFCVT_F_XU_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFCVT.F.X.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48019057, op: VFCVT_F_X_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48019057, op: VFCVT_F_X_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFCVT.RTZ.XU.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48031057, op: VFCVT_RTZ_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48031057, op: VFCVT_RTZ_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFCVT.RTZ.X.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48039057, op: VFCVT_RTZ_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48039057, op: VFCVT_RTZ_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFCVT.XU.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48001057, op: VFCVT_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48001057, op: VFCVT_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFCVT.X.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48009057, op: VFCVT_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48009057, op: VFCVT_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFDIV.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x80005057, op: VFDIV_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80005057, op: VFDIV_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFDIV.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x80001057, op: VFDIV_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80001057, op: VFDIV_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFIRST.M vm, vs2, rd
-	{mask: 0xfc0ff07f, value: 0x4008a057, op: VFIRST_M, args: argTypeList{arg_vm, arg_vs2, arg_rd}},
+	{mask: 0xfc0ff07f, value: 0x4008a057, op: VFIRST_M, args: argTypeList{arg_vm, arg_vs2, arg_rd}, ext: ExtV},
 	// VFMACC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xb0005057, op: VFMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb0005057, op: VFMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMACC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb0001057, op: VFMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb0001057, op: VFMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMADD.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xa0005057, op: VFMADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa0005057, op: VFMADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa0001057, op: VFMADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa0001057, op: VFMADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMAX.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x18005057, op: VFMAX_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x18005057, op: VFMAX_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMAX.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x18001057, op: VFMAX_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x18001057, op: VFMAX_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMERGE.VFM vs2, fs1, vd
-	{mask: 0xfe00707f, value: 0x5c005057, op: VFMERGE_VFM, args: argTypeList{arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x5c005057, op: VFMERGE_VFM, args: argTypeList{arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMIN.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x10005057, op: VFMIN_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x10005057, op: VFMIN_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMIN.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x10001057, op: VFMIN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x10001057, op: VFMIN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMSAC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xb8005057, op: VFMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb8005057, op: VFMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMSAC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb8001057, op: VFMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb8001057, op: VFMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMSUB.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xa8005057, op: VFMSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8005057, op: VFMSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa8001057, op: VFMSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8001057, op: VFMSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMUL.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x90005057, op: VFMUL_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x90005057, op: VFMUL_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFMUL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x90001057, op: VFMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x90001057, op: VFMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFMV.F.S vs2, fd
-	{mask: 0xfe0ff07f, value: 0x42001057, op: VFMV_F_S, args: argTypeList{arg_vs2, arg_fd}},
+	{mask: 0xfe0ff07f, value: 0x42001057, op: VFMV_F_S, args: argTypeList{arg_vs2, arg_fd}, ext: ExtV},
 	// VFMV.S.F fs1, vd
-	{mask: 0xfff0707f, value: 0x42005057, op: VFMV_S_F, args: argTypeList{arg_fs1, arg_vd}},
+	{mask: 0xfff0707f, value: 0x42005057, op: VFMV_S_F, args: argTypeList{arg_fs1, arg_vd}, ext: ExtV},
 	// VFMV.V.F fs1, vd
-	{mask: 0xfff0707f, value: 0x5e005057, op: VFMV_V_F, args: argTypeList{arg_fs1, arg_vd}},
+	{mask: 0xfff0707f, value: 0x5e005057, op: VFMV_V_F, args: argTypeList{arg_fs1, arg_vd}, ext: ExtV},
+	// VFNCVTBF16.F.F.W vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x480e9057, op: VFNCVTBF16_F_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvfbfmin},
 	// VFNCVT.F.F.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x480a1057, op: VFNCVT_F_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x480a1057, op: VFNCVT_F_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.F.XU.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48091057, op: VFNCVT_F_XU_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48091057, op: VFNCVT_F_XU_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.F.X.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48099057, op: VFNCVT_F_X_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48099057, op: VFNCVT_F_X_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.ROD.F.F.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x480a9057, op: VFNCVT_ROD_F_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x480a9057, op: VFNCVT_ROD_F_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.RTZ.XU.F.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x480b1057, op: VFNCVT_RTZ_XU_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x480b1057, op: VFNCVT_RTZ_XU_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.RTZ.X.F.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x480b9057, op: VFNCVT_RTZ_X_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x480b9057, op: VFNCVT_RTZ_X_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.XU.F.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48081057, op: VFNCVT_XU_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48081057, op: VFNCVT_XU_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNCVT.X.F.W vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48089057, op: VFNCVT_X_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48089057, op: VFNCVT_X_F_W, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFNMACC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xb4005057, op: VFNMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4005057, op: VFNMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFNMACC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb4001057, op: VFNMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4001057, op: VFNMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFNMADD.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xa4005057, op: VFNMADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4005057, op: VFNMADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFNMADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa4001057, op: VFNMADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4001057, op: VFNMADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFNMSAC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xbc005057, op: VFNMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc005057, op: VFNMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFNMSAC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xbc001057, op: VFNMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc001057, op: VFNMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFNMSUB.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xac005057, op: VFNMSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac005057, op: VFNMSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFNMSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xac001057, op: VFNMSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac001057, op: VFNMSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFRDIV.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x84005057, op: VFRDIV_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84005057, op: VFRDIV_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFREC7.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4c029057, op: VFREC7_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4c029057, op: VFREC7_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFREDMAX.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x1c001057, op: VFREDMAX_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x1c001057, op: VFREDMAX_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFREDMIN.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x14001057, op: VFREDMIN_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x14001057, op: VFREDMIN_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFREDOSUM.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x0c001057, op: VFREDOSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c001057, op: VFREDOSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFREDUSUM.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x04001057, op: VFREDUSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x04001057, op: VFREDUSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFRSQRT7.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4c021057, op: VFRSQRT7_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4c021057, op: VFRSQRT7_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFRSUB.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x9c005057, op: VFRSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x9c005057, op: VFRSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSGNJN.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x24005057, op: VFSGNJN_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24005057, op: VFSGNJN_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSGNJN.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x24001057, op: VFSGNJN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24001057, op: VFSGNJN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFSGNJX.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x28005057, op: VFSGNJX_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28005057, op: VFSGNJX_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSGNJX.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x28001057, op: VFSGNJX_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28001057, op: VFSGNJX_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFSGNJ.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x20005057, op: VFSGNJ_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x20005057, op: VFSGNJ_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSGNJ.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x20001057, op: VFSGNJ_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x20001057, op: VFSGNJ_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFSLIDE1DOWN.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x3c005057, op: VFSLIDE1DOWN_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x3c005057, op: VFSLIDE1DOWN_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSLIDE1UP.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x38005057, op: VFSLIDE1UP_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x38005057, op: VFSLIDE1UP_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSQRT.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4c001057, op: VFSQRT_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4c001057, op: VFSQRT_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFSUB.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x08005057, op: VFSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08005057, op: VFSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x08001057, op: VFSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08001057, op: VFSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWADD.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xc0005057, op: VFWADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc0005057, op: VFWADD_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc0001057, op: VFWADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc0001057, op: VFWADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWADD.WF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xd0005057, op: VFWADD_WF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd0005057, op: VFWADD_WF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWADD.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xd0001057, op: VFWADD_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd0001057, op: VFWADD_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
+	// VFWCVTBF16.F.F.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x48069057, op: VFWCVTBF16_F_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvfbfmin},
 	// VFWCVT.F.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48061057, op: VFWCVT_F_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48061057, op: VFWCVT_F_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFWCVT.F.XU.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48051057, op: VFWCVT_F_XU_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48051057, op: VFWCVT_F_XU_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFWCVT.F.X.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48059057, op: VFWCVT_F_X_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48059057, op: VFWCVT_F_X_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFWCVT.RTZ.XU.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48071057, op: VFWCVT_RTZ_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48071057, op: VFWCVT_RTZ_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFWCVT.RTZ.X.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48079057, op: VFWCVT_RTZ_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48079057, op: VFWCVT_RTZ_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFWCVT.XU.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48041057, op: VFWCVT_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48041057, op: VFWCVT_XU_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VFWCVT.X.F.V vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48049057, op: VFWCVT_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48049057, op: VFWCVT_X_F_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
+	// VFWMACCBF16.VF vm, vs2, fs1, vd
+	{mask: 0xfc00707f, value: 0xec005057, op: VFWMACCBF16_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtZvfbfwma},
+	// VFWMACCBF16.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0xec001057, op: VFWMACCBF16_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvfbfwma},
 	// VFWMACC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xf0005057, op: VFWMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf0005057, op: VFWMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWMACC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xf0001057, op: VFWMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf0001057, op: VFWMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWMSAC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xf8005057, op: VFWMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf8005057, op: VFWMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWMSAC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xf8001057, op: VFWMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf8001057, op: VFWMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWMUL.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xe0005057, op: VFWMUL_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe0005057, op: VFWMUL_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWMUL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xe0001057, op: VFWMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe0001057, op: VFWMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWNMACC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xf4005057, op: VFWNMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf4005057, op: VFWNMACC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWNMACC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xf4001057, op: VFWNMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf4001057, op: VFWNMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWNMSAC.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xfc005057, op: VFWNMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xfc005057, op: VFWNMSAC_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWNMSAC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xfc001057, op: VFWNMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xfc001057, op: VFWNMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWREDOSUM.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xcc001057, op: VFWREDOSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc001057, op: VFWREDOSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWREDUSUM.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc4001057, op: VFWREDUSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4001057, op: VFWREDUSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWSUB.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xc8005057, op: VFWSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8005057, op: VFWSUB_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc8001057, op: VFWSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8001057, op: VFWSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VFWSUB.WF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0xd8005057, op: VFWSUB_WF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd8005057, op: VFWSUB_WF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VFWSUB.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xd8001057, op: VFWSUB_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd8001057, op: VFWSUB_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VID.V vm, vd
-	{mask: 0xfdfff07f, value: 0x5008a057, op: VID_V, args: argTypeList{arg_vm, arg_vd}},
+	{mask: 0xfdfff07f, value: 0x5008a057, op: VID_V, args: argTypeList{arg_vm, arg_vd}, ext: ExtV},
 	// VIOTA.M vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x50082057, op: VIOTA_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x50082057, op: VIOTA_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VL1RE16.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x02805007, op: VL1RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x02805007, op: VL1RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL1RE32.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x02806007, op: VL1RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x02806007, op: VL1RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL1RE64.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x02807007, op: VL1RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x02807007, op: VL1RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL1RE8.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x02800007, op: VL1RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x02800007, op: VL1RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL2RE16.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x22805007, op: VL2RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x22805007, op: VL2RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL2RE32.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x22806007, op: VL2RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x22806007, op: VL2RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL2RE64.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x22807007, op: VL2RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x22807007, op: VL2RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL2RE8.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x22800007, op: VL2RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x22800007, op: VL2RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL4RE16.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x62805007, op: VL4RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x62805007, op: VL4RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL4RE32.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x62806007, op: VL4RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x62806007, op: VL4RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL4RE64.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x62807007, op: VL4RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x62807007, op: VL4RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL4RE8.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x62800007, op: VL4RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x62800007, op: VL4RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL8RE16.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0xe2805007, op: VL8RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0xe2805007, op: VL8RE16_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL8RE32.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0xe2806007, op: VL8RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0xe2806007, op: VL8RE32_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL8RE64.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0xe2807007, op: VL8RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0xe2807007, op: VL8RE64_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VL8RE8.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0xe2800007, op: VL8RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0xe2800007, op: VL8RE8_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x01005007, op: VLE16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x01005007, op: VLE16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x00005007, op: VLE16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x00005007, op: VLE16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x01006007, op: VLE32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x01006007, op: VLE32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x00006007, op: VLE32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x00006007, op: VLE32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x01007007, op: VLE64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x01007007, op: VLE64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x00007007, op: VLE64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x00007007, op: VLE64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x01000007, op: VLE8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x01000007, op: VLE8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLE8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x00000007, op: VLE8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x00000007, op: VLE8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLM.V rs1_ptr, vd
-	{mask: 0xfff0707f, value: 0x02b00007, op: VLM_V, args: argTypeList{arg_rs1_ptr, arg_vd}},
+	{mask: 0xfff0707f, value: 0x02b00007, op: VLM_V, args: argTypeList{arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXEI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x0c005007, op: VLOXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c005007, op: VLOXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXEI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x0c006007, op: VLOXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c006007, op: VLOXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXEI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x0c007007, op: VLOXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c007007, op: VLOXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXEI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x0c000007, op: VLOXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c000007, op: VLOXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG2EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x2c005007, op: VLOXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c005007, op: VLOXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG2EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x2c006007, op: VLOXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c006007, op: VLOXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG2EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x2c007007, op: VLOXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c007007, op: VLOXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG2EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x2c000007, op: VLOXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c000007, op: VLOXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG3EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x4c005007, op: VLOXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x4c005007, op: VLOXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG3EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x4c006007, op: VLOXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x4c006007, op: VLOXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG3EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x4c007007, op: VLOXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x4c007007, op: VLOXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG3EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x4c000007, op: VLOXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x4c000007, op: VLOXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG4EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x6c005007, op: VLOXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c005007, op: VLOXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG4EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x6c006007, op: VLOXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c006007, op: VLOXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG4EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x6c007007, op: VLOXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c007007, op: VLOXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG4EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x6c000007, op: VLOXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c000007, op: VLOXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG5EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x8c005007, op: VLOXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c005007, op: VLOXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG5EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x8c006007, op: VLOXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c006007, op: VLOXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG5EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x8c007007, op: VLOXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c007007, op: VLOXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG5EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x8c000007, op: VLOXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c000007, op: VLOXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG6EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xac005007, op: VLOXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac005007, op: VLOXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG6EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xac006007, op: VLOXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac006007, op: VLOXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG6EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xac007007, op: VLOXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac007007, op: VLOXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG6EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xac000007, op: VLOXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac000007, op: VLOXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG7EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xcc005007, op: VLOXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc005007, op: VLOXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG7EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xcc006007, op: VLOXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc006007, op: VLOXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG7EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xcc007007, op: VLOXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc007007, op: VLOXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG7EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xcc000007, op: VLOXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc000007, op: VLOXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG8EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xec005007, op: VLOXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xec005007, op: VLOXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG8EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xec006007, op: VLOXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xec006007, op: VLOXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG8EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xec007007, op: VLOXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xec007007, op: VLOXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLOXSEG8EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xec000007, op: VLOXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xec000007, op: VLOXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSE16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x08005007, op: VLSE16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08005007, op: VLSE16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSE32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x08006007, op: VLSE32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08006007, op: VLSE32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSE64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x08007007, op: VLSE64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08007007, op: VLSE64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSE8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x08000007, op: VLSE8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08000007, op: VLSE8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x21005007, op: VLSEG2E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x21005007, op: VLSEG2E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x20005007, op: VLSEG2E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x20005007, op: VLSEG2E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x21006007, op: VLSEG2E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x21006007, op: VLSEG2E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x20006007, op: VLSEG2E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x20006007, op: VLSEG2E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x21007007, op: VLSEG2E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x21007007, op: VLSEG2E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x20007007, op: VLSEG2E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x20007007, op: VLSEG2E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x21000007, op: VLSEG2E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x21000007, op: VLSEG2E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG2E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x20000007, op: VLSEG2E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x20000007, op: VLSEG2E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x41005007, op: VLSEG3E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x41005007, op: VLSEG3E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x40005007, op: VLSEG3E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x40005007, op: VLSEG3E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x41006007, op: VLSEG3E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x41006007, op: VLSEG3E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x40006007, op: VLSEG3E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x40006007, op: VLSEG3E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x41007007, op: VLSEG3E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x41007007, op: VLSEG3E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x40007007, op: VLSEG3E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x40007007, op: VLSEG3E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x41000007, op: VLSEG3E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x41000007, op: VLSEG3E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG3E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x40000007, op: VLSEG3E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x40000007, op: VLSEG3E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x61005007, op: VLSEG4E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x61005007, op: VLSEG4E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x60005007, op: VLSEG4E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x60005007, op: VLSEG4E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x61006007, op: VLSEG4E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x61006007, op: VLSEG4E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x60006007, op: VLSEG4E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x60006007, op: VLSEG4E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x61007007, op: VLSEG4E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x61007007, op: VLSEG4E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x60007007, op: VLSEG4E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x60007007, op: VLSEG4E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x61000007, op: VLSEG4E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x61000007, op: VLSEG4E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG4E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x60000007, op: VLSEG4E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x60000007, op: VLSEG4E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x81005007, op: VLSEG5E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x81005007, op: VLSEG5E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x80005007, op: VLSEG5E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x80005007, op: VLSEG5E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x81006007, op: VLSEG5E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x81006007, op: VLSEG5E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x80006007, op: VLSEG5E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x80006007, op: VLSEG5E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x81007007, op: VLSEG5E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x81007007, op: VLSEG5E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x80007007, op: VLSEG5E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x80007007, op: VLSEG5E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x81000007, op: VLSEG5E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x81000007, op: VLSEG5E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG5E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0x80000007, op: VLSEG5E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0x80000007, op: VLSEG5E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa1005007, op: VLSEG6E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa1005007, op: VLSEG6E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa0005007, op: VLSEG6E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa0005007, op: VLSEG6E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa1006007, op: VLSEG6E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa1006007, op: VLSEG6E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa0006007, op: VLSEG6E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa0006007, op: VLSEG6E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa1007007, op: VLSEG6E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa1007007, op: VLSEG6E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa0007007, op: VLSEG6E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa0007007, op: VLSEG6E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa1000007, op: VLSEG6E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa1000007, op: VLSEG6E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG6E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xa0000007, op: VLSEG6E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xa0000007, op: VLSEG6E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc1005007, op: VLSEG7E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc1005007, op: VLSEG7E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc0005007, op: VLSEG7E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc0005007, op: VLSEG7E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc1006007, op: VLSEG7E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc1006007, op: VLSEG7E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc0006007, op: VLSEG7E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc0006007, op: VLSEG7E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc1007007, op: VLSEG7E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc1007007, op: VLSEG7E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc0007007, op: VLSEG7E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc0007007, op: VLSEG7E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc1000007, op: VLSEG7E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc1000007, op: VLSEG7E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG7E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xc0000007, op: VLSEG7E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xc0000007, op: VLSEG7E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E16FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe1005007, op: VLSEG8E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe1005007, op: VLSEG8E16FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E16.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe0005007, op: VLSEG8E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe0005007, op: VLSEG8E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E32FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe1006007, op: VLSEG8E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe1006007, op: VLSEG8E32FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E32.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe0006007, op: VLSEG8E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe0006007, op: VLSEG8E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E64FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe1007007, op: VLSEG8E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe1007007, op: VLSEG8E64FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E64.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe0007007, op: VLSEG8E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe0007007, op: VLSEG8E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E8FF.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe1000007, op: VLSEG8E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe1000007, op: VLSEG8E8FF_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSEG8E8.V vm, rs1_ptr, vd
-	{mask: 0xfdf0707f, value: 0xe0000007, op: VLSEG8E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfdf0707f, value: 0xe0000007, op: VLSEG8E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG2E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x28005007, op: VLSSEG2E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28005007, op: VLSSEG2E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG2E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x28006007, op: VLSSEG2E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28006007, op: VLSSEG2E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG2E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x28007007, op: VLSSEG2E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28007007, op: VLSSEG2E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG2E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x28000007, op: VLSSEG2E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28000007, op: VLSSEG2E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG3E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x48005007, op: VLSSEG3E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x48005007, op: VLSSEG3E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG3E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x48006007, op: VLSSEG3E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x48006007, op: VLSSEG3E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG3E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x48007007, op: VLSSEG3E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x48007007, op: VLSSEG3E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG3E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x48000007, op: VLSSEG3E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x48000007, op: VLSSEG3E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG4E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x68005007, op: VLSSEG4E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x68005007, op: VLSSEG4E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG4E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x68006007, op: VLSSEG4E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x68006007, op: VLSSEG4E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG4E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x68007007, op: VLSSEG4E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x68007007, op: VLSSEG4E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG4E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x68000007, op: VLSSEG4E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x68000007, op: VLSSEG4E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG5E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x88005007, op: VLSSEG5E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88005007, op: VLSSEG5E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG5E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x88006007, op: VLSSEG5E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88006007, op: VLSSEG5E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG5E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x88007007, op: VLSSEG5E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88007007, op: VLSSEG5E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG5E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x88000007, op: VLSSEG5E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88000007, op: VLSSEG5E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG6E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa8005007, op: VLSSEG6E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8005007, op: VLSSEG6E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG6E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa8006007, op: VLSSEG6E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8006007, op: VLSSEG6E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG6E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa8007007, op: VLSSEG6E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8007007, op: VLSSEG6E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG6E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa8000007, op: VLSSEG6E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8000007, op: VLSSEG6E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG7E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc8005007, op: VLSSEG7E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8005007, op: VLSSEG7E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG7E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc8006007, op: VLSSEG7E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8006007, op: VLSSEG7E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG7E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc8007007, op: VLSSEG7E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8007007, op: VLSSEG7E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG7E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc8000007, op: VLSSEG7E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8000007, op: VLSSEG7E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG8E16.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe8005007, op: VLSSEG8E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe8005007, op: VLSSEG8E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG8E32.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe8006007, op: VLSSEG8E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe8006007, op: VLSSEG8E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG8E64.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe8007007, op: VLSSEG8E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe8007007, op: VLSSEG8E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLSSEG8E8.V vm, rs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe8000007, op: VLSSEG8E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe8000007, op: VLSSEG8E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXEI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x04005007, op: VLUXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x04005007, op: VLUXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXEI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x04006007, op: VLUXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x04006007, op: VLUXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXEI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x04007007, op: VLUXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x04007007, op: VLUXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXEI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x04000007, op: VLUXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x04000007, op: VLUXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG2EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x24005007, op: VLUXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24005007, op: VLUXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG2EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x24006007, op: VLUXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24006007, op: VLUXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG2EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x24007007, op: VLUXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24007007, op: VLUXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG2EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x24000007, op: VLUXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x24000007, op: VLUXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG3EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x44005007, op: VLUXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x44005007, op: VLUXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG3EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x44006007, op: VLUXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x44006007, op: VLUXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG3EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x44007007, op: VLUXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x44007007, op: VLUXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG3EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x44000007, op: VLUXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x44000007, op: VLUXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG4EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x64005007, op: VLUXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64005007, op: VLUXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG4EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x64006007, op: VLUXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64006007, op: VLUXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG4EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x64007007, op: VLUXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64007007, op: VLUXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG4EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x64000007, op: VLUXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64000007, op: VLUXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG5EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x84005007, op: VLUXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84005007, op: VLUXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG5EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x84006007, op: VLUXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84006007, op: VLUXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG5EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x84007007, op: VLUXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84007007, op: VLUXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG5EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0x84000007, op: VLUXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84000007, op: VLUXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG6EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa4005007, op: VLUXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4005007, op: VLUXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG6EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa4006007, op: VLUXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4006007, op: VLUXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG6EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa4007007, op: VLUXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4007007, op: VLUXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG6EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xa4000007, op: VLUXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4000007, op: VLUXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG7EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc4005007, op: VLUXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4005007, op: VLUXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG7EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc4006007, op: VLUXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4006007, op: VLUXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG7EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc4007007, op: VLUXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4007007, op: VLUXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG7EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xc4000007, op: VLUXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4000007, op: VLUXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG8EI16.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe4005007, op: VLUXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe4005007, op: VLUXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG8EI32.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe4006007, op: VLUXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe4006007, op: VLUXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG8EI64.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe4007007, op: VLUXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe4007007, op: VLUXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VLUXSEG8EI8.V vm, vs2, rs1_ptr, vd
-	{mask: 0xfc00707f, value: 0xe4000007, op: VLUXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe4000007, op: VLUXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vd}, ext: ExtV},
 	// VMACC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb4002057, op: VMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4002057, op: VMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMACC.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xb4006057, op: VMACC_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4006057, op: VMACC_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMADC.VI vs2, simm5, vd
-	{mask: 0xfe00707f, value: 0x46003057, op: VMADC_VI, args: argTypeList{arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfe00707f, value: 0x46003057, op: VMADC_VI, args: argTypeList{arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMADC.VIM vs2, simm5, vd
-	{mask: 0xfe00707f, value: 0x44003057, op: VMADC_VIM, args: argTypeList{arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfe00707f, value: 0x44003057, op: VMADC_VIM, args: argTypeList{arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMADC.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x46000057, op: VMADC_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x46000057, op: VMADC_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMADC.VVM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x44000057, op: VMADC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x44000057, op: VMADC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMADC.VX vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x46004057, op: VMADC_VX, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x46004057, op: VMADC_VX, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMADC.VXM vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x44004057, op: VMADC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x44004057, op: VMADC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa4002057, op: VMADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4002057, op: VMADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMADD.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xa4006057, op: VMADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4006057, op: VMADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMANDN.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x62002057, op: VMANDN_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x62002057, op: VMANDN_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMAND.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x66002057, op: VMAND_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x66002057, op: VMAND_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMAXU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x18000057, op: VMAXU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x18000057, op: VMAXU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMAXU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x18004057, op: VMAXU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x18004057, op: VMAXU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMAX.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x1c000057, op: VMAX_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x1c000057, op: VMAX_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMAX.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x1c004057, op: VMAX_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x1c004057, op: VMAX_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMERGE.VIM vs2, simm5, vd
-	{mask: 0xfe00707f, value: 0x5c003057, op: VMERGE_VIM, args: argTypeList{arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfe00707f, value: 0x5c003057, op: VMERGE_VIM, args: argTypeList{arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMERGE.VVM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x5c000057, op: VMERGE_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x5c000057, op: VMERGE_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMERGE.VXM vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x5c004057, op: VMERGE_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x5c004057, op: VMERGE_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMFEQ.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x60005057, op: VMFEQ_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x60005057, op: VMFEQ_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VMFEQ.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x60001057, op: VMFEQ_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x60001057, op: VMFEQ_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMFGE.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x7c005057, op: VMFGE_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x7c005057, op: VMFGE_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VMFGT.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x74005057, op: VMFGT_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x74005057, op: VMFGT_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VMFLE.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x64005057, op: VMFLE_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64005057, op: VMFLE_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VMFLE.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x64001057, op: VMFLE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64001057, op: VMFLE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMFLT.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x6c005057, op: VMFLT_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c005057, op: VMFLT_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VMFLT.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x6c001057, op: VMFLT_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c001057, op: VMFLT_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMFNE.VF vm, vs2, fs1, vd
-	{mask: 0xfc00707f, value: 0x70005057, op: VMFNE_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x70005057, op: VMFNE_VF, args: argTypeList{arg_vm, arg_vs2, arg_fs1, arg_vd}, ext: ExtV},
 	// VMFNE.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x70001057, op: VMFNE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x70001057, op: VMFNE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMINU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x10000057, op: VMINU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x10000057, op: VMINU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMINU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x10004057, op: VMINU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x10004057, op: VMINU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMIN.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x14000057, op: VMIN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x14000057, op: VMIN_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMIN.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x14004057, op: VMIN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x14004057, op: VMIN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMNAND.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x76002057, op: VMNAND_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x76002057, op: VMNAND_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMNOR.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x7a002057, op: VMNOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x7a002057, op: VMNOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMORN.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x72002057, op: VMORN_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x72002057, op: VMORN_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMOR.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x6a002057, op: VMOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x6a002057, op: VMOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSBC.VV vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x4e000057, op: VMSBC_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x4e000057, op: VMSBC_VV, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSBC.VVM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x4c000057, op: VMSBC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x4c000057, op: VMSBC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSBC.VX vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x4e004057, op: VMSBC_VX, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x4e004057, op: VMSBC_VX, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSBC.VXM vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x4c004057, op: VMSBC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x4c004057, op: VMSBC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSBF.M vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x5000a057, op: VMSBF_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x5000a057, op: VMSBF_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VMSEQ.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x60003057, op: VMSEQ_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x60003057, op: VMSEQ_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMSEQ.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x60000057, op: VMSEQ_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x60000057, op: VMSEQ_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSEQ.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x60004057, op: VMSEQ_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x60004057, op: VMSEQ_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSGTU.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x78003057, op: VMSGTU_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x78003057, op: VMSGTU_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMSGTU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x78004057, op: VMSGTU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x78004057, op: VMSGTU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSGT.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x7c003057, op: VMSGT_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x7c003057, op: VMSGT_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMSGT.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x7c004057, op: VMSGT_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x7c004057, op: VMSGT_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSIF.M vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x5001a057, op: VMSIF_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x5001a057, op: VMSIF_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VMSLEU.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x70003057, op: VMSLEU_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x70003057, op: VMSLEU_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMSLEU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x70000057, op: VMSLEU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x70000057, op: VMSLEU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSLEU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x70004057, op: VMSLEU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x70004057, op: VMSLEU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSLE.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x74003057, op: VMSLE_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x74003057, op: VMSLE_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMSLE.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x74000057, op: VMSLE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x74000057, op: VMSLE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSLE.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x74004057, op: VMSLE_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x74004057, op: VMSLE_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSLTU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x68000057, op: VMSLTU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x68000057, op: VMSLTU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSLTU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x68004057, op: VMSLTU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x68004057, op: VMSLTU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSLT.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x6c000057, op: VMSLT_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c000057, op: VMSLT_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSLT.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x6c004057, op: VMSLT_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x6c004057, op: VMSLT_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSNE.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x64003057, op: VMSNE_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64003057, op: VMSNE_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VMSNE.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x64000057, op: VMSNE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64000057, op: VMSNE_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMSNE.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x64004057, op: VMSNE_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x64004057, op: VMSNE_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMSOF.M vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x50012057, op: VMSOF_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x50012057, op: VMSOF_M, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VMULHSU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x98002057, op: VMULHSU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x98002057, op: VMULHSU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMULHSU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x98006057, op: VMULHSU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x98006057, op: VMULHSU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMULHU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x90002057, op: VMULHU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x90002057, op: VMULHU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMULHU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x90006057, op: VMULHU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x90006057, op: VMULHU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMULH.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x9c002057, op: VMULH_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x9c002057, op: VMULH_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMULH.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x9c006057, op: VMULH_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x9c006057, op: VMULH_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMUL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x94002057, op: VMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x94002057, op: VMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMUL.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x94006057, op: VMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x94006057, op: VMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VMV1R.V vs2, vd
-	{mask: 0xfe0ff07f, value: 0x9e003057, op: VMV1R_V, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0x9e003057, op: VMV1R_V, args: argTypeList{arg_vs2, arg_vd}, ext: ExtV},
 	// VMV2R.V vs2, vd
-	{mask: 0xfe0ff07f, value: 0x9e00b057, op: VMV2R_V, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0x9e00b057, op: VMV2R_V, args: argTypeList{arg_vs2, arg_vd}, ext: ExtV},
 	// VMV4R.V vs2, vd
-	{mask: 0xfe0ff07f, value: 0x9e01b057, op: VMV4R_V, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0x9e01b057, op: VMV4R_V, args: argTypeList{arg_vs2, arg_vd}, ext: ExtV},
 	// VMV8R.V vs2, vd
-	{mask: 0xfe0ff07f, value: 0x9e03b057, op: VMV8R_V, args: argTypeList{arg_vs2, arg_vd}},
+	{mask: 0xfe0ff07f, value: 0x9e03b057, op: VMV8R_V, args: argTypeList{arg_vs2, arg_vd}, ext: ExtV},
 	// VMV.S.X rs1, vd
-	{mask: 0xfff0707f, value: 0x42006057, op: VMV_S_X, args: argTypeList{arg_rs1, arg_vd}},
+	{mask: 0xfff0707f, value: 0x42006057, op: VMV_S_X, args: argTypeList{arg_rs1, arg_vd}, ext: ExtV},
 	// VMV.V.I simm5, vd
-	{mask: 0xfff0707f, value: 0x5e003057, op: VMV_V_I, args: argTypeList{arg_simm5, arg_vd}},
+	{mask: 0xfff0707f, value: 0x5e003057, op: VMV_V_I, args: argTypeList{arg_simm5, arg_vd}, ext: ExtV},
 	// VMV.V.V vs1, vd
-	{mask: 0xfff0707f, value: 0x5e000057, op: VMV_V_V, args: argTypeList{arg_vs1, arg_vd}},
+	{mask: 0xfff0707f, value: 0x5e000057, op: VMV_V_V, args: argTypeList{arg_vs1, arg_vd}, ext: ExtV},
 	// VMV.V.X rs1, vd
-	{mask: 0xfff0707f, value: 0x5e004057, op: VMV_V_X, args: argTypeList{arg_rs1, arg_vd}},
+	{mask: 0xfff0707f, value: 0x5e004057, op: VMV_V_X, args: argTypeList{arg_rs1, arg_vd}, ext: ExtV},
 	// VMV.X.S vs2, rd
-	{mask: 0xfe0ff07f, value: 0x42002057, op: VMV_X_S, args: argTypeList{arg_vs2, arg_rd}},
+	{mask: 0xfe0ff07f, value: 0x42002057, op: VMV_X_S, args: argTypeList{arg_vs2, arg_rd}, ext: ExtV},
 	// VMXNOR.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x7e002057, op: VMXNOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x7e002057, op: VMXNOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VMXOR.MM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x6e002057, op: VMXOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x6e002057, op: VMXOR_MM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNCLIPU.WI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xb8003057, op: VNCLIPU_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb8003057, op: VNCLIPU_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VNCLIPU.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb8000057, op: VNCLIPU_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb8000057, op: VNCLIPU_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNCLIPU.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xb8004057, op: VNCLIPU_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb8004057, op: VNCLIPU_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VNCLIP.WI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xbc003057, op: VNCLIP_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc003057, op: VNCLIP_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VNCLIP.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xbc000057, op: VNCLIP_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc000057, op: VNCLIP_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNCLIP.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xbc004057, op: VNCLIP_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc004057, op: VNCLIP_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VNMSAC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xbc002057, op: VNMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc002057, op: VNMSAC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNMSAC.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xbc006057, op: VNMSAC_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xbc006057, op: VNMSAC_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VNMSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xac002057, op: VNMSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac002057, op: VNMSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNMSUB.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xac006057, op: VNMSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac006057, op: VNMSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VNSRA.WI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xb4003057, op: VNSRA_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4003057, op: VNSRA_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VNSRA.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb4000057, op: VNSRA_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4000057, op: VNSRA_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNSRA.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xb4004057, op: VNSRA_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb4004057, op: VNSRA_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VNSRL.WI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xb0003057, op: VNSRL_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb0003057, op: VNSRL_WI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VNSRL.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xb0000057, op: VNSRL_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb0000057, op: VNSRL_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VNSRL.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xb0004057, op: VNSRL_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xb0004057, op: VNSRL_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VOR.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x28003057, op: VOR_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28003057, op: VOR_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VOR.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x28000057, op: VOR_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28000057, op: VOR_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VOR.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x28004057, op: VOR_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x28004057, op: VOR_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VREDAND.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x04002057, op: VREDAND_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x04002057, op: VREDAND_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDMAXU.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x18002057, op: VREDMAXU_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x18002057, op: VREDMAXU_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDMAX.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x1c002057, op: VREDMAX_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x1c002057, op: VREDMAX_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDMINU.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x10002057, op: VREDMINU_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x10002057, op: VREDMINU_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDMIN.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x14002057, op: VREDMIN_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x14002057, op: VREDMIN_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDOR.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x08002057, op: VREDOR_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08002057, op: VREDOR_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDSUM.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x00002057, op: VREDSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x00002057, op: VREDSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREDXOR.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x0c002057, op: VREDXOR_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c002057, op: VREDXOR_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREMU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x88002057, op: VREMU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88002057, op: VREMU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREMU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x88006057, op: VREMU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88006057, op: VREMU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VREM.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x8c002057, op: VREM_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c002057, op: VREM_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VREM.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x8c006057, op: VREM_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c006057, op: VREM_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
+	// VREV8.V vm, vs2, vd
+	{mask: 0xfc0ff07f, value: 0x4804a057, op: VREV8_V, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtZvkb},
 	// VRGATHEREI16.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x38000057, op: VRGATHEREI16_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x38000057, op: VRGATHEREI16_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VRGATHER.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0x30003057, op: VRGATHER_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x30003057, op: VRGATHER_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VRGATHER.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x30000057, op: VRGATHER_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x30000057, op: VRGATHER_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VRGATHER.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x30004057, op: VRGATHER_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x30004057, op: VRGATHER_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
+	// VROL.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0x54000057, op: VROL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvkb},
+	// VROL.VX vm, vs2, rs1, vd
+	{mask: 0xfc00707f, value: 0x54004057, op: VROL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtZvkb},
+	// VROR.VI vm, vs2, zimm6, vd
+	{mask: 0xf800707f, value: 0x50003057, op: VROR_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm6, arg_vd}, ext: ExtZvkb},
+	// VROR.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0x50000057, op: VROR_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvkb},
+	// VROR.VX vm, vs2, rs1, vd
+	{mask: 0xfc00707f, value: 0x50004057, op: VROR_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtZvkb},
 	// VRSUB.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x0c003057, op: VRSUB_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c003057, op: VRSUB_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VRSUB.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x0c004057, op: VRSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x0c004057, op: VRSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VS1R.V rs1_ptr, vs3
-	{mask: 0xfff0707f, value: 0x02800027, op: VS1R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfff0707f, value: 0x02800027, op: VS1R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VS2R.V rs1_ptr, vs3
-	{mask: 0xfff0707f, value: 0x22800027, op: VS2R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfff0707f, value: 0x22800027, op: VS2R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VS4R.V rs1_ptr, vs3
-	{mask: 0xfff0707f, value: 0x62800027, op: VS4R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfff0707f, value: 0x62800027, op: VS4R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VS8R.V rs1_ptr, vs3
-	{mask: 0xfff0707f, value: 0xe2800027, op: VS8R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfff0707f, value: 0xe2800027, op: VS8R_V, args: argTypeList{arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSADDU.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x80003057, op: VSADDU_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80003057, op: VSADDU_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VSADDU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x80000057, op: VSADDU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80000057, op: VSADDU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSADDU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x80004057, op: VSADDU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x80004057, op: VSADDU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSADD.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x84003057, op: VSADD_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84003057, op: VSADD_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VSADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x84000057, op: VSADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84000057, op: VSADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSADD.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x84004057, op: VSADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x84004057, op: VSADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSBC.VVM vs2, vs1, vd
-	{mask: 0xfe00707f, value: 0x48000057, op: VSBC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x48000057, op: VSBC_VVM, args: argTypeList{arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSBC.VXM vs2, rs1, vd
-	{mask: 0xfe00707f, value: 0x48004057, op: VSBC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfe00707f, value: 0x48004057, op: VSBC_VXM, args: argTypeList{arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSE16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x00005027, op: VSE16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x00005027, op: VSE16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSE32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x00006027, op: VSE32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x00006027, op: VSE32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSE64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x00007027, op: VSE64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x00007027, op: VSE64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSE8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x00000027, op: VSE8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x00000027, op: VSE8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSETIVLI vtype_zimm10, zimm, rd
-	{mask: 0xc000707f, value: 0xc0007057, op: VSETIVLI, args: argTypeList{arg_vtype_zimm10, arg_zimm, arg_rd}},
+	{mask: 0xc000707f, value: 0xc0007057, op: VSETIVLI, args: argTypeList{arg_vtype_zimm10, arg_zimm, arg_rd}, ext: ExtV},
 	// VSETVL rs2, rs1, rd
-	{mask: 0xfe00707f, value: 0x80007057, op: VSETVL, args: argTypeList{arg_rs2, arg_rs1, arg_rd}},
+	{mask: 0xfe00707f, value: 0x80007057, op: VSETVL, args: argTypeList{arg_rs2, arg_rs1, arg_rd}, ext: ExtV},
 	// VSETVLI vtype_zimm11, rs1, rd
-	{mask: 0x8000707f, value: 0x00007057, op: VSETVLI, args: argTypeList{arg_vtype_zimm11, arg_rs1, arg_rd}},
+	{mask: 0x8000707f, value: 0x00007057, op: VSETVLI, args: argTypeList{arg_vtype_zimm11, arg_rs1, arg_rd}, ext: ExtV},
 	// VSEXT.VF2 vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4803a057, op: VSEXT_VF2, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4803a057, op: VSEXT_VF2, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VSEXT.VF4 vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4802a057, op: VSEXT_VF4, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4802a057, op: VSEXT_VF4, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VSEXT.VF8 vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x4801a057, op: VSEXT_VF8, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x4801a057, op: VSEXT_VF8, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VSLIDE1DOWN.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x3c006057, op: VSLIDE1DOWN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x3c006057, op: VSLIDE1DOWN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSLIDE1UP.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x38006057, op: VSLIDE1UP_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x38006057, op: VSLIDE1UP_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSLIDEDOWN.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0x3c003057, op: VSLIDEDOWN_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x3c003057, op: VSLIDEDOWN_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSLIDEDOWN.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x3c004057, op: VSLIDEDOWN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x3c004057, op: VSLIDEDOWN_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSLIDEUP.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0x38003057, op: VSLIDEUP_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x38003057, op: VSLIDEUP_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSLIDEUP.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x38004057, op: VSLIDEUP_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x38004057, op: VSLIDEUP_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSLL.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0x94003057, op: VSLL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x94003057, op: VSLL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSLL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x94000057, op: VSLL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x94000057, op: VSLL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSLL.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x94004057, op: VSLL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x94004057, op: VSLL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSMUL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x9c000057, op: VSMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x9c000057, op: VSMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSMUL.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x9c004057, op: VSMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x9c004057, op: VSMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSM.V rs1_ptr, vs3
-	{mask: 0xfff0707f, value: 0x02b00027, op: VSM_V, args: argTypeList{arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfff0707f, value: 0x02b00027, op: VSM_V, args: argTypeList{arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXEI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x0c005027, op: VSOXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x0c005027, op: VSOXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXEI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x0c006027, op: VSOXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x0c006027, op: VSOXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXEI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x0c007027, op: VSOXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x0c007027, op: VSOXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXEI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x0c000027, op: VSOXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x0c000027, op: VSOXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG2EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x2c005027, op: VSOXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x2c005027, op: VSOXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG2EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x2c006027, op: VSOXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x2c006027, op: VSOXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG2EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x2c007027, op: VSOXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x2c007027, op: VSOXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG2EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x2c000027, op: VSOXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x2c000027, op: VSOXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG3EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x4c005027, op: VSOXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x4c005027, op: VSOXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG3EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x4c006027, op: VSOXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x4c006027, op: VSOXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG3EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x4c007027, op: VSOXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x4c007027, op: VSOXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG3EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x4c000027, op: VSOXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x4c000027, op: VSOXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG4EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x6c005027, op: VSOXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x6c005027, op: VSOXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG4EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x6c006027, op: VSOXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x6c006027, op: VSOXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG4EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x6c007027, op: VSOXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x6c007027, op: VSOXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG4EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x6c000027, op: VSOXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x6c000027, op: VSOXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG5EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x8c005027, op: VSOXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x8c005027, op: VSOXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG5EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x8c006027, op: VSOXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x8c006027, op: VSOXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG5EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x8c007027, op: VSOXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x8c007027, op: VSOXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG5EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x8c000027, op: VSOXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x8c000027, op: VSOXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG6EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xac005027, op: VSOXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xac005027, op: VSOXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG6EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xac006027, op: VSOXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xac006027, op: VSOXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG6EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xac007027, op: VSOXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xac007027, op: VSOXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG6EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xac000027, op: VSOXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xac000027, op: VSOXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG7EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xcc005027, op: VSOXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xcc005027, op: VSOXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG7EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xcc006027, op: VSOXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xcc006027, op: VSOXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG7EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xcc007027, op: VSOXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xcc007027, op: VSOXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG7EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xcc000027, op: VSOXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xcc000027, op: VSOXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG8EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xec005027, op: VSOXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xec005027, op: VSOXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG8EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xec006027, op: VSOXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xec006027, op: VSOXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG8EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xec007027, op: VSOXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xec007027, op: VSOXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSOXSEG8EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xec000027, op: VSOXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xec000027, op: VSOXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSRA.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xa4003057, op: VSRA_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4003057, op: VSRA_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSRA.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa4000057, op: VSRA_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4000057, op: VSRA_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSRA.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xa4004057, op: VSRA_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa4004057, op: VSRA_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSRL.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xa0003057, op: VSRL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa0003057, op: VSRL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSRL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa0000057, op: VSRL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa0000057, op: VSRL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSRL.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xa0004057, op: VSRL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa0004057, op: VSRL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSSE16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x08005027, op: VSSE16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x08005027, op: VSSE16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSE32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x08006027, op: VSSE32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x08006027, op: VSSE32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSE64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x08007027, op: VSSE64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x08007027, op: VSSE64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSE8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x08000027, op: VSSE8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x08000027, op: VSSE8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG2E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x20005027, op: VSSEG2E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x20005027, op: VSSEG2E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG2E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x20006027, op: VSSEG2E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x20006027, op: VSSEG2E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG2E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x20007027, op: VSSEG2E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x20007027, op: VSSEG2E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG2E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x20000027, op: VSSEG2E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x20000027, op: VSSEG2E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG3E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x40005027, op: VSSEG3E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x40005027, op: VSSEG3E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG3E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x40006027, op: VSSEG3E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x40006027, op: VSSEG3E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG3E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x40007027, op: VSSEG3E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x40007027, op: VSSEG3E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG3E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x40000027, op: VSSEG3E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x40000027, op: VSSEG3E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG4E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x60005027, op: VSSEG4E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x60005027, op: VSSEG4E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG4E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x60006027, op: VSSEG4E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x60006027, op: VSSEG4E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG4E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x60007027, op: VSSEG4E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x60007027, op: VSSEG4E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG4E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x60000027, op: VSSEG4E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x60000027, op: VSSEG4E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG5E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x80005027, op: VSSEG5E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x80005027, op: VSSEG5E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG5E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x80006027, op: VSSEG5E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x80006027, op: VSSEG5E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG5E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x80007027, op: VSSEG5E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x80007027, op: VSSEG5E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG5E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0x80000027, op: VSSEG5E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0x80000027, op: VSSEG5E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG6E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xa0005027, op: VSSEG6E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xa0005027, op: VSSEG6E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG6E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xa0006027, op: VSSEG6E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xa0006027, op: VSSEG6E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG6E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xa0007027, op: VSSEG6E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xa0007027, op: VSSEG6E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG6E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xa0000027, op: VSSEG6E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xa0000027, op: VSSEG6E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG7E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xc0005027, op: VSSEG7E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xc0005027, op: VSSEG7E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG7E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xc0006027, op: VSSEG7E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xc0006027, op: VSSEG7E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG7E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xc0007027, op: VSSEG7E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xc0007027, op: VSSEG7E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG7E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xc0000027, op: VSSEG7E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xc0000027, op: VSSEG7E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG8E16.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xe0005027, op: VSSEG8E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xe0005027, op: VSSEG8E16_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG8E32.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xe0006027, op: VSSEG8E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xe0006027, op: VSSEG8E32_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG8E64.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xe0007027, op: VSSEG8E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xe0007027, op: VSSEG8E64_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSEG8E8.V vm, rs1_ptr, vs3
-	{mask: 0xfdf0707f, value: 0xe0000027, op: VSSEG8E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfdf0707f, value: 0xe0000027, op: VSSEG8E8_V, args: argTypeList{arg_vm, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSRA.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xac003057, op: VSSRA_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac003057, op: VSSRA_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSSRA.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xac000057, op: VSSRA_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac000057, op: VSSRA_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSSRA.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xac004057, op: VSSRA_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xac004057, op: VSSRA_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSSRL.VI vm, vs2, zimm5, vd
-	{mask: 0xfc00707f, value: 0xa8003057, op: VSSRL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8003057, op: VSSRL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm5, arg_vd}, ext: ExtV},
 	// VSSRL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xa8000057, op: VSSRL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8000057, op: VSSRL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSSRL.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xa8004057, op: VSSRL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xa8004057, op: VSSRL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSSSEG2E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x28005027, op: VSSSEG2E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x28005027, op: VSSSEG2E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG2E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x28006027, op: VSSSEG2E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x28006027, op: VSSSEG2E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG2E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x28007027, op: VSSSEG2E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x28007027, op: VSSSEG2E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG2E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x28000027, op: VSSSEG2E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x28000027, op: VSSSEG2E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG3E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x48005027, op: VSSSEG3E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x48005027, op: VSSSEG3E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG3E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x48006027, op: VSSSEG3E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x48006027, op: VSSSEG3E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG3E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x48007027, op: VSSSEG3E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x48007027, op: VSSSEG3E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG3E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x48000027, op: VSSSEG3E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x48000027, op: VSSSEG3E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG4E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x68005027, op: VSSSEG4E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x68005027, op: VSSSEG4E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG4E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x68006027, op: VSSSEG4E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x68006027, op: VSSSEG4E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG4E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x68007027, op: VSSSEG4E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x68007027, op: VSSSEG4E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG4E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x68000027, op: VSSSEG4E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x68000027, op: VSSSEG4E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG5E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x88005027, op: VSSSEG5E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x88005027, op: VSSSEG5E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG5E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x88006027, op: VSSSEG5E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x88006027, op: VSSSEG5E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG5E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x88007027, op: VSSSEG5E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x88007027, op: VSSSEG5E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG5E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x88000027, op: VSSSEG5E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x88000027, op: VSSSEG5E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG6E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa8005027, op: VSSSEG6E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa8005027, op: VSSSEG6E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG6E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa8006027, op: VSSSEG6E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa8006027, op: VSSSEG6E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG6E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa8007027, op: VSSSEG6E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa8007027, op: VSSSEG6E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG6E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa8000027, op: VSSSEG6E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa8000027, op: VSSSEG6E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG7E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc8005027, op: VSSSEG7E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc8005027, op: VSSSEG7E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG7E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc8006027, op: VSSSEG7E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc8006027, op: VSSSEG7E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG7E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc8007027, op: VSSSEG7E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc8007027, op: VSSSEG7E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG7E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc8000027, op: VSSSEG7E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc8000027, op: VSSSEG7E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG8E16.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe8005027, op: VSSSEG8E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe8005027, op: VSSSEG8E16_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG8E32.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe8006027, op: VSSSEG8E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe8006027, op: VSSSEG8E32_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG8E64.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe8007027, op: VSSSEG8E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe8007027, op: VSSSEG8E64_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSSEG8E8.V vm, rs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe8000027, op: VSSSEG8E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe8000027, op: VSSSEG8E8_V, args: argTypeList{arg_vm, arg_rs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSSUBU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x88000057, op: VSSUBU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88000057, op: VSSUBU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSSUBU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x88004057, op: VSSUBU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x88004057, op: VSSUBU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x8c000057, op: VSSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c000057, op: VSSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSSUB.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x8c004057, op: VSSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x8c004057, op: VSSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x08000057, op: VSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08000057, op: VSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VSUB.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x08004057, op: VSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x08004057, op: VSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VSUXEI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x04005027, op: VSUXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x04005027, op: VSUXEI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXEI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x04006027, op: VSUXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x04006027, op: VSUXEI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXEI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x04007027, op: VSUXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x04007027, op: VSUXEI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXEI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x04000027, op: VSUXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x04000027, op: VSUXEI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG2EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x24005027, op: VSUXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x24005027, op: VSUXSEG2EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG2EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x24006027, op: VSUXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x24006027, op: VSUXSEG2EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG2EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x24007027, op: VSUXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x24007027, op: VSUXSEG2EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG2EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x24000027, op: VSUXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x24000027, op: VSUXSEG2EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG3EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x44005027, op: VSUXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x44005027, op: VSUXSEG3EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG3EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x44006027, op: VSUXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x44006027, op: VSUXSEG3EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG3EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x44007027, op: VSUXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x44007027, op: VSUXSEG3EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG3EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x44000027, op: VSUXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x44000027, op: VSUXSEG3EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG4EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x64005027, op: VSUXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x64005027, op: VSUXSEG4EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG4EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x64006027, op: VSUXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x64006027, op: VSUXSEG4EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG4EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x64007027, op: VSUXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x64007027, op: VSUXSEG4EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG4EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x64000027, op: VSUXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x64000027, op: VSUXSEG4EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG5EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x84005027, op: VSUXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x84005027, op: VSUXSEG5EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG5EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x84006027, op: VSUXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x84006027, op: VSUXSEG5EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG5EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x84007027, op: VSUXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x84007027, op: VSUXSEG5EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG5EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0x84000027, op: VSUXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0x84000027, op: VSUXSEG5EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG6EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa4005027, op: VSUXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa4005027, op: VSUXSEG6EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG6EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa4006027, op: VSUXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa4006027, op: VSUXSEG6EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG6EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa4007027, op: VSUXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa4007027, op: VSUXSEG6EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG6EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xa4000027, op: VSUXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xa4000027, op: VSUXSEG6EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG7EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc4005027, op: VSUXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc4005027, op: VSUXSEG7EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG7EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc4006027, op: VSUXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc4006027, op: VSUXSEG7EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG7EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc4007027, op: VSUXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc4007027, op: VSUXSEG7EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG7EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xc4000027, op: VSUXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xc4000027, op: VSUXSEG7EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG8EI16.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe4005027, op: VSUXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe4005027, op: VSUXSEG8EI16_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG8EI32.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe4006027, op: VSUXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe4006027, op: VSUXSEG8EI32_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG8EI64.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe4007027, op: VSUXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe4007027, op: VSUXSEG8EI64_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VSUXSEG8EI8.V vm, vs2, rs1_ptr, vs3
-	{mask: 0xfc00707f, value: 0xe4000027, op: VSUXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}},
+	{mask: 0xfc00707f, value: 0xe4000027, op: VSUXSEG8EI8_V, args: argTypeList{arg_vm, arg_vs2, arg_rs1_ptr, arg_vs3}, ext: ExtV},
 	// VWADDU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc0002057, op: VWADDU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc0002057, op: VWADDU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWADDU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xc0006057, op: VWADDU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc0006057, op: VWADDU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWADDU.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xd0002057, op: VWADDU_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd0002057, op: VWADDU_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWADDU.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xd0006057, op: VWADDU_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd0006057, op: VWADDU_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWADD.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc4002057, op: VWADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4002057, op: VWADD_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWADD.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xc4006057, op: VWADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4006057, op: VWADD_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWADD.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xd4002057, op: VWADD_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd4002057, op: VWADD_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWADD.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xd4006057, op: VWADD_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd4006057, op: VWADD_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMACCSU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xfc002057, op: VWMACCSU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xfc002057, op: VWMACCSU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWMACCSU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xfc006057, op: VWMACCSU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xfc006057, op: VWMACCSU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMACCUS.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xf8006057, op: VWMACCUS_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf8006057, op: VWMACCUS_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMACCU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xf0002057, op: VWMACCU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf0002057, op: VWMACCU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWMACCU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xf0006057, op: VWMACCU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf0006057, op: VWMACCU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMACC.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xf4002057, op: VWMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf4002057, op: VWMACC_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWMACC.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xf4006057, op: VWMACC_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xf4006057, op: VWMACC_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMULSU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xe8002057, op: VWMULSU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe8002057, op: VWMULSU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWMULSU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xe8006057, op: VWMULSU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe8006057, op: VWMULSU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMULU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xe0002057, op: VWMULU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe0002057, op: VWMULU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWMULU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xe0006057, op: VWMULU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xe0006057, op: VWMULU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWMUL.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xec002057, op: VWMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xec002057, op: VWMUL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWMUL.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xec006057, op: VWMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xec006057, op: VWMUL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWREDSUMU.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc0000057, op: VWREDSUMU_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc0000057, op: VWREDSUMU_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWREDSUM.VS vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc4000057, op: VWREDSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc4000057, op: VWREDSUM_VS, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
+	// VWSLL.VI vm, vs2, zimm, vd
+	{mask: 0xfc00707f, value: 0xd4003057, op: VWSLL_VI, args: argTypeList{arg_vm, arg_vs2, arg_zimm, arg_vd}, ext: ExtZvbb},
+	// VWSLL.VV vm, vs2, vs1, vd
+	{mask: 0xfc00707f, value: 0xd4000057, op: VWSLL_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtZvbb},
+	// VWSLL.VX vm, vs2, rs1, vd
+	{mask: 0xfc00707f, value: 0xd4004057, op: VWSLL_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtZvbb},
 	// VWSUBU.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xc8002057, op: VWSUBU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8002057, op: VWSUBU_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWSUBU.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xc8006057, op: VWSUBU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xc8006057, op: VWSUBU_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWSUBU.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xd8002057, op: VWSUBU_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd8002057, op: VWSUBU_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWSUBU.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xd8006057, op: VWSUBU_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xd8006057, op: VWSUBU_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWSUB.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xcc002057, op: VWSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc002057, op: VWSUB_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWSUB.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xcc006057, op: VWSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xcc006057, op: VWSUB_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VWSUB.WV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0xdc002057, op: VWSUB_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xdc002057, op: VWSUB_WV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VWSUB.WX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0xdc006057, op: VWSUB_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0xdc006057, op: VWSUB_WX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VXOR.VI vm, vs2, simm5, vd
-	{mask: 0xfc00707f, value: 0x2c003057, op: VXOR_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c003057, op: VXOR_VI, args: argTypeList{arg_vm, arg_vs2, arg_simm5, arg_vd}, ext: ExtV},
 	// VXOR.VV vm, vs2, vs1, vd
-	{mask: 0xfc00707f, value: 0x2c000057, op: VXOR_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c000057, op: VXOR_VV, args: argTypeList{arg_vm, arg_vs2, arg_vs1, arg_vd}, ext: ExtV},
 	// VXOR.VX vm, vs2, rs1, vd
-	{mask: 0xfc00707f, value: 0x2c004057, op: VXOR_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}},
+	{mask: 0xfc00707f, value: 0x2c004057, op: VXOR_VX, args: argTypeList{arg_vm, arg_vs2, arg_rs1, arg_vd}, ext: ExtV},
 	// VZEXT.VF2 vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48032057, op: VZEXT_VF2, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48032057, op: VZEXT_VF2, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VZEXT.VF4 vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48022057, op: VZEXT_VF4, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48022057, op: VZEXT_VF4, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// VZEXT.VF8 vm, vs2, vd
-	{mask: 0xfc0ff07f, value: 0x48012057, op: VZEXT_VF8, args: argTypeList{arg_vm, arg_vs2, arg_vd}},
+	{mask: 0xfc0ff07f, value: 0x48012057, op: VZEXT_VF8, args: argTypeList{arg_vm, arg_vs2, arg_vd}, ext: ExtV},
 	// XNOR rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x40004033, op: XNOR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x40004033, op: XNOR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtZbb},
 	// XOR rd, rs1, rs2
-	{mask: 0xfe00707f, value: 0x00004033, op: XOR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}},
+	{mask: 0xfe00707f, value: 0x00004033, op: XOR, args: argTypeList{arg_rd, arg_rs1, arg_rs2}, ext: ExtI},
 	// XORI rd, rs1, imm12
-	{mask: 0x0000707f, value: 0x00004013, op: XORI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}},
+	{mask: 0x0000707f, value: 0x00004013, op: XORI, args: argTypeList{arg_rd, arg_rs1, arg_imm12}, ext: ExtI},
 	// ZEXT.H rd, rs1
-	{mask: 0xfff0707f, value: 0x0800403b, op: ZEXT_H, args: argTypeList{arg_rd, arg_rs1}},
+	{mask: 0xfff0707f, value: 0x0800403b, op: ZEXT_H, args: argTypeList{arg_rd, arg_rs1}, ext: ExtZbb},
 }
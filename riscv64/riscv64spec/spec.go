@@ -27,20 +27,37 @@ var extensions = []string{
 	"rv_c",
 	"rv_c_d",
 	"rv_d",
+	"rv_d_zfa",
+	"rv_d_zfh",
 	"rv_f",
 	"rv_i",
 	"rv_m",
 	"rv_q",
+	"rv_q_zfa",
+	"rv_q_zfh",
 	"rv_v",
+	"rv_zabha",
+	"rv_zacas",
 	"rv_zba",
 	"rv_zbb",
 	"rv_zbc",
 	"rv_zbs",
+	"rv_zcb",
+	"rv_zcmp",
+	"rv_zcmt",
 	"rv_zicbo",
+	"rv_zfa",
+	"rv_zfbfmin",
 	"rv_zfh",
+	"rv_zfh_zfa",
 	"rv_zicond",
 	"rv_zicsr",
 	"rv_zifencei",
+	"rv_zvbb",
+	"rv_zvbc",
+	"rv_zvfbfmin",
+	"rv_zvfbfwma",
+	"rv_zvkb",
 	"rv_zvkg",
 	"rv_zvkned",
 	"rv_zvknha",
@@ -53,9 +70,12 @@ var extensions = []string{
 	"rv64_i",
 	"rv64_m",
 	"rv64_q",
+	"rv64_q_zfa",
+	"rv64_zacas",
 	"rv64_zba",
 	"rv64_zbb",
 	"rv64_zbs",
+	"rv64_zcb",
 	"rv64_zfh",
 }
 
@@ -111,21 +131,22 @@ func main() {
 				continue
 			}
 
-			// skip $pseudo_op except rv_zbb/rv64_zbb
+			// skip $pseudo_op except rv_zbb/rv64_zbb and rv_zcmt,
+			// where cm.jt and cm.jalt share one encoding
 			if words[0][0] == '$' {
-				if ext != "rv_zbb" && ext != "rv64_zbb" {
+				if ext != "rv_zbb" && ext != "rv64_zbb" && ext != "rv_zcmt" {
 					continue
 				}
 				words = words[2:]
 			}
 
-			genInst(words)
+			genInst(words, ext)
 		}
 	}
 
 	// c.unimp wasn't in riscv-opcodes, so add it there
 	c_unimp := "c.unimp 15..0=0"
-	genInst(strings.Fields(c_unimp))
+	genInst(strings.Fields(c_unimp), "rv_c")
 
 	sort.Strings(ops)
 
@@ -177,8 +198,9 @@ func main() {
 	}
 }
 
-func genInst(words []string) {
+func genInst(words []string, ext string) {
 	op := strings.ToUpper(strings.Replace(words[0], ".", "_", -1))
+	instExt := extExpr(op, ext)
 	opstr := fmt.Sprintf("%s:\t\"%s\",", op, strings.ToUpper(words[0]))
 
 	var value uint32
@@ -207,7 +229,7 @@ func genInst(words []string) {
 
 	instArgsStr := inferFormats(argTypeList, op)
 	instFormatComment := "// " + strings.Replace(op, "_", ".", -1) + " " + strings.Replace(instArgsStr, "arg_", "", -1)
-	instFormat := fmt.Sprintf("{mask: %#08x, value: %#08x, op: %s, args: argTypeList{%s}},", mask, value, op, instArgsStr)
+	instFormat := fmt.Sprintf("{mask: %#08x, value: %#08x, op: %s, args: argTypeList{%s}, ext: %s},", mask, value, op, instArgsStr, instExt)
 
 	// Handle the suffix of atomic instruction.
 	if isAtomic(op) {
@@ -219,7 +241,7 @@ func genInst(words []string) {
 			avalue := value | (uint32(i) << 25)
 			amask := mask | 0x06000000
 			ainstFormatComment := "// " + strings.Replace(aop, "_", ".", -1) + " " + strings.Replace(instArgsStr, "arg_", "", -1)
-			ainstFormat := fmt.Sprintf("{mask: %#08x, value: %#08x, op: %s, args: argTypeList{%s}},", amask, avalue, aop, instArgsStr)
+			ainstFormat := fmt.Sprintf("{mask: %#08x, value: %#08x, op: %s, args: argTypeList{%s}, ext: %s},", amask, avalue, aop, instArgsStr, instExt)
 			ops = append(ops, aop)
 			opstrs[aop] = aopstr
 			instFormats[aop] = ainstFormat
@@ -254,7 +276,7 @@ func genInst(words []string) {
 		segOpStr := fmt.Sprintf("%s:\t\"%s\",", segOp, segName)
 		segValue := value | (i-1)<<29
 		instFormatComment := "// " + segName + " " + strings.Replace(instArgsStr, "arg_", "", -1)
-		instFormat := fmt.Sprintf("{mask: %#08x, value: %#08x, op: %s, args: argTypeList{%s}},", mask, segValue, segOp, instArgsStr)
+		instFormat := fmt.Sprintf("{mask: %#08x, value: %#08x, op: %s, args: argTypeList{%s}, ext: %s},", mask, segValue, segOp, instArgsStr, instExt)
 		ops = append(ops, segOp)
 		opstrs[segOp] = segOpStr
 		instFormats[segOp] = instFormat
@@ -262,6 +284,39 @@ func genInst(words []string) {
 	}
 }
 
+// extOverrides lists the instructions that riscv-opcodes files under a
+// larger extension than the smallest one that provides them.
+var extOverrides = map[string]string{
+	"CBO_CLEAN": "ExtZicbom",
+	"CBO_FLUSH": "ExtZicbom",
+	"CBO_INVAL": "ExtZicbom",
+	"CBO_ZERO":  "ExtZicboz",
+	"FCVT_D_H":  "ExtD | ExtZfhmin",
+	"FCVT_H_D":  "ExtD | ExtZfhmin",
+	"FCVT_H_Q":  "ExtQ | ExtZfhmin",
+	"FCVT_H_S":  "ExtZfhmin",
+	"FCVT_Q_H":  "ExtQ | ExtZfhmin",
+	"FCVT_S_H":  "ExtZfhmin",
+	"FLH":       "ExtZfhmin",
+	"FMV_H_X":   "ExtZfhmin",
+	"FMV_X_H":   "ExtZfhmin",
+	"FSH":       "ExtZfhmin",
+}
+
+// extExpr returns the riscv64asm Ext expression for op, which is listed
+// in the riscv-opcodes file ext. A file such as rv_d_zfa holds the
+// instructions that need every extension in its name.
+func extExpr(op, ext string) string {
+	if e, ok := extOverrides[op]; ok {
+		return e
+	}
+	var exts []string
+	for _, e := range strings.Split(ext, "_")[1:] {
+		exts = append(exts, "Ext"+strings.ToUpper(e[:1])+e[1:])
+	}
+	return strings.Join(exts, " | ")
+}
+
 func isVectorLoadStore(op string) bool {
 	if op == "VSM_V" || op == "VLM_V" {
 		return true
@@ -325,6 +380,9 @@ func inferFormats(argTypeList []string, op string) string {
 	case op == "FENCE_I":
 		return ""
 
+	case strings.HasPrefix(op, "FLI_"):
+		return "arg_fd, arg_fli"
+
 	case op == "FENCE":
 		return "arg_pred, arg_succ"
 
@@ -414,6 +472,10 @@ func decodeArgs(arg string, op string) string {
 	case arg == "zimm5":
 		return "arg_zimm"
 
+	case arg == "zimm6lo":
+		// zimm6hi is folded into arg_zimm6.
+		return "arg_zimm6"
+
 	case arg == "zimm10":
 		return "arg_vtype_zimm10"
 
@@ -506,6 +568,27 @@ func decodeArgs(arg string, op string) string {
 
 	case arg == "c_nzimm18lo":
 		return "arg_c_nzimm18"
+
+	case arg == "c_uimm1":
+		return "arg_c_uimm1"
+
+	case arg == "c_uimm2":
+		return "arg_c_uimm2"
+
+	case arg == "c_rlist":
+		return "arg_c_rlist"
+
+	case arg == "c_spimm":
+		return "arg_c_spimm"
+
+	case arg == "c_sreg1":
+		return "arg_c_sreg1"
+
+	case arg == "c_sreg2":
+		return "arg_c_sreg2"
+
+	case arg == "c_index":
+		return "arg_c_index"
 	}
 	return ""
 }
@@ -554,6 +637,10 @@ func isFloatReg(op string, reg string) bool {
 		strings.Contains(op, "FCVT_D_Q") || strings.Contains(op, "FCVT_Q_D") ||
 		strings.Contains(op, "FCVT_S_Q") || strings.Contains(op, "FCVT_Q_S") ||
 		strings.Contains(op, "FCVT_H_S") || strings.Contains(op, "FCVT_S_H") ||
+		strings.Contains(op, "FCVT_D_H") || strings.Contains(op, "FCVT_H_D") ||
+		strings.Contains(op, "FCVT_Q_H") || strings.Contains(op, "FCVT_H_Q") ||
+		strings.Contains(op, "FCVT_BF16_S") || strings.Contains(op, "FCVT_S_BF16") ||
+		strings.Contains(op, "FROUND") ||
 		strings.Contains(op, "FNM") || strings.Contains(op, "FNEG") ||
 		strings.Contains(op, "FSQRT") || strings.Contains(op, "FSGNJ") ||
 		strings.Contains(op, "VFRSUB") || strings.Contains(op, "VFRSUB") ||
@@ -572,13 +659,14 @@ func isFloatReg(op string, reg string) bool {
 		strings.Contains(op, "FCVT_W") || strings.Contains(op, "FEQ") ||
 		strings.Contains(op, "FLE") || strings.Contains(op, "FLT") ||
 		strings.Contains(op, "FMV_X_H") || strings.Contains(op, "FMV_X_D") ||
-		strings.Contains(op, "FMV_X_W"):
+		strings.Contains(op, "FMV_X_W") || strings.Contains(op, "FMVH_X") ||
+		strings.Contains(op, "FCVTMOD_W"):
 		return reg != "rd"
 
 	case strings.Contains(op, "FCVT_D") || strings.Contains(op, "FCVT_S") ||
 		strings.Contains(op, "FCVT_H") || strings.Contains(op, "FCVT_Q") ||
 		strings.Contains(op, "FMV_H_X") || strings.Contains(op, "FMV_D_X") ||
-		strings.Contains(op, "FMV_W_X"):
+		strings.Contains(op, "FMV_W_X") || strings.Contains(op, "FMVP"):
 		return reg != "rs"
 
 	default:
@@ -79,3 +79,38 @@ func decode(data []byte, t *testing.T, filename string) {
 		}
 	}
 }
+
+func TestOpArch(t *testing.T) {
+	tests := []struct {
+		op   Op
+		arch Arch
+	}{
+		{A, Arch5},
+		{AY, Arch6},
+		{AFI, Arch7},
+		{CRJ, Arch8},
+		{ARK, Arch9},
+		{TBEGIN, Arch10},
+		{VA, Arch11},
+		{VMSL, Arch12},
+		{SELR, Arch13},
+		{NNPA, Arch14},
+		{BDEPG, Arch15},
+		{VBLEND, Arch15},
+		{0, 0},
+		{Op(len(opstr)), 0},
+	}
+	for _, tt := range tests {
+		if arch := tt.op.Arch(); arch != tt.arch {
+			t.Errorf("%v.Arch() = %v, want %v", tt.op, arch, tt.arch)
+		}
+	}
+	for _, f := range instFormats {
+		if f.Op.Arch() == 0 {
+			t.Errorf("%v.Arch() is not set", f.Op)
+		}
+	}
+	if s := Arch15.String(); s != "arch15" {
+		t.Errorf("Arch15.String() = %q, want %q", s, "arch15")
+	}
+}
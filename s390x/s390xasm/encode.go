@@ -0,0 +1,267 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package s390xasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// Encode returns the encoding of inst, 2, 4 or 6 bytes long depending
+// on inst.Op. inst.Op and inst.Args select the encoding. The bits of
+// inst.Enc that the instruction ignores are kept, so an instruction
+// returned by Decode encodes to the bytes it was decoded from.
+//
+// Encode reports an error if an argument has the wrong type or does
+// not fit its field, such as a Disp20 outside the signed 20-bit range
+// or a Mask wider than 4 bits.
+func Encode(inst Inst) ([]byte, error) {
+	formats := getOpFormats()[inst.Op]
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("cannot encode %v: unknown instruction", inst.Op)
+	}
+	var err error
+	for _, f := range formats {
+		var enc []byte
+		if enc, err = f.encode(&inst); err == nil {
+			return enc, nil
+		}
+	}
+	return nil, err
+}
+
+// getOpFormats returns the instruction formats of each Op.
+var getOpFormats = sync.OnceValue(func() map[Op][]*instFormat {
+	formats := make(map[Op][]*instFormat)
+	for i := range instFormats {
+		f := &instFormats[i]
+		formats[f.Op] = append(formats[f.Op], f)
+	}
+	return formats
+})
+
+// flagRXB marks the RXB field of a vector instruction, which holds
+// the high bits of the numbers of its vector registers. Decode
+// returns the field as an Imm argument after the others. Encode sets
+// the bits that the vector registers need, whether or not the
+// argument has them.
+const flagRXB = 0xc00
+
+// rxbBit returns the RXB bit, in bits 36 to 39, that extends the
+// vector register field at offset offs, or 0 if there is none.
+func rxbBit(offs uint8) uint64 {
+	switch offs {
+	case 8:
+		return 1 << 27
+	case 12:
+		return 1 << 26
+	case 16:
+		return 1 << 25
+	case 32:
+		return 1 << 24
+	}
+	return 0
+}
+
+// encode returns the encoding of inst in the format f.
+func (f *instFormat) encode(inst *Inst) ([]byte, error) {
+	var n int
+	switch f.Value >> 62 {
+	case 0:
+		n = 2
+	case 1, 2:
+		n = 4
+	case 3:
+		n = 6
+	}
+
+	// Decode keeps the encoding of 2 and 4 byte instructions in the
+	// low bits of Enc, and of 6 byte ones in the high bits.
+	orig := inst.Enc
+	switch inst.Len {
+	case 2:
+		orig <<= 48
+	case 4:
+		orig <<= 32
+	}
+	used := f.Mask | f.DontCare | ^uint64(0)>>(8*n)
+	for _, a := range f.Args {
+		if a == nil {
+			break
+		}
+		used |= uint64(1<<a.Bits-1) << (64 - a.Offs - a.Bits)
+		if a.Type == TypeVecReg {
+			used |= rxbBit(a.Offs)
+		}
+	}
+	w := f.Value | orig&^used
+
+	want := inst.Args
+	for j, a := range f.Args {
+		if a == nil {
+			if inst.Args[j] != nil {
+				return nil, fmt.Errorf("cannot encode %v: too many arguments", inst.Op)
+			}
+			break
+		}
+		var ok bool
+		if w, ok = a.encode(w, inst.Args[j]); !ok {
+			return nil, fmt.Errorf("cannot encode %v: invalid %v argument %d", inst.Op, a.Type, j+1)
+		}
+		if a.flags == flagRXB {
+			want[j] = a.Parse(w)
+		}
+	}
+
+	enc := binary.BigEndian.AppendUint64(nil, w)[:n]
+	if dec, err := Decode(enc); err != nil || dec.Op != inst.Op || dec.Args != want {
+		return nil, fmt.Errorf("cannot encode %v: encoding decodes as %v", inst.Op, dec.Op)
+	}
+	return enc, nil
+}
+
+// encode sets the field of the argument a in the instruction w
+// to the value of arg, the inverse of Parse. It reports whether
+// arg has the type of a and fits the field.
+func (a argField) encode(w uint64, arg Arg) (uint64, bool) {
+	var v int64
+	signed := false
+	switch a.Type {
+	default:
+		return w, false
+	case TypeReg:
+		v = regNum(arg, R0)
+	case TypeFPReg:
+		v = regNum(arg, F0)
+	case TypeCReg:
+		v = regNum(arg, C0)
+	case TypeACReg:
+		v = regNum(arg, A0)
+	case TypeBaseReg:
+		b, ok := arg.(Base)
+		if !ok {
+			return w, false
+		}
+		v = int64(b - B0)
+	case TypeIndexReg:
+		x, ok := arg.(Index)
+		if !ok {
+			return w, false
+		}
+		v = int64(x - X0)
+	case TypeVecReg:
+		r, ok := arg.(VReg)
+		if !ok || r > V31 {
+			return w, false
+		}
+		v = int64(r - V0)
+		if v >= 16 {
+			// The high bit of the register number is in RXB.
+			rxb := rxbBit(a.Offs)
+			if rxb == 0 {
+				return w, false
+			}
+			w |= rxb
+			v -= 16
+		}
+	case TypeDispUnsigned:
+		d, ok := arg.(Disp12)
+		if !ok {
+			return w, false
+		}
+		v = int64(d)
+	case TypeDispSigned20:
+		d, ok := arg.(Disp20)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(int32(d)), true
+	case TypeImmSigned8:
+		imm, ok := arg.(Sign8)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(imm), true
+	case TypeImmSigned16:
+		imm, ok := arg.(Sign16)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(imm), true
+	case TypeImmSigned32:
+		imm, ok := arg.(Sign32)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(imm), true
+	case TypeImmUnsigned:
+		// The RXB field may be left out.
+		imm, ok := arg.(Imm)
+		if !ok && (arg != nil || a.flags != flagRXB) {
+			return w, false
+		}
+		v = int64(imm)
+	case TypeRegImSigned12:
+		// Parse sign extends the offset to the width of the type.
+		off, ok := arg.(RegIm12)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(int16(off)), true
+	case TypeRegImSigned16:
+		off, ok := arg.(RegIm16)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(int16(off)), true
+	case TypeRegImSigned24:
+		off, ok := arg.(RegIm24)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(int32(off)), true
+	case TypeRegImSigned32:
+		off, ok := arg.(RegIm32)
+		if !ok {
+			return w, false
+		}
+		v, signed = int64(int32(off)), true
+	case TypeMask:
+		m, ok := arg.(Mask)
+		if !ok {
+			return w, false
+		}
+		v = int64(m)
+	case TypeLen:
+		l, ok := arg.(Len)
+		if !ok {
+			return w, false
+		}
+		v = int64(l)
+	}
+	lo, hi := int64(0), int64(1)<<a.Bits
+	if signed {
+		lo, hi = -hi/2, hi/2
+	}
+	if v < lo || v >= hi {
+		return w, false
+	}
+	if a.flags == flagRXB {
+		// Keep the bits set by the vector registers.
+		v |= int64(a.BitField.Parse(w))
+	}
+	return a.BitField.insert(w, uint64(v)), true
+}
+
+// regNum returns the number of the register arg in the register file
+// of 16 registers that starts at base, or -1 if arg is not in it.
+func regNum(arg Arg, base Reg) int64 {
+	r, ok := arg.(Reg)
+	if !ok || r < base || r >= base+16 {
+		return -1
+	}
+	return int64(r - base)
+}
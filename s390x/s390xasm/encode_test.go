@@ -0,0 +1,182 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package s390xasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestEncode checks that every instruction in the decode test cases
+// encodes back to its original bytes.
+func TestEncode(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "decode*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			f := strings.Fields(line)
+			code, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+			if err != nil {
+				t.Errorf("%s: parsing %q: %v", file, f[0], err)
+				continue
+			}
+			inst, err := Decode(code)
+			if err != nil || inst.Op == 0 {
+				continue
+			}
+			n++
+			code = code[:inst.Len]
+			if enc, err := Encode(inst); err != nil || !bytes.Equal(enc, code) {
+				t.Errorf("%s: Encode(%v) = %x, %v, want %x", file, inst.String(0), enc, err, code)
+			}
+		}
+	}
+	if n == 0 {
+		t.Fatal("no test cases")
+	}
+}
+
+// TestEncodeLength checks that the length code in the top two bits of
+// the first byte of each encoding, which is all that Decode reads to
+// find the length, agrees with the format: 00 for 2 bytes, 01 and 10
+// for 4 and 11 for 6.
+func TestEncodeLength(t *testing.T) {
+	for i := range instFormats {
+		f := &instFormats[i]
+		inst, err := Decode(binary.BigEndian.AppendUint64(nil, f.Value))
+		if err != nil || inst.Op != f.Op {
+			continue // decodes as a more specific format
+		}
+		inst.Enc = 0
+		enc, err := Encode(inst)
+		if err != nil {
+			t.Errorf("Encode(%v): %v", inst.String(0), err)
+			continue
+		}
+		if want := [4]int{2, 4, 4, 6}[enc[0]>>6]; len(enc) != want {
+			t.Errorf("Encode(%v) = %x, %d bytes, want %d for length code %02b", inst.String(0), enc, len(enc), want, enc[0]>>6)
+		}
+	}
+
+	tests := []struct {
+		inst Inst
+		want string
+	}{
+		{Inst{Op: AR, Args: Args{R1, R2}}, "1a12"},
+		{Inst{Op: AHI, Args: Args{R1, Sign16(1)}}, "a71a0001"},
+		{Inst{Op: AFI, Args: Args{R1, Sign32(1)}}, "c21900000001"},
+		{Inst{Op: A, Args: Args{R8, Disp12(11), X2, B1}}, "5a82100b"},
+		{Inst{Op: AY, Args: Args{R8, Disp20(11), X2, B1}}, "e382100b005a"},
+		{Inst{Op: LG, Args: Args{R1, Disp20(0xfffffff8), X0, B15}}, "e310fff8ff04"},
+	}
+	for _, tt := range tests {
+		checkEncode(t, tt.inst, tt.want)
+	}
+}
+
+// TestEncodeRelative checks the relative offsets, which count
+// halfwords, so that a byte offset is always even: 16 bits in the RI
+// formats, reaching 64 KiB either way, and 32 bits in the RIL
+// formats, reaching 4 GiB either way.
+func TestEncodeRelative(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want string
+	}{
+		{Inst{Op: BRC, Args: Args{Mask(15), RegIm16(0x7fff)}}, "a7f47fff"},
+		{Inst{Op: BRC, Args: Args{Mask(15), RegIm16(0x8000)}}, "a7f48000"},
+		{Inst{Op: BRCT, Args: Args{R1, RegIm16(0xffff)}}, "a716ffff"},
+		{Inst{Op: CIJ, Args: Args{R1, Sign8(-128), Mask(8), RegIm16(0x8000)}}, "ec188000807e"},
+
+		// Beyond 64 KiB a branch needs the RIL form.
+		{Inst{Op: BRCL, Args: Args{Mask(15), RegIm32(0x8000)}}, "c0f400008000"},
+		{Inst{Op: BRCL, Args: Args{Mask(15), RegIm32(0x7fffffff)}}, "c0f47fffffff"},
+		{Inst{Op: BRCL, Args: Args{Mask(15), RegIm32(0x80000000)}}, "c0f480000000"},
+		{Inst{Op: BRASL, Args: Args{R14, RegIm32(0x7fffffff)}}, "c0e57fffffff"},
+		{Inst{Op: BRCTH, Args: Args{R1, RegIm32(0x7fffffff)}}, "cc167fffffff"},
+		{Inst{Op: LARL, Args: Args{R1, RegIm32(1)}}, "c01000000001"},
+		{Inst{Op: LARL, Args: Args{R1, RegIm32(0x80000000)}}, "c01080000000"},
+		{Inst{Op: EXRL, Args: Args{R1, RegIm32(1)}}, "c61000000001"},
+		{Inst{Op: PFDRL, Args: Args{Mask(2), RegIm32(3)}}, "c62200000003"},
+		{Inst{Op: LLHRL, Args: Args{R3, RegIm32(0xffffffff)}}, "c432ffffffff"},
+
+		// BPRP has a 12-bit and a 24-bit offset, sign extended.
+		{Inst{Op: BPRP, Args: Args{Mask(0), RegIm12(0xf800), RegIm24(0x7fffff)}}, "c508007fffff"},
+		{Inst{Op: BPRP, Args: Args{Mask(0), RegIm12(0x7ff), RegIm24(0xff800000)}}, "c507ff800000"},
+	}
+	for _, tt := range tests {
+		checkEncode(t, tt.inst, tt.want)
+	}
+
+	for _, inst := range []Inst{
+		{Op: BRC, Args: Args{Mask(15), RegIm32(0x8000)}},                 // RIL offset in an RI instruction
+		{Op: BRCL, Args: Args{Mask(15), RegIm16(0x8000)}},                // RI offset in an RIL instruction
+		{Op: BRC, Args: Args{Mask(16), RegIm16(0)}},                      // mask out of range
+		{Op: BPRP, Args: Args{Mask(0), RegIm12(0x800), RegIm24(0)}},      // 12-bit offset out of range
+		{Op: BPRP, Args: Args{Mask(0), RegIm12(0), RegIm24(0x800000)}},   // 24-bit offset out of range
+		{Op: BPRP, Args: Args{Mask(0), RegIm12(0xf7ff), RegIm24(0)}},     // likewise, negative
+		{Op: BPRP, Args: Args{Mask(0), RegIm12(0), RegIm24(0xff7fffff)}}, // likewise
+	} {
+		if enc, err := Encode(inst); err == nil {
+			t.Errorf("Encode(%v) = %x, want error", inst.Op, enc)
+		}
+	}
+}
+
+func TestEncodeInst(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want string
+	}{
+		// The RXB field holds the high bits of the vector registers.
+		{Inst{Op: VA, Args: Args{V16, V1, V31, Mask(0)}}, "e701f0000af3"},
+		{Inst{Op: VA, Args: Args{V16, V1, V31, Mask(0), Imm(0xa)}}, "e701f0000af3"},
+	}
+	for _, tt := range tests {
+		checkEncode(t, tt.inst, tt.want)
+	}
+
+	for _, inst := range []Inst{
+		{Op: AY, Args: Args{R8, Disp20(1 << 19), X2, B1}}, // displacement out of range
+		{Op: A, Args: Args{R8, Disp12(0x1000), X2, B1}},   // displacement out of range
+		{Op: AHI, Args: Args{R1, Sign16(-1), Sign16(0)}},  // extra argument
+		{Op: AR, Args: Args{F8, R0}},                      // wrong register kind
+		{Op: A, Args: Args{R8, Disp20(11), X2, B1}},       // wrong displacement kind
+		{Op: VA, Args: Args{VReg(32), V1, V31, Mask(0)}},  // no such register
+		{},
+	} {
+		if enc, err := Encode(inst); err == nil {
+			t.Errorf("Encode(%v) = %x, want error", inst.Op, enc)
+		}
+	}
+}
+
+func checkEncode(t *testing.T, inst Inst, want string) {
+	t.Helper()
+	enc, err := Encode(inst)
+	if err != nil {
+		t.Errorf("Encode(%v): %v", inst.Op, err)
+		return
+	}
+	if got := hex.EncodeToString(enc); got != want {
+		t.Errorf("Encode(%v) = %s, want %s", inst.Op, got, want)
+	}
+}
@@ -45,3 +45,19 @@ func (b BitField) ParseSigned(i uint64) int64 {
 	u := int64(b.Parse(i))
 	return u << (64 - b.Bits) >> (64 - b.Bits)
 }
+
+// insert sets the bitfield b of i to the low b.Bits bits of u, and
+// returns the result. It is the inverse of Parse, and will panic if b
+// is invalid.
+func (b BitField) insert(i uint64, u uint64) uint64 {
+	if b.Bits > 64 || b.Bits == 0 || b.Offs > 63 || b.Offs+b.Bits > 64 {
+		panic(fmt.Sprintf("invalid bitfiled %v", b))
+	}
+	if b.Bits == 20 {
+		// The low 12 bits come first, then the high 8 bits.
+		u = (u&0xFFF)<<8 | (u>>12)&0xFF
+	}
+	shift := 64 - b.Offs - b.Bits
+	mask := uint64(1<<b.Bits-1) << shift
+	return i&^mask | u<<shift&mask
+}
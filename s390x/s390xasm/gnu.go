@@ -589,6 +589,24 @@ func HandleExtndMnemonic(inst *Inst) string {
 			}
 		}
 
+	// Vector-enhancements facility 3 instructions with an element size M-field.
+	// VD, VDL, VR and VRL have no byte and halfword forms.
+	case "vgem", "vblend", "vd", "vdl", "vr", "vrl":
+		off, first := 2, 0
+		switch opString {
+		case "vblend":
+			off = 4
+		case "vd", "vdl", "vr", "vrl":
+			off, first = 3, 2
+		}
+		for i := first; i < len(vecInstrExtndMnics)-1; i++ { // 0,1,2,3,4
+			if uint8(inst.Args[off].(Mask)) == vecInstrExtndMnics[i].Value {
+				newOpStr = opString + vecInstrExtndMnics[i].ExtnOpStr
+				removeArg(inst, int8(off))
+				break
+			}
+		}
+
 	case "vllez":
 		for i := 0; i < len(vecInstrExtndMnics); i++ {
 			if i == 4 {
@@ -105,6 +105,39 @@ func (o Op) String() string {
 	return opstr[o]
 }
 
+// Arch returns the architecture level which introduced the operation.
+func (o Op) Arch() Arch {
+	if int(o) >= len(oparch) {
+		return 0
+	}
+	return oparch[o]
+}
+
+// An Arch is a z/Architecture level, numbered as in the -march=archN
+// option of the GNU toolchain. Each level is a superset of the previous ones.
+type Arch uint8
+
+const (
+	Arch5  Arch = 5 + iota // z900
+	Arch6                  // z990
+	Arch7                  // z9-109
+	Arch8                  // z10
+	Arch9                  // z196
+	Arch10                 // zEC12
+	Arch11                 // z13
+	Arch12                 // z14
+	Arch13                 // z15
+	Arch14                 // z16
+	Arch15                 // z17
+)
+
+func (a Arch) String() string {
+	if a < Arch5 || a > Arch15 {
+		return fmt.Sprintf("Arch(%d)", int(a))
+	}
+	return fmt.Sprintf("arch%d", int(a))
+}
+
 // An Arg is a single instruction argument.
 // One of these types: Reg, Base, Index, Disp20, Disp12, Len, Mask, Sign8, Sign16, Sign32, RegIm12, RegIm16, RegIm24, RegIm32.
 type Arg interface {
@@ -87,6 +87,8 @@ const (
 	NILF
 	NCRK
 	NCGRK
+	BDEPG
+	BEXTG
 	BAL
 	BALR
 	BAS
@@ -153,6 +155,9 @@ const (
 	CRJ
 	CGRJ
 	CFC
+	CAL
+	CALG
+	CALGF
 	CRDTE
 	KXBR
 	KXTR
@@ -353,6 +358,8 @@ const (
 	CU41
 	CPYA
 	CPSDR
+	CLZG
+	CTZG
 	VSCSHP
 	VSCHP
 	DFLTCC
@@ -546,6 +553,11 @@ const (
 	LOCFH
 	LOCFHR
 	LGFI
+	LXAB
+	LXAH
+	LXAF
+	LXAG
+	LXAQ
 	LXDB
 	LXDBR
 	LXDTR
@@ -583,6 +595,11 @@ const (
 	LLILH
 	LLILL
 	LLILF
+	LLXAB
+	LLXAH
+	LLXAF
+	LLXAG
+	LLXAQ
 	LLGFRL
 	LLGT
 	LLGTR
@@ -793,6 +810,7 @@ const (
 	PCKMO
 	PFPO
 	PFMF
+	PFCR
 	PLO
 	PPA
 	PRNO
@@ -1056,6 +1074,7 @@ const (
 	VAVG
 	VAVGL
 	VBPERM
+	VBLEND
 	VCKSM
 	VCP
 	VCEQ
@@ -1064,12 +1083,16 @@ const (
 	VCSPH
 	VCVB
 	VCVBG
+	VCVBQ
 	VCVD
 	VCVDG
+	VCVDQ
 	VCLZDP
 	VCLZ
 	VCTZ
+	VD
 	VDP
+	VDL
 	VEC
 	VECL
 	VERIM
@@ -1081,6 +1104,7 @@ const (
 	VESRAV
 	VESRL
 	VESRLV
+	VEVAL
 	VX
 	VFAE
 	VFEE
@@ -1123,6 +1147,7 @@ const (
 	VGEF
 	VGEG
 	VGBM
+	VGEM
 	VGM
 	VISTR
 	VL
@@ -1193,7 +1218,9 @@ const (
 	VPERM
 	VPDI
 	VPOPCT
+	VR
 	VRP
+	VRL
 	VREP
 	VREPI
 	VSCEF
@@ -1238,6 +1265,7 @@ const (
 	VSUM
 	VTP
 	VTM
+	VTZ
 	VUPH
 	VUPLH
 	VUPLL
@@ -1332,6 +1360,8 @@ var opstr = [...]string{
 	NILF:    "nilf",
 	NCRK:    "ncrk",
 	NCGRK:   "ncgrk",
+	BDEPG:   "bdepg",
+	BEXTG:   "bextg",
 	BAL:     "bal",
 	BALR:    "balr",
 	BAS:     "bas",
@@ -1398,6 +1428,9 @@ var opstr = [...]string{
 	CRJ:     "crj",
 	CGRJ:    "cgrj",
 	CFC:     "cfc",
+	CAL:     "cal",
+	CALG:    "calg",
+	CALGF:   "calgf",
 	CRDTE:   "crdte",
 	KXBR:    "kxbr",
 	KXTR:    "kxtr",
@@ -1598,6 +1631,8 @@ var opstr = [...]string{
 	CU41:    "cu41",
 	CPYA:    "cpya",
 	CPSDR:   "cpsdr",
+	CLZG:    "clzg",
+	CTZG:    "ctzg",
 	VSCSHP:  "vscshp",
 	VSCHP:   "vschp",
 	DFLTCC:  "dfltcc",
@@ -1791,6 +1826,11 @@ var opstr = [...]string{
 	LOCFH:   "locfh",
 	LOCFHR:  "locfhr",
 	LGFI:    "lgfi",
+	LXAB:    "lxab",
+	LXAH:    "lxah",
+	LXAF:    "lxaf",
+	LXAG:    "lxag",
+	LXAQ:    "lxaq",
 	LXDB:    "lxdb",
 	LXDBR:   "lxdbr",
 	LXDTR:   "lxdtr",
@@ -1828,6 +1868,11 @@ var opstr = [...]string{
 	LLILH:   "llilh",
 	LLILL:   "llill",
 	LLILF:   "llilf",
+	LLXAB:   "llxab",
+	LLXAH:   "llxah",
+	LLXAF:   "llxaf",
+	LLXAG:   "llxag",
+	LLXAQ:   "llxaq",
 	LLGFRL:  "llgfrl",
 	LLGT:    "llgt",
 	LLGTR:   "llgtr",
@@ -2038,6 +2083,7 @@ var opstr = [...]string{
 	PCKMO:   "pckmo",
 	PFPO:    "pfpo",
 	PFMF:    "pfmf",
+	PFCR:    "pfcr",
 	PLO:     "plo",
 	PPA:     "ppa",
 	PRNO:    "prno",
@@ -2301,6 +2347,7 @@ var opstr = [...]string{
 	VAVG:    "vavg",
 	VAVGL:   "vavgl",
 	VBPERM:  "vbperm",
+	VBLEND:  "vblend",
 	VCKSM:   "vcksm",
 	VCP:     "vcp",
 	VCEQ:    "vceq",
@@ -2309,12 +2356,16 @@ var opstr = [...]string{
 	VCSPH:   "vcsph",
 	VCVB:    "vcvb",
 	VCVBG:   "vcvbg",
+	VCVBQ:   "vcvbq",
 	VCVD:    "vcvd",
 	VCVDG:   "vcvdg",
+	VCVDQ:   "vcvdq",
 	VCLZDP:  "vclzdp",
 	VCLZ:    "vclz",
 	VCTZ:    "vctz",
+	VD:      "vd",
 	VDP:     "vdp",
+	VDL:     "vdl",
 	VEC:     "vec",
 	VECL:    "vecl",
 	VERIM:   "verim",
@@ -2326,6 +2377,7 @@ var opstr = [...]string{
 	VESRAV:  "vesrav",
 	VESRL:   "vesrl",
 	VESRLV:  "vesrlv",
+	VEVAL:   "veval",
 	VX:      "vx",
 	VFAE:    "vfae",
 	VFEE:    "vfee",
@@ -2368,6 +2420,7 @@ var opstr = [...]string{
 	VGEF:    "vgef",
 	VGEG:    "vgeg",
 	VGBM:    "vgbm",
+	VGEM:    "vgem",
 	VGM:     "vgm",
 	VISTR:   "vistr",
 	VL:      "vl",
@@ -2438,7 +2491,9 @@ var opstr = [...]string{
 	VPERM:   "vperm",
 	VPDI:    "vpdi",
 	VPOPCT:  "vpopct",
+	VR:      "vr",
 	VRP:     "vrp",
+	VRL:     "vrl",
 	VREP:    "vrep",
 	VREPI:   "vrepi",
 	VSCEF:   "vscef",
@@ -2483,6 +2538,7 @@ var opstr = [...]string{
 	VSUM:    "vsum",
 	VTP:     "vtp",
 	VTM:     "vtm",
+	VTZ:     "vtz",
 	VUPH:    "vuph",
 	VUPLH:   "vuplh",
 	VUPLL:   "vupll",
@@ -2493,6 +2549,1279 @@ var opstr = [...]string{
 	ZAP:     "zap",
 }
 
+var oparch = [...]Arch{
+	A:       Arch5,
+	AR:      Arch5,
+	ARK:     Arch9,
+	AY:      Arch6,
+	AG:      Arch5,
+	AGR:     Arch5,
+	AGRK:    Arch9,
+	AGF:     Arch5,
+	AGFR:    Arch5,
+	AXBR:    Arch5,
+	AXTR:    Arch7,
+	AXTRA:   Arch9,
+	ADB:     Arch5,
+	ADBR:    Arch5,
+	ADTR:    Arch7,
+	ADTRA:   Arch9,
+	AEB:     Arch5,
+	AEBR:    Arch5,
+	AP:      Arch5,
+	AH:      Arch5,
+	AHY:     Arch6,
+	AGH:     Arch12,
+	AHI:     Arch5,
+	AGHI:    Arch5,
+	AHHHR:   Arch9,
+	AHHLR:   Arch9,
+	AFI:     Arch7,
+	AHIK:    Arch9,
+	ASI:     Arch8,
+	AGHIK:   Arch9,
+	AGFI:    Arch7,
+	AGSI:    Arch8,
+	AIH:     Arch9,
+	AL:      Arch5,
+	ALR:     Arch5,
+	ALRK:    Arch9,
+	ALY:     Arch6,
+	ALG:     Arch5,
+	ALGR:    Arch5,
+	ALGRK:   Arch9,
+	ALGF:    Arch5,
+	ALGFR:   Arch5,
+	ALHHHR:  Arch9,
+	ALHHLR:  Arch9,
+	ALFI:    Arch7,
+	ALGFI:   Arch7,
+	ALC:     Arch5,
+	ALCR:    Arch5,
+	ALCG:    Arch5,
+	ALCGR:   Arch5,
+	ALHSIK:  Arch9,
+	ALSI:    Arch8,
+	ALGHSIK: Arch9,
+	ALGSI:   Arch8,
+	ALSIH:   Arch9,
+	ALSIHN:  Arch9,
+	AXR:     Arch5,
+	AD:      Arch5,
+	ADR:     Arch5,
+	AE:      Arch5,
+	AER:     Arch5,
+	AW:      Arch5,
+	AWR:     Arch5,
+	AU:      Arch5,
+	AUR:     Arch5,
+	N:       Arch5,
+	NR:      Arch5,
+	NRK:     Arch9,
+	NY:      Arch6,
+	NG:      Arch5,
+	NGR:     Arch5,
+	NGRK:    Arch9,
+	NC:      Arch5,
+	NI:      Arch5,
+	NIY:     Arch6,
+	NIHH:    Arch5,
+	NIHL:    Arch5,
+	NIHF:    Arch7,
+	NILH:    Arch5,
+	NILL:    Arch5,
+	NILF:    Arch7,
+	NCRK:    Arch13,
+	NCGRK:   Arch13,
+	BDEPG:   Arch15,
+	BEXTG:   Arch15,
+	BAL:     Arch5,
+	BALR:    Arch5,
+	BAS:     Arch5,
+	BASR:    Arch5,
+	BASSM:   Arch5,
+	BSA:     Arch5,
+	BSM:     Arch5,
+	BAKR:    Arch5,
+	BSG:     Arch5,
+	BIC:     Arch12,
+	BC:      Arch5,
+	BCR:     Arch5,
+	BCT:     Arch5,
+	BCTR:    Arch5,
+	BCTG:    Arch5,
+	BCTGR:   Arch5,
+	BXH:     Arch5,
+	BXHG:    Arch5,
+	BXLE:    Arch5,
+	BXLEG:   Arch5,
+	BPP:     Arch10,
+	BPRP:    Arch10,
+	BRAS:    Arch5,
+	BRASL:   Arch5,
+	BRC:     Arch5,
+	BRCL:    Arch5,
+	BRCT:    Arch5,
+	BRCTG:   Arch5,
+	BRCTH:   Arch9,
+	BRXH:    Arch5,
+	BRXHG:   Arch5,
+	BRXLE:   Arch5,
+	BRXLG:   Arch5,
+	XSCH:    Arch5,
+	CKSM:    Arch5,
+	KM:      Arch6,
+	KMA:     Arch12,
+	KMC:     Arch6,
+	KMF:     Arch9,
+	KMCTR:   Arch9,
+	KMO:     Arch9,
+	CSCH:    Arch5,
+	C:       Arch5,
+	CR:      Arch5,
+	CY:      Arch5,
+	CG:      Arch5,
+	CGR:     Arch5,
+	CGF:     Arch5,
+	CGFR:    Arch5,
+	CXBR:    Arch5,
+	CXTR:    Arch7,
+	CXR:     Arch5,
+	CDB:     Arch5,
+	CDBR:    Arch5,
+	CDTR:    Arch7,
+	CD:      Arch5,
+	CDR:     Arch5,
+	CEB:     Arch5,
+	CEBR:    Arch5,
+	CE:      Arch5,
+	CER:     Arch5,
+	CRB:     Arch8,
+	CGRB:    Arch8,
+	CRJ:     Arch8,
+	CGRJ:    Arch8,
+	CFC:     Arch5,
+	CAL:     Arch15,
+	CALG:    Arch15,
+	CALGF:   Arch15,
+	CRDTE:   Arch10,
+	KXBR:    Arch5,
+	KXTR:    Arch7,
+	KDB:     Arch5,
+	KDBR:    Arch5,
+	KDTR:    Arch7,
+	KEB:     Arch5,
+	KEBR:    Arch5,
+	CS:      Arch5,
+	CSY:     Arch6,
+	CSG:     Arch5,
+	CSP:     Arch5,
+	CSPG:    Arch6,
+	CSST:    Arch7,
+	CRT:     Arch8,
+	CGRT:    Arch8,
+	CEXTR:   Arch7,
+	CEDTR:   Arch7,
+	CP:      Arch5,
+	CDS:     Arch5,
+	CDSY:    Arch6,
+	CDSG:    Arch5,
+	CH:      Arch5,
+	CHY:     Arch6,
+	CGH:     Arch8,
+	CHHSI:   Arch8,
+	CHI:     Arch5,
+	CHSI:    Arch8,
+	CGHI:    Arch5,
+	CGHSI:   Arch8,
+	CHRL:    Arch8,
+	CGHRL:   Arch8,
+	CHF:     Arch9,
+	CHHR:    Arch9,
+	CHLR:    Arch9,
+	CFI:     Arch7,
+	CGFI:    Arch7,
+	CIB:     Arch8,
+	CGIB:    Arch8,
+	CIJ:     Arch8,
+	CGIJ:    Arch8,
+	CIT:     Arch8,
+	CGIT:    Arch8,
+	CIH:     Arch9,
+	CL:      Arch5,
+	CLR:     Arch5,
+	CLY:     Arch6,
+	CLG:     Arch5,
+	CLGR:    Arch5,
+	CLGF:    Arch5,
+	CLGFR:   Arch5,
+	CLC:     Arch5,
+	CLI:     Arch5,
+	CLIY:    Arch6,
+	CLRB:    Arch8,
+	CLGRB:   Arch8,
+	CLRJ:    Arch8,
+	CLGRJ:   Arch8,
+	CLRT:    Arch8,
+	CLT:     Arch10,
+	CLGRT:   Arch8,
+	CLGT:    Arch10,
+	CLMH:    Arch5,
+	CLM:     Arch5,
+	CLMY:    Arch6,
+	CLHF:    Arch9,
+	CLHHR:   Arch9,
+	CLHLR:   Arch9,
+	CLHHSI:  Arch8,
+	CLFI:    Arch7,
+	CLFHSI:  Arch8,
+	CLGHSI:  Arch8,
+	CLGFI:   Arch7,
+	CLIB:    Arch8,
+	CLGIB:   Arch8,
+	CLIJ:    Arch8,
+	CLGIJ:   Arch8,
+	CLFIT:   Arch8,
+	CLGIT:   Arch8,
+	CLIH:    Arch9,
+	CLCL:    Arch5,
+	CLCLE:   Arch5,
+	CLCLU:   Arch5,
+	CLRL:    Arch8,
+	CLHRL:   Arch8,
+	CLGRL:   Arch8,
+	CLGHRL:  Arch8,
+	CLGFRL:  Arch8,
+	CLST:    Arch5,
+	CRL:     Arch8,
+	CGRL:    Arch8,
+	CGFRL:   Arch8,
+	CUSE:    Arch5,
+	CMPSC:   Arch5,
+	KDSA:    Arch13,
+	KIMD:    Arch6,
+	KLMD:    Arch6,
+	KMAC:    Arch6,
+	THDR:    Arch5,
+	THDER:   Arch5,
+	CXFBR:   Arch5,
+	CXFBRA:  Arch9,
+	CXFTR:   Arch9,
+	CXFR:    Arch5,
+	CDFBR:   Arch5,
+	CDFBRA:  Arch9,
+	CDFTR:   Arch5,
+	CDFR:    Arch5,
+	CEFBR:   Arch5,
+	CEFBRA:  Arch9,
+	CEFR:    Arch5,
+	CXGBR:   Arch5,
+	CXGBRA:  Arch9,
+	CXGTR:   Arch7,
+	CXGTRA:  Arch9,
+	CXGR:    Arch5,
+	CDGBR:   Arch5,
+	CDGBRA:  Arch9,
+	CDGTR:   Arch7,
+	CDGTRA:  Arch9,
+	CDGR:    Arch5,
+	CEGBR:   Arch5,
+	CEGBRA:  Arch9,
+	CEGR:    Arch5,
+	CXLFBR:  Arch9,
+	CXLFTR:  Arch9,
+	CDLFBR:  Arch9,
+	CDLFTR:  Arch9,
+	CELFBR:  Arch9,
+	CXLGBR:  Arch9,
+	CXLGTR:  Arch9,
+	CDLGBR:  Arch9,
+	CDLGTR:  Arch9,
+	CELGBR:  Arch9,
+	CXPT:    Arch11,
+	CDPT:    Arch11,
+	CXSTR:   Arch7,
+	CDSTR:   Arch7,
+	CXUTR:   Arch7,
+	CDUTR:   Arch7,
+	CXZT:    Arch10,
+	CDZT:    Arch10,
+	TBEDR:   Arch5,
+	TBDR:    Arch5,
+	CVB:     Arch5,
+	CVBY:    Arch6,
+	CVBG:    Arch5,
+	CVD:     Arch5,
+	CVDY:    Arch6,
+	CVDG:    Arch5,
+	CFXBR:   Arch5,
+	CFXBRA:  Arch9,
+	CGXBR:   Arch5,
+	CGXBRA:  Arch9,
+	CFXTR:   Arch9,
+	CGXTR:   Arch7,
+	CGXTRA:  Arch9,
+	CFXR:    Arch5,
+	CGXR:    Arch5,
+	CFDBR:   Arch5,
+	CFDBRA:  Arch9,
+	CGDBR:   Arch5,
+	CGDBRA:  Arch9,
+	CFDTR:   Arch9,
+	CGDTR:   Arch7,
+	CGDTRA:  Arch9,
+	CFDR:    Arch5,
+	CGDR:    Arch5,
+	CFEBR:   Arch5,
+	CFEBRA:  Arch9,
+	CGEBR:   Arch5,
+	CGEBRA:  Arch9,
+	CFER:    Arch5,
+	CGER:    Arch5,
+	CLFXBR:  Arch9,
+	CLGXBR:  Arch9,
+	CLFXTR:  Arch9,
+	CLGXTR:  Arch9,
+	CLFDBR:  Arch9,
+	CLGDBR:  Arch9,
+	CLFDTR:  Arch9,
+	CLGDTR:  Arch9,
+	CLFEBR:  Arch9,
+	CLGEBR:  Arch9,
+	CPXT:    Arch11,
+	CPDT:    Arch11,
+	CSXTR:   Arch7,
+	CSDTR:   Arch7,
+	CUXTR:   Arch7,
+	CUDTR:   Arch7,
+	CZXT:    Arch10,
+	CZDT:    Arch10,
+	CU24:    Arch6,
+	CU21:    Arch5,
+	CU12:    Arch5,
+	CU14:    Arch6,
+	CU42:    Arch6,
+	CU41:    Arch6,
+	CPYA:    Arch5,
+	CPSDR:   Arch7,
+	CLZG:    Arch15,
+	CTZG:    Arch15,
+	VSCSHP:  Arch14,
+	VSCHP:   Arch14,
+	DFLTCC:  Arch13,
+	D:       Arch5,
+	DR:      Arch5,
+	DXBR:    Arch5,
+	DXTR:    Arch7,
+	DXTRA:   Arch9,
+	DXR:     Arch5,
+	DDB:     Arch5,
+	DDBR:    Arch5,
+	DDTR:    Arch7,
+	DDTRA:   Arch9,
+	DD:      Arch5,
+	DDR:     Arch5,
+	DEB:     Arch5,
+	DEBR:    Arch5,
+	DE:      Arch5,
+	DER:     Arch5,
+	DP:      Arch5,
+	DL:      Arch5,
+	DLR:     Arch5,
+	DLG:     Arch5,
+	DLGR:    Arch5,
+	DSG:     Arch5,
+	DSGR:    Arch5,
+	DSGF:    Arch5,
+	DSGFR:   Arch5,
+	DIDBR:   Arch5,
+	DIEBR:   Arch5,
+	ED:      Arch5,
+	EDMK:    Arch5,
+	X:       Arch5,
+	XR:      Arch5,
+	XRK:     Arch9,
+	XY:      Arch6,
+	XG:      Arch5,
+	XGR:     Arch5,
+	XGRK:    Arch9,
+	XC:      Arch5,
+	XI:      Arch5,
+	XIY:     Arch6,
+	XIHF:    Arch7,
+	XILF:    Arch7,
+	EX:      Arch5,
+	EXRL:    Arch8,
+	EAR:     Arch5,
+	ESEA:    Arch5,
+	EEXTR:   Arch7,
+	EEDTR:   Arch7,
+	ECAG:    Arch8,
+	ECTG:    Arch7,
+	EFPC:    Arch5,
+	EPAR:    Arch5,
+	EPAIR:   Arch5,
+	EPSW:    Arch5,
+	ESAR:    Arch5,
+	ESAIR:   Arch6,
+	ESXTR:   Arch7,
+	ESDTR:   Arch7,
+	EREG:    Arch5,
+	EREGG:   Arch5,
+	ESTA:    Arch5,
+	ETND:    Arch10,
+	FLOGR:   Arch7,
+	HSCH:    Arch5,
+	HDR:     Arch5,
+	HER:     Arch5,
+	IAC:     Arch5,
+	IEXTR:   Arch7,
+	IEDTR:   Arch7,
+	IC:      Arch5,
+	ICY:     Arch6,
+	ICMH:    Arch5,
+	ICM:     Arch5,
+	ICMY:    Arch6,
+	IIHH:    Arch5,
+	IIHL:    Arch5,
+	IIHF:    Arch7,
+	IILH:    Arch5,
+	IILL:    Arch5,
+	IILF:    Arch7,
+	IPM:     Arch5,
+	IPK:     Arch5,
+	IRBM:    Arch12,
+	ISKE:    Arch5,
+	IVSK:    Arch5,
+	IDTE:    Arch6,
+	IPTE:    Arch5,
+	L:       Arch5,
+	LR:      Arch5,
+	LY:      Arch6,
+	LG:      Arch5,
+	LGR:     Arch5,
+	LGF:     Arch5,
+	LGFR:    Arch5,
+	LXR:     Arch5,
+	LD:      Arch5,
+	LDR:     Arch5,
+	LDY:     Arch6,
+	LE:      Arch5,
+	LER:     Arch5,
+	LEY:     Arch6,
+	LAM:     Arch5,
+	LAMY:    Arch6,
+	LA:      Arch5,
+	LAY:     Arch6,
+	LAE:     Arch5,
+	LAEY:    Arch8,
+	LARL:    Arch5,
+	LASP:    Arch5,
+	LAA:     Arch9,
+	LAAG:    Arch9,
+	LAAL:    Arch9,
+	LAALG:   Arch9,
+	LAN:     Arch9,
+	LANG:    Arch9,
+	LAX:     Arch9,
+	LAXG:    Arch9,
+	LAO:     Arch9,
+	LAOG:    Arch9,
+	LT:      Arch7,
+	LTR:     Arch5,
+	LTG:     Arch7,
+	LTGR:    Arch5,
+	LTGF:    Arch8,
+	LTGFR:   Arch5,
+	LTXBR:   Arch5,
+	LTXTR:   Arch7,
+	LTXR:    Arch5,
+	LTDBR:   Arch5,
+	LTDTR:   Arch7,
+	LTDR:    Arch5,
+	LTEBR:   Arch5,
+	LTER:    Arch5,
+	LAT:     Arch10,
+	LGAT:    Arch10,
+	LZRF:    Arch11,
+	LZRG:    Arch11,
+	LBEAR:   Arch14,
+	LB:      Arch6,
+	LBR:     Arch7,
+	LGB:     Arch6,
+	LGBR:    Arch7,
+	LBH:     Arch9,
+	LCR:     Arch5,
+	LCGR:    Arch5,
+	LCGFR:   Arch5,
+	LCXBR:   Arch5,
+	LCXR:    Arch5,
+	LCDBR:   Arch5,
+	LCDR:    Arch5,
+	LCDFR:   Arch7,
+	LCEBR:   Arch5,
+	LCER:    Arch5,
+	LCTL:    Arch5,
+	LCTLG:   Arch5,
+	LCBB:    Arch11,
+	FIXBR:   Arch5,
+	FIXBRA:  Arch9,
+	FIXTR:   Arch7,
+	FIXR:    Arch5,
+	FIDBR:   Arch5,
+	FIDBRA:  Arch9,
+	FIDTR:   Arch7,
+	FIDR:    Arch5,
+	FIEBR:   Arch5,
+	FIEBRA:  Arch9,
+	FIER:    Arch5,
+	LFPC:    Arch5,
+	LFAS:    Arch7,
+	LDGR:    Arch7,
+	LGDR:    Arch7,
+	LGG:     Arch12,
+	LGSC:    Arch12,
+	LH:      Arch5,
+	LHR:     Arch7,
+	LHY:     Arch6,
+	LGH:     Arch5,
+	LGHR:    Arch7,
+	LHH:     Arch9,
+	LOCHHI:  Arch11,
+	LHI:     Arch5,
+	LGHI:    Arch5,
+	LOCHI:   Arch11,
+	LOCGHI:  Arch11,
+	LHRL:    Arch8,
+	LGHRL:   Arch8,
+	LFH:     Arch9,
+	LFHAT:   Arch10,
+	LOCFH:   Arch11,
+	LOCFHR:  Arch11,
+	LGFI:    Arch7,
+	LXAB:    Arch15,
+	LXAH:    Arch15,
+	LXAF:    Arch15,
+	LXAG:    Arch15,
+	LXAQ:    Arch15,
+	LXDB:    Arch5,
+	LXDBR:   Arch5,
+	LXDTR:   Arch7,
+	LXD:     Arch5,
+	LXDR:    Arch5,
+	LXEB:    Arch5,
+	LXEBR:   Arch5,
+	LXE:     Arch5,
+	LXER:    Arch5,
+	LDEB:    Arch5,
+	LDEBR:   Arch5,
+	LDETR:   Arch7,
+	LDE:     Arch5,
+	LDER:    Arch5,
+	LLGF:    Arch5,
+	LLGFR:   Arch5,
+	LLGFSG:  Arch12,
+	LLGFAT:  Arch10,
+	LLZRGF:  Arch11,
+	LLC:     Arch7,
+	LLCR:    Arch7,
+	LLGC:    Arch5,
+	LLGCR:   Arch7,
+	LLCH:    Arch9,
+	LLH:     Arch7,
+	LLHR:    Arch7,
+	LLGH:    Arch5,
+	LLGHR:   Arch7,
+	LLHH:    Arch9,
+	LLHRL:   Arch8,
+	LLGHRL:  Arch8,
+	LLIHH:   Arch5,
+	LLIHL:   Arch5,
+	LLIHF:   Arch7,
+	LLILH:   Arch5,
+	LLILL:   Arch5,
+	LLILF:   Arch7,
+	LLXAB:   Arch15,
+	LLXAH:   Arch15,
+	LLXAF:   Arch15,
+	LLXAG:   Arch15,
+	LLXAQ:   Arch15,
+	LLGFRL:  Arch8,
+	LLGT:    Arch5,
+	LLGTR:   Arch5,
+	LLGTAT:  Arch10,
+	LM:      Arch5,
+	LMY:     Arch6,
+	LMG:     Arch5,
+	LMD:     Arch5,
+	LMH:     Arch5,
+	LNR:     Arch5,
+	LNGR:    Arch5,
+	LNGFR:   Arch5,
+	LNXBR:   Arch5,
+	LNXR:    Arch5,
+	LNDBR:   Arch5,
+	LNDR:    Arch5,
+	LNDFR:   Arch7,
+	LNEBR:   Arch5,
+	LNER:    Arch5,
+	LOC:     Arch9,
+	LOCR:    Arch9,
+	LOCG:    Arch9,
+	LOCGR:   Arch9,
+	LPTEA:   Arch7,
+	LPD:     Arch9,
+	LPDG:    Arch9,
+	LPQ:     Arch5,
+	LPR:     Arch5,
+	LPGR:    Arch5,
+	LPGFR:   Arch5,
+	LPXBR:   Arch5,
+	LPXR:    Arch5,
+	LPDBR:   Arch5,
+	LPDR:    Arch5,
+	LPDFR:   Arch7,
+	LPEBR:   Arch5,
+	LPER:    Arch5,
+	LPSW:    Arch5,
+	LPSWE:   Arch5,
+	LPSWEY:  Arch14,
+	LRA:     Arch5,
+	LRAY:    Arch6,
+	LRAG:    Arch5,
+	LRL:     Arch8,
+	LGRL:    Arch8,
+	LGFRL:   Arch8,
+	LRVH:    Arch5,
+	LRV:     Arch5,
+	LRVR:    Arch5,
+	LRVG:    Arch5,
+	LRVGR:   Arch5,
+	LDXBR:   Arch5,
+	LDXBRA:  Arch9,
+	LDXTR:   Arch7,
+	LDXR:    Arch5,
+	LRDR:    Arch5,
+	LEXBR:   Arch5,
+	LEXBRA:  Arch9,
+	LEXR:    Arch5,
+	LEDBR:   Arch5,
+	LEDBRA:  Arch9,
+	LEDTR:   Arch7,
+	LEDR:    Arch5,
+	LRER:    Arch5,
+	LURA:    Arch5,
+	LURAG:   Arch5,
+	LZXR:    Arch5,
+	LZDR:    Arch5,
+	LZER:    Arch5,
+	MSTA:    Arch5,
+	MSCH:    Arch5,
+	MC:      Arch5,
+	MVHHI:   Arch8,
+	MVHI:    Arch8,
+	MVGHI:   Arch8,
+	MVC:     Arch5,
+	MVI:     Arch5,
+	MVIY:    Arch5,
+	MVCIN:   Arch5,
+	MVCL:    Arch5,
+	MVCLE:   Arch5,
+	MVCLU:   Arch5,
+	MVN:     Arch5,
+	MVPG:    Arch5,
+	MVCRL:   Arch13,
+	MVST:    Arch5,
+	MVCP:    Arch5,
+	MVCS:    Arch5,
+	MVCDK:   Arch5,
+	MVCK:    Arch5,
+	MVO:     Arch5,
+	MVCOS:   Arch7,
+	MVCSK:   Arch5,
+	MVZ:     Arch5,
+	MG:      Arch12,
+	MGRK:    Arch12,
+	M:       Arch5,
+	MFY:     Arch8,
+	MR:      Arch5,
+	MXBR:    Arch5,
+	MXTR:    Arch7,
+	MXTRA:   Arch9,
+	MXR:     Arch5,
+	MDB:     Arch5,
+	MDBR:    Arch5,
+	MDTR:    Arch7,
+	MDTRA:   Arch9,
+	MD:      Arch5,
+	MDR:     Arch5,
+	MXDB:    Arch5,
+	MXDBR:   Arch5,
+	MXD:     Arch5,
+	MXDR:    Arch5,
+	MEEB:    Arch5,
+	MEEBR:   Arch5,
+	MEE:     Arch5,
+	MEER:    Arch5,
+	MDEB:    Arch5,
+	MDEBR:   Arch5,
+	MDE:     Arch5,
+	MDER:    Arch5,
+	ME:      Arch5,
+	MER:     Arch5,
+	MAY:     Arch7,
+	MAYR:    Arch7,
+	MADB:    Arch5,
+	MADBR:   Arch5,
+	MAD:     Arch5,
+	MADR:    Arch5,
+	MAEB:    Arch5,
+	MAEBR:   Arch5,
+	MAE:     Arch5,
+	MAER:    Arch5,
+	MAYH:    Arch7,
+	MAYHR:   Arch7,
+	MAYL:    Arch7,
+	MAYLR:   Arch7,
+	MSDB:    Arch5,
+	MSDBR:   Arch5,
+	MSD:     Arch5,
+	MSDR:    Arch5,
+	MSEB:    Arch5,
+	MSEBR:   Arch5,
+	MSE:     Arch5,
+	MSER:    Arch5,
+	MP:      Arch5,
+	MH:      Arch5,
+	MHY:     Arch8,
+	MGH:     Arch12,
+	MHI:     Arch5,
+	MGHI:    Arch5,
+	MLG:     Arch5,
+	MLGR:    Arch5,
+	ML:      Arch5,
+	MLR:     Arch5,
+	MS:      Arch5,
+	MSC:     Arch12,
+	MSR:     Arch5,
+	MSRKC:   Arch12,
+	MSY:     Arch6,
+	MSG:     Arch5,
+	MSGC:    Arch12,
+	MSGR:    Arch5,
+	MSGRKC:  Arch12,
+	MSGF:    Arch5,
+	MSGFR:   Arch5,
+	MSFI:    Arch8,
+	MSGFI:   Arch8,
+	MYH:     Arch7,
+	MYHR:    Arch7,
+	MYL:     Arch7,
+	MYLR:    Arch7,
+	MY:      Arch7,
+	MYR:     Arch7,
+	NNRK:    Arch13,
+	NNGRK:   Arch13,
+	NNPA:    Arch14,
+	NIAI:    Arch10,
+	NTSTG:   Arch10,
+	NORK:    Arch13,
+	NOGRK:   Arch13,
+	NXRK:    Arch13,
+	NXGRK:   Arch13,
+	O:       Arch5,
+	OR:      Arch5,
+	ORK:     Arch9,
+	OY:      Arch6,
+	OG:      Arch5,
+	OGR:     Arch5,
+	OGRK:    Arch9,
+	OC:      Arch5,
+	OI:      Arch5,
+	OIY:     Arch6,
+	OIHH:    Arch5,
+	OIHL:    Arch5,
+	OIHF:    Arch7,
+	OILH:    Arch5,
+	OILL:    Arch5,
+	OILF:    Arch7,
+	OCRK:    Arch13,
+	OCGRK:   Arch13,
+	PACK:    Arch5,
+	PKA:     Arch5,
+	PKU:     Arch5,
+	PGIN:    Arch5,
+	PGOUT:   Arch5,
+	PCC:     Arch9,
+	PCKMO:   Arch9,
+	PFPO:    Arch7,
+	PFMF:    Arch8,
+	PFCR:    Arch15,
+	PLO:     Arch5,
+	PPA:     Arch10,
+	PRNO:    Arch12,
+	PTFF:    Arch7,
+	PTF:     Arch8,
+	POPCNT:  Arch9,
+	PFD:     Arch8,
+	PFDRL:   Arch8,
+	PC:      Arch5,
+	PR:      Arch5,
+	PT:      Arch5,
+	PTI:     Arch5,
+	PALB:    Arch5,
+	PTLB:    Arch5,
+	QAXTR:   Arch7,
+	QADTR:   Arch7,
+	QPACI:   Arch14,
+	RRXTR:   Arch7,
+	RRDTR:   Arch7,
+	RCHP:    Arch5,
+	RDP:     Arch14,
+	RRBE:    Arch5,
+	RRBM:    Arch9,
+	RP:      Arch5,
+	RSCH:    Arch5,
+	RLL:     Arch5,
+	RLLG:    Arch5,
+	RNSBG:   Arch8,
+	RXSBG:   Arch8,
+	RISBG:   Arch8,
+	RISBGN:  Arch10,
+	RISBHG:  Arch9,
+	RISBLG:  Arch9,
+	ROSBG:   Arch8,
+	SRST:    Arch5,
+	SRSTU:   Arch6,
+	SELR:    Arch13,
+	SELGR:   Arch13,
+	SELFHR:  Arch13,
+	SAR:     Arch5,
+	SAL:     Arch5,
+	SAC:     Arch5,
+	SACF:    Arch5,
+	SAM24:   Arch5,
+	SAM31:   Arch5,
+	SAM64:   Arch5,
+	SRNM:    Arch5,
+	SRNMB:   Arch9,
+	SCHM:    Arch5,
+	SCK:     Arch5,
+	SCKC:    Arch5,
+	SCKPF:   Arch5,
+	SPT:     Arch5,
+	SRNMT:   Arch7,
+	SFPC:    Arch5,
+	SFASR:   Arch7,
+	SPX:     Arch5,
+	SPM:     Arch5,
+	SPKA:    Arch5,
+	SSAR:    Arch5,
+	SSAIR:   Arch6,
+	SSKE:    Arch5,
+	SSM:     Arch5,
+	SRP:     Arch5,
+	SLDA:    Arch5,
+	SLDL:    Arch5,
+	SLA:     Arch5,
+	SLAK:    Arch9,
+	SLAG:    Arch5,
+	SLL:     Arch5,
+	SLLK:    Arch9,
+	SLLG:    Arch5,
+	SRDA:    Arch5,
+	SRDL:    Arch5,
+	SRA:     Arch5,
+	SRAK:    Arch9,
+	SRAG:    Arch5,
+	SRL:     Arch5,
+	SRLK:    Arch9,
+	SRLG:    Arch5,
+	SLXT:    Arch7,
+	SLDT:    Arch7,
+	SRXT:    Arch7,
+	SRDT:    Arch7,
+	SIGP:    Arch5,
+	SORTL:   Arch13,
+	SQXBR:   Arch5,
+	SQXR:    Arch5,
+	SQDB:    Arch5,
+	SQDBR:   Arch5,
+	SQD:     Arch5,
+	SQDR:    Arch5,
+	SQEB:    Arch5,
+	SQEBR:   Arch5,
+	SQE:     Arch5,
+	SQER:    Arch5,
+	SSCH:    Arch5,
+	ST:      Arch5,
+	STY:     Arch6,
+	STG:     Arch5,
+	STD:     Arch5,
+	STDY:    Arch6,
+	STE:     Arch5,
+	STEY:    Arch6,
+	STAM:    Arch5,
+	STAMY:   Arch6,
+	STBEAR:  Arch14,
+	STCPS:   Arch5,
+	STCRW:   Arch5,
+	STC:     Arch5,
+	STCY:    Arch6,
+	STCH:    Arch9,
+	STCMH:   Arch5,
+	STCM:    Arch5,
+	STCMY:   Arch6,
+	STCK:    Arch5,
+	STCKC:   Arch5,
+	STCKE:   Arch5,
+	STCKF:   Arch7,
+	STCTL:   Arch5,
+	STCTG:   Arch5,
+	STAP:    Arch5,
+	STIDP:   Arch5,
+	STPT:    Arch5,
+	STFL:    Arch5,
+	STFLE:   Arch7,
+	STFPC:   Arch5,
+	STGSC:   Arch12,
+	STH:     Arch5,
+	STHY:    Arch6,
+	STHH:    Arch9,
+	STHRL:   Arch8,
+	STFH:    Arch9,
+	STOCFH:  Arch11,
+	STM:     Arch5,
+	STMY:    Arch6,
+	STMG:    Arch5,
+	STMH:    Arch5,
+	STOC:    Arch9,
+	STOCG:   Arch9,
+	STPQ:    Arch5,
+	STPX:    Arch5,
+	STRAG:   Arch5,
+	STRL:    Arch8,
+	STGRL:   Arch8,
+	STRVH:   Arch5,
+	STRV:    Arch5,
+	STRVG:   Arch5,
+	STSCH:   Arch5,
+	STSI:    Arch5,
+	STNSM:   Arch5,
+	STOSM:   Arch5,
+	STURA:   Arch5,
+	STURG:   Arch5,
+	S:       Arch5,
+	SR:      Arch5,
+	SRK:     Arch9,
+	SY:      Arch5,
+	SG:      Arch5,
+	SGR:     Arch5,
+	SGRK:    Arch9,
+	SGF:     Arch5,
+	SGFR:    Arch5,
+	SXBR:    Arch5,
+	SXTR:    Arch7,
+	SXTRA:   Arch9,
+	SDB:     Arch5,
+	SDBR:    Arch5,
+	SDTR:    Arch7,
+	SDTRA:   Arch9,
+	SEB:     Arch5,
+	SEBR:    Arch5,
+	SP:      Arch5,
+	SH:      Arch5,
+	SHY:     Arch6,
+	SGH:     Arch12,
+	SHHHR:   Arch9,
+	SHHLR:   Arch9,
+	SL:      Arch5,
+	SLR:     Arch5,
+	SLRK:    Arch9,
+	SLY:     Arch6,
+	SLG:     Arch5,
+	SLGR:    Arch5,
+	SLGRK:   Arch9,
+	SLGF:    Arch5,
+	SLGFR:   Arch5,
+	SLHHHR:  Arch9,
+	SLHHLR:  Arch9,
+	SLFI:    Arch7,
+	SLGFI:   Arch7,
+	SLB:     Arch5,
+	SLBR:    Arch5,
+	SLBG:    Arch5,
+	SLBGR:   Arch5,
+	SXR:     Arch5,
+	SD:      Arch5,
+	SDR:     Arch5,
+	SE:      Arch5,
+	SER:     Arch5,
+	SW:      Arch5,
+	SWR:     Arch5,
+	SU:      Arch5,
+	SUR:     Arch5,
+	SVC:     Arch5,
+	TAR:     Arch5,
+	TAM:     Arch5,
+	TS:      Arch5,
+	TB:      Arch5,
+	TCXB:    Arch5,
+	TDCXT:   Arch7,
+	TCDB:    Arch5,
+	TDCDT:   Arch7,
+	TCEB:    Arch5,
+	TDCET:   Arch7,
+	TDGXT:   Arch7,
+	TDGDT:   Arch7,
+	TDGET:   Arch7,
+	TP:      Arch5,
+	TPEI:    Arch12,
+	TPI:     Arch5,
+	TPROT:   Arch5,
+	TSCH:    Arch5,
+	TM:      Arch5,
+	TMY:     Arch6,
+	TMHH:    Arch5,
+	TMHL:    Arch5,
+	TMLH:    Arch5,
+	TMLL:    Arch5,
+	TMH:     Arch5,
+	TML:     Arch5,
+	TRACE:   Arch5,
+	TRACG:   Arch5,
+	TABORT:  Arch10,
+	TBEGINC: Arch10,
+	TBEGIN:  Arch10,
+	TEND:    Arch10,
+	TR:      Arch5,
+	TRT:     Arch5,
+	TRTE:    Arch8,
+	TRTR:    Arch6,
+	TRTRE:   Arch8,
+	TRE:     Arch5,
+	TROO:    Arch5,
+	TROT:    Arch5,
+	TRTO:    Arch5,
+	TRTT:    Arch5,
+	TRAP2:   Arch5,
+	TRAP4:   Arch5,
+	UNPK:    Arch5,
+	UNPKA:   Arch5,
+	UNPKU:   Arch5,
+	UPT:     Arch5,
+	VA:      Arch11,
+	VACC:    Arch11,
+	VAP:     Arch12,
+	VAC:     Arch11,
+	VACCC:   Arch11,
+	VN:      Arch11,
+	VNC:     Arch11,
+	VAVG:    Arch11,
+	VAVGL:   Arch11,
+	VBPERM:  Arch12,
+	VBLEND:  Arch15,
+	VCKSM:   Arch11,
+	VCP:     Arch12,
+	VCEQ:    Arch11,
+	VCH:     Arch11,
+	VCHL:    Arch11,
+	VCSPH:   Arch14,
+	VCVB:    Arch12,
+	VCVBG:   Arch12,
+	VCVBQ:   Arch15,
+	VCVD:    Arch12,
+	VCVDG:   Arch12,
+	VCVDQ:   Arch15,
+	VCLZDP:  Arch14,
+	VCLZ:    Arch11,
+	VCTZ:    Arch11,
+	VD:      Arch15,
+	VDP:     Arch12,
+	VDL:     Arch15,
+	VEC:     Arch11,
+	VECL:    Arch11,
+	VERIM:   Arch11,
+	VERLL:   Arch11,
+	VERLLV:  Arch11,
+	VESLV:   Arch11,
+	VESL:    Arch11,
+	VESRA:   Arch11,
+	VESRAV:  Arch11,
+	VESRL:   Arch11,
+	VESRLV:  Arch11,
+	VEVAL:   Arch15,
+	VX:      Arch11,
+	VFAE:    Arch11,
+	VFEE:    Arch11,
+	VFENE:   Arch11,
+	VFA:     Arch11,
+	WFK:     Arch11,
+	VFCE:    Arch11,
+	VFCH:    Arch11,
+	VFCHE:   Arch11,
+	WFC:     Arch11,
+	VCLFNH:  Arch14,
+	VCLFNL:  Arch14,
+	VCRNF:   Arch14,
+	VCFPS:   Arch13,
+	VCDG:    Arch11,
+	VCFPL:   Arch13,
+	VCDLG:   Arch11,
+	VCFN:    Arch14,
+	VCSFP:   Arch13,
+	VCGD:    Arch11,
+	VCLFP:   Arch13,
+	VCLGD:   Arch11,
+	VCNF:    Arch14,
+	VFD:     Arch11,
+	VFLL:    Arch11,
+	VFLR:    Arch11,
+	VFMAX:   Arch12,
+	VFMIN:   Arch12,
+	VFM:     Arch11,
+	VFMA:    Arch11,
+	VFMS:    Arch11,
+	VFNMA:   Arch12,
+	VFNMS:   Arch12,
+	VFPSO:   Arch11,
+	VFSQ:    Arch11,
+	VFS:     Arch11,
+	VFTCI:   Arch11,
+	VGFM:    Arch11,
+	VGFMA:   Arch11,
+	VGEF:    Arch11,
+	VGEG:    Arch11,
+	VGBM:    Arch11,
+	VGEM:    Arch15,
+	VGM:     Arch11,
+	VISTR:   Arch11,
+	VL:      Arch11,
+	VLR:     Arch11,
+	VLREP:   Arch11,
+	VLEBRH:  Arch13,
+	VLEBRF:  Arch13,
+	VLEBRG:  Arch13,
+	VLBRREP: Arch13,
+	VLLEBRZ: Arch13,
+	VLBR:    Arch13,
+	VLC:     Arch11,
+	VLEH:    Arch11,
+	VLEF:    Arch11,
+	VLEG:    Arch11,
+	VLEB:    Arch11,
+	VLEIH:   Arch11,
+	VLEIF:   Arch11,
+	VLEIG:   Arch11,
+	VLEIB:   Arch11,
+	VLER:    Arch13,
+	VFI:     Arch11,
+	VLGV:    Arch11,
+	VLIP:    Arch12,
+	VLLEZ:   Arch11,
+	VLM:     Arch11,
+	VLP:     Arch11,
+	VLRL:    Arch12,
+	VLRLR:   Arch12,
+	VLBB:    Arch11,
+	VLVG:    Arch11,
+	VLVGP:   Arch11,
+	VLL:     Arch11,
+	VMX:     Arch11,
+	VMXL:    Arch11,
+	VMRH:    Arch11,
+	VMRL:    Arch11,
+	VMN:     Arch11,
+	VMNL:    Arch11,
+	VMAE:    Arch11,
+	VMAH:    Arch11,
+	VMALE:   Arch11,
+	VMALH:   Arch11,
+	VMALO:   Arch11,
+	VMAL:    Arch11,
+	VMAO:    Arch11,
+	VMSP:    Arch12,
+	VMP:     Arch12,
+	VME:     Arch11,
+	VMH:     Arch11,
+	VMLE:    Arch11,
+	VMLH:    Arch11,
+	VMLO:    Arch11,
+	VML:     Arch11,
+	VMO:     Arch11,
+	VMSL:    Arch12,
+	VNN:     Arch12,
+	VNO:     Arch11,
+	VNX:     Arch12,
+	VO:      Arch11,
+	VOC:     Arch12,
+	VPK:     Arch11,
+	VPKLS:   Arch11,
+	VPKS:    Arch11,
+	VPKZ:    Arch12,
+	VPKZR:   Arch14,
+	VPSOP:   Arch12,
+	VPERM:   Arch11,
+	VPDI:    Arch11,
+	VPOPCT:  Arch11,
+	VR:      Arch15,
+	VRP:     Arch12,
+	VRL:     Arch15,
+	VREP:    Arch11,
+	VREPI:   Arch11,
+	VSCEF:   Arch11,
+	VSCEG:   Arch11,
+	VSEL:    Arch11,
+	VSDP:    Arch12,
+	VSRP:    Arch12,
+	VSRPR:   Arch14,
+	VSL:     Arch11,
+	VSLB:    Arch11,
+	VSLD:    Arch13,
+	VSLDB:   Arch11,
+	VSRA:    Arch11,
+	VSRAB:   Arch11,
+	VSRD:    Arch13,
+	VSRL:    Arch11,
+	VSRLB:   Arch11,
+	VSEG:    Arch11,
+	VST:     Arch11,
+	VSTEBRH: Arch13,
+	VSTEBRF: Arch13,
+	VSTEBRG: Arch13,
+	VSTBR:   Arch13,
+	VSTEH:   Arch11,
+	VSTEF:   Arch11,
+	VSTEG:   Arch11,
+	VSTEB:   Arch11,
+	VSTER:   Arch13,
+	VSTM:    Arch11,
+	VSTRL:   Arch12,
+	VSTRLR:  Arch12,
+	VSTL:    Arch11,
+	VSTRC:   Arch11,
+	VSTRS:   Arch13,
+	VS:      Arch11,
+	VSCBI:   Arch11,
+	VSP:     Arch12,
+	VSBCBI:  Arch11,
+	VSBI:    Arch11,
+	VSUMG:   Arch11,
+	VSUMQ:   Arch11,
+	VSUM:    Arch11,
+	VTP:     Arch12,
+	VTM:     Arch11,
+	VTZ:     Arch15,
+	VUPH:    Arch11,
+	VUPLH:   Arch11,
+	VUPLL:   Arch11,
+	VUPL:    Arch11,
+	VUPKZ:   Arch12,
+	VUPKZH:  Arch14,
+	VUPKZL:  Arch14,
+	ZAP:     Arch5,
+}
+
 var (
 	ap_Reg_8_11            = &argField{Type: TypeReg, flags: 0x1, BitField: BitField{8, 4}}
 	ap_DispUnsigned_20_31  = &argField{Type: TypeDispUnsigned, flags: 0x10, BitField: BitField{20, 12}}
@@ -2557,6 +3886,7 @@ var (
 	ap_Mask_28_31          = &argField{Type: TypeMask, flags: 0x800, BitField: BitField{28, 4}}
 	ap_ImmUnsigned_16_27   = &argField{Type: TypeImmUnsigned, flags: 0x0, BitField: BitField{16, 12}}
 	ap_ImmUnsigned_32_35   = &argField{Type: TypeImmUnsigned, flags: 0x0, BitField: BitField{32, 4}}
+	ap_ImmUnsigned_20_35   = &argField{Type: TypeImmUnsigned, flags: 0x0, BitField: BitField{20, 16}}
 )
 
 var instFormats = [...]instFormat{
@@ -2726,6 +4056,10 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_Reg_24_27, ap_Reg_28_31, ap_Reg_16_19}},
 	{NCGRK, 0xffff000000000000, 0xb9e5000000000000, 0xf0000000000, // AND WITH COMPLEMENT(64) (NCGRK R1,R2,R3)
 		[8]*argField{ap_Reg_24_27, ap_Reg_28_31, ap_Reg_16_19}},
+	{BDEPG, 0xffff000000000000, 0xb96d000000000000, 0xf0000000000, // BIT DEPOSIT (BDEPG R1,R2,R3)
+		[8]*argField{ap_Reg_24_27, ap_Reg_28_31, ap_Reg_16_19}},
+	{BEXTG, 0xffff000000000000, 0xb96c000000000000, 0xf0000000000, // BIT EXTRACT (BEXTG R1,R2,R3)
+		[8]*argField{ap_Reg_24_27, ap_Reg_28_31, ap_Reg_16_19}},
 	{BAL, 0xff00000000000000, 0x4500000000000000, 0x0, // BRANCH AND LINK (BAL R1,D2(X2,B2))
 		[8]*argField{ap_Reg_8_11, ap_DispUnsigned_20_31, ap_IndexReg_12_15, ap_BaseReg_16_19}},
 	{BALR, 0xff00000000000000, 0x500000000000000, 0x0, // BRANCH AND LINK (BALR R1,R2)
@@ -2858,6 +4192,12 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_Reg_8_11, ap_Reg_12_15, ap_Mask_32_35, ap_RegImSigned16_16_31}},
 	{CFC, 0xffff000000000000, 0xb21a000000000000, 0x0, // COMPARE AND FORM CODEWORD (CFC D2(B2))
 		[8]*argField{ap_DispUnsigned_20_31, ap_BaseReg_16_19}},
+	{CAL, 0xff0f000000000000, 0xc806000000000000, 0x0, // COMPARE AND LOAD (32) (CAL R3,D1(B1),D2(B2))
+		[8]*argField{ap_Reg_8_11, ap_DispUnsigned_20_31, ap_BaseReg_16_19, ap_DispUnsigned_36_47, ap_BaseReg_32_35}},
+	{CALG, 0xff0f000000000000, 0xc807000000000000, 0x0, // COMPARE AND LOAD (64) (CALG R3,D1(B1),D2(B2))
+		[8]*argField{ap_Reg_8_11, ap_DispUnsigned_20_31, ap_BaseReg_16_19, ap_DispUnsigned_36_47, ap_BaseReg_32_35}},
+	{CALGF, 0xff0f000000000000, 0xc80f000000000000, 0x0, // COMPARE AND LOAD (64←32) (CALGF R3,D1(B1),D2(B2))
+		[8]*argField{ap_Reg_8_11, ap_DispUnsigned_20_31, ap_BaseReg_16_19, ap_DispUnsigned_36_47, ap_BaseReg_32_35}},
 	{CRDTE, 0xffff000000000000, 0xb98f000000000000, 0x0, // COMPARE AND REPLACE DAT TABLE ENTRY (CRDTE R1,R3,R2,M4)
 		[8]*argField{ap_Reg_24_27, ap_Reg_16_19, ap_Reg_28_31, ap_Mask_20_23}},
 	{KXBR, 0xffff000000000000, 0xb348000000000000, 0xff0000000000, // COMPARE AND SIGNAL (extended BFP) (KXBR R1,R2)
@@ -3258,6 +4598,10 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_ACReg_24_27, ap_ACReg_28_31}},
 	{CPSDR, 0xffff000000000000, 0xb372000000000000, 0xf0000000000, // COPY SIGN (long) (CPSDR R1,R3,R2)
 		[8]*argField{ap_FPReg_24_27, ap_FPReg_16_19, ap_FPReg_28_31}},
+	{CLZG, 0xffff000000000000, 0xb968000000000000, 0xff0000000000, // COUNT LEADING ZEROS (64) (CLZG R1,R2)
+		[8]*argField{ap_Reg_24_27, ap_Reg_28_31}},
+	{CTZG, 0xffff000000000000, 0xb969000000000000, 0xff0000000000, // COUNT TRAILING ZEROS (64) (CTZG R1,R2)
+		[8]*argField{ap_Reg_24_27, ap_Reg_28_31}},
 	{VSCSHP, 0xff00000000ff0000, 0xe6000000007c0000, 0xffff0000000, // DECIMAL SCALE AND CONVERT AND SPLIT TO HFP (VSCSHP V1,V2,V3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_36_39}},
 	{VSCHP, 0xff00000000ff0000, 0xe600000000740000, 0xf0f00000000, // DECIMAL SCALE AND CONVERT TO HFP (VSCHP V1,V2,V3,M4,M5)
@@ -3644,6 +4988,16 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_Reg_24_27, ap_Reg_28_31, ap_Mask_16_19}},
 	{LGFI, 0xff0f000000000000, 0xc001000000000000, 0x0, // LOAD IMMEDIATE (64→32) (LGFI R1,I2)
 		[8]*argField{ap_Reg_8_11, ap_ImmSigned32_16_47}},
+	{LXAB, 0xff00000000ff0000, 0xe300000000600000, 0x0, // LOAD INDEXED ADDRESS (32←8) (LXAB R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LXAH, 0xff00000000ff0000, 0xe300000000620000, 0x0, // LOAD INDEXED ADDRESS (32←16) (LXAH R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LXAF, 0xff00000000ff0000, 0xe300000000640000, 0x0, // LOAD INDEXED ADDRESS (32←32) (LXAF R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LXAG, 0xff00000000ff0000, 0xe300000000660000, 0x0, // LOAD INDEXED ADDRESS (32←64) (LXAG R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LXAQ, 0xff00000000ff0000, 0xe300000000680000, 0x0, // LOAD INDEXED ADDRESS (32←128) (LXAQ R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
 	{LXDB, 0xff00000000ff0000, 0xed00000000050000, 0xff000000, // LOAD LENGTHENED (long to extended BFP) (LXDB R1,D2(X2,B2))
 		[8]*argField{ap_FPReg_8_11, ap_DispUnsigned_20_31, ap_IndexReg_12_15, ap_BaseReg_16_19}},
 	{LXDBR, 0xffff000000000000, 0xb305000000000000, 0xff0000000000, // LOAD LENGTHENED (long to extended BFP) (LXDBR R1,R2)
@@ -3718,6 +5072,16 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_Reg_8_11, ap_ImmSigned16_16_31}},
 	{LLILF, 0xff0f000000000000, 0xc00f000000000000, 0x0, // LOAD LOGICAL IMMEDIATE (low) (LLILF R1,I2)
 		[8]*argField{ap_Reg_8_11, ap_ImmSigned32_16_47}},
+	{LLXAB, 0xff00000000ff0000, 0xe300000000610000, 0x0, // LOAD LOGICAL INDEXED ADDRESS (64←8) (LLXAB R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LLXAH, 0xff00000000ff0000, 0xe300000000630000, 0x0, // LOAD LOGICAL INDEXED ADDRESS (64←16) (LLXAH R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LLXAF, 0xff00000000ff0000, 0xe300000000650000, 0x0, // LOAD LOGICAL INDEXED ADDRESS (64←32) (LLXAF R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LLXAG, 0xff00000000ff0000, 0xe300000000670000, 0x0, // LOAD LOGICAL INDEXED ADDRESS (64←64) (LLXAG R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
+	{LLXAQ, 0xff00000000ff0000, 0xe300000000690000, 0x0, // LOAD LOGICAL INDEXED ADDRESS (64←128) (LLXAQ R1,D2(X2,B2))
+		[8]*argField{ap_Reg_8_11, ap_DispSigned20_20_39, ap_IndexReg_12_15, ap_BaseReg_16_19}},
 	{LLGFRL, 0xff0f000000000000, 0xc40e000000000000, 0x0, // LOAD LOGICAL RELATIVE LONG (64→32) (LLGFRL R1,RI2)
 		[8]*argField{ap_Reg_8_11, ap_RegImSigned32_16_47}},
 	{LLGT, 0xff00000000ff0000, 0xe300000000170000, 0x0, // LOAD LOGICAL THIRTY ONE BITS (64→31) (LLGT R1,D2(X2,B2))
@@ -4138,6 +5502,8 @@ var instFormats = [...]instFormat{
 		[8]*argField{}},
 	{PFMF, 0xffff000000000000, 0xb9af000000000000, 0xff0000000000, // PERFORM FRAME MANAGEMENT FUNCTION (PFMF R1,R2)
 		[8]*argField{ap_Reg_24_27, ap_Reg_28_31}},
+	{PFCR, 0xff00000000ff0000, 0xeb00000000160000, 0x0, // PERFORM FUNCTIONS WITH CONCURRENT RESULTS (PFCR R1,R3,D2(B2))
+		[8]*argField{ap_Reg_8_11, ap_Reg_12_15, ap_DispSigned20_20_39, ap_BaseReg_16_19}},
 	{PLO, 0xff00000000000000, 0xee00000000000000, 0x0, // PERFORM LOCKED OPERATION (PLO R1,D2(B2),R3,D4(B4))
 		[8]*argField{ap_Reg_8_11, ap_DispUnsigned_20_31, ap_BaseReg_16_19, ap_Reg_12_15, ap_DispUnsigned_36_47, ap_BaseReg_32_35}},
 	{PPA, 0xffff000000000000, 0xb2e8000000000000, 0xf0000000000, // PERFORM PROCESSOR ASSIST (PPA R1,R2,M3)
@@ -4664,6 +6030,8 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VBPERM, 0xff00000000ff0000, 0xe700000000850000, 0xffff0000000, // VECTOR BIT PERMUTE (VBPERM V1,V2,V3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_36_39}},
+	{VBLEND, 0xff00000000ff0000, 0xe700000000890000, 0xff00000000, // VECTOR BLEND (VBLEND V1,V2,V3,V4,M5)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_VecReg_32_35, ap_Mask_20_23, ap_ImmUnsigned_36_39}},
 	{VCKSM, 0xff00000000ff0000, 0xe700000000660000, 0xffff0000000, // VECTOR CHECKSUM (VCKSM V1,V2,V3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_36_39}},
 	{VCP, 0xff00000000ff0000, 0xe600000000770000, 0xf00f0ff0000000, // VECTOR COMPARE DECIMAL (VCP V1,V2,M3)
@@ -4680,18 +6048,26 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_Reg_8_11, ap_VecReg_12_15, ap_Mask_24_27, ap_Mask_28_31, ap_ImmUnsigned_36_39}},
 	{VCVBG, 0xff00000000ff0000, 0xe600000000520000, 0xff00f0000000, // VECTOR CONVERT TO BINARY (VCVBG R1,V2,M3,M4)
 		[8]*argField{ap_Reg_8_11, ap_VecReg_12_15, ap_Mask_24_27, ap_Mask_28_31, ap_ImmUnsigned_36_39}},
+	{VCVBQ, 0xff00000000ff0000, 0xe6000000004e0000, 0xff0ff0000000, // VECTOR CONVERT TO BINARY (128) (VCVBQ V1,V2,M3)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
 	{VCVD, 0xff00000000ff0000, 0xe600000000580000, 0xff0000000000, // VECTOR CONVERT TO DECIMAL (VCVD V1,R2,I3,M4)
 		[8]*argField{ap_VecReg_8_11, ap_Reg_12_15, ap_ImmUnsigned_28_35, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
 	{VCVDG, 0xff00000000ff0000, 0xe6000000005a0000, 0xff0000000000, // VECTOR CONVERT TO DECIMAL (VCVDG V1,R2,I3,M4)
 		[8]*argField{ap_VecReg_8_11, ap_Reg_12_15, ap_ImmUnsigned_28_35, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
+	{VCVDQ, 0xff00000000ff0000, 0xe6000000004a0000, 0xff0000000000, // VECTOR CONVERT TO DECIMAL (128) (VCVDQ V1,V2,I3,M4)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_ImmUnsigned_28_35, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
 	{VCLZDP, 0xff00000000ff0000, 0xe600000000510000, 0xff0ff0000000, // VECTOR COUNT LEADING ZERO DIGITS (VCLZDP V1,V2,M3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
 	{VCLZ, 0xff00000000ff0000, 0xe700000000530000, 0xffff00000000, // VECTOR COUNT LEADING ZEROS (VCLZ V1,V2,M3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VCTZ, 0xff00000000ff0000, 0xe700000000520000, 0xffff00000000, // VECTOR COUNT TRAILING ZEROS (VCTZ V1,V2,M3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
+	{VD, 0xff00000000ff0000, 0xe700000000b20000, 0xff000000000, // VECTOR DIVIDE (VD V1,V2,V3,M4,M5)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_Mask_28_31, ap_ImmUnsigned_36_39}},
 	{VDP, 0xff00000000ff0000, 0xe6000000007a0000, 0xf0000000000, // VECTOR DIVIDE DECIMAL (VDP V1,V2,V3,I4,M5)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_28_35, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
+	{VDL, 0xff00000000ff0000, 0xe700000000b00000, 0xff000000000, // VECTOR DIVIDE LOGICAL (VDL V1,V2,V3,M4,M5)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_Mask_28_31, ap_ImmUnsigned_36_39}},
 	{VEC, 0xff00000000ff0000, 0xe700000000db0000, 0xffff00000000, // VECTOR ELEMENT COMPARE (VEC V1,V2,M3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VECL, 0xff00000000ff0000, 0xe700000000d90000, 0xffff00000000, // VECTOR ELEMENT COMPARE LOGICAL (VECL V1,V2,M3)
@@ -4714,6 +6090,8 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_DispUnsigned_20_31, ap_BaseReg_16_19, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VESRLV, 0xff00000000ff0000, 0xe700000000780000, 0xfff00000000, // VECTOR ELEMENT SHIFT RIGHT LOGICAL (VESRLV V1,V2,V3,M4)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
+	{VEVAL, 0xff00000000ff0000, 0xe700000000880000, 0xf0000000000, // VECTOR EVALUATE (VEVAL V1,V2,V3,V4,I5)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_VecReg_32_35, ap_ImmUnsigned_24_31, ap_ImmUnsigned_36_39}},
 	{VX, 0xff00000000ff0000, 0xe7000000006d0000, 0xffff0000000, // VECTOR EXCLUSIVE OR (VX V1,V2,V3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_36_39}},
 	{VFAE, 0xff00000000ff0000, 0xe700000000820000, 0xf0f00000000, // VECTOR FIND ANY ELEMENT EQUAL (VFAE V1,V2,V3,M4,M5)
@@ -4798,6 +6176,8 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_VecReg_8_11, ap_DispUnsigned_20_31, ap_VecReg_12_15, ap_BaseReg_16_19, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VGBM, 0xff00000000ff0000, 0xe700000000440000, 0xf0000f0000000, // VECTOR GENERATE BYTE MASK (VGBM V1,I2)
 		[8]*argField{ap_VecReg_8_11, ap_ImmUnsigned_16_31, ap_ImmUnsigned_36_39}},
+	{VGEM, 0xff00000000ff0000, 0xe700000000540000, 0xffff00000000, // VECTOR GENERATE ELEMENT MASKS (VGEM V1,V2,M3)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VGM, 0xff00000000ff0000, 0xe700000000460000, 0xf000000000000, // VECTOR GENERATE MASK (VGM V1,I2,I3,M4)
 		[8]*argField{ap_VecReg_8_11, ap_ImmUnsigned_16_23, ap_ImmUnsigned_24_31, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VISTR, 0xff00000000ff0000, 0xe7000000005c0000, 0xff0f00000000, // VECTOR ISOLATE STRING (VISTR V1,V2,M3,M5)
@@ -4938,8 +6318,12 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VPOPCT, 0xff00000000ff0000, 0xe700000000500000, 0xffff00000000, // VECTOR POPULATION COUNT (VPOPCT V1,V2,M3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
+	{VR, 0xff00000000ff0000, 0xe700000000b30000, 0xff000000000, // VECTOR REMAINDER (VR V1,V2,V3,M4,M5)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_Mask_28_31, ap_ImmUnsigned_36_39}},
 	{VRP, 0xff00000000ff0000, 0xe6000000007b0000, 0xf0000000000, // VECTOR REMAINDER DECIMAL (VRP V1,V2,V3,I4,M5)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_28_35, ap_Mask_24_27, ap_ImmUnsigned_36_39}},
+	{VRL, 0xff00000000ff0000, 0xe700000000b10000, 0xff000000000, // VECTOR REMAINDER LOGICAL (VRL V1,V2,V3,M4,M5)
+		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_VecReg_16_19, ap_Mask_32_35, ap_Mask_28_31, ap_ImmUnsigned_36_39}},
 	{VREP, 0xff00000000ff0000, 0xe7000000004d0000, 0x0, // VECTOR REPLICATE (VREP V1,V3,I2,M4)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_ImmUnsigned_16_31, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VREPI, 0xff00000000ff0000, 0xe700000000450000, 0xf000000000000, // VECTOR REPLICATE IMMEDIATE (VREPI V1,I2,M3)
@@ -5028,6 +6412,8 @@ var instFormats = [...]instFormat{
 		[8]*argField{ap_VecReg_12_15, ap_ImmUnsigned_36_39}},
 	{VTM, 0xff00000000ff0000, 0xe700000000d80000, 0xfffff0000000, // VECTOR TEST UNDER MASK (VTM V1,V2)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_ImmUnsigned_36_39}},
+	{VTZ, 0xff00000000ff0000, 0xe6000000007f0000, 0xf0000000000000, // VECTOR TEST ZONED (VTZ V1,V2,I3)
+		[8]*argField{ap_VecReg_12_15, ap_VecReg_16_19, ap_ImmUnsigned_20_35, ap_ImmUnsigned_36_39}},
 	{VUPH, 0xff00000000ff0000, 0xe700000000d70000, 0xffff00000000, // VECTOR UNPACK HIGH (VUPH V1,V2,M3)
 		[8]*argField{ap_VecReg_8_11, ap_VecReg_12_15, ap_Mask_32_35, ap_ImmUnsigned_36_39}},
 	{VUPLH, 0xff00000000ff0000, 0xe700000000d50000, 0xffff00000000, // VECTOR UNPACK LOGICAL HIGH (VUPLH V1,V2,M3)
@@ -95,13 +95,13 @@ func readCSV(file string) (*Prog, error) {
 	if len(table) == 0 {
 		return nil, fmt.Errorf("empty csv input")
 	}
-	if len(table[0]) < 3 {
-		return nil, fmt.Errorf("csv too narrow: need at least four columns")
+	if len(table[0]) < 5 {
+		return nil, fmt.Errorf("csv too narrow: need at least five columns")
 	}
 
 	p := &Prog{}
 	for _, row := range table {
-		add(p, row[0], row[1], row[2], row[3])
+		add(p, row[0], row[1], row[2], row[3], row[4])
 	}
 	return p, nil
 }
@@ -132,6 +132,7 @@ type Inst struct {
 	DontCare uint64
 	Len      uint16
 	Fields   []Field
+	Arch     string // architecture level, such as "arch15"
 }
 
 func (i Inst) String() string {
@@ -326,7 +327,7 @@ func check_flags(flags string) bool {
 // detected instructions into p. One entry may generate multiple intruction
 // entries as each extended mnemonic listed in text is treated like a unique
 // instruction.
-func add(p *Prog, text, mnemonics, encoding, flags string) {
+func add(p *Prog, text, mnemonics, encoding, flags, arch string) {
 	// Parse encoding, building size and offset of each field.
 	// The first field in the encoding is the smallest offset.
 	// And note the MSB is bit 0, not bit 31.
@@ -337,7 +338,10 @@ func add(p *Prog, text, mnemonics, encoding, flags string) {
 	mask, value, dontCare := computeMaskValueReserved(args, text)
 
 	// split mnemonics into individual instructions
-	inst := Inst{Text: text, Encoding: mnemonics, Value: value, Mask: mask, DontCare: dontCare}
+	inst := Inst{Text: text, Encoding: mnemonics, Value: value, Mask: mask, DontCare: dontCare, Arch: arch}
+	if !archRe.MatchString(arch) {
+		log.Fatalf("%s: invalid architecture level %q", text, arch)
+	}
 
 	// order inst.Args according to mnemonics order
 	for i, opr := range operandRe.FindAllString(mnemonics, -1) {
@@ -521,6 +525,9 @@ func add(p *Prog, text, mnemonics, encoding, flags string) {
 	p.Insts = append(p.Insts, inst)
 }
 
+// archRe matches the architecture level column.
+var archRe = regexp.MustCompile(`^arch[0-9]+$`)
+
 // operandRe matches each operand (including opcode) in instruction mnemonics
 var operandRe = regexp.MustCompile(`([[:alpha:]][[:alnum:]_]*\.?)`)
 
@@ -593,6 +600,19 @@ func printDecoder(p *Prog) {
 	}
 	fmt.Fprint(&buf, "}\n\n\n")
 
+	// Emit slice mapping opcode number to the architecture level which introduced it.
+	m = map[string]bool{}
+	fmt.Fprintf(&buf, "var oparch = [...]Arch{\n")
+	for _, inst := range p.Insts {
+		name := inst.Op
+		if ok := m[name]; ok {
+			continue
+		}
+		m[name] = true
+		fmt.Fprintf(&buf, "\t%s: %s,\n", inst.Op, strings.Replace(inst.Arch, "arch", "Arch", 1))
+	}
+	fmt.Fprint(&buf, "}\n\n\n")
+
 	// print out argFields
 	fmt.Fprintf(&buf, "var (\n")
 	m = map[string]bool{}
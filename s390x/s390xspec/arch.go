@@ -0,0 +1,158 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "strings"
+
+// The Principles of Operation does not say which machine introduced each
+// instruction, so the architecture level is looked up by mnemonic here.
+// The levels are named as in the -march options of the GNU toolchain:
+//
+//	arch5	z900	z/Architecture
+//	arch6	z990	long-displacement, message-security assist
+//	arch7	z9-109	extended-immediate, decimal floating point
+//	arch8	z10	general-instructions-extension
+//	arch9	z196	distinct-operands, high-word, interlocked-access
+//	arch10	zEC12	transactional-execution
+//	arch11	z13	vector
+//	arch12	z14	vector-enhancements 1, vector packed decimal
+//	arch13	z15	vector-enhancements 2, miscellaneous-instruction-extensions 3
+//	arch14	z16	neural-network-processing-assist
+//	arch15	z17	vector-enhancements 3, miscellaneous-instruction-extensions 4
+//
+// Every instruction must be listed, so that an instruction added by a
+// new edition of the Principles of Operation is noticed: s390xspec fails
+// for mnemonics missing from the list.
+var archLevels = []struct {
+	level     string
+	mnemonics string
+}{
+	{"arch5", `A AD ADB ADBR ADR AE AEB AEBR AER AG AGF AGFR AGHI AGR AH AHI AL ALC
+		ALCG ALCGR ALCR ALG ALGF ALGFR ALGR ALR AP AR AU AUR AW AWR AXBR AXR
+		BAKR BAL BALR BAS BASR BASSM BC BCR BCT BCTG BCTGR BCTR BRAS BRASL
+		BRC BRCL BRCT BRCTG BRXH BRXHG BRXLE BRXLG BSA BSG BSM BXH BXHG BXLE
+		BXLEG C CD CDB CDBR CDFBR CDFR CDFTR CDGBR CDGR CDR CDS CDSG CE CEB
+		CEBR CEFBR CEFR CEGBR CEGR CER CFC CFDBR CFDR CFEBR CFER CFXBR CFXR
+		CG CGDBR CGDR CGEBR CGER CGF CGFR CGHI CGR CGXBR CGXR CH CHI CKSM CL
+		CLC CLCL CLCLE CLCLU CLG CLGF CLGFR CLGR CLI CLM CLMH CLR CLST CMPSC
+		CP CPYA CR CS CSCH CSG CSP CU12 CU21 CUSE CUTFU CUUTF CVB CVBG CVD
+		CVDG CXBR CXFBR CXFR CXGBR CXGR CXR CY D DD DDB DDBR DDR DE DEB DEBR
+		DER DIDBR DIEBR DL DLG DLGR DLR DP DR DSG DSGF DSGFR DSGR DXBR DXR
+		EAR ED EDMK EFPC EPAIR EPAR EPSW EREG EREGG ESAR ESEA ESTA EX FIDBR
+		FIDR FIEBR FIER FIXBR FIXR HDR HER HSCH IAC IC ICM ICMH IIHH IIHL
+		IILH IILL IPK IPM IPTE ISKE IVSK KDB KDBR KEB KEBR KXBR L LA LAE LAM
+		LARL LASP LCDBR LCDR LCEBR LCER LCGFR LCGR LCR LCTL LCTLG LCXBR LCXR
+		LD LDE LDEB LDEBR LDER LDR LDXBR LDXR LE LEDBR LEDR LER LEXBR LEXR
+		LFPC LG LGF LGFR LGH LGHI LGR LH LHI LLGC LLGF LLGFR LLGH LLGT LLGTR
+		LLIHH LLIHL LLILH LLILL LM LMD LMG LMH LNDBR LNDR LNEBR LNER LNGFR
+		LNGR LNR LNXBR LNXR LPDBR LPDR LPEBR LPER LPGFR LPGR LPQ LPR LPSW
+		LPSWE LPXBR LPXR LR LRA LRAG LRDR LRER LRV LRVG LRVGR LRVH LRVR
+		LTDBR LTDR LTEBR LTER LTGFR LTGR LTR LTXBR LTXR LURA LURAG LXD LXDB
+		LXDBR LXDR LXE LXEB LXEBR LXER LXR LZDR LZER LZXR M MAD MADB MADBR
+		MADR MAE MAEB MAEBR MAER MC MD MDB MDBR MDE MDEB MDEBR MDER MDR ME
+		MEE MEEB MEEBR MEER MER MGHI MH MHI ML MLG MLGR MLR MP MR MS MSCH
+		MSD MSDB MSDBR MSDR MSE MSEB MSEBR MSER MSG MSGF MSGFR MSGR MSR MSTA
+		MVC MVCDK MVCIN MVCK MVCL MVCLE MVCLU MVCP MVCS MVCSK MVI MVIY MVN
+		MVO MVPG MVST MVZ MXBR MXD MXDB MXDBR MXDR MXR N NC NG NGR NI NIHH
+		NIHL NILH NILL NR O OC OG OGR OI OIHH OIHL OILH OILL OR PACK PALB PC
+		PGIN PGOUT PKA PKU PLO PR PT PTI PTLB RCHP RLL RLLG RP RRBE RSCH S
+		SAC SACF SAL SAM24 SAM31 SAM64 SAR SCHM SCK SCKC SCKPF SD SDB SDBR
+		SDR SE SEB SEBR SER SFPC SG SGF SGFR SGR SH SIGP SL SLA SLAG SLB
+		SLBG SLBGR SLBR SLDA SLDL SLG SLGF SLGFR SLGR SLL SLLG SLR SP SPKA
+		SPM SPT SPX SQD SQDB SQDBR SQDR SQE SQEB SQEBR SQER SQXBR SQXR SR
+		SRA SRAG SRDA SRDL SRL SRLG SRNM SRP SRST SSAR SSCH SSKE SSM ST STAM
+		STAP STC STCK STCKC STCKE STCM STCMH STCPS STCRW STCTG STCTL STD STE
+		STFL STFPC STG STH STIDP STM STMG STMH STNSM STOSM STPQ STPT STPX
+		STRAG STRV STRVG STRVH STSCH STSI STURA STURG SU SUR SVC SW SWR SXBR
+		SXR SY TAM TAR TB TBDR TBEDR TCDB TCEB TCXB THDER THDR TM TMH TMHH
+		TMHL TML TMLH TMLL TP TPI TPROT TR TRACE TRACG TRAP2 TRAP4 TRE TROO
+		TROT TRT TRTO TRTT TS TSCH UNPK UNPKA UNPKU UPT X XC XG XGR XI XR
+		XSCH ZAP`},
+	{"arch6", `AHY ALY AY CDSY CHY CLIY CLMY CLY CSY CVBY CVDY ICMY ICY LAMY LAY
+		LB LDY LEY LGB LHY LMY LRAY LY MSY NIY NY OIY OY SHY SLY STAMY STCMY
+		STCY STDY STEY STHY STMY STY TMY XIY XY
+		CSPG CU14 CU24 CU41 CU42 ESAIR IDTE KIMD KLMD KM KMAC KMC SRSTU SSAIR TRTR`},
+	{"arch7", `AFI AGFI ALFI ALGFI CFI CGFI CLFI CLGFI FLOGR IIHF IILF LBR LGBR
+		LGFI LGHR LHR LLC LLCR LLGCR LLGHR LLH LLHR LLIHF LLILF LT LTG NIHF NILF
+		OIHF OILF SLFI SLGFI XIHF XILF
+		ADTR AXTR CDGTR CDSTR CDTR CDUTR CEDTR CEXTR CGDTR CGXTR CSDTR CSXTR
+		CUDTR CUXTR CXGTR CXSTR CXTR CXUTR DDTR DXTR EEDTR EEXTR ESDTR ESXTR
+		FIDTR FIXTR IEDTR IEXTR KDTR KXTR LDETR LDXTR LEDTR LTDTR LTXTR LXDTR
+		MDTR MXTR QADTR QAXTR RRDTR RRXTR SDTR SLDT SLXT SRDT SRXT SXTR TDCDT
+		TDCET TDCXT TDGDT TDGET TDGXT
+		CPSDR LCDFR LDGR LGDR LNDFR LPDFR LFAS SFASR SRNMT PFPO
+		MAY MAYH MAYHR MAYL MAYLR MAYR MY MYH MYHR MYL MYLR MYR
+		CSST ECTG LPTEA MVCOS PTFF STCKF STFLE`},
+	{"arch8", `AGSI ALGSI ALSI ASI CGFRL CGH CGHRL CGHSI CGIB CGIJ CGIT CGRB
+		CGRJ CGRL CGRT CHHSI CHRL CHSI CIB CIJ CIT CLFHSI CLFIT CLGFRL CLGHRL
+		CLGHSI CLGIB CLGIJ CLGIT CLGRB CLGRJ CLGRL CLGRT CLHHSI CLHRL CLIB CLIJ
+		CLRB CLRJ CLRL CLRT CRB CRJ CRL CRT ECAG EXRL LAEY LGFRL LGHRL LGRL LHRL
+		LLGFRL LLGHRL LLHRL LRL LTGF MFY MHY MSFI MSGFI MVGHI MVHHI MVHI PFD
+		PFDRL RISBG RNSBG ROSBG RXSBG STGRL STHRL STRL
+		PFMF PTF TRTE TRTRE`},
+	{"arch9", `AGHIK AGRK AHIK ALGHSIK ALGRK ALHSIK ALRK ARK NGRK NRK OGRK ORK
+		SGRK SLAK SLGRK SLLK SLRK SRAK SRK SRLK XGRK XRK
+		AHHHR AHHLR AIH ALHHHR ALHHLR ALSIH ALSIHN BRCTH CHF CHHR CHLR CIH
+		CLHF CLHHR CLHLR CLIH LBH LFH LHH LLCH LLHH RISBHG RISBLG SHHHR SHHLR
+		SLHHHR SLHHLR STCH STFH STHH
+		LAA LAAG LAAL LAALG LAN LANG LAO LAOG LAX LAXG LPD LPDG
+		LOC LOCG LOCGR LOCR STOC STOCG POPCNT
+		ADTRA AXTRA CDFBRA CDGBRA CDGTRA CDLFBR CDLFTR CDLGBR CDLGTR CEFBRA
+		CEGBRA CELFBR CELGBR CFDBRA CFDTR CFEBRA CFXBRA CFXTR CGDBRA CGDTRA
+		CGEBRA CGXBRA CGXTRA CLFDBR CLFDTR CLFEBR CLFXBR CLFXTR CLGDBR CLGDTR
+		CLGEBR CLGXBR CLGXTR CXFBRA CXFTR CXGBRA CXGTRA CXLFBR CXLFTR CXLGBR
+		CXLGTR DDTRA DXTRA FIDBRA FIEBRA FIXBRA LDXBRA LEDBRA LEXBRA MDTRA
+		MXTRA SDTRA SXTRA SRNMB
+		KMCTR KMF KMO PCC PCKMO RRBM`},
+	{"arch10", `BPP BPRP NIAI CLGT CLT LAT LFHAT LGAT LLGFAT LLGTAT RISBGN
+		ETND NTSTG TABORT TBEGIN TBEGINC TEND CDZT CXZT CZDT CZXT CRDTE PPA`},
+	{"arch11", `LLZRGF LZRF LZRG LOCFH LOCFHR LOCGHI LOCHHI LOCHI STOCFH
+		CDPT CPDT CPXT CXPT LCBB PPNO
+		VA VAC VACC VACCC VAVG VAVGL VCDG VCDLG VCEQ VCGD VCH VCHL VCKSM
+		VCLGD VCLZ VCTZ VEC VECL VERIM VERLL VERLLV VESL VESLV VESRA VESRAV
+		VESRL VESRLV VFA VFAE VFCE VFCH VFCHE VFD VFEE VFENE VFI VFLL VFLR
+		VFM VFMA VFMS VFPSO VFS VFSQ VFTCI VGBM VGEF VGEG VGFM VGFMA VGM
+		VISTR VL VLBB VLC VLEB VLEF VLEG VLEH VLEIB VLEIF VLEIG VLEIH VLGV
+		VLL VLLEZ VLM VLP VLR VLREP VLVG VLVGP VMAE VMAH VMAL VMALE VMALH
+		VMALO VMAO VME VMH VML VMLE VMLH VMLO VMN VMNL VMO VMRH VMRL VMX
+		VMXL VN VNC VNO VO VPDI VPERM VPK VPKLS VPKS VPOPCT VREP VREPI VS
+		VSBCBI VSBI VSCBI VSCEF VSCEG VSEG VSEL VSL VSLB VSLDB VSRA VSRAB
+		VSRL VSRLB VST VSTEB VSTEF VSTEG VSTEH VSTL VSTM VSTRC VSUM VSUMG
+		VSUMQ VTM VUPH VUPL VUPLH VUPLL VX WFC WFK`},
+	{"arch12", `AGH BIC LGG LGSC LLGFSG MG MGH MGRK MSC MSGC MSGRKC MSRKC SGH STGSC
+		IRBM KMA PRNO TPEI
+		VBPERM VFMAX VFMIN VFNMA VFNMS VLRL VLRLR VMSL VNN VNX VOC VSTRL VSTRLR
+		VAP VCP VCVB VCVBG VCVD VCVDG VDP VLIP VMP VMSP VPKZ VPSOP VRP VSDP
+		VSP VSRP VTP VUPKZ`},
+	{"arch13", `NCGRK NCRK NNGRK NNRK NOGRK NORK NXGRK NXRK OCGRK OCRK SELFHR SELGR
+		SELR MVCRL DFLTCC SORTL KDSA
+		VCFPL VCFPS VCLFP VCSFP VLBR VLBRREP VLEBRF VLEBRG VLEBRH VLER VLLEBRZ
+		VSLD VSRD VSTBR VSTEBRF VSTEBRG VSTEBRH VSTER VSTRS`},
+	{"arch14", `LBEAR LPSWEY NNPA QPACI RDP STBEAR
+		VCFN VCLFNH VCLFNL VCLZDP VCNF VCRNF VCSPH VPKZR VSCHP VSCSHP VSRPR
+		VUPKZH VUPKZL`},
+	{"arch15", `BDEPG BEXTG CAL CALG CALGF CLZG CTZG LLXAB LLXAF LLXAG LLXAH
+		LLXAQ LXAB LXAF LXAG LXAH LXAQ PFCR
+		VBLEND VCVBQ VCVDQ VD VDL VEVAL VGEM VR VRL VTZ`},
+}
+
+var archLevel = map[string]string{}
+
+func init() {
+	for _, l := range archLevels {
+		for _, m := range strings.Fields(l.mnemonics) {
+			if _, dup := archLevel[m]; dup {
+				panic("duplicate architecture level for " + m)
+			}
+			archLevel[m] = l.level
+		}
+	}
+}
+
+// instArch returns the architecture level that introduced mnemonic,
+// or "" if the level is not known.
+func instArch(mnemonic string) string {
+	return archLevel[mnemonic]
+}
@@ -10,7 +10,7 @@
 //
 //	s390xspec z_Architecture_Principles_of_Operation.pdf > s390x.csv
 //
-// Each CSV line contains three fields:
+// Each CSV line contains five fields:
 //
 //	instruction
 //		The instruction heading, such as "BRANCH AND LINK".
@@ -20,6 +20,10 @@
 //		The instruction encoding, a sequence of opcode and operands encoding in respective bit positions
 //		such as operand@bitposition each separated by |
 //		Ex: "45@0|R1@8|X2@12|B2@16|D2@20|"
+//	characteristics
+//		The instruction characteristics, such as "B" or "SP Dv".
+//	arch
+//		The architecture level that introduced the instruction, such as "arch5" or "arch15".
 //
 // For more on the exact meaning of these fields, see the Principle of Operations IBM-Z Architecture PDF Manual.
 package main
@@ -42,6 +46,7 @@ type Inst struct {
 	Text  string
 	Enc   string
 	Flags string
+	Arch  string
 }
 
 var stdout *bufio.Writer
@@ -70,8 +75,13 @@ func main() {
 		page := f.Page(pageNum)
 		t1 := getPageContent(page)
 		if len(t1) > 0 && match(t1[0], "Helvetica-Bold", 13.98, "Instructions Arranged by Name") {
-			for n := pageNum; n < pageNum+24; n++ {
+			// The table grows with each edition, so parse pages
+			// until the next list of instructions starts.
+			for n := pageNum; n <= f.NumPage(); n++ {
 				page := f.Page(n)
+				if t := getPageContent(page); n > pageNum && len(t) > 0 && match(t[0], "Helvetica-Bold", 13.98, "Instructions Arranged by") {
+					break
+				}
 				table := parsePage(n, page)
 				all = append(all, table...)
 			}
@@ -80,6 +90,16 @@ func main() {
 			continue
 		}
 	}
+	var missing []string
+	for _, inst := range all {
+		if inst.Arch == "" {
+			missing = append(missing, strconv.Quote(inst.Text))
+		}
+	}
+	if len(missing) > 0 {
+		log.Fatalf("unknown architecture level for %s; add them to archLevels", strings.Join(missing, ", "))
+	}
+
 	stdout = bufio.NewWriter(os.Stdout)
 	for _, inst := range all {
 		if strings.Contains(inst.Name, "\x00I") {
@@ -89,7 +109,7 @@ func main() {
 			r := rune(0x2192)
 			inst.Name = strings.Replace(inst.Name, "I\x00", string(r), -1)
 		}
-		fmt.Fprintf(stdout, "%q,%q,%q,%q\n", inst.Name, inst.Text, inst.Enc, inst.Flags)
+		fmt.Fprintf(stdout, "%q,%q,%q,%q,%q\n", inst.Name, inst.Text, inst.Enc, inst.Flags, inst.Arch)
 	}
 	stdout.Flush()
 
@@ -190,7 +210,7 @@ func parsePage(num int, p pdf.Page) []Inst {
 		}
 		if match(text[0], "Helvetica-Oblique", 9, "") {
 			text = text[2:]
-			insts = append(insts, Inst{heading, mnemonic1, encoding, flags})
+			insts = append(insts, Inst{heading, mnemonic1, encoding, flags, instArch(mnemonic)})
 			continue
 		}
 		if strings.HasPrefix(text[0].S, "(") {
@@ -203,7 +223,7 @@ func parsePage(num int, p pdf.Page) []Inst {
 			heading += " " + text[0].S
 			text = text[1:]
 		}
-		insts = append(insts, Inst{heading, mnemonic1, encoding, flags})
+		insts = append(insts, Inst{heading, mnemonic1, encoding, flags, instArch(mnemonic)})
 		if match(text[0], "Helvetica-Oblique", 9, "") {
 			break
 		}
@@ -288,7 +308,7 @@ func frameMnemonic(mnemonic, format, opcode string) (string, string) {
 		mn, enc = mnemonic_SSE(mnemonic, opcode)
 	case "SSF":
 		mn, enc = mnemonic_SSF(mnemonic, opcode)
-	case "VRI-a", "VRI-b", "VRI-c", "VRI-d", "VRI-e", "VRI-f", "VRI-g", "VRI-h", "VRI-i":
+	case "VRI-a", "VRI-b", "VRI-c", "VRI-d", "VRI-e", "VRI-f", "VRI-g", "VRI-h", "VRI-i", "VRI-j", "VRI-k", "VRI-l":
 		mn, enc = mnemonic_VRI(mnemonic, format, opcode)
 	case "VRR-a", "VRR-b", "VRR-c", "VRR-d", "VRR-e", "VRR-f", "VRR-g", "VRR-h", "VRR-i", "VRR-j", "VRR-k":
 		mn, enc = mnemonic_VRR(mnemonic, format, opcode)
@@ -767,7 +787,7 @@ func mnemonic_SSF(mnemonic, opcode string) (string, string) {
 	val2, _ := strconv.ParseUint(opcode[2:], 16, 16)
 	str2 := strconv.Itoa(int(val2))
 	switch mnemonic {
-	case "LPD", "LPDG":
+	case "LPD", "LPDG", "CAL", "CALG", "CALGF":
 		mnemonic += " R3,D1(B1),D2(B2)"
 	default:
 		mnemonic += " D1(B1),D2(B2),R3"
@@ -820,6 +840,15 @@ func mnemonic_VRI(mnemonic, format, opcode string) (string, string) {
 	case "VRI-i":
 		mnemonic += " V1,R2,I3,M4"
 		enc = str1 + "@0|V1@8|R2@12|//@16|M4@24|I3@28|RXB@36|" + str2 + "@40|??@48"
+	case "VRI-j":
+		mnemonic += " V1,V2,I3,M4"
+		enc = str1 + "@0|V1@8|V2@12|//@16|M4@24|I3@28|RXB@36|" + str2 + "@40|??@48"
+	case "VRI-k":
+		mnemonic += " V1,V2,V3,V4,I5"
+		enc = str1 + "@0|V1@8|V2@12|V3@16|//@20|I5@24|V4@32|RXB@36|" + str2 + "@40|??@48"
+	case "VRI-l":
+		mnemonic += " V1,V2,I3"
+		enc = str1 + "@0|//@8|V1@12|V2@16|I3@20|RXB@36|" + str2 + "@40|??@48"
 	}
 	return mnemonic, enc
 }
@@ -837,7 +866,7 @@ func mnemonic_VRR(mnemonic, format, opcode string) (string, string) {
 			mnemonic += " V1,V2"
 			enc = str1 + "@0|V1@8|V2@12|//@16|RXB@36|" + str2 + "@40|??@48"
 
-		case "VSEG", "VUPH", "VUPLH", "VUPL", "VUPLL", "VCLZ", "VCTZ", "VEC", "VECL", "VLC", "VLP", "VPOPCT": // V1,V2,M3
+		case "VSEG", "VUPH", "VUPLH", "VUPL", "VUPLL", "VCLZ", "VCTZ", "VEC", "VECL", "VGEM", "VLC", "VLP", "VPOPCT": // V1,V2,M3
 			mnemonic += " V1,V2,M3"
 			enc = str1 + "@0|V1@8|V2@12|//@16|M3@32|RXB@36|" + str2 + "@40|??@48"
 
@@ -864,7 +893,7 @@ func mnemonic_VRR(mnemonic, format, opcode string) (string, string) {
 		}
 	case "VRR-c":
 		switch mnemonic {
-		case "VFA", "VFD", "VFM", "VFS", "VCRNF": // V1,V2,V3,M4,M5
+		case "VFA", "VFD", "VFM", "VFS", "VCRNF", "VD", "VDL", "VR", "VRL": // V1,V2,V3,M4,M5
 			mnemonic += " V1,V2,V3,M4,M5"
 			enc = str1 + "@0|V1@8|V2@12|V3@16|//@20|M5@28|M4@32|RXB@36|" + str2 + "@40|??@48"
 
@@ -2,10 +2,10 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 //
-// This file requires gcc and binutils with -march=z16 support.
+// This file requires gcc and binutils with -march=arch15 support.
 // s390xutil runs a series of commands like:
 //    go run map.go -fmt=asm ../s390x.csv > asm.S
-//    /usr/bin/gcc -c asm.S -march=z16
+//    /usr/bin/gcc -c asm.S -march=arch15
 //    /usr/bin/objdump -d asm.o
 // to create the file decode_generated.txt used to verify the disassembler.
 //
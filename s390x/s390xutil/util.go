@@ -25,10 +25,10 @@ import (
 )
 
 // Emit a test file using the generator called name.txt.  This requires
-// a GCC toolchain which supports -march=z16.
+// a GCC toolchain which supports -march=arch15.
 func genOutput(name, tcPfx string, generator func(io.Writer)) {
 	// Generate object code from gcc
-	cmd := exec.Command(tcPfx+"gcc", "-c", "-march=z16", "-x", "assembler-with-cpp", "-o", name+".o", "-")
+	cmd := exec.Command(tcPfx+"gcc", "-c", "-march=arch15", "-x", "assembler-with-cpp", "-o", name+".o", "-")
 	input, _ := cmd.StdinPipe()
 	cmd.Stderr = os.Stderr
 	go func() {
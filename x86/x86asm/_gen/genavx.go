@@ -3,7 +3,8 @@
 // license that can be found in the LICENSE file.
 
 // genavx generates data tables for AVX instructions based on XED data,
-// used in x86asm.
+// used in x86asm. With -apx, it generates the tables for the Intel APX
+// instructions in EVEX map 4 instead.
 package main
 
 import (
@@ -14,6 +15,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -24,6 +26,7 @@ var (
 	db      *xeddata.Database
 	xedPath string
 	outFile string
+	apx     bool
 )
 
 func main() {
@@ -32,6 +35,7 @@ func main() {
 
 	flag.StringVar(&xedPath, "xedPath", "", "XED datafiles location")
 	flag.StringVar(&outFile, "o", "", "output file (stdout if empty)")
+	flag.BoolVar(&apx, "apx", false, "generate APX tables")
 	flag.Parse()
 
 	if xedPath == "" {
@@ -47,6 +51,10 @@ func main() {
 		log.Fatalf("open database: %v", err)
 	}
 
+	if apx {
+		generateAPX()
+		return
+	}
 	generate()
 }
 
@@ -66,6 +74,7 @@ type instruction struct {
 	bcstScale int      // EVEX broadcast scale
 	memBytes  uint8    // Memory width in bytes
 	vsib      bool     // Uses VSIB addressing
+	cpuid     []string // Feature constant names
 }
 
 var registerArgs = map[string]string{
@@ -105,10 +114,31 @@ var registerArgs = map[string]string{
 	"MASK_B()": "argK_B",
 
 	"MASKNOT0()": "argKnot0",
+
+	"TMM_R()": "argTmm_R",
+	"TMM_B()": "argTmm_B",
+	"TMM_N()": "argTmm_N",
+}
+
+// cryptoPattern matches the XED categories, extensions and ISA sets of
+// the cryptographic instructions, such as AES, VAES, SHA512, SM4 and
+// KEYLOCKER_WIDE, so that a new extension of these families is picked
+// up from the XED data. x86asm must decode all of their instructions,
+// including the legacy ones in tables.go, so generate lists them in
+// xedCryptoOps, and the pattern in xedCryptoPattern, for
+// TestCryptoCoverage.
+const cryptoPattern = `^(AVX)?(V?AES|GFNI|V?PCLMULQDQ|SHA[0-9]*|SM[0-9]|KEYLOCKER)(_|$)`
+
+var cryptoRE = regexp.MustCompile(cryptoPattern)
+
+// isCrypto reports whether inst is a cryptographic instruction.
+func isCrypto(inst *xeddata.Inst) bool {
+	return cryptoRE.MatchString(inst.Category) || cryptoRE.MatchString(inst.Extension) || cryptoRE.MatchString(inst.ISASet)
 }
 
 func generate() {
 	var insts []*instruction
+	crypto := make(map[string][]string)
 
 	err := xeddata.WalkInsts(xedPath, func(inst *xeddata.Inst) {
 		inst.Pattern = xeddata.ExpandStates(db, inst.Pattern)
@@ -117,11 +147,17 @@ func generate() {
 		if inst.HasAttribute("AMDONLY") || inst.Extension == "XOP" {
 			return
 		}
+		if isCrypto(inst) && inst.RealOpcode != "N" {
+			if ops := crypto[inst.Extension]; !slices.Contains(ops, inst.Iclass) {
+				crypto[inst.Extension] = append(ops, inst.Iclass)
+			}
+		}
 		if !pset.Is("VEX") && !pset.Is("EVEX") {
 			return
 		}
-		if !strings.HasPrefix(inst.Iclass, "V") && !strings.HasPrefix(inst.Iclass, "K") {
-			// Handle only AVX instructions for now.
+		if !strings.HasPrefix(inst.Iclass, "V") && !strings.HasPrefix(inst.Iclass, "K") &&
+			!strings.HasPrefix(inst.Extension, "AMX") {
+			// Handle only AVX and AMX instructions for now.
 			return
 		}
 		if inst.RealOpcode == "N" {
@@ -136,6 +172,7 @@ func generate() {
 
 		dec.opdigit = findOpdigit(pset)
 		dec.opbyte = findOpbyte(pset)
+		dec.cpuid = isaFeatures(inst, dec.evex)
 
 		// Parse args
 		for _, f := range strings.Fields(inst.Operands) {
@@ -168,7 +205,12 @@ func generate() {
 				}
 				dec.args = append(dec.args, arg)
 			case "MEM0":
-				dec.args = append(dec.args, "argM")
+				if pset["RM=4"] {
+					// AMX tile loads and stores (SIBMEM) require a SIB byte.
+					dec.args = append(dec.args, "argSIBMem")
+				} else {
+					dec.args = append(dec.args, "argM")
+				}
 			}
 		}
 
@@ -229,6 +271,10 @@ func generate() {
 				break
 			}
 		}
+		if slices.Contains(dec.args, "argSIBMem") {
+			// A tile load or store moves up to 64 bytes per row.
+			dec.memBytes = 64
+		}
 
 		insts = append(insts, dec)
 	})
@@ -236,7 +282,61 @@ func generate() {
 		log.Fatalf("walk: %v", err)
 	}
 
-	printTables(outFile, insts)
+	printTables(outFile, insts, crypto)
+}
+
+// isaFeatures returns the names of the x86asm Feature constants for
+// the CPUID features that inst requires. XED names the ISA set of each
+// instruction form, with a suffix giving the vector length for AVX-512;
+// the 128- and 256-bit forms of AVX-512 instructions also require
+// AVX512VL. Older XED data only gives the extension.
+func isaFeatures(inst *xeddata.Inst, evex bool) []string {
+	isa := inst.ISASet
+	if isa == "" {
+		isa = inst.Extension
+	}
+	base, vl := isa, false
+	for _, suffix := range []string{"_128N", "_128", "_256", "_512", "_SCALAR", "_KOP"} {
+		if b, ok := strings.CutSuffix(isa, suffix); ok {
+			base, vl = b, suffix == "_128" || suffix == "_256"
+			break
+		}
+	}
+
+	var feats []string
+	switch {
+	case inst.Iclass == "VPCLMULQDQ" && !evex && (base == "AVX" || base == "AVXAES"):
+		feats = []string{"PCLMULQDQ", "AVX"}
+	case base == "AVXAES":
+		feats = []string{"AES", "AVX"}
+	case base == "AVX2GATHER":
+		feats = []string{"AVX2"}
+	case base == "AVX_GFNI":
+		feats = []string{"GFNI", "AVX"}
+	case base == "AVX512_GFNI", base == "AVX512_VAES", base == "AVX512_VPCLMULQDQ":
+		feats = []string{strings.TrimPrefix(base, "AVX512_")}
+		if !vl {
+			feats = append(feats, "AVX512F")
+		}
+	case strings.HasPrefix(base, "AVX10_2"):
+		feats = []string{"AVX10_2"}
+		if strings.Contains(base, "MOVRS") {
+			feats = append(feats, "MOVRS")
+		}
+		vl = false
+	case base == "AVX512EVEX", base == "AVX512VEX":
+		log.Printf("%s: no ISA set for %s", inst.Iclass, base)
+		return nil
+	default:
+		feats = []string{base}
+	}
+	if vl {
+		feats = append(feats, "AVX512VL")
+	}
+	for i, f := range feats {
+		feats[i] = "Feature" + f
+	}
+	return feats
 }
 
 func findOpdigit(pset xeddata.PatternSet) string {
@@ -361,7 +461,7 @@ func evexBcstScale(pset xeddata.PatternSet, inst *xeddata.Inst) int {
 	return 0
 }
 
-func printTables(outFile string, insts []*instruction) {
+func printTables(outFile string, insts []*instruction, crypto map[string][]string) {
 	// Group by Map and Opcode Byte.
 	// map[string]map[string][]*instruction  // Map -> OpcodeByte -> []Inst
 	groups := make(map[string]map[uint8][]*instruction)
@@ -414,7 +514,7 @@ package x86asm
 	fmt.Fprintf(&buf, "}\n\n")
 
 	if len(ops) > 0 {
-		fmt.Fprintf(&buf, "const maxOp = %s\n\n", ops[len(ops)-1])
+		fmt.Fprintf(&buf, "const maxAVXOp = %s\n\n", ops[len(ops)-1])
 	}
 
 	vsibOps := make(map[string]bool)
@@ -457,6 +557,7 @@ package x86asm
 	bcstScale int8
 	memBytes  uint8
 	vsib      bool
+	cpuid     [2]Feature // CPUID features required
 }
 
 `)
@@ -465,11 +566,37 @@ package x86asm
 	emitTable(&buf, "avxMap0F38", groups["2"])
 	emitTable(&buf, "avxMap0F3A", groups["3"])
 
+	var exts []string
+	for ext := range crypto {
+		exts = append(exts, ext)
+	}
+	sort.Strings(exts)
+	fmt.Fprintf(&buf, "// xedCryptoPattern matches the XED categories, extensions and ISA sets\n")
+	fmt.Fprintf(&buf, "// of the cryptographic instructions.\n")
+	fmt.Fprintf(&buf, "const xedCryptoPattern = `%s`\n\n", cryptoPattern)
+	fmt.Fprintf(&buf, "// xedCryptoOps lists the instructions of the cryptographic extensions\n")
+	fmt.Fprintf(&buf, "// in the XED data, by extension.\n")
+	fmt.Fprintf(&buf, "var xedCryptoOps = map[string][]string{\n")
+	for _, ext := range exts {
+		ops := crypto[ext]
+		sort.Strings(ops)
+		fmt.Fprintf(&buf, "\t%q: {%q", ext, ops[0])
+		for _, op := range ops[1:] {
+			fmt.Fprintf(&buf, ", %q", op)
+		}
+		fmt.Fprintf(&buf, "},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+
 	src, err := format.Source(buf.Bytes())
 	if err != nil {
 		log.Fatalf("gofmt failed: %v\nsource:\n%s", err, buf.Bytes())
 	}
 
+	writeOutput(outFile, src)
+}
+
+func writeOutput(outFile string, src []byte) {
 	w := os.Stdout
 	if outFile != "" {
 		f, err := os.Create(outFile)
@@ -531,6 +658,9 @@ func emitTable(buf *bytes.Buffer, name string, m map[uint8][]*instruction) {
 			if inst.vsib {
 				fields = append(fields, "vsib: true")
 			}
+			if len(inst.cpuid) > 0 {
+				fields = append(fields, fmt.Sprintf("cpuid: [2]Feature{%s}", strings.Join(inst.cpuid, ", ")))
+			}
 
 			fmt.Fprintf(buf, "\t\t{%s},\n", strings.Join(fields, ", "))
 		}
@@ -538,3 +668,336 @@ func emitTable(buf *bytes.Buffer, name string, m map[uint8][]*instruction) {
 	}
 	fmt.Fprintf(buf, "}\n\n")
 }
+
+// apxInstruction is an instruction form in EVEX map 4.
+type apxInstruction struct {
+	iclass  string
+	opbyte  uint8
+	opdigit string   // "0"-"7" or ""
+	pp      string   // 0 = none, 1 = 66, 2 = F3, 3 = F2
+	w       string   // 0 = any, 1 = W0, 2 = W1
+	nd      [2]bool  // EVEX.ND values accepted
+	nf      [2]bool  // EVEX.NF values accepted
+	args    []string // apxArgType names, in XED (i.e. Intel) order
+	byteOp  bool
+	scc     bool
+}
+
+// apxConds lists the condition codes in encoding order, as spelled
+// in XED iclasses and in x86asm ops. CCMPscc and CTESTscc use
+// T and F in place of P and NP.
+var apxConds = [16][2]string{
+	{"O", "O"}, {"NO", "NO"}, {"B", "B"}, {"NB", "AE"},
+	{"Z", "E"}, {"NZ", "NE"}, {"BE", "BE"}, {"NBE", "A"},
+	{"S", "S"}, {"NS", "NS"}, {"P", "P"}, {"NP", "NP"},
+	{"L", "L"}, {"NL", "GE"}, {"LE", "LE"}, {"NLE", "G"},
+}
+
+// apxCondFamilies are the iclass prefixes of the APX instructions
+// that come in one form per condition code.
+var apxCondFamilies = []string{"CCMP", "CFCMOV", "CTEST"}
+
+// apxLegacyOps are the new APX instructions outside EVEX map 4,
+// encoded with REX2.
+var apxLegacyOps = []string{"JMPABS", "POPP", "PUSHP"}
+
+// apxCond splits a conditional iclass into its family and the
+// condition code number, or returns -1 if it is not conditional.
+func apxCond(iclass string) (string, int) {
+	for _, fam := range append([]string{"CMOV"}, apxCondFamilies...) {
+		cc, ok := strings.CutPrefix(iclass, fam)
+		if !ok {
+			continue
+		}
+		for i, c := range apxConds {
+			if cc == c[0] || fam != "CMOV" && fam != "CFCMOV" && (i == 10 && cc == "T" || i == 11 && cc == "F") {
+				return fam, i
+			}
+		}
+	}
+	return "", -1
+}
+
+// apxOpName returns the x86asm op name for the XED iclass.
+func apxOpName(iclass string) string {
+	fam, cc := apxCond(iclass)
+	if cc < 0 {
+		return iclass
+	}
+	name := apxConds[cc][1]
+	if fam == "CCMP" || fam == "CTEST" {
+		switch cc {
+		case 10:
+			name = "T"
+		case 11:
+			name = "F"
+		}
+	}
+	return fam + name
+}
+
+func generateAPX() {
+	var insts []*apxInstruction
+	byKey := make(map[string]*apxInstruction)
+
+	err := xeddata.WalkInsts(xedPath, func(inst *xeddata.Inst) {
+		inst.Pattern = xeddata.ExpandStates(db, inst.Pattern)
+		pset := xeddata.NewPatternSet(inst.Pattern)
+
+		if !pset.Is("EVEX") || !pset["MAP=4"] {
+			return
+		}
+		if inst.RealOpcode == "N" {
+			return
+		}
+
+		fam, cc := apxCond(inst.Iclass)
+		dec := &apxInstruction{
+			iclass:  apxOpName(inst.Iclass),
+			opdigit: findOpdigit(pset),
+			scc:     fam == "CCMP" || fam == "CTEST",
+		}
+		if dec.scc {
+			// The source condition code is in EVEX.P2[3:0],
+			// so all 16 forms share one table entry.
+			if cc != 0 {
+				return
+			}
+		}
+		opbyte := findOpbyte(pset)
+		if opbyte == "" {
+			return
+		}
+		fmt.Sscanf(opbyte, "0x%02X", &dec.opbyte)
+
+		for _, f := range strings.Fields(inst.Operands) {
+			xarg, err := xeddata.NewOperand(db, f)
+			if err != nil {
+				continue
+			}
+			if xarg.Action == "" || !xarg.IsVisible() {
+				continue
+			}
+			switch name := xarg.NameLHS(); name {
+			case "IMM0":
+				switch {
+				case strings.Contains(f, "IMM_CONST1"):
+					dec.args = append(dec.args, "apxArg1")
+				case xarg.Width == "z":
+					dec.args = append(dec.args, "apxArgImmz")
+				case pset["IMM0SIGNED=1"]:
+					dec.args = append(dec.args, "apxArgImm8")
+				default:
+					dec.args = append(dec.args, "apxArgImm8u")
+				}
+			case "REG0", "REG1", "REG2", "REG3":
+				switch rhs := xarg.NameRHS(); rhs {
+				case "GPR8_R()", "GPRv_R()":
+					dec.args = append(dec.args, "apxArgR")
+				case "GPR8_B()", "GPRv_B()":
+					dec.args = append(dec.args, "apxArgRM")
+				case "GPR64_B()":
+					dec.args = append(dec.args, "apxArgB64")
+				case "GPR64_N()":
+					dec.args = append(dec.args, "apxArgN64")
+				case "GPR8_N()", "GPRv_N()", "VGPR8_N()", "VGPRv_N()":
+					// The new data destination is implied by EVEX.ND.
+				case "XED_REG_CL":
+					dec.args = append(dec.args, "apxArgCL")
+				default:
+					log.Printf("unknown reg: %s", rhs)
+					return
+				}
+				if strings.HasPrefix(xarg.NameRHS(), "GPR8") {
+					dec.byteOp = true
+				}
+			case "MEM0":
+				dec.args = append(dec.args, "apxArgRM")
+				if xarg.Width == "b" {
+					dec.byteOp = true
+				}
+			}
+		}
+
+		dec.pp = pset.Match(
+			"VEX_PREFIX=1", "1", // 66
+			"VEX_PREFIX=3", "2", // F3
+			"VEX_PREFIX=2", "3") // F2
+		if dec.pp == "" {
+			dec.pp = "0"
+		}
+		dec.w = pset.Match(
+			"REXW=0", "1",
+			"REXW=1", "2")
+		if dec.w == "" || !strings.HasSuffix(inst.Iclass, "2") && !strings.HasSuffix(inst.Iclass, "2P") {
+			// Only PUSH2 and POP2 use W as an opcode bit.
+			// Elsewhere it selects 64-bit operands.
+			dec.w = "0"
+		}
+		nd := 0
+		if pset["ND=1"] {
+			nd = 1
+		}
+		nf := 0
+		if pset["NF=1"] {
+			nf = 1
+		}
+
+		key := fmt.Sprint(dec.iclass, dec.opbyte, dec.opdigit, dec.pp, dec.w, dec.args, dec.byteOp)
+		if prev := byKey[key]; prev != nil {
+			prev.nd[nd] = true
+			prev.nf[nf] = true
+			return
+		}
+		dec.nd[nd] = true
+		dec.nf[nf] = true
+		byKey[key] = dec
+		insts = append(insts, dec)
+	})
+	if err != nil {
+		log.Fatalf("walk: %v", err)
+	}
+
+	printAPXTables(outFile, insts)
+}
+
+// knownOps returns the ops already defined in tables.go and avx_tables.go.
+func knownOps() map[string]bool {
+	known := make(map[string]bool)
+	re := regexp.MustCompile(`(?m)^\t([A-Z][A-Z0-9_]*):\s+"`)
+	for _, file := range []string{"tables.go", "avx_tables.go"} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, m := range re.FindAllSubmatch(data, -1) {
+			known[string(m[1])] = true
+		}
+	}
+	return known
+}
+
+func printAPXTables(outFile string, insts []*apxInstruction) {
+	known := knownOps()
+	families := make(map[string]bool)
+	for _, op := range apxLegacyOps {
+		families[op] = true
+	}
+Insts:
+	for _, inst := range insts {
+		for _, fam := range apxCondFamilies {
+			if strings.HasPrefix(inst.iclass, fam) {
+				families[fam] = true
+				continue Insts
+			}
+		}
+		if !known[inst.iclass] {
+			families[inst.iclass] = true
+		}
+	}
+	var sorted []string
+	for fam := range families {
+		sorted = append(sorted, fam)
+	}
+	sort.Strings(sorted)
+	var ops []string
+	for _, fam := range sorted {
+		if !slices.Contains(apxCondFamilies, fam) {
+			ops = append(ops, fam)
+			continue
+		}
+		for i := range apxConds {
+			ops = append(ops, apxOpName(fam+apxConds[i][0]))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `// Code generated by genavx -apx. DO NOT EDIT.
+
+package x86asm
+
+// The Intel APX instructions that are not in tables.go or avx_tables.go.
+// The CCMPscc and CTESTscc ops must stay in source condition code order
+// (O, NO, B, AE, E, NE, BE, A, S, NS, T, F, L, GE, LE, G).
+`)
+	fmt.Fprintf(&buf, "const (\n")
+	fmt.Fprintf(&buf, "\t_ Op = iota + maxAVXOp\n")
+	for _, op := range ops {
+		fmt.Fprintf(&buf, "\t%s\n", op)
+	}
+	fmt.Fprintf(&buf, ")\n\n")
+	fmt.Fprintf(&buf, "const maxOp = %s\n\n", ops[len(ops)-1])
+
+	fmt.Fprintf(&buf, "var apxOpNames = [...]string{\n")
+	for _, op := range ops {
+		fmt.Fprintf(&buf, "\t%s: %q,\n", op, op)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	var table [256][]*apxInstruction
+	for _, inst := range insts {
+		table[inst.opbyte] = append(table[inst.opbyte], inst)
+	}
+	digit := func(inst *apxInstruction) int {
+		if inst.opdigit == "" {
+			return -1
+		}
+		return int(inst.opdigit[0] - '0')
+	}
+
+	fmt.Fprintf(&buf, "// apxMap4 lists the EVEX map 4 instruction forms by opcode byte.\n")
+	fmt.Fprintf(&buf, "var apxMap4 = [256][]*apxOptab{\n")
+	for i, list := range table {
+		if len(list) == 0 {
+			continue
+		}
+		sort.SliceStable(list, func(i, j int) bool { return digit(list[i]) < digit(list[j]) })
+		fmt.Fprintf(&buf, "\t0x%02X: {\n", i)
+		for _, inst := range list {
+			var fields []string
+			fields = append(fields, fmt.Sprintf("op: %s", inst.iclass))
+			if len(inst.args) > 0 {
+				fields = append(fields, fmt.Sprintf("args: [3]apxArgType{%s}", strings.Join(inst.args, ", ")))
+			}
+			if inst.pp != "0" {
+				fields = append(fields, fmt.Sprintf("pp: %s", inst.pp))
+			}
+			if inst.w != "0" {
+				fields = append(fields, fmt.Sprintf("w: %s", inst.w))
+			}
+			if inst.opdigit == "" {
+				fields = append(fields, "opdigit: -1")
+			} else {
+				fields = append(fields, fmt.Sprintf("opdigit: %s", inst.opdigit))
+			}
+			if !inst.scc {
+				for _, f := range []struct {
+					name string
+					v    [2]bool
+				}{{"nd", inst.nd}, {"nf", inst.nf}} {
+					switch {
+					case f.v[0] && f.v[1]:
+						fields = append(fields, f.name+": 2")
+					case f.v[1]:
+						fields = append(fields, f.name+": 1")
+					}
+				}
+			}
+			if inst.byteOp {
+				fields = append(fields, "byteOp: true")
+			}
+			if inst.scc {
+				fields = append(fields, "scc: true")
+			}
+			fmt.Fprintf(&buf, "\t\t{%s},\n", strings.Join(fields, ", "))
+		}
+		fmt.Fprintf(&buf, "\t},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("gofmt failed: %v\nsource:\n%s", err, buf.Bytes())
+	}
+	writeOutput(outFile, src)
+}
@@ -0,0 +1,361 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+// This file contains the handling of Intel APX (Advanced Performance
+// Extensions): the REX2 prefix, which extends legacy map 0 and map 1
+// instructions to the general-purpose registers R16-R31, and EVEX map 4,
+// which holds the legacy instructions promoted to EVEX with the
+// new data destination (NDD) and no-flags (NF) forms, together with
+// new instructions like PUSH2, POP2, CCMPscc, CTESTscc and CFCMOVcc.
+// The EVEX map 4 instructions are described by the tables in apx_tables.go,
+// generated from the XED data.
+
+//go:generate go run _gen/genavx.go -apx -o apx_tables.go
+
+// rex2Valid reports whether the opcode byte op may follow a REX2 prefix.
+// The rows of the opcode maps that have neither a ModR/M byte
+// nor a register encoded in the opcode do not accept REX2.
+// In map 0 they are 0x40-0x4F (REX), 0x70-0x7F (Jcc),
+// 0xA0-0xAF and 0xE0-0xEF, and in map 1 they are 0x30-0x3F
+// and 0x80-0x8F (Jcc). The 0F escape byte is not allowed either:
+// REX2.M0 selects map 1 instead.
+func rex2Valid(op byte, map1 bool) bool {
+	if map1 {
+		switch op >> 4 {
+		case 0x3, 0x8:
+			return false
+		}
+		return true
+	}
+	switch op >> 4 {
+	case 0x4, 0x7, 0xA, 0xE:
+		return false
+	}
+	return op != 0x0F
+}
+
+// decodeJMPABS decodes JMPABS, the 64-bit absolute jump encoded as
+// a REX2 prefix with REX2.M0=0 followed by opcode A1 and a 64-bit address.
+func decodeJMPABS(src []byte, pos int, inst Inst) (Inst, error) {
+	if inst.Prefix[pos-1]&PrefixREXW != 0 {
+		return Inst{Len: pos + 1}, ErrUnrecognized
+	}
+	if pos+9 > len(src) {
+		return truncated(src, 64)
+	}
+	inst.Op = JMPABS
+	inst.Opcode = 0xA1 << 24
+	inst.Args[0] = Imm(binary.LittleEndian.Uint64(src[pos+1:]))
+	inst.Mode = 64
+	inst.AddrSize = 64
+	inst.DataSize = 64
+	inst.Len = pos + 9
+	return inst, nil
+}
+
+// decodeAPX decodes an instruction in EVEX map 4.
+// It is called from decodeAVX when the EVEX prefix selects map 4.
+func decodeAPX(src []byte, pos int, vexIndex int, inst Inst) (Inst, error) {
+	p0 := uint8(inst.Prefix[vexIndex+1])
+	p1 := uint8(inst.Prefix[vexIndex+2])
+	p2 := uint8(inst.Prefix[vexIndex+3])
+
+	// The R, X, B, R4 and X4 bits and vvvv are stored inverted.
+	// B4, ND and NF are not.
+	var r, x, b uint8
+	r = (^p0>>7)&1<<3 | (^p0>>4)&1<<4
+	x = (^p0>>6)&1<<3 | (^p1>>2)&1<<4
+	b = (^p0>>5)&1<<3 | (p0>>3)&1<<4
+	w := p1 >> 7
+	vvvv := (^p1>>3)&0xF | (^p2>>3)&1<<4
+	pp := p1 & 3
+	nd := (p2 >> 4) & 1
+	nf := (p2 >> 2) & 1
+	scc := p2 & 0xF
+	dfv := (p1 >> 3) & 0xF
+
+	if pos+1 >= len(src) {
+		return truncated(src, 64)
+	}
+	opbyte := src[pos]
+	modrm := src[pos+1]
+	mod := modrm >> 6
+	reg := (modrm >> 3) & 7
+	rm := modrm & 7
+
+	var match *apxOptab
+	for _, c := range apxMap4[opbyte] {
+		if c.opdigit >= 0 && reg != uint8(c.opdigit) {
+			continue
+		}
+		if c.pp != 0 && pp != c.pp || c.pp == 0 && pp > 1 {
+			continue
+		}
+		if c.w != 0 && w != c.w-1 {
+			continue
+		}
+		if c.scc {
+			if p2&0xF0 != 0 {
+				continue
+			}
+		} else {
+			if p2&0xE3 != 0 {
+				continue
+			}
+			if c.nd < 2 && nd != c.nd || c.nf < 2 && nf != c.nf {
+				continue
+			}
+		}
+		if c.args[0] == apxArgN64 && mod != 3 {
+			continue
+		}
+		match = c
+		break
+	}
+	if match == nil {
+		return Inst{Len: pos + 1}, ErrUnrecognized
+	}
+	// With EVEX.ND=1, vvvv is a new data destination, unless
+	// the instruction uses it as an ordinary source (PUSH2, POP2).
+	ndd := nd == 1 && match.args[0] != apxArgN64
+	if !ndd && !match.scc && match.args[0] != apxArgN64 && vvvv != 0 {
+		return Inst{Len: pos + 1}, ErrUnrecognized
+	}
+
+	inst.Op = match.op
+	inst.cpuid = apxFeatures(match.op)
+	if match.scc {
+		// The CCMPscc and CTESTscc ops are listed in
+		// source condition code order.
+		inst.Op += Op(scc)
+		inst.DFV = dfv
+	} else {
+		inst.NF = nf != 0
+	}
+	inst.Opcode = uint32(opbyte)<<24 | uint32(modrm)<<16
+	pos += 2
+
+	dataSize := 32
+	if w != 0 {
+		dataSize = 64
+	} else if pp == 1 && match.pp == 0 {
+		dataSize = 16
+	}
+	if match.byteOp {
+		dataSize = 8
+	}
+
+	// Decode the memory operand, if any.
+	// EVEX map 4 does not use compressed displacements.
+	var mem Mem
+	if mod != 3 {
+		base := rm | b
+		if rm == 4 {
+			if pos >= len(src) {
+				return truncated(src, 64)
+			}
+			sib := src[pos]
+			pos++
+			index := (sib>>3)&7 | x
+			base = sib&7 | b
+			mem.Scale = 1 << (sib >> 6)
+			if index != 4 {
+				mem.Index = RAX + Reg(index)
+			}
+		}
+		switch {
+		case mod == 0 && rm == 5:
+			mem.Base = RIP
+			inst.PCRelOff = pos
+			inst.PCRel = 4
+		case mod == 0 && rm == 4 && base&7 == 5:
+			// no base
+		default:
+			mem.Base = RAX + Reg(base)
+		}
+		switch {
+		case mod == 0 && (rm == 5 || rm == 4 && base&7 == 5), mod == 2:
+			if pos+4 > len(src) {
+				return truncated(src, 64)
+			}
+			mem.Disp = int64(int32(binary.LittleEndian.Uint32(src[pos:])))
+			pos += 4
+		case mod == 1:
+			if pos >= len(src) {
+				return truncated(src, 64)
+			}
+			mem.Disp = int64(int8(src[pos]))
+			pos++
+		}
+		inst.MemBytes = dataSize / 8
+	}
+
+	gpr := func(size int, n uint8) Reg {
+		if size == 8 && n >= 4 {
+			// With EVEX, as with REX, 4-7 are SPL, BPL, SIL and DIL.
+			return SPB + Reg(n-4)
+		}
+		return baseRegForBits(size) + Reg(n)
+	}
+
+	narg := 0
+	if ndd {
+		inst.Args[narg] = gpr(dataSize, vvvv)
+		narg++
+	}
+	for _, a := range match.args {
+		var arg Arg
+		switch a {
+		case apxArgNone:
+			continue
+		case apxArgR:
+			arg = gpr(dataSize, reg|r)
+		case apxArgRM:
+			if mod != 3 {
+				arg = mem
+			} else {
+				arg = gpr(dataSize, rm|b)
+			}
+		case apxArgN64:
+			arg = RAX + Reg(vvvv)
+		case apxArgB64:
+			arg = RAX + Reg(rm|b)
+		case apxArgImm8, apxArgImm8u:
+			if pos >= len(src) {
+				return truncated(src, 64)
+			}
+			if a == apxArgImm8 {
+				arg = Imm(int8(src[pos]))
+			} else {
+				arg = Imm(src[pos])
+			}
+			pos++
+		case apxArgImmz:
+			if dataSize == 16 {
+				if pos+2 > len(src) {
+					return truncated(src, 64)
+				}
+				arg = Imm(int16(binary.LittleEndian.Uint16(src[pos:])))
+				pos += 2
+			} else {
+				if pos+4 > len(src) {
+					return truncated(src, 64)
+				}
+				arg = Imm(int32(binary.LittleEndian.Uint32(src[pos:])))
+				pos += 4
+			}
+		case apxArgCL:
+			arg = CL
+		case apxArg1:
+			arg = Imm(1)
+		default:
+			return inst, errors.New("unknown APX argument type")
+		}
+		inst.Args[narg] = arg
+		narg++
+	}
+	inst.Mode = 64
+	inst.AddrSize = 64
+	inst.DataSize = dataSize
+	inst.Len = pos
+	return inst, nil
+}
+
+// isAPXEVEX reports whether inst is encoded in EVEX map 4.
+func isAPXEVEX(inst *Inst) bool {
+	return inst.Prefix[0]&0xFF == PrefixEVEX && inst.Prefix[1]&7 == 4
+}
+
+// apxArgType defines how to decode an argument of an EVEX map 4 instruction.
+type apxArgType uint8
+
+const (
+	apxArgNone  apxArgType = iota
+	apxArgR                // ModRM.reg GPR
+	apxArgRM               // ModRM.rm GPR or memory
+	apxArgN64              // EVEX.vvvv 64-bit GPR
+	apxArgB64              // ModRM.rm 64-bit GPR, register only
+	apxArgImm8             // imm8, sign-extended
+	apxArgImm8u            // imm8
+	apxArgImmz             // imm16 or imm32, sign-extended
+	apxArgCL               // CL
+	apxArg1                // constant 1
+)
+
+// An apxOptab describes one form of an EVEX map 4 instruction.
+// The new data destination of the NDD forms (EVEX.ND=1) is not listed
+// in args: it is always the first argument and has the size of the others.
+type apxOptab struct {
+	op      Op
+	args    [3]apxArgType
+	pp      uint8 // 0 = none (66 selects 16-bit operands), 1 = 66, 2 = F3, 3 = F2
+	w       uint8 // 0 = any (W1 selects 64-bit operands), 1 = W0, 2 = W1
+	opdigit int8  // -1 if none
+	nd      uint8 // 0 = ND0, 1 = ND1, 2 = either
+	nf      uint8 // 0 = NF0, 1 = NF1, 2 = either
+	byteOp  bool  // 8-bit operands
+	scc     bool  // EVEX.P2[3:0] is a source condition code, EVEX.vvvv the default flags
+}
+
+// apxFeatures returns the CPUID features required by op in EVEX map 4:
+// APX_F, and the feature of the legacy instruction, if any.
+func apxFeatures(op Op) [2]Feature {
+	switch op {
+	case ADCX, ADOX:
+		return [2]Feature{FeatureAPX_F, FeatureADX}
+	case LZCNT:
+		return [2]Feature{FeatureAPX_F, FeatureLZCNT}
+	case POPCNT:
+		return [2]Feature{FeatureAPX_F, FeaturePOPCNT}
+	case TZCNT:
+		return [2]Feature{FeatureAPX_F, FeatureBMI1}
+	}
+	return [2]Feature{FeatureAPX_F}
+}
+
+// hasDFV reports whether op takes a default flags value (Inst.DFV).
+func hasDFV(op Op) bool {
+	return CCMPO <= op && op <= CCMPG || CTESTO <= op && op <= CTESTG
+}
+
+// dfvString returns the assembler syntax for the default flags value dfv
+// of a CCMPscc or CTESTscc instruction, like {dfv=of, cf}.
+func dfvString(dfv uint8) string {
+	var flags []string
+	for i, name := range []string{"of", "sf", "zf", "cf"} {
+		if dfv&(8>>i) != 0 {
+			flags = append(flags, name)
+		}
+	}
+	return "{dfv=" + strings.Join(flags, ", ") + "}"
+}
+
+// apxPseudoPrefix returns the pseudo-prefix that distinguishes
+// an EVEX map 4 encoding of inst from the legacy encoding:
+// {nf} if it does not update the flags, {evex} if it has the same
+// meaning as the legacy instruction, and the empty string otherwise.
+func apxPseudoPrefix(inst *Inst) string {
+	if !isAPXEVEX(inst) {
+		return ""
+	}
+	switch {
+	case inst.NF:
+		return "{nf} "
+	case hasDFV(inst.Op), CFCMOVO <= inst.Op && inst.Op <= CFCMOVG,
+		inst.Op == PUSH2, inst.Op == PUSH2P, inst.Op == POP2, inst.Op == POP2P:
+		return ""
+	case inst.Prefix[3]&0x10 != 0:
+		// EVEX.ND=1: the new data destination form has no legacy encoding.
+		return ""
+	}
+	return "{evex} "
+}
@@ -54,6 +54,9 @@ func decodeAVX(src []byte, pos int, vex Prefix, vexIndex int, inst Inst, mode in
 	} else if vex == 0x62 { // EVEX
 		evex = true
 		b1 := uint8(inst.Prefix[vexIndex+1])
+		if b1&7 == 4 {
+			return decodeAPX(src, pos, vexIndex, inst)
+		}
 		b2 := uint8(inst.Prefix[vexIndex+2])
 		b3 := uint8(inst.Prefix[vexIndex+3])
 
@@ -141,6 +144,12 @@ func decodeAVX(src []byte, pos int, vex Prefix, vexIndex int, inst Inst, mode in
 		if c.vexL != match_vexL {
 			continue
 		}
+		if evex && c.vexL == 0 && match_vexL == vexL && isZmmForm(c) {
+			// The 512-bit embedded rounding and SAE forms are listed
+			// with VL=0, since EVEX.L'L holds the rounding control.
+			// They apply only when EVEX.b is set for register operands.
+			continue
+		}
 		if c.vexW != vexW {
 			continue
 		}
@@ -167,6 +176,15 @@ func decodeAVX(src []byte, pos int, vex Prefix, vexIndex int, inst Inst, mode in
 	}
 
 	inst.Op = match.op
+	inst.cpuid = match.cpuid
+
+	switch inst.Op {
+	case TILERELEASE, TILEZERO:
+		// The ModRM.rm field is unused and must be zero.
+		if modrm&7 != 0 {
+			return Inst{Len: 1}, ErrUnrecognized
+		}
+	}
 
 	var mod, reg, rm uint8
 	var sib uint8
@@ -432,8 +450,19 @@ func decodeAVX(src []byte, pos int, vex Prefix, vexIndex int, inst Inst, mode in
 				return inst, errors.New("k0 mask not allowed")
 			}
 			arg = K0 + Reg(evex_aaa)
+		case argTmm_R:
+			arg = TMM0 + Reg(reg&7)
+		case argTmm_B:
+			arg = TMM0 + Reg(rm&7)
+		case argTmm_N:
+			arg = TMM0 + Reg((15-vvvv)&7)
 		case argM:
 			arg = mem
+		case argSIBMem:
+			if !haveSIB {
+				return Inst{Len: 1}, ErrUnrecognized
+			}
+			arg = mem
 		}
 
 		if arg != nil {
@@ -467,6 +496,7 @@ func decodeAVX(src []byte, pos int, vex Prefix, vexIndex int, inst Inst, mode in
 			}
 		}
 	}
+	inst.Mode = mode
 	inst.Len = pos
 
 	if match.vsib && haveSIB {
@@ -476,6 +506,17 @@ func decodeAVX(src []byte, pos int, vex Prefix, vexIndex int, inst Inst, mode in
 	return inst, nil
 }
 
+// isZmmForm reports whether c has a 512-bit vector argument.
+func isZmmForm(c *avxOptab) bool {
+	for _, a := range c.args {
+		switch a {
+		case argZmm_R, argZmm_B, argZmm_N:
+			return true
+		}
+	}
+	return false
+}
+
 // fixVSIB calculates the correct vector register size based on data and index element sizes.
 func fixVSIB(inst *Inst, vexL uint8, evex bool, evexV_prime uint8, vexX uint8, sib uint8) {
 	var indexElemBits, dataElemBits int
@@ -610,9 +651,15 @@ const (
 	argK_B
 	argK_N
 
-	argM     // Memory operand (ModRM.rm)
-	argKnot0 // Mask register k1-k7
-	argKmask // Mask register k0-k7
+	// AMX tiles
+	argTmm_R
+	argTmm_B
+	argTmm_N
+
+	argM      // Memory operand (ModRM.rm)
+	argSIBMem // Memory operand (ModRM.rm) that must use a SIB byte
+	argKnot0  // Mask register k1-k7
+	argKmask  // Mask register k0-k7
 )
 
 // hasRC returns true if the instruction supports static rounding control in AVX-512.
@@ -0,0 +1,302 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"slices"
+)
+
+// A Feature is a processor feature reported by the CPUID instruction,
+// such as SSE4_1 or AVX2, which an instruction requires.
+type Feature uint8
+
+const (
+	_ Feature = iota
+
+	// x86-64-v1
+	FeatureMMX
+	FeatureSSE
+	FeatureSSE2
+
+	// x86-64-v2
+	FeatureCMPXCHG16B
+	FeatureLAHF_SAHF
+	FeaturePOPCNT
+	FeatureSSE3
+	FeatureSSSE3
+	FeatureSSE4_1
+	FeatureSSE4_2
+
+	// x86-64-v3
+	FeatureAVX
+	FeatureAVX2
+	FeatureBMI1
+	FeatureBMI2
+	FeatureF16C
+	FeatureFMA
+	FeatureLZCNT
+	FeatureMOVBE
+	FeatureXSAVE
+
+	// x86-64-v4
+	FeatureAVX512F
+	FeatureAVX512BW
+	FeatureAVX512CD
+	FeatureAVX512DQ
+	FeatureAVX512VL
+
+	// Features outside the x86-64 levels.
+	FeatureADX
+	FeatureAES
+	FeatureAESKLE
+	FeatureAMX_BF16
+	FeatureAMX_FP16
+	FeatureAMX_INT8
+	FeatureAMX_TILE
+	FeatureAPX_F
+	FeatureAVX10_2
+	FeatureAVX512_4FMAPS
+	FeatureAVX512_4VNNIW
+	FeatureAVX512_BF16
+	FeatureAVX512_BITALG
+	FeatureAVX512_FP16
+	FeatureAVX512_IFMA
+	FeatureAVX512_VBMI
+	FeatureAVX512_VBMI2
+	FeatureAVX512_VNNI
+	FeatureAVX512_VP2INTERSECT
+	FeatureAVX512_VPOPCNTDQ
+	FeatureAVX512ER
+	FeatureAVX512PF
+	FeatureAVX_IFMA
+	FeatureAVX_NE_CONVERT
+	FeatureAVX_VNNI
+	FeatureAVX_VNNI_INT16
+	FeatureAVX_VNNI_INT8
+	FeatureCET_IBT
+	FeatureCET_SS
+	FeatureCLWB
+	FeatureFSGSBASE
+	FeatureGFNI
+	FeatureHLE
+	FeatureINVPCID
+	FeatureKL
+	FeatureMONITOR
+	FeatureMOVRS
+	FeaturePCLMULQDQ
+	FeaturePRFCHW
+	FeatureRDPID
+	FeatureRDRAND
+	FeatureRDTSCP
+	FeatureRTM
+	FeatureSERIALIZE
+	FeatureSHA
+	FeatureSHA512
+	FeatureSM3
+	FeatureSM4
+	FeatureUINTR
+	FeatureVAES
+	FeatureVPCLMULQDQ
+	FeatureWAITPKG
+	FeatureWIDE_KL
+	FeatureXSAVEC
+	FeatureXSAVEOPT
+	FeatureXSAVES
+
+	maxFeature = FeatureXSAVES
+)
+
+var featureNames = [...]string{
+	FeatureMMX:                 "MMX",
+	FeatureSSE:                 "SSE",
+	FeatureSSE2:                "SSE2",
+	FeatureCMPXCHG16B:          "CMPXCHG16B",
+	FeatureLAHF_SAHF:           "LAHF-SAHF",
+	FeaturePOPCNT:              "POPCNT",
+	FeatureSSE3:                "SSE3",
+	FeatureSSSE3:               "SSSE3",
+	FeatureSSE4_1:              "SSE4_1",
+	FeatureSSE4_2:              "SSE4_2",
+	FeatureAVX:                 "AVX",
+	FeatureAVX2:                "AVX2",
+	FeatureBMI1:                "BMI1",
+	FeatureBMI2:                "BMI2",
+	FeatureF16C:                "F16C",
+	FeatureFMA:                 "FMA",
+	FeatureLZCNT:               "LZCNT",
+	FeatureMOVBE:               "MOVBE",
+	FeatureXSAVE:               "XSAVE",
+	FeatureAVX512F:             "AVX512F",
+	FeatureAVX512BW:            "AVX512BW",
+	FeatureAVX512CD:            "AVX512CD",
+	FeatureAVX512DQ:            "AVX512DQ",
+	FeatureAVX512VL:            "AVX512VL",
+	FeatureADX:                 "ADX",
+	FeatureAES:                 "AES",
+	FeatureAESKLE:              "AESKLE",
+	FeatureAMX_BF16:            "AMX_BF16",
+	FeatureAMX_FP16:            "AMX_FP16",
+	FeatureAMX_INT8:            "AMX_INT8",
+	FeatureAMX_TILE:            "AMX_TILE",
+	FeatureAPX_F:               "APX_F",
+	FeatureAVX10_2:             "AVX10_2",
+	FeatureAVX512_4FMAPS:       "AVX512_4FMAPS",
+	FeatureAVX512_4VNNIW:       "AVX512_4VNNIW",
+	FeatureAVX512_BF16:         "AVX512_BF16",
+	FeatureAVX512_BITALG:       "AVX512_BITALG",
+	FeatureAVX512_FP16:         "AVX512_FP16",
+	FeatureAVX512_IFMA:         "AVX512_IFMA",
+	FeatureAVX512_VBMI:         "AVX512_VBMI",
+	FeatureAVX512_VBMI2:        "AVX512_VBMI2",
+	FeatureAVX512_VNNI:         "AVX512_VNNI",
+	FeatureAVX512_VP2INTERSECT: "AVX512_VP2INTERSECT",
+	FeatureAVX512_VPOPCNTDQ:    "AVX512_VPOPCNTDQ",
+	FeatureAVX512ER:            "AVX512ER",
+	FeatureAVX512PF:            "AVX512PF",
+	FeatureAVX_IFMA:            "AVX_IFMA",
+	FeatureAVX_NE_CONVERT:      "AVX_NE_CONVERT",
+	FeatureAVX_VNNI:            "AVX_VNNI",
+	FeatureAVX_VNNI_INT16:      "AVX_VNNI_INT16",
+	FeatureAVX_VNNI_INT8:       "AVX_VNNI_INT8",
+	FeatureCET_IBT:             "CET_IBT",
+	FeatureCET_SS:              "CET_SS",
+	FeatureCLWB:                "CLWB",
+	FeatureFSGSBASE:            "FSGSBASE",
+	FeatureGFNI:                "GFNI",
+	FeatureHLE:                 "HLE",
+	FeatureINVPCID:             "INVPCID",
+	FeatureKL:                  "KL",
+	FeatureMONITOR:             "MONITOR",
+	FeatureMOVRS:               "MOVRS",
+	FeaturePCLMULQDQ:           "PCLMULQDQ",
+	FeaturePRFCHW:              "PRFCHW",
+	FeatureRDPID:               "RDPID",
+	FeatureRDRAND:              "RDRAND",
+	FeatureRDTSCP:              "RDTSCP",
+	FeatureRTM:                 "RTM",
+	FeatureSERIALIZE:           "SERIALIZE",
+	FeatureSHA:                 "SHA",
+	FeatureSHA512:              "SHA512",
+	FeatureSM3:                 "SM3",
+	FeatureSM4:                 "SM4",
+	FeatureUINTR:               "UINTR",
+	FeatureVAES:                "VAES",
+	FeatureVPCLMULQDQ:          "VPCLMULQDQ",
+	FeatureWAITPKG:             "WAITPKG",
+	FeatureWIDE_KL:             "WIDE_KL",
+	FeatureXSAVEC:              "XSAVEC",
+	FeatureXSAVEOPT:            "XSAVEOPT",
+	FeatureXSAVES:              "XSAVES",
+}
+
+// String returns the name of the feature as written in the Intel manual.
+func (f Feature) String() string {
+	if 0 < f && f <= maxFeature {
+		return featureNames[f]
+	}
+	return fmt.Sprintf("Feature(%d)", int(f))
+}
+
+// Level returns the lowest x86-64 microarchitecture level that includes f.
+func (f Feature) Level() Level {
+	switch {
+	case f <= FeatureSSE2:
+		return LevelV1
+	case f <= FeatureSSE4_2:
+		return LevelV2
+	case f <= FeatureXSAVE:
+		return LevelV3
+	case f <= FeatureAVX512VL:
+		return LevelV4
+	}
+	return LevelOther
+}
+
+// A Level is one of the x86-64 microarchitecture levels defined by the
+// x86-64 psABI, which are also the values of GOAMD64.
+type Level uint8
+
+const (
+	_          Level = iota
+	LevelV1          // baseline x86-64: MMX, SSE, SSE2
+	LevelV2          // adds CMPXCHG16B, LAHF-SAHF, POPCNT, SSE3, SSSE3, SSE4_1, SSE4_2
+	LevelV3          // adds AVX, AVX2, BMI1, BMI2, F16C, FMA, LZCNT, MOVBE, XSAVE
+	LevelV4          // adds AVX512F, AVX512BW, AVX512CD, AVX512DQ, AVX512VL
+	LevelOther       // needs a feature that no level guarantees, such as AES
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelV1, LevelV2, LevelV3, LevelV4:
+		return fmt.Sprintf("v%d", int(l))
+	case LevelOther:
+		return "other"
+	}
+	return fmt.Sprintf("Level(%d)", int(l))
+}
+
+// Features returns the CPUID features that the processor must report
+// to execute inst, as recorded by Decode for the instruction's encoding
+// form. The instructions of the original x86-64 instruction set, such
+// as the general-purpose and x87 instructions, require no features.
+func (inst Inst) Features() []Feature {
+	var feats []Feature
+	for _, f := range inst.cpuid {
+		if f == 0 || f == FeatureLAHF_SAHF && inst.Mode != 64 {
+			// LAHF and SAHF are always available outside 64-bit mode.
+			continue
+		}
+		feats = append(feats, f)
+	}
+	if usesEGPR(&inst) && !slices.Contains(feats, FeatureAPX_F) {
+		feats = append(feats, FeatureAPX_F)
+	}
+	return feats
+}
+
+// Level returns the lowest x86-64 microarchitecture level that
+// guarantees all the features inst requires, or LevelOther if inst
+// requires a feature outside every level.
+func (inst Inst) Level() Level {
+	l := LevelV1
+	for _, f := range inst.Features() {
+		l = max(l, f.Level())
+	}
+	return l
+}
+
+// usesEGPR reports whether inst uses the REX2 prefix or one of the
+// extended general-purpose registers R16 through R31 of Intel APX.
+func usesEGPR(inst *Inst) bool {
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p.IsREX2() {
+			return true
+		}
+	}
+	for _, a := range inst.Args {
+		switch a := a.(type) {
+		case nil:
+			return false
+		case Reg:
+			if isEGPR(a) {
+				return true
+			}
+		case Mem:
+			if isEGPR(a.Base) || isEGPR(a.Index) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isEGPR(r Reg) bool {
+	return R16B <= r && r <= R31B || R16W <= r && r <= R31W ||
+		R16L <= r && r <= R31L || R16 <= r && r <= R31
+}
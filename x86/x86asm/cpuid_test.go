@@ -0,0 +1,93 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestFeatures(t *testing.T) {
+	tests := []struct {
+		enc   string
+		mode  int
+		feats string
+		level Level
+	}{
+		{"01c8", 64, "[]", LevelV1},                         // add eax, ecx
+		{"0ffcc1", 64, "[MMX]", LevelV1},                    // paddb mm0, mm1
+		{"660ffcc1", 64, "[SSE2]", LevelV1},                 // paddb xmm0, xmm1
+		{"0fc5c000", 64, "[SSE]", LevelV1},                  // pextrw eax, mm0, 0
+		{"660fc5c000", 64, "[SSE2]", LevelV1},               // pextrw eax, xmm0, 0
+		{"660f3a15c000", 64, "[SSE4_1]", LevelV2},           // pextrw eax, xmm0, 0
+		{"f30fb8c1", 64, "[POPCNT]", LevelV2},               // popcnt eax, ecx
+		{"9f", 64, "[LAHF-SAHF]", LevelV2},                  // lahf
+		{"9f", 32, "[]", LevelV1},                           // lahf
+		{"480fc70e", 64, "[CMPXCHG16B]", LevelV2},           // cmpxchg16b [rsi]
+		{"f20f38f1c1", 64, "[SSE4_2]", LevelV2},             // crc32 eax, ecx
+		{"0f38f006", 64, "[MOVBE]", LevelV3},                // movbe eax, [rsi]
+		{"f30fbdc1", 64, "[LZCNT]", LevelV3},                // lzcnt eax, ecx
+		{"c5f458c2", 64, "[AVX]", LevelV3},                  // vaddps ymm0, ymm1, ymm2
+		{"c4e27d58c1", 64, "[AVX2]", LevelV3},               // vpbroadcastd ymm0, xmm1
+		{"c4e271a8c2", 64, "[FMA]", LevelV3},                // vfmadd213ps xmm0, xmm1, xmm2
+		{"62f1744858c2", 64, "[AVX512F]", LevelV4},          // vaddps zmm0, zmm1, zmm2
+		{"62f1740858c2", 64, "[AVX512F AVX512VL]", LevelV4}, // vaddps xmm0, xmm1, xmm2
+		{"62f27d4866c1", 64, "[AVX512BW]", LevelV4},         // vpblendmb zmm0, zmm1, zmm0
+		{"62f2754850c2", 64, "[AVX512_VNNI]", LevelOther},   // vpdpbusd zmm0, zmm1, zmm2
+		{"c4e27550c2", 64, "[AVX_VNNI]", LevelOther},        // vpdpbusd ymm0, ymm1, ymm2
+		{"0f38c8c1", 64, "[SHA]", LevelOther},               // sha1nexte xmm0, xmm1
+		{"660f38dcc1", 64, "[AES]", LevelOther},             // aesenc xmm0, xmm1
+		{"c4e271dcc2", 64, "[AES AVX]", LevelOther},         // vaesenc xmm0, xmm1, xmm2
+		{"c4e27b4b0c18", 64, "[AMX_TILE]", LevelOther},      // tileloadd tmm1, [rax+rbx]
+		{"d51801c0", 64, "[APX_F]", LevelOther},             // add r16, rax
+		{"62f4fc1801cb", 64, "[APX_F]", LevelOther},         // add rax, rbx, rcx
+		{"62f47c0888c1", 64, "[APX_F POPCNT]", LevelOther},  // popcnt eax, ecx
+		{"0fc7f0", 64, "[RDRAND]", LevelOther},              // rdrand eax
+		{"0f01f9", 64, "[RDTSCP]", LevelOther},              // rdtscp
+		{"f30f38d806", 64, "[AESKLE WIDE_KL]", LevelOther},  // aesencwide128kl [rsi]
+		{"0f01d6", 64, "[RTM]", LevelOther},                 // xtest
+	}
+	for _, tt := range tests {
+		enc, err := hex.DecodeString(tt.enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := Decode(enc, tt.mode)
+		if err != nil {
+			t.Errorf("Decode(%s, %d): %v", tt.enc, tt.mode, err)
+			continue
+		}
+		if feats := fmt.Sprint(inst.Features()); feats != tt.feats {
+			t.Errorf("%s (%s): Features() = %s, want %s", tt.enc, inst.Op, feats, tt.feats)
+		}
+		if level := inst.Level(); level != tt.level {
+			t.Errorf("%s (%s): Level() = %v, want %v", tt.enc, inst.Op, level, tt.level)
+		}
+	}
+}
+
+func TestFeatureTables(t *testing.T) {
+	for _, m := range []*[256][]*avxOptab{&avxMap0F, &avxMap0F38, &avxMap0F3A} {
+		for _, list := range m {
+			for _, e := range list {
+				if e.cpuid[0] == 0 {
+					t.Errorf("%s: no CPUID features", e.op)
+				}
+			}
+		}
+	}
+	for f := Feature(1); f <= maxFeature; f++ {
+		if featureNames[f] == "" {
+			t.Errorf("Feature(%d) has no name", f)
+		}
+	}
+	if s := Feature(0).String(); s != "Feature(0)" {
+		t.Errorf("Feature(0).String() = %q", s)
+	}
+	if s := LevelV3.String(); s != "v3" {
+		t.Errorf("LevelV3.String() = %q", s)
+	}
+}
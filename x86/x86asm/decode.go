@@ -48,7 +48,8 @@ const (
 	xCondAddrSize // switch on address size
 	xCondIsMem    // switch on memory vs register argument
 
-	xSetOp // set instruction opcode
+	xSetOp      // set instruction opcode
+	xSetFeature // add required CPUID feature
 
 	xReadSlashR // read /r
 	xReadIb     // read ib
@@ -262,6 +263,9 @@ func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
 		rex           Prefix // rex byte if present (or 0)
 		rexUsed       Prefix // bits used in rex byte
 		rexIndex      = -1   // index of rex byte
+		rex2          Prefix // rex2 payload byte if present (or 0)
+		rex2Index     = -1   // index of rex2 prefix
+		rex2Escape    bool   // rex2 selects map 1: match a virtual 0F opcode byte
 		vex           Prefix // use vex encoding
 		vexIndex      = -1   // index of vex prefix
 
@@ -274,6 +278,8 @@ func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
 		mod       int
 		regop     int
 		rm        int
+		regop4    int // REX2.R4 extension of regop, for GPR arguments only
+		rm4       int // REX2.B4 extension of rm, for GPR arguments only
 
 		// if ModR/M is memory reference, Mem form
 		mem     Mem
@@ -473,6 +479,36 @@ ReadPrefixes:
 				inst.Prefix[dataSizeIndex] |= PrefixIgnored
 			}
 		}
+	} else if pos+1 < len(src) && mode == 64 && Prefix(src[pos]).IsREX2() && vex == 0 {
+		// Read REX2 prefix (Intel APX). The payload carries the REX bits,
+		// which we record in rex so that the rest of the decoder treats
+		// them the same way, plus a fourth bit for each register field
+		// and a bit selecting opcode map 1 without the 0F escape byte.
+		if pos+1 >= len(inst.Prefix) {
+			return instPrefix(src[0], mode) // too long
+		}
+		rex2 = Prefix(src[pos+1])
+		rex2Index = pos
+		rex = PrefixREX | rex2&0x0F
+		inst.Prefix[pos] = PrefixREX2 | PrefixImplicit
+		inst.Prefix[pos+1] = rex2 | PrefixImplicit
+		pos += 2
+		if rex&PrefixREXW != 0 {
+			dataMode = 64
+			if dataSizeIndex >= 0 {
+				inst.Prefix[dataSizeIndex] |= PrefixIgnored
+			}
+		}
+		if pos >= len(src) {
+			return truncated(src, mode)
+		}
+		rex2Escape = rex2&PrefixREX2M0 != 0
+		if !rex2Escape && src[pos] == 0xA1 {
+			return decodeJMPABS(src, pos, inst)
+		}
+		if !rex2Valid(src[pos], rex2Escape) {
+			return Inst{Len: pos + 1}, ErrUnrecognized
+		}
 	}
 
 	// Decode instruction stream, interpreting decoding instructions.
@@ -522,6 +558,12 @@ Decode:
 				rexUsed |= PrefixREXR
 				regop |= 8
 			}
+			if rex2&PrefixREX2R4 != 0 {
+				regop4 = 16
+			}
+			if rex2&PrefixREX2B4 != 0 {
+				rm4 = 16
+			}
 			if addrMode == 16 {
 				// 16-bit modrm form
 				if mod != 3 {
@@ -576,6 +618,12 @@ Decode:
 						rexUsed |= PrefixREXX
 						index |= 8
 					}
+					if rex2&PrefixREX2B4 != 0 {
+						base |= 16
+					}
+					if rex2&PrefixREX2X4 != 0 {
+						index |= 16
+					}
 
 					mem.Scale = 1 << uint(scale)
 					if index == 4 {
@@ -596,7 +644,7 @@ Decode:
 					if mod == 0 && rm&7 == 5 || rm&7 == 4 {
 						// base omitted
 					} else if mod != 3 {
-						mem.Base = baseRegForBits(addrMode) + Reg(rm)
+						mem.Base = baseRegForBits(addrMode) + Reg(rm|rm4)
 					}
 				}
 
@@ -661,6 +709,9 @@ Decode:
 				return truncated(src, mode)
 			}
 			b := src[pos]
+			if rex2Escape {
+				b = 0x0F
+			}
 			n := int(decoder[pc])
 			pc++
 			for i := 0; i < n; i++ {
@@ -668,7 +719,11 @@ Decode:
 				pc += 2
 				if b == byte(xb) {
 					pc = xpc
-					pos++
+					if rex2Escape {
+						rex2Escape = false
+					} else {
+						pos++
+					}
 					if opshift >= 0 {
 						inst.Opcode |= uint32(b) << uint(opshift)
 						opshift -= 8
@@ -1028,6 +1083,14 @@ Decode:
 			inst.Op = Op(decoder[pc])
 			pc++
 
+		case xSetFeature:
+			if inst.cpuid[0] == 0 {
+				inst.cpuid[0] = Feature(decoder[pc])
+			} else {
+				inst.cpuid[1] = Feature(decoder[pc])
+			}
+			pc++
+
 		case xArg1,
 			xArg3,
 			xArgAL,
@@ -1149,6 +1212,10 @@ Decode:
 		case xArgR8, xArgR16, xArgR32, xArgR64, xArgXmm, xArgXmm1, xArgDR0dashDR7:
 			base := baseReg[x]
 			index := Reg(regop)
+			switch decodeOp(x) {
+			case xArgR8, xArgR16, xArgR32, xArgR64:
+				index += Reg(regop4)
+			}
 			if rex != 0 && base == AL && index >= 4 {
 				rexUsed |= PrefixREX
 				index -= 4
@@ -1189,6 +1256,7 @@ Decode:
 				rexUsed |= PrefixREXB
 				index += 8
 			}
+			index += Reg(rm4)
 			inst.Args[narg] = base + index
 			narg++
 
@@ -1200,6 +1268,9 @@ Decode:
 				rexUsed |= PrefixREXB
 				index += 8
 			}
+			if rex2&PrefixREX2B4 != 0 && decodeOp(x) != xArgSTi {
+				index += 16
+			}
 			if rex != 0 && base == AL && index >= 4 {
 				rexUsed |= PrefixREX
 				index -= 4
@@ -1226,11 +1297,14 @@ Decode:
 					// There are only 8 MMX registers, so these ignore the REX.X bit.
 					index &= 7
 				case xArgRM8:
+					index += Reg(rm4)
 					if rex != 0 && index >= 4 {
 						rexUsed |= PrefixREX
 						index -= 4
 						base = SPB
 					}
+				case xArgRM16, xArgRM32, xArgRM64, xArgR32M16, xArgR32M8, xArgR64M16:
+					index += Reg(rm4)
 				case xArgYmm2M256:
 					if vex == 0xC4 && inst.Prefix[vexIndex+1]&0x40 == 0x40 {
 						index += 8
@@ -1541,6 +1615,17 @@ Decode:
 		}
 	}
 
+	// REX2.W turns PUSH and POP of a register into PUSHP and POPP,
+	// which hint that the push and pop are a matched pair.
+	if rex2Index >= 0 && rex&PrefixREXW != 0 {
+		switch op := inst.Opcode >> 24; {
+		case inst.Op == PUSH && 0x50 <= op && op <= 0x57:
+			inst.Op = PUSHP
+		case inst.Op == POP && 0x58 <= op && op <= 0x5F:
+			inst.Op = POPP
+		}
+	}
+
 	// If REX was present, mark implicit if all the 1 bits were consumed.
 	if rexIndex >= 0 {
 		if rexUsed != 0 {
@@ -0,0 +1,1577 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// This file contains the encoder, the inverse of Decode.
+//
+// Rather than keep a second description of the instruction set,
+// the encoder runs the decoding tables backward: the decoder program
+// in tables.go for the legacy instructions, avx_tables.go for VEX and
+// EVEX, and apx_tables.go for EVEX map 4. Every form of inst.Op that
+// can hold inst's arguments yields a candidate encoding, and Encode
+// returns the shortest candidate that Decode turns back into inst.
+// Checking the candidates with Decode keeps the two directions in
+// agreement even where the tables are ambiguous, for example when
+// two opcodes share a form or a condition in the decoder program
+// excludes some operand values.
+
+// Encode returns the machine code for inst, the shortest encoding
+// that Decode, in processor mode inst.Mode, decodes to an instruction
+// with the same Op, Args and AVX-512 and APX flags as inst.
+// A zero Mode means 64-bit mode.
+//
+// The operand and address sizes are those of inst.DataSize and
+// inst.AddrSize, or of the operands if those are zero. A DataSize of 8
+// selects the forms with byte operands. inst.MemBytes, if not zero,
+// selects among memory operand sizes, as for FLD m32fp and FLD m64fp;
+// otherwise the memory operand has the operand size, if any form
+// has it, so INC [RAX] with a DataSize of 32 is INC m32, not INC m8.
+// Encode reports an error if the memory operand size is ambiguous.
+// The segment of a memory operand is encoded as a
+// segment override prefix. The prefixes in inst.Prefix that Decode
+// marks as neither implicit nor ignored, like LOCK or REP, are kept;
+// Encode computes the others, like REX, VEX and EVEX, from the
+// arguments, so an Inst returned by Decode can encode to fewer bytes
+// than it was decoded from. The arguments of a string instruction,
+// like MOVSB, are implied by the opcode and may be omitted.
+//
+// As in Decode, a Rel argument is relative to the end of the
+// instruction. A caller moving a branch target must therefore
+// account for the length of the encoding Encode chooses, which
+// depends on the size of the displacement.
+func Encode(inst Inst) ([]byte, error) {
+	if inst.Mode == 0 {
+		inst.Mode = 64
+	}
+	switch inst.Mode {
+	case 16, 32, 64:
+		// ok
+	default:
+		return nil, ErrInvalidMode
+	}
+
+	if inst.Op == 0 && inst.Prefix[0] != 0 && inst.Prefix[1] == 0 {
+		// A prefix that Decode reported by itself
+		// because the instruction after it was invalid.
+		return []byte{byte(inst.Prefix[0])}, nil
+	}
+
+	e := newEncoder(&inst)
+	var cands [][]byte
+	cands = e.appendLegacy(cands)
+	cands = e.appendAVX(cands)
+	cands = e.appendAPX(cands)
+	slices.SortStableFunc(cands, func(a, b []byte) int {
+		return len(a) - len(b)
+	})
+	// Without inst.MemBytes, the operand size selects the size
+	// of a memory operand. Candidates of another memory size are
+	// used only if they all agree on it.
+	hasMem := inst.MemBytes == 0 && slices.ContainsFunc(inst.Args[:], func(a Arg) bool {
+		_, ok := a.(Mem)
+		return ok
+	})
+	var other []byte
+	otherBytes, ambiguous := 0, false
+	for _, enc := range cands {
+		if len(enc) > 15 {
+			break
+		}
+		dec, err := Decode(enc, inst.Mode)
+		if err != nil || dec.Len != len(enc) || !sameInst(&inst, &dec) {
+			continue
+		}
+		if !hasMem || inst.DataSize != 0 && dec.MemBytes*8 == inst.DataSize {
+			return enc, nil
+		}
+		if other == nil {
+			other, otherBytes = enc, dec.MemBytes
+		} else if dec.MemBytes != otherBytes {
+			ambiguous = true
+		}
+	}
+	if ambiguous {
+		return nil, fmt.Errorf("cannot encode %v: ambiguous memory operand size", inst)
+	}
+	if other != nil {
+		return other, nil
+	}
+	return nil, fmt.Errorf("cannot encode %v", inst)
+}
+
+// An encoder holds the parts of an instruction's encoding that do not
+// depend on the form being tried.
+type encoder struct {
+	inst     *Inst
+	prefixes []byte // the prefixes to keep from inst.Prefix
+	rex      Prefix // an explicit REX prefix from inst.Prefix, or 0
+	addrSize int    // address size implied by the memory operands, or 0
+}
+
+func newEncoder(inst *Inst) *encoder {
+	e := &encoder{inst: inst}
+	for _, p := range keptPrefixes(inst) {
+		if p.IsREX() {
+			e.rex = p
+			continue
+		}
+		e.prefixes = append(e.prefixes, byte(p))
+	}
+	for _, a := range inst.Args {
+		if m, ok := a.(Mem); ok {
+			if size := regAddrSize(m.Base); size != 0 {
+				e.addrSize = size
+			} else if size := regAddrSize(m.Index); size != 0 {
+				e.addrSize = size
+			}
+		}
+	}
+	return e
+}
+
+// keptPrefixes returns the prefixes of inst that Encode keeps:
+// the ones that are not ignored and whose effect is not recorded
+// elsewhere in inst. The operand and address size prefixes are
+// recorded in DataSize and AddrSize, the segment override of a
+// memory operand in its Segment, and the REX, REX2, VEX and EVEX
+// prefixes, unless they have unused bits, in the arguments.
+// The mandatory prefixes that are part of the opcode, like the
+// F3 of POPCNT, are kept too, so that REP MOVSB does not become
+// MOVSB, and so is an operand size prefix that the instruction
+// does not use, like the 66 of INC m8.
+func keptPrefixes(inst *Inst) []Prefix {
+	var kept []Prefix
+	start := 0
+	switch inst.Prefix[0] & 0xFF {
+	case PrefixVEX2Bytes:
+		start = 2
+	case PrefixVEX3Bytes:
+		start = 3
+	case PrefixEVEX:
+		start = 4
+	}
+	for _, p := range inst.Prefix[start:] {
+		if p == 0 {
+			break
+		}
+		if p&PrefixIgnored != 0 || p.IsREX2() || p&PrefixImplicit != 0 && p.IsREX() {
+			continue
+		}
+		switch p & 0xFF {
+		case PrefixDataSize:
+			// Decode marks the 66 prefix implicit when it selects
+			// the operand size.
+			if p&PrefixImplicit != 0 {
+				continue
+			}
+		case PrefixAddrSize:
+			continue
+		case PrefixCS, PrefixDS, PrefixES, PrefixFS, PrefixGS, PrefixSS:
+			if p&PrefixImplicit != 0 {
+				continue
+			}
+		}
+		if p&0xFF00 == PrefixImplicit && rex2Payload(inst, p) {
+			continue
+		}
+		kept = append(kept, p&^(PrefixImplicit|PrefixInvalid))
+	}
+	return kept
+}
+
+// rex2Payload reports whether p is the payload byte of a REX2 prefix in inst.
+func rex2Payload(inst *Inst, p Prefix) bool {
+	for i := 1; i < len(inst.Prefix) && inst.Prefix[i] != 0; i++ {
+		if inst.Prefix[i] == p && inst.Prefix[i-1].IsREX2() {
+			return true
+		}
+	}
+	return false
+}
+
+// sameInst reports whether got, decoded from a candidate encoding,
+// is the instruction want that Encode was asked to encode.
+func sameInst(want, got *Inst) bool {
+	if got.Op != want.Op || got.Mode != want.Mode ||
+		got.Broadcast != want.Broadcast || got.Zeroing != want.Zeroing ||
+		got.SAE != want.SAE || got.SAE && got.Rounding != want.Rounding ||
+		got.NF != want.NF || got.DFV != want.DFV {
+		return false
+	}
+	if !sameDataSize(want.DataSize, got.DataSize, want.Mode) ||
+		want.AddrSize != 0 && got.AddrSize != 0 && got.AddrSize != want.AddrSize {
+		return false
+	}
+	if stringArgs(want.Op) && want.Args[0] == nil {
+		// The operands of a string instruction are implied.
+		return sameKeptPrefixes(want, got)
+	}
+	hasMem := false
+	for i, a := range want.Args {
+		if m, ok := a.(Mem); ok {
+			hasMem = true
+			gm, ok := got.Args[i].(Mem)
+			if !ok || !sameMem(m, gm) {
+				return false
+			}
+			continue
+		}
+		if got.Args[i] != a {
+			return false
+		}
+	}
+	if hasMem && want.MemBytes != 0 && got.MemBytes != want.MemBytes {
+		return false
+	}
+	return sameKeptPrefixes(want, got)
+}
+
+// sameDataSize reports whether got, the operand size of an instruction
+// decoded from a candidate encoding, is the operand size want. Decode
+// reports the operand size of an instruction with byte operands as 8
+// in EVEX map 4 but as the size the prefixes select in the legacy
+// encodings, so 8 and the default size of the mode match each other.
+func sameDataSize(want, got, mode int) bool {
+	def := 32
+	if mode == 16 {
+		def = 16
+	}
+	return want == 0 || got == 0 || want == got ||
+		want == 8 && got == def || want == def && got == 8
+}
+
+// sameKeptPrefixes reports whether want and got have the same
+// prefixes to keep, in any order.
+func sameKeptPrefixes(want, got *Inst) bool {
+	w, g := keptPrefixes(want), keptPrefixes(got)
+	slices.Sort(w)
+	slices.Sort(g)
+	return slices.Equal(w, g)
+}
+
+// sameMem reports whether two memory references are the same.
+// Decode zero-extends 16- and 32-bit displacements but sign-extends
+// 8-bit ones, so the displacements need only agree in their low 16
+// or 32 bits, depending on the address size.
+func sameMem(m, g Mem) bool {
+	if m.Segment != g.Segment || m.Base != g.Base || m.Index != g.Index {
+		return false
+	}
+	if m.Index != 0 && max(m.Scale, 1) != max(g.Scale, 1) {
+		return false
+	}
+	if m.Disp == g.Disp {
+		return true
+	}
+	if regAddrSize(m.Base) == 16 || regAddrSize(m.Index) == 16 {
+		return uint16(m.Disp) == uint16(g.Disp)
+	}
+	return fitsIn32(m.Disp) && fitsIn32(g.Disp) && uint32(m.Disp) == uint32(g.Disp)
+}
+
+// fitsIn32 reports whether v is a signed or unsigned 32-bit value.
+func fitsIn32(v int64) bool {
+	return int64(int32(v)) == v || int64(uint32(v)) == v
+}
+
+// regAddrSize returns the address size of a base or index register,
+// or 0 if r is not one.
+func regAddrSize(r Reg) int {
+	switch {
+	case r == IP || r == BX || r == BP || r == SI || r == DI:
+		return 16
+	case r == EIP || EAX <= r && r <= R31L:
+		return 32
+	case r == RIP || RAX <= r && r <= R31:
+		return 64
+	}
+	return 0
+}
+
+// A legacyForm is a path through the decoder program from the start
+// to an xMatch: the conditions it tests, and the bytes it reads.
+type legacyForm struct {
+	prefix   []Prefix   // mandatory prefixes (xCondPrefix)
+	steps    []formStep // opcode bytes, ModR/M and immediates, in order
+	digit    int8       // ModR/M reg field (xCondSlashR), or -1
+	is64     int8       // 0 any mode, 1 not 64-bit mode, 2 64-bit mode
+	dataSize int8       // operand size (xCondDataSize), or 0
+	addrSize int8       // address size (xCondAddrSize), or 0
+	mem      int8       // 0 either, 1 register, 2 memory (xCondIsMem)
+	args     []decodeOp
+}
+
+// A formStep is a part of a legacy instruction after the prefixes.
+type formStep struct {
+	op decodeOp // xCondByte for an opcode byte, or the xRead op
+	b  byte     // the opcode byte
+}
+
+// legacyForms returns the legacy forms of each Op.
+var legacyForms = sync.OnceValue(func() map[Op][]*legacyForm {
+	forms := make(map[Op][]*legacyForm)
+	var walk func(pc int, op Op, f legacyForm)
+	walk = func(pc int, op Op, f legacyForm) {
+		for {
+			x := decodeOp(decoder[pc])
+			pc++
+			switch x {
+			case xFail:
+				return
+
+			case xMatch:
+				if op != 0 {
+					forms[op] = append(forms[op], &f)
+				}
+				return
+
+			case xJump:
+				pc = int(decoder[pc])
+
+			case xCondByte:
+				n := int(decoder[pc])
+				pc++
+				for i := 0; i < n; i++ {
+					g := f
+					g.steps = append(slices.Clip(f.steps), formStep{xCondByte, byte(decoder[pc+2*i])})
+					walk(int(decoder[pc+2*i+1]), op, g)
+				}
+				// Fall through, as the decoder does when no byte matches.
+				pc += 2 * n
+				if decodeOp(decoder[pc]) == xJump {
+					pc = int(decoder[pc+1])
+				}
+
+			case xCondSlashR:
+				f.steps = append(slices.Clip(f.steps), formStep{op: xReadSlashR})
+				for i := 0; i < 8; i++ {
+					g := f
+					g.digit = int8(i)
+					walk(int(decoder[pc+i]), op, g)
+				}
+				return
+
+			case xCondPrefix:
+				n := int(decoder[pc])
+				pc++
+				for i := 0; i < n; i++ {
+					g := f
+					if p := Prefix(decoder[pc+2*i]); p != 0 {
+						g.prefix = append(slices.Clip(f.prefix), p)
+					}
+					walk(int(decoder[pc+2*i+1]), op, g)
+				}
+				return
+
+			case xCondIs64:
+				for i := range 2 {
+					g := f
+					g.is64 = int8(i + 1)
+					walk(int(decoder[pc+i]), op, g)
+				}
+				return
+
+			case xCondDataSize, xCondAddrSize:
+				for i, size := range []int8{16, 32, 64} {
+					g := f
+					if x == xCondDataSize {
+						g.dataSize = size
+					} else {
+						g.addrSize = size
+					}
+					walk(int(decoder[pc+i]), op, g)
+				}
+				return
+
+			case xCondIsMem:
+				for i := range 2 {
+					g := f
+					g.mem = int8(i + 1)
+					walk(int(decoder[pc+i]), op, g)
+				}
+				return
+
+			case xSetOp:
+				op = Op(decoder[pc])
+				pc++
+
+			case xSetFeature:
+				pc++
+
+			case xReadSlashR, xReadIb, xReadIw, xReadId, xReadIo,
+				xReadCb, xReadCw, xReadCd, xReadCp, xReadCm:
+				f.steps = append(slices.Clip(f.steps), formStep{op: x})
+
+			default:
+				f.args = append(slices.Clip(f.args), x)
+			}
+		}
+	}
+	walk(1, 0, legacyForm{digit: -1})
+	return forms
+})
+
+// legacyArgs records where a legacy form puts the arguments.
+type legacyArgs struct {
+	reg   int  // register in ModR/M reg, with its REX and REX2 bits, or -1
+	rm    int  // register in ModR/M rm, or -1
+	op    int  // register in the low 3 bits of the opcode, or -1
+	mem   *Mem // memory operand in ModR/M rm
+	moffs *Mem // memory operand given by its offset
+	imm   int64
+	imm8  int64
+	immc  int64
+	rex   int8 // 1 if REX is required, -1 if REX is not allowed
+	lock  bool // LOCK selects CR8-CR15 outside 64-bit mode
+}
+
+// stringArgs reports whether the arguments of op are not in the decoder
+// tables but added by Decode. They are the string instructions, whose
+// memory operands are implied by the opcode.
+func stringArgs(op Op) bool {
+	switch op {
+	case INSB, INSW, INSD, OUTSB, OUTSW, OUTSD,
+		MOVSB, MOVSW, MOVSD, MOVSQ, CMPSB, CMPSW, CMPSD, CMPSQ,
+		LODSB, LODSW, LODSD, LODSQ, STOSB, STOSW, STOSD, STOSQ,
+		SCASB, SCASW, SCASD, SCASQ, XLATB:
+		return true
+	}
+	return false
+}
+
+// appendLegacy appends the encodings of inst in the legacy forms.
+func (e *encoder) appendLegacy(cands [][]byte) [][]byte {
+	inst := e.inst
+	op := inst.Op
+	pushp := false
+	switch op {
+	case NOP:
+		if inst.Args[0] == nil {
+			// NOP is XCHG EAX, EAX (opcode 90), which the decoder rewrites.
+			if b := e.legacyBytes(&legacyForm{steps: []formStep{{xCondByte, 0x90}}}, &legacyArgs{reg: -1, rm: -1, op: -1}, false, false); b != nil {
+				cands = append(cands, b)
+			}
+			return cands
+		}
+	case PAUSE:
+		f := &legacyForm{prefix: []Prefix{PrefixREP}, steps: []formStep{{xCondByte, 0x90}}}
+		if b := e.legacyBytes(f, &legacyArgs{reg: -1, rm: -1, op: -1}, false, false); b != nil {
+			cands = append(cands, b)
+		}
+		return cands
+	case PUSHP, POPP:
+		// PUSHP and POPP are PUSH and POP with REX2.W.
+		op = PUSH + (op - PUSHP)
+		if inst.Op == POPP {
+			op = POP
+		}
+		pushp = true
+	case JMPABS:
+		if inst.Mode == 64 && inst.Args[1] == nil {
+			if imm, ok := inst.Args[0].(Imm); ok {
+				b := append([]byte{byte(PrefixREX2), 0, 0xA1}, binary.LittleEndian.AppendUint64(nil, uint64(imm))...)
+				cands = append(cands, append(slices.Clip(e.prefixes), b...))
+			}
+		}
+		return cands
+	}
+	for _, f := range legacyForms()[op] {
+		a := e.legacyArgs(f)
+		if a == nil {
+			continue
+		}
+		if !pushp {
+			if b := e.legacyBytes(f, a, false, false); b != nil {
+				cands = append(cands, b)
+			}
+		}
+		// Decode does not show the unused bits of a REX2 prefix,
+		// as it does those of a REX prefix, so an instruction with
+		// an unused REX2.W, like the operand size of 64 bits of a byte
+		// instruction, may need REX2.
+		if inst.Mode == 64 {
+			if b := e.legacyBytes(f, a, true, pushp); b != nil {
+				cands = append(cands, b)
+			}
+		}
+	}
+	return cands
+}
+
+// legacyArgs matches the arguments of inst to the form f.
+// It returns nil if they do not fit.
+func (e *encoder) legacyArgs(f *legacyForm) *legacyArgs {
+	inst := e.inst
+	a := &legacyArgs{reg: -1, rm: -1, op: -1}
+	args := inst.Args[:]
+	if stringArgs(inst.Op) {
+		// The arguments are implied, except for the segment of the
+		// source operand, which legacyBytes reads from inst.
+		return a
+	}
+	for _, x := range f.args {
+		if len(args) == 0 || args[0] == nil {
+			return nil
+		}
+		arg := args[0]
+		args = args[1:]
+		switch x {
+		case xArg1, xArg3, xArgAL, xArgAX, xArgCL, xArgCS, xArgDS, xArgDX,
+			xArgEAX, xArgEDX, xArgES, xArgFS, xArgGS, xArgRAX, xArgRDX,
+			xArgSS, xArgST, xArgXMM0:
+			if arg != fixedArg[x] {
+				return nil
+			}
+
+		case xArgImm8, xArgImm8u, xArgImm16, xArgImm16u, xArgImm32, xArgImm64:
+			imm, ok := arg.(Imm)
+			v := int64(imm)
+			if !ok ||
+				x == xArgImm8 && v != int64(int8(v)) ||
+				x == xArgImm8u && v != int64(uint8(v)) ||
+				x == xArgImm16 && v != int64(int16(v)) ||
+				x == xArgImm16u && v != int64(uint16(v)) ||
+				x == xArgImm32 && v != int64(int32(v)) {
+				return nil
+			}
+			if x == xArgImm8 || x == xArgImm8u {
+				a.imm8 = v
+			} else {
+				a.imm = v
+			}
+
+		case xArgRel8, xArgRel16, xArgRel32:
+			rel, ok := arg.(Rel)
+			v := int64(rel)
+			if !ok ||
+				x == xArgRel8 && v != int64(int8(v)) ||
+				x == xArgRel16 && v != int64(int16(v)) {
+				return nil
+			}
+			a.immc = v
+
+		case xArgMoffs8, xArgMoffs16, xArgMoffs32, xArgMoffs64:
+			m, ok := arg.(Mem)
+			if !ok || m.Base != 0 || m.Index != 0 {
+				return nil
+			}
+			a.moffs = &m
+			a.immc = m.Disp
+
+		case xArgPtr16colon16, xArgPtr16colon32:
+			if len(args) == 0 {
+				return nil
+			}
+			seg, ok1 := arg.(Imm)
+			off, ok2 := args[0].(Imm)
+			args = args[1:]
+			bits := 16
+			if x == xArgPtr16colon32 {
+				bits = 32
+			}
+			if !ok1 || !ok2 || uint64(seg) >= 1<<16 || uint64(off) >= 1<<bits {
+				return nil
+			}
+			a.immc = int64(seg)<<bits | int64(off)
+
+		case xArgR8, xArgR16, xArgR32, xArgR64:
+			if a.reg = a.gpr(arg, baseReg[x]); a.reg < 0 {
+				return nil
+			}
+
+		case xArgXmm, xArgXmm1:
+			if a.reg = regIndex(arg, X0, 16); a.reg < 0 {
+				return nil
+			}
+
+		case xArgMm, xArgMm1:
+			if a.reg = regIndex(arg, M0, 8); a.reg < 0 {
+				return nil
+			}
+
+		case xArgTR0dashTR7:
+			if a.reg = regIndex(arg, TR0, 8); a.reg < 0 {
+				return nil
+			}
+
+		case xArgDR0dashDR7:
+			if a.reg = regIndex(arg, DR0, 16); a.reg < 0 {
+				return nil
+			}
+
+		case xArgCR0dashCR7:
+			if a.reg = regIndex(arg, CR0, 16); a.reg < 0 {
+				return nil
+			}
+			if a.reg >= 8 && inst.Mode != 64 {
+				a.reg -= 8
+				a.lock = true
+			}
+
+		case xArgSreg:
+			if a.reg = regIndex(arg, ES, 6); a.reg < 0 {
+				return nil
+			}
+
+		case xArgRM8, xArgRM16, xArgRM32, xArgRM64, xArgR32M16, xArgR32M8, xArgR64M16,
+			xArgMmM32, xArgMmM64, xArgMm2M64,
+			xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmmM64, xArgXmmM128, xArgXmmM32, xArgXmm2M128:
+			if m, ok := arg.(Mem); ok {
+				if f.mem == 1 {
+					return nil
+				}
+				a.mem = &m
+				break
+			}
+			if f.mem == 2 {
+				return nil
+			}
+			fallthrough
+
+		case xArgRmf16, xArgRmf32, xArgRmf64, xArgMm2, xArgXmm2:
+			switch base := baseReg[x]; base {
+			case M0:
+				a.rm = regIndex(arg, M0, 8)
+			case X0:
+				a.rm = regIndex(arg, X0, 16)
+			default:
+				a.rm = a.gpr(arg, base)
+			}
+			if a.rm < 0 {
+				return nil
+			}
+
+		case xArgR8op, xArgR16op, xArgR32op, xArgR64op:
+			if a.op = a.gpr(arg, baseReg[x]); a.op < 0 {
+				return nil
+			}
+
+		case xArgSTi:
+			if a.op = regIndex(arg, F0, 8); a.op < 0 {
+				return nil
+			}
+
+		case xArgM, xArgM128, xArgM256, xArgM1428byte, xArgM16, xArgM16and16,
+			xArgM16and32, xArgM16and64, xArgM16colon16, xArgM16colon32,
+			xArgM16colon64, xArgM16int, xArgM2byte, xArgM32, xArgM32and32,
+			xArgM32fp, xArgM32int, xArgM512byte, xArgM64, xArgM64fp, xArgM64int,
+			xArgM8, xArgM80bcd, xArgM80dec, xArgM80fp, xArgM94108byte, xArgMem:
+			m, ok := arg.(Mem)
+			if !ok || f.mem == 1 {
+				return nil
+			}
+			a.mem = &m
+
+		default:
+			return nil
+		}
+	}
+	if len(args) > 0 && args[0] != nil {
+		return nil
+	}
+	return a
+}
+
+// gpr returns the number of the general-purpose register arg, which must
+// be in the range starting at base (AL, AX, EAX or RAX), and records
+// whether the register requires or forbids a REX prefix. It returns -1
+// if arg is not such a register.
+func (a *legacyArgs) gpr(arg Arg, base Reg) int {
+	r, ok := arg.(Reg)
+	if !ok {
+		return -1
+	}
+	if base != AL {
+		return regIndex(r, base, 32)
+	}
+	n, rex := gpr8(r)
+	if n < 0 || rex != 0 && a.rex != 0 && rex != a.rex {
+		return -1
+	}
+	if rex != 0 {
+		a.rex = rex
+	}
+	return n
+}
+
+// gpr8 returns the number of the 8-bit register r, and 1 if it
+// requires a REX prefix or -1 if it does not allow one.
+// It returns -1, 0 if r is not an 8-bit register.
+func gpr8(r Reg) (int, int8) {
+	switch {
+	case AL <= r && r <= BL:
+		return int(r - AL), 0
+	case AH <= r && r <= BH:
+		return int(r - AL), -1
+	case SPB <= r && r <= R31B:
+		return int(r-SPB) + 4, 1
+	}
+	return -1, 0
+}
+
+// regIndex returns the index of arg among the n registers starting at base,
+// or -1 if arg is not one of them.
+func regIndex(arg Arg, base Reg, n int) int {
+	r, ok := arg.(Reg)
+	if !ok || r < base || r >= base+Reg(n) {
+		return -1
+	}
+	return int(r - base)
+}
+
+// legacyBytes returns the encoding of inst in the legacy form f with the
+// arguments placed as in a, or nil if there is none. If forceREX2 is set,
+// the encoding uses a REX2 prefix even if it could use REX or nothing,
+// and if forceW is set, the prefix has the W bit.
+func (e *encoder) legacyBytes(f *legacyForm, a *legacyArgs, forceREX2, forceW bool) []byte {
+	inst := e.inst
+	mode := inst.Mode
+	if f.is64 == 1 && mode == 64 || f.is64 == 2 && mode != 64 {
+		return nil
+	}
+
+	// Operand size: the 66 prefix switches between 16 and 32 bits,
+	// and REX.W selects 64 bits.
+	defSize := 32
+	if mode == 16 {
+		defSize = 16
+	}
+	mandatory66 := slices.Contains(f.prefix, PrefixDataSize)
+	dataSize := defSize
+	if mandatory66 {
+		dataSize = 48 - defSize
+	}
+	want := inst.DataSize
+	if want == 0 {
+		want = int(f.dataSize)
+	} else if f.dataSize != 0 && want != int(f.dataSize) {
+		return nil
+	}
+	var rex Prefix
+	size66 := false
+	switch want {
+	case 0, dataSize:
+	case 8:
+		// The size of the byte operands is not in the prefixes.
+	case 64:
+		// A form whose operand size is not in the prefixes, like
+		// INC m8, has no 64-bit size but that of an unused REX.W,
+		// which only an instruction returned by Decode has.
+		if f.dataSize == 0 && e.rex&PrefixREXW == 0 && !slices.ContainsFunc(inst.Prefix[:], Prefix.IsREX2) {
+			return nil
+		}
+		rex |= PrefixREXW
+	case 48 - defSize:
+		if mandatory66 {
+			return nil
+		}
+		// Likewise, the 66 prefix of INC m8 is an ignored one,
+		// which Encode keeps.
+		if f.dataSize == 0 {
+			if !slices.Contains(e.prefixes, byte(PrefixDataSize)) {
+				return nil
+			}
+			break
+		}
+		size66 = true
+	default:
+		return nil
+	}
+
+	// Address size: the 67 prefix switches between 16 and 32 bits
+	// outside 64-bit mode and between 32 and 64 bits in 64-bit mode.
+	addrSize := inst.AddrSize
+	if addrSize == 0 {
+		addrSize = e.addrSize
+	}
+	if addrSize == 0 && stringArgs(inst.Op) {
+		for _, arg := range inst.Args {
+			if m, ok := arg.(Mem); ok {
+				addrSize = regAddrSize(m.Base)
+			}
+		}
+	}
+	if addrSize == 0 {
+		addrSize = int(f.addrSize)
+	}
+	if addrSize == 0 {
+		addrSize = mode
+	}
+	if f.addrSize != 0 && addrSize != int(f.addrSize) {
+		return nil
+	}
+	switch {
+	case addrSize == mode:
+	case addrSize == 32, addrSize == 16 && mode == 32:
+	default:
+		return nil
+	}
+
+	// Segment override.
+	var seg Reg
+	switch {
+	case a.mem != nil:
+		seg = a.mem.Segment
+	case a.moffs != nil:
+		seg = a.moffs.Segment
+	case stringArgs(inst.Op):
+		// Only the segment of the source operand, addressed by SI
+		// or, for XLATB, BX, can be overridden. The destination,
+		// addressed by DI, is always in ES.
+		for _, arg := range inst.Args {
+			m, ok := arg.(Mem)
+			if !ok {
+				continue
+			}
+			switch m.Base {
+			case SI, ESI, RSI, BX, EBX, RBX:
+				if m.Segment != DS {
+					seg = m.Segment
+				}
+			}
+		}
+	}
+
+	// ModR/M, SIB and displacement.
+	reg := a.reg
+	if reg < 0 {
+		reg = max(int(f.digit), 0)
+	}
+	var modrm []byte
+	var rexb, rexx int
+	switch {
+	case a.mem != nil:
+		if f.mem == 1 {
+			return nil
+		}
+		var ok bool
+		modrm, rexb, rexx, ok = encodeMem(*a.mem, addrSize, mode, reg, 0, mode != 64, false)
+		if !ok {
+			return nil
+		}
+	case a.rm >= 0:
+		if f.mem == 2 {
+			return nil
+		}
+		modrm = []byte{0xC0 | byte(reg&7)<<3 | byte(a.rm&7)}
+		rexb = a.rm
+	default:
+		if f.mem == 2 {
+			return nil
+		}
+		modrm = []byte{0xC0 | byte(reg&7)<<3}
+		rexb = a.op
+	}
+
+	// REX and REX2 prefixes.
+	// The REX2 bits R4, X4 and B4 are kept in rex shifted left by 4,
+	// above the REX bits, until the REX2 payload is assembled.
+	ext := func(n int, bit, bit4 Prefix) {
+		if n >= 0 && n&8 != 0 {
+			rex |= bit
+		}
+		if n >= 0 && n&16 != 0 {
+			rex |= bit4
+		}
+	}
+	if a.reg >= 0 {
+		ext(a.reg, PrefixREXR, PrefixREX2R4<<4)
+	}
+	ext(rexb, PrefixREXB, PrefixREX2B4<<4)
+	ext(rexx, PrefixREXX, PrefixREX2X4<<4)
+	rex |= e.rex &^ PrefixREX
+	rex2 := forceREX2 || rex&0xF00 != 0
+	if forceW {
+		rex |= PrefixREXW
+	}
+	needREX := rex2 || rex != 0 || e.rex != 0 || a.rex > 0
+	if needREX && (mode != 64 || a.rex < 0) {
+		return nil
+	}
+
+	var b []byte
+	b = append(b, e.prefixes...)
+	if seg != 0 {
+		b = append(b, segmentPrefix(seg))
+	}
+	if size66 {
+		b = append(b, 0x66)
+	}
+	if addrSize != mode {
+		b = append(b, 0x67)
+	}
+	for _, p := range f.prefix {
+		if !slices.Contains(b, byte(p)) {
+			b = append(b, byte(p))
+		}
+	}
+	if a.lock && !slices.Contains(b, byte(PrefixLOCK)) {
+		b = append(b, byte(PrefixLOCK))
+	}
+	steps := f.steps
+	if rex2 {
+		payload := byte(rex&0xF) | byte(rex>>4)&0xF0
+		if len(steps) > 1 && steps[0] == (formStep{xCondByte, 0x0F}) {
+			if steps[1].b == 0x38 || steps[1].b == 0x3A {
+				return nil
+			}
+			payload |= byte(PrefixREX2M0)
+			steps = steps[1:]
+		}
+		b = append(b, byte(PrefixREX2), payload)
+	} else if needREX {
+		b = append(b, byte(PrefixREX|rex&0xF))
+	}
+
+	last := -1
+	for i, s := range steps {
+		if s.op == xCondByte {
+			last = i
+		}
+	}
+	for i, s := range steps {
+		switch s.op {
+		case xCondByte:
+			c := s.b
+			if i == last && a.op >= 0 {
+				if c&7 != 0 {
+					// Use the first of the eight opcodes.
+					return nil
+				}
+				c |= byte(a.op & 7)
+			}
+			b = append(b, c)
+		case xReadSlashR:
+			b = append(b, modrm...)
+		case xReadIb:
+			b = append(b, byte(a.imm8))
+		case xReadIw:
+			b = binary.LittleEndian.AppendUint16(b, uint16(a.imm))
+		case xReadId:
+			b = binary.LittleEndian.AppendUint32(b, uint32(a.imm))
+		case xReadIo:
+			b = binary.LittleEndian.AppendUint64(b, uint64(a.imm))
+		case xReadCb:
+			b = append(b, byte(a.immc))
+		case xReadCw:
+			b = binary.LittleEndian.AppendUint16(b, uint16(a.immc))
+		case xReadCd:
+			b = binary.LittleEndian.AppendUint32(b, uint32(a.immc))
+		case xReadCp:
+			b = binary.LittleEndian.AppendUint32(b, uint32(a.immc))
+			b = binary.LittleEndian.AppendUint16(b, uint16(a.immc>>32))
+		case xReadCm:
+			switch addrSize {
+			case 16:
+				b = binary.LittleEndian.AppendUint16(b, uint16(a.immc))
+			case 32:
+				b = binary.LittleEndian.AppendUint32(b, uint32(a.immc))
+			default:
+				b = binary.LittleEndian.AppendUint64(b, uint64(a.immc))
+			}
+		}
+	}
+	return b
+}
+
+// segmentPrefix returns the segment override prefix for seg.
+func segmentPrefix(seg Reg) byte {
+	switch seg {
+	case ES:
+		return byte(PrefixES)
+	case CS:
+		return byte(PrefixCS)
+	case SS:
+		return byte(PrefixSS)
+	case DS:
+		return byte(PrefixDS)
+	case FS:
+		return byte(PrefixFS)
+	case GS:
+		return byte(PrefixGS)
+	}
+	return 0
+}
+
+// encodeMem returns the ModR/M byte, SIB byte and displacement that
+// encode the memory reference m with the given address size and
+// ModR/M reg field, along with the numbers of the base and index
+// registers, whose upper bits go in the REX, REX2, VEX or EVEX prefix.
+// An 8-bit displacement is scaled by dispScale if not zero, as in EVEX.
+// If abs is set, an absolute address uses the ModR/M encoding that is
+// RIP-relative in 64-bit mode, and if sib is set, the encoding must
+// have a SIB byte. The index may be a vector register (VSIB).
+func encodeMem(m Mem, addrSize, mode, reg, dispScale int, abs, sib bool) (b []byte, base, index int, ok bool) {
+	disp := m.Disp
+	modrm := byte(reg&7) << 3
+	if addrSize == 16 {
+		rm := -1
+		for i, am := range addr16 {
+			if am.Base == m.Base && am.Index == m.Index && m.Scale <= 1 {
+				rm = i
+			}
+		}
+		if m.Base == 0 && m.Index == 0 {
+			rm = 6
+		}
+		if rm < 0 || sib || disp != int64(int16(disp)) && disp != int64(uint16(disp)) {
+			return nil, -1, -1, false
+		}
+		d := int16(disp)
+		switch {
+		case m.Base == 0 && m.Index == 0:
+			b = binary.LittleEndian.AppendUint16([]byte{modrm | 6}, uint16(d))
+		case d == 0 && rm != 6:
+			b = []byte{modrm | byte(rm)}
+		case d == int16(int8(d)):
+			b = []byte{0x40 | modrm | byte(rm), byte(d)}
+		default:
+			b = binary.LittleEndian.AppendUint16([]byte{0x80 | modrm | byte(rm)}, uint16(d))
+		}
+		return b, -1, -1, true
+	}
+
+	if !fitsIn32(disp) {
+		return nil, -1, -1, false
+	}
+	d := int32(disp)
+	regBase := baseRegForBits(addrSize)
+	base, index = -1, -1
+	rip := false
+	switch {
+	case m.Base == 0:
+	case m.Base == RIP && addrSize == 64, m.Base == EIP && addrSize == 32:
+		rip = true
+	case regBase <= m.Base && m.Base < regBase+32:
+		base = int(m.Base - regBase)
+	default:
+		return nil, -1, -1, false
+	}
+	switch {
+	case m.Index == 0:
+	case regBase <= m.Index && m.Index < regBase+32 && m.Index != regBase+4:
+		index = int(m.Index - regBase)
+	case X0 <= m.Index && m.Index <= Z31:
+		index = int(m.Index-X0) % 32
+	default:
+		return nil, -1, -1, false
+	}
+	var scale byte
+	switch m.Scale {
+	case 0, 1:
+	case 2:
+		scale = 1
+	case 4:
+		scale = 2
+	case 8:
+		scale = 3
+	default:
+		return nil, -1, -1, false
+	}
+
+	if rip {
+		if mode != 64 || index >= 0 || sib {
+			return nil, -1, -1, false
+		}
+		return binary.LittleEndian.AppendUint32([]byte{modrm | 5}, uint32(d)), -1, -1, true
+	}
+	if base < 0 && index < 0 && abs && !sib {
+		return binary.LittleEndian.AppendUint32([]byte{modrm | 5}, uint32(d)), -1, -1, true
+	}
+
+	// The displacement, and the mod field that selects its size.
+	var mod byte
+	var db []byte
+	scaled := int32(1)
+	if dispScale > 0 {
+		scaled = int32(dispScale)
+	}
+	switch {
+	case base < 0:
+		db = binary.LittleEndian.AppendUint32(nil, uint32(d))
+	case d == 0 && base&7 != 5:
+	case d%scaled == 0 && d/scaled == int32(int8(d/scaled)):
+		mod = 0x40
+		db = []byte{byte(d / scaled)}
+	default:
+		mod = 0x80
+		db = binary.LittleEndian.AppendUint32(nil, uint32(d))
+	}
+
+	if index < 0 && base >= 0 && base&7 != 4 && !sib {
+		return append([]byte{mod | modrm | byte(base&7)}, db...), base, -1, true
+	}
+	sibByte := scale<<6 | 4<<3 | 5
+	if index >= 0 {
+		sibByte = scale<<6 | byte(index&7)<<3 | 5
+	}
+	if base >= 0 {
+		sibByte = sibByte&^7 | byte(base&7)
+	}
+	return append([]byte{mod | modrm | 4, sibByte}, db...), base, index, true
+}
+
+// An avxForm is an entry in the VEX and EVEX tables,
+// with the opcode map and byte it is listed under.
+type avxForm struct {
+	*avxOptab
+	m      byte // 1 for 0F, 2 for 0F38, 3 for 0F3A
+	opcode byte
+}
+
+// avxForms returns the VEX and EVEX forms of each Op.
+var avxForms = sync.OnceValue(func() map[Op][]avxForm {
+	forms := make(map[Op][]avxForm)
+	for i, m := range []*[256][]*avxOptab{&avxMap0F, &avxMap0F38, &avxMap0F3A} {
+		for opcode, list := range m {
+			for _, c := range list {
+				forms[c.op] = append(forms[c.op], avxForm{c, byte(i + 1), byte(opcode)})
+			}
+		}
+	}
+	return forms
+})
+
+// appendAVX appends the encodings of inst in the VEX and EVEX forms.
+func (e *encoder) appendAVX(cands [][]byte) [][]byte {
+	if e.inst.Mode == 16 || len(e.prefixes) > 0 || e.rex != 0 {
+		return cands
+	}
+	for _, f := range avxForms()[e.inst.Op] {
+		if b := e.avxBytes(f, false); b != nil {
+			cands = append(cands, b)
+		}
+		// Outside 64-bit mode, a two-byte VEX prefix that sets
+		// the top bit of VEX.vvvv is LDS, so try three bytes too.
+		if !f.evex {
+			if b := e.avxBytes(f, true); b != nil {
+				cands = append(cands, b)
+			}
+		}
+	}
+	return cands
+}
+
+// avxBytes returns the encoding of inst in the VEX or EVEX form f,
+// or nil if there is none. If vex3 is set, the encoding uses the
+// three-byte VEX prefix even if the two-byte one would do.
+func (e *encoder) avxBytes(f avxForm, vex3 bool) []byte {
+	inst := e.inst
+	if !f.evex && (inst.Zeroing || inst.Broadcast || inst.SAE) {
+		return nil
+	}
+	vl := f.vexL
+	if inst.SAE {
+		vl = 2
+	}
+	vecBase := [...]Reg{X0, Y0, Z0}[vl]
+	nregs := 16
+	if f.evex {
+		nregs = 32
+	}
+	gprBase := baseRegForBits(inst.Mode)
+
+	reg, rm, vvvv, aaa, imm := -1, -1, 0, 0, -1
+	var mem *Mem
+	args := inst.Args[:]
+	for _, t := range f.args {
+		if t == argNone {
+			continue
+		}
+		if t == argKmask {
+			if n := regIndex(args[0], K0, 8); n > 0 {
+				aaa = n
+				args = args[1:]
+			}
+			continue
+		}
+		if args[0] == nil {
+			return nil
+		}
+		arg := args[0]
+		args = args[1:]
+		n := -1
+		switch t {
+		case argImm8, argImm8u:
+			if v, ok := arg.(Imm); ok && -128 <= v && v <= 255 {
+				imm = int(v) & 0xFF
+				continue
+			}
+			return nil
+		case argXmm_SE, argYmm_SE:
+			base := X0
+			if t == argYmm_SE {
+				base = Y0
+			}
+			if n = regIndex(arg, base, 16); n < 0 {
+				return nil
+			}
+			imm = n<<4 | max(imm, 0)&0xF
+			continue
+		case argGPR_R, argGPR_B, argGPR_N:
+			base := gprBase
+			if t == argGPR_N && f.vexW == 1 {
+				base = RAX
+			}
+			n = regIndex(arg, base, 16)
+		case argGPR32_R, argGPR32_B, argGPR32_N:
+			n = regIndex(arg, EAX, 16)
+		case argGPR64_R, argGPR64_B, argGPR64_N:
+			n = regIndex(arg, RAX, 16)
+		case argXmm_R, argXmm_B, argXmm_N, argXmmEvex_R, argXmmEvex_B, argXmmEvex_N:
+			n = regIndex(arg, X0, nregs)
+		case argYmm_R, argYmm_B, argYmm_N, argYmmEvex_R, argYmmEvex_B, argYmmEvex_N:
+			n = regIndex(arg, Y0, nregs)
+		case argZmm_R, argZmm_N:
+			n = regIndex(arg, vecBase, 32)
+		case argZmm_B:
+			if f.ismem == 0 {
+				n = regIndex(arg, vecBase, 32)
+				break
+			}
+			fallthrough
+		case argM, argSIBMem:
+			m, ok := arg.(Mem)
+			if !ok {
+				return nil
+			}
+			mem = &m
+			continue
+		case argK_R, argK_B, argK_N:
+			n = regIndex(arg, K0, 8)
+		case argTmm_R, argTmm_B, argTmm_N:
+			n = regIndex(arg, TMM0, 8)
+		case argKnot0:
+			if aaa = regIndex(arg, K0, 8); aaa <= 0 {
+				return nil
+			}
+			continue
+		default:
+			return nil
+		}
+		if n < 0 {
+			return nil
+		}
+		switch t {
+		case argGPR_R, argGPR32_R, argGPR64_R, argXmm_R, argXmmEvex_R, argYmm_R, argYmmEvex_R,
+			argZmm_R, argK_R, argTmm_R:
+			reg = n
+		case argGPR_B, argGPR32_B, argGPR64_B, argXmm_B, argXmmEvex_B, argYmm_B, argYmmEvex_B,
+			argZmm_B, argK_B, argTmm_B:
+			rm = n
+		default:
+			vvvv = n
+		}
+	}
+	if len(args) > 0 && args[0] != nil {
+		return nil
+	}
+	if f.opdigit >= 0 {
+		if reg >= 0 {
+			return nil
+		}
+		reg = int(f.opdigit)
+	}
+	reg = max(reg, 0)
+	if inst.Broadcast && (mem == nil || f.bcstScale == 0) || inst.SAE && mem != nil {
+		return nil
+	}
+
+	// ModR/M, SIB and displacement.
+	var modrm []byte
+	base, index := -1, -1
+	switch {
+	case mem != nil:
+		if f.ismem == 0 {
+			return nil
+		}
+		scale := 0
+		if f.evex {
+			scale = int(f.dispScale)
+			if inst.Broadcast {
+				scale = int(f.bcstScale)
+			}
+		}
+		var ok bool
+		// Decode accepts a VSIB operand without a SIB byte,
+		// as a memory operand with no index.
+		sib := f.vsib && mem.Index != 0 || slices.Contains(f.args[:], argSIBMem)
+		modrm, base, index, ok = encodeMem(*mem, inst.Mode, inst.Mode, reg, scale, true, sib)
+		if !ok || base >= 16 || !f.vsib && index >= 16 {
+			return nil
+		}
+	case f.ismem == 1:
+		return nil
+	case rm >= 0 || f.ismem == 0:
+		rm = max(rm, 0)
+		modrm = []byte{0xC0 | byte(reg&7)<<3 | byte(rm&7)}
+		base = rm & 15
+		if f.evex {
+			index = rm & 16 >> 1
+		}
+	}
+
+	bit := func(n, b int) byte {
+		if n >= 0 && n&b != 0 {
+			return 0
+		}
+		return 1
+	}
+	r, x, bb := bit(reg, 8), bit(index, 8), bit(base, 8)
+	pp := [...]byte{0, 1, 3, 2}[f.vexP]
+	l := vl
+	if inst.SAE {
+		l = uint8(inst.Rounding)
+	}
+	var b []byte
+	switch {
+	case f.evex:
+		vp := bit(vvvv, 16)
+		if f.vsib {
+			vp = bit(index, 16)
+		}
+		var z, bc byte
+		if inst.Zeroing {
+			z = 1
+		}
+		if inst.Broadcast || inst.SAE {
+			bc = 1
+		}
+		b = []byte{byte(PrefixEVEX),
+			r<<7 | x<<6 | bb<<5 | bit(reg, 16)<<4 | f.m,
+			f.vexW<<7 | byte(^vvvv&15)<<3 | 4 | pp,
+			z<<7 | l<<5 | bc<<4 | vp<<3 | byte(aaa)}
+	case vl > 1 || reg|vvvv|max(index, 0)|max(base, 0) >= 16 || aaa != 0:
+		return nil
+	case !vex3 && f.m == 1 && f.vexW == 0 && x == 1 && bb == 1:
+		b = []byte{byte(PrefixVEX2Bytes), r<<7 | byte(^vvvv&15)<<3 | l<<2 | pp}
+	default:
+		b = []byte{byte(PrefixVEX3Bytes), r<<7 | x<<6 | bb<<5 | f.m, f.vexW<<7 | byte(^vvvv&15)<<3 | l<<2 | pp}
+	}
+	b = append(b, f.opcode)
+	b = append(b, modrm...)
+	if imm >= 0 {
+		b = append(b, byte(imm))
+	}
+	return b
+}
+
+// apxForm is an entry in the EVEX map 4 table with its opcode byte.
+type apxForm struct {
+	*apxOptab
+	opcode byte
+}
+
+// apxForms returns the EVEX map 4 forms of each Op.
+// The forms of the CCMPscc and CTESTscc instructions
+// are listed under CCMPO and CTESTO.
+var apxForms = sync.OnceValue(func() map[Op][]apxForm {
+	forms := make(map[Op][]apxForm)
+	for opcode, list := range apxMap4 {
+		for _, c := range list {
+			forms[c.op] = append(forms[c.op], apxForm{c, byte(opcode)})
+		}
+	}
+	return forms
+})
+
+// appendAPX appends the encodings of inst in the EVEX map 4 forms.
+func (e *encoder) appendAPX(cands [][]byte) [][]byte {
+	inst := e.inst
+	if inst.Mode != 64 || len(e.prefixes) > 0 || e.rex != 0 {
+		return cands
+	}
+	op, scc := inst.Op, 0
+	switch {
+	case CCMPO <= op && op <= CCMPG:
+		op, scc = CCMPO, int(op-CCMPO)
+	case CTESTO <= op && op <= CTESTG:
+		op, scc = CTESTO, int(op-CTESTO)
+	}
+	for _, f := range apxForms()[op] {
+		for nd := range 2 {
+			for w := range 2 {
+				for pp66 := range 2 {
+					if b := e.apxBytes(f, scc, nd, w, pp66); b != nil {
+						cands = append(cands, b)
+					}
+				}
+			}
+		}
+	}
+	return cands
+}
+
+// apxBytes returns the encoding of inst in the EVEX map 4 form f with
+// the given source condition code and EVEX.ND, EVEX.W and 66 (EVEX.pp=1)
+// bits, or nil if there is none.
+func (e *encoder) apxBytes(f apxForm, scc, nd, w, pp66 int) []byte {
+	inst := e.inst
+	if f.scc && (nd != 0 || inst.NF) || !f.scc && (scc != 0 || inst.DFV != 0) ||
+		!f.scc && f.nd < 2 && nd != int(f.nd) ||
+		f.w != 0 && w != int(f.w)-1 ||
+		f.pp != 0 && pp66 != 0 ||
+		f.byteOp && (w != 0 || pp66 != 0) {
+		return nil
+	}
+	nf := 0
+	if inst.NF {
+		if f.nf == 0 {
+			return nil
+		}
+		nf = 1
+	} else if f.nf == 1 {
+		return nil
+	}
+	pp := int(f.pp)
+	if pp66 != 0 {
+		pp = 1
+	}
+	opSize := 32
+	switch {
+	case f.byteOp:
+		opSize = 8
+	case w != 0:
+		opSize = 64
+	case pp66 != 0:
+		opSize = 16
+	}
+	gpr := func(arg Arg, size int) int {
+		r, ok := arg.(Reg)
+		if !ok {
+			return -1
+		}
+		if size == 8 {
+			n, rex := gpr8(r)
+			if rex < 0 {
+				return -1
+			}
+			return n
+		}
+		return regIndex(r, baseRegForBits(size), 32)
+	}
+
+	reg, rm, vvvv, imm, immLen := 0, -1, 0, int64(0), 0
+	var mem *Mem
+	args := inst.Args[:]
+	if nd == 1 && f.args[0] != apxArgN64 {
+		if vvvv = gpr(args[0], opSize); vvvv < 0 {
+			return nil
+		}
+		args = args[1:]
+	}
+	for _, t := range f.args {
+		if t == apxArgNone {
+			continue
+		}
+		if len(args) == 0 || args[0] == nil {
+			return nil
+		}
+		arg := args[0]
+		args = args[1:]
+		switch t {
+		case apxArgR:
+			if reg = gpr(arg, opSize); reg < 0 {
+				return nil
+			}
+		case apxArgRM:
+			if m, ok := arg.(Mem); ok {
+				mem = &m
+			} else if rm = gpr(arg, opSize); rm < 0 {
+				return nil
+			}
+		case apxArgN64:
+			if vvvv = regIndex(arg, RAX, 32); vvvv < 0 {
+				return nil
+			}
+		case apxArgB64:
+			if rm = regIndex(arg, RAX, 32); rm < 0 {
+				return nil
+			}
+		case apxArgImm8, apxArgImm8u, apxArgImmz:
+			v, ok := arg.(Imm)
+			imm, immLen = int64(v), 1
+			switch {
+			case !ok,
+				t == apxArgImm8 && imm != int64(int8(imm)),
+				t == apxArgImm8u && imm != int64(uint8(imm)):
+				return nil
+			case t == apxArgImmz && opSize == 16:
+				if imm != int64(int16(imm)) {
+					return nil
+				}
+				immLen = 2
+			case t == apxArgImmz:
+				if imm != int64(int32(imm)) {
+					return nil
+				}
+				immLen = 4
+			}
+		case apxArgCL:
+			if arg != CL {
+				return nil
+			}
+		case apxArg1:
+			if arg != Imm(1) {
+				return nil
+			}
+		}
+	}
+	if len(args) > 0 && args[0] != nil {
+		return nil
+	}
+	if f.opdigit >= 0 {
+		reg = int(f.opdigit)
+	}
+
+	var modrm []byte
+	base, index := rm, -1
+	if mem != nil {
+		var ok bool
+		modrm, base, index, ok = encodeMem(*mem, 64, 64, reg, 0, false, false)
+		if !ok {
+			return nil
+		}
+	} else {
+		if rm < 0 {
+			return nil
+		}
+		modrm = []byte{0xC0 | byte(reg&7)<<3 | byte(rm&7)}
+	}
+
+	inv := func(n, b int) byte {
+		if n >= 0 && n&b != 0 {
+			return 0
+		}
+		return 1
+	}
+	var b4 byte
+	if base >= 0 && base&16 != 0 {
+		b4 = 1
+	}
+	p0 := inv(reg, 8)<<7 | inv(index, 8)<<6 | inv(base, 8)<<5 | inv(reg, 16)<<4 | b4<<3 | 4
+	p1 := byte(w)<<7 | inv(index, 16)<<2 | byte(pp)
+	var p2 byte
+	if f.scc {
+		p1 |= inst.DFV << 3
+		p2 = byte(scc)
+	} else {
+		p1 |= byte(^vvvv&15) << 3
+		p2 = byte(nd)<<4 | inv(vvvv, 16)<<3 | byte(nf)<<2
+	}
+	b := []byte{byte(PrefixEVEX), p0, p1, p2, f.opcode}
+	b = append(b, modrm...)
+	for i := 0; i < immLen; i++ {
+		b = append(b, byte(imm>>(8*i)))
+	}
+	return b
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestEncode checks that every instruction in testdata/decode.txt
+// that decodes successfully encodes back to an equivalent instruction
+// no longer than the original.
+func TestEncode(t *testing.T) {
+	data, err := os.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type key struct {
+		code string
+		mode int
+	}
+	seen := make(map[key]bool)
+	n := 0
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			continue
+		}
+		hexCode := strings.Replace(f[0], "|", "", 1)
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		if seen[key{hexCode, mode}] {
+			continue
+		}
+		seen[key{hexCode, mode}] = true
+		code, err := hex.DecodeString(hexCode)
+		if err != nil {
+			t.Errorf("parsing %q: %v", f[0], err)
+			continue
+		}
+		inst, err := Decode(code, mode)
+		if err != nil {
+			continue
+		}
+		n++
+		enc, err := Encode(inst)
+		if err != nil {
+			t.Errorf("Encode(%x [%d]): %v", code[:inst.Len], mode, err)
+			continue
+		}
+		if len(enc) > inst.Len {
+			t.Errorf("Encode(%x [%d]) = %x, longer than the original", code[:inst.Len], mode, enc)
+		}
+		if inst.Op == 0 {
+			// A lone prefix, which only decodes before an invalid instruction.
+			if len(enc) != 1 || enc[0] != code[0] {
+				t.Errorf("Encode(%x [%d]) = %x", code[:inst.Len], mode, enc)
+			}
+			continue
+		}
+		dec, err := Decode(enc, mode)
+		if err != nil || !sameInst(&inst, &dec) {
+			t.Errorf("Encode(%x [%d]) = %x, which decodes to %v, want %v", code[:inst.Len], mode, enc, dec, inst)
+		}
+	}
+	if n == 0 {
+		t.Fatal("no test cases")
+	}
+}
+
+// TestEncodePrefix checks that Encode picks the shortest of the REX,
+// REX2, VEX and EVEX prefixes that can encode the registers and flags
+// of an instruction.
+func TestEncodePrefix(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want string // "" if the instruction cannot be encoded
+	}{
+		// Legacy instructions need REX for R8-R15 and REX2 for R16-R31,
+		// and EVEX only for the APX flags and the new data destination.
+		{Inst{Op: ADD, Args: Args{EAX, EBX}}, "01d8"},
+		{Inst{Op: ADD, Args: Args{RAX, RBX}}, "4801d8"},
+		{Inst{Op: ADD, Args: Args{R8L, EAX}}, "4101c0"},
+		{Inst{Op: ADD, Args: Args{R16L, EAX}}, "d51001c0"},
+		{Inst{Op: ADD, Args: Args{RAX, R31}}, "d54c01f8"},
+		{Inst{Op: ADD, Args: Args{RAX, Mem{Base: R17, Index: R9, Scale: 2}}}, "d51a030449"},
+		{Inst{Op: MOV, Args: Args{R16, Imm(-1)}}, "d518c7c0ffffffff"},
+		{Inst{Op: PUSH, Args: Args{R8}}, "4150"},
+		{Inst{Op: PUSH, Args: Args{R31}}, "d51157"},
+		{Inst{Op: ADD, Args: Args{RAX, RBX}, NF: true}, "62f4fc0c01d8"},
+		{Inst{Op: ADD, Args: Args{R16, RAX, RCX}}, "62f4fc1001c8"},
+		{Inst{Op: ADD, Args: Args{RAX, RAX, RCX}}, "62f4fc1801c8"},
+
+		// The two-byte VEX prefix has no VEX.B, and only EVEX reaches
+		// X16-X31, the opmask registers and the 512-bit registers.
+		{Inst{Op: VADDPS, Args: Args{X1, X2, X3}}, "c5e858cb"},
+		{Inst{Op: VADDPS, Args: Args{X9, X2, X3}}, "c56858cb"},
+		{Inst{Op: VADDPS, Args: Args{X1, X2, X9}}, "c4c16858c9"},
+		{Inst{Op: VADDPS, Args: Args{X1, X2, X16}}, "62b16c0858c8"},
+		{Inst{Op: VADDPS, Args: Args{X1, K1, X2, X3}}, "62f16c0958cb"},
+		{Inst{Op: VADDPS, Args: Args{Z1, Z2, Z3}}, "62f16c4858cb"},
+
+		// EVEX scales an 8-bit displacement by the memory operand size,
+		// so a displacement that is not a multiple of it needs 32 bits.
+		{Inst{Op: VADDPS, Args: Args{Y1, Y2, Mem{Base: RAX, Disp: 64}}}, "c5ec584840"},
+		{Inst{Op: VADDPS, Args: Args{Z1, Z2, Mem{Base: RAX, Disp: 64}}}, "62f16c48584801"},
+		{Inst{Op: VADDPS, Args: Args{Z1, Z2, Mem{Base: RAX, Disp: 60}}}, "62f16c4858883c000000"},
+
+		// Without MemBytes, the operand size is that of the memory
+		// operand, and a byte operand does not take the 66 prefix
+		// or REX.W.
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 8}, "fe00"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 16}, "66ff00"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 32}, "ff00"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 64}, "48ff00"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 32, MemBytes: 1}, "fe00"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 32, NF: true}, "62f47c0cff00"},
+		{Inst{Op: MOV, Args: Args{Mem{Base: RAX}, Imm(1)}, DataSize: 8}, "c60001"},
+		{Inst{Op: MOV, Args: Args{Mem{Base: RAX}, Imm(1)}, DataSize: 16}, "66c7000100"},
+		{Inst{Op: MOV, Args: Args{Mem{Base: RAX}, Imm(1)}, DataSize: 32}, "c70001000000"},
+		{Inst{Op: ADD, Args: Args{Mem{Base: RAX}, CL}, DataSize: 32}, "0008"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}}, ""},
+		{Inst{Op: MOVZX, Args: Args{EAX, Mem{Base: RAX}}, DataSize: 32}, ""},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 16, MemBytes: 1}, ""},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 64, MemBytes: 1}, ""},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 16, MemBytes: 1, NF: true}, ""},
+		{Inst{Op: ADD, Args: Args{Mem{Base: RAX}, CL}, DataSize: 16}, ""},
+		{Inst{Op: INC, Args: Args{AL}, DataSize: 16}, ""},
+
+		// Only a 66 prefix or REX.W that Decode found unused is kept.
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 16, MemBytes: 1, Prefix: Prefixes{PrefixData16}}, "66fe00"},
+		{Inst{Op: INC, Args: Args{Mem{Base: RAX}}, DataSize: 64, MemBytes: 1, Prefix: Prefixes{PrefixREX | PrefixREXW}}, "48fe00"},
+	}
+	for _, tt := range tests {
+		enc, err := Encode(tt.inst)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("Encode(%v) = %x, want error", tt.inst, enc)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Encode(%v): %v", tt.inst, err)
+			continue
+		}
+		if got := hex.EncodeToString(enc); got != tt.want {
+			t.Errorf("Encode(%v) = %s, want %s", tt.inst, got, tt.want)
+		}
+	}
+	if _, err := Encode(Inst{Op: ADD, Args: Args{R8, RAX}, Mode: 32}); err == nil {
+		t.Errorf("Encode(ADD R8, RAX) in 32-bit mode succeeded, want error")
+	}
+}
+
+// TestEncodeByteReg checks the 8-bit registers: with any REX or REX2
+// prefix, the encodings of AH, CH, DH and BH select SPL, BPL, SIL and
+// DIL instead, so the two sets cannot meet in one instruction.
+func TestEncodeByteReg(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		want string // "" if the instruction cannot be encoded
+	}{
+		{Inst{Op: MOV, Args: Args{AH, AL}}, "88c4"},
+		{Inst{Op: MOV, Args: Args{SPB, AL}}, "4088c4"},
+		{Inst{Op: MOV, Args: Args{AL, R8B}}, "4488c0"},
+		{Inst{Op: MOV, Args: Args{R16B, AL}}, "d51088c0"},
+		{Inst{Op: MOV, Args: Args{AH, Mem{Base: RAX}}}, "8a20"},
+		{Inst{Op: MOVZX, Args: Args{EAX, AH}}, "0fb6c4"},
+		{Inst{Op: MOVZX, Args: Args{EAX, DIB}}, "400fb6c7"},
+		{Inst{Op: MOV, Args: Args{AH, AL}, Mode: 32}, "88c4"},
+
+		{Inst{Op: MOV, Args: Args{AH, R8B}}, ""},
+		{Inst{Op: MOV, Args: Args{AH, SPB}}, ""},
+		{Inst{Op: MOV, Args: Args{SIB, AH}}, ""},
+		{Inst{Op: MOV, Args: Args{AH, R16B}}, ""},
+		{Inst{Op: MOV, Args: Args{AH, Mem{Base: R8}}}, ""},
+		{Inst{Op: MOVZX, Args: Args{R8L, AH}}, ""},
+		{Inst{Op: MOV, Args: Args{SPB, AL}, Mode: 32}, ""},
+	}
+	for _, tt := range tests {
+		enc, err := Encode(tt.inst)
+		switch {
+		case tt.want == "" && err == nil:
+			t.Errorf("Encode(%v) = %x, want error", tt.inst, enc)
+		case tt.want != "" && err != nil:
+			t.Errorf("Encode(%v): %v", tt.inst, err)
+		case tt.want != "" && hex.EncodeToString(enc) != tt.want:
+			t.Errorf("Encode(%v) = %x, want %s", tt.inst, enc, tt.want)
+		}
+	}
+}
+
+func TestEncodeMode(t *testing.T) {
+	if _, err := Encode(Inst{Op: RET, Mode: 8}); err != ErrInvalidMode {
+		t.Errorf("Encode(RET) in mode 8: %v, want %v", err, ErrInvalidMode)
+	}
+}
@@ -268,7 +268,7 @@ SuffixLoop:
 				}
 			}
 
-			if AL <= a && a <= R15 || ES <= a && a <= GS || X0 <= a && a <= Z31 || M0 <= a && a <= M7 || K0 <= a && a <= K7 {
+			if AL <= a && a <= R31 || ES <= a && a <= GS || X0 <= a && a <= Z31 || M0 <= a && a <= M7 || K0 <= a && a <= K7 || TMM0 <= a && a <= TMM7 {
 				needSuffix = false
 				break SuffixLoop
 			}
@@ -279,7 +279,8 @@ SuffixLoop:
 		switch inst.Op {
 		case CMPXCHG8B, FLDCW, FNSTCW, FNSTSW, LDMXCSR, LLDT, LMSW, LTR, PCLMULQDQ,
 			SETA, SETAE, SETB, SETBE, SETE, SETG, SETGE, SETL, SETLE, SETNE, SETNO, SETNP, SETNS, SETO, SETP, SETS,
-			SLDT, SMSW, STMXCSR, STR, VERR, VERW, VLDMXCSR, VSTMXCSR:
+			SLDT, SMSW, STMXCSR, STR, VERR, VERW, VLDMXCSR, VSTMXCSR,
+			LDTILECFG, STTILECFG:
 			// For various reasons, libopcodes emits no suffix for these instructions.
 
 		case CRC32:
@@ -356,6 +357,9 @@ SuffixLoop:
 
 	isVexOrEvex := false
 	for _, p := range inst.Prefix {
+		if p.IsREX2() {
+			break
+		}
 		if p.IsVEX() || p&0xFF == 0x62 {
 			isVexOrEvex = true
 			break
@@ -407,7 +411,7 @@ SuffixLoop:
 					is64 = true
 				} else {
 					for _, a := range inst.Args {
-						if r, ok := a.(Reg); ok && RAX <= r && r <= R15 {
+						if r, ok := a.(Reg); ok && RAX <= r && r <= R31 {
 							is64 = true
 							break
 						}
@@ -575,7 +579,7 @@ SuffixLoop:
 		}
 	}
 	for _, p := range inst.Prefix {
-		if p == 0 || p.IsVEX() || p.IsEVEX() {
+		if p == 0 || p.IsVEX() || p.IsEVEX() || p.IsREX2() {
 			break
 		}
 		if p&PrefixImplicit != 0 {
@@ -641,7 +645,10 @@ SuffixLoop:
 	}
 
 	// Finally! Put it all together.
-	text := prefix + op
+	text := prefix + apxPseudoPrefix(&inst) + op
+	if hasDFV(inst.Op) {
+		text += " " + dfvString(inst.DFV)
+	}
 	if args != nil {
 		text += " "
 		// Indirect call/jmp gets a star to distinguish from direct jump address.
@@ -664,7 +671,7 @@ func gnuArg(inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool)
 	case Reg:
 		switch inst.Op {
 		case CVTSI2SS, CVTSI2SD, CVTSS2SI, CVTSD2SI, CVTTSD2SI, CVTTSS2SI:
-			if inst.DataSize == 16 && EAX <= x && x <= R15L {
+			if inst.DataSize == 16 && EAX <= x && x <= R31L {
 				x -= EAX - AX
 			}
 
@@ -855,6 +862,22 @@ var gccRegName = [...]string{
 	R13B: "%r13b",
 	R14B: "%r14b",
 	R15B: "%r15b",
+	R16B: "%r16b",
+	R17B: "%r17b",
+	R18B: "%r18b",
+	R19B: "%r19b",
+	R20B: "%r20b",
+	R21B: "%r21b",
+	R22B: "%r22b",
+	R23B: "%r23b",
+	R24B: "%r24b",
+	R25B: "%r25b",
+	R26B: "%r26b",
+	R27B: "%r27b",
+	R28B: "%r28b",
+	R29B: "%r29b",
+	R30B: "%r30b",
+	R31B: "%r31b",
 	AX:   "%ax",
 	CX:   "%cx",
 	BX:   "%bx",
@@ -871,6 +894,22 @@ var gccRegName = [...]string{
 	R13W: "%r13w",
 	R14W: "%r14w",
 	R15W: "%r15w",
+	R16W: "%r16w",
+	R17W: "%r17w",
+	R18W: "%r18w",
+	R19W: "%r19w",
+	R20W: "%r20w",
+	R21W: "%r21w",
+	R22W: "%r22w",
+	R23W: "%r23w",
+	R24W: "%r24w",
+	R25W: "%r25w",
+	R26W: "%r26w",
+	R27W: "%r27w",
+	R28W: "%r28w",
+	R29W: "%r29w",
+	R30W: "%r30w",
+	R31W: "%r31w",
 	EAX:  "%eax",
 	ECX:  "%ecx",
 	EDX:  "%edx",
@@ -887,6 +926,22 @@ var gccRegName = [...]string{
 	R13L: "%r13d",
 	R14L: "%r14d",
 	R15L: "%r15d",
+	R16L: "%r16d",
+	R17L: "%r17d",
+	R18L: "%r18d",
+	R19L: "%r19d",
+	R20L: "%r20d",
+	R21L: "%r21d",
+	R22L: "%r22d",
+	R23L: "%r23d",
+	R24L: "%r24d",
+	R25L: "%r25d",
+	R26L: "%r26d",
+	R27L: "%r27d",
+	R28L: "%r28d",
+	R29L: "%r29d",
+	R30L: "%r30d",
+	R31L: "%r31d",
 	RAX:  "%rax",
 	RCX:  "%rcx",
 	RDX:  "%rdx",
@@ -903,6 +958,22 @@ var gccRegName = [...]string{
 	R13:  "%r13",
 	R14:  "%r14",
 	R15:  "%r15",
+	R16:  "%r16",
+	R17:  "%r17",
+	R18:  "%r18",
+	R19:  "%r19",
+	R20:  "%r20",
+	R21:  "%r21",
+	R22:  "%r22",
+	R23:  "%r23",
+	R24:  "%r24",
+	R25:  "%r25",
+	R26:  "%r26",
+	R27:  "%r27",
+	R28:  "%r28",
+	R29:  "%r29",
+	R30:  "%r30",
+	R31:  "%r31",
 	IP:   "%ip",
 	EIP:  "%eip",
 	RIP:  "%rip",
@@ -1026,6 +1097,14 @@ var gccRegName = [...]string{
 	K5:   "%k5",
 	K6:   "%k6",
 	K7:   "%k7",
+	TMM0: "%tmm0",
+	TMM1: "%tmm1",
+	TMM2: "%tmm2",
+	TMM3: "%tmm3",
+	TMM4: "%tmm4",
+	TMM5: "%tmm5",
+	TMM6: "%tmm6",
+	TMM7: "%tmm7",
 	CS:   "%cs",
 	SS:   "%ss",
 	DS:   "%ds",
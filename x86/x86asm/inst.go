@@ -28,6 +28,11 @@ type Inst struct {
 	Zeroing   bool // EVEX zeroing
 	SAE       bool // Suppress All Exceptions
 	Rounding  int8 // Rounding control (0-3), valid only when SAE is true
+	// APX flags
+	NF  bool  // EVEX no-flags: the instruction does not update RFLAGS
+	DFV uint8 // CCMP/CTEST default flags value: OF, SF, ZF, CF in bits 3 to 0
+
+	cpuid [2]Feature // CPUID features required by the encoding form; see Features
 }
 
 // Prefixes is an array of prefixes associated with a single instruction.
@@ -85,6 +90,15 @@ const (
 	PrefixVEX2Bytes Prefix = 0xC5 // Short form of vex prefix
 	PrefixVEX3Bytes Prefix = 0xC4 // Long form of vex prefix
 	PrefixEVEX      Prefix = 0x62 // EVEX prefix
+
+	// The REX2 prefix (Intel APX) is the byte PrefixREX2 followed by a
+	// payload byte. The low four bits of the payload are the REX W, R, X
+	// and B bits; the high four bits are the following.
+	PrefixREX2   Prefix = 0xD5 // REX2 prefix
+	PrefixREX2M0 Prefix = 0x80 // map select bit (0F opcode map)
+	PrefixREX2R4 Prefix = 0x40 // extension bit R4 (r field in modrm)
+	PrefixREX2X4 Prefix = 0x20 // extension bit X4 (index field in sib)
+	PrefixREX2B4 Prefix = 0x10 // extension bit B4 (r/m field in modrm or base field in sib)
 )
 
 // IsREX reports whether p is a REX prefix byte.
@@ -100,6 +114,11 @@ func (p Prefix) IsEVEX() bool {
 	return p&0xFF == PrefixEVEX
 }
 
+// IsREX2 reports whether p is a REX2 prefix byte.
+func (p Prefix) IsREX2() bool {
+	return p&0xFF == PrefixREX2
+}
+
 func (p Prefix) String() string {
 	p &^= PrefixImplicit | PrefixIgnored | PrefixInvalid
 	if s := prefixNames[p]; s != "" {
@@ -135,6 +154,9 @@ func (op Op) String() string {
 		if i < len(avxOpNames) && avxOpNames[i] != "" {
 			return avxOpNames[i]
 		}
+		if i < len(apxOpNames) && apxOpNames[i] != "" {
+			return apxOpNames[i]
+		}
 		return fmt.Sprintf("Op(%d)", i)
 	}
 	return opNames[i]
@@ -158,7 +180,7 @@ type Arg interface {
 
 // A Reg is a single register.
 // The zero Reg value has no name but indicates “no register.”
-type Reg uint8
+type Reg uint16
 
 const (
 	_ Reg = iota
@@ -184,6 +206,22 @@ const (
 	R13B
 	R14B
 	R15B
+	R16B
+	R17B
+	R18B
+	R19B
+	R20B
+	R21B
+	R22B
+	R23B
+	R24B
+	R25B
+	R26B
+	R27B
+	R28B
+	R29B
+	R30B
+	R31B
 
 	// 16-bit
 	AX
@@ -202,6 +240,22 @@ const (
 	R13W
 	R14W
 	R15W
+	R16W
+	R17W
+	R18W
+	R19W
+	R20W
+	R21W
+	R22W
+	R23W
+	R24W
+	R25W
+	R26W
+	R27W
+	R28W
+	R29W
+	R30W
+	R31W
 
 	// 32-bit
 	EAX
@@ -220,6 +274,22 @@ const (
 	R13L
 	R14L
 	R15L
+	R16L
+	R17L
+	R18L
+	R19L
+	R20L
+	R21L
+	R22L
+	R23L
+	R24L
+	R25L
+	R26L
+	R27L
+	R28L
+	R29L
+	R30L
+	R31L
 
 	// 64-bit
 	RAX
@@ -238,6 +308,22 @@ const (
 	R13
 	R14
 	R15
+	R16
+	R17
+	R18
+	R19
+	R20
+	R21
+	R22
+	R23
+	R24
+	R25
+	R26
+	R27
+	R28
+	R29
+	R30
+	R31
 
 	// Instruction pointer.
 	IP  // 16-bit
@@ -376,6 +462,16 @@ const (
 	K6
 	K7
 
+	// AMX tile registers.
+	TMM0
+	TMM1
+	TMM2
+	TMM3
+	TMM4
+	TMM5
+	TMM6
+	TMM7
+
 	// Segment registers.
 	ES
 	CS
@@ -549,16 +645,16 @@ func regBytes(a Arg) int {
 	if !ok {
 		return 0
 	}
-	if AL <= r && r <= R15B {
+	if AL <= r && r <= R31B {
 		return 1
 	}
-	if AX <= r && r <= R15W {
+	if AX <= r && r <= R31W {
 		return 2
 	}
-	if EAX <= r && r <= R15L {
+	if EAX <= r && r <= R31L {
 		return 4
 	}
-	if RAX <= r && r <= R15 {
+	if RAX <= r && r <= R31 {
 		return 8
 	}
 	return 0
@@ -594,6 +690,7 @@ var prefixNames = map[Prefix]string{
 	PrefixXACQUIRE: "XACQUIRE",
 	PrefixXRELEASE: "XRELEASE",
 	PrefixREX:      "REX",
+	PrefixREX2:     "REX2",
 	PrefixPT:       "PT",
 	PrefixPN:       "PN",
 }
@@ -619,6 +716,22 @@ var regNames = [...]string{
 	R13B: "R13B",
 	R14B: "R14B",
 	R15B: "R15B",
+	R16B: "R16B",
+	R17B: "R17B",
+	R18B: "R18B",
+	R19B: "R19B",
+	R20B: "R20B",
+	R21B: "R21B",
+	R22B: "R22B",
+	R23B: "R23B",
+	R24B: "R24B",
+	R25B: "R25B",
+	R26B: "R26B",
+	R27B: "R27B",
+	R28B: "R28B",
+	R29B: "R29B",
+	R30B: "R30B",
+	R31B: "R31B",
 	AX:   "AX",
 	CX:   "CX",
 	BX:   "BX",
@@ -635,6 +748,22 @@ var regNames = [...]string{
 	R13W: "R13W",
 	R14W: "R14W",
 	R15W: "R15W",
+	R16W: "R16W",
+	R17W: "R17W",
+	R18W: "R18W",
+	R19W: "R19W",
+	R20W: "R20W",
+	R21W: "R21W",
+	R22W: "R22W",
+	R23W: "R23W",
+	R24W: "R24W",
+	R25W: "R25W",
+	R26W: "R26W",
+	R27W: "R27W",
+	R28W: "R28W",
+	R29W: "R29W",
+	R30W: "R30W",
+	R31W: "R31W",
 	EAX:  "EAX",
 	ECX:  "ECX",
 	EDX:  "EDX",
@@ -651,6 +780,22 @@ var regNames = [...]string{
 	R13L: "R13L",
 	R14L: "R14L",
 	R15L: "R15L",
+	R16L: "R16L",
+	R17L: "R17L",
+	R18L: "R18L",
+	R19L: "R19L",
+	R20L: "R20L",
+	R21L: "R21L",
+	R22L: "R22L",
+	R23L: "R23L",
+	R24L: "R24L",
+	R25L: "R25L",
+	R26L: "R26L",
+	R27L: "R27L",
+	R28L: "R28L",
+	R29L: "R29L",
+	R30L: "R30L",
+	R31L: "R31L",
 	RAX:  "RAX",
 	RCX:  "RCX",
 	RDX:  "RDX",
@@ -667,6 +812,22 @@ var regNames = [...]string{
 	R13:  "R13",
 	R14:  "R14",
 	R15:  "R15",
+	R16:  "R16",
+	R17:  "R17",
+	R18:  "R18",
+	R19:  "R19",
+	R20:  "R20",
+	R21:  "R21",
+	R22:  "R22",
+	R23:  "R23",
+	R24:  "R24",
+	R25:  "R25",
+	R26:  "R26",
+	R27:  "R27",
+	R28:  "R28",
+	R29:  "R29",
+	R30:  "R30",
+	R31:  "R31",
 	IP:   "IP",
 	EIP:  "EIP",
 	RIP:  "RIP",
@@ -790,6 +951,14 @@ var regNames = [...]string{
 	K5:   "K5",
 	K6:   "K6",
 	K7:   "K7",
+	TMM0: "TMM0",
+	TMM1: "TMM1",
+	TMM2: "TMM2",
+	TMM3: "TMM3",
+	TMM4: "TMM4",
+	TMM5: "TMM5",
+	TMM6: "TMM6",
+	TMM7: "TMM7",
 	CS:   "CS",
 	SS:   "SS",
 	DS:   "DS",
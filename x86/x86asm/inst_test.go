@@ -5,8 +5,14 @@
 package x86asm
 
 import (
+	"flag"
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
+
+	"golang.org/x/arch/x86/xeddata"
 )
 
 func TestRegString(t *testing.T) {
@@ -18,3 +24,197 @@ func TestRegString(t *testing.T) {
 		}
 	}
 }
+
+var xedData = flag.String("xeddata", "", "also check crypto coverage against the XED datafiles in `dir`")
+
+func TestCryptoCoverage(t *testing.T) {
+	ops := make(map[string]bool)
+	for op := Op(1); op <= maxOp; op++ {
+		ops[op.String()] = true
+	}
+	for ext, names := range xedCryptoOps {
+		for _, name := range names {
+			if !ops[name] {
+				t.Errorf("%s instruction %s is missing from the tables", ext, name)
+			}
+		}
+	}
+
+	// Every form of a cryptographic instruction in the XED data,
+	// found by category, extension or ISA set, must decode.
+	crypto := regexp.MustCompile(xedCryptoPattern)
+	paths := []string{"../x86avxgen/testdata/xedpath"}
+	if *xedData != "" {
+		paths = append(paths, *xedData)
+	}
+	for _, path := range paths {
+		exts := make(map[string]bool)
+		err := xeddata.WalkInsts(path, func(inst *xeddata.Inst) {
+			if !crypto.MatchString(inst.Category) && !crypto.MatchString(inst.Extension) && !crypto.MatchString(inst.ISASet) {
+				return
+			}
+			exts[inst.Extension] = true
+			if !ops[inst.Iclass] {
+				t.Errorf("%s: %s instruction %s is missing from the tables", path, inst.Extension, inst.Iclass)
+				return
+			}
+			if inst.RealOpcode == "N" {
+				return
+			}
+			enc, err := xedEncode(inst.Pattern)
+			if err != nil {
+				t.Errorf("%s: %s: %v", path, inst.Iform, err)
+				return
+			}
+			checkCryptoForm(t, inst, enc)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(exts) == 0 {
+			t.Errorf("%s: no cryptographic instructions", path)
+		}
+	}
+}
+
+// checkCryptoForm decodes enc, the encoding of the XED form inst,
+// and checks the opcode and the first operand against the form.
+func checkCryptoForm(t *testing.T, inst *xeddata.Inst, enc []byte) {
+	t.Helper()
+	d, err := Decode(enc, 64)
+	if err != nil {
+		t.Errorf("%s: decode %x: %v", inst.Iform, enc, err)
+		return
+	}
+	if d.Len != len(enc) || d.Op.String() != inst.Iclass {
+		t.Errorf("%s: decode %x = %v (%d bytes), want %s (%d bytes)", inst.Iform, enc, d, d.Len, inst.Iclass, len(enc))
+		return
+	}
+	// xedEncode puts register 1 or [RAX] in the first operand.
+	var want Arg
+	first, _, _ := strings.Cut(strings.Fields(inst.Operands)[0], ":")
+	_, file, _ := strings.Cut(first, "=")
+	switch {
+	case strings.HasPrefix(first, "MEM0"):
+		want = Mem{Base: RAX}
+	case strings.HasPrefix(file, "XMM_"):
+		want = X1
+	case strings.HasPrefix(file, "YMM_"):
+		want = Y1
+	case strings.HasPrefix(file, "ZMM_"):
+		want = Z1
+	case strings.HasPrefix(file, "GPR32_"):
+		want = ECX
+	default:
+		t.Errorf("%s: unsupported operand %s", inst.Iform, first)
+		return
+	}
+	if m, ok := d.Args[0].(Mem); ok {
+		m.Segment = 0
+		d.Args[0] = m
+	}
+	if d.Args[0] != want {
+		t.Errorf("%s: decode %x = %v, want %v first", inst.Iform, enc, d, want)
+	}
+}
+
+// xedEncode returns an encoding of the XED decoder pattern. The encoding
+// uses register 1 in ModRM.reg, unless the pattern fixes the field,
+// register 2 in ModRM.rm or [RAX] for memory forms, register 3 in
+// VEX.vvvv, and 0x11 as the immediate.
+//
+// It understands only the pattern bits of the cryptographic instructions
+// and fails on any other, so that a new kind of form is not skipped.
+func xedEncode(pattern string) ([]byte, error) {
+	var (
+		opcode    []byte
+		prefix    byte
+		vex, evex bool
+		pp, mmmmm byte
+		w, l      byte
+		vvvv      byte = 3
+		reg       byte = 1
+		mem, imm  bool
+	)
+	for _, tok := range strings.Fields(pattern) {
+		switch tok {
+		case "VV1":
+			vex = true
+		case "EVV":
+			evex = true
+		case "VNP", "no_refining_prefix":
+		case "V66":
+			pp = 1
+		case "VF3":
+			pp = 2
+		case "VF2":
+			pp = 3
+		case "osz_refining_prefix":
+			prefix = 0x66
+		case "f3_refining_prefix":
+			prefix = 0xF3
+		case "f2_refining_prefix":
+			prefix = 0xF2
+		case "V0F":
+			mmmmm = 1
+		case "V0F38":
+			mmmmm = 2
+		case "V0F3A":
+			mmmmm = 3
+		case "VL128":
+			l = 0
+		case "VL256":
+			l = 1
+		case "VL512":
+			l = 2
+		case "W0":
+			w = 0
+		case "W1":
+			w = 1
+		case "NOVSR":
+			vvvv = 0
+		case "MOD[0b11]", "MOD=3":
+		case "MOD[mm]", "MOD!=3":
+			mem = true
+		case "UIMM8()":
+			imm = true
+		case "REG[rrr]", "RM[nnn]", "MODRM()", "BCRC=0", "ZEROING=0", "MASK=0":
+		default:
+			if strings.HasPrefix(tok, "ESIZE_") || strings.HasPrefix(tok, "NELEM_") {
+				break
+			}
+			if bits, ok := strings.CutPrefix(tok, "REG[0b"); ok {
+				r, err := strconv.ParseUint(strings.TrimSuffix(bits, "]"), 2, 3)
+				if err != nil {
+					return nil, fmt.Errorf("unsupported pattern bits %q", tok)
+				}
+				reg = byte(r)
+				break
+			}
+			b, err := strconv.ParseUint(tok, 0, 8)
+			if !strings.HasPrefix(tok, "0x") || err != nil {
+				return nil, fmt.Errorf("unsupported pattern bits %q", tok)
+			}
+			opcode = append(opcode, byte(b))
+		}
+	}
+	var enc []byte
+	switch {
+	case evex:
+		enc = []byte{0x62, 0xF0 | mmmmm, w<<7 | (^vvvv&15)<<3 | 1<<2 | pp, l<<5 | 1<<3}
+	case vex:
+		enc = []byte{0xC4, 0xE0 | mmmmm, w<<7 | (^vvvv&15)<<3 | l<<2 | pp}
+	case prefix != 0:
+		enc = []byte{prefix}
+	}
+	enc = append(enc, opcode...)
+	if mem {
+		enc = append(enc, reg<<3)
+	} else {
+		enc = append(enc, 0xC0|reg<<3|2)
+	}
+	if imm {
+		enc = append(enc, 0x11)
+	}
+	return enc, nil
+}
@@ -39,11 +39,11 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	case MOV:
 		dst, _ := inst.Args[0].(Reg)
 		src, _ := inst.Args[1].(Reg)
-		if ES <= dst && dst <= GS && EAX <= src && src <= R15L {
+		if ES <= dst && dst <= GS && EAX <= src && src <= R31L {
 			src -= EAX - AX
 			iargs[1] = src
 		}
-		if ES <= dst && dst <= GS && RAX <= src && src <= R15 {
+		if ES <= dst && dst <= GS && RAX <= src && src <= R31 {
 			src -= RAX - AX
 			iargs[1] = src
 		}
@@ -89,6 +89,11 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 			case PrefixData16, PrefixData32, PrefixCS, PrefixDS, PrefixES, PrefixSS:
 				inst.Prefix[i] |= PrefixImplicit
 			}
+			if p.IsREX2() {
+				inst.Prefix[i] |= PrefixImplicit
+				inst.Prefix[i+1] |= PrefixImplicit
+				break
+			}
 			if p.IsREX() {
 				inst.Prefix[i] |= PrefixImplicit
 			}
@@ -417,10 +422,13 @@ func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	if op == "" {
 		op = strings.ToLower(inst.Op.String())
 	}
+	if hasDFV(inst.Op) {
+		op += " " + dfvString(inst.DFV)
+	}
 	if args != nil {
 		op += " " + strings.Join(args, ", ")
 	}
-	return prefix + op
+	return prefix + apxPseudoPrefix(&inst) + op
 }
 
 func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
@@ -490,7 +498,7 @@ func intelArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
 			} else {
 				prefix = "dword "
 			}
-		case PREFETCHW, PREFETCHNTA, PREFETCHT0, PREFETCHT1, PREFETCHT2, CLFLUSH:
+		case PREFETCHW, PREFETCHNTA, PREFETCHT0, PREFETCHT1, PREFETCHT2, CLFLUSH, CLWB:
 			prefix = "zmmword "
 		}
 		switch inst.Op {
@@ -733,6 +741,15 @@ var intelReg = [...]string{
 	K6:  "k6",
 	K7:  "k7",
 
+	TMM0: "tmm0",
+	TMM1: "tmm1",
+	TMM2: "tmm2",
+	TMM3: "tmm3",
+	TMM4: "tmm4",
+	TMM5: "tmm5",
+	TMM6: "tmm6",
+	TMM7: "tmm7",
+
 	// TODO: Maybe the constants are named wrong.
 	SPB: "spl",
 	BPB: "bpl",
@@ -747,4 +764,20 @@ var intelReg = [...]string{
 	R13L: "r13d",
 	R14L: "r14d",
 	R15L: "r15d",
+	R16L: "r16d",
+	R17L: "r17d",
+	R18L: "r18d",
+	R19L: "r19d",
+	R20L: "r20d",
+	R21L: "r21d",
+	R22L: "r22d",
+	R23L: "r23d",
+	R24L: "r24d",
+	R25L: "r25d",
+	R26L: "r26d",
+	R27L: "r27d",
+	R28L: "r28d",
+	R29L: "r29d",
+	R30L: "r30d",
+	R31L: "r31d",
 }
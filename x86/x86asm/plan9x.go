@@ -34,7 +34,7 @@ func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	var rep string
 	var last Prefix
 	for _, p := range inst.Prefix {
-		if p == 0 || p.IsREX() || p.IsVEX() || p.IsEVEX() {
+		if p == 0 || p.IsREX() || p.IsVEX() || p.IsEVEX() || p.IsREX2() {
 			break
 		}
 
@@ -99,7 +99,7 @@ func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
 			is64 = true
 		} else {
 			for _, a := range inst.Args {
-				if r, ok := a.(Reg); ok && RAX <= r && r <= R15 {
+				if r, ok := a.(Reg); ok && RAX <= r && r <= R31 {
 					is64 = true
 					break
 				}
@@ -116,12 +116,12 @@ func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
 				op += "Q"
 			}
 		}
-	} else if plan9Suffix[inst.Op] {
+	} else if plan9Suffix[inst.Op] || hasDFV(inst.Op) {
 		s := inst.DataSize
 		if inst.MemBytes != 0 {
 			s = inst.MemBytes * 8
 		} else if inst.Args[1] == nil { // look for register-only 64-bit instruction, like PUSHQ AX
-			if r, ok := inst.Args[0].(Reg); ok && RAX <= r && r <= R15 {
+			if r, ok := inst.Args[0].(Reg); ok && RAX <= r && r <= R31 {
 				s = 64
 			}
 		}
@@ -159,8 +159,14 @@ func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	if inst.Zeroing {
 		op += ".Z"
 	}
+	if inst.NF {
+		op += ".NF"
+	}
+	if hasDFV(inst.Op) {
+		op += " " + strings.ToUpper(dfvString(inst.DFV))
+	}
 
-	if inst.Op == CMP {
+	if inst.Op == CMP || hasDFV(inst.Op) {
 		// Use reads-left-to-right ordering for comparisons.
 		// See issue 60920.
 		args[0], args[1] = args[1], args[0]
@@ -303,7 +309,9 @@ var plan9Suffix = [maxOp + 1]bool{
 	POP:       true,
 	POPA:      true,
 	POPCNT:    true,
+	POPP:      true,
 	PUSH:      true,
+	PUSHP:     true,
 	PUSHA:     true,
 	RCL:       true,
 	RCR:       true,
@@ -343,6 +351,22 @@ var plan9Reg = [...]string{
 	R13B: "R13",
 	R14B: "R14",
 	R15B: "R15",
+	R16B: "R16",
+	R17B: "R17",
+	R18B: "R18",
+	R19B: "R19",
+	R20B: "R20",
+	R21B: "R21",
+	R22B: "R22",
+	R23B: "R23",
+	R24B: "R24",
+	R25B: "R25",
+	R26B: "R26",
+	R27B: "R27",
+	R28B: "R28",
+	R29B: "R29",
+	R30B: "R30",
+	R31B: "R31",
 	AX:   "AX",
 	CX:   "CX",
 	BX:   "BX",
@@ -359,6 +383,22 @@ var plan9Reg = [...]string{
 	R13W: "R13",
 	R14W: "R14",
 	R15W: "R15",
+	R16W: "R16",
+	R17W: "R17",
+	R18W: "R18",
+	R19W: "R19",
+	R20W: "R20",
+	R21W: "R21",
+	R22W: "R22",
+	R23W: "R23",
+	R24W: "R24",
+	R25W: "R25",
+	R26W: "R26",
+	R27W: "R27",
+	R28W: "R28",
+	R29W: "R29",
+	R30W: "R30",
+	R31W: "R31",
 	EAX:  "AX",
 	ECX:  "CX",
 	EDX:  "DX",
@@ -375,6 +415,22 @@ var plan9Reg = [...]string{
 	R13L: "R13",
 	R14L: "R14",
 	R15L: "R15",
+	R16L: "R16",
+	R17L: "R17",
+	R18L: "R18",
+	R19L: "R19",
+	R20L: "R20",
+	R21L: "R21",
+	R22L: "R22",
+	R23L: "R23",
+	R24L: "R24",
+	R25L: "R25",
+	R26L: "R26",
+	R27L: "R27",
+	R28L: "R28",
+	R29L: "R29",
+	R30L: "R30",
+	R31L: "R31",
 	RAX:  "AX",
 	RCX:  "CX",
 	RDX:  "DX",
@@ -391,6 +447,22 @@ var plan9Reg = [...]string{
 	R13:  "R13",
 	R14:  "R14",
 	R15:  "R15",
+	R16:  "R16",
+	R17:  "R17",
+	R18:  "R18",
+	R19:  "R19",
+	R20:  "R20",
+	R21:  "R21",
+	R22:  "R22",
+	R23:  "R23",
+	R24:  "R24",
+	R25:  "R25",
+	R26:  "R26",
+	R27:  "R27",
+	R28:  "R28",
+	R29:  "R29",
+	R30:  "R30",
+	R31:  "R31",
 	IP:   "IP",
 	EIP:  "IP",
 	RIP:  "IP",
@@ -514,6 +586,14 @@ var plan9Reg = [...]string{
 	K5:   "K5",
 	K6:   "K6",
 	K7:   "K7",
+	TMM0: "TMM0",
+	TMM1: "TMM1",
+	TMM2: "TMM2",
+	TMM3: "TMM3",
+	TMM4: "TMM4",
+	TMM5: "TMM5",
+	TMM6: "TMM6",
+	TMM7: "TMM7",
 	CS:   "CS",
 	SS:   "SS",
 	DS:   "DS",
@@ -21,233 +21,233 @@ var decoder = [...]uint16{
 	0x0D, 685,
 	0x0E, 714,
 	0x0F, 721,
-	0x10, 8104,
-	0x11, 8110,
-	0x12, 8139,
-	0x13, 8145,
-	0x14, 8174,
-	0x15, 8180,
-	0x16, 8209,
-	0x17, 8216,
-	0x18, 8223,
-	0x19, 8229,
-	0x1A, 8258,
-	0x1B, 8264,
-	0x1C, 8293,
-	0x1D, 8299,
-	0x1E, 8328,
-	0x1F, 8335,
-	0x20, 8342,
-	0x21, 8348,
-	0x22, 8377,
-	0x23, 8383,
-	0x24, 8412,
-	0x25, 8418,
-	0x27, 8447,
-	0x28, 8453,
-	0x29, 8459,
-	0x2A, 8488,
-	0x2B, 8494,
-	0x2C, 8523,
-	0x2D, 8529,
-	0x2F, 8558,
-	0x30, 8564,
-	0x31, 8570,
-	0x32, 8599,
-	0x33, 8605,
-	0x34, 8634,
-	0x35, 8640,
-	0x37, 8669,
-	0x38, 8675,
-	0x39, 8681,
-	0x3A, 8710,
-	0x3B, 8716,
-	0x3C, 8745,
-	0x3D, 8751,
-	0x3F, 8780,
-	0x40, 8786,
-	0x41, 8786,
-	0x42, 8786,
-	0x43, 8786,
-	0x44, 8786,
-	0x45, 8786,
-	0x46, 8786,
-	0x47, 8786,
-	0x48, 8801,
-	0x49, 8801,
-	0x4a, 8801,
-	0x4b, 8801,
-	0x4c, 8801,
-	0x4d, 8801,
-	0x4e, 8801,
-	0x4f, 8801,
-	0x50, 8816,
-	0x51, 8816,
-	0x52, 8816,
-	0x53, 8816,
-	0x54, 8816,
-	0x55, 8816,
-	0x56, 8816,
-	0x57, 8816,
-	0x58, 8843,
-	0x59, 8843,
-	0x5a, 8843,
-	0x5b, 8843,
-	0x5c, 8843,
-	0x5d, 8843,
-	0x5e, 8843,
-	0x5f, 8843,
-	0x60, 8870,
-	0x61, 8883,
-	0x62, 8896,
-	0x63, 8915,
-	0x68, 8946,
-	0x69, 8965,
-	0x6A, 9000,
-	0x6B, 9005,
-	0x6C, 9040,
-	0x6D, 9043,
-	0x6E, 9056,
-	0x6F, 9059,
-	0x70, 9072,
-	0x71, 9077,
-	0x72, 9082,
-	0x73, 9087,
-	0x74, 9092,
-	0x75, 9097,
-	0x76, 9102,
-	0x77, 9107,
-	0x78, 9112,
-	0x79, 9117,
-	0x7A, 9122,
-	0x7B, 9127,
-	0x7C, 9132,
-	0x7D, 9137,
-	0x7E, 9142,
-	0x7F, 9147,
-	0x80, 9152,
-	0x81, 9209,
-	0x83, 9450,
-	0x84, 9691,
-	0x85, 9697,
-	0x86, 9726,
-	0x87, 9732,
-	0x88, 9761,
-	0x89, 9767,
-	0x8A, 9789,
-	0x8B, 9795,
-	0x8C, 9817,
-	0x8D, 9846,
-	0x8E, 9875,
-	0x8F, 9904,
-	0x90, 9940,
-	0x91, 9940,
-	0x92, 9940,
-	0x93, 9940,
-	0x94, 9940,
-	0x95, 9940,
-	0x96, 9940,
-	0x97, 9940,
-	0x98, 9966,
-	0x99, 9986,
-	0x9A, 10006,
-	0x9B, 10023,
-	0x9C, 10026,
-	0x9D, 10049,
-	0x9E, 10072,
-	0x9F, 10075,
-	0xA0, 10078,
-	0xA1, 10097,
-	0xA2, 10119,
-	0xA3, 10138,
-	0xA4, 10160,
-	0xA5, 10163,
-	0xA6, 10183,
-	0xA7, 10186,
-	0xA8, 10206,
-	0xA9, 10212,
-	0xAA, 10241,
-	0xAB, 10244,
-	0xAC, 10264,
-	0xAD, 10267,
-	0xAE, 10287,
-	0xAF, 10290,
-	0xb0, 10310,
-	0xb1, 10310,
-	0xb2, 10310,
-	0xb3, 10310,
-	0xb4, 10310,
-	0xb5, 10310,
-	0xb6, 10310,
-	0xb7, 10310,
-	0xb8, 10316,
-	0xb9, 10316,
-	0xba, 10316,
-	0xbb, 10316,
-	0xbc, 10316,
-	0xbd, 10316,
-	0xbe, 10316,
-	0xbf, 10316,
-	0xC0, 10345,
-	0xC1, 10396,
-	0xC2, 10594,
-	0xC3, 10599,
-	0xC4, 10602,
-	0xC5, 10621,
-	0xC6, 10640,
-	0xC7, 10664,
-	0xC8, 10725,
-	0xC9, 10732,
-	0xCA, 10755,
-	0xCB, 10760,
-	0xCC, 10763,
-	0xCD, 10767,
-	0xCE, 10772,
-	0xCF, 10778,
-	0xD0, 10798,
-	0xD1, 10842,
-	0xD2, 11033,
-	0xD3, 11077,
-	0xD4, 11268,
-	0xD5, 11276,
-	0xD7, 11284,
-	0xD8, 11297,
-	0xD9, 11506,
-	0xDA, 11725,
-	0xDB, 11857,
-	0xDC, 12028,
-	0xDD, 12197,
-	0xDE, 12336,
-	0xDF, 12510,
-	0xE0, 12621,
-	0xE1, 12626,
-	0xE2, 12631,
-	0xE3, 12636,
-	0xE4, 12662,
-	0xE5, 12668,
-	0xE6, 12690,
-	0xE7, 12696,
-	0xE8, 12718,
-	0xE9, 12749,
-	0xEA, 12780,
-	0xEB, 12797,
-	0xEC, 12802,
-	0xED, 12807,
-	0xEE, 12826,
-	0xEF, 12831,
-	0xF1, 12850,
-	0xF4, 12853,
-	0xF5, 12856,
-	0xF6, 12859,
-	0xF7, 12898,
-	0xF8, 13074,
-	0xF9, 13077,
-	0xFA, 13080,
-	0xFB, 13083,
-	0xFC, 13086,
-	0xFD, 13089,
-	0xFE, 13092,
-	0xFF, 13109,
+	0x10, 9737,
+	0x11, 9743,
+	0x12, 9772,
+	0x13, 9778,
+	0x14, 9807,
+	0x15, 9813,
+	0x16, 9842,
+	0x17, 9849,
+	0x18, 9856,
+	0x19, 9862,
+	0x1A, 9891,
+	0x1B, 9897,
+	0x1C, 9926,
+	0x1D, 9932,
+	0x1E, 9961,
+	0x1F, 9968,
+	0x20, 9975,
+	0x21, 9981,
+	0x22, 10010,
+	0x23, 10016,
+	0x24, 10045,
+	0x25, 10051,
+	0x27, 10080,
+	0x28, 10086,
+	0x29, 10092,
+	0x2A, 10121,
+	0x2B, 10127,
+	0x2C, 10156,
+	0x2D, 10162,
+	0x2F, 10191,
+	0x30, 10197,
+	0x31, 10203,
+	0x32, 10232,
+	0x33, 10238,
+	0x34, 10267,
+	0x35, 10273,
+	0x37, 10302,
+	0x38, 10308,
+	0x39, 10314,
+	0x3A, 10343,
+	0x3B, 10349,
+	0x3C, 10378,
+	0x3D, 10384,
+	0x3F, 10413,
+	0x40, 10419,
+	0x41, 10419,
+	0x42, 10419,
+	0x43, 10419,
+	0x44, 10419,
+	0x45, 10419,
+	0x46, 10419,
+	0x47, 10419,
+	0x48, 10434,
+	0x49, 10434,
+	0x4a, 10434,
+	0x4b, 10434,
+	0x4c, 10434,
+	0x4d, 10434,
+	0x4e, 10434,
+	0x4f, 10434,
+	0x50, 10449,
+	0x51, 10449,
+	0x52, 10449,
+	0x53, 10449,
+	0x54, 10449,
+	0x55, 10449,
+	0x56, 10449,
+	0x57, 10449,
+	0x58, 10476,
+	0x59, 10476,
+	0x5a, 10476,
+	0x5b, 10476,
+	0x5c, 10476,
+	0x5d, 10476,
+	0x5e, 10476,
+	0x5f, 10476,
+	0x60, 10503,
+	0x61, 10516,
+	0x62, 10529,
+	0x63, 10548,
+	0x68, 10579,
+	0x69, 10598,
+	0x6A, 10633,
+	0x6B, 10638,
+	0x6C, 10673,
+	0x6D, 10676,
+	0x6E, 10689,
+	0x6F, 10692,
+	0x70, 10705,
+	0x71, 10710,
+	0x72, 10715,
+	0x73, 10720,
+	0x74, 10725,
+	0x75, 10730,
+	0x76, 10735,
+	0x77, 10740,
+	0x78, 10745,
+	0x79, 10750,
+	0x7A, 10755,
+	0x7B, 10760,
+	0x7C, 10765,
+	0x7D, 10770,
+	0x7E, 10775,
+	0x7F, 10780,
+	0x80, 10785,
+	0x81, 10842,
+	0x83, 11083,
+	0x84, 11324,
+	0x85, 11330,
+	0x86, 11359,
+	0x87, 11365,
+	0x88, 11394,
+	0x89, 11400,
+	0x8A, 11422,
+	0x8B, 11428,
+	0x8C, 11450,
+	0x8D, 11479,
+	0x8E, 11508,
+	0x8F, 11537,
+	0x90, 11573,
+	0x91, 11573,
+	0x92, 11573,
+	0x93, 11573,
+	0x94, 11573,
+	0x95, 11573,
+	0x96, 11573,
+	0x97, 11573,
+	0x98, 11599,
+	0x99, 11619,
+	0x9A, 11639,
+	0x9B, 11656,
+	0x9C, 11659,
+	0x9D, 11682,
+	0x9E, 11705,
+	0x9F, 11710,
+	0xA0, 11715,
+	0xA1, 11734,
+	0xA2, 11756,
+	0xA3, 11775,
+	0xA4, 11797,
+	0xA5, 11800,
+	0xA6, 11820,
+	0xA7, 11823,
+	0xA8, 11843,
+	0xA9, 11849,
+	0xAA, 11878,
+	0xAB, 11881,
+	0xAC, 11901,
+	0xAD, 11904,
+	0xAE, 11924,
+	0xAF, 11927,
+	0xb0, 11947,
+	0xb1, 11947,
+	0xb2, 11947,
+	0xb3, 11947,
+	0xb4, 11947,
+	0xb5, 11947,
+	0xb6, 11947,
+	0xb7, 11947,
+	0xb8, 11953,
+	0xb9, 11953,
+	0xba, 11953,
+	0xbb, 11953,
+	0xbc, 11953,
+	0xbd, 11953,
+	0xbe, 11953,
+	0xbf, 11953,
+	0xC0, 11982,
+	0xC1, 12033,
+	0xC2, 12231,
+	0xC3, 12236,
+	0xC4, 12239,
+	0xC5, 12258,
+	0xC6, 12277,
+	0xC7, 12303,
+	0xC8, 12370,
+	0xC9, 12377,
+	0xCA, 12400,
+	0xCB, 12405,
+	0xCC, 12408,
+	0xCD, 12412,
+	0xCE, 12417,
+	0xCF, 12423,
+	0xD0, 12443,
+	0xD1, 12487,
+	0xD2, 12678,
+	0xD3, 12722,
+	0xD4, 12913,
+	0xD5, 12921,
+	0xD7, 12929,
+	0xD8, 12942,
+	0xD9, 13151,
+	0xDA, 13370,
+	0xDB, 13502,
+	0xDC, 13675,
+	0xDD, 13844,
+	0xDE, 13985,
+	0xDF, 14159,
+	0xE0, 14272,
+	0xE1, 14277,
+	0xE2, 14282,
+	0xE3, 14287,
+	0xE4, 14313,
+	0xE5, 14319,
+	0xE6, 14341,
+	0xE7, 14347,
+	0xE8, 14369,
+	0xE9, 14400,
+	0xEA, 14431,
+	0xEB, 14448,
+	0xEC, 14453,
+	0xED, 14458,
+	0xEE, 14477,
+	0xEF, 14482,
+	0xF1, 14501,
+	0xF4, 14504,
+	0xF5, 14507,
+	0xF6, 14510,
+	0xF7, 14549,
+	0xF8, 14725,
+	0xF9, 14728,
+	0xFA, 14731,
+	0xFB, 14734,
+	0xFC, 14737,
+	0xFD, 14740,
+	0xFE, 14743,
+	0xFF, 14760,
 	uint16(xFail),
 	/*490*/ uint16(xSetOp), uint16(ADD),
 	/*492*/ uint16(xReadSlashR),
@@ -399,588 +399,721 @@ var decoder = [...]uint16{
 	/*717*/ uint16(xSetOp), uint16(PUSH),
 	/*719*/ uint16(xArgCS),
 	/*720*/ uint16(xMatch),
-	/*721*/ uint16(xCondByte), 229,
-	0x00, 1182,
-	0x01, 1239,
-	0x02, 1347,
-	0x03, 1369,
-	0x05, 1391,
-	0x06, 1397,
-	0x07, 1400,
-	0x08, 1406,
-	0x09, 1409,
-	0x0B, 1412,
-	0x0D, 1415,
-	0x10, 1428,
-	0x11, 1462,
-	0x12, 1496,
-	0x13, 1539,
-	0x14, 1557,
-	0x15, 1575,
-	0x16, 1593,
-	0x17, 1628,
-	0x18, 1646,
-	0x1F, 1671,
-	0x20, 1692,
-	0x21, 1707,
-	0x22, 1722,
-	0x23, 1737,
-	0x24, 1752,
-	0x26, 1767,
-	0x28, 1782,
-	0x29, 1800,
-	0x2A, 1818,
-	0x2B, 1905,
-	0x2C, 1939,
-	0x2D, 2026,
-	0x2E, 2113,
-	0x2F, 2131,
-	0x30, 2149,
-	0x31, 2152,
-	0x32, 2155,
-	0x33, 2158,
-	0x34, 2161,
-	0x35, 2164,
-	0x38, 2174,
-	0x3A, 3124,
-	0x40, 3545,
-	0x41, 3574,
-	0x42, 3603,
-	0x43, 3632,
-	0x44, 3661,
-	0x45, 3690,
-	0x46, 3719,
-	0x47, 3748,
-	0x48, 3777,
-	0x49, 3806,
-	0x4A, 3835,
-	0x4B, 3864,
-	0x4C, 3893,
-	0x4D, 3922,
-	0x4E, 3951,
-	0x4F, 3980,
-	0x50, 4009,
-	0x51, 4027,
-	0x52, 4061,
-	0x53, 4079,
-	0x54, 4097,
-	0x55, 4115,
-	0x56, 4133,
-	0x57, 4151,
-	0x58, 4169,
-	0x59, 4203,
-	0x5A, 4237,
-	0x5B, 4271,
-	0x5C, 4297,
-	0x5D, 4331,
-	0x5E, 4365,
-	0x5F, 4399,
-	0x60, 4433,
-	0x61, 4451,
-	0x62, 4469,
-	0x63, 4487,
-	0x64, 4505,
-	0x65, 4523,
-	0x66, 4541,
-	0x67, 4559,
-	0x68, 4577,
-	0x69, 4595,
-	0x6A, 4613,
-	0x6B, 4631,
-	0x6C, 4649,
-	0x6D, 4659,
-	0x6E, 4669,
-	0x6F, 4736,
-	0x70, 4762,
-	0x71, 4804,
-	0x72, 4867,
-	0x73, 4930,
-	0x74, 4995,
-	0x75, 5013,
-	0x76, 5031,
-	0x77, 5049,
-	0x7C, 5052,
-	0x7D, 5070,
-	0x7E, 5088,
-	0x7F, 5165,
-	0x80, 5191,
-	0x81, 5222,
-	0x82, 5253,
-	0x83, 5284,
-	0x84, 5315,
-	0x85, 5346,
-	0x86, 5377,
-	0x87, 5408,
-	0x88, 5439,
-	0x89, 5470,
-	0x8A, 5501,
-	0x8B, 5532,
-	0x8C, 5563,
-	0x8D, 5594,
-	0x8E, 5625,
-	0x8F, 5656,
-	0x90, 5687,
-	0x91, 5692,
-	0x92, 5697,
-	0x93, 5702,
-	0x94, 5707,
-	0x95, 5712,
-	0x96, 5717,
-	0x97, 5722,
-	0x98, 5727,
-	0x99, 5732,
-	0x9A, 5737,
-	0x9B, 5742,
-	0x9C, 5747,
-	0x9D, 5752,
-	0x9E, 5757,
-	0x9F, 5762,
-	0xA0, 5767,
-	0xA1, 5771,
-	0xA2, 5798,
-	0xA3, 5801,
-	0xA4, 5830,
-	0xA5, 5865,
-	0xA8, 5897,
-	0xA9, 5901,
-	0xAA, 5928,
-	0xAB, 5931,
-	0xAC, 5960,
-	0xAD, 5995,
-	0xAE, 6027,
-	0xAF, 6285,
-	0xB0, 6314,
-	0xB1, 6320,
-	0xB2, 6349,
-	0xB3, 6378,
-	0xB4, 6407,
-	0xB5, 6436,
-	0xB6, 6465,
-	0xB7, 6494,
-	0xB8, 6523,
-	0xB9, 6560,
-	0xBA, 6570,
-	0xBB, 6695,
-	0xBC, 6724,
-	0xBD, 6791,
-	0xBE, 6858,
-	0xBF, 6887,
-	0xC0, 6916,
-	0xC1, 6922,
-	0xC2, 6951,
-	0xC3, 6993,
-	0xC4, 7022,
-	0xC5, 7044,
-	0xC6, 7066,
-	0xC7, 7088,
-	0xc8, 7217,
-	0xc9, 7217,
-	0xca, 7217,
-	0xcb, 7217,
-	0xcc, 7217,
-	0xcd, 7217,
-	0xce, 7217,
-	0xcf, 7217,
-	0xD0, 7240,
-	0xD1, 7258,
-	0xD2, 7276,
-	0xD3, 7294,
-	0xD4, 7312,
-	0xD5, 7330,
-	0xD6, 7348,
-	0xD7, 7374,
-	0xD8, 7392,
-	0xD9, 7410,
-	0xDA, 7428,
-	0xDB, 7446,
-	0xDC, 7464,
-	0xDD, 7482,
-	0xDE, 7500,
-	0xDF, 7518,
-	0xE0, 7536,
-	0xE1, 7554,
-	0xE2, 7572,
-	0xE3, 7590,
-	0xE4, 7608,
-	0xE5, 7626,
-	0xE6, 7644,
-	0xE7, 7670,
-	0xE8, 7688,
-	0xE9, 7706,
-	0xEA, 7724,
-	0xEB, 7742,
-	0xEC, 7760,
-	0xED, 7778,
-	0xEE, 7796,
-	0xEF, 7814,
-	0xF0, 7832,
-	0xF1, 7842,
-	0xF2, 7860,
-	0xF3, 7878,
-	0xF4, 7896,
-	0xF5, 7914,
-	0xF6, 7932,
-	0xF7, 7950,
-	0xF8, 7968,
-	0xF9, 7986,
-	0xFA, 8004,
-	0xFB, 8022,
-	0xFC, 8040,
-	0xFD, 8058,
-	0xFE, 8076,
-	0xFF, 8094,
+	/*721*/ uint16(xCondByte), 230,
+	0x00, 1184,
+	0x01, 1241,
+	0x02, 1461,
+	0x03, 1483,
+	0x05, 1505,
+	0x06, 1511,
+	0x07, 1514,
+	0x08, 1520,
+	0x09, 1523,
+	0x0B, 1526,
+	0x0D, 1529,
+	0x10, 1544,
+	0x11, 1586,
+	0x12, 1628,
+	0x13, 1681,
+	0x14, 1703,
+	0x15, 1725,
+	0x16, 1747,
+	0x17, 1790,
+	0x18, 1812,
+	0x1E, 1845,
+	0x1F, 1924,
+	0x20, 1945,
+	0x21, 1960,
+	0x22, 1975,
+	0x23, 1990,
+	0x24, 2005,
+	0x26, 2020,
+	0x28, 2035,
+	0x29, 2057,
+	0x2A, 2079,
+	0x2B, 2182,
+	0x2C, 2224,
+	0x2D, 2327,
+	0x2E, 2430,
+	0x2F, 2452,
+	0x30, 2474,
+	0x31, 2477,
+	0x32, 2480,
+	0x33, 2483,
+	0x34, 2486,
+	0x35, 2489,
+	0x38, 2499,
+	0x3A, 3900,
+	0x40, 4413,
+	0x41, 4442,
+	0x42, 4471,
+	0x43, 4500,
+	0x44, 4529,
+	0x45, 4558,
+	0x46, 4587,
+	0x47, 4616,
+	0x48, 4645,
+	0x49, 4674,
+	0x4A, 4703,
+	0x4B, 4732,
+	0x4C, 4761,
+	0x4D, 4790,
+	0x4E, 4819,
+	0x4F, 4848,
+	0x50, 4877,
+	0x51, 4899,
+	0x52, 4941,
+	0x53, 4963,
+	0x54, 4985,
+	0x55, 5007,
+	0x56, 5029,
+	0x57, 5051,
+	0x58, 5073,
+	0x59, 5115,
+	0x5A, 5157,
+	0x5B, 5199,
+	0x5C, 5231,
+	0x5D, 5273,
+	0x5E, 5315,
+	0x5F, 5357,
+	0x60, 5399,
+	0x61, 5421,
+	0x62, 5443,
+	0x63, 5465,
+	0x64, 5487,
+	0x65, 5509,
+	0x66, 5531,
+	0x67, 5553,
+	0x68, 5575,
+	0x69, 5597,
+	0x6A, 5619,
+	0x6B, 5641,
+	0x6C, 5663,
+	0x6D, 5675,
+	0x6E, 5687,
+	0x6F, 5766,
+	0x70, 5798,
+	0x71, 5848,
+	0x72, 5923,
+	0x73, 5998,
+	0x74, 6075,
+	0x75, 6097,
+	0x76, 6119,
+	0x77, 6141,
+	0x7C, 6146,
+	0x7D, 6168,
+	0x7E, 6190,
+	0x7F, 6281,
+	0x80, 6313,
+	0x81, 6344,
+	0x82, 6375,
+	0x83, 6406,
+	0x84, 6437,
+	0x85, 6468,
+	0x86, 6499,
+	0x87, 6530,
+	0x88, 6561,
+	0x89, 6592,
+	0x8A, 6623,
+	0x8B, 6654,
+	0x8C, 6685,
+	0x8D, 6716,
+	0x8E, 6747,
+	0x8F, 6778,
+	0x90, 6809,
+	0x91, 6814,
+	0x92, 6819,
+	0x93, 6824,
+	0x94, 6829,
+	0x95, 6834,
+	0x96, 6839,
+	0x97, 6844,
+	0x98, 6849,
+	0x99, 6854,
+	0x9A, 6859,
+	0x9B, 6864,
+	0x9C, 6869,
+	0x9D, 6874,
+	0x9E, 6879,
+	0x9F, 6884,
+	0xA0, 6889,
+	0xA1, 6893,
+	0xA2, 6920,
+	0xA3, 6923,
+	0xA4, 6952,
+	0xA5, 6987,
+	0xA8, 7019,
+	0xA9, 7023,
+	0xAA, 7050,
+	0xAB, 7053,
+	0xAC, 7082,
+	0xAD, 7117,
+	0xAE, 7149,
+	0xAF, 7614,
+	0xB0, 7643,
+	0xB1, 7649,
+	0xB2, 7678,
+	0xB3, 7707,
+	0xB4, 7736,
+	0xB5, 7765,
+	0xB6, 7794,
+	0xB7, 7823,
+	0xB8, 7852,
+	0xB9, 7895,
+	0xBA, 7905,
+	0xBB, 8030,
+	0xBC, 8059,
+	0xBD, 8132,
+	0xBE, 8205,
+	0xBF, 8234,
+	0xC0, 8263,
+	0xC1, 8269,
+	0xC2, 8298,
+	0xC3, 8348,
+	0xC4, 8383,
+	0xC5, 8409,
+	0xC6, 8435,
+	0xC7, 8461,
+	0xc8, 8660,
+	0xc9, 8660,
+	0xca, 8660,
+	0xcb, 8660,
+	0xcc, 8660,
+	0xcd, 8660,
+	0xce, 8660,
+	0xcf, 8660,
+	0xD0, 8683,
+	0xD1, 8705,
+	0xD2, 8727,
+	0xD3, 8749,
+	0xD4, 8771,
+	0xD5, 8793,
+	0xD6, 8815,
+	0xD7, 8847,
+	0xD8, 8869,
+	0xD9, 8891,
+	0xDA, 8913,
+	0xDB, 8935,
+	0xDC, 8957,
+	0xDD, 8979,
+	0xDE, 9001,
+	0xDF, 9023,
+	0xE0, 9045,
+	0xE1, 9067,
+	0xE2, 9089,
+	0xE3, 9111,
+	0xE4, 9133,
+	0xE5, 9155,
+	0xE6, 9177,
+	0xE7, 9209,
+	0xE8, 9231,
+	0xE9, 9253,
+	0xEA, 9275,
+	0xEB, 9297,
+	0xEC, 9319,
+	0xED, 9341,
+	0xEE, 9363,
+	0xEF, 9385,
+	0xF0, 9407,
+	0xF1, 9419,
+	0xF2, 9441,
+	0xF3, 9463,
+	0xF4, 9485,
+	0xF5, 9507,
+	0xF6, 9529,
+	0xF7, 9551,
+	0xF8, 9573,
+	0xF9, 9595,
+	0xFA, 9617,
+	0xFB, 9639,
+	0xFC, 9661,
+	0xFD, 9683,
+	0xFE, 9705,
+	0xFF, 9727,
 	uint16(xFail),
-	/*1182*/ uint16(xCondSlashR),
-	1191, // 0
-	1207, // 1
-	1223, // 2
-	1227, // 3
-	1231, // 4
-	1235, // 5
+	/*1184*/ uint16(xCondSlashR),
+	1193, // 0
+	1209, // 1
+	1225, // 2
+	1229, // 3
+	1233, // 4
+	1237, // 5
 	0,    // 6
 	0,    // 7
-	/*1191*/ uint16(xCondDataSize), 1195, 1199, 1203,
-	/*1195*/ uint16(xSetOp), uint16(SLDT),
-	/*1197*/ uint16(xArgRM16),
-	/*1198*/ uint16(xMatch),
-	/*1199*/ uint16(xSetOp), uint16(SLDT),
-	/*1201*/ uint16(xArgR32M16),
-	/*1202*/ uint16(xMatch),
-	/*1203*/ uint16(xSetOp), uint16(SLDT),
-	/*1205*/ uint16(xArgR64M16),
-	/*1206*/ uint16(xMatch),
-	/*1207*/ uint16(xCondDataSize), 1211, 1215, 1219,
-	/*1211*/ uint16(xSetOp), uint16(STR),
-	/*1213*/ uint16(xArgRM16),
-	/*1214*/ uint16(xMatch),
-	/*1215*/ uint16(xSetOp), uint16(STR),
-	/*1217*/ uint16(xArgR32M16),
-	/*1218*/ uint16(xMatch),
-	/*1219*/ uint16(xSetOp), uint16(STR),
-	/*1221*/ uint16(xArgR64M16),
-	/*1222*/ uint16(xMatch),
-	/*1223*/ uint16(xSetOp), uint16(LLDT),
-	/*1225*/ uint16(xArgRM16),
-	/*1226*/ uint16(xMatch),
-	/*1227*/ uint16(xSetOp), uint16(LTR),
-	/*1229*/ uint16(xArgRM16),
-	/*1230*/ uint16(xMatch),
-	/*1231*/ uint16(xSetOp), uint16(VERR),
-	/*1233*/ uint16(xArgRM16),
-	/*1234*/ uint16(xMatch),
-	/*1235*/ uint16(xSetOp), uint16(VERW),
-	/*1237*/ uint16(xArgRM16),
-	/*1238*/ uint16(xMatch),
-	/*1239*/ uint16(xCondByte), 8,
-	0xC8, 1320,
-	0xC9, 1323,
-	0xD0, 1326,
-	0xD1, 1329,
-	0xD5, 1332,
-	0xD6, 1335,
-	0xF8, 1338,
-	0xF9, 1344,
-	/*1257*/ uint16(xCondSlashR),
-	1266, // 0
-	1270, // 1
-	1274, // 2
-	1285, // 3
-	1296, // 4
-	0,    // 5
-	1312, // 6
-	1316, // 7
-	/*1266*/ uint16(xSetOp), uint16(SGDT),
-	/*1268*/ uint16(xArgM),
-	/*1269*/ uint16(xMatch),
-	/*1270*/ uint16(xSetOp), uint16(SIDT),
-	/*1272*/ uint16(xArgM),
-	/*1273*/ uint16(xMatch),
-	/*1274*/ uint16(xCondIs64), 1277, 1281,
-	/*1277*/ uint16(xSetOp), uint16(LGDT),
-	/*1279*/ uint16(xArgM16and32),
-	/*1280*/ uint16(xMatch),
-	/*1281*/ uint16(xSetOp), uint16(LGDT),
-	/*1283*/ uint16(xArgM16and64),
-	/*1284*/ uint16(xMatch),
-	/*1285*/ uint16(xCondIs64), 1288, 1292,
-	/*1288*/ uint16(xSetOp), uint16(LIDT),
-	/*1290*/ uint16(xArgM16and32),
-	/*1291*/ uint16(xMatch),
-	/*1292*/ uint16(xSetOp), uint16(LIDT),
-	/*1294*/ uint16(xArgM16and64),
-	/*1295*/ uint16(xMatch),
-	/*1296*/ uint16(xCondDataSize), 1300, 1304, 1308,
-	/*1300*/ uint16(xSetOp), uint16(SMSW),
-	/*1302*/ uint16(xArgRM16),
-	/*1303*/ uint16(xMatch),
-	/*1304*/ uint16(xSetOp), uint16(SMSW),
-	/*1306*/ uint16(xArgR32M16),
-	/*1307*/ uint16(xMatch),
-	/*1308*/ uint16(xSetOp), uint16(SMSW),
-	/*1310*/ uint16(xArgR64M16),
-	/*1311*/ uint16(xMatch),
-	/*1312*/ uint16(xSetOp), uint16(LMSW),
-	/*1314*/ uint16(xArgRM16),
-	/*1315*/ uint16(xMatch),
-	/*1316*/ uint16(xSetOp), uint16(INVLPG),
-	/*1318*/ uint16(xArgM),
-	/*1319*/ uint16(xMatch),
-	/*1320*/ uint16(xSetOp), uint16(MONITOR),
-	/*1322*/ uint16(xMatch),
-	/*1323*/ uint16(xSetOp), uint16(MWAIT),
+	/*1193*/ uint16(xCondDataSize), 1197, 1201, 1205,
+	/*1197*/ uint16(xSetOp), uint16(SLDT),
+	/*1199*/ uint16(xArgRM16),
+	/*1200*/ uint16(xMatch),
+	/*1201*/ uint16(xSetOp), uint16(SLDT),
+	/*1203*/ uint16(xArgR32M16),
+	/*1204*/ uint16(xMatch),
+	/*1205*/ uint16(xSetOp), uint16(SLDT),
+	/*1207*/ uint16(xArgR64M16),
+	/*1208*/ uint16(xMatch),
+	/*1209*/ uint16(xCondDataSize), 1213, 1217, 1221,
+	/*1213*/ uint16(xSetOp), uint16(STR),
+	/*1215*/ uint16(xArgRM16),
+	/*1216*/ uint16(xMatch),
+	/*1217*/ uint16(xSetOp), uint16(STR),
+	/*1219*/ uint16(xArgR32M16),
+	/*1220*/ uint16(xMatch),
+	/*1221*/ uint16(xSetOp), uint16(STR),
+	/*1223*/ uint16(xArgR64M16),
+	/*1224*/ uint16(xMatch),
+	/*1225*/ uint16(xSetOp), uint16(LLDT),
+	/*1227*/ uint16(xArgRM16),
+	/*1228*/ uint16(xMatch),
+	/*1229*/ uint16(xSetOp), uint16(LTR),
+	/*1231*/ uint16(xArgRM16),
+	/*1232*/ uint16(xMatch),
+	/*1233*/ uint16(xSetOp), uint16(VERR),
+	/*1235*/ uint16(xArgRM16),
+	/*1236*/ uint16(xMatch),
+	/*1237*/ uint16(xSetOp), uint16(VERW),
+	/*1239*/ uint16(xArgRM16),
+	/*1240*/ uint16(xMatch),
+	/*1241*/ uint16(xCondByte), 14,
+	0xC8, 1347,
+	0xC9, 1352,
+	0xD0, 1357,
+	0xD1, 1362,
+	0xD5, 1367,
+	0xD6, 1372,
+	0xE8, 1377,
+	0xEA, 1393,
+	0xEC, 1402,
+	0xED, 1414,
+	0xEE, 1426,
+	0xEF, 1438,
+	0xF8, 1450,
+	0xF9, 1456,
+	/*1271*/ uint16(xCondSlashR),
+	1280, // 0
+	1284, // 1
+	1288, // 2
+	1299, // 3
+	1310, // 4
+	1326, // 5
+	1339, // 6
+	1343, // 7
+	/*1280*/ uint16(xSetOp), uint16(SGDT),
+	/*1282*/ uint16(xArgM),
+	/*1283*/ uint16(xMatch),
+	/*1284*/ uint16(xSetOp), uint16(SIDT),
+	/*1286*/ uint16(xArgM),
+	/*1287*/ uint16(xMatch),
+	/*1288*/ uint16(xCondIs64), 1291, 1295,
+	/*1291*/ uint16(xSetOp), uint16(LGDT),
+	/*1293*/ uint16(xArgM16and32),
+	/*1294*/ uint16(xMatch),
+	/*1295*/ uint16(xSetOp), uint16(LGDT),
+	/*1297*/ uint16(xArgM16and64),
+	/*1298*/ uint16(xMatch),
+	/*1299*/ uint16(xCondIs64), 1302, 1306,
+	/*1302*/ uint16(xSetOp), uint16(LIDT),
+	/*1304*/ uint16(xArgM16and32),
+	/*1305*/ uint16(xMatch),
+	/*1306*/ uint16(xSetOp), uint16(LIDT),
+	/*1308*/ uint16(xArgM16and64),
+	/*1309*/ uint16(xMatch),
+	/*1310*/ uint16(xCondDataSize), 1314, 1318, 1322,
+	/*1314*/ uint16(xSetOp), uint16(SMSW),
+	/*1316*/ uint16(xArgRM16),
+	/*1317*/ uint16(xMatch),
+	/*1318*/ uint16(xSetOp), uint16(SMSW),
+	/*1320*/ uint16(xArgR32M16),
+	/*1321*/ uint16(xMatch),
+	/*1322*/ uint16(xSetOp), uint16(SMSW),
+	/*1324*/ uint16(xArgR64M16),
 	/*1325*/ uint16(xMatch),
-	/*1326*/ uint16(xSetOp), uint16(XGETBV),
-	/*1328*/ uint16(xMatch),
-	/*1329*/ uint16(xSetOp), uint16(XSETBV),
-	/*1331*/ uint16(xMatch),
-	/*1332*/ uint16(xSetOp), uint16(XEND),
-	/*1334*/ uint16(xMatch),
-	/*1335*/ uint16(xSetOp), uint16(XTEST),
-	/*1337*/ uint16(xMatch),
-	/*1338*/ uint16(xCondIs64), 0, 1341,
-	/*1341*/ uint16(xSetOp), uint16(SWAPGS),
-	/*1343*/ uint16(xMatch),
-	/*1344*/ uint16(xSetOp), uint16(RDTSCP),
+	/*1326*/ uint16(xCondPrefix), 1,
+	0xF3, 1330,
+	/*1330*/ uint16(xCondIsMem), 0, 1333,
+	/*1333*/ uint16(xSetOp), uint16(RSTORSSP),
+	/*1335*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*1337*/ uint16(xArgM64),
+	/*1338*/ uint16(xMatch),
+	/*1339*/ uint16(xSetOp), uint16(LMSW),
+	/*1341*/ uint16(xArgRM16),
+	/*1342*/ uint16(xMatch),
+	/*1343*/ uint16(xSetOp), uint16(INVLPG),
+	/*1345*/ uint16(xArgM),
 	/*1346*/ uint16(xMatch),
-	/*1347*/ uint16(xCondDataSize), 1351, 1357, 1363,
-	/*1351*/ uint16(xSetOp), uint16(LAR),
-	/*1353*/ uint16(xReadSlashR),
-	/*1354*/ uint16(xArgR16),
-	/*1355*/ uint16(xArgRM16),
+	/*1347*/ uint16(xSetOp), uint16(MONITOR),
+	/*1349*/ uint16(xSetFeature), uint16(FeatureMONITOR),
+	/*1351*/ uint16(xMatch),
+	/*1352*/ uint16(xSetOp), uint16(MWAIT),
+	/*1354*/ uint16(xSetFeature), uint16(FeatureMONITOR),
 	/*1356*/ uint16(xMatch),
-	/*1357*/ uint16(xSetOp), uint16(LAR),
-	/*1359*/ uint16(xReadSlashR),
-	/*1360*/ uint16(xArgR32),
-	/*1361*/ uint16(xArgR32M16),
-	/*1362*/ uint16(xMatch),
-	/*1363*/ uint16(xSetOp), uint16(LAR),
-	/*1365*/ uint16(xReadSlashR),
-	/*1366*/ uint16(xArgR64),
-	/*1367*/ uint16(xArgR64M16),
-	/*1368*/ uint16(xMatch),
-	/*1369*/ uint16(xCondDataSize), 1373, 1379, 1385,
-	/*1373*/ uint16(xSetOp), uint16(LSL),
-	/*1375*/ uint16(xReadSlashR),
-	/*1376*/ uint16(xArgR16),
-	/*1377*/ uint16(xArgRM16),
-	/*1378*/ uint16(xMatch),
-	/*1379*/ uint16(xSetOp), uint16(LSL),
-	/*1381*/ uint16(xReadSlashR),
-	/*1382*/ uint16(xArgR32),
-	/*1383*/ uint16(xArgR32M16),
-	/*1384*/ uint16(xMatch),
-	/*1385*/ uint16(xSetOp), uint16(LSL),
-	/*1387*/ uint16(xReadSlashR),
-	/*1388*/ uint16(xArgR64),
-	/*1389*/ uint16(xArgR32M16),
-	/*1390*/ uint16(xMatch),
-	/*1391*/ uint16(xCondIs64), 0, 1394,
-	/*1394*/ uint16(xSetOp), uint16(SYSCALL),
-	/*1396*/ uint16(xMatch),
-	/*1397*/ uint16(xSetOp), uint16(CLTS),
-	/*1399*/ uint16(xMatch),
-	/*1400*/ uint16(xCondIs64), 0, 1403,
-	/*1403*/ uint16(xSetOp), uint16(SYSRET),
-	/*1405*/ uint16(xMatch),
-	/*1406*/ uint16(xSetOp), uint16(INVD),
-	/*1408*/ uint16(xMatch),
-	/*1409*/ uint16(xSetOp), uint16(WBINVD),
-	/*1411*/ uint16(xMatch),
-	/*1412*/ uint16(xSetOp), uint16(UD2),
-	/*1414*/ uint16(xMatch),
-	/*1415*/ uint16(xCondSlashR),
+	/*1357*/ uint16(xSetOp), uint16(XGETBV),
+	/*1359*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*1361*/ uint16(xMatch),
+	/*1362*/ uint16(xSetOp), uint16(XSETBV),
+	/*1364*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*1366*/ uint16(xMatch),
+	/*1367*/ uint16(xSetOp), uint16(XEND),
+	/*1369*/ uint16(xSetFeature), uint16(FeatureRTM),
+	/*1371*/ uint16(xMatch),
+	/*1372*/ uint16(xSetOp), uint16(XTEST),
+	/*1374*/ uint16(xSetFeature), uint16(FeatureRTM),
+	/*1376*/ uint16(xMatch),
+	/*1377*/ uint16(xCondPrefix), 2,
+	0xF3, 1388,
+	0x0, 1383,
+	/*1383*/ uint16(xSetOp), uint16(SERIALIZE),
+	/*1385*/ uint16(xSetFeature), uint16(FeatureSERIALIZE),
+	/*1387*/ uint16(xMatch),
+	/*1388*/ uint16(xSetOp), uint16(SETSSBSY),
+	/*1390*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*1392*/ uint16(xMatch),
+	/*1393*/ uint16(xCondPrefix), 1,
+	0xF3, 1397,
+	/*1397*/ uint16(xSetOp), uint16(SAVEPREVSSP),
+	/*1399*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*1401*/ uint16(xMatch),
+	/*1402*/ uint16(xCondIs64), 0, 1405,
+	/*1405*/ uint16(xCondPrefix), 1,
+	0xF3, 1409,
+	/*1409*/ uint16(xSetOp), uint16(UIRET),
+	/*1411*/ uint16(xSetFeature), uint16(FeatureUINTR),
+	/*1413*/ uint16(xMatch),
+	/*1414*/ uint16(xCondIs64), 0, 1417,
+	/*1417*/ uint16(xCondPrefix), 1,
+	0xF3, 1421,
+	/*1421*/ uint16(xSetOp), uint16(TESTUI),
+	/*1423*/ uint16(xSetFeature), uint16(FeatureUINTR),
+	/*1425*/ uint16(xMatch),
+	/*1426*/ uint16(xCondIs64), 0, 1429,
+	/*1429*/ uint16(xCondPrefix), 1,
+	0xF3, 1433,
+	/*1433*/ uint16(xSetOp), uint16(CLUI),
+	/*1435*/ uint16(xSetFeature), uint16(FeatureUINTR),
+	/*1437*/ uint16(xMatch),
+	/*1438*/ uint16(xCondIs64), 0, 1441,
+	/*1441*/ uint16(xCondPrefix), 1,
+	0xF3, 1445,
+	/*1445*/ uint16(xSetOp), uint16(STUI),
+	/*1447*/ uint16(xSetFeature), uint16(FeatureUINTR),
+	/*1449*/ uint16(xMatch),
+	/*1450*/ uint16(xCondIs64), 0, 1453,
+	/*1453*/ uint16(xSetOp), uint16(SWAPGS),
+	/*1455*/ uint16(xMatch),
+	/*1456*/ uint16(xSetOp), uint16(RDTSCP),
+	/*1458*/ uint16(xSetFeature), uint16(FeatureRDTSCP),
+	/*1460*/ uint16(xMatch),
+	/*1461*/ uint16(xCondDataSize), 1465, 1471, 1477,
+	/*1465*/ uint16(xSetOp), uint16(LAR),
+	/*1467*/ uint16(xReadSlashR),
+	/*1468*/ uint16(xArgR16),
+	/*1469*/ uint16(xArgRM16),
+	/*1470*/ uint16(xMatch),
+	/*1471*/ uint16(xSetOp), uint16(LAR),
+	/*1473*/ uint16(xReadSlashR),
+	/*1474*/ uint16(xArgR32),
+	/*1475*/ uint16(xArgR32M16),
+	/*1476*/ uint16(xMatch),
+	/*1477*/ uint16(xSetOp), uint16(LAR),
+	/*1479*/ uint16(xReadSlashR),
+	/*1480*/ uint16(xArgR64),
+	/*1481*/ uint16(xArgR64M16),
+	/*1482*/ uint16(xMatch),
+	/*1483*/ uint16(xCondDataSize), 1487, 1493, 1499,
+	/*1487*/ uint16(xSetOp), uint16(LSL),
+	/*1489*/ uint16(xReadSlashR),
+	/*1490*/ uint16(xArgR16),
+	/*1491*/ uint16(xArgRM16),
+	/*1492*/ uint16(xMatch),
+	/*1493*/ uint16(xSetOp), uint16(LSL),
+	/*1495*/ uint16(xReadSlashR),
+	/*1496*/ uint16(xArgR32),
+	/*1497*/ uint16(xArgR32M16),
+	/*1498*/ uint16(xMatch),
+	/*1499*/ uint16(xSetOp), uint16(LSL),
+	/*1501*/ uint16(xReadSlashR),
+	/*1502*/ uint16(xArgR64),
+	/*1503*/ uint16(xArgR32M16),
+	/*1504*/ uint16(xMatch),
+	/*1505*/ uint16(xCondIs64), 0, 1508,
+	/*1508*/ uint16(xSetOp), uint16(SYSCALL),
+	/*1510*/ uint16(xMatch),
+	/*1511*/ uint16(xSetOp), uint16(CLTS),
+	/*1513*/ uint16(xMatch),
+	/*1514*/ uint16(xCondIs64), 0, 1517,
+	/*1517*/ uint16(xSetOp), uint16(SYSRET),
+	/*1519*/ uint16(xMatch),
+	/*1520*/ uint16(xSetOp), uint16(INVD),
+	/*1522*/ uint16(xMatch),
+	/*1523*/ uint16(xSetOp), uint16(WBINVD),
+	/*1525*/ uint16(xMatch),
+	/*1526*/ uint16(xSetOp), uint16(UD2),
+	/*1528*/ uint16(xMatch),
+	/*1529*/ uint16(xCondSlashR),
 	0,    // 0
-	1424, // 1
+	1538, // 1
 	0,    // 2
 	0,    // 3
 	0,    // 4
 	0,    // 5
 	0,    // 6
 	0,    // 7
-	/*1424*/ uint16(xSetOp), uint16(PREFETCHW),
-	/*1426*/ uint16(xArgM8),
-	/*1427*/ uint16(xMatch),
-	/*1428*/ uint16(xCondPrefix), 4,
-	0xF3, 1456,
-	0xF2, 1450,
-	0x66, 1444,
-	0x0, 1438,
-	/*1438*/ uint16(xSetOp), uint16(MOVUPS),
-	/*1440*/ uint16(xReadSlashR),
-	/*1441*/ uint16(xArgXmm1),
-	/*1442*/ uint16(xArgXmm2M128),
-	/*1443*/ uint16(xMatch),
-	/*1444*/ uint16(xSetOp), uint16(MOVUPD),
-	/*1446*/ uint16(xReadSlashR),
-	/*1447*/ uint16(xArgXmm1),
-	/*1448*/ uint16(xArgXmm2M128),
-	/*1449*/ uint16(xMatch),
-	/*1450*/ uint16(xSetOp), uint16(MOVSD_XMM),
-	/*1452*/ uint16(xReadSlashR),
-	/*1453*/ uint16(xArgXmm1),
-	/*1454*/ uint16(xArgXmm2M64),
-	/*1455*/ uint16(xMatch),
-	/*1456*/ uint16(xSetOp), uint16(MOVSS),
-	/*1458*/ uint16(xReadSlashR),
-	/*1459*/ uint16(xArgXmm1),
-	/*1460*/ uint16(xArgXmm2M32),
-	/*1461*/ uint16(xMatch),
-	/*1462*/ uint16(xCondPrefix), 4,
-	0xF3, 1490,
-	0xF2, 1484,
-	0x66, 1478,
-	0x0, 1472,
-	/*1472*/ uint16(xSetOp), uint16(MOVUPS),
-	/*1474*/ uint16(xReadSlashR),
-	/*1475*/ uint16(xArgXmm2M128),
-	/*1476*/ uint16(xArgXmm1),
-	/*1477*/ uint16(xMatch),
-	/*1478*/ uint16(xSetOp), uint16(MOVUPD),
-	/*1480*/ uint16(xReadSlashR),
-	/*1481*/ uint16(xArgXmm2M128),
-	/*1482*/ uint16(xArgXmm),
-	/*1483*/ uint16(xMatch),
-	/*1484*/ uint16(xSetOp), uint16(MOVSD_XMM),
-	/*1486*/ uint16(xReadSlashR),
-	/*1487*/ uint16(xArgXmm2M64),
-	/*1488*/ uint16(xArgXmm1),
-	/*1489*/ uint16(xMatch),
-	/*1490*/ uint16(xSetOp), uint16(MOVSS),
-	/*1492*/ uint16(xReadSlashR),
-	/*1493*/ uint16(xArgXmm2M32),
-	/*1494*/ uint16(xArgXmm),
-	/*1495*/ uint16(xMatch),
-	/*1496*/ uint16(xCondPrefix), 4,
-	0xF3, 1533,
-	0xF2, 1527,
-	0x66, 1521,
-	0x0, 1506,
-	/*1506*/ uint16(xCondIsMem), 1509, 1515,
-	/*1509*/ uint16(xSetOp), uint16(MOVHLPS),
-	/*1511*/ uint16(xReadSlashR),
-	/*1512*/ uint16(xArgXmm1),
-	/*1513*/ uint16(xArgXmm2),
-	/*1514*/ uint16(xMatch),
-	/*1515*/ uint16(xSetOp), uint16(MOVLPS),
-	/*1517*/ uint16(xReadSlashR),
-	/*1518*/ uint16(xArgXmm),
-	/*1519*/ uint16(xArgM64),
-	/*1520*/ uint16(xMatch),
-	/*1521*/ uint16(xSetOp), uint16(MOVLPD),
-	/*1523*/ uint16(xReadSlashR),
-	/*1524*/ uint16(xArgXmm),
-	/*1525*/ uint16(xArgXmm2M64),
-	/*1526*/ uint16(xMatch),
-	/*1527*/ uint16(xSetOp), uint16(MOVDDUP),
-	/*1529*/ uint16(xReadSlashR),
-	/*1530*/ uint16(xArgXmm1),
-	/*1531*/ uint16(xArgXmm2M64),
-	/*1532*/ uint16(xMatch),
-	/*1533*/ uint16(xSetOp), uint16(MOVSLDUP),
-	/*1535*/ uint16(xReadSlashR),
-	/*1536*/ uint16(xArgXmm1),
-	/*1537*/ uint16(xArgXmm2M128),
-	/*1538*/ uint16(xMatch),
-	/*1539*/ uint16(xCondPrefix), 2,
-	0x66, 1551,
-	0x0, 1545,
-	/*1545*/ uint16(xSetOp), uint16(MOVLPS),
-	/*1547*/ uint16(xReadSlashR),
-	/*1548*/ uint16(xArgM64),
-	/*1549*/ uint16(xArgXmm),
-	/*1550*/ uint16(xMatch),
-	/*1551*/ uint16(xSetOp), uint16(MOVLPD),
-	/*1553*/ uint16(xReadSlashR),
-	/*1554*/ uint16(xArgXmm2M64),
-	/*1555*/ uint16(xArgXmm),
-	/*1556*/ uint16(xMatch),
-	/*1557*/ uint16(xCondPrefix), 2,
-	0x66, 1569,
-	0x0, 1563,
-	/*1563*/ uint16(xSetOp), uint16(UNPCKLPS),
-	/*1565*/ uint16(xReadSlashR),
-	/*1566*/ uint16(xArgXmm1),
-	/*1567*/ uint16(xArgXmm2M128),
-	/*1568*/ uint16(xMatch),
-	/*1569*/ uint16(xSetOp), uint16(UNPCKLPD),
-	/*1571*/ uint16(xReadSlashR),
-	/*1572*/ uint16(xArgXmm1),
-	/*1573*/ uint16(xArgXmm2M128),
-	/*1574*/ uint16(xMatch),
-	/*1575*/ uint16(xCondPrefix), 2,
-	0x66, 1587,
-	0x0, 1581,
-	/*1581*/ uint16(xSetOp), uint16(UNPCKHPS),
-	/*1583*/ uint16(xReadSlashR),
-	/*1584*/ uint16(xArgXmm1),
-	/*1585*/ uint16(xArgXmm2M128),
-	/*1586*/ uint16(xMatch),
-	/*1587*/ uint16(xSetOp), uint16(UNPCKHPD),
-	/*1589*/ uint16(xReadSlashR),
-	/*1590*/ uint16(xArgXmm1),
-	/*1591*/ uint16(xArgXmm2M128),
-	/*1592*/ uint16(xMatch),
-	/*1593*/ uint16(xCondPrefix), 3,
-	0xF3, 1622,
-	0x66, 1616,
-	0x0, 1601,
-	/*1601*/ uint16(xCondIsMem), 1604, 1610,
-	/*1604*/ uint16(xSetOp), uint16(MOVLHPS),
-	/*1606*/ uint16(xReadSlashR),
-	/*1607*/ uint16(xArgXmm1),
-	/*1608*/ uint16(xArgXmm2),
-	/*1609*/ uint16(xMatch),
-	/*1610*/ uint16(xSetOp), uint16(MOVHPS),
-	/*1612*/ uint16(xReadSlashR),
-	/*1613*/ uint16(xArgXmm),
-	/*1614*/ uint16(xArgM64),
-	/*1615*/ uint16(xMatch),
-	/*1616*/ uint16(xSetOp), uint16(MOVHPD),
-	/*1618*/ uint16(xReadSlashR),
-	/*1619*/ uint16(xArgXmm),
-	/*1620*/ uint16(xArgXmm2M64),
-	/*1621*/ uint16(xMatch),
-	/*1622*/ uint16(xSetOp), uint16(MOVSHDUP),
+	/*1538*/ uint16(xSetOp), uint16(PREFETCHW),
+	/*1540*/ uint16(xSetFeature), uint16(FeaturePRFCHW),
+	/*1542*/ uint16(xArgM8),
+	/*1543*/ uint16(xMatch),
+	/*1544*/ uint16(xCondPrefix), 4,
+	0xF3, 1578,
+	0xF2, 1570,
+	0x66, 1562,
+	0x0, 1554,
+	/*1554*/ uint16(xSetOp), uint16(MOVUPS),
+	/*1556*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1558*/ uint16(xReadSlashR),
+	/*1559*/ uint16(xArgXmm1),
+	/*1560*/ uint16(xArgXmm2M128),
+	/*1561*/ uint16(xMatch),
+	/*1562*/ uint16(xSetOp), uint16(MOVUPD),
+	/*1564*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1566*/ uint16(xReadSlashR),
+	/*1567*/ uint16(xArgXmm1),
+	/*1568*/ uint16(xArgXmm2M128),
+	/*1569*/ uint16(xMatch),
+	/*1570*/ uint16(xSetOp), uint16(MOVSD_XMM),
+	/*1572*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1574*/ uint16(xReadSlashR),
+	/*1575*/ uint16(xArgXmm1),
+	/*1576*/ uint16(xArgXmm2M64),
+	/*1577*/ uint16(xMatch),
+	/*1578*/ uint16(xSetOp), uint16(MOVSS),
+	/*1580*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1582*/ uint16(xReadSlashR),
+	/*1583*/ uint16(xArgXmm1),
+	/*1584*/ uint16(xArgXmm2M32),
+	/*1585*/ uint16(xMatch),
+	/*1586*/ uint16(xCondPrefix), 4,
+	0xF3, 1620,
+	0xF2, 1612,
+	0x66, 1604,
+	0x0, 1596,
+	/*1596*/ uint16(xSetOp), uint16(MOVUPS),
+	/*1598*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1600*/ uint16(xReadSlashR),
+	/*1601*/ uint16(xArgXmm2M128),
+	/*1602*/ uint16(xArgXmm1),
+	/*1603*/ uint16(xMatch),
+	/*1604*/ uint16(xSetOp), uint16(MOVUPD),
+	/*1606*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1608*/ uint16(xReadSlashR),
+	/*1609*/ uint16(xArgXmm2M128),
+	/*1610*/ uint16(xArgXmm),
+	/*1611*/ uint16(xMatch),
+	/*1612*/ uint16(xSetOp), uint16(MOVSD_XMM),
+	/*1614*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1616*/ uint16(xReadSlashR),
+	/*1617*/ uint16(xArgXmm2M64),
+	/*1618*/ uint16(xArgXmm1),
+	/*1619*/ uint16(xMatch),
+	/*1620*/ uint16(xSetOp), uint16(MOVSS),
+	/*1622*/ uint16(xSetFeature), uint16(FeatureSSE),
 	/*1624*/ uint16(xReadSlashR),
-	/*1625*/ uint16(xArgXmm1),
-	/*1626*/ uint16(xArgXmm2M128),
+	/*1625*/ uint16(xArgXmm2M32),
+	/*1626*/ uint16(xArgXmm),
 	/*1627*/ uint16(xMatch),
-	/*1628*/ uint16(xCondPrefix), 2,
-	0x66, 1640,
-	0x0, 1634,
-	/*1634*/ uint16(xSetOp), uint16(MOVHPS),
-	/*1636*/ uint16(xReadSlashR),
-	/*1637*/ uint16(xArgM64),
-	/*1638*/ uint16(xArgXmm),
-	/*1639*/ uint16(xMatch),
-	/*1640*/ uint16(xSetOp), uint16(MOVHPD),
-	/*1642*/ uint16(xReadSlashR),
-	/*1643*/ uint16(xArgXmm2M64),
-	/*1644*/ uint16(xArgXmm),
-	/*1645*/ uint16(xMatch),
-	/*1646*/ uint16(xCondSlashR),
-	1655, // 0
-	1659, // 1
-	1663, // 2
-	1667, // 3
+	/*1628*/ uint16(xCondPrefix), 4,
+	0xF3, 1673,
+	0xF2, 1665,
+	0x66, 1657,
+	0x0, 1638,
+	/*1638*/ uint16(xCondIsMem), 1641, 1649,
+	/*1641*/ uint16(xSetOp), uint16(MOVHLPS),
+	/*1643*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1645*/ uint16(xReadSlashR),
+	/*1646*/ uint16(xArgXmm1),
+	/*1647*/ uint16(xArgXmm2),
+	/*1648*/ uint16(xMatch),
+	/*1649*/ uint16(xSetOp), uint16(MOVLPS),
+	/*1651*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1653*/ uint16(xReadSlashR),
+	/*1654*/ uint16(xArgXmm),
+	/*1655*/ uint16(xArgM64),
+	/*1656*/ uint16(xMatch),
+	/*1657*/ uint16(xSetOp), uint16(MOVLPD),
+	/*1659*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1661*/ uint16(xReadSlashR),
+	/*1662*/ uint16(xArgXmm),
+	/*1663*/ uint16(xArgXmm2M64),
+	/*1664*/ uint16(xMatch),
+	/*1665*/ uint16(xSetOp), uint16(MOVDDUP),
+	/*1667*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*1669*/ uint16(xReadSlashR),
+	/*1670*/ uint16(xArgXmm1),
+	/*1671*/ uint16(xArgXmm2M64),
+	/*1672*/ uint16(xMatch),
+	/*1673*/ uint16(xSetOp), uint16(MOVSLDUP),
+	/*1675*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*1677*/ uint16(xReadSlashR),
+	/*1678*/ uint16(xArgXmm1),
+	/*1679*/ uint16(xArgXmm2M128),
+	/*1680*/ uint16(xMatch),
+	/*1681*/ uint16(xCondPrefix), 2,
+	0x66, 1695,
+	0x0, 1687,
+	/*1687*/ uint16(xSetOp), uint16(MOVLPS),
+	/*1689*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1691*/ uint16(xReadSlashR),
+	/*1692*/ uint16(xArgM64),
+	/*1693*/ uint16(xArgXmm),
+	/*1694*/ uint16(xMatch),
+	/*1695*/ uint16(xSetOp), uint16(MOVLPD),
+	/*1697*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1699*/ uint16(xReadSlashR),
+	/*1700*/ uint16(xArgXmm2M64),
+	/*1701*/ uint16(xArgXmm),
+	/*1702*/ uint16(xMatch),
+	/*1703*/ uint16(xCondPrefix), 2,
+	0x66, 1717,
+	0x0, 1709,
+	/*1709*/ uint16(xSetOp), uint16(UNPCKLPS),
+	/*1711*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1713*/ uint16(xReadSlashR),
+	/*1714*/ uint16(xArgXmm1),
+	/*1715*/ uint16(xArgXmm2M128),
+	/*1716*/ uint16(xMatch),
+	/*1717*/ uint16(xSetOp), uint16(UNPCKLPD),
+	/*1719*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1721*/ uint16(xReadSlashR),
+	/*1722*/ uint16(xArgXmm1),
+	/*1723*/ uint16(xArgXmm2M128),
+	/*1724*/ uint16(xMatch),
+	/*1725*/ uint16(xCondPrefix), 2,
+	0x66, 1739,
+	0x0, 1731,
+	/*1731*/ uint16(xSetOp), uint16(UNPCKHPS),
+	/*1733*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1735*/ uint16(xReadSlashR),
+	/*1736*/ uint16(xArgXmm1),
+	/*1737*/ uint16(xArgXmm2M128),
+	/*1738*/ uint16(xMatch),
+	/*1739*/ uint16(xSetOp), uint16(UNPCKHPD),
+	/*1741*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1743*/ uint16(xReadSlashR),
+	/*1744*/ uint16(xArgXmm1),
+	/*1745*/ uint16(xArgXmm2M128),
+	/*1746*/ uint16(xMatch),
+	/*1747*/ uint16(xCondPrefix), 3,
+	0xF3, 1782,
+	0x66, 1774,
+	0x0, 1755,
+	/*1755*/ uint16(xCondIsMem), 1758, 1766,
+	/*1758*/ uint16(xSetOp), uint16(MOVLHPS),
+	/*1760*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1762*/ uint16(xReadSlashR),
+	/*1763*/ uint16(xArgXmm1),
+	/*1764*/ uint16(xArgXmm2),
+	/*1765*/ uint16(xMatch),
+	/*1766*/ uint16(xSetOp), uint16(MOVHPS),
+	/*1768*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1770*/ uint16(xReadSlashR),
+	/*1771*/ uint16(xArgXmm),
+	/*1772*/ uint16(xArgM64),
+	/*1773*/ uint16(xMatch),
+	/*1774*/ uint16(xSetOp), uint16(MOVHPD),
+	/*1776*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1778*/ uint16(xReadSlashR),
+	/*1779*/ uint16(xArgXmm),
+	/*1780*/ uint16(xArgXmm2M64),
+	/*1781*/ uint16(xMatch),
+	/*1782*/ uint16(xSetOp), uint16(MOVSHDUP),
+	/*1784*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*1786*/ uint16(xReadSlashR),
+	/*1787*/ uint16(xArgXmm1),
+	/*1788*/ uint16(xArgXmm2M128),
+	/*1789*/ uint16(xMatch),
+	/*1790*/ uint16(xCondPrefix), 2,
+	0x66, 1804,
+	0x0, 1796,
+	/*1796*/ uint16(xSetOp), uint16(MOVHPS),
+	/*1798*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1800*/ uint16(xReadSlashR),
+	/*1801*/ uint16(xArgM64),
+	/*1802*/ uint16(xArgXmm),
+	/*1803*/ uint16(xMatch),
+	/*1804*/ uint16(xSetOp), uint16(MOVHPD),
+	/*1806*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*1808*/ uint16(xReadSlashR),
+	/*1809*/ uint16(xArgXmm2M64),
+	/*1810*/ uint16(xArgXmm),
+	/*1811*/ uint16(xMatch),
+	/*1812*/ uint16(xCondSlashR),
+	1821, // 0
+	1827, // 1
+	1833, // 2
+	1839, // 3
 	0,    // 4
 	0,    // 5
 	0,    // 6
 	0,    // 7
-	/*1655*/ uint16(xSetOp), uint16(PREFETCHNTA),
-	/*1657*/ uint16(xArgM8),
-	/*1658*/ uint16(xMatch),
-	/*1659*/ uint16(xSetOp), uint16(PREFETCHT0),
-	/*1661*/ uint16(xArgM8),
-	/*1662*/ uint16(xMatch),
-	/*1663*/ uint16(xSetOp), uint16(PREFETCHT1),
-	/*1665*/ uint16(xArgM8),
-	/*1666*/ uint16(xMatch),
-	/*1667*/ uint16(xSetOp), uint16(PREFETCHT2),
-	/*1669*/ uint16(xArgM8),
-	/*1670*/ uint16(xMatch),
-	/*1671*/ uint16(xCondSlashR),
-	1680, // 0
+	/*1821*/ uint16(xSetOp), uint16(PREFETCHNTA),
+	/*1823*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1825*/ uint16(xArgM8),
+	/*1826*/ uint16(xMatch),
+	/*1827*/ uint16(xSetOp), uint16(PREFETCHT0),
+	/*1829*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1831*/ uint16(xArgM8),
+	/*1832*/ uint16(xMatch),
+	/*1833*/ uint16(xSetOp), uint16(PREFETCHT1),
+	/*1835*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1837*/ uint16(xArgM8),
+	/*1838*/ uint16(xMatch),
+	/*1839*/ uint16(xSetOp), uint16(PREFETCHT2),
+	/*1841*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*1843*/ uint16(xArgM8),
+	/*1844*/ uint16(xMatch),
+	/*1845*/ uint16(xCondByte), 2,
+	0xFA, 1906,
+	0xFB, 1915,
+	/*1851*/ uint16(xCondSlashR),
+	0,    // 0
+	1860, // 1
+	0,    // 2
+	0,    // 3
+	0,    // 4
+	0,    // 5
+	0,    // 6
+	0,    // 7
+	/*1860*/ uint16(xCondIs64), 1863, 1889,
+	/*1863*/ uint16(xCondPrefix), 1,
+	0xF3, 1867,
+	/*1867*/ uint16(xCondDataSize), 1871, 1880, 0,
+	/*1871*/ uint16(xCondIsMem), 1874, 0,
+	/*1874*/ uint16(xSetOp), uint16(RDSSPD),
+	/*1876*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*1878*/ uint16(xArgRmf32),
+	/*1879*/ uint16(xMatch),
+	/*1880*/ uint16(xCondIsMem), 1883, 0,
+	/*1883*/ uint16(xSetOp), uint16(RDSSPD),
+	/*1885*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*1887*/ uint16(xArgRmf32),
+	/*1888*/ uint16(xMatch),
+	/*1889*/ uint16(xCondPrefix), 1,
+	0xF3, 1893,
+	/*1893*/ uint16(xCondDataSize), 1871, 1880, 1897,
+	/*1897*/ uint16(xCondIsMem), 1900, 0,
+	/*1900*/ uint16(xSetOp), uint16(RDSSPQ),
+	/*1902*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*1904*/ uint16(xArgRmf64),
+	/*1905*/ uint16(xMatch),
+	/*1906*/ uint16(xCondPrefix), 1,
+	0xF3, 1910,
+	/*1910*/ uint16(xSetOp), uint16(ENDBR64),
+	/*1912*/ uint16(xSetFeature), uint16(FeatureCET_IBT),
+	/*1914*/ uint16(xMatch),
+	/*1915*/ uint16(xCondPrefix), 1,
+	0xF3, 1919,
+	/*1919*/ uint16(xSetOp), uint16(ENDBR32),
+	/*1921*/ uint16(xSetFeature), uint16(FeatureCET_IBT),
+	/*1923*/ uint16(xMatch),
+	/*1924*/ uint16(xCondSlashR),
+	1933, // 0
 	0,    // 1
 	0,    // 2
 	0,    // 3
@@ -988,7616 +1121,8343 @@ var decoder = [...]uint16{
 	0,    // 5
 	0,    // 6
 	0,    // 7
-	/*1680*/ uint16(xCondDataSize), 1684, 1688, 0,
-	/*1684*/ uint16(xSetOp), uint16(NOP),
-	/*1686*/ uint16(xArgRM16),
-	/*1687*/ uint16(xMatch),
-	/*1688*/ uint16(xSetOp), uint16(NOP),
-	/*1690*/ uint16(xArgRM32),
-	/*1691*/ uint16(xMatch),
-	/*1692*/ uint16(xCondIs64), 1695, 1701,
-	/*1695*/ uint16(xSetOp), uint16(MOV),
-	/*1697*/ uint16(xReadSlashR),
-	/*1698*/ uint16(xArgRmf32),
-	/*1699*/ uint16(xArgCR0dashCR7),
-	/*1700*/ uint16(xMatch),
-	/*1701*/ uint16(xSetOp), uint16(MOV),
-	/*1703*/ uint16(xReadSlashR),
-	/*1704*/ uint16(xArgRmf64),
-	/*1705*/ uint16(xArgCR0dashCR7),
-	/*1706*/ uint16(xMatch),
-	/*1707*/ uint16(xCondIs64), 1710, 1716,
-	/*1710*/ uint16(xSetOp), uint16(MOV),
-	/*1712*/ uint16(xReadSlashR),
-	/*1713*/ uint16(xArgRmf32),
-	/*1714*/ uint16(xArgDR0dashDR7),
-	/*1715*/ uint16(xMatch),
-	/*1716*/ uint16(xSetOp), uint16(MOV),
-	/*1718*/ uint16(xReadSlashR),
-	/*1719*/ uint16(xArgRmf64),
-	/*1720*/ uint16(xArgDR0dashDR7),
-	/*1721*/ uint16(xMatch),
-	/*1722*/ uint16(xCondIs64), 1725, 1731,
-	/*1725*/ uint16(xSetOp), uint16(MOV),
-	/*1727*/ uint16(xReadSlashR),
-	/*1728*/ uint16(xArgCR0dashCR7),
-	/*1729*/ uint16(xArgRmf32),
-	/*1730*/ uint16(xMatch),
-	/*1731*/ uint16(xSetOp), uint16(MOV),
-	/*1733*/ uint16(xReadSlashR),
-	/*1734*/ uint16(xArgCR0dashCR7),
-	/*1735*/ uint16(xArgRmf64),
-	/*1736*/ uint16(xMatch),
-	/*1737*/ uint16(xCondIs64), 1740, 1746,
-	/*1740*/ uint16(xSetOp), uint16(MOV),
-	/*1742*/ uint16(xReadSlashR),
-	/*1743*/ uint16(xArgDR0dashDR7),
-	/*1744*/ uint16(xArgRmf32),
-	/*1745*/ uint16(xMatch),
-	/*1746*/ uint16(xSetOp), uint16(MOV),
-	/*1748*/ uint16(xReadSlashR),
-	/*1749*/ uint16(xArgDR0dashDR7),
-	/*1750*/ uint16(xArgRmf64),
-	/*1751*/ uint16(xMatch),
-	/*1752*/ uint16(xCondIs64), 1755, 1761,
-	/*1755*/ uint16(xSetOp), uint16(MOV),
-	/*1757*/ uint16(xReadSlashR),
-	/*1758*/ uint16(xArgRmf32),
-	/*1759*/ uint16(xArgTR0dashTR7),
-	/*1760*/ uint16(xMatch),
-	/*1761*/ uint16(xSetOp), uint16(MOV),
-	/*1763*/ uint16(xReadSlashR),
-	/*1764*/ uint16(xArgRmf64),
-	/*1765*/ uint16(xArgTR0dashTR7),
-	/*1766*/ uint16(xMatch),
-	/*1767*/ uint16(xCondIs64), 1770, 1776,
-	/*1770*/ uint16(xSetOp), uint16(MOV),
-	/*1772*/ uint16(xReadSlashR),
-	/*1773*/ uint16(xArgTR0dashTR7),
-	/*1774*/ uint16(xArgRmf32),
-	/*1775*/ uint16(xMatch),
-	/*1776*/ uint16(xSetOp), uint16(MOV),
-	/*1778*/ uint16(xReadSlashR),
-	/*1779*/ uint16(xArgTR0dashTR7),
-	/*1780*/ uint16(xArgRmf64),
-	/*1781*/ uint16(xMatch),
-	/*1782*/ uint16(xCondPrefix), 2,
-	0x66, 1794,
-	0x0, 1788,
-	/*1788*/ uint16(xSetOp), uint16(MOVAPS),
-	/*1790*/ uint16(xReadSlashR),
-	/*1791*/ uint16(xArgXmm1),
-	/*1792*/ uint16(xArgXmm2M128),
-	/*1793*/ uint16(xMatch),
-	/*1794*/ uint16(xSetOp), uint16(MOVAPD),
-	/*1796*/ uint16(xReadSlashR),
-	/*1797*/ uint16(xArgXmm1),
-	/*1798*/ uint16(xArgXmm2M128),
-	/*1799*/ uint16(xMatch),
-	/*1800*/ uint16(xCondPrefix), 2,
-	0x66, 1812,
-	0x0, 1806,
-	/*1806*/ uint16(xSetOp), uint16(MOVAPS),
-	/*1808*/ uint16(xReadSlashR),
-	/*1809*/ uint16(xArgXmm2M128),
-	/*1810*/ uint16(xArgXmm1),
-	/*1811*/ uint16(xMatch),
-	/*1812*/ uint16(xSetOp), uint16(MOVAPD),
-	/*1814*/ uint16(xReadSlashR),
-	/*1815*/ uint16(xArgXmm2M128),
-	/*1816*/ uint16(xArgXmm1),
-	/*1817*/ uint16(xMatch),
-	/*1818*/ uint16(xCondIs64), 1821, 1875,
-	/*1821*/ uint16(xCondPrefix), 4,
-	0xF3, 1859,
-	0xF2, 1843,
-	0x66, 1837,
-	0x0, 1831,
-	/*1831*/ uint16(xSetOp), uint16(CVTPI2PS),
-	/*1833*/ uint16(xReadSlashR),
-	/*1834*/ uint16(xArgXmm),
-	/*1835*/ uint16(xArgMmM64),
-	/*1836*/ uint16(xMatch),
-	/*1837*/ uint16(xSetOp), uint16(CVTPI2PD),
-	/*1839*/ uint16(xReadSlashR),
-	/*1840*/ uint16(xArgXmm),
-	/*1841*/ uint16(xArgMmM64),
-	/*1842*/ uint16(xMatch),
-	/*1843*/ uint16(xCondDataSize), 1847, 1853, 0,
-	/*1847*/ uint16(xSetOp), uint16(CVTSI2SD),
-	/*1849*/ uint16(xReadSlashR),
-	/*1850*/ uint16(xArgXmm),
-	/*1851*/ uint16(xArgRM32),
-	/*1852*/ uint16(xMatch),
-	/*1853*/ uint16(xSetOp), uint16(CVTSI2SD),
-	/*1855*/ uint16(xReadSlashR),
-	/*1856*/ uint16(xArgXmm),
-	/*1857*/ uint16(xArgRM32),
-	/*1858*/ uint16(xMatch),
-	/*1859*/ uint16(xCondDataSize), 1863, 1869, 0,
-	/*1863*/ uint16(xSetOp), uint16(CVTSI2SS),
-	/*1865*/ uint16(xReadSlashR),
-	/*1866*/ uint16(xArgXmm),
-	/*1867*/ uint16(xArgRM32),
-	/*1868*/ uint16(xMatch),
-	/*1869*/ uint16(xSetOp), uint16(CVTSI2SS),
-	/*1871*/ uint16(xReadSlashR),
-	/*1872*/ uint16(xArgXmm),
-	/*1873*/ uint16(xArgRM32),
-	/*1874*/ uint16(xMatch),
-	/*1875*/ uint16(xCondPrefix), 4,
-	0xF3, 1895,
-	0xF2, 1885,
-	0x66, 1837,
-	0x0, 1831,
-	/*1885*/ uint16(xCondDataSize), 1847, 1853, 1889,
-	/*1889*/ uint16(xSetOp), uint16(CVTSI2SD),
-	/*1891*/ uint16(xReadSlashR),
-	/*1892*/ uint16(xArgXmm),
-	/*1893*/ uint16(xArgRM64),
-	/*1894*/ uint16(xMatch),
-	/*1895*/ uint16(xCondDataSize), 1863, 1869, 1899,
-	/*1899*/ uint16(xSetOp), uint16(CVTSI2SS),
-	/*1901*/ uint16(xReadSlashR),
-	/*1902*/ uint16(xArgXmm),
-	/*1903*/ uint16(xArgRM64),
-	/*1904*/ uint16(xMatch),
-	/*1905*/ uint16(xCondPrefix), 4,
-	0xF3, 1933,
-	0xF2, 1927,
-	0x66, 1921,
-	0x0, 1915,
-	/*1915*/ uint16(xSetOp), uint16(MOVNTPS),
-	/*1917*/ uint16(xReadSlashR),
-	/*1918*/ uint16(xArgM128),
-	/*1919*/ uint16(xArgXmm),
-	/*1920*/ uint16(xMatch),
-	/*1921*/ uint16(xSetOp), uint16(MOVNTPD),
-	/*1923*/ uint16(xReadSlashR),
-	/*1924*/ uint16(xArgM128),
-	/*1925*/ uint16(xArgXmm),
-	/*1926*/ uint16(xMatch),
-	/*1927*/ uint16(xSetOp), uint16(MOVNTSD),
-	/*1929*/ uint16(xReadSlashR),
-	/*1930*/ uint16(xArgM64),
-	/*1931*/ uint16(xArgXmm),
-	/*1932*/ uint16(xMatch),
-	/*1933*/ uint16(xSetOp), uint16(MOVNTSS),
-	/*1935*/ uint16(xReadSlashR),
-	/*1936*/ uint16(xArgM32),
-	/*1937*/ uint16(xArgXmm),
-	/*1938*/ uint16(xMatch),
-	/*1939*/ uint16(xCondIs64), 1942, 1996,
-	/*1942*/ uint16(xCondPrefix), 4,
-	0xF3, 1980,
-	0xF2, 1964,
-	0x66, 1958,
-	0x0, 1952,
-	/*1952*/ uint16(xSetOp), uint16(CVTTPS2PI),
-	/*1954*/ uint16(xReadSlashR),
-	/*1955*/ uint16(xArgMm),
-	/*1956*/ uint16(xArgXmmM64),
-	/*1957*/ uint16(xMatch),
-	/*1958*/ uint16(xSetOp), uint16(CVTTPD2PI),
-	/*1960*/ uint16(xReadSlashR),
-	/*1961*/ uint16(xArgMm),
-	/*1962*/ uint16(xArgXmmM128),
-	/*1963*/ uint16(xMatch),
-	/*1964*/ uint16(xCondDataSize), 1968, 1974, 0,
-	/*1968*/ uint16(xSetOp), uint16(CVTTSD2SI),
-	/*1970*/ uint16(xReadSlashR),
-	/*1971*/ uint16(xArgR32),
-	/*1972*/ uint16(xArgXmmM64),
-	/*1973*/ uint16(xMatch),
-	/*1974*/ uint16(xSetOp), uint16(CVTTSD2SI),
-	/*1976*/ uint16(xReadSlashR),
-	/*1977*/ uint16(xArgR32),
-	/*1978*/ uint16(xArgXmmM64),
-	/*1979*/ uint16(xMatch),
-	/*1980*/ uint16(xCondDataSize), 1984, 1990, 0,
-	/*1984*/ uint16(xSetOp), uint16(CVTTSS2SI),
+	/*1933*/ uint16(xCondDataSize), 1937, 1941, 0,
+	/*1937*/ uint16(xSetOp), uint16(NOP),
+	/*1939*/ uint16(xArgRM16),
+	/*1940*/ uint16(xMatch),
+	/*1941*/ uint16(xSetOp), uint16(NOP),
+	/*1943*/ uint16(xArgRM32),
+	/*1944*/ uint16(xMatch),
+	/*1945*/ uint16(xCondIs64), 1948, 1954,
+	/*1948*/ uint16(xSetOp), uint16(MOV),
+	/*1950*/ uint16(xReadSlashR),
+	/*1951*/ uint16(xArgRmf32),
+	/*1952*/ uint16(xArgCR0dashCR7),
+	/*1953*/ uint16(xMatch),
+	/*1954*/ uint16(xSetOp), uint16(MOV),
+	/*1956*/ uint16(xReadSlashR),
+	/*1957*/ uint16(xArgRmf64),
+	/*1958*/ uint16(xArgCR0dashCR7),
+	/*1959*/ uint16(xMatch),
+	/*1960*/ uint16(xCondIs64), 1963, 1969,
+	/*1963*/ uint16(xSetOp), uint16(MOV),
+	/*1965*/ uint16(xReadSlashR),
+	/*1966*/ uint16(xArgRmf32),
+	/*1967*/ uint16(xArgDR0dashDR7),
+	/*1968*/ uint16(xMatch),
+	/*1969*/ uint16(xSetOp), uint16(MOV),
+	/*1971*/ uint16(xReadSlashR),
+	/*1972*/ uint16(xArgRmf64),
+	/*1973*/ uint16(xArgDR0dashDR7),
+	/*1974*/ uint16(xMatch),
+	/*1975*/ uint16(xCondIs64), 1978, 1984,
+	/*1978*/ uint16(xSetOp), uint16(MOV),
+	/*1980*/ uint16(xReadSlashR),
+	/*1981*/ uint16(xArgCR0dashCR7),
+	/*1982*/ uint16(xArgRmf32),
+	/*1983*/ uint16(xMatch),
+	/*1984*/ uint16(xSetOp), uint16(MOV),
 	/*1986*/ uint16(xReadSlashR),
-	/*1987*/ uint16(xArgR32),
-	/*1988*/ uint16(xArgXmmM32),
+	/*1987*/ uint16(xArgCR0dashCR7),
+	/*1988*/ uint16(xArgRmf64),
 	/*1989*/ uint16(xMatch),
-	/*1990*/ uint16(xSetOp), uint16(CVTTSS2SI),
-	/*1992*/ uint16(xReadSlashR),
-	/*1993*/ uint16(xArgR32),
-	/*1994*/ uint16(xArgXmmM32),
-	/*1995*/ uint16(xMatch),
-	/*1996*/ uint16(xCondPrefix), 4,
-	0xF3, 2016,
-	0xF2, 2006,
-	0x66, 1958,
-	0x0, 1952,
-	/*2006*/ uint16(xCondDataSize), 1968, 1974, 2010,
-	/*2010*/ uint16(xSetOp), uint16(CVTTSD2SI),
-	/*2012*/ uint16(xReadSlashR),
-	/*2013*/ uint16(xArgR64),
-	/*2014*/ uint16(xArgXmmM64),
-	/*2015*/ uint16(xMatch),
-	/*2016*/ uint16(xCondDataSize), 1984, 1990, 2020,
-	/*2020*/ uint16(xSetOp), uint16(CVTTSS2SI),
-	/*2022*/ uint16(xReadSlashR),
-	/*2023*/ uint16(xArgR64),
-	/*2024*/ uint16(xArgXmmM32),
-	/*2025*/ uint16(xMatch),
-	/*2026*/ uint16(xCondIs64), 2029, 2083,
-	/*2029*/ uint16(xCondPrefix), 4,
-	0xF3, 2067,
-	0xF2, 2051,
-	0x66, 2045,
-	0x0, 2039,
-	/*2039*/ uint16(xSetOp), uint16(CVTPS2PI),
-	/*2041*/ uint16(xReadSlashR),
-	/*2042*/ uint16(xArgMm),
-	/*2043*/ uint16(xArgXmmM64),
-	/*2044*/ uint16(xMatch),
-	/*2045*/ uint16(xSetOp), uint16(CVTPD2PI),
-	/*2047*/ uint16(xReadSlashR),
-	/*2048*/ uint16(xArgMm),
-	/*2049*/ uint16(xArgXmmM128),
-	/*2050*/ uint16(xMatch),
-	/*2051*/ uint16(xCondDataSize), 2055, 2061, 0,
-	/*2055*/ uint16(xSetOp), uint16(CVTSD2SI),
-	/*2057*/ uint16(xReadSlashR),
-	/*2058*/ uint16(xArgR32),
-	/*2059*/ uint16(xArgXmmM64),
-	/*2060*/ uint16(xMatch),
-	/*2061*/ uint16(xSetOp), uint16(CVTSD2SI),
-	/*2063*/ uint16(xReadSlashR),
-	/*2064*/ uint16(xArgR32),
-	/*2065*/ uint16(xArgXmmM64),
-	/*2066*/ uint16(xMatch),
-	/*2067*/ uint16(xCondDataSize), 2071, 2077, 0,
-	/*2071*/ uint16(xSetOp), uint16(CVTSS2SI),
-	/*2073*/ uint16(xReadSlashR),
-	/*2074*/ uint16(xArgR32),
-	/*2075*/ uint16(xArgXmmM32),
-	/*2076*/ uint16(xMatch),
-	/*2077*/ uint16(xSetOp), uint16(CVTSS2SI),
-	/*2079*/ uint16(xReadSlashR),
-	/*2080*/ uint16(xArgR32),
-	/*2081*/ uint16(xArgXmmM32),
-	/*2082*/ uint16(xMatch),
-	/*2083*/ uint16(xCondPrefix), 4,
-	0xF3, 2103,
-	0xF2, 2093,
-	0x66, 2045,
-	0x0, 2039,
-	/*2093*/ uint16(xCondDataSize), 2055, 2061, 2097,
-	/*2097*/ uint16(xSetOp), uint16(CVTSD2SI),
-	/*2099*/ uint16(xReadSlashR),
-	/*2100*/ uint16(xArgR64),
-	/*2101*/ uint16(xArgXmmM64),
-	/*2102*/ uint16(xMatch),
-	/*2103*/ uint16(xCondDataSize), 2071, 2077, 2107,
-	/*2107*/ uint16(xSetOp), uint16(CVTSS2SI),
-	/*2109*/ uint16(xReadSlashR),
-	/*2110*/ uint16(xArgR64),
-	/*2111*/ uint16(xArgXmmM32),
-	/*2112*/ uint16(xMatch),
-	/*2113*/ uint16(xCondPrefix), 2,
-	0x66, 2125,
-	0x0, 2119,
-	/*2119*/ uint16(xSetOp), uint16(UCOMISS),
-	/*2121*/ uint16(xReadSlashR),
-	/*2122*/ uint16(xArgXmm1),
-	/*2123*/ uint16(xArgXmm2M32),
-	/*2124*/ uint16(xMatch),
-	/*2125*/ uint16(xSetOp), uint16(UCOMISD),
-	/*2127*/ uint16(xReadSlashR),
-	/*2128*/ uint16(xArgXmm1),
-	/*2129*/ uint16(xArgXmm2M64),
-	/*2130*/ uint16(xMatch),
-	/*2131*/ uint16(xCondPrefix), 2,
-	0x66, 2143,
-	0x0, 2137,
-	/*2137*/ uint16(xSetOp), uint16(COMISS),
-	/*2139*/ uint16(xReadSlashR),
-	/*2140*/ uint16(xArgXmm1),
-	/*2141*/ uint16(xArgXmm2M32),
-	/*2142*/ uint16(xMatch),
-	/*2143*/ uint16(xSetOp), uint16(COMISD),
-	/*2145*/ uint16(xReadSlashR),
-	/*2146*/ uint16(xArgXmm1),
-	/*2147*/ uint16(xArgXmm2M64),
-	/*2148*/ uint16(xMatch),
-	/*2149*/ uint16(xSetOp), uint16(WRMSR),
-	/*2151*/ uint16(xMatch),
-	/*2152*/ uint16(xSetOp), uint16(RDTSC),
-	/*2154*/ uint16(xMatch),
-	/*2155*/ uint16(xSetOp), uint16(RDMSR),
-	/*2157*/ uint16(xMatch),
-	/*2158*/ uint16(xSetOp), uint16(RDPMC),
-	/*2160*/ uint16(xMatch),
-	/*2161*/ uint16(xSetOp), uint16(SYSENTER),
-	/*2163*/ uint16(xMatch),
-	/*2164*/ uint16(xCondDataSize), 2168, 2168, 2171,
-	/*2168*/ uint16(xSetOp), uint16(SYSEXIT),
-	/*2170*/ uint16(xMatch),
-	/*2171*/ uint16(xSetOp), uint16(SYSEXIT),
-	/*2173*/ uint16(xMatch),
-	/*2174*/ uint16(xCondByte), 60,
-	0x00, 2297,
-	0x01, 2315,
-	0x02, 2333,
-	0x03, 2351,
-	0x04, 2369,
-	0x05, 2387,
-	0x06, 2405,
-	0x07, 2423,
-	0x08, 2441,
-	0x09, 2459,
-	0x0A, 2477,
-	0x0B, 2495,
-	0x10, 2513,
-	0x14, 2524,
-	0x15, 2535,
-	0x17, 2546,
-	0x1C, 2556,
-	0x1D, 2574,
-	0x1E, 2592,
-	0x20, 2610,
-	0x21, 2620,
-	0x22, 2630,
-	0x23, 2640,
-	0x24, 2650,
-	0x25, 2660,
-	0x28, 2670,
-	0x29, 2680,
-	0x2A, 2690,
-	0x2B, 2700,
-	0x30, 2710,
-	0x31, 2720,
-	0x32, 2730,
-	0x33, 2740,
-	0x34, 2750,
-	0x35, 2760,
-	0x37, 2770,
-	0x38, 2780,
-	0x39, 2790,
-	0x3A, 2800,
-	0x3B, 2810,
-	0x3C, 2820,
-	0x3D, 2830,
-	0x3E, 2840,
-	0x3F, 2850,
-	0x40, 2860,
-	0x41, 2870,
-	0x82, 2880,
-	0xC8, 2903,
-	0xC9, 2909,
-	0xCA, 2915,
-	0xCB, 2921,
-	0xCC, 2928,
-	0xCD, 2934,
-	0xDB, 2940,
-	0xDC, 2950,
-	0xDD, 2960,
-	0xDE, 2970,
-	0xDF, 2980,
-	0xF0, 2990,
-	0xF1, 3057,
-	uint16(xFail),
-	/*2297*/ uint16(xCondPrefix), 2,
-	0x66, 2309,
-	0x0, 2303,
-	/*2303*/ uint16(xSetOp), uint16(PSHUFB),
-	/*2305*/ uint16(xReadSlashR),
-	/*2306*/ uint16(xArgMm1),
-	/*2307*/ uint16(xArgMm2M64),
-	/*2308*/ uint16(xMatch),
-	/*2309*/ uint16(xSetOp), uint16(PSHUFB),
+	/*1990*/ uint16(xCondIs64), 1993, 1999,
+	/*1993*/ uint16(xSetOp), uint16(MOV),
+	/*1995*/ uint16(xReadSlashR),
+	/*1996*/ uint16(xArgDR0dashDR7),
+	/*1997*/ uint16(xArgRmf32),
+	/*1998*/ uint16(xMatch),
+	/*1999*/ uint16(xSetOp), uint16(MOV),
+	/*2001*/ uint16(xReadSlashR),
+	/*2002*/ uint16(xArgDR0dashDR7),
+	/*2003*/ uint16(xArgRmf64),
+	/*2004*/ uint16(xMatch),
+	/*2005*/ uint16(xCondIs64), 2008, 2014,
+	/*2008*/ uint16(xSetOp), uint16(MOV),
+	/*2010*/ uint16(xReadSlashR),
+	/*2011*/ uint16(xArgRmf32),
+	/*2012*/ uint16(xArgTR0dashTR7),
+	/*2013*/ uint16(xMatch),
+	/*2014*/ uint16(xSetOp), uint16(MOV),
+	/*2016*/ uint16(xReadSlashR),
+	/*2017*/ uint16(xArgRmf64),
+	/*2018*/ uint16(xArgTR0dashTR7),
+	/*2019*/ uint16(xMatch),
+	/*2020*/ uint16(xCondIs64), 2023, 2029,
+	/*2023*/ uint16(xSetOp), uint16(MOV),
+	/*2025*/ uint16(xReadSlashR),
+	/*2026*/ uint16(xArgTR0dashTR7),
+	/*2027*/ uint16(xArgRmf32),
+	/*2028*/ uint16(xMatch),
+	/*2029*/ uint16(xSetOp), uint16(MOV),
+	/*2031*/ uint16(xReadSlashR),
+	/*2032*/ uint16(xArgTR0dashTR7),
+	/*2033*/ uint16(xArgRmf64),
+	/*2034*/ uint16(xMatch),
+	/*2035*/ uint16(xCondPrefix), 2,
+	0x66, 2049,
+	0x0, 2041,
+	/*2041*/ uint16(xSetOp), uint16(MOVAPS),
+	/*2043*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2045*/ uint16(xReadSlashR),
+	/*2046*/ uint16(xArgXmm1),
+	/*2047*/ uint16(xArgXmm2M128),
+	/*2048*/ uint16(xMatch),
+	/*2049*/ uint16(xSetOp), uint16(MOVAPD),
+	/*2051*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2053*/ uint16(xReadSlashR),
+	/*2054*/ uint16(xArgXmm1),
+	/*2055*/ uint16(xArgXmm2M128),
+	/*2056*/ uint16(xMatch),
+	/*2057*/ uint16(xCondPrefix), 2,
+	0x66, 2071,
+	0x0, 2063,
+	/*2063*/ uint16(xSetOp), uint16(MOVAPS),
+	/*2065*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2067*/ uint16(xReadSlashR),
+	/*2068*/ uint16(xArgXmm2M128),
+	/*2069*/ uint16(xArgXmm1),
+	/*2070*/ uint16(xMatch),
+	/*2071*/ uint16(xSetOp), uint16(MOVAPD),
+	/*2073*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2075*/ uint16(xReadSlashR),
+	/*2076*/ uint16(xArgXmm2M128),
+	/*2077*/ uint16(xArgXmm1),
+	/*2078*/ uint16(xMatch),
+	/*2079*/ uint16(xCondIs64), 2082, 2148,
+	/*2082*/ uint16(xCondPrefix), 4,
+	0xF3, 2128,
+	0xF2, 2108,
+	0x66, 2100,
+	0x0, 2092,
+	/*2092*/ uint16(xSetOp), uint16(CVTPI2PS),
+	/*2094*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2096*/ uint16(xReadSlashR),
+	/*2097*/ uint16(xArgXmm),
+	/*2098*/ uint16(xArgMmM64),
+	/*2099*/ uint16(xMatch),
+	/*2100*/ uint16(xSetOp), uint16(CVTPI2PD),
+	/*2102*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2104*/ uint16(xReadSlashR),
+	/*2105*/ uint16(xArgXmm),
+	/*2106*/ uint16(xArgMmM64),
+	/*2107*/ uint16(xMatch),
+	/*2108*/ uint16(xCondDataSize), 2112, 2120, 0,
+	/*2112*/ uint16(xSetOp), uint16(CVTSI2SD),
+	/*2114*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2116*/ uint16(xReadSlashR),
+	/*2117*/ uint16(xArgXmm),
+	/*2118*/ uint16(xArgRM32),
+	/*2119*/ uint16(xMatch),
+	/*2120*/ uint16(xSetOp), uint16(CVTSI2SD),
+	/*2122*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2124*/ uint16(xReadSlashR),
+	/*2125*/ uint16(xArgXmm),
+	/*2126*/ uint16(xArgRM32),
+	/*2127*/ uint16(xMatch),
+	/*2128*/ uint16(xCondDataSize), 2132, 2140, 0,
+	/*2132*/ uint16(xSetOp), uint16(CVTSI2SS),
+	/*2134*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2136*/ uint16(xReadSlashR),
+	/*2137*/ uint16(xArgXmm),
+	/*2138*/ uint16(xArgRM32),
+	/*2139*/ uint16(xMatch),
+	/*2140*/ uint16(xSetOp), uint16(CVTSI2SS),
+	/*2142*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2144*/ uint16(xReadSlashR),
+	/*2145*/ uint16(xArgXmm),
+	/*2146*/ uint16(xArgRM32),
+	/*2147*/ uint16(xMatch),
+	/*2148*/ uint16(xCondPrefix), 4,
+	0xF3, 2170,
+	0xF2, 2158,
+	0x66, 2100,
+	0x0, 2092,
+	/*2158*/ uint16(xCondDataSize), 2112, 2120, 2162,
+	/*2162*/ uint16(xSetOp), uint16(CVTSI2SD),
+	/*2164*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2166*/ uint16(xReadSlashR),
+	/*2167*/ uint16(xArgXmm),
+	/*2168*/ uint16(xArgRM64),
+	/*2169*/ uint16(xMatch),
+	/*2170*/ uint16(xCondDataSize), 2132, 2140, 2174,
+	/*2174*/ uint16(xSetOp), uint16(CVTSI2SS),
+	/*2176*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2178*/ uint16(xReadSlashR),
+	/*2179*/ uint16(xArgXmm),
+	/*2180*/ uint16(xArgRM64),
+	/*2181*/ uint16(xMatch),
+	/*2182*/ uint16(xCondPrefix), 4,
+	0xF3, 2216,
+	0xF2, 2208,
+	0x66, 2200,
+	0x0, 2192,
+	/*2192*/ uint16(xSetOp), uint16(MOVNTPS),
+	/*2194*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2196*/ uint16(xReadSlashR),
+	/*2197*/ uint16(xArgM128),
+	/*2198*/ uint16(xArgXmm),
+	/*2199*/ uint16(xMatch),
+	/*2200*/ uint16(xSetOp), uint16(MOVNTPD),
+	/*2202*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2204*/ uint16(xReadSlashR),
+	/*2205*/ uint16(xArgM128),
+	/*2206*/ uint16(xArgXmm),
+	/*2207*/ uint16(xMatch),
+	/*2208*/ uint16(xSetOp), uint16(MOVNTSD),
+	/*2210*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2212*/ uint16(xReadSlashR),
+	/*2213*/ uint16(xArgM64),
+	/*2214*/ uint16(xArgXmm),
+	/*2215*/ uint16(xMatch),
+	/*2216*/ uint16(xSetOp), uint16(MOVNTSS),
+	/*2218*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2220*/ uint16(xReadSlashR),
+	/*2221*/ uint16(xArgM32),
+	/*2222*/ uint16(xArgXmm),
+	/*2223*/ uint16(xMatch),
+	/*2224*/ uint16(xCondIs64), 2227, 2293,
+	/*2227*/ uint16(xCondPrefix), 4,
+	0xF3, 2273,
+	0xF2, 2253,
+	0x66, 2245,
+	0x0, 2237,
+	/*2237*/ uint16(xSetOp), uint16(CVTTPS2PI),
+	/*2239*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2241*/ uint16(xReadSlashR),
+	/*2242*/ uint16(xArgMm),
+	/*2243*/ uint16(xArgXmmM64),
+	/*2244*/ uint16(xMatch),
+	/*2245*/ uint16(xSetOp), uint16(CVTTPD2PI),
+	/*2247*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2249*/ uint16(xReadSlashR),
+	/*2250*/ uint16(xArgMm),
+	/*2251*/ uint16(xArgXmmM128),
+	/*2252*/ uint16(xMatch),
+	/*2253*/ uint16(xCondDataSize), 2257, 2265, 0,
+	/*2257*/ uint16(xSetOp), uint16(CVTTSD2SI),
+	/*2259*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2261*/ uint16(xReadSlashR),
+	/*2262*/ uint16(xArgR32),
+	/*2263*/ uint16(xArgXmmM64),
+	/*2264*/ uint16(xMatch),
+	/*2265*/ uint16(xSetOp), uint16(CVTTSD2SI),
+	/*2267*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2269*/ uint16(xReadSlashR),
+	/*2270*/ uint16(xArgR32),
+	/*2271*/ uint16(xArgXmmM64),
+	/*2272*/ uint16(xMatch),
+	/*2273*/ uint16(xCondDataSize), 2277, 2285, 0,
+	/*2277*/ uint16(xSetOp), uint16(CVTTSS2SI),
+	/*2279*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2281*/ uint16(xReadSlashR),
+	/*2282*/ uint16(xArgR32),
+	/*2283*/ uint16(xArgXmmM32),
+	/*2284*/ uint16(xMatch),
+	/*2285*/ uint16(xSetOp), uint16(CVTTSS2SI),
+	/*2287*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2289*/ uint16(xReadSlashR),
+	/*2290*/ uint16(xArgR32),
+	/*2291*/ uint16(xArgXmmM32),
+	/*2292*/ uint16(xMatch),
+	/*2293*/ uint16(xCondPrefix), 4,
+	0xF3, 2315,
+	0xF2, 2303,
+	0x66, 2245,
+	0x0, 2237,
+	/*2303*/ uint16(xCondDataSize), 2257, 2265, 2307,
+	/*2307*/ uint16(xSetOp), uint16(CVTTSD2SI),
+	/*2309*/ uint16(xSetFeature), uint16(FeatureSSE2),
 	/*2311*/ uint16(xReadSlashR),
-	/*2312*/ uint16(xArgXmm1),
-	/*2313*/ uint16(xArgXmm2M128),
+	/*2312*/ uint16(xArgR64),
+	/*2313*/ uint16(xArgXmmM64),
 	/*2314*/ uint16(xMatch),
-	/*2315*/ uint16(xCondPrefix), 2,
-	0x66, 2327,
-	0x0, 2321,
-	/*2321*/ uint16(xSetOp), uint16(PHADDW),
+	/*2315*/ uint16(xCondDataSize), 2277, 2285, 2319,
+	/*2319*/ uint16(xSetOp), uint16(CVTTSS2SI),
+	/*2321*/ uint16(xSetFeature), uint16(FeatureSSE),
 	/*2323*/ uint16(xReadSlashR),
-	/*2324*/ uint16(xArgMm1),
-	/*2325*/ uint16(xArgMm2M64),
+	/*2324*/ uint16(xArgR64),
+	/*2325*/ uint16(xArgXmmM32),
 	/*2326*/ uint16(xMatch),
-	/*2327*/ uint16(xSetOp), uint16(PHADDW),
-	/*2329*/ uint16(xReadSlashR),
-	/*2330*/ uint16(xArgXmm1),
-	/*2331*/ uint16(xArgXmm2M128),
-	/*2332*/ uint16(xMatch),
-	/*2333*/ uint16(xCondPrefix), 2,
-	0x66, 2345,
-	0x0, 2339,
-	/*2339*/ uint16(xSetOp), uint16(PHADDD),
-	/*2341*/ uint16(xReadSlashR),
-	/*2342*/ uint16(xArgMm1),
-	/*2343*/ uint16(xArgMm2M64),
-	/*2344*/ uint16(xMatch),
-	/*2345*/ uint16(xSetOp), uint16(PHADDD),
-	/*2347*/ uint16(xReadSlashR),
-	/*2348*/ uint16(xArgXmm1),
-	/*2349*/ uint16(xArgXmm2M128),
-	/*2350*/ uint16(xMatch),
-	/*2351*/ uint16(xCondPrefix), 2,
-	0x66, 2363,
-	0x0, 2357,
-	/*2357*/ uint16(xSetOp), uint16(PHADDSW),
-	/*2359*/ uint16(xReadSlashR),
-	/*2360*/ uint16(xArgMm1),
-	/*2361*/ uint16(xArgMm2M64),
-	/*2362*/ uint16(xMatch),
-	/*2363*/ uint16(xSetOp), uint16(PHADDSW),
-	/*2365*/ uint16(xReadSlashR),
-	/*2366*/ uint16(xArgXmm1),
-	/*2367*/ uint16(xArgXmm2M128),
-	/*2368*/ uint16(xMatch),
-	/*2369*/ uint16(xCondPrefix), 2,
-	0x66, 2381,
-	0x0, 2375,
-	/*2375*/ uint16(xSetOp), uint16(PMADDUBSW),
-	/*2377*/ uint16(xReadSlashR),
-	/*2378*/ uint16(xArgMm1),
-	/*2379*/ uint16(xArgMm2M64),
-	/*2380*/ uint16(xMatch),
-	/*2381*/ uint16(xSetOp), uint16(PMADDUBSW),
-	/*2383*/ uint16(xReadSlashR),
-	/*2384*/ uint16(xArgXmm1),
-	/*2385*/ uint16(xArgXmm2M128),
-	/*2386*/ uint16(xMatch),
-	/*2387*/ uint16(xCondPrefix), 2,
-	0x66, 2399,
-	0x0, 2393,
-	/*2393*/ uint16(xSetOp), uint16(PHSUBW),
-	/*2395*/ uint16(xReadSlashR),
-	/*2396*/ uint16(xArgMm1),
-	/*2397*/ uint16(xArgMm2M64),
-	/*2398*/ uint16(xMatch),
-	/*2399*/ uint16(xSetOp), uint16(PHSUBW),
-	/*2401*/ uint16(xReadSlashR),
-	/*2402*/ uint16(xArgXmm1),
-	/*2403*/ uint16(xArgXmm2M128),
-	/*2404*/ uint16(xMatch),
-	/*2405*/ uint16(xCondPrefix), 2,
-	0x66, 2417,
-	0x0, 2411,
-	/*2411*/ uint16(xSetOp), uint16(PHSUBD),
-	/*2413*/ uint16(xReadSlashR),
-	/*2414*/ uint16(xArgMm1),
-	/*2415*/ uint16(xArgMm2M64),
-	/*2416*/ uint16(xMatch),
-	/*2417*/ uint16(xSetOp), uint16(PHSUBD),
-	/*2419*/ uint16(xReadSlashR),
-	/*2420*/ uint16(xArgXmm1),
-	/*2421*/ uint16(xArgXmm2M128),
-	/*2422*/ uint16(xMatch),
-	/*2423*/ uint16(xCondPrefix), 2,
-	0x66, 2435,
-	0x0, 2429,
-	/*2429*/ uint16(xSetOp), uint16(PHSUBSW),
-	/*2431*/ uint16(xReadSlashR),
-	/*2432*/ uint16(xArgMm1),
-	/*2433*/ uint16(xArgMm2M64),
-	/*2434*/ uint16(xMatch),
-	/*2435*/ uint16(xSetOp), uint16(PHSUBSW),
-	/*2437*/ uint16(xReadSlashR),
-	/*2438*/ uint16(xArgXmm1),
-	/*2439*/ uint16(xArgXmm2M128),
-	/*2440*/ uint16(xMatch),
-	/*2441*/ uint16(xCondPrefix), 2,
-	0x66, 2453,
-	0x0, 2447,
-	/*2447*/ uint16(xSetOp), uint16(PSIGNB),
-	/*2449*/ uint16(xReadSlashR),
-	/*2450*/ uint16(xArgMm1),
-	/*2451*/ uint16(xArgMm2M64),
-	/*2452*/ uint16(xMatch),
-	/*2453*/ uint16(xSetOp), uint16(PSIGNB),
-	/*2455*/ uint16(xReadSlashR),
-	/*2456*/ uint16(xArgXmm1),
-	/*2457*/ uint16(xArgXmm2M128),
-	/*2458*/ uint16(xMatch),
-	/*2459*/ uint16(xCondPrefix), 2,
-	0x66, 2471,
-	0x0, 2465,
-	/*2465*/ uint16(xSetOp), uint16(PSIGNW),
-	/*2467*/ uint16(xReadSlashR),
-	/*2468*/ uint16(xArgMm1),
-	/*2469*/ uint16(xArgMm2M64),
-	/*2470*/ uint16(xMatch),
-	/*2471*/ uint16(xSetOp), uint16(PSIGNW),
-	/*2473*/ uint16(xReadSlashR),
-	/*2474*/ uint16(xArgXmm1),
-	/*2475*/ uint16(xArgXmm2M128),
+	/*2327*/ uint16(xCondIs64), 2330, 2396,
+	/*2330*/ uint16(xCondPrefix), 4,
+	0xF3, 2376,
+	0xF2, 2356,
+	0x66, 2348,
+	0x0, 2340,
+	/*2340*/ uint16(xSetOp), uint16(CVTPS2PI),
+	/*2342*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2344*/ uint16(xReadSlashR),
+	/*2345*/ uint16(xArgMm),
+	/*2346*/ uint16(xArgXmmM64),
+	/*2347*/ uint16(xMatch),
+	/*2348*/ uint16(xSetOp), uint16(CVTPD2PI),
+	/*2350*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2352*/ uint16(xReadSlashR),
+	/*2353*/ uint16(xArgMm),
+	/*2354*/ uint16(xArgXmmM128),
+	/*2355*/ uint16(xMatch),
+	/*2356*/ uint16(xCondDataSize), 2360, 2368, 0,
+	/*2360*/ uint16(xSetOp), uint16(CVTSD2SI),
+	/*2362*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2364*/ uint16(xReadSlashR),
+	/*2365*/ uint16(xArgR32),
+	/*2366*/ uint16(xArgXmmM64),
+	/*2367*/ uint16(xMatch),
+	/*2368*/ uint16(xSetOp), uint16(CVTSD2SI),
+	/*2370*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2372*/ uint16(xReadSlashR),
+	/*2373*/ uint16(xArgR32),
+	/*2374*/ uint16(xArgXmmM64),
+	/*2375*/ uint16(xMatch),
+	/*2376*/ uint16(xCondDataSize), 2380, 2388, 0,
+	/*2380*/ uint16(xSetOp), uint16(CVTSS2SI),
+	/*2382*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2384*/ uint16(xReadSlashR),
+	/*2385*/ uint16(xArgR32),
+	/*2386*/ uint16(xArgXmmM32),
+	/*2387*/ uint16(xMatch),
+	/*2388*/ uint16(xSetOp), uint16(CVTSS2SI),
+	/*2390*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2392*/ uint16(xReadSlashR),
+	/*2393*/ uint16(xArgR32),
+	/*2394*/ uint16(xArgXmmM32),
+	/*2395*/ uint16(xMatch),
+	/*2396*/ uint16(xCondPrefix), 4,
+	0xF3, 2418,
+	0xF2, 2406,
+	0x66, 2348,
+	0x0, 2340,
+	/*2406*/ uint16(xCondDataSize), 2360, 2368, 2410,
+	/*2410*/ uint16(xSetOp), uint16(CVTSD2SI),
+	/*2412*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2414*/ uint16(xReadSlashR),
+	/*2415*/ uint16(xArgR64),
+	/*2416*/ uint16(xArgXmmM64),
+	/*2417*/ uint16(xMatch),
+	/*2418*/ uint16(xCondDataSize), 2380, 2388, 2422,
+	/*2422*/ uint16(xSetOp), uint16(CVTSS2SI),
+	/*2424*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2426*/ uint16(xReadSlashR),
+	/*2427*/ uint16(xArgR64),
+	/*2428*/ uint16(xArgXmmM32),
+	/*2429*/ uint16(xMatch),
+	/*2430*/ uint16(xCondPrefix), 2,
+	0x66, 2444,
+	0x0, 2436,
+	/*2436*/ uint16(xSetOp), uint16(UCOMISS),
+	/*2438*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2440*/ uint16(xReadSlashR),
+	/*2441*/ uint16(xArgXmm1),
+	/*2442*/ uint16(xArgXmm2M32),
+	/*2443*/ uint16(xMatch),
+	/*2444*/ uint16(xSetOp), uint16(UCOMISD),
+	/*2446*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2448*/ uint16(xReadSlashR),
+	/*2449*/ uint16(xArgXmm1),
+	/*2450*/ uint16(xArgXmm2M64),
+	/*2451*/ uint16(xMatch),
+	/*2452*/ uint16(xCondPrefix), 2,
+	0x66, 2466,
+	0x0, 2458,
+	/*2458*/ uint16(xSetOp), uint16(COMISS),
+	/*2460*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*2462*/ uint16(xReadSlashR),
+	/*2463*/ uint16(xArgXmm1),
+	/*2464*/ uint16(xArgXmm2M32),
+	/*2465*/ uint16(xMatch),
+	/*2466*/ uint16(xSetOp), uint16(COMISD),
+	/*2468*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*2470*/ uint16(xReadSlashR),
+	/*2471*/ uint16(xArgXmm1),
+	/*2472*/ uint16(xArgXmm2M64),
+	/*2473*/ uint16(xMatch),
+	/*2474*/ uint16(xSetOp), uint16(WRMSR),
 	/*2476*/ uint16(xMatch),
-	/*2477*/ uint16(xCondPrefix), 2,
-	0x66, 2489,
-	0x0, 2483,
-	/*2483*/ uint16(xSetOp), uint16(PSIGND),
-	/*2485*/ uint16(xReadSlashR),
-	/*2486*/ uint16(xArgMm1),
-	/*2487*/ uint16(xArgMm2M64),
+	/*2477*/ uint16(xSetOp), uint16(RDTSC),
+	/*2479*/ uint16(xMatch),
+	/*2480*/ uint16(xSetOp), uint16(RDMSR),
+	/*2482*/ uint16(xMatch),
+	/*2483*/ uint16(xSetOp), uint16(RDPMC),
+	/*2485*/ uint16(xMatch),
+	/*2486*/ uint16(xSetOp), uint16(SYSENTER),
 	/*2488*/ uint16(xMatch),
-	/*2489*/ uint16(xSetOp), uint16(PSIGND),
-	/*2491*/ uint16(xReadSlashR),
-	/*2492*/ uint16(xArgXmm1),
-	/*2493*/ uint16(xArgXmm2M128),
-	/*2494*/ uint16(xMatch),
-	/*2495*/ uint16(xCondPrefix), 2,
-	0x66, 2507,
-	0x0, 2501,
-	/*2501*/ uint16(xSetOp), uint16(PMULHRSW),
-	/*2503*/ uint16(xReadSlashR),
-	/*2504*/ uint16(xArgMm1),
-	/*2505*/ uint16(xArgMm2M64),
-	/*2506*/ uint16(xMatch),
-	/*2507*/ uint16(xSetOp), uint16(PMULHRSW),
-	/*2509*/ uint16(xReadSlashR),
-	/*2510*/ uint16(xArgXmm1),
-	/*2511*/ uint16(xArgXmm2M128),
-	/*2512*/ uint16(xMatch),
-	/*2513*/ uint16(xCondPrefix), 1,
-	0x66, 2517,
-	/*2517*/ uint16(xSetOp), uint16(PBLENDVB),
-	/*2519*/ uint16(xReadSlashR),
-	/*2520*/ uint16(xArgXmm1),
-	/*2521*/ uint16(xArgXmm2M128),
-	/*2522*/ uint16(xArgXMM0),
-	/*2523*/ uint16(xMatch),
-	/*2524*/ uint16(xCondPrefix), 1,
-	0x66, 2528,
-	/*2528*/ uint16(xSetOp), uint16(BLENDVPS),
-	/*2530*/ uint16(xReadSlashR),
-	/*2531*/ uint16(xArgXmm1),
-	/*2532*/ uint16(xArgXmm2M128),
-	/*2533*/ uint16(xArgXMM0),
-	/*2534*/ uint16(xMatch),
-	/*2535*/ uint16(xCondPrefix), 1,
-	0x66, 2539,
-	/*2539*/ uint16(xSetOp), uint16(BLENDVPD),
-	/*2541*/ uint16(xReadSlashR),
-	/*2542*/ uint16(xArgXmm1),
-	/*2543*/ uint16(xArgXmm2M128),
-	/*2544*/ uint16(xArgXMM0),
-	/*2545*/ uint16(xMatch),
-	/*2546*/ uint16(xCondPrefix), 1,
-	0x66, 2550,
-	/*2550*/ uint16(xSetOp), uint16(PTEST),
-	/*2552*/ uint16(xReadSlashR),
-	/*2553*/ uint16(xArgXmm1),
-	/*2554*/ uint16(xArgXmm2M128),
-	/*2555*/ uint16(xMatch),
-	/*2556*/ uint16(xCondPrefix), 2,
-	0x66, 2568,
-	0x0, 2562,
-	/*2562*/ uint16(xSetOp), uint16(PABSB),
-	/*2564*/ uint16(xReadSlashR),
-	/*2565*/ uint16(xArgMm1),
-	/*2566*/ uint16(xArgMm2M64),
-	/*2567*/ uint16(xMatch),
-	/*2568*/ uint16(xSetOp), uint16(PABSB),
-	/*2570*/ uint16(xReadSlashR),
-	/*2571*/ uint16(xArgXmm1),
-	/*2572*/ uint16(xArgXmm2M128),
-	/*2573*/ uint16(xMatch),
-	/*2574*/ uint16(xCondPrefix), 2,
-	0x66, 2586,
-	0x0, 2580,
-	/*2580*/ uint16(xSetOp), uint16(PABSW),
-	/*2582*/ uint16(xReadSlashR),
-	/*2583*/ uint16(xArgMm1),
-	/*2584*/ uint16(xArgMm2M64),
-	/*2585*/ uint16(xMatch),
-	/*2586*/ uint16(xSetOp), uint16(PABSW),
-	/*2588*/ uint16(xReadSlashR),
-	/*2589*/ uint16(xArgXmm1),
-	/*2590*/ uint16(xArgXmm2M128),
-	/*2591*/ uint16(xMatch),
-	/*2592*/ uint16(xCondPrefix), 2,
-	0x66, 2604,
-	0x0, 2598,
-	/*2598*/ uint16(xSetOp), uint16(PABSD),
-	/*2600*/ uint16(xReadSlashR),
-	/*2601*/ uint16(xArgMm1),
-	/*2602*/ uint16(xArgMm2M64),
-	/*2603*/ uint16(xMatch),
-	/*2604*/ uint16(xSetOp), uint16(PABSD),
-	/*2606*/ uint16(xReadSlashR),
-	/*2607*/ uint16(xArgXmm1),
-	/*2608*/ uint16(xArgXmm2M128),
-	/*2609*/ uint16(xMatch),
-	/*2610*/ uint16(xCondPrefix), 1,
-	0x66, 2614,
-	/*2614*/ uint16(xSetOp), uint16(PMOVSXBW),
-	/*2616*/ uint16(xReadSlashR),
-	/*2617*/ uint16(xArgXmm1),
-	/*2618*/ uint16(xArgXmm2M64),
-	/*2619*/ uint16(xMatch),
-	/*2620*/ uint16(xCondPrefix), 1,
-	0x66, 2624,
-	/*2624*/ uint16(xSetOp), uint16(PMOVSXBD),
-	/*2626*/ uint16(xReadSlashR),
-	/*2627*/ uint16(xArgXmm1),
-	/*2628*/ uint16(xArgXmm2M32),
-	/*2629*/ uint16(xMatch),
-	/*2630*/ uint16(xCondPrefix), 1,
-	0x66, 2634,
-	/*2634*/ uint16(xSetOp), uint16(PMOVSXBQ),
-	/*2636*/ uint16(xReadSlashR),
-	/*2637*/ uint16(xArgXmm1),
-	/*2638*/ uint16(xArgXmm2M16),
-	/*2639*/ uint16(xMatch),
-	/*2640*/ uint16(xCondPrefix), 1,
-	0x66, 2644,
-	/*2644*/ uint16(xSetOp), uint16(PMOVSXWD),
-	/*2646*/ uint16(xReadSlashR),
-	/*2647*/ uint16(xArgXmm1),
-	/*2648*/ uint16(xArgXmm2M64),
-	/*2649*/ uint16(xMatch),
-	/*2650*/ uint16(xCondPrefix), 1,
-	0x66, 2654,
-	/*2654*/ uint16(xSetOp), uint16(PMOVSXWQ),
-	/*2656*/ uint16(xReadSlashR),
-	/*2657*/ uint16(xArgXmm1),
-	/*2658*/ uint16(xArgXmm2M32),
-	/*2659*/ uint16(xMatch),
-	/*2660*/ uint16(xCondPrefix), 1,
-	0x66, 2664,
-	/*2664*/ uint16(xSetOp), uint16(PMOVSXDQ),
+	/*2489*/ uint16(xCondDataSize), 2493, 2493, 2496,
+	/*2493*/ uint16(xSetOp), uint16(SYSEXIT),
+	/*2495*/ uint16(xMatch),
+	/*2496*/ uint16(xSetOp), uint16(SYSEXIT),
+	/*2498*/ uint16(xMatch),
+	/*2499*/ uint16(xCondByte), 66,
+	0x00, 2634,
+	0x01, 2656,
+	0x02, 2678,
+	0x03, 2700,
+	0x04, 2722,
+	0x05, 2744,
+	0x06, 2766,
+	0x07, 2788,
+	0x08, 2810,
+	0x09, 2832,
+	0x0A, 2854,
+	0x0B, 2876,
+	0x10, 2898,
+	0x14, 2911,
+	0x15, 2924,
+	0x17, 2937,
+	0x1C, 2949,
+	0x1D, 2971,
+	0x1E, 2993,
+	0x20, 3015,
+	0x21, 3027,
+	0x22, 3039,
+	0x23, 3051,
+	0x24, 3063,
+	0x25, 3075,
+	0x28, 3087,
+	0x29, 3099,
+	0x2A, 3111,
+	0x2B, 3123,
+	0x30, 3135,
+	0x31, 3147,
+	0x32, 3159,
+	0x33, 3171,
+	0x34, 3183,
+	0x35, 3195,
+	0x37, 3207,
+	0x38, 3219,
+	0x39, 3231,
+	0x3A, 3243,
+	0x3B, 3255,
+	0x3C, 3267,
+	0x3D, 3279,
+	0x3E, 3291,
+	0x3F, 3303,
+	0x40, 3315,
+	0x41, 3327,
+	0x82, 3339,
+	0xC8, 3366,
+	0xC9, 3374,
+	0xCA, 3382,
+	0xCB, 3390,
+	0xCC, 3399,
+	0xCD, 3407,
+	0xCF, 3415,
+	0xD8, 3427,
+	0xDB, 3496,
+	0xDC, 3508,
+	0xDD, 3541,
+	0xDE, 3566,
+	0xDF, 3591,
+	0xF0, 3616,
+	0xF1, 3695,
+	0xF5, 3774,
+	0xF6, 3826,
+	0xFA, 3870,
+	0xFB, 3885,
+	uint16(xFail),
+	/*2634*/ uint16(xCondPrefix), 2,
+	0x66, 2648,
+	0x0, 2640,
+	/*2640*/ uint16(xSetOp), uint16(PSHUFB),
+	/*2642*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2644*/ uint16(xReadSlashR),
+	/*2645*/ uint16(xArgMm1),
+	/*2646*/ uint16(xArgMm2M64),
+	/*2647*/ uint16(xMatch),
+	/*2648*/ uint16(xSetOp), uint16(PSHUFB),
+	/*2650*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2652*/ uint16(xReadSlashR),
+	/*2653*/ uint16(xArgXmm1),
+	/*2654*/ uint16(xArgXmm2M128),
+	/*2655*/ uint16(xMatch),
+	/*2656*/ uint16(xCondPrefix), 2,
+	0x66, 2670,
+	0x0, 2662,
+	/*2662*/ uint16(xSetOp), uint16(PHADDW),
+	/*2664*/ uint16(xSetFeature), uint16(FeatureSSSE3),
 	/*2666*/ uint16(xReadSlashR),
-	/*2667*/ uint16(xArgXmm1),
-	/*2668*/ uint16(xArgXmm2M64),
+	/*2667*/ uint16(xArgMm1),
+	/*2668*/ uint16(xArgMm2M64),
 	/*2669*/ uint16(xMatch),
-	/*2670*/ uint16(xCondPrefix), 1,
-	0x66, 2674,
-	/*2674*/ uint16(xSetOp), uint16(PMULDQ),
-	/*2676*/ uint16(xReadSlashR),
-	/*2677*/ uint16(xArgXmm1),
-	/*2678*/ uint16(xArgXmm2M128),
-	/*2679*/ uint16(xMatch),
-	/*2680*/ uint16(xCondPrefix), 1,
-	0x66, 2684,
-	/*2684*/ uint16(xSetOp), uint16(PCMPEQQ),
-	/*2686*/ uint16(xReadSlashR),
-	/*2687*/ uint16(xArgXmm1),
-	/*2688*/ uint16(xArgXmm2M128),
-	/*2689*/ uint16(xMatch),
-	/*2690*/ uint16(xCondPrefix), 1,
-	0x66, 2694,
-	/*2694*/ uint16(xSetOp), uint16(MOVNTDQA),
+	/*2670*/ uint16(xSetOp), uint16(PHADDW),
+	/*2672*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2674*/ uint16(xReadSlashR),
+	/*2675*/ uint16(xArgXmm1),
+	/*2676*/ uint16(xArgXmm2M128),
+	/*2677*/ uint16(xMatch),
+	/*2678*/ uint16(xCondPrefix), 2,
+	0x66, 2692,
+	0x0, 2684,
+	/*2684*/ uint16(xSetOp), uint16(PHADDD),
+	/*2686*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2688*/ uint16(xReadSlashR),
+	/*2689*/ uint16(xArgMm1),
+	/*2690*/ uint16(xArgMm2M64),
+	/*2691*/ uint16(xMatch),
+	/*2692*/ uint16(xSetOp), uint16(PHADDD),
+	/*2694*/ uint16(xSetFeature), uint16(FeatureSSSE3),
 	/*2696*/ uint16(xReadSlashR),
 	/*2697*/ uint16(xArgXmm1),
-	/*2698*/ uint16(xArgM128),
+	/*2698*/ uint16(xArgXmm2M128),
 	/*2699*/ uint16(xMatch),
-	/*2700*/ uint16(xCondPrefix), 1,
-	0x66, 2704,
-	/*2704*/ uint16(xSetOp), uint16(PACKUSDW),
-	/*2706*/ uint16(xReadSlashR),
-	/*2707*/ uint16(xArgXmm1),
-	/*2708*/ uint16(xArgXmm2M128),
-	/*2709*/ uint16(xMatch),
-	/*2710*/ uint16(xCondPrefix), 1,
+	/*2700*/ uint16(xCondPrefix), 2,
 	0x66, 2714,
-	/*2714*/ uint16(xSetOp), uint16(PMOVZXBW),
-	/*2716*/ uint16(xReadSlashR),
-	/*2717*/ uint16(xArgXmm1),
-	/*2718*/ uint16(xArgXmm2M64),
-	/*2719*/ uint16(xMatch),
-	/*2720*/ uint16(xCondPrefix), 1,
-	0x66, 2724,
-	/*2724*/ uint16(xSetOp), uint16(PMOVZXBD),
-	/*2726*/ uint16(xReadSlashR),
-	/*2727*/ uint16(xArgXmm1),
-	/*2728*/ uint16(xArgXmm2M32),
-	/*2729*/ uint16(xMatch),
-	/*2730*/ uint16(xCondPrefix), 1,
-	0x66, 2734,
-	/*2734*/ uint16(xSetOp), uint16(PMOVZXBQ),
-	/*2736*/ uint16(xReadSlashR),
-	/*2737*/ uint16(xArgXmm1),
-	/*2738*/ uint16(xArgXmm2M16),
-	/*2739*/ uint16(xMatch),
-	/*2740*/ uint16(xCondPrefix), 1,
-	0x66, 2744,
-	/*2744*/ uint16(xSetOp), uint16(PMOVZXWD),
-	/*2746*/ uint16(xReadSlashR),
-	/*2747*/ uint16(xArgXmm1),
-	/*2748*/ uint16(xArgXmm2M64),
-	/*2749*/ uint16(xMatch),
-	/*2750*/ uint16(xCondPrefix), 1,
-	0x66, 2754,
-	/*2754*/ uint16(xSetOp), uint16(PMOVZXWQ),
-	/*2756*/ uint16(xReadSlashR),
-	/*2757*/ uint16(xArgXmm1),
-	/*2758*/ uint16(xArgXmm2M32),
-	/*2759*/ uint16(xMatch),
-	/*2760*/ uint16(xCondPrefix), 1,
-	0x66, 2764,
-	/*2764*/ uint16(xSetOp), uint16(PMOVZXDQ),
-	/*2766*/ uint16(xReadSlashR),
-	/*2767*/ uint16(xArgXmm1),
-	/*2768*/ uint16(xArgXmm2M64),
-	/*2769*/ uint16(xMatch),
-	/*2770*/ uint16(xCondPrefix), 1,
-	0x66, 2774,
-	/*2774*/ uint16(xSetOp), uint16(PCMPGTQ),
+	0x0, 2706,
+	/*2706*/ uint16(xSetOp), uint16(PHADDSW),
+	/*2708*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2710*/ uint16(xReadSlashR),
+	/*2711*/ uint16(xArgMm1),
+	/*2712*/ uint16(xArgMm2M64),
+	/*2713*/ uint16(xMatch),
+	/*2714*/ uint16(xSetOp), uint16(PHADDSW),
+	/*2716*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2718*/ uint16(xReadSlashR),
+	/*2719*/ uint16(xArgXmm1),
+	/*2720*/ uint16(xArgXmm2M128),
+	/*2721*/ uint16(xMatch),
+	/*2722*/ uint16(xCondPrefix), 2,
+	0x66, 2736,
+	0x0, 2728,
+	/*2728*/ uint16(xSetOp), uint16(PMADDUBSW),
+	/*2730*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2732*/ uint16(xReadSlashR),
+	/*2733*/ uint16(xArgMm1),
+	/*2734*/ uint16(xArgMm2M64),
+	/*2735*/ uint16(xMatch),
+	/*2736*/ uint16(xSetOp), uint16(PMADDUBSW),
+	/*2738*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2740*/ uint16(xReadSlashR),
+	/*2741*/ uint16(xArgXmm1),
+	/*2742*/ uint16(xArgXmm2M128),
+	/*2743*/ uint16(xMatch),
+	/*2744*/ uint16(xCondPrefix), 2,
+	0x66, 2758,
+	0x0, 2750,
+	/*2750*/ uint16(xSetOp), uint16(PHSUBW),
+	/*2752*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2754*/ uint16(xReadSlashR),
+	/*2755*/ uint16(xArgMm1),
+	/*2756*/ uint16(xArgMm2M64),
+	/*2757*/ uint16(xMatch),
+	/*2758*/ uint16(xSetOp), uint16(PHSUBW),
+	/*2760*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2762*/ uint16(xReadSlashR),
+	/*2763*/ uint16(xArgXmm1),
+	/*2764*/ uint16(xArgXmm2M128),
+	/*2765*/ uint16(xMatch),
+	/*2766*/ uint16(xCondPrefix), 2,
+	0x66, 2780,
+	0x0, 2772,
+	/*2772*/ uint16(xSetOp), uint16(PHSUBD),
+	/*2774*/ uint16(xSetFeature), uint16(FeatureSSSE3),
 	/*2776*/ uint16(xReadSlashR),
-	/*2777*/ uint16(xArgXmm1),
-	/*2778*/ uint16(xArgXmm2M128),
+	/*2777*/ uint16(xArgMm1),
+	/*2778*/ uint16(xArgMm2M64),
 	/*2779*/ uint16(xMatch),
-	/*2780*/ uint16(xCondPrefix), 1,
-	0x66, 2784,
-	/*2784*/ uint16(xSetOp), uint16(PMINSB),
-	/*2786*/ uint16(xReadSlashR),
-	/*2787*/ uint16(xArgXmm1),
-	/*2788*/ uint16(xArgXmm2M128),
-	/*2789*/ uint16(xMatch),
-	/*2790*/ uint16(xCondPrefix), 1,
-	0x66, 2794,
-	/*2794*/ uint16(xSetOp), uint16(PMINSD),
-	/*2796*/ uint16(xReadSlashR),
-	/*2797*/ uint16(xArgXmm1),
-	/*2798*/ uint16(xArgXmm2M128),
-	/*2799*/ uint16(xMatch),
-	/*2800*/ uint16(xCondPrefix), 1,
-	0x66, 2804,
-	/*2804*/ uint16(xSetOp), uint16(PMINUW),
+	/*2780*/ uint16(xSetOp), uint16(PHSUBD),
+	/*2782*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2784*/ uint16(xReadSlashR),
+	/*2785*/ uint16(xArgXmm1),
+	/*2786*/ uint16(xArgXmm2M128),
+	/*2787*/ uint16(xMatch),
+	/*2788*/ uint16(xCondPrefix), 2,
+	0x66, 2802,
+	0x0, 2794,
+	/*2794*/ uint16(xSetOp), uint16(PHSUBSW),
+	/*2796*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2798*/ uint16(xReadSlashR),
+	/*2799*/ uint16(xArgMm1),
+	/*2800*/ uint16(xArgMm2M64),
+	/*2801*/ uint16(xMatch),
+	/*2802*/ uint16(xSetOp), uint16(PHSUBSW),
+	/*2804*/ uint16(xSetFeature), uint16(FeatureSSSE3),
 	/*2806*/ uint16(xReadSlashR),
 	/*2807*/ uint16(xArgXmm1),
 	/*2808*/ uint16(xArgXmm2M128),
 	/*2809*/ uint16(xMatch),
-	/*2810*/ uint16(xCondPrefix), 1,
-	0x66, 2814,
-	/*2814*/ uint16(xSetOp), uint16(PMINUD),
-	/*2816*/ uint16(xReadSlashR),
-	/*2817*/ uint16(xArgXmm1),
-	/*2818*/ uint16(xArgXmm2M128),
-	/*2819*/ uint16(xMatch),
-	/*2820*/ uint16(xCondPrefix), 1,
+	/*2810*/ uint16(xCondPrefix), 2,
 	0x66, 2824,
-	/*2824*/ uint16(xSetOp), uint16(PMAXSB),
-	/*2826*/ uint16(xReadSlashR),
-	/*2827*/ uint16(xArgXmm1),
-	/*2828*/ uint16(xArgXmm2M128),
-	/*2829*/ uint16(xMatch),
-	/*2830*/ uint16(xCondPrefix), 1,
-	0x66, 2834,
-	/*2834*/ uint16(xSetOp), uint16(PMAXSD),
-	/*2836*/ uint16(xReadSlashR),
-	/*2837*/ uint16(xArgXmm1),
-	/*2838*/ uint16(xArgXmm2M128),
-	/*2839*/ uint16(xMatch),
-	/*2840*/ uint16(xCondPrefix), 1,
-	0x66, 2844,
-	/*2844*/ uint16(xSetOp), uint16(PMAXUW),
-	/*2846*/ uint16(xReadSlashR),
-	/*2847*/ uint16(xArgXmm1),
-	/*2848*/ uint16(xArgXmm2M128),
-	/*2849*/ uint16(xMatch),
-	/*2850*/ uint16(xCondPrefix), 1,
-	0x66, 2854,
-	/*2854*/ uint16(xSetOp), uint16(PMAXUD),
-	/*2856*/ uint16(xReadSlashR),
-	/*2857*/ uint16(xArgXmm1),
-	/*2858*/ uint16(xArgXmm2M128),
-	/*2859*/ uint16(xMatch),
-	/*2860*/ uint16(xCondPrefix), 1,
-	0x66, 2864,
-	/*2864*/ uint16(xSetOp), uint16(PMULLD),
-	/*2866*/ uint16(xReadSlashR),
-	/*2867*/ uint16(xArgXmm1),
-	/*2868*/ uint16(xArgXmm2M128),
-	/*2869*/ uint16(xMatch),
-	/*2870*/ uint16(xCondPrefix), 1,
-	0x66, 2874,
-	/*2874*/ uint16(xSetOp), uint16(PHMINPOSUW),
-	/*2876*/ uint16(xReadSlashR),
-	/*2877*/ uint16(xArgXmm1),
-	/*2878*/ uint16(xArgXmm2M128),
-	/*2879*/ uint16(xMatch),
-	/*2880*/ uint16(xCondIs64), 2883, 2893,
-	/*2883*/ uint16(xCondPrefix), 1,
-	0x66, 2887,
-	/*2887*/ uint16(xSetOp), uint16(INVPCID),
-	/*2889*/ uint16(xReadSlashR),
-	/*2890*/ uint16(xArgR32),
-	/*2891*/ uint16(xArgM128),
-	/*2892*/ uint16(xMatch),
-	/*2893*/ uint16(xCondPrefix), 1,
-	0x66, 2897,
-	/*2897*/ uint16(xSetOp), uint16(INVPCID),
-	/*2899*/ uint16(xReadSlashR),
-	/*2900*/ uint16(xArgR64),
-	/*2901*/ uint16(xArgM128),
-	/*2902*/ uint16(xMatch),
-	/*2903*/ uint16(xSetOp), uint16(SHA1NEXTE),
-	/*2905*/ uint16(xReadSlashR),
-	/*2906*/ uint16(xArgXmm1),
-	/*2907*/ uint16(xArgXmm2M128),
-	/*2908*/ uint16(xMatch),
-	/*2909*/ uint16(xSetOp), uint16(SHA1MSG1),
-	/*2911*/ uint16(xReadSlashR),
-	/*2912*/ uint16(xArgXmm1),
-	/*2913*/ uint16(xArgXmm2M128),
-	/*2914*/ uint16(xMatch),
-	/*2915*/ uint16(xSetOp), uint16(SHA1MSG2),
-	/*2917*/ uint16(xReadSlashR),
-	/*2918*/ uint16(xArgXmm1),
-	/*2919*/ uint16(xArgXmm2M128),
-	/*2920*/ uint16(xMatch),
-	/*2921*/ uint16(xSetOp), uint16(SHA256RNDS2),
-	/*2923*/ uint16(xReadSlashR),
-	/*2924*/ uint16(xArgXmm1),
-	/*2925*/ uint16(xArgXmm2M128),
-	/*2926*/ uint16(xArgXMM0),
-	/*2927*/ uint16(xMatch),
-	/*2928*/ uint16(xSetOp), uint16(SHA256MSG1),
-	/*2930*/ uint16(xReadSlashR),
-	/*2931*/ uint16(xArgXmm1),
-	/*2932*/ uint16(xArgXmm2M128),
-	/*2933*/ uint16(xMatch),
-	/*2934*/ uint16(xSetOp), uint16(SHA256MSG2),
-	/*2936*/ uint16(xReadSlashR),
-	/*2937*/ uint16(xArgXmm1),
-	/*2938*/ uint16(xArgXmm2M128),
-	/*2939*/ uint16(xMatch),
-	/*2940*/ uint16(xCondPrefix), 1,
-	0x66, 2944,
-	/*2944*/ uint16(xSetOp), uint16(AESIMC),
-	/*2946*/ uint16(xReadSlashR),
-	/*2947*/ uint16(xArgXmm1),
-	/*2948*/ uint16(xArgXmm2M128),
-	/*2949*/ uint16(xMatch),
-	/*2950*/ uint16(xCondPrefix), 1,
-	0x66, 2954,
-	/*2954*/ uint16(xSetOp), uint16(AESENC),
-	/*2956*/ uint16(xReadSlashR),
-	/*2957*/ uint16(xArgXmm1),
-	/*2958*/ uint16(xArgXmm2M128),
-	/*2959*/ uint16(xMatch),
-	/*2960*/ uint16(xCondPrefix), 1,
-	0x66, 2964,
-	/*2964*/ uint16(xSetOp), uint16(AESENCLAST),
-	/*2966*/ uint16(xReadSlashR),
-	/*2967*/ uint16(xArgXmm1),
-	/*2968*/ uint16(xArgXmm2M128),
-	/*2969*/ uint16(xMatch),
-	/*2970*/ uint16(xCondPrefix), 1,
-	0x66, 2974,
-	/*2974*/ uint16(xSetOp), uint16(AESDEC),
-	/*2976*/ uint16(xReadSlashR),
-	/*2977*/ uint16(xArgXmm1),
-	/*2978*/ uint16(xArgXmm2M128),
-	/*2979*/ uint16(xMatch),
-	/*2980*/ uint16(xCondPrefix), 1,
-	0x66, 2984,
-	/*2984*/ uint16(xSetOp), uint16(AESDECLAST),
-	/*2986*/ uint16(xReadSlashR),
-	/*2987*/ uint16(xArgXmm1),
-	/*2988*/ uint16(xArgXmm2M128),
-	/*2989*/ uint16(xMatch),
-	/*2990*/ uint16(xCondIs64), 2993, 3031,
+	0x0, 2816,
+	/*2816*/ uint16(xSetOp), uint16(PSIGNB),
+	/*2818*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2820*/ uint16(xReadSlashR),
+	/*2821*/ uint16(xArgMm1),
+	/*2822*/ uint16(xArgMm2M64),
+	/*2823*/ uint16(xMatch),
+	/*2824*/ uint16(xSetOp), uint16(PSIGNB),
+	/*2826*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2828*/ uint16(xReadSlashR),
+	/*2829*/ uint16(xArgXmm1),
+	/*2830*/ uint16(xArgXmm2M128),
+	/*2831*/ uint16(xMatch),
+	/*2832*/ uint16(xCondPrefix), 2,
+	0x66, 2846,
+	0x0, 2838,
+	/*2838*/ uint16(xSetOp), uint16(PSIGNW),
+	/*2840*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2842*/ uint16(xReadSlashR),
+	/*2843*/ uint16(xArgMm1),
+	/*2844*/ uint16(xArgMm2M64),
+	/*2845*/ uint16(xMatch),
+	/*2846*/ uint16(xSetOp), uint16(PSIGNW),
+	/*2848*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2850*/ uint16(xReadSlashR),
+	/*2851*/ uint16(xArgXmm1),
+	/*2852*/ uint16(xArgXmm2M128),
+	/*2853*/ uint16(xMatch),
+	/*2854*/ uint16(xCondPrefix), 2,
+	0x66, 2868,
+	0x0, 2860,
+	/*2860*/ uint16(xSetOp), uint16(PSIGND),
+	/*2862*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2864*/ uint16(xReadSlashR),
+	/*2865*/ uint16(xArgMm1),
+	/*2866*/ uint16(xArgMm2M64),
+	/*2867*/ uint16(xMatch),
+	/*2868*/ uint16(xSetOp), uint16(PSIGND),
+	/*2870*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2872*/ uint16(xReadSlashR),
+	/*2873*/ uint16(xArgXmm1),
+	/*2874*/ uint16(xArgXmm2M128),
+	/*2875*/ uint16(xMatch),
+	/*2876*/ uint16(xCondPrefix), 2,
+	0x66, 2890,
+	0x0, 2882,
+	/*2882*/ uint16(xSetOp), uint16(PMULHRSW),
+	/*2884*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2886*/ uint16(xReadSlashR),
+	/*2887*/ uint16(xArgMm1),
+	/*2888*/ uint16(xArgMm2M64),
+	/*2889*/ uint16(xMatch),
+	/*2890*/ uint16(xSetOp), uint16(PMULHRSW),
+	/*2892*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2894*/ uint16(xReadSlashR),
+	/*2895*/ uint16(xArgXmm1),
+	/*2896*/ uint16(xArgXmm2M128),
+	/*2897*/ uint16(xMatch),
+	/*2898*/ uint16(xCondPrefix), 1,
+	0x66, 2902,
+	/*2902*/ uint16(xSetOp), uint16(PBLENDVB),
+	/*2904*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*2906*/ uint16(xReadSlashR),
+	/*2907*/ uint16(xArgXmm1),
+	/*2908*/ uint16(xArgXmm2M128),
+	/*2909*/ uint16(xArgXMM0),
+	/*2910*/ uint16(xMatch),
+	/*2911*/ uint16(xCondPrefix), 1,
+	0x66, 2915,
+	/*2915*/ uint16(xSetOp), uint16(BLENDVPS),
+	/*2917*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*2919*/ uint16(xReadSlashR),
+	/*2920*/ uint16(xArgXmm1),
+	/*2921*/ uint16(xArgXmm2M128),
+	/*2922*/ uint16(xArgXMM0),
+	/*2923*/ uint16(xMatch),
+	/*2924*/ uint16(xCondPrefix), 1,
+	0x66, 2928,
+	/*2928*/ uint16(xSetOp), uint16(BLENDVPD),
+	/*2930*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*2932*/ uint16(xReadSlashR),
+	/*2933*/ uint16(xArgXmm1),
+	/*2934*/ uint16(xArgXmm2M128),
+	/*2935*/ uint16(xArgXMM0),
+	/*2936*/ uint16(xMatch),
+	/*2937*/ uint16(xCondPrefix), 1,
+	0x66, 2941,
+	/*2941*/ uint16(xSetOp), uint16(PTEST),
+	/*2943*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*2945*/ uint16(xReadSlashR),
+	/*2946*/ uint16(xArgXmm1),
+	/*2947*/ uint16(xArgXmm2M128),
+	/*2948*/ uint16(xMatch),
+	/*2949*/ uint16(xCondPrefix), 2,
+	0x66, 2963,
+	0x0, 2955,
+	/*2955*/ uint16(xSetOp), uint16(PABSB),
+	/*2957*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2959*/ uint16(xReadSlashR),
+	/*2960*/ uint16(xArgMm1),
+	/*2961*/ uint16(xArgMm2M64),
+	/*2962*/ uint16(xMatch),
+	/*2963*/ uint16(xSetOp), uint16(PABSB),
+	/*2965*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2967*/ uint16(xReadSlashR),
+	/*2968*/ uint16(xArgXmm1),
+	/*2969*/ uint16(xArgXmm2M128),
+	/*2970*/ uint16(xMatch),
+	/*2971*/ uint16(xCondPrefix), 2,
+	0x66, 2985,
+	0x0, 2977,
+	/*2977*/ uint16(xSetOp), uint16(PABSW),
+	/*2979*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2981*/ uint16(xReadSlashR),
+	/*2982*/ uint16(xArgMm1),
+	/*2983*/ uint16(xArgMm2M64),
+	/*2984*/ uint16(xMatch),
+	/*2985*/ uint16(xSetOp), uint16(PABSW),
+	/*2987*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*2989*/ uint16(xReadSlashR),
+	/*2990*/ uint16(xArgXmm1),
+	/*2991*/ uint16(xArgXmm2M128),
+	/*2992*/ uint16(xMatch),
 	/*2993*/ uint16(xCondPrefix), 2,
-	0xF2, 3015,
+	0x66, 3007,
 	0x0, 2999,
-	/*2999*/ uint16(xCondDataSize), 3003, 3009, 0,
-	/*3003*/ uint16(xSetOp), uint16(MOVBE),
-	/*3005*/ uint16(xReadSlashR),
-	/*3006*/ uint16(xArgR16),
-	/*3007*/ uint16(xArgM16),
-	/*3008*/ uint16(xMatch),
-	/*3009*/ uint16(xSetOp), uint16(MOVBE),
+	/*2999*/ uint16(xSetOp), uint16(PABSD),
+	/*3001*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*3003*/ uint16(xReadSlashR),
+	/*3004*/ uint16(xArgMm1),
+	/*3005*/ uint16(xArgMm2M64),
+	/*3006*/ uint16(xMatch),
+	/*3007*/ uint16(xSetOp), uint16(PABSD),
+	/*3009*/ uint16(xSetFeature), uint16(FeatureSSSE3),
 	/*3011*/ uint16(xReadSlashR),
-	/*3012*/ uint16(xArgR32),
-	/*3013*/ uint16(xArgM32),
+	/*3012*/ uint16(xArgXmm1),
+	/*3013*/ uint16(xArgXmm2M128),
 	/*3014*/ uint16(xMatch),
-	/*3015*/ uint16(xCondDataSize), 3019, 3025, 0,
-	/*3019*/ uint16(xSetOp), uint16(CRC32),
-	/*3021*/ uint16(xReadSlashR),
-	/*3022*/ uint16(xArgR32),
-	/*3023*/ uint16(xArgRM8),
-	/*3024*/ uint16(xMatch),
-	/*3025*/ uint16(xSetOp), uint16(CRC32),
-	/*3027*/ uint16(xReadSlashR),
-	/*3028*/ uint16(xArgR32),
-	/*3029*/ uint16(xArgRM8),
-	/*3030*/ uint16(xMatch),
-	/*3031*/ uint16(xCondPrefix), 2,
-	0xF2, 3047,
-	0x0, 3037,
-	/*3037*/ uint16(xCondDataSize), 3003, 3009, 3041,
-	/*3041*/ uint16(xSetOp), uint16(MOVBE),
-	/*3043*/ uint16(xReadSlashR),
-	/*3044*/ uint16(xArgR64),
-	/*3045*/ uint16(xArgM64),
-	/*3046*/ uint16(xMatch),
-	/*3047*/ uint16(xCondDataSize), 3019, 3025, 3051,
-	/*3051*/ uint16(xSetOp), uint16(CRC32),
-	/*3053*/ uint16(xReadSlashR),
-	/*3054*/ uint16(xArgR64),
-	/*3055*/ uint16(xArgRM8),
-	/*3056*/ uint16(xMatch),
-	/*3057*/ uint16(xCondIs64), 3060, 3098,
-	/*3060*/ uint16(xCondPrefix), 2,
-	0xF2, 3082,
-	0x0, 3066,
-	/*3066*/ uint16(xCondDataSize), 3070, 3076, 0,
-	/*3070*/ uint16(xSetOp), uint16(MOVBE),
-	/*3072*/ uint16(xReadSlashR),
-	/*3073*/ uint16(xArgM16),
-	/*3074*/ uint16(xArgR16),
-	/*3075*/ uint16(xMatch),
-	/*3076*/ uint16(xSetOp), uint16(MOVBE),
-	/*3078*/ uint16(xReadSlashR),
-	/*3079*/ uint16(xArgM32),
-	/*3080*/ uint16(xArgR32),
-	/*3081*/ uint16(xMatch),
-	/*3082*/ uint16(xCondDataSize), 3086, 3092, 0,
-	/*3086*/ uint16(xSetOp), uint16(CRC32),
-	/*3088*/ uint16(xReadSlashR),
-	/*3089*/ uint16(xArgR32),
-	/*3090*/ uint16(xArgRM16),
-	/*3091*/ uint16(xMatch),
-	/*3092*/ uint16(xSetOp), uint16(CRC32),
-	/*3094*/ uint16(xReadSlashR),
-	/*3095*/ uint16(xArgR32),
-	/*3096*/ uint16(xArgRM32),
-	/*3097*/ uint16(xMatch),
-	/*3098*/ uint16(xCondPrefix), 2,
-	0xF2, 3114,
-	0x0, 3104,
-	/*3104*/ uint16(xCondDataSize), 3070, 3076, 3108,
-	/*3108*/ uint16(xSetOp), uint16(MOVBE),
-	/*3110*/ uint16(xReadSlashR),
-	/*3111*/ uint16(xArgM64),
-	/*3112*/ uint16(xArgR64),
-	/*3113*/ uint16(xMatch),
-	/*3114*/ uint16(xCondDataSize), 3086, 3092, 3118,
-	/*3118*/ uint16(xSetOp), uint16(CRC32),
-	/*3120*/ uint16(xReadSlashR),
-	/*3121*/ uint16(xArgR64),
-	/*3122*/ uint16(xArgRM64),
-	/*3123*/ uint16(xMatch),
-	/*3124*/ uint16(xCondByte), 25,
-	0x08, 3177,
-	0x09, 3189,
-	0x0A, 3201,
-	0x0B, 3213,
-	0x0C, 3225,
-	0x0D, 3237,
-	0x0E, 3249,
-	0x0F, 3261,
-	0x14, 3283,
-	0x15, 3295,
-	0x16, 3307,
-	0x17, 3350,
-	0x20, 3362,
-	0x21, 3374,
-	0x22, 3386,
-	0x40, 3429,
-	0x41, 3441,
-	0x42, 3453,
-	0x44, 3465,
-	0x60, 3477,
-	0x61, 3489,
-	0x62, 3501,
-	0x63, 3513,
-	0xCC, 3525,
-	0xDF, 3533,
-	uint16(xFail),
-	/*3177*/ uint16(xCondPrefix), 1,
-	0x66, 3181,
-	/*3181*/ uint16(xSetOp), uint16(ROUNDPS),
-	/*3183*/ uint16(xReadSlashR),
-	/*3184*/ uint16(xReadIb),
-	/*3185*/ uint16(xArgXmm1),
-	/*3186*/ uint16(xArgXmm2M128),
-	/*3187*/ uint16(xArgImm8u),
-	/*3188*/ uint16(xMatch),
-	/*3189*/ uint16(xCondPrefix), 1,
-	0x66, 3193,
-	/*3193*/ uint16(xSetOp), uint16(ROUNDPD),
-	/*3195*/ uint16(xReadSlashR),
-	/*3196*/ uint16(xReadIb),
-	/*3197*/ uint16(xArgXmm1),
-	/*3198*/ uint16(xArgXmm2M128),
-	/*3199*/ uint16(xArgImm8u),
-	/*3200*/ uint16(xMatch),
-	/*3201*/ uint16(xCondPrefix), 1,
-	0x66, 3205,
-	/*3205*/ uint16(xSetOp), uint16(ROUNDSS),
-	/*3207*/ uint16(xReadSlashR),
-	/*3208*/ uint16(xReadIb),
-	/*3209*/ uint16(xArgXmm1),
-	/*3210*/ uint16(xArgXmm2M32),
-	/*3211*/ uint16(xArgImm8u),
-	/*3212*/ uint16(xMatch),
-	/*3213*/ uint16(xCondPrefix), 1,
-	0x66, 3217,
-	/*3217*/ uint16(xSetOp), uint16(ROUNDSD),
-	/*3219*/ uint16(xReadSlashR),
-	/*3220*/ uint16(xReadIb),
-	/*3221*/ uint16(xArgXmm1),
-	/*3222*/ uint16(xArgXmm2M64),
-	/*3223*/ uint16(xArgImm8u),
-	/*3224*/ uint16(xMatch),
-	/*3225*/ uint16(xCondPrefix), 1,
-	0x66, 3229,
-	/*3229*/ uint16(xSetOp), uint16(BLENDPS),
-	/*3231*/ uint16(xReadSlashR),
-	/*3232*/ uint16(xReadIb),
-	/*3233*/ uint16(xArgXmm1),
-	/*3234*/ uint16(xArgXmm2M128),
-	/*3235*/ uint16(xArgImm8u),
-	/*3236*/ uint16(xMatch),
-	/*3237*/ uint16(xCondPrefix), 1,
-	0x66, 3241,
-	/*3241*/ uint16(xSetOp), uint16(BLENDPD),
-	/*3243*/ uint16(xReadSlashR),
-	/*3244*/ uint16(xReadIb),
-	/*3245*/ uint16(xArgXmm1),
-	/*3246*/ uint16(xArgXmm2M128),
-	/*3247*/ uint16(xArgImm8u),
-	/*3248*/ uint16(xMatch),
-	/*3249*/ uint16(xCondPrefix), 1,
-	0x66, 3253,
-	/*3253*/ uint16(xSetOp), uint16(PBLENDW),
-	/*3255*/ uint16(xReadSlashR),
-	/*3256*/ uint16(xReadIb),
-	/*3257*/ uint16(xArgXmm1),
-	/*3258*/ uint16(xArgXmm2M128),
-	/*3259*/ uint16(xArgImm8u),
-	/*3260*/ uint16(xMatch),
-	/*3261*/ uint16(xCondPrefix), 2,
-	0x66, 3275,
-	0x0, 3267,
-	/*3267*/ uint16(xSetOp), uint16(PALIGNR),
-	/*3269*/ uint16(xReadSlashR),
-	/*3270*/ uint16(xReadIb),
-	/*3271*/ uint16(xArgMm1),
-	/*3272*/ uint16(xArgMm2M64),
-	/*3273*/ uint16(xArgImm8u),
-	/*3274*/ uint16(xMatch),
-	/*3275*/ uint16(xSetOp), uint16(PALIGNR),
-	/*3277*/ uint16(xReadSlashR),
-	/*3278*/ uint16(xReadIb),
-	/*3279*/ uint16(xArgXmm1),
-	/*3280*/ uint16(xArgXmm2M128),
-	/*3281*/ uint16(xArgImm8u),
-	/*3282*/ uint16(xMatch),
-	/*3283*/ uint16(xCondPrefix), 1,
-	0x66, 3287,
-	/*3287*/ uint16(xSetOp), uint16(PEXTRB),
-	/*3289*/ uint16(xReadSlashR),
-	/*3290*/ uint16(xReadIb),
-	/*3291*/ uint16(xArgR32M8),
-	/*3292*/ uint16(xArgXmm1),
-	/*3293*/ uint16(xArgImm8u),
-	/*3294*/ uint16(xMatch),
-	/*3295*/ uint16(xCondPrefix), 1,
-	0x66, 3299,
-	/*3299*/ uint16(xSetOp), uint16(PEXTRW),
-	/*3301*/ uint16(xReadSlashR),
-	/*3302*/ uint16(xReadIb),
-	/*3303*/ uint16(xArgR32M16),
-	/*3304*/ uint16(xArgXmm1),
-	/*3305*/ uint16(xArgImm8u),
-	/*3306*/ uint16(xMatch),
-	/*3307*/ uint16(xCondIs64), 3310, 3334,
-	/*3310*/ uint16(xCondPrefix), 1,
-	0x66, 3314,
-	/*3314*/ uint16(xCondDataSize), 3318, 3326, 0,
-	/*3318*/ uint16(xSetOp), uint16(PEXTRD),
-	/*3320*/ uint16(xReadSlashR),
-	/*3321*/ uint16(xReadIb),
-	/*3322*/ uint16(xArgRM32),
-	/*3323*/ uint16(xArgXmm1),
-	/*3324*/ uint16(xArgImm8u),
-	/*3325*/ uint16(xMatch),
-	/*3326*/ uint16(xSetOp), uint16(PEXTRD),
-	/*3328*/ uint16(xReadSlashR),
-	/*3329*/ uint16(xReadIb),
-	/*3330*/ uint16(xArgRM32),
-	/*3331*/ uint16(xArgXmm1),
-	/*3332*/ uint16(xArgImm8u),
-	/*3333*/ uint16(xMatch),
-	/*3334*/ uint16(xCondPrefix), 1,
-	0x66, 3338,
-	/*3338*/ uint16(xCondDataSize), 3318, 3326, 3342,
-	/*3342*/ uint16(xSetOp), uint16(PEXTRQ),
-	/*3344*/ uint16(xReadSlashR),
-	/*3345*/ uint16(xReadIb),
-	/*3346*/ uint16(xArgRM64),
-	/*3347*/ uint16(xArgXmm1),
-	/*3348*/ uint16(xArgImm8u),
-	/*3349*/ uint16(xMatch),
-	/*3350*/ uint16(xCondPrefix), 1,
-	0x66, 3354,
-	/*3354*/ uint16(xSetOp), uint16(EXTRACTPS),
-	/*3356*/ uint16(xReadSlashR),
-	/*3357*/ uint16(xReadIb),
-	/*3358*/ uint16(xArgRM32),
-	/*3359*/ uint16(xArgXmm1),
-	/*3360*/ uint16(xArgImm8u),
-	/*3361*/ uint16(xMatch),
-	/*3362*/ uint16(xCondPrefix), 1,
-	0x66, 3366,
-	/*3366*/ uint16(xSetOp), uint16(PINSRB),
-	/*3368*/ uint16(xReadSlashR),
-	/*3369*/ uint16(xReadIb),
-	/*3370*/ uint16(xArgXmm1),
-	/*3371*/ uint16(xArgR32M8),
-	/*3372*/ uint16(xArgImm8u),
+	/*3015*/ uint16(xCondPrefix), 1,
+	0x66, 3019,
+	/*3019*/ uint16(xSetOp), uint16(PMOVSXBW),
+	/*3021*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3023*/ uint16(xReadSlashR),
+	/*3024*/ uint16(xArgXmm1),
+	/*3025*/ uint16(xArgXmm2M64),
+	/*3026*/ uint16(xMatch),
+	/*3027*/ uint16(xCondPrefix), 1,
+	0x66, 3031,
+	/*3031*/ uint16(xSetOp), uint16(PMOVSXBD),
+	/*3033*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3035*/ uint16(xReadSlashR),
+	/*3036*/ uint16(xArgXmm1),
+	/*3037*/ uint16(xArgXmm2M32),
+	/*3038*/ uint16(xMatch),
+	/*3039*/ uint16(xCondPrefix), 1,
+	0x66, 3043,
+	/*3043*/ uint16(xSetOp), uint16(PMOVSXBQ),
+	/*3045*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3047*/ uint16(xReadSlashR),
+	/*3048*/ uint16(xArgXmm1),
+	/*3049*/ uint16(xArgXmm2M16),
+	/*3050*/ uint16(xMatch),
+	/*3051*/ uint16(xCondPrefix), 1,
+	0x66, 3055,
+	/*3055*/ uint16(xSetOp), uint16(PMOVSXWD),
+	/*3057*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3059*/ uint16(xReadSlashR),
+	/*3060*/ uint16(xArgXmm1),
+	/*3061*/ uint16(xArgXmm2M64),
+	/*3062*/ uint16(xMatch),
+	/*3063*/ uint16(xCondPrefix), 1,
+	0x66, 3067,
+	/*3067*/ uint16(xSetOp), uint16(PMOVSXWQ),
+	/*3069*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3071*/ uint16(xReadSlashR),
+	/*3072*/ uint16(xArgXmm1),
+	/*3073*/ uint16(xArgXmm2M32),
+	/*3074*/ uint16(xMatch),
+	/*3075*/ uint16(xCondPrefix), 1,
+	0x66, 3079,
+	/*3079*/ uint16(xSetOp), uint16(PMOVSXDQ),
+	/*3081*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3083*/ uint16(xReadSlashR),
+	/*3084*/ uint16(xArgXmm1),
+	/*3085*/ uint16(xArgXmm2M64),
+	/*3086*/ uint16(xMatch),
+	/*3087*/ uint16(xCondPrefix), 1,
+	0x66, 3091,
+	/*3091*/ uint16(xSetOp), uint16(PMULDQ),
+	/*3093*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3095*/ uint16(xReadSlashR),
+	/*3096*/ uint16(xArgXmm1),
+	/*3097*/ uint16(xArgXmm2M128),
+	/*3098*/ uint16(xMatch),
+	/*3099*/ uint16(xCondPrefix), 1,
+	0x66, 3103,
+	/*3103*/ uint16(xSetOp), uint16(PCMPEQQ),
+	/*3105*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3107*/ uint16(xReadSlashR),
+	/*3108*/ uint16(xArgXmm1),
+	/*3109*/ uint16(xArgXmm2M128),
+	/*3110*/ uint16(xMatch),
+	/*3111*/ uint16(xCondPrefix), 1,
+	0x66, 3115,
+	/*3115*/ uint16(xSetOp), uint16(MOVNTDQA),
+	/*3117*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3119*/ uint16(xReadSlashR),
+	/*3120*/ uint16(xArgXmm1),
+	/*3121*/ uint16(xArgM128),
+	/*3122*/ uint16(xMatch),
+	/*3123*/ uint16(xCondPrefix), 1,
+	0x66, 3127,
+	/*3127*/ uint16(xSetOp), uint16(PACKUSDW),
+	/*3129*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3131*/ uint16(xReadSlashR),
+	/*3132*/ uint16(xArgXmm1),
+	/*3133*/ uint16(xArgXmm2M128),
+	/*3134*/ uint16(xMatch),
+	/*3135*/ uint16(xCondPrefix), 1,
+	0x66, 3139,
+	/*3139*/ uint16(xSetOp), uint16(PMOVZXBW),
+	/*3141*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3143*/ uint16(xReadSlashR),
+	/*3144*/ uint16(xArgXmm1),
+	/*3145*/ uint16(xArgXmm2M64),
+	/*3146*/ uint16(xMatch),
+	/*3147*/ uint16(xCondPrefix), 1,
+	0x66, 3151,
+	/*3151*/ uint16(xSetOp), uint16(PMOVZXBD),
+	/*3153*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3155*/ uint16(xReadSlashR),
+	/*3156*/ uint16(xArgXmm1),
+	/*3157*/ uint16(xArgXmm2M32),
+	/*3158*/ uint16(xMatch),
+	/*3159*/ uint16(xCondPrefix), 1,
+	0x66, 3163,
+	/*3163*/ uint16(xSetOp), uint16(PMOVZXBQ),
+	/*3165*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3167*/ uint16(xReadSlashR),
+	/*3168*/ uint16(xArgXmm1),
+	/*3169*/ uint16(xArgXmm2M16),
+	/*3170*/ uint16(xMatch),
+	/*3171*/ uint16(xCondPrefix), 1,
+	0x66, 3175,
+	/*3175*/ uint16(xSetOp), uint16(PMOVZXWD),
+	/*3177*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3179*/ uint16(xReadSlashR),
+	/*3180*/ uint16(xArgXmm1),
+	/*3181*/ uint16(xArgXmm2M64),
+	/*3182*/ uint16(xMatch),
+	/*3183*/ uint16(xCondPrefix), 1,
+	0x66, 3187,
+	/*3187*/ uint16(xSetOp), uint16(PMOVZXWQ),
+	/*3189*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3191*/ uint16(xReadSlashR),
+	/*3192*/ uint16(xArgXmm1),
+	/*3193*/ uint16(xArgXmm2M32),
+	/*3194*/ uint16(xMatch),
+	/*3195*/ uint16(xCondPrefix), 1,
+	0x66, 3199,
+	/*3199*/ uint16(xSetOp), uint16(PMOVZXDQ),
+	/*3201*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3203*/ uint16(xReadSlashR),
+	/*3204*/ uint16(xArgXmm1),
+	/*3205*/ uint16(xArgXmm2M64),
+	/*3206*/ uint16(xMatch),
+	/*3207*/ uint16(xCondPrefix), 1,
+	0x66, 3211,
+	/*3211*/ uint16(xSetOp), uint16(PCMPGTQ),
+	/*3213*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3215*/ uint16(xReadSlashR),
+	/*3216*/ uint16(xArgXmm1),
+	/*3217*/ uint16(xArgXmm2M128),
+	/*3218*/ uint16(xMatch),
+	/*3219*/ uint16(xCondPrefix), 1,
+	0x66, 3223,
+	/*3223*/ uint16(xSetOp), uint16(PMINSB),
+	/*3225*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3227*/ uint16(xReadSlashR),
+	/*3228*/ uint16(xArgXmm1),
+	/*3229*/ uint16(xArgXmm2M128),
+	/*3230*/ uint16(xMatch),
+	/*3231*/ uint16(xCondPrefix), 1,
+	0x66, 3235,
+	/*3235*/ uint16(xSetOp), uint16(PMINSD),
+	/*3237*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3239*/ uint16(xReadSlashR),
+	/*3240*/ uint16(xArgXmm1),
+	/*3241*/ uint16(xArgXmm2M128),
+	/*3242*/ uint16(xMatch),
+	/*3243*/ uint16(xCondPrefix), 1,
+	0x66, 3247,
+	/*3247*/ uint16(xSetOp), uint16(PMINUW),
+	/*3249*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3251*/ uint16(xReadSlashR),
+	/*3252*/ uint16(xArgXmm1),
+	/*3253*/ uint16(xArgXmm2M128),
+	/*3254*/ uint16(xMatch),
+	/*3255*/ uint16(xCondPrefix), 1,
+	0x66, 3259,
+	/*3259*/ uint16(xSetOp), uint16(PMINUD),
+	/*3261*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3263*/ uint16(xReadSlashR),
+	/*3264*/ uint16(xArgXmm1),
+	/*3265*/ uint16(xArgXmm2M128),
+	/*3266*/ uint16(xMatch),
+	/*3267*/ uint16(xCondPrefix), 1,
+	0x66, 3271,
+	/*3271*/ uint16(xSetOp), uint16(PMAXSB),
+	/*3273*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3275*/ uint16(xReadSlashR),
+	/*3276*/ uint16(xArgXmm1),
+	/*3277*/ uint16(xArgXmm2M128),
+	/*3278*/ uint16(xMatch),
+	/*3279*/ uint16(xCondPrefix), 1,
+	0x66, 3283,
+	/*3283*/ uint16(xSetOp), uint16(PMAXSD),
+	/*3285*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3287*/ uint16(xReadSlashR),
+	/*3288*/ uint16(xArgXmm1),
+	/*3289*/ uint16(xArgXmm2M128),
+	/*3290*/ uint16(xMatch),
+	/*3291*/ uint16(xCondPrefix), 1,
+	0x66, 3295,
+	/*3295*/ uint16(xSetOp), uint16(PMAXUW),
+	/*3297*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3299*/ uint16(xReadSlashR),
+	/*3300*/ uint16(xArgXmm1),
+	/*3301*/ uint16(xArgXmm2M128),
+	/*3302*/ uint16(xMatch),
+	/*3303*/ uint16(xCondPrefix), 1,
+	0x66, 3307,
+	/*3307*/ uint16(xSetOp), uint16(PMAXUD),
+	/*3309*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3311*/ uint16(xReadSlashR),
+	/*3312*/ uint16(xArgXmm1),
+	/*3313*/ uint16(xArgXmm2M128),
+	/*3314*/ uint16(xMatch),
+	/*3315*/ uint16(xCondPrefix), 1,
+	0x66, 3319,
+	/*3319*/ uint16(xSetOp), uint16(PMULLD),
+	/*3321*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3323*/ uint16(xReadSlashR),
+	/*3324*/ uint16(xArgXmm1),
+	/*3325*/ uint16(xArgXmm2M128),
+	/*3326*/ uint16(xMatch),
+	/*3327*/ uint16(xCondPrefix), 1,
+	0x66, 3331,
+	/*3331*/ uint16(xSetOp), uint16(PHMINPOSUW),
+	/*3333*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3335*/ uint16(xReadSlashR),
+	/*3336*/ uint16(xArgXmm1),
+	/*3337*/ uint16(xArgXmm2M128),
+	/*3338*/ uint16(xMatch),
+	/*3339*/ uint16(xCondIs64), 3342, 3354,
+	/*3342*/ uint16(xCondPrefix), 1,
+	0x66, 3346,
+	/*3346*/ uint16(xSetOp), uint16(INVPCID),
+	/*3348*/ uint16(xSetFeature), uint16(FeatureINVPCID),
+	/*3350*/ uint16(xReadSlashR),
+	/*3351*/ uint16(xArgR32),
+	/*3352*/ uint16(xArgM128),
+	/*3353*/ uint16(xMatch),
+	/*3354*/ uint16(xCondPrefix), 1,
+	0x66, 3358,
+	/*3358*/ uint16(xSetOp), uint16(INVPCID),
+	/*3360*/ uint16(xSetFeature), uint16(FeatureINVPCID),
+	/*3362*/ uint16(xReadSlashR),
+	/*3363*/ uint16(xArgR64),
+	/*3364*/ uint16(xArgM128),
+	/*3365*/ uint16(xMatch),
+	/*3366*/ uint16(xSetOp), uint16(SHA1NEXTE),
+	/*3368*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*3370*/ uint16(xReadSlashR),
+	/*3371*/ uint16(xArgXmm1),
+	/*3372*/ uint16(xArgXmm2M128),
 	/*3373*/ uint16(xMatch),
-	/*3374*/ uint16(xCondPrefix), 1,
-	0x66, 3378,
-	/*3378*/ uint16(xSetOp), uint16(INSERTPS),
-	/*3380*/ uint16(xReadSlashR),
-	/*3381*/ uint16(xReadIb),
-	/*3382*/ uint16(xArgXmm1),
-	/*3383*/ uint16(xArgXmm2M32),
-	/*3384*/ uint16(xArgImm8u),
-	/*3385*/ uint16(xMatch),
-	/*3386*/ uint16(xCondIs64), 3389, 3413,
-	/*3389*/ uint16(xCondPrefix), 1,
-	0x66, 3393,
-	/*3393*/ uint16(xCondDataSize), 3397, 3405, 0,
-	/*3397*/ uint16(xSetOp), uint16(PINSRD),
-	/*3399*/ uint16(xReadSlashR),
-	/*3400*/ uint16(xReadIb),
-	/*3401*/ uint16(xArgXmm1),
-	/*3402*/ uint16(xArgRM32),
-	/*3403*/ uint16(xArgImm8u),
-	/*3404*/ uint16(xMatch),
-	/*3405*/ uint16(xSetOp), uint16(PINSRD),
-	/*3407*/ uint16(xReadSlashR),
-	/*3408*/ uint16(xReadIb),
-	/*3409*/ uint16(xArgXmm1),
-	/*3410*/ uint16(xArgRM32),
-	/*3411*/ uint16(xArgImm8u),
-	/*3412*/ uint16(xMatch),
-	/*3413*/ uint16(xCondPrefix), 1,
-	0x66, 3417,
-	/*3417*/ uint16(xCondDataSize), 3397, 3405, 3421,
-	/*3421*/ uint16(xSetOp), uint16(PINSRQ),
+	/*3374*/ uint16(xSetOp), uint16(SHA1MSG1),
+	/*3376*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*3378*/ uint16(xReadSlashR),
+	/*3379*/ uint16(xArgXmm1),
+	/*3380*/ uint16(xArgXmm2M128),
+	/*3381*/ uint16(xMatch),
+	/*3382*/ uint16(xSetOp), uint16(SHA1MSG2),
+	/*3384*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*3386*/ uint16(xReadSlashR),
+	/*3387*/ uint16(xArgXmm1),
+	/*3388*/ uint16(xArgXmm2M128),
+	/*3389*/ uint16(xMatch),
+	/*3390*/ uint16(xSetOp), uint16(SHA256RNDS2),
+	/*3392*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*3394*/ uint16(xReadSlashR),
+	/*3395*/ uint16(xArgXmm1),
+	/*3396*/ uint16(xArgXmm2M128),
+	/*3397*/ uint16(xArgXMM0),
+	/*3398*/ uint16(xMatch),
+	/*3399*/ uint16(xSetOp), uint16(SHA256MSG1),
+	/*3401*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*3403*/ uint16(xReadSlashR),
+	/*3404*/ uint16(xArgXmm1),
+	/*3405*/ uint16(xArgXmm2M128),
+	/*3406*/ uint16(xMatch),
+	/*3407*/ uint16(xSetOp), uint16(SHA256MSG2),
+	/*3409*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*3411*/ uint16(xReadSlashR),
+	/*3412*/ uint16(xArgXmm1),
+	/*3413*/ uint16(xArgXmm2M128),
+	/*3414*/ uint16(xMatch),
+	/*3415*/ uint16(xCondPrefix), 1,
+	0x66, 3419,
+	/*3419*/ uint16(xSetOp), uint16(GF2P8MULB),
+	/*3421*/ uint16(xSetFeature), uint16(FeatureGFNI),
 	/*3423*/ uint16(xReadSlashR),
-	/*3424*/ uint16(xReadIb),
-	/*3425*/ uint16(xArgXmm1),
-	/*3426*/ uint16(xArgRM64),
-	/*3427*/ uint16(xArgImm8u),
-	/*3428*/ uint16(xMatch),
-	/*3429*/ uint16(xCondPrefix), 1,
-	0x66, 3433,
-	/*3433*/ uint16(xSetOp), uint16(DPPS),
-	/*3435*/ uint16(xReadSlashR),
-	/*3436*/ uint16(xReadIb),
-	/*3437*/ uint16(xArgXmm1),
-	/*3438*/ uint16(xArgXmm2M128),
-	/*3439*/ uint16(xArgImm8u),
-	/*3440*/ uint16(xMatch),
-	/*3441*/ uint16(xCondPrefix), 1,
-	0x66, 3445,
-	/*3445*/ uint16(xSetOp), uint16(DPPD),
-	/*3447*/ uint16(xReadSlashR),
-	/*3448*/ uint16(xReadIb),
-	/*3449*/ uint16(xArgXmm1),
-	/*3450*/ uint16(xArgXmm2M128),
-	/*3451*/ uint16(xArgImm8u),
-	/*3452*/ uint16(xMatch),
-	/*3453*/ uint16(xCondPrefix), 1,
-	0x66, 3457,
-	/*3457*/ uint16(xSetOp), uint16(MPSADBW),
-	/*3459*/ uint16(xReadSlashR),
-	/*3460*/ uint16(xReadIb),
-	/*3461*/ uint16(xArgXmm1),
-	/*3462*/ uint16(xArgXmm2M128),
-	/*3463*/ uint16(xArgImm8u),
-	/*3464*/ uint16(xMatch),
-	/*3465*/ uint16(xCondPrefix), 1,
-	0x66, 3469,
-	/*3469*/ uint16(xSetOp), uint16(PCLMULQDQ),
-	/*3471*/ uint16(xReadSlashR),
-	/*3472*/ uint16(xReadIb),
-	/*3473*/ uint16(xArgXmm1),
-	/*3474*/ uint16(xArgXmm2M128),
-	/*3475*/ uint16(xArgImm8u),
-	/*3476*/ uint16(xMatch),
-	/*3477*/ uint16(xCondPrefix), 1,
-	0x66, 3481,
-	/*3481*/ uint16(xSetOp), uint16(PCMPESTRM),
-	/*3483*/ uint16(xReadSlashR),
-	/*3484*/ uint16(xReadIb),
-	/*3485*/ uint16(xArgXmm1),
-	/*3486*/ uint16(xArgXmm2M128),
-	/*3487*/ uint16(xArgImm8u),
-	/*3488*/ uint16(xMatch),
-	/*3489*/ uint16(xCondPrefix), 1,
-	0x66, 3493,
-	/*3493*/ uint16(xSetOp), uint16(PCMPESTRI),
-	/*3495*/ uint16(xReadSlashR),
-	/*3496*/ uint16(xReadIb),
-	/*3497*/ uint16(xArgXmm1),
-	/*3498*/ uint16(xArgXmm2M128),
-	/*3499*/ uint16(xArgImm8u),
-	/*3500*/ uint16(xMatch),
-	/*3501*/ uint16(xCondPrefix), 1,
-	0x66, 3505,
-	/*3505*/ uint16(xSetOp), uint16(PCMPISTRM),
-	/*3507*/ uint16(xReadSlashR),
-	/*3508*/ uint16(xReadIb),
-	/*3509*/ uint16(xArgXmm1),
-	/*3510*/ uint16(xArgXmm2M128),
-	/*3511*/ uint16(xArgImm8u),
-	/*3512*/ uint16(xMatch),
-	/*3513*/ uint16(xCondPrefix), 1,
-	0x66, 3517,
-	/*3517*/ uint16(xSetOp), uint16(PCMPISTRI),
-	/*3519*/ uint16(xReadSlashR),
-	/*3520*/ uint16(xReadIb),
-	/*3521*/ uint16(xArgXmm1),
-	/*3522*/ uint16(xArgXmm2M128),
-	/*3523*/ uint16(xArgImm8u),
-	/*3524*/ uint16(xMatch),
-	/*3525*/ uint16(xSetOp), uint16(SHA1RNDS4),
-	/*3527*/ uint16(xReadSlashR),
-	/*3528*/ uint16(xReadIb),
-	/*3529*/ uint16(xArgXmm1),
-	/*3530*/ uint16(xArgXmm2M128),
-	/*3531*/ uint16(xArgImm8u),
+	/*3424*/ uint16(xArgXmm1),
+	/*3425*/ uint16(xArgXmm2M128),
+	/*3426*/ uint16(xMatch),
+	/*3427*/ uint16(xCondSlashR),
+	3436, // 0
+	3451, // 1
+	3466, // 2
+	3481, // 3
+	0,    // 4
+	0,    // 5
+	0,    // 6
+	0,    // 7
+	/*3436*/ uint16(xCondPrefix), 1,
+	0xF3, 3440,
+	/*3440*/ uint16(xCondIsMem), 0, 3443,
+	/*3443*/ uint16(xSetOp), uint16(AESENCWIDE128KL),
+	/*3445*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3447*/ uint16(xSetFeature), uint16(FeatureWIDE_KL),
+	/*3449*/ uint16(xArgM),
+	/*3450*/ uint16(xMatch),
+	/*3451*/ uint16(xCondPrefix), 1,
+	0xF3, 3455,
+	/*3455*/ uint16(xCondIsMem), 0, 3458,
+	/*3458*/ uint16(xSetOp), uint16(AESDECWIDE128KL),
+	/*3460*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3462*/ uint16(xSetFeature), uint16(FeatureWIDE_KL),
+	/*3464*/ uint16(xArgM),
+	/*3465*/ uint16(xMatch),
+	/*3466*/ uint16(xCondPrefix), 1,
+	0xF3, 3470,
+	/*3470*/ uint16(xCondIsMem), 0, 3473,
+	/*3473*/ uint16(xSetOp), uint16(AESENCWIDE256KL),
+	/*3475*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3477*/ uint16(xSetFeature), uint16(FeatureWIDE_KL),
+	/*3479*/ uint16(xArgM),
+	/*3480*/ uint16(xMatch),
+	/*3481*/ uint16(xCondPrefix), 1,
+	0xF3, 3485,
+	/*3485*/ uint16(xCondIsMem), 0, 3488,
+	/*3488*/ uint16(xSetOp), uint16(AESDECWIDE256KL),
+	/*3490*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3492*/ uint16(xSetFeature), uint16(FeatureWIDE_KL),
+	/*3494*/ uint16(xArgM),
+	/*3495*/ uint16(xMatch),
+	/*3496*/ uint16(xCondPrefix), 1,
+	0x66, 3500,
+	/*3500*/ uint16(xSetOp), uint16(AESIMC),
+	/*3502*/ uint16(xSetFeature), uint16(FeatureAES),
+	/*3504*/ uint16(xReadSlashR),
+	/*3505*/ uint16(xArgXmm1),
+	/*3506*/ uint16(xArgXmm2M128),
+	/*3507*/ uint16(xMatch),
+	/*3508*/ uint16(xCondPrefix), 2,
+	0xF3, 3522,
+	0x66, 3514,
+	/*3514*/ uint16(xSetOp), uint16(AESENC),
+	/*3516*/ uint16(xSetFeature), uint16(FeatureAES),
+	/*3518*/ uint16(xReadSlashR),
+	/*3519*/ uint16(xArgXmm1),
+	/*3520*/ uint16(xArgXmm2M128),
+	/*3521*/ uint16(xMatch),
+	/*3522*/ uint16(xCondIsMem), 3525, 3533,
+	/*3525*/ uint16(xSetOp), uint16(LOADIWKEY),
+	/*3527*/ uint16(xSetFeature), uint16(FeatureKL),
+	/*3529*/ uint16(xReadSlashR),
+	/*3530*/ uint16(xArgXmm1),
+	/*3531*/ uint16(xArgXmm2),
 	/*3532*/ uint16(xMatch),
-	/*3533*/ uint16(xCondPrefix), 1,
-	0x66, 3537,
-	/*3537*/ uint16(xSetOp), uint16(AESKEYGENASSIST),
-	/*3539*/ uint16(xReadSlashR),
-	/*3540*/ uint16(xReadIb),
-	/*3541*/ uint16(xArgXmm1),
-	/*3542*/ uint16(xArgXmm2M128),
-	/*3543*/ uint16(xArgImm8u),
-	/*3544*/ uint16(xMatch),
-	/*3545*/ uint16(xCondIs64), 3548, 3564,
-	/*3548*/ uint16(xCondDataSize), 3552, 3558, 0,
-	/*3552*/ uint16(xSetOp), uint16(CMOVO),
-	/*3554*/ uint16(xReadSlashR),
-	/*3555*/ uint16(xArgR16),
-	/*3556*/ uint16(xArgRM16),
-	/*3557*/ uint16(xMatch),
-	/*3558*/ uint16(xSetOp), uint16(CMOVO),
-	/*3560*/ uint16(xReadSlashR),
-	/*3561*/ uint16(xArgR32),
-	/*3562*/ uint16(xArgRM32),
-	/*3563*/ uint16(xMatch),
-	/*3564*/ uint16(xCondDataSize), 3552, 3558, 3568,
-	/*3568*/ uint16(xSetOp), uint16(CMOVO),
-	/*3570*/ uint16(xReadSlashR),
-	/*3571*/ uint16(xArgR64),
-	/*3572*/ uint16(xArgRM64),
-	/*3573*/ uint16(xMatch),
-	/*3574*/ uint16(xCondIs64), 3577, 3593,
-	/*3577*/ uint16(xCondDataSize), 3581, 3587, 0,
-	/*3581*/ uint16(xSetOp), uint16(CMOVNO),
-	/*3583*/ uint16(xReadSlashR),
-	/*3584*/ uint16(xArgR16),
-	/*3585*/ uint16(xArgRM16),
-	/*3586*/ uint16(xMatch),
-	/*3587*/ uint16(xSetOp), uint16(CMOVNO),
-	/*3589*/ uint16(xReadSlashR),
-	/*3590*/ uint16(xArgR32),
-	/*3591*/ uint16(xArgRM32),
-	/*3592*/ uint16(xMatch),
-	/*3593*/ uint16(xCondDataSize), 3581, 3587, 3597,
-	/*3597*/ uint16(xSetOp), uint16(CMOVNO),
-	/*3599*/ uint16(xReadSlashR),
-	/*3600*/ uint16(xArgR64),
-	/*3601*/ uint16(xArgRM64),
-	/*3602*/ uint16(xMatch),
-	/*3603*/ uint16(xCondIs64), 3606, 3622,
-	/*3606*/ uint16(xCondDataSize), 3610, 3616, 0,
-	/*3610*/ uint16(xSetOp), uint16(CMOVB),
+	/*3533*/ uint16(xSetOp), uint16(AESENC128KL),
+	/*3535*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3537*/ uint16(xReadSlashR),
+	/*3538*/ uint16(xArgXmm1),
+	/*3539*/ uint16(xArgM),
+	/*3540*/ uint16(xMatch),
+	/*3541*/ uint16(xCondPrefix), 2,
+	0xF3, 3555,
+	0x66, 3547,
+	/*3547*/ uint16(xSetOp), uint16(AESENCLAST),
+	/*3549*/ uint16(xSetFeature), uint16(FeatureAES),
+	/*3551*/ uint16(xReadSlashR),
+	/*3552*/ uint16(xArgXmm1),
+	/*3553*/ uint16(xArgXmm2M128),
+	/*3554*/ uint16(xMatch),
+	/*3555*/ uint16(xCondIsMem), 0, 3558,
+	/*3558*/ uint16(xSetOp), uint16(AESDEC128KL),
+	/*3560*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3562*/ uint16(xReadSlashR),
+	/*3563*/ uint16(xArgXmm1),
+	/*3564*/ uint16(xArgM),
+	/*3565*/ uint16(xMatch),
+	/*3566*/ uint16(xCondPrefix), 2,
+	0xF3, 3580,
+	0x66, 3572,
+	/*3572*/ uint16(xSetOp), uint16(AESDEC),
+	/*3574*/ uint16(xSetFeature), uint16(FeatureAES),
+	/*3576*/ uint16(xReadSlashR),
+	/*3577*/ uint16(xArgXmm1),
+	/*3578*/ uint16(xArgXmm2M128),
+	/*3579*/ uint16(xMatch),
+	/*3580*/ uint16(xCondIsMem), 0, 3583,
+	/*3583*/ uint16(xSetOp), uint16(AESENC256KL),
+	/*3585*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3587*/ uint16(xReadSlashR),
+	/*3588*/ uint16(xArgXmm1),
+	/*3589*/ uint16(xArgM),
+	/*3590*/ uint16(xMatch),
+	/*3591*/ uint16(xCondPrefix), 2,
+	0xF3, 3605,
+	0x66, 3597,
+	/*3597*/ uint16(xSetOp), uint16(AESDECLAST),
+	/*3599*/ uint16(xSetFeature), uint16(FeatureAES),
+	/*3601*/ uint16(xReadSlashR),
+	/*3602*/ uint16(xArgXmm1),
+	/*3603*/ uint16(xArgXmm2M128),
+	/*3604*/ uint16(xMatch),
+	/*3605*/ uint16(xCondIsMem), 0, 3608,
+	/*3608*/ uint16(xSetOp), uint16(AESDEC256KL),
+	/*3610*/ uint16(xSetFeature), uint16(FeatureAESKLE),
 	/*3612*/ uint16(xReadSlashR),
-	/*3613*/ uint16(xArgR16),
-	/*3614*/ uint16(xArgRM16),
+	/*3613*/ uint16(xArgXmm1),
+	/*3614*/ uint16(xArgM),
 	/*3615*/ uint16(xMatch),
-	/*3616*/ uint16(xSetOp), uint16(CMOVB),
-	/*3618*/ uint16(xReadSlashR),
-	/*3619*/ uint16(xArgR32),
-	/*3620*/ uint16(xArgRM32),
-	/*3621*/ uint16(xMatch),
-	/*3622*/ uint16(xCondDataSize), 3610, 3616, 3626,
-	/*3626*/ uint16(xSetOp), uint16(CMOVB),
-	/*3628*/ uint16(xReadSlashR),
-	/*3629*/ uint16(xArgR64),
-	/*3630*/ uint16(xArgRM64),
-	/*3631*/ uint16(xMatch),
-	/*3632*/ uint16(xCondIs64), 3635, 3651,
-	/*3635*/ uint16(xCondDataSize), 3639, 3645, 0,
-	/*3639*/ uint16(xSetOp), uint16(CMOVAE),
+	/*3616*/ uint16(xCondIs64), 3619, 3665,
+	/*3619*/ uint16(xCondPrefix), 2,
+	0xF2, 3645,
+	0x0, 3625,
+	/*3625*/ uint16(xCondDataSize), 3629, 3637, 0,
+	/*3629*/ uint16(xSetOp), uint16(MOVBE),
+	/*3631*/ uint16(xSetFeature), uint16(FeatureMOVBE),
+	/*3633*/ uint16(xReadSlashR),
+	/*3634*/ uint16(xArgR16),
+	/*3635*/ uint16(xArgM16),
+	/*3636*/ uint16(xMatch),
+	/*3637*/ uint16(xSetOp), uint16(MOVBE),
+	/*3639*/ uint16(xSetFeature), uint16(FeatureMOVBE),
 	/*3641*/ uint16(xReadSlashR),
-	/*3642*/ uint16(xArgR16),
-	/*3643*/ uint16(xArgRM16),
+	/*3642*/ uint16(xArgR32),
+	/*3643*/ uint16(xArgM32),
 	/*3644*/ uint16(xMatch),
-	/*3645*/ uint16(xSetOp), uint16(CMOVAE),
-	/*3647*/ uint16(xReadSlashR),
-	/*3648*/ uint16(xArgR32),
-	/*3649*/ uint16(xArgRM32),
-	/*3650*/ uint16(xMatch),
-	/*3651*/ uint16(xCondDataSize), 3639, 3645, 3655,
-	/*3655*/ uint16(xSetOp), uint16(CMOVAE),
-	/*3657*/ uint16(xReadSlashR),
-	/*3658*/ uint16(xArgR64),
-	/*3659*/ uint16(xArgRM64),
-	/*3660*/ uint16(xMatch),
-	/*3661*/ uint16(xCondIs64), 3664, 3680,
-	/*3664*/ uint16(xCondDataSize), 3668, 3674, 0,
-	/*3668*/ uint16(xSetOp), uint16(CMOVE),
-	/*3670*/ uint16(xReadSlashR),
-	/*3671*/ uint16(xArgR16),
-	/*3672*/ uint16(xArgRM16),
-	/*3673*/ uint16(xMatch),
-	/*3674*/ uint16(xSetOp), uint16(CMOVE),
-	/*3676*/ uint16(xReadSlashR),
-	/*3677*/ uint16(xArgR32),
-	/*3678*/ uint16(xArgRM32),
-	/*3679*/ uint16(xMatch),
-	/*3680*/ uint16(xCondDataSize), 3668, 3674, 3684,
-	/*3684*/ uint16(xSetOp), uint16(CMOVE),
-	/*3686*/ uint16(xReadSlashR),
-	/*3687*/ uint16(xArgR64),
-	/*3688*/ uint16(xArgRM64),
-	/*3689*/ uint16(xMatch),
-	/*3690*/ uint16(xCondIs64), 3693, 3709,
-	/*3693*/ uint16(xCondDataSize), 3697, 3703, 0,
-	/*3697*/ uint16(xSetOp), uint16(CMOVNE),
-	/*3699*/ uint16(xReadSlashR),
-	/*3700*/ uint16(xArgR16),
-	/*3701*/ uint16(xArgRM16),
-	/*3702*/ uint16(xMatch),
-	/*3703*/ uint16(xSetOp), uint16(CMOVNE),
-	/*3705*/ uint16(xReadSlashR),
-	/*3706*/ uint16(xArgR32),
-	/*3707*/ uint16(xArgRM32),
-	/*3708*/ uint16(xMatch),
-	/*3709*/ uint16(xCondDataSize), 3697, 3703, 3713,
-	/*3713*/ uint16(xSetOp), uint16(CMOVNE),
-	/*3715*/ uint16(xReadSlashR),
-	/*3716*/ uint16(xArgR64),
-	/*3717*/ uint16(xArgRM64),
-	/*3718*/ uint16(xMatch),
-	/*3719*/ uint16(xCondIs64), 3722, 3738,
-	/*3722*/ uint16(xCondDataSize), 3726, 3732, 0,
-	/*3726*/ uint16(xSetOp), uint16(CMOVBE),
-	/*3728*/ uint16(xReadSlashR),
-	/*3729*/ uint16(xArgR16),
-	/*3730*/ uint16(xArgRM16),
-	/*3731*/ uint16(xMatch),
-	/*3732*/ uint16(xSetOp), uint16(CMOVBE),
-	/*3734*/ uint16(xReadSlashR),
-	/*3735*/ uint16(xArgR32),
-	/*3736*/ uint16(xArgRM32),
-	/*3737*/ uint16(xMatch),
-	/*3738*/ uint16(xCondDataSize), 3726, 3732, 3742,
-	/*3742*/ uint16(xSetOp), uint16(CMOVBE),
-	/*3744*/ uint16(xReadSlashR),
-	/*3745*/ uint16(xArgR64),
-	/*3746*/ uint16(xArgRM64),
-	/*3747*/ uint16(xMatch),
-	/*3748*/ uint16(xCondIs64), 3751, 3767,
-	/*3751*/ uint16(xCondDataSize), 3755, 3761, 0,
-	/*3755*/ uint16(xSetOp), uint16(CMOVA),
-	/*3757*/ uint16(xReadSlashR),
-	/*3758*/ uint16(xArgR16),
-	/*3759*/ uint16(xArgRM16),
-	/*3760*/ uint16(xMatch),
-	/*3761*/ uint16(xSetOp), uint16(CMOVA),
-	/*3763*/ uint16(xReadSlashR),
-	/*3764*/ uint16(xArgR32),
-	/*3765*/ uint16(xArgRM32),
-	/*3766*/ uint16(xMatch),
-	/*3767*/ uint16(xCondDataSize), 3755, 3761, 3771,
-	/*3771*/ uint16(xSetOp), uint16(CMOVA),
-	/*3773*/ uint16(xReadSlashR),
-	/*3774*/ uint16(xArgR64),
-	/*3775*/ uint16(xArgRM64),
-	/*3776*/ uint16(xMatch),
-	/*3777*/ uint16(xCondIs64), 3780, 3796,
-	/*3780*/ uint16(xCondDataSize), 3784, 3790, 0,
-	/*3784*/ uint16(xSetOp), uint16(CMOVS),
-	/*3786*/ uint16(xReadSlashR),
-	/*3787*/ uint16(xArgR16),
-	/*3788*/ uint16(xArgRM16),
-	/*3789*/ uint16(xMatch),
-	/*3790*/ uint16(xSetOp), uint16(CMOVS),
+	/*3645*/ uint16(xCondDataSize), 3649, 3657, 0,
+	/*3649*/ uint16(xSetOp), uint16(CRC32),
+	/*3651*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3653*/ uint16(xReadSlashR),
+	/*3654*/ uint16(xArgR32),
+	/*3655*/ uint16(xArgRM8),
+	/*3656*/ uint16(xMatch),
+	/*3657*/ uint16(xSetOp), uint16(CRC32),
+	/*3659*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3661*/ uint16(xReadSlashR),
+	/*3662*/ uint16(xArgR32),
+	/*3663*/ uint16(xArgRM8),
+	/*3664*/ uint16(xMatch),
+	/*3665*/ uint16(xCondPrefix), 2,
+	0xF2, 3683,
+	0x0, 3671,
+	/*3671*/ uint16(xCondDataSize), 3629, 3637, 3675,
+	/*3675*/ uint16(xSetOp), uint16(MOVBE),
+	/*3677*/ uint16(xSetFeature), uint16(FeatureMOVBE),
+	/*3679*/ uint16(xReadSlashR),
+	/*3680*/ uint16(xArgR64),
+	/*3681*/ uint16(xArgM64),
+	/*3682*/ uint16(xMatch),
+	/*3683*/ uint16(xCondDataSize), 3649, 3657, 3687,
+	/*3687*/ uint16(xSetOp), uint16(CRC32),
+	/*3689*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3691*/ uint16(xReadSlashR),
+	/*3692*/ uint16(xArgR64),
+	/*3693*/ uint16(xArgRM8),
+	/*3694*/ uint16(xMatch),
+	/*3695*/ uint16(xCondIs64), 3698, 3744,
+	/*3698*/ uint16(xCondPrefix), 2,
+	0xF2, 3724,
+	0x0, 3704,
+	/*3704*/ uint16(xCondDataSize), 3708, 3716, 0,
+	/*3708*/ uint16(xSetOp), uint16(MOVBE),
+	/*3710*/ uint16(xSetFeature), uint16(FeatureMOVBE),
+	/*3712*/ uint16(xReadSlashR),
+	/*3713*/ uint16(xArgM16),
+	/*3714*/ uint16(xArgR16),
+	/*3715*/ uint16(xMatch),
+	/*3716*/ uint16(xSetOp), uint16(MOVBE),
+	/*3718*/ uint16(xSetFeature), uint16(FeatureMOVBE),
+	/*3720*/ uint16(xReadSlashR),
+	/*3721*/ uint16(xArgM32),
+	/*3722*/ uint16(xArgR32),
+	/*3723*/ uint16(xMatch),
+	/*3724*/ uint16(xCondDataSize), 3728, 3736, 0,
+	/*3728*/ uint16(xSetOp), uint16(CRC32),
+	/*3730*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3732*/ uint16(xReadSlashR),
+	/*3733*/ uint16(xArgR32),
+	/*3734*/ uint16(xArgRM16),
+	/*3735*/ uint16(xMatch),
+	/*3736*/ uint16(xSetOp), uint16(CRC32),
+	/*3738*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3740*/ uint16(xReadSlashR),
+	/*3741*/ uint16(xArgR32),
+	/*3742*/ uint16(xArgRM32),
+	/*3743*/ uint16(xMatch),
+	/*3744*/ uint16(xCondPrefix), 2,
+	0xF2, 3762,
+	0x0, 3750,
+	/*3750*/ uint16(xCondDataSize), 3708, 3716, 3754,
+	/*3754*/ uint16(xSetOp), uint16(MOVBE),
+	/*3756*/ uint16(xSetFeature), uint16(FeatureMOVBE),
+	/*3758*/ uint16(xReadSlashR),
+	/*3759*/ uint16(xArgM64),
+	/*3760*/ uint16(xArgR64),
+	/*3761*/ uint16(xMatch),
+	/*3762*/ uint16(xCondDataSize), 3728, 3736, 3766,
+	/*3766*/ uint16(xSetOp), uint16(CRC32),
+	/*3768*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*3770*/ uint16(xReadSlashR),
+	/*3771*/ uint16(xArgR64),
+	/*3772*/ uint16(xArgRM64),
+	/*3773*/ uint16(xMatch),
+	/*3774*/ uint16(xCondIs64), 3777, 3807,
+	/*3777*/ uint16(xCondPrefix), 1,
+	0x66, 3781,
+	/*3781*/ uint16(xCondDataSize), 3785, 3796, 0,
+	/*3785*/ uint16(xCondIsMem), 0, 3788,
+	/*3788*/ uint16(xSetOp), uint16(WRUSSD),
+	/*3790*/ uint16(xSetFeature), uint16(FeatureCET_SS),
 	/*3792*/ uint16(xReadSlashR),
-	/*3793*/ uint16(xArgR32),
-	/*3794*/ uint16(xArgRM32),
+	/*3793*/ uint16(xArgM32),
+	/*3794*/ uint16(xArgR32),
 	/*3795*/ uint16(xMatch),
-	/*3796*/ uint16(xCondDataSize), 3784, 3790, 3800,
-	/*3800*/ uint16(xSetOp), uint16(CMOVS),
-	/*3802*/ uint16(xReadSlashR),
-	/*3803*/ uint16(xArgR64),
-	/*3804*/ uint16(xArgRM64),
-	/*3805*/ uint16(xMatch),
-	/*3806*/ uint16(xCondIs64), 3809, 3825,
-	/*3809*/ uint16(xCondDataSize), 3813, 3819, 0,
-	/*3813*/ uint16(xSetOp), uint16(CMOVNS),
-	/*3815*/ uint16(xReadSlashR),
-	/*3816*/ uint16(xArgR16),
-	/*3817*/ uint16(xArgRM16),
-	/*3818*/ uint16(xMatch),
-	/*3819*/ uint16(xSetOp), uint16(CMOVNS),
-	/*3821*/ uint16(xReadSlashR),
-	/*3822*/ uint16(xArgR32),
-	/*3823*/ uint16(xArgRM32),
-	/*3824*/ uint16(xMatch),
-	/*3825*/ uint16(xCondDataSize), 3813, 3819, 3829,
-	/*3829*/ uint16(xSetOp), uint16(CMOVNS),
-	/*3831*/ uint16(xReadSlashR),
-	/*3832*/ uint16(xArgR64),
-	/*3833*/ uint16(xArgRM64),
-	/*3834*/ uint16(xMatch),
-	/*3835*/ uint16(xCondIs64), 3838, 3854,
-	/*3838*/ uint16(xCondDataSize), 3842, 3848, 0,
-	/*3842*/ uint16(xSetOp), uint16(CMOVP),
-	/*3844*/ uint16(xReadSlashR),
-	/*3845*/ uint16(xArgR16),
-	/*3846*/ uint16(xArgRM16),
-	/*3847*/ uint16(xMatch),
-	/*3848*/ uint16(xSetOp), uint16(CMOVP),
-	/*3850*/ uint16(xReadSlashR),
-	/*3851*/ uint16(xArgR32),
-	/*3852*/ uint16(xArgRM32),
-	/*3853*/ uint16(xMatch),
-	/*3854*/ uint16(xCondDataSize), 3842, 3848, 3858,
-	/*3858*/ uint16(xSetOp), uint16(CMOVP),
-	/*3860*/ uint16(xReadSlashR),
-	/*3861*/ uint16(xArgR64),
-	/*3862*/ uint16(xArgRM64),
-	/*3863*/ uint16(xMatch),
-	/*3864*/ uint16(xCondIs64), 3867, 3883,
-	/*3867*/ uint16(xCondDataSize), 3871, 3877, 0,
-	/*3871*/ uint16(xSetOp), uint16(CMOVNP),
-	/*3873*/ uint16(xReadSlashR),
-	/*3874*/ uint16(xArgR16),
-	/*3875*/ uint16(xArgRM16),
-	/*3876*/ uint16(xMatch),
-	/*3877*/ uint16(xSetOp), uint16(CMOVNP),
-	/*3879*/ uint16(xReadSlashR),
-	/*3880*/ uint16(xArgR32),
-	/*3881*/ uint16(xArgRM32),
-	/*3882*/ uint16(xMatch),
-	/*3883*/ uint16(xCondDataSize), 3871, 3877, 3887,
-	/*3887*/ uint16(xSetOp), uint16(CMOVNP),
-	/*3889*/ uint16(xReadSlashR),
-	/*3890*/ uint16(xArgR64),
-	/*3891*/ uint16(xArgRM64),
-	/*3892*/ uint16(xMatch),
-	/*3893*/ uint16(xCondIs64), 3896, 3912,
-	/*3896*/ uint16(xCondDataSize), 3900, 3906, 0,
-	/*3900*/ uint16(xSetOp), uint16(CMOVL),
-	/*3902*/ uint16(xReadSlashR),
-	/*3903*/ uint16(xArgR16),
-	/*3904*/ uint16(xArgRM16),
-	/*3905*/ uint16(xMatch),
-	/*3906*/ uint16(xSetOp), uint16(CMOVL),
-	/*3908*/ uint16(xReadSlashR),
-	/*3909*/ uint16(xArgR32),
-	/*3910*/ uint16(xArgRM32),
-	/*3911*/ uint16(xMatch),
-	/*3912*/ uint16(xCondDataSize), 3900, 3906, 3916,
-	/*3916*/ uint16(xSetOp), uint16(CMOVL),
-	/*3918*/ uint16(xReadSlashR),
-	/*3919*/ uint16(xArgR64),
-	/*3920*/ uint16(xArgRM64),
-	/*3921*/ uint16(xMatch),
-	/*3922*/ uint16(xCondIs64), 3925, 3941,
-	/*3925*/ uint16(xCondDataSize), 3929, 3935, 0,
-	/*3929*/ uint16(xSetOp), uint16(CMOVGE),
-	/*3931*/ uint16(xReadSlashR),
-	/*3932*/ uint16(xArgR16),
-	/*3933*/ uint16(xArgRM16),
-	/*3934*/ uint16(xMatch),
-	/*3935*/ uint16(xSetOp), uint16(CMOVGE),
-	/*3937*/ uint16(xReadSlashR),
-	/*3938*/ uint16(xArgR32),
-	/*3939*/ uint16(xArgRM32),
-	/*3940*/ uint16(xMatch),
-	/*3941*/ uint16(xCondDataSize), 3929, 3935, 3945,
-	/*3945*/ uint16(xSetOp), uint16(CMOVGE),
-	/*3947*/ uint16(xReadSlashR),
-	/*3948*/ uint16(xArgR64),
-	/*3949*/ uint16(xArgRM64),
-	/*3950*/ uint16(xMatch),
-	/*3951*/ uint16(xCondIs64), 3954, 3970,
-	/*3954*/ uint16(xCondDataSize), 3958, 3964, 0,
-	/*3958*/ uint16(xSetOp), uint16(CMOVLE),
-	/*3960*/ uint16(xReadSlashR),
-	/*3961*/ uint16(xArgR16),
-	/*3962*/ uint16(xArgRM16),
-	/*3963*/ uint16(xMatch),
-	/*3964*/ uint16(xSetOp), uint16(CMOVLE),
-	/*3966*/ uint16(xReadSlashR),
-	/*3967*/ uint16(xArgR32),
-	/*3968*/ uint16(xArgRM32),
-	/*3969*/ uint16(xMatch),
-	/*3970*/ uint16(xCondDataSize), 3958, 3964, 3974,
-	/*3974*/ uint16(xSetOp), uint16(CMOVLE),
-	/*3976*/ uint16(xReadSlashR),
-	/*3977*/ uint16(xArgR64),
-	/*3978*/ uint16(xArgRM64),
-	/*3979*/ uint16(xMatch),
-	/*3980*/ uint16(xCondIs64), 3983, 3999,
-	/*3983*/ uint16(xCondDataSize), 3987, 3993, 0,
-	/*3987*/ uint16(xSetOp), uint16(CMOVG),
-	/*3989*/ uint16(xReadSlashR),
-	/*3990*/ uint16(xArgR16),
-	/*3991*/ uint16(xArgRM16),
-	/*3992*/ uint16(xMatch),
-	/*3993*/ uint16(xSetOp), uint16(CMOVG),
-	/*3995*/ uint16(xReadSlashR),
-	/*3996*/ uint16(xArgR32),
-	/*3997*/ uint16(xArgRM32),
+	/*3796*/ uint16(xCondIsMem), 0, 3799,
+	/*3799*/ uint16(xSetOp), uint16(WRUSSD),
+	/*3801*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*3803*/ uint16(xReadSlashR),
+	/*3804*/ uint16(xArgM32),
+	/*3805*/ uint16(xArgR32),
+	/*3806*/ uint16(xMatch),
+	/*3807*/ uint16(xCondPrefix), 1,
+	0x66, 3811,
+	/*3811*/ uint16(xCondDataSize), 3785, 3796, 3815,
+	/*3815*/ uint16(xCondIsMem), 0, 3818,
+	/*3818*/ uint16(xSetOp), uint16(WRUSSQ),
+	/*3820*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*3822*/ uint16(xReadSlashR),
+	/*3823*/ uint16(xArgM64),
+	/*3824*/ uint16(xArgR64),
+	/*3825*/ uint16(xMatch),
+	/*3826*/ uint16(xCondIs64), 3829, 3855,
+	/*3829*/ uint16(xCondDataSize), 3833, 3844, 0,
+	/*3833*/ uint16(xCondIsMem), 0, 3836,
+	/*3836*/ uint16(xSetOp), uint16(WRSSD),
+	/*3838*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*3840*/ uint16(xReadSlashR),
+	/*3841*/ uint16(xArgM32),
+	/*3842*/ uint16(xArgR32),
+	/*3843*/ uint16(xMatch),
+	/*3844*/ uint16(xCondIsMem), 0, 3847,
+	/*3847*/ uint16(xSetOp), uint16(WRSSD),
+	/*3849*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*3851*/ uint16(xReadSlashR),
+	/*3852*/ uint16(xArgM32),
+	/*3853*/ uint16(xArgR32),
+	/*3854*/ uint16(xMatch),
+	/*3855*/ uint16(xCondDataSize), 3833, 3844, 3859,
+	/*3859*/ uint16(xCondIsMem), 0, 3862,
+	/*3862*/ uint16(xSetOp), uint16(WRSSQ),
+	/*3864*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*3866*/ uint16(xReadSlashR),
+	/*3867*/ uint16(xArgM64),
+	/*3868*/ uint16(xArgR64),
+	/*3869*/ uint16(xMatch),
+	/*3870*/ uint16(xCondPrefix), 1,
+	0xF3, 3874,
+	/*3874*/ uint16(xCondIsMem), 3877, 0,
+	/*3877*/ uint16(xSetOp), uint16(ENCODEKEY128),
+	/*3879*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3881*/ uint16(xReadSlashR),
+	/*3882*/ uint16(xArgR32),
+	/*3883*/ uint16(xArgRmf32),
+	/*3884*/ uint16(xMatch),
+	/*3885*/ uint16(xCondPrefix), 1,
+	0xF3, 3889,
+	/*3889*/ uint16(xCondIsMem), 3892, 0,
+	/*3892*/ uint16(xSetOp), uint16(ENCODEKEY256),
+	/*3894*/ uint16(xSetFeature), uint16(FeatureAESKLE),
+	/*3896*/ uint16(xReadSlashR),
+	/*3897*/ uint16(xArgR32),
+	/*3898*/ uint16(xArgRmf32),
+	/*3899*/ uint16(xMatch),
+	/*3900*/ uint16(xCondByte), 27,
+	0x08, 3957,
+	0x09, 3971,
+	0x0A, 3985,
+	0x0B, 3999,
+	0x0C, 4013,
+	0x0D, 4027,
+	0x0E, 4041,
+	0x0F, 4055,
+	0x14, 4081,
+	0x15, 4095,
+	0x16, 4109,
+	0x17, 4158,
+	0x20, 4172,
+	0x21, 4186,
+	0x22, 4200,
+	0x40, 4249,
+	0x41, 4263,
+	0x42, 4277,
+	0x44, 4291,
+	0x60, 4305,
+	0x61, 4319,
+	0x62, 4333,
+	0x63, 4347,
+	0xCC, 4361,
+	0xCE, 4371,
+	0xCF, 4385,
+	0xDF, 4399,
+	uint16(xFail),
+	/*3957*/ uint16(xCondPrefix), 1,
+	0x66, 3961,
+	/*3961*/ uint16(xSetOp), uint16(ROUNDPS),
+	/*3963*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3965*/ uint16(xReadSlashR),
+	/*3966*/ uint16(xReadIb),
+	/*3967*/ uint16(xArgXmm1),
+	/*3968*/ uint16(xArgXmm2M128),
+	/*3969*/ uint16(xArgImm8u),
+	/*3970*/ uint16(xMatch),
+	/*3971*/ uint16(xCondPrefix), 1,
+	0x66, 3975,
+	/*3975*/ uint16(xSetOp), uint16(ROUNDPD),
+	/*3977*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3979*/ uint16(xReadSlashR),
+	/*3980*/ uint16(xReadIb),
+	/*3981*/ uint16(xArgXmm1),
+	/*3982*/ uint16(xArgXmm2M128),
+	/*3983*/ uint16(xArgImm8u),
+	/*3984*/ uint16(xMatch),
+	/*3985*/ uint16(xCondPrefix), 1,
+	0x66, 3989,
+	/*3989*/ uint16(xSetOp), uint16(ROUNDSS),
+	/*3991*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*3993*/ uint16(xReadSlashR),
+	/*3994*/ uint16(xReadIb),
+	/*3995*/ uint16(xArgXmm1),
+	/*3996*/ uint16(xArgXmm2M32),
+	/*3997*/ uint16(xArgImm8u),
 	/*3998*/ uint16(xMatch),
-	/*3999*/ uint16(xCondDataSize), 3987, 3993, 4003,
-	/*4003*/ uint16(xSetOp), uint16(CMOVG),
-	/*4005*/ uint16(xReadSlashR),
-	/*4006*/ uint16(xArgR64),
-	/*4007*/ uint16(xArgRM64),
-	/*4008*/ uint16(xMatch),
-	/*4009*/ uint16(xCondPrefix), 2,
-	0x66, 4021,
-	0x0, 4015,
-	/*4015*/ uint16(xSetOp), uint16(MOVMSKPS),
-	/*4017*/ uint16(xReadSlashR),
-	/*4018*/ uint16(xArgR32),
-	/*4019*/ uint16(xArgXmm2),
-	/*4020*/ uint16(xMatch),
-	/*4021*/ uint16(xSetOp), uint16(MOVMSKPD),
-	/*4023*/ uint16(xReadSlashR),
-	/*4024*/ uint16(xArgR32),
-	/*4025*/ uint16(xArgXmm2),
+	/*3999*/ uint16(xCondPrefix), 1,
+	0x66, 4003,
+	/*4003*/ uint16(xSetOp), uint16(ROUNDSD),
+	/*4005*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4007*/ uint16(xReadSlashR),
+	/*4008*/ uint16(xReadIb),
+	/*4009*/ uint16(xArgXmm1),
+	/*4010*/ uint16(xArgXmm2M64),
+	/*4011*/ uint16(xArgImm8u),
+	/*4012*/ uint16(xMatch),
+	/*4013*/ uint16(xCondPrefix), 1,
+	0x66, 4017,
+	/*4017*/ uint16(xSetOp), uint16(BLENDPS),
+	/*4019*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4021*/ uint16(xReadSlashR),
+	/*4022*/ uint16(xReadIb),
+	/*4023*/ uint16(xArgXmm1),
+	/*4024*/ uint16(xArgXmm2M128),
+	/*4025*/ uint16(xArgImm8u),
 	/*4026*/ uint16(xMatch),
-	/*4027*/ uint16(xCondPrefix), 4,
-	0xF3, 4055,
-	0xF2, 4049,
-	0x66, 4043,
-	0x0, 4037,
-	/*4037*/ uint16(xSetOp), uint16(SQRTPS),
-	/*4039*/ uint16(xReadSlashR),
-	/*4040*/ uint16(xArgXmm1),
-	/*4041*/ uint16(xArgXmm2M128),
-	/*4042*/ uint16(xMatch),
-	/*4043*/ uint16(xSetOp), uint16(SQRTPD),
-	/*4045*/ uint16(xReadSlashR),
-	/*4046*/ uint16(xArgXmm1),
-	/*4047*/ uint16(xArgXmm2M128),
-	/*4048*/ uint16(xMatch),
-	/*4049*/ uint16(xSetOp), uint16(SQRTSD),
-	/*4051*/ uint16(xReadSlashR),
-	/*4052*/ uint16(xArgXmm1),
-	/*4053*/ uint16(xArgXmm2M64),
+	/*4027*/ uint16(xCondPrefix), 1,
+	0x66, 4031,
+	/*4031*/ uint16(xSetOp), uint16(BLENDPD),
+	/*4033*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4035*/ uint16(xReadSlashR),
+	/*4036*/ uint16(xReadIb),
+	/*4037*/ uint16(xArgXmm1),
+	/*4038*/ uint16(xArgXmm2M128),
+	/*4039*/ uint16(xArgImm8u),
+	/*4040*/ uint16(xMatch),
+	/*4041*/ uint16(xCondPrefix), 1,
+	0x66, 4045,
+	/*4045*/ uint16(xSetOp), uint16(PBLENDW),
+	/*4047*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4049*/ uint16(xReadSlashR),
+	/*4050*/ uint16(xReadIb),
+	/*4051*/ uint16(xArgXmm1),
+	/*4052*/ uint16(xArgXmm2M128),
+	/*4053*/ uint16(xArgImm8u),
 	/*4054*/ uint16(xMatch),
-	/*4055*/ uint16(xSetOp), uint16(SQRTSS),
-	/*4057*/ uint16(xReadSlashR),
-	/*4058*/ uint16(xArgXmm1),
-	/*4059*/ uint16(xArgXmm2M32),
-	/*4060*/ uint16(xMatch),
-	/*4061*/ uint16(xCondPrefix), 2,
-	0xF3, 4073,
-	0x0, 4067,
-	/*4067*/ uint16(xSetOp), uint16(RSQRTPS),
-	/*4069*/ uint16(xReadSlashR),
-	/*4070*/ uint16(xArgXmm1),
-	/*4071*/ uint16(xArgXmm2M128),
-	/*4072*/ uint16(xMatch),
-	/*4073*/ uint16(xSetOp), uint16(RSQRTSS),
+	/*4055*/ uint16(xCondPrefix), 2,
+	0x66, 4071,
+	0x0, 4061,
+	/*4061*/ uint16(xSetOp), uint16(PALIGNR),
+	/*4063*/ uint16(xSetFeature), uint16(FeatureSSSE3),
+	/*4065*/ uint16(xReadSlashR),
+	/*4066*/ uint16(xReadIb),
+	/*4067*/ uint16(xArgMm1),
+	/*4068*/ uint16(xArgMm2M64),
+	/*4069*/ uint16(xArgImm8u),
+	/*4070*/ uint16(xMatch),
+	/*4071*/ uint16(xSetOp), uint16(PALIGNR),
+	/*4073*/ uint16(xSetFeature), uint16(FeatureSSSE3),
 	/*4075*/ uint16(xReadSlashR),
-	/*4076*/ uint16(xArgXmm1),
-	/*4077*/ uint16(xArgXmm2M32),
-	/*4078*/ uint16(xMatch),
-	/*4079*/ uint16(xCondPrefix), 2,
-	0xF3, 4091,
-	0x0, 4085,
-	/*4085*/ uint16(xSetOp), uint16(RCPPS),
-	/*4087*/ uint16(xReadSlashR),
-	/*4088*/ uint16(xArgXmm1),
-	/*4089*/ uint16(xArgXmm2M128),
-	/*4090*/ uint16(xMatch),
-	/*4091*/ uint16(xSetOp), uint16(RCPSS),
-	/*4093*/ uint16(xReadSlashR),
-	/*4094*/ uint16(xArgXmm1),
-	/*4095*/ uint16(xArgXmm2M32),
-	/*4096*/ uint16(xMatch),
-	/*4097*/ uint16(xCondPrefix), 2,
-	0x66, 4109,
-	0x0, 4103,
-	/*4103*/ uint16(xSetOp), uint16(ANDPS),
-	/*4105*/ uint16(xReadSlashR),
+	/*4076*/ uint16(xReadIb),
+	/*4077*/ uint16(xArgXmm1),
+	/*4078*/ uint16(xArgXmm2M128),
+	/*4079*/ uint16(xArgImm8u),
+	/*4080*/ uint16(xMatch),
+	/*4081*/ uint16(xCondPrefix), 1,
+	0x66, 4085,
+	/*4085*/ uint16(xSetOp), uint16(PEXTRB),
+	/*4087*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4089*/ uint16(xReadSlashR),
+	/*4090*/ uint16(xReadIb),
+	/*4091*/ uint16(xArgR32M8),
+	/*4092*/ uint16(xArgXmm1),
+	/*4093*/ uint16(xArgImm8u),
+	/*4094*/ uint16(xMatch),
+	/*4095*/ uint16(xCondPrefix), 1,
+	0x66, 4099,
+	/*4099*/ uint16(xSetOp), uint16(PEXTRW),
+	/*4101*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4103*/ uint16(xReadSlashR),
+	/*4104*/ uint16(xReadIb),
+	/*4105*/ uint16(xArgR32M16),
 	/*4106*/ uint16(xArgXmm1),
-	/*4107*/ uint16(xArgXmm2M128),
+	/*4107*/ uint16(xArgImm8u),
 	/*4108*/ uint16(xMatch),
-	/*4109*/ uint16(xSetOp), uint16(ANDPD),
-	/*4111*/ uint16(xReadSlashR),
-	/*4112*/ uint16(xArgXmm1),
-	/*4113*/ uint16(xArgXmm2M128),
-	/*4114*/ uint16(xMatch),
-	/*4115*/ uint16(xCondPrefix), 2,
-	0x66, 4127,
-	0x0, 4121,
-	/*4121*/ uint16(xSetOp), uint16(ANDNPS),
-	/*4123*/ uint16(xReadSlashR),
-	/*4124*/ uint16(xArgXmm1),
-	/*4125*/ uint16(xArgXmm2M128),
-	/*4126*/ uint16(xMatch),
-	/*4127*/ uint16(xSetOp), uint16(ANDNPD),
-	/*4129*/ uint16(xReadSlashR),
-	/*4130*/ uint16(xArgXmm1),
-	/*4131*/ uint16(xArgXmm2M128),
-	/*4132*/ uint16(xMatch),
-	/*4133*/ uint16(xCondPrefix), 2,
-	0x66, 4145,
-	0x0, 4139,
-	/*4139*/ uint16(xSetOp), uint16(ORPS),
-	/*4141*/ uint16(xReadSlashR),
-	/*4142*/ uint16(xArgXmm1),
-	/*4143*/ uint16(xArgXmm2M128),
-	/*4144*/ uint16(xMatch),
-	/*4145*/ uint16(xSetOp), uint16(ORPD),
-	/*4147*/ uint16(xReadSlashR),
-	/*4148*/ uint16(xArgXmm1),
-	/*4149*/ uint16(xArgXmm2M128),
-	/*4150*/ uint16(xMatch),
-	/*4151*/ uint16(xCondPrefix), 2,
-	0x66, 4163,
-	0x0, 4157,
-	/*4157*/ uint16(xSetOp), uint16(XORPS),
-	/*4159*/ uint16(xReadSlashR),
-	/*4160*/ uint16(xArgXmm1),
-	/*4161*/ uint16(xArgXmm2M128),
-	/*4162*/ uint16(xMatch),
-	/*4163*/ uint16(xSetOp), uint16(XORPD),
-	/*4165*/ uint16(xReadSlashR),
-	/*4166*/ uint16(xArgXmm1),
-	/*4167*/ uint16(xArgXmm2M128),
-	/*4168*/ uint16(xMatch),
-	/*4169*/ uint16(xCondPrefix), 4,
-	0xF3, 4197,
-	0xF2, 4191,
-	0x66, 4185,
-	0x0, 4179,
-	/*4179*/ uint16(xSetOp), uint16(ADDPS),
-	/*4181*/ uint16(xReadSlashR),
+	/*4109*/ uint16(xCondIs64), 4112, 4140,
+	/*4112*/ uint16(xCondPrefix), 1,
+	0x66, 4116,
+	/*4116*/ uint16(xCondDataSize), 4120, 4130, 0,
+	/*4120*/ uint16(xSetOp), uint16(PEXTRD),
+	/*4122*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4124*/ uint16(xReadSlashR),
+	/*4125*/ uint16(xReadIb),
+	/*4126*/ uint16(xArgRM32),
+	/*4127*/ uint16(xArgXmm1),
+	/*4128*/ uint16(xArgImm8u),
+	/*4129*/ uint16(xMatch),
+	/*4130*/ uint16(xSetOp), uint16(PEXTRD),
+	/*4132*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4134*/ uint16(xReadSlashR),
+	/*4135*/ uint16(xReadIb),
+	/*4136*/ uint16(xArgRM32),
+	/*4137*/ uint16(xArgXmm1),
+	/*4138*/ uint16(xArgImm8u),
+	/*4139*/ uint16(xMatch),
+	/*4140*/ uint16(xCondPrefix), 1,
+	0x66, 4144,
+	/*4144*/ uint16(xCondDataSize), 4120, 4130, 4148,
+	/*4148*/ uint16(xSetOp), uint16(PEXTRQ),
+	/*4150*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4152*/ uint16(xReadSlashR),
+	/*4153*/ uint16(xReadIb),
+	/*4154*/ uint16(xArgRM64),
+	/*4155*/ uint16(xArgXmm1),
+	/*4156*/ uint16(xArgImm8u),
+	/*4157*/ uint16(xMatch),
+	/*4158*/ uint16(xCondPrefix), 1,
+	0x66, 4162,
+	/*4162*/ uint16(xSetOp), uint16(EXTRACTPS),
+	/*4164*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4166*/ uint16(xReadSlashR),
+	/*4167*/ uint16(xReadIb),
+	/*4168*/ uint16(xArgRM32),
+	/*4169*/ uint16(xArgXmm1),
+	/*4170*/ uint16(xArgImm8u),
+	/*4171*/ uint16(xMatch),
+	/*4172*/ uint16(xCondPrefix), 1,
+	0x66, 4176,
+	/*4176*/ uint16(xSetOp), uint16(PINSRB),
+	/*4178*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4180*/ uint16(xReadSlashR),
+	/*4181*/ uint16(xReadIb),
 	/*4182*/ uint16(xArgXmm1),
-	/*4183*/ uint16(xArgXmm2M128),
-	/*4184*/ uint16(xMatch),
-	/*4185*/ uint16(xSetOp), uint16(ADDPD),
-	/*4187*/ uint16(xReadSlashR),
-	/*4188*/ uint16(xArgXmm1),
-	/*4189*/ uint16(xArgXmm2M128),
-	/*4190*/ uint16(xMatch),
-	/*4191*/ uint16(xSetOp), uint16(ADDSD),
-	/*4193*/ uint16(xReadSlashR),
-	/*4194*/ uint16(xArgXmm1),
-	/*4195*/ uint16(xArgXmm2M64),
-	/*4196*/ uint16(xMatch),
-	/*4197*/ uint16(xSetOp), uint16(ADDSS),
-	/*4199*/ uint16(xReadSlashR),
-	/*4200*/ uint16(xArgXmm1),
-	/*4201*/ uint16(xArgXmm2M32),
-	/*4202*/ uint16(xMatch),
-	/*4203*/ uint16(xCondPrefix), 4,
-	0xF3, 4231,
-	0xF2, 4225,
-	0x66, 4219,
-	0x0, 4213,
-	/*4213*/ uint16(xSetOp), uint16(MULPS),
+	/*4183*/ uint16(xArgR32M8),
+	/*4184*/ uint16(xArgImm8u),
+	/*4185*/ uint16(xMatch),
+	/*4186*/ uint16(xCondPrefix), 1,
+	0x66, 4190,
+	/*4190*/ uint16(xSetOp), uint16(INSERTPS),
+	/*4192*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4194*/ uint16(xReadSlashR),
+	/*4195*/ uint16(xReadIb),
+	/*4196*/ uint16(xArgXmm1),
+	/*4197*/ uint16(xArgXmm2M32),
+	/*4198*/ uint16(xArgImm8u),
+	/*4199*/ uint16(xMatch),
+	/*4200*/ uint16(xCondIs64), 4203, 4231,
+	/*4203*/ uint16(xCondPrefix), 1,
+	0x66, 4207,
+	/*4207*/ uint16(xCondDataSize), 4211, 4221, 0,
+	/*4211*/ uint16(xSetOp), uint16(PINSRD),
+	/*4213*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
 	/*4215*/ uint16(xReadSlashR),
-	/*4216*/ uint16(xArgXmm1),
-	/*4217*/ uint16(xArgXmm2M128),
-	/*4218*/ uint16(xMatch),
-	/*4219*/ uint16(xSetOp), uint16(MULPD),
-	/*4221*/ uint16(xReadSlashR),
-	/*4222*/ uint16(xArgXmm1),
-	/*4223*/ uint16(xArgXmm2M128),
-	/*4224*/ uint16(xMatch),
-	/*4225*/ uint16(xSetOp), uint16(MULSD),
-	/*4227*/ uint16(xReadSlashR),
-	/*4228*/ uint16(xArgXmm1),
-	/*4229*/ uint16(xArgXmm2M64),
+	/*4216*/ uint16(xReadIb),
+	/*4217*/ uint16(xArgXmm1),
+	/*4218*/ uint16(xArgRM32),
+	/*4219*/ uint16(xArgImm8u),
+	/*4220*/ uint16(xMatch),
+	/*4221*/ uint16(xSetOp), uint16(PINSRD),
+	/*4223*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4225*/ uint16(xReadSlashR),
+	/*4226*/ uint16(xReadIb),
+	/*4227*/ uint16(xArgXmm1),
+	/*4228*/ uint16(xArgRM32),
+	/*4229*/ uint16(xArgImm8u),
 	/*4230*/ uint16(xMatch),
-	/*4231*/ uint16(xSetOp), uint16(MULSS),
-	/*4233*/ uint16(xReadSlashR),
-	/*4234*/ uint16(xArgXmm1),
-	/*4235*/ uint16(xArgXmm2M32),
-	/*4236*/ uint16(xMatch),
-	/*4237*/ uint16(xCondPrefix), 4,
-	0xF3, 4265,
-	0xF2, 4259,
+	/*4231*/ uint16(xCondPrefix), 1,
+	0x66, 4235,
+	/*4235*/ uint16(xCondDataSize), 4211, 4221, 4239,
+	/*4239*/ uint16(xSetOp), uint16(PINSRQ),
+	/*4241*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4243*/ uint16(xReadSlashR),
+	/*4244*/ uint16(xReadIb),
+	/*4245*/ uint16(xArgXmm1),
+	/*4246*/ uint16(xArgRM64),
+	/*4247*/ uint16(xArgImm8u),
+	/*4248*/ uint16(xMatch),
+	/*4249*/ uint16(xCondPrefix), 1,
 	0x66, 4253,
-	0x0, 4247,
-	/*4247*/ uint16(xSetOp), uint16(CVTPS2PD),
-	/*4249*/ uint16(xReadSlashR),
-	/*4250*/ uint16(xArgXmm1),
-	/*4251*/ uint16(xArgXmm2M64),
-	/*4252*/ uint16(xMatch),
-	/*4253*/ uint16(xSetOp), uint16(CVTPD2PS),
-	/*4255*/ uint16(xReadSlashR),
-	/*4256*/ uint16(xArgXmm1),
-	/*4257*/ uint16(xArgXmm2M128),
-	/*4258*/ uint16(xMatch),
-	/*4259*/ uint16(xSetOp), uint16(CVTSD2SS),
-	/*4261*/ uint16(xReadSlashR),
-	/*4262*/ uint16(xArgXmm1),
-	/*4263*/ uint16(xArgXmm2M64),
-	/*4264*/ uint16(xMatch),
-	/*4265*/ uint16(xSetOp), uint16(CVTSS2SD),
-	/*4267*/ uint16(xReadSlashR),
-	/*4268*/ uint16(xArgXmm1),
-	/*4269*/ uint16(xArgXmm2M32),
-	/*4270*/ uint16(xMatch),
-	/*4271*/ uint16(xCondPrefix), 3,
-	0xF3, 4291,
-	0x66, 4285,
-	0x0, 4279,
-	/*4279*/ uint16(xSetOp), uint16(CVTDQ2PS),
-	/*4281*/ uint16(xReadSlashR),
-	/*4282*/ uint16(xArgXmm1),
-	/*4283*/ uint16(xArgXmm2M128),
-	/*4284*/ uint16(xMatch),
-	/*4285*/ uint16(xSetOp), uint16(CVTPS2DQ),
-	/*4287*/ uint16(xReadSlashR),
-	/*4288*/ uint16(xArgXmm1),
-	/*4289*/ uint16(xArgXmm2M128),
+	/*4253*/ uint16(xSetOp), uint16(DPPS),
+	/*4255*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4257*/ uint16(xReadSlashR),
+	/*4258*/ uint16(xReadIb),
+	/*4259*/ uint16(xArgXmm1),
+	/*4260*/ uint16(xArgXmm2M128),
+	/*4261*/ uint16(xArgImm8u),
+	/*4262*/ uint16(xMatch),
+	/*4263*/ uint16(xCondPrefix), 1,
+	0x66, 4267,
+	/*4267*/ uint16(xSetOp), uint16(DPPD),
+	/*4269*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4271*/ uint16(xReadSlashR),
+	/*4272*/ uint16(xReadIb),
+	/*4273*/ uint16(xArgXmm1),
+	/*4274*/ uint16(xArgXmm2M128),
+	/*4275*/ uint16(xArgImm8u),
+	/*4276*/ uint16(xMatch),
+	/*4277*/ uint16(xCondPrefix), 1,
+	0x66, 4281,
+	/*4281*/ uint16(xSetOp), uint16(MPSADBW),
+	/*4283*/ uint16(xSetFeature), uint16(FeatureSSE4_1),
+	/*4285*/ uint16(xReadSlashR),
+	/*4286*/ uint16(xReadIb),
+	/*4287*/ uint16(xArgXmm1),
+	/*4288*/ uint16(xArgXmm2M128),
+	/*4289*/ uint16(xArgImm8u),
 	/*4290*/ uint16(xMatch),
-	/*4291*/ uint16(xSetOp), uint16(CVTTPS2DQ),
-	/*4293*/ uint16(xReadSlashR),
-	/*4294*/ uint16(xArgXmm1),
-	/*4295*/ uint16(xArgXmm2M128),
-	/*4296*/ uint16(xMatch),
-	/*4297*/ uint16(xCondPrefix), 4,
-	0xF3, 4325,
-	0xF2, 4319,
-	0x66, 4313,
-	0x0, 4307,
-	/*4307*/ uint16(xSetOp), uint16(SUBPS),
-	/*4309*/ uint16(xReadSlashR),
-	/*4310*/ uint16(xArgXmm1),
-	/*4311*/ uint16(xArgXmm2M128),
-	/*4312*/ uint16(xMatch),
-	/*4313*/ uint16(xSetOp), uint16(SUBPD),
-	/*4315*/ uint16(xReadSlashR),
-	/*4316*/ uint16(xArgXmm1),
-	/*4317*/ uint16(xArgXmm2M128),
+	/*4291*/ uint16(xCondPrefix), 1,
+	0x66, 4295,
+	/*4295*/ uint16(xSetOp), uint16(PCLMULQDQ),
+	/*4297*/ uint16(xSetFeature), uint16(FeaturePCLMULQDQ),
+	/*4299*/ uint16(xReadSlashR),
+	/*4300*/ uint16(xReadIb),
+	/*4301*/ uint16(xArgXmm1),
+	/*4302*/ uint16(xArgXmm2M128),
+	/*4303*/ uint16(xArgImm8u),
+	/*4304*/ uint16(xMatch),
+	/*4305*/ uint16(xCondPrefix), 1,
+	0x66, 4309,
+	/*4309*/ uint16(xSetOp), uint16(PCMPESTRM),
+	/*4311*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*4313*/ uint16(xReadSlashR),
+	/*4314*/ uint16(xReadIb),
+	/*4315*/ uint16(xArgXmm1),
+	/*4316*/ uint16(xArgXmm2M128),
+	/*4317*/ uint16(xArgImm8u),
 	/*4318*/ uint16(xMatch),
-	/*4319*/ uint16(xSetOp), uint16(SUBSD),
-	/*4321*/ uint16(xReadSlashR),
-	/*4322*/ uint16(xArgXmm1),
-	/*4323*/ uint16(xArgXmm2M64),
-	/*4324*/ uint16(xMatch),
-	/*4325*/ uint16(xSetOp), uint16(SUBSS),
+	/*4319*/ uint16(xCondPrefix), 1,
+	0x66, 4323,
+	/*4323*/ uint16(xSetOp), uint16(PCMPESTRI),
+	/*4325*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
 	/*4327*/ uint16(xReadSlashR),
-	/*4328*/ uint16(xArgXmm1),
-	/*4329*/ uint16(xArgXmm2M32),
-	/*4330*/ uint16(xMatch),
-	/*4331*/ uint16(xCondPrefix), 4,
-	0xF3, 4359,
-	0xF2, 4353,
-	0x66, 4347,
-	0x0, 4341,
-	/*4341*/ uint16(xSetOp), uint16(MINPS),
-	/*4343*/ uint16(xReadSlashR),
-	/*4344*/ uint16(xArgXmm1),
-	/*4345*/ uint16(xArgXmm2M128),
+	/*4328*/ uint16(xReadIb),
+	/*4329*/ uint16(xArgXmm1),
+	/*4330*/ uint16(xArgXmm2M128),
+	/*4331*/ uint16(xArgImm8u),
+	/*4332*/ uint16(xMatch),
+	/*4333*/ uint16(xCondPrefix), 1,
+	0x66, 4337,
+	/*4337*/ uint16(xSetOp), uint16(PCMPISTRM),
+	/*4339*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
+	/*4341*/ uint16(xReadSlashR),
+	/*4342*/ uint16(xReadIb),
+	/*4343*/ uint16(xArgXmm1),
+	/*4344*/ uint16(xArgXmm2M128),
+	/*4345*/ uint16(xArgImm8u),
 	/*4346*/ uint16(xMatch),
-	/*4347*/ uint16(xSetOp), uint16(MINPD),
-	/*4349*/ uint16(xReadSlashR),
-	/*4350*/ uint16(xArgXmm1),
-	/*4351*/ uint16(xArgXmm2M128),
-	/*4352*/ uint16(xMatch),
-	/*4353*/ uint16(xSetOp), uint16(MINSD),
+	/*4347*/ uint16(xCondPrefix), 1,
+	0x66, 4351,
+	/*4351*/ uint16(xSetOp), uint16(PCMPISTRI),
+	/*4353*/ uint16(xSetFeature), uint16(FeatureSSE4_2),
 	/*4355*/ uint16(xReadSlashR),
-	/*4356*/ uint16(xArgXmm1),
-	/*4357*/ uint16(xArgXmm2M64),
-	/*4358*/ uint16(xMatch),
-	/*4359*/ uint16(xSetOp), uint16(MINSS),
-	/*4361*/ uint16(xReadSlashR),
-	/*4362*/ uint16(xArgXmm1),
-	/*4363*/ uint16(xArgXmm2M32),
-	/*4364*/ uint16(xMatch),
-	/*4365*/ uint16(xCondPrefix), 4,
-	0xF3, 4393,
-	0xF2, 4387,
-	0x66, 4381,
-	0x0, 4375,
-	/*4375*/ uint16(xSetOp), uint16(DIVPS),
-	/*4377*/ uint16(xReadSlashR),
-	/*4378*/ uint16(xArgXmm1),
-	/*4379*/ uint16(xArgXmm2M128),
-	/*4380*/ uint16(xMatch),
-	/*4381*/ uint16(xSetOp), uint16(DIVPD),
-	/*4383*/ uint16(xReadSlashR),
-	/*4384*/ uint16(xArgXmm1),
-	/*4385*/ uint16(xArgXmm2M128),
-	/*4386*/ uint16(xMatch),
-	/*4387*/ uint16(xSetOp), uint16(DIVSD),
-	/*4389*/ uint16(xReadSlashR),
-	/*4390*/ uint16(xArgXmm1),
-	/*4391*/ uint16(xArgXmm2M64),
-	/*4392*/ uint16(xMatch),
-	/*4393*/ uint16(xSetOp), uint16(DIVSS),
-	/*4395*/ uint16(xReadSlashR),
-	/*4396*/ uint16(xArgXmm1),
-	/*4397*/ uint16(xArgXmm2M32),
+	/*4356*/ uint16(xReadIb),
+	/*4357*/ uint16(xArgXmm1),
+	/*4358*/ uint16(xArgXmm2M128),
+	/*4359*/ uint16(xArgImm8u),
+	/*4360*/ uint16(xMatch),
+	/*4361*/ uint16(xSetOp), uint16(SHA1RNDS4),
+	/*4363*/ uint16(xSetFeature), uint16(FeatureSHA),
+	/*4365*/ uint16(xReadSlashR),
+	/*4366*/ uint16(xReadIb),
+	/*4367*/ uint16(xArgXmm1),
+	/*4368*/ uint16(xArgXmm2M128),
+	/*4369*/ uint16(xArgImm8u),
+	/*4370*/ uint16(xMatch),
+	/*4371*/ uint16(xCondPrefix), 1,
+	0x66, 4375,
+	/*4375*/ uint16(xSetOp), uint16(GF2P8AFFINEQB),
+	/*4377*/ uint16(xSetFeature), uint16(FeatureGFNI),
+	/*4379*/ uint16(xReadSlashR),
+	/*4380*/ uint16(xReadIb),
+	/*4381*/ uint16(xArgXmm1),
+	/*4382*/ uint16(xArgXmm2M128),
+	/*4383*/ uint16(xArgImm8u),
+	/*4384*/ uint16(xMatch),
+	/*4385*/ uint16(xCondPrefix), 1,
+	0x66, 4389,
+	/*4389*/ uint16(xSetOp), uint16(GF2P8AFFINEINVQB),
+	/*4391*/ uint16(xSetFeature), uint16(FeatureGFNI),
+	/*4393*/ uint16(xReadSlashR),
+	/*4394*/ uint16(xReadIb),
+	/*4395*/ uint16(xArgXmm1),
+	/*4396*/ uint16(xArgXmm2M128),
+	/*4397*/ uint16(xArgImm8u),
 	/*4398*/ uint16(xMatch),
-	/*4399*/ uint16(xCondPrefix), 4,
-	0xF3, 4427,
-	0xF2, 4421,
-	0x66, 4415,
-	0x0, 4409,
-	/*4409*/ uint16(xSetOp), uint16(MAXPS),
-	/*4411*/ uint16(xReadSlashR),
-	/*4412*/ uint16(xArgXmm1),
-	/*4413*/ uint16(xArgXmm2M128),
-	/*4414*/ uint16(xMatch),
-	/*4415*/ uint16(xSetOp), uint16(MAXPD),
-	/*4417*/ uint16(xReadSlashR),
-	/*4418*/ uint16(xArgXmm1),
-	/*4419*/ uint16(xArgXmm2M128),
-	/*4420*/ uint16(xMatch),
-	/*4421*/ uint16(xSetOp), uint16(MAXSD),
-	/*4423*/ uint16(xReadSlashR),
-	/*4424*/ uint16(xArgXmm1),
-	/*4425*/ uint16(xArgXmm2M64),
-	/*4426*/ uint16(xMatch),
-	/*4427*/ uint16(xSetOp), uint16(MAXSS),
-	/*4429*/ uint16(xReadSlashR),
-	/*4430*/ uint16(xArgXmm1),
-	/*4431*/ uint16(xArgXmm2M32),
-	/*4432*/ uint16(xMatch),
-	/*4433*/ uint16(xCondPrefix), 2,
-	0x66, 4445,
-	0x0, 4439,
-	/*4439*/ uint16(xSetOp), uint16(PUNPCKLBW),
-	/*4441*/ uint16(xReadSlashR),
-	/*4442*/ uint16(xArgMm),
-	/*4443*/ uint16(xArgMmM32),
-	/*4444*/ uint16(xMatch),
-	/*4445*/ uint16(xSetOp), uint16(PUNPCKLBW),
-	/*4447*/ uint16(xReadSlashR),
-	/*4448*/ uint16(xArgXmm1),
-	/*4449*/ uint16(xArgXmm2M128),
-	/*4450*/ uint16(xMatch),
-	/*4451*/ uint16(xCondPrefix), 2,
-	0x66, 4463,
-	0x0, 4457,
-	/*4457*/ uint16(xSetOp), uint16(PUNPCKLWD),
-	/*4459*/ uint16(xReadSlashR),
-	/*4460*/ uint16(xArgMm),
-	/*4461*/ uint16(xArgMmM32),
-	/*4462*/ uint16(xMatch),
-	/*4463*/ uint16(xSetOp), uint16(PUNPCKLWD),
-	/*4465*/ uint16(xReadSlashR),
-	/*4466*/ uint16(xArgXmm1),
-	/*4467*/ uint16(xArgXmm2M128),
-	/*4468*/ uint16(xMatch),
-	/*4469*/ uint16(xCondPrefix), 2,
-	0x66, 4481,
-	0x0, 4475,
-	/*4475*/ uint16(xSetOp), uint16(PUNPCKLDQ),
-	/*4477*/ uint16(xReadSlashR),
-	/*4478*/ uint16(xArgMm),
-	/*4479*/ uint16(xArgMmM32),
-	/*4480*/ uint16(xMatch),
-	/*4481*/ uint16(xSetOp), uint16(PUNPCKLDQ),
-	/*4483*/ uint16(xReadSlashR),
-	/*4484*/ uint16(xArgXmm1),
-	/*4485*/ uint16(xArgXmm2M128),
-	/*4486*/ uint16(xMatch),
-	/*4487*/ uint16(xCondPrefix), 2,
-	0x66, 4499,
-	0x0, 4493,
-	/*4493*/ uint16(xSetOp), uint16(PACKSSWB),
-	/*4495*/ uint16(xReadSlashR),
-	/*4496*/ uint16(xArgMm1),
-	/*4497*/ uint16(xArgMm2M64),
-	/*4498*/ uint16(xMatch),
-	/*4499*/ uint16(xSetOp), uint16(PACKSSWB),
-	/*4501*/ uint16(xReadSlashR),
-	/*4502*/ uint16(xArgXmm1),
-	/*4503*/ uint16(xArgXmm2M128),
-	/*4504*/ uint16(xMatch),
-	/*4505*/ uint16(xCondPrefix), 2,
-	0x66, 4517,
-	0x0, 4511,
-	/*4511*/ uint16(xSetOp), uint16(PCMPGTB),
-	/*4513*/ uint16(xReadSlashR),
-	/*4514*/ uint16(xArgMm),
-	/*4515*/ uint16(xArgMmM64),
-	/*4516*/ uint16(xMatch),
-	/*4517*/ uint16(xSetOp), uint16(PCMPGTB),
-	/*4519*/ uint16(xReadSlashR),
-	/*4520*/ uint16(xArgXmm1),
-	/*4521*/ uint16(xArgXmm2M128),
-	/*4522*/ uint16(xMatch),
-	/*4523*/ uint16(xCondPrefix), 2,
-	0x66, 4535,
-	0x0, 4529,
-	/*4529*/ uint16(xSetOp), uint16(PCMPGTW),
-	/*4531*/ uint16(xReadSlashR),
-	/*4532*/ uint16(xArgMm),
-	/*4533*/ uint16(xArgMmM64),
-	/*4534*/ uint16(xMatch),
-	/*4535*/ uint16(xSetOp), uint16(PCMPGTW),
-	/*4537*/ uint16(xReadSlashR),
-	/*4538*/ uint16(xArgXmm1),
-	/*4539*/ uint16(xArgXmm2M128),
-	/*4540*/ uint16(xMatch),
-	/*4541*/ uint16(xCondPrefix), 2,
-	0x66, 4553,
-	0x0, 4547,
-	/*4547*/ uint16(xSetOp), uint16(PCMPGTD),
-	/*4549*/ uint16(xReadSlashR),
-	/*4550*/ uint16(xArgMm),
-	/*4551*/ uint16(xArgMmM64),
-	/*4552*/ uint16(xMatch),
-	/*4553*/ uint16(xSetOp), uint16(PCMPGTD),
-	/*4555*/ uint16(xReadSlashR),
-	/*4556*/ uint16(xArgXmm1),
-	/*4557*/ uint16(xArgXmm2M128),
-	/*4558*/ uint16(xMatch),
-	/*4559*/ uint16(xCondPrefix), 2,
-	0x66, 4571,
-	0x0, 4565,
-	/*4565*/ uint16(xSetOp), uint16(PACKUSWB),
+	/*4399*/ uint16(xCondPrefix), 1,
+	0x66, 4403,
+	/*4403*/ uint16(xSetOp), uint16(AESKEYGENASSIST),
+	/*4405*/ uint16(xSetFeature), uint16(FeatureAES),
+	/*4407*/ uint16(xReadSlashR),
+	/*4408*/ uint16(xReadIb),
+	/*4409*/ uint16(xArgXmm1),
+	/*4410*/ uint16(xArgXmm2M128),
+	/*4411*/ uint16(xArgImm8u),
+	/*4412*/ uint16(xMatch),
+	/*4413*/ uint16(xCondIs64), 4416, 4432,
+	/*4416*/ uint16(xCondDataSize), 4420, 4426, 0,
+	/*4420*/ uint16(xSetOp), uint16(CMOVO),
+	/*4422*/ uint16(xReadSlashR),
+	/*4423*/ uint16(xArgR16),
+	/*4424*/ uint16(xArgRM16),
+	/*4425*/ uint16(xMatch),
+	/*4426*/ uint16(xSetOp), uint16(CMOVO),
+	/*4428*/ uint16(xReadSlashR),
+	/*4429*/ uint16(xArgR32),
+	/*4430*/ uint16(xArgRM32),
+	/*4431*/ uint16(xMatch),
+	/*4432*/ uint16(xCondDataSize), 4420, 4426, 4436,
+	/*4436*/ uint16(xSetOp), uint16(CMOVO),
+	/*4438*/ uint16(xReadSlashR),
+	/*4439*/ uint16(xArgR64),
+	/*4440*/ uint16(xArgRM64),
+	/*4441*/ uint16(xMatch),
+	/*4442*/ uint16(xCondIs64), 4445, 4461,
+	/*4445*/ uint16(xCondDataSize), 4449, 4455, 0,
+	/*4449*/ uint16(xSetOp), uint16(CMOVNO),
+	/*4451*/ uint16(xReadSlashR),
+	/*4452*/ uint16(xArgR16),
+	/*4453*/ uint16(xArgRM16),
+	/*4454*/ uint16(xMatch),
+	/*4455*/ uint16(xSetOp), uint16(CMOVNO),
+	/*4457*/ uint16(xReadSlashR),
+	/*4458*/ uint16(xArgR32),
+	/*4459*/ uint16(xArgRM32),
+	/*4460*/ uint16(xMatch),
+	/*4461*/ uint16(xCondDataSize), 4449, 4455, 4465,
+	/*4465*/ uint16(xSetOp), uint16(CMOVNO),
+	/*4467*/ uint16(xReadSlashR),
+	/*4468*/ uint16(xArgR64),
+	/*4469*/ uint16(xArgRM64),
+	/*4470*/ uint16(xMatch),
+	/*4471*/ uint16(xCondIs64), 4474, 4490,
+	/*4474*/ uint16(xCondDataSize), 4478, 4484, 0,
+	/*4478*/ uint16(xSetOp), uint16(CMOVB),
+	/*4480*/ uint16(xReadSlashR),
+	/*4481*/ uint16(xArgR16),
+	/*4482*/ uint16(xArgRM16),
+	/*4483*/ uint16(xMatch),
+	/*4484*/ uint16(xSetOp), uint16(CMOVB),
+	/*4486*/ uint16(xReadSlashR),
+	/*4487*/ uint16(xArgR32),
+	/*4488*/ uint16(xArgRM32),
+	/*4489*/ uint16(xMatch),
+	/*4490*/ uint16(xCondDataSize), 4478, 4484, 4494,
+	/*4494*/ uint16(xSetOp), uint16(CMOVB),
+	/*4496*/ uint16(xReadSlashR),
+	/*4497*/ uint16(xArgR64),
+	/*4498*/ uint16(xArgRM64),
+	/*4499*/ uint16(xMatch),
+	/*4500*/ uint16(xCondIs64), 4503, 4519,
+	/*4503*/ uint16(xCondDataSize), 4507, 4513, 0,
+	/*4507*/ uint16(xSetOp), uint16(CMOVAE),
+	/*4509*/ uint16(xReadSlashR),
+	/*4510*/ uint16(xArgR16),
+	/*4511*/ uint16(xArgRM16),
+	/*4512*/ uint16(xMatch),
+	/*4513*/ uint16(xSetOp), uint16(CMOVAE),
+	/*4515*/ uint16(xReadSlashR),
+	/*4516*/ uint16(xArgR32),
+	/*4517*/ uint16(xArgRM32),
+	/*4518*/ uint16(xMatch),
+	/*4519*/ uint16(xCondDataSize), 4507, 4513, 4523,
+	/*4523*/ uint16(xSetOp), uint16(CMOVAE),
+	/*4525*/ uint16(xReadSlashR),
+	/*4526*/ uint16(xArgR64),
+	/*4527*/ uint16(xArgRM64),
+	/*4528*/ uint16(xMatch),
+	/*4529*/ uint16(xCondIs64), 4532, 4548,
+	/*4532*/ uint16(xCondDataSize), 4536, 4542, 0,
+	/*4536*/ uint16(xSetOp), uint16(CMOVE),
+	/*4538*/ uint16(xReadSlashR),
+	/*4539*/ uint16(xArgR16),
+	/*4540*/ uint16(xArgRM16),
+	/*4541*/ uint16(xMatch),
+	/*4542*/ uint16(xSetOp), uint16(CMOVE),
+	/*4544*/ uint16(xReadSlashR),
+	/*4545*/ uint16(xArgR32),
+	/*4546*/ uint16(xArgRM32),
+	/*4547*/ uint16(xMatch),
+	/*4548*/ uint16(xCondDataSize), 4536, 4542, 4552,
+	/*4552*/ uint16(xSetOp), uint16(CMOVE),
+	/*4554*/ uint16(xReadSlashR),
+	/*4555*/ uint16(xArgR64),
+	/*4556*/ uint16(xArgRM64),
+	/*4557*/ uint16(xMatch),
+	/*4558*/ uint16(xCondIs64), 4561, 4577,
+	/*4561*/ uint16(xCondDataSize), 4565, 4571, 0,
+	/*4565*/ uint16(xSetOp), uint16(CMOVNE),
 	/*4567*/ uint16(xReadSlashR),
-	/*4568*/ uint16(xArgMm),
-	/*4569*/ uint16(xArgMmM64),
+	/*4568*/ uint16(xArgR16),
+	/*4569*/ uint16(xArgRM16),
 	/*4570*/ uint16(xMatch),
-	/*4571*/ uint16(xSetOp), uint16(PACKUSWB),
+	/*4571*/ uint16(xSetOp), uint16(CMOVNE),
 	/*4573*/ uint16(xReadSlashR),
-	/*4574*/ uint16(xArgXmm1),
-	/*4575*/ uint16(xArgXmm2M128),
+	/*4574*/ uint16(xArgR32),
+	/*4575*/ uint16(xArgRM32),
 	/*4576*/ uint16(xMatch),
-	/*4577*/ uint16(xCondPrefix), 2,
-	0x66, 4589,
-	0x0, 4583,
-	/*4583*/ uint16(xSetOp), uint16(PUNPCKHBW),
-	/*4585*/ uint16(xReadSlashR),
-	/*4586*/ uint16(xArgMm),
-	/*4587*/ uint16(xArgMmM64),
-	/*4588*/ uint16(xMatch),
-	/*4589*/ uint16(xSetOp), uint16(PUNPCKHBW),
-	/*4591*/ uint16(xReadSlashR),
-	/*4592*/ uint16(xArgXmm1),
-	/*4593*/ uint16(xArgXmm2M128),
-	/*4594*/ uint16(xMatch),
-	/*4595*/ uint16(xCondPrefix), 2,
-	0x66, 4607,
-	0x0, 4601,
-	/*4601*/ uint16(xSetOp), uint16(PUNPCKHWD),
-	/*4603*/ uint16(xReadSlashR),
-	/*4604*/ uint16(xArgMm),
-	/*4605*/ uint16(xArgMmM64),
-	/*4606*/ uint16(xMatch),
-	/*4607*/ uint16(xSetOp), uint16(PUNPCKHWD),
-	/*4609*/ uint16(xReadSlashR),
-	/*4610*/ uint16(xArgXmm1),
-	/*4611*/ uint16(xArgXmm2M128),
-	/*4612*/ uint16(xMatch),
-	/*4613*/ uint16(xCondPrefix), 2,
-	0x66, 4625,
-	0x0, 4619,
-	/*4619*/ uint16(xSetOp), uint16(PUNPCKHDQ),
-	/*4621*/ uint16(xReadSlashR),
-	/*4622*/ uint16(xArgMm),
-	/*4623*/ uint16(xArgMmM64),
-	/*4624*/ uint16(xMatch),
-	/*4625*/ uint16(xSetOp), uint16(PUNPCKHDQ),
-	/*4627*/ uint16(xReadSlashR),
-	/*4628*/ uint16(xArgXmm1),
-	/*4629*/ uint16(xArgXmm2M128),
-	/*4630*/ uint16(xMatch),
-	/*4631*/ uint16(xCondPrefix), 2,
-	0x66, 4643,
-	0x0, 4637,
-	/*4637*/ uint16(xSetOp), uint16(PACKSSDW),
-	/*4639*/ uint16(xReadSlashR),
-	/*4640*/ uint16(xArgMm1),
-	/*4641*/ uint16(xArgMm2M64),
-	/*4642*/ uint16(xMatch),
-	/*4643*/ uint16(xSetOp), uint16(PACKSSDW),
-	/*4645*/ uint16(xReadSlashR),
-	/*4646*/ uint16(xArgXmm1),
-	/*4647*/ uint16(xArgXmm2M128),
-	/*4648*/ uint16(xMatch),
-	/*4649*/ uint16(xCondPrefix), 1,
-	0x66, 4653,
-	/*4653*/ uint16(xSetOp), uint16(PUNPCKLQDQ),
-	/*4655*/ uint16(xReadSlashR),
-	/*4656*/ uint16(xArgXmm1),
-	/*4657*/ uint16(xArgXmm2M128),
-	/*4658*/ uint16(xMatch),
-	/*4659*/ uint16(xCondPrefix), 1,
-	0x66, 4663,
-	/*4663*/ uint16(xSetOp), uint16(PUNPCKHQDQ),
-	/*4665*/ uint16(xReadSlashR),
-	/*4666*/ uint16(xArgXmm1),
-	/*4667*/ uint16(xArgXmm2M128),
-	/*4668*/ uint16(xMatch),
-	/*4669*/ uint16(xCondIs64), 4672, 4710,
-	/*4672*/ uint16(xCondPrefix), 2,
-	0x66, 4694,
-	0x0, 4678,
-	/*4678*/ uint16(xCondDataSize), 4682, 4688, 0,
-	/*4682*/ uint16(xSetOp), uint16(MOVD),
-	/*4684*/ uint16(xReadSlashR),
-	/*4685*/ uint16(xArgMm),
-	/*4686*/ uint16(xArgRM32),
-	/*4687*/ uint16(xMatch),
-	/*4688*/ uint16(xSetOp), uint16(MOVD),
-	/*4690*/ uint16(xReadSlashR),
-	/*4691*/ uint16(xArgMm),
-	/*4692*/ uint16(xArgRM32),
-	/*4693*/ uint16(xMatch),
-	/*4694*/ uint16(xCondDataSize), 4698, 4704, 0,
-	/*4698*/ uint16(xSetOp), uint16(MOVD),
-	/*4700*/ uint16(xReadSlashR),
-	/*4701*/ uint16(xArgXmm),
-	/*4702*/ uint16(xArgRM32),
-	/*4703*/ uint16(xMatch),
-	/*4704*/ uint16(xSetOp), uint16(MOVD),
-	/*4706*/ uint16(xReadSlashR),
-	/*4707*/ uint16(xArgXmm),
-	/*4708*/ uint16(xArgRM32),
-	/*4709*/ uint16(xMatch),
-	/*4710*/ uint16(xCondPrefix), 2,
-	0x66, 4726,
-	0x0, 4716,
-	/*4716*/ uint16(xCondDataSize), 4682, 4688, 4720,
-	/*4720*/ uint16(xSetOp), uint16(MOVQ),
-	/*4722*/ uint16(xReadSlashR),
-	/*4723*/ uint16(xArgMm),
-	/*4724*/ uint16(xArgRM64),
-	/*4725*/ uint16(xMatch),
-	/*4726*/ uint16(xCondDataSize), 4698, 4704, 4730,
-	/*4730*/ uint16(xSetOp), uint16(MOVQ),
-	/*4732*/ uint16(xReadSlashR),
-	/*4733*/ uint16(xArgXmm),
-	/*4734*/ uint16(xArgRM64),
-	/*4735*/ uint16(xMatch),
-	/*4736*/ uint16(xCondPrefix), 3,
-	0xF3, 4756,
-	0x66, 4750,
-	0x0, 4744,
-	/*4744*/ uint16(xSetOp), uint16(MOVQ),
-	/*4746*/ uint16(xReadSlashR),
-	/*4747*/ uint16(xArgMm),
-	/*4748*/ uint16(xArgMmM64),
-	/*4749*/ uint16(xMatch),
-	/*4750*/ uint16(xSetOp), uint16(MOVDQA),
-	/*4752*/ uint16(xReadSlashR),
-	/*4753*/ uint16(xArgXmm1),
-	/*4754*/ uint16(xArgXmm2M128),
-	/*4755*/ uint16(xMatch),
-	/*4756*/ uint16(xSetOp), uint16(MOVDQU),
-	/*4758*/ uint16(xReadSlashR),
-	/*4759*/ uint16(xArgXmm1),
-	/*4760*/ uint16(xArgXmm2M128),
-	/*4761*/ uint16(xMatch),
-	/*4762*/ uint16(xCondPrefix), 4,
-	0xF3, 4796,
-	0xF2, 4788,
-	0x66, 4780,
-	0x0, 4772,
-	/*4772*/ uint16(xSetOp), uint16(PSHUFW),
-	/*4774*/ uint16(xReadSlashR),
-	/*4775*/ uint16(xReadIb),
-	/*4776*/ uint16(xArgMm1),
-	/*4777*/ uint16(xArgMm2M64),
-	/*4778*/ uint16(xArgImm8u),
+	/*4577*/ uint16(xCondDataSize), 4565, 4571, 4581,
+	/*4581*/ uint16(xSetOp), uint16(CMOVNE),
+	/*4583*/ uint16(xReadSlashR),
+	/*4584*/ uint16(xArgR64),
+	/*4585*/ uint16(xArgRM64),
+	/*4586*/ uint16(xMatch),
+	/*4587*/ uint16(xCondIs64), 4590, 4606,
+	/*4590*/ uint16(xCondDataSize), 4594, 4600, 0,
+	/*4594*/ uint16(xSetOp), uint16(CMOVBE),
+	/*4596*/ uint16(xReadSlashR),
+	/*4597*/ uint16(xArgR16),
+	/*4598*/ uint16(xArgRM16),
+	/*4599*/ uint16(xMatch),
+	/*4600*/ uint16(xSetOp), uint16(CMOVBE),
+	/*4602*/ uint16(xReadSlashR),
+	/*4603*/ uint16(xArgR32),
+	/*4604*/ uint16(xArgRM32),
+	/*4605*/ uint16(xMatch),
+	/*4606*/ uint16(xCondDataSize), 4594, 4600, 4610,
+	/*4610*/ uint16(xSetOp), uint16(CMOVBE),
+	/*4612*/ uint16(xReadSlashR),
+	/*4613*/ uint16(xArgR64),
+	/*4614*/ uint16(xArgRM64),
+	/*4615*/ uint16(xMatch),
+	/*4616*/ uint16(xCondIs64), 4619, 4635,
+	/*4619*/ uint16(xCondDataSize), 4623, 4629, 0,
+	/*4623*/ uint16(xSetOp), uint16(CMOVA),
+	/*4625*/ uint16(xReadSlashR),
+	/*4626*/ uint16(xArgR16),
+	/*4627*/ uint16(xArgRM16),
+	/*4628*/ uint16(xMatch),
+	/*4629*/ uint16(xSetOp), uint16(CMOVA),
+	/*4631*/ uint16(xReadSlashR),
+	/*4632*/ uint16(xArgR32),
+	/*4633*/ uint16(xArgRM32),
+	/*4634*/ uint16(xMatch),
+	/*4635*/ uint16(xCondDataSize), 4623, 4629, 4639,
+	/*4639*/ uint16(xSetOp), uint16(CMOVA),
+	/*4641*/ uint16(xReadSlashR),
+	/*4642*/ uint16(xArgR64),
+	/*4643*/ uint16(xArgRM64),
+	/*4644*/ uint16(xMatch),
+	/*4645*/ uint16(xCondIs64), 4648, 4664,
+	/*4648*/ uint16(xCondDataSize), 4652, 4658, 0,
+	/*4652*/ uint16(xSetOp), uint16(CMOVS),
+	/*4654*/ uint16(xReadSlashR),
+	/*4655*/ uint16(xArgR16),
+	/*4656*/ uint16(xArgRM16),
+	/*4657*/ uint16(xMatch),
+	/*4658*/ uint16(xSetOp), uint16(CMOVS),
+	/*4660*/ uint16(xReadSlashR),
+	/*4661*/ uint16(xArgR32),
+	/*4662*/ uint16(xArgRM32),
+	/*4663*/ uint16(xMatch),
+	/*4664*/ uint16(xCondDataSize), 4652, 4658, 4668,
+	/*4668*/ uint16(xSetOp), uint16(CMOVS),
+	/*4670*/ uint16(xReadSlashR),
+	/*4671*/ uint16(xArgR64),
+	/*4672*/ uint16(xArgRM64),
+	/*4673*/ uint16(xMatch),
+	/*4674*/ uint16(xCondIs64), 4677, 4693,
+	/*4677*/ uint16(xCondDataSize), 4681, 4687, 0,
+	/*4681*/ uint16(xSetOp), uint16(CMOVNS),
+	/*4683*/ uint16(xReadSlashR),
+	/*4684*/ uint16(xArgR16),
+	/*4685*/ uint16(xArgRM16),
+	/*4686*/ uint16(xMatch),
+	/*4687*/ uint16(xSetOp), uint16(CMOVNS),
+	/*4689*/ uint16(xReadSlashR),
+	/*4690*/ uint16(xArgR32),
+	/*4691*/ uint16(xArgRM32),
+	/*4692*/ uint16(xMatch),
+	/*4693*/ uint16(xCondDataSize), 4681, 4687, 4697,
+	/*4697*/ uint16(xSetOp), uint16(CMOVNS),
+	/*4699*/ uint16(xReadSlashR),
+	/*4700*/ uint16(xArgR64),
+	/*4701*/ uint16(xArgRM64),
+	/*4702*/ uint16(xMatch),
+	/*4703*/ uint16(xCondIs64), 4706, 4722,
+	/*4706*/ uint16(xCondDataSize), 4710, 4716, 0,
+	/*4710*/ uint16(xSetOp), uint16(CMOVP),
+	/*4712*/ uint16(xReadSlashR),
+	/*4713*/ uint16(xArgR16),
+	/*4714*/ uint16(xArgRM16),
+	/*4715*/ uint16(xMatch),
+	/*4716*/ uint16(xSetOp), uint16(CMOVP),
+	/*4718*/ uint16(xReadSlashR),
+	/*4719*/ uint16(xArgR32),
+	/*4720*/ uint16(xArgRM32),
+	/*4721*/ uint16(xMatch),
+	/*4722*/ uint16(xCondDataSize), 4710, 4716, 4726,
+	/*4726*/ uint16(xSetOp), uint16(CMOVP),
+	/*4728*/ uint16(xReadSlashR),
+	/*4729*/ uint16(xArgR64),
+	/*4730*/ uint16(xArgRM64),
+	/*4731*/ uint16(xMatch),
+	/*4732*/ uint16(xCondIs64), 4735, 4751,
+	/*4735*/ uint16(xCondDataSize), 4739, 4745, 0,
+	/*4739*/ uint16(xSetOp), uint16(CMOVNP),
+	/*4741*/ uint16(xReadSlashR),
+	/*4742*/ uint16(xArgR16),
+	/*4743*/ uint16(xArgRM16),
+	/*4744*/ uint16(xMatch),
+	/*4745*/ uint16(xSetOp), uint16(CMOVNP),
+	/*4747*/ uint16(xReadSlashR),
+	/*4748*/ uint16(xArgR32),
+	/*4749*/ uint16(xArgRM32),
+	/*4750*/ uint16(xMatch),
+	/*4751*/ uint16(xCondDataSize), 4739, 4745, 4755,
+	/*4755*/ uint16(xSetOp), uint16(CMOVNP),
+	/*4757*/ uint16(xReadSlashR),
+	/*4758*/ uint16(xArgR64),
+	/*4759*/ uint16(xArgRM64),
+	/*4760*/ uint16(xMatch),
+	/*4761*/ uint16(xCondIs64), 4764, 4780,
+	/*4764*/ uint16(xCondDataSize), 4768, 4774, 0,
+	/*4768*/ uint16(xSetOp), uint16(CMOVL),
+	/*4770*/ uint16(xReadSlashR),
+	/*4771*/ uint16(xArgR16),
+	/*4772*/ uint16(xArgRM16),
+	/*4773*/ uint16(xMatch),
+	/*4774*/ uint16(xSetOp), uint16(CMOVL),
+	/*4776*/ uint16(xReadSlashR),
+	/*4777*/ uint16(xArgR32),
+	/*4778*/ uint16(xArgRM32),
 	/*4779*/ uint16(xMatch),
-	/*4780*/ uint16(xSetOp), uint16(PSHUFD),
-	/*4782*/ uint16(xReadSlashR),
-	/*4783*/ uint16(xReadIb),
-	/*4784*/ uint16(xArgXmm1),
-	/*4785*/ uint16(xArgXmm2M128),
-	/*4786*/ uint16(xArgImm8u),
-	/*4787*/ uint16(xMatch),
-	/*4788*/ uint16(xSetOp), uint16(PSHUFLW),
-	/*4790*/ uint16(xReadSlashR),
-	/*4791*/ uint16(xReadIb),
-	/*4792*/ uint16(xArgXmm1),
-	/*4793*/ uint16(xArgXmm2M128),
-	/*4794*/ uint16(xArgImm8u),
-	/*4795*/ uint16(xMatch),
-	/*4796*/ uint16(xSetOp), uint16(PSHUFHW),
-	/*4798*/ uint16(xReadSlashR),
-	/*4799*/ uint16(xReadIb),
-	/*4800*/ uint16(xArgXmm1),
-	/*4801*/ uint16(xArgXmm2M128),
-	/*4802*/ uint16(xArgImm8u),
-	/*4803*/ uint16(xMatch),
-	/*4804*/ uint16(xCondSlashR),
-	0,    // 0
-	0,    // 1
-	4813, // 2
-	0,    // 3
-	4831, // 4
-	0,    // 5
-	4849, // 6
-	0,    // 7
-	/*4813*/ uint16(xCondPrefix), 2,
-	0x66, 4825,
-	0x0, 4819,
-	/*4819*/ uint16(xSetOp), uint16(PSRLW),
-	/*4821*/ uint16(xReadIb),
-	/*4822*/ uint16(xArgMm2),
-	/*4823*/ uint16(xArgImm8u),
-	/*4824*/ uint16(xMatch),
-	/*4825*/ uint16(xSetOp), uint16(PSRLW),
-	/*4827*/ uint16(xReadIb),
-	/*4828*/ uint16(xArgXmm2),
-	/*4829*/ uint16(xArgImm8u),
-	/*4830*/ uint16(xMatch),
-	/*4831*/ uint16(xCondPrefix), 2,
-	0x66, 4843,
-	0x0, 4837,
-	/*4837*/ uint16(xSetOp), uint16(PSRAW),
-	/*4839*/ uint16(xReadIb),
-	/*4840*/ uint16(xArgMm2),
-	/*4841*/ uint16(xArgImm8u),
-	/*4842*/ uint16(xMatch),
-	/*4843*/ uint16(xSetOp), uint16(PSRAW),
-	/*4845*/ uint16(xReadIb),
-	/*4846*/ uint16(xArgXmm2),
-	/*4847*/ uint16(xArgImm8u),
-	/*4848*/ uint16(xMatch),
-	/*4849*/ uint16(xCondPrefix), 2,
-	0x66, 4861,
-	0x0, 4855,
-	/*4855*/ uint16(xSetOp), uint16(PSLLW),
-	/*4857*/ uint16(xReadIb),
-	/*4858*/ uint16(xArgMm2),
-	/*4859*/ uint16(xArgImm8u),
+	/*4780*/ uint16(xCondDataSize), 4768, 4774, 4784,
+	/*4784*/ uint16(xSetOp), uint16(CMOVL),
+	/*4786*/ uint16(xReadSlashR),
+	/*4787*/ uint16(xArgR64),
+	/*4788*/ uint16(xArgRM64),
+	/*4789*/ uint16(xMatch),
+	/*4790*/ uint16(xCondIs64), 4793, 4809,
+	/*4793*/ uint16(xCondDataSize), 4797, 4803, 0,
+	/*4797*/ uint16(xSetOp), uint16(CMOVGE),
+	/*4799*/ uint16(xReadSlashR),
+	/*4800*/ uint16(xArgR16),
+	/*4801*/ uint16(xArgRM16),
+	/*4802*/ uint16(xMatch),
+	/*4803*/ uint16(xSetOp), uint16(CMOVGE),
+	/*4805*/ uint16(xReadSlashR),
+	/*4806*/ uint16(xArgR32),
+	/*4807*/ uint16(xArgRM32),
+	/*4808*/ uint16(xMatch),
+	/*4809*/ uint16(xCondDataSize), 4797, 4803, 4813,
+	/*4813*/ uint16(xSetOp), uint16(CMOVGE),
+	/*4815*/ uint16(xReadSlashR),
+	/*4816*/ uint16(xArgR64),
+	/*4817*/ uint16(xArgRM64),
+	/*4818*/ uint16(xMatch),
+	/*4819*/ uint16(xCondIs64), 4822, 4838,
+	/*4822*/ uint16(xCondDataSize), 4826, 4832, 0,
+	/*4826*/ uint16(xSetOp), uint16(CMOVLE),
+	/*4828*/ uint16(xReadSlashR),
+	/*4829*/ uint16(xArgR16),
+	/*4830*/ uint16(xArgRM16),
+	/*4831*/ uint16(xMatch),
+	/*4832*/ uint16(xSetOp), uint16(CMOVLE),
+	/*4834*/ uint16(xReadSlashR),
+	/*4835*/ uint16(xArgR32),
+	/*4836*/ uint16(xArgRM32),
+	/*4837*/ uint16(xMatch),
+	/*4838*/ uint16(xCondDataSize), 4826, 4832, 4842,
+	/*4842*/ uint16(xSetOp), uint16(CMOVLE),
+	/*4844*/ uint16(xReadSlashR),
+	/*4845*/ uint16(xArgR64),
+	/*4846*/ uint16(xArgRM64),
+	/*4847*/ uint16(xMatch),
+	/*4848*/ uint16(xCondIs64), 4851, 4867,
+	/*4851*/ uint16(xCondDataSize), 4855, 4861, 0,
+	/*4855*/ uint16(xSetOp), uint16(CMOVG),
+	/*4857*/ uint16(xReadSlashR),
+	/*4858*/ uint16(xArgR16),
+	/*4859*/ uint16(xArgRM16),
 	/*4860*/ uint16(xMatch),
-	/*4861*/ uint16(xSetOp), uint16(PSLLW),
-	/*4863*/ uint16(xReadIb),
-	/*4864*/ uint16(xArgXmm2),
-	/*4865*/ uint16(xArgImm8u),
+	/*4861*/ uint16(xSetOp), uint16(CMOVG),
+	/*4863*/ uint16(xReadSlashR),
+	/*4864*/ uint16(xArgR32),
+	/*4865*/ uint16(xArgRM32),
 	/*4866*/ uint16(xMatch),
-	/*4867*/ uint16(xCondSlashR),
-	0,    // 0
-	0,    // 1
-	4876, // 2
-	0,    // 3
-	4894, // 4
-	0,    // 5
-	4912, // 6
-	0,    // 7
-	/*4876*/ uint16(xCondPrefix), 2,
-	0x66, 4888,
-	0x0, 4882,
-	/*4882*/ uint16(xSetOp), uint16(PSRLD),
-	/*4884*/ uint16(xReadIb),
-	/*4885*/ uint16(xArgMm2),
-	/*4886*/ uint16(xArgImm8u),
-	/*4887*/ uint16(xMatch),
-	/*4888*/ uint16(xSetOp), uint16(PSRLD),
-	/*4890*/ uint16(xReadIb),
-	/*4891*/ uint16(xArgXmm2),
-	/*4892*/ uint16(xArgImm8u),
-	/*4893*/ uint16(xMatch),
-	/*4894*/ uint16(xCondPrefix), 2,
-	0x66, 4906,
-	0x0, 4900,
-	/*4900*/ uint16(xSetOp), uint16(PSRAD),
-	/*4902*/ uint16(xReadIb),
-	/*4903*/ uint16(xArgMm2),
-	/*4904*/ uint16(xArgImm8u),
-	/*4905*/ uint16(xMatch),
-	/*4906*/ uint16(xSetOp), uint16(PSRAD),
-	/*4908*/ uint16(xReadIb),
-	/*4909*/ uint16(xArgXmm2),
-	/*4910*/ uint16(xArgImm8u),
-	/*4911*/ uint16(xMatch),
-	/*4912*/ uint16(xCondPrefix), 2,
-	0x66, 4924,
-	0x0, 4918,
-	/*4918*/ uint16(xSetOp), uint16(PSLLD),
-	/*4920*/ uint16(xReadIb),
-	/*4921*/ uint16(xArgMm2),
-	/*4922*/ uint16(xArgImm8u),
-	/*4923*/ uint16(xMatch),
-	/*4924*/ uint16(xSetOp), uint16(PSLLD),
-	/*4926*/ uint16(xReadIb),
-	/*4927*/ uint16(xArgXmm2),
-	/*4928*/ uint16(xArgImm8u),
-	/*4929*/ uint16(xMatch),
-	/*4930*/ uint16(xCondSlashR),
-	0,    // 0
-	0,    // 1
-	4939, // 2
-	4957, // 3
-	0,    // 4
-	0,    // 5
-	4967, // 6
-	4985, // 7
-	/*4939*/ uint16(xCondPrefix), 2,
-	0x66, 4951,
-	0x0, 4945,
-	/*4945*/ uint16(xSetOp), uint16(PSRLQ),
-	/*4947*/ uint16(xReadIb),
-	/*4948*/ uint16(xArgMm2),
-	/*4949*/ uint16(xArgImm8u),
-	/*4950*/ uint16(xMatch),
-	/*4951*/ uint16(xSetOp), uint16(PSRLQ),
-	/*4953*/ uint16(xReadIb),
-	/*4954*/ uint16(xArgXmm2),
-	/*4955*/ uint16(xArgImm8u),
-	/*4956*/ uint16(xMatch),
-	/*4957*/ uint16(xCondPrefix), 1,
-	0x66, 4961,
-	/*4961*/ uint16(xSetOp), uint16(PSRLDQ),
-	/*4963*/ uint16(xReadIb),
-	/*4964*/ uint16(xArgXmm2),
-	/*4965*/ uint16(xArgImm8u),
-	/*4966*/ uint16(xMatch),
-	/*4967*/ uint16(xCondPrefix), 2,
-	0x66, 4979,
-	0x0, 4973,
-	/*4973*/ uint16(xSetOp), uint16(PSLLQ),
-	/*4975*/ uint16(xReadIb),
-	/*4976*/ uint16(xArgMm2),
-	/*4977*/ uint16(xArgImm8u),
-	/*4978*/ uint16(xMatch),
-	/*4979*/ uint16(xSetOp), uint16(PSLLQ),
-	/*4981*/ uint16(xReadIb),
-	/*4982*/ uint16(xArgXmm2),
-	/*4983*/ uint16(xArgImm8u),
+	/*4867*/ uint16(xCondDataSize), 4855, 4861, 4871,
+	/*4871*/ uint16(xSetOp), uint16(CMOVG),
+	/*4873*/ uint16(xReadSlashR),
+	/*4874*/ uint16(xArgR64),
+	/*4875*/ uint16(xArgRM64),
+	/*4876*/ uint16(xMatch),
+	/*4877*/ uint16(xCondPrefix), 2,
+	0x66, 4891,
+	0x0, 4883,
+	/*4883*/ uint16(xSetOp), uint16(MOVMSKPS),
+	/*4885*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4887*/ uint16(xReadSlashR),
+	/*4888*/ uint16(xArgR32),
+	/*4889*/ uint16(xArgXmm2),
+	/*4890*/ uint16(xMatch),
+	/*4891*/ uint16(xSetOp), uint16(MOVMSKPD),
+	/*4893*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*4895*/ uint16(xReadSlashR),
+	/*4896*/ uint16(xArgR32),
+	/*4897*/ uint16(xArgXmm2),
+	/*4898*/ uint16(xMatch),
+	/*4899*/ uint16(xCondPrefix), 4,
+	0xF3, 4933,
+	0xF2, 4925,
+	0x66, 4917,
+	0x0, 4909,
+	/*4909*/ uint16(xSetOp), uint16(SQRTPS),
+	/*4911*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4913*/ uint16(xReadSlashR),
+	/*4914*/ uint16(xArgXmm1),
+	/*4915*/ uint16(xArgXmm2M128),
+	/*4916*/ uint16(xMatch),
+	/*4917*/ uint16(xSetOp), uint16(SQRTPD),
+	/*4919*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*4921*/ uint16(xReadSlashR),
+	/*4922*/ uint16(xArgXmm1),
+	/*4923*/ uint16(xArgXmm2M128),
+	/*4924*/ uint16(xMatch),
+	/*4925*/ uint16(xSetOp), uint16(SQRTSD),
+	/*4927*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*4929*/ uint16(xReadSlashR),
+	/*4930*/ uint16(xArgXmm1),
+	/*4931*/ uint16(xArgXmm2M64),
+	/*4932*/ uint16(xMatch),
+	/*4933*/ uint16(xSetOp), uint16(SQRTSS),
+	/*4935*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4937*/ uint16(xReadSlashR),
+	/*4938*/ uint16(xArgXmm1),
+	/*4939*/ uint16(xArgXmm2M32),
+	/*4940*/ uint16(xMatch),
+	/*4941*/ uint16(xCondPrefix), 2,
+	0xF3, 4955,
+	0x0, 4947,
+	/*4947*/ uint16(xSetOp), uint16(RSQRTPS),
+	/*4949*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4951*/ uint16(xReadSlashR),
+	/*4952*/ uint16(xArgXmm1),
+	/*4953*/ uint16(xArgXmm2M128),
+	/*4954*/ uint16(xMatch),
+	/*4955*/ uint16(xSetOp), uint16(RSQRTSS),
+	/*4957*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4959*/ uint16(xReadSlashR),
+	/*4960*/ uint16(xArgXmm1),
+	/*4961*/ uint16(xArgXmm2M32),
+	/*4962*/ uint16(xMatch),
+	/*4963*/ uint16(xCondPrefix), 2,
+	0xF3, 4977,
+	0x0, 4969,
+	/*4969*/ uint16(xSetOp), uint16(RCPPS),
+	/*4971*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4973*/ uint16(xReadSlashR),
+	/*4974*/ uint16(xArgXmm1),
+	/*4975*/ uint16(xArgXmm2M128),
+	/*4976*/ uint16(xMatch),
+	/*4977*/ uint16(xSetOp), uint16(RCPSS),
+	/*4979*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4981*/ uint16(xReadSlashR),
+	/*4982*/ uint16(xArgXmm1),
+	/*4983*/ uint16(xArgXmm2M32),
 	/*4984*/ uint16(xMatch),
-	/*4985*/ uint16(xCondPrefix), 1,
-	0x66, 4989,
-	/*4989*/ uint16(xSetOp), uint16(PSLLDQ),
-	/*4991*/ uint16(xReadIb),
-	/*4992*/ uint16(xArgXmm2),
-	/*4993*/ uint16(xArgImm8u),
-	/*4994*/ uint16(xMatch),
-	/*4995*/ uint16(xCondPrefix), 2,
-	0x66, 5007,
-	0x0, 5001,
-	/*5001*/ uint16(xSetOp), uint16(PCMPEQB),
+	/*4985*/ uint16(xCondPrefix), 2,
+	0x66, 4999,
+	0x0, 4991,
+	/*4991*/ uint16(xSetOp), uint16(ANDPS),
+	/*4993*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*4995*/ uint16(xReadSlashR),
+	/*4996*/ uint16(xArgXmm1),
+	/*4997*/ uint16(xArgXmm2M128),
+	/*4998*/ uint16(xMatch),
+	/*4999*/ uint16(xSetOp), uint16(ANDPD),
+	/*5001*/ uint16(xSetFeature), uint16(FeatureSSE2),
 	/*5003*/ uint16(xReadSlashR),
-	/*5004*/ uint16(xArgMm),
-	/*5005*/ uint16(xArgMmM64),
+	/*5004*/ uint16(xArgXmm1),
+	/*5005*/ uint16(xArgXmm2M128),
 	/*5006*/ uint16(xMatch),
-	/*5007*/ uint16(xSetOp), uint16(PCMPEQB),
-	/*5009*/ uint16(xReadSlashR),
-	/*5010*/ uint16(xArgXmm1),
-	/*5011*/ uint16(xArgXmm2M128),
-	/*5012*/ uint16(xMatch),
-	/*5013*/ uint16(xCondPrefix), 2,
-	0x66, 5025,
-	0x0, 5019,
-	/*5019*/ uint16(xSetOp), uint16(PCMPEQW),
-	/*5021*/ uint16(xReadSlashR),
-	/*5022*/ uint16(xArgMm),
-	/*5023*/ uint16(xArgMmM64),
-	/*5024*/ uint16(xMatch),
-	/*5025*/ uint16(xSetOp), uint16(PCMPEQW),
-	/*5027*/ uint16(xReadSlashR),
-	/*5028*/ uint16(xArgXmm1),
-	/*5029*/ uint16(xArgXmm2M128),
-	/*5030*/ uint16(xMatch),
-	/*5031*/ uint16(xCondPrefix), 2,
+	/*5007*/ uint16(xCondPrefix), 2,
+	0x66, 5021,
+	0x0, 5013,
+	/*5013*/ uint16(xSetOp), uint16(ANDNPS),
+	/*5015*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5017*/ uint16(xReadSlashR),
+	/*5018*/ uint16(xArgXmm1),
+	/*5019*/ uint16(xArgXmm2M128),
+	/*5020*/ uint16(xMatch),
+	/*5021*/ uint16(xSetOp), uint16(ANDNPD),
+	/*5023*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5025*/ uint16(xReadSlashR),
+	/*5026*/ uint16(xArgXmm1),
+	/*5027*/ uint16(xArgXmm2M128),
+	/*5028*/ uint16(xMatch),
+	/*5029*/ uint16(xCondPrefix), 2,
 	0x66, 5043,
-	0x0, 5037,
-	/*5037*/ uint16(xSetOp), uint16(PCMPEQD),
+	0x0, 5035,
+	/*5035*/ uint16(xSetOp), uint16(ORPS),
+	/*5037*/ uint16(xSetFeature), uint16(FeatureSSE),
 	/*5039*/ uint16(xReadSlashR),
-	/*5040*/ uint16(xArgMm),
-	/*5041*/ uint16(xArgMmM64),
+	/*5040*/ uint16(xArgXmm1),
+	/*5041*/ uint16(xArgXmm2M128),
 	/*5042*/ uint16(xMatch),
-	/*5043*/ uint16(xSetOp), uint16(PCMPEQD),
-	/*5045*/ uint16(xReadSlashR),
-	/*5046*/ uint16(xArgXmm1),
-	/*5047*/ uint16(xArgXmm2M128),
-	/*5048*/ uint16(xMatch),
-	/*5049*/ uint16(xSetOp), uint16(EMMS),
-	/*5051*/ uint16(xMatch),
-	/*5052*/ uint16(xCondPrefix), 2,
-	0xF2, 5064,
-	0x66, 5058,
-	/*5058*/ uint16(xSetOp), uint16(HADDPD),
-	/*5060*/ uint16(xReadSlashR),
-	/*5061*/ uint16(xArgXmm1),
-	/*5062*/ uint16(xArgXmm2M128),
-	/*5063*/ uint16(xMatch),
-	/*5064*/ uint16(xSetOp), uint16(HADDPS),
-	/*5066*/ uint16(xReadSlashR),
-	/*5067*/ uint16(xArgXmm1),
-	/*5068*/ uint16(xArgXmm2M128),
-	/*5069*/ uint16(xMatch),
-	/*5070*/ uint16(xCondPrefix), 2,
-	0xF2, 5082,
-	0x66, 5076,
-	/*5076*/ uint16(xSetOp), uint16(HSUBPD),
-	/*5078*/ uint16(xReadSlashR),
-	/*5079*/ uint16(xArgXmm1),
-	/*5080*/ uint16(xArgXmm2M128),
-	/*5081*/ uint16(xMatch),
-	/*5082*/ uint16(xSetOp), uint16(HSUBPS),
-	/*5084*/ uint16(xReadSlashR),
-	/*5085*/ uint16(xArgXmm1),
-	/*5086*/ uint16(xArgXmm2M128),
-	/*5087*/ uint16(xMatch),
-	/*5088*/ uint16(xCondIs64), 5091, 5137,
-	/*5091*/ uint16(xCondPrefix), 3,
-	0xF3, 5131,
-	0x66, 5115,
-	0x0, 5099,
-	/*5099*/ uint16(xCondDataSize), 5103, 5109, 0,
-	/*5103*/ uint16(xSetOp), uint16(MOVD),
-	/*5105*/ uint16(xReadSlashR),
-	/*5106*/ uint16(xArgRM32),
-	/*5107*/ uint16(xArgMm),
-	/*5108*/ uint16(xMatch),
-	/*5109*/ uint16(xSetOp), uint16(MOVD),
+	/*5043*/ uint16(xSetOp), uint16(ORPD),
+	/*5045*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5047*/ uint16(xReadSlashR),
+	/*5048*/ uint16(xArgXmm1),
+	/*5049*/ uint16(xArgXmm2M128),
+	/*5050*/ uint16(xMatch),
+	/*5051*/ uint16(xCondPrefix), 2,
+	0x66, 5065,
+	0x0, 5057,
+	/*5057*/ uint16(xSetOp), uint16(XORPS),
+	/*5059*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5061*/ uint16(xReadSlashR),
+	/*5062*/ uint16(xArgXmm1),
+	/*5063*/ uint16(xArgXmm2M128),
+	/*5064*/ uint16(xMatch),
+	/*5065*/ uint16(xSetOp), uint16(XORPD),
+	/*5067*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5069*/ uint16(xReadSlashR),
+	/*5070*/ uint16(xArgXmm1),
+	/*5071*/ uint16(xArgXmm2M128),
+	/*5072*/ uint16(xMatch),
+	/*5073*/ uint16(xCondPrefix), 4,
+	0xF3, 5107,
+	0xF2, 5099,
+	0x66, 5091,
+	0x0, 5083,
+	/*5083*/ uint16(xSetOp), uint16(ADDPS),
+	/*5085*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5087*/ uint16(xReadSlashR),
+	/*5088*/ uint16(xArgXmm1),
+	/*5089*/ uint16(xArgXmm2M128),
+	/*5090*/ uint16(xMatch),
+	/*5091*/ uint16(xSetOp), uint16(ADDPD),
+	/*5093*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5095*/ uint16(xReadSlashR),
+	/*5096*/ uint16(xArgXmm1),
+	/*5097*/ uint16(xArgXmm2M128),
+	/*5098*/ uint16(xMatch),
+	/*5099*/ uint16(xSetOp), uint16(ADDSD),
+	/*5101*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5103*/ uint16(xReadSlashR),
+	/*5104*/ uint16(xArgXmm1),
+	/*5105*/ uint16(xArgXmm2M64),
+	/*5106*/ uint16(xMatch),
+	/*5107*/ uint16(xSetOp), uint16(ADDSS),
+	/*5109*/ uint16(xSetFeature), uint16(FeatureSSE),
 	/*5111*/ uint16(xReadSlashR),
-	/*5112*/ uint16(xArgRM32),
-	/*5113*/ uint16(xArgMm),
+	/*5112*/ uint16(xArgXmm1),
+	/*5113*/ uint16(xArgXmm2M32),
 	/*5114*/ uint16(xMatch),
-	/*5115*/ uint16(xCondDataSize), 5119, 5125, 0,
-	/*5119*/ uint16(xSetOp), uint16(MOVD),
-	/*5121*/ uint16(xReadSlashR),
-	/*5122*/ uint16(xArgRM32),
-	/*5123*/ uint16(xArgXmm),
-	/*5124*/ uint16(xMatch),
-	/*5125*/ uint16(xSetOp), uint16(MOVD),
-	/*5127*/ uint16(xReadSlashR),
-	/*5128*/ uint16(xArgRM32),
-	/*5129*/ uint16(xArgXmm),
-	/*5130*/ uint16(xMatch),
-	/*5131*/ uint16(xSetOp), uint16(MOVQ),
-	/*5133*/ uint16(xReadSlashR),
-	/*5134*/ uint16(xArgXmm1),
-	/*5135*/ uint16(xArgXmm2M64),
-	/*5136*/ uint16(xMatch),
-	/*5137*/ uint16(xCondPrefix), 3,
-	0xF3, 5131,
-	0x66, 5155,
-	0x0, 5145,
-	/*5145*/ uint16(xCondDataSize), 5103, 5109, 5149,
-	/*5149*/ uint16(xSetOp), uint16(MOVQ),
-	/*5151*/ uint16(xReadSlashR),
-	/*5152*/ uint16(xArgRM64),
-	/*5153*/ uint16(xArgMm),
-	/*5154*/ uint16(xMatch),
-	/*5155*/ uint16(xCondDataSize), 5119, 5125, 5159,
-	/*5159*/ uint16(xSetOp), uint16(MOVQ),
-	/*5161*/ uint16(xReadSlashR),
-	/*5162*/ uint16(xArgRM64),
-	/*5163*/ uint16(xArgXmm),
-	/*5164*/ uint16(xMatch),
-	/*5165*/ uint16(xCondPrefix), 3,
-	0xF3, 5185,
-	0x66, 5179,
-	0x0, 5173,
-	/*5173*/ uint16(xSetOp), uint16(MOVQ),
-	/*5175*/ uint16(xReadSlashR),
-	/*5176*/ uint16(xArgMmM64),
-	/*5177*/ uint16(xArgMm),
-	/*5178*/ uint16(xMatch),
-	/*5179*/ uint16(xSetOp), uint16(MOVDQA),
-	/*5181*/ uint16(xReadSlashR),
-	/*5182*/ uint16(xArgXmm2M128),
-	/*5183*/ uint16(xArgXmm1),
-	/*5184*/ uint16(xMatch),
-	/*5185*/ uint16(xSetOp), uint16(MOVDQU),
+	/*5115*/ uint16(xCondPrefix), 4,
+	0xF3, 5149,
+	0xF2, 5141,
+	0x66, 5133,
+	0x0, 5125,
+	/*5125*/ uint16(xSetOp), uint16(MULPS),
+	/*5127*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5129*/ uint16(xReadSlashR),
+	/*5130*/ uint16(xArgXmm1),
+	/*5131*/ uint16(xArgXmm2M128),
+	/*5132*/ uint16(xMatch),
+	/*5133*/ uint16(xSetOp), uint16(MULPD),
+	/*5135*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5137*/ uint16(xReadSlashR),
+	/*5138*/ uint16(xArgXmm1),
+	/*5139*/ uint16(xArgXmm2M128),
+	/*5140*/ uint16(xMatch),
+	/*5141*/ uint16(xSetOp), uint16(MULSD),
+	/*5143*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5145*/ uint16(xReadSlashR),
+	/*5146*/ uint16(xArgXmm1),
+	/*5147*/ uint16(xArgXmm2M64),
+	/*5148*/ uint16(xMatch),
+	/*5149*/ uint16(xSetOp), uint16(MULSS),
+	/*5151*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5153*/ uint16(xReadSlashR),
+	/*5154*/ uint16(xArgXmm1),
+	/*5155*/ uint16(xArgXmm2M32),
+	/*5156*/ uint16(xMatch),
+	/*5157*/ uint16(xCondPrefix), 4,
+	0xF3, 5191,
+	0xF2, 5183,
+	0x66, 5175,
+	0x0, 5167,
+	/*5167*/ uint16(xSetOp), uint16(CVTPS2PD),
+	/*5169*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5171*/ uint16(xReadSlashR),
+	/*5172*/ uint16(xArgXmm1),
+	/*5173*/ uint16(xArgXmm2M64),
+	/*5174*/ uint16(xMatch),
+	/*5175*/ uint16(xSetOp), uint16(CVTPD2PS),
+	/*5177*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5179*/ uint16(xReadSlashR),
+	/*5180*/ uint16(xArgXmm1),
+	/*5181*/ uint16(xArgXmm2M128),
+	/*5182*/ uint16(xMatch),
+	/*5183*/ uint16(xSetOp), uint16(CVTSD2SS),
+	/*5185*/ uint16(xSetFeature), uint16(FeatureSSE2),
 	/*5187*/ uint16(xReadSlashR),
-	/*5188*/ uint16(xArgXmm2M128),
-	/*5189*/ uint16(xArgXmm1),
+	/*5188*/ uint16(xArgXmm1),
+	/*5189*/ uint16(xArgXmm2M64),
 	/*5190*/ uint16(xMatch),
-	/*5191*/ uint16(xCondIs64), 5194, 5208,
-	/*5194*/ uint16(xCondDataSize), 5198, 5203, 0,
-	/*5198*/ uint16(xSetOp), uint16(JO),
-	/*5200*/ uint16(xReadCw),
-	/*5201*/ uint16(xArgRel16),
-	/*5202*/ uint16(xMatch),
-	/*5203*/ uint16(xSetOp), uint16(JO),
-	/*5205*/ uint16(xReadCd),
-	/*5206*/ uint16(xArgRel32),
-	/*5207*/ uint16(xMatch),
-	/*5208*/ uint16(xCondDataSize), 5212, 5203, 5217,
-	/*5212*/ uint16(xSetOp), uint16(JO),
-	/*5214*/ uint16(xReadCd),
-	/*5215*/ uint16(xArgRel32),
-	/*5216*/ uint16(xMatch),
-	/*5217*/ uint16(xSetOp), uint16(JO),
-	/*5219*/ uint16(xReadCd),
-	/*5220*/ uint16(xArgRel32),
-	/*5221*/ uint16(xMatch),
-	/*5222*/ uint16(xCondIs64), 5225, 5239,
-	/*5225*/ uint16(xCondDataSize), 5229, 5234, 0,
-	/*5229*/ uint16(xSetOp), uint16(JNO),
-	/*5231*/ uint16(xReadCw),
-	/*5232*/ uint16(xArgRel16),
-	/*5233*/ uint16(xMatch),
-	/*5234*/ uint16(xSetOp), uint16(JNO),
-	/*5236*/ uint16(xReadCd),
-	/*5237*/ uint16(xArgRel32),
-	/*5238*/ uint16(xMatch),
-	/*5239*/ uint16(xCondDataSize), 5243, 5234, 5248,
-	/*5243*/ uint16(xSetOp), uint16(JNO),
-	/*5245*/ uint16(xReadCd),
-	/*5246*/ uint16(xArgRel32),
-	/*5247*/ uint16(xMatch),
-	/*5248*/ uint16(xSetOp), uint16(JNO),
-	/*5250*/ uint16(xReadCd),
-	/*5251*/ uint16(xArgRel32),
-	/*5252*/ uint16(xMatch),
-	/*5253*/ uint16(xCondIs64), 5256, 5270,
-	/*5256*/ uint16(xCondDataSize), 5260, 5265, 0,
-	/*5260*/ uint16(xSetOp), uint16(JB),
-	/*5262*/ uint16(xReadCw),
-	/*5263*/ uint16(xArgRel16),
+	/*5191*/ uint16(xSetOp), uint16(CVTSS2SD),
+	/*5193*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5195*/ uint16(xReadSlashR),
+	/*5196*/ uint16(xArgXmm1),
+	/*5197*/ uint16(xArgXmm2M32),
+	/*5198*/ uint16(xMatch),
+	/*5199*/ uint16(xCondPrefix), 3,
+	0xF3, 5223,
+	0x66, 5215,
+	0x0, 5207,
+	/*5207*/ uint16(xSetOp), uint16(CVTDQ2PS),
+	/*5209*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5211*/ uint16(xReadSlashR),
+	/*5212*/ uint16(xArgXmm1),
+	/*5213*/ uint16(xArgXmm2M128),
+	/*5214*/ uint16(xMatch),
+	/*5215*/ uint16(xSetOp), uint16(CVTPS2DQ),
+	/*5217*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5219*/ uint16(xReadSlashR),
+	/*5220*/ uint16(xArgXmm1),
+	/*5221*/ uint16(xArgXmm2M128),
+	/*5222*/ uint16(xMatch),
+	/*5223*/ uint16(xSetOp), uint16(CVTTPS2DQ),
+	/*5225*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5227*/ uint16(xReadSlashR),
+	/*5228*/ uint16(xArgXmm1),
+	/*5229*/ uint16(xArgXmm2M128),
+	/*5230*/ uint16(xMatch),
+	/*5231*/ uint16(xCondPrefix), 4,
+	0xF3, 5265,
+	0xF2, 5257,
+	0x66, 5249,
+	0x0, 5241,
+	/*5241*/ uint16(xSetOp), uint16(SUBPS),
+	/*5243*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5245*/ uint16(xReadSlashR),
+	/*5246*/ uint16(xArgXmm1),
+	/*5247*/ uint16(xArgXmm2M128),
+	/*5248*/ uint16(xMatch),
+	/*5249*/ uint16(xSetOp), uint16(SUBPD),
+	/*5251*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5253*/ uint16(xReadSlashR),
+	/*5254*/ uint16(xArgXmm1),
+	/*5255*/ uint16(xArgXmm2M128),
+	/*5256*/ uint16(xMatch),
+	/*5257*/ uint16(xSetOp), uint16(SUBSD),
+	/*5259*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5261*/ uint16(xReadSlashR),
+	/*5262*/ uint16(xArgXmm1),
+	/*5263*/ uint16(xArgXmm2M64),
 	/*5264*/ uint16(xMatch),
-	/*5265*/ uint16(xSetOp), uint16(JB),
-	/*5267*/ uint16(xReadCd),
-	/*5268*/ uint16(xArgRel32),
-	/*5269*/ uint16(xMatch),
-	/*5270*/ uint16(xCondDataSize), 5274, 5265, 5279,
-	/*5274*/ uint16(xSetOp), uint16(JB),
-	/*5276*/ uint16(xReadCd),
-	/*5277*/ uint16(xArgRel32),
-	/*5278*/ uint16(xMatch),
-	/*5279*/ uint16(xSetOp), uint16(JB),
-	/*5281*/ uint16(xReadCd),
-	/*5282*/ uint16(xArgRel32),
-	/*5283*/ uint16(xMatch),
-	/*5284*/ uint16(xCondIs64), 5287, 5301,
-	/*5287*/ uint16(xCondDataSize), 5291, 5296, 0,
-	/*5291*/ uint16(xSetOp), uint16(JAE),
-	/*5293*/ uint16(xReadCw),
-	/*5294*/ uint16(xArgRel16),
-	/*5295*/ uint16(xMatch),
-	/*5296*/ uint16(xSetOp), uint16(JAE),
-	/*5298*/ uint16(xReadCd),
-	/*5299*/ uint16(xArgRel32),
-	/*5300*/ uint16(xMatch),
-	/*5301*/ uint16(xCondDataSize), 5305, 5296, 5310,
-	/*5305*/ uint16(xSetOp), uint16(JAE),
-	/*5307*/ uint16(xReadCd),
-	/*5308*/ uint16(xArgRel32),
-	/*5309*/ uint16(xMatch),
-	/*5310*/ uint16(xSetOp), uint16(JAE),
-	/*5312*/ uint16(xReadCd),
-	/*5313*/ uint16(xArgRel32),
+	/*5265*/ uint16(xSetOp), uint16(SUBSS),
+	/*5267*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5269*/ uint16(xReadSlashR),
+	/*5270*/ uint16(xArgXmm1),
+	/*5271*/ uint16(xArgXmm2M32),
+	/*5272*/ uint16(xMatch),
+	/*5273*/ uint16(xCondPrefix), 4,
+	0xF3, 5307,
+	0xF2, 5299,
+	0x66, 5291,
+	0x0, 5283,
+	/*5283*/ uint16(xSetOp), uint16(MINPS),
+	/*5285*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5287*/ uint16(xReadSlashR),
+	/*5288*/ uint16(xArgXmm1),
+	/*5289*/ uint16(xArgXmm2M128),
+	/*5290*/ uint16(xMatch),
+	/*5291*/ uint16(xSetOp), uint16(MINPD),
+	/*5293*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5295*/ uint16(xReadSlashR),
+	/*5296*/ uint16(xArgXmm1),
+	/*5297*/ uint16(xArgXmm2M128),
+	/*5298*/ uint16(xMatch),
+	/*5299*/ uint16(xSetOp), uint16(MINSD),
+	/*5301*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5303*/ uint16(xReadSlashR),
+	/*5304*/ uint16(xArgXmm1),
+	/*5305*/ uint16(xArgXmm2M64),
+	/*5306*/ uint16(xMatch),
+	/*5307*/ uint16(xSetOp), uint16(MINSS),
+	/*5309*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5311*/ uint16(xReadSlashR),
+	/*5312*/ uint16(xArgXmm1),
+	/*5313*/ uint16(xArgXmm2M32),
 	/*5314*/ uint16(xMatch),
-	/*5315*/ uint16(xCondIs64), 5318, 5332,
-	/*5318*/ uint16(xCondDataSize), 5322, 5327, 0,
-	/*5322*/ uint16(xSetOp), uint16(JE),
-	/*5324*/ uint16(xReadCw),
-	/*5325*/ uint16(xArgRel16),
-	/*5326*/ uint16(xMatch),
-	/*5327*/ uint16(xSetOp), uint16(JE),
-	/*5329*/ uint16(xReadCd),
-	/*5330*/ uint16(xArgRel32),
-	/*5331*/ uint16(xMatch),
-	/*5332*/ uint16(xCondDataSize), 5336, 5327, 5341,
-	/*5336*/ uint16(xSetOp), uint16(JE),
-	/*5338*/ uint16(xReadCd),
-	/*5339*/ uint16(xArgRel32),
+	/*5315*/ uint16(xCondPrefix), 4,
+	0xF3, 5349,
+	0xF2, 5341,
+	0x66, 5333,
+	0x0, 5325,
+	/*5325*/ uint16(xSetOp), uint16(DIVPS),
+	/*5327*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5329*/ uint16(xReadSlashR),
+	/*5330*/ uint16(xArgXmm1),
+	/*5331*/ uint16(xArgXmm2M128),
+	/*5332*/ uint16(xMatch),
+	/*5333*/ uint16(xSetOp), uint16(DIVPD),
+	/*5335*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5337*/ uint16(xReadSlashR),
+	/*5338*/ uint16(xArgXmm1),
+	/*5339*/ uint16(xArgXmm2M128),
 	/*5340*/ uint16(xMatch),
-	/*5341*/ uint16(xSetOp), uint16(JE),
-	/*5343*/ uint16(xReadCd),
-	/*5344*/ uint16(xArgRel32),
-	/*5345*/ uint16(xMatch),
-	/*5346*/ uint16(xCondIs64), 5349, 5363,
-	/*5349*/ uint16(xCondDataSize), 5353, 5358, 0,
-	/*5353*/ uint16(xSetOp), uint16(JNE),
-	/*5355*/ uint16(xReadCw),
-	/*5356*/ uint16(xArgRel16),
-	/*5357*/ uint16(xMatch),
-	/*5358*/ uint16(xSetOp), uint16(JNE),
-	/*5360*/ uint16(xReadCd),
-	/*5361*/ uint16(xArgRel32),
-	/*5362*/ uint16(xMatch),
-	/*5363*/ uint16(xCondDataSize), 5367, 5358, 5372,
-	/*5367*/ uint16(xSetOp), uint16(JNE),
-	/*5369*/ uint16(xReadCd),
-	/*5370*/ uint16(xArgRel32),
-	/*5371*/ uint16(xMatch),
-	/*5372*/ uint16(xSetOp), uint16(JNE),
-	/*5374*/ uint16(xReadCd),
-	/*5375*/ uint16(xArgRel32),
-	/*5376*/ uint16(xMatch),
-	/*5377*/ uint16(xCondIs64), 5380, 5394,
-	/*5380*/ uint16(xCondDataSize), 5384, 5389, 0,
-	/*5384*/ uint16(xSetOp), uint16(JBE),
-	/*5386*/ uint16(xReadCw),
-	/*5387*/ uint16(xArgRel16),
-	/*5388*/ uint16(xMatch),
-	/*5389*/ uint16(xSetOp), uint16(JBE),
-	/*5391*/ uint16(xReadCd),
-	/*5392*/ uint16(xArgRel32),
-	/*5393*/ uint16(xMatch),
-	/*5394*/ uint16(xCondDataSize), 5398, 5389, 5403,
-	/*5398*/ uint16(xSetOp), uint16(JBE),
-	/*5400*/ uint16(xReadCd),
-	/*5401*/ uint16(xArgRel32),
-	/*5402*/ uint16(xMatch),
-	/*5403*/ uint16(xSetOp), uint16(JBE),
-	/*5405*/ uint16(xReadCd),
-	/*5406*/ uint16(xArgRel32),
-	/*5407*/ uint16(xMatch),
-	/*5408*/ uint16(xCondIs64), 5411, 5425,
-	/*5411*/ uint16(xCondDataSize), 5415, 5420, 0,
-	/*5415*/ uint16(xSetOp), uint16(JA),
-	/*5417*/ uint16(xReadCw),
-	/*5418*/ uint16(xArgRel16),
-	/*5419*/ uint16(xMatch),
-	/*5420*/ uint16(xSetOp), uint16(JA),
-	/*5422*/ uint16(xReadCd),
-	/*5423*/ uint16(xArgRel32),
-	/*5424*/ uint16(xMatch),
-	/*5425*/ uint16(xCondDataSize), 5429, 5420, 5434,
-	/*5429*/ uint16(xSetOp), uint16(JA),
-	/*5431*/ uint16(xReadCd),
-	/*5432*/ uint16(xArgRel32),
-	/*5433*/ uint16(xMatch),
-	/*5434*/ uint16(xSetOp), uint16(JA),
-	/*5436*/ uint16(xReadCd),
-	/*5437*/ uint16(xArgRel32),
-	/*5438*/ uint16(xMatch),
-	/*5439*/ uint16(xCondIs64), 5442, 5456,
-	/*5442*/ uint16(xCondDataSize), 5446, 5451, 0,
-	/*5446*/ uint16(xSetOp), uint16(JS),
-	/*5448*/ uint16(xReadCw),
-	/*5449*/ uint16(xArgRel16),
-	/*5450*/ uint16(xMatch),
-	/*5451*/ uint16(xSetOp), uint16(JS),
-	/*5453*/ uint16(xReadCd),
-	/*5454*/ uint16(xArgRel32),
-	/*5455*/ uint16(xMatch),
-	/*5456*/ uint16(xCondDataSize), 5460, 5451, 5465,
-	/*5460*/ uint16(xSetOp), uint16(JS),
-	/*5462*/ uint16(xReadCd),
-	/*5463*/ uint16(xArgRel32),
+	/*5341*/ uint16(xSetOp), uint16(DIVSD),
+	/*5343*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5345*/ uint16(xReadSlashR),
+	/*5346*/ uint16(xArgXmm1),
+	/*5347*/ uint16(xArgXmm2M64),
+	/*5348*/ uint16(xMatch),
+	/*5349*/ uint16(xSetOp), uint16(DIVSS),
+	/*5351*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5353*/ uint16(xReadSlashR),
+	/*5354*/ uint16(xArgXmm1),
+	/*5355*/ uint16(xArgXmm2M32),
+	/*5356*/ uint16(xMatch),
+	/*5357*/ uint16(xCondPrefix), 4,
+	0xF3, 5391,
+	0xF2, 5383,
+	0x66, 5375,
+	0x0, 5367,
+	/*5367*/ uint16(xSetOp), uint16(MAXPS),
+	/*5369*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5371*/ uint16(xReadSlashR),
+	/*5372*/ uint16(xArgXmm1),
+	/*5373*/ uint16(xArgXmm2M128),
+	/*5374*/ uint16(xMatch),
+	/*5375*/ uint16(xSetOp), uint16(MAXPD),
+	/*5377*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5379*/ uint16(xReadSlashR),
+	/*5380*/ uint16(xArgXmm1),
+	/*5381*/ uint16(xArgXmm2M128),
+	/*5382*/ uint16(xMatch),
+	/*5383*/ uint16(xSetOp), uint16(MAXSD),
+	/*5385*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5387*/ uint16(xReadSlashR),
+	/*5388*/ uint16(xArgXmm1),
+	/*5389*/ uint16(xArgXmm2M64),
+	/*5390*/ uint16(xMatch),
+	/*5391*/ uint16(xSetOp), uint16(MAXSS),
+	/*5393*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5395*/ uint16(xReadSlashR),
+	/*5396*/ uint16(xArgXmm1),
+	/*5397*/ uint16(xArgXmm2M32),
+	/*5398*/ uint16(xMatch),
+	/*5399*/ uint16(xCondPrefix), 2,
+	0x66, 5413,
+	0x0, 5405,
+	/*5405*/ uint16(xSetOp), uint16(PUNPCKLBW),
+	/*5407*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5409*/ uint16(xReadSlashR),
+	/*5410*/ uint16(xArgMm),
+	/*5411*/ uint16(xArgMmM32),
+	/*5412*/ uint16(xMatch),
+	/*5413*/ uint16(xSetOp), uint16(PUNPCKLBW),
+	/*5415*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5417*/ uint16(xReadSlashR),
+	/*5418*/ uint16(xArgXmm1),
+	/*5419*/ uint16(xArgXmm2M128),
+	/*5420*/ uint16(xMatch),
+	/*5421*/ uint16(xCondPrefix), 2,
+	0x66, 5435,
+	0x0, 5427,
+	/*5427*/ uint16(xSetOp), uint16(PUNPCKLWD),
+	/*5429*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5431*/ uint16(xReadSlashR),
+	/*5432*/ uint16(xArgMm),
+	/*5433*/ uint16(xArgMmM32),
+	/*5434*/ uint16(xMatch),
+	/*5435*/ uint16(xSetOp), uint16(PUNPCKLWD),
+	/*5437*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5439*/ uint16(xReadSlashR),
+	/*5440*/ uint16(xArgXmm1),
+	/*5441*/ uint16(xArgXmm2M128),
+	/*5442*/ uint16(xMatch),
+	/*5443*/ uint16(xCondPrefix), 2,
+	0x66, 5457,
+	0x0, 5449,
+	/*5449*/ uint16(xSetOp), uint16(PUNPCKLDQ),
+	/*5451*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5453*/ uint16(xReadSlashR),
+	/*5454*/ uint16(xArgMm),
+	/*5455*/ uint16(xArgMmM32),
+	/*5456*/ uint16(xMatch),
+	/*5457*/ uint16(xSetOp), uint16(PUNPCKLDQ),
+	/*5459*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5461*/ uint16(xReadSlashR),
+	/*5462*/ uint16(xArgXmm1),
+	/*5463*/ uint16(xArgXmm2M128),
 	/*5464*/ uint16(xMatch),
-	/*5465*/ uint16(xSetOp), uint16(JS),
-	/*5467*/ uint16(xReadCd),
-	/*5468*/ uint16(xArgRel32),
-	/*5469*/ uint16(xMatch),
-	/*5470*/ uint16(xCondIs64), 5473, 5487,
-	/*5473*/ uint16(xCondDataSize), 5477, 5482, 0,
-	/*5477*/ uint16(xSetOp), uint16(JNS),
-	/*5479*/ uint16(xReadCw),
-	/*5480*/ uint16(xArgRel16),
-	/*5481*/ uint16(xMatch),
-	/*5482*/ uint16(xSetOp), uint16(JNS),
-	/*5484*/ uint16(xReadCd),
-	/*5485*/ uint16(xArgRel32),
+	/*5465*/ uint16(xCondPrefix), 2,
+	0x66, 5479,
+	0x0, 5471,
+	/*5471*/ uint16(xSetOp), uint16(PACKSSWB),
+	/*5473*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5475*/ uint16(xReadSlashR),
+	/*5476*/ uint16(xArgMm1),
+	/*5477*/ uint16(xArgMm2M64),
+	/*5478*/ uint16(xMatch),
+	/*5479*/ uint16(xSetOp), uint16(PACKSSWB),
+	/*5481*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5483*/ uint16(xReadSlashR),
+	/*5484*/ uint16(xArgXmm1),
+	/*5485*/ uint16(xArgXmm2M128),
 	/*5486*/ uint16(xMatch),
-	/*5487*/ uint16(xCondDataSize), 5491, 5482, 5496,
-	/*5491*/ uint16(xSetOp), uint16(JNS),
-	/*5493*/ uint16(xReadCd),
-	/*5494*/ uint16(xArgRel32),
-	/*5495*/ uint16(xMatch),
-	/*5496*/ uint16(xSetOp), uint16(JNS),
-	/*5498*/ uint16(xReadCd),
-	/*5499*/ uint16(xArgRel32),
+	/*5487*/ uint16(xCondPrefix), 2,
+	0x66, 5501,
+	0x0, 5493,
+	/*5493*/ uint16(xSetOp), uint16(PCMPGTB),
+	/*5495*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5497*/ uint16(xReadSlashR),
+	/*5498*/ uint16(xArgMm),
+	/*5499*/ uint16(xArgMmM64),
 	/*5500*/ uint16(xMatch),
-	/*5501*/ uint16(xCondIs64), 5504, 5518,
-	/*5504*/ uint16(xCondDataSize), 5508, 5513, 0,
-	/*5508*/ uint16(xSetOp), uint16(JP),
-	/*5510*/ uint16(xReadCw),
-	/*5511*/ uint16(xArgRel16),
-	/*5512*/ uint16(xMatch),
-	/*5513*/ uint16(xSetOp), uint16(JP),
-	/*5515*/ uint16(xReadCd),
-	/*5516*/ uint16(xArgRel32),
-	/*5517*/ uint16(xMatch),
-	/*5518*/ uint16(xCondDataSize), 5522, 5513, 5527,
-	/*5522*/ uint16(xSetOp), uint16(JP),
-	/*5524*/ uint16(xReadCd),
-	/*5525*/ uint16(xArgRel32),
-	/*5526*/ uint16(xMatch),
-	/*5527*/ uint16(xSetOp), uint16(JP),
-	/*5529*/ uint16(xReadCd),
-	/*5530*/ uint16(xArgRel32),
-	/*5531*/ uint16(xMatch),
-	/*5532*/ uint16(xCondIs64), 5535, 5549,
-	/*5535*/ uint16(xCondDataSize), 5539, 5544, 0,
-	/*5539*/ uint16(xSetOp), uint16(JNP),
-	/*5541*/ uint16(xReadCw),
-	/*5542*/ uint16(xArgRel16),
-	/*5543*/ uint16(xMatch),
-	/*5544*/ uint16(xSetOp), uint16(JNP),
-	/*5546*/ uint16(xReadCd),
-	/*5547*/ uint16(xArgRel32),
-	/*5548*/ uint16(xMatch),
-	/*5549*/ uint16(xCondDataSize), 5553, 5544, 5558,
-	/*5553*/ uint16(xSetOp), uint16(JNP),
-	/*5555*/ uint16(xReadCd),
-	/*5556*/ uint16(xArgRel32),
-	/*5557*/ uint16(xMatch),
-	/*5558*/ uint16(xSetOp), uint16(JNP),
-	/*5560*/ uint16(xReadCd),
-	/*5561*/ uint16(xArgRel32),
-	/*5562*/ uint16(xMatch),
-	/*5563*/ uint16(xCondIs64), 5566, 5580,
-	/*5566*/ uint16(xCondDataSize), 5570, 5575, 0,
-	/*5570*/ uint16(xSetOp), uint16(JL),
-	/*5572*/ uint16(xReadCw),
-	/*5573*/ uint16(xArgRel16),
+	/*5501*/ uint16(xSetOp), uint16(PCMPGTB),
+	/*5503*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5505*/ uint16(xReadSlashR),
+	/*5506*/ uint16(xArgXmm1),
+	/*5507*/ uint16(xArgXmm2M128),
+	/*5508*/ uint16(xMatch),
+	/*5509*/ uint16(xCondPrefix), 2,
+	0x66, 5523,
+	0x0, 5515,
+	/*5515*/ uint16(xSetOp), uint16(PCMPGTW),
+	/*5517*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5519*/ uint16(xReadSlashR),
+	/*5520*/ uint16(xArgMm),
+	/*5521*/ uint16(xArgMmM64),
+	/*5522*/ uint16(xMatch),
+	/*5523*/ uint16(xSetOp), uint16(PCMPGTW),
+	/*5525*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5527*/ uint16(xReadSlashR),
+	/*5528*/ uint16(xArgXmm1),
+	/*5529*/ uint16(xArgXmm2M128),
+	/*5530*/ uint16(xMatch),
+	/*5531*/ uint16(xCondPrefix), 2,
+	0x66, 5545,
+	0x0, 5537,
+	/*5537*/ uint16(xSetOp), uint16(PCMPGTD),
+	/*5539*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5541*/ uint16(xReadSlashR),
+	/*5542*/ uint16(xArgMm),
+	/*5543*/ uint16(xArgMmM64),
+	/*5544*/ uint16(xMatch),
+	/*5545*/ uint16(xSetOp), uint16(PCMPGTD),
+	/*5547*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5549*/ uint16(xReadSlashR),
+	/*5550*/ uint16(xArgXmm1),
+	/*5551*/ uint16(xArgXmm2M128),
+	/*5552*/ uint16(xMatch),
+	/*5553*/ uint16(xCondPrefix), 2,
+	0x66, 5567,
+	0x0, 5559,
+	/*5559*/ uint16(xSetOp), uint16(PACKUSWB),
+	/*5561*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5563*/ uint16(xReadSlashR),
+	/*5564*/ uint16(xArgMm),
+	/*5565*/ uint16(xArgMmM64),
+	/*5566*/ uint16(xMatch),
+	/*5567*/ uint16(xSetOp), uint16(PACKUSWB),
+	/*5569*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5571*/ uint16(xReadSlashR),
+	/*5572*/ uint16(xArgXmm1),
+	/*5573*/ uint16(xArgXmm2M128),
 	/*5574*/ uint16(xMatch),
-	/*5575*/ uint16(xSetOp), uint16(JL),
-	/*5577*/ uint16(xReadCd),
-	/*5578*/ uint16(xArgRel32),
-	/*5579*/ uint16(xMatch),
-	/*5580*/ uint16(xCondDataSize), 5584, 5575, 5589,
-	/*5584*/ uint16(xSetOp), uint16(JL),
-	/*5586*/ uint16(xReadCd),
-	/*5587*/ uint16(xArgRel32),
+	/*5575*/ uint16(xCondPrefix), 2,
+	0x66, 5589,
+	0x0, 5581,
+	/*5581*/ uint16(xSetOp), uint16(PUNPCKHBW),
+	/*5583*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5585*/ uint16(xReadSlashR),
+	/*5586*/ uint16(xArgMm),
+	/*5587*/ uint16(xArgMmM64),
 	/*5588*/ uint16(xMatch),
-	/*5589*/ uint16(xSetOp), uint16(JL),
-	/*5591*/ uint16(xReadCd),
-	/*5592*/ uint16(xArgRel32),
-	/*5593*/ uint16(xMatch),
-	/*5594*/ uint16(xCondIs64), 5597, 5611,
-	/*5597*/ uint16(xCondDataSize), 5601, 5606, 0,
-	/*5601*/ uint16(xSetOp), uint16(JGE),
-	/*5603*/ uint16(xReadCw),
-	/*5604*/ uint16(xArgRel16),
-	/*5605*/ uint16(xMatch),
-	/*5606*/ uint16(xSetOp), uint16(JGE),
-	/*5608*/ uint16(xReadCd),
-	/*5609*/ uint16(xArgRel32),
+	/*5589*/ uint16(xSetOp), uint16(PUNPCKHBW),
+	/*5591*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5593*/ uint16(xReadSlashR),
+	/*5594*/ uint16(xArgXmm1),
+	/*5595*/ uint16(xArgXmm2M128),
+	/*5596*/ uint16(xMatch),
+	/*5597*/ uint16(xCondPrefix), 2,
+	0x66, 5611,
+	0x0, 5603,
+	/*5603*/ uint16(xSetOp), uint16(PUNPCKHWD),
+	/*5605*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5607*/ uint16(xReadSlashR),
+	/*5608*/ uint16(xArgMm),
+	/*5609*/ uint16(xArgMmM64),
 	/*5610*/ uint16(xMatch),
-	/*5611*/ uint16(xCondDataSize), 5615, 5606, 5620,
-	/*5615*/ uint16(xSetOp), uint16(JGE),
-	/*5617*/ uint16(xReadCd),
-	/*5618*/ uint16(xArgRel32),
-	/*5619*/ uint16(xMatch),
-	/*5620*/ uint16(xSetOp), uint16(JGE),
-	/*5622*/ uint16(xReadCd),
-	/*5623*/ uint16(xArgRel32),
-	/*5624*/ uint16(xMatch),
-	/*5625*/ uint16(xCondIs64), 5628, 5642,
-	/*5628*/ uint16(xCondDataSize), 5632, 5637, 0,
-	/*5632*/ uint16(xSetOp), uint16(JLE),
-	/*5634*/ uint16(xReadCw),
-	/*5635*/ uint16(xArgRel16),
-	/*5636*/ uint16(xMatch),
-	/*5637*/ uint16(xSetOp), uint16(JLE),
-	/*5639*/ uint16(xReadCd),
-	/*5640*/ uint16(xArgRel32),
-	/*5641*/ uint16(xMatch),
-	/*5642*/ uint16(xCondDataSize), 5646, 5637, 5651,
-	/*5646*/ uint16(xSetOp), uint16(JLE),
-	/*5648*/ uint16(xReadCd),
-	/*5649*/ uint16(xArgRel32),
-	/*5650*/ uint16(xMatch),
-	/*5651*/ uint16(xSetOp), uint16(JLE),
-	/*5653*/ uint16(xReadCd),
-	/*5654*/ uint16(xArgRel32),
-	/*5655*/ uint16(xMatch),
-	/*5656*/ uint16(xCondIs64), 5659, 5673,
-	/*5659*/ uint16(xCondDataSize), 5663, 5668, 0,
-	/*5663*/ uint16(xSetOp), uint16(JG),
-	/*5665*/ uint16(xReadCw),
-	/*5666*/ uint16(xArgRel16),
-	/*5667*/ uint16(xMatch),
-	/*5668*/ uint16(xSetOp), uint16(JG),
-	/*5670*/ uint16(xReadCd),
-	/*5671*/ uint16(xArgRel32),
-	/*5672*/ uint16(xMatch),
-	/*5673*/ uint16(xCondDataSize), 5677, 5668, 5682,
-	/*5677*/ uint16(xSetOp), uint16(JG),
-	/*5679*/ uint16(xReadCd),
-	/*5680*/ uint16(xArgRel32),
-	/*5681*/ uint16(xMatch),
-	/*5682*/ uint16(xSetOp), uint16(JG),
-	/*5684*/ uint16(xReadCd),
-	/*5685*/ uint16(xArgRel32),
+	/*5611*/ uint16(xSetOp), uint16(PUNPCKHWD),
+	/*5613*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5615*/ uint16(xReadSlashR),
+	/*5616*/ uint16(xArgXmm1),
+	/*5617*/ uint16(xArgXmm2M128),
+	/*5618*/ uint16(xMatch),
+	/*5619*/ uint16(xCondPrefix), 2,
+	0x66, 5633,
+	0x0, 5625,
+	/*5625*/ uint16(xSetOp), uint16(PUNPCKHDQ),
+	/*5627*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5629*/ uint16(xReadSlashR),
+	/*5630*/ uint16(xArgMm),
+	/*5631*/ uint16(xArgMmM64),
+	/*5632*/ uint16(xMatch),
+	/*5633*/ uint16(xSetOp), uint16(PUNPCKHDQ),
+	/*5635*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5637*/ uint16(xReadSlashR),
+	/*5638*/ uint16(xArgXmm1),
+	/*5639*/ uint16(xArgXmm2M128),
+	/*5640*/ uint16(xMatch),
+	/*5641*/ uint16(xCondPrefix), 2,
+	0x66, 5655,
+	0x0, 5647,
+	/*5647*/ uint16(xSetOp), uint16(PACKSSDW),
+	/*5649*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5651*/ uint16(xReadSlashR),
+	/*5652*/ uint16(xArgMm1),
+	/*5653*/ uint16(xArgMm2M64),
+	/*5654*/ uint16(xMatch),
+	/*5655*/ uint16(xSetOp), uint16(PACKSSDW),
+	/*5657*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5659*/ uint16(xReadSlashR),
+	/*5660*/ uint16(xArgXmm1),
+	/*5661*/ uint16(xArgXmm2M128),
+	/*5662*/ uint16(xMatch),
+	/*5663*/ uint16(xCondPrefix), 1,
+	0x66, 5667,
+	/*5667*/ uint16(xSetOp), uint16(PUNPCKLQDQ),
+	/*5669*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5671*/ uint16(xReadSlashR),
+	/*5672*/ uint16(xArgXmm1),
+	/*5673*/ uint16(xArgXmm2M128),
+	/*5674*/ uint16(xMatch),
+	/*5675*/ uint16(xCondPrefix), 1,
+	0x66, 5679,
+	/*5679*/ uint16(xSetOp), uint16(PUNPCKHQDQ),
+	/*5681*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5683*/ uint16(xReadSlashR),
+	/*5684*/ uint16(xArgXmm1),
+	/*5685*/ uint16(xArgXmm2M128),
 	/*5686*/ uint16(xMatch),
-	/*5687*/ uint16(xSetOp), uint16(SETO),
-	/*5689*/ uint16(xReadSlashR),
-	/*5690*/ uint16(xArgRM8),
-	/*5691*/ uint16(xMatch),
-	/*5692*/ uint16(xSetOp), uint16(SETNO),
-	/*5694*/ uint16(xReadSlashR),
-	/*5695*/ uint16(xArgRM8),
-	/*5696*/ uint16(xMatch),
-	/*5697*/ uint16(xSetOp), uint16(SETB),
-	/*5699*/ uint16(xReadSlashR),
-	/*5700*/ uint16(xArgRM8),
-	/*5701*/ uint16(xMatch),
-	/*5702*/ uint16(xSetOp), uint16(SETAE),
+	/*5687*/ uint16(xCondIs64), 5690, 5736,
+	/*5690*/ uint16(xCondPrefix), 2,
+	0x66, 5716,
+	0x0, 5696,
+	/*5696*/ uint16(xCondDataSize), 5700, 5708, 0,
+	/*5700*/ uint16(xSetOp), uint16(MOVD),
+	/*5702*/ uint16(xSetFeature), uint16(FeatureMMX),
 	/*5704*/ uint16(xReadSlashR),
-	/*5705*/ uint16(xArgRM8),
-	/*5706*/ uint16(xMatch),
-	/*5707*/ uint16(xSetOp), uint16(SETE),
-	/*5709*/ uint16(xReadSlashR),
-	/*5710*/ uint16(xArgRM8),
-	/*5711*/ uint16(xMatch),
-	/*5712*/ uint16(xSetOp), uint16(SETNE),
-	/*5714*/ uint16(xReadSlashR),
-	/*5715*/ uint16(xArgRM8),
-	/*5716*/ uint16(xMatch),
-	/*5717*/ uint16(xSetOp), uint16(SETBE),
-	/*5719*/ uint16(xReadSlashR),
-	/*5720*/ uint16(xArgRM8),
-	/*5721*/ uint16(xMatch),
-	/*5722*/ uint16(xSetOp), uint16(SETA),
+	/*5705*/ uint16(xArgMm),
+	/*5706*/ uint16(xArgRM32),
+	/*5707*/ uint16(xMatch),
+	/*5708*/ uint16(xSetOp), uint16(MOVD),
+	/*5710*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5712*/ uint16(xReadSlashR),
+	/*5713*/ uint16(xArgMm),
+	/*5714*/ uint16(xArgRM32),
+	/*5715*/ uint16(xMatch),
+	/*5716*/ uint16(xCondDataSize), 5720, 5728, 0,
+	/*5720*/ uint16(xSetOp), uint16(MOVD),
+	/*5722*/ uint16(xSetFeature), uint16(FeatureSSE2),
 	/*5724*/ uint16(xReadSlashR),
-	/*5725*/ uint16(xArgRM8),
-	/*5726*/ uint16(xMatch),
-	/*5727*/ uint16(xSetOp), uint16(SETS),
-	/*5729*/ uint16(xReadSlashR),
-	/*5730*/ uint16(xArgRM8),
-	/*5731*/ uint16(xMatch),
-	/*5732*/ uint16(xSetOp), uint16(SETNS),
-	/*5734*/ uint16(xReadSlashR),
-	/*5735*/ uint16(xArgRM8),
-	/*5736*/ uint16(xMatch),
-	/*5737*/ uint16(xSetOp), uint16(SETP),
-	/*5739*/ uint16(xReadSlashR),
-	/*5740*/ uint16(xArgRM8),
-	/*5741*/ uint16(xMatch),
-	/*5742*/ uint16(xSetOp), uint16(SETNP),
-	/*5744*/ uint16(xReadSlashR),
-	/*5745*/ uint16(xArgRM8),
-	/*5746*/ uint16(xMatch),
-	/*5747*/ uint16(xSetOp), uint16(SETL),
-	/*5749*/ uint16(xReadSlashR),
-	/*5750*/ uint16(xArgRM8),
-	/*5751*/ uint16(xMatch),
-	/*5752*/ uint16(xSetOp), uint16(SETGE),
-	/*5754*/ uint16(xReadSlashR),
-	/*5755*/ uint16(xArgRM8),
-	/*5756*/ uint16(xMatch),
-	/*5757*/ uint16(xSetOp), uint16(SETLE),
-	/*5759*/ uint16(xReadSlashR),
-	/*5760*/ uint16(xArgRM8),
-	/*5761*/ uint16(xMatch),
-	/*5762*/ uint16(xSetOp), uint16(SETG),
-	/*5764*/ uint16(xReadSlashR),
-	/*5765*/ uint16(xArgRM8),
-	/*5766*/ uint16(xMatch),
-	/*5767*/ uint16(xSetOp), uint16(PUSH),
-	/*5769*/ uint16(xArgFS),
-	/*5770*/ uint16(xMatch),
-	/*5771*/ uint16(xCondIs64), 5774, 5786,
-	/*5774*/ uint16(xCondDataSize), 5778, 5782, 0,
-	/*5778*/ uint16(xSetOp), uint16(POP),
-	/*5780*/ uint16(xArgFS),
+	/*5725*/ uint16(xArgXmm),
+	/*5726*/ uint16(xArgRM32),
+	/*5727*/ uint16(xMatch),
+	/*5728*/ uint16(xSetOp), uint16(MOVD),
+	/*5730*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5732*/ uint16(xReadSlashR),
+	/*5733*/ uint16(xArgXmm),
+	/*5734*/ uint16(xArgRM32),
+	/*5735*/ uint16(xMatch),
+	/*5736*/ uint16(xCondPrefix), 2,
+	0x66, 5754,
+	0x0, 5742,
+	/*5742*/ uint16(xCondDataSize), 5700, 5708, 5746,
+	/*5746*/ uint16(xSetOp), uint16(MOVQ),
+	/*5748*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5750*/ uint16(xReadSlashR),
+	/*5751*/ uint16(xArgMm),
+	/*5752*/ uint16(xArgRM64),
+	/*5753*/ uint16(xMatch),
+	/*5754*/ uint16(xCondDataSize), 5720, 5728, 5758,
+	/*5758*/ uint16(xSetOp), uint16(MOVQ),
+	/*5760*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5762*/ uint16(xReadSlashR),
+	/*5763*/ uint16(xArgXmm),
+	/*5764*/ uint16(xArgRM64),
+	/*5765*/ uint16(xMatch),
+	/*5766*/ uint16(xCondPrefix), 3,
+	0xF3, 5790,
+	0x66, 5782,
+	0x0, 5774,
+	/*5774*/ uint16(xSetOp), uint16(MOVQ),
+	/*5776*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5778*/ uint16(xReadSlashR),
+	/*5779*/ uint16(xArgMm),
+	/*5780*/ uint16(xArgMmM64),
 	/*5781*/ uint16(xMatch),
-	/*5782*/ uint16(xSetOp), uint16(POP),
-	/*5784*/ uint16(xArgFS),
-	/*5785*/ uint16(xMatch),
-	/*5786*/ uint16(xCondDataSize), 5778, 5790, 5794,
-	/*5790*/ uint16(xSetOp), uint16(POP),
-	/*5792*/ uint16(xArgFS),
-	/*5793*/ uint16(xMatch),
-	/*5794*/ uint16(xSetOp), uint16(POP),
-	/*5796*/ uint16(xArgFS),
+	/*5782*/ uint16(xSetOp), uint16(MOVDQA),
+	/*5784*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5786*/ uint16(xReadSlashR),
+	/*5787*/ uint16(xArgXmm1),
+	/*5788*/ uint16(xArgXmm2M128),
+	/*5789*/ uint16(xMatch),
+	/*5790*/ uint16(xSetOp), uint16(MOVDQU),
+	/*5792*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5794*/ uint16(xReadSlashR),
+	/*5795*/ uint16(xArgXmm1),
+	/*5796*/ uint16(xArgXmm2M128),
 	/*5797*/ uint16(xMatch),
-	/*5798*/ uint16(xSetOp), uint16(CPUID),
-	/*5800*/ uint16(xMatch),
-	/*5801*/ uint16(xCondIs64), 5804, 5820,
-	/*5804*/ uint16(xCondDataSize), 5808, 5814, 0,
-	/*5808*/ uint16(xSetOp), uint16(BT),
-	/*5810*/ uint16(xReadSlashR),
-	/*5811*/ uint16(xArgRM16),
-	/*5812*/ uint16(xArgR16),
-	/*5813*/ uint16(xMatch),
-	/*5814*/ uint16(xSetOp), uint16(BT),
-	/*5816*/ uint16(xReadSlashR),
-	/*5817*/ uint16(xArgRM32),
-	/*5818*/ uint16(xArgR32),
-	/*5819*/ uint16(xMatch),
-	/*5820*/ uint16(xCondDataSize), 5808, 5814, 5824,
-	/*5824*/ uint16(xSetOp), uint16(BT),
-	/*5826*/ uint16(xReadSlashR),
-	/*5827*/ uint16(xArgRM64),
-	/*5828*/ uint16(xArgR64),
-	/*5829*/ uint16(xMatch),
-	/*5830*/ uint16(xCondIs64), 5833, 5853,
-	/*5833*/ uint16(xCondDataSize), 5837, 5845, 0,
-	/*5837*/ uint16(xSetOp), uint16(SHLD),
-	/*5839*/ uint16(xReadSlashR),
-	/*5840*/ uint16(xReadIb),
-	/*5841*/ uint16(xArgRM16),
-	/*5842*/ uint16(xArgR16),
-	/*5843*/ uint16(xArgImm8u),
-	/*5844*/ uint16(xMatch),
-	/*5845*/ uint16(xSetOp), uint16(SHLD),
-	/*5847*/ uint16(xReadSlashR),
-	/*5848*/ uint16(xReadIb),
-	/*5849*/ uint16(xArgRM32),
-	/*5850*/ uint16(xArgR32),
-	/*5851*/ uint16(xArgImm8u),
-	/*5852*/ uint16(xMatch),
-	/*5853*/ uint16(xCondDataSize), 5837, 5845, 5857,
-	/*5857*/ uint16(xSetOp), uint16(SHLD),
-	/*5859*/ uint16(xReadSlashR),
-	/*5860*/ uint16(xReadIb),
-	/*5861*/ uint16(xArgRM64),
-	/*5862*/ uint16(xArgR64),
-	/*5863*/ uint16(xArgImm8u),
-	/*5864*/ uint16(xMatch),
-	/*5865*/ uint16(xCondIs64), 5868, 5886,
-	/*5868*/ uint16(xCondDataSize), 5872, 5879, 0,
-	/*5872*/ uint16(xSetOp), uint16(SHLD),
-	/*5874*/ uint16(xReadSlashR),
-	/*5875*/ uint16(xArgRM16),
-	/*5876*/ uint16(xArgR16),
-	/*5877*/ uint16(xArgCL),
+	/*5798*/ uint16(xCondPrefix), 4,
+	0xF3, 5838,
+	0xF2, 5828,
+	0x66, 5818,
+	0x0, 5808,
+	/*5808*/ uint16(xSetOp), uint16(PSHUFW),
+	/*5810*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*5812*/ uint16(xReadSlashR),
+	/*5813*/ uint16(xReadIb),
+	/*5814*/ uint16(xArgMm1),
+	/*5815*/ uint16(xArgMm2M64),
+	/*5816*/ uint16(xArgImm8u),
+	/*5817*/ uint16(xMatch),
+	/*5818*/ uint16(xSetOp), uint16(PSHUFD),
+	/*5820*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5822*/ uint16(xReadSlashR),
+	/*5823*/ uint16(xReadIb),
+	/*5824*/ uint16(xArgXmm1),
+	/*5825*/ uint16(xArgXmm2M128),
+	/*5826*/ uint16(xArgImm8u),
+	/*5827*/ uint16(xMatch),
+	/*5828*/ uint16(xSetOp), uint16(PSHUFLW),
+	/*5830*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5832*/ uint16(xReadSlashR),
+	/*5833*/ uint16(xReadIb),
+	/*5834*/ uint16(xArgXmm1),
+	/*5835*/ uint16(xArgXmm2M128),
+	/*5836*/ uint16(xArgImm8u),
+	/*5837*/ uint16(xMatch),
+	/*5838*/ uint16(xSetOp), uint16(PSHUFHW),
+	/*5840*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5842*/ uint16(xReadSlashR),
+	/*5843*/ uint16(xReadIb),
+	/*5844*/ uint16(xArgXmm1),
+	/*5845*/ uint16(xArgXmm2M128),
+	/*5846*/ uint16(xArgImm8u),
+	/*5847*/ uint16(xMatch),
+	/*5848*/ uint16(xCondSlashR),
+	0,    // 0
+	0,    // 1
+	5857, // 2
+	0,    // 3
+	5879, // 4
+	0,    // 5
+	5901, // 6
+	0,    // 7
+	/*5857*/ uint16(xCondPrefix), 2,
+	0x66, 5871,
+	0x0, 5863,
+	/*5863*/ uint16(xSetOp), uint16(PSRLW),
+	/*5865*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5867*/ uint16(xReadIb),
+	/*5868*/ uint16(xArgMm2),
+	/*5869*/ uint16(xArgImm8u),
+	/*5870*/ uint16(xMatch),
+	/*5871*/ uint16(xSetOp), uint16(PSRLW),
+	/*5873*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5875*/ uint16(xReadIb),
+	/*5876*/ uint16(xArgXmm2),
+	/*5877*/ uint16(xArgImm8u),
 	/*5878*/ uint16(xMatch),
-	/*5879*/ uint16(xSetOp), uint16(SHLD),
-	/*5881*/ uint16(xReadSlashR),
-	/*5882*/ uint16(xArgRM32),
-	/*5883*/ uint16(xArgR32),
-	/*5884*/ uint16(xArgCL),
-	/*5885*/ uint16(xMatch),
-	/*5886*/ uint16(xCondDataSize), 5872, 5879, 5890,
-	/*5890*/ uint16(xSetOp), uint16(SHLD),
-	/*5892*/ uint16(xReadSlashR),
-	/*5893*/ uint16(xArgRM64),
-	/*5894*/ uint16(xArgR64),
-	/*5895*/ uint16(xArgCL),
-	/*5896*/ uint16(xMatch),
-	/*5897*/ uint16(xSetOp), uint16(PUSH),
-	/*5899*/ uint16(xArgGS),
+	/*5879*/ uint16(xCondPrefix), 2,
+	0x66, 5893,
+	0x0, 5885,
+	/*5885*/ uint16(xSetOp), uint16(PSRAW),
+	/*5887*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5889*/ uint16(xReadIb),
+	/*5890*/ uint16(xArgMm2),
+	/*5891*/ uint16(xArgImm8u),
+	/*5892*/ uint16(xMatch),
+	/*5893*/ uint16(xSetOp), uint16(PSRAW),
+	/*5895*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5897*/ uint16(xReadIb),
+	/*5898*/ uint16(xArgXmm2),
+	/*5899*/ uint16(xArgImm8u),
 	/*5900*/ uint16(xMatch),
-	/*5901*/ uint16(xCondIs64), 5904, 5916,
-	/*5904*/ uint16(xCondDataSize), 5908, 5912, 0,
-	/*5908*/ uint16(xSetOp), uint16(POP),
-	/*5910*/ uint16(xArgGS),
-	/*5911*/ uint16(xMatch),
-	/*5912*/ uint16(xSetOp), uint16(POP),
-	/*5914*/ uint16(xArgGS),
-	/*5915*/ uint16(xMatch),
-	/*5916*/ uint16(xCondDataSize), 5908, 5920, 5924,
-	/*5920*/ uint16(xSetOp), uint16(POP),
-	/*5922*/ uint16(xArgGS),
-	/*5923*/ uint16(xMatch),
-	/*5924*/ uint16(xSetOp), uint16(POP),
-	/*5926*/ uint16(xArgGS),
-	/*5927*/ uint16(xMatch),
-	/*5928*/ uint16(xSetOp), uint16(RSM),
-	/*5930*/ uint16(xMatch),
-	/*5931*/ uint16(xCondIs64), 5934, 5950,
-	/*5934*/ uint16(xCondDataSize), 5938, 5944, 0,
-	/*5938*/ uint16(xSetOp), uint16(BTS),
-	/*5940*/ uint16(xReadSlashR),
-	/*5941*/ uint16(xArgRM16),
-	/*5942*/ uint16(xArgR16),
-	/*5943*/ uint16(xMatch),
-	/*5944*/ uint16(xSetOp), uint16(BTS),
-	/*5946*/ uint16(xReadSlashR),
-	/*5947*/ uint16(xArgRM32),
-	/*5948*/ uint16(xArgR32),
-	/*5949*/ uint16(xMatch),
-	/*5950*/ uint16(xCondDataSize), 5938, 5944, 5954,
-	/*5954*/ uint16(xSetOp), uint16(BTS),
-	/*5956*/ uint16(xReadSlashR),
-	/*5957*/ uint16(xArgRM64),
-	/*5958*/ uint16(xArgR64),
-	/*5959*/ uint16(xMatch),
-	/*5960*/ uint16(xCondIs64), 5963, 5983,
-	/*5963*/ uint16(xCondDataSize), 5967, 5975, 0,
-	/*5967*/ uint16(xSetOp), uint16(SHRD),
-	/*5969*/ uint16(xReadSlashR),
-	/*5970*/ uint16(xReadIb),
-	/*5971*/ uint16(xArgRM16),
-	/*5972*/ uint16(xArgR16),
-	/*5973*/ uint16(xArgImm8u),
-	/*5974*/ uint16(xMatch),
-	/*5975*/ uint16(xSetOp), uint16(SHRD),
-	/*5977*/ uint16(xReadSlashR),
-	/*5978*/ uint16(xReadIb),
-	/*5979*/ uint16(xArgRM32),
-	/*5980*/ uint16(xArgR32),
-	/*5981*/ uint16(xArgImm8u),
-	/*5982*/ uint16(xMatch),
-	/*5983*/ uint16(xCondDataSize), 5967, 5975, 5987,
-	/*5987*/ uint16(xSetOp), uint16(SHRD),
-	/*5989*/ uint16(xReadSlashR),
-	/*5990*/ uint16(xReadIb),
-	/*5991*/ uint16(xArgRM64),
-	/*5992*/ uint16(xArgR64),
-	/*5993*/ uint16(xArgImm8u),
-	/*5994*/ uint16(xMatch),
-	/*5995*/ uint16(xCondIs64), 5998, 6016,
-	/*5998*/ uint16(xCondDataSize), 6002, 6009, 0,
-	/*6002*/ uint16(xSetOp), uint16(SHRD),
-	/*6004*/ uint16(xReadSlashR),
-	/*6005*/ uint16(xArgRM16),
-	/*6006*/ uint16(xArgR16),
-	/*6007*/ uint16(xArgCL),
-	/*6008*/ uint16(xMatch),
-	/*6009*/ uint16(xSetOp), uint16(SHRD),
-	/*6011*/ uint16(xReadSlashR),
-	/*6012*/ uint16(xArgRM32),
-	/*6013*/ uint16(xArgR32),
-	/*6014*/ uint16(xArgCL),
-	/*6015*/ uint16(xMatch),
-	/*6016*/ uint16(xCondDataSize), 6002, 6009, 6020,
-	/*6020*/ uint16(xSetOp), uint16(SHRD),
-	/*6022*/ uint16(xReadSlashR),
-	/*6023*/ uint16(xArgRM64),
-	/*6024*/ uint16(xArgR64),
-	/*6025*/ uint16(xArgCL),
-	/*6026*/ uint16(xMatch),
-	/*6027*/ uint16(xCondByte), 3,
-	0xE8, 6276,
-	0xF0, 6279,
-	0xF8, 6282,
-	/*6035*/ uint16(xCondSlashR),
-	6044, // 0
-	6098, // 1
-	6152, // 2
-	6181, // 3
-	6210, // 4
-	6233, // 5
-	6256, // 6
-	6272, // 7
-	/*6044*/ uint16(xCondIs64), 6047, 6059,
-	/*6047*/ uint16(xCondDataSize), 6051, 6055, 0,
-	/*6051*/ uint16(xSetOp), uint16(FXSAVE),
-	/*6053*/ uint16(xArgM512byte),
+	/*5901*/ uint16(xCondPrefix), 2,
+	0x66, 5915,
+	0x0, 5907,
+	/*5907*/ uint16(xSetOp), uint16(PSLLW),
+	/*5909*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5911*/ uint16(xReadIb),
+	/*5912*/ uint16(xArgMm2),
+	/*5913*/ uint16(xArgImm8u),
+	/*5914*/ uint16(xMatch),
+	/*5915*/ uint16(xSetOp), uint16(PSLLW),
+	/*5917*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5919*/ uint16(xReadIb),
+	/*5920*/ uint16(xArgXmm2),
+	/*5921*/ uint16(xArgImm8u),
+	/*5922*/ uint16(xMatch),
+	/*5923*/ uint16(xCondSlashR),
+	0,    // 0
+	0,    // 1
+	5932, // 2
+	0,    // 3
+	5954, // 4
+	0,    // 5
+	5976, // 6
+	0,    // 7
+	/*5932*/ uint16(xCondPrefix), 2,
+	0x66, 5946,
+	0x0, 5938,
+	/*5938*/ uint16(xSetOp), uint16(PSRLD),
+	/*5940*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5942*/ uint16(xReadIb),
+	/*5943*/ uint16(xArgMm2),
+	/*5944*/ uint16(xArgImm8u),
+	/*5945*/ uint16(xMatch),
+	/*5946*/ uint16(xSetOp), uint16(PSRLD),
+	/*5948*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5950*/ uint16(xReadIb),
+	/*5951*/ uint16(xArgXmm2),
+	/*5952*/ uint16(xArgImm8u),
+	/*5953*/ uint16(xMatch),
+	/*5954*/ uint16(xCondPrefix), 2,
+	0x66, 5968,
+	0x0, 5960,
+	/*5960*/ uint16(xSetOp), uint16(PSRAD),
+	/*5962*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5964*/ uint16(xReadIb),
+	/*5965*/ uint16(xArgMm2),
+	/*5966*/ uint16(xArgImm8u),
+	/*5967*/ uint16(xMatch),
+	/*5968*/ uint16(xSetOp), uint16(PSRAD),
+	/*5970*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5972*/ uint16(xReadIb),
+	/*5973*/ uint16(xArgXmm2),
+	/*5974*/ uint16(xArgImm8u),
+	/*5975*/ uint16(xMatch),
+	/*5976*/ uint16(xCondPrefix), 2,
+	0x66, 5990,
+	0x0, 5982,
+	/*5982*/ uint16(xSetOp), uint16(PSLLD),
+	/*5984*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*5986*/ uint16(xReadIb),
+	/*5987*/ uint16(xArgMm2),
+	/*5988*/ uint16(xArgImm8u),
+	/*5989*/ uint16(xMatch),
+	/*5990*/ uint16(xSetOp), uint16(PSLLD),
+	/*5992*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*5994*/ uint16(xReadIb),
+	/*5995*/ uint16(xArgXmm2),
+	/*5996*/ uint16(xArgImm8u),
+	/*5997*/ uint16(xMatch),
+	/*5998*/ uint16(xCondSlashR),
+	0,    // 0
+	0,    // 1
+	6007, // 2
+	6029, // 3
+	0,    // 4
+	0,    // 5
+	6041, // 6
+	6063, // 7
+	/*6007*/ uint16(xCondPrefix), 2,
+	0x66, 6021,
+	0x0, 6013,
+	/*6013*/ uint16(xSetOp), uint16(PSRLQ),
+	/*6015*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6017*/ uint16(xReadIb),
+	/*6018*/ uint16(xArgMm2),
+	/*6019*/ uint16(xArgImm8u),
+	/*6020*/ uint16(xMatch),
+	/*6021*/ uint16(xSetOp), uint16(PSRLQ),
+	/*6023*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6025*/ uint16(xReadIb),
+	/*6026*/ uint16(xArgXmm2),
+	/*6027*/ uint16(xArgImm8u),
+	/*6028*/ uint16(xMatch),
+	/*6029*/ uint16(xCondPrefix), 1,
+	0x66, 6033,
+	/*6033*/ uint16(xSetOp), uint16(PSRLDQ),
+	/*6035*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6037*/ uint16(xReadIb),
+	/*6038*/ uint16(xArgXmm2),
+	/*6039*/ uint16(xArgImm8u),
+	/*6040*/ uint16(xMatch),
+	/*6041*/ uint16(xCondPrefix), 2,
+	0x66, 6055,
+	0x0, 6047,
+	/*6047*/ uint16(xSetOp), uint16(PSLLQ),
+	/*6049*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6051*/ uint16(xReadIb),
+	/*6052*/ uint16(xArgMm2),
+	/*6053*/ uint16(xArgImm8u),
 	/*6054*/ uint16(xMatch),
-	/*6055*/ uint16(xSetOp), uint16(FXSAVE),
-	/*6057*/ uint16(xArgM512byte),
-	/*6058*/ uint16(xMatch),
-	/*6059*/ uint16(xCondPrefix), 2,
-	0xF3, 6073,
-	0x0, 6065,
-	/*6065*/ uint16(xCondDataSize), 6051, 6055, 6069,
-	/*6069*/ uint16(xSetOp), uint16(FXSAVE64),
-	/*6071*/ uint16(xArgM512byte),
-	/*6072*/ uint16(xMatch),
-	/*6073*/ uint16(xCondDataSize), 6077, 6084, 6091,
-	/*6077*/ uint16(xCondIsMem), 6080, 0,
-	/*6080*/ uint16(xSetOp), uint16(RDFSBASE),
-	/*6082*/ uint16(xArgRM32),
-	/*6083*/ uint16(xMatch),
-	/*6084*/ uint16(xCondIsMem), 6087, 0,
-	/*6087*/ uint16(xSetOp), uint16(RDFSBASE),
-	/*6089*/ uint16(xArgRM32),
-	/*6090*/ uint16(xMatch),
-	/*6091*/ uint16(xCondIsMem), 6094, 0,
-	/*6094*/ uint16(xSetOp), uint16(RDFSBASE),
-	/*6096*/ uint16(xArgRM64),
-	/*6097*/ uint16(xMatch),
-	/*6098*/ uint16(xCondIs64), 6101, 6113,
-	/*6101*/ uint16(xCondDataSize), 6105, 6109, 0,
-	/*6105*/ uint16(xSetOp), uint16(FXRSTOR),
-	/*6107*/ uint16(xArgM512byte),
-	/*6108*/ uint16(xMatch),
-	/*6109*/ uint16(xSetOp), uint16(FXRSTOR),
-	/*6111*/ uint16(xArgM512byte),
-	/*6112*/ uint16(xMatch),
-	/*6113*/ uint16(xCondPrefix), 2,
-	0xF3, 6127,
-	0x0, 6119,
-	/*6119*/ uint16(xCondDataSize), 6105, 6109, 6123,
-	/*6123*/ uint16(xSetOp), uint16(FXRSTOR64),
-	/*6125*/ uint16(xArgM512byte),
-	/*6126*/ uint16(xMatch),
-	/*6127*/ uint16(xCondDataSize), 6131, 6138, 6145,
-	/*6131*/ uint16(xCondIsMem), 6134, 0,
-	/*6134*/ uint16(xSetOp), uint16(RDGSBASE),
-	/*6136*/ uint16(xArgRM32),
-	/*6137*/ uint16(xMatch),
-	/*6138*/ uint16(xCondIsMem), 6141, 0,
-	/*6141*/ uint16(xSetOp), uint16(RDGSBASE),
-	/*6143*/ uint16(xArgRM32),
-	/*6144*/ uint16(xMatch),
-	/*6145*/ uint16(xCondIsMem), 6148, 0,
-	/*6148*/ uint16(xSetOp), uint16(RDGSBASE),
-	/*6150*/ uint16(xArgRM64),
-	/*6151*/ uint16(xMatch),
-	/*6152*/ uint16(xCondIs64), 6155, 6159,
-	/*6155*/ uint16(xSetOp), uint16(LDMXCSR),
-	/*6157*/ uint16(xArgM32),
-	/*6158*/ uint16(xMatch),
-	/*6159*/ uint16(xCondPrefix), 2,
-	0xF3, 6165,
-	0x0, 6155,
-	/*6165*/ uint16(xCondDataSize), 6169, 6173, 6177,
-	/*6169*/ uint16(xSetOp), uint16(WRFSBASE),
-	/*6171*/ uint16(xArgRM32),
-	/*6172*/ uint16(xMatch),
-	/*6173*/ uint16(xSetOp), uint16(WRFSBASE),
-	/*6175*/ uint16(xArgRM32),
-	/*6176*/ uint16(xMatch),
-	/*6177*/ uint16(xSetOp), uint16(WRFSBASE),
-	/*6179*/ uint16(xArgRM64),
-	/*6180*/ uint16(xMatch),
-	/*6181*/ uint16(xCondIs64), 6184, 6188,
-	/*6184*/ uint16(xSetOp), uint16(STMXCSR),
-	/*6186*/ uint16(xArgM32),
-	/*6187*/ uint16(xMatch),
-	/*6188*/ uint16(xCondPrefix), 2,
-	0xF3, 6194,
-	0x0, 6184,
-	/*6194*/ uint16(xCondDataSize), 6198, 6202, 6206,
-	/*6198*/ uint16(xSetOp), uint16(WRGSBASE),
-	/*6200*/ uint16(xArgRM32),
-	/*6201*/ uint16(xMatch),
-	/*6202*/ uint16(xSetOp), uint16(WRGSBASE),
-	/*6204*/ uint16(xArgRM32),
-	/*6205*/ uint16(xMatch),
-	/*6206*/ uint16(xSetOp), uint16(WRGSBASE),
-	/*6208*/ uint16(xArgRM64),
-	/*6209*/ uint16(xMatch),
-	/*6210*/ uint16(xCondIs64), 6213, 6225,
-	/*6213*/ uint16(xCondDataSize), 6217, 6221, 0,
-	/*6217*/ uint16(xSetOp), uint16(XSAVE),
-	/*6219*/ uint16(xArgMem),
+	/*6055*/ uint16(xSetOp), uint16(PSLLQ),
+	/*6057*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6059*/ uint16(xReadIb),
+	/*6060*/ uint16(xArgXmm2),
+	/*6061*/ uint16(xArgImm8u),
+	/*6062*/ uint16(xMatch),
+	/*6063*/ uint16(xCondPrefix), 1,
+	0x66, 6067,
+	/*6067*/ uint16(xSetOp), uint16(PSLLDQ),
+	/*6069*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6071*/ uint16(xReadIb),
+	/*6072*/ uint16(xArgXmm2),
+	/*6073*/ uint16(xArgImm8u),
+	/*6074*/ uint16(xMatch),
+	/*6075*/ uint16(xCondPrefix), 2,
+	0x66, 6089,
+	0x0, 6081,
+	/*6081*/ uint16(xSetOp), uint16(PCMPEQB),
+	/*6083*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6085*/ uint16(xReadSlashR),
+	/*6086*/ uint16(xArgMm),
+	/*6087*/ uint16(xArgMmM64),
+	/*6088*/ uint16(xMatch),
+	/*6089*/ uint16(xSetOp), uint16(PCMPEQB),
+	/*6091*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6093*/ uint16(xReadSlashR),
+	/*6094*/ uint16(xArgXmm1),
+	/*6095*/ uint16(xArgXmm2M128),
+	/*6096*/ uint16(xMatch),
+	/*6097*/ uint16(xCondPrefix), 2,
+	0x66, 6111,
+	0x0, 6103,
+	/*6103*/ uint16(xSetOp), uint16(PCMPEQW),
+	/*6105*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6107*/ uint16(xReadSlashR),
+	/*6108*/ uint16(xArgMm),
+	/*6109*/ uint16(xArgMmM64),
+	/*6110*/ uint16(xMatch),
+	/*6111*/ uint16(xSetOp), uint16(PCMPEQW),
+	/*6113*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6115*/ uint16(xReadSlashR),
+	/*6116*/ uint16(xArgXmm1),
+	/*6117*/ uint16(xArgXmm2M128),
+	/*6118*/ uint16(xMatch),
+	/*6119*/ uint16(xCondPrefix), 2,
+	0x66, 6133,
+	0x0, 6125,
+	/*6125*/ uint16(xSetOp), uint16(PCMPEQD),
+	/*6127*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6129*/ uint16(xReadSlashR),
+	/*6130*/ uint16(xArgMm),
+	/*6131*/ uint16(xArgMmM64),
+	/*6132*/ uint16(xMatch),
+	/*6133*/ uint16(xSetOp), uint16(PCMPEQD),
+	/*6135*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6137*/ uint16(xReadSlashR),
+	/*6138*/ uint16(xArgXmm1),
+	/*6139*/ uint16(xArgXmm2M128),
+	/*6140*/ uint16(xMatch),
+	/*6141*/ uint16(xSetOp), uint16(EMMS),
+	/*6143*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6145*/ uint16(xMatch),
+	/*6146*/ uint16(xCondPrefix), 2,
+	0xF2, 6160,
+	0x66, 6152,
+	/*6152*/ uint16(xSetOp), uint16(HADDPD),
+	/*6154*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*6156*/ uint16(xReadSlashR),
+	/*6157*/ uint16(xArgXmm1),
+	/*6158*/ uint16(xArgXmm2M128),
+	/*6159*/ uint16(xMatch),
+	/*6160*/ uint16(xSetOp), uint16(HADDPS),
+	/*6162*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*6164*/ uint16(xReadSlashR),
+	/*6165*/ uint16(xArgXmm1),
+	/*6166*/ uint16(xArgXmm2M128),
+	/*6167*/ uint16(xMatch),
+	/*6168*/ uint16(xCondPrefix), 2,
+	0xF2, 6182,
+	0x66, 6174,
+	/*6174*/ uint16(xSetOp), uint16(HSUBPD),
+	/*6176*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*6178*/ uint16(xReadSlashR),
+	/*6179*/ uint16(xArgXmm1),
+	/*6180*/ uint16(xArgXmm2M128),
+	/*6181*/ uint16(xMatch),
+	/*6182*/ uint16(xSetOp), uint16(HSUBPS),
+	/*6184*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*6186*/ uint16(xReadSlashR),
+	/*6187*/ uint16(xArgXmm1),
+	/*6188*/ uint16(xArgXmm2M128),
+	/*6189*/ uint16(xMatch),
+	/*6190*/ uint16(xCondIs64), 6193, 6249,
+	/*6193*/ uint16(xCondPrefix), 3,
+	0xF3, 6241,
+	0x66, 6221,
+	0x0, 6201,
+	/*6201*/ uint16(xCondDataSize), 6205, 6213, 0,
+	/*6205*/ uint16(xSetOp), uint16(MOVD),
+	/*6207*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6209*/ uint16(xReadSlashR),
+	/*6210*/ uint16(xArgRM32),
+	/*6211*/ uint16(xArgMm),
+	/*6212*/ uint16(xMatch),
+	/*6213*/ uint16(xSetOp), uint16(MOVD),
+	/*6215*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6217*/ uint16(xReadSlashR),
+	/*6218*/ uint16(xArgRM32),
+	/*6219*/ uint16(xArgMm),
 	/*6220*/ uint16(xMatch),
-	/*6221*/ uint16(xSetOp), uint16(XSAVE),
-	/*6223*/ uint16(xArgMem),
-	/*6224*/ uint16(xMatch),
-	/*6225*/ uint16(xCondDataSize), 6217, 6221, 6229,
-	/*6229*/ uint16(xSetOp), uint16(XSAVE64),
-	/*6231*/ uint16(xArgMem),
+	/*6221*/ uint16(xCondDataSize), 6225, 6233, 0,
+	/*6225*/ uint16(xSetOp), uint16(MOVD),
+	/*6227*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6229*/ uint16(xReadSlashR),
+	/*6230*/ uint16(xArgRM32),
+	/*6231*/ uint16(xArgXmm),
 	/*6232*/ uint16(xMatch),
-	/*6233*/ uint16(xCondIs64), 6236, 6248,
-	/*6236*/ uint16(xCondDataSize), 6240, 6244, 0,
-	/*6240*/ uint16(xSetOp), uint16(XRSTOR),
-	/*6242*/ uint16(xArgMem),
-	/*6243*/ uint16(xMatch),
-	/*6244*/ uint16(xSetOp), uint16(XRSTOR),
-	/*6246*/ uint16(xArgMem),
-	/*6247*/ uint16(xMatch),
-	/*6248*/ uint16(xCondDataSize), 6240, 6244, 6252,
-	/*6252*/ uint16(xSetOp), uint16(XRSTOR64),
-	/*6254*/ uint16(xArgMem),
-	/*6255*/ uint16(xMatch),
-	/*6256*/ uint16(xCondDataSize), 6260, 6264, 6268,
-	/*6260*/ uint16(xSetOp), uint16(XSAVEOPT),
-	/*6262*/ uint16(xArgMem),
-	/*6263*/ uint16(xMatch),
-	/*6264*/ uint16(xSetOp), uint16(XSAVEOPT),
-	/*6266*/ uint16(xArgMem),
-	/*6267*/ uint16(xMatch),
-	/*6268*/ uint16(xSetOp), uint16(XSAVEOPT64),
-	/*6270*/ uint16(xArgMem),
-	/*6271*/ uint16(xMatch),
-	/*6272*/ uint16(xSetOp), uint16(CLFLUSH),
-	/*6274*/ uint16(xArgM8),
-	/*6275*/ uint16(xMatch),
-	/*6276*/ uint16(xSetOp), uint16(LFENCE),
-	/*6278*/ uint16(xMatch),
-	/*6279*/ uint16(xSetOp), uint16(MFENCE),
-	/*6281*/ uint16(xMatch),
-	/*6282*/ uint16(xSetOp), uint16(SFENCE),
-	/*6284*/ uint16(xMatch),
-	/*6285*/ uint16(xCondIs64), 6288, 6304,
-	/*6288*/ uint16(xCondDataSize), 6292, 6298, 0,
-	/*6292*/ uint16(xSetOp), uint16(IMUL),
-	/*6294*/ uint16(xReadSlashR),
-	/*6295*/ uint16(xArgR16),
-	/*6296*/ uint16(xArgRM16),
-	/*6297*/ uint16(xMatch),
-	/*6298*/ uint16(xSetOp), uint16(IMUL),
-	/*6300*/ uint16(xReadSlashR),
-	/*6301*/ uint16(xArgR32),
-	/*6302*/ uint16(xArgRM32),
-	/*6303*/ uint16(xMatch),
-	/*6304*/ uint16(xCondDataSize), 6292, 6298, 6308,
-	/*6308*/ uint16(xSetOp), uint16(IMUL),
-	/*6310*/ uint16(xReadSlashR),
-	/*6311*/ uint16(xArgR64),
-	/*6312*/ uint16(xArgRM64),
-	/*6313*/ uint16(xMatch),
-	/*6314*/ uint16(xSetOp), uint16(CMPXCHG),
-	/*6316*/ uint16(xReadSlashR),
-	/*6317*/ uint16(xArgRM8),
-	/*6318*/ uint16(xArgR8),
-	/*6319*/ uint16(xMatch),
-	/*6320*/ uint16(xCondIs64), 6323, 6339,
-	/*6323*/ uint16(xCondDataSize), 6327, 6333, 0,
-	/*6327*/ uint16(xSetOp), uint16(CMPXCHG),
-	/*6329*/ uint16(xReadSlashR),
-	/*6330*/ uint16(xArgRM16),
-	/*6331*/ uint16(xArgR16),
-	/*6332*/ uint16(xMatch),
-	/*6333*/ uint16(xSetOp), uint16(CMPXCHG),
-	/*6335*/ uint16(xReadSlashR),
-	/*6336*/ uint16(xArgRM32),
-	/*6337*/ uint16(xArgR32),
+	/*6233*/ uint16(xSetOp), uint16(MOVD),
+	/*6235*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6237*/ uint16(xReadSlashR),
+	/*6238*/ uint16(xArgRM32),
+	/*6239*/ uint16(xArgXmm),
+	/*6240*/ uint16(xMatch),
+	/*6241*/ uint16(xSetOp), uint16(MOVQ),
+	/*6243*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6245*/ uint16(xReadSlashR),
+	/*6246*/ uint16(xArgXmm1),
+	/*6247*/ uint16(xArgXmm2M64),
+	/*6248*/ uint16(xMatch),
+	/*6249*/ uint16(xCondPrefix), 3,
+	0xF3, 6241,
+	0x66, 6269,
+	0x0, 6257,
+	/*6257*/ uint16(xCondDataSize), 6205, 6213, 6261,
+	/*6261*/ uint16(xSetOp), uint16(MOVQ),
+	/*6263*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6265*/ uint16(xReadSlashR),
+	/*6266*/ uint16(xArgRM64),
+	/*6267*/ uint16(xArgMm),
+	/*6268*/ uint16(xMatch),
+	/*6269*/ uint16(xCondDataSize), 6225, 6233, 6273,
+	/*6273*/ uint16(xSetOp), uint16(MOVQ),
+	/*6275*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6277*/ uint16(xReadSlashR),
+	/*6278*/ uint16(xArgRM64),
+	/*6279*/ uint16(xArgXmm),
+	/*6280*/ uint16(xMatch),
+	/*6281*/ uint16(xCondPrefix), 3,
+	0xF3, 6305,
+	0x66, 6297,
+	0x0, 6289,
+	/*6289*/ uint16(xSetOp), uint16(MOVQ),
+	/*6291*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*6293*/ uint16(xReadSlashR),
+	/*6294*/ uint16(xArgMmM64),
+	/*6295*/ uint16(xArgMm),
+	/*6296*/ uint16(xMatch),
+	/*6297*/ uint16(xSetOp), uint16(MOVDQA),
+	/*6299*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6301*/ uint16(xReadSlashR),
+	/*6302*/ uint16(xArgXmm2M128),
+	/*6303*/ uint16(xArgXmm1),
+	/*6304*/ uint16(xMatch),
+	/*6305*/ uint16(xSetOp), uint16(MOVDQU),
+	/*6307*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*6309*/ uint16(xReadSlashR),
+	/*6310*/ uint16(xArgXmm2M128),
+	/*6311*/ uint16(xArgXmm1),
+	/*6312*/ uint16(xMatch),
+	/*6313*/ uint16(xCondIs64), 6316, 6330,
+	/*6316*/ uint16(xCondDataSize), 6320, 6325, 0,
+	/*6320*/ uint16(xSetOp), uint16(JO),
+	/*6322*/ uint16(xReadCw),
+	/*6323*/ uint16(xArgRel16),
+	/*6324*/ uint16(xMatch),
+	/*6325*/ uint16(xSetOp), uint16(JO),
+	/*6327*/ uint16(xReadCd),
+	/*6328*/ uint16(xArgRel32),
+	/*6329*/ uint16(xMatch),
+	/*6330*/ uint16(xCondDataSize), 6334, 6325, 6339,
+	/*6334*/ uint16(xSetOp), uint16(JO),
+	/*6336*/ uint16(xReadCd),
+	/*6337*/ uint16(xArgRel32),
 	/*6338*/ uint16(xMatch),
-	/*6339*/ uint16(xCondDataSize), 6327, 6333, 6343,
-	/*6343*/ uint16(xSetOp), uint16(CMPXCHG),
-	/*6345*/ uint16(xReadSlashR),
-	/*6346*/ uint16(xArgRM64),
-	/*6347*/ uint16(xArgR64),
-	/*6348*/ uint16(xMatch),
-	/*6349*/ uint16(xCondIs64), 6352, 6368,
-	/*6352*/ uint16(xCondDataSize), 6356, 6362, 0,
-	/*6356*/ uint16(xSetOp), uint16(LSS),
-	/*6358*/ uint16(xReadSlashR),
-	/*6359*/ uint16(xArgR16),
-	/*6360*/ uint16(xArgM16colon16),
-	/*6361*/ uint16(xMatch),
-	/*6362*/ uint16(xSetOp), uint16(LSS),
-	/*6364*/ uint16(xReadSlashR),
-	/*6365*/ uint16(xArgR32),
-	/*6366*/ uint16(xArgM16colon32),
-	/*6367*/ uint16(xMatch),
-	/*6368*/ uint16(xCondDataSize), 6356, 6362, 6372,
-	/*6372*/ uint16(xSetOp), uint16(LSS),
-	/*6374*/ uint16(xReadSlashR),
-	/*6375*/ uint16(xArgR64),
-	/*6376*/ uint16(xArgM16colon64),
-	/*6377*/ uint16(xMatch),
-	/*6378*/ uint16(xCondIs64), 6381, 6397,
-	/*6381*/ uint16(xCondDataSize), 6385, 6391, 0,
-	/*6385*/ uint16(xSetOp), uint16(BTR),
-	/*6387*/ uint16(xReadSlashR),
-	/*6388*/ uint16(xArgRM16),
-	/*6389*/ uint16(xArgR16),
-	/*6390*/ uint16(xMatch),
-	/*6391*/ uint16(xSetOp), uint16(BTR),
-	/*6393*/ uint16(xReadSlashR),
-	/*6394*/ uint16(xArgRM32),
-	/*6395*/ uint16(xArgR32),
-	/*6396*/ uint16(xMatch),
-	/*6397*/ uint16(xCondDataSize), 6385, 6391, 6401,
-	/*6401*/ uint16(xSetOp), uint16(BTR),
-	/*6403*/ uint16(xReadSlashR),
-	/*6404*/ uint16(xArgRM64),
-	/*6405*/ uint16(xArgR64),
-	/*6406*/ uint16(xMatch),
-	/*6407*/ uint16(xCondIs64), 6410, 6426,
-	/*6410*/ uint16(xCondDataSize), 6414, 6420, 0,
-	/*6414*/ uint16(xSetOp), uint16(LFS),
-	/*6416*/ uint16(xReadSlashR),
-	/*6417*/ uint16(xArgR16),
-	/*6418*/ uint16(xArgM16colon16),
-	/*6419*/ uint16(xMatch),
-	/*6420*/ uint16(xSetOp), uint16(LFS),
-	/*6422*/ uint16(xReadSlashR),
-	/*6423*/ uint16(xArgR32),
-	/*6424*/ uint16(xArgM16colon32),
-	/*6425*/ uint16(xMatch),
-	/*6426*/ uint16(xCondDataSize), 6414, 6420, 6430,
-	/*6430*/ uint16(xSetOp), uint16(LFS),
-	/*6432*/ uint16(xReadSlashR),
-	/*6433*/ uint16(xArgR64),
-	/*6434*/ uint16(xArgM16colon64),
-	/*6435*/ uint16(xMatch),
-	/*6436*/ uint16(xCondIs64), 6439, 6455,
-	/*6439*/ uint16(xCondDataSize), 6443, 6449, 0,
-	/*6443*/ uint16(xSetOp), uint16(LGS),
-	/*6445*/ uint16(xReadSlashR),
-	/*6446*/ uint16(xArgR16),
-	/*6447*/ uint16(xArgM16colon16),
+	/*6339*/ uint16(xSetOp), uint16(JO),
+	/*6341*/ uint16(xReadCd),
+	/*6342*/ uint16(xArgRel32),
+	/*6343*/ uint16(xMatch),
+	/*6344*/ uint16(xCondIs64), 6347, 6361,
+	/*6347*/ uint16(xCondDataSize), 6351, 6356, 0,
+	/*6351*/ uint16(xSetOp), uint16(JNO),
+	/*6353*/ uint16(xReadCw),
+	/*6354*/ uint16(xArgRel16),
+	/*6355*/ uint16(xMatch),
+	/*6356*/ uint16(xSetOp), uint16(JNO),
+	/*6358*/ uint16(xReadCd),
+	/*6359*/ uint16(xArgRel32),
+	/*6360*/ uint16(xMatch),
+	/*6361*/ uint16(xCondDataSize), 6365, 6356, 6370,
+	/*6365*/ uint16(xSetOp), uint16(JNO),
+	/*6367*/ uint16(xReadCd),
+	/*6368*/ uint16(xArgRel32),
+	/*6369*/ uint16(xMatch),
+	/*6370*/ uint16(xSetOp), uint16(JNO),
+	/*6372*/ uint16(xReadCd),
+	/*6373*/ uint16(xArgRel32),
+	/*6374*/ uint16(xMatch),
+	/*6375*/ uint16(xCondIs64), 6378, 6392,
+	/*6378*/ uint16(xCondDataSize), 6382, 6387, 0,
+	/*6382*/ uint16(xSetOp), uint16(JB),
+	/*6384*/ uint16(xReadCw),
+	/*6385*/ uint16(xArgRel16),
+	/*6386*/ uint16(xMatch),
+	/*6387*/ uint16(xSetOp), uint16(JB),
+	/*6389*/ uint16(xReadCd),
+	/*6390*/ uint16(xArgRel32),
+	/*6391*/ uint16(xMatch),
+	/*6392*/ uint16(xCondDataSize), 6396, 6387, 6401,
+	/*6396*/ uint16(xSetOp), uint16(JB),
+	/*6398*/ uint16(xReadCd),
+	/*6399*/ uint16(xArgRel32),
+	/*6400*/ uint16(xMatch),
+	/*6401*/ uint16(xSetOp), uint16(JB),
+	/*6403*/ uint16(xReadCd),
+	/*6404*/ uint16(xArgRel32),
+	/*6405*/ uint16(xMatch),
+	/*6406*/ uint16(xCondIs64), 6409, 6423,
+	/*6409*/ uint16(xCondDataSize), 6413, 6418, 0,
+	/*6413*/ uint16(xSetOp), uint16(JAE),
+	/*6415*/ uint16(xReadCw),
+	/*6416*/ uint16(xArgRel16),
+	/*6417*/ uint16(xMatch),
+	/*6418*/ uint16(xSetOp), uint16(JAE),
+	/*6420*/ uint16(xReadCd),
+	/*6421*/ uint16(xArgRel32),
+	/*6422*/ uint16(xMatch),
+	/*6423*/ uint16(xCondDataSize), 6427, 6418, 6432,
+	/*6427*/ uint16(xSetOp), uint16(JAE),
+	/*6429*/ uint16(xReadCd),
+	/*6430*/ uint16(xArgRel32),
+	/*6431*/ uint16(xMatch),
+	/*6432*/ uint16(xSetOp), uint16(JAE),
+	/*6434*/ uint16(xReadCd),
+	/*6435*/ uint16(xArgRel32),
+	/*6436*/ uint16(xMatch),
+	/*6437*/ uint16(xCondIs64), 6440, 6454,
+	/*6440*/ uint16(xCondDataSize), 6444, 6449, 0,
+	/*6444*/ uint16(xSetOp), uint16(JE),
+	/*6446*/ uint16(xReadCw),
+	/*6447*/ uint16(xArgRel16),
 	/*6448*/ uint16(xMatch),
-	/*6449*/ uint16(xSetOp), uint16(LGS),
-	/*6451*/ uint16(xReadSlashR),
-	/*6452*/ uint16(xArgR32),
-	/*6453*/ uint16(xArgM16colon32),
-	/*6454*/ uint16(xMatch),
-	/*6455*/ uint16(xCondDataSize), 6443, 6449, 6459,
-	/*6459*/ uint16(xSetOp), uint16(LGS),
-	/*6461*/ uint16(xReadSlashR),
-	/*6462*/ uint16(xArgR64),
-	/*6463*/ uint16(xArgM16colon64),
-	/*6464*/ uint16(xMatch),
-	/*6465*/ uint16(xCondIs64), 6468, 6484,
-	/*6468*/ uint16(xCondDataSize), 6472, 6478, 0,
-	/*6472*/ uint16(xSetOp), uint16(MOVZX),
-	/*6474*/ uint16(xReadSlashR),
-	/*6475*/ uint16(xArgR16),
-	/*6476*/ uint16(xArgRM8),
-	/*6477*/ uint16(xMatch),
-	/*6478*/ uint16(xSetOp), uint16(MOVZX),
-	/*6480*/ uint16(xReadSlashR),
-	/*6481*/ uint16(xArgR32),
-	/*6482*/ uint16(xArgRM8),
-	/*6483*/ uint16(xMatch),
-	/*6484*/ uint16(xCondDataSize), 6472, 6478, 6488,
-	/*6488*/ uint16(xSetOp), uint16(MOVZX),
-	/*6490*/ uint16(xReadSlashR),
-	/*6491*/ uint16(xArgR64),
-	/*6492*/ uint16(xArgRM8),
+	/*6449*/ uint16(xSetOp), uint16(JE),
+	/*6451*/ uint16(xReadCd),
+	/*6452*/ uint16(xArgRel32),
+	/*6453*/ uint16(xMatch),
+	/*6454*/ uint16(xCondDataSize), 6458, 6449, 6463,
+	/*6458*/ uint16(xSetOp), uint16(JE),
+	/*6460*/ uint16(xReadCd),
+	/*6461*/ uint16(xArgRel32),
+	/*6462*/ uint16(xMatch),
+	/*6463*/ uint16(xSetOp), uint16(JE),
+	/*6465*/ uint16(xReadCd),
+	/*6466*/ uint16(xArgRel32),
+	/*6467*/ uint16(xMatch),
+	/*6468*/ uint16(xCondIs64), 6471, 6485,
+	/*6471*/ uint16(xCondDataSize), 6475, 6480, 0,
+	/*6475*/ uint16(xSetOp), uint16(JNE),
+	/*6477*/ uint16(xReadCw),
+	/*6478*/ uint16(xArgRel16),
+	/*6479*/ uint16(xMatch),
+	/*6480*/ uint16(xSetOp), uint16(JNE),
+	/*6482*/ uint16(xReadCd),
+	/*6483*/ uint16(xArgRel32),
+	/*6484*/ uint16(xMatch),
+	/*6485*/ uint16(xCondDataSize), 6489, 6480, 6494,
+	/*6489*/ uint16(xSetOp), uint16(JNE),
+	/*6491*/ uint16(xReadCd),
+	/*6492*/ uint16(xArgRel32),
 	/*6493*/ uint16(xMatch),
-	/*6494*/ uint16(xCondIs64), 6497, 6513,
-	/*6497*/ uint16(xCondDataSize), 6501, 6507, 0,
-	/*6501*/ uint16(xSetOp), uint16(MOVZX),
-	/*6503*/ uint16(xReadSlashR),
-	/*6504*/ uint16(xArgR16),
-	/*6505*/ uint16(xArgRM16),
-	/*6506*/ uint16(xMatch),
-	/*6507*/ uint16(xSetOp), uint16(MOVZX),
-	/*6509*/ uint16(xReadSlashR),
-	/*6510*/ uint16(xArgR32),
-	/*6511*/ uint16(xArgRM16),
-	/*6512*/ uint16(xMatch),
-	/*6513*/ uint16(xCondDataSize), 6501, 6507, 6517,
-	/*6517*/ uint16(xSetOp), uint16(MOVZX),
-	/*6519*/ uint16(xReadSlashR),
-	/*6520*/ uint16(xArgR64),
-	/*6521*/ uint16(xArgRM16),
-	/*6522*/ uint16(xMatch),
-	/*6523*/ uint16(xCondIs64), 6526, 6546,
-	/*6526*/ uint16(xCondPrefix), 1,
-	0xF3, 6530,
-	/*6530*/ uint16(xCondDataSize), 6534, 6540, 0,
-	/*6534*/ uint16(xSetOp), uint16(POPCNT),
-	/*6536*/ uint16(xReadSlashR),
-	/*6537*/ uint16(xArgR16),
-	/*6538*/ uint16(xArgRM16),
-	/*6539*/ uint16(xMatch),
-	/*6540*/ uint16(xSetOp), uint16(POPCNT),
-	/*6542*/ uint16(xReadSlashR),
-	/*6543*/ uint16(xArgR32),
-	/*6544*/ uint16(xArgRM32),
-	/*6545*/ uint16(xMatch),
-	/*6546*/ uint16(xCondPrefix), 1,
-	0xF3, 6550,
-	/*6550*/ uint16(xCondDataSize), 6534, 6540, 6554,
-	/*6554*/ uint16(xSetOp), uint16(POPCNT),
-	/*6556*/ uint16(xReadSlashR),
-	/*6557*/ uint16(xArgR64),
-	/*6558*/ uint16(xArgRM64),
-	/*6559*/ uint16(xMatch),
-	/*6560*/ uint16(xCondDataSize), 0, 6564, 0,
-	/*6564*/ uint16(xSetOp), uint16(UD1),
-	/*6566*/ uint16(xReadSlashR),
-	/*6567*/ uint16(xArgR32),
-	/*6568*/ uint16(xArgRM32),
-	/*6569*/ uint16(xMatch),
-	/*6570*/ uint16(xCondSlashR),
-	0,    // 0
-	0,    // 1
-	0,    // 2
-	0,    // 3
-	6579, // 4
-	6608, // 5
-	6637, // 6
-	6666, // 7
-	/*6579*/ uint16(xCondIs64), 6582, 6598,
-	/*6582*/ uint16(xCondDataSize), 6586, 6592, 0,
-	/*6586*/ uint16(xSetOp), uint16(BT),
-	/*6588*/ uint16(xReadIb),
-	/*6589*/ uint16(xArgRM16),
-	/*6590*/ uint16(xArgImm8u),
+	/*6494*/ uint16(xSetOp), uint16(JNE),
+	/*6496*/ uint16(xReadCd),
+	/*6497*/ uint16(xArgRel32),
+	/*6498*/ uint16(xMatch),
+	/*6499*/ uint16(xCondIs64), 6502, 6516,
+	/*6502*/ uint16(xCondDataSize), 6506, 6511, 0,
+	/*6506*/ uint16(xSetOp), uint16(JBE),
+	/*6508*/ uint16(xReadCw),
+	/*6509*/ uint16(xArgRel16),
+	/*6510*/ uint16(xMatch),
+	/*6511*/ uint16(xSetOp), uint16(JBE),
+	/*6513*/ uint16(xReadCd),
+	/*6514*/ uint16(xArgRel32),
+	/*6515*/ uint16(xMatch),
+	/*6516*/ uint16(xCondDataSize), 6520, 6511, 6525,
+	/*6520*/ uint16(xSetOp), uint16(JBE),
+	/*6522*/ uint16(xReadCd),
+	/*6523*/ uint16(xArgRel32),
+	/*6524*/ uint16(xMatch),
+	/*6525*/ uint16(xSetOp), uint16(JBE),
+	/*6527*/ uint16(xReadCd),
+	/*6528*/ uint16(xArgRel32),
+	/*6529*/ uint16(xMatch),
+	/*6530*/ uint16(xCondIs64), 6533, 6547,
+	/*6533*/ uint16(xCondDataSize), 6537, 6542, 0,
+	/*6537*/ uint16(xSetOp), uint16(JA),
+	/*6539*/ uint16(xReadCw),
+	/*6540*/ uint16(xArgRel16),
+	/*6541*/ uint16(xMatch),
+	/*6542*/ uint16(xSetOp), uint16(JA),
+	/*6544*/ uint16(xReadCd),
+	/*6545*/ uint16(xArgRel32),
+	/*6546*/ uint16(xMatch),
+	/*6547*/ uint16(xCondDataSize), 6551, 6542, 6556,
+	/*6551*/ uint16(xSetOp), uint16(JA),
+	/*6553*/ uint16(xReadCd),
+	/*6554*/ uint16(xArgRel32),
+	/*6555*/ uint16(xMatch),
+	/*6556*/ uint16(xSetOp), uint16(JA),
+	/*6558*/ uint16(xReadCd),
+	/*6559*/ uint16(xArgRel32),
+	/*6560*/ uint16(xMatch),
+	/*6561*/ uint16(xCondIs64), 6564, 6578,
+	/*6564*/ uint16(xCondDataSize), 6568, 6573, 0,
+	/*6568*/ uint16(xSetOp), uint16(JS),
+	/*6570*/ uint16(xReadCw),
+	/*6571*/ uint16(xArgRel16),
+	/*6572*/ uint16(xMatch),
+	/*6573*/ uint16(xSetOp), uint16(JS),
+	/*6575*/ uint16(xReadCd),
+	/*6576*/ uint16(xArgRel32),
+	/*6577*/ uint16(xMatch),
+	/*6578*/ uint16(xCondDataSize), 6582, 6573, 6587,
+	/*6582*/ uint16(xSetOp), uint16(JS),
+	/*6584*/ uint16(xReadCd),
+	/*6585*/ uint16(xArgRel32),
+	/*6586*/ uint16(xMatch),
+	/*6587*/ uint16(xSetOp), uint16(JS),
+	/*6589*/ uint16(xReadCd),
+	/*6590*/ uint16(xArgRel32),
 	/*6591*/ uint16(xMatch),
-	/*6592*/ uint16(xSetOp), uint16(BT),
-	/*6594*/ uint16(xReadIb),
-	/*6595*/ uint16(xArgRM32),
-	/*6596*/ uint16(xArgImm8u),
-	/*6597*/ uint16(xMatch),
-	/*6598*/ uint16(xCondDataSize), 6586, 6592, 6602,
-	/*6602*/ uint16(xSetOp), uint16(BT),
-	/*6604*/ uint16(xReadIb),
-	/*6605*/ uint16(xArgRM64),
-	/*6606*/ uint16(xArgImm8u),
-	/*6607*/ uint16(xMatch),
-	/*6608*/ uint16(xCondIs64), 6611, 6627,
-	/*6611*/ uint16(xCondDataSize), 6615, 6621, 0,
-	/*6615*/ uint16(xSetOp), uint16(BTS),
-	/*6617*/ uint16(xReadIb),
-	/*6618*/ uint16(xArgRM16),
-	/*6619*/ uint16(xArgImm8u),
-	/*6620*/ uint16(xMatch),
-	/*6621*/ uint16(xSetOp), uint16(BTS),
-	/*6623*/ uint16(xReadIb),
-	/*6624*/ uint16(xArgRM32),
-	/*6625*/ uint16(xArgImm8u),
-	/*6626*/ uint16(xMatch),
-	/*6627*/ uint16(xCondDataSize), 6615, 6621, 6631,
-	/*6631*/ uint16(xSetOp), uint16(BTS),
-	/*6633*/ uint16(xReadIb),
-	/*6634*/ uint16(xArgRM64),
-	/*6635*/ uint16(xArgImm8u),
-	/*6636*/ uint16(xMatch),
-	/*6637*/ uint16(xCondIs64), 6640, 6656,
-	/*6640*/ uint16(xCondDataSize), 6644, 6650, 0,
-	/*6644*/ uint16(xSetOp), uint16(BTR),
-	/*6646*/ uint16(xReadIb),
-	/*6647*/ uint16(xArgRM16),
-	/*6648*/ uint16(xArgImm8u),
-	/*6649*/ uint16(xMatch),
-	/*6650*/ uint16(xSetOp), uint16(BTR),
-	/*6652*/ uint16(xReadIb),
-	/*6653*/ uint16(xArgRM32),
-	/*6654*/ uint16(xArgImm8u),
-	/*6655*/ uint16(xMatch),
-	/*6656*/ uint16(xCondDataSize), 6644, 6650, 6660,
-	/*6660*/ uint16(xSetOp), uint16(BTR),
-	/*6662*/ uint16(xReadIb),
-	/*6663*/ uint16(xArgRM64),
-	/*6664*/ uint16(xArgImm8u),
+	/*6592*/ uint16(xCondIs64), 6595, 6609,
+	/*6595*/ uint16(xCondDataSize), 6599, 6604, 0,
+	/*6599*/ uint16(xSetOp), uint16(JNS),
+	/*6601*/ uint16(xReadCw),
+	/*6602*/ uint16(xArgRel16),
+	/*6603*/ uint16(xMatch),
+	/*6604*/ uint16(xSetOp), uint16(JNS),
+	/*6606*/ uint16(xReadCd),
+	/*6607*/ uint16(xArgRel32),
+	/*6608*/ uint16(xMatch),
+	/*6609*/ uint16(xCondDataSize), 6613, 6604, 6618,
+	/*6613*/ uint16(xSetOp), uint16(JNS),
+	/*6615*/ uint16(xReadCd),
+	/*6616*/ uint16(xArgRel32),
+	/*6617*/ uint16(xMatch),
+	/*6618*/ uint16(xSetOp), uint16(JNS),
+	/*6620*/ uint16(xReadCd),
+	/*6621*/ uint16(xArgRel32),
+	/*6622*/ uint16(xMatch),
+	/*6623*/ uint16(xCondIs64), 6626, 6640,
+	/*6626*/ uint16(xCondDataSize), 6630, 6635, 0,
+	/*6630*/ uint16(xSetOp), uint16(JP),
+	/*6632*/ uint16(xReadCw),
+	/*6633*/ uint16(xArgRel16),
+	/*6634*/ uint16(xMatch),
+	/*6635*/ uint16(xSetOp), uint16(JP),
+	/*6637*/ uint16(xReadCd),
+	/*6638*/ uint16(xArgRel32),
+	/*6639*/ uint16(xMatch),
+	/*6640*/ uint16(xCondDataSize), 6644, 6635, 6649,
+	/*6644*/ uint16(xSetOp), uint16(JP),
+	/*6646*/ uint16(xReadCd),
+	/*6647*/ uint16(xArgRel32),
+	/*6648*/ uint16(xMatch),
+	/*6649*/ uint16(xSetOp), uint16(JP),
+	/*6651*/ uint16(xReadCd),
+	/*6652*/ uint16(xArgRel32),
+	/*6653*/ uint16(xMatch),
+	/*6654*/ uint16(xCondIs64), 6657, 6671,
+	/*6657*/ uint16(xCondDataSize), 6661, 6666, 0,
+	/*6661*/ uint16(xSetOp), uint16(JNP),
+	/*6663*/ uint16(xReadCw),
+	/*6664*/ uint16(xArgRel16),
 	/*6665*/ uint16(xMatch),
-	/*6666*/ uint16(xCondIs64), 6669, 6685,
-	/*6669*/ uint16(xCondDataSize), 6673, 6679, 0,
-	/*6673*/ uint16(xSetOp), uint16(BTC),
-	/*6675*/ uint16(xReadIb),
-	/*6676*/ uint16(xArgRM16),
-	/*6677*/ uint16(xArgImm8u),
-	/*6678*/ uint16(xMatch),
-	/*6679*/ uint16(xSetOp), uint16(BTC),
-	/*6681*/ uint16(xReadIb),
-	/*6682*/ uint16(xArgRM32),
-	/*6683*/ uint16(xArgImm8u),
+	/*6666*/ uint16(xSetOp), uint16(JNP),
+	/*6668*/ uint16(xReadCd),
+	/*6669*/ uint16(xArgRel32),
+	/*6670*/ uint16(xMatch),
+	/*6671*/ uint16(xCondDataSize), 6675, 6666, 6680,
+	/*6675*/ uint16(xSetOp), uint16(JNP),
+	/*6677*/ uint16(xReadCd),
+	/*6678*/ uint16(xArgRel32),
+	/*6679*/ uint16(xMatch),
+	/*6680*/ uint16(xSetOp), uint16(JNP),
+	/*6682*/ uint16(xReadCd),
+	/*6683*/ uint16(xArgRel32),
 	/*6684*/ uint16(xMatch),
-	/*6685*/ uint16(xCondDataSize), 6673, 6679, 6689,
-	/*6689*/ uint16(xSetOp), uint16(BTC),
-	/*6691*/ uint16(xReadIb),
-	/*6692*/ uint16(xArgRM64),
-	/*6693*/ uint16(xArgImm8u),
-	/*6694*/ uint16(xMatch),
-	/*6695*/ uint16(xCondIs64), 6698, 6714,
-	/*6698*/ uint16(xCondDataSize), 6702, 6708, 0,
-	/*6702*/ uint16(xSetOp), uint16(BTC),
-	/*6704*/ uint16(xReadSlashR),
-	/*6705*/ uint16(xArgRM16),
-	/*6706*/ uint16(xArgR16),
-	/*6707*/ uint16(xMatch),
-	/*6708*/ uint16(xSetOp), uint16(BTC),
-	/*6710*/ uint16(xReadSlashR),
-	/*6711*/ uint16(xArgRM32),
-	/*6712*/ uint16(xArgR32),
-	/*6713*/ uint16(xMatch),
-	/*6714*/ uint16(xCondDataSize), 6702, 6708, 6718,
-	/*6718*/ uint16(xSetOp), uint16(BTC),
-	/*6720*/ uint16(xReadSlashR),
-	/*6721*/ uint16(xArgRM64),
-	/*6722*/ uint16(xArgR64),
-	/*6723*/ uint16(xMatch),
-	/*6724*/ uint16(xCondIs64), 6727, 6765,
-	/*6727*/ uint16(xCondPrefix), 2,
-	0xF3, 6749,
-	0x0, 6733,
-	/*6733*/ uint16(xCondDataSize), 6737, 6743, 0,
-	/*6737*/ uint16(xSetOp), uint16(BSF),
-	/*6739*/ uint16(xReadSlashR),
-	/*6740*/ uint16(xArgR16),
-	/*6741*/ uint16(xArgRM16),
-	/*6742*/ uint16(xMatch),
-	/*6743*/ uint16(xSetOp), uint16(BSF),
-	/*6745*/ uint16(xReadSlashR),
-	/*6746*/ uint16(xArgR32),
-	/*6747*/ uint16(xArgRM32),
-	/*6748*/ uint16(xMatch),
-	/*6749*/ uint16(xCondDataSize), 6753, 6759, 0,
-	/*6753*/ uint16(xSetOp), uint16(TZCNT),
-	/*6755*/ uint16(xReadSlashR),
-	/*6756*/ uint16(xArgR16),
-	/*6757*/ uint16(xArgRM16),
+	/*6685*/ uint16(xCondIs64), 6688, 6702,
+	/*6688*/ uint16(xCondDataSize), 6692, 6697, 0,
+	/*6692*/ uint16(xSetOp), uint16(JL),
+	/*6694*/ uint16(xReadCw),
+	/*6695*/ uint16(xArgRel16),
+	/*6696*/ uint16(xMatch),
+	/*6697*/ uint16(xSetOp), uint16(JL),
+	/*6699*/ uint16(xReadCd),
+	/*6700*/ uint16(xArgRel32),
+	/*6701*/ uint16(xMatch),
+	/*6702*/ uint16(xCondDataSize), 6706, 6697, 6711,
+	/*6706*/ uint16(xSetOp), uint16(JL),
+	/*6708*/ uint16(xReadCd),
+	/*6709*/ uint16(xArgRel32),
+	/*6710*/ uint16(xMatch),
+	/*6711*/ uint16(xSetOp), uint16(JL),
+	/*6713*/ uint16(xReadCd),
+	/*6714*/ uint16(xArgRel32),
+	/*6715*/ uint16(xMatch),
+	/*6716*/ uint16(xCondIs64), 6719, 6733,
+	/*6719*/ uint16(xCondDataSize), 6723, 6728, 0,
+	/*6723*/ uint16(xSetOp), uint16(JGE),
+	/*6725*/ uint16(xReadCw),
+	/*6726*/ uint16(xArgRel16),
+	/*6727*/ uint16(xMatch),
+	/*6728*/ uint16(xSetOp), uint16(JGE),
+	/*6730*/ uint16(xReadCd),
+	/*6731*/ uint16(xArgRel32),
+	/*6732*/ uint16(xMatch),
+	/*6733*/ uint16(xCondDataSize), 6737, 6728, 6742,
+	/*6737*/ uint16(xSetOp), uint16(JGE),
+	/*6739*/ uint16(xReadCd),
+	/*6740*/ uint16(xArgRel32),
+	/*6741*/ uint16(xMatch),
+	/*6742*/ uint16(xSetOp), uint16(JGE),
+	/*6744*/ uint16(xReadCd),
+	/*6745*/ uint16(xArgRel32),
+	/*6746*/ uint16(xMatch),
+	/*6747*/ uint16(xCondIs64), 6750, 6764,
+	/*6750*/ uint16(xCondDataSize), 6754, 6759, 0,
+	/*6754*/ uint16(xSetOp), uint16(JLE),
+	/*6756*/ uint16(xReadCw),
+	/*6757*/ uint16(xArgRel16),
 	/*6758*/ uint16(xMatch),
-	/*6759*/ uint16(xSetOp), uint16(TZCNT),
-	/*6761*/ uint16(xReadSlashR),
-	/*6762*/ uint16(xArgR32),
-	/*6763*/ uint16(xArgRM32),
-	/*6764*/ uint16(xMatch),
-	/*6765*/ uint16(xCondPrefix), 2,
-	0xF3, 6781,
-	0x0, 6771,
-	/*6771*/ uint16(xCondDataSize), 6737, 6743, 6775,
-	/*6775*/ uint16(xSetOp), uint16(BSF),
-	/*6777*/ uint16(xReadSlashR),
-	/*6778*/ uint16(xArgR64),
-	/*6779*/ uint16(xArgRM64),
-	/*6780*/ uint16(xMatch),
-	/*6781*/ uint16(xCondDataSize), 6753, 6759, 6785,
-	/*6785*/ uint16(xSetOp), uint16(TZCNT),
-	/*6787*/ uint16(xReadSlashR),
-	/*6788*/ uint16(xArgR64),
-	/*6789*/ uint16(xArgRM64),
-	/*6790*/ uint16(xMatch),
-	/*6791*/ uint16(xCondIs64), 6794, 6832,
-	/*6794*/ uint16(xCondPrefix), 2,
-	0xF3, 6816,
-	0x0, 6800,
-	/*6800*/ uint16(xCondDataSize), 6804, 6810, 0,
-	/*6804*/ uint16(xSetOp), uint16(BSR),
-	/*6806*/ uint16(xReadSlashR),
-	/*6807*/ uint16(xArgR16),
-	/*6808*/ uint16(xArgRM16),
-	/*6809*/ uint16(xMatch),
-	/*6810*/ uint16(xSetOp), uint16(BSR),
-	/*6812*/ uint16(xReadSlashR),
-	/*6813*/ uint16(xArgR32),
-	/*6814*/ uint16(xArgRM32),
-	/*6815*/ uint16(xMatch),
-	/*6816*/ uint16(xCondDataSize), 6820, 6826, 0,
-	/*6820*/ uint16(xSetOp), uint16(LZCNT),
-	/*6822*/ uint16(xReadSlashR),
-	/*6823*/ uint16(xArgR16),
-	/*6824*/ uint16(xArgRM16),
-	/*6825*/ uint16(xMatch),
-	/*6826*/ uint16(xSetOp), uint16(LZCNT),
-	/*6828*/ uint16(xReadSlashR),
-	/*6829*/ uint16(xArgR32),
-	/*6830*/ uint16(xArgRM32),
-	/*6831*/ uint16(xMatch),
-	/*6832*/ uint16(xCondPrefix), 2,
-	0xF3, 6848,
-	0x0, 6838,
-	/*6838*/ uint16(xCondDataSize), 6804, 6810, 6842,
-	/*6842*/ uint16(xSetOp), uint16(BSR),
-	/*6844*/ uint16(xReadSlashR),
-	/*6845*/ uint16(xArgR64),
-	/*6846*/ uint16(xArgRM64),
-	/*6847*/ uint16(xMatch),
-	/*6848*/ uint16(xCondDataSize), 6820, 6826, 6852,
-	/*6852*/ uint16(xSetOp), uint16(LZCNT),
-	/*6854*/ uint16(xReadSlashR),
-	/*6855*/ uint16(xArgR64),
-	/*6856*/ uint16(xArgRM64),
-	/*6857*/ uint16(xMatch),
-	/*6858*/ uint16(xCondIs64), 6861, 6877,
-	/*6861*/ uint16(xCondDataSize), 6865, 6871, 0,
-	/*6865*/ uint16(xSetOp), uint16(MOVSX),
-	/*6867*/ uint16(xReadSlashR),
-	/*6868*/ uint16(xArgR16),
-	/*6869*/ uint16(xArgRM8),
-	/*6870*/ uint16(xMatch),
-	/*6871*/ uint16(xSetOp), uint16(MOVSX),
-	/*6873*/ uint16(xReadSlashR),
-	/*6874*/ uint16(xArgR32),
-	/*6875*/ uint16(xArgRM8),
-	/*6876*/ uint16(xMatch),
-	/*6877*/ uint16(xCondDataSize), 6865, 6871, 6881,
-	/*6881*/ uint16(xSetOp), uint16(MOVSX),
-	/*6883*/ uint16(xReadSlashR),
-	/*6884*/ uint16(xArgR64),
-	/*6885*/ uint16(xArgRM8),
-	/*6886*/ uint16(xMatch),
-	/*6887*/ uint16(xCondIs64), 6890, 6906,
-	/*6890*/ uint16(xCondDataSize), 6894, 6900, 0,
-	/*6894*/ uint16(xSetOp), uint16(MOVSX),
-	/*6896*/ uint16(xReadSlashR),
-	/*6897*/ uint16(xArgR16),
-	/*6898*/ uint16(xArgRM16),
-	/*6899*/ uint16(xMatch),
-	/*6900*/ uint16(xSetOp), uint16(MOVSX),
-	/*6902*/ uint16(xReadSlashR),
-	/*6903*/ uint16(xArgR32),
-	/*6904*/ uint16(xArgRM16),
-	/*6905*/ uint16(xMatch),
-	/*6906*/ uint16(xCondDataSize), 6894, 6900, 6910,
-	/*6910*/ uint16(xSetOp), uint16(MOVSX),
-	/*6912*/ uint16(xReadSlashR),
-	/*6913*/ uint16(xArgR64),
-	/*6914*/ uint16(xArgRM16),
+	/*6759*/ uint16(xSetOp), uint16(JLE),
+	/*6761*/ uint16(xReadCd),
+	/*6762*/ uint16(xArgRel32),
+	/*6763*/ uint16(xMatch),
+	/*6764*/ uint16(xCondDataSize), 6768, 6759, 6773,
+	/*6768*/ uint16(xSetOp), uint16(JLE),
+	/*6770*/ uint16(xReadCd),
+	/*6771*/ uint16(xArgRel32),
+	/*6772*/ uint16(xMatch),
+	/*6773*/ uint16(xSetOp), uint16(JLE),
+	/*6775*/ uint16(xReadCd),
+	/*6776*/ uint16(xArgRel32),
+	/*6777*/ uint16(xMatch),
+	/*6778*/ uint16(xCondIs64), 6781, 6795,
+	/*6781*/ uint16(xCondDataSize), 6785, 6790, 0,
+	/*6785*/ uint16(xSetOp), uint16(JG),
+	/*6787*/ uint16(xReadCw),
+	/*6788*/ uint16(xArgRel16),
+	/*6789*/ uint16(xMatch),
+	/*6790*/ uint16(xSetOp), uint16(JG),
+	/*6792*/ uint16(xReadCd),
+	/*6793*/ uint16(xArgRel32),
+	/*6794*/ uint16(xMatch),
+	/*6795*/ uint16(xCondDataSize), 6799, 6790, 6804,
+	/*6799*/ uint16(xSetOp), uint16(JG),
+	/*6801*/ uint16(xReadCd),
+	/*6802*/ uint16(xArgRel32),
+	/*6803*/ uint16(xMatch),
+	/*6804*/ uint16(xSetOp), uint16(JG),
+	/*6806*/ uint16(xReadCd),
+	/*6807*/ uint16(xArgRel32),
+	/*6808*/ uint16(xMatch),
+	/*6809*/ uint16(xSetOp), uint16(SETO),
+	/*6811*/ uint16(xReadSlashR),
+	/*6812*/ uint16(xArgRM8),
+	/*6813*/ uint16(xMatch),
+	/*6814*/ uint16(xSetOp), uint16(SETNO),
+	/*6816*/ uint16(xReadSlashR),
+	/*6817*/ uint16(xArgRM8),
+	/*6818*/ uint16(xMatch),
+	/*6819*/ uint16(xSetOp), uint16(SETB),
+	/*6821*/ uint16(xReadSlashR),
+	/*6822*/ uint16(xArgRM8),
+	/*6823*/ uint16(xMatch),
+	/*6824*/ uint16(xSetOp), uint16(SETAE),
+	/*6826*/ uint16(xReadSlashR),
+	/*6827*/ uint16(xArgRM8),
+	/*6828*/ uint16(xMatch),
+	/*6829*/ uint16(xSetOp), uint16(SETE),
+	/*6831*/ uint16(xReadSlashR),
+	/*6832*/ uint16(xArgRM8),
+	/*6833*/ uint16(xMatch),
+	/*6834*/ uint16(xSetOp), uint16(SETNE),
+	/*6836*/ uint16(xReadSlashR),
+	/*6837*/ uint16(xArgRM8),
+	/*6838*/ uint16(xMatch),
+	/*6839*/ uint16(xSetOp), uint16(SETBE),
+	/*6841*/ uint16(xReadSlashR),
+	/*6842*/ uint16(xArgRM8),
+	/*6843*/ uint16(xMatch),
+	/*6844*/ uint16(xSetOp), uint16(SETA),
+	/*6846*/ uint16(xReadSlashR),
+	/*6847*/ uint16(xArgRM8),
+	/*6848*/ uint16(xMatch),
+	/*6849*/ uint16(xSetOp), uint16(SETS),
+	/*6851*/ uint16(xReadSlashR),
+	/*6852*/ uint16(xArgRM8),
+	/*6853*/ uint16(xMatch),
+	/*6854*/ uint16(xSetOp), uint16(SETNS),
+	/*6856*/ uint16(xReadSlashR),
+	/*6857*/ uint16(xArgRM8),
+	/*6858*/ uint16(xMatch),
+	/*6859*/ uint16(xSetOp), uint16(SETP),
+	/*6861*/ uint16(xReadSlashR),
+	/*6862*/ uint16(xArgRM8),
+	/*6863*/ uint16(xMatch),
+	/*6864*/ uint16(xSetOp), uint16(SETNP),
+	/*6866*/ uint16(xReadSlashR),
+	/*6867*/ uint16(xArgRM8),
+	/*6868*/ uint16(xMatch),
+	/*6869*/ uint16(xSetOp), uint16(SETL),
+	/*6871*/ uint16(xReadSlashR),
+	/*6872*/ uint16(xArgRM8),
+	/*6873*/ uint16(xMatch),
+	/*6874*/ uint16(xSetOp), uint16(SETGE),
+	/*6876*/ uint16(xReadSlashR),
+	/*6877*/ uint16(xArgRM8),
+	/*6878*/ uint16(xMatch),
+	/*6879*/ uint16(xSetOp), uint16(SETLE),
+	/*6881*/ uint16(xReadSlashR),
+	/*6882*/ uint16(xArgRM8),
+	/*6883*/ uint16(xMatch),
+	/*6884*/ uint16(xSetOp), uint16(SETG),
+	/*6886*/ uint16(xReadSlashR),
+	/*6887*/ uint16(xArgRM8),
+	/*6888*/ uint16(xMatch),
+	/*6889*/ uint16(xSetOp), uint16(PUSH),
+	/*6891*/ uint16(xArgFS),
+	/*6892*/ uint16(xMatch),
+	/*6893*/ uint16(xCondIs64), 6896, 6908,
+	/*6896*/ uint16(xCondDataSize), 6900, 6904, 0,
+	/*6900*/ uint16(xSetOp), uint16(POP),
+	/*6902*/ uint16(xArgFS),
+	/*6903*/ uint16(xMatch),
+	/*6904*/ uint16(xSetOp), uint16(POP),
+	/*6906*/ uint16(xArgFS),
+	/*6907*/ uint16(xMatch),
+	/*6908*/ uint16(xCondDataSize), 6900, 6912, 6916,
+	/*6912*/ uint16(xSetOp), uint16(POP),
+	/*6914*/ uint16(xArgFS),
 	/*6915*/ uint16(xMatch),
-	/*6916*/ uint16(xSetOp), uint16(XADD),
-	/*6918*/ uint16(xReadSlashR),
-	/*6919*/ uint16(xArgRM8),
-	/*6920*/ uint16(xArgR8),
-	/*6921*/ uint16(xMatch),
-	/*6922*/ uint16(xCondIs64), 6925, 6941,
-	/*6925*/ uint16(xCondDataSize), 6929, 6935, 0,
-	/*6929*/ uint16(xSetOp), uint16(XADD),
-	/*6931*/ uint16(xReadSlashR),
-	/*6932*/ uint16(xArgRM16),
-	/*6933*/ uint16(xArgR16),
-	/*6934*/ uint16(xMatch),
-	/*6935*/ uint16(xSetOp), uint16(XADD),
-	/*6937*/ uint16(xReadSlashR),
-	/*6938*/ uint16(xArgRM32),
-	/*6939*/ uint16(xArgR32),
-	/*6940*/ uint16(xMatch),
-	/*6941*/ uint16(xCondDataSize), 6929, 6935, 6945,
-	/*6945*/ uint16(xSetOp), uint16(XADD),
-	/*6947*/ uint16(xReadSlashR),
-	/*6948*/ uint16(xArgRM64),
-	/*6949*/ uint16(xArgR64),
-	/*6950*/ uint16(xMatch),
-	/*6951*/ uint16(xCondPrefix), 4,
-	0xF3, 6985,
-	0xF2, 6977,
-	0x66, 6969,
-	0x0, 6961,
-	/*6961*/ uint16(xSetOp), uint16(CMPPS),
-	/*6963*/ uint16(xReadSlashR),
-	/*6964*/ uint16(xReadIb),
-	/*6965*/ uint16(xArgXmm1),
-	/*6966*/ uint16(xArgXmm2M128),
-	/*6967*/ uint16(xArgImm8u),
-	/*6968*/ uint16(xMatch),
-	/*6969*/ uint16(xSetOp), uint16(CMPPD),
-	/*6971*/ uint16(xReadSlashR),
-	/*6972*/ uint16(xReadIb),
-	/*6973*/ uint16(xArgXmm1),
-	/*6974*/ uint16(xArgXmm2M128),
-	/*6975*/ uint16(xArgImm8u),
-	/*6976*/ uint16(xMatch),
-	/*6977*/ uint16(xSetOp), uint16(CMPSD_XMM),
-	/*6979*/ uint16(xReadSlashR),
-	/*6980*/ uint16(xReadIb),
-	/*6981*/ uint16(xArgXmm1),
-	/*6982*/ uint16(xArgXmm2M64),
-	/*6983*/ uint16(xArgImm8u),
-	/*6984*/ uint16(xMatch),
-	/*6985*/ uint16(xSetOp), uint16(CMPSS),
-	/*6987*/ uint16(xReadSlashR),
-	/*6988*/ uint16(xReadIb),
-	/*6989*/ uint16(xArgXmm1),
-	/*6990*/ uint16(xArgXmm2M32),
-	/*6991*/ uint16(xArgImm8u),
-	/*6992*/ uint16(xMatch),
-	/*6993*/ uint16(xCondIs64), 6996, 7012,
-	/*6996*/ uint16(xCondDataSize), 7000, 7006, 0,
-	/*7000*/ uint16(xSetOp), uint16(MOVNTI),
-	/*7002*/ uint16(xReadSlashR),
-	/*7003*/ uint16(xArgM32),
-	/*7004*/ uint16(xArgR32),
-	/*7005*/ uint16(xMatch),
-	/*7006*/ uint16(xSetOp), uint16(MOVNTI),
-	/*7008*/ uint16(xReadSlashR),
-	/*7009*/ uint16(xArgM32),
-	/*7010*/ uint16(xArgR32),
-	/*7011*/ uint16(xMatch),
-	/*7012*/ uint16(xCondDataSize), 7000, 7006, 7016,
-	/*7016*/ uint16(xSetOp), uint16(MOVNTI),
-	/*7018*/ uint16(xReadSlashR),
-	/*7019*/ uint16(xArgM64),
-	/*7020*/ uint16(xArgR64),
-	/*7021*/ uint16(xMatch),
-	/*7022*/ uint16(xCondPrefix), 2,
-	0x66, 7036,
-	0x0, 7028,
-	/*7028*/ uint16(xSetOp), uint16(PINSRW),
-	/*7030*/ uint16(xReadSlashR),
-	/*7031*/ uint16(xReadIb),
-	/*7032*/ uint16(xArgMm),
-	/*7033*/ uint16(xArgR32M16),
-	/*7034*/ uint16(xArgImm8u),
-	/*7035*/ uint16(xMatch),
-	/*7036*/ uint16(xSetOp), uint16(PINSRW),
-	/*7038*/ uint16(xReadSlashR),
-	/*7039*/ uint16(xReadIb),
-	/*7040*/ uint16(xArgXmm),
-	/*7041*/ uint16(xArgR32M16),
-	/*7042*/ uint16(xArgImm8u),
-	/*7043*/ uint16(xMatch),
-	/*7044*/ uint16(xCondPrefix), 2,
-	0x66, 7058,
-	0x0, 7050,
-	/*7050*/ uint16(xSetOp), uint16(PEXTRW),
-	/*7052*/ uint16(xReadSlashR),
-	/*7053*/ uint16(xReadIb),
-	/*7054*/ uint16(xArgR32),
-	/*7055*/ uint16(xArgMm2),
-	/*7056*/ uint16(xArgImm8u),
-	/*7057*/ uint16(xMatch),
-	/*7058*/ uint16(xSetOp), uint16(PEXTRW),
-	/*7060*/ uint16(xReadSlashR),
-	/*7061*/ uint16(xReadIb),
-	/*7062*/ uint16(xArgR32),
-	/*7063*/ uint16(xArgXmm2),
-	/*7064*/ uint16(xArgImm8u),
+	/*6916*/ uint16(xSetOp), uint16(POP),
+	/*6918*/ uint16(xArgFS),
+	/*6919*/ uint16(xMatch),
+	/*6920*/ uint16(xSetOp), uint16(CPUID),
+	/*6922*/ uint16(xMatch),
+	/*6923*/ uint16(xCondIs64), 6926, 6942,
+	/*6926*/ uint16(xCondDataSize), 6930, 6936, 0,
+	/*6930*/ uint16(xSetOp), uint16(BT),
+	/*6932*/ uint16(xReadSlashR),
+	/*6933*/ uint16(xArgRM16),
+	/*6934*/ uint16(xArgR16),
+	/*6935*/ uint16(xMatch),
+	/*6936*/ uint16(xSetOp), uint16(BT),
+	/*6938*/ uint16(xReadSlashR),
+	/*6939*/ uint16(xArgRM32),
+	/*6940*/ uint16(xArgR32),
+	/*6941*/ uint16(xMatch),
+	/*6942*/ uint16(xCondDataSize), 6930, 6936, 6946,
+	/*6946*/ uint16(xSetOp), uint16(BT),
+	/*6948*/ uint16(xReadSlashR),
+	/*6949*/ uint16(xArgRM64),
+	/*6950*/ uint16(xArgR64),
+	/*6951*/ uint16(xMatch),
+	/*6952*/ uint16(xCondIs64), 6955, 6975,
+	/*6955*/ uint16(xCondDataSize), 6959, 6967, 0,
+	/*6959*/ uint16(xSetOp), uint16(SHLD),
+	/*6961*/ uint16(xReadSlashR),
+	/*6962*/ uint16(xReadIb),
+	/*6963*/ uint16(xArgRM16),
+	/*6964*/ uint16(xArgR16),
+	/*6965*/ uint16(xArgImm8u),
+	/*6966*/ uint16(xMatch),
+	/*6967*/ uint16(xSetOp), uint16(SHLD),
+	/*6969*/ uint16(xReadSlashR),
+	/*6970*/ uint16(xReadIb),
+	/*6971*/ uint16(xArgRM32),
+	/*6972*/ uint16(xArgR32),
+	/*6973*/ uint16(xArgImm8u),
+	/*6974*/ uint16(xMatch),
+	/*6975*/ uint16(xCondDataSize), 6959, 6967, 6979,
+	/*6979*/ uint16(xSetOp), uint16(SHLD),
+	/*6981*/ uint16(xReadSlashR),
+	/*6982*/ uint16(xReadIb),
+	/*6983*/ uint16(xArgRM64),
+	/*6984*/ uint16(xArgR64),
+	/*6985*/ uint16(xArgImm8u),
+	/*6986*/ uint16(xMatch),
+	/*6987*/ uint16(xCondIs64), 6990, 7008,
+	/*6990*/ uint16(xCondDataSize), 6994, 7001, 0,
+	/*6994*/ uint16(xSetOp), uint16(SHLD),
+	/*6996*/ uint16(xReadSlashR),
+	/*6997*/ uint16(xArgRM16),
+	/*6998*/ uint16(xArgR16),
+	/*6999*/ uint16(xArgCL),
+	/*7000*/ uint16(xMatch),
+	/*7001*/ uint16(xSetOp), uint16(SHLD),
+	/*7003*/ uint16(xReadSlashR),
+	/*7004*/ uint16(xArgRM32),
+	/*7005*/ uint16(xArgR32),
+	/*7006*/ uint16(xArgCL),
+	/*7007*/ uint16(xMatch),
+	/*7008*/ uint16(xCondDataSize), 6994, 7001, 7012,
+	/*7012*/ uint16(xSetOp), uint16(SHLD),
+	/*7014*/ uint16(xReadSlashR),
+	/*7015*/ uint16(xArgRM64),
+	/*7016*/ uint16(xArgR64),
+	/*7017*/ uint16(xArgCL),
+	/*7018*/ uint16(xMatch),
+	/*7019*/ uint16(xSetOp), uint16(PUSH),
+	/*7021*/ uint16(xArgGS),
+	/*7022*/ uint16(xMatch),
+	/*7023*/ uint16(xCondIs64), 7026, 7038,
+	/*7026*/ uint16(xCondDataSize), 7030, 7034, 0,
+	/*7030*/ uint16(xSetOp), uint16(POP),
+	/*7032*/ uint16(xArgGS),
+	/*7033*/ uint16(xMatch),
+	/*7034*/ uint16(xSetOp), uint16(POP),
+	/*7036*/ uint16(xArgGS),
+	/*7037*/ uint16(xMatch),
+	/*7038*/ uint16(xCondDataSize), 7030, 7042, 7046,
+	/*7042*/ uint16(xSetOp), uint16(POP),
+	/*7044*/ uint16(xArgGS),
+	/*7045*/ uint16(xMatch),
+	/*7046*/ uint16(xSetOp), uint16(POP),
+	/*7048*/ uint16(xArgGS),
+	/*7049*/ uint16(xMatch),
+	/*7050*/ uint16(xSetOp), uint16(RSM),
+	/*7052*/ uint16(xMatch),
+	/*7053*/ uint16(xCondIs64), 7056, 7072,
+	/*7056*/ uint16(xCondDataSize), 7060, 7066, 0,
+	/*7060*/ uint16(xSetOp), uint16(BTS),
+	/*7062*/ uint16(xReadSlashR),
+	/*7063*/ uint16(xArgRM16),
+	/*7064*/ uint16(xArgR16),
 	/*7065*/ uint16(xMatch),
-	/*7066*/ uint16(xCondPrefix), 2,
-	0x66, 7080,
-	0x0, 7072,
-	/*7072*/ uint16(xSetOp), uint16(SHUFPS),
-	/*7074*/ uint16(xReadSlashR),
-	/*7075*/ uint16(xReadIb),
-	/*7076*/ uint16(xArgXmm1),
-	/*7077*/ uint16(xArgXmm2M128),
-	/*7078*/ uint16(xArgImm8u),
-	/*7079*/ uint16(xMatch),
-	/*7080*/ uint16(xSetOp), uint16(SHUFPD),
-	/*7082*/ uint16(xReadSlashR),
-	/*7083*/ uint16(xReadIb),
-	/*7084*/ uint16(xArgXmm1),
-	/*7085*/ uint16(xArgXmm2M128),
-	/*7086*/ uint16(xArgImm8u),
-	/*7087*/ uint16(xMatch),
-	/*7088*/ uint16(xCondSlashR),
-	0,    // 0
-	7097, // 1
-	0,    // 2
-	7120, // 3
-	7143, // 4
-	7166, // 5
-	7189, // 6
-	0,    // 7
-	/*7097*/ uint16(xCondIs64), 7100, 7112,
-	/*7100*/ uint16(xCondDataSize), 7104, 7108, 0,
-	/*7104*/ uint16(xSetOp), uint16(CMPXCHG8B),
-	/*7106*/ uint16(xArgM64),
-	/*7107*/ uint16(xMatch),
-	/*7108*/ uint16(xSetOp), uint16(CMPXCHG8B),
-	/*7110*/ uint16(xArgM64),
-	/*7111*/ uint16(xMatch),
-	/*7112*/ uint16(xCondDataSize), 7104, 7108, 7116,
-	/*7116*/ uint16(xSetOp), uint16(CMPXCHG16B),
-	/*7118*/ uint16(xArgM128),
-	/*7119*/ uint16(xMatch),
-	/*7120*/ uint16(xCondIs64), 7123, 7135,
-	/*7123*/ uint16(xCondDataSize), 7127, 7131, 0,
-	/*7127*/ uint16(xSetOp), uint16(XRSTORS),
-	/*7129*/ uint16(xArgMem),
+	/*7066*/ uint16(xSetOp), uint16(BTS),
+	/*7068*/ uint16(xReadSlashR),
+	/*7069*/ uint16(xArgRM32),
+	/*7070*/ uint16(xArgR32),
+	/*7071*/ uint16(xMatch),
+	/*7072*/ uint16(xCondDataSize), 7060, 7066, 7076,
+	/*7076*/ uint16(xSetOp), uint16(BTS),
+	/*7078*/ uint16(xReadSlashR),
+	/*7079*/ uint16(xArgRM64),
+	/*7080*/ uint16(xArgR64),
+	/*7081*/ uint16(xMatch),
+	/*7082*/ uint16(xCondIs64), 7085, 7105,
+	/*7085*/ uint16(xCondDataSize), 7089, 7097, 0,
+	/*7089*/ uint16(xSetOp), uint16(SHRD),
+	/*7091*/ uint16(xReadSlashR),
+	/*7092*/ uint16(xReadIb),
+	/*7093*/ uint16(xArgRM16),
+	/*7094*/ uint16(xArgR16),
+	/*7095*/ uint16(xArgImm8u),
+	/*7096*/ uint16(xMatch),
+	/*7097*/ uint16(xSetOp), uint16(SHRD),
+	/*7099*/ uint16(xReadSlashR),
+	/*7100*/ uint16(xReadIb),
+	/*7101*/ uint16(xArgRM32),
+	/*7102*/ uint16(xArgR32),
+	/*7103*/ uint16(xArgImm8u),
+	/*7104*/ uint16(xMatch),
+	/*7105*/ uint16(xCondDataSize), 7089, 7097, 7109,
+	/*7109*/ uint16(xSetOp), uint16(SHRD),
+	/*7111*/ uint16(xReadSlashR),
+	/*7112*/ uint16(xReadIb),
+	/*7113*/ uint16(xArgRM64),
+	/*7114*/ uint16(xArgR64),
+	/*7115*/ uint16(xArgImm8u),
+	/*7116*/ uint16(xMatch),
+	/*7117*/ uint16(xCondIs64), 7120, 7138,
+	/*7120*/ uint16(xCondDataSize), 7124, 7131, 0,
+	/*7124*/ uint16(xSetOp), uint16(SHRD),
+	/*7126*/ uint16(xReadSlashR),
+	/*7127*/ uint16(xArgRM16),
+	/*7128*/ uint16(xArgR16),
+	/*7129*/ uint16(xArgCL),
 	/*7130*/ uint16(xMatch),
-	/*7131*/ uint16(xSetOp), uint16(XRSTORS),
-	/*7133*/ uint16(xArgMem),
-	/*7134*/ uint16(xMatch),
-	/*7135*/ uint16(xCondDataSize), 7127, 7131, 7139,
-	/*7139*/ uint16(xSetOp), uint16(XRSTORS64),
-	/*7141*/ uint16(xArgMem),
-	/*7142*/ uint16(xMatch),
-	/*7143*/ uint16(xCondIs64), 7146, 7158,
-	/*7146*/ uint16(xCondDataSize), 7150, 7154, 0,
-	/*7150*/ uint16(xSetOp), uint16(XSAVEC),
-	/*7152*/ uint16(xArgMem),
-	/*7153*/ uint16(xMatch),
-	/*7154*/ uint16(xSetOp), uint16(XSAVEC),
-	/*7156*/ uint16(xArgMem),
-	/*7157*/ uint16(xMatch),
-	/*7158*/ uint16(xCondDataSize), 7150, 7154, 7162,
-	/*7162*/ uint16(xSetOp), uint16(XSAVEC64),
-	/*7164*/ uint16(xArgMem),
-	/*7165*/ uint16(xMatch),
-	/*7166*/ uint16(xCondIs64), 7169, 7181,
-	/*7169*/ uint16(xCondDataSize), 7173, 7177, 0,
-	/*7173*/ uint16(xSetOp), uint16(XSAVES),
-	/*7175*/ uint16(xArgMem),
+	/*7131*/ uint16(xSetOp), uint16(SHRD),
+	/*7133*/ uint16(xReadSlashR),
+	/*7134*/ uint16(xArgRM32),
+	/*7135*/ uint16(xArgR32),
+	/*7136*/ uint16(xArgCL),
+	/*7137*/ uint16(xMatch),
+	/*7138*/ uint16(xCondDataSize), 7124, 7131, 7142,
+	/*7142*/ uint16(xSetOp), uint16(SHRD),
+	/*7144*/ uint16(xReadSlashR),
+	/*7145*/ uint16(xArgRM64),
+	/*7146*/ uint16(xArgR64),
+	/*7147*/ uint16(xArgCL),
+	/*7148*/ uint16(xMatch),
+	/*7149*/ uint16(xCondByte), 1,
+	0xF8, 7609,
+	/*7153*/ uint16(xCondSlashR),
+	7162, // 0
+	7222, // 1
+	7282, // 2
+	7319, // 3
+	7356, // 4
+	7385, // 5
+	7478, // 6
+	7605, // 7
+	/*7162*/ uint16(xCondIs64), 7165, 7177,
+	/*7165*/ uint16(xCondDataSize), 7169, 7173, 0,
+	/*7169*/ uint16(xSetOp), uint16(FXSAVE),
+	/*7171*/ uint16(xArgM512byte),
+	/*7172*/ uint16(xMatch),
+	/*7173*/ uint16(xSetOp), uint16(FXSAVE),
+	/*7175*/ uint16(xArgM512byte),
 	/*7176*/ uint16(xMatch),
-	/*7177*/ uint16(xSetOp), uint16(XSAVES),
-	/*7179*/ uint16(xArgMem),
-	/*7180*/ uint16(xMatch),
-	/*7181*/ uint16(xCondDataSize), 7173, 7177, 7185,
-	/*7185*/ uint16(xSetOp), uint16(XSAVES64),
-	/*7187*/ uint16(xArgMem),
-	/*7188*/ uint16(xMatch),
-	/*7189*/ uint16(xCondIs64), 7192, 7210,
-	/*7192*/ uint16(xCondDataSize), 7196, 7203, 0,
-	/*7196*/ uint16(xCondIsMem), 7199, 0,
-	/*7199*/ uint16(xSetOp), uint16(RDRAND),
-	/*7201*/ uint16(xArgRmf16),
-	/*7202*/ uint16(xMatch),
-	/*7203*/ uint16(xCondIsMem), 7206, 0,
-	/*7206*/ uint16(xSetOp), uint16(RDRAND),
-	/*7208*/ uint16(xArgRmf32),
-	/*7209*/ uint16(xMatch),
-	/*7210*/ uint16(xCondDataSize), 7196, 7203, 7214,
-	/*7214*/ uint16(xSetOp), uint16(RDRAND),
-	/*7216*/ uint16(xMatch),
-	/*7217*/ uint16(xCondIs64), 7220, 7232,
-	/*7220*/ uint16(xCondDataSize), 7224, 7228, 0,
-	/*7224*/ uint16(xSetOp), uint16(BSWAP),
-	/*7226*/ uint16(xArgR16op),
-	/*7227*/ uint16(xMatch),
-	/*7228*/ uint16(xSetOp), uint16(BSWAP),
-	/*7230*/ uint16(xArgR32op),
-	/*7231*/ uint16(xMatch),
-	/*7232*/ uint16(xCondDataSize), 7224, 7228, 7236,
-	/*7236*/ uint16(xSetOp), uint16(BSWAP),
-	/*7238*/ uint16(xArgR64op),
-	/*7239*/ uint16(xMatch),
-	/*7240*/ uint16(xCondPrefix), 2,
-	0xF2, 7252,
-	0x66, 7246,
-	/*7246*/ uint16(xSetOp), uint16(ADDSUBPD),
-	/*7248*/ uint16(xReadSlashR),
-	/*7249*/ uint16(xArgXmm1),
-	/*7250*/ uint16(xArgXmm2M128),
-	/*7251*/ uint16(xMatch),
-	/*7252*/ uint16(xSetOp), uint16(ADDSUBPS),
-	/*7254*/ uint16(xReadSlashR),
-	/*7255*/ uint16(xArgXmm1),
-	/*7256*/ uint16(xArgXmm2M128),
-	/*7257*/ uint16(xMatch),
-	/*7258*/ uint16(xCondPrefix), 2,
-	0x66, 7270,
-	0x0, 7264,
-	/*7264*/ uint16(xSetOp), uint16(PSRLW),
-	/*7266*/ uint16(xReadSlashR),
-	/*7267*/ uint16(xArgMm),
-	/*7268*/ uint16(xArgMmM64),
-	/*7269*/ uint16(xMatch),
-	/*7270*/ uint16(xSetOp), uint16(PSRLW),
-	/*7272*/ uint16(xReadSlashR),
-	/*7273*/ uint16(xArgXmm1),
-	/*7274*/ uint16(xArgXmm2M128),
-	/*7275*/ uint16(xMatch),
-	/*7276*/ uint16(xCondPrefix), 2,
-	0x66, 7288,
-	0x0, 7282,
-	/*7282*/ uint16(xSetOp), uint16(PSRLD),
-	/*7284*/ uint16(xReadSlashR),
-	/*7285*/ uint16(xArgMm),
-	/*7286*/ uint16(xArgMmM64),
-	/*7287*/ uint16(xMatch),
-	/*7288*/ uint16(xSetOp), uint16(PSRLD),
-	/*7290*/ uint16(xReadSlashR),
-	/*7291*/ uint16(xArgXmm1),
-	/*7292*/ uint16(xArgXmm2M128),
-	/*7293*/ uint16(xMatch),
-	/*7294*/ uint16(xCondPrefix), 2,
-	0x66, 7306,
-	0x0, 7300,
-	/*7300*/ uint16(xSetOp), uint16(PSRLQ),
-	/*7302*/ uint16(xReadSlashR),
-	/*7303*/ uint16(xArgMm),
-	/*7304*/ uint16(xArgMmM64),
-	/*7305*/ uint16(xMatch),
-	/*7306*/ uint16(xSetOp), uint16(PSRLQ),
-	/*7308*/ uint16(xReadSlashR),
-	/*7309*/ uint16(xArgXmm1),
-	/*7310*/ uint16(xArgXmm2M128),
-	/*7311*/ uint16(xMatch),
-	/*7312*/ uint16(xCondPrefix), 2,
-	0x66, 7324,
-	0x0, 7318,
-	/*7318*/ uint16(xSetOp), uint16(PADDQ),
-	/*7320*/ uint16(xReadSlashR),
-	/*7321*/ uint16(xArgMm1),
-	/*7322*/ uint16(xArgMm2M64),
-	/*7323*/ uint16(xMatch),
-	/*7324*/ uint16(xSetOp), uint16(PADDQ),
-	/*7326*/ uint16(xReadSlashR),
-	/*7327*/ uint16(xArgXmm1),
-	/*7328*/ uint16(xArgXmm2M128),
-	/*7329*/ uint16(xMatch),
-	/*7330*/ uint16(xCondPrefix), 2,
-	0x66, 7342,
-	0x0, 7336,
-	/*7336*/ uint16(xSetOp), uint16(PMULLW),
-	/*7338*/ uint16(xReadSlashR),
-	/*7339*/ uint16(xArgMm),
-	/*7340*/ uint16(xArgMmM64),
-	/*7341*/ uint16(xMatch),
-	/*7342*/ uint16(xSetOp), uint16(PMULLW),
-	/*7344*/ uint16(xReadSlashR),
-	/*7345*/ uint16(xArgXmm1),
-	/*7346*/ uint16(xArgXmm2M128),
-	/*7347*/ uint16(xMatch),
-	/*7348*/ uint16(xCondPrefix), 3,
-	0xF3, 7368,
-	0xF2, 7362,
-	0x66, 7356,
-	/*7356*/ uint16(xSetOp), uint16(MOVQ),
-	/*7358*/ uint16(xReadSlashR),
-	/*7359*/ uint16(xArgXmm2M64),
-	/*7360*/ uint16(xArgXmm1),
-	/*7361*/ uint16(xMatch),
-	/*7362*/ uint16(xSetOp), uint16(MOVDQ2Q),
-	/*7364*/ uint16(xReadSlashR),
-	/*7365*/ uint16(xArgMm),
-	/*7366*/ uint16(xArgXmm2),
-	/*7367*/ uint16(xMatch),
-	/*7368*/ uint16(xSetOp), uint16(MOVQ2DQ),
-	/*7370*/ uint16(xReadSlashR),
-	/*7371*/ uint16(xArgXmm1),
-	/*7372*/ uint16(xArgMm2),
-	/*7373*/ uint16(xMatch),
-	/*7374*/ uint16(xCondPrefix), 2,
-	0x66, 7386,
-	0x0, 7380,
-	/*7380*/ uint16(xSetOp), uint16(PMOVMSKB),
-	/*7382*/ uint16(xReadSlashR),
-	/*7383*/ uint16(xArgR32),
-	/*7384*/ uint16(xArgMm2),
-	/*7385*/ uint16(xMatch),
-	/*7386*/ uint16(xSetOp), uint16(PMOVMSKB),
-	/*7388*/ uint16(xReadSlashR),
-	/*7389*/ uint16(xArgR32),
-	/*7390*/ uint16(xArgXmm2),
-	/*7391*/ uint16(xMatch),
-	/*7392*/ uint16(xCondPrefix), 2,
-	0x66, 7404,
-	0x0, 7398,
-	/*7398*/ uint16(xSetOp), uint16(PSUBUSB),
-	/*7400*/ uint16(xReadSlashR),
-	/*7401*/ uint16(xArgMm),
-	/*7402*/ uint16(xArgMmM64),
-	/*7403*/ uint16(xMatch),
-	/*7404*/ uint16(xSetOp), uint16(PSUBUSB),
-	/*7406*/ uint16(xReadSlashR),
-	/*7407*/ uint16(xArgXmm1),
-	/*7408*/ uint16(xArgXmm2M128),
-	/*7409*/ uint16(xMatch),
-	/*7410*/ uint16(xCondPrefix), 2,
-	0x66, 7422,
-	0x0, 7416,
-	/*7416*/ uint16(xSetOp), uint16(PSUBUSW),
-	/*7418*/ uint16(xReadSlashR),
-	/*7419*/ uint16(xArgMm),
-	/*7420*/ uint16(xArgMmM64),
-	/*7421*/ uint16(xMatch),
-	/*7422*/ uint16(xSetOp), uint16(PSUBUSW),
-	/*7424*/ uint16(xReadSlashR),
-	/*7425*/ uint16(xArgXmm1),
-	/*7426*/ uint16(xArgXmm2M128),
-	/*7427*/ uint16(xMatch),
-	/*7428*/ uint16(xCondPrefix), 2,
-	0x66, 7440,
-	0x0, 7434,
-	/*7434*/ uint16(xSetOp), uint16(PMINUB),
-	/*7436*/ uint16(xReadSlashR),
-	/*7437*/ uint16(xArgMm1),
-	/*7438*/ uint16(xArgMm2M64),
-	/*7439*/ uint16(xMatch),
-	/*7440*/ uint16(xSetOp), uint16(PMINUB),
-	/*7442*/ uint16(xReadSlashR),
-	/*7443*/ uint16(xArgXmm1),
-	/*7444*/ uint16(xArgXmm2M128),
+	/*7177*/ uint16(xCondPrefix), 2,
+	0xF3, 7191,
+	0x0, 7183,
+	/*7183*/ uint16(xCondDataSize), 7169, 7173, 7187,
+	/*7187*/ uint16(xSetOp), uint16(FXSAVE64),
+	/*7189*/ uint16(xArgM512byte),
+	/*7190*/ uint16(xMatch),
+	/*7191*/ uint16(xCondDataSize), 7195, 7204, 7213,
+	/*7195*/ uint16(xCondIsMem), 7198, 0,
+	/*7198*/ uint16(xSetOp), uint16(RDFSBASE),
+	/*7200*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7202*/ uint16(xArgRM32),
+	/*7203*/ uint16(xMatch),
+	/*7204*/ uint16(xCondIsMem), 7207, 0,
+	/*7207*/ uint16(xSetOp), uint16(RDFSBASE),
+	/*7209*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7211*/ uint16(xArgRM32),
+	/*7212*/ uint16(xMatch),
+	/*7213*/ uint16(xCondIsMem), 7216, 0,
+	/*7216*/ uint16(xSetOp), uint16(RDFSBASE),
+	/*7218*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7220*/ uint16(xArgRM64),
+	/*7221*/ uint16(xMatch),
+	/*7222*/ uint16(xCondIs64), 7225, 7237,
+	/*7225*/ uint16(xCondDataSize), 7229, 7233, 0,
+	/*7229*/ uint16(xSetOp), uint16(FXRSTOR),
+	/*7231*/ uint16(xArgM512byte),
+	/*7232*/ uint16(xMatch),
+	/*7233*/ uint16(xSetOp), uint16(FXRSTOR),
+	/*7235*/ uint16(xArgM512byte),
+	/*7236*/ uint16(xMatch),
+	/*7237*/ uint16(xCondPrefix), 2,
+	0xF3, 7251,
+	0x0, 7243,
+	/*7243*/ uint16(xCondDataSize), 7229, 7233, 7247,
+	/*7247*/ uint16(xSetOp), uint16(FXRSTOR64),
+	/*7249*/ uint16(xArgM512byte),
+	/*7250*/ uint16(xMatch),
+	/*7251*/ uint16(xCondDataSize), 7255, 7264, 7273,
+	/*7255*/ uint16(xCondIsMem), 7258, 0,
+	/*7258*/ uint16(xSetOp), uint16(RDGSBASE),
+	/*7260*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7262*/ uint16(xArgRM32),
+	/*7263*/ uint16(xMatch),
+	/*7264*/ uint16(xCondIsMem), 7267, 0,
+	/*7267*/ uint16(xSetOp), uint16(RDGSBASE),
+	/*7269*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7271*/ uint16(xArgRM32),
+	/*7272*/ uint16(xMatch),
+	/*7273*/ uint16(xCondIsMem), 7276, 0,
+	/*7276*/ uint16(xSetOp), uint16(RDGSBASE),
+	/*7278*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7280*/ uint16(xArgRM64),
+	/*7281*/ uint16(xMatch),
+	/*7282*/ uint16(xCondIs64), 7285, 7291,
+	/*7285*/ uint16(xSetOp), uint16(LDMXCSR),
+	/*7287*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*7289*/ uint16(xArgM32),
+	/*7290*/ uint16(xMatch),
+	/*7291*/ uint16(xCondPrefix), 2,
+	0xF3, 7297,
+	0x0, 7285,
+	/*7297*/ uint16(xCondDataSize), 7301, 7307, 7313,
+	/*7301*/ uint16(xSetOp), uint16(WRFSBASE),
+	/*7303*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7305*/ uint16(xArgRM32),
+	/*7306*/ uint16(xMatch),
+	/*7307*/ uint16(xSetOp), uint16(WRFSBASE),
+	/*7309*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7311*/ uint16(xArgRM32),
+	/*7312*/ uint16(xMatch),
+	/*7313*/ uint16(xSetOp), uint16(WRFSBASE),
+	/*7315*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7317*/ uint16(xArgRM64),
+	/*7318*/ uint16(xMatch),
+	/*7319*/ uint16(xCondIs64), 7322, 7328,
+	/*7322*/ uint16(xSetOp), uint16(STMXCSR),
+	/*7324*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*7326*/ uint16(xArgM32),
+	/*7327*/ uint16(xMatch),
+	/*7328*/ uint16(xCondPrefix), 2,
+	0xF3, 7334,
+	0x0, 7322,
+	/*7334*/ uint16(xCondDataSize), 7338, 7344, 7350,
+	/*7338*/ uint16(xSetOp), uint16(WRGSBASE),
+	/*7340*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7342*/ uint16(xArgRM32),
+	/*7343*/ uint16(xMatch),
+	/*7344*/ uint16(xSetOp), uint16(WRGSBASE),
+	/*7346*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7348*/ uint16(xArgRM32),
+	/*7349*/ uint16(xMatch),
+	/*7350*/ uint16(xSetOp), uint16(WRGSBASE),
+	/*7352*/ uint16(xSetFeature), uint16(FeatureFSGSBASE),
+	/*7354*/ uint16(xArgRM64),
+	/*7355*/ uint16(xMatch),
+	/*7356*/ uint16(xCondIs64), 7359, 7375,
+	/*7359*/ uint16(xCondDataSize), 7363, 7369, 0,
+	/*7363*/ uint16(xSetOp), uint16(XSAVE),
+	/*7365*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*7367*/ uint16(xArgMem),
+	/*7368*/ uint16(xMatch),
+	/*7369*/ uint16(xSetOp), uint16(XSAVE),
+	/*7371*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*7373*/ uint16(xArgMem),
+	/*7374*/ uint16(xMatch),
+	/*7375*/ uint16(xCondDataSize), 7363, 7369, 7379,
+	/*7379*/ uint16(xSetOp), uint16(XSAVE64),
+	/*7381*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*7383*/ uint16(xArgMem),
+	/*7384*/ uint16(xMatch),
+	/*7385*/ uint16(xCondIs64), 7388, 7446,
+	/*7388*/ uint16(xCondPrefix), 2,
+	0xF3, 7424,
+	0x0, 7394,
+	/*7394*/ uint16(xCondDataSize), 7398, 7412, 7421,
+	/*7398*/ uint16(xCondIsMem), 7401, 7406,
+	/*7401*/ uint16(xSetOp), uint16(LFENCE),
+	/*7403*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*7405*/ uint16(xMatch),
+	/*7406*/ uint16(xSetOp), uint16(XRSTOR),
+	/*7408*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*7410*/ uint16(xArgMem),
+	/*7411*/ uint16(xMatch),
+	/*7412*/ uint16(xCondIsMem), 7401, 7415,
+	/*7415*/ uint16(xSetOp), uint16(XRSTOR),
+	/*7417*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*7419*/ uint16(xArgMem),
+	/*7420*/ uint16(xMatch),
+	/*7421*/ uint16(xCondIsMem), 7401, 0,
+	/*7424*/ uint16(xCondDataSize), 7428, 7437, 0,
+	/*7428*/ uint16(xCondIsMem), 7431, 0,
+	/*7431*/ uint16(xSetOp), uint16(INCSSPD),
+	/*7433*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*7435*/ uint16(xArgRmf32),
+	/*7436*/ uint16(xMatch),
+	/*7437*/ uint16(xCondIsMem), 7440, 0,
+	/*7440*/ uint16(xSetOp), uint16(INCSSPD),
+	/*7442*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*7444*/ uint16(xArgRmf32),
 	/*7445*/ uint16(xMatch),
 	/*7446*/ uint16(xCondPrefix), 2,
-	0x66, 7458,
+	0xF3, 7465,
 	0x0, 7452,
-	/*7452*/ uint16(xSetOp), uint16(PAND),
-	/*7454*/ uint16(xReadSlashR),
-	/*7455*/ uint16(xArgMm),
-	/*7456*/ uint16(xArgMmM64),
-	/*7457*/ uint16(xMatch),
-	/*7458*/ uint16(xSetOp), uint16(PAND),
-	/*7460*/ uint16(xReadSlashR),
-	/*7461*/ uint16(xArgXmm1),
-	/*7462*/ uint16(xArgXmm2M128),
-	/*7463*/ uint16(xMatch),
-	/*7464*/ uint16(xCondPrefix), 2,
-	0x66, 7476,
-	0x0, 7470,
-	/*7470*/ uint16(xSetOp), uint16(PADDUSB),
-	/*7472*/ uint16(xReadSlashR),
-	/*7473*/ uint16(xArgMm),
-	/*7474*/ uint16(xArgMmM64),
-	/*7475*/ uint16(xMatch),
-	/*7476*/ uint16(xSetOp), uint16(PADDUSB),
-	/*7478*/ uint16(xReadSlashR),
-	/*7479*/ uint16(xArgXmm1),
-	/*7480*/ uint16(xArgXmm2M128),
-	/*7481*/ uint16(xMatch),
-	/*7482*/ uint16(xCondPrefix), 2,
-	0x66, 7494,
-	0x0, 7488,
-	/*7488*/ uint16(xSetOp), uint16(PADDUSW),
-	/*7490*/ uint16(xReadSlashR),
-	/*7491*/ uint16(xArgMm),
-	/*7492*/ uint16(xArgMmM64),
-	/*7493*/ uint16(xMatch),
-	/*7494*/ uint16(xSetOp), uint16(PADDUSW),
-	/*7496*/ uint16(xReadSlashR),
-	/*7497*/ uint16(xArgXmm1),
-	/*7498*/ uint16(xArgXmm2M128),
-	/*7499*/ uint16(xMatch),
-	/*7500*/ uint16(xCondPrefix), 2,
-	0x66, 7512,
-	0x0, 7506,
-	/*7506*/ uint16(xSetOp), uint16(PMAXUB),
-	/*7508*/ uint16(xReadSlashR),
-	/*7509*/ uint16(xArgMm1),
-	/*7510*/ uint16(xArgMm2M64),
-	/*7511*/ uint16(xMatch),
-	/*7512*/ uint16(xSetOp), uint16(PMAXUB),
-	/*7514*/ uint16(xReadSlashR),
-	/*7515*/ uint16(xArgXmm1),
-	/*7516*/ uint16(xArgXmm2M128),
+	/*7452*/ uint16(xCondDataSize), 7398, 7412, 7456,
+	/*7456*/ uint16(xCondIsMem), 7401, 7459,
+	/*7459*/ uint16(xSetOp), uint16(XRSTOR64),
+	/*7461*/ uint16(xSetFeature), uint16(FeatureXSAVE),
+	/*7463*/ uint16(xArgMem),
+	/*7464*/ uint16(xMatch),
+	/*7465*/ uint16(xCondDataSize), 7428, 7437, 7469,
+	/*7469*/ uint16(xCondIsMem), 7472, 0,
+	/*7472*/ uint16(xSetOp), uint16(INCSSPQ),
+	/*7474*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*7476*/ uint16(xArgRmf64),
+	/*7477*/ uint16(xMatch),
+	/*7478*/ uint16(xCondIs64), 7481, 7582,
+	/*7481*/ uint16(xCondPrefix), 4,
+	0xF3, 7551,
+	0xF2, 7542,
+	0x66, 7527,
+	0x0, 7491,
+	/*7491*/ uint16(xCondDataSize), 7495, 7509, 7518,
+	/*7495*/ uint16(xCondIsMem), 7498, 7503,
+	/*7498*/ uint16(xSetOp), uint16(MFENCE),
+	/*7500*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*7502*/ uint16(xMatch),
+	/*7503*/ uint16(xSetOp), uint16(XSAVEOPT),
+	/*7505*/ uint16(xSetFeature), uint16(FeatureXSAVEOPT),
+	/*7507*/ uint16(xArgMem),
+	/*7508*/ uint16(xMatch),
+	/*7509*/ uint16(xCondIsMem), 7498, 7512,
+	/*7512*/ uint16(xSetOp), uint16(XSAVEOPT),
+	/*7514*/ uint16(xSetFeature), uint16(FeatureXSAVEOPT),
+	/*7516*/ uint16(xArgMem),
 	/*7517*/ uint16(xMatch),
-	/*7518*/ uint16(xCondPrefix), 2,
-	0x66, 7530,
-	0x0, 7524,
-	/*7524*/ uint16(xSetOp), uint16(PANDN),
-	/*7526*/ uint16(xReadSlashR),
-	/*7527*/ uint16(xArgMm),
-	/*7528*/ uint16(xArgMmM64),
-	/*7529*/ uint16(xMatch),
-	/*7530*/ uint16(xSetOp), uint16(PANDN),
-	/*7532*/ uint16(xReadSlashR),
-	/*7533*/ uint16(xArgXmm1),
-	/*7534*/ uint16(xArgXmm2M128),
+	/*7518*/ uint16(xCondIsMem), 7498, 7521,
+	/*7521*/ uint16(xSetOp), uint16(XSAVEOPT64),
+	/*7523*/ uint16(xSetFeature), uint16(FeatureXSAVEOPT),
+	/*7525*/ uint16(xArgMem),
+	/*7526*/ uint16(xMatch),
+	/*7527*/ uint16(xCondIsMem), 7530, 7536,
+	/*7530*/ uint16(xSetOp), uint16(TPAUSE),
+	/*7532*/ uint16(xSetFeature), uint16(FeatureWAITPKG),
+	/*7534*/ uint16(xArgRmf32),
 	/*7535*/ uint16(xMatch),
-	/*7536*/ uint16(xCondPrefix), 2,
-	0x66, 7548,
-	0x0, 7542,
-	/*7542*/ uint16(xSetOp), uint16(PAVGB),
-	/*7544*/ uint16(xReadSlashR),
-	/*7545*/ uint16(xArgMm1),
-	/*7546*/ uint16(xArgMm2M64),
-	/*7547*/ uint16(xMatch),
-	/*7548*/ uint16(xSetOp), uint16(PAVGB),
-	/*7550*/ uint16(xReadSlashR),
-	/*7551*/ uint16(xArgXmm1),
-	/*7552*/ uint16(xArgXmm2M128),
-	/*7553*/ uint16(xMatch),
-	/*7554*/ uint16(xCondPrefix), 2,
-	0x66, 7566,
-	0x0, 7560,
-	/*7560*/ uint16(xSetOp), uint16(PSRAW),
-	/*7562*/ uint16(xReadSlashR),
-	/*7563*/ uint16(xArgMm),
-	/*7564*/ uint16(xArgMmM64),
-	/*7565*/ uint16(xMatch),
-	/*7566*/ uint16(xSetOp), uint16(PSRAW),
-	/*7568*/ uint16(xReadSlashR),
-	/*7569*/ uint16(xArgXmm1),
-	/*7570*/ uint16(xArgXmm2M128),
-	/*7571*/ uint16(xMatch),
-	/*7572*/ uint16(xCondPrefix), 2,
-	0x66, 7584,
-	0x0, 7578,
-	/*7578*/ uint16(xSetOp), uint16(PSRAD),
-	/*7580*/ uint16(xReadSlashR),
-	/*7581*/ uint16(xArgMm),
-	/*7582*/ uint16(xArgMmM64),
-	/*7583*/ uint16(xMatch),
-	/*7584*/ uint16(xSetOp), uint16(PSRAD),
-	/*7586*/ uint16(xReadSlashR),
-	/*7587*/ uint16(xArgXmm1),
-	/*7588*/ uint16(xArgXmm2M128),
-	/*7589*/ uint16(xMatch),
-	/*7590*/ uint16(xCondPrefix), 2,
-	0x66, 7602,
-	0x0, 7596,
-	/*7596*/ uint16(xSetOp), uint16(PAVGW),
-	/*7598*/ uint16(xReadSlashR),
-	/*7599*/ uint16(xArgMm1),
-	/*7600*/ uint16(xArgMm2M64),
-	/*7601*/ uint16(xMatch),
-	/*7602*/ uint16(xSetOp), uint16(PAVGW),
-	/*7604*/ uint16(xReadSlashR),
-	/*7605*/ uint16(xArgXmm1),
-	/*7606*/ uint16(xArgXmm2M128),
-	/*7607*/ uint16(xMatch),
-	/*7608*/ uint16(xCondPrefix), 2,
-	0x66, 7620,
-	0x0, 7614,
-	/*7614*/ uint16(xSetOp), uint16(PMULHUW),
-	/*7616*/ uint16(xReadSlashR),
-	/*7617*/ uint16(xArgMm1),
-	/*7618*/ uint16(xArgMm2M64),
-	/*7619*/ uint16(xMatch),
-	/*7620*/ uint16(xSetOp), uint16(PMULHUW),
-	/*7622*/ uint16(xReadSlashR),
-	/*7623*/ uint16(xArgXmm1),
-	/*7624*/ uint16(xArgXmm2M128),
-	/*7625*/ uint16(xMatch),
-	/*7626*/ uint16(xCondPrefix), 2,
-	0x66, 7638,
-	0x0, 7632,
-	/*7632*/ uint16(xSetOp), uint16(PMULHW),
-	/*7634*/ uint16(xReadSlashR),
-	/*7635*/ uint16(xArgMm),
-	/*7636*/ uint16(xArgMmM64),
-	/*7637*/ uint16(xMatch),
-	/*7638*/ uint16(xSetOp), uint16(PMULHW),
-	/*7640*/ uint16(xReadSlashR),
-	/*7641*/ uint16(xArgXmm1),
-	/*7642*/ uint16(xArgXmm2M128),
-	/*7643*/ uint16(xMatch),
-	/*7644*/ uint16(xCondPrefix), 3,
-	0xF3, 7664,
-	0xF2, 7658,
-	0x66, 7652,
-	/*7652*/ uint16(xSetOp), uint16(CVTTPD2DQ),
-	/*7654*/ uint16(xReadSlashR),
-	/*7655*/ uint16(xArgXmm1),
-	/*7656*/ uint16(xArgXmm2M128),
-	/*7657*/ uint16(xMatch),
-	/*7658*/ uint16(xSetOp), uint16(CVTPD2DQ),
-	/*7660*/ uint16(xReadSlashR),
-	/*7661*/ uint16(xArgXmm1),
-	/*7662*/ uint16(xArgXmm2M128),
-	/*7663*/ uint16(xMatch),
-	/*7664*/ uint16(xSetOp), uint16(CVTDQ2PD),
-	/*7666*/ uint16(xReadSlashR),
-	/*7667*/ uint16(xArgXmm1),
-	/*7668*/ uint16(xArgXmm2M64),
-	/*7669*/ uint16(xMatch),
-	/*7670*/ uint16(xCondPrefix), 2,
-	0x66, 7682,
-	0x0, 7676,
-	/*7676*/ uint16(xSetOp), uint16(MOVNTQ),
-	/*7678*/ uint16(xReadSlashR),
-	/*7679*/ uint16(xArgM64),
-	/*7680*/ uint16(xArgMm),
-	/*7681*/ uint16(xMatch),
-	/*7682*/ uint16(xSetOp), uint16(MOVNTDQ),
-	/*7684*/ uint16(xReadSlashR),
-	/*7685*/ uint16(xArgM128),
-	/*7686*/ uint16(xArgXmm),
-	/*7687*/ uint16(xMatch),
-	/*7688*/ uint16(xCondPrefix), 2,
-	0x66, 7700,
-	0x0, 7694,
-	/*7694*/ uint16(xSetOp), uint16(PSUBSB),
-	/*7696*/ uint16(xReadSlashR),
-	/*7697*/ uint16(xArgMm),
-	/*7698*/ uint16(xArgMmM64),
-	/*7699*/ uint16(xMatch),
-	/*7700*/ uint16(xSetOp), uint16(PSUBSB),
-	/*7702*/ uint16(xReadSlashR),
-	/*7703*/ uint16(xArgXmm1),
-	/*7704*/ uint16(xArgXmm2M128),
-	/*7705*/ uint16(xMatch),
-	/*7706*/ uint16(xCondPrefix), 2,
-	0x66, 7718,
-	0x0, 7712,
-	/*7712*/ uint16(xSetOp), uint16(PSUBSW),
-	/*7714*/ uint16(xReadSlashR),
-	/*7715*/ uint16(xArgMm),
-	/*7716*/ uint16(xArgMmM64),
-	/*7717*/ uint16(xMatch),
-	/*7718*/ uint16(xSetOp), uint16(PSUBSW),
-	/*7720*/ uint16(xReadSlashR),
-	/*7721*/ uint16(xArgXmm1),
-	/*7722*/ uint16(xArgXmm2M128),
-	/*7723*/ uint16(xMatch),
-	/*7724*/ uint16(xCondPrefix), 2,
-	0x66, 7736,
-	0x0, 7730,
-	/*7730*/ uint16(xSetOp), uint16(PMINSW),
+	/*7536*/ uint16(xSetOp), uint16(CLWB),
+	/*7538*/ uint16(xSetFeature), uint16(FeatureCLWB),
+	/*7540*/ uint16(xArgM8),
+	/*7541*/ uint16(xMatch),
+	/*7542*/ uint16(xCondIsMem), 7545, 0,
+	/*7545*/ uint16(xSetOp), uint16(UMWAIT),
+	/*7547*/ uint16(xSetFeature), uint16(FeatureWAITPKG),
+	/*7549*/ uint16(xArgRmf32),
+	/*7550*/ uint16(xMatch),
+	/*7551*/ uint16(xCondAddrSize), 7555, 7570, 7579,
+	/*7555*/ uint16(xCondIsMem), 7558, 7564,
+	/*7558*/ uint16(xSetOp), uint16(UMONITOR),
+	/*7560*/ uint16(xSetFeature), uint16(FeatureWAITPKG),
+	/*7562*/ uint16(xArgRmf16),
+	/*7563*/ uint16(xMatch),
+	/*7564*/ uint16(xSetOp), uint16(CLRSSBSY),
+	/*7566*/ uint16(xSetFeature), uint16(FeatureCET_SS),
+	/*7568*/ uint16(xArgM64),
+	/*7569*/ uint16(xMatch),
+	/*7570*/ uint16(xCondIsMem), 7573, 7564,
+	/*7573*/ uint16(xSetOp), uint16(UMONITOR),
+	/*7575*/ uint16(xSetFeature), uint16(FeatureWAITPKG),
+	/*7577*/ uint16(xArgRmf32),
+	/*7578*/ uint16(xMatch),
+	/*7579*/ uint16(xCondIsMem), 0, 7564,
+	/*7582*/ uint16(xCondPrefix), 4,
+	0xF3, 7592,
+	0xF2, 7542,
+	0x66, 7527,
+	0x0, 7491,
+	/*7592*/ uint16(xCondAddrSize), 7579, 7570, 7596,
+	/*7596*/ uint16(xCondIsMem), 7599, 7564,
+	/*7599*/ uint16(xSetOp), uint16(UMONITOR),
+	/*7601*/ uint16(xSetFeature), uint16(FeatureWAITPKG),
+	/*7603*/ uint16(xArgRmf64),
+	/*7604*/ uint16(xMatch),
+	/*7605*/ uint16(xSetOp), uint16(CLFLUSH),
+	/*7607*/ uint16(xArgM8),
+	/*7608*/ uint16(xMatch),
+	/*7609*/ uint16(xSetOp), uint16(SFENCE),
+	/*7611*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*7613*/ uint16(xMatch),
+	/*7614*/ uint16(xCondIs64), 7617, 7633,
+	/*7617*/ uint16(xCondDataSize), 7621, 7627, 0,
+	/*7621*/ uint16(xSetOp), uint16(IMUL),
+	/*7623*/ uint16(xReadSlashR),
+	/*7624*/ uint16(xArgR16),
+	/*7625*/ uint16(xArgRM16),
+	/*7626*/ uint16(xMatch),
+	/*7627*/ uint16(xSetOp), uint16(IMUL),
+	/*7629*/ uint16(xReadSlashR),
+	/*7630*/ uint16(xArgR32),
+	/*7631*/ uint16(xArgRM32),
+	/*7632*/ uint16(xMatch),
+	/*7633*/ uint16(xCondDataSize), 7621, 7627, 7637,
+	/*7637*/ uint16(xSetOp), uint16(IMUL),
+	/*7639*/ uint16(xReadSlashR),
+	/*7640*/ uint16(xArgR64),
+	/*7641*/ uint16(xArgRM64),
+	/*7642*/ uint16(xMatch),
+	/*7643*/ uint16(xSetOp), uint16(CMPXCHG),
+	/*7645*/ uint16(xReadSlashR),
+	/*7646*/ uint16(xArgRM8),
+	/*7647*/ uint16(xArgR8),
+	/*7648*/ uint16(xMatch),
+	/*7649*/ uint16(xCondIs64), 7652, 7668,
+	/*7652*/ uint16(xCondDataSize), 7656, 7662, 0,
+	/*7656*/ uint16(xSetOp), uint16(CMPXCHG),
+	/*7658*/ uint16(xReadSlashR),
+	/*7659*/ uint16(xArgRM16),
+	/*7660*/ uint16(xArgR16),
+	/*7661*/ uint16(xMatch),
+	/*7662*/ uint16(xSetOp), uint16(CMPXCHG),
+	/*7664*/ uint16(xReadSlashR),
+	/*7665*/ uint16(xArgRM32),
+	/*7666*/ uint16(xArgR32),
+	/*7667*/ uint16(xMatch),
+	/*7668*/ uint16(xCondDataSize), 7656, 7662, 7672,
+	/*7672*/ uint16(xSetOp), uint16(CMPXCHG),
+	/*7674*/ uint16(xReadSlashR),
+	/*7675*/ uint16(xArgRM64),
+	/*7676*/ uint16(xArgR64),
+	/*7677*/ uint16(xMatch),
+	/*7678*/ uint16(xCondIs64), 7681, 7697,
+	/*7681*/ uint16(xCondDataSize), 7685, 7691, 0,
+	/*7685*/ uint16(xSetOp), uint16(LSS),
+	/*7687*/ uint16(xReadSlashR),
+	/*7688*/ uint16(xArgR16),
+	/*7689*/ uint16(xArgM16colon16),
+	/*7690*/ uint16(xMatch),
+	/*7691*/ uint16(xSetOp), uint16(LSS),
+	/*7693*/ uint16(xReadSlashR),
+	/*7694*/ uint16(xArgR32),
+	/*7695*/ uint16(xArgM16colon32),
+	/*7696*/ uint16(xMatch),
+	/*7697*/ uint16(xCondDataSize), 7685, 7691, 7701,
+	/*7701*/ uint16(xSetOp), uint16(LSS),
+	/*7703*/ uint16(xReadSlashR),
+	/*7704*/ uint16(xArgR64),
+	/*7705*/ uint16(xArgM16colon64),
+	/*7706*/ uint16(xMatch),
+	/*7707*/ uint16(xCondIs64), 7710, 7726,
+	/*7710*/ uint16(xCondDataSize), 7714, 7720, 0,
+	/*7714*/ uint16(xSetOp), uint16(BTR),
+	/*7716*/ uint16(xReadSlashR),
+	/*7717*/ uint16(xArgRM16),
+	/*7718*/ uint16(xArgR16),
+	/*7719*/ uint16(xMatch),
+	/*7720*/ uint16(xSetOp), uint16(BTR),
+	/*7722*/ uint16(xReadSlashR),
+	/*7723*/ uint16(xArgRM32),
+	/*7724*/ uint16(xArgR32),
+	/*7725*/ uint16(xMatch),
+	/*7726*/ uint16(xCondDataSize), 7714, 7720, 7730,
+	/*7730*/ uint16(xSetOp), uint16(BTR),
 	/*7732*/ uint16(xReadSlashR),
-	/*7733*/ uint16(xArgMm1),
-	/*7734*/ uint16(xArgMm2M64),
+	/*7733*/ uint16(xArgRM64),
+	/*7734*/ uint16(xArgR64),
 	/*7735*/ uint16(xMatch),
-	/*7736*/ uint16(xSetOp), uint16(PMINSW),
-	/*7738*/ uint16(xReadSlashR),
-	/*7739*/ uint16(xArgXmm1),
-	/*7740*/ uint16(xArgXmm2M128),
-	/*7741*/ uint16(xMatch),
-	/*7742*/ uint16(xCondPrefix), 2,
-	0x66, 7754,
-	0x0, 7748,
-	/*7748*/ uint16(xSetOp), uint16(POR),
-	/*7750*/ uint16(xReadSlashR),
-	/*7751*/ uint16(xArgMm),
-	/*7752*/ uint16(xArgMmM64),
-	/*7753*/ uint16(xMatch),
-	/*7754*/ uint16(xSetOp), uint16(POR),
-	/*7756*/ uint16(xReadSlashR),
-	/*7757*/ uint16(xArgXmm1),
-	/*7758*/ uint16(xArgXmm2M128),
-	/*7759*/ uint16(xMatch),
-	/*7760*/ uint16(xCondPrefix), 2,
-	0x66, 7772,
-	0x0, 7766,
-	/*7766*/ uint16(xSetOp), uint16(PADDSB),
-	/*7768*/ uint16(xReadSlashR),
-	/*7769*/ uint16(xArgMm),
-	/*7770*/ uint16(xArgMmM64),
-	/*7771*/ uint16(xMatch),
-	/*7772*/ uint16(xSetOp), uint16(PADDSB),
+	/*7736*/ uint16(xCondIs64), 7739, 7755,
+	/*7739*/ uint16(xCondDataSize), 7743, 7749, 0,
+	/*7743*/ uint16(xSetOp), uint16(LFS),
+	/*7745*/ uint16(xReadSlashR),
+	/*7746*/ uint16(xArgR16),
+	/*7747*/ uint16(xArgM16colon16),
+	/*7748*/ uint16(xMatch),
+	/*7749*/ uint16(xSetOp), uint16(LFS),
+	/*7751*/ uint16(xReadSlashR),
+	/*7752*/ uint16(xArgR32),
+	/*7753*/ uint16(xArgM16colon32),
+	/*7754*/ uint16(xMatch),
+	/*7755*/ uint16(xCondDataSize), 7743, 7749, 7759,
+	/*7759*/ uint16(xSetOp), uint16(LFS),
+	/*7761*/ uint16(xReadSlashR),
+	/*7762*/ uint16(xArgR64),
+	/*7763*/ uint16(xArgM16colon64),
+	/*7764*/ uint16(xMatch),
+	/*7765*/ uint16(xCondIs64), 7768, 7784,
+	/*7768*/ uint16(xCondDataSize), 7772, 7778, 0,
+	/*7772*/ uint16(xSetOp), uint16(LGS),
 	/*7774*/ uint16(xReadSlashR),
-	/*7775*/ uint16(xArgXmm1),
-	/*7776*/ uint16(xArgXmm2M128),
+	/*7775*/ uint16(xArgR16),
+	/*7776*/ uint16(xArgM16colon16),
 	/*7777*/ uint16(xMatch),
-	/*7778*/ uint16(xCondPrefix), 2,
-	0x66, 7790,
-	0x0, 7784,
-	/*7784*/ uint16(xSetOp), uint16(PADDSW),
-	/*7786*/ uint16(xReadSlashR),
-	/*7787*/ uint16(xArgMm),
-	/*7788*/ uint16(xArgMmM64),
-	/*7789*/ uint16(xMatch),
-	/*7790*/ uint16(xSetOp), uint16(PADDSW),
-	/*7792*/ uint16(xReadSlashR),
-	/*7793*/ uint16(xArgXmm1),
-	/*7794*/ uint16(xArgXmm2M128),
-	/*7795*/ uint16(xMatch),
-	/*7796*/ uint16(xCondPrefix), 2,
-	0x66, 7808,
-	0x0, 7802,
-	/*7802*/ uint16(xSetOp), uint16(PMAXSW),
-	/*7804*/ uint16(xReadSlashR),
-	/*7805*/ uint16(xArgMm1),
-	/*7806*/ uint16(xArgMm2M64),
-	/*7807*/ uint16(xMatch),
-	/*7808*/ uint16(xSetOp), uint16(PMAXSW),
-	/*7810*/ uint16(xReadSlashR),
-	/*7811*/ uint16(xArgXmm1),
-	/*7812*/ uint16(xArgXmm2M128),
-	/*7813*/ uint16(xMatch),
-	/*7814*/ uint16(xCondPrefix), 2,
-	0x66, 7826,
-	0x0, 7820,
-	/*7820*/ uint16(xSetOp), uint16(PXOR),
-	/*7822*/ uint16(xReadSlashR),
-	/*7823*/ uint16(xArgMm),
-	/*7824*/ uint16(xArgMmM64),
-	/*7825*/ uint16(xMatch),
-	/*7826*/ uint16(xSetOp), uint16(PXOR),
-	/*7828*/ uint16(xReadSlashR),
-	/*7829*/ uint16(xArgXmm1),
-	/*7830*/ uint16(xArgXmm2M128),
-	/*7831*/ uint16(xMatch),
-	/*7832*/ uint16(xCondPrefix), 1,
-	0xF2, 7836,
-	/*7836*/ uint16(xSetOp), uint16(LDDQU),
+	/*7778*/ uint16(xSetOp), uint16(LGS),
+	/*7780*/ uint16(xReadSlashR),
+	/*7781*/ uint16(xArgR32),
+	/*7782*/ uint16(xArgM16colon32),
+	/*7783*/ uint16(xMatch),
+	/*7784*/ uint16(xCondDataSize), 7772, 7778, 7788,
+	/*7788*/ uint16(xSetOp), uint16(LGS),
+	/*7790*/ uint16(xReadSlashR),
+	/*7791*/ uint16(xArgR64),
+	/*7792*/ uint16(xArgM16colon64),
+	/*7793*/ uint16(xMatch),
+	/*7794*/ uint16(xCondIs64), 7797, 7813,
+	/*7797*/ uint16(xCondDataSize), 7801, 7807, 0,
+	/*7801*/ uint16(xSetOp), uint16(MOVZX),
+	/*7803*/ uint16(xReadSlashR),
+	/*7804*/ uint16(xArgR16),
+	/*7805*/ uint16(xArgRM8),
+	/*7806*/ uint16(xMatch),
+	/*7807*/ uint16(xSetOp), uint16(MOVZX),
+	/*7809*/ uint16(xReadSlashR),
+	/*7810*/ uint16(xArgR32),
+	/*7811*/ uint16(xArgRM8),
+	/*7812*/ uint16(xMatch),
+	/*7813*/ uint16(xCondDataSize), 7801, 7807, 7817,
+	/*7817*/ uint16(xSetOp), uint16(MOVZX),
+	/*7819*/ uint16(xReadSlashR),
+	/*7820*/ uint16(xArgR64),
+	/*7821*/ uint16(xArgRM8),
+	/*7822*/ uint16(xMatch),
+	/*7823*/ uint16(xCondIs64), 7826, 7842,
+	/*7826*/ uint16(xCondDataSize), 7830, 7836, 0,
+	/*7830*/ uint16(xSetOp), uint16(MOVZX),
+	/*7832*/ uint16(xReadSlashR),
+	/*7833*/ uint16(xArgR16),
+	/*7834*/ uint16(xArgRM16),
+	/*7835*/ uint16(xMatch),
+	/*7836*/ uint16(xSetOp), uint16(MOVZX),
 	/*7838*/ uint16(xReadSlashR),
-	/*7839*/ uint16(xArgXmm1),
-	/*7840*/ uint16(xArgM128),
+	/*7839*/ uint16(xArgR32),
+	/*7840*/ uint16(xArgRM16),
 	/*7841*/ uint16(xMatch),
-	/*7842*/ uint16(xCondPrefix), 2,
-	0x66, 7854,
-	0x0, 7848,
-	/*7848*/ uint16(xSetOp), uint16(PSLLW),
-	/*7850*/ uint16(xReadSlashR),
-	/*7851*/ uint16(xArgMm),
-	/*7852*/ uint16(xArgMmM64),
-	/*7853*/ uint16(xMatch),
-	/*7854*/ uint16(xSetOp), uint16(PSLLW),
-	/*7856*/ uint16(xReadSlashR),
-	/*7857*/ uint16(xArgXmm1),
-	/*7858*/ uint16(xArgXmm2M128),
-	/*7859*/ uint16(xMatch),
-	/*7860*/ uint16(xCondPrefix), 2,
-	0x66, 7872,
-	0x0, 7866,
-	/*7866*/ uint16(xSetOp), uint16(PSLLD),
-	/*7868*/ uint16(xReadSlashR),
-	/*7869*/ uint16(xArgMm),
-	/*7870*/ uint16(xArgMmM64),
-	/*7871*/ uint16(xMatch),
-	/*7872*/ uint16(xSetOp), uint16(PSLLD),
-	/*7874*/ uint16(xReadSlashR),
-	/*7875*/ uint16(xArgXmm1),
-	/*7876*/ uint16(xArgXmm2M128),
-	/*7877*/ uint16(xMatch),
-	/*7878*/ uint16(xCondPrefix), 2,
-	0x66, 7890,
-	0x0, 7884,
-	/*7884*/ uint16(xSetOp), uint16(PSLLQ),
-	/*7886*/ uint16(xReadSlashR),
-	/*7887*/ uint16(xArgMm),
-	/*7888*/ uint16(xArgMmM64),
-	/*7889*/ uint16(xMatch),
-	/*7890*/ uint16(xSetOp), uint16(PSLLQ),
-	/*7892*/ uint16(xReadSlashR),
-	/*7893*/ uint16(xArgXmm1),
-	/*7894*/ uint16(xArgXmm2M128),
-	/*7895*/ uint16(xMatch),
-	/*7896*/ uint16(xCondPrefix), 2,
-	0x66, 7908,
-	0x0, 7902,
-	/*7902*/ uint16(xSetOp), uint16(PMULUDQ),
-	/*7904*/ uint16(xReadSlashR),
-	/*7905*/ uint16(xArgMm1),
-	/*7906*/ uint16(xArgMm2M64),
-	/*7907*/ uint16(xMatch),
-	/*7908*/ uint16(xSetOp), uint16(PMULUDQ),
-	/*7910*/ uint16(xReadSlashR),
-	/*7911*/ uint16(xArgXmm1),
-	/*7912*/ uint16(xArgXmm2M128),
-	/*7913*/ uint16(xMatch),
-	/*7914*/ uint16(xCondPrefix), 2,
-	0x66, 7926,
-	0x0, 7920,
-	/*7920*/ uint16(xSetOp), uint16(PMADDWD),
-	/*7922*/ uint16(xReadSlashR),
-	/*7923*/ uint16(xArgMm),
-	/*7924*/ uint16(xArgMmM64),
-	/*7925*/ uint16(xMatch),
-	/*7926*/ uint16(xSetOp), uint16(PMADDWD),
-	/*7928*/ uint16(xReadSlashR),
-	/*7929*/ uint16(xArgXmm1),
-	/*7930*/ uint16(xArgXmm2M128),
-	/*7931*/ uint16(xMatch),
-	/*7932*/ uint16(xCondPrefix), 2,
-	0x66, 7944,
-	0x0, 7938,
-	/*7938*/ uint16(xSetOp), uint16(PSADBW),
-	/*7940*/ uint16(xReadSlashR),
-	/*7941*/ uint16(xArgMm1),
-	/*7942*/ uint16(xArgMm2M64),
-	/*7943*/ uint16(xMatch),
-	/*7944*/ uint16(xSetOp), uint16(PSADBW),
-	/*7946*/ uint16(xReadSlashR),
-	/*7947*/ uint16(xArgXmm1),
-	/*7948*/ uint16(xArgXmm2M128),
-	/*7949*/ uint16(xMatch),
-	/*7950*/ uint16(xCondPrefix), 2,
-	0x66, 7962,
-	0x0, 7956,
-	/*7956*/ uint16(xSetOp), uint16(MASKMOVQ),
-	/*7958*/ uint16(xReadSlashR),
-	/*7959*/ uint16(xArgMm1),
-	/*7960*/ uint16(xArgMm2),
+	/*7842*/ uint16(xCondDataSize), 7830, 7836, 7846,
+	/*7846*/ uint16(xSetOp), uint16(MOVZX),
+	/*7848*/ uint16(xReadSlashR),
+	/*7849*/ uint16(xArgR64),
+	/*7850*/ uint16(xArgRM16),
+	/*7851*/ uint16(xMatch),
+	/*7852*/ uint16(xCondIs64), 7855, 7879,
+	/*7855*/ uint16(xCondPrefix), 1,
+	0xF3, 7859,
+	/*7859*/ uint16(xCondDataSize), 7863, 7871, 0,
+	/*7863*/ uint16(xSetOp), uint16(POPCNT),
+	/*7865*/ uint16(xSetFeature), uint16(FeaturePOPCNT),
+	/*7867*/ uint16(xReadSlashR),
+	/*7868*/ uint16(xArgR16),
+	/*7869*/ uint16(xArgRM16),
+	/*7870*/ uint16(xMatch),
+	/*7871*/ uint16(xSetOp), uint16(POPCNT),
+	/*7873*/ uint16(xSetFeature), uint16(FeaturePOPCNT),
+	/*7875*/ uint16(xReadSlashR),
+	/*7876*/ uint16(xArgR32),
+	/*7877*/ uint16(xArgRM32),
+	/*7878*/ uint16(xMatch),
+	/*7879*/ uint16(xCondPrefix), 1,
+	0xF3, 7883,
+	/*7883*/ uint16(xCondDataSize), 7863, 7871, 7887,
+	/*7887*/ uint16(xSetOp), uint16(POPCNT),
+	/*7889*/ uint16(xSetFeature), uint16(FeaturePOPCNT),
+	/*7891*/ uint16(xReadSlashR),
+	/*7892*/ uint16(xArgR64),
+	/*7893*/ uint16(xArgRM64),
+	/*7894*/ uint16(xMatch),
+	/*7895*/ uint16(xCondDataSize), 0, 7899, 0,
+	/*7899*/ uint16(xSetOp), uint16(UD1),
+	/*7901*/ uint16(xReadSlashR),
+	/*7902*/ uint16(xArgR32),
+	/*7903*/ uint16(xArgRM32),
+	/*7904*/ uint16(xMatch),
+	/*7905*/ uint16(xCondSlashR),
+	0,    // 0
+	0,    // 1
+	0,    // 2
+	0,    // 3
+	7914, // 4
+	7943, // 5
+	7972, // 6
+	8001, // 7
+	/*7914*/ uint16(xCondIs64), 7917, 7933,
+	/*7917*/ uint16(xCondDataSize), 7921, 7927, 0,
+	/*7921*/ uint16(xSetOp), uint16(BT),
+	/*7923*/ uint16(xReadIb),
+	/*7924*/ uint16(xArgRM16),
+	/*7925*/ uint16(xArgImm8u),
+	/*7926*/ uint16(xMatch),
+	/*7927*/ uint16(xSetOp), uint16(BT),
+	/*7929*/ uint16(xReadIb),
+	/*7930*/ uint16(xArgRM32),
+	/*7931*/ uint16(xArgImm8u),
+	/*7932*/ uint16(xMatch),
+	/*7933*/ uint16(xCondDataSize), 7921, 7927, 7937,
+	/*7937*/ uint16(xSetOp), uint16(BT),
+	/*7939*/ uint16(xReadIb),
+	/*7940*/ uint16(xArgRM64),
+	/*7941*/ uint16(xArgImm8u),
+	/*7942*/ uint16(xMatch),
+	/*7943*/ uint16(xCondIs64), 7946, 7962,
+	/*7946*/ uint16(xCondDataSize), 7950, 7956, 0,
+	/*7950*/ uint16(xSetOp), uint16(BTS),
+	/*7952*/ uint16(xReadIb),
+	/*7953*/ uint16(xArgRM16),
+	/*7954*/ uint16(xArgImm8u),
+	/*7955*/ uint16(xMatch),
+	/*7956*/ uint16(xSetOp), uint16(BTS),
+	/*7958*/ uint16(xReadIb),
+	/*7959*/ uint16(xArgRM32),
+	/*7960*/ uint16(xArgImm8u),
 	/*7961*/ uint16(xMatch),
-	/*7962*/ uint16(xSetOp), uint16(MASKMOVDQU),
-	/*7964*/ uint16(xReadSlashR),
-	/*7965*/ uint16(xArgXmm1),
-	/*7966*/ uint16(xArgXmm2),
-	/*7967*/ uint16(xMatch),
-	/*7968*/ uint16(xCondPrefix), 2,
-	0x66, 7980,
-	0x0, 7974,
-	/*7974*/ uint16(xSetOp), uint16(PSUBB),
-	/*7976*/ uint16(xReadSlashR),
-	/*7977*/ uint16(xArgMm),
-	/*7978*/ uint16(xArgMmM64),
-	/*7979*/ uint16(xMatch),
-	/*7980*/ uint16(xSetOp), uint16(PSUBB),
-	/*7982*/ uint16(xReadSlashR),
-	/*7983*/ uint16(xArgXmm1),
-	/*7984*/ uint16(xArgXmm2M128),
-	/*7985*/ uint16(xMatch),
-	/*7986*/ uint16(xCondPrefix), 2,
-	0x66, 7998,
-	0x0, 7992,
-	/*7992*/ uint16(xSetOp), uint16(PSUBW),
-	/*7994*/ uint16(xReadSlashR),
-	/*7995*/ uint16(xArgMm),
-	/*7996*/ uint16(xArgMmM64),
-	/*7997*/ uint16(xMatch),
-	/*7998*/ uint16(xSetOp), uint16(PSUBW),
-	/*8000*/ uint16(xReadSlashR),
-	/*8001*/ uint16(xArgXmm1),
-	/*8002*/ uint16(xArgXmm2M128),
-	/*8003*/ uint16(xMatch),
-	/*8004*/ uint16(xCondPrefix), 2,
-	0x66, 8016,
-	0x0, 8010,
-	/*8010*/ uint16(xSetOp), uint16(PSUBD),
-	/*8012*/ uint16(xReadSlashR),
-	/*8013*/ uint16(xArgMm),
-	/*8014*/ uint16(xArgMmM64),
-	/*8015*/ uint16(xMatch),
-	/*8016*/ uint16(xSetOp), uint16(PSUBD),
-	/*8018*/ uint16(xReadSlashR),
-	/*8019*/ uint16(xArgXmm1),
-	/*8020*/ uint16(xArgXmm2M128),
-	/*8021*/ uint16(xMatch),
-	/*8022*/ uint16(xCondPrefix), 2,
-	0x66, 8034,
-	0x0, 8028,
-	/*8028*/ uint16(xSetOp), uint16(PSUBQ),
-	/*8030*/ uint16(xReadSlashR),
-	/*8031*/ uint16(xArgMm1),
-	/*8032*/ uint16(xArgMm2M64),
-	/*8033*/ uint16(xMatch),
-	/*8034*/ uint16(xSetOp), uint16(PSUBQ),
-	/*8036*/ uint16(xReadSlashR),
-	/*8037*/ uint16(xArgXmm1),
-	/*8038*/ uint16(xArgXmm2M128),
-	/*8039*/ uint16(xMatch),
-	/*8040*/ uint16(xCondPrefix), 2,
-	0x66, 8052,
-	0x0, 8046,
-	/*8046*/ uint16(xSetOp), uint16(PADDB),
-	/*8048*/ uint16(xReadSlashR),
-	/*8049*/ uint16(xArgMm),
-	/*8050*/ uint16(xArgMmM64),
-	/*8051*/ uint16(xMatch),
-	/*8052*/ uint16(xSetOp), uint16(PADDB),
-	/*8054*/ uint16(xReadSlashR),
-	/*8055*/ uint16(xArgXmm1),
-	/*8056*/ uint16(xArgXmm2M128),
-	/*8057*/ uint16(xMatch),
-	/*8058*/ uint16(xCondPrefix), 2,
-	0x66, 8070,
-	0x0, 8064,
-	/*8064*/ uint16(xSetOp), uint16(PADDW),
-	/*8066*/ uint16(xReadSlashR),
-	/*8067*/ uint16(xArgMm),
-	/*8068*/ uint16(xArgMmM64),
-	/*8069*/ uint16(xMatch),
-	/*8070*/ uint16(xSetOp), uint16(PADDW),
-	/*8072*/ uint16(xReadSlashR),
-	/*8073*/ uint16(xArgXmm1),
-	/*8074*/ uint16(xArgXmm2M128),
-	/*8075*/ uint16(xMatch),
-	/*8076*/ uint16(xCondPrefix), 2,
-	0x66, 8088,
-	0x0, 8082,
-	/*8082*/ uint16(xSetOp), uint16(PADDD),
-	/*8084*/ uint16(xReadSlashR),
-	/*8085*/ uint16(xArgMm),
-	/*8086*/ uint16(xArgMmM64),
-	/*8087*/ uint16(xMatch),
-	/*8088*/ uint16(xSetOp), uint16(PADDD),
-	/*8090*/ uint16(xReadSlashR),
-	/*8091*/ uint16(xArgXmm1),
-	/*8092*/ uint16(xArgXmm2M128),
-	/*8093*/ uint16(xMatch),
-	/*8094*/ uint16(xCondDataSize), 0, 8098, 0,
-	/*8098*/ uint16(xSetOp), uint16(UD0),
+	/*7962*/ uint16(xCondDataSize), 7950, 7956, 7966,
+	/*7966*/ uint16(xSetOp), uint16(BTS),
+	/*7968*/ uint16(xReadIb),
+	/*7969*/ uint16(xArgRM64),
+	/*7970*/ uint16(xArgImm8u),
+	/*7971*/ uint16(xMatch),
+	/*7972*/ uint16(xCondIs64), 7975, 7991,
+	/*7975*/ uint16(xCondDataSize), 7979, 7985, 0,
+	/*7979*/ uint16(xSetOp), uint16(BTR),
+	/*7981*/ uint16(xReadIb),
+	/*7982*/ uint16(xArgRM16),
+	/*7983*/ uint16(xArgImm8u),
+	/*7984*/ uint16(xMatch),
+	/*7985*/ uint16(xSetOp), uint16(BTR),
+	/*7987*/ uint16(xReadIb),
+	/*7988*/ uint16(xArgRM32),
+	/*7989*/ uint16(xArgImm8u),
+	/*7990*/ uint16(xMatch),
+	/*7991*/ uint16(xCondDataSize), 7979, 7985, 7995,
+	/*7995*/ uint16(xSetOp), uint16(BTR),
+	/*7997*/ uint16(xReadIb),
+	/*7998*/ uint16(xArgRM64),
+	/*7999*/ uint16(xArgImm8u),
+	/*8000*/ uint16(xMatch),
+	/*8001*/ uint16(xCondIs64), 8004, 8020,
+	/*8004*/ uint16(xCondDataSize), 8008, 8014, 0,
+	/*8008*/ uint16(xSetOp), uint16(BTC),
+	/*8010*/ uint16(xReadIb),
+	/*8011*/ uint16(xArgRM16),
+	/*8012*/ uint16(xArgImm8u),
+	/*8013*/ uint16(xMatch),
+	/*8014*/ uint16(xSetOp), uint16(BTC),
+	/*8016*/ uint16(xReadIb),
+	/*8017*/ uint16(xArgRM32),
+	/*8018*/ uint16(xArgImm8u),
+	/*8019*/ uint16(xMatch),
+	/*8020*/ uint16(xCondDataSize), 8008, 8014, 8024,
+	/*8024*/ uint16(xSetOp), uint16(BTC),
+	/*8026*/ uint16(xReadIb),
+	/*8027*/ uint16(xArgRM64),
+	/*8028*/ uint16(xArgImm8u),
+	/*8029*/ uint16(xMatch),
+	/*8030*/ uint16(xCondIs64), 8033, 8049,
+	/*8033*/ uint16(xCondDataSize), 8037, 8043, 0,
+	/*8037*/ uint16(xSetOp), uint16(BTC),
+	/*8039*/ uint16(xReadSlashR),
+	/*8040*/ uint16(xArgRM16),
+	/*8041*/ uint16(xArgR16),
+	/*8042*/ uint16(xMatch),
+	/*8043*/ uint16(xSetOp), uint16(BTC),
+	/*8045*/ uint16(xReadSlashR),
+	/*8046*/ uint16(xArgRM32),
+	/*8047*/ uint16(xArgR32),
+	/*8048*/ uint16(xMatch),
+	/*8049*/ uint16(xCondDataSize), 8037, 8043, 8053,
+	/*8053*/ uint16(xSetOp), uint16(BTC),
+	/*8055*/ uint16(xReadSlashR),
+	/*8056*/ uint16(xArgRM64),
+	/*8057*/ uint16(xArgR64),
+	/*8058*/ uint16(xMatch),
+	/*8059*/ uint16(xCondIs64), 8062, 8104,
+	/*8062*/ uint16(xCondPrefix), 2,
+	0xF3, 8084,
+	0x0, 8068,
+	/*8068*/ uint16(xCondDataSize), 8072, 8078, 0,
+	/*8072*/ uint16(xSetOp), uint16(BSF),
+	/*8074*/ uint16(xReadSlashR),
+	/*8075*/ uint16(xArgR16),
+	/*8076*/ uint16(xArgRM16),
+	/*8077*/ uint16(xMatch),
+	/*8078*/ uint16(xSetOp), uint16(BSF),
+	/*8080*/ uint16(xReadSlashR),
+	/*8081*/ uint16(xArgR32),
+	/*8082*/ uint16(xArgRM32),
+	/*8083*/ uint16(xMatch),
+	/*8084*/ uint16(xCondDataSize), 8088, 8096, 0,
+	/*8088*/ uint16(xSetOp), uint16(TZCNT),
+	/*8090*/ uint16(xSetFeature), uint16(FeatureBMI1),
+	/*8092*/ uint16(xReadSlashR),
+	/*8093*/ uint16(xArgR16),
+	/*8094*/ uint16(xArgRM16),
+	/*8095*/ uint16(xMatch),
+	/*8096*/ uint16(xSetOp), uint16(TZCNT),
+	/*8098*/ uint16(xSetFeature), uint16(FeatureBMI1),
 	/*8100*/ uint16(xReadSlashR),
 	/*8101*/ uint16(xArgR32),
 	/*8102*/ uint16(xArgRM32),
 	/*8103*/ uint16(xMatch),
-	/*8104*/ uint16(xSetOp), uint16(ADC),
-	/*8106*/ uint16(xReadSlashR),
-	/*8107*/ uint16(xArgRM8),
-	/*8108*/ uint16(xArgR8),
-	/*8109*/ uint16(xMatch),
-	/*8110*/ uint16(xCondIs64), 8113, 8129,
-	/*8113*/ uint16(xCondDataSize), 8117, 8123, 0,
-	/*8117*/ uint16(xSetOp), uint16(ADC),
-	/*8119*/ uint16(xReadSlashR),
-	/*8120*/ uint16(xArgRM16),
-	/*8121*/ uint16(xArgR16),
-	/*8122*/ uint16(xMatch),
-	/*8123*/ uint16(xSetOp), uint16(ADC),
-	/*8125*/ uint16(xReadSlashR),
-	/*8126*/ uint16(xArgRM32),
-	/*8127*/ uint16(xArgR32),
-	/*8128*/ uint16(xMatch),
-	/*8129*/ uint16(xCondDataSize), 8117, 8123, 8133,
-	/*8133*/ uint16(xSetOp), uint16(ADC),
-	/*8135*/ uint16(xReadSlashR),
-	/*8136*/ uint16(xArgRM64),
-	/*8137*/ uint16(xArgR64),
-	/*8138*/ uint16(xMatch),
-	/*8139*/ uint16(xSetOp), uint16(ADC),
-	/*8141*/ uint16(xReadSlashR),
-	/*8142*/ uint16(xArgR8),
-	/*8143*/ uint16(xArgRM8),
-	/*8144*/ uint16(xMatch),
-	/*8145*/ uint16(xCondIs64), 8148, 8164,
-	/*8148*/ uint16(xCondDataSize), 8152, 8158, 0,
-	/*8152*/ uint16(xSetOp), uint16(ADC),
-	/*8154*/ uint16(xReadSlashR),
-	/*8155*/ uint16(xArgR16),
-	/*8156*/ uint16(xArgRM16),
-	/*8157*/ uint16(xMatch),
-	/*8158*/ uint16(xSetOp), uint16(ADC),
-	/*8160*/ uint16(xReadSlashR),
-	/*8161*/ uint16(xArgR32),
-	/*8162*/ uint16(xArgRM32),
-	/*8163*/ uint16(xMatch),
-	/*8164*/ uint16(xCondDataSize), 8152, 8158, 8168,
-	/*8168*/ uint16(xSetOp), uint16(ADC),
-	/*8170*/ uint16(xReadSlashR),
-	/*8171*/ uint16(xArgR64),
-	/*8172*/ uint16(xArgRM64),
-	/*8173*/ uint16(xMatch),
-	/*8174*/ uint16(xSetOp), uint16(ADC),
-	/*8176*/ uint16(xReadIb),
-	/*8177*/ uint16(xArgAL),
-	/*8178*/ uint16(xArgImm8u),
-	/*8179*/ uint16(xMatch),
-	/*8180*/ uint16(xCondIs64), 8183, 8199,
-	/*8183*/ uint16(xCondDataSize), 8187, 8193, 0,
-	/*8187*/ uint16(xSetOp), uint16(ADC),
-	/*8189*/ uint16(xReadIw),
-	/*8190*/ uint16(xArgAX),
-	/*8191*/ uint16(xArgImm16),
+	/*8104*/ uint16(xCondPrefix), 2,
+	0xF3, 8120,
+	0x0, 8110,
+	/*8110*/ uint16(xCondDataSize), 8072, 8078, 8114,
+	/*8114*/ uint16(xSetOp), uint16(BSF),
+	/*8116*/ uint16(xReadSlashR),
+	/*8117*/ uint16(xArgR64),
+	/*8118*/ uint16(xArgRM64),
+	/*8119*/ uint16(xMatch),
+	/*8120*/ uint16(xCondDataSize), 8088, 8096, 8124,
+	/*8124*/ uint16(xSetOp), uint16(TZCNT),
+	/*8126*/ uint16(xSetFeature), uint16(FeatureBMI1),
+	/*8128*/ uint16(xReadSlashR),
+	/*8129*/ uint16(xArgR64),
+	/*8130*/ uint16(xArgRM64),
+	/*8131*/ uint16(xMatch),
+	/*8132*/ uint16(xCondIs64), 8135, 8177,
+	/*8135*/ uint16(xCondPrefix), 2,
+	0xF3, 8157,
+	0x0, 8141,
+	/*8141*/ uint16(xCondDataSize), 8145, 8151, 0,
+	/*8145*/ uint16(xSetOp), uint16(BSR),
+	/*8147*/ uint16(xReadSlashR),
+	/*8148*/ uint16(xArgR16),
+	/*8149*/ uint16(xArgRM16),
+	/*8150*/ uint16(xMatch),
+	/*8151*/ uint16(xSetOp), uint16(BSR),
+	/*8153*/ uint16(xReadSlashR),
+	/*8154*/ uint16(xArgR32),
+	/*8155*/ uint16(xArgRM32),
+	/*8156*/ uint16(xMatch),
+	/*8157*/ uint16(xCondDataSize), 8161, 8169, 0,
+	/*8161*/ uint16(xSetOp), uint16(LZCNT),
+	/*8163*/ uint16(xSetFeature), uint16(FeatureLZCNT),
+	/*8165*/ uint16(xReadSlashR),
+	/*8166*/ uint16(xArgR16),
+	/*8167*/ uint16(xArgRM16),
+	/*8168*/ uint16(xMatch),
+	/*8169*/ uint16(xSetOp), uint16(LZCNT),
+	/*8171*/ uint16(xSetFeature), uint16(FeatureLZCNT),
+	/*8173*/ uint16(xReadSlashR),
+	/*8174*/ uint16(xArgR32),
+	/*8175*/ uint16(xArgRM32),
+	/*8176*/ uint16(xMatch),
+	/*8177*/ uint16(xCondPrefix), 2,
+	0xF3, 8193,
+	0x0, 8183,
+	/*8183*/ uint16(xCondDataSize), 8145, 8151, 8187,
+	/*8187*/ uint16(xSetOp), uint16(BSR),
+	/*8189*/ uint16(xReadSlashR),
+	/*8190*/ uint16(xArgR64),
+	/*8191*/ uint16(xArgRM64),
 	/*8192*/ uint16(xMatch),
-	/*8193*/ uint16(xSetOp), uint16(ADC),
-	/*8195*/ uint16(xReadId),
-	/*8196*/ uint16(xArgEAX),
-	/*8197*/ uint16(xArgImm32),
-	/*8198*/ uint16(xMatch),
-	/*8199*/ uint16(xCondDataSize), 8187, 8193, 8203,
-	/*8203*/ uint16(xSetOp), uint16(ADC),
-	/*8205*/ uint16(xReadId),
-	/*8206*/ uint16(xArgRAX),
-	/*8207*/ uint16(xArgImm32),
-	/*8208*/ uint16(xMatch),
-	/*8209*/ uint16(xCondIs64), 8212, 0,
-	/*8212*/ uint16(xSetOp), uint16(PUSH),
-	/*8214*/ uint16(xArgSS),
-	/*8215*/ uint16(xMatch),
-	/*8216*/ uint16(xCondIs64), 8219, 0,
-	/*8219*/ uint16(xSetOp), uint16(POP),
-	/*8221*/ uint16(xArgSS),
-	/*8222*/ uint16(xMatch),
-	/*8223*/ uint16(xSetOp), uint16(SBB),
-	/*8225*/ uint16(xReadSlashR),
-	/*8226*/ uint16(xArgRM8),
-	/*8227*/ uint16(xArgR8),
-	/*8228*/ uint16(xMatch),
-	/*8229*/ uint16(xCondIs64), 8232, 8248,
-	/*8232*/ uint16(xCondDataSize), 8236, 8242, 0,
-	/*8236*/ uint16(xSetOp), uint16(SBB),
-	/*8238*/ uint16(xReadSlashR),
-	/*8239*/ uint16(xArgRM16),
-	/*8240*/ uint16(xArgR16),
-	/*8241*/ uint16(xMatch),
-	/*8242*/ uint16(xSetOp), uint16(SBB),
-	/*8244*/ uint16(xReadSlashR),
-	/*8245*/ uint16(xArgRM32),
-	/*8246*/ uint16(xArgR32),
-	/*8247*/ uint16(xMatch),
-	/*8248*/ uint16(xCondDataSize), 8236, 8242, 8252,
-	/*8252*/ uint16(xSetOp), uint16(SBB),
-	/*8254*/ uint16(xReadSlashR),
-	/*8255*/ uint16(xArgRM64),
-	/*8256*/ uint16(xArgR64),
-	/*8257*/ uint16(xMatch),
-	/*8258*/ uint16(xSetOp), uint16(SBB),
-	/*8260*/ uint16(xReadSlashR),
-	/*8261*/ uint16(xArgR8),
-	/*8262*/ uint16(xArgRM8),
-	/*8263*/ uint16(xMatch),
-	/*8264*/ uint16(xCondIs64), 8267, 8283,
-	/*8267*/ uint16(xCondDataSize), 8271, 8277, 0,
-	/*8271*/ uint16(xSetOp), uint16(SBB),
-	/*8273*/ uint16(xReadSlashR),
-	/*8274*/ uint16(xArgR16),
-	/*8275*/ uint16(xArgRM16),
-	/*8276*/ uint16(xMatch),
-	/*8277*/ uint16(xSetOp), uint16(SBB),
-	/*8279*/ uint16(xReadSlashR),
-	/*8280*/ uint16(xArgR32),
-	/*8281*/ uint16(xArgRM32),
-	/*8282*/ uint16(xMatch),
-	/*8283*/ uint16(xCondDataSize), 8271, 8277, 8287,
-	/*8287*/ uint16(xSetOp), uint16(SBB),
-	/*8289*/ uint16(xReadSlashR),
-	/*8290*/ uint16(xArgR64),
-	/*8291*/ uint16(xArgRM64),
-	/*8292*/ uint16(xMatch),
-	/*8293*/ uint16(xSetOp), uint16(SBB),
-	/*8295*/ uint16(xReadIb),
-	/*8296*/ uint16(xArgAL),
-	/*8297*/ uint16(xArgImm8u),
-	/*8298*/ uint16(xMatch),
-	/*8299*/ uint16(xCondIs64), 8302, 8318,
-	/*8302*/ uint16(xCondDataSize), 8306, 8312, 0,
-	/*8306*/ uint16(xSetOp), uint16(SBB),
-	/*8308*/ uint16(xReadIw),
-	/*8309*/ uint16(xArgAX),
-	/*8310*/ uint16(xArgImm16),
-	/*8311*/ uint16(xMatch),
-	/*8312*/ uint16(xSetOp), uint16(SBB),
-	/*8314*/ uint16(xReadId),
-	/*8315*/ uint16(xArgEAX),
-	/*8316*/ uint16(xArgImm32),
+	/*8193*/ uint16(xCondDataSize), 8161, 8169, 8197,
+	/*8197*/ uint16(xSetOp), uint16(LZCNT),
+	/*8199*/ uint16(xSetFeature), uint16(FeatureLZCNT),
+	/*8201*/ uint16(xReadSlashR),
+	/*8202*/ uint16(xArgR64),
+	/*8203*/ uint16(xArgRM64),
+	/*8204*/ uint16(xMatch),
+	/*8205*/ uint16(xCondIs64), 8208, 8224,
+	/*8208*/ uint16(xCondDataSize), 8212, 8218, 0,
+	/*8212*/ uint16(xSetOp), uint16(MOVSX),
+	/*8214*/ uint16(xReadSlashR),
+	/*8215*/ uint16(xArgR16),
+	/*8216*/ uint16(xArgRM8),
+	/*8217*/ uint16(xMatch),
+	/*8218*/ uint16(xSetOp), uint16(MOVSX),
+	/*8220*/ uint16(xReadSlashR),
+	/*8221*/ uint16(xArgR32),
+	/*8222*/ uint16(xArgRM8),
+	/*8223*/ uint16(xMatch),
+	/*8224*/ uint16(xCondDataSize), 8212, 8218, 8228,
+	/*8228*/ uint16(xSetOp), uint16(MOVSX),
+	/*8230*/ uint16(xReadSlashR),
+	/*8231*/ uint16(xArgR64),
+	/*8232*/ uint16(xArgRM8),
+	/*8233*/ uint16(xMatch),
+	/*8234*/ uint16(xCondIs64), 8237, 8253,
+	/*8237*/ uint16(xCondDataSize), 8241, 8247, 0,
+	/*8241*/ uint16(xSetOp), uint16(MOVSX),
+	/*8243*/ uint16(xReadSlashR),
+	/*8244*/ uint16(xArgR16),
+	/*8245*/ uint16(xArgRM16),
+	/*8246*/ uint16(xMatch),
+	/*8247*/ uint16(xSetOp), uint16(MOVSX),
+	/*8249*/ uint16(xReadSlashR),
+	/*8250*/ uint16(xArgR32),
+	/*8251*/ uint16(xArgRM16),
+	/*8252*/ uint16(xMatch),
+	/*8253*/ uint16(xCondDataSize), 8241, 8247, 8257,
+	/*8257*/ uint16(xSetOp), uint16(MOVSX),
+	/*8259*/ uint16(xReadSlashR),
+	/*8260*/ uint16(xArgR64),
+	/*8261*/ uint16(xArgRM16),
+	/*8262*/ uint16(xMatch),
+	/*8263*/ uint16(xSetOp), uint16(XADD),
+	/*8265*/ uint16(xReadSlashR),
+	/*8266*/ uint16(xArgRM8),
+	/*8267*/ uint16(xArgR8),
+	/*8268*/ uint16(xMatch),
+	/*8269*/ uint16(xCondIs64), 8272, 8288,
+	/*8272*/ uint16(xCondDataSize), 8276, 8282, 0,
+	/*8276*/ uint16(xSetOp), uint16(XADD),
+	/*8278*/ uint16(xReadSlashR),
+	/*8279*/ uint16(xArgRM16),
+	/*8280*/ uint16(xArgR16),
+	/*8281*/ uint16(xMatch),
+	/*8282*/ uint16(xSetOp), uint16(XADD),
+	/*8284*/ uint16(xReadSlashR),
+	/*8285*/ uint16(xArgRM32),
+	/*8286*/ uint16(xArgR32),
+	/*8287*/ uint16(xMatch),
+	/*8288*/ uint16(xCondDataSize), 8276, 8282, 8292,
+	/*8292*/ uint16(xSetOp), uint16(XADD),
+	/*8294*/ uint16(xReadSlashR),
+	/*8295*/ uint16(xArgRM64),
+	/*8296*/ uint16(xArgR64),
+	/*8297*/ uint16(xMatch),
+	/*8298*/ uint16(xCondPrefix), 4,
+	0xF3, 8338,
+	0xF2, 8328,
+	0x66, 8318,
+	0x0, 8308,
+	/*8308*/ uint16(xSetOp), uint16(CMPPS),
+	/*8310*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8312*/ uint16(xReadSlashR),
+	/*8313*/ uint16(xReadIb),
+	/*8314*/ uint16(xArgXmm1),
+	/*8315*/ uint16(xArgXmm2M128),
+	/*8316*/ uint16(xArgImm8u),
 	/*8317*/ uint16(xMatch),
-	/*8318*/ uint16(xCondDataSize), 8306, 8312, 8322,
-	/*8322*/ uint16(xSetOp), uint16(SBB),
-	/*8324*/ uint16(xReadId),
-	/*8325*/ uint16(xArgRAX),
-	/*8326*/ uint16(xArgImm32),
+	/*8318*/ uint16(xSetOp), uint16(CMPPD),
+	/*8320*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8322*/ uint16(xReadSlashR),
+	/*8323*/ uint16(xReadIb),
+	/*8324*/ uint16(xArgXmm1),
+	/*8325*/ uint16(xArgXmm2M128),
+	/*8326*/ uint16(xArgImm8u),
 	/*8327*/ uint16(xMatch),
-	/*8328*/ uint16(xCondIs64), 8331, 0,
-	/*8331*/ uint16(xSetOp), uint16(PUSH),
-	/*8333*/ uint16(xArgDS),
-	/*8334*/ uint16(xMatch),
-	/*8335*/ uint16(xCondIs64), 8338, 0,
-	/*8338*/ uint16(xSetOp), uint16(POP),
-	/*8340*/ uint16(xArgDS),
-	/*8341*/ uint16(xMatch),
-	/*8342*/ uint16(xSetOp), uint16(AND),
-	/*8344*/ uint16(xReadSlashR),
-	/*8345*/ uint16(xArgRM8),
-	/*8346*/ uint16(xArgR8),
+	/*8328*/ uint16(xSetOp), uint16(CMPSD_XMM),
+	/*8330*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8332*/ uint16(xReadSlashR),
+	/*8333*/ uint16(xReadIb),
+	/*8334*/ uint16(xArgXmm1),
+	/*8335*/ uint16(xArgXmm2M64),
+	/*8336*/ uint16(xArgImm8u),
+	/*8337*/ uint16(xMatch),
+	/*8338*/ uint16(xSetOp), uint16(CMPSS),
+	/*8340*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8342*/ uint16(xReadSlashR),
+	/*8343*/ uint16(xReadIb),
+	/*8344*/ uint16(xArgXmm1),
+	/*8345*/ uint16(xArgXmm2M32),
+	/*8346*/ uint16(xArgImm8u),
 	/*8347*/ uint16(xMatch),
-	/*8348*/ uint16(xCondIs64), 8351, 8367,
-	/*8351*/ uint16(xCondDataSize), 8355, 8361, 0,
-	/*8355*/ uint16(xSetOp), uint16(AND),
-	/*8357*/ uint16(xReadSlashR),
-	/*8358*/ uint16(xArgRM16),
-	/*8359*/ uint16(xArgR16),
-	/*8360*/ uint16(xMatch),
-	/*8361*/ uint16(xSetOp), uint16(AND),
-	/*8363*/ uint16(xReadSlashR),
-	/*8364*/ uint16(xArgRM32),
-	/*8365*/ uint16(xArgR32),
-	/*8366*/ uint16(xMatch),
-	/*8367*/ uint16(xCondDataSize), 8355, 8361, 8371,
-	/*8371*/ uint16(xSetOp), uint16(AND),
-	/*8373*/ uint16(xReadSlashR),
-	/*8374*/ uint16(xArgRM64),
-	/*8375*/ uint16(xArgR64),
-	/*8376*/ uint16(xMatch),
-	/*8377*/ uint16(xSetOp), uint16(AND),
+	/*8348*/ uint16(xCondIs64), 8351, 8371,
+	/*8351*/ uint16(xCondDataSize), 8355, 8363, 0,
+	/*8355*/ uint16(xSetOp), uint16(MOVNTI),
+	/*8357*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8359*/ uint16(xReadSlashR),
+	/*8360*/ uint16(xArgM32),
+	/*8361*/ uint16(xArgR32),
+	/*8362*/ uint16(xMatch),
+	/*8363*/ uint16(xSetOp), uint16(MOVNTI),
+	/*8365*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8367*/ uint16(xReadSlashR),
+	/*8368*/ uint16(xArgM32),
+	/*8369*/ uint16(xArgR32),
+	/*8370*/ uint16(xMatch),
+	/*8371*/ uint16(xCondDataSize), 8355, 8363, 8375,
+	/*8375*/ uint16(xSetOp), uint16(MOVNTI),
+	/*8377*/ uint16(xSetFeature), uint16(FeatureSSE2),
 	/*8379*/ uint16(xReadSlashR),
-	/*8380*/ uint16(xArgR8),
-	/*8381*/ uint16(xArgRM8),
+	/*8380*/ uint16(xArgM64),
+	/*8381*/ uint16(xArgR64),
 	/*8382*/ uint16(xMatch),
-	/*8383*/ uint16(xCondIs64), 8386, 8402,
-	/*8386*/ uint16(xCondDataSize), 8390, 8396, 0,
-	/*8390*/ uint16(xSetOp), uint16(AND),
-	/*8392*/ uint16(xReadSlashR),
-	/*8393*/ uint16(xArgR16),
-	/*8394*/ uint16(xArgRM16),
-	/*8395*/ uint16(xMatch),
-	/*8396*/ uint16(xSetOp), uint16(AND),
-	/*8398*/ uint16(xReadSlashR),
-	/*8399*/ uint16(xArgR32),
-	/*8400*/ uint16(xArgRM32),
-	/*8401*/ uint16(xMatch),
-	/*8402*/ uint16(xCondDataSize), 8390, 8396, 8406,
-	/*8406*/ uint16(xSetOp), uint16(AND),
-	/*8408*/ uint16(xReadSlashR),
-	/*8409*/ uint16(xArgR64),
-	/*8410*/ uint16(xArgRM64),
-	/*8411*/ uint16(xMatch),
-	/*8412*/ uint16(xSetOp), uint16(AND),
-	/*8414*/ uint16(xReadIb),
-	/*8415*/ uint16(xArgAL),
-	/*8416*/ uint16(xArgImm8u),
-	/*8417*/ uint16(xMatch),
-	/*8418*/ uint16(xCondIs64), 8421, 8437,
-	/*8421*/ uint16(xCondDataSize), 8425, 8431, 0,
-	/*8425*/ uint16(xSetOp), uint16(AND),
-	/*8427*/ uint16(xReadIw),
-	/*8428*/ uint16(xArgAX),
-	/*8429*/ uint16(xArgImm16),
-	/*8430*/ uint16(xMatch),
-	/*8431*/ uint16(xSetOp), uint16(AND),
-	/*8433*/ uint16(xReadId),
-	/*8434*/ uint16(xArgEAX),
-	/*8435*/ uint16(xArgImm32),
-	/*8436*/ uint16(xMatch),
-	/*8437*/ uint16(xCondDataSize), 8425, 8431, 8441,
-	/*8441*/ uint16(xSetOp), uint16(AND),
-	/*8443*/ uint16(xReadId),
-	/*8444*/ uint16(xArgRAX),
-	/*8445*/ uint16(xArgImm32),
-	/*8446*/ uint16(xMatch),
-	/*8447*/ uint16(xCondIs64), 8450, 0,
-	/*8450*/ uint16(xSetOp), uint16(DAA),
-	/*8452*/ uint16(xMatch),
-	/*8453*/ uint16(xSetOp), uint16(SUB),
+	/*8383*/ uint16(xCondPrefix), 2,
+	0x66, 8399,
+	0x0, 8389,
+	/*8389*/ uint16(xSetOp), uint16(PINSRW),
+	/*8391*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8393*/ uint16(xReadSlashR),
+	/*8394*/ uint16(xReadIb),
+	/*8395*/ uint16(xArgMm),
+	/*8396*/ uint16(xArgR32M16),
+	/*8397*/ uint16(xArgImm8u),
+	/*8398*/ uint16(xMatch),
+	/*8399*/ uint16(xSetOp), uint16(PINSRW),
+	/*8401*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8403*/ uint16(xReadSlashR),
+	/*8404*/ uint16(xReadIb),
+	/*8405*/ uint16(xArgXmm),
+	/*8406*/ uint16(xArgR32M16),
+	/*8407*/ uint16(xArgImm8u),
+	/*8408*/ uint16(xMatch),
+	/*8409*/ uint16(xCondPrefix), 2,
+	0x66, 8425,
+	0x0, 8415,
+	/*8415*/ uint16(xSetOp), uint16(PEXTRW),
+	/*8417*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8419*/ uint16(xReadSlashR),
+	/*8420*/ uint16(xReadIb),
+	/*8421*/ uint16(xArgR32),
+	/*8422*/ uint16(xArgMm2),
+	/*8423*/ uint16(xArgImm8u),
+	/*8424*/ uint16(xMatch),
+	/*8425*/ uint16(xSetOp), uint16(PEXTRW),
+	/*8427*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8429*/ uint16(xReadSlashR),
+	/*8430*/ uint16(xReadIb),
+	/*8431*/ uint16(xArgR32),
+	/*8432*/ uint16(xArgXmm2),
+	/*8433*/ uint16(xArgImm8u),
+	/*8434*/ uint16(xMatch),
+	/*8435*/ uint16(xCondPrefix), 2,
+	0x66, 8451,
+	0x0, 8441,
+	/*8441*/ uint16(xSetOp), uint16(SHUFPS),
+	/*8443*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8445*/ uint16(xReadSlashR),
+	/*8446*/ uint16(xReadIb),
+	/*8447*/ uint16(xArgXmm1),
+	/*8448*/ uint16(xArgXmm2M128),
+	/*8449*/ uint16(xArgImm8u),
+	/*8450*/ uint16(xMatch),
+	/*8451*/ uint16(xSetOp), uint16(SHUFPD),
+	/*8453*/ uint16(xSetFeature), uint16(FeatureSSE2),
 	/*8455*/ uint16(xReadSlashR),
-	/*8456*/ uint16(xArgRM8),
-	/*8457*/ uint16(xArgR8),
-	/*8458*/ uint16(xMatch),
-	/*8459*/ uint16(xCondIs64), 8462, 8478,
-	/*8462*/ uint16(xCondDataSize), 8466, 8472, 0,
-	/*8466*/ uint16(xSetOp), uint16(SUB),
-	/*8468*/ uint16(xReadSlashR),
-	/*8469*/ uint16(xArgRM16),
-	/*8470*/ uint16(xArgR16),
-	/*8471*/ uint16(xMatch),
-	/*8472*/ uint16(xSetOp), uint16(SUB),
-	/*8474*/ uint16(xReadSlashR),
-	/*8475*/ uint16(xArgRM32),
-	/*8476*/ uint16(xArgR32),
-	/*8477*/ uint16(xMatch),
-	/*8478*/ uint16(xCondDataSize), 8466, 8472, 8482,
-	/*8482*/ uint16(xSetOp), uint16(SUB),
-	/*8484*/ uint16(xReadSlashR),
-	/*8485*/ uint16(xArgRM64),
-	/*8486*/ uint16(xArgR64),
-	/*8487*/ uint16(xMatch),
-	/*8488*/ uint16(xSetOp), uint16(SUB),
-	/*8490*/ uint16(xReadSlashR),
-	/*8491*/ uint16(xArgR8),
-	/*8492*/ uint16(xArgRM8),
-	/*8493*/ uint16(xMatch),
-	/*8494*/ uint16(xCondIs64), 8497, 8513,
-	/*8497*/ uint16(xCondDataSize), 8501, 8507, 0,
-	/*8501*/ uint16(xSetOp), uint16(SUB),
-	/*8503*/ uint16(xReadSlashR),
-	/*8504*/ uint16(xArgR16),
-	/*8505*/ uint16(xArgRM16),
-	/*8506*/ uint16(xMatch),
-	/*8507*/ uint16(xSetOp), uint16(SUB),
-	/*8509*/ uint16(xReadSlashR),
-	/*8510*/ uint16(xArgR32),
-	/*8511*/ uint16(xArgRM32),
-	/*8512*/ uint16(xMatch),
-	/*8513*/ uint16(xCondDataSize), 8501, 8507, 8517,
-	/*8517*/ uint16(xSetOp), uint16(SUB),
-	/*8519*/ uint16(xReadSlashR),
-	/*8520*/ uint16(xArgR64),
-	/*8521*/ uint16(xArgRM64),
-	/*8522*/ uint16(xMatch),
-	/*8523*/ uint16(xSetOp), uint16(SUB),
-	/*8525*/ uint16(xReadIb),
-	/*8526*/ uint16(xArgAL),
-	/*8527*/ uint16(xArgImm8u),
-	/*8528*/ uint16(xMatch),
-	/*8529*/ uint16(xCondIs64), 8532, 8548,
-	/*8532*/ uint16(xCondDataSize), 8536, 8542, 0,
-	/*8536*/ uint16(xSetOp), uint16(SUB),
-	/*8538*/ uint16(xReadIw),
-	/*8539*/ uint16(xArgAX),
-	/*8540*/ uint16(xArgImm16),
-	/*8541*/ uint16(xMatch),
-	/*8542*/ uint16(xSetOp), uint16(SUB),
-	/*8544*/ uint16(xReadId),
-	/*8545*/ uint16(xArgEAX),
-	/*8546*/ uint16(xArgImm32),
-	/*8547*/ uint16(xMatch),
-	/*8548*/ uint16(xCondDataSize), 8536, 8542, 8552,
-	/*8552*/ uint16(xSetOp), uint16(SUB),
-	/*8554*/ uint16(xReadId),
-	/*8555*/ uint16(xArgRAX),
-	/*8556*/ uint16(xArgImm32),
-	/*8557*/ uint16(xMatch),
-	/*8558*/ uint16(xCondIs64), 8561, 0,
-	/*8561*/ uint16(xSetOp), uint16(DAS),
-	/*8563*/ uint16(xMatch),
-	/*8564*/ uint16(xSetOp), uint16(XOR),
-	/*8566*/ uint16(xReadSlashR),
-	/*8567*/ uint16(xArgRM8),
-	/*8568*/ uint16(xArgR8),
-	/*8569*/ uint16(xMatch),
-	/*8570*/ uint16(xCondIs64), 8573, 8589,
-	/*8573*/ uint16(xCondDataSize), 8577, 8583, 0,
-	/*8577*/ uint16(xSetOp), uint16(XOR),
-	/*8579*/ uint16(xReadSlashR),
-	/*8580*/ uint16(xArgRM16),
-	/*8581*/ uint16(xArgR16),
-	/*8582*/ uint16(xMatch),
-	/*8583*/ uint16(xSetOp), uint16(XOR),
-	/*8585*/ uint16(xReadSlashR),
-	/*8586*/ uint16(xArgRM32),
-	/*8587*/ uint16(xArgR32),
-	/*8588*/ uint16(xMatch),
-	/*8589*/ uint16(xCondDataSize), 8577, 8583, 8593,
-	/*8593*/ uint16(xSetOp), uint16(XOR),
-	/*8595*/ uint16(xReadSlashR),
-	/*8596*/ uint16(xArgRM64),
-	/*8597*/ uint16(xArgR64),
-	/*8598*/ uint16(xMatch),
-	/*8599*/ uint16(xSetOp), uint16(XOR),
-	/*8601*/ uint16(xReadSlashR),
-	/*8602*/ uint16(xArgR8),
-	/*8603*/ uint16(xArgRM8),
-	/*8604*/ uint16(xMatch),
-	/*8605*/ uint16(xCondIs64), 8608, 8624,
-	/*8608*/ uint16(xCondDataSize), 8612, 8618, 0,
-	/*8612*/ uint16(xSetOp), uint16(XOR),
-	/*8614*/ uint16(xReadSlashR),
-	/*8615*/ uint16(xArgR16),
-	/*8616*/ uint16(xArgRM16),
-	/*8617*/ uint16(xMatch),
-	/*8618*/ uint16(xSetOp), uint16(XOR),
-	/*8620*/ uint16(xReadSlashR),
-	/*8621*/ uint16(xArgR32),
-	/*8622*/ uint16(xArgRM32),
-	/*8623*/ uint16(xMatch),
-	/*8624*/ uint16(xCondDataSize), 8612, 8618, 8628,
-	/*8628*/ uint16(xSetOp), uint16(XOR),
-	/*8630*/ uint16(xReadSlashR),
-	/*8631*/ uint16(xArgR64),
-	/*8632*/ uint16(xArgRM64),
-	/*8633*/ uint16(xMatch),
-	/*8634*/ uint16(xSetOp), uint16(XOR),
-	/*8636*/ uint16(xReadIb),
-	/*8637*/ uint16(xArgAL),
-	/*8638*/ uint16(xArgImm8u),
-	/*8639*/ uint16(xMatch),
-	/*8640*/ uint16(xCondIs64), 8643, 8659,
-	/*8643*/ uint16(xCondDataSize), 8647, 8653, 0,
-	/*8647*/ uint16(xSetOp), uint16(XOR),
-	/*8649*/ uint16(xReadIw),
-	/*8650*/ uint16(xArgAX),
-	/*8651*/ uint16(xArgImm16),
-	/*8652*/ uint16(xMatch),
-	/*8653*/ uint16(xSetOp), uint16(XOR),
-	/*8655*/ uint16(xReadId),
-	/*8656*/ uint16(xArgEAX),
-	/*8657*/ uint16(xArgImm32),
-	/*8658*/ uint16(xMatch),
-	/*8659*/ uint16(xCondDataSize), 8647, 8653, 8663,
-	/*8663*/ uint16(xSetOp), uint16(XOR),
-	/*8665*/ uint16(xReadId),
-	/*8666*/ uint16(xArgRAX),
-	/*8667*/ uint16(xArgImm32),
-	/*8668*/ uint16(xMatch),
-	/*8669*/ uint16(xCondIs64), 8672, 0,
-	/*8672*/ uint16(xSetOp), uint16(AAA),
+	/*8456*/ uint16(xReadIb),
+	/*8457*/ uint16(xArgXmm1),
+	/*8458*/ uint16(xArgXmm2M128),
+	/*8459*/ uint16(xArgImm8u),
+	/*8460*/ uint16(xMatch),
+	/*8461*/ uint16(xCondSlashR),
+	0,    // 0
+	8470, // 1
+	0,    // 2
+	8495, // 3
+	8524, // 4
+	8553, // 5
+	8582, // 6
+	8631, // 7
+	/*8470*/ uint16(xCondIs64), 8473, 8485,
+	/*8473*/ uint16(xCondDataSize), 8477, 8481, 0,
+	/*8477*/ uint16(xSetOp), uint16(CMPXCHG8B),
+	/*8479*/ uint16(xArgM64),
+	/*8480*/ uint16(xMatch),
+	/*8481*/ uint16(xSetOp), uint16(CMPXCHG8B),
+	/*8483*/ uint16(xArgM64),
+	/*8484*/ uint16(xMatch),
+	/*8485*/ uint16(xCondDataSize), 8477, 8481, 8489,
+	/*8489*/ uint16(xSetOp), uint16(CMPXCHG16B),
+	/*8491*/ uint16(xSetFeature), uint16(FeatureCMPXCHG16B),
+	/*8493*/ uint16(xArgM128),
+	/*8494*/ uint16(xMatch),
+	/*8495*/ uint16(xCondIs64), 8498, 8514,
+	/*8498*/ uint16(xCondDataSize), 8502, 8508, 0,
+	/*8502*/ uint16(xSetOp), uint16(XRSTORS),
+	/*8504*/ uint16(xSetFeature), uint16(FeatureXSAVES),
+	/*8506*/ uint16(xArgMem),
+	/*8507*/ uint16(xMatch),
+	/*8508*/ uint16(xSetOp), uint16(XRSTORS),
+	/*8510*/ uint16(xSetFeature), uint16(FeatureXSAVES),
+	/*8512*/ uint16(xArgMem),
+	/*8513*/ uint16(xMatch),
+	/*8514*/ uint16(xCondDataSize), 8502, 8508, 8518,
+	/*8518*/ uint16(xSetOp), uint16(XRSTORS64),
+	/*8520*/ uint16(xSetFeature), uint16(FeatureXSAVES),
+	/*8522*/ uint16(xArgMem),
+	/*8523*/ uint16(xMatch),
+	/*8524*/ uint16(xCondIs64), 8527, 8543,
+	/*8527*/ uint16(xCondDataSize), 8531, 8537, 0,
+	/*8531*/ uint16(xSetOp), uint16(XSAVEC),
+	/*8533*/ uint16(xSetFeature), uint16(FeatureXSAVEC),
+	/*8535*/ uint16(xArgMem),
+	/*8536*/ uint16(xMatch),
+	/*8537*/ uint16(xSetOp), uint16(XSAVEC),
+	/*8539*/ uint16(xSetFeature), uint16(FeatureXSAVEC),
+	/*8541*/ uint16(xArgMem),
+	/*8542*/ uint16(xMatch),
+	/*8543*/ uint16(xCondDataSize), 8531, 8537, 8547,
+	/*8547*/ uint16(xSetOp), uint16(XSAVEC64),
+	/*8549*/ uint16(xSetFeature), uint16(FeatureXSAVEC),
+	/*8551*/ uint16(xArgMem),
+	/*8552*/ uint16(xMatch),
+	/*8553*/ uint16(xCondIs64), 8556, 8572,
+	/*8556*/ uint16(xCondDataSize), 8560, 8566, 0,
+	/*8560*/ uint16(xSetOp), uint16(XSAVES),
+	/*8562*/ uint16(xSetFeature), uint16(FeatureXSAVES),
+	/*8564*/ uint16(xArgMem),
+	/*8565*/ uint16(xMatch),
+	/*8566*/ uint16(xSetOp), uint16(XSAVES),
+	/*8568*/ uint16(xSetFeature), uint16(FeatureXSAVES),
+	/*8570*/ uint16(xArgMem),
+	/*8571*/ uint16(xMatch),
+	/*8572*/ uint16(xCondDataSize), 8560, 8566, 8576,
+	/*8576*/ uint16(xSetOp), uint16(XSAVES64),
+	/*8578*/ uint16(xSetFeature), uint16(FeatureXSAVES),
+	/*8580*/ uint16(xArgMem),
+	/*8581*/ uint16(xMatch),
+	/*8582*/ uint16(xCondIs64), 8585, 8607,
+	/*8585*/ uint16(xCondDataSize), 8589, 8598, 0,
+	/*8589*/ uint16(xCondIsMem), 8592, 0,
+	/*8592*/ uint16(xSetOp), uint16(RDRAND),
+	/*8594*/ uint16(xSetFeature), uint16(FeatureRDRAND),
+	/*8596*/ uint16(xArgRmf16),
+	/*8597*/ uint16(xMatch),
+	/*8598*/ uint16(xCondIsMem), 8601, 0,
+	/*8601*/ uint16(xSetOp), uint16(RDRAND),
+	/*8603*/ uint16(xSetFeature), uint16(FeatureRDRAND),
+	/*8605*/ uint16(xArgRmf32),
+	/*8606*/ uint16(xMatch),
+	/*8607*/ uint16(xCondPrefix), 2,
+	0xF3, 8622,
+	0x0, 8613,
+	/*8613*/ uint16(xCondDataSize), 8589, 8598, 8617,
+	/*8617*/ uint16(xSetOp), uint16(RDRAND),
+	/*8619*/ uint16(xSetFeature), uint16(FeatureRDRAND),
+	/*8621*/ uint16(xMatch),
+	/*8622*/ uint16(xCondIsMem), 8625, 0,
+	/*8625*/ uint16(xSetOp), uint16(SENDUIPI),
+	/*8627*/ uint16(xSetFeature), uint16(FeatureUINTR),
+	/*8629*/ uint16(xArgRmf64),
+	/*8630*/ uint16(xMatch),
+	/*8631*/ uint16(xCondIs64), 8634, 8647,
+	/*8634*/ uint16(xCondPrefix), 1,
+	0xF3, 8638,
+	/*8638*/ uint16(xCondIsMem), 8641, 0,
+	/*8641*/ uint16(xSetOp), uint16(RDPID),
+	/*8643*/ uint16(xSetFeature), uint16(FeatureRDPID),
+	/*8645*/ uint16(xArgRmf32),
+	/*8646*/ uint16(xMatch),
+	/*8647*/ uint16(xCondPrefix), 1,
+	0xF3, 8651,
+	/*8651*/ uint16(xCondIsMem), 8654, 0,
+	/*8654*/ uint16(xSetOp), uint16(RDPID),
+	/*8656*/ uint16(xSetFeature), uint16(FeatureRDPID),
+	/*8658*/ uint16(xArgRmf64),
+	/*8659*/ uint16(xMatch),
+	/*8660*/ uint16(xCondIs64), 8663, 8675,
+	/*8663*/ uint16(xCondDataSize), 8667, 8671, 0,
+	/*8667*/ uint16(xSetOp), uint16(BSWAP),
+	/*8669*/ uint16(xArgR16op),
+	/*8670*/ uint16(xMatch),
+	/*8671*/ uint16(xSetOp), uint16(BSWAP),
+	/*8673*/ uint16(xArgR32op),
 	/*8674*/ uint16(xMatch),
-	/*8675*/ uint16(xSetOp), uint16(CMP),
-	/*8677*/ uint16(xReadSlashR),
-	/*8678*/ uint16(xArgRM8),
-	/*8679*/ uint16(xArgR8),
-	/*8680*/ uint16(xMatch),
-	/*8681*/ uint16(xCondIs64), 8684, 8700,
-	/*8684*/ uint16(xCondDataSize), 8688, 8694, 0,
-	/*8688*/ uint16(xSetOp), uint16(CMP),
-	/*8690*/ uint16(xReadSlashR),
-	/*8691*/ uint16(xArgRM16),
-	/*8692*/ uint16(xArgR16),
-	/*8693*/ uint16(xMatch),
-	/*8694*/ uint16(xSetOp), uint16(CMP),
-	/*8696*/ uint16(xReadSlashR),
-	/*8697*/ uint16(xArgRM32),
-	/*8698*/ uint16(xArgR32),
-	/*8699*/ uint16(xMatch),
-	/*8700*/ uint16(xCondDataSize), 8688, 8694, 8704,
-	/*8704*/ uint16(xSetOp), uint16(CMP),
-	/*8706*/ uint16(xReadSlashR),
-	/*8707*/ uint16(xArgRM64),
-	/*8708*/ uint16(xArgR64),
-	/*8709*/ uint16(xMatch),
-	/*8710*/ uint16(xSetOp), uint16(CMP),
-	/*8712*/ uint16(xReadSlashR),
-	/*8713*/ uint16(xArgR8),
-	/*8714*/ uint16(xArgRM8),
-	/*8715*/ uint16(xMatch),
-	/*8716*/ uint16(xCondIs64), 8719, 8735,
-	/*8719*/ uint16(xCondDataSize), 8723, 8729, 0,
-	/*8723*/ uint16(xSetOp), uint16(CMP),
-	/*8725*/ uint16(xReadSlashR),
-	/*8726*/ uint16(xArgR16),
-	/*8727*/ uint16(xArgRM16),
-	/*8728*/ uint16(xMatch),
-	/*8729*/ uint16(xSetOp), uint16(CMP),
-	/*8731*/ uint16(xReadSlashR),
-	/*8732*/ uint16(xArgR32),
-	/*8733*/ uint16(xArgRM32),
-	/*8734*/ uint16(xMatch),
-	/*8735*/ uint16(xCondDataSize), 8723, 8729, 8739,
-	/*8739*/ uint16(xSetOp), uint16(CMP),
-	/*8741*/ uint16(xReadSlashR),
-	/*8742*/ uint16(xArgR64),
-	/*8743*/ uint16(xArgRM64),
-	/*8744*/ uint16(xMatch),
-	/*8745*/ uint16(xSetOp), uint16(CMP),
-	/*8747*/ uint16(xReadIb),
-	/*8748*/ uint16(xArgAL),
-	/*8749*/ uint16(xArgImm8u),
-	/*8750*/ uint16(xMatch),
-	/*8751*/ uint16(xCondIs64), 8754, 8770,
-	/*8754*/ uint16(xCondDataSize), 8758, 8764, 0,
-	/*8758*/ uint16(xSetOp), uint16(CMP),
-	/*8760*/ uint16(xReadIw),
-	/*8761*/ uint16(xArgAX),
-	/*8762*/ uint16(xArgImm16),
-	/*8763*/ uint16(xMatch),
-	/*8764*/ uint16(xSetOp), uint16(CMP),
-	/*8766*/ uint16(xReadId),
-	/*8767*/ uint16(xArgEAX),
-	/*8768*/ uint16(xArgImm32),
-	/*8769*/ uint16(xMatch),
-	/*8770*/ uint16(xCondDataSize), 8758, 8764, 8774,
-	/*8774*/ uint16(xSetOp), uint16(CMP),
-	/*8776*/ uint16(xReadId),
-	/*8777*/ uint16(xArgRAX),
-	/*8778*/ uint16(xArgImm32),
-	/*8779*/ uint16(xMatch),
-	/*8780*/ uint16(xCondIs64), 8783, 0,
-	/*8783*/ uint16(xSetOp), uint16(AAS),
-	/*8785*/ uint16(xMatch),
-	/*8786*/ uint16(xCondIs64), 8789, 0,
-	/*8789*/ uint16(xCondDataSize), 8793, 8797, 0,
-	/*8793*/ uint16(xSetOp), uint16(INC),
-	/*8795*/ uint16(xArgR16op),
-	/*8796*/ uint16(xMatch),
-	/*8797*/ uint16(xSetOp), uint16(INC),
-	/*8799*/ uint16(xArgR32op),
-	/*8800*/ uint16(xMatch),
-	/*8801*/ uint16(xCondIs64), 8804, 0,
-	/*8804*/ uint16(xCondDataSize), 8808, 8812, 0,
-	/*8808*/ uint16(xSetOp), uint16(DEC),
-	/*8810*/ uint16(xArgR16op),
-	/*8811*/ uint16(xMatch),
-	/*8812*/ uint16(xSetOp), uint16(DEC),
-	/*8814*/ uint16(xArgR32op),
-	/*8815*/ uint16(xMatch),
-	/*8816*/ uint16(xCondIs64), 8819, 8831,
-	/*8819*/ uint16(xCondDataSize), 8823, 8827, 0,
-	/*8823*/ uint16(xSetOp), uint16(PUSH),
-	/*8825*/ uint16(xArgR16op),
-	/*8826*/ uint16(xMatch),
-	/*8827*/ uint16(xSetOp), uint16(PUSH),
-	/*8829*/ uint16(xArgR32op),
+	/*8675*/ uint16(xCondDataSize), 8667, 8671, 8679,
+	/*8679*/ uint16(xSetOp), uint16(BSWAP),
+	/*8681*/ uint16(xArgR64op),
+	/*8682*/ uint16(xMatch),
+	/*8683*/ uint16(xCondPrefix), 2,
+	0xF2, 8697,
+	0x66, 8689,
+	/*8689*/ uint16(xSetOp), uint16(ADDSUBPD),
+	/*8691*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*8693*/ uint16(xReadSlashR),
+	/*8694*/ uint16(xArgXmm1),
+	/*8695*/ uint16(xArgXmm2M128),
+	/*8696*/ uint16(xMatch),
+	/*8697*/ uint16(xSetOp), uint16(ADDSUBPS),
+	/*8699*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*8701*/ uint16(xReadSlashR),
+	/*8702*/ uint16(xArgXmm1),
+	/*8703*/ uint16(xArgXmm2M128),
+	/*8704*/ uint16(xMatch),
+	/*8705*/ uint16(xCondPrefix), 2,
+	0x66, 8719,
+	0x0, 8711,
+	/*8711*/ uint16(xSetOp), uint16(PSRLW),
+	/*8713*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8715*/ uint16(xReadSlashR),
+	/*8716*/ uint16(xArgMm),
+	/*8717*/ uint16(xArgMmM64),
+	/*8718*/ uint16(xMatch),
+	/*8719*/ uint16(xSetOp), uint16(PSRLW),
+	/*8721*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8723*/ uint16(xReadSlashR),
+	/*8724*/ uint16(xArgXmm1),
+	/*8725*/ uint16(xArgXmm2M128),
+	/*8726*/ uint16(xMatch),
+	/*8727*/ uint16(xCondPrefix), 2,
+	0x66, 8741,
+	0x0, 8733,
+	/*8733*/ uint16(xSetOp), uint16(PSRLD),
+	/*8735*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8737*/ uint16(xReadSlashR),
+	/*8738*/ uint16(xArgMm),
+	/*8739*/ uint16(xArgMmM64),
+	/*8740*/ uint16(xMatch),
+	/*8741*/ uint16(xSetOp), uint16(PSRLD),
+	/*8743*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8745*/ uint16(xReadSlashR),
+	/*8746*/ uint16(xArgXmm1),
+	/*8747*/ uint16(xArgXmm2M128),
+	/*8748*/ uint16(xMatch),
+	/*8749*/ uint16(xCondPrefix), 2,
+	0x66, 8763,
+	0x0, 8755,
+	/*8755*/ uint16(xSetOp), uint16(PSRLQ),
+	/*8757*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8759*/ uint16(xReadSlashR),
+	/*8760*/ uint16(xArgMm),
+	/*8761*/ uint16(xArgMmM64),
+	/*8762*/ uint16(xMatch),
+	/*8763*/ uint16(xSetOp), uint16(PSRLQ),
+	/*8765*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8767*/ uint16(xReadSlashR),
+	/*8768*/ uint16(xArgXmm1),
+	/*8769*/ uint16(xArgXmm2M128),
+	/*8770*/ uint16(xMatch),
+	/*8771*/ uint16(xCondPrefix), 2,
+	0x66, 8785,
+	0x0, 8777,
+	/*8777*/ uint16(xSetOp), uint16(PADDQ),
+	/*8779*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8781*/ uint16(xReadSlashR),
+	/*8782*/ uint16(xArgMm1),
+	/*8783*/ uint16(xArgMm2M64),
+	/*8784*/ uint16(xMatch),
+	/*8785*/ uint16(xSetOp), uint16(PADDQ),
+	/*8787*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8789*/ uint16(xReadSlashR),
+	/*8790*/ uint16(xArgXmm1),
+	/*8791*/ uint16(xArgXmm2M128),
+	/*8792*/ uint16(xMatch),
+	/*8793*/ uint16(xCondPrefix), 2,
+	0x66, 8807,
+	0x0, 8799,
+	/*8799*/ uint16(xSetOp), uint16(PMULLW),
+	/*8801*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8803*/ uint16(xReadSlashR),
+	/*8804*/ uint16(xArgMm),
+	/*8805*/ uint16(xArgMmM64),
+	/*8806*/ uint16(xMatch),
+	/*8807*/ uint16(xSetOp), uint16(PMULLW),
+	/*8809*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8811*/ uint16(xReadSlashR),
+	/*8812*/ uint16(xArgXmm1),
+	/*8813*/ uint16(xArgXmm2M128),
+	/*8814*/ uint16(xMatch),
+	/*8815*/ uint16(xCondPrefix), 3,
+	0xF3, 8839,
+	0xF2, 8831,
+	0x66, 8823,
+	/*8823*/ uint16(xSetOp), uint16(MOVQ),
+	/*8825*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8827*/ uint16(xReadSlashR),
+	/*8828*/ uint16(xArgXmm2M64),
+	/*8829*/ uint16(xArgXmm1),
 	/*8830*/ uint16(xMatch),
-	/*8831*/ uint16(xCondDataSize), 8823, 8835, 8839,
-	/*8835*/ uint16(xSetOp), uint16(PUSH),
-	/*8837*/ uint16(xArgR64op),
+	/*8831*/ uint16(xSetOp), uint16(MOVDQ2Q),
+	/*8833*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8835*/ uint16(xReadSlashR),
+	/*8836*/ uint16(xArgMm),
+	/*8837*/ uint16(xArgXmm2),
 	/*8838*/ uint16(xMatch),
-	/*8839*/ uint16(xSetOp), uint16(PUSH),
-	/*8841*/ uint16(xArgR64op),
-	/*8842*/ uint16(xMatch),
-	/*8843*/ uint16(xCondIs64), 8846, 8858,
-	/*8846*/ uint16(xCondDataSize), 8850, 8854, 0,
-	/*8850*/ uint16(xSetOp), uint16(POP),
-	/*8852*/ uint16(xArgR16op),
-	/*8853*/ uint16(xMatch),
-	/*8854*/ uint16(xSetOp), uint16(POP),
-	/*8856*/ uint16(xArgR32op),
-	/*8857*/ uint16(xMatch),
-	/*8858*/ uint16(xCondDataSize), 8850, 8862, 8866,
-	/*8862*/ uint16(xSetOp), uint16(POP),
-	/*8864*/ uint16(xArgR64op),
-	/*8865*/ uint16(xMatch),
-	/*8866*/ uint16(xSetOp), uint16(POP),
-	/*8868*/ uint16(xArgR64op),
-	/*8869*/ uint16(xMatch),
-	/*8870*/ uint16(xCondIs64), 8873, 0,
-	/*8873*/ uint16(xCondDataSize), 8877, 8880, 0,
-	/*8877*/ uint16(xSetOp), uint16(PUSHA),
-	/*8879*/ uint16(xMatch),
-	/*8880*/ uint16(xSetOp), uint16(PUSHAD),
+	/*8839*/ uint16(xSetOp), uint16(MOVQ2DQ),
+	/*8841*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8843*/ uint16(xReadSlashR),
+	/*8844*/ uint16(xArgXmm1),
+	/*8845*/ uint16(xArgMm2),
+	/*8846*/ uint16(xMatch),
+	/*8847*/ uint16(xCondPrefix), 2,
+	0x66, 8861,
+	0x0, 8853,
+	/*8853*/ uint16(xSetOp), uint16(PMOVMSKB),
+	/*8855*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8857*/ uint16(xReadSlashR),
+	/*8858*/ uint16(xArgR32),
+	/*8859*/ uint16(xArgMm2),
+	/*8860*/ uint16(xMatch),
+	/*8861*/ uint16(xSetOp), uint16(PMOVMSKB),
+	/*8863*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8865*/ uint16(xReadSlashR),
+	/*8866*/ uint16(xArgR32),
+	/*8867*/ uint16(xArgXmm2),
+	/*8868*/ uint16(xMatch),
+	/*8869*/ uint16(xCondPrefix), 2,
+	0x66, 8883,
+	0x0, 8875,
+	/*8875*/ uint16(xSetOp), uint16(PSUBUSB),
+	/*8877*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8879*/ uint16(xReadSlashR),
+	/*8880*/ uint16(xArgMm),
+	/*8881*/ uint16(xArgMmM64),
 	/*8882*/ uint16(xMatch),
-	/*8883*/ uint16(xCondIs64), 8886, 0,
-	/*8886*/ uint16(xCondDataSize), 8890, 8893, 0,
-	/*8890*/ uint16(xSetOp), uint16(POPA),
-	/*8892*/ uint16(xMatch),
-	/*8893*/ uint16(xSetOp), uint16(POPAD),
-	/*8895*/ uint16(xMatch),
-	/*8896*/ uint16(xCondIs64), 8899, 0,
-	/*8899*/ uint16(xCondDataSize), 8903, 8909, 0,
-	/*8903*/ uint16(xSetOp), uint16(BOUND),
-	/*8905*/ uint16(xReadSlashR),
-	/*8906*/ uint16(xArgR16),
-	/*8907*/ uint16(xArgM16and16),
-	/*8908*/ uint16(xMatch),
-	/*8909*/ uint16(xSetOp), uint16(BOUND),
-	/*8911*/ uint16(xReadSlashR),
-	/*8912*/ uint16(xArgR32),
-	/*8913*/ uint16(xArgM32and32),
-	/*8914*/ uint16(xMatch),
-	/*8915*/ uint16(xCondIs64), 8918, 8924,
-	/*8918*/ uint16(xSetOp), uint16(ARPL),
-	/*8920*/ uint16(xReadSlashR),
-	/*8921*/ uint16(xArgRM16),
-	/*8922*/ uint16(xArgR16),
-	/*8923*/ uint16(xMatch),
-	/*8924*/ uint16(xCondDataSize), 8928, 8934, 8940,
-	/*8928*/ uint16(xSetOp), uint16(MOVSXD),
-	/*8930*/ uint16(xReadSlashR),
-	/*8931*/ uint16(xArgR16),
-	/*8932*/ uint16(xArgRM32),
-	/*8933*/ uint16(xMatch),
-	/*8934*/ uint16(xSetOp), uint16(MOVSXD),
-	/*8936*/ uint16(xReadSlashR),
-	/*8937*/ uint16(xArgR32),
-	/*8938*/ uint16(xArgRM32),
-	/*8939*/ uint16(xMatch),
-	/*8940*/ uint16(xSetOp), uint16(MOVSXD),
-	/*8942*/ uint16(xReadSlashR),
-	/*8943*/ uint16(xArgR64),
-	/*8944*/ uint16(xArgRM32),
-	/*8945*/ uint16(xMatch),
-	/*8946*/ uint16(xCondDataSize), 8950, 8955, 8960,
-	/*8950*/ uint16(xSetOp), uint16(PUSH),
-	/*8952*/ uint16(xReadIw),
-	/*8953*/ uint16(xArgImm16),
-	/*8954*/ uint16(xMatch),
-	/*8955*/ uint16(xSetOp), uint16(PUSH),
-	/*8957*/ uint16(xReadId),
-	/*8958*/ uint16(xArgImm32),
-	/*8959*/ uint16(xMatch),
-	/*8960*/ uint16(xSetOp), uint16(PUSH),
-	/*8962*/ uint16(xReadId),
-	/*8963*/ uint16(xArgImm32),
-	/*8964*/ uint16(xMatch),
-	/*8965*/ uint16(xCondIs64), 8968, 8988,
-	/*8968*/ uint16(xCondDataSize), 8972, 8980, 0,
-	/*8972*/ uint16(xSetOp), uint16(IMUL),
-	/*8974*/ uint16(xReadSlashR),
-	/*8975*/ uint16(xReadIw),
-	/*8976*/ uint16(xArgR16),
-	/*8977*/ uint16(xArgRM16),
-	/*8978*/ uint16(xArgImm16),
-	/*8979*/ uint16(xMatch),
-	/*8980*/ uint16(xSetOp), uint16(IMUL),
-	/*8982*/ uint16(xReadSlashR),
-	/*8983*/ uint16(xReadId),
-	/*8984*/ uint16(xArgR32),
-	/*8985*/ uint16(xArgRM32),
-	/*8986*/ uint16(xArgImm32),
-	/*8987*/ uint16(xMatch),
-	/*8988*/ uint16(xCondDataSize), 8972, 8980, 8992,
-	/*8992*/ uint16(xSetOp), uint16(IMUL),
-	/*8994*/ uint16(xReadSlashR),
-	/*8995*/ uint16(xReadId),
-	/*8996*/ uint16(xArgR64),
-	/*8997*/ uint16(xArgRM64),
-	/*8998*/ uint16(xArgImm32),
-	/*8999*/ uint16(xMatch),
-	/*9000*/ uint16(xSetOp), uint16(PUSH),
-	/*9002*/ uint16(xReadIb),
-	/*9003*/ uint16(xArgImm8),
-	/*9004*/ uint16(xMatch),
-	/*9005*/ uint16(xCondIs64), 9008, 9028,
-	/*9008*/ uint16(xCondDataSize), 9012, 9020, 0,
-	/*9012*/ uint16(xSetOp), uint16(IMUL),
-	/*9014*/ uint16(xReadSlashR),
-	/*9015*/ uint16(xReadIb),
-	/*9016*/ uint16(xArgR16),
-	/*9017*/ uint16(xArgRM16),
-	/*9018*/ uint16(xArgImm8),
-	/*9019*/ uint16(xMatch),
-	/*9020*/ uint16(xSetOp), uint16(IMUL),
-	/*9022*/ uint16(xReadSlashR),
-	/*9023*/ uint16(xReadIb),
-	/*9024*/ uint16(xArgR32),
-	/*9025*/ uint16(xArgRM32),
-	/*9026*/ uint16(xArgImm8),
-	/*9027*/ uint16(xMatch),
-	/*9028*/ uint16(xCondDataSize), 9012, 9020, 9032,
-	/*9032*/ uint16(xSetOp), uint16(IMUL),
-	/*9034*/ uint16(xReadSlashR),
-	/*9035*/ uint16(xReadIb),
-	/*9036*/ uint16(xArgR64),
-	/*9037*/ uint16(xArgRM64),
-	/*9038*/ uint16(xArgImm8),
-	/*9039*/ uint16(xMatch),
-	/*9040*/ uint16(xSetOp), uint16(INSB),
-	/*9042*/ uint16(xMatch),
-	/*9043*/ uint16(xCondDataSize), 9047, 9050, 9053,
-	/*9047*/ uint16(xSetOp), uint16(INSW),
-	/*9049*/ uint16(xMatch),
-	/*9050*/ uint16(xSetOp), uint16(INSD),
-	/*9052*/ uint16(xMatch),
-	/*9053*/ uint16(xSetOp), uint16(INSD),
-	/*9055*/ uint16(xMatch),
-	/*9056*/ uint16(xSetOp), uint16(OUTSB),
+	/*8883*/ uint16(xSetOp), uint16(PSUBUSB),
+	/*8885*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8887*/ uint16(xReadSlashR),
+	/*8888*/ uint16(xArgXmm1),
+	/*8889*/ uint16(xArgXmm2M128),
+	/*8890*/ uint16(xMatch),
+	/*8891*/ uint16(xCondPrefix), 2,
+	0x66, 8905,
+	0x0, 8897,
+	/*8897*/ uint16(xSetOp), uint16(PSUBUSW),
+	/*8899*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8901*/ uint16(xReadSlashR),
+	/*8902*/ uint16(xArgMm),
+	/*8903*/ uint16(xArgMmM64),
+	/*8904*/ uint16(xMatch),
+	/*8905*/ uint16(xSetOp), uint16(PSUBUSW),
+	/*8907*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8909*/ uint16(xReadSlashR),
+	/*8910*/ uint16(xArgXmm1),
+	/*8911*/ uint16(xArgXmm2M128),
+	/*8912*/ uint16(xMatch),
+	/*8913*/ uint16(xCondPrefix), 2,
+	0x66, 8927,
+	0x0, 8919,
+	/*8919*/ uint16(xSetOp), uint16(PMINUB),
+	/*8921*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*8923*/ uint16(xReadSlashR),
+	/*8924*/ uint16(xArgMm1),
+	/*8925*/ uint16(xArgMm2M64),
+	/*8926*/ uint16(xMatch),
+	/*8927*/ uint16(xSetOp), uint16(PMINUB),
+	/*8929*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8931*/ uint16(xReadSlashR),
+	/*8932*/ uint16(xArgXmm1),
+	/*8933*/ uint16(xArgXmm2M128),
+	/*8934*/ uint16(xMatch),
+	/*8935*/ uint16(xCondPrefix), 2,
+	0x66, 8949,
+	0x0, 8941,
+	/*8941*/ uint16(xSetOp), uint16(PAND),
+	/*8943*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8945*/ uint16(xReadSlashR),
+	/*8946*/ uint16(xArgMm),
+	/*8947*/ uint16(xArgMmM64),
+	/*8948*/ uint16(xMatch),
+	/*8949*/ uint16(xSetOp), uint16(PAND),
+	/*8951*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8953*/ uint16(xReadSlashR),
+	/*8954*/ uint16(xArgXmm1),
+	/*8955*/ uint16(xArgXmm2M128),
+	/*8956*/ uint16(xMatch),
+	/*8957*/ uint16(xCondPrefix), 2,
+	0x66, 8971,
+	0x0, 8963,
+	/*8963*/ uint16(xSetOp), uint16(PADDUSB),
+	/*8965*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8967*/ uint16(xReadSlashR),
+	/*8968*/ uint16(xArgMm),
+	/*8969*/ uint16(xArgMmM64),
+	/*8970*/ uint16(xMatch),
+	/*8971*/ uint16(xSetOp), uint16(PADDUSB),
+	/*8973*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8975*/ uint16(xReadSlashR),
+	/*8976*/ uint16(xArgXmm1),
+	/*8977*/ uint16(xArgXmm2M128),
+	/*8978*/ uint16(xMatch),
+	/*8979*/ uint16(xCondPrefix), 2,
+	0x66, 8993,
+	0x0, 8985,
+	/*8985*/ uint16(xSetOp), uint16(PADDUSW),
+	/*8987*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*8989*/ uint16(xReadSlashR),
+	/*8990*/ uint16(xArgMm),
+	/*8991*/ uint16(xArgMmM64),
+	/*8992*/ uint16(xMatch),
+	/*8993*/ uint16(xSetOp), uint16(PADDUSW),
+	/*8995*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*8997*/ uint16(xReadSlashR),
+	/*8998*/ uint16(xArgXmm1),
+	/*8999*/ uint16(xArgXmm2M128),
+	/*9000*/ uint16(xMatch),
+	/*9001*/ uint16(xCondPrefix), 2,
+	0x66, 9015,
+	0x0, 9007,
+	/*9007*/ uint16(xSetOp), uint16(PMAXUB),
+	/*9009*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9011*/ uint16(xReadSlashR),
+	/*9012*/ uint16(xArgMm1),
+	/*9013*/ uint16(xArgMm2M64),
+	/*9014*/ uint16(xMatch),
+	/*9015*/ uint16(xSetOp), uint16(PMAXUB),
+	/*9017*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9019*/ uint16(xReadSlashR),
+	/*9020*/ uint16(xArgXmm1),
+	/*9021*/ uint16(xArgXmm2M128),
+	/*9022*/ uint16(xMatch),
+	/*9023*/ uint16(xCondPrefix), 2,
+	0x66, 9037,
+	0x0, 9029,
+	/*9029*/ uint16(xSetOp), uint16(PANDN),
+	/*9031*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9033*/ uint16(xReadSlashR),
+	/*9034*/ uint16(xArgMm),
+	/*9035*/ uint16(xArgMmM64),
+	/*9036*/ uint16(xMatch),
+	/*9037*/ uint16(xSetOp), uint16(PANDN),
+	/*9039*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9041*/ uint16(xReadSlashR),
+	/*9042*/ uint16(xArgXmm1),
+	/*9043*/ uint16(xArgXmm2M128),
+	/*9044*/ uint16(xMatch),
+	/*9045*/ uint16(xCondPrefix), 2,
+	0x66, 9059,
+	0x0, 9051,
+	/*9051*/ uint16(xSetOp), uint16(PAVGB),
+	/*9053*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9055*/ uint16(xReadSlashR),
+	/*9056*/ uint16(xArgMm1),
+	/*9057*/ uint16(xArgMm2M64),
 	/*9058*/ uint16(xMatch),
-	/*9059*/ uint16(xCondDataSize), 9063, 9066, 9069,
-	/*9063*/ uint16(xSetOp), uint16(OUTSW),
-	/*9065*/ uint16(xMatch),
-	/*9066*/ uint16(xSetOp), uint16(OUTSD),
-	/*9068*/ uint16(xMatch),
-	/*9069*/ uint16(xSetOp), uint16(OUTSD),
-	/*9071*/ uint16(xMatch),
-	/*9072*/ uint16(xSetOp), uint16(JO),
-	/*9074*/ uint16(xReadCb),
-	/*9075*/ uint16(xArgRel8),
-	/*9076*/ uint16(xMatch),
-	/*9077*/ uint16(xSetOp), uint16(JNO),
-	/*9079*/ uint16(xReadCb),
-	/*9080*/ uint16(xArgRel8),
-	/*9081*/ uint16(xMatch),
-	/*9082*/ uint16(xSetOp), uint16(JB),
-	/*9084*/ uint16(xReadCb),
-	/*9085*/ uint16(xArgRel8),
-	/*9086*/ uint16(xMatch),
-	/*9087*/ uint16(xSetOp), uint16(JAE),
-	/*9089*/ uint16(xReadCb),
-	/*9090*/ uint16(xArgRel8),
-	/*9091*/ uint16(xMatch),
-	/*9092*/ uint16(xSetOp), uint16(JE),
-	/*9094*/ uint16(xReadCb),
-	/*9095*/ uint16(xArgRel8),
-	/*9096*/ uint16(xMatch),
-	/*9097*/ uint16(xSetOp), uint16(JNE),
-	/*9099*/ uint16(xReadCb),
-	/*9100*/ uint16(xArgRel8),
-	/*9101*/ uint16(xMatch),
-	/*9102*/ uint16(xSetOp), uint16(JBE),
-	/*9104*/ uint16(xReadCb),
-	/*9105*/ uint16(xArgRel8),
-	/*9106*/ uint16(xMatch),
-	/*9107*/ uint16(xSetOp), uint16(JA),
-	/*9109*/ uint16(xReadCb),
-	/*9110*/ uint16(xArgRel8),
-	/*9111*/ uint16(xMatch),
-	/*9112*/ uint16(xSetOp), uint16(JS),
-	/*9114*/ uint16(xReadCb),
-	/*9115*/ uint16(xArgRel8),
-	/*9116*/ uint16(xMatch),
-	/*9117*/ uint16(xSetOp), uint16(JNS),
-	/*9119*/ uint16(xReadCb),
-	/*9120*/ uint16(xArgRel8),
-	/*9121*/ uint16(xMatch),
-	/*9122*/ uint16(xSetOp), uint16(JP),
-	/*9124*/ uint16(xReadCb),
-	/*9125*/ uint16(xArgRel8),
-	/*9126*/ uint16(xMatch),
-	/*9127*/ uint16(xSetOp), uint16(JNP),
-	/*9129*/ uint16(xReadCb),
-	/*9130*/ uint16(xArgRel8),
-	/*9131*/ uint16(xMatch),
-	/*9132*/ uint16(xSetOp), uint16(JL),
-	/*9134*/ uint16(xReadCb),
-	/*9135*/ uint16(xArgRel8),
-	/*9136*/ uint16(xMatch),
-	/*9137*/ uint16(xSetOp), uint16(JGE),
-	/*9139*/ uint16(xReadCb),
-	/*9140*/ uint16(xArgRel8),
-	/*9141*/ uint16(xMatch),
-	/*9142*/ uint16(xSetOp), uint16(JLE),
-	/*9144*/ uint16(xReadCb),
-	/*9145*/ uint16(xArgRel8),
+	/*9059*/ uint16(xSetOp), uint16(PAVGB),
+	/*9061*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9063*/ uint16(xReadSlashR),
+	/*9064*/ uint16(xArgXmm1),
+	/*9065*/ uint16(xArgXmm2M128),
+	/*9066*/ uint16(xMatch),
+	/*9067*/ uint16(xCondPrefix), 2,
+	0x66, 9081,
+	0x0, 9073,
+	/*9073*/ uint16(xSetOp), uint16(PSRAW),
+	/*9075*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9077*/ uint16(xReadSlashR),
+	/*9078*/ uint16(xArgMm),
+	/*9079*/ uint16(xArgMmM64),
+	/*9080*/ uint16(xMatch),
+	/*9081*/ uint16(xSetOp), uint16(PSRAW),
+	/*9083*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9085*/ uint16(xReadSlashR),
+	/*9086*/ uint16(xArgXmm1),
+	/*9087*/ uint16(xArgXmm2M128),
+	/*9088*/ uint16(xMatch),
+	/*9089*/ uint16(xCondPrefix), 2,
+	0x66, 9103,
+	0x0, 9095,
+	/*9095*/ uint16(xSetOp), uint16(PSRAD),
+	/*9097*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9099*/ uint16(xReadSlashR),
+	/*9100*/ uint16(xArgMm),
+	/*9101*/ uint16(xArgMmM64),
+	/*9102*/ uint16(xMatch),
+	/*9103*/ uint16(xSetOp), uint16(PSRAD),
+	/*9105*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9107*/ uint16(xReadSlashR),
+	/*9108*/ uint16(xArgXmm1),
+	/*9109*/ uint16(xArgXmm2M128),
+	/*9110*/ uint16(xMatch),
+	/*9111*/ uint16(xCondPrefix), 2,
+	0x66, 9125,
+	0x0, 9117,
+	/*9117*/ uint16(xSetOp), uint16(PAVGW),
+	/*9119*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9121*/ uint16(xReadSlashR),
+	/*9122*/ uint16(xArgMm1),
+	/*9123*/ uint16(xArgMm2M64),
+	/*9124*/ uint16(xMatch),
+	/*9125*/ uint16(xSetOp), uint16(PAVGW),
+	/*9127*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9129*/ uint16(xReadSlashR),
+	/*9130*/ uint16(xArgXmm1),
+	/*9131*/ uint16(xArgXmm2M128),
+	/*9132*/ uint16(xMatch),
+	/*9133*/ uint16(xCondPrefix), 2,
+	0x66, 9147,
+	0x0, 9139,
+	/*9139*/ uint16(xSetOp), uint16(PMULHUW),
+	/*9141*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9143*/ uint16(xReadSlashR),
+	/*9144*/ uint16(xArgMm1),
+	/*9145*/ uint16(xArgMm2M64),
 	/*9146*/ uint16(xMatch),
-	/*9147*/ uint16(xSetOp), uint16(JG),
-	/*9149*/ uint16(xReadCb),
-	/*9150*/ uint16(xArgRel8),
-	/*9151*/ uint16(xMatch),
-	/*9152*/ uint16(xCondSlashR),
-	9161, // 0
-	9167, // 1
-	9173, // 2
-	9179, // 3
-	9185, // 4
-	9191, // 5
-	9197, // 6
-	9203, // 7
-	/*9161*/ uint16(xSetOp), uint16(ADD),
-	/*9163*/ uint16(xReadIb),
-	/*9164*/ uint16(xArgRM8),
-	/*9165*/ uint16(xArgImm8u),
-	/*9166*/ uint16(xMatch),
-	/*9167*/ uint16(xSetOp), uint16(OR),
-	/*9169*/ uint16(xReadIb),
-	/*9170*/ uint16(xArgRM8),
-	/*9171*/ uint16(xArgImm8u),
-	/*9172*/ uint16(xMatch),
-	/*9173*/ uint16(xSetOp), uint16(ADC),
-	/*9175*/ uint16(xReadIb),
-	/*9176*/ uint16(xArgRM8),
-	/*9177*/ uint16(xArgImm8u),
-	/*9178*/ uint16(xMatch),
-	/*9179*/ uint16(xSetOp), uint16(SBB),
-	/*9181*/ uint16(xReadIb),
-	/*9182*/ uint16(xArgRM8),
-	/*9183*/ uint16(xArgImm8u),
-	/*9184*/ uint16(xMatch),
-	/*9185*/ uint16(xSetOp), uint16(AND),
-	/*9187*/ uint16(xReadIb),
-	/*9188*/ uint16(xArgRM8),
-	/*9189*/ uint16(xArgImm8u),
-	/*9190*/ uint16(xMatch),
-	/*9191*/ uint16(xSetOp), uint16(SUB),
-	/*9193*/ uint16(xReadIb),
-	/*9194*/ uint16(xArgRM8),
-	/*9195*/ uint16(xArgImm8u),
-	/*9196*/ uint16(xMatch),
-	/*9197*/ uint16(xSetOp), uint16(XOR),
-	/*9199*/ uint16(xReadIb),
-	/*9200*/ uint16(xArgRM8),
-	/*9201*/ uint16(xArgImm8u),
-	/*9202*/ uint16(xMatch),
-	/*9203*/ uint16(xSetOp), uint16(CMP),
-	/*9205*/ uint16(xReadIb),
-	/*9206*/ uint16(xArgRM8),
-	/*9207*/ uint16(xArgImm8u),
+	/*9147*/ uint16(xSetOp), uint16(PMULHUW),
+	/*9149*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9151*/ uint16(xReadSlashR),
+	/*9152*/ uint16(xArgXmm1),
+	/*9153*/ uint16(xArgXmm2M128),
+	/*9154*/ uint16(xMatch),
+	/*9155*/ uint16(xCondPrefix), 2,
+	0x66, 9169,
+	0x0, 9161,
+	/*9161*/ uint16(xSetOp), uint16(PMULHW),
+	/*9163*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9165*/ uint16(xReadSlashR),
+	/*9166*/ uint16(xArgMm),
+	/*9167*/ uint16(xArgMmM64),
+	/*9168*/ uint16(xMatch),
+	/*9169*/ uint16(xSetOp), uint16(PMULHW),
+	/*9171*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9173*/ uint16(xReadSlashR),
+	/*9174*/ uint16(xArgXmm1),
+	/*9175*/ uint16(xArgXmm2M128),
+	/*9176*/ uint16(xMatch),
+	/*9177*/ uint16(xCondPrefix), 3,
+	0xF3, 9201,
+	0xF2, 9193,
+	0x66, 9185,
+	/*9185*/ uint16(xSetOp), uint16(CVTTPD2DQ),
+	/*9187*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9189*/ uint16(xReadSlashR),
+	/*9190*/ uint16(xArgXmm1),
+	/*9191*/ uint16(xArgXmm2M128),
+	/*9192*/ uint16(xMatch),
+	/*9193*/ uint16(xSetOp), uint16(CVTPD2DQ),
+	/*9195*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9197*/ uint16(xReadSlashR),
+	/*9198*/ uint16(xArgXmm1),
+	/*9199*/ uint16(xArgXmm2M128),
+	/*9200*/ uint16(xMatch),
+	/*9201*/ uint16(xSetOp), uint16(CVTDQ2PD),
+	/*9203*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9205*/ uint16(xReadSlashR),
+	/*9206*/ uint16(xArgXmm1),
+	/*9207*/ uint16(xArgXmm2M64),
 	/*9208*/ uint16(xMatch),
-	/*9209*/ uint16(xCondSlashR),
-	9218, // 0
-	9247, // 1
-	9276, // 2
-	9305, // 3
-	9334, // 4
-	9363, // 5
-	9392, // 6
-	9421, // 7
-	/*9218*/ uint16(xCondIs64), 9221, 9237,
-	/*9221*/ uint16(xCondDataSize), 9225, 9231, 0,
-	/*9225*/ uint16(xSetOp), uint16(ADD),
-	/*9227*/ uint16(xReadIw),
-	/*9228*/ uint16(xArgRM16),
-	/*9229*/ uint16(xArgImm16),
+	/*9209*/ uint16(xCondPrefix), 2,
+	0x66, 9223,
+	0x0, 9215,
+	/*9215*/ uint16(xSetOp), uint16(MOVNTQ),
+	/*9217*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9219*/ uint16(xReadSlashR),
+	/*9220*/ uint16(xArgM64),
+	/*9221*/ uint16(xArgMm),
+	/*9222*/ uint16(xMatch),
+	/*9223*/ uint16(xSetOp), uint16(MOVNTDQ),
+	/*9225*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9227*/ uint16(xReadSlashR),
+	/*9228*/ uint16(xArgM128),
+	/*9229*/ uint16(xArgXmm),
 	/*9230*/ uint16(xMatch),
-	/*9231*/ uint16(xSetOp), uint16(ADD),
-	/*9233*/ uint16(xReadId),
-	/*9234*/ uint16(xArgRM32),
-	/*9235*/ uint16(xArgImm32),
-	/*9236*/ uint16(xMatch),
-	/*9237*/ uint16(xCondDataSize), 9225, 9231, 9241,
-	/*9241*/ uint16(xSetOp), uint16(ADD),
-	/*9243*/ uint16(xReadId),
-	/*9244*/ uint16(xArgRM64),
-	/*9245*/ uint16(xArgImm32),
-	/*9246*/ uint16(xMatch),
-	/*9247*/ uint16(xCondIs64), 9250, 9266,
-	/*9250*/ uint16(xCondDataSize), 9254, 9260, 0,
-	/*9254*/ uint16(xSetOp), uint16(OR),
-	/*9256*/ uint16(xReadIw),
-	/*9257*/ uint16(xArgRM16),
-	/*9258*/ uint16(xArgImm16),
-	/*9259*/ uint16(xMatch),
-	/*9260*/ uint16(xSetOp), uint16(OR),
-	/*9262*/ uint16(xReadId),
-	/*9263*/ uint16(xArgRM32),
-	/*9264*/ uint16(xArgImm32),
-	/*9265*/ uint16(xMatch),
-	/*9266*/ uint16(xCondDataSize), 9254, 9260, 9270,
-	/*9270*/ uint16(xSetOp), uint16(OR),
-	/*9272*/ uint16(xReadId),
-	/*9273*/ uint16(xArgRM64),
-	/*9274*/ uint16(xArgImm32),
-	/*9275*/ uint16(xMatch),
-	/*9276*/ uint16(xCondIs64), 9279, 9295,
-	/*9279*/ uint16(xCondDataSize), 9283, 9289, 0,
-	/*9283*/ uint16(xSetOp), uint16(ADC),
-	/*9285*/ uint16(xReadIw),
-	/*9286*/ uint16(xArgRM16),
-	/*9287*/ uint16(xArgImm16),
+	/*9231*/ uint16(xCondPrefix), 2,
+	0x66, 9245,
+	0x0, 9237,
+	/*9237*/ uint16(xSetOp), uint16(PSUBSB),
+	/*9239*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9241*/ uint16(xReadSlashR),
+	/*9242*/ uint16(xArgMm),
+	/*9243*/ uint16(xArgMmM64),
+	/*9244*/ uint16(xMatch),
+	/*9245*/ uint16(xSetOp), uint16(PSUBSB),
+	/*9247*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9249*/ uint16(xReadSlashR),
+	/*9250*/ uint16(xArgXmm1),
+	/*9251*/ uint16(xArgXmm2M128),
+	/*9252*/ uint16(xMatch),
+	/*9253*/ uint16(xCondPrefix), 2,
+	0x66, 9267,
+	0x0, 9259,
+	/*9259*/ uint16(xSetOp), uint16(PSUBSW),
+	/*9261*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9263*/ uint16(xReadSlashR),
+	/*9264*/ uint16(xArgMm),
+	/*9265*/ uint16(xArgMmM64),
+	/*9266*/ uint16(xMatch),
+	/*9267*/ uint16(xSetOp), uint16(PSUBSW),
+	/*9269*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9271*/ uint16(xReadSlashR),
+	/*9272*/ uint16(xArgXmm1),
+	/*9273*/ uint16(xArgXmm2M128),
+	/*9274*/ uint16(xMatch),
+	/*9275*/ uint16(xCondPrefix), 2,
+	0x66, 9289,
+	0x0, 9281,
+	/*9281*/ uint16(xSetOp), uint16(PMINSW),
+	/*9283*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9285*/ uint16(xReadSlashR),
+	/*9286*/ uint16(xArgMm1),
+	/*9287*/ uint16(xArgMm2M64),
 	/*9288*/ uint16(xMatch),
-	/*9289*/ uint16(xSetOp), uint16(ADC),
-	/*9291*/ uint16(xReadId),
-	/*9292*/ uint16(xArgRM32),
-	/*9293*/ uint16(xArgImm32),
-	/*9294*/ uint16(xMatch),
-	/*9295*/ uint16(xCondDataSize), 9283, 9289, 9299,
-	/*9299*/ uint16(xSetOp), uint16(ADC),
-	/*9301*/ uint16(xReadId),
-	/*9302*/ uint16(xArgRM64),
-	/*9303*/ uint16(xArgImm32),
-	/*9304*/ uint16(xMatch),
-	/*9305*/ uint16(xCondIs64), 9308, 9324,
-	/*9308*/ uint16(xCondDataSize), 9312, 9318, 0,
-	/*9312*/ uint16(xSetOp), uint16(SBB),
-	/*9314*/ uint16(xReadIw),
-	/*9315*/ uint16(xArgRM16),
-	/*9316*/ uint16(xArgImm16),
-	/*9317*/ uint16(xMatch),
-	/*9318*/ uint16(xSetOp), uint16(SBB),
-	/*9320*/ uint16(xReadId),
-	/*9321*/ uint16(xArgRM32),
-	/*9322*/ uint16(xArgImm32),
-	/*9323*/ uint16(xMatch),
-	/*9324*/ uint16(xCondDataSize), 9312, 9318, 9328,
-	/*9328*/ uint16(xSetOp), uint16(SBB),
-	/*9330*/ uint16(xReadId),
-	/*9331*/ uint16(xArgRM64),
-	/*9332*/ uint16(xArgImm32),
-	/*9333*/ uint16(xMatch),
-	/*9334*/ uint16(xCondIs64), 9337, 9353,
-	/*9337*/ uint16(xCondDataSize), 9341, 9347, 0,
-	/*9341*/ uint16(xSetOp), uint16(AND),
-	/*9343*/ uint16(xReadIw),
-	/*9344*/ uint16(xArgRM16),
-	/*9345*/ uint16(xArgImm16),
-	/*9346*/ uint16(xMatch),
-	/*9347*/ uint16(xSetOp), uint16(AND),
-	/*9349*/ uint16(xReadId),
-	/*9350*/ uint16(xArgRM32),
-	/*9351*/ uint16(xArgImm32),
-	/*9352*/ uint16(xMatch),
-	/*9353*/ uint16(xCondDataSize), 9341, 9347, 9357,
-	/*9357*/ uint16(xSetOp), uint16(AND),
-	/*9359*/ uint16(xReadId),
-	/*9360*/ uint16(xArgRM64),
-	/*9361*/ uint16(xArgImm32),
+	/*9289*/ uint16(xSetOp), uint16(PMINSW),
+	/*9291*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9293*/ uint16(xReadSlashR),
+	/*9294*/ uint16(xArgXmm1),
+	/*9295*/ uint16(xArgXmm2M128),
+	/*9296*/ uint16(xMatch),
+	/*9297*/ uint16(xCondPrefix), 2,
+	0x66, 9311,
+	0x0, 9303,
+	/*9303*/ uint16(xSetOp), uint16(POR),
+	/*9305*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9307*/ uint16(xReadSlashR),
+	/*9308*/ uint16(xArgMm),
+	/*9309*/ uint16(xArgMmM64),
+	/*9310*/ uint16(xMatch),
+	/*9311*/ uint16(xSetOp), uint16(POR),
+	/*9313*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9315*/ uint16(xReadSlashR),
+	/*9316*/ uint16(xArgXmm1),
+	/*9317*/ uint16(xArgXmm2M128),
+	/*9318*/ uint16(xMatch),
+	/*9319*/ uint16(xCondPrefix), 2,
+	0x66, 9333,
+	0x0, 9325,
+	/*9325*/ uint16(xSetOp), uint16(PADDSB),
+	/*9327*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9329*/ uint16(xReadSlashR),
+	/*9330*/ uint16(xArgMm),
+	/*9331*/ uint16(xArgMmM64),
+	/*9332*/ uint16(xMatch),
+	/*9333*/ uint16(xSetOp), uint16(PADDSB),
+	/*9335*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9337*/ uint16(xReadSlashR),
+	/*9338*/ uint16(xArgXmm1),
+	/*9339*/ uint16(xArgXmm2M128),
+	/*9340*/ uint16(xMatch),
+	/*9341*/ uint16(xCondPrefix), 2,
+	0x66, 9355,
+	0x0, 9347,
+	/*9347*/ uint16(xSetOp), uint16(PADDSW),
+	/*9349*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9351*/ uint16(xReadSlashR),
+	/*9352*/ uint16(xArgMm),
+	/*9353*/ uint16(xArgMmM64),
+	/*9354*/ uint16(xMatch),
+	/*9355*/ uint16(xSetOp), uint16(PADDSW),
+	/*9357*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9359*/ uint16(xReadSlashR),
+	/*9360*/ uint16(xArgXmm1),
+	/*9361*/ uint16(xArgXmm2M128),
 	/*9362*/ uint16(xMatch),
-	/*9363*/ uint16(xCondIs64), 9366, 9382,
-	/*9366*/ uint16(xCondDataSize), 9370, 9376, 0,
-	/*9370*/ uint16(xSetOp), uint16(SUB),
-	/*9372*/ uint16(xReadIw),
-	/*9373*/ uint16(xArgRM16),
-	/*9374*/ uint16(xArgImm16),
-	/*9375*/ uint16(xMatch),
-	/*9376*/ uint16(xSetOp), uint16(SUB),
-	/*9378*/ uint16(xReadId),
-	/*9379*/ uint16(xArgRM32),
-	/*9380*/ uint16(xArgImm32),
-	/*9381*/ uint16(xMatch),
-	/*9382*/ uint16(xCondDataSize), 9370, 9376, 9386,
-	/*9386*/ uint16(xSetOp), uint16(SUB),
-	/*9388*/ uint16(xReadId),
-	/*9389*/ uint16(xArgRM64),
-	/*9390*/ uint16(xArgImm32),
-	/*9391*/ uint16(xMatch),
-	/*9392*/ uint16(xCondIs64), 9395, 9411,
-	/*9395*/ uint16(xCondDataSize), 9399, 9405, 0,
-	/*9399*/ uint16(xSetOp), uint16(XOR),
-	/*9401*/ uint16(xReadIw),
-	/*9402*/ uint16(xArgRM16),
-	/*9403*/ uint16(xArgImm16),
-	/*9404*/ uint16(xMatch),
-	/*9405*/ uint16(xSetOp), uint16(XOR),
-	/*9407*/ uint16(xReadId),
-	/*9408*/ uint16(xArgRM32),
-	/*9409*/ uint16(xArgImm32),
-	/*9410*/ uint16(xMatch),
-	/*9411*/ uint16(xCondDataSize), 9399, 9405, 9415,
-	/*9415*/ uint16(xSetOp), uint16(XOR),
-	/*9417*/ uint16(xReadId),
-	/*9418*/ uint16(xArgRM64),
-	/*9419*/ uint16(xArgImm32),
-	/*9420*/ uint16(xMatch),
-	/*9421*/ uint16(xCondIs64), 9424, 9440,
-	/*9424*/ uint16(xCondDataSize), 9428, 9434, 0,
-	/*9428*/ uint16(xSetOp), uint16(CMP),
-	/*9430*/ uint16(xReadIw),
-	/*9431*/ uint16(xArgRM16),
-	/*9432*/ uint16(xArgImm16),
-	/*9433*/ uint16(xMatch),
-	/*9434*/ uint16(xSetOp), uint16(CMP),
-	/*9436*/ uint16(xReadId),
-	/*9437*/ uint16(xArgRM32),
-	/*9438*/ uint16(xArgImm32),
-	/*9439*/ uint16(xMatch),
-	/*9440*/ uint16(xCondDataSize), 9428, 9434, 9444,
-	/*9444*/ uint16(xSetOp), uint16(CMP),
-	/*9446*/ uint16(xReadId),
-	/*9447*/ uint16(xArgRM64),
-	/*9448*/ uint16(xArgImm32),
-	/*9449*/ uint16(xMatch),
-	/*9450*/ uint16(xCondSlashR),
-	9459, // 0
-	9488, // 1
-	9517, // 2
-	9546, // 3
-	9575, // 4
-	9604, // 5
-	9633, // 6
-	9662, // 7
-	/*9459*/ uint16(xCondIs64), 9462, 9478,
-	/*9462*/ uint16(xCondDataSize), 9466, 9472, 0,
-	/*9466*/ uint16(xSetOp), uint16(ADD),
-	/*9468*/ uint16(xReadIb),
-	/*9469*/ uint16(xArgRM16),
-	/*9470*/ uint16(xArgImm8),
-	/*9471*/ uint16(xMatch),
-	/*9472*/ uint16(xSetOp), uint16(ADD),
-	/*9474*/ uint16(xReadIb),
-	/*9475*/ uint16(xArgRM32),
-	/*9476*/ uint16(xArgImm8),
-	/*9477*/ uint16(xMatch),
-	/*9478*/ uint16(xCondDataSize), 9466, 9472, 9482,
-	/*9482*/ uint16(xSetOp), uint16(ADD),
-	/*9484*/ uint16(xReadIb),
-	/*9485*/ uint16(xArgRM64),
-	/*9486*/ uint16(xArgImm8),
-	/*9487*/ uint16(xMatch),
-	/*9488*/ uint16(xCondIs64), 9491, 9507,
-	/*9491*/ uint16(xCondDataSize), 9495, 9501, 0,
-	/*9495*/ uint16(xSetOp), uint16(OR),
-	/*9497*/ uint16(xReadIb),
-	/*9498*/ uint16(xArgRM16),
-	/*9499*/ uint16(xArgImm8),
-	/*9500*/ uint16(xMatch),
-	/*9501*/ uint16(xSetOp), uint16(OR),
-	/*9503*/ uint16(xReadIb),
-	/*9504*/ uint16(xArgRM32),
-	/*9505*/ uint16(xArgImm8),
+	/*9363*/ uint16(xCondPrefix), 2,
+	0x66, 9377,
+	0x0, 9369,
+	/*9369*/ uint16(xSetOp), uint16(PMAXSW),
+	/*9371*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9373*/ uint16(xReadSlashR),
+	/*9374*/ uint16(xArgMm1),
+	/*9375*/ uint16(xArgMm2M64),
+	/*9376*/ uint16(xMatch),
+	/*9377*/ uint16(xSetOp), uint16(PMAXSW),
+	/*9379*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9381*/ uint16(xReadSlashR),
+	/*9382*/ uint16(xArgXmm1),
+	/*9383*/ uint16(xArgXmm2M128),
+	/*9384*/ uint16(xMatch),
+	/*9385*/ uint16(xCondPrefix), 2,
+	0x66, 9399,
+	0x0, 9391,
+	/*9391*/ uint16(xSetOp), uint16(PXOR),
+	/*9393*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9395*/ uint16(xReadSlashR),
+	/*9396*/ uint16(xArgMm),
+	/*9397*/ uint16(xArgMmM64),
+	/*9398*/ uint16(xMatch),
+	/*9399*/ uint16(xSetOp), uint16(PXOR),
+	/*9401*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9403*/ uint16(xReadSlashR),
+	/*9404*/ uint16(xArgXmm1),
+	/*9405*/ uint16(xArgXmm2M128),
+	/*9406*/ uint16(xMatch),
+	/*9407*/ uint16(xCondPrefix), 1,
+	0xF2, 9411,
+	/*9411*/ uint16(xSetOp), uint16(LDDQU),
+	/*9413*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*9415*/ uint16(xReadSlashR),
+	/*9416*/ uint16(xArgXmm1),
+	/*9417*/ uint16(xArgM128),
+	/*9418*/ uint16(xMatch),
+	/*9419*/ uint16(xCondPrefix), 2,
+	0x66, 9433,
+	0x0, 9425,
+	/*9425*/ uint16(xSetOp), uint16(PSLLW),
+	/*9427*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9429*/ uint16(xReadSlashR),
+	/*9430*/ uint16(xArgMm),
+	/*9431*/ uint16(xArgMmM64),
+	/*9432*/ uint16(xMatch),
+	/*9433*/ uint16(xSetOp), uint16(PSLLW),
+	/*9435*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9437*/ uint16(xReadSlashR),
+	/*9438*/ uint16(xArgXmm1),
+	/*9439*/ uint16(xArgXmm2M128),
+	/*9440*/ uint16(xMatch),
+	/*9441*/ uint16(xCondPrefix), 2,
+	0x66, 9455,
+	0x0, 9447,
+	/*9447*/ uint16(xSetOp), uint16(PSLLD),
+	/*9449*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9451*/ uint16(xReadSlashR),
+	/*9452*/ uint16(xArgMm),
+	/*9453*/ uint16(xArgMmM64),
+	/*9454*/ uint16(xMatch),
+	/*9455*/ uint16(xSetOp), uint16(PSLLD),
+	/*9457*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9459*/ uint16(xReadSlashR),
+	/*9460*/ uint16(xArgXmm1),
+	/*9461*/ uint16(xArgXmm2M128),
+	/*9462*/ uint16(xMatch),
+	/*9463*/ uint16(xCondPrefix), 2,
+	0x66, 9477,
+	0x0, 9469,
+	/*9469*/ uint16(xSetOp), uint16(PSLLQ),
+	/*9471*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9473*/ uint16(xReadSlashR),
+	/*9474*/ uint16(xArgMm),
+	/*9475*/ uint16(xArgMmM64),
+	/*9476*/ uint16(xMatch),
+	/*9477*/ uint16(xSetOp), uint16(PSLLQ),
+	/*9479*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9481*/ uint16(xReadSlashR),
+	/*9482*/ uint16(xArgXmm1),
+	/*9483*/ uint16(xArgXmm2M128),
+	/*9484*/ uint16(xMatch),
+	/*9485*/ uint16(xCondPrefix), 2,
+	0x66, 9499,
+	0x0, 9491,
+	/*9491*/ uint16(xSetOp), uint16(PMULUDQ),
+	/*9493*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9495*/ uint16(xReadSlashR),
+	/*9496*/ uint16(xArgMm1),
+	/*9497*/ uint16(xArgMm2M64),
+	/*9498*/ uint16(xMatch),
+	/*9499*/ uint16(xSetOp), uint16(PMULUDQ),
+	/*9501*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9503*/ uint16(xReadSlashR),
+	/*9504*/ uint16(xArgXmm1),
+	/*9505*/ uint16(xArgXmm2M128),
 	/*9506*/ uint16(xMatch),
-	/*9507*/ uint16(xCondDataSize), 9495, 9501, 9511,
-	/*9511*/ uint16(xSetOp), uint16(OR),
-	/*9513*/ uint16(xReadIb),
-	/*9514*/ uint16(xArgRM64),
-	/*9515*/ uint16(xArgImm8),
-	/*9516*/ uint16(xMatch),
-	/*9517*/ uint16(xCondIs64), 9520, 9536,
-	/*9520*/ uint16(xCondDataSize), 9524, 9530, 0,
-	/*9524*/ uint16(xSetOp), uint16(ADC),
-	/*9526*/ uint16(xReadIb),
-	/*9527*/ uint16(xArgRM16),
-	/*9528*/ uint16(xArgImm8),
-	/*9529*/ uint16(xMatch),
-	/*9530*/ uint16(xSetOp), uint16(ADC),
-	/*9532*/ uint16(xReadIb),
-	/*9533*/ uint16(xArgRM32),
-	/*9534*/ uint16(xArgImm8),
-	/*9535*/ uint16(xMatch),
-	/*9536*/ uint16(xCondDataSize), 9524, 9530, 9540,
-	/*9540*/ uint16(xSetOp), uint16(ADC),
-	/*9542*/ uint16(xReadIb),
-	/*9543*/ uint16(xArgRM64),
-	/*9544*/ uint16(xArgImm8),
-	/*9545*/ uint16(xMatch),
-	/*9546*/ uint16(xCondIs64), 9549, 9565,
-	/*9549*/ uint16(xCondDataSize), 9553, 9559, 0,
-	/*9553*/ uint16(xSetOp), uint16(SBB),
-	/*9555*/ uint16(xReadIb),
-	/*9556*/ uint16(xArgRM16),
-	/*9557*/ uint16(xArgImm8),
-	/*9558*/ uint16(xMatch),
-	/*9559*/ uint16(xSetOp), uint16(SBB),
-	/*9561*/ uint16(xReadIb),
-	/*9562*/ uint16(xArgRM32),
-	/*9563*/ uint16(xArgImm8),
+	/*9507*/ uint16(xCondPrefix), 2,
+	0x66, 9521,
+	0x0, 9513,
+	/*9513*/ uint16(xSetOp), uint16(PMADDWD),
+	/*9515*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9517*/ uint16(xReadSlashR),
+	/*9518*/ uint16(xArgMm),
+	/*9519*/ uint16(xArgMmM64),
+	/*9520*/ uint16(xMatch),
+	/*9521*/ uint16(xSetOp), uint16(PMADDWD),
+	/*9523*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9525*/ uint16(xReadSlashR),
+	/*9526*/ uint16(xArgXmm1),
+	/*9527*/ uint16(xArgXmm2M128),
+	/*9528*/ uint16(xMatch),
+	/*9529*/ uint16(xCondPrefix), 2,
+	0x66, 9543,
+	0x0, 9535,
+	/*9535*/ uint16(xSetOp), uint16(PSADBW),
+	/*9537*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9539*/ uint16(xReadSlashR),
+	/*9540*/ uint16(xArgMm1),
+	/*9541*/ uint16(xArgMm2M64),
+	/*9542*/ uint16(xMatch),
+	/*9543*/ uint16(xSetOp), uint16(PSADBW),
+	/*9545*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9547*/ uint16(xReadSlashR),
+	/*9548*/ uint16(xArgXmm1),
+	/*9549*/ uint16(xArgXmm2M128),
+	/*9550*/ uint16(xMatch),
+	/*9551*/ uint16(xCondPrefix), 2,
+	0x66, 9565,
+	0x0, 9557,
+	/*9557*/ uint16(xSetOp), uint16(MASKMOVQ),
+	/*9559*/ uint16(xSetFeature), uint16(FeatureSSE),
+	/*9561*/ uint16(xReadSlashR),
+	/*9562*/ uint16(xArgMm1),
+	/*9563*/ uint16(xArgMm2),
 	/*9564*/ uint16(xMatch),
-	/*9565*/ uint16(xCondDataSize), 9553, 9559, 9569,
-	/*9569*/ uint16(xSetOp), uint16(SBB),
-	/*9571*/ uint16(xReadIb),
-	/*9572*/ uint16(xArgRM64),
-	/*9573*/ uint16(xArgImm8),
-	/*9574*/ uint16(xMatch),
-	/*9575*/ uint16(xCondIs64), 9578, 9594,
-	/*9578*/ uint16(xCondDataSize), 9582, 9588, 0,
-	/*9582*/ uint16(xSetOp), uint16(AND),
-	/*9584*/ uint16(xReadIb),
-	/*9585*/ uint16(xArgRM16),
-	/*9586*/ uint16(xArgImm8),
-	/*9587*/ uint16(xMatch),
-	/*9588*/ uint16(xSetOp), uint16(AND),
-	/*9590*/ uint16(xReadIb),
-	/*9591*/ uint16(xArgRM32),
-	/*9592*/ uint16(xArgImm8),
-	/*9593*/ uint16(xMatch),
-	/*9594*/ uint16(xCondDataSize), 9582, 9588, 9598,
-	/*9598*/ uint16(xSetOp), uint16(AND),
-	/*9600*/ uint16(xReadIb),
-	/*9601*/ uint16(xArgRM64),
-	/*9602*/ uint16(xArgImm8),
-	/*9603*/ uint16(xMatch),
-	/*9604*/ uint16(xCondIs64), 9607, 9623,
-	/*9607*/ uint16(xCondDataSize), 9611, 9617, 0,
-	/*9611*/ uint16(xSetOp), uint16(SUB),
-	/*9613*/ uint16(xReadIb),
-	/*9614*/ uint16(xArgRM16),
-	/*9615*/ uint16(xArgImm8),
+	/*9565*/ uint16(xSetOp), uint16(MASKMOVDQU),
+	/*9567*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9569*/ uint16(xReadSlashR),
+	/*9570*/ uint16(xArgXmm1),
+	/*9571*/ uint16(xArgXmm2),
+	/*9572*/ uint16(xMatch),
+	/*9573*/ uint16(xCondPrefix), 2,
+	0x66, 9587,
+	0x0, 9579,
+	/*9579*/ uint16(xSetOp), uint16(PSUBB),
+	/*9581*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9583*/ uint16(xReadSlashR),
+	/*9584*/ uint16(xArgMm),
+	/*9585*/ uint16(xArgMmM64),
+	/*9586*/ uint16(xMatch),
+	/*9587*/ uint16(xSetOp), uint16(PSUBB),
+	/*9589*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9591*/ uint16(xReadSlashR),
+	/*9592*/ uint16(xArgXmm1),
+	/*9593*/ uint16(xArgXmm2M128),
+	/*9594*/ uint16(xMatch),
+	/*9595*/ uint16(xCondPrefix), 2,
+	0x66, 9609,
+	0x0, 9601,
+	/*9601*/ uint16(xSetOp), uint16(PSUBW),
+	/*9603*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9605*/ uint16(xReadSlashR),
+	/*9606*/ uint16(xArgMm),
+	/*9607*/ uint16(xArgMmM64),
+	/*9608*/ uint16(xMatch),
+	/*9609*/ uint16(xSetOp), uint16(PSUBW),
+	/*9611*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9613*/ uint16(xReadSlashR),
+	/*9614*/ uint16(xArgXmm1),
+	/*9615*/ uint16(xArgXmm2M128),
 	/*9616*/ uint16(xMatch),
-	/*9617*/ uint16(xSetOp), uint16(SUB),
-	/*9619*/ uint16(xReadIb),
-	/*9620*/ uint16(xArgRM32),
-	/*9621*/ uint16(xArgImm8),
-	/*9622*/ uint16(xMatch),
-	/*9623*/ uint16(xCondDataSize), 9611, 9617, 9627,
-	/*9627*/ uint16(xSetOp), uint16(SUB),
-	/*9629*/ uint16(xReadIb),
-	/*9630*/ uint16(xArgRM64),
-	/*9631*/ uint16(xArgImm8),
-	/*9632*/ uint16(xMatch),
-	/*9633*/ uint16(xCondIs64), 9636, 9652,
-	/*9636*/ uint16(xCondDataSize), 9640, 9646, 0,
-	/*9640*/ uint16(xSetOp), uint16(XOR),
-	/*9642*/ uint16(xReadIb),
-	/*9643*/ uint16(xArgRM16),
-	/*9644*/ uint16(xArgImm8),
-	/*9645*/ uint16(xMatch),
-	/*9646*/ uint16(xSetOp), uint16(XOR),
-	/*9648*/ uint16(xReadIb),
-	/*9649*/ uint16(xArgRM32),
-	/*9650*/ uint16(xArgImm8),
-	/*9651*/ uint16(xMatch),
-	/*9652*/ uint16(xCondDataSize), 9640, 9646, 9656,
-	/*9656*/ uint16(xSetOp), uint16(XOR),
-	/*9658*/ uint16(xReadIb),
-	/*9659*/ uint16(xArgRM64),
-	/*9660*/ uint16(xArgImm8),
-	/*9661*/ uint16(xMatch),
-	/*9662*/ uint16(xCondIs64), 9665, 9681,
-	/*9665*/ uint16(xCondDataSize), 9669, 9675, 0,
-	/*9669*/ uint16(xSetOp), uint16(CMP),
-	/*9671*/ uint16(xReadIb),
-	/*9672*/ uint16(xArgRM16),
-	/*9673*/ uint16(xArgImm8),
+	/*9617*/ uint16(xCondPrefix), 2,
+	0x66, 9631,
+	0x0, 9623,
+	/*9623*/ uint16(xSetOp), uint16(PSUBD),
+	/*9625*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9627*/ uint16(xReadSlashR),
+	/*9628*/ uint16(xArgMm),
+	/*9629*/ uint16(xArgMmM64),
+	/*9630*/ uint16(xMatch),
+	/*9631*/ uint16(xSetOp), uint16(PSUBD),
+	/*9633*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9635*/ uint16(xReadSlashR),
+	/*9636*/ uint16(xArgXmm1),
+	/*9637*/ uint16(xArgXmm2M128),
+	/*9638*/ uint16(xMatch),
+	/*9639*/ uint16(xCondPrefix), 2,
+	0x66, 9653,
+	0x0, 9645,
+	/*9645*/ uint16(xSetOp), uint16(PSUBQ),
+	/*9647*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9649*/ uint16(xReadSlashR),
+	/*9650*/ uint16(xArgMm1),
+	/*9651*/ uint16(xArgMm2M64),
+	/*9652*/ uint16(xMatch),
+	/*9653*/ uint16(xSetOp), uint16(PSUBQ),
+	/*9655*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9657*/ uint16(xReadSlashR),
+	/*9658*/ uint16(xArgXmm1),
+	/*9659*/ uint16(xArgXmm2M128),
+	/*9660*/ uint16(xMatch),
+	/*9661*/ uint16(xCondPrefix), 2,
+	0x66, 9675,
+	0x0, 9667,
+	/*9667*/ uint16(xSetOp), uint16(PADDB),
+	/*9669*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9671*/ uint16(xReadSlashR),
+	/*9672*/ uint16(xArgMm),
+	/*9673*/ uint16(xArgMmM64),
 	/*9674*/ uint16(xMatch),
-	/*9675*/ uint16(xSetOp), uint16(CMP),
-	/*9677*/ uint16(xReadIb),
-	/*9678*/ uint16(xArgRM32),
-	/*9679*/ uint16(xArgImm8),
-	/*9680*/ uint16(xMatch),
-	/*9681*/ uint16(xCondDataSize), 9669, 9675, 9685,
-	/*9685*/ uint16(xSetOp), uint16(CMP),
-	/*9687*/ uint16(xReadIb),
-	/*9688*/ uint16(xArgRM64),
-	/*9689*/ uint16(xArgImm8),
-	/*9690*/ uint16(xMatch),
-	/*9691*/ uint16(xSetOp), uint16(TEST),
+	/*9675*/ uint16(xSetOp), uint16(PADDB),
+	/*9677*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9679*/ uint16(xReadSlashR),
+	/*9680*/ uint16(xArgXmm1),
+	/*9681*/ uint16(xArgXmm2M128),
+	/*9682*/ uint16(xMatch),
+	/*9683*/ uint16(xCondPrefix), 2,
+	0x66, 9697,
+	0x0, 9689,
+	/*9689*/ uint16(xSetOp), uint16(PADDW),
+	/*9691*/ uint16(xSetFeature), uint16(FeatureMMX),
 	/*9693*/ uint16(xReadSlashR),
-	/*9694*/ uint16(xArgRM8),
-	/*9695*/ uint16(xArgR8),
+	/*9694*/ uint16(xArgMm),
+	/*9695*/ uint16(xArgMmM64),
 	/*9696*/ uint16(xMatch),
-	/*9697*/ uint16(xCondIs64), 9700, 9716,
-	/*9700*/ uint16(xCondDataSize), 9704, 9710, 0,
-	/*9704*/ uint16(xSetOp), uint16(TEST),
-	/*9706*/ uint16(xReadSlashR),
-	/*9707*/ uint16(xArgRM16),
-	/*9708*/ uint16(xArgR16),
-	/*9709*/ uint16(xMatch),
-	/*9710*/ uint16(xSetOp), uint16(TEST),
-	/*9712*/ uint16(xReadSlashR),
-	/*9713*/ uint16(xArgRM32),
-	/*9714*/ uint16(xArgR32),
-	/*9715*/ uint16(xMatch),
-	/*9716*/ uint16(xCondDataSize), 9704, 9710, 9720,
-	/*9720*/ uint16(xSetOp), uint16(TEST),
-	/*9722*/ uint16(xReadSlashR),
-	/*9723*/ uint16(xArgRM64),
-	/*9724*/ uint16(xArgR64),
-	/*9725*/ uint16(xMatch),
-	/*9726*/ uint16(xSetOp), uint16(XCHG),
-	/*9728*/ uint16(xReadSlashR),
-	/*9729*/ uint16(xArgRM8),
-	/*9730*/ uint16(xArgR8),
-	/*9731*/ uint16(xMatch),
-	/*9732*/ uint16(xCondIs64), 9735, 9751,
-	/*9735*/ uint16(xCondDataSize), 9739, 9745, 0,
-	/*9739*/ uint16(xSetOp), uint16(XCHG),
-	/*9741*/ uint16(xReadSlashR),
-	/*9742*/ uint16(xArgRM16),
-	/*9743*/ uint16(xArgR16),
-	/*9744*/ uint16(xMatch),
-	/*9745*/ uint16(xSetOp), uint16(XCHG),
-	/*9747*/ uint16(xReadSlashR),
-	/*9748*/ uint16(xArgRM32),
-	/*9749*/ uint16(xArgR32),
-	/*9750*/ uint16(xMatch),
-	/*9751*/ uint16(xCondDataSize), 9739, 9745, 9755,
-	/*9755*/ uint16(xSetOp), uint16(XCHG),
-	/*9757*/ uint16(xReadSlashR),
-	/*9758*/ uint16(xArgRM64),
-	/*9759*/ uint16(xArgR64),
-	/*9760*/ uint16(xMatch),
-	/*9761*/ uint16(xSetOp), uint16(MOV),
-	/*9763*/ uint16(xReadSlashR),
-	/*9764*/ uint16(xArgRM8),
-	/*9765*/ uint16(xArgR8),
-	/*9766*/ uint16(xMatch),
-	/*9767*/ uint16(xCondDataSize), 9771, 9777, 9783,
-	/*9771*/ uint16(xSetOp), uint16(MOV),
-	/*9773*/ uint16(xReadSlashR),
-	/*9774*/ uint16(xArgRM16),
-	/*9775*/ uint16(xArgR16),
-	/*9776*/ uint16(xMatch),
-	/*9777*/ uint16(xSetOp), uint16(MOV),
-	/*9779*/ uint16(xReadSlashR),
-	/*9780*/ uint16(xArgRM32),
-	/*9781*/ uint16(xArgR32),
-	/*9782*/ uint16(xMatch),
-	/*9783*/ uint16(xSetOp), uint16(MOV),
-	/*9785*/ uint16(xReadSlashR),
-	/*9786*/ uint16(xArgRM64),
-	/*9787*/ uint16(xArgR64),
-	/*9788*/ uint16(xMatch),
-	/*9789*/ uint16(xSetOp), uint16(MOV),
-	/*9791*/ uint16(xReadSlashR),
-	/*9792*/ uint16(xArgR8),
-	/*9793*/ uint16(xArgRM8),
-	/*9794*/ uint16(xMatch),
-	/*9795*/ uint16(xCondDataSize), 9799, 9805, 9811,
-	/*9799*/ uint16(xSetOp), uint16(MOV),
-	/*9801*/ uint16(xReadSlashR),
-	/*9802*/ uint16(xArgR16),
-	/*9803*/ uint16(xArgRM16),
-	/*9804*/ uint16(xMatch),
-	/*9805*/ uint16(xSetOp), uint16(MOV),
-	/*9807*/ uint16(xReadSlashR),
-	/*9808*/ uint16(xArgR32),
-	/*9809*/ uint16(xArgRM32),
-	/*9810*/ uint16(xMatch),
-	/*9811*/ uint16(xSetOp), uint16(MOV),
-	/*9813*/ uint16(xReadSlashR),
-	/*9814*/ uint16(xArgR64),
-	/*9815*/ uint16(xArgRM64),
-	/*9816*/ uint16(xMatch),
-	/*9817*/ uint16(xCondIs64), 9820, 9836,
-	/*9820*/ uint16(xCondDataSize), 9824, 9830, 0,
-	/*9824*/ uint16(xSetOp), uint16(MOV),
-	/*9826*/ uint16(xReadSlashR),
-	/*9827*/ uint16(xArgRM16),
-	/*9828*/ uint16(xArgSreg),
-	/*9829*/ uint16(xMatch),
-	/*9830*/ uint16(xSetOp), uint16(MOV),
-	/*9832*/ uint16(xReadSlashR),
-	/*9833*/ uint16(xArgR32M16),
-	/*9834*/ uint16(xArgSreg),
-	/*9835*/ uint16(xMatch),
-	/*9836*/ uint16(xCondDataSize), 9824, 9830, 9840,
-	/*9840*/ uint16(xSetOp), uint16(MOV),
-	/*9842*/ uint16(xReadSlashR),
-	/*9843*/ uint16(xArgR64M16),
-	/*9844*/ uint16(xArgSreg),
-	/*9845*/ uint16(xMatch),
-	/*9846*/ uint16(xCondIs64), 9849, 9865,
-	/*9849*/ uint16(xCondDataSize), 9853, 9859, 0,
-	/*9853*/ uint16(xSetOp), uint16(LEA),
-	/*9855*/ uint16(xReadSlashR),
-	/*9856*/ uint16(xArgR16),
-	/*9857*/ uint16(xArgM),
-	/*9858*/ uint16(xMatch),
-	/*9859*/ uint16(xSetOp), uint16(LEA),
-	/*9861*/ uint16(xReadSlashR),
-	/*9862*/ uint16(xArgR32),
-	/*9863*/ uint16(xArgM),
-	/*9864*/ uint16(xMatch),
-	/*9865*/ uint16(xCondDataSize), 9853, 9859, 9869,
-	/*9869*/ uint16(xSetOp), uint16(LEA),
+	/*9697*/ uint16(xSetOp), uint16(PADDW),
+	/*9699*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9701*/ uint16(xReadSlashR),
+	/*9702*/ uint16(xArgXmm1),
+	/*9703*/ uint16(xArgXmm2M128),
+	/*9704*/ uint16(xMatch),
+	/*9705*/ uint16(xCondPrefix), 2,
+	0x66, 9719,
+	0x0, 9711,
+	/*9711*/ uint16(xSetOp), uint16(PADDD),
+	/*9713*/ uint16(xSetFeature), uint16(FeatureMMX),
+	/*9715*/ uint16(xReadSlashR),
+	/*9716*/ uint16(xArgMm),
+	/*9717*/ uint16(xArgMmM64),
+	/*9718*/ uint16(xMatch),
+	/*9719*/ uint16(xSetOp), uint16(PADDD),
+	/*9721*/ uint16(xSetFeature), uint16(FeatureSSE2),
+	/*9723*/ uint16(xReadSlashR),
+	/*9724*/ uint16(xArgXmm1),
+	/*9725*/ uint16(xArgXmm2M128),
+	/*9726*/ uint16(xMatch),
+	/*9727*/ uint16(xCondDataSize), 0, 9731, 0,
+	/*9731*/ uint16(xSetOp), uint16(UD0),
+	/*9733*/ uint16(xReadSlashR),
+	/*9734*/ uint16(xArgR32),
+	/*9735*/ uint16(xArgRM32),
+	/*9736*/ uint16(xMatch),
+	/*9737*/ uint16(xSetOp), uint16(ADC),
+	/*9739*/ uint16(xReadSlashR),
+	/*9740*/ uint16(xArgRM8),
+	/*9741*/ uint16(xArgR8),
+	/*9742*/ uint16(xMatch),
+	/*9743*/ uint16(xCondIs64), 9746, 9762,
+	/*9746*/ uint16(xCondDataSize), 9750, 9756, 0,
+	/*9750*/ uint16(xSetOp), uint16(ADC),
+	/*9752*/ uint16(xReadSlashR),
+	/*9753*/ uint16(xArgRM16),
+	/*9754*/ uint16(xArgR16),
+	/*9755*/ uint16(xMatch),
+	/*9756*/ uint16(xSetOp), uint16(ADC),
+	/*9758*/ uint16(xReadSlashR),
+	/*9759*/ uint16(xArgRM32),
+	/*9760*/ uint16(xArgR32),
+	/*9761*/ uint16(xMatch),
+	/*9762*/ uint16(xCondDataSize), 9750, 9756, 9766,
+	/*9766*/ uint16(xSetOp), uint16(ADC),
+	/*9768*/ uint16(xReadSlashR),
+	/*9769*/ uint16(xArgRM64),
+	/*9770*/ uint16(xArgR64),
+	/*9771*/ uint16(xMatch),
+	/*9772*/ uint16(xSetOp), uint16(ADC),
+	/*9774*/ uint16(xReadSlashR),
+	/*9775*/ uint16(xArgR8),
+	/*9776*/ uint16(xArgRM8),
+	/*9777*/ uint16(xMatch),
+	/*9778*/ uint16(xCondIs64), 9781, 9797,
+	/*9781*/ uint16(xCondDataSize), 9785, 9791, 0,
+	/*9785*/ uint16(xSetOp), uint16(ADC),
+	/*9787*/ uint16(xReadSlashR),
+	/*9788*/ uint16(xArgR16),
+	/*9789*/ uint16(xArgRM16),
+	/*9790*/ uint16(xMatch),
+	/*9791*/ uint16(xSetOp), uint16(ADC),
+	/*9793*/ uint16(xReadSlashR),
+	/*9794*/ uint16(xArgR32),
+	/*9795*/ uint16(xArgRM32),
+	/*9796*/ uint16(xMatch),
+	/*9797*/ uint16(xCondDataSize), 9785, 9791, 9801,
+	/*9801*/ uint16(xSetOp), uint16(ADC),
+	/*9803*/ uint16(xReadSlashR),
+	/*9804*/ uint16(xArgR64),
+	/*9805*/ uint16(xArgRM64),
+	/*9806*/ uint16(xMatch),
+	/*9807*/ uint16(xSetOp), uint16(ADC),
+	/*9809*/ uint16(xReadIb),
+	/*9810*/ uint16(xArgAL),
+	/*9811*/ uint16(xArgImm8u),
+	/*9812*/ uint16(xMatch),
+	/*9813*/ uint16(xCondIs64), 9816, 9832,
+	/*9816*/ uint16(xCondDataSize), 9820, 9826, 0,
+	/*9820*/ uint16(xSetOp), uint16(ADC),
+	/*9822*/ uint16(xReadIw),
+	/*9823*/ uint16(xArgAX),
+	/*9824*/ uint16(xArgImm16),
+	/*9825*/ uint16(xMatch),
+	/*9826*/ uint16(xSetOp), uint16(ADC),
+	/*9828*/ uint16(xReadId),
+	/*9829*/ uint16(xArgEAX),
+	/*9830*/ uint16(xArgImm32),
+	/*9831*/ uint16(xMatch),
+	/*9832*/ uint16(xCondDataSize), 9820, 9826, 9836,
+	/*9836*/ uint16(xSetOp), uint16(ADC),
+	/*9838*/ uint16(xReadId),
+	/*9839*/ uint16(xArgRAX),
+	/*9840*/ uint16(xArgImm32),
+	/*9841*/ uint16(xMatch),
+	/*9842*/ uint16(xCondIs64), 9845, 0,
+	/*9845*/ uint16(xSetOp), uint16(PUSH),
+	/*9847*/ uint16(xArgSS),
+	/*9848*/ uint16(xMatch),
+	/*9849*/ uint16(xCondIs64), 9852, 0,
+	/*9852*/ uint16(xSetOp), uint16(POP),
+	/*9854*/ uint16(xArgSS),
+	/*9855*/ uint16(xMatch),
+	/*9856*/ uint16(xSetOp), uint16(SBB),
+	/*9858*/ uint16(xReadSlashR),
+	/*9859*/ uint16(xArgRM8),
+	/*9860*/ uint16(xArgR8),
+	/*9861*/ uint16(xMatch),
+	/*9862*/ uint16(xCondIs64), 9865, 9881,
+	/*9865*/ uint16(xCondDataSize), 9869, 9875, 0,
+	/*9869*/ uint16(xSetOp), uint16(SBB),
 	/*9871*/ uint16(xReadSlashR),
-	/*9872*/ uint16(xArgR64),
-	/*9873*/ uint16(xArgM),
+	/*9872*/ uint16(xArgRM16),
+	/*9873*/ uint16(xArgR16),
 	/*9874*/ uint16(xMatch),
-	/*9875*/ uint16(xCondIs64), 9878, 9894,
-	/*9878*/ uint16(xCondDataSize), 9882, 9888, 0,
-	/*9882*/ uint16(xSetOp), uint16(MOV),
-	/*9884*/ uint16(xReadSlashR),
-	/*9885*/ uint16(xArgSreg),
-	/*9886*/ uint16(xArgRM16),
-	/*9887*/ uint16(xMatch),
-	/*9888*/ uint16(xSetOp), uint16(MOV),
-	/*9890*/ uint16(xReadSlashR),
-	/*9891*/ uint16(xArgSreg),
-	/*9892*/ uint16(xArgR32M16),
-	/*9893*/ uint16(xMatch),
-	/*9894*/ uint16(xCondDataSize), 9882, 9888, 9898,
-	/*9898*/ uint16(xSetOp), uint16(MOV),
-	/*9900*/ uint16(xReadSlashR),
-	/*9901*/ uint16(xArgSreg),
-	/*9902*/ uint16(xArgR64M16),
-	/*9903*/ uint16(xMatch),
-	/*9904*/ uint16(xCondSlashR),
-	9913, // 0
-	0,    // 1
-	0,    // 2
-	0,    // 3
-	0,    // 4
-	0,    // 5
-	0,    // 6
-	0,    // 7
-	/*9913*/ uint16(xCondIs64), 9916, 9928,
-	/*9916*/ uint16(xCondDataSize), 9920, 9924, 0,
-	/*9920*/ uint16(xSetOp), uint16(POP),
-	/*9922*/ uint16(xArgRM16),
-	/*9923*/ uint16(xMatch),
-	/*9924*/ uint16(xSetOp), uint16(POP),
-	/*9926*/ uint16(xArgRM32),
-	/*9927*/ uint16(xMatch),
-	/*9928*/ uint16(xCondDataSize), 9920, 9932, 9936,
-	/*9932*/ uint16(xSetOp), uint16(POP),
-	/*9934*/ uint16(xArgRM64),
-	/*9935*/ uint16(xMatch),
-	/*9936*/ uint16(xSetOp), uint16(POP),
-	/*9938*/ uint16(xArgRM64),
-	/*9939*/ uint16(xMatch),
-	/*9940*/ uint16(xCondIs64), 9943, 9957,
-	/*9943*/ uint16(xCondDataSize), 9947, 9952, 0,
-	/*9947*/ uint16(xSetOp), uint16(XCHG),
-	/*9949*/ uint16(xArgR16op),
-	/*9950*/ uint16(xArgAX),
-	/*9951*/ uint16(xMatch),
-	/*9952*/ uint16(xSetOp), uint16(XCHG),
-	/*9954*/ uint16(xArgR32op),
-	/*9955*/ uint16(xArgEAX),
-	/*9956*/ uint16(xMatch),
-	/*9957*/ uint16(xCondDataSize), 9947, 9952, 9961,
-	/*9961*/ uint16(xSetOp), uint16(XCHG),
-	/*9963*/ uint16(xArgR64op),
-	/*9964*/ uint16(xArgRAX),
-	/*9965*/ uint16(xMatch),
-	/*9966*/ uint16(xCondIs64), 9969, 9979,
-	/*9969*/ uint16(xCondDataSize), 9973, 9976, 0,
-	/*9973*/ uint16(xSetOp), uint16(CBW),
-	/*9975*/ uint16(xMatch),
-	/*9976*/ uint16(xSetOp), uint16(CWDE),
-	/*9978*/ uint16(xMatch),
-	/*9979*/ uint16(xCondDataSize), 9973, 9976, 9983,
-	/*9983*/ uint16(xSetOp), uint16(CDQE),
-	/*9985*/ uint16(xMatch),
-	/*9986*/ uint16(xCondIs64), 9989, 9999,
-	/*9989*/ uint16(xCondDataSize), 9993, 9996, 0,
-	/*9993*/ uint16(xSetOp), uint16(CWD),
-	/*9995*/ uint16(xMatch),
-	/*9996*/ uint16(xSetOp), uint16(CDQ),
-	/*9998*/ uint16(xMatch),
-	/*9999*/ uint16(xCondDataSize), 9993, 9996, 10003,
-	/*10003*/ uint16(xSetOp), uint16(CQO),
-	/*10005*/ uint16(xMatch),
-	/*10006*/ uint16(xCondIs64), 10009, 0,
-	/*10009*/ uint16(xCondDataSize), 10013, 10018, 0,
-	/*10013*/ uint16(xSetOp), uint16(LCALL),
-	/*10015*/ uint16(xReadCd),
-	/*10016*/ uint16(xArgPtr16colon16),
-	/*10017*/ uint16(xMatch),
-	/*10018*/ uint16(xSetOp), uint16(LCALL),
-	/*10020*/ uint16(xReadCp),
-	/*10021*/ uint16(xArgPtr16colon32),
-	/*10022*/ uint16(xMatch),
-	/*10023*/ uint16(xSetOp), uint16(FWAIT),
-	/*10025*/ uint16(xMatch),
-	/*10026*/ uint16(xCondIs64), 10029, 10039,
-	/*10029*/ uint16(xCondDataSize), 10033, 10036, 0,
-	/*10033*/ uint16(xSetOp), uint16(PUSHF),
-	/*10035*/ uint16(xMatch),
-	/*10036*/ uint16(xSetOp), uint16(PUSHFD),
-	/*10038*/ uint16(xMatch),
-	/*10039*/ uint16(xCondDataSize), 10033, 10043, 10046,
-	/*10043*/ uint16(xSetOp), uint16(PUSHFQ),
-	/*10045*/ uint16(xMatch),
-	/*10046*/ uint16(xSetOp), uint16(PUSHFQ),
-	/*10048*/ uint16(xMatch),
-	/*10049*/ uint16(xCondIs64), 10052, 10062,
-	/*10052*/ uint16(xCondDataSize), 10056, 10059, 0,
-	/*10056*/ uint16(xSetOp), uint16(POPF),
-	/*10058*/ uint16(xMatch),
-	/*10059*/ uint16(xSetOp), uint16(POPFD),
-	/*10061*/ uint16(xMatch),
-	/*10062*/ uint16(xCondDataSize), 10056, 10066, 10069,
-	/*10066*/ uint16(xSetOp), uint16(POPFQ),
-	/*10068*/ uint16(xMatch),
-	/*10069*/ uint16(xSetOp), uint16(POPFQ),
-	/*10071*/ uint16(xMatch),
-	/*10072*/ uint16(xSetOp), uint16(SAHF),
-	/*10074*/ uint16(xMatch),
-	/*10075*/ uint16(xSetOp), uint16(LAHF),
-	/*10077*/ uint16(xMatch),
-	/*10078*/ uint16(xCondIs64), 10081, 10087,
-	/*10081*/ uint16(xSetOp), uint16(MOV),
-	/*10083*/ uint16(xReadCm),
-	/*10084*/ uint16(xArgAL),
-	/*10085*/ uint16(xArgMoffs8),
-	/*10086*/ uint16(xMatch),
-	/*10087*/ uint16(xCondDataSize), 10081, 10081, 10091,
-	/*10091*/ uint16(xSetOp), uint16(MOV),
-	/*10093*/ uint16(xReadCm),
-	/*10094*/ uint16(xArgAL),
-	/*10095*/ uint16(xArgMoffs8),
-	/*10096*/ uint16(xMatch),
-	/*10097*/ uint16(xCondDataSize), 10101, 10107, 10113,
-	/*10101*/ uint16(xSetOp), uint16(MOV),
-	/*10103*/ uint16(xReadCm),
-	/*10104*/ uint16(xArgAX),
-	/*10105*/ uint16(xArgMoffs16),
-	/*10106*/ uint16(xMatch),
-	/*10107*/ uint16(xSetOp), uint16(MOV),
-	/*10109*/ uint16(xReadCm),
-	/*10110*/ uint16(xArgEAX),
-	/*10111*/ uint16(xArgMoffs32),
-	/*10112*/ uint16(xMatch),
-	/*10113*/ uint16(xSetOp), uint16(MOV),
-	/*10115*/ uint16(xReadCm),
-	/*10116*/ uint16(xArgRAX),
-	/*10117*/ uint16(xArgMoffs64),
-	/*10118*/ uint16(xMatch),
-	/*10119*/ uint16(xCondIs64), 10122, 10128,
-	/*10122*/ uint16(xSetOp), uint16(MOV),
-	/*10124*/ uint16(xReadCm),
-	/*10125*/ uint16(xArgMoffs8),
-	/*10126*/ uint16(xArgAL),
-	/*10127*/ uint16(xMatch),
-	/*10128*/ uint16(xCondDataSize), 10122, 10122, 10132,
-	/*10132*/ uint16(xSetOp), uint16(MOV),
-	/*10134*/ uint16(xReadCm),
-	/*10135*/ uint16(xArgMoffs8),
-	/*10136*/ uint16(xArgAL),
-	/*10137*/ uint16(xMatch),
-	/*10138*/ uint16(xCondDataSize), 10142, 10148, 10154,
-	/*10142*/ uint16(xSetOp), uint16(MOV),
-	/*10144*/ uint16(xReadCm),
-	/*10145*/ uint16(xArgMoffs16),
-	/*10146*/ uint16(xArgAX),
-	/*10147*/ uint16(xMatch),
-	/*10148*/ uint16(xSetOp), uint16(MOV),
-	/*10150*/ uint16(xReadCm),
-	/*10151*/ uint16(xArgMoffs32),
-	/*10152*/ uint16(xArgEAX),
-	/*10153*/ uint16(xMatch),
-	/*10154*/ uint16(xSetOp), uint16(MOV),
-	/*10156*/ uint16(xReadCm),
-	/*10157*/ uint16(xArgMoffs64),
-	/*10158*/ uint16(xArgRAX),
-	/*10159*/ uint16(xMatch),
-	/*10160*/ uint16(xSetOp), uint16(MOVSB),
-	/*10162*/ uint16(xMatch),
-	/*10163*/ uint16(xCondIs64), 10166, 10176,
-	/*10166*/ uint16(xCondDataSize), 10170, 10173, 0,
-	/*10170*/ uint16(xSetOp), uint16(MOVSW),
-	/*10172*/ uint16(xMatch),
-	/*10173*/ uint16(xSetOp), uint16(MOVSD),
-	/*10175*/ uint16(xMatch),
-	/*10176*/ uint16(xCondDataSize), 10170, 10173, 10180,
-	/*10180*/ uint16(xSetOp), uint16(MOVSQ),
-	/*10182*/ uint16(xMatch),
-	/*10183*/ uint16(xSetOp), uint16(CMPSB),
-	/*10185*/ uint16(xMatch),
-	/*10186*/ uint16(xCondIs64), 10189, 10199,
-	/*10189*/ uint16(xCondDataSize), 10193, 10196, 0,
-	/*10193*/ uint16(xSetOp), uint16(CMPSW),
-	/*10195*/ uint16(xMatch),
-	/*10196*/ uint16(xSetOp), uint16(CMPSD),
-	/*10198*/ uint16(xMatch),
-	/*10199*/ uint16(xCondDataSize), 10193, 10196, 10203,
-	/*10203*/ uint16(xSetOp), uint16(CMPSQ),
-	/*10205*/ uint16(xMatch),
-	/*10206*/ uint16(xSetOp), uint16(TEST),
-	/*10208*/ uint16(xReadIb),
-	/*10209*/ uint16(xArgAL),
-	/*10210*/ uint16(xArgImm8u),
-	/*10211*/ uint16(xMatch),
-	/*10212*/ uint16(xCondIs64), 10215, 10231,
-	/*10215*/ uint16(xCondDataSize), 10219, 10225, 0,
-	/*10219*/ uint16(xSetOp), uint16(TEST),
-	/*10221*/ uint16(xReadIw),
-	/*10222*/ uint16(xArgAX),
-	/*10223*/ uint16(xArgImm16),
-	/*10224*/ uint16(xMatch),
-	/*10225*/ uint16(xSetOp), uint16(TEST),
-	/*10227*/ uint16(xReadId),
-	/*10228*/ uint16(xArgEAX),
-	/*10229*/ uint16(xArgImm32),
-	/*10230*/ uint16(xMatch),
-	/*10231*/ uint16(xCondDataSize), 10219, 10225, 10235,
-	/*10235*/ uint16(xSetOp), uint16(TEST),
-	/*10237*/ uint16(xReadId),
-	/*10238*/ uint16(xArgRAX),
-	/*10239*/ uint16(xArgImm32),
-	/*10240*/ uint16(xMatch),
-	/*10241*/ uint16(xSetOp), uint16(STOSB),
-	/*10243*/ uint16(xMatch),
-	/*10244*/ uint16(xCondIs64), 10247, 10257,
-	/*10247*/ uint16(xCondDataSize), 10251, 10254, 0,
-	/*10251*/ uint16(xSetOp), uint16(STOSW),
-	/*10253*/ uint16(xMatch),
-	/*10254*/ uint16(xSetOp), uint16(STOSD),
+	/*9875*/ uint16(xSetOp), uint16(SBB),
+	/*9877*/ uint16(xReadSlashR),
+	/*9878*/ uint16(xArgRM32),
+	/*9879*/ uint16(xArgR32),
+	/*9880*/ uint16(xMatch),
+	/*9881*/ uint16(xCondDataSize), 9869, 9875, 9885,
+	/*9885*/ uint16(xSetOp), uint16(SBB),
+	/*9887*/ uint16(xReadSlashR),
+	/*9888*/ uint16(xArgRM64),
+	/*9889*/ uint16(xArgR64),
+	/*9890*/ uint16(xMatch),
+	/*9891*/ uint16(xSetOp), uint16(SBB),
+	/*9893*/ uint16(xReadSlashR),
+	/*9894*/ uint16(xArgR8),
+	/*9895*/ uint16(xArgRM8),
+	/*9896*/ uint16(xMatch),
+	/*9897*/ uint16(xCondIs64), 9900, 9916,
+	/*9900*/ uint16(xCondDataSize), 9904, 9910, 0,
+	/*9904*/ uint16(xSetOp), uint16(SBB),
+	/*9906*/ uint16(xReadSlashR),
+	/*9907*/ uint16(xArgR16),
+	/*9908*/ uint16(xArgRM16),
+	/*9909*/ uint16(xMatch),
+	/*9910*/ uint16(xSetOp), uint16(SBB),
+	/*9912*/ uint16(xReadSlashR),
+	/*9913*/ uint16(xArgR32),
+	/*9914*/ uint16(xArgRM32),
+	/*9915*/ uint16(xMatch),
+	/*9916*/ uint16(xCondDataSize), 9904, 9910, 9920,
+	/*9920*/ uint16(xSetOp), uint16(SBB),
+	/*9922*/ uint16(xReadSlashR),
+	/*9923*/ uint16(xArgR64),
+	/*9924*/ uint16(xArgRM64),
+	/*9925*/ uint16(xMatch),
+	/*9926*/ uint16(xSetOp), uint16(SBB),
+	/*9928*/ uint16(xReadIb),
+	/*9929*/ uint16(xArgAL),
+	/*9930*/ uint16(xArgImm8u),
+	/*9931*/ uint16(xMatch),
+	/*9932*/ uint16(xCondIs64), 9935, 9951,
+	/*9935*/ uint16(xCondDataSize), 9939, 9945, 0,
+	/*9939*/ uint16(xSetOp), uint16(SBB),
+	/*9941*/ uint16(xReadIw),
+	/*9942*/ uint16(xArgAX),
+	/*9943*/ uint16(xArgImm16),
+	/*9944*/ uint16(xMatch),
+	/*9945*/ uint16(xSetOp), uint16(SBB),
+	/*9947*/ uint16(xReadId),
+	/*9948*/ uint16(xArgEAX),
+	/*9949*/ uint16(xArgImm32),
+	/*9950*/ uint16(xMatch),
+	/*9951*/ uint16(xCondDataSize), 9939, 9945, 9955,
+	/*9955*/ uint16(xSetOp), uint16(SBB),
+	/*9957*/ uint16(xReadId),
+	/*9958*/ uint16(xArgRAX),
+	/*9959*/ uint16(xArgImm32),
+	/*9960*/ uint16(xMatch),
+	/*9961*/ uint16(xCondIs64), 9964, 0,
+	/*9964*/ uint16(xSetOp), uint16(PUSH),
+	/*9966*/ uint16(xArgDS),
+	/*9967*/ uint16(xMatch),
+	/*9968*/ uint16(xCondIs64), 9971, 0,
+	/*9971*/ uint16(xSetOp), uint16(POP),
+	/*9973*/ uint16(xArgDS),
+	/*9974*/ uint16(xMatch),
+	/*9975*/ uint16(xSetOp), uint16(AND),
+	/*9977*/ uint16(xReadSlashR),
+	/*9978*/ uint16(xArgRM8),
+	/*9979*/ uint16(xArgR8),
+	/*9980*/ uint16(xMatch),
+	/*9981*/ uint16(xCondIs64), 9984, 10000,
+	/*9984*/ uint16(xCondDataSize), 9988, 9994, 0,
+	/*9988*/ uint16(xSetOp), uint16(AND),
+	/*9990*/ uint16(xReadSlashR),
+	/*9991*/ uint16(xArgRM16),
+	/*9992*/ uint16(xArgR16),
+	/*9993*/ uint16(xMatch),
+	/*9994*/ uint16(xSetOp), uint16(AND),
+	/*9996*/ uint16(xReadSlashR),
+	/*9997*/ uint16(xArgRM32),
+	/*9998*/ uint16(xArgR32),
+	/*9999*/ uint16(xMatch),
+	/*10000*/ uint16(xCondDataSize), 9988, 9994, 10004,
+	/*10004*/ uint16(xSetOp), uint16(AND),
+	/*10006*/ uint16(xReadSlashR),
+	/*10007*/ uint16(xArgRM64),
+	/*10008*/ uint16(xArgR64),
+	/*10009*/ uint16(xMatch),
+	/*10010*/ uint16(xSetOp), uint16(AND),
+	/*10012*/ uint16(xReadSlashR),
+	/*10013*/ uint16(xArgR8),
+	/*10014*/ uint16(xArgRM8),
+	/*10015*/ uint16(xMatch),
+	/*10016*/ uint16(xCondIs64), 10019, 10035,
+	/*10019*/ uint16(xCondDataSize), 10023, 10029, 0,
+	/*10023*/ uint16(xSetOp), uint16(AND),
+	/*10025*/ uint16(xReadSlashR),
+	/*10026*/ uint16(xArgR16),
+	/*10027*/ uint16(xArgRM16),
+	/*10028*/ uint16(xMatch),
+	/*10029*/ uint16(xSetOp), uint16(AND),
+	/*10031*/ uint16(xReadSlashR),
+	/*10032*/ uint16(xArgR32),
+	/*10033*/ uint16(xArgRM32),
+	/*10034*/ uint16(xMatch),
+	/*10035*/ uint16(xCondDataSize), 10023, 10029, 10039,
+	/*10039*/ uint16(xSetOp), uint16(AND),
+	/*10041*/ uint16(xReadSlashR),
+	/*10042*/ uint16(xArgR64),
+	/*10043*/ uint16(xArgRM64),
+	/*10044*/ uint16(xMatch),
+	/*10045*/ uint16(xSetOp), uint16(AND),
+	/*10047*/ uint16(xReadIb),
+	/*10048*/ uint16(xArgAL),
+	/*10049*/ uint16(xArgImm8u),
+	/*10050*/ uint16(xMatch),
+	/*10051*/ uint16(xCondIs64), 10054, 10070,
+	/*10054*/ uint16(xCondDataSize), 10058, 10064, 0,
+	/*10058*/ uint16(xSetOp), uint16(AND),
+	/*10060*/ uint16(xReadIw),
+	/*10061*/ uint16(xArgAX),
+	/*10062*/ uint16(xArgImm16),
+	/*10063*/ uint16(xMatch),
+	/*10064*/ uint16(xSetOp), uint16(AND),
+	/*10066*/ uint16(xReadId),
+	/*10067*/ uint16(xArgEAX),
+	/*10068*/ uint16(xArgImm32),
+	/*10069*/ uint16(xMatch),
+	/*10070*/ uint16(xCondDataSize), 10058, 10064, 10074,
+	/*10074*/ uint16(xSetOp), uint16(AND),
+	/*10076*/ uint16(xReadId),
+	/*10077*/ uint16(xArgRAX),
+	/*10078*/ uint16(xArgImm32),
+	/*10079*/ uint16(xMatch),
+	/*10080*/ uint16(xCondIs64), 10083, 0,
+	/*10083*/ uint16(xSetOp), uint16(DAA),
+	/*10085*/ uint16(xMatch),
+	/*10086*/ uint16(xSetOp), uint16(SUB),
+	/*10088*/ uint16(xReadSlashR),
+	/*10089*/ uint16(xArgRM8),
+	/*10090*/ uint16(xArgR8),
+	/*10091*/ uint16(xMatch),
+	/*10092*/ uint16(xCondIs64), 10095, 10111,
+	/*10095*/ uint16(xCondDataSize), 10099, 10105, 0,
+	/*10099*/ uint16(xSetOp), uint16(SUB),
+	/*10101*/ uint16(xReadSlashR),
+	/*10102*/ uint16(xArgRM16),
+	/*10103*/ uint16(xArgR16),
+	/*10104*/ uint16(xMatch),
+	/*10105*/ uint16(xSetOp), uint16(SUB),
+	/*10107*/ uint16(xReadSlashR),
+	/*10108*/ uint16(xArgRM32),
+	/*10109*/ uint16(xArgR32),
+	/*10110*/ uint16(xMatch),
+	/*10111*/ uint16(xCondDataSize), 10099, 10105, 10115,
+	/*10115*/ uint16(xSetOp), uint16(SUB),
+	/*10117*/ uint16(xReadSlashR),
+	/*10118*/ uint16(xArgRM64),
+	/*10119*/ uint16(xArgR64),
+	/*10120*/ uint16(xMatch),
+	/*10121*/ uint16(xSetOp), uint16(SUB),
+	/*10123*/ uint16(xReadSlashR),
+	/*10124*/ uint16(xArgR8),
+	/*10125*/ uint16(xArgRM8),
+	/*10126*/ uint16(xMatch),
+	/*10127*/ uint16(xCondIs64), 10130, 10146,
+	/*10130*/ uint16(xCondDataSize), 10134, 10140, 0,
+	/*10134*/ uint16(xSetOp), uint16(SUB),
+	/*10136*/ uint16(xReadSlashR),
+	/*10137*/ uint16(xArgR16),
+	/*10138*/ uint16(xArgRM16),
+	/*10139*/ uint16(xMatch),
+	/*10140*/ uint16(xSetOp), uint16(SUB),
+	/*10142*/ uint16(xReadSlashR),
+	/*10143*/ uint16(xArgR32),
+	/*10144*/ uint16(xArgRM32),
+	/*10145*/ uint16(xMatch),
+	/*10146*/ uint16(xCondDataSize), 10134, 10140, 10150,
+	/*10150*/ uint16(xSetOp), uint16(SUB),
+	/*10152*/ uint16(xReadSlashR),
+	/*10153*/ uint16(xArgR64),
+	/*10154*/ uint16(xArgRM64),
+	/*10155*/ uint16(xMatch),
+	/*10156*/ uint16(xSetOp), uint16(SUB),
+	/*10158*/ uint16(xReadIb),
+	/*10159*/ uint16(xArgAL),
+	/*10160*/ uint16(xArgImm8u),
+	/*10161*/ uint16(xMatch),
+	/*10162*/ uint16(xCondIs64), 10165, 10181,
+	/*10165*/ uint16(xCondDataSize), 10169, 10175, 0,
+	/*10169*/ uint16(xSetOp), uint16(SUB),
+	/*10171*/ uint16(xReadIw),
+	/*10172*/ uint16(xArgAX),
+	/*10173*/ uint16(xArgImm16),
+	/*10174*/ uint16(xMatch),
+	/*10175*/ uint16(xSetOp), uint16(SUB),
+	/*10177*/ uint16(xReadId),
+	/*10178*/ uint16(xArgEAX),
+	/*10179*/ uint16(xArgImm32),
+	/*10180*/ uint16(xMatch),
+	/*10181*/ uint16(xCondDataSize), 10169, 10175, 10185,
+	/*10185*/ uint16(xSetOp), uint16(SUB),
+	/*10187*/ uint16(xReadId),
+	/*10188*/ uint16(xArgRAX),
+	/*10189*/ uint16(xArgImm32),
+	/*10190*/ uint16(xMatch),
+	/*10191*/ uint16(xCondIs64), 10194, 0,
+	/*10194*/ uint16(xSetOp), uint16(DAS),
+	/*10196*/ uint16(xMatch),
+	/*10197*/ uint16(xSetOp), uint16(XOR),
+	/*10199*/ uint16(xReadSlashR),
+	/*10200*/ uint16(xArgRM8),
+	/*10201*/ uint16(xArgR8),
+	/*10202*/ uint16(xMatch),
+	/*10203*/ uint16(xCondIs64), 10206, 10222,
+	/*10206*/ uint16(xCondDataSize), 10210, 10216, 0,
+	/*10210*/ uint16(xSetOp), uint16(XOR),
+	/*10212*/ uint16(xReadSlashR),
+	/*10213*/ uint16(xArgRM16),
+	/*10214*/ uint16(xArgR16),
+	/*10215*/ uint16(xMatch),
+	/*10216*/ uint16(xSetOp), uint16(XOR),
+	/*10218*/ uint16(xReadSlashR),
+	/*10219*/ uint16(xArgRM32),
+	/*10220*/ uint16(xArgR32),
+	/*10221*/ uint16(xMatch),
+	/*10222*/ uint16(xCondDataSize), 10210, 10216, 10226,
+	/*10226*/ uint16(xSetOp), uint16(XOR),
+	/*10228*/ uint16(xReadSlashR),
+	/*10229*/ uint16(xArgRM64),
+	/*10230*/ uint16(xArgR64),
+	/*10231*/ uint16(xMatch),
+	/*10232*/ uint16(xSetOp), uint16(XOR),
+	/*10234*/ uint16(xReadSlashR),
+	/*10235*/ uint16(xArgR8),
+	/*10236*/ uint16(xArgRM8),
+	/*10237*/ uint16(xMatch),
+	/*10238*/ uint16(xCondIs64), 10241, 10257,
+	/*10241*/ uint16(xCondDataSize), 10245, 10251, 0,
+	/*10245*/ uint16(xSetOp), uint16(XOR),
+	/*10247*/ uint16(xReadSlashR),
+	/*10248*/ uint16(xArgR16),
+	/*10249*/ uint16(xArgRM16),
+	/*10250*/ uint16(xMatch),
+	/*10251*/ uint16(xSetOp), uint16(XOR),
+	/*10253*/ uint16(xReadSlashR),
+	/*10254*/ uint16(xArgR32),
+	/*10255*/ uint16(xArgRM32),
 	/*10256*/ uint16(xMatch),
-	/*10257*/ uint16(xCondDataSize), 10251, 10254, 10261,
-	/*10261*/ uint16(xSetOp), uint16(STOSQ),
-	/*10263*/ uint16(xMatch),
-	/*10264*/ uint16(xSetOp), uint16(LODSB),
+	/*10257*/ uint16(xCondDataSize), 10245, 10251, 10261,
+	/*10261*/ uint16(xSetOp), uint16(XOR),
+	/*10263*/ uint16(xReadSlashR),
+	/*10264*/ uint16(xArgR64),
+	/*10265*/ uint16(xArgRM64),
 	/*10266*/ uint16(xMatch),
-	/*10267*/ uint16(xCondIs64), 10270, 10280,
-	/*10270*/ uint16(xCondDataSize), 10274, 10277, 0,
-	/*10274*/ uint16(xSetOp), uint16(LODSW),
-	/*10276*/ uint16(xMatch),
-	/*10277*/ uint16(xSetOp), uint16(LODSD),
-	/*10279*/ uint16(xMatch),
-	/*10280*/ uint16(xCondDataSize), 10274, 10277, 10284,
-	/*10284*/ uint16(xSetOp), uint16(LODSQ),
-	/*10286*/ uint16(xMatch),
-	/*10287*/ uint16(xSetOp), uint16(SCASB),
-	/*10289*/ uint16(xMatch),
-	/*10290*/ uint16(xCondIs64), 10293, 10303,
-	/*10293*/ uint16(xCondDataSize), 10297, 10300, 0,
-	/*10297*/ uint16(xSetOp), uint16(SCASW),
-	/*10299*/ uint16(xMatch),
-	/*10300*/ uint16(xSetOp), uint16(SCASD),
-	/*10302*/ uint16(xMatch),
-	/*10303*/ uint16(xCondDataSize), 10297, 10300, 10307,
-	/*10307*/ uint16(xSetOp), uint16(SCASQ),
-	/*10309*/ uint16(xMatch),
-	/*10310*/ uint16(xSetOp), uint16(MOV),
-	/*10312*/ uint16(xReadIb),
-	/*10313*/ uint16(xArgR8op),
-	/*10314*/ uint16(xArgImm8u),
-	/*10315*/ uint16(xMatch),
-	/*10316*/ uint16(xCondIs64), 10319, 10335,
-	/*10319*/ uint16(xCondDataSize), 10323, 10329, 0,
-	/*10323*/ uint16(xSetOp), uint16(MOV),
-	/*10325*/ uint16(xReadIw),
-	/*10326*/ uint16(xArgR16op),
-	/*10327*/ uint16(xArgImm16),
-	/*10328*/ uint16(xMatch),
-	/*10329*/ uint16(xSetOp), uint16(MOV),
-	/*10331*/ uint16(xReadId),
-	/*10332*/ uint16(xArgR32op),
-	/*10333*/ uint16(xArgImm32),
-	/*10334*/ uint16(xMatch),
-	/*10335*/ uint16(xCondDataSize), 10323, 10329, 10339,
-	/*10339*/ uint16(xSetOp), uint16(MOV),
-	/*10341*/ uint16(xReadIo),
-	/*10342*/ uint16(xArgR64op),
-	/*10343*/ uint16(xArgImm64),
-	/*10344*/ uint16(xMatch),
-	/*10345*/ uint16(xCondSlashR),
-	10354, // 0
-	10360, // 1
-	10366, // 2
-	10372, // 3
-	10378, // 4
-	10384, // 5
-	0,     // 6
-	10390, // 7
-	/*10354*/ uint16(xSetOp), uint16(ROL),
-	/*10356*/ uint16(xReadIb),
-	/*10357*/ uint16(xArgRM8),
-	/*10358*/ uint16(xArgImm8u),
-	/*10359*/ uint16(xMatch),
-	/*10360*/ uint16(xSetOp), uint16(ROR),
-	/*10362*/ uint16(xReadIb),
-	/*10363*/ uint16(xArgRM8),
-	/*10364*/ uint16(xArgImm8u),
-	/*10365*/ uint16(xMatch),
-	/*10366*/ uint16(xSetOp), uint16(RCL),
-	/*10368*/ uint16(xReadIb),
-	/*10369*/ uint16(xArgRM8),
-	/*10370*/ uint16(xArgImm8u),
-	/*10371*/ uint16(xMatch),
-	/*10372*/ uint16(xSetOp), uint16(RCR),
-	/*10374*/ uint16(xReadIb),
-	/*10375*/ uint16(xArgRM8),
-	/*10376*/ uint16(xArgImm8u),
+	/*10267*/ uint16(xSetOp), uint16(XOR),
+	/*10269*/ uint16(xReadIb),
+	/*10270*/ uint16(xArgAL),
+	/*10271*/ uint16(xArgImm8u),
+	/*10272*/ uint16(xMatch),
+	/*10273*/ uint16(xCondIs64), 10276, 10292,
+	/*10276*/ uint16(xCondDataSize), 10280, 10286, 0,
+	/*10280*/ uint16(xSetOp), uint16(XOR),
+	/*10282*/ uint16(xReadIw),
+	/*10283*/ uint16(xArgAX),
+	/*10284*/ uint16(xArgImm16),
+	/*10285*/ uint16(xMatch),
+	/*10286*/ uint16(xSetOp), uint16(XOR),
+	/*10288*/ uint16(xReadId),
+	/*10289*/ uint16(xArgEAX),
+	/*10290*/ uint16(xArgImm32),
+	/*10291*/ uint16(xMatch),
+	/*10292*/ uint16(xCondDataSize), 10280, 10286, 10296,
+	/*10296*/ uint16(xSetOp), uint16(XOR),
+	/*10298*/ uint16(xReadId),
+	/*10299*/ uint16(xArgRAX),
+	/*10300*/ uint16(xArgImm32),
+	/*10301*/ uint16(xMatch),
+	/*10302*/ uint16(xCondIs64), 10305, 0,
+	/*10305*/ uint16(xSetOp), uint16(AAA),
+	/*10307*/ uint16(xMatch),
+	/*10308*/ uint16(xSetOp), uint16(CMP),
+	/*10310*/ uint16(xReadSlashR),
+	/*10311*/ uint16(xArgRM8),
+	/*10312*/ uint16(xArgR8),
+	/*10313*/ uint16(xMatch),
+	/*10314*/ uint16(xCondIs64), 10317, 10333,
+	/*10317*/ uint16(xCondDataSize), 10321, 10327, 0,
+	/*10321*/ uint16(xSetOp), uint16(CMP),
+	/*10323*/ uint16(xReadSlashR),
+	/*10324*/ uint16(xArgRM16),
+	/*10325*/ uint16(xArgR16),
+	/*10326*/ uint16(xMatch),
+	/*10327*/ uint16(xSetOp), uint16(CMP),
+	/*10329*/ uint16(xReadSlashR),
+	/*10330*/ uint16(xArgRM32),
+	/*10331*/ uint16(xArgR32),
+	/*10332*/ uint16(xMatch),
+	/*10333*/ uint16(xCondDataSize), 10321, 10327, 10337,
+	/*10337*/ uint16(xSetOp), uint16(CMP),
+	/*10339*/ uint16(xReadSlashR),
+	/*10340*/ uint16(xArgRM64),
+	/*10341*/ uint16(xArgR64),
+	/*10342*/ uint16(xMatch),
+	/*10343*/ uint16(xSetOp), uint16(CMP),
+	/*10345*/ uint16(xReadSlashR),
+	/*10346*/ uint16(xArgR8),
+	/*10347*/ uint16(xArgRM8),
+	/*10348*/ uint16(xMatch),
+	/*10349*/ uint16(xCondIs64), 10352, 10368,
+	/*10352*/ uint16(xCondDataSize), 10356, 10362, 0,
+	/*10356*/ uint16(xSetOp), uint16(CMP),
+	/*10358*/ uint16(xReadSlashR),
+	/*10359*/ uint16(xArgR16),
+	/*10360*/ uint16(xArgRM16),
+	/*10361*/ uint16(xMatch),
+	/*10362*/ uint16(xSetOp), uint16(CMP),
+	/*10364*/ uint16(xReadSlashR),
+	/*10365*/ uint16(xArgR32),
+	/*10366*/ uint16(xArgRM32),
+	/*10367*/ uint16(xMatch),
+	/*10368*/ uint16(xCondDataSize), 10356, 10362, 10372,
+	/*10372*/ uint16(xSetOp), uint16(CMP),
+	/*10374*/ uint16(xReadSlashR),
+	/*10375*/ uint16(xArgR64),
+	/*10376*/ uint16(xArgRM64),
 	/*10377*/ uint16(xMatch),
-	/*10378*/ uint16(xSetOp), uint16(SHL),
+	/*10378*/ uint16(xSetOp), uint16(CMP),
 	/*10380*/ uint16(xReadIb),
-	/*10381*/ uint16(xArgRM8),
+	/*10381*/ uint16(xArgAL),
 	/*10382*/ uint16(xArgImm8u),
 	/*10383*/ uint16(xMatch),
-	/*10384*/ uint16(xSetOp), uint16(SHR),
-	/*10386*/ uint16(xReadIb),
-	/*10387*/ uint16(xArgRM8),
-	/*10388*/ uint16(xArgImm8u),
-	/*10389*/ uint16(xMatch),
-	/*10390*/ uint16(xSetOp), uint16(SAR),
-	/*10392*/ uint16(xReadIb),
-	/*10393*/ uint16(xArgRM8),
-	/*10394*/ uint16(xArgImm8u),
-	/*10395*/ uint16(xMatch),
-	/*10396*/ uint16(xCondSlashR),
-	10405, // 0
-	10427, // 1
-	10449, // 2
-	10478, // 3
-	10507, // 4
-	10536, // 5
-	0,     // 6
-	10565, // 7
-	/*10405*/ uint16(xCondDataSize), 10409, 10415, 10421,
-	/*10409*/ uint16(xSetOp), uint16(ROL),
-	/*10411*/ uint16(xReadIb),
-	/*10412*/ uint16(xArgRM16),
-	/*10413*/ uint16(xArgImm8u),
-	/*10414*/ uint16(xMatch),
-	/*10415*/ uint16(xSetOp), uint16(ROL),
-	/*10417*/ uint16(xReadIb),
-	/*10418*/ uint16(xArgRM32),
-	/*10419*/ uint16(xArgImm8u),
-	/*10420*/ uint16(xMatch),
-	/*10421*/ uint16(xSetOp), uint16(ROL),
-	/*10423*/ uint16(xReadIb),
-	/*10424*/ uint16(xArgRM64),
-	/*10425*/ uint16(xArgImm8u),
-	/*10426*/ uint16(xMatch),
-	/*10427*/ uint16(xCondDataSize), 10431, 10437, 10443,
-	/*10431*/ uint16(xSetOp), uint16(ROR),
-	/*10433*/ uint16(xReadIb),
-	/*10434*/ uint16(xArgRM16),
-	/*10435*/ uint16(xArgImm8u),
-	/*10436*/ uint16(xMatch),
-	/*10437*/ uint16(xSetOp), uint16(ROR),
-	/*10439*/ uint16(xReadIb),
-	/*10440*/ uint16(xArgRM32),
-	/*10441*/ uint16(xArgImm8u),
-	/*10442*/ uint16(xMatch),
-	/*10443*/ uint16(xSetOp), uint16(ROR),
-	/*10445*/ uint16(xReadIb),
-	/*10446*/ uint16(xArgRM64),
-	/*10447*/ uint16(xArgImm8u),
+	/*10384*/ uint16(xCondIs64), 10387, 10403,
+	/*10387*/ uint16(xCondDataSize), 10391, 10397, 0,
+	/*10391*/ uint16(xSetOp), uint16(CMP),
+	/*10393*/ uint16(xReadIw),
+	/*10394*/ uint16(xArgAX),
+	/*10395*/ uint16(xArgImm16),
+	/*10396*/ uint16(xMatch),
+	/*10397*/ uint16(xSetOp), uint16(CMP),
+	/*10399*/ uint16(xReadId),
+	/*10400*/ uint16(xArgEAX),
+	/*10401*/ uint16(xArgImm32),
+	/*10402*/ uint16(xMatch),
+	/*10403*/ uint16(xCondDataSize), 10391, 10397, 10407,
+	/*10407*/ uint16(xSetOp), uint16(CMP),
+	/*10409*/ uint16(xReadId),
+	/*10410*/ uint16(xArgRAX),
+	/*10411*/ uint16(xArgImm32),
+	/*10412*/ uint16(xMatch),
+	/*10413*/ uint16(xCondIs64), 10416, 0,
+	/*10416*/ uint16(xSetOp), uint16(AAS),
+	/*10418*/ uint16(xMatch),
+	/*10419*/ uint16(xCondIs64), 10422, 0,
+	/*10422*/ uint16(xCondDataSize), 10426, 10430, 0,
+	/*10426*/ uint16(xSetOp), uint16(INC),
+	/*10428*/ uint16(xArgR16op),
+	/*10429*/ uint16(xMatch),
+	/*10430*/ uint16(xSetOp), uint16(INC),
+	/*10432*/ uint16(xArgR32op),
+	/*10433*/ uint16(xMatch),
+	/*10434*/ uint16(xCondIs64), 10437, 0,
+	/*10437*/ uint16(xCondDataSize), 10441, 10445, 0,
+	/*10441*/ uint16(xSetOp), uint16(DEC),
+	/*10443*/ uint16(xArgR16op),
+	/*10444*/ uint16(xMatch),
+	/*10445*/ uint16(xSetOp), uint16(DEC),
+	/*10447*/ uint16(xArgR32op),
 	/*10448*/ uint16(xMatch),
-	/*10449*/ uint16(xCondIs64), 10452, 10468,
-	/*10452*/ uint16(xCondDataSize), 10456, 10462, 0,
-	/*10456*/ uint16(xSetOp), uint16(RCL),
-	/*10458*/ uint16(xReadIb),
-	/*10459*/ uint16(xArgRM16),
-	/*10460*/ uint16(xArgImm8u),
-	/*10461*/ uint16(xMatch),
-	/*10462*/ uint16(xSetOp), uint16(RCL),
-	/*10464*/ uint16(xReadIb),
-	/*10465*/ uint16(xArgRM32),
-	/*10466*/ uint16(xArgImm8u),
-	/*10467*/ uint16(xMatch),
-	/*10468*/ uint16(xCondDataSize), 10456, 10462, 10472,
-	/*10472*/ uint16(xSetOp), uint16(RCL),
-	/*10474*/ uint16(xReadIb),
-	/*10475*/ uint16(xArgRM64),
-	/*10476*/ uint16(xArgImm8u),
-	/*10477*/ uint16(xMatch),
-	/*10478*/ uint16(xCondIs64), 10481, 10497,
-	/*10481*/ uint16(xCondDataSize), 10485, 10491, 0,
-	/*10485*/ uint16(xSetOp), uint16(RCR),
-	/*10487*/ uint16(xReadIb),
-	/*10488*/ uint16(xArgRM16),
-	/*10489*/ uint16(xArgImm8u),
+	/*10449*/ uint16(xCondIs64), 10452, 10464,
+	/*10452*/ uint16(xCondDataSize), 10456, 10460, 0,
+	/*10456*/ uint16(xSetOp), uint16(PUSH),
+	/*10458*/ uint16(xArgR16op),
+	/*10459*/ uint16(xMatch),
+	/*10460*/ uint16(xSetOp), uint16(PUSH),
+	/*10462*/ uint16(xArgR32op),
+	/*10463*/ uint16(xMatch),
+	/*10464*/ uint16(xCondDataSize), 10456, 10468, 10472,
+	/*10468*/ uint16(xSetOp), uint16(PUSH),
+	/*10470*/ uint16(xArgR64op),
+	/*10471*/ uint16(xMatch),
+	/*10472*/ uint16(xSetOp), uint16(PUSH),
+	/*10474*/ uint16(xArgR64op),
+	/*10475*/ uint16(xMatch),
+	/*10476*/ uint16(xCondIs64), 10479, 10491,
+	/*10479*/ uint16(xCondDataSize), 10483, 10487, 0,
+	/*10483*/ uint16(xSetOp), uint16(POP),
+	/*10485*/ uint16(xArgR16op),
+	/*10486*/ uint16(xMatch),
+	/*10487*/ uint16(xSetOp), uint16(POP),
+	/*10489*/ uint16(xArgR32op),
 	/*10490*/ uint16(xMatch),
-	/*10491*/ uint16(xSetOp), uint16(RCR),
-	/*10493*/ uint16(xReadIb),
-	/*10494*/ uint16(xArgRM32),
-	/*10495*/ uint16(xArgImm8u),
-	/*10496*/ uint16(xMatch),
-	/*10497*/ uint16(xCondDataSize), 10485, 10491, 10501,
-	/*10501*/ uint16(xSetOp), uint16(RCR),
-	/*10503*/ uint16(xReadIb),
-	/*10504*/ uint16(xArgRM64),
-	/*10505*/ uint16(xArgImm8u),
-	/*10506*/ uint16(xMatch),
-	/*10507*/ uint16(xCondIs64), 10510, 10526,
-	/*10510*/ uint16(xCondDataSize), 10514, 10520, 0,
-	/*10514*/ uint16(xSetOp), uint16(SHL),
-	/*10516*/ uint16(xReadIb),
-	/*10517*/ uint16(xArgRM16),
-	/*10518*/ uint16(xArgImm8u),
-	/*10519*/ uint16(xMatch),
-	/*10520*/ uint16(xSetOp), uint16(SHL),
-	/*10522*/ uint16(xReadIb),
-	/*10523*/ uint16(xArgRM32),
-	/*10524*/ uint16(xArgImm8u),
+	/*10491*/ uint16(xCondDataSize), 10483, 10495, 10499,
+	/*10495*/ uint16(xSetOp), uint16(POP),
+	/*10497*/ uint16(xArgR64op),
+	/*10498*/ uint16(xMatch),
+	/*10499*/ uint16(xSetOp), uint16(POP),
+	/*10501*/ uint16(xArgR64op),
+	/*10502*/ uint16(xMatch),
+	/*10503*/ uint16(xCondIs64), 10506, 0,
+	/*10506*/ uint16(xCondDataSize), 10510, 10513, 0,
+	/*10510*/ uint16(xSetOp), uint16(PUSHA),
+	/*10512*/ uint16(xMatch),
+	/*10513*/ uint16(xSetOp), uint16(PUSHAD),
+	/*10515*/ uint16(xMatch),
+	/*10516*/ uint16(xCondIs64), 10519, 0,
+	/*10519*/ uint16(xCondDataSize), 10523, 10526, 0,
+	/*10523*/ uint16(xSetOp), uint16(POPA),
 	/*10525*/ uint16(xMatch),
-	/*10526*/ uint16(xCondDataSize), 10514, 10520, 10530,
-	/*10530*/ uint16(xSetOp), uint16(SHL),
-	/*10532*/ uint16(xReadIb),
-	/*10533*/ uint16(xArgRM64),
-	/*10534*/ uint16(xArgImm8u),
-	/*10535*/ uint16(xMatch),
-	/*10536*/ uint16(xCondIs64), 10539, 10555,
-	/*10539*/ uint16(xCondDataSize), 10543, 10549, 0,
-	/*10543*/ uint16(xSetOp), uint16(SHR),
-	/*10545*/ uint16(xReadIb),
-	/*10546*/ uint16(xArgRM16),
-	/*10547*/ uint16(xArgImm8u),
-	/*10548*/ uint16(xMatch),
-	/*10549*/ uint16(xSetOp), uint16(SHR),
-	/*10551*/ uint16(xReadIb),
-	/*10552*/ uint16(xArgRM32),
-	/*10553*/ uint16(xArgImm8u),
-	/*10554*/ uint16(xMatch),
-	/*10555*/ uint16(xCondDataSize), 10543, 10549, 10559,
-	/*10559*/ uint16(xSetOp), uint16(SHR),
-	/*10561*/ uint16(xReadIb),
-	/*10562*/ uint16(xArgRM64),
-	/*10563*/ uint16(xArgImm8u),
-	/*10564*/ uint16(xMatch),
-	/*10565*/ uint16(xCondIs64), 10568, 10584,
-	/*10568*/ uint16(xCondDataSize), 10572, 10578, 0,
-	/*10572*/ uint16(xSetOp), uint16(SAR),
-	/*10574*/ uint16(xReadIb),
-	/*10575*/ uint16(xArgRM16),
-	/*10576*/ uint16(xArgImm8u),
-	/*10577*/ uint16(xMatch),
-	/*10578*/ uint16(xSetOp), uint16(SAR),
-	/*10580*/ uint16(xReadIb),
-	/*10581*/ uint16(xArgRM32),
-	/*10582*/ uint16(xArgImm8u),
-	/*10583*/ uint16(xMatch),
-	/*10584*/ uint16(xCondDataSize), 10572, 10578, 10588,
-	/*10588*/ uint16(xSetOp), uint16(SAR),
-	/*10590*/ uint16(xReadIb),
-	/*10591*/ uint16(xArgRM64),
-	/*10592*/ uint16(xArgImm8u),
-	/*10593*/ uint16(xMatch),
-	/*10594*/ uint16(xSetOp), uint16(RET),
-	/*10596*/ uint16(xReadIw),
-	/*10597*/ uint16(xArgImm16u),
-	/*10598*/ uint16(xMatch),
-	/*10599*/ uint16(xSetOp), uint16(RET),
-	/*10601*/ uint16(xMatch),
-	/*10602*/ uint16(xCondIs64), 10605, 0,
-	/*10605*/ uint16(xCondDataSize), 10609, 10615, 0,
-	/*10609*/ uint16(xSetOp), uint16(LES),
-	/*10611*/ uint16(xReadSlashR),
-	/*10612*/ uint16(xArgR16),
-	/*10613*/ uint16(xArgM16colon16),
-	/*10614*/ uint16(xMatch),
-	/*10615*/ uint16(xSetOp), uint16(LES),
-	/*10617*/ uint16(xReadSlashR),
-	/*10618*/ uint16(xArgR32),
-	/*10619*/ uint16(xArgM16colon32),
+	/*10526*/ uint16(xSetOp), uint16(POPAD),
+	/*10528*/ uint16(xMatch),
+	/*10529*/ uint16(xCondIs64), 10532, 0,
+	/*10532*/ uint16(xCondDataSize), 10536, 10542, 0,
+	/*10536*/ uint16(xSetOp), uint16(BOUND),
+	/*10538*/ uint16(xReadSlashR),
+	/*10539*/ uint16(xArgR16),
+	/*10540*/ uint16(xArgM16and16),
+	/*10541*/ uint16(xMatch),
+	/*10542*/ uint16(xSetOp), uint16(BOUND),
+	/*10544*/ uint16(xReadSlashR),
+	/*10545*/ uint16(xArgR32),
+	/*10546*/ uint16(xArgM32and32),
+	/*10547*/ uint16(xMatch),
+	/*10548*/ uint16(xCondIs64), 10551, 10557,
+	/*10551*/ uint16(xSetOp), uint16(ARPL),
+	/*10553*/ uint16(xReadSlashR),
+	/*10554*/ uint16(xArgRM16),
+	/*10555*/ uint16(xArgR16),
+	/*10556*/ uint16(xMatch),
+	/*10557*/ uint16(xCondDataSize), 10561, 10567, 10573,
+	/*10561*/ uint16(xSetOp), uint16(MOVSXD),
+	/*10563*/ uint16(xReadSlashR),
+	/*10564*/ uint16(xArgR16),
+	/*10565*/ uint16(xArgRM32),
+	/*10566*/ uint16(xMatch),
+	/*10567*/ uint16(xSetOp), uint16(MOVSXD),
+	/*10569*/ uint16(xReadSlashR),
+	/*10570*/ uint16(xArgR32),
+	/*10571*/ uint16(xArgRM32),
+	/*10572*/ uint16(xMatch),
+	/*10573*/ uint16(xSetOp), uint16(MOVSXD),
+	/*10575*/ uint16(xReadSlashR),
+	/*10576*/ uint16(xArgR64),
+	/*10577*/ uint16(xArgRM32),
+	/*10578*/ uint16(xMatch),
+	/*10579*/ uint16(xCondDataSize), 10583, 10588, 10593,
+	/*10583*/ uint16(xSetOp), uint16(PUSH),
+	/*10585*/ uint16(xReadIw),
+	/*10586*/ uint16(xArgImm16),
+	/*10587*/ uint16(xMatch),
+	/*10588*/ uint16(xSetOp), uint16(PUSH),
+	/*10590*/ uint16(xReadId),
+	/*10591*/ uint16(xArgImm32),
+	/*10592*/ uint16(xMatch),
+	/*10593*/ uint16(xSetOp), uint16(PUSH),
+	/*10595*/ uint16(xReadId),
+	/*10596*/ uint16(xArgImm32),
+	/*10597*/ uint16(xMatch),
+	/*10598*/ uint16(xCondIs64), 10601, 10621,
+	/*10601*/ uint16(xCondDataSize), 10605, 10613, 0,
+	/*10605*/ uint16(xSetOp), uint16(IMUL),
+	/*10607*/ uint16(xReadSlashR),
+	/*10608*/ uint16(xReadIw),
+	/*10609*/ uint16(xArgR16),
+	/*10610*/ uint16(xArgRM16),
+	/*10611*/ uint16(xArgImm16),
+	/*10612*/ uint16(xMatch),
+	/*10613*/ uint16(xSetOp), uint16(IMUL),
+	/*10615*/ uint16(xReadSlashR),
+	/*10616*/ uint16(xReadId),
+	/*10617*/ uint16(xArgR32),
+	/*10618*/ uint16(xArgRM32),
+	/*10619*/ uint16(xArgImm32),
 	/*10620*/ uint16(xMatch),
-	/*10621*/ uint16(xCondIs64), 10624, 0,
-	/*10624*/ uint16(xCondDataSize), 10628, 10634, 0,
-	/*10628*/ uint16(xSetOp), uint16(LDS),
-	/*10630*/ uint16(xReadSlashR),
-	/*10631*/ uint16(xArgR16),
-	/*10632*/ uint16(xArgM16colon16),
-	/*10633*/ uint16(xMatch),
-	/*10634*/ uint16(xSetOp), uint16(LDS),
-	/*10636*/ uint16(xReadSlashR),
-	/*10637*/ uint16(xArgR32),
-	/*10638*/ uint16(xArgM16colon32),
-	/*10639*/ uint16(xMatch),
-	/*10640*/ uint16(xCondByte), 1,
-	0xF8, 10659,
-	/*10644*/ uint16(xCondSlashR),
-	10653, // 0
-	0,     // 1
-	0,     // 2
-	0,     // 3
-	0,     // 4
-	0,     // 5
-	0,     // 6
-	0,     // 7
-	/*10653*/ uint16(xSetOp), uint16(MOV),
-	/*10655*/ uint16(xReadIb),
-	/*10656*/ uint16(xArgRM8),
-	/*10657*/ uint16(xArgImm8u),
-	/*10658*/ uint16(xMatch),
-	/*10659*/ uint16(xSetOp), uint16(XABORT),
-	/*10661*/ uint16(xReadIb),
-	/*10662*/ uint16(xArgImm8u),
-	/*10663*/ uint16(xMatch),
-	/*10664*/ uint16(xCondByte), 1,
-	0xF8, 10706,
-	/*10668*/ uint16(xCondSlashR),
-	10677, // 0
-	0,     // 1
-	0,     // 2
-	0,     // 3
-	0,     // 4
-	0,     // 5
-	0,     // 6
-	0,     // 7
-	/*10677*/ uint16(xCondIs64), 10680, 10696,
-	/*10680*/ uint16(xCondDataSize), 10684, 10690, 0,
-	/*10684*/ uint16(xSetOp), uint16(MOV),
-	/*10686*/ uint16(xReadIw),
-	/*10687*/ uint16(xArgRM16),
-	/*10688*/ uint16(xArgImm16),
-	/*10689*/ uint16(xMatch),
-	/*10690*/ uint16(xSetOp), uint16(MOV),
-	/*10692*/ uint16(xReadId),
-	/*10693*/ uint16(xArgRM32),
-	/*10694*/ uint16(xArgImm32),
-	/*10695*/ uint16(xMatch),
-	/*10696*/ uint16(xCondDataSize), 10684, 10690, 10700,
-	/*10700*/ uint16(xSetOp), uint16(MOV),
-	/*10702*/ uint16(xReadId),
-	/*10703*/ uint16(xArgRM64),
-	/*10704*/ uint16(xArgImm32),
-	/*10705*/ uint16(xMatch),
-	/*10706*/ uint16(xCondDataSize), 10710, 10715, 10720,
-	/*10710*/ uint16(xSetOp), uint16(XBEGIN),
-	/*10712*/ uint16(xReadCw),
-	/*10713*/ uint16(xArgRel16),
+	/*10621*/ uint16(xCondDataSize), 10605, 10613, 10625,
+	/*10625*/ uint16(xSetOp), uint16(IMUL),
+	/*10627*/ uint16(xReadSlashR),
+	/*10628*/ uint16(xReadId),
+	/*10629*/ uint16(xArgR64),
+	/*10630*/ uint16(xArgRM64),
+	/*10631*/ uint16(xArgImm32),
+	/*10632*/ uint16(xMatch),
+	/*10633*/ uint16(xSetOp), uint16(PUSH),
+	/*10635*/ uint16(xReadIb),
+	/*10636*/ uint16(xArgImm8),
+	/*10637*/ uint16(xMatch),
+	/*10638*/ uint16(xCondIs64), 10641, 10661,
+	/*10641*/ uint16(xCondDataSize), 10645, 10653, 0,
+	/*10645*/ uint16(xSetOp), uint16(IMUL),
+	/*10647*/ uint16(xReadSlashR),
+	/*10648*/ uint16(xReadIb),
+	/*10649*/ uint16(xArgR16),
+	/*10650*/ uint16(xArgRM16),
+	/*10651*/ uint16(xArgImm8),
+	/*10652*/ uint16(xMatch),
+	/*10653*/ uint16(xSetOp), uint16(IMUL),
+	/*10655*/ uint16(xReadSlashR),
+	/*10656*/ uint16(xReadIb),
+	/*10657*/ uint16(xArgR32),
+	/*10658*/ uint16(xArgRM32),
+	/*10659*/ uint16(xArgImm8),
+	/*10660*/ uint16(xMatch),
+	/*10661*/ uint16(xCondDataSize), 10645, 10653, 10665,
+	/*10665*/ uint16(xSetOp), uint16(IMUL),
+	/*10667*/ uint16(xReadSlashR),
+	/*10668*/ uint16(xReadIb),
+	/*10669*/ uint16(xArgR64),
+	/*10670*/ uint16(xArgRM64),
+	/*10671*/ uint16(xArgImm8),
+	/*10672*/ uint16(xMatch),
+	/*10673*/ uint16(xSetOp), uint16(INSB),
+	/*10675*/ uint16(xMatch),
+	/*10676*/ uint16(xCondDataSize), 10680, 10683, 10686,
+	/*10680*/ uint16(xSetOp), uint16(INSW),
+	/*10682*/ uint16(xMatch),
+	/*10683*/ uint16(xSetOp), uint16(INSD),
+	/*10685*/ uint16(xMatch),
+	/*10686*/ uint16(xSetOp), uint16(INSD),
+	/*10688*/ uint16(xMatch),
+	/*10689*/ uint16(xSetOp), uint16(OUTSB),
+	/*10691*/ uint16(xMatch),
+	/*10692*/ uint16(xCondDataSize), 10696, 10699, 10702,
+	/*10696*/ uint16(xSetOp), uint16(OUTSW),
+	/*10698*/ uint16(xMatch),
+	/*10699*/ uint16(xSetOp), uint16(OUTSD),
+	/*10701*/ uint16(xMatch),
+	/*10702*/ uint16(xSetOp), uint16(OUTSD),
+	/*10704*/ uint16(xMatch),
+	/*10705*/ uint16(xSetOp), uint16(JO),
+	/*10707*/ uint16(xReadCb),
+	/*10708*/ uint16(xArgRel8),
+	/*10709*/ uint16(xMatch),
+	/*10710*/ uint16(xSetOp), uint16(JNO),
+	/*10712*/ uint16(xReadCb),
+	/*10713*/ uint16(xArgRel8),
 	/*10714*/ uint16(xMatch),
-	/*10715*/ uint16(xSetOp), uint16(XBEGIN),
-	/*10717*/ uint16(xReadCd),
-	/*10718*/ uint16(xArgRel32),
+	/*10715*/ uint16(xSetOp), uint16(JB),
+	/*10717*/ uint16(xReadCb),
+	/*10718*/ uint16(xArgRel8),
 	/*10719*/ uint16(xMatch),
-	/*10720*/ uint16(xSetOp), uint16(XBEGIN),
-	/*10722*/ uint16(xReadCd),
-	/*10723*/ uint16(xArgRel32),
+	/*10720*/ uint16(xSetOp), uint16(JAE),
+	/*10722*/ uint16(xReadCb),
+	/*10723*/ uint16(xArgRel8),
 	/*10724*/ uint16(xMatch),
-	/*10725*/ uint16(xSetOp), uint16(ENTER),
-	/*10727*/ uint16(xReadIw),
-	/*10728*/ uint16(xReadIb),
-	/*10729*/ uint16(xArgImm16u),
-	/*10730*/ uint16(xArgImm8u),
-	/*10731*/ uint16(xMatch),
-	/*10732*/ uint16(xCondIs64), 10735, 10745,
-	/*10735*/ uint16(xCondDataSize), 10739, 10742, 0,
-	/*10739*/ uint16(xSetOp), uint16(LEAVE),
-	/*10741*/ uint16(xMatch),
-	/*10742*/ uint16(xSetOp), uint16(LEAVE),
+	/*10725*/ uint16(xSetOp), uint16(JE),
+	/*10727*/ uint16(xReadCb),
+	/*10728*/ uint16(xArgRel8),
+	/*10729*/ uint16(xMatch),
+	/*10730*/ uint16(xSetOp), uint16(JNE),
+	/*10732*/ uint16(xReadCb),
+	/*10733*/ uint16(xArgRel8),
+	/*10734*/ uint16(xMatch),
+	/*10735*/ uint16(xSetOp), uint16(JBE),
+	/*10737*/ uint16(xReadCb),
+	/*10738*/ uint16(xArgRel8),
+	/*10739*/ uint16(xMatch),
+	/*10740*/ uint16(xSetOp), uint16(JA),
+	/*10742*/ uint16(xReadCb),
+	/*10743*/ uint16(xArgRel8),
 	/*10744*/ uint16(xMatch),
-	/*10745*/ uint16(xCondDataSize), 10739, 10749, 10752,
-	/*10749*/ uint16(xSetOp), uint16(LEAVE),
-	/*10751*/ uint16(xMatch),
-	/*10752*/ uint16(xSetOp), uint16(LEAVE),
+	/*10745*/ uint16(xSetOp), uint16(JS),
+	/*10747*/ uint16(xReadCb),
+	/*10748*/ uint16(xArgRel8),
+	/*10749*/ uint16(xMatch),
+	/*10750*/ uint16(xSetOp), uint16(JNS),
+	/*10752*/ uint16(xReadCb),
+	/*10753*/ uint16(xArgRel8),
 	/*10754*/ uint16(xMatch),
-	/*10755*/ uint16(xSetOp), uint16(LRET),
-	/*10757*/ uint16(xReadIw),
-	/*10758*/ uint16(xArgImm16u),
+	/*10755*/ uint16(xSetOp), uint16(JP),
+	/*10757*/ uint16(xReadCb),
+	/*10758*/ uint16(xArgRel8),
 	/*10759*/ uint16(xMatch),
-	/*10760*/ uint16(xSetOp), uint16(LRET),
-	/*10762*/ uint16(xMatch),
-	/*10763*/ uint16(xSetOp), uint16(INT),
-	/*10765*/ uint16(xArg3),
-	/*10766*/ uint16(xMatch),
-	/*10767*/ uint16(xSetOp), uint16(INT),
-	/*10769*/ uint16(xReadIb),
-	/*10770*/ uint16(xArgImm8u),
-	/*10771*/ uint16(xMatch),
-	/*10772*/ uint16(xCondIs64), 10775, 0,
-	/*10775*/ uint16(xSetOp), uint16(INTO),
-	/*10777*/ uint16(xMatch),
-	/*10778*/ uint16(xCondIs64), 10781, 10791,
-	/*10781*/ uint16(xCondDataSize), 10785, 10788, 0,
-	/*10785*/ uint16(xSetOp), uint16(IRET),
-	/*10787*/ uint16(xMatch),
-	/*10788*/ uint16(xSetOp), uint16(IRETD),
-	/*10790*/ uint16(xMatch),
-	/*10791*/ uint16(xCondDataSize), 10785, 10788, 10795,
-	/*10795*/ uint16(xSetOp), uint16(IRETQ),
-	/*10797*/ uint16(xMatch),
-	/*10798*/ uint16(xCondSlashR),
-	10807, // 0
-	10812, // 1
-	10817, // 2
-	10822, // 3
-	10827, // 4
-	10832, // 5
-	0,     // 6
-	10837, // 7
-	/*10807*/ uint16(xSetOp), uint16(ROL),
+	/*10760*/ uint16(xSetOp), uint16(JNP),
+	/*10762*/ uint16(xReadCb),
+	/*10763*/ uint16(xArgRel8),
+	/*10764*/ uint16(xMatch),
+	/*10765*/ uint16(xSetOp), uint16(JL),
+	/*10767*/ uint16(xReadCb),
+	/*10768*/ uint16(xArgRel8),
+	/*10769*/ uint16(xMatch),
+	/*10770*/ uint16(xSetOp), uint16(JGE),
+	/*10772*/ uint16(xReadCb),
+	/*10773*/ uint16(xArgRel8),
+	/*10774*/ uint16(xMatch),
+	/*10775*/ uint16(xSetOp), uint16(JLE),
+	/*10777*/ uint16(xReadCb),
+	/*10778*/ uint16(xArgRel8),
+	/*10779*/ uint16(xMatch),
+	/*10780*/ uint16(xSetOp), uint16(JG),
+	/*10782*/ uint16(xReadCb),
+	/*10783*/ uint16(xArgRel8),
+	/*10784*/ uint16(xMatch),
+	/*10785*/ uint16(xCondSlashR),
+	10794, // 0
+	10800, // 1
+	10806, // 2
+	10812, // 3
+	10818, // 4
+	10824, // 5
+	10830, // 6
+	10836, // 7
+	/*10794*/ uint16(xSetOp), uint16(ADD),
+	/*10796*/ uint16(xReadIb),
+	/*10797*/ uint16(xArgRM8),
+	/*10798*/ uint16(xArgImm8u),
+	/*10799*/ uint16(xMatch),
+	/*10800*/ uint16(xSetOp), uint16(OR),
+	/*10802*/ uint16(xReadIb),
+	/*10803*/ uint16(xArgRM8),
+	/*10804*/ uint16(xArgImm8u),
+	/*10805*/ uint16(xMatch),
+	/*10806*/ uint16(xSetOp), uint16(ADC),
+	/*10808*/ uint16(xReadIb),
 	/*10809*/ uint16(xArgRM8),
-	/*10810*/ uint16(xArg1),
+	/*10810*/ uint16(xArgImm8u),
 	/*10811*/ uint16(xMatch),
-	/*10812*/ uint16(xSetOp), uint16(ROR),
-	/*10814*/ uint16(xArgRM8),
-	/*10815*/ uint16(xArg1),
-	/*10816*/ uint16(xMatch),
-	/*10817*/ uint16(xSetOp), uint16(RCL),
-	/*10819*/ uint16(xArgRM8),
-	/*10820*/ uint16(xArg1),
-	/*10821*/ uint16(xMatch),
-	/*10822*/ uint16(xSetOp), uint16(RCR),
-	/*10824*/ uint16(xArgRM8),
-	/*10825*/ uint16(xArg1),
-	/*10826*/ uint16(xMatch),
-	/*10827*/ uint16(xSetOp), uint16(SHL),
-	/*10829*/ uint16(xArgRM8),
-	/*10830*/ uint16(xArg1),
-	/*10831*/ uint16(xMatch),
-	/*10832*/ uint16(xSetOp), uint16(SHR),
-	/*10834*/ uint16(xArgRM8),
-	/*10835*/ uint16(xArg1),
-	/*10836*/ uint16(xMatch),
-	/*10837*/ uint16(xSetOp), uint16(SAR),
+	/*10812*/ uint16(xSetOp), uint16(SBB),
+	/*10814*/ uint16(xReadIb),
+	/*10815*/ uint16(xArgRM8),
+	/*10816*/ uint16(xArgImm8u),
+	/*10817*/ uint16(xMatch),
+	/*10818*/ uint16(xSetOp), uint16(AND),
+	/*10820*/ uint16(xReadIb),
+	/*10821*/ uint16(xArgRM8),
+	/*10822*/ uint16(xArgImm8u),
+	/*10823*/ uint16(xMatch),
+	/*10824*/ uint16(xSetOp), uint16(SUB),
+	/*10826*/ uint16(xReadIb),
+	/*10827*/ uint16(xArgRM8),
+	/*10828*/ uint16(xArgImm8u),
+	/*10829*/ uint16(xMatch),
+	/*10830*/ uint16(xSetOp), uint16(XOR),
+	/*10832*/ uint16(xReadIb),
+	/*10833*/ uint16(xArgRM8),
+	/*10834*/ uint16(xArgImm8u),
+	/*10835*/ uint16(xMatch),
+	/*10836*/ uint16(xSetOp), uint16(CMP),
+	/*10838*/ uint16(xReadIb),
 	/*10839*/ uint16(xArgRM8),
-	/*10840*/ uint16(xArg1),
+	/*10840*/ uint16(xArgImm8u),
 	/*10841*/ uint16(xMatch),
 	/*10842*/ uint16(xCondSlashR),
 	10851, // 0
-	10877, // 1
-	10903, // 2
-	10929, // 3
-	10955, // 4
-	10981, // 5
-	0,     // 6
-	11007, // 7
-	/*10851*/ uint16(xCondIs64), 10854, 10868,
-	/*10854*/ uint16(xCondDataSize), 10858, 10863, 0,
-	/*10858*/ uint16(xSetOp), uint16(ROL),
-	/*10860*/ uint16(xArgRM16),
-	/*10861*/ uint16(xArg1),
-	/*10862*/ uint16(xMatch),
-	/*10863*/ uint16(xSetOp), uint16(ROL),
-	/*10865*/ uint16(xArgRM32),
-	/*10866*/ uint16(xArg1),
-	/*10867*/ uint16(xMatch),
-	/*10868*/ uint16(xCondDataSize), 10858, 10863, 10872,
-	/*10872*/ uint16(xSetOp), uint16(ROL),
-	/*10874*/ uint16(xArgRM64),
-	/*10875*/ uint16(xArg1),
-	/*10876*/ uint16(xMatch),
-	/*10877*/ uint16(xCondIs64), 10880, 10894,
-	/*10880*/ uint16(xCondDataSize), 10884, 10889, 0,
-	/*10884*/ uint16(xSetOp), uint16(ROR),
-	/*10886*/ uint16(xArgRM16),
-	/*10887*/ uint16(xArg1),
-	/*10888*/ uint16(xMatch),
-	/*10889*/ uint16(xSetOp), uint16(ROR),
-	/*10891*/ uint16(xArgRM32),
-	/*10892*/ uint16(xArg1),
-	/*10893*/ uint16(xMatch),
-	/*10894*/ uint16(xCondDataSize), 10884, 10889, 10898,
-	/*10898*/ uint16(xSetOp), uint16(ROR),
-	/*10900*/ uint16(xArgRM64),
-	/*10901*/ uint16(xArg1),
-	/*10902*/ uint16(xMatch),
-	/*10903*/ uint16(xCondIs64), 10906, 10920,
-	/*10906*/ uint16(xCondDataSize), 10910, 10915, 0,
-	/*10910*/ uint16(xSetOp), uint16(RCL),
-	/*10912*/ uint16(xArgRM16),
-	/*10913*/ uint16(xArg1),
-	/*10914*/ uint16(xMatch),
-	/*10915*/ uint16(xSetOp), uint16(RCL),
-	/*10917*/ uint16(xArgRM32),
-	/*10918*/ uint16(xArg1),
-	/*10919*/ uint16(xMatch),
-	/*10920*/ uint16(xCondDataSize), 10910, 10915, 10924,
-	/*10924*/ uint16(xSetOp), uint16(RCL),
-	/*10926*/ uint16(xArgRM64),
-	/*10927*/ uint16(xArg1),
-	/*10928*/ uint16(xMatch),
-	/*10929*/ uint16(xCondIs64), 10932, 10946,
-	/*10932*/ uint16(xCondDataSize), 10936, 10941, 0,
-	/*10936*/ uint16(xSetOp), uint16(RCR),
-	/*10938*/ uint16(xArgRM16),
-	/*10939*/ uint16(xArg1),
-	/*10940*/ uint16(xMatch),
-	/*10941*/ uint16(xSetOp), uint16(RCR),
-	/*10943*/ uint16(xArgRM32),
-	/*10944*/ uint16(xArg1),
-	/*10945*/ uint16(xMatch),
-	/*10946*/ uint16(xCondDataSize), 10936, 10941, 10950,
-	/*10950*/ uint16(xSetOp), uint16(RCR),
-	/*10952*/ uint16(xArgRM64),
-	/*10953*/ uint16(xArg1),
-	/*10954*/ uint16(xMatch),
-	/*10955*/ uint16(xCondIs64), 10958, 10972,
-	/*10958*/ uint16(xCondDataSize), 10962, 10967, 0,
-	/*10962*/ uint16(xSetOp), uint16(SHL),
-	/*10964*/ uint16(xArgRM16),
-	/*10965*/ uint16(xArg1),
+	10880, // 1
+	10909, // 2
+	10938, // 3
+	10967, // 4
+	10996, // 5
+	11025, // 6
+	11054, // 7
+	/*10851*/ uint16(xCondIs64), 10854, 10870,
+	/*10854*/ uint16(xCondDataSize), 10858, 10864, 0,
+	/*10858*/ uint16(xSetOp), uint16(ADD),
+	/*10860*/ uint16(xReadIw),
+	/*10861*/ uint16(xArgRM16),
+	/*10862*/ uint16(xArgImm16),
+	/*10863*/ uint16(xMatch),
+	/*10864*/ uint16(xSetOp), uint16(ADD),
+	/*10866*/ uint16(xReadId),
+	/*10867*/ uint16(xArgRM32),
+	/*10868*/ uint16(xArgImm32),
+	/*10869*/ uint16(xMatch),
+	/*10870*/ uint16(xCondDataSize), 10858, 10864, 10874,
+	/*10874*/ uint16(xSetOp), uint16(ADD),
+	/*10876*/ uint16(xReadId),
+	/*10877*/ uint16(xArgRM64),
+	/*10878*/ uint16(xArgImm32),
+	/*10879*/ uint16(xMatch),
+	/*10880*/ uint16(xCondIs64), 10883, 10899,
+	/*10883*/ uint16(xCondDataSize), 10887, 10893, 0,
+	/*10887*/ uint16(xSetOp), uint16(OR),
+	/*10889*/ uint16(xReadIw),
+	/*10890*/ uint16(xArgRM16),
+	/*10891*/ uint16(xArgImm16),
+	/*10892*/ uint16(xMatch),
+	/*10893*/ uint16(xSetOp), uint16(OR),
+	/*10895*/ uint16(xReadId),
+	/*10896*/ uint16(xArgRM32),
+	/*10897*/ uint16(xArgImm32),
+	/*10898*/ uint16(xMatch),
+	/*10899*/ uint16(xCondDataSize), 10887, 10893, 10903,
+	/*10903*/ uint16(xSetOp), uint16(OR),
+	/*10905*/ uint16(xReadId),
+	/*10906*/ uint16(xArgRM64),
+	/*10907*/ uint16(xArgImm32),
+	/*10908*/ uint16(xMatch),
+	/*10909*/ uint16(xCondIs64), 10912, 10928,
+	/*10912*/ uint16(xCondDataSize), 10916, 10922, 0,
+	/*10916*/ uint16(xSetOp), uint16(ADC),
+	/*10918*/ uint16(xReadIw),
+	/*10919*/ uint16(xArgRM16),
+	/*10920*/ uint16(xArgImm16),
+	/*10921*/ uint16(xMatch),
+	/*10922*/ uint16(xSetOp), uint16(ADC),
+	/*10924*/ uint16(xReadId),
+	/*10925*/ uint16(xArgRM32),
+	/*10926*/ uint16(xArgImm32),
+	/*10927*/ uint16(xMatch),
+	/*10928*/ uint16(xCondDataSize), 10916, 10922, 10932,
+	/*10932*/ uint16(xSetOp), uint16(ADC),
+	/*10934*/ uint16(xReadId),
+	/*10935*/ uint16(xArgRM64),
+	/*10936*/ uint16(xArgImm32),
+	/*10937*/ uint16(xMatch),
+	/*10938*/ uint16(xCondIs64), 10941, 10957,
+	/*10941*/ uint16(xCondDataSize), 10945, 10951, 0,
+	/*10945*/ uint16(xSetOp), uint16(SBB),
+	/*10947*/ uint16(xReadIw),
+	/*10948*/ uint16(xArgRM16),
+	/*10949*/ uint16(xArgImm16),
+	/*10950*/ uint16(xMatch),
+	/*10951*/ uint16(xSetOp), uint16(SBB),
+	/*10953*/ uint16(xReadId),
+	/*10954*/ uint16(xArgRM32),
+	/*10955*/ uint16(xArgImm32),
+	/*10956*/ uint16(xMatch),
+	/*10957*/ uint16(xCondDataSize), 10945, 10951, 10961,
+	/*10961*/ uint16(xSetOp), uint16(SBB),
+	/*10963*/ uint16(xReadId),
+	/*10964*/ uint16(xArgRM64),
+	/*10965*/ uint16(xArgImm32),
 	/*10966*/ uint16(xMatch),
-	/*10967*/ uint16(xSetOp), uint16(SHL),
-	/*10969*/ uint16(xArgRM32),
-	/*10970*/ uint16(xArg1),
-	/*10971*/ uint16(xMatch),
-	/*10972*/ uint16(xCondDataSize), 10962, 10967, 10976,
-	/*10976*/ uint16(xSetOp), uint16(SHL),
-	/*10978*/ uint16(xArgRM64),
-	/*10979*/ uint16(xArg1),
-	/*10980*/ uint16(xMatch),
-	/*10981*/ uint16(xCondIs64), 10984, 10998,
-	/*10984*/ uint16(xCondDataSize), 10988, 10993, 0,
-	/*10988*/ uint16(xSetOp), uint16(SHR),
-	/*10990*/ uint16(xArgRM16),
-	/*10991*/ uint16(xArg1),
-	/*10992*/ uint16(xMatch),
-	/*10993*/ uint16(xSetOp), uint16(SHR),
-	/*10995*/ uint16(xArgRM32),
-	/*10996*/ uint16(xArg1),
-	/*10997*/ uint16(xMatch),
-	/*10998*/ uint16(xCondDataSize), 10988, 10993, 11002,
-	/*11002*/ uint16(xSetOp), uint16(SHR),
-	/*11004*/ uint16(xArgRM64),
-	/*11005*/ uint16(xArg1),
-	/*11006*/ uint16(xMatch),
-	/*11007*/ uint16(xCondIs64), 11010, 11024,
-	/*11010*/ uint16(xCondDataSize), 11014, 11019, 0,
-	/*11014*/ uint16(xSetOp), uint16(SAR),
-	/*11016*/ uint16(xArgRM16),
-	/*11017*/ uint16(xArg1),
-	/*11018*/ uint16(xMatch),
-	/*11019*/ uint16(xSetOp), uint16(SAR),
-	/*11021*/ uint16(xArgRM32),
-	/*11022*/ uint16(xArg1),
-	/*11023*/ uint16(xMatch),
-	/*11024*/ uint16(xCondDataSize), 11014, 11019, 11028,
-	/*11028*/ uint16(xSetOp), uint16(SAR),
-	/*11030*/ uint16(xArgRM64),
-	/*11031*/ uint16(xArg1),
-	/*11032*/ uint16(xMatch),
-	/*11033*/ uint16(xCondSlashR),
-	11042, // 0
-	11047, // 1
-	11052, // 2
-	11057, // 3
-	11062, // 4
-	11067, // 5
-	0,     // 6
-	11072, // 7
-	/*11042*/ uint16(xSetOp), uint16(ROL),
-	/*11044*/ uint16(xArgRM8),
-	/*11045*/ uint16(xArgCL),
-	/*11046*/ uint16(xMatch),
-	/*11047*/ uint16(xSetOp), uint16(ROR),
-	/*11049*/ uint16(xArgRM8),
-	/*11050*/ uint16(xArgCL),
-	/*11051*/ uint16(xMatch),
-	/*11052*/ uint16(xSetOp), uint16(RCL),
-	/*11054*/ uint16(xArgRM8),
-	/*11055*/ uint16(xArgCL),
-	/*11056*/ uint16(xMatch),
-	/*11057*/ uint16(xSetOp), uint16(RCR),
-	/*11059*/ uint16(xArgRM8),
-	/*11060*/ uint16(xArgCL),
-	/*11061*/ uint16(xMatch),
-	/*11062*/ uint16(xSetOp), uint16(SHL),
-	/*11064*/ uint16(xArgRM8),
-	/*11065*/ uint16(xArgCL),
+	/*10967*/ uint16(xCondIs64), 10970, 10986,
+	/*10970*/ uint16(xCondDataSize), 10974, 10980, 0,
+	/*10974*/ uint16(xSetOp), uint16(AND),
+	/*10976*/ uint16(xReadIw),
+	/*10977*/ uint16(xArgRM16),
+	/*10978*/ uint16(xArgImm16),
+	/*10979*/ uint16(xMatch),
+	/*10980*/ uint16(xSetOp), uint16(AND),
+	/*10982*/ uint16(xReadId),
+	/*10983*/ uint16(xArgRM32),
+	/*10984*/ uint16(xArgImm32),
+	/*10985*/ uint16(xMatch),
+	/*10986*/ uint16(xCondDataSize), 10974, 10980, 10990,
+	/*10990*/ uint16(xSetOp), uint16(AND),
+	/*10992*/ uint16(xReadId),
+	/*10993*/ uint16(xArgRM64),
+	/*10994*/ uint16(xArgImm32),
+	/*10995*/ uint16(xMatch),
+	/*10996*/ uint16(xCondIs64), 10999, 11015,
+	/*10999*/ uint16(xCondDataSize), 11003, 11009, 0,
+	/*11003*/ uint16(xSetOp), uint16(SUB),
+	/*11005*/ uint16(xReadIw),
+	/*11006*/ uint16(xArgRM16),
+	/*11007*/ uint16(xArgImm16),
+	/*11008*/ uint16(xMatch),
+	/*11009*/ uint16(xSetOp), uint16(SUB),
+	/*11011*/ uint16(xReadId),
+	/*11012*/ uint16(xArgRM32),
+	/*11013*/ uint16(xArgImm32),
+	/*11014*/ uint16(xMatch),
+	/*11015*/ uint16(xCondDataSize), 11003, 11009, 11019,
+	/*11019*/ uint16(xSetOp), uint16(SUB),
+	/*11021*/ uint16(xReadId),
+	/*11022*/ uint16(xArgRM64),
+	/*11023*/ uint16(xArgImm32),
+	/*11024*/ uint16(xMatch),
+	/*11025*/ uint16(xCondIs64), 11028, 11044,
+	/*11028*/ uint16(xCondDataSize), 11032, 11038, 0,
+	/*11032*/ uint16(xSetOp), uint16(XOR),
+	/*11034*/ uint16(xReadIw),
+	/*11035*/ uint16(xArgRM16),
+	/*11036*/ uint16(xArgImm16),
+	/*11037*/ uint16(xMatch),
+	/*11038*/ uint16(xSetOp), uint16(XOR),
+	/*11040*/ uint16(xReadId),
+	/*11041*/ uint16(xArgRM32),
+	/*11042*/ uint16(xArgImm32),
+	/*11043*/ uint16(xMatch),
+	/*11044*/ uint16(xCondDataSize), 11032, 11038, 11048,
+	/*11048*/ uint16(xSetOp), uint16(XOR),
+	/*11050*/ uint16(xReadId),
+	/*11051*/ uint16(xArgRM64),
+	/*11052*/ uint16(xArgImm32),
+	/*11053*/ uint16(xMatch),
+	/*11054*/ uint16(xCondIs64), 11057, 11073,
+	/*11057*/ uint16(xCondDataSize), 11061, 11067, 0,
+	/*11061*/ uint16(xSetOp), uint16(CMP),
+	/*11063*/ uint16(xReadIw),
+	/*11064*/ uint16(xArgRM16),
+	/*11065*/ uint16(xArgImm16),
 	/*11066*/ uint16(xMatch),
-	/*11067*/ uint16(xSetOp), uint16(SHR),
-	/*11069*/ uint16(xArgRM8),
-	/*11070*/ uint16(xArgCL),
-	/*11071*/ uint16(xMatch),
-	/*11072*/ uint16(xSetOp), uint16(SAR),
-	/*11074*/ uint16(xArgRM8),
-	/*11075*/ uint16(xArgCL),
-	/*11076*/ uint16(xMatch),
-	/*11077*/ uint16(xCondSlashR),
-	11086, // 0
-	11112, // 1
-	11138, // 2
-	11164, // 3
-	11190, // 4
-	11216, // 5
-	0,     // 6
-	11242, // 7
-	/*11086*/ uint16(xCondIs64), 11089, 11103,
-	/*11089*/ uint16(xCondDataSize), 11093, 11098, 0,
-	/*11093*/ uint16(xSetOp), uint16(ROL),
-	/*11095*/ uint16(xArgRM16),
-	/*11096*/ uint16(xArgCL),
-	/*11097*/ uint16(xMatch),
-	/*11098*/ uint16(xSetOp), uint16(ROL),
-	/*11100*/ uint16(xArgRM32),
-	/*11101*/ uint16(xArgCL),
-	/*11102*/ uint16(xMatch),
-	/*11103*/ uint16(xCondDataSize), 11093, 11098, 11107,
-	/*11107*/ uint16(xSetOp), uint16(ROL),
-	/*11109*/ uint16(xArgRM64),
-	/*11110*/ uint16(xArgCL),
-	/*11111*/ uint16(xMatch),
-	/*11112*/ uint16(xCondIs64), 11115, 11129,
-	/*11115*/ uint16(xCondDataSize), 11119, 11124, 0,
-	/*11119*/ uint16(xSetOp), uint16(ROR),
-	/*11121*/ uint16(xArgRM16),
-	/*11122*/ uint16(xArgCL),
-	/*11123*/ uint16(xMatch),
-	/*11124*/ uint16(xSetOp), uint16(ROR),
-	/*11126*/ uint16(xArgRM32),
-	/*11127*/ uint16(xArgCL),
-	/*11128*/ uint16(xMatch),
-	/*11129*/ uint16(xCondDataSize), 11119, 11124, 11133,
-	/*11133*/ uint16(xSetOp), uint16(ROR),
-	/*11135*/ uint16(xArgRM64),
-	/*11136*/ uint16(xArgCL),
-	/*11137*/ uint16(xMatch),
-	/*11138*/ uint16(xCondIs64), 11141, 11155,
-	/*11141*/ uint16(xCondDataSize), 11145, 11150, 0,
-	/*11145*/ uint16(xSetOp), uint16(RCL),
-	/*11147*/ uint16(xArgRM16),
-	/*11148*/ uint16(xArgCL),
+	/*11067*/ uint16(xSetOp), uint16(CMP),
+	/*11069*/ uint16(xReadId),
+	/*11070*/ uint16(xArgRM32),
+	/*11071*/ uint16(xArgImm32),
+	/*11072*/ uint16(xMatch),
+	/*11073*/ uint16(xCondDataSize), 11061, 11067, 11077,
+	/*11077*/ uint16(xSetOp), uint16(CMP),
+	/*11079*/ uint16(xReadId),
+	/*11080*/ uint16(xArgRM64),
+	/*11081*/ uint16(xArgImm32),
+	/*11082*/ uint16(xMatch),
+	/*11083*/ uint16(xCondSlashR),
+	11092, // 0
+	11121, // 1
+	11150, // 2
+	11179, // 3
+	11208, // 4
+	11237, // 5
+	11266, // 6
+	11295, // 7
+	/*11092*/ uint16(xCondIs64), 11095, 11111,
+	/*11095*/ uint16(xCondDataSize), 11099, 11105, 0,
+	/*11099*/ uint16(xSetOp), uint16(ADD),
+	/*11101*/ uint16(xReadIb),
+	/*11102*/ uint16(xArgRM16),
+	/*11103*/ uint16(xArgImm8),
+	/*11104*/ uint16(xMatch),
+	/*11105*/ uint16(xSetOp), uint16(ADD),
+	/*11107*/ uint16(xReadIb),
+	/*11108*/ uint16(xArgRM32),
+	/*11109*/ uint16(xArgImm8),
+	/*11110*/ uint16(xMatch),
+	/*11111*/ uint16(xCondDataSize), 11099, 11105, 11115,
+	/*11115*/ uint16(xSetOp), uint16(ADD),
+	/*11117*/ uint16(xReadIb),
+	/*11118*/ uint16(xArgRM64),
+	/*11119*/ uint16(xArgImm8),
+	/*11120*/ uint16(xMatch),
+	/*11121*/ uint16(xCondIs64), 11124, 11140,
+	/*11124*/ uint16(xCondDataSize), 11128, 11134, 0,
+	/*11128*/ uint16(xSetOp), uint16(OR),
+	/*11130*/ uint16(xReadIb),
+	/*11131*/ uint16(xArgRM16),
+	/*11132*/ uint16(xArgImm8),
+	/*11133*/ uint16(xMatch),
+	/*11134*/ uint16(xSetOp), uint16(OR),
+	/*11136*/ uint16(xReadIb),
+	/*11137*/ uint16(xArgRM32),
+	/*11138*/ uint16(xArgImm8),
+	/*11139*/ uint16(xMatch),
+	/*11140*/ uint16(xCondDataSize), 11128, 11134, 11144,
+	/*11144*/ uint16(xSetOp), uint16(OR),
+	/*11146*/ uint16(xReadIb),
+	/*11147*/ uint16(xArgRM64),
+	/*11148*/ uint16(xArgImm8),
 	/*11149*/ uint16(xMatch),
-	/*11150*/ uint16(xSetOp), uint16(RCL),
-	/*11152*/ uint16(xArgRM32),
-	/*11153*/ uint16(xArgCL),
-	/*11154*/ uint16(xMatch),
-	/*11155*/ uint16(xCondDataSize), 11145, 11150, 11159,
-	/*11159*/ uint16(xSetOp), uint16(RCL),
-	/*11161*/ uint16(xArgRM64),
-	/*11162*/ uint16(xArgCL),
-	/*11163*/ uint16(xMatch),
-	/*11164*/ uint16(xCondIs64), 11167, 11181,
-	/*11167*/ uint16(xCondDataSize), 11171, 11176, 0,
-	/*11171*/ uint16(xSetOp), uint16(RCR),
-	/*11173*/ uint16(xArgRM16),
-	/*11174*/ uint16(xArgCL),
-	/*11175*/ uint16(xMatch),
-	/*11176*/ uint16(xSetOp), uint16(RCR),
-	/*11178*/ uint16(xArgRM32),
-	/*11179*/ uint16(xArgCL),
-	/*11180*/ uint16(xMatch),
-	/*11181*/ uint16(xCondDataSize), 11171, 11176, 11185,
-	/*11185*/ uint16(xSetOp), uint16(RCR),
-	/*11187*/ uint16(xArgRM64),
-	/*11188*/ uint16(xArgCL),
-	/*11189*/ uint16(xMatch),
-	/*11190*/ uint16(xCondIs64), 11193, 11207,
-	/*11193*/ uint16(xCondDataSize), 11197, 11202, 0,
-	/*11197*/ uint16(xSetOp), uint16(SHL),
-	/*11199*/ uint16(xArgRM16),
-	/*11200*/ uint16(xArgCL),
-	/*11201*/ uint16(xMatch),
-	/*11202*/ uint16(xSetOp), uint16(SHL),
-	/*11204*/ uint16(xArgRM32),
-	/*11205*/ uint16(xArgCL),
-	/*11206*/ uint16(xMatch),
-	/*11207*/ uint16(xCondDataSize), 11197, 11202, 11211,
-	/*11211*/ uint16(xSetOp), uint16(SHL),
-	/*11213*/ uint16(xArgRM64),
-	/*11214*/ uint16(xArgCL),
-	/*11215*/ uint16(xMatch),
-	/*11216*/ uint16(xCondIs64), 11219, 11233,
-	/*11219*/ uint16(xCondDataSize), 11223, 11228, 0,
-	/*11223*/ uint16(xSetOp), uint16(SHR),
-	/*11225*/ uint16(xArgRM16),
-	/*11226*/ uint16(xArgCL),
-	/*11227*/ uint16(xMatch),
-	/*11228*/ uint16(xSetOp), uint16(SHR),
-	/*11230*/ uint16(xArgRM32),
-	/*11231*/ uint16(xArgCL),
-	/*11232*/ uint16(xMatch),
-	/*11233*/ uint16(xCondDataSize), 11223, 11228, 11237,
-	/*11237*/ uint16(xSetOp), uint16(SHR),
-	/*11239*/ uint16(xArgRM64),
-	/*11240*/ uint16(xArgCL),
-	/*11241*/ uint16(xMatch),
-	/*11242*/ uint16(xCondIs64), 11245, 11259,
-	/*11245*/ uint16(xCondDataSize), 11249, 11254, 0,
-	/*11249*/ uint16(xSetOp), uint16(SAR),
-	/*11251*/ uint16(xArgRM16),
-	/*11252*/ uint16(xArgCL),
-	/*11253*/ uint16(xMatch),
-	/*11254*/ uint16(xSetOp), uint16(SAR),
-	/*11256*/ uint16(xArgRM32),
-	/*11257*/ uint16(xArgCL),
-	/*11258*/ uint16(xMatch),
-	/*11259*/ uint16(xCondDataSize), 11249, 11254, 11263,
-	/*11263*/ uint16(xSetOp), uint16(SAR),
-	/*11265*/ uint16(xArgRM64),
-	/*11266*/ uint16(xArgCL),
-	/*11267*/ uint16(xMatch),
-	/*11268*/ uint16(xCondIs64), 11271, 0,
-	/*11271*/ uint16(xSetOp), uint16(AAM),
-	/*11273*/ uint16(xReadIb),
-	/*11274*/ uint16(xArgImm8u),
-	/*11275*/ uint16(xMatch),
-	/*11276*/ uint16(xCondIs64), 11279, 0,
-	/*11279*/ uint16(xSetOp), uint16(AAD),
+	/*11150*/ uint16(xCondIs64), 11153, 11169,
+	/*11153*/ uint16(xCondDataSize), 11157, 11163, 0,
+	/*11157*/ uint16(xSetOp), uint16(ADC),
+	/*11159*/ uint16(xReadIb),
+	/*11160*/ uint16(xArgRM16),
+	/*11161*/ uint16(xArgImm8),
+	/*11162*/ uint16(xMatch),
+	/*11163*/ uint16(xSetOp), uint16(ADC),
+	/*11165*/ uint16(xReadIb),
+	/*11166*/ uint16(xArgRM32),
+	/*11167*/ uint16(xArgImm8),
+	/*11168*/ uint16(xMatch),
+	/*11169*/ uint16(xCondDataSize), 11157, 11163, 11173,
+	/*11173*/ uint16(xSetOp), uint16(ADC),
+	/*11175*/ uint16(xReadIb),
+	/*11176*/ uint16(xArgRM64),
+	/*11177*/ uint16(xArgImm8),
+	/*11178*/ uint16(xMatch),
+	/*11179*/ uint16(xCondIs64), 11182, 11198,
+	/*11182*/ uint16(xCondDataSize), 11186, 11192, 0,
+	/*11186*/ uint16(xSetOp), uint16(SBB),
+	/*11188*/ uint16(xReadIb),
+	/*11189*/ uint16(xArgRM16),
+	/*11190*/ uint16(xArgImm8),
+	/*11191*/ uint16(xMatch),
+	/*11192*/ uint16(xSetOp), uint16(SBB),
+	/*11194*/ uint16(xReadIb),
+	/*11195*/ uint16(xArgRM32),
+	/*11196*/ uint16(xArgImm8),
+	/*11197*/ uint16(xMatch),
+	/*11198*/ uint16(xCondDataSize), 11186, 11192, 11202,
+	/*11202*/ uint16(xSetOp), uint16(SBB),
+	/*11204*/ uint16(xReadIb),
+	/*11205*/ uint16(xArgRM64),
+	/*11206*/ uint16(xArgImm8),
+	/*11207*/ uint16(xMatch),
+	/*11208*/ uint16(xCondIs64), 11211, 11227,
+	/*11211*/ uint16(xCondDataSize), 11215, 11221, 0,
+	/*11215*/ uint16(xSetOp), uint16(AND),
+	/*11217*/ uint16(xReadIb),
+	/*11218*/ uint16(xArgRM16),
+	/*11219*/ uint16(xArgImm8),
+	/*11220*/ uint16(xMatch),
+	/*11221*/ uint16(xSetOp), uint16(AND),
+	/*11223*/ uint16(xReadIb),
+	/*11224*/ uint16(xArgRM32),
+	/*11225*/ uint16(xArgImm8),
+	/*11226*/ uint16(xMatch),
+	/*11227*/ uint16(xCondDataSize), 11215, 11221, 11231,
+	/*11231*/ uint16(xSetOp), uint16(AND),
+	/*11233*/ uint16(xReadIb),
+	/*11234*/ uint16(xArgRM64),
+	/*11235*/ uint16(xArgImm8),
+	/*11236*/ uint16(xMatch),
+	/*11237*/ uint16(xCondIs64), 11240, 11256,
+	/*11240*/ uint16(xCondDataSize), 11244, 11250, 0,
+	/*11244*/ uint16(xSetOp), uint16(SUB),
+	/*11246*/ uint16(xReadIb),
+	/*11247*/ uint16(xArgRM16),
+	/*11248*/ uint16(xArgImm8),
+	/*11249*/ uint16(xMatch),
+	/*11250*/ uint16(xSetOp), uint16(SUB),
+	/*11252*/ uint16(xReadIb),
+	/*11253*/ uint16(xArgRM32),
+	/*11254*/ uint16(xArgImm8),
+	/*11255*/ uint16(xMatch),
+	/*11256*/ uint16(xCondDataSize), 11244, 11250, 11260,
+	/*11260*/ uint16(xSetOp), uint16(SUB),
+	/*11262*/ uint16(xReadIb),
+	/*11263*/ uint16(xArgRM64),
+	/*11264*/ uint16(xArgImm8),
+	/*11265*/ uint16(xMatch),
+	/*11266*/ uint16(xCondIs64), 11269, 11285,
+	/*11269*/ uint16(xCondDataSize), 11273, 11279, 0,
+	/*11273*/ uint16(xSetOp), uint16(XOR),
+	/*11275*/ uint16(xReadIb),
+	/*11276*/ uint16(xArgRM16),
+	/*11277*/ uint16(xArgImm8),
+	/*11278*/ uint16(xMatch),
+	/*11279*/ uint16(xSetOp), uint16(XOR),
 	/*11281*/ uint16(xReadIb),
-	/*11282*/ uint16(xArgImm8u),
-	/*11283*/ uint16(xMatch),
-	/*11284*/ uint16(xCondIs64), 11287, 11290,
-	/*11287*/ uint16(xSetOp), uint16(XLATB),
-	/*11289*/ uint16(xMatch),
-	/*11290*/ uint16(xCondDataSize), 11287, 11287, 11294,
-	/*11294*/ uint16(xSetOp), uint16(XLATB),
-	/*11296*/ uint16(xMatch),
-	/*11297*/ uint16(xCondByte), 64,
-	0xc0, 11468,
-	0xc1, 11468,
-	0xc2, 11468,
-	0xc3, 11468,
-	0xc4, 11468,
-	0xc5, 11468,
-	0xc6, 11468,
-	0xc7, 11468,
-	0xc8, 11473,
-	0xc9, 11473,
-	0xca, 11473,
-	0xcb, 11473,
-	0xcc, 11473,
-	0xcd, 11473,
-	0xce, 11473,
-	0xcf, 11473,
-	0xd0, 11478,
-	0xd1, 11478,
-	0xd2, 11478,
-	0xd3, 11478,
-	0xd4, 11478,
-	0xd5, 11478,
-	0xd6, 11478,
-	0xd7, 11478,
-	0xd8, 11482,
-	0xd9, 11482,
-	0xda, 11482,
-	0xdb, 11482,
-	0xdc, 11482,
-	0xdd, 11482,
-	0xde, 11482,
-	0xdf, 11482,
-	0xe0, 11486,
-	0xe1, 11486,
-	0xe2, 11486,
-	0xe3, 11486,
-	0xe4, 11486,
-	0xe5, 11486,
-	0xe6, 11486,
-	0xe7, 11486,
-	0xe8, 11491,
-	0xe9, 11491,
-	0xea, 11491,
-	0xeb, 11491,
-	0xec, 11491,
-	0xed, 11491,
-	0xee, 11491,
-	0xef, 11491,
-	0xf0, 11496,
-	0xf1, 11496,
-	0xf2, 11496,
-	0xf3, 11496,
-	0xf4, 11496,
-	0xf5, 11496,
-	0xf6, 11496,
-	0xf7, 11496,
-	0xf8, 11501,
-	0xf9, 11501,
-	0xfa, 11501,
-	0xfb, 11501,
-	0xfc, 11501,
-	0xfd, 11501,
-	0xfe, 11501,
-	0xff, 11501,
-	/*11427*/ uint16(xCondSlashR),
-	11436, // 0
-	11440, // 1
-	11444, // 2
-	11448, // 3
-	11452, // 4
-	11456, // 5
-	11460, // 6
-	11464, // 7
-	/*11436*/ uint16(xSetOp), uint16(FADD),
-	/*11438*/ uint16(xArgM32fp),
-	/*11439*/ uint16(xMatch),
-	/*11440*/ uint16(xSetOp), uint16(FMUL),
-	/*11442*/ uint16(xArgM32fp),
+	/*11282*/ uint16(xArgRM32),
+	/*11283*/ uint16(xArgImm8),
+	/*11284*/ uint16(xMatch),
+	/*11285*/ uint16(xCondDataSize), 11273, 11279, 11289,
+	/*11289*/ uint16(xSetOp), uint16(XOR),
+	/*11291*/ uint16(xReadIb),
+	/*11292*/ uint16(xArgRM64),
+	/*11293*/ uint16(xArgImm8),
+	/*11294*/ uint16(xMatch),
+	/*11295*/ uint16(xCondIs64), 11298, 11314,
+	/*11298*/ uint16(xCondDataSize), 11302, 11308, 0,
+	/*11302*/ uint16(xSetOp), uint16(CMP),
+	/*11304*/ uint16(xReadIb),
+	/*11305*/ uint16(xArgRM16),
+	/*11306*/ uint16(xArgImm8),
+	/*11307*/ uint16(xMatch),
+	/*11308*/ uint16(xSetOp), uint16(CMP),
+	/*11310*/ uint16(xReadIb),
+	/*11311*/ uint16(xArgRM32),
+	/*11312*/ uint16(xArgImm8),
+	/*11313*/ uint16(xMatch),
+	/*11314*/ uint16(xCondDataSize), 11302, 11308, 11318,
+	/*11318*/ uint16(xSetOp), uint16(CMP),
+	/*11320*/ uint16(xReadIb),
+	/*11321*/ uint16(xArgRM64),
+	/*11322*/ uint16(xArgImm8),
+	/*11323*/ uint16(xMatch),
+	/*11324*/ uint16(xSetOp), uint16(TEST),
+	/*11326*/ uint16(xReadSlashR),
+	/*11327*/ uint16(xArgRM8),
+	/*11328*/ uint16(xArgR8),
+	/*11329*/ uint16(xMatch),
+	/*11330*/ uint16(xCondIs64), 11333, 11349,
+	/*11333*/ uint16(xCondDataSize), 11337, 11343, 0,
+	/*11337*/ uint16(xSetOp), uint16(TEST),
+	/*11339*/ uint16(xReadSlashR),
+	/*11340*/ uint16(xArgRM16),
+	/*11341*/ uint16(xArgR16),
+	/*11342*/ uint16(xMatch),
+	/*11343*/ uint16(xSetOp), uint16(TEST),
+	/*11345*/ uint16(xReadSlashR),
+	/*11346*/ uint16(xArgRM32),
+	/*11347*/ uint16(xArgR32),
+	/*11348*/ uint16(xMatch),
+	/*11349*/ uint16(xCondDataSize), 11337, 11343, 11353,
+	/*11353*/ uint16(xSetOp), uint16(TEST),
+	/*11355*/ uint16(xReadSlashR),
+	/*11356*/ uint16(xArgRM64),
+	/*11357*/ uint16(xArgR64),
+	/*11358*/ uint16(xMatch),
+	/*11359*/ uint16(xSetOp), uint16(XCHG),
+	/*11361*/ uint16(xReadSlashR),
+	/*11362*/ uint16(xArgRM8),
+	/*11363*/ uint16(xArgR8),
+	/*11364*/ uint16(xMatch),
+	/*11365*/ uint16(xCondIs64), 11368, 11384,
+	/*11368*/ uint16(xCondDataSize), 11372, 11378, 0,
+	/*11372*/ uint16(xSetOp), uint16(XCHG),
+	/*11374*/ uint16(xReadSlashR),
+	/*11375*/ uint16(xArgRM16),
+	/*11376*/ uint16(xArgR16),
+	/*11377*/ uint16(xMatch),
+	/*11378*/ uint16(xSetOp), uint16(XCHG),
+	/*11380*/ uint16(xReadSlashR),
+	/*11381*/ uint16(xArgRM32),
+	/*11382*/ uint16(xArgR32),
+	/*11383*/ uint16(xMatch),
+	/*11384*/ uint16(xCondDataSize), 11372, 11378, 11388,
+	/*11388*/ uint16(xSetOp), uint16(XCHG),
+	/*11390*/ uint16(xReadSlashR),
+	/*11391*/ uint16(xArgRM64),
+	/*11392*/ uint16(xArgR64),
+	/*11393*/ uint16(xMatch),
+	/*11394*/ uint16(xSetOp), uint16(MOV),
+	/*11396*/ uint16(xReadSlashR),
+	/*11397*/ uint16(xArgRM8),
+	/*11398*/ uint16(xArgR8),
+	/*11399*/ uint16(xMatch),
+	/*11400*/ uint16(xCondDataSize), 11404, 11410, 11416,
+	/*11404*/ uint16(xSetOp), uint16(MOV),
+	/*11406*/ uint16(xReadSlashR),
+	/*11407*/ uint16(xArgRM16),
+	/*11408*/ uint16(xArgR16),
+	/*11409*/ uint16(xMatch),
+	/*11410*/ uint16(xSetOp), uint16(MOV),
+	/*11412*/ uint16(xReadSlashR),
+	/*11413*/ uint16(xArgRM32),
+	/*11414*/ uint16(xArgR32),
+	/*11415*/ uint16(xMatch),
+	/*11416*/ uint16(xSetOp), uint16(MOV),
+	/*11418*/ uint16(xReadSlashR),
+	/*11419*/ uint16(xArgRM64),
+	/*11420*/ uint16(xArgR64),
+	/*11421*/ uint16(xMatch),
+	/*11422*/ uint16(xSetOp), uint16(MOV),
+	/*11424*/ uint16(xReadSlashR),
+	/*11425*/ uint16(xArgR8),
+	/*11426*/ uint16(xArgRM8),
+	/*11427*/ uint16(xMatch),
+	/*11428*/ uint16(xCondDataSize), 11432, 11438, 11444,
+	/*11432*/ uint16(xSetOp), uint16(MOV),
+	/*11434*/ uint16(xReadSlashR),
+	/*11435*/ uint16(xArgR16),
+	/*11436*/ uint16(xArgRM16),
+	/*11437*/ uint16(xMatch),
+	/*11438*/ uint16(xSetOp), uint16(MOV),
+	/*11440*/ uint16(xReadSlashR),
+	/*11441*/ uint16(xArgR32),
+	/*11442*/ uint16(xArgRM32),
 	/*11443*/ uint16(xMatch),
-	/*11444*/ uint16(xSetOp), uint16(FCOM),
-	/*11446*/ uint16(xArgM32fp),
-	/*11447*/ uint16(xMatch),
-	/*11448*/ uint16(xSetOp), uint16(FCOMP),
-	/*11450*/ uint16(xArgM32fp),
-	/*11451*/ uint16(xMatch),
-	/*11452*/ uint16(xSetOp), uint16(FSUB),
-	/*11454*/ uint16(xArgM32fp),
-	/*11455*/ uint16(xMatch),
-	/*11456*/ uint16(xSetOp), uint16(FSUBR),
-	/*11458*/ uint16(xArgM32fp),
-	/*11459*/ uint16(xMatch),
-	/*11460*/ uint16(xSetOp), uint16(FDIV),
-	/*11462*/ uint16(xArgM32fp),
-	/*11463*/ uint16(xMatch),
-	/*11464*/ uint16(xSetOp), uint16(FDIVR),
-	/*11466*/ uint16(xArgM32fp),
-	/*11467*/ uint16(xMatch),
-	/*11468*/ uint16(xSetOp), uint16(FADD),
-	/*11470*/ uint16(xArgST),
-	/*11471*/ uint16(xArgSTi),
-	/*11472*/ uint16(xMatch),
-	/*11473*/ uint16(xSetOp), uint16(FMUL),
-	/*11475*/ uint16(xArgST),
-	/*11476*/ uint16(xArgSTi),
-	/*11477*/ uint16(xMatch),
-	/*11478*/ uint16(xSetOp), uint16(FCOM),
-	/*11480*/ uint16(xArgSTi),
-	/*11481*/ uint16(xMatch),
-	/*11482*/ uint16(xSetOp), uint16(FCOMP),
-	/*11484*/ uint16(xArgSTi),
-	/*11485*/ uint16(xMatch),
-	/*11486*/ uint16(xSetOp), uint16(FSUB),
-	/*11488*/ uint16(xArgST),
-	/*11489*/ uint16(xArgSTi),
-	/*11490*/ uint16(xMatch),
-	/*11491*/ uint16(xSetOp), uint16(FSUBR),
-	/*11493*/ uint16(xArgST),
-	/*11494*/ uint16(xArgSTi),
-	/*11495*/ uint16(xMatch),
-	/*11496*/ uint16(xSetOp), uint16(FDIV),
-	/*11498*/ uint16(xArgST),
-	/*11499*/ uint16(xArgSTi),
-	/*11500*/ uint16(xMatch),
-	/*11501*/ uint16(xSetOp), uint16(FDIVR),
-	/*11503*/ uint16(xArgST),
-	/*11504*/ uint16(xArgSTi),
-	/*11505*/ uint16(xMatch),
-	/*11506*/ uint16(xCondByte), 44,
-	0xc0, 11633,
-	0xc1, 11633,
-	0xc2, 11633,
-	0xc3, 11633,
-	0xc4, 11633,
-	0xc5, 11633,
-	0xc6, 11633,
-	0xc7, 11633,
-	0xc8, 11637,
-	0xc9, 11637,
-	0xca, 11637,
-	0xcb, 11637,
-	0xcc, 11637,
-	0xcd, 11637,
-	0xce, 11637,
-	0xcf, 11637,
-	0xD0, 11641,
-	0xE0, 11644,
-	0xE1, 11647,
-	0xE4, 11650,
-	0xE5, 11653,
-	0xE8, 11656,
-	0xE9, 11659,
-	0xEA, 11662,
-	0xEB, 11665,
-	0xEC, 11668,
-	0xED, 11671,
-	0xEE, 11674,
-	0xF0, 11677,
-	0xF1, 11680,
-	0xF2, 11683,
-	0xF3, 11686,
-	0xF4, 11689,
-	0xF5, 11692,
-	0xF6, 11695,
-	0xF7, 11698,
-	0xF8, 11701,
-	0xF9, 11704,
-	0xFA, 11707,
-	0xFB, 11710,
-	0xFC, 11713,
-	0xFD, 11716,
-	0xFE, 11719,
-	0xFF, 11722,
-	/*11596*/ uint16(xCondSlashR),
-	11605, // 0
+	/*11444*/ uint16(xSetOp), uint16(MOV),
+	/*11446*/ uint16(xReadSlashR),
+	/*11447*/ uint16(xArgR64),
+	/*11448*/ uint16(xArgRM64),
+	/*11449*/ uint16(xMatch),
+	/*11450*/ uint16(xCondIs64), 11453, 11469,
+	/*11453*/ uint16(xCondDataSize), 11457, 11463, 0,
+	/*11457*/ uint16(xSetOp), uint16(MOV),
+	/*11459*/ uint16(xReadSlashR),
+	/*11460*/ uint16(xArgRM16),
+	/*11461*/ uint16(xArgSreg),
+	/*11462*/ uint16(xMatch),
+	/*11463*/ uint16(xSetOp), uint16(MOV),
+	/*11465*/ uint16(xReadSlashR),
+	/*11466*/ uint16(xArgR32M16),
+	/*11467*/ uint16(xArgSreg),
+	/*11468*/ uint16(xMatch),
+	/*11469*/ uint16(xCondDataSize), 11457, 11463, 11473,
+	/*11473*/ uint16(xSetOp), uint16(MOV),
+	/*11475*/ uint16(xReadSlashR),
+	/*11476*/ uint16(xArgR64M16),
+	/*11477*/ uint16(xArgSreg),
+	/*11478*/ uint16(xMatch),
+	/*11479*/ uint16(xCondIs64), 11482, 11498,
+	/*11482*/ uint16(xCondDataSize), 11486, 11492, 0,
+	/*11486*/ uint16(xSetOp), uint16(LEA),
+	/*11488*/ uint16(xReadSlashR),
+	/*11489*/ uint16(xArgR16),
+	/*11490*/ uint16(xArgM),
+	/*11491*/ uint16(xMatch),
+	/*11492*/ uint16(xSetOp), uint16(LEA),
+	/*11494*/ uint16(xReadSlashR),
+	/*11495*/ uint16(xArgR32),
+	/*11496*/ uint16(xArgM),
+	/*11497*/ uint16(xMatch),
+	/*11498*/ uint16(xCondDataSize), 11486, 11492, 11502,
+	/*11502*/ uint16(xSetOp), uint16(LEA),
+	/*11504*/ uint16(xReadSlashR),
+	/*11505*/ uint16(xArgR64),
+	/*11506*/ uint16(xArgM),
+	/*11507*/ uint16(xMatch),
+	/*11508*/ uint16(xCondIs64), 11511, 11527,
+	/*11511*/ uint16(xCondDataSize), 11515, 11521, 0,
+	/*11515*/ uint16(xSetOp), uint16(MOV),
+	/*11517*/ uint16(xReadSlashR),
+	/*11518*/ uint16(xArgSreg),
+	/*11519*/ uint16(xArgRM16),
+	/*11520*/ uint16(xMatch),
+	/*11521*/ uint16(xSetOp), uint16(MOV),
+	/*11523*/ uint16(xReadSlashR),
+	/*11524*/ uint16(xArgSreg),
+	/*11525*/ uint16(xArgR32M16),
+	/*11526*/ uint16(xMatch),
+	/*11527*/ uint16(xCondDataSize), 11515, 11521, 11531,
+	/*11531*/ uint16(xSetOp), uint16(MOV),
+	/*11533*/ uint16(xReadSlashR),
+	/*11534*/ uint16(xArgSreg),
+	/*11535*/ uint16(xArgR64M16),
+	/*11536*/ uint16(xMatch),
+	/*11537*/ uint16(xCondSlashR),
+	11546, // 0
 	0,     // 1
-	11609, // 2
-	11613, // 3
-	11617, // 4
-	11621, // 5
-	11625, // 6
-	11629, // 7
-	/*11605*/ uint16(xSetOp), uint16(FLD),
-	/*11607*/ uint16(xArgM32fp),
+	0,     // 2
+	0,     // 3
+	0,     // 4
+	0,     // 5
+	0,     // 6
+	0,     // 7
+	/*11546*/ uint16(xCondIs64), 11549, 11561,
+	/*11549*/ uint16(xCondDataSize), 11553, 11557, 0,
+	/*11553*/ uint16(xSetOp), uint16(POP),
+	/*11555*/ uint16(xArgRM16),
+	/*11556*/ uint16(xMatch),
+	/*11557*/ uint16(xSetOp), uint16(POP),
+	/*11559*/ uint16(xArgRM32),
+	/*11560*/ uint16(xMatch),
+	/*11561*/ uint16(xCondDataSize), 11553, 11565, 11569,
+	/*11565*/ uint16(xSetOp), uint16(POP),
+	/*11567*/ uint16(xArgRM64),
+	/*11568*/ uint16(xMatch),
+	/*11569*/ uint16(xSetOp), uint16(POP),
+	/*11571*/ uint16(xArgRM64),
+	/*11572*/ uint16(xMatch),
+	/*11573*/ uint16(xCondIs64), 11576, 11590,
+	/*11576*/ uint16(xCondDataSize), 11580, 11585, 0,
+	/*11580*/ uint16(xSetOp), uint16(XCHG),
+	/*11582*/ uint16(xArgR16op),
+	/*11583*/ uint16(xArgAX),
+	/*11584*/ uint16(xMatch),
+	/*11585*/ uint16(xSetOp), uint16(XCHG),
+	/*11587*/ uint16(xArgR32op),
+	/*11588*/ uint16(xArgEAX),
+	/*11589*/ uint16(xMatch),
+	/*11590*/ uint16(xCondDataSize), 11580, 11585, 11594,
+	/*11594*/ uint16(xSetOp), uint16(XCHG),
+	/*11596*/ uint16(xArgR64op),
+	/*11597*/ uint16(xArgRAX),
+	/*11598*/ uint16(xMatch),
+	/*11599*/ uint16(xCondIs64), 11602, 11612,
+	/*11602*/ uint16(xCondDataSize), 11606, 11609, 0,
+	/*11606*/ uint16(xSetOp), uint16(CBW),
 	/*11608*/ uint16(xMatch),
-	/*11609*/ uint16(xSetOp), uint16(FST),
-	/*11611*/ uint16(xArgM32fp),
-	/*11612*/ uint16(xMatch),
-	/*11613*/ uint16(xSetOp), uint16(FSTP),
-	/*11615*/ uint16(xArgM32fp),
-	/*11616*/ uint16(xMatch),
-	/*11617*/ uint16(xSetOp), uint16(FLDENV),
-	/*11619*/ uint16(xArgM1428byte),
-	/*11620*/ uint16(xMatch),
-	/*11621*/ uint16(xSetOp), uint16(FLDCW),
-	/*11623*/ uint16(xArgM2byte),
-	/*11624*/ uint16(xMatch),
-	/*11625*/ uint16(xSetOp), uint16(FNSTENV),
-	/*11627*/ uint16(xArgM1428byte),
+	/*11609*/ uint16(xSetOp), uint16(CWDE),
+	/*11611*/ uint16(xMatch),
+	/*11612*/ uint16(xCondDataSize), 11606, 11609, 11616,
+	/*11616*/ uint16(xSetOp), uint16(CDQE),
+	/*11618*/ uint16(xMatch),
+	/*11619*/ uint16(xCondIs64), 11622, 11632,
+	/*11622*/ uint16(xCondDataSize), 11626, 11629, 0,
+	/*11626*/ uint16(xSetOp), uint16(CWD),
 	/*11628*/ uint16(xMatch),
-	/*11629*/ uint16(xSetOp), uint16(FNSTCW),
-	/*11631*/ uint16(xArgM2byte),
-	/*11632*/ uint16(xMatch),
-	/*11633*/ uint16(xSetOp), uint16(FLD),
-	/*11635*/ uint16(xArgSTi),
-	/*11636*/ uint16(xMatch),
-	/*11637*/ uint16(xSetOp), uint16(FXCH),
-	/*11639*/ uint16(xArgSTi),
-	/*11640*/ uint16(xMatch),
-	/*11641*/ uint16(xSetOp), uint16(FNOP),
-	/*11643*/ uint16(xMatch),
-	/*11644*/ uint16(xSetOp), uint16(FCHS),
-	/*11646*/ uint16(xMatch),
-	/*11647*/ uint16(xSetOp), uint16(FABS),
-	/*11649*/ uint16(xMatch),
-	/*11650*/ uint16(xSetOp), uint16(FTST),
-	/*11652*/ uint16(xMatch),
-	/*11653*/ uint16(xSetOp), uint16(FXAM),
+	/*11629*/ uint16(xSetOp), uint16(CDQ),
+	/*11631*/ uint16(xMatch),
+	/*11632*/ uint16(xCondDataSize), 11626, 11629, 11636,
+	/*11636*/ uint16(xSetOp), uint16(CQO),
+	/*11638*/ uint16(xMatch),
+	/*11639*/ uint16(xCondIs64), 11642, 0,
+	/*11642*/ uint16(xCondDataSize), 11646, 11651, 0,
+	/*11646*/ uint16(xSetOp), uint16(LCALL),
+	/*11648*/ uint16(xReadCd),
+	/*11649*/ uint16(xArgPtr16colon16),
+	/*11650*/ uint16(xMatch),
+	/*11651*/ uint16(xSetOp), uint16(LCALL),
+	/*11653*/ uint16(xReadCp),
+	/*11654*/ uint16(xArgPtr16colon32),
 	/*11655*/ uint16(xMatch),
-	/*11656*/ uint16(xSetOp), uint16(FLD1),
+	/*11656*/ uint16(xSetOp), uint16(FWAIT),
 	/*11658*/ uint16(xMatch),
-	/*11659*/ uint16(xSetOp), uint16(FLDL2T),
-	/*11661*/ uint16(xMatch),
-	/*11662*/ uint16(xSetOp), uint16(FLDL2E),
-	/*11664*/ uint16(xMatch),
-	/*11665*/ uint16(xSetOp), uint16(FLDPI),
-	/*11667*/ uint16(xMatch),
-	/*11668*/ uint16(xSetOp), uint16(FLDLG2),
-	/*11670*/ uint16(xMatch),
-	/*11671*/ uint16(xSetOp), uint16(FLDLN2),
-	/*11673*/ uint16(xMatch),
-	/*11674*/ uint16(xSetOp), uint16(FLDZ),
-	/*11676*/ uint16(xMatch),
-	/*11677*/ uint16(xSetOp), uint16(F2XM1),
-	/*11679*/ uint16(xMatch),
-	/*11680*/ uint16(xSetOp), uint16(FYL2X),
-	/*11682*/ uint16(xMatch),
-	/*11683*/ uint16(xSetOp), uint16(FPTAN),
-	/*11685*/ uint16(xMatch),
-	/*11686*/ uint16(xSetOp), uint16(FPATAN),
-	/*11688*/ uint16(xMatch),
-	/*11689*/ uint16(xSetOp), uint16(FXTRACT),
+	/*11659*/ uint16(xCondIs64), 11662, 11672,
+	/*11662*/ uint16(xCondDataSize), 11666, 11669, 0,
+	/*11666*/ uint16(xSetOp), uint16(PUSHF),
+	/*11668*/ uint16(xMatch),
+	/*11669*/ uint16(xSetOp), uint16(PUSHFD),
+	/*11671*/ uint16(xMatch),
+	/*11672*/ uint16(xCondDataSize), 11666, 11676, 11679,
+	/*11676*/ uint16(xSetOp), uint16(PUSHFQ),
+	/*11678*/ uint16(xMatch),
+	/*11679*/ uint16(xSetOp), uint16(PUSHFQ),
+	/*11681*/ uint16(xMatch),
+	/*11682*/ uint16(xCondIs64), 11685, 11695,
+	/*11685*/ uint16(xCondDataSize), 11689, 11692, 0,
+	/*11689*/ uint16(xSetOp), uint16(POPF),
 	/*11691*/ uint16(xMatch),
-	/*11692*/ uint16(xSetOp), uint16(FPREM1),
+	/*11692*/ uint16(xSetOp), uint16(POPFD),
 	/*11694*/ uint16(xMatch),
-	/*11695*/ uint16(xSetOp), uint16(FDECSTP),
-	/*11697*/ uint16(xMatch),
-	/*11698*/ uint16(xSetOp), uint16(FINCSTP),
-	/*11700*/ uint16(xMatch),
-	/*11701*/ uint16(xSetOp), uint16(FPREM),
-	/*11703*/ uint16(xMatch),
-	/*11704*/ uint16(xSetOp), uint16(FYL2XP1),
-	/*11706*/ uint16(xMatch),
-	/*11707*/ uint16(xSetOp), uint16(FSQRT),
+	/*11695*/ uint16(xCondDataSize), 11689, 11699, 11702,
+	/*11699*/ uint16(xSetOp), uint16(POPFQ),
+	/*11701*/ uint16(xMatch),
+	/*11702*/ uint16(xSetOp), uint16(POPFQ),
+	/*11704*/ uint16(xMatch),
+	/*11705*/ uint16(xSetOp), uint16(SAHF),
+	/*11707*/ uint16(xSetFeature), uint16(FeatureLAHF_SAHF),
 	/*11709*/ uint16(xMatch),
-	/*11710*/ uint16(xSetOp), uint16(FSINCOS),
-	/*11712*/ uint16(xMatch),
-	/*11713*/ uint16(xSetOp), uint16(FRNDINT),
-	/*11715*/ uint16(xMatch),
-	/*11716*/ uint16(xSetOp), uint16(FSCALE),
-	/*11718*/ uint16(xMatch),
-	/*11719*/ uint16(xSetOp), uint16(FSIN),
-	/*11721*/ uint16(xMatch),
-	/*11722*/ uint16(xSetOp), uint16(FCOS),
-	/*11724*/ uint16(xMatch),
-	/*11725*/ uint16(xCondByte), 33,
-	0xc0, 11834,
-	0xc1, 11834,
-	0xc2, 11834,
-	0xc3, 11834,
-	0xc4, 11834,
-	0xc5, 11834,
-	0xc6, 11834,
-	0xc7, 11834,
-	0xc8, 11839,
-	0xc9, 11839,
-	0xca, 11839,
-	0xcb, 11839,
-	0xcc, 11839,
-	0xcd, 11839,
-	0xce, 11839,
-	0xcf, 11839,
-	0xd0, 11844,
-	0xd1, 11844,
-	0xd2, 11844,
-	0xd3, 11844,
-	0xd4, 11844,
-	0xd5, 11844,
-	0xd6, 11844,
-	0xd7, 11844,
-	0xd8, 11849,
-	0xd9, 11849,
-	0xda, 11849,
-	0xdb, 11849,
-	0xdc, 11849,
-	0xdd, 11849,
-	0xde, 11849,
-	0xdf, 11849,
-	0xE9, 11854,
-	/*11793*/ uint16(xCondSlashR),
-	11802, // 0
-	11806, // 1
-	11810, // 2
-	11814, // 3
-	11818, // 4
-	11822, // 5
-	11826, // 6
-	11830, // 7
-	/*11802*/ uint16(xSetOp), uint16(FIADD),
-	/*11804*/ uint16(xArgM32int),
-	/*11805*/ uint16(xMatch),
-	/*11806*/ uint16(xSetOp), uint16(FIMUL),
-	/*11808*/ uint16(xArgM32int),
+	/*11710*/ uint16(xSetOp), uint16(LAHF),
+	/*11712*/ uint16(xSetFeature), uint16(FeatureLAHF_SAHF),
+	/*11714*/ uint16(xMatch),
+	/*11715*/ uint16(xCondIs64), 11718, 11724,
+	/*11718*/ uint16(xSetOp), uint16(MOV),
+	/*11720*/ uint16(xReadCm),
+	/*11721*/ uint16(xArgAL),
+	/*11722*/ uint16(xArgMoffs8),
+	/*11723*/ uint16(xMatch),
+	/*11724*/ uint16(xCondDataSize), 11718, 11718, 11728,
+	/*11728*/ uint16(xSetOp), uint16(MOV),
+	/*11730*/ uint16(xReadCm),
+	/*11731*/ uint16(xArgAL),
+	/*11732*/ uint16(xArgMoffs8),
+	/*11733*/ uint16(xMatch),
+	/*11734*/ uint16(xCondDataSize), 11738, 11744, 11750,
+	/*11738*/ uint16(xSetOp), uint16(MOV),
+	/*11740*/ uint16(xReadCm),
+	/*11741*/ uint16(xArgAX),
+	/*11742*/ uint16(xArgMoffs16),
+	/*11743*/ uint16(xMatch),
+	/*11744*/ uint16(xSetOp), uint16(MOV),
+	/*11746*/ uint16(xReadCm),
+	/*11747*/ uint16(xArgEAX),
+	/*11748*/ uint16(xArgMoffs32),
+	/*11749*/ uint16(xMatch),
+	/*11750*/ uint16(xSetOp), uint16(MOV),
+	/*11752*/ uint16(xReadCm),
+	/*11753*/ uint16(xArgRAX),
+	/*11754*/ uint16(xArgMoffs64),
+	/*11755*/ uint16(xMatch),
+	/*11756*/ uint16(xCondIs64), 11759, 11765,
+	/*11759*/ uint16(xSetOp), uint16(MOV),
+	/*11761*/ uint16(xReadCm),
+	/*11762*/ uint16(xArgMoffs8),
+	/*11763*/ uint16(xArgAL),
+	/*11764*/ uint16(xMatch),
+	/*11765*/ uint16(xCondDataSize), 11759, 11759, 11769,
+	/*11769*/ uint16(xSetOp), uint16(MOV),
+	/*11771*/ uint16(xReadCm),
+	/*11772*/ uint16(xArgMoffs8),
+	/*11773*/ uint16(xArgAL),
+	/*11774*/ uint16(xMatch),
+	/*11775*/ uint16(xCondDataSize), 11779, 11785, 11791,
+	/*11779*/ uint16(xSetOp), uint16(MOV),
+	/*11781*/ uint16(xReadCm),
+	/*11782*/ uint16(xArgMoffs16),
+	/*11783*/ uint16(xArgAX),
+	/*11784*/ uint16(xMatch),
+	/*11785*/ uint16(xSetOp), uint16(MOV),
+	/*11787*/ uint16(xReadCm),
+	/*11788*/ uint16(xArgMoffs32),
+	/*11789*/ uint16(xArgEAX),
+	/*11790*/ uint16(xMatch),
+	/*11791*/ uint16(xSetOp), uint16(MOV),
+	/*11793*/ uint16(xReadCm),
+	/*11794*/ uint16(xArgMoffs64),
+	/*11795*/ uint16(xArgRAX),
+	/*11796*/ uint16(xMatch),
+	/*11797*/ uint16(xSetOp), uint16(MOVSB),
+	/*11799*/ uint16(xMatch),
+	/*11800*/ uint16(xCondIs64), 11803, 11813,
+	/*11803*/ uint16(xCondDataSize), 11807, 11810, 0,
+	/*11807*/ uint16(xSetOp), uint16(MOVSW),
 	/*11809*/ uint16(xMatch),
-	/*11810*/ uint16(xSetOp), uint16(FICOM),
-	/*11812*/ uint16(xArgM32int),
-	/*11813*/ uint16(xMatch),
-	/*11814*/ uint16(xSetOp), uint16(FICOMP),
-	/*11816*/ uint16(xArgM32int),
-	/*11817*/ uint16(xMatch),
-	/*11818*/ uint16(xSetOp), uint16(FISUB),
-	/*11820*/ uint16(xArgM32int),
-	/*11821*/ uint16(xMatch),
-	/*11822*/ uint16(xSetOp), uint16(FISUBR),
-	/*11824*/ uint16(xArgM32int),
-	/*11825*/ uint16(xMatch),
-	/*11826*/ uint16(xSetOp), uint16(FIDIV),
-	/*11828*/ uint16(xArgM32int),
-	/*11829*/ uint16(xMatch),
-	/*11830*/ uint16(xSetOp), uint16(FIDIVR),
-	/*11832*/ uint16(xArgM32int),
-	/*11833*/ uint16(xMatch),
-	/*11834*/ uint16(xSetOp), uint16(FCMOVB),
-	/*11836*/ uint16(xArgST),
-	/*11837*/ uint16(xArgSTi),
-	/*11838*/ uint16(xMatch),
-	/*11839*/ uint16(xSetOp), uint16(FCMOVE),
-	/*11841*/ uint16(xArgST),
-	/*11842*/ uint16(xArgSTi),
-	/*11843*/ uint16(xMatch),
-	/*11844*/ uint16(xSetOp), uint16(FCMOVBE),
-	/*11846*/ uint16(xArgST),
-	/*11847*/ uint16(xArgSTi),
+	/*11810*/ uint16(xSetOp), uint16(MOVSD),
+	/*11812*/ uint16(xMatch),
+	/*11813*/ uint16(xCondDataSize), 11807, 11810, 11817,
+	/*11817*/ uint16(xSetOp), uint16(MOVSQ),
+	/*11819*/ uint16(xMatch),
+	/*11820*/ uint16(xSetOp), uint16(CMPSB),
+	/*11822*/ uint16(xMatch),
+	/*11823*/ uint16(xCondIs64), 11826, 11836,
+	/*11826*/ uint16(xCondDataSize), 11830, 11833, 0,
+	/*11830*/ uint16(xSetOp), uint16(CMPSW),
+	/*11832*/ uint16(xMatch),
+	/*11833*/ uint16(xSetOp), uint16(CMPSD),
+	/*11835*/ uint16(xMatch),
+	/*11836*/ uint16(xCondDataSize), 11830, 11833, 11840,
+	/*11840*/ uint16(xSetOp), uint16(CMPSQ),
+	/*11842*/ uint16(xMatch),
+	/*11843*/ uint16(xSetOp), uint16(TEST),
+	/*11845*/ uint16(xReadIb),
+	/*11846*/ uint16(xArgAL),
+	/*11847*/ uint16(xArgImm8u),
 	/*11848*/ uint16(xMatch),
-	/*11849*/ uint16(xSetOp), uint16(FCMOVU),
-	/*11851*/ uint16(xArgST),
-	/*11852*/ uint16(xArgSTi),
-	/*11853*/ uint16(xMatch),
-	/*11854*/ uint16(xSetOp), uint16(FUCOMPP),
-	/*11856*/ uint16(xMatch),
-	/*11857*/ uint16(xCondByte), 50,
-	0xc0, 11992,
-	0xc1, 11992,
-	0xc2, 11992,
-	0xc3, 11992,
-	0xc4, 11992,
-	0xc5, 11992,
-	0xc6, 11992,
-	0xc7, 11992,
-	0xc8, 11997,
-	0xc9, 11997,
-	0xca, 11997,
-	0xcb, 11997,
-	0xcc, 11997,
-	0xcd, 11997,
-	0xce, 11997,
-	0xcf, 11997,
-	0xd0, 12002,
-	0xd1, 12002,
-	0xd2, 12002,
-	0xd3, 12002,
-	0xd4, 12002,
-	0xd5, 12002,
-	0xd6, 12002,
-	0xd7, 12002,
-	0xd8, 12007,
-	0xd9, 12007,
-	0xda, 12007,
-	0xdb, 12007,
-	0xdc, 12007,
-	0xdd, 12007,
-	0xde, 12007,
-	0xdf, 12007,
-	0xE2, 12012,
-	0xE3, 12015,
-	0xe8, 12018,
-	0xe9, 12018,
-	0xea, 12018,
-	0xeb, 12018,
-	0xec, 12018,
-	0xed, 12018,
-	0xee, 12018,
-	0xef, 12018,
-	0xf0, 12023,
-	0xf1, 12023,
-	0xf2, 12023,
-	0xf3, 12023,
-	0xf4, 12023,
-	0xf5, 12023,
-	0xf6, 12023,
-	0xf7, 12023,
-	/*11959*/ uint16(xCondSlashR),
-	11968, // 0
-	11972, // 1
-	11976, // 2
-	11980, // 3
-	0,     // 4
-	11984, // 5
-	0,     // 6
-	11988, // 7
-	/*11968*/ uint16(xSetOp), uint16(FILD),
-	/*11970*/ uint16(xArgM32int),
+	/*11849*/ uint16(xCondIs64), 11852, 11868,
+	/*11852*/ uint16(xCondDataSize), 11856, 11862, 0,
+	/*11856*/ uint16(xSetOp), uint16(TEST),
+	/*11858*/ uint16(xReadIw),
+	/*11859*/ uint16(xArgAX),
+	/*11860*/ uint16(xArgImm16),
+	/*11861*/ uint16(xMatch),
+	/*11862*/ uint16(xSetOp), uint16(TEST),
+	/*11864*/ uint16(xReadId),
+	/*11865*/ uint16(xArgEAX),
+	/*11866*/ uint16(xArgImm32),
+	/*11867*/ uint16(xMatch),
+	/*11868*/ uint16(xCondDataSize), 11856, 11862, 11872,
+	/*11872*/ uint16(xSetOp), uint16(TEST),
+	/*11874*/ uint16(xReadId),
+	/*11875*/ uint16(xArgRAX),
+	/*11876*/ uint16(xArgImm32),
+	/*11877*/ uint16(xMatch),
+	/*11878*/ uint16(xSetOp), uint16(STOSB),
+	/*11880*/ uint16(xMatch),
+	/*11881*/ uint16(xCondIs64), 11884, 11894,
+	/*11884*/ uint16(xCondDataSize), 11888, 11891, 0,
+	/*11888*/ uint16(xSetOp), uint16(STOSW),
+	/*11890*/ uint16(xMatch),
+	/*11891*/ uint16(xSetOp), uint16(STOSD),
+	/*11893*/ uint16(xMatch),
+	/*11894*/ uint16(xCondDataSize), 11888, 11891, 11898,
+	/*11898*/ uint16(xSetOp), uint16(STOSQ),
+	/*11900*/ uint16(xMatch),
+	/*11901*/ uint16(xSetOp), uint16(LODSB),
+	/*11903*/ uint16(xMatch),
+	/*11904*/ uint16(xCondIs64), 11907, 11917,
+	/*11907*/ uint16(xCondDataSize), 11911, 11914, 0,
+	/*11911*/ uint16(xSetOp), uint16(LODSW),
+	/*11913*/ uint16(xMatch),
+	/*11914*/ uint16(xSetOp), uint16(LODSD),
+	/*11916*/ uint16(xMatch),
+	/*11917*/ uint16(xCondDataSize), 11911, 11914, 11921,
+	/*11921*/ uint16(xSetOp), uint16(LODSQ),
+	/*11923*/ uint16(xMatch),
+	/*11924*/ uint16(xSetOp), uint16(SCASB),
+	/*11926*/ uint16(xMatch),
+	/*11927*/ uint16(xCondIs64), 11930, 11940,
+	/*11930*/ uint16(xCondDataSize), 11934, 11937, 0,
+	/*11934*/ uint16(xSetOp), uint16(SCASW),
+	/*11936*/ uint16(xMatch),
+	/*11937*/ uint16(xSetOp), uint16(SCASD),
+	/*11939*/ uint16(xMatch),
+	/*11940*/ uint16(xCondDataSize), 11934, 11937, 11944,
+	/*11944*/ uint16(xSetOp), uint16(SCASQ),
+	/*11946*/ uint16(xMatch),
+	/*11947*/ uint16(xSetOp), uint16(MOV),
+	/*11949*/ uint16(xReadIb),
+	/*11950*/ uint16(xArgR8op),
+	/*11951*/ uint16(xArgImm8u),
+	/*11952*/ uint16(xMatch),
+	/*11953*/ uint16(xCondIs64), 11956, 11972,
+	/*11956*/ uint16(xCondDataSize), 11960, 11966, 0,
+	/*11960*/ uint16(xSetOp), uint16(MOV),
+	/*11962*/ uint16(xReadIw),
+	/*11963*/ uint16(xArgR16op),
+	/*11964*/ uint16(xArgImm16),
+	/*11965*/ uint16(xMatch),
+	/*11966*/ uint16(xSetOp), uint16(MOV),
+	/*11968*/ uint16(xReadId),
+	/*11969*/ uint16(xArgR32op),
+	/*11970*/ uint16(xArgImm32),
 	/*11971*/ uint16(xMatch),
-	/*11972*/ uint16(xSetOp), uint16(FISTTP),
-	/*11974*/ uint16(xArgM32int),
-	/*11975*/ uint16(xMatch),
-	/*11976*/ uint16(xSetOp), uint16(FIST),
-	/*11978*/ uint16(xArgM32int),
-	/*11979*/ uint16(xMatch),
-	/*11980*/ uint16(xSetOp), uint16(FISTP),
-	/*11982*/ uint16(xArgM32int),
-	/*11983*/ uint16(xMatch),
-	/*11984*/ uint16(xSetOp), uint16(FLD),
-	/*11986*/ uint16(xArgM80fp),
-	/*11987*/ uint16(xMatch),
-	/*11988*/ uint16(xSetOp), uint16(FSTP),
-	/*11990*/ uint16(xArgM80fp),
-	/*11991*/ uint16(xMatch),
-	/*11992*/ uint16(xSetOp), uint16(FCMOVNB),
-	/*11994*/ uint16(xArgST),
-	/*11995*/ uint16(xArgSTi),
+	/*11972*/ uint16(xCondDataSize), 11960, 11966, 11976,
+	/*11976*/ uint16(xSetOp), uint16(MOV),
+	/*11978*/ uint16(xReadIo),
+	/*11979*/ uint16(xArgR64op),
+	/*11980*/ uint16(xArgImm64),
+	/*11981*/ uint16(xMatch),
+	/*11982*/ uint16(xCondSlashR),
+	11991, // 0
+	11997, // 1
+	12003, // 2
+	12009, // 3
+	12015, // 4
+	12021, // 5
+	0,     // 6
+	12027, // 7
+	/*11991*/ uint16(xSetOp), uint16(ROL),
+	/*11993*/ uint16(xReadIb),
+	/*11994*/ uint16(xArgRM8),
+	/*11995*/ uint16(xArgImm8u),
 	/*11996*/ uint16(xMatch),
-	/*11997*/ uint16(xSetOp), uint16(FCMOVNE),
-	/*11999*/ uint16(xArgST),
-	/*12000*/ uint16(xArgSTi),
-	/*12001*/ uint16(xMatch),
-	/*12002*/ uint16(xSetOp), uint16(FCMOVNBE),
-	/*12004*/ uint16(xArgST),
-	/*12005*/ uint16(xArgSTi),
-	/*12006*/ uint16(xMatch),
-	/*12007*/ uint16(xSetOp), uint16(FCMOVNU),
-	/*12009*/ uint16(xArgST),
-	/*12010*/ uint16(xArgSTi),
-	/*12011*/ uint16(xMatch),
-	/*12012*/ uint16(xSetOp), uint16(FNCLEX),
+	/*11997*/ uint16(xSetOp), uint16(ROR),
+	/*11999*/ uint16(xReadIb),
+	/*12000*/ uint16(xArgRM8),
+	/*12001*/ uint16(xArgImm8u),
+	/*12002*/ uint16(xMatch),
+	/*12003*/ uint16(xSetOp), uint16(RCL),
+	/*12005*/ uint16(xReadIb),
+	/*12006*/ uint16(xArgRM8),
+	/*12007*/ uint16(xArgImm8u),
+	/*12008*/ uint16(xMatch),
+	/*12009*/ uint16(xSetOp), uint16(RCR),
+	/*12011*/ uint16(xReadIb),
+	/*12012*/ uint16(xArgRM8),
+	/*12013*/ uint16(xArgImm8u),
 	/*12014*/ uint16(xMatch),
-	/*12015*/ uint16(xSetOp), uint16(FNINIT),
-	/*12017*/ uint16(xMatch),
-	/*12018*/ uint16(xSetOp), uint16(FUCOMI),
-	/*12020*/ uint16(xArgST),
-	/*12021*/ uint16(xArgSTi),
-	/*12022*/ uint16(xMatch),
-	/*12023*/ uint16(xSetOp), uint16(FCOMI),
-	/*12025*/ uint16(xArgST),
-	/*12026*/ uint16(xArgSTi),
-	/*12027*/ uint16(xMatch),
-	/*12028*/ uint16(xCondByte), 48,
-	0xc0, 12167,
-	0xc1, 12167,
-	0xc2, 12167,
-	0xc3, 12167,
-	0xc4, 12167,
-	0xc5, 12167,
-	0xc6, 12167,
-	0xc7, 12167,
-	0xc8, 12172,
-	0xc9, 12172,
-	0xca, 12172,
-	0xcb, 12172,
-	0xcc, 12172,
-	0xcd, 12172,
-	0xce, 12172,
-	0xcf, 12172,
-	0xe0, 12177,
-	0xe1, 12177,
-	0xe2, 12177,
-	0xe3, 12177,
-	0xe4, 12177,
-	0xe5, 12177,
-	0xe6, 12177,
-	0xe7, 12177,
-	0xe8, 12182,
-	0xe9, 12182,
-	0xea, 12182,
-	0xeb, 12182,
-	0xec, 12182,
-	0xed, 12182,
-	0xee, 12182,
-	0xef, 12182,
-	0xf0, 12187,
-	0xf1, 12187,
-	0xf2, 12187,
-	0xf3, 12187,
-	0xf4, 12187,
-	0xf5, 12187,
-	0xf6, 12187,
-	0xf7, 12187,
-	0xf8, 12192,
-	0xf9, 12192,
-	0xfa, 12192,
-	0xfb, 12192,
-	0xfc, 12192,
-	0xfd, 12192,
-	0xfe, 12192,
-	0xff, 12192,
-	/*12126*/ uint16(xCondSlashR),
-	12135, // 0
-	12139, // 1
-	12143, // 2
-	12147, // 3
-	12151, // 4
-	12155, // 5
-	12159, // 6
-	12163, // 7
-	/*12135*/ uint16(xSetOp), uint16(FADD),
-	/*12137*/ uint16(xArgM64fp),
-	/*12138*/ uint16(xMatch),
-	/*12139*/ uint16(xSetOp), uint16(FMUL),
-	/*12141*/ uint16(xArgM64fp),
-	/*12142*/ uint16(xMatch),
-	/*12143*/ uint16(xSetOp), uint16(FCOM),
-	/*12145*/ uint16(xArgM64fp),
-	/*12146*/ uint16(xMatch),
-	/*12147*/ uint16(xSetOp), uint16(FCOMP),
-	/*12149*/ uint16(xArgM64fp),
-	/*12150*/ uint16(xMatch),
-	/*12151*/ uint16(xSetOp), uint16(FSUB),
-	/*12153*/ uint16(xArgM64fp),
-	/*12154*/ uint16(xMatch),
-	/*12155*/ uint16(xSetOp), uint16(FSUBR),
-	/*12157*/ uint16(xArgM64fp),
-	/*12158*/ uint16(xMatch),
-	/*12159*/ uint16(xSetOp), uint16(FDIV),
-	/*12161*/ uint16(xArgM64fp),
+	/*12015*/ uint16(xSetOp), uint16(SHL),
+	/*12017*/ uint16(xReadIb),
+	/*12018*/ uint16(xArgRM8),
+	/*12019*/ uint16(xArgImm8u),
+	/*12020*/ uint16(xMatch),
+	/*12021*/ uint16(xSetOp), uint16(SHR),
+	/*12023*/ uint16(xReadIb),
+	/*12024*/ uint16(xArgRM8),
+	/*12025*/ uint16(xArgImm8u),
+	/*12026*/ uint16(xMatch),
+	/*12027*/ uint16(xSetOp), uint16(SAR),
+	/*12029*/ uint16(xReadIb),
+	/*12030*/ uint16(xArgRM8),
+	/*12031*/ uint16(xArgImm8u),
+	/*12032*/ uint16(xMatch),
+	/*12033*/ uint16(xCondSlashR),
+	12042, // 0
+	12064, // 1
+	12086, // 2
+	12115, // 3
+	12144, // 4
+	12173, // 5
+	0,     // 6
+	12202, // 7
+	/*12042*/ uint16(xCondDataSize), 12046, 12052, 12058,
+	/*12046*/ uint16(xSetOp), uint16(ROL),
+	/*12048*/ uint16(xReadIb),
+	/*12049*/ uint16(xArgRM16),
+	/*12050*/ uint16(xArgImm8u),
+	/*12051*/ uint16(xMatch),
+	/*12052*/ uint16(xSetOp), uint16(ROL),
+	/*12054*/ uint16(xReadIb),
+	/*12055*/ uint16(xArgRM32),
+	/*12056*/ uint16(xArgImm8u),
+	/*12057*/ uint16(xMatch),
+	/*12058*/ uint16(xSetOp), uint16(ROL),
+	/*12060*/ uint16(xReadIb),
+	/*12061*/ uint16(xArgRM64),
+	/*12062*/ uint16(xArgImm8u),
+	/*12063*/ uint16(xMatch),
+	/*12064*/ uint16(xCondDataSize), 12068, 12074, 12080,
+	/*12068*/ uint16(xSetOp), uint16(ROR),
+	/*12070*/ uint16(xReadIb),
+	/*12071*/ uint16(xArgRM16),
+	/*12072*/ uint16(xArgImm8u),
+	/*12073*/ uint16(xMatch),
+	/*12074*/ uint16(xSetOp), uint16(ROR),
+	/*12076*/ uint16(xReadIb),
+	/*12077*/ uint16(xArgRM32),
+	/*12078*/ uint16(xArgImm8u),
+	/*12079*/ uint16(xMatch),
+	/*12080*/ uint16(xSetOp), uint16(ROR),
+	/*12082*/ uint16(xReadIb),
+	/*12083*/ uint16(xArgRM64),
+	/*12084*/ uint16(xArgImm8u),
+	/*12085*/ uint16(xMatch),
+	/*12086*/ uint16(xCondIs64), 12089, 12105,
+	/*12089*/ uint16(xCondDataSize), 12093, 12099, 0,
+	/*12093*/ uint16(xSetOp), uint16(RCL),
+	/*12095*/ uint16(xReadIb),
+	/*12096*/ uint16(xArgRM16),
+	/*12097*/ uint16(xArgImm8u),
+	/*12098*/ uint16(xMatch),
+	/*12099*/ uint16(xSetOp), uint16(RCL),
+	/*12101*/ uint16(xReadIb),
+	/*12102*/ uint16(xArgRM32),
+	/*12103*/ uint16(xArgImm8u),
+	/*12104*/ uint16(xMatch),
+	/*12105*/ uint16(xCondDataSize), 12093, 12099, 12109,
+	/*12109*/ uint16(xSetOp), uint16(RCL),
+	/*12111*/ uint16(xReadIb),
+	/*12112*/ uint16(xArgRM64),
+	/*12113*/ uint16(xArgImm8u),
+	/*12114*/ uint16(xMatch),
+	/*12115*/ uint16(xCondIs64), 12118, 12134,
+	/*12118*/ uint16(xCondDataSize), 12122, 12128, 0,
+	/*12122*/ uint16(xSetOp), uint16(RCR),
+	/*12124*/ uint16(xReadIb),
+	/*12125*/ uint16(xArgRM16),
+	/*12126*/ uint16(xArgImm8u),
+	/*12127*/ uint16(xMatch),
+	/*12128*/ uint16(xSetOp), uint16(RCR),
+	/*12130*/ uint16(xReadIb),
+	/*12131*/ uint16(xArgRM32),
+	/*12132*/ uint16(xArgImm8u),
+	/*12133*/ uint16(xMatch),
+	/*12134*/ uint16(xCondDataSize), 12122, 12128, 12138,
+	/*12138*/ uint16(xSetOp), uint16(RCR),
+	/*12140*/ uint16(xReadIb),
+	/*12141*/ uint16(xArgRM64),
+	/*12142*/ uint16(xArgImm8u),
+	/*12143*/ uint16(xMatch),
+	/*12144*/ uint16(xCondIs64), 12147, 12163,
+	/*12147*/ uint16(xCondDataSize), 12151, 12157, 0,
+	/*12151*/ uint16(xSetOp), uint16(SHL),
+	/*12153*/ uint16(xReadIb),
+	/*12154*/ uint16(xArgRM16),
+	/*12155*/ uint16(xArgImm8u),
+	/*12156*/ uint16(xMatch),
+	/*12157*/ uint16(xSetOp), uint16(SHL),
+	/*12159*/ uint16(xReadIb),
+	/*12160*/ uint16(xArgRM32),
+	/*12161*/ uint16(xArgImm8u),
 	/*12162*/ uint16(xMatch),
-	/*12163*/ uint16(xSetOp), uint16(FDIVR),
-	/*12165*/ uint16(xArgM64fp),
-	/*12166*/ uint16(xMatch),
-	/*12167*/ uint16(xSetOp), uint16(FADD),
-	/*12169*/ uint16(xArgSTi),
-	/*12170*/ uint16(xArgST),
-	/*12171*/ uint16(xMatch),
-	/*12172*/ uint16(xSetOp), uint16(FMUL),
-	/*12174*/ uint16(xArgSTi),
-	/*12175*/ uint16(xArgST),
-	/*12176*/ uint16(xMatch),
-	/*12177*/ uint16(xSetOp), uint16(FSUBR),
-	/*12179*/ uint16(xArgSTi),
-	/*12180*/ uint16(xArgST),
-	/*12181*/ uint16(xMatch),
-	/*12182*/ uint16(xSetOp), uint16(FSUB),
-	/*12184*/ uint16(xArgSTi),
-	/*12185*/ uint16(xArgST),
-	/*12186*/ uint16(xMatch),
-	/*12187*/ uint16(xSetOp), uint16(FDIVR),
-	/*12189*/ uint16(xArgSTi),
-	/*12190*/ uint16(xArgST),
+	/*12163*/ uint16(xCondDataSize), 12151, 12157, 12167,
+	/*12167*/ uint16(xSetOp), uint16(SHL),
+	/*12169*/ uint16(xReadIb),
+	/*12170*/ uint16(xArgRM64),
+	/*12171*/ uint16(xArgImm8u),
+	/*12172*/ uint16(xMatch),
+	/*12173*/ uint16(xCondIs64), 12176, 12192,
+	/*12176*/ uint16(xCondDataSize), 12180, 12186, 0,
+	/*12180*/ uint16(xSetOp), uint16(SHR),
+	/*12182*/ uint16(xReadIb),
+	/*12183*/ uint16(xArgRM16),
+	/*12184*/ uint16(xArgImm8u),
+	/*12185*/ uint16(xMatch),
+	/*12186*/ uint16(xSetOp), uint16(SHR),
+	/*12188*/ uint16(xReadIb),
+	/*12189*/ uint16(xArgRM32),
+	/*12190*/ uint16(xArgImm8u),
 	/*12191*/ uint16(xMatch),
-	/*12192*/ uint16(xSetOp), uint16(FDIV),
-	/*12194*/ uint16(xArgSTi),
-	/*12195*/ uint16(xArgST),
-	/*12196*/ uint16(xMatch),
-	/*12197*/ uint16(xCondByte), 40,
-	0xc0, 12316,
-	0xc1, 12316,
-	0xc2, 12316,
-	0xc3, 12316,
-	0xc4, 12316,
-	0xc5, 12316,
-	0xc6, 12316,
-	0xc7, 12316,
-	0xd0, 12320,
-	0xd1, 12320,
-	0xd2, 12320,
-	0xd3, 12320,
-	0xd4, 12320,
-	0xd5, 12320,
-	0xd6, 12320,
-	0xd7, 12320,
-	0xd8, 12324,
-	0xd9, 12324,
-	0xda, 12324,
-	0xdb, 12324,
-	0xdc, 12324,
-	0xdd, 12324,
-	0xde, 12324,
-	0xdf, 12324,
-	0xe0, 12328,
-	0xe1, 12328,
-	0xe2, 12328,
-	0xe3, 12328,
-	0xe4, 12328,
-	0xe5, 12328,
-	0xe6, 12328,
-	0xe7, 12328,
-	0xe8, 12332,
-	0xe9, 12332,
-	0xea, 12332,
-	0xeb, 12332,
-	0xec, 12332,
-	0xed, 12332,
-	0xee, 12332,
-	0xef, 12332,
-	/*12279*/ uint16(xCondSlashR),
-	12288, // 0
-	12292, // 1
-	12296, // 2
-	12300, // 3
-	12304, // 4
+	/*12192*/ uint16(xCondDataSize), 12180, 12186, 12196,
+	/*12196*/ uint16(xSetOp), uint16(SHR),
+	/*12198*/ uint16(xReadIb),
+	/*12199*/ uint16(xArgRM64),
+	/*12200*/ uint16(xArgImm8u),
+	/*12201*/ uint16(xMatch),
+	/*12202*/ uint16(xCondIs64), 12205, 12221,
+	/*12205*/ uint16(xCondDataSize), 12209, 12215, 0,
+	/*12209*/ uint16(xSetOp), uint16(SAR),
+	/*12211*/ uint16(xReadIb),
+	/*12212*/ uint16(xArgRM16),
+	/*12213*/ uint16(xArgImm8u),
+	/*12214*/ uint16(xMatch),
+	/*12215*/ uint16(xSetOp), uint16(SAR),
+	/*12217*/ uint16(xReadIb),
+	/*12218*/ uint16(xArgRM32),
+	/*12219*/ uint16(xArgImm8u),
+	/*12220*/ uint16(xMatch),
+	/*12221*/ uint16(xCondDataSize), 12209, 12215, 12225,
+	/*12225*/ uint16(xSetOp), uint16(SAR),
+	/*12227*/ uint16(xReadIb),
+	/*12228*/ uint16(xArgRM64),
+	/*12229*/ uint16(xArgImm8u),
+	/*12230*/ uint16(xMatch),
+	/*12231*/ uint16(xSetOp), uint16(RET),
+	/*12233*/ uint16(xReadIw),
+	/*12234*/ uint16(xArgImm16u),
+	/*12235*/ uint16(xMatch),
+	/*12236*/ uint16(xSetOp), uint16(RET),
+	/*12238*/ uint16(xMatch),
+	/*12239*/ uint16(xCondIs64), 12242, 0,
+	/*12242*/ uint16(xCondDataSize), 12246, 12252, 0,
+	/*12246*/ uint16(xSetOp), uint16(LES),
+	/*12248*/ uint16(xReadSlashR),
+	/*12249*/ uint16(xArgR16),
+	/*12250*/ uint16(xArgM16colon16),
+	/*12251*/ uint16(xMatch),
+	/*12252*/ uint16(xSetOp), uint16(LES),
+	/*12254*/ uint16(xReadSlashR),
+	/*12255*/ uint16(xArgR32),
+	/*12256*/ uint16(xArgM16colon32),
+	/*12257*/ uint16(xMatch),
+	/*12258*/ uint16(xCondIs64), 12261, 0,
+	/*12261*/ uint16(xCondDataSize), 12265, 12271, 0,
+	/*12265*/ uint16(xSetOp), uint16(LDS),
+	/*12267*/ uint16(xReadSlashR),
+	/*12268*/ uint16(xArgR16),
+	/*12269*/ uint16(xArgM16colon16),
+	/*12270*/ uint16(xMatch),
+	/*12271*/ uint16(xSetOp), uint16(LDS),
+	/*12273*/ uint16(xReadSlashR),
+	/*12274*/ uint16(xArgR32),
+	/*12275*/ uint16(xArgM16colon32),
+	/*12276*/ uint16(xMatch),
+	/*12277*/ uint16(xCondByte), 1,
+	0xF8, 12296,
+	/*12281*/ uint16(xCondSlashR),
+	12290, // 0
+	0,     // 1
+	0,     // 2
+	0,     // 3
+	0,     // 4
 	0,     // 5
-	12308, // 6
-	12312, // 7
-	/*12288*/ uint16(xSetOp), uint16(FLD),
-	/*12290*/ uint16(xArgM64fp),
-	/*12291*/ uint16(xMatch),
-	/*12292*/ uint16(xSetOp), uint16(FISTTP),
-	/*12294*/ uint16(xArgM64int),
+	0,     // 6
+	0,     // 7
+	/*12290*/ uint16(xSetOp), uint16(MOV),
+	/*12292*/ uint16(xReadIb),
+	/*12293*/ uint16(xArgRM8),
+	/*12294*/ uint16(xArgImm8u),
 	/*12295*/ uint16(xMatch),
-	/*12296*/ uint16(xSetOp), uint16(FST),
-	/*12298*/ uint16(xArgM64fp),
-	/*12299*/ uint16(xMatch),
-	/*12300*/ uint16(xSetOp), uint16(FSTP),
-	/*12302*/ uint16(xArgM64fp),
-	/*12303*/ uint16(xMatch),
-	/*12304*/ uint16(xSetOp), uint16(FRSTOR),
-	/*12306*/ uint16(xArgM94108byte),
-	/*12307*/ uint16(xMatch),
-	/*12308*/ uint16(xSetOp), uint16(FNSAVE),
-	/*12310*/ uint16(xArgM94108byte),
-	/*12311*/ uint16(xMatch),
-	/*12312*/ uint16(xSetOp), uint16(FNSTSW),
-	/*12314*/ uint16(xArgM2byte),
-	/*12315*/ uint16(xMatch),
-	/*12316*/ uint16(xSetOp), uint16(FFREE),
-	/*12318*/ uint16(xArgSTi),
-	/*12319*/ uint16(xMatch),
-	/*12320*/ uint16(xSetOp), uint16(FST),
-	/*12322*/ uint16(xArgSTi),
-	/*12323*/ uint16(xMatch),
-	/*12324*/ uint16(xSetOp), uint16(FSTP),
-	/*12326*/ uint16(xArgSTi),
-	/*12327*/ uint16(xMatch),
-	/*12328*/ uint16(xSetOp), uint16(FUCOM),
-	/*12330*/ uint16(xArgSTi),
-	/*12331*/ uint16(xMatch),
-	/*12332*/ uint16(xSetOp), uint16(FUCOMP),
-	/*12334*/ uint16(xArgSTi),
-	/*12335*/ uint16(xMatch),
-	/*12336*/ uint16(xCondByte), 49,
-	0xc0, 12477,
-	0xc1, 12477,
-	0xc2, 12477,
-	0xc3, 12477,
-	0xc4, 12477,
-	0xc5, 12477,
-	0xc6, 12477,
-	0xc7, 12477,
-	0xc8, 12482,
-	0xc9, 12482,
-	0xca, 12482,
-	0xcb, 12482,
-	0xcc, 12482,
-	0xcd, 12482,
-	0xce, 12482,
-	0xcf, 12482,
-	0xD9, 12487,
-	0xe0, 12490,
-	0xe1, 12490,
-	0xe2, 12490,
-	0xe3, 12490,
-	0xe4, 12490,
-	0xe5, 12490,
-	0xe6, 12490,
-	0xe7, 12490,
-	0xe8, 12495,
-	0xe9, 12495,
-	0xea, 12495,
-	0xeb, 12495,
-	0xec, 12495,
-	0xed, 12495,
-	0xee, 12495,
-	0xef, 12495,
-	0xf0, 12500,
-	0xf1, 12500,
-	0xf2, 12500,
-	0xf3, 12500,
-	0xf4, 12500,
-	0xf5, 12500,
-	0xf6, 12500,
-	0xf7, 12500,
-	0xf8, 12505,
-	0xf9, 12505,
-	0xfa, 12505,
-	0xfb, 12505,
-	0xfc, 12505,
-	0xfd, 12505,
-	0xfe, 12505,
-	0xff, 12505,
-	/*12436*/ uint16(xCondSlashR),
-	12445, // 0
-	12449, // 1
-	12453, // 2
-	12457, // 3
-	12461, // 4
-	12465, // 5
-	12469, // 6
-	12473, // 7
-	/*12445*/ uint16(xSetOp), uint16(FIADD),
-	/*12447*/ uint16(xArgM16int),
-	/*12448*/ uint16(xMatch),
-	/*12449*/ uint16(xSetOp), uint16(FIMUL),
-	/*12451*/ uint16(xArgM16int),
-	/*12452*/ uint16(xMatch),
-	/*12453*/ uint16(xSetOp), uint16(FICOM),
-	/*12455*/ uint16(xArgM16int),
+	/*12296*/ uint16(xSetOp), uint16(XABORT),
+	/*12298*/ uint16(xSetFeature), uint16(FeatureRTM),
+	/*12300*/ uint16(xReadIb),
+	/*12301*/ uint16(xArgImm8u),
+	/*12302*/ uint16(xMatch),
+	/*12303*/ uint16(xCondByte), 1,
+	0xF8, 12345,
+	/*12307*/ uint16(xCondSlashR),
+	12316, // 0
+	0,     // 1
+	0,     // 2
+	0,     // 3
+	0,     // 4
+	0,     // 5
+	0,     // 6
+	0,     // 7
+	/*12316*/ uint16(xCondIs64), 12319, 12335,
+	/*12319*/ uint16(xCondDataSize), 12323, 12329, 0,
+	/*12323*/ uint16(xSetOp), uint16(MOV),
+	/*12325*/ uint16(xReadIw),
+	/*12326*/ uint16(xArgRM16),
+	/*12327*/ uint16(xArgImm16),
+	/*12328*/ uint16(xMatch),
+	/*12329*/ uint16(xSetOp), uint16(MOV),
+	/*12331*/ uint16(xReadId),
+	/*12332*/ uint16(xArgRM32),
+	/*12333*/ uint16(xArgImm32),
+	/*12334*/ uint16(xMatch),
+	/*12335*/ uint16(xCondDataSize), 12323, 12329, 12339,
+	/*12339*/ uint16(xSetOp), uint16(MOV),
+	/*12341*/ uint16(xReadId),
+	/*12342*/ uint16(xArgRM64),
+	/*12343*/ uint16(xArgImm32),
+	/*12344*/ uint16(xMatch),
+	/*12345*/ uint16(xCondDataSize), 12349, 12356, 12363,
+	/*12349*/ uint16(xSetOp), uint16(XBEGIN),
+	/*12351*/ uint16(xSetFeature), uint16(FeatureRTM),
+	/*12353*/ uint16(xReadCw),
+	/*12354*/ uint16(xArgRel16),
+	/*12355*/ uint16(xMatch),
+	/*12356*/ uint16(xSetOp), uint16(XBEGIN),
+	/*12358*/ uint16(xSetFeature), uint16(FeatureRTM),
+	/*12360*/ uint16(xReadCd),
+	/*12361*/ uint16(xArgRel32),
+	/*12362*/ uint16(xMatch),
+	/*12363*/ uint16(xSetOp), uint16(XBEGIN),
+	/*12365*/ uint16(xSetFeature), uint16(FeatureRTM),
+	/*12367*/ uint16(xReadCd),
+	/*12368*/ uint16(xArgRel32),
+	/*12369*/ uint16(xMatch),
+	/*12370*/ uint16(xSetOp), uint16(ENTER),
+	/*12372*/ uint16(xReadIw),
+	/*12373*/ uint16(xReadIb),
+	/*12374*/ uint16(xArgImm16u),
+	/*12375*/ uint16(xArgImm8u),
+	/*12376*/ uint16(xMatch),
+	/*12377*/ uint16(xCondIs64), 12380, 12390,
+	/*12380*/ uint16(xCondDataSize), 12384, 12387, 0,
+	/*12384*/ uint16(xSetOp), uint16(LEAVE),
+	/*12386*/ uint16(xMatch),
+	/*12387*/ uint16(xSetOp), uint16(LEAVE),
+	/*12389*/ uint16(xMatch),
+	/*12390*/ uint16(xCondDataSize), 12384, 12394, 12397,
+	/*12394*/ uint16(xSetOp), uint16(LEAVE),
+	/*12396*/ uint16(xMatch),
+	/*12397*/ uint16(xSetOp), uint16(LEAVE),
+	/*12399*/ uint16(xMatch),
+	/*12400*/ uint16(xSetOp), uint16(LRET),
+	/*12402*/ uint16(xReadIw),
+	/*12403*/ uint16(xArgImm16u),
+	/*12404*/ uint16(xMatch),
+	/*12405*/ uint16(xSetOp), uint16(LRET),
+	/*12407*/ uint16(xMatch),
+	/*12408*/ uint16(xSetOp), uint16(INT),
+	/*12410*/ uint16(xArg3),
+	/*12411*/ uint16(xMatch),
+	/*12412*/ uint16(xSetOp), uint16(INT),
+	/*12414*/ uint16(xReadIb),
+	/*12415*/ uint16(xArgImm8u),
+	/*12416*/ uint16(xMatch),
+	/*12417*/ uint16(xCondIs64), 12420, 0,
+	/*12420*/ uint16(xSetOp), uint16(INTO),
+	/*12422*/ uint16(xMatch),
+	/*12423*/ uint16(xCondIs64), 12426, 12436,
+	/*12426*/ uint16(xCondDataSize), 12430, 12433, 0,
+	/*12430*/ uint16(xSetOp), uint16(IRET),
+	/*12432*/ uint16(xMatch),
+	/*12433*/ uint16(xSetOp), uint16(IRETD),
+	/*12435*/ uint16(xMatch),
+	/*12436*/ uint16(xCondDataSize), 12430, 12433, 12440,
+	/*12440*/ uint16(xSetOp), uint16(IRETQ),
+	/*12442*/ uint16(xMatch),
+	/*12443*/ uint16(xCondSlashR),
+	12452, // 0
+	12457, // 1
+	12462, // 2
+	12467, // 3
+	12472, // 4
+	12477, // 5
+	0,     // 6
+	12482, // 7
+	/*12452*/ uint16(xSetOp), uint16(ROL),
+	/*12454*/ uint16(xArgRM8),
+	/*12455*/ uint16(xArg1),
 	/*12456*/ uint16(xMatch),
-	/*12457*/ uint16(xSetOp), uint16(FICOMP),
-	/*12459*/ uint16(xArgM16int),
-	/*12460*/ uint16(xMatch),
-	/*12461*/ uint16(xSetOp), uint16(FISUB),
-	/*12463*/ uint16(xArgM16int),
-	/*12464*/ uint16(xMatch),
-	/*12465*/ uint16(xSetOp), uint16(FISUBR),
-	/*12467*/ uint16(xArgM16int),
-	/*12468*/ uint16(xMatch),
-	/*12469*/ uint16(xSetOp), uint16(FIDIV),
-	/*12471*/ uint16(xArgM16int),
-	/*12472*/ uint16(xMatch),
-	/*12473*/ uint16(xSetOp), uint16(FIDIVR),
-	/*12475*/ uint16(xArgM16int),
+	/*12457*/ uint16(xSetOp), uint16(ROR),
+	/*12459*/ uint16(xArgRM8),
+	/*12460*/ uint16(xArg1),
+	/*12461*/ uint16(xMatch),
+	/*12462*/ uint16(xSetOp), uint16(RCL),
+	/*12464*/ uint16(xArgRM8),
+	/*12465*/ uint16(xArg1),
+	/*12466*/ uint16(xMatch),
+	/*12467*/ uint16(xSetOp), uint16(RCR),
+	/*12469*/ uint16(xArgRM8),
+	/*12470*/ uint16(xArg1),
+	/*12471*/ uint16(xMatch),
+	/*12472*/ uint16(xSetOp), uint16(SHL),
+	/*12474*/ uint16(xArgRM8),
+	/*12475*/ uint16(xArg1),
 	/*12476*/ uint16(xMatch),
-	/*12477*/ uint16(xSetOp), uint16(FADDP),
-	/*12479*/ uint16(xArgSTi),
-	/*12480*/ uint16(xArgST),
+	/*12477*/ uint16(xSetOp), uint16(SHR),
+	/*12479*/ uint16(xArgRM8),
+	/*12480*/ uint16(xArg1),
 	/*12481*/ uint16(xMatch),
-	/*12482*/ uint16(xSetOp), uint16(FMULP),
-	/*12484*/ uint16(xArgSTi),
-	/*12485*/ uint16(xArgST),
+	/*12482*/ uint16(xSetOp), uint16(SAR),
+	/*12484*/ uint16(xArgRM8),
+	/*12485*/ uint16(xArg1),
 	/*12486*/ uint16(xMatch),
-	/*12487*/ uint16(xSetOp), uint16(FCOMPP),
-	/*12489*/ uint16(xMatch),
-	/*12490*/ uint16(xSetOp), uint16(FSUBRP),
-	/*12492*/ uint16(xArgSTi),
-	/*12493*/ uint16(xArgST),
-	/*12494*/ uint16(xMatch),
-	/*12495*/ uint16(xSetOp), uint16(FSUBP),
-	/*12497*/ uint16(xArgSTi),
-	/*12498*/ uint16(xArgST),
-	/*12499*/ uint16(xMatch),
-	/*12500*/ uint16(xSetOp), uint16(FDIVRP),
-	/*12502*/ uint16(xArgSTi),
-	/*12503*/ uint16(xArgST),
-	/*12504*/ uint16(xMatch),
-	/*12505*/ uint16(xSetOp), uint16(FDIVP),
-	/*12507*/ uint16(xArgSTi),
-	/*12508*/ uint16(xArgST),
-	/*12509*/ uint16(xMatch),
-	/*12510*/ uint16(xCondByte), 25,
-	0xc0, 12603,
-	0xc1, 12603,
-	0xc2, 12603,
-	0xc3, 12603,
-	0xc4, 12603,
-	0xc5, 12603,
-	0xc6, 12603,
-	0xc7, 12603,
-	0xE0, 12607,
-	0xe8, 12611,
-	0xe9, 12611,
-	0xea, 12611,
-	0xeb, 12611,
-	0xec, 12611,
-	0xed, 12611,
-	0xee, 12611,
-	0xef, 12611,
-	0xf0, 12616,
-	0xf1, 12616,
-	0xf2, 12616,
-	0xf3, 12616,
-	0xf4, 12616,
-	0xf5, 12616,
-	0xf6, 12616,
-	0xf7, 12616,
-	/*12562*/ uint16(xCondSlashR),
-	12571, // 0
-	12575, // 1
-	12579, // 2
-	12583, // 3
-	12587, // 4
-	12591, // 5
-	12595, // 6
-	12599, // 7
-	/*12571*/ uint16(xSetOp), uint16(FILD),
-	/*12573*/ uint16(xArgM16int),
-	/*12574*/ uint16(xMatch),
-	/*12575*/ uint16(xSetOp), uint16(FISTTP),
-	/*12577*/ uint16(xArgM16int),
-	/*12578*/ uint16(xMatch),
-	/*12579*/ uint16(xSetOp), uint16(FIST),
-	/*12581*/ uint16(xArgM16int),
-	/*12582*/ uint16(xMatch),
-	/*12583*/ uint16(xSetOp), uint16(FISTP),
-	/*12585*/ uint16(xArgM16int),
-	/*12586*/ uint16(xMatch),
-	/*12587*/ uint16(xSetOp), uint16(FBLD),
-	/*12589*/ uint16(xArgM80dec),
+	/*12487*/ uint16(xCondSlashR),
+	12496, // 0
+	12522, // 1
+	12548, // 2
+	12574, // 3
+	12600, // 4
+	12626, // 5
+	0,     // 6
+	12652, // 7
+	/*12496*/ uint16(xCondIs64), 12499, 12513,
+	/*12499*/ uint16(xCondDataSize), 12503, 12508, 0,
+	/*12503*/ uint16(xSetOp), uint16(ROL),
+	/*12505*/ uint16(xArgRM16),
+	/*12506*/ uint16(xArg1),
+	/*12507*/ uint16(xMatch),
+	/*12508*/ uint16(xSetOp), uint16(ROL),
+	/*12510*/ uint16(xArgRM32),
+	/*12511*/ uint16(xArg1),
+	/*12512*/ uint16(xMatch),
+	/*12513*/ uint16(xCondDataSize), 12503, 12508, 12517,
+	/*12517*/ uint16(xSetOp), uint16(ROL),
+	/*12519*/ uint16(xArgRM64),
+	/*12520*/ uint16(xArg1),
+	/*12521*/ uint16(xMatch),
+	/*12522*/ uint16(xCondIs64), 12525, 12539,
+	/*12525*/ uint16(xCondDataSize), 12529, 12534, 0,
+	/*12529*/ uint16(xSetOp), uint16(ROR),
+	/*12531*/ uint16(xArgRM16),
+	/*12532*/ uint16(xArg1),
+	/*12533*/ uint16(xMatch),
+	/*12534*/ uint16(xSetOp), uint16(ROR),
+	/*12536*/ uint16(xArgRM32),
+	/*12537*/ uint16(xArg1),
+	/*12538*/ uint16(xMatch),
+	/*12539*/ uint16(xCondDataSize), 12529, 12534, 12543,
+	/*12543*/ uint16(xSetOp), uint16(ROR),
+	/*12545*/ uint16(xArgRM64),
+	/*12546*/ uint16(xArg1),
+	/*12547*/ uint16(xMatch),
+	/*12548*/ uint16(xCondIs64), 12551, 12565,
+	/*12551*/ uint16(xCondDataSize), 12555, 12560, 0,
+	/*12555*/ uint16(xSetOp), uint16(RCL),
+	/*12557*/ uint16(xArgRM16),
+	/*12558*/ uint16(xArg1),
+	/*12559*/ uint16(xMatch),
+	/*12560*/ uint16(xSetOp), uint16(RCL),
+	/*12562*/ uint16(xArgRM32),
+	/*12563*/ uint16(xArg1),
+	/*12564*/ uint16(xMatch),
+	/*12565*/ uint16(xCondDataSize), 12555, 12560, 12569,
+	/*12569*/ uint16(xSetOp), uint16(RCL),
+	/*12571*/ uint16(xArgRM64),
+	/*12572*/ uint16(xArg1),
+	/*12573*/ uint16(xMatch),
+	/*12574*/ uint16(xCondIs64), 12577, 12591,
+	/*12577*/ uint16(xCondDataSize), 12581, 12586, 0,
+	/*12581*/ uint16(xSetOp), uint16(RCR),
+	/*12583*/ uint16(xArgRM16),
+	/*12584*/ uint16(xArg1),
+	/*12585*/ uint16(xMatch),
+	/*12586*/ uint16(xSetOp), uint16(RCR),
+	/*12588*/ uint16(xArgRM32),
+	/*12589*/ uint16(xArg1),
 	/*12590*/ uint16(xMatch),
-	/*12591*/ uint16(xSetOp), uint16(FILD),
-	/*12593*/ uint16(xArgM64int),
-	/*12594*/ uint16(xMatch),
-	/*12595*/ uint16(xSetOp), uint16(FBSTP),
-	/*12597*/ uint16(xArgM80bcd),
-	/*12598*/ uint16(xMatch),
-	/*12599*/ uint16(xSetOp), uint16(FISTP),
-	/*12601*/ uint16(xArgM64int),
-	/*12602*/ uint16(xMatch),
-	/*12603*/ uint16(xSetOp), uint16(FFREEP),
-	/*12605*/ uint16(xArgSTi),
-	/*12606*/ uint16(xMatch),
-	/*12607*/ uint16(xSetOp), uint16(FNSTSW),
-	/*12609*/ uint16(xArgAX),
-	/*12610*/ uint16(xMatch),
-	/*12611*/ uint16(xSetOp), uint16(FUCOMIP),
-	/*12613*/ uint16(xArgST),
-	/*12614*/ uint16(xArgSTi),
-	/*12615*/ uint16(xMatch),
-	/*12616*/ uint16(xSetOp), uint16(FCOMIP),
-	/*12618*/ uint16(xArgST),
-	/*12619*/ uint16(xArgSTi),
-	/*12620*/ uint16(xMatch),
-	/*12621*/ uint16(xSetOp), uint16(LOOPNE),
-	/*12623*/ uint16(xReadCb),
-	/*12624*/ uint16(xArgRel8),
+	/*12591*/ uint16(xCondDataSize), 12581, 12586, 12595,
+	/*12595*/ uint16(xSetOp), uint16(RCR),
+	/*12597*/ uint16(xArgRM64),
+	/*12598*/ uint16(xArg1),
+	/*12599*/ uint16(xMatch),
+	/*12600*/ uint16(xCondIs64), 12603, 12617,
+	/*12603*/ uint16(xCondDataSize), 12607, 12612, 0,
+	/*12607*/ uint16(xSetOp), uint16(SHL),
+	/*12609*/ uint16(xArgRM16),
+	/*12610*/ uint16(xArg1),
+	/*12611*/ uint16(xMatch),
+	/*12612*/ uint16(xSetOp), uint16(SHL),
+	/*12614*/ uint16(xArgRM32),
+	/*12615*/ uint16(xArg1),
+	/*12616*/ uint16(xMatch),
+	/*12617*/ uint16(xCondDataSize), 12607, 12612, 12621,
+	/*12621*/ uint16(xSetOp), uint16(SHL),
+	/*12623*/ uint16(xArgRM64),
+	/*12624*/ uint16(xArg1),
 	/*12625*/ uint16(xMatch),
-	/*12626*/ uint16(xSetOp), uint16(LOOPE),
-	/*12628*/ uint16(xReadCb),
-	/*12629*/ uint16(xArgRel8),
-	/*12630*/ uint16(xMatch),
-	/*12631*/ uint16(xSetOp), uint16(LOOP),
-	/*12633*/ uint16(xReadCb),
-	/*12634*/ uint16(xArgRel8),
-	/*12635*/ uint16(xMatch),
-	/*12636*/ uint16(xCondIs64), 12639, 12653,
-	/*12639*/ uint16(xCondAddrSize), 12643, 12648, 0,
-	/*12643*/ uint16(xSetOp), uint16(JCXZ),
-	/*12645*/ uint16(xReadCb),
-	/*12646*/ uint16(xArgRel8),
-	/*12647*/ uint16(xMatch),
-	/*12648*/ uint16(xSetOp), uint16(JECXZ),
-	/*12650*/ uint16(xReadCb),
-	/*12651*/ uint16(xArgRel8),
-	/*12652*/ uint16(xMatch),
-	/*12653*/ uint16(xCondAddrSize), 0, 12648, 12657,
-	/*12657*/ uint16(xSetOp), uint16(JRCXZ),
-	/*12659*/ uint16(xReadCb),
-	/*12660*/ uint16(xArgRel8),
-	/*12661*/ uint16(xMatch),
-	/*12662*/ uint16(xSetOp), uint16(IN),
-	/*12664*/ uint16(xReadIb),
-	/*12665*/ uint16(xArgAL),
-	/*12666*/ uint16(xArgImm8u),
-	/*12667*/ uint16(xMatch),
-	/*12668*/ uint16(xCondDataSize), 12672, 12678, 12684,
-	/*12672*/ uint16(xSetOp), uint16(IN),
-	/*12674*/ uint16(xReadIb),
-	/*12675*/ uint16(xArgAX),
-	/*12676*/ uint16(xArgImm8u),
+	/*12626*/ uint16(xCondIs64), 12629, 12643,
+	/*12629*/ uint16(xCondDataSize), 12633, 12638, 0,
+	/*12633*/ uint16(xSetOp), uint16(SHR),
+	/*12635*/ uint16(xArgRM16),
+	/*12636*/ uint16(xArg1),
+	/*12637*/ uint16(xMatch),
+	/*12638*/ uint16(xSetOp), uint16(SHR),
+	/*12640*/ uint16(xArgRM32),
+	/*12641*/ uint16(xArg1),
+	/*12642*/ uint16(xMatch),
+	/*12643*/ uint16(xCondDataSize), 12633, 12638, 12647,
+	/*12647*/ uint16(xSetOp), uint16(SHR),
+	/*12649*/ uint16(xArgRM64),
+	/*12650*/ uint16(xArg1),
+	/*12651*/ uint16(xMatch),
+	/*12652*/ uint16(xCondIs64), 12655, 12669,
+	/*12655*/ uint16(xCondDataSize), 12659, 12664, 0,
+	/*12659*/ uint16(xSetOp), uint16(SAR),
+	/*12661*/ uint16(xArgRM16),
+	/*12662*/ uint16(xArg1),
+	/*12663*/ uint16(xMatch),
+	/*12664*/ uint16(xSetOp), uint16(SAR),
+	/*12666*/ uint16(xArgRM32),
+	/*12667*/ uint16(xArg1),
+	/*12668*/ uint16(xMatch),
+	/*12669*/ uint16(xCondDataSize), 12659, 12664, 12673,
+	/*12673*/ uint16(xSetOp), uint16(SAR),
+	/*12675*/ uint16(xArgRM64),
+	/*12676*/ uint16(xArg1),
 	/*12677*/ uint16(xMatch),
-	/*12678*/ uint16(xSetOp), uint16(IN),
-	/*12680*/ uint16(xReadIb),
-	/*12681*/ uint16(xArgEAX),
-	/*12682*/ uint16(xArgImm8u),
-	/*12683*/ uint16(xMatch),
-	/*12684*/ uint16(xSetOp), uint16(IN),
-	/*12686*/ uint16(xReadIb),
-	/*12687*/ uint16(xArgEAX),
-	/*12688*/ uint16(xArgImm8u),
-	/*12689*/ uint16(xMatch),
-	/*12690*/ uint16(xSetOp), uint16(OUT),
-	/*12692*/ uint16(xReadIb),
-	/*12693*/ uint16(xArgImm8u),
-	/*12694*/ uint16(xArgAL),
-	/*12695*/ uint16(xMatch),
-	/*12696*/ uint16(xCondDataSize), 12700, 12706, 12712,
-	/*12700*/ uint16(xSetOp), uint16(OUT),
-	/*12702*/ uint16(xReadIb),
-	/*12703*/ uint16(xArgImm8u),
-	/*12704*/ uint16(xArgAX),
-	/*12705*/ uint16(xMatch),
-	/*12706*/ uint16(xSetOp), uint16(OUT),
-	/*12708*/ uint16(xReadIb),
-	/*12709*/ uint16(xArgImm8u),
-	/*12710*/ uint16(xArgEAX),
+	/*12678*/ uint16(xCondSlashR),
+	12687, // 0
+	12692, // 1
+	12697, // 2
+	12702, // 3
+	12707, // 4
+	12712, // 5
+	0,     // 6
+	12717, // 7
+	/*12687*/ uint16(xSetOp), uint16(ROL),
+	/*12689*/ uint16(xArgRM8),
+	/*12690*/ uint16(xArgCL),
+	/*12691*/ uint16(xMatch),
+	/*12692*/ uint16(xSetOp), uint16(ROR),
+	/*12694*/ uint16(xArgRM8),
+	/*12695*/ uint16(xArgCL),
+	/*12696*/ uint16(xMatch),
+	/*12697*/ uint16(xSetOp), uint16(RCL),
+	/*12699*/ uint16(xArgRM8),
+	/*12700*/ uint16(xArgCL),
+	/*12701*/ uint16(xMatch),
+	/*12702*/ uint16(xSetOp), uint16(RCR),
+	/*12704*/ uint16(xArgRM8),
+	/*12705*/ uint16(xArgCL),
+	/*12706*/ uint16(xMatch),
+	/*12707*/ uint16(xSetOp), uint16(SHL),
+	/*12709*/ uint16(xArgRM8),
+	/*12710*/ uint16(xArgCL),
 	/*12711*/ uint16(xMatch),
-	/*12712*/ uint16(xSetOp), uint16(OUT),
-	/*12714*/ uint16(xReadIb),
-	/*12715*/ uint16(xArgImm8u),
-	/*12716*/ uint16(xArgEAX),
-	/*12717*/ uint16(xMatch),
-	/*12718*/ uint16(xCondIs64), 12721, 12735,
-	/*12721*/ uint16(xCondDataSize), 12725, 12730, 0,
-	/*12725*/ uint16(xSetOp), uint16(CALL),
-	/*12727*/ uint16(xReadCw),
-	/*12728*/ uint16(xArgRel16),
-	/*12729*/ uint16(xMatch),
-	/*12730*/ uint16(xSetOp), uint16(CALL),
-	/*12732*/ uint16(xReadCd),
-	/*12733*/ uint16(xArgRel32),
-	/*12734*/ uint16(xMatch),
-	/*12735*/ uint16(xCondDataSize), 12739, 12730, 12744,
-	/*12739*/ uint16(xSetOp), uint16(CALL),
-	/*12741*/ uint16(xReadCd),
-	/*12742*/ uint16(xArgRel32),
-	/*12743*/ uint16(xMatch),
-	/*12744*/ uint16(xSetOp), uint16(CALL),
-	/*12746*/ uint16(xReadCd),
-	/*12747*/ uint16(xArgRel32),
-	/*12748*/ uint16(xMatch),
-	/*12749*/ uint16(xCondIs64), 12752, 12766,
-	/*12752*/ uint16(xCondDataSize), 12756, 12761, 0,
-	/*12756*/ uint16(xSetOp), uint16(JMP),
-	/*12758*/ uint16(xReadCw),
-	/*12759*/ uint16(xArgRel16),
-	/*12760*/ uint16(xMatch),
-	/*12761*/ uint16(xSetOp), uint16(JMP),
-	/*12763*/ uint16(xReadCd),
-	/*12764*/ uint16(xArgRel32),
-	/*12765*/ uint16(xMatch),
-	/*12766*/ uint16(xCondDataSize), 12770, 12761, 12775,
-	/*12770*/ uint16(xSetOp), uint16(JMP),
-	/*12772*/ uint16(xReadCd),
-	/*12773*/ uint16(xArgRel32),
-	/*12774*/ uint16(xMatch),
-	/*12775*/ uint16(xSetOp), uint16(JMP),
-	/*12777*/ uint16(xReadCd),
-	/*12778*/ uint16(xArgRel32),
-	/*12779*/ uint16(xMatch),
-	/*12780*/ uint16(xCondIs64), 12783, 0,
-	/*12783*/ uint16(xCondDataSize), 12787, 12792, 0,
-	/*12787*/ uint16(xSetOp), uint16(LJMP),
-	/*12789*/ uint16(xReadCd),
-	/*12790*/ uint16(xArgPtr16colon16),
-	/*12791*/ uint16(xMatch),
-	/*12792*/ uint16(xSetOp), uint16(LJMP),
-	/*12794*/ uint16(xReadCp),
-	/*12795*/ uint16(xArgPtr16colon32),
-	/*12796*/ uint16(xMatch),
-	/*12797*/ uint16(xSetOp), uint16(JMP),
-	/*12799*/ uint16(xReadCb),
-	/*12800*/ uint16(xArgRel8),
-	/*12801*/ uint16(xMatch),
-	/*12802*/ uint16(xSetOp), uint16(IN),
-	/*12804*/ uint16(xArgAL),
-	/*12805*/ uint16(xArgDX),
-	/*12806*/ uint16(xMatch),
-	/*12807*/ uint16(xCondDataSize), 12811, 12816, 12821,
-	/*12811*/ uint16(xSetOp), uint16(IN),
-	/*12813*/ uint16(xArgAX),
-	/*12814*/ uint16(xArgDX),
-	/*12815*/ uint16(xMatch),
-	/*12816*/ uint16(xSetOp), uint16(IN),
-	/*12818*/ uint16(xArgEAX),
-	/*12819*/ uint16(xArgDX),
+	/*12712*/ uint16(xSetOp), uint16(SHR),
+	/*12714*/ uint16(xArgRM8),
+	/*12715*/ uint16(xArgCL),
+	/*12716*/ uint16(xMatch),
+	/*12717*/ uint16(xSetOp), uint16(SAR),
+	/*12719*/ uint16(xArgRM8),
+	/*12720*/ uint16(xArgCL),
+	/*12721*/ uint16(xMatch),
+	/*12722*/ uint16(xCondSlashR),
+	12731, // 0
+	12757, // 1
+	12783, // 2
+	12809, // 3
+	12835, // 4
+	12861, // 5
+	0,     // 6
+	12887, // 7
+	/*12731*/ uint16(xCondIs64), 12734, 12748,
+	/*12734*/ uint16(xCondDataSize), 12738, 12743, 0,
+	/*12738*/ uint16(xSetOp), uint16(ROL),
+	/*12740*/ uint16(xArgRM16),
+	/*12741*/ uint16(xArgCL),
+	/*12742*/ uint16(xMatch),
+	/*12743*/ uint16(xSetOp), uint16(ROL),
+	/*12745*/ uint16(xArgRM32),
+	/*12746*/ uint16(xArgCL),
+	/*12747*/ uint16(xMatch),
+	/*12748*/ uint16(xCondDataSize), 12738, 12743, 12752,
+	/*12752*/ uint16(xSetOp), uint16(ROL),
+	/*12754*/ uint16(xArgRM64),
+	/*12755*/ uint16(xArgCL),
+	/*12756*/ uint16(xMatch),
+	/*12757*/ uint16(xCondIs64), 12760, 12774,
+	/*12760*/ uint16(xCondDataSize), 12764, 12769, 0,
+	/*12764*/ uint16(xSetOp), uint16(ROR),
+	/*12766*/ uint16(xArgRM16),
+	/*12767*/ uint16(xArgCL),
+	/*12768*/ uint16(xMatch),
+	/*12769*/ uint16(xSetOp), uint16(ROR),
+	/*12771*/ uint16(xArgRM32),
+	/*12772*/ uint16(xArgCL),
+	/*12773*/ uint16(xMatch),
+	/*12774*/ uint16(xCondDataSize), 12764, 12769, 12778,
+	/*12778*/ uint16(xSetOp), uint16(ROR),
+	/*12780*/ uint16(xArgRM64),
+	/*12781*/ uint16(xArgCL),
+	/*12782*/ uint16(xMatch),
+	/*12783*/ uint16(xCondIs64), 12786, 12800,
+	/*12786*/ uint16(xCondDataSize), 12790, 12795, 0,
+	/*12790*/ uint16(xSetOp), uint16(RCL),
+	/*12792*/ uint16(xArgRM16),
+	/*12793*/ uint16(xArgCL),
+	/*12794*/ uint16(xMatch),
+	/*12795*/ uint16(xSetOp), uint16(RCL),
+	/*12797*/ uint16(xArgRM32),
+	/*12798*/ uint16(xArgCL),
+	/*12799*/ uint16(xMatch),
+	/*12800*/ uint16(xCondDataSize), 12790, 12795, 12804,
+	/*12804*/ uint16(xSetOp), uint16(RCL),
+	/*12806*/ uint16(xArgRM64),
+	/*12807*/ uint16(xArgCL),
+	/*12808*/ uint16(xMatch),
+	/*12809*/ uint16(xCondIs64), 12812, 12826,
+	/*12812*/ uint16(xCondDataSize), 12816, 12821, 0,
+	/*12816*/ uint16(xSetOp), uint16(RCR),
+	/*12818*/ uint16(xArgRM16),
+	/*12819*/ uint16(xArgCL),
 	/*12820*/ uint16(xMatch),
-	/*12821*/ uint16(xSetOp), uint16(IN),
-	/*12823*/ uint16(xArgEAX),
-	/*12824*/ uint16(xArgDX),
+	/*12821*/ uint16(xSetOp), uint16(RCR),
+	/*12823*/ uint16(xArgRM32),
+	/*12824*/ uint16(xArgCL),
 	/*12825*/ uint16(xMatch),
-	/*12826*/ uint16(xSetOp), uint16(OUT),
-	/*12828*/ uint16(xArgDX),
-	/*12829*/ uint16(xArgAL),
-	/*12830*/ uint16(xMatch),
-	/*12831*/ uint16(xCondDataSize), 12835, 12840, 12845,
-	/*12835*/ uint16(xSetOp), uint16(OUT),
-	/*12837*/ uint16(xArgDX),
-	/*12838*/ uint16(xArgAX),
-	/*12839*/ uint16(xMatch),
-	/*12840*/ uint16(xSetOp), uint16(OUT),
-	/*12842*/ uint16(xArgDX),
-	/*12843*/ uint16(xArgEAX),
-	/*12844*/ uint16(xMatch),
-	/*12845*/ uint16(xSetOp), uint16(OUT),
-	/*12847*/ uint16(xArgDX),
-	/*12848*/ uint16(xArgEAX),
-	/*12849*/ uint16(xMatch),
-	/*12850*/ uint16(xSetOp), uint16(ICEBP),
-	/*12852*/ uint16(xMatch),
-	/*12853*/ uint16(xSetOp), uint16(HLT),
-	/*12855*/ uint16(xMatch),
-	/*12856*/ uint16(xSetOp), uint16(CMC),
-	/*12858*/ uint16(xMatch),
-	/*12859*/ uint16(xCondSlashR),
-	12868, // 0
-	0,     // 1
-	12874, // 2
-	12878, // 3
-	12882, // 4
-	12886, // 5
-	12890, // 6
-	12894, // 7
-	/*12868*/ uint16(xSetOp), uint16(TEST),
-	/*12870*/ uint16(xReadIb),
-	/*12871*/ uint16(xArgRM8),
-	/*12872*/ uint16(xArgImm8u),
-	/*12873*/ uint16(xMatch),
-	/*12874*/ uint16(xSetOp), uint16(NOT),
-	/*12876*/ uint16(xArgRM8),
+	/*12826*/ uint16(xCondDataSize), 12816, 12821, 12830,
+	/*12830*/ uint16(xSetOp), uint16(RCR),
+	/*12832*/ uint16(xArgRM64),
+	/*12833*/ uint16(xArgCL),
+	/*12834*/ uint16(xMatch),
+	/*12835*/ uint16(xCondIs64), 12838, 12852,
+	/*12838*/ uint16(xCondDataSize), 12842, 12847, 0,
+	/*12842*/ uint16(xSetOp), uint16(SHL),
+	/*12844*/ uint16(xArgRM16),
+	/*12845*/ uint16(xArgCL),
+	/*12846*/ uint16(xMatch),
+	/*12847*/ uint16(xSetOp), uint16(SHL),
+	/*12849*/ uint16(xArgRM32),
+	/*12850*/ uint16(xArgCL),
+	/*12851*/ uint16(xMatch),
+	/*12852*/ uint16(xCondDataSize), 12842, 12847, 12856,
+	/*12856*/ uint16(xSetOp), uint16(SHL),
+	/*12858*/ uint16(xArgRM64),
+	/*12859*/ uint16(xArgCL),
+	/*12860*/ uint16(xMatch),
+	/*12861*/ uint16(xCondIs64), 12864, 12878,
+	/*12864*/ uint16(xCondDataSize), 12868, 12873, 0,
+	/*12868*/ uint16(xSetOp), uint16(SHR),
+	/*12870*/ uint16(xArgRM16),
+	/*12871*/ uint16(xArgCL),
+	/*12872*/ uint16(xMatch),
+	/*12873*/ uint16(xSetOp), uint16(SHR),
+	/*12875*/ uint16(xArgRM32),
+	/*12876*/ uint16(xArgCL),
 	/*12877*/ uint16(xMatch),
-	/*12878*/ uint16(xSetOp), uint16(NEG),
-	/*12880*/ uint16(xArgRM8),
-	/*12881*/ uint16(xMatch),
-	/*12882*/ uint16(xSetOp), uint16(MUL),
-	/*12884*/ uint16(xArgRM8),
-	/*12885*/ uint16(xMatch),
-	/*12886*/ uint16(xSetOp), uint16(IMUL),
-	/*12888*/ uint16(xArgRM8),
-	/*12889*/ uint16(xMatch),
-	/*12890*/ uint16(xSetOp), uint16(DIV),
-	/*12892*/ uint16(xArgRM8),
-	/*12893*/ uint16(xMatch),
-	/*12894*/ uint16(xSetOp), uint16(IDIV),
-	/*12896*/ uint16(xArgRM8),
-	/*12897*/ uint16(xMatch),
-	/*12898*/ uint16(xCondSlashR),
-	12907, // 0
-	0,     // 1
-	12936, // 2
-	12959, // 3
-	12982, // 4
-	13005, // 5
-	13028, // 6
-	13051, // 7
-	/*12907*/ uint16(xCondIs64), 12910, 12926,
-	/*12910*/ uint16(xCondDataSize), 12914, 12920, 0,
-	/*12914*/ uint16(xSetOp), uint16(TEST),
-	/*12916*/ uint16(xReadIw),
-	/*12917*/ uint16(xArgRM16),
-	/*12918*/ uint16(xArgImm16),
-	/*12919*/ uint16(xMatch),
-	/*12920*/ uint16(xSetOp), uint16(TEST),
-	/*12922*/ uint16(xReadId),
-	/*12923*/ uint16(xArgRM32),
-	/*12924*/ uint16(xArgImm32),
-	/*12925*/ uint16(xMatch),
-	/*12926*/ uint16(xCondDataSize), 12914, 12920, 12930,
-	/*12930*/ uint16(xSetOp), uint16(TEST),
-	/*12932*/ uint16(xReadId),
-	/*12933*/ uint16(xArgRM64),
-	/*12934*/ uint16(xArgImm32),
-	/*12935*/ uint16(xMatch),
-	/*12936*/ uint16(xCondIs64), 12939, 12951,
-	/*12939*/ uint16(xCondDataSize), 12943, 12947, 0,
-	/*12943*/ uint16(xSetOp), uint16(NOT),
-	/*12945*/ uint16(xArgRM16),
-	/*12946*/ uint16(xMatch),
-	/*12947*/ uint16(xSetOp), uint16(NOT),
-	/*12949*/ uint16(xArgRM32),
-	/*12950*/ uint16(xMatch),
-	/*12951*/ uint16(xCondDataSize), 12943, 12947, 12955,
-	/*12955*/ uint16(xSetOp), uint16(NOT),
-	/*12957*/ uint16(xArgRM64),
-	/*12958*/ uint16(xMatch),
-	/*12959*/ uint16(xCondIs64), 12962, 12974,
-	/*12962*/ uint16(xCondDataSize), 12966, 12970, 0,
-	/*12966*/ uint16(xSetOp), uint16(NEG),
-	/*12968*/ uint16(xArgRM16),
-	/*12969*/ uint16(xMatch),
-	/*12970*/ uint16(xSetOp), uint16(NEG),
-	/*12972*/ uint16(xArgRM32),
-	/*12973*/ uint16(xMatch),
-	/*12974*/ uint16(xCondDataSize), 12966, 12970, 12978,
-	/*12978*/ uint16(xSetOp), uint16(NEG),
-	/*12980*/ uint16(xArgRM64),
-	/*12981*/ uint16(xMatch),
-	/*12982*/ uint16(xCondIs64), 12985, 12997,
-	/*12985*/ uint16(xCondDataSize), 12989, 12993, 0,
-	/*12989*/ uint16(xSetOp), uint16(MUL),
-	/*12991*/ uint16(xArgRM16),
-	/*12992*/ uint16(xMatch),
-	/*12993*/ uint16(xSetOp), uint16(MUL),
-	/*12995*/ uint16(xArgRM32),
-	/*12996*/ uint16(xMatch),
-	/*12997*/ uint16(xCondDataSize), 12989, 12993, 13001,
-	/*13001*/ uint16(xSetOp), uint16(MUL),
-	/*13003*/ uint16(xArgRM64),
-	/*13004*/ uint16(xMatch),
-	/*13005*/ uint16(xCondIs64), 13008, 13020,
-	/*13008*/ uint16(xCondDataSize), 13012, 13016, 0,
-	/*13012*/ uint16(xSetOp), uint16(IMUL),
-	/*13014*/ uint16(xArgRM16),
-	/*13015*/ uint16(xMatch),
-	/*13016*/ uint16(xSetOp), uint16(IMUL),
-	/*13018*/ uint16(xArgRM32),
-	/*13019*/ uint16(xMatch),
-	/*13020*/ uint16(xCondDataSize), 13012, 13016, 13024,
-	/*13024*/ uint16(xSetOp), uint16(IMUL),
-	/*13026*/ uint16(xArgRM64),
-	/*13027*/ uint16(xMatch),
-	/*13028*/ uint16(xCondIs64), 13031, 13043,
-	/*13031*/ uint16(xCondDataSize), 13035, 13039, 0,
-	/*13035*/ uint16(xSetOp), uint16(DIV),
-	/*13037*/ uint16(xArgRM16),
-	/*13038*/ uint16(xMatch),
-	/*13039*/ uint16(xSetOp), uint16(DIV),
-	/*13041*/ uint16(xArgRM32),
-	/*13042*/ uint16(xMatch),
-	/*13043*/ uint16(xCondDataSize), 13035, 13039, 13047,
-	/*13047*/ uint16(xSetOp), uint16(DIV),
-	/*13049*/ uint16(xArgRM64),
-	/*13050*/ uint16(xMatch),
-	/*13051*/ uint16(xCondIs64), 13054, 13066,
-	/*13054*/ uint16(xCondDataSize), 13058, 13062, 0,
-	/*13058*/ uint16(xSetOp), uint16(IDIV),
-	/*13060*/ uint16(xArgRM16),
-	/*13061*/ uint16(xMatch),
-	/*13062*/ uint16(xSetOp), uint16(IDIV),
-	/*13064*/ uint16(xArgRM32),
-	/*13065*/ uint16(xMatch),
-	/*13066*/ uint16(xCondDataSize), 13058, 13062, 13070,
-	/*13070*/ uint16(xSetOp), uint16(IDIV),
-	/*13072*/ uint16(xArgRM64),
-	/*13073*/ uint16(xMatch),
-	/*13074*/ uint16(xSetOp), uint16(CLC),
-	/*13076*/ uint16(xMatch),
-	/*13077*/ uint16(xSetOp), uint16(STC),
-	/*13079*/ uint16(xMatch),
-	/*13080*/ uint16(xSetOp), uint16(CLI),
-	/*13082*/ uint16(xMatch),
-	/*13083*/ uint16(xSetOp), uint16(STI),
-	/*13085*/ uint16(xMatch),
-	/*13086*/ uint16(xSetOp), uint16(CLD),
+	/*12878*/ uint16(xCondDataSize), 12868, 12873, 12882,
+	/*12882*/ uint16(xSetOp), uint16(SHR),
+	/*12884*/ uint16(xArgRM64),
+	/*12885*/ uint16(xArgCL),
+	/*12886*/ uint16(xMatch),
+	/*12887*/ uint16(xCondIs64), 12890, 12904,
+	/*12890*/ uint16(xCondDataSize), 12894, 12899, 0,
+	/*12894*/ uint16(xSetOp), uint16(SAR),
+	/*12896*/ uint16(xArgRM16),
+	/*12897*/ uint16(xArgCL),
+	/*12898*/ uint16(xMatch),
+	/*12899*/ uint16(xSetOp), uint16(SAR),
+	/*12901*/ uint16(xArgRM32),
+	/*12902*/ uint16(xArgCL),
+	/*12903*/ uint16(xMatch),
+	/*12904*/ uint16(xCondDataSize), 12894, 12899, 12908,
+	/*12908*/ uint16(xSetOp), uint16(SAR),
+	/*12910*/ uint16(xArgRM64),
+	/*12911*/ uint16(xArgCL),
+	/*12912*/ uint16(xMatch),
+	/*12913*/ uint16(xCondIs64), 12916, 0,
+	/*12916*/ uint16(xSetOp), uint16(AAM),
+	/*12918*/ uint16(xReadIb),
+	/*12919*/ uint16(xArgImm8u),
+	/*12920*/ uint16(xMatch),
+	/*12921*/ uint16(xCondIs64), 12924, 0,
+	/*12924*/ uint16(xSetOp), uint16(AAD),
+	/*12926*/ uint16(xReadIb),
+	/*12927*/ uint16(xArgImm8u),
+	/*12928*/ uint16(xMatch),
+	/*12929*/ uint16(xCondIs64), 12932, 12935,
+	/*12932*/ uint16(xSetOp), uint16(XLATB),
+	/*12934*/ uint16(xMatch),
+	/*12935*/ uint16(xCondDataSize), 12932, 12932, 12939,
+	/*12939*/ uint16(xSetOp), uint16(XLATB),
+	/*12941*/ uint16(xMatch),
+	/*12942*/ uint16(xCondByte), 64,
+	0xc0, 13113,
+	0xc1, 13113,
+	0xc2, 13113,
+	0xc3, 13113,
+	0xc4, 13113,
+	0xc5, 13113,
+	0xc6, 13113,
+	0xc7, 13113,
+	0xc8, 13118,
+	0xc9, 13118,
+	0xca, 13118,
+	0xcb, 13118,
+	0xcc, 13118,
+	0xcd, 13118,
+	0xce, 13118,
+	0xcf, 13118,
+	0xd0, 13123,
+	0xd1, 13123,
+	0xd2, 13123,
+	0xd3, 13123,
+	0xd4, 13123,
+	0xd5, 13123,
+	0xd6, 13123,
+	0xd7, 13123,
+	0xd8, 13127,
+	0xd9, 13127,
+	0xda, 13127,
+	0xdb, 13127,
+	0xdc, 13127,
+	0xdd, 13127,
+	0xde, 13127,
+	0xdf, 13127,
+	0xe0, 13131,
+	0xe1, 13131,
+	0xe2, 13131,
+	0xe3, 13131,
+	0xe4, 13131,
+	0xe5, 13131,
+	0xe6, 13131,
+	0xe7, 13131,
+	0xe8, 13136,
+	0xe9, 13136,
+	0xea, 13136,
+	0xeb, 13136,
+	0xec, 13136,
+	0xed, 13136,
+	0xee, 13136,
+	0xef, 13136,
+	0xf0, 13141,
+	0xf1, 13141,
+	0xf2, 13141,
+	0xf3, 13141,
+	0xf4, 13141,
+	0xf5, 13141,
+	0xf6, 13141,
+	0xf7, 13141,
+	0xf8, 13146,
+	0xf9, 13146,
+	0xfa, 13146,
+	0xfb, 13146,
+	0xfc, 13146,
+	0xfd, 13146,
+	0xfe, 13146,
+	0xff, 13146,
+	/*13072*/ uint16(xCondSlashR),
+	13081, // 0
+	13085, // 1
+	13089, // 2
+	13093, // 3
+	13097, // 4
+	13101, // 5
+	13105, // 6
+	13109, // 7
+	/*13081*/ uint16(xSetOp), uint16(FADD),
+	/*13083*/ uint16(xArgM32fp),
+	/*13084*/ uint16(xMatch),
+	/*13085*/ uint16(xSetOp), uint16(FMUL),
+	/*13087*/ uint16(xArgM32fp),
 	/*13088*/ uint16(xMatch),
-	/*13089*/ uint16(xSetOp), uint16(STD),
-	/*13091*/ uint16(xMatch),
-	/*13092*/ uint16(xCondSlashR),
-	13101, // 0
-	13105, // 1
+	/*13089*/ uint16(xSetOp), uint16(FCOM),
+	/*13091*/ uint16(xArgM32fp),
+	/*13092*/ uint16(xMatch),
+	/*13093*/ uint16(xSetOp), uint16(FCOMP),
+	/*13095*/ uint16(xArgM32fp),
+	/*13096*/ uint16(xMatch),
+	/*13097*/ uint16(xSetOp), uint16(FSUB),
+	/*13099*/ uint16(xArgM32fp),
+	/*13100*/ uint16(xMatch),
+	/*13101*/ uint16(xSetOp), uint16(FSUBR),
+	/*13103*/ uint16(xArgM32fp),
+	/*13104*/ uint16(xMatch),
+	/*13105*/ uint16(xSetOp), uint16(FDIV),
+	/*13107*/ uint16(xArgM32fp),
+	/*13108*/ uint16(xMatch),
+	/*13109*/ uint16(xSetOp), uint16(FDIVR),
+	/*13111*/ uint16(xArgM32fp),
+	/*13112*/ uint16(xMatch),
+	/*13113*/ uint16(xSetOp), uint16(FADD),
+	/*13115*/ uint16(xArgST),
+	/*13116*/ uint16(xArgSTi),
+	/*13117*/ uint16(xMatch),
+	/*13118*/ uint16(xSetOp), uint16(FMUL),
+	/*13120*/ uint16(xArgST),
+	/*13121*/ uint16(xArgSTi),
+	/*13122*/ uint16(xMatch),
+	/*13123*/ uint16(xSetOp), uint16(FCOM),
+	/*13125*/ uint16(xArgSTi),
+	/*13126*/ uint16(xMatch),
+	/*13127*/ uint16(xSetOp), uint16(FCOMP),
+	/*13129*/ uint16(xArgSTi),
+	/*13130*/ uint16(xMatch),
+	/*13131*/ uint16(xSetOp), uint16(FSUB),
+	/*13133*/ uint16(xArgST),
+	/*13134*/ uint16(xArgSTi),
+	/*13135*/ uint16(xMatch),
+	/*13136*/ uint16(xSetOp), uint16(FSUBR),
+	/*13138*/ uint16(xArgST),
+	/*13139*/ uint16(xArgSTi),
+	/*13140*/ uint16(xMatch),
+	/*13141*/ uint16(xSetOp), uint16(FDIV),
+	/*13143*/ uint16(xArgST),
+	/*13144*/ uint16(xArgSTi),
+	/*13145*/ uint16(xMatch),
+	/*13146*/ uint16(xSetOp), uint16(FDIVR),
+	/*13148*/ uint16(xArgST),
+	/*13149*/ uint16(xArgSTi),
+	/*13150*/ uint16(xMatch),
+	/*13151*/ uint16(xCondByte), 44,
+	0xc0, 13278,
+	0xc1, 13278,
+	0xc2, 13278,
+	0xc3, 13278,
+	0xc4, 13278,
+	0xc5, 13278,
+	0xc6, 13278,
+	0xc7, 13278,
+	0xc8, 13282,
+	0xc9, 13282,
+	0xca, 13282,
+	0xcb, 13282,
+	0xcc, 13282,
+	0xcd, 13282,
+	0xce, 13282,
+	0xcf, 13282,
+	0xD0, 13286,
+	0xE0, 13289,
+	0xE1, 13292,
+	0xE4, 13295,
+	0xE5, 13298,
+	0xE8, 13301,
+	0xE9, 13304,
+	0xEA, 13307,
+	0xEB, 13310,
+	0xEC, 13313,
+	0xED, 13316,
+	0xEE, 13319,
+	0xF0, 13322,
+	0xF1, 13325,
+	0xF2, 13328,
+	0xF3, 13331,
+	0xF4, 13334,
+	0xF5, 13337,
+	0xF6, 13340,
+	0xF7, 13343,
+	0xF8, 13346,
+	0xF9, 13349,
+	0xFA, 13352,
+	0xFB, 13355,
+	0xFC, 13358,
+	0xFD, 13361,
+	0xFE, 13364,
+	0xFF, 13367,
+	/*13241*/ uint16(xCondSlashR),
+	13250, // 0
+	0,     // 1
+	13254, // 2
+	13258, // 3
+	13262, // 4
+	13266, // 5
+	13270, // 6
+	13274, // 7
+	/*13250*/ uint16(xSetOp), uint16(FLD),
+	/*13252*/ uint16(xArgM32fp),
+	/*13253*/ uint16(xMatch),
+	/*13254*/ uint16(xSetOp), uint16(FST),
+	/*13256*/ uint16(xArgM32fp),
+	/*13257*/ uint16(xMatch),
+	/*13258*/ uint16(xSetOp), uint16(FSTP),
+	/*13260*/ uint16(xArgM32fp),
+	/*13261*/ uint16(xMatch),
+	/*13262*/ uint16(xSetOp), uint16(FLDENV),
+	/*13264*/ uint16(xArgM1428byte),
+	/*13265*/ uint16(xMatch),
+	/*13266*/ uint16(xSetOp), uint16(FLDCW),
+	/*13268*/ uint16(xArgM2byte),
+	/*13269*/ uint16(xMatch),
+	/*13270*/ uint16(xSetOp), uint16(FNSTENV),
+	/*13272*/ uint16(xArgM1428byte),
+	/*13273*/ uint16(xMatch),
+	/*13274*/ uint16(xSetOp), uint16(FNSTCW),
+	/*13276*/ uint16(xArgM2byte),
+	/*13277*/ uint16(xMatch),
+	/*13278*/ uint16(xSetOp), uint16(FLD),
+	/*13280*/ uint16(xArgSTi),
+	/*13281*/ uint16(xMatch),
+	/*13282*/ uint16(xSetOp), uint16(FXCH),
+	/*13284*/ uint16(xArgSTi),
+	/*13285*/ uint16(xMatch),
+	/*13286*/ uint16(xSetOp), uint16(FNOP),
+	/*13288*/ uint16(xMatch),
+	/*13289*/ uint16(xSetOp), uint16(FCHS),
+	/*13291*/ uint16(xMatch),
+	/*13292*/ uint16(xSetOp), uint16(FABS),
+	/*13294*/ uint16(xMatch),
+	/*13295*/ uint16(xSetOp), uint16(FTST),
+	/*13297*/ uint16(xMatch),
+	/*13298*/ uint16(xSetOp), uint16(FXAM),
+	/*13300*/ uint16(xMatch),
+	/*13301*/ uint16(xSetOp), uint16(FLD1),
+	/*13303*/ uint16(xMatch),
+	/*13304*/ uint16(xSetOp), uint16(FLDL2T),
+	/*13306*/ uint16(xMatch),
+	/*13307*/ uint16(xSetOp), uint16(FLDL2E),
+	/*13309*/ uint16(xMatch),
+	/*13310*/ uint16(xSetOp), uint16(FLDPI),
+	/*13312*/ uint16(xMatch),
+	/*13313*/ uint16(xSetOp), uint16(FLDLG2),
+	/*13315*/ uint16(xMatch),
+	/*13316*/ uint16(xSetOp), uint16(FLDLN2),
+	/*13318*/ uint16(xMatch),
+	/*13319*/ uint16(xSetOp), uint16(FLDZ),
+	/*13321*/ uint16(xMatch),
+	/*13322*/ uint16(xSetOp), uint16(F2XM1),
+	/*13324*/ uint16(xMatch),
+	/*13325*/ uint16(xSetOp), uint16(FYL2X),
+	/*13327*/ uint16(xMatch),
+	/*13328*/ uint16(xSetOp), uint16(FPTAN),
+	/*13330*/ uint16(xMatch),
+	/*13331*/ uint16(xSetOp), uint16(FPATAN),
+	/*13333*/ uint16(xMatch),
+	/*13334*/ uint16(xSetOp), uint16(FXTRACT),
+	/*13336*/ uint16(xMatch),
+	/*13337*/ uint16(xSetOp), uint16(FPREM1),
+	/*13339*/ uint16(xMatch),
+	/*13340*/ uint16(xSetOp), uint16(FDECSTP),
+	/*13342*/ uint16(xMatch),
+	/*13343*/ uint16(xSetOp), uint16(FINCSTP),
+	/*13345*/ uint16(xMatch),
+	/*13346*/ uint16(xSetOp), uint16(FPREM),
+	/*13348*/ uint16(xMatch),
+	/*13349*/ uint16(xSetOp), uint16(FYL2XP1),
+	/*13351*/ uint16(xMatch),
+	/*13352*/ uint16(xSetOp), uint16(FSQRT),
+	/*13354*/ uint16(xMatch),
+	/*13355*/ uint16(xSetOp), uint16(FSINCOS),
+	/*13357*/ uint16(xMatch),
+	/*13358*/ uint16(xSetOp), uint16(FRNDINT),
+	/*13360*/ uint16(xMatch),
+	/*13361*/ uint16(xSetOp), uint16(FSCALE),
+	/*13363*/ uint16(xMatch),
+	/*13364*/ uint16(xSetOp), uint16(FSIN),
+	/*13366*/ uint16(xMatch),
+	/*13367*/ uint16(xSetOp), uint16(FCOS),
+	/*13369*/ uint16(xMatch),
+	/*13370*/ uint16(xCondByte), 33,
+	0xc0, 13479,
+	0xc1, 13479,
+	0xc2, 13479,
+	0xc3, 13479,
+	0xc4, 13479,
+	0xc5, 13479,
+	0xc6, 13479,
+	0xc7, 13479,
+	0xc8, 13484,
+	0xc9, 13484,
+	0xca, 13484,
+	0xcb, 13484,
+	0xcc, 13484,
+	0xcd, 13484,
+	0xce, 13484,
+	0xcf, 13484,
+	0xd0, 13489,
+	0xd1, 13489,
+	0xd2, 13489,
+	0xd3, 13489,
+	0xd4, 13489,
+	0xd5, 13489,
+	0xd6, 13489,
+	0xd7, 13489,
+	0xd8, 13494,
+	0xd9, 13494,
+	0xda, 13494,
+	0xdb, 13494,
+	0xdc, 13494,
+	0xdd, 13494,
+	0xde, 13494,
+	0xdf, 13494,
+	0xE9, 13499,
+	/*13438*/ uint16(xCondSlashR),
+	13447, // 0
+	13451, // 1
+	13455, // 2
+	13459, // 3
+	13463, // 4
+	13467, // 5
+	13471, // 6
+	13475, // 7
+	/*13447*/ uint16(xSetOp), uint16(FIADD),
+	/*13449*/ uint16(xArgM32int),
+	/*13450*/ uint16(xMatch),
+	/*13451*/ uint16(xSetOp), uint16(FIMUL),
+	/*13453*/ uint16(xArgM32int),
+	/*13454*/ uint16(xMatch),
+	/*13455*/ uint16(xSetOp), uint16(FICOM),
+	/*13457*/ uint16(xArgM32int),
+	/*13458*/ uint16(xMatch),
+	/*13459*/ uint16(xSetOp), uint16(FICOMP),
+	/*13461*/ uint16(xArgM32int),
+	/*13462*/ uint16(xMatch),
+	/*13463*/ uint16(xSetOp), uint16(FISUB),
+	/*13465*/ uint16(xArgM32int),
+	/*13466*/ uint16(xMatch),
+	/*13467*/ uint16(xSetOp), uint16(FISUBR),
+	/*13469*/ uint16(xArgM32int),
+	/*13470*/ uint16(xMatch),
+	/*13471*/ uint16(xSetOp), uint16(FIDIV),
+	/*13473*/ uint16(xArgM32int),
+	/*13474*/ uint16(xMatch),
+	/*13475*/ uint16(xSetOp), uint16(FIDIVR),
+	/*13477*/ uint16(xArgM32int),
+	/*13478*/ uint16(xMatch),
+	/*13479*/ uint16(xSetOp), uint16(FCMOVB),
+	/*13481*/ uint16(xArgST),
+	/*13482*/ uint16(xArgSTi),
+	/*13483*/ uint16(xMatch),
+	/*13484*/ uint16(xSetOp), uint16(FCMOVE),
+	/*13486*/ uint16(xArgST),
+	/*13487*/ uint16(xArgSTi),
+	/*13488*/ uint16(xMatch),
+	/*13489*/ uint16(xSetOp), uint16(FCMOVBE),
+	/*13491*/ uint16(xArgST),
+	/*13492*/ uint16(xArgSTi),
+	/*13493*/ uint16(xMatch),
+	/*13494*/ uint16(xSetOp), uint16(FCMOVU),
+	/*13496*/ uint16(xArgST),
+	/*13497*/ uint16(xArgSTi),
+	/*13498*/ uint16(xMatch),
+	/*13499*/ uint16(xSetOp), uint16(FUCOMPP),
+	/*13501*/ uint16(xMatch),
+	/*13502*/ uint16(xCondByte), 50,
+	0xc0, 13639,
+	0xc1, 13639,
+	0xc2, 13639,
+	0xc3, 13639,
+	0xc4, 13639,
+	0xc5, 13639,
+	0xc6, 13639,
+	0xc7, 13639,
+	0xc8, 13644,
+	0xc9, 13644,
+	0xca, 13644,
+	0xcb, 13644,
+	0xcc, 13644,
+	0xcd, 13644,
+	0xce, 13644,
+	0xcf, 13644,
+	0xd0, 13649,
+	0xd1, 13649,
+	0xd2, 13649,
+	0xd3, 13649,
+	0xd4, 13649,
+	0xd5, 13649,
+	0xd6, 13649,
+	0xd7, 13649,
+	0xd8, 13654,
+	0xd9, 13654,
+	0xda, 13654,
+	0xdb, 13654,
+	0xdc, 13654,
+	0xdd, 13654,
+	0xde, 13654,
+	0xdf, 13654,
+	0xE2, 13659,
+	0xE3, 13662,
+	0xe8, 13665,
+	0xe9, 13665,
+	0xea, 13665,
+	0xeb, 13665,
+	0xec, 13665,
+	0xed, 13665,
+	0xee, 13665,
+	0xef, 13665,
+	0xf0, 13670,
+	0xf1, 13670,
+	0xf2, 13670,
+	0xf3, 13670,
+	0xf4, 13670,
+	0xf5, 13670,
+	0xf6, 13670,
+	0xf7, 13670,
+	/*13604*/ uint16(xCondSlashR),
+	13613, // 0
+	13617, // 1
+	13623, // 2
+	13627, // 3
+	0,     // 4
+	13631, // 5
+	0,     // 6
+	13635, // 7
+	/*13613*/ uint16(xSetOp), uint16(FILD),
+	/*13615*/ uint16(xArgM32int),
+	/*13616*/ uint16(xMatch),
+	/*13617*/ uint16(xSetOp), uint16(FISTTP),
+	/*13619*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*13621*/ uint16(xArgM32int),
+	/*13622*/ uint16(xMatch),
+	/*13623*/ uint16(xSetOp), uint16(FIST),
+	/*13625*/ uint16(xArgM32int),
+	/*13626*/ uint16(xMatch),
+	/*13627*/ uint16(xSetOp), uint16(FISTP),
+	/*13629*/ uint16(xArgM32int),
+	/*13630*/ uint16(xMatch),
+	/*13631*/ uint16(xSetOp), uint16(FLD),
+	/*13633*/ uint16(xArgM80fp),
+	/*13634*/ uint16(xMatch),
+	/*13635*/ uint16(xSetOp), uint16(FSTP),
+	/*13637*/ uint16(xArgM80fp),
+	/*13638*/ uint16(xMatch),
+	/*13639*/ uint16(xSetOp), uint16(FCMOVNB),
+	/*13641*/ uint16(xArgST),
+	/*13642*/ uint16(xArgSTi),
+	/*13643*/ uint16(xMatch),
+	/*13644*/ uint16(xSetOp), uint16(FCMOVNE),
+	/*13646*/ uint16(xArgST),
+	/*13647*/ uint16(xArgSTi),
+	/*13648*/ uint16(xMatch),
+	/*13649*/ uint16(xSetOp), uint16(FCMOVNBE),
+	/*13651*/ uint16(xArgST),
+	/*13652*/ uint16(xArgSTi),
+	/*13653*/ uint16(xMatch),
+	/*13654*/ uint16(xSetOp), uint16(FCMOVNU),
+	/*13656*/ uint16(xArgST),
+	/*13657*/ uint16(xArgSTi),
+	/*13658*/ uint16(xMatch),
+	/*13659*/ uint16(xSetOp), uint16(FNCLEX),
+	/*13661*/ uint16(xMatch),
+	/*13662*/ uint16(xSetOp), uint16(FNINIT),
+	/*13664*/ uint16(xMatch),
+	/*13665*/ uint16(xSetOp), uint16(FUCOMI),
+	/*13667*/ uint16(xArgST),
+	/*13668*/ uint16(xArgSTi),
+	/*13669*/ uint16(xMatch),
+	/*13670*/ uint16(xSetOp), uint16(FCOMI),
+	/*13672*/ uint16(xArgST),
+	/*13673*/ uint16(xArgSTi),
+	/*13674*/ uint16(xMatch),
+	/*13675*/ uint16(xCondByte), 48,
+	0xc0, 13814,
+	0xc1, 13814,
+	0xc2, 13814,
+	0xc3, 13814,
+	0xc4, 13814,
+	0xc5, 13814,
+	0xc6, 13814,
+	0xc7, 13814,
+	0xc8, 13819,
+	0xc9, 13819,
+	0xca, 13819,
+	0xcb, 13819,
+	0xcc, 13819,
+	0xcd, 13819,
+	0xce, 13819,
+	0xcf, 13819,
+	0xe0, 13824,
+	0xe1, 13824,
+	0xe2, 13824,
+	0xe3, 13824,
+	0xe4, 13824,
+	0xe5, 13824,
+	0xe6, 13824,
+	0xe7, 13824,
+	0xe8, 13829,
+	0xe9, 13829,
+	0xea, 13829,
+	0xeb, 13829,
+	0xec, 13829,
+	0xed, 13829,
+	0xee, 13829,
+	0xef, 13829,
+	0xf0, 13834,
+	0xf1, 13834,
+	0xf2, 13834,
+	0xf3, 13834,
+	0xf4, 13834,
+	0xf5, 13834,
+	0xf6, 13834,
+	0xf7, 13834,
+	0xf8, 13839,
+	0xf9, 13839,
+	0xfa, 13839,
+	0xfb, 13839,
+	0xfc, 13839,
+	0xfd, 13839,
+	0xfe, 13839,
+	0xff, 13839,
+	/*13773*/ uint16(xCondSlashR),
+	13782, // 0
+	13786, // 1
+	13790, // 2
+	13794, // 3
+	13798, // 4
+	13802, // 5
+	13806, // 6
+	13810, // 7
+	/*13782*/ uint16(xSetOp), uint16(FADD),
+	/*13784*/ uint16(xArgM64fp),
+	/*13785*/ uint16(xMatch),
+	/*13786*/ uint16(xSetOp), uint16(FMUL),
+	/*13788*/ uint16(xArgM64fp),
+	/*13789*/ uint16(xMatch),
+	/*13790*/ uint16(xSetOp), uint16(FCOM),
+	/*13792*/ uint16(xArgM64fp),
+	/*13793*/ uint16(xMatch),
+	/*13794*/ uint16(xSetOp), uint16(FCOMP),
+	/*13796*/ uint16(xArgM64fp),
+	/*13797*/ uint16(xMatch),
+	/*13798*/ uint16(xSetOp), uint16(FSUB),
+	/*13800*/ uint16(xArgM64fp),
+	/*13801*/ uint16(xMatch),
+	/*13802*/ uint16(xSetOp), uint16(FSUBR),
+	/*13804*/ uint16(xArgM64fp),
+	/*13805*/ uint16(xMatch),
+	/*13806*/ uint16(xSetOp), uint16(FDIV),
+	/*13808*/ uint16(xArgM64fp),
+	/*13809*/ uint16(xMatch),
+	/*13810*/ uint16(xSetOp), uint16(FDIVR),
+	/*13812*/ uint16(xArgM64fp),
+	/*13813*/ uint16(xMatch),
+	/*13814*/ uint16(xSetOp), uint16(FADD),
+	/*13816*/ uint16(xArgSTi),
+	/*13817*/ uint16(xArgST),
+	/*13818*/ uint16(xMatch),
+	/*13819*/ uint16(xSetOp), uint16(FMUL),
+	/*13821*/ uint16(xArgSTi),
+	/*13822*/ uint16(xArgST),
+	/*13823*/ uint16(xMatch),
+	/*13824*/ uint16(xSetOp), uint16(FSUBR),
+	/*13826*/ uint16(xArgSTi),
+	/*13827*/ uint16(xArgST),
+	/*13828*/ uint16(xMatch),
+	/*13829*/ uint16(xSetOp), uint16(FSUB),
+	/*13831*/ uint16(xArgSTi),
+	/*13832*/ uint16(xArgST),
+	/*13833*/ uint16(xMatch),
+	/*13834*/ uint16(xSetOp), uint16(FDIVR),
+	/*13836*/ uint16(xArgSTi),
+	/*13837*/ uint16(xArgST),
+	/*13838*/ uint16(xMatch),
+	/*13839*/ uint16(xSetOp), uint16(FDIV),
+	/*13841*/ uint16(xArgSTi),
+	/*13842*/ uint16(xArgST),
+	/*13843*/ uint16(xMatch),
+	/*13844*/ uint16(xCondByte), 40,
+	0xc0, 13965,
+	0xc1, 13965,
+	0xc2, 13965,
+	0xc3, 13965,
+	0xc4, 13965,
+	0xc5, 13965,
+	0xc6, 13965,
+	0xc7, 13965,
+	0xd0, 13969,
+	0xd1, 13969,
+	0xd2, 13969,
+	0xd3, 13969,
+	0xd4, 13969,
+	0xd5, 13969,
+	0xd6, 13969,
+	0xd7, 13969,
+	0xd8, 13973,
+	0xd9, 13973,
+	0xda, 13973,
+	0xdb, 13973,
+	0xdc, 13973,
+	0xdd, 13973,
+	0xde, 13973,
+	0xdf, 13973,
+	0xe0, 13977,
+	0xe1, 13977,
+	0xe2, 13977,
+	0xe3, 13977,
+	0xe4, 13977,
+	0xe5, 13977,
+	0xe6, 13977,
+	0xe7, 13977,
+	0xe8, 13981,
+	0xe9, 13981,
+	0xea, 13981,
+	0xeb, 13981,
+	0xec, 13981,
+	0xed, 13981,
+	0xee, 13981,
+	0xef, 13981,
+	/*13926*/ uint16(xCondSlashR),
+	13935, // 0
+	13939, // 1
+	13945, // 2
+	13949, // 3
+	13953, // 4
+	0,     // 5
+	13957, // 6
+	13961, // 7
+	/*13935*/ uint16(xSetOp), uint16(FLD),
+	/*13937*/ uint16(xArgM64fp),
+	/*13938*/ uint16(xMatch),
+	/*13939*/ uint16(xSetOp), uint16(FISTTP),
+	/*13941*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*13943*/ uint16(xArgM64int),
+	/*13944*/ uint16(xMatch),
+	/*13945*/ uint16(xSetOp), uint16(FST),
+	/*13947*/ uint16(xArgM64fp),
+	/*13948*/ uint16(xMatch),
+	/*13949*/ uint16(xSetOp), uint16(FSTP),
+	/*13951*/ uint16(xArgM64fp),
+	/*13952*/ uint16(xMatch),
+	/*13953*/ uint16(xSetOp), uint16(FRSTOR),
+	/*13955*/ uint16(xArgM94108byte),
+	/*13956*/ uint16(xMatch),
+	/*13957*/ uint16(xSetOp), uint16(FNSAVE),
+	/*13959*/ uint16(xArgM94108byte),
+	/*13960*/ uint16(xMatch),
+	/*13961*/ uint16(xSetOp), uint16(FNSTSW),
+	/*13963*/ uint16(xArgM2byte),
+	/*13964*/ uint16(xMatch),
+	/*13965*/ uint16(xSetOp), uint16(FFREE),
+	/*13967*/ uint16(xArgSTi),
+	/*13968*/ uint16(xMatch),
+	/*13969*/ uint16(xSetOp), uint16(FST),
+	/*13971*/ uint16(xArgSTi),
+	/*13972*/ uint16(xMatch),
+	/*13973*/ uint16(xSetOp), uint16(FSTP),
+	/*13975*/ uint16(xArgSTi),
+	/*13976*/ uint16(xMatch),
+	/*13977*/ uint16(xSetOp), uint16(FUCOM),
+	/*13979*/ uint16(xArgSTi),
+	/*13980*/ uint16(xMatch),
+	/*13981*/ uint16(xSetOp), uint16(FUCOMP),
+	/*13983*/ uint16(xArgSTi),
+	/*13984*/ uint16(xMatch),
+	/*13985*/ uint16(xCondByte), 49,
+	0xc0, 14126,
+	0xc1, 14126,
+	0xc2, 14126,
+	0xc3, 14126,
+	0xc4, 14126,
+	0xc5, 14126,
+	0xc6, 14126,
+	0xc7, 14126,
+	0xc8, 14131,
+	0xc9, 14131,
+	0xca, 14131,
+	0xcb, 14131,
+	0xcc, 14131,
+	0xcd, 14131,
+	0xce, 14131,
+	0xcf, 14131,
+	0xD9, 14136,
+	0xe0, 14139,
+	0xe1, 14139,
+	0xe2, 14139,
+	0xe3, 14139,
+	0xe4, 14139,
+	0xe5, 14139,
+	0xe6, 14139,
+	0xe7, 14139,
+	0xe8, 14144,
+	0xe9, 14144,
+	0xea, 14144,
+	0xeb, 14144,
+	0xec, 14144,
+	0xed, 14144,
+	0xee, 14144,
+	0xef, 14144,
+	0xf0, 14149,
+	0xf1, 14149,
+	0xf2, 14149,
+	0xf3, 14149,
+	0xf4, 14149,
+	0xf5, 14149,
+	0xf6, 14149,
+	0xf7, 14149,
+	0xf8, 14154,
+	0xf9, 14154,
+	0xfa, 14154,
+	0xfb, 14154,
+	0xfc, 14154,
+	0xfd, 14154,
+	0xfe, 14154,
+	0xff, 14154,
+	/*14085*/ uint16(xCondSlashR),
+	14094, // 0
+	14098, // 1
+	14102, // 2
+	14106, // 3
+	14110, // 4
+	14114, // 5
+	14118, // 6
+	14122, // 7
+	/*14094*/ uint16(xSetOp), uint16(FIADD),
+	/*14096*/ uint16(xArgM16int),
+	/*14097*/ uint16(xMatch),
+	/*14098*/ uint16(xSetOp), uint16(FIMUL),
+	/*14100*/ uint16(xArgM16int),
+	/*14101*/ uint16(xMatch),
+	/*14102*/ uint16(xSetOp), uint16(FICOM),
+	/*14104*/ uint16(xArgM16int),
+	/*14105*/ uint16(xMatch),
+	/*14106*/ uint16(xSetOp), uint16(FICOMP),
+	/*14108*/ uint16(xArgM16int),
+	/*14109*/ uint16(xMatch),
+	/*14110*/ uint16(xSetOp), uint16(FISUB),
+	/*14112*/ uint16(xArgM16int),
+	/*14113*/ uint16(xMatch),
+	/*14114*/ uint16(xSetOp), uint16(FISUBR),
+	/*14116*/ uint16(xArgM16int),
+	/*14117*/ uint16(xMatch),
+	/*14118*/ uint16(xSetOp), uint16(FIDIV),
+	/*14120*/ uint16(xArgM16int),
+	/*14121*/ uint16(xMatch),
+	/*14122*/ uint16(xSetOp), uint16(FIDIVR),
+	/*14124*/ uint16(xArgM16int),
+	/*14125*/ uint16(xMatch),
+	/*14126*/ uint16(xSetOp), uint16(FADDP),
+	/*14128*/ uint16(xArgSTi),
+	/*14129*/ uint16(xArgST),
+	/*14130*/ uint16(xMatch),
+	/*14131*/ uint16(xSetOp), uint16(FMULP),
+	/*14133*/ uint16(xArgSTi),
+	/*14134*/ uint16(xArgST),
+	/*14135*/ uint16(xMatch),
+	/*14136*/ uint16(xSetOp), uint16(FCOMPP),
+	/*14138*/ uint16(xMatch),
+	/*14139*/ uint16(xSetOp), uint16(FSUBRP),
+	/*14141*/ uint16(xArgSTi),
+	/*14142*/ uint16(xArgST),
+	/*14143*/ uint16(xMatch),
+	/*14144*/ uint16(xSetOp), uint16(FSUBP),
+	/*14146*/ uint16(xArgSTi),
+	/*14147*/ uint16(xArgST),
+	/*14148*/ uint16(xMatch),
+	/*14149*/ uint16(xSetOp), uint16(FDIVRP),
+	/*14151*/ uint16(xArgSTi),
+	/*14152*/ uint16(xArgST),
+	/*14153*/ uint16(xMatch),
+	/*14154*/ uint16(xSetOp), uint16(FDIVP),
+	/*14156*/ uint16(xArgSTi),
+	/*14157*/ uint16(xArgST),
+	/*14158*/ uint16(xMatch),
+	/*14159*/ uint16(xCondByte), 25,
+	0xc0, 14254,
+	0xc1, 14254,
+	0xc2, 14254,
+	0xc3, 14254,
+	0xc4, 14254,
+	0xc5, 14254,
+	0xc6, 14254,
+	0xc7, 14254,
+	0xE0, 14258,
+	0xe8, 14262,
+	0xe9, 14262,
+	0xea, 14262,
+	0xeb, 14262,
+	0xec, 14262,
+	0xed, 14262,
+	0xee, 14262,
+	0xef, 14262,
+	0xf0, 14267,
+	0xf1, 14267,
+	0xf2, 14267,
+	0xf3, 14267,
+	0xf4, 14267,
+	0xf5, 14267,
+	0xf6, 14267,
+	0xf7, 14267,
+	/*14211*/ uint16(xCondSlashR),
+	14220, // 0
+	14224, // 1
+	14230, // 2
+	14234, // 3
+	14238, // 4
+	14242, // 5
+	14246, // 6
+	14250, // 7
+	/*14220*/ uint16(xSetOp), uint16(FILD),
+	/*14222*/ uint16(xArgM16int),
+	/*14223*/ uint16(xMatch),
+	/*14224*/ uint16(xSetOp), uint16(FISTTP),
+	/*14226*/ uint16(xSetFeature), uint16(FeatureSSE3),
+	/*14228*/ uint16(xArgM16int),
+	/*14229*/ uint16(xMatch),
+	/*14230*/ uint16(xSetOp), uint16(FIST),
+	/*14232*/ uint16(xArgM16int),
+	/*14233*/ uint16(xMatch),
+	/*14234*/ uint16(xSetOp), uint16(FISTP),
+	/*14236*/ uint16(xArgM16int),
+	/*14237*/ uint16(xMatch),
+	/*14238*/ uint16(xSetOp), uint16(FBLD),
+	/*14240*/ uint16(xArgM80dec),
+	/*14241*/ uint16(xMatch),
+	/*14242*/ uint16(xSetOp), uint16(FILD),
+	/*14244*/ uint16(xArgM64int),
+	/*14245*/ uint16(xMatch),
+	/*14246*/ uint16(xSetOp), uint16(FBSTP),
+	/*14248*/ uint16(xArgM80bcd),
+	/*14249*/ uint16(xMatch),
+	/*14250*/ uint16(xSetOp), uint16(FISTP),
+	/*14252*/ uint16(xArgM64int),
+	/*14253*/ uint16(xMatch),
+	/*14254*/ uint16(xSetOp), uint16(FFREEP),
+	/*14256*/ uint16(xArgSTi),
+	/*14257*/ uint16(xMatch),
+	/*14258*/ uint16(xSetOp), uint16(FNSTSW),
+	/*14260*/ uint16(xArgAX),
+	/*14261*/ uint16(xMatch),
+	/*14262*/ uint16(xSetOp), uint16(FUCOMIP),
+	/*14264*/ uint16(xArgST),
+	/*14265*/ uint16(xArgSTi),
+	/*14266*/ uint16(xMatch),
+	/*14267*/ uint16(xSetOp), uint16(FCOMIP),
+	/*14269*/ uint16(xArgST),
+	/*14270*/ uint16(xArgSTi),
+	/*14271*/ uint16(xMatch),
+	/*14272*/ uint16(xSetOp), uint16(LOOPNE),
+	/*14274*/ uint16(xReadCb),
+	/*14275*/ uint16(xArgRel8),
+	/*14276*/ uint16(xMatch),
+	/*14277*/ uint16(xSetOp), uint16(LOOPE),
+	/*14279*/ uint16(xReadCb),
+	/*14280*/ uint16(xArgRel8),
+	/*14281*/ uint16(xMatch),
+	/*14282*/ uint16(xSetOp), uint16(LOOP),
+	/*14284*/ uint16(xReadCb),
+	/*14285*/ uint16(xArgRel8),
+	/*14286*/ uint16(xMatch),
+	/*14287*/ uint16(xCondIs64), 14290, 14304,
+	/*14290*/ uint16(xCondAddrSize), 14294, 14299, 0,
+	/*14294*/ uint16(xSetOp), uint16(JCXZ),
+	/*14296*/ uint16(xReadCb),
+	/*14297*/ uint16(xArgRel8),
+	/*14298*/ uint16(xMatch),
+	/*14299*/ uint16(xSetOp), uint16(JECXZ),
+	/*14301*/ uint16(xReadCb),
+	/*14302*/ uint16(xArgRel8),
+	/*14303*/ uint16(xMatch),
+	/*14304*/ uint16(xCondAddrSize), 0, 14299, 14308,
+	/*14308*/ uint16(xSetOp), uint16(JRCXZ),
+	/*14310*/ uint16(xReadCb),
+	/*14311*/ uint16(xArgRel8),
+	/*14312*/ uint16(xMatch),
+	/*14313*/ uint16(xSetOp), uint16(IN),
+	/*14315*/ uint16(xReadIb),
+	/*14316*/ uint16(xArgAL),
+	/*14317*/ uint16(xArgImm8u),
+	/*14318*/ uint16(xMatch),
+	/*14319*/ uint16(xCondDataSize), 14323, 14329, 14335,
+	/*14323*/ uint16(xSetOp), uint16(IN),
+	/*14325*/ uint16(xReadIb),
+	/*14326*/ uint16(xArgAX),
+	/*14327*/ uint16(xArgImm8u),
+	/*14328*/ uint16(xMatch),
+	/*14329*/ uint16(xSetOp), uint16(IN),
+	/*14331*/ uint16(xReadIb),
+	/*14332*/ uint16(xArgEAX),
+	/*14333*/ uint16(xArgImm8u),
+	/*14334*/ uint16(xMatch),
+	/*14335*/ uint16(xSetOp), uint16(IN),
+	/*14337*/ uint16(xReadIb),
+	/*14338*/ uint16(xArgEAX),
+	/*14339*/ uint16(xArgImm8u),
+	/*14340*/ uint16(xMatch),
+	/*14341*/ uint16(xSetOp), uint16(OUT),
+	/*14343*/ uint16(xReadIb),
+	/*14344*/ uint16(xArgImm8u),
+	/*14345*/ uint16(xArgAL),
+	/*14346*/ uint16(xMatch),
+	/*14347*/ uint16(xCondDataSize), 14351, 14357, 14363,
+	/*14351*/ uint16(xSetOp), uint16(OUT),
+	/*14353*/ uint16(xReadIb),
+	/*14354*/ uint16(xArgImm8u),
+	/*14355*/ uint16(xArgAX),
+	/*14356*/ uint16(xMatch),
+	/*14357*/ uint16(xSetOp), uint16(OUT),
+	/*14359*/ uint16(xReadIb),
+	/*14360*/ uint16(xArgImm8u),
+	/*14361*/ uint16(xArgEAX),
+	/*14362*/ uint16(xMatch),
+	/*14363*/ uint16(xSetOp), uint16(OUT),
+	/*14365*/ uint16(xReadIb),
+	/*14366*/ uint16(xArgImm8u),
+	/*14367*/ uint16(xArgEAX),
+	/*14368*/ uint16(xMatch),
+	/*14369*/ uint16(xCondIs64), 14372, 14386,
+	/*14372*/ uint16(xCondDataSize), 14376, 14381, 0,
+	/*14376*/ uint16(xSetOp), uint16(CALL),
+	/*14378*/ uint16(xReadCw),
+	/*14379*/ uint16(xArgRel16),
+	/*14380*/ uint16(xMatch),
+	/*14381*/ uint16(xSetOp), uint16(CALL),
+	/*14383*/ uint16(xReadCd),
+	/*14384*/ uint16(xArgRel32),
+	/*14385*/ uint16(xMatch),
+	/*14386*/ uint16(xCondDataSize), 14390, 14381, 14395,
+	/*14390*/ uint16(xSetOp), uint16(CALL),
+	/*14392*/ uint16(xReadCd),
+	/*14393*/ uint16(xArgRel32),
+	/*14394*/ uint16(xMatch),
+	/*14395*/ uint16(xSetOp), uint16(CALL),
+	/*14397*/ uint16(xReadCd),
+	/*14398*/ uint16(xArgRel32),
+	/*14399*/ uint16(xMatch),
+	/*14400*/ uint16(xCondIs64), 14403, 14417,
+	/*14403*/ uint16(xCondDataSize), 14407, 14412, 0,
+	/*14407*/ uint16(xSetOp), uint16(JMP),
+	/*14409*/ uint16(xReadCw),
+	/*14410*/ uint16(xArgRel16),
+	/*14411*/ uint16(xMatch),
+	/*14412*/ uint16(xSetOp), uint16(JMP),
+	/*14414*/ uint16(xReadCd),
+	/*14415*/ uint16(xArgRel32),
+	/*14416*/ uint16(xMatch),
+	/*14417*/ uint16(xCondDataSize), 14421, 14412, 14426,
+	/*14421*/ uint16(xSetOp), uint16(JMP),
+	/*14423*/ uint16(xReadCd),
+	/*14424*/ uint16(xArgRel32),
+	/*14425*/ uint16(xMatch),
+	/*14426*/ uint16(xSetOp), uint16(JMP),
+	/*14428*/ uint16(xReadCd),
+	/*14429*/ uint16(xArgRel32),
+	/*14430*/ uint16(xMatch),
+	/*14431*/ uint16(xCondIs64), 14434, 0,
+	/*14434*/ uint16(xCondDataSize), 14438, 14443, 0,
+	/*14438*/ uint16(xSetOp), uint16(LJMP),
+	/*14440*/ uint16(xReadCd),
+	/*14441*/ uint16(xArgPtr16colon16),
+	/*14442*/ uint16(xMatch),
+	/*14443*/ uint16(xSetOp), uint16(LJMP),
+	/*14445*/ uint16(xReadCp),
+	/*14446*/ uint16(xArgPtr16colon32),
+	/*14447*/ uint16(xMatch),
+	/*14448*/ uint16(xSetOp), uint16(JMP),
+	/*14450*/ uint16(xReadCb),
+	/*14451*/ uint16(xArgRel8),
+	/*14452*/ uint16(xMatch),
+	/*14453*/ uint16(xSetOp), uint16(IN),
+	/*14455*/ uint16(xArgAL),
+	/*14456*/ uint16(xArgDX),
+	/*14457*/ uint16(xMatch),
+	/*14458*/ uint16(xCondDataSize), 14462, 14467, 14472,
+	/*14462*/ uint16(xSetOp), uint16(IN),
+	/*14464*/ uint16(xArgAX),
+	/*14465*/ uint16(xArgDX),
+	/*14466*/ uint16(xMatch),
+	/*14467*/ uint16(xSetOp), uint16(IN),
+	/*14469*/ uint16(xArgEAX),
+	/*14470*/ uint16(xArgDX),
+	/*14471*/ uint16(xMatch),
+	/*14472*/ uint16(xSetOp), uint16(IN),
+	/*14474*/ uint16(xArgEAX),
+	/*14475*/ uint16(xArgDX),
+	/*14476*/ uint16(xMatch),
+	/*14477*/ uint16(xSetOp), uint16(OUT),
+	/*14479*/ uint16(xArgDX),
+	/*14480*/ uint16(xArgAL),
+	/*14481*/ uint16(xMatch),
+	/*14482*/ uint16(xCondDataSize), 14486, 14491, 14496,
+	/*14486*/ uint16(xSetOp), uint16(OUT),
+	/*14488*/ uint16(xArgDX),
+	/*14489*/ uint16(xArgAX),
+	/*14490*/ uint16(xMatch),
+	/*14491*/ uint16(xSetOp), uint16(OUT),
+	/*14493*/ uint16(xArgDX),
+	/*14494*/ uint16(xArgEAX),
+	/*14495*/ uint16(xMatch),
+	/*14496*/ uint16(xSetOp), uint16(OUT),
+	/*14498*/ uint16(xArgDX),
+	/*14499*/ uint16(xArgEAX),
+	/*14500*/ uint16(xMatch),
+	/*14501*/ uint16(xSetOp), uint16(ICEBP),
+	/*14503*/ uint16(xMatch),
+	/*14504*/ uint16(xSetOp), uint16(HLT),
+	/*14506*/ uint16(xMatch),
+	/*14507*/ uint16(xSetOp), uint16(CMC),
+	/*14509*/ uint16(xMatch),
+	/*14510*/ uint16(xCondSlashR),
+	14519, // 0
+	0,     // 1
+	14525, // 2
+	14529, // 3
+	14533, // 4
+	14537, // 5
+	14541, // 6
+	14545, // 7
+	/*14519*/ uint16(xSetOp), uint16(TEST),
+	/*14521*/ uint16(xReadIb),
+	/*14522*/ uint16(xArgRM8),
+	/*14523*/ uint16(xArgImm8u),
+	/*14524*/ uint16(xMatch),
+	/*14525*/ uint16(xSetOp), uint16(NOT),
+	/*14527*/ uint16(xArgRM8),
+	/*14528*/ uint16(xMatch),
+	/*14529*/ uint16(xSetOp), uint16(NEG),
+	/*14531*/ uint16(xArgRM8),
+	/*14532*/ uint16(xMatch),
+	/*14533*/ uint16(xSetOp), uint16(MUL),
+	/*14535*/ uint16(xArgRM8),
+	/*14536*/ uint16(xMatch),
+	/*14537*/ uint16(xSetOp), uint16(IMUL),
+	/*14539*/ uint16(xArgRM8),
+	/*14540*/ uint16(xMatch),
+	/*14541*/ uint16(xSetOp), uint16(DIV),
+	/*14543*/ uint16(xArgRM8),
+	/*14544*/ uint16(xMatch),
+	/*14545*/ uint16(xSetOp), uint16(IDIV),
+	/*14547*/ uint16(xArgRM8),
+	/*14548*/ uint16(xMatch),
+	/*14549*/ uint16(xCondSlashR),
+	14558, // 0
+	0,     // 1
+	14587, // 2
+	14610, // 3
+	14633, // 4
+	14656, // 5
+	14679, // 6
+	14702, // 7
+	/*14558*/ uint16(xCondIs64), 14561, 14577,
+	/*14561*/ uint16(xCondDataSize), 14565, 14571, 0,
+	/*14565*/ uint16(xSetOp), uint16(TEST),
+	/*14567*/ uint16(xReadIw),
+	/*14568*/ uint16(xArgRM16),
+	/*14569*/ uint16(xArgImm16),
+	/*14570*/ uint16(xMatch),
+	/*14571*/ uint16(xSetOp), uint16(TEST),
+	/*14573*/ uint16(xReadId),
+	/*14574*/ uint16(xArgRM32),
+	/*14575*/ uint16(xArgImm32),
+	/*14576*/ uint16(xMatch),
+	/*14577*/ uint16(xCondDataSize), 14565, 14571, 14581,
+	/*14581*/ uint16(xSetOp), uint16(TEST),
+	/*14583*/ uint16(xReadId),
+	/*14584*/ uint16(xArgRM64),
+	/*14585*/ uint16(xArgImm32),
+	/*14586*/ uint16(xMatch),
+	/*14587*/ uint16(xCondIs64), 14590, 14602,
+	/*14590*/ uint16(xCondDataSize), 14594, 14598, 0,
+	/*14594*/ uint16(xSetOp), uint16(NOT),
+	/*14596*/ uint16(xArgRM16),
+	/*14597*/ uint16(xMatch),
+	/*14598*/ uint16(xSetOp), uint16(NOT),
+	/*14600*/ uint16(xArgRM32),
+	/*14601*/ uint16(xMatch),
+	/*14602*/ uint16(xCondDataSize), 14594, 14598, 14606,
+	/*14606*/ uint16(xSetOp), uint16(NOT),
+	/*14608*/ uint16(xArgRM64),
+	/*14609*/ uint16(xMatch),
+	/*14610*/ uint16(xCondIs64), 14613, 14625,
+	/*14613*/ uint16(xCondDataSize), 14617, 14621, 0,
+	/*14617*/ uint16(xSetOp), uint16(NEG),
+	/*14619*/ uint16(xArgRM16),
+	/*14620*/ uint16(xMatch),
+	/*14621*/ uint16(xSetOp), uint16(NEG),
+	/*14623*/ uint16(xArgRM32),
+	/*14624*/ uint16(xMatch),
+	/*14625*/ uint16(xCondDataSize), 14617, 14621, 14629,
+	/*14629*/ uint16(xSetOp), uint16(NEG),
+	/*14631*/ uint16(xArgRM64),
+	/*14632*/ uint16(xMatch),
+	/*14633*/ uint16(xCondIs64), 14636, 14648,
+	/*14636*/ uint16(xCondDataSize), 14640, 14644, 0,
+	/*14640*/ uint16(xSetOp), uint16(MUL),
+	/*14642*/ uint16(xArgRM16),
+	/*14643*/ uint16(xMatch),
+	/*14644*/ uint16(xSetOp), uint16(MUL),
+	/*14646*/ uint16(xArgRM32),
+	/*14647*/ uint16(xMatch),
+	/*14648*/ uint16(xCondDataSize), 14640, 14644, 14652,
+	/*14652*/ uint16(xSetOp), uint16(MUL),
+	/*14654*/ uint16(xArgRM64),
+	/*14655*/ uint16(xMatch),
+	/*14656*/ uint16(xCondIs64), 14659, 14671,
+	/*14659*/ uint16(xCondDataSize), 14663, 14667, 0,
+	/*14663*/ uint16(xSetOp), uint16(IMUL),
+	/*14665*/ uint16(xArgRM16),
+	/*14666*/ uint16(xMatch),
+	/*14667*/ uint16(xSetOp), uint16(IMUL),
+	/*14669*/ uint16(xArgRM32),
+	/*14670*/ uint16(xMatch),
+	/*14671*/ uint16(xCondDataSize), 14663, 14667, 14675,
+	/*14675*/ uint16(xSetOp), uint16(IMUL),
+	/*14677*/ uint16(xArgRM64),
+	/*14678*/ uint16(xMatch),
+	/*14679*/ uint16(xCondIs64), 14682, 14694,
+	/*14682*/ uint16(xCondDataSize), 14686, 14690, 0,
+	/*14686*/ uint16(xSetOp), uint16(DIV),
+	/*14688*/ uint16(xArgRM16),
+	/*14689*/ uint16(xMatch),
+	/*14690*/ uint16(xSetOp), uint16(DIV),
+	/*14692*/ uint16(xArgRM32),
+	/*14693*/ uint16(xMatch),
+	/*14694*/ uint16(xCondDataSize), 14686, 14690, 14698,
+	/*14698*/ uint16(xSetOp), uint16(DIV),
+	/*14700*/ uint16(xArgRM64),
+	/*14701*/ uint16(xMatch),
+	/*14702*/ uint16(xCondIs64), 14705, 14717,
+	/*14705*/ uint16(xCondDataSize), 14709, 14713, 0,
+	/*14709*/ uint16(xSetOp), uint16(IDIV),
+	/*14711*/ uint16(xArgRM16),
+	/*14712*/ uint16(xMatch),
+	/*14713*/ uint16(xSetOp), uint16(IDIV),
+	/*14715*/ uint16(xArgRM32),
+	/*14716*/ uint16(xMatch),
+	/*14717*/ uint16(xCondDataSize), 14709, 14713, 14721,
+	/*14721*/ uint16(xSetOp), uint16(IDIV),
+	/*14723*/ uint16(xArgRM64),
+	/*14724*/ uint16(xMatch),
+	/*14725*/ uint16(xSetOp), uint16(CLC),
+	/*14727*/ uint16(xMatch),
+	/*14728*/ uint16(xSetOp), uint16(STC),
+	/*14730*/ uint16(xMatch),
+	/*14731*/ uint16(xSetOp), uint16(CLI),
+	/*14733*/ uint16(xMatch),
+	/*14734*/ uint16(xSetOp), uint16(STI),
+	/*14736*/ uint16(xMatch),
+	/*14737*/ uint16(xSetOp), uint16(CLD),
+	/*14739*/ uint16(xMatch),
+	/*14740*/ uint16(xSetOp), uint16(STD),
+	/*14742*/ uint16(xMatch),
+	/*14743*/ uint16(xCondSlashR),
+	14752, // 0
+	14756, // 1
 	0,     // 2
 	0,     // 3
 	0,     // 4
 	0,     // 5
 	0,     // 6
 	0,     // 7
-	/*13101*/ uint16(xSetOp), uint16(INC),
-	/*13103*/ uint16(xArgRM8),
-	/*13104*/ uint16(xMatch),
-	/*13105*/ uint16(xSetOp), uint16(DEC),
-	/*13107*/ uint16(xArgRM8),
-	/*13108*/ uint16(xMatch),
-	/*13109*/ uint16(xCondSlashR),
-	13118, // 0
-	13141, // 1
-	13164, // 2
-	13183, // 3
-	13206, // 4
-	13225, // 5
-	13248, // 6
+	/*14752*/ uint16(xSetOp), uint16(INC),
+	/*14754*/ uint16(xArgRM8),
+	/*14755*/ uint16(xMatch),
+	/*14756*/ uint16(xSetOp), uint16(DEC),
+	/*14758*/ uint16(xArgRM8),
+	/*14759*/ uint16(xMatch),
+	/*14760*/ uint16(xCondSlashR),
+	14769, // 0
+	14792, // 1
+	14815, // 2
+	14834, // 3
+	14857, // 4
+	14876, // 5
+	14899, // 6
 	0,     // 7
-	/*13118*/ uint16(xCondIs64), 13121, 13133,
-	/*13121*/ uint16(xCondDataSize), 13125, 13129, 0,
-	/*13125*/ uint16(xSetOp), uint16(INC),
-	/*13127*/ uint16(xArgRM16),
-	/*13128*/ uint16(xMatch),
-	/*13129*/ uint16(xSetOp), uint16(INC),
-	/*13131*/ uint16(xArgRM32),
-	/*13132*/ uint16(xMatch),
-	/*13133*/ uint16(xCondDataSize), 13125, 13129, 13137,
-	/*13137*/ uint16(xSetOp), uint16(INC),
-	/*13139*/ uint16(xArgRM64),
-	/*13140*/ uint16(xMatch),
-	/*13141*/ uint16(xCondIs64), 13144, 13156,
-	/*13144*/ uint16(xCondDataSize), 13148, 13152, 0,
-	/*13148*/ uint16(xSetOp), uint16(DEC),
-	/*13150*/ uint16(xArgRM16),
-	/*13151*/ uint16(xMatch),
-	/*13152*/ uint16(xSetOp), uint16(DEC),
-	/*13154*/ uint16(xArgRM32),
-	/*13155*/ uint16(xMatch),
-	/*13156*/ uint16(xCondDataSize), 13148, 13152, 13160,
-	/*13160*/ uint16(xSetOp), uint16(DEC),
-	/*13162*/ uint16(xArgRM64),
-	/*13163*/ uint16(xMatch),
-	/*13164*/ uint16(xCondIs64), 13167, 13179,
-	/*13167*/ uint16(xCondDataSize), 13171, 13175, 0,
-	/*13171*/ uint16(xSetOp), uint16(CALL),
-	/*13173*/ uint16(xArgRM16),
-	/*13174*/ uint16(xMatch),
-	/*13175*/ uint16(xSetOp), uint16(CALL),
-	/*13177*/ uint16(xArgRM32),
-	/*13178*/ uint16(xMatch),
-	/*13179*/ uint16(xSetOp), uint16(CALL),
-	/*13181*/ uint16(xArgRM64),
-	/*13182*/ uint16(xMatch),
-	/*13183*/ uint16(xCondIs64), 13186, 13198,
-	/*13186*/ uint16(xCondDataSize), 13190, 13194, 0,
-	/*13190*/ uint16(xSetOp), uint16(LCALL),
-	/*13192*/ uint16(xArgM16colon16),
-	/*13193*/ uint16(xMatch),
-	/*13194*/ uint16(xSetOp), uint16(LCALL),
-	/*13196*/ uint16(xArgM16colon32),
-	/*13197*/ uint16(xMatch),
-	/*13198*/ uint16(xCondDataSize), 13190, 13194, 13202,
-	/*13202*/ uint16(xSetOp), uint16(LCALL),
-	/*13204*/ uint16(xArgM16colon64),
-	/*13205*/ uint16(xMatch),
-	/*13206*/ uint16(xCondIs64), 13209, 13221,
-	/*13209*/ uint16(xCondDataSize), 13213, 13217, 0,
-	/*13213*/ uint16(xSetOp), uint16(JMP),
-	/*13215*/ uint16(xArgRM16),
-	/*13216*/ uint16(xMatch),
-	/*13217*/ uint16(xSetOp), uint16(JMP),
-	/*13219*/ uint16(xArgRM32),
-	/*13220*/ uint16(xMatch),
-	/*13221*/ uint16(xSetOp), uint16(JMP),
-	/*13223*/ uint16(xArgRM64),
-	/*13224*/ uint16(xMatch),
-	/*13225*/ uint16(xCondIs64), 13228, 13240,
-	/*13228*/ uint16(xCondDataSize), 13232, 13236, 0,
-	/*13232*/ uint16(xSetOp), uint16(LJMP),
-	/*13234*/ uint16(xArgM16colon16),
-	/*13235*/ uint16(xMatch),
-	/*13236*/ uint16(xSetOp), uint16(LJMP),
-	/*13238*/ uint16(xArgM16colon32),
-	/*13239*/ uint16(xMatch),
-	/*13240*/ uint16(xCondDataSize), 13232, 13236, 13244,
-	/*13244*/ uint16(xSetOp), uint16(LJMP),
-	/*13246*/ uint16(xArgM16colon64),
-	/*13247*/ uint16(xMatch),
-	/*13248*/ uint16(xCondIs64), 13251, 13263,
-	/*13251*/ uint16(xCondDataSize), 13255, 13259, 0,
-	/*13255*/ uint16(xSetOp), uint16(PUSH),
-	/*13257*/ uint16(xArgRM16),
-	/*13258*/ uint16(xMatch),
-	/*13259*/ uint16(xSetOp), uint16(PUSH),
-	/*13261*/ uint16(xArgRM32),
-	/*13262*/ uint16(xMatch),
-	/*13263*/ uint16(xCondDataSize), 13255, 13267, 13271,
-	/*13267*/ uint16(xSetOp), uint16(PUSH),
-	/*13269*/ uint16(xArgRM64),
-	/*13270*/ uint16(xMatch),
-	/*13271*/ uint16(xSetOp), uint16(PUSH),
-	/*13273*/ uint16(xArgRM64),
-	/*13274*/ uint16(xMatch),
+	/*14769*/ uint16(xCondIs64), 14772, 14784,
+	/*14772*/ uint16(xCondDataSize), 14776, 14780, 0,
+	/*14776*/ uint16(xSetOp), uint16(INC),
+	/*14778*/ uint16(xArgRM16),
+	/*14779*/ uint16(xMatch),
+	/*14780*/ uint16(xSetOp), uint16(INC),
+	/*14782*/ uint16(xArgRM32),
+	/*14783*/ uint16(xMatch),
+	/*14784*/ uint16(xCondDataSize), 14776, 14780, 14788,
+	/*14788*/ uint16(xSetOp), uint16(INC),
+	/*14790*/ uint16(xArgRM64),
+	/*14791*/ uint16(xMatch),
+	/*14792*/ uint16(xCondIs64), 14795, 14807,
+	/*14795*/ uint16(xCondDataSize), 14799, 14803, 0,
+	/*14799*/ uint16(xSetOp), uint16(DEC),
+	/*14801*/ uint16(xArgRM16),
+	/*14802*/ uint16(xMatch),
+	/*14803*/ uint16(xSetOp), uint16(DEC),
+	/*14805*/ uint16(xArgRM32),
+	/*14806*/ uint16(xMatch),
+	/*14807*/ uint16(xCondDataSize), 14799, 14803, 14811,
+	/*14811*/ uint16(xSetOp), uint16(DEC),
+	/*14813*/ uint16(xArgRM64),
+	/*14814*/ uint16(xMatch),
+	/*14815*/ uint16(xCondIs64), 14818, 14830,
+	/*14818*/ uint16(xCondDataSize), 14822, 14826, 0,
+	/*14822*/ uint16(xSetOp), uint16(CALL),
+	/*14824*/ uint16(xArgRM16),
+	/*14825*/ uint16(xMatch),
+	/*14826*/ uint16(xSetOp), uint16(CALL),
+	/*14828*/ uint16(xArgRM32),
+	/*14829*/ uint16(xMatch),
+	/*14830*/ uint16(xSetOp), uint16(CALL),
+	/*14832*/ uint16(xArgRM64),
+	/*14833*/ uint16(xMatch),
+	/*14834*/ uint16(xCondIs64), 14837, 14849,
+	/*14837*/ uint16(xCondDataSize), 14841, 14845, 0,
+	/*14841*/ uint16(xSetOp), uint16(LCALL),
+	/*14843*/ uint16(xArgM16colon16),
+	/*14844*/ uint16(xMatch),
+	/*14845*/ uint16(xSetOp), uint16(LCALL),
+	/*14847*/ uint16(xArgM16colon32),
+	/*14848*/ uint16(xMatch),
+	/*14849*/ uint16(xCondDataSize), 14841, 14845, 14853,
+	/*14853*/ uint16(xSetOp), uint16(LCALL),
+	/*14855*/ uint16(xArgM16colon64),
+	/*14856*/ uint16(xMatch),
+	/*14857*/ uint16(xCondIs64), 14860, 14872,
+	/*14860*/ uint16(xCondDataSize), 14864, 14868, 0,
+	/*14864*/ uint16(xSetOp), uint16(JMP),
+	/*14866*/ uint16(xArgRM16),
+	/*14867*/ uint16(xMatch),
+	/*14868*/ uint16(xSetOp), uint16(JMP),
+	/*14870*/ uint16(xArgRM32),
+	/*14871*/ uint16(xMatch),
+	/*14872*/ uint16(xSetOp), uint16(JMP),
+	/*14874*/ uint16(xArgRM64),
+	/*14875*/ uint16(xMatch),
+	/*14876*/ uint16(xCondIs64), 14879, 14891,
+	/*14879*/ uint16(xCondDataSize), 14883, 14887, 0,
+	/*14883*/ uint16(xSetOp), uint16(LJMP),
+	/*14885*/ uint16(xArgM16colon16),
+	/*14886*/ uint16(xMatch),
+	/*14887*/ uint16(xSetOp), uint16(LJMP),
+	/*14889*/ uint16(xArgM16colon32),
+	/*14890*/ uint16(xMatch),
+	/*14891*/ uint16(xCondDataSize), 14883, 14887, 14895,
+	/*14895*/ uint16(xSetOp), uint16(LJMP),
+	/*14897*/ uint16(xArgM16colon64),
+	/*14898*/ uint16(xMatch),
+	/*14899*/ uint16(xCondIs64), 14902, 14914,
+	/*14902*/ uint16(xCondDataSize), 14906, 14910, 0,
+	/*14906*/ uint16(xSetOp), uint16(PUSH),
+	/*14908*/ uint16(xArgRM16),
+	/*14909*/ uint16(xMatch),
+	/*14910*/ uint16(xSetOp), uint16(PUSH),
+	/*14912*/ uint16(xArgRM32),
+	/*14913*/ uint16(xMatch),
+	/*14914*/ uint16(xCondDataSize), 14906, 14918, 14922,
+	/*14918*/ uint16(xSetOp), uint16(PUSH),
+	/*14920*/ uint16(xArgRM64),
+	/*14921*/ uint16(xMatch),
+	/*14922*/ uint16(xSetOp), uint16(PUSH),
+	/*14924*/ uint16(xArgRM64),
+	/*14925*/ uint16(xMatch),
 }
 
 const (
@@ -8616,9 +9476,17 @@ const (
 	ADDSUBPD
 	ADDSUBPS
 	AESDEC
+	AESDEC128KL
+	AESDEC256KL
 	AESDECLAST
+	AESDECWIDE128KL
+	AESDECWIDE256KL
 	AESENC
+	AESENC128KL
+	AESENC256KL
 	AESENCLAST
+	AESENCWIDE128KL
+	AESENCWIDE256KL
 	AESIMC
 	AESKEYGENASSIST
 	AND
@@ -8647,7 +9515,10 @@ const (
 	CLD
 	CLFLUSH
 	CLI
+	CLRSSBSY
 	CLTS
+	CLUI
+	CLWB
 	CMC
 	CMOVA
 	CMOVAE
@@ -8717,6 +9588,10 @@ const (
 	DPPD
 	DPPS
 	EMMS
+	ENCODEKEY128
+	ENCODEKEY256
+	ENDBR32
+	ENDBR64
 	ENTER
 	EXTRACTPS
 	F2XM1
@@ -8811,6 +9686,9 @@ const (
 	FXTRACT
 	FYL2X
 	FYL2XP1
+	GF2P8AFFINEINVQB
+	GF2P8AFFINEQB
+	GF2P8MULB
 	HADDPD
 	HADDPS
 	HLT
@@ -8821,6 +9699,8 @@ const (
 	IMUL
 	IN
 	INC
+	INCSSPD
+	INCSSPQ
 	INSB
 	INSD
 	INSERTPS
@@ -8870,6 +9750,7 @@ const (
 	LJMP
 	LLDT
 	LMSW
+	LOADIWKEY
 	LODSB
 	LODSD
 	LODSQ
@@ -9099,8 +9980,11 @@ const (
 	RDFSBASE
 	RDGSBASE
 	RDMSR
+	RDPID
 	RDPMC
 	RDRAND
+	RDSSPD
+	RDSSPQ
 	RDTSC
 	RDTSCP
 	RET
@@ -9113,13 +9997,17 @@ const (
 	RSM
 	RSQRTPS
 	RSQRTSS
+	RSTORSSP
 	SAHF
 	SAR
+	SAVEPREVSSP
 	SBB
 	SCASB
 	SCASD
 	SCASQ
 	SCASW
+	SENDUIPI
+	SERIALIZE
 	SETA
 	SETAE
 	SETB
@@ -9136,6 +10024,7 @@ const (
 	SETO
 	SETP
 	SETS
+	SETSSBSY
 	SFENCE
 	SGDT
 	SHA1MSG1
@@ -9167,6 +10056,7 @@ const (
 	STOSQ
 	STOSW
 	STR
+	STUI
 	SUB
 	SUBPD
 	SUBPS
@@ -9178,12 +10068,17 @@ const (
 	SYSEXIT
 	SYSRET
 	TEST
+	TESTUI
+	TPAUSE
 	TZCNT
 	UCOMISD
 	UCOMISS
 	UD0
 	UD1
 	UD2
+	UIRET
+	UMONITOR
+	UMWAIT
 	UNPCKHPD
 	UNPCKHPS
 	UNPCKLPD
@@ -9194,6 +10089,10 @@ const (
 	WRFSBASE
 	WRGSBASE
 	WRMSR
+	WRSSD
+	WRSSQ
+	WRUSSD
+	WRUSSQ
 	XABORT
 	XADD
 	XBEGIN
@@ -9223,619 +10122,658 @@ const (
 const maxNonAVXOp = XTEST
 
 var opNames = [...]string{
-	AAA:             "AAA",
-	AAD:             "AAD",
-	AAM:             "AAM",
-	AAS:             "AAS",
-	ADC:             "ADC",
-	ADD:             "ADD",
-	ADDPD:           "ADDPD",
-	ADDPS:           "ADDPS",
-	ADDSD:           "ADDSD",
-	ADDSS:           "ADDSS",
-	ADDSUBPD:        "ADDSUBPD",
-	ADDSUBPS:        "ADDSUBPS",
-	AESDEC:          "AESDEC",
-	AESDECLAST:      "AESDECLAST",
-	AESENC:          "AESENC",
-	AESENCLAST:      "AESENCLAST",
-	AESIMC:          "AESIMC",
-	AESKEYGENASSIST: "AESKEYGENASSIST",
-	AND:             "AND",
-	ANDNPD:          "ANDNPD",
-	ANDNPS:          "ANDNPS",
-	ANDPD:           "ANDPD",
-	ANDPS:           "ANDPS",
-	ARPL:            "ARPL",
-	BLENDPD:         "BLENDPD",
-	BLENDPS:         "BLENDPS",
-	BLENDVPD:        "BLENDVPD",
-	BLENDVPS:        "BLENDVPS",
-	BOUND:           "BOUND",
-	BSF:             "BSF",
-	BSR:             "BSR",
-	BSWAP:           "BSWAP",
-	BT:              "BT",
-	BTC:             "BTC",
-	BTR:             "BTR",
-	BTS:             "BTS",
-	CALL:            "CALL",
-	CBW:             "CBW",
-	CDQ:             "CDQ",
-	CDQE:            "CDQE",
-	CLC:             "CLC",
-	CLD:             "CLD",
-	CLFLUSH:         "CLFLUSH",
-	CLI:             "CLI",
-	CLTS:            "CLTS",
-	CMC:             "CMC",
-	CMOVA:           "CMOVA",
-	CMOVAE:          "CMOVAE",
-	CMOVB:           "CMOVB",
-	CMOVBE:          "CMOVBE",
-	CMOVE:           "CMOVE",
-	CMOVG:           "CMOVG",
-	CMOVGE:          "CMOVGE",
-	CMOVL:           "CMOVL",
-	CMOVLE:          "CMOVLE",
-	CMOVNE:          "CMOVNE",
-	CMOVNO:          "CMOVNO",
-	CMOVNP:          "CMOVNP",
-	CMOVNS:          "CMOVNS",
-	CMOVO:           "CMOVO",
-	CMOVP:           "CMOVP",
-	CMOVS:           "CMOVS",
-	CMP:             "CMP",
-	CMPPD:           "CMPPD",
-	CMPPS:           "CMPPS",
-	CMPSB:           "CMPSB",
-	CMPSD:           "CMPSD",
-	CMPSD_XMM:       "CMPSD_XMM",
-	CMPSQ:           "CMPSQ",
-	CMPSS:           "CMPSS",
-	CMPSW:           "CMPSW",
-	CMPXCHG:         "CMPXCHG",
-	CMPXCHG16B:      "CMPXCHG16B",
-	CMPXCHG8B:       "CMPXCHG8B",
-	COMISD:          "COMISD",
-	COMISS:          "COMISS",
-	CPUID:           "CPUID",
-	CQO:             "CQO",
-	CRC32:           "CRC32",
-	CVTDQ2PD:        "CVTDQ2PD",
-	CVTDQ2PS:        "CVTDQ2PS",
-	CVTPD2DQ:        "CVTPD2DQ",
-	CVTPD2PI:        "CVTPD2PI",
-	CVTPD2PS:        "CVTPD2PS",
-	CVTPI2PD:        "CVTPI2PD",
-	CVTPI2PS:        "CVTPI2PS",
-	CVTPS2DQ:        "CVTPS2DQ",
-	CVTPS2PD:        "CVTPS2PD",
-	CVTPS2PI:        "CVTPS2PI",
-	CVTSD2SI:        "CVTSD2SI",
-	CVTSD2SS:        "CVTSD2SS",
-	CVTSI2SD:        "CVTSI2SD",
-	CVTSI2SS:        "CVTSI2SS",
-	CVTSS2SD:        "CVTSS2SD",
-	CVTSS2SI:        "CVTSS2SI",
-	CVTTPD2DQ:       "CVTTPD2DQ",
-	CVTTPD2PI:       "CVTTPD2PI",
-	CVTTPS2DQ:       "CVTTPS2DQ",
-	CVTTPS2PI:       "CVTTPS2PI",
-	CVTTSD2SI:       "CVTTSD2SI",
-	CVTTSS2SI:       "CVTTSS2SI",
-	CWD:             "CWD",
-	CWDE:            "CWDE",
-	DAA:             "DAA",
-	DAS:             "DAS",
-	DEC:             "DEC",
-	DIV:             "DIV",
-	DIVPD:           "DIVPD",
-	DIVPS:           "DIVPS",
-	DIVSD:           "DIVSD",
-	DIVSS:           "DIVSS",
-	DPPD:            "DPPD",
-	DPPS:            "DPPS",
-	EMMS:            "EMMS",
-	ENTER:           "ENTER",
-	EXTRACTPS:       "EXTRACTPS",
-	F2XM1:           "F2XM1",
-	FABS:            "FABS",
-	FADD:            "FADD",
-	FADDP:           "FADDP",
-	FBLD:            "FBLD",
-	FBSTP:           "FBSTP",
-	FCHS:            "FCHS",
-	FCMOVB:          "FCMOVB",
-	FCMOVBE:         "FCMOVBE",
-	FCMOVE:          "FCMOVE",
-	FCMOVNB:         "FCMOVNB",
-	FCMOVNBE:        "FCMOVNBE",
-	FCMOVNE:         "FCMOVNE",
-	FCMOVNU:         "FCMOVNU",
-	FCMOVU:          "FCMOVU",
-	FCOM:            "FCOM",
-	FCOMI:           "FCOMI",
-	FCOMIP:          "FCOMIP",
-	FCOMP:           "FCOMP",
-	FCOMPP:          "FCOMPP",
-	FCOS:            "FCOS",
-	FDECSTP:         "FDECSTP",
-	FDIV:            "FDIV",
-	FDIVP:           "FDIVP",
-	FDIVR:           "FDIVR",
-	FDIVRP:          "FDIVRP",
-	FFREE:           "FFREE",
-	FFREEP:          "FFREEP",
-	FIADD:           "FIADD",
-	FICOM:           "FICOM",
-	FICOMP:          "FICOMP",
-	FIDIV:           "FIDIV",
-	FIDIVR:          "FIDIVR",
-	FILD:            "FILD",
-	FIMUL:           "FIMUL",
-	FINCSTP:         "FINCSTP",
-	FIST:            "FIST",
-	FISTP:           "FISTP",
-	FISTTP:          "FISTTP",
-	FISUB:           "FISUB",
-	FISUBR:          "FISUBR",
-	FLD:             "FLD",
-	FLD1:            "FLD1",
-	FLDCW:           "FLDCW",
-	FLDENV:          "FLDENV",
-	FLDL2E:          "FLDL2E",
-	FLDL2T:          "FLDL2T",
-	FLDLG2:          "FLDLG2",
-	FLDLN2:          "FLDLN2",
-	FLDPI:           "FLDPI",
-	FLDZ:            "FLDZ",
-	FMUL:            "FMUL",
-	FMULP:           "FMULP",
-	FNCLEX:          "FNCLEX",
-	FNINIT:          "FNINIT",
-	FNOP:            "FNOP",
-	FNSAVE:          "FNSAVE",
-	FNSTCW:          "FNSTCW",
-	FNSTENV:         "FNSTENV",
-	FNSTSW:          "FNSTSW",
-	FPATAN:          "FPATAN",
-	FPREM:           "FPREM",
-	FPREM1:          "FPREM1",
-	FPTAN:           "FPTAN",
-	FRNDINT:         "FRNDINT",
-	FRSTOR:          "FRSTOR",
-	FSCALE:          "FSCALE",
-	FSIN:            "FSIN",
-	FSINCOS:         "FSINCOS",
-	FSQRT:           "FSQRT",
-	FST:             "FST",
-	FSTP:            "FSTP",
-	FSUB:            "FSUB",
-	FSUBP:           "FSUBP",
-	FSUBR:           "FSUBR",
-	FSUBRP:          "FSUBRP",
-	FTST:            "FTST",
-	FUCOM:           "FUCOM",
-	FUCOMI:          "FUCOMI",
-	FUCOMIP:         "FUCOMIP",
-	FUCOMP:          "FUCOMP",
-	FUCOMPP:         "FUCOMPP",
-	FWAIT:           "FWAIT",
-	FXAM:            "FXAM",
-	FXCH:            "FXCH",
-	FXRSTOR:         "FXRSTOR",
-	FXRSTOR64:       "FXRSTOR64",
-	FXSAVE:          "FXSAVE",
-	FXSAVE64:        "FXSAVE64",
-	FXTRACT:         "FXTRACT",
-	FYL2X:           "FYL2X",
-	FYL2XP1:         "FYL2XP1",
-	HADDPD:          "HADDPD",
-	HADDPS:          "HADDPS",
-	HLT:             "HLT",
-	HSUBPD:          "HSUBPD",
-	HSUBPS:          "HSUBPS",
-	ICEBP:           "ICEBP",
-	IDIV:            "IDIV",
-	IMUL:            "IMUL",
-	IN:              "IN",
-	INC:             "INC",
-	INSB:            "INSB",
-	INSD:            "INSD",
-	INSERTPS:        "INSERTPS",
-	INSW:            "INSW",
-	INT:             "INT",
-	INTO:            "INTO",
-	INVD:            "INVD",
-	INVLPG:          "INVLPG",
-	INVPCID:         "INVPCID",
-	IRET:            "IRET",
-	IRETD:           "IRETD",
-	IRETQ:           "IRETQ",
-	JA:              "JA",
-	JAE:             "JAE",
-	JB:              "JB",
-	JBE:             "JBE",
-	JCXZ:            "JCXZ",
-	JE:              "JE",
-	JECXZ:           "JECXZ",
-	JG:              "JG",
-	JGE:             "JGE",
-	JL:              "JL",
-	JLE:             "JLE",
-	JMP:             "JMP",
-	JNE:             "JNE",
-	JNO:             "JNO",
-	JNP:             "JNP",
-	JNS:             "JNS",
-	JO:              "JO",
-	JP:              "JP",
-	JRCXZ:           "JRCXZ",
-	JS:              "JS",
-	LAHF:            "LAHF",
-	LAR:             "LAR",
-	LCALL:           "LCALL",
-	LDDQU:           "LDDQU",
-	LDMXCSR:         "LDMXCSR",
-	LDS:             "LDS",
-	LEA:             "LEA",
-	LEAVE:           "LEAVE",
-	LES:             "LES",
-	LFENCE:          "LFENCE",
-	LFS:             "LFS",
-	LGDT:            "LGDT",
-	LGS:             "LGS",
-	LIDT:            "LIDT",
-	LJMP:            "LJMP",
-	LLDT:            "LLDT",
-	LMSW:            "LMSW",
-	LODSB:           "LODSB",
-	LODSD:           "LODSD",
-	LODSQ:           "LODSQ",
-	LODSW:           "LODSW",
-	LOOP:            "LOOP",
-	LOOPE:           "LOOPE",
-	LOOPNE:          "LOOPNE",
-	LRET:            "LRET",
-	LSL:             "LSL",
-	LSS:             "LSS",
-	LTR:             "LTR",
-	LZCNT:           "LZCNT",
-	MASKMOVDQU:      "MASKMOVDQU",
-	MASKMOVQ:        "MASKMOVQ",
-	MAXPD:           "MAXPD",
-	MAXPS:           "MAXPS",
-	MAXSD:           "MAXSD",
-	MAXSS:           "MAXSS",
-	MFENCE:          "MFENCE",
-	MINPD:           "MINPD",
-	MINPS:           "MINPS",
-	MINSD:           "MINSD",
-	MINSS:           "MINSS",
-	MONITOR:         "MONITOR",
-	MOV:             "MOV",
-	MOVAPD:          "MOVAPD",
-	MOVAPS:          "MOVAPS",
-	MOVBE:           "MOVBE",
-	MOVD:            "MOVD",
-	MOVDDUP:         "MOVDDUP",
-	MOVDQ2Q:         "MOVDQ2Q",
-	MOVDQA:          "MOVDQA",
-	MOVDQU:          "MOVDQU",
-	MOVHLPS:         "MOVHLPS",
-	MOVHPD:          "MOVHPD",
-	MOVHPS:          "MOVHPS",
-	MOVLHPS:         "MOVLHPS",
-	MOVLPD:          "MOVLPD",
-	MOVLPS:          "MOVLPS",
-	MOVMSKPD:        "MOVMSKPD",
-	MOVMSKPS:        "MOVMSKPS",
-	MOVNTDQ:         "MOVNTDQ",
-	MOVNTDQA:        "MOVNTDQA",
-	MOVNTI:          "MOVNTI",
-	MOVNTPD:         "MOVNTPD",
-	MOVNTPS:         "MOVNTPS",
-	MOVNTQ:          "MOVNTQ",
-	MOVNTSD:         "MOVNTSD",
-	MOVNTSS:         "MOVNTSS",
-	MOVQ:            "MOVQ",
-	MOVQ2DQ:         "MOVQ2DQ",
-	MOVSB:           "MOVSB",
-	MOVSD:           "MOVSD",
-	MOVSD_XMM:       "MOVSD_XMM",
-	MOVSHDUP:        "MOVSHDUP",
-	MOVSLDUP:        "MOVSLDUP",
-	MOVSQ:           "MOVSQ",
-	MOVSS:           "MOVSS",
-	MOVSW:           "MOVSW",
-	MOVSX:           "MOVSX",
-	MOVSXD:          "MOVSXD",
-	MOVUPD:          "MOVUPD",
-	MOVUPS:          "MOVUPS",
-	MOVZX:           "MOVZX",
-	MPSADBW:         "MPSADBW",
-	MUL:             "MUL",
-	MULPD:           "MULPD",
-	MULPS:           "MULPS",
-	MULSD:           "MULSD",
-	MULSS:           "MULSS",
-	MWAIT:           "MWAIT",
-	NEG:             "NEG",
-	NOP:             "NOP",
-	NOT:             "NOT",
-	OR:              "OR",
-	ORPD:            "ORPD",
-	ORPS:            "ORPS",
-	OUT:             "OUT",
-	OUTSB:           "OUTSB",
-	OUTSD:           "OUTSD",
-	OUTSW:           "OUTSW",
-	PABSB:           "PABSB",
-	PABSD:           "PABSD",
-	PABSW:           "PABSW",
-	PACKSSDW:        "PACKSSDW",
-	PACKSSWB:        "PACKSSWB",
-	PACKUSDW:        "PACKUSDW",
-	PACKUSWB:        "PACKUSWB",
-	PADDB:           "PADDB",
-	PADDD:           "PADDD",
-	PADDQ:           "PADDQ",
-	PADDSB:          "PADDSB",
-	PADDSW:          "PADDSW",
-	PADDUSB:         "PADDUSB",
-	PADDUSW:         "PADDUSW",
-	PADDW:           "PADDW",
-	PALIGNR:         "PALIGNR",
-	PAND:            "PAND",
-	PANDN:           "PANDN",
-	PAUSE:           "PAUSE",
-	PAVGB:           "PAVGB",
-	PAVGW:           "PAVGW",
-	PBLENDVB:        "PBLENDVB",
-	PBLENDW:         "PBLENDW",
-	PCLMULQDQ:       "PCLMULQDQ",
-	PCMPEQB:         "PCMPEQB",
-	PCMPEQD:         "PCMPEQD",
-	PCMPEQQ:         "PCMPEQQ",
-	PCMPEQW:         "PCMPEQW",
-	PCMPESTRI:       "PCMPESTRI",
-	PCMPESTRM:       "PCMPESTRM",
-	PCMPGTB:         "PCMPGTB",
-	PCMPGTD:         "PCMPGTD",
-	PCMPGTQ:         "PCMPGTQ",
-	PCMPGTW:         "PCMPGTW",
-	PCMPISTRI:       "PCMPISTRI",
-	PCMPISTRM:       "PCMPISTRM",
-	PEXTRB:          "PEXTRB",
-	PEXTRD:          "PEXTRD",
-	PEXTRQ:          "PEXTRQ",
-	PEXTRW:          "PEXTRW",
-	PHADDD:          "PHADDD",
-	PHADDSW:         "PHADDSW",
-	PHADDW:          "PHADDW",
-	PHMINPOSUW:      "PHMINPOSUW",
-	PHSUBD:          "PHSUBD",
-	PHSUBSW:         "PHSUBSW",
-	PHSUBW:          "PHSUBW",
-	PINSRB:          "PINSRB",
-	PINSRD:          "PINSRD",
-	PINSRQ:          "PINSRQ",
-	PINSRW:          "PINSRW",
-	PMADDUBSW:       "PMADDUBSW",
-	PMADDWD:         "PMADDWD",
-	PMAXSB:          "PMAXSB",
-	PMAXSD:          "PMAXSD",
-	PMAXSW:          "PMAXSW",
-	PMAXUB:          "PMAXUB",
-	PMAXUD:          "PMAXUD",
-	PMAXUW:          "PMAXUW",
-	PMINSB:          "PMINSB",
-	PMINSD:          "PMINSD",
-	PMINSW:          "PMINSW",
-	PMINUB:          "PMINUB",
-	PMINUD:          "PMINUD",
-	PMINUW:          "PMINUW",
-	PMOVMSKB:        "PMOVMSKB",
-	PMOVSXBD:        "PMOVSXBD",
-	PMOVSXBQ:        "PMOVSXBQ",
-	PMOVSXBW:        "PMOVSXBW",
-	PMOVSXDQ:        "PMOVSXDQ",
-	PMOVSXWD:        "PMOVSXWD",
-	PMOVSXWQ:        "PMOVSXWQ",
-	PMOVZXBD:        "PMOVZXBD",
-	PMOVZXBQ:        "PMOVZXBQ",
-	PMOVZXBW:        "PMOVZXBW",
-	PMOVZXDQ:        "PMOVZXDQ",
-	PMOVZXWD:        "PMOVZXWD",
-	PMOVZXWQ:        "PMOVZXWQ",
-	PMULDQ:          "PMULDQ",
-	PMULHRSW:        "PMULHRSW",
-	PMULHUW:         "PMULHUW",
-	PMULHW:          "PMULHW",
-	PMULLD:          "PMULLD",
-	PMULLW:          "PMULLW",
-	PMULUDQ:         "PMULUDQ",
-	POP:             "POP",
-	POPA:            "POPA",
-	POPAD:           "POPAD",
-	POPCNT:          "POPCNT",
-	POPF:            "POPF",
-	POPFD:           "POPFD",
-	POPFQ:           "POPFQ",
-	POR:             "POR",
-	PREFETCHNTA:     "PREFETCHNTA",
-	PREFETCHT0:      "PREFETCHT0",
-	PREFETCHT1:      "PREFETCHT1",
-	PREFETCHT2:      "PREFETCHT2",
-	PREFETCHW:       "PREFETCHW",
-	PSADBW:          "PSADBW",
-	PSHUFB:          "PSHUFB",
-	PSHUFD:          "PSHUFD",
-	PSHUFHW:         "PSHUFHW",
-	PSHUFLW:         "PSHUFLW",
-	PSHUFW:          "PSHUFW",
-	PSIGNB:          "PSIGNB",
-	PSIGND:          "PSIGND",
-	PSIGNW:          "PSIGNW",
-	PSLLD:           "PSLLD",
-	PSLLDQ:          "PSLLDQ",
-	PSLLQ:           "PSLLQ",
-	PSLLW:           "PSLLW",
-	PSRAD:           "PSRAD",
-	PSRAW:           "PSRAW",
-	PSRLD:           "PSRLD",
-	PSRLDQ:          "PSRLDQ",
-	PSRLQ:           "PSRLQ",
-	PSRLW:           "PSRLW",
-	PSUBB:           "PSUBB",
-	PSUBD:           "PSUBD",
-	PSUBQ:           "PSUBQ",
-	PSUBSB:          "PSUBSB",
-	PSUBSW:          "PSUBSW",
-	PSUBUSB:         "PSUBUSB",
-	PSUBUSW:         "PSUBUSW",
-	PSUBW:           "PSUBW",
-	PTEST:           "PTEST",
-	PUNPCKHBW:       "PUNPCKHBW",
-	PUNPCKHDQ:       "PUNPCKHDQ",
-	PUNPCKHQDQ:      "PUNPCKHQDQ",
-	PUNPCKHWD:       "PUNPCKHWD",
-	PUNPCKLBW:       "PUNPCKLBW",
-	PUNPCKLDQ:       "PUNPCKLDQ",
-	PUNPCKLQDQ:      "PUNPCKLQDQ",
-	PUNPCKLWD:       "PUNPCKLWD",
-	PUSH:            "PUSH",
-	PUSHA:           "PUSHA",
-	PUSHAD:          "PUSHAD",
-	PUSHF:           "PUSHF",
-	PUSHFD:          "PUSHFD",
-	PUSHFQ:          "PUSHFQ",
-	PXOR:            "PXOR",
-	RCL:             "RCL",
-	RCPPS:           "RCPPS",
-	RCPSS:           "RCPSS",
-	RCR:             "RCR",
-	RDFSBASE:        "RDFSBASE",
-	RDGSBASE:        "RDGSBASE",
-	RDMSR:           "RDMSR",
-	RDPMC:           "RDPMC",
-	RDRAND:          "RDRAND",
-	RDTSC:           "RDTSC",
-	RDTSCP:          "RDTSCP",
-	RET:             "RET",
-	ROL:             "ROL",
-	ROR:             "ROR",
-	ROUNDPD:         "ROUNDPD",
-	ROUNDPS:         "ROUNDPS",
-	ROUNDSD:         "ROUNDSD",
-	ROUNDSS:         "ROUNDSS",
-	RSM:             "RSM",
-	RSQRTPS:         "RSQRTPS",
-	RSQRTSS:         "RSQRTSS",
-	SAHF:            "SAHF",
-	SAR:             "SAR",
-	SBB:             "SBB",
-	SCASB:           "SCASB",
-	SCASD:           "SCASD",
-	SCASQ:           "SCASQ",
-	SCASW:           "SCASW",
-	SETA:            "SETA",
-	SETAE:           "SETAE",
-	SETB:            "SETB",
-	SETBE:           "SETBE",
-	SETE:            "SETE",
-	SETG:            "SETG",
-	SETGE:           "SETGE",
-	SETL:            "SETL",
-	SETLE:           "SETLE",
-	SETNE:           "SETNE",
-	SETNO:           "SETNO",
-	SETNP:           "SETNP",
-	SETNS:           "SETNS",
-	SETO:            "SETO",
-	SETP:            "SETP",
-	SETS:            "SETS",
-	SFENCE:          "SFENCE",
-	SGDT:            "SGDT",
-	SHA1MSG1:        "SHA1MSG1",
-	SHA1MSG2:        "SHA1MSG2",
-	SHA1NEXTE:       "SHA1NEXTE",
-	SHA1RNDS4:       "SHA1RNDS4",
-	SHA256MSG1:      "SHA256MSG1",
-	SHA256MSG2:      "SHA256MSG2",
-	SHA256RNDS2:     "SHA256RNDS2",
-	SHL:             "SHL",
-	SHLD:            "SHLD",
-	SHR:             "SHR",
-	SHRD:            "SHRD",
-	SHUFPD:          "SHUFPD",
-	SHUFPS:          "SHUFPS",
-	SIDT:            "SIDT",
-	SLDT:            "SLDT",
-	SMSW:            "SMSW",
-	SQRTPD:          "SQRTPD",
-	SQRTPS:          "SQRTPS",
-	SQRTSD:          "SQRTSD",
-	SQRTSS:          "SQRTSS",
-	STC:             "STC",
-	STD:             "STD",
-	STI:             "STI",
-	STMXCSR:         "STMXCSR",
-	STOSB:           "STOSB",
-	STOSD:           "STOSD",
-	STOSQ:           "STOSQ",
-	STOSW:           "STOSW",
-	STR:             "STR",
-	SUB:             "SUB",
-	SUBPD:           "SUBPD",
-	SUBPS:           "SUBPS",
-	SUBSD:           "SUBSD",
-	SUBSS:           "SUBSS",
-	SWAPGS:          "SWAPGS",
-	SYSCALL:         "SYSCALL",
-	SYSENTER:        "SYSENTER",
-	SYSEXIT:         "SYSEXIT",
-	SYSRET:          "SYSRET",
-	TEST:            "TEST",
-	TZCNT:           "TZCNT",
-	UCOMISD:         "UCOMISD",
-	UCOMISS:         "UCOMISS",
-	UD0:             "UD0",
-	UD1:             "UD1",
-	UD2:             "UD2",
-	UNPCKHPD:        "UNPCKHPD",
-	UNPCKHPS:        "UNPCKHPS",
-	UNPCKLPD:        "UNPCKLPD",
-	UNPCKLPS:        "UNPCKLPS",
-	VERR:            "VERR",
-	VERW:            "VERW",
-	WBINVD:          "WBINVD",
-	WRFSBASE:        "WRFSBASE",
-	WRGSBASE:        "WRGSBASE",
-	WRMSR:           "WRMSR",
-	XABORT:          "XABORT",
-	XADD:            "XADD",
-	XBEGIN:          "XBEGIN",
-	XCHG:            "XCHG",
-	XEND:            "XEND",
-	XGETBV:          "XGETBV",
-	XLATB:           "XLATB",
-	XOR:             "XOR",
-	XORPD:           "XORPD",
-	XORPS:           "XORPS",
-	XRSTOR:          "XRSTOR",
-	XRSTOR64:        "XRSTOR64",
-	XRSTORS:         "XRSTORS",
-	XRSTORS64:       "XRSTORS64",
-	XSAVE:           "XSAVE",
-	XSAVE64:         "XSAVE64",
-	XSAVEC:          "XSAVEC",
-	XSAVEC64:        "XSAVEC64",
-	XSAVEOPT:        "XSAVEOPT",
-	XSAVEOPT64:      "XSAVEOPT64",
-	XSAVES:          "XSAVES",
-	XSAVES64:        "XSAVES64",
-	XSETBV:          "XSETBV",
-	XTEST:           "XTEST",
+	AAA:              "AAA",
+	AAD:              "AAD",
+	AAM:              "AAM",
+	AAS:              "AAS",
+	ADC:              "ADC",
+	ADD:              "ADD",
+	ADDPD:            "ADDPD",
+	ADDPS:            "ADDPS",
+	ADDSD:            "ADDSD",
+	ADDSS:            "ADDSS",
+	ADDSUBPD:         "ADDSUBPD",
+	ADDSUBPS:         "ADDSUBPS",
+	AESDEC:           "AESDEC",
+	AESDEC128KL:      "AESDEC128KL",
+	AESDEC256KL:      "AESDEC256KL",
+	AESDECLAST:       "AESDECLAST",
+	AESDECWIDE128KL:  "AESDECWIDE128KL",
+	AESDECWIDE256KL:  "AESDECWIDE256KL",
+	AESENC:           "AESENC",
+	AESENC128KL:      "AESENC128KL",
+	AESENC256KL:      "AESENC256KL",
+	AESENCLAST:       "AESENCLAST",
+	AESENCWIDE128KL:  "AESENCWIDE128KL",
+	AESENCWIDE256KL:  "AESENCWIDE256KL",
+	AESIMC:           "AESIMC",
+	AESKEYGENASSIST:  "AESKEYGENASSIST",
+	AND:              "AND",
+	ANDNPD:           "ANDNPD",
+	ANDNPS:           "ANDNPS",
+	ANDPD:            "ANDPD",
+	ANDPS:            "ANDPS",
+	ARPL:             "ARPL",
+	BLENDPD:          "BLENDPD",
+	BLENDPS:          "BLENDPS",
+	BLENDVPD:         "BLENDVPD",
+	BLENDVPS:         "BLENDVPS",
+	BOUND:            "BOUND",
+	BSF:              "BSF",
+	BSR:              "BSR",
+	BSWAP:            "BSWAP",
+	BT:               "BT",
+	BTC:              "BTC",
+	BTR:              "BTR",
+	BTS:              "BTS",
+	CALL:             "CALL",
+	CBW:              "CBW",
+	CDQ:              "CDQ",
+	CDQE:             "CDQE",
+	CLC:              "CLC",
+	CLD:              "CLD",
+	CLFLUSH:          "CLFLUSH",
+	CLI:              "CLI",
+	CLRSSBSY:         "CLRSSBSY",
+	CLTS:             "CLTS",
+	CLUI:             "CLUI",
+	CLWB:             "CLWB",
+	CMC:              "CMC",
+	CMOVA:            "CMOVA",
+	CMOVAE:           "CMOVAE",
+	CMOVB:            "CMOVB",
+	CMOVBE:           "CMOVBE",
+	CMOVE:            "CMOVE",
+	CMOVG:            "CMOVG",
+	CMOVGE:           "CMOVGE",
+	CMOVL:            "CMOVL",
+	CMOVLE:           "CMOVLE",
+	CMOVNE:           "CMOVNE",
+	CMOVNO:           "CMOVNO",
+	CMOVNP:           "CMOVNP",
+	CMOVNS:           "CMOVNS",
+	CMOVO:            "CMOVO",
+	CMOVP:            "CMOVP",
+	CMOVS:            "CMOVS",
+	CMP:              "CMP",
+	CMPPD:            "CMPPD",
+	CMPPS:            "CMPPS",
+	CMPSB:            "CMPSB",
+	CMPSD:            "CMPSD",
+	CMPSD_XMM:        "CMPSD_XMM",
+	CMPSQ:            "CMPSQ",
+	CMPSS:            "CMPSS",
+	CMPSW:            "CMPSW",
+	CMPXCHG:          "CMPXCHG",
+	CMPXCHG16B:       "CMPXCHG16B",
+	CMPXCHG8B:        "CMPXCHG8B",
+	COMISD:           "COMISD",
+	COMISS:           "COMISS",
+	CPUID:            "CPUID",
+	CQO:              "CQO",
+	CRC32:            "CRC32",
+	CVTDQ2PD:         "CVTDQ2PD",
+	CVTDQ2PS:         "CVTDQ2PS",
+	CVTPD2DQ:         "CVTPD2DQ",
+	CVTPD2PI:         "CVTPD2PI",
+	CVTPD2PS:         "CVTPD2PS",
+	CVTPI2PD:         "CVTPI2PD",
+	CVTPI2PS:         "CVTPI2PS",
+	CVTPS2DQ:         "CVTPS2DQ",
+	CVTPS2PD:         "CVTPS2PD",
+	CVTPS2PI:         "CVTPS2PI",
+	CVTSD2SI:         "CVTSD2SI",
+	CVTSD2SS:         "CVTSD2SS",
+	CVTSI2SD:         "CVTSI2SD",
+	CVTSI2SS:         "CVTSI2SS",
+	CVTSS2SD:         "CVTSS2SD",
+	CVTSS2SI:         "CVTSS2SI",
+	CVTTPD2DQ:        "CVTTPD2DQ",
+	CVTTPD2PI:        "CVTTPD2PI",
+	CVTTPS2DQ:        "CVTTPS2DQ",
+	CVTTPS2PI:        "CVTTPS2PI",
+	CVTTSD2SI:        "CVTTSD2SI",
+	CVTTSS2SI:        "CVTTSS2SI",
+	CWD:              "CWD",
+	CWDE:             "CWDE",
+	DAA:              "DAA",
+	DAS:              "DAS",
+	DEC:              "DEC",
+	DIV:              "DIV",
+	DIVPD:            "DIVPD",
+	DIVPS:            "DIVPS",
+	DIVSD:            "DIVSD",
+	DIVSS:            "DIVSS",
+	DPPD:             "DPPD",
+	DPPS:             "DPPS",
+	EMMS:             "EMMS",
+	ENCODEKEY128:     "ENCODEKEY128",
+	ENCODEKEY256:     "ENCODEKEY256",
+	ENDBR32:          "ENDBR32",
+	ENDBR64:          "ENDBR64",
+	ENTER:            "ENTER",
+	EXTRACTPS:        "EXTRACTPS",
+	F2XM1:            "F2XM1",
+	FABS:             "FABS",
+	FADD:             "FADD",
+	FADDP:            "FADDP",
+	FBLD:             "FBLD",
+	FBSTP:            "FBSTP",
+	FCHS:             "FCHS",
+	FCMOVB:           "FCMOVB",
+	FCMOVBE:          "FCMOVBE",
+	FCMOVE:           "FCMOVE",
+	FCMOVNB:          "FCMOVNB",
+	FCMOVNBE:         "FCMOVNBE",
+	FCMOVNE:          "FCMOVNE",
+	FCMOVNU:          "FCMOVNU",
+	FCMOVU:           "FCMOVU",
+	FCOM:             "FCOM",
+	FCOMI:            "FCOMI",
+	FCOMIP:           "FCOMIP",
+	FCOMP:            "FCOMP",
+	FCOMPP:           "FCOMPP",
+	FCOS:             "FCOS",
+	FDECSTP:          "FDECSTP",
+	FDIV:             "FDIV",
+	FDIVP:            "FDIVP",
+	FDIVR:            "FDIVR",
+	FDIVRP:           "FDIVRP",
+	FFREE:            "FFREE",
+	FFREEP:           "FFREEP",
+	FIADD:            "FIADD",
+	FICOM:            "FICOM",
+	FICOMP:           "FICOMP",
+	FIDIV:            "FIDIV",
+	FIDIVR:           "FIDIVR",
+	FILD:             "FILD",
+	FIMUL:            "FIMUL",
+	FINCSTP:          "FINCSTP",
+	FIST:             "FIST",
+	FISTP:            "FISTP",
+	FISTTP:           "FISTTP",
+	FISUB:            "FISUB",
+	FISUBR:           "FISUBR",
+	FLD:              "FLD",
+	FLD1:             "FLD1",
+	FLDCW:            "FLDCW",
+	FLDENV:           "FLDENV",
+	FLDL2E:           "FLDL2E",
+	FLDL2T:           "FLDL2T",
+	FLDLG2:           "FLDLG2",
+	FLDLN2:           "FLDLN2",
+	FLDPI:            "FLDPI",
+	FLDZ:             "FLDZ",
+	FMUL:             "FMUL",
+	FMULP:            "FMULP",
+	FNCLEX:           "FNCLEX",
+	FNINIT:           "FNINIT",
+	FNOP:             "FNOP",
+	FNSAVE:           "FNSAVE",
+	FNSTCW:           "FNSTCW",
+	FNSTENV:          "FNSTENV",
+	FNSTSW:           "FNSTSW",
+	FPATAN:           "FPATAN",
+	FPREM:            "FPREM",
+	FPREM1:           "FPREM1",
+	FPTAN:            "FPTAN",
+	FRNDINT:          "FRNDINT",
+	FRSTOR:           "FRSTOR",
+	FSCALE:           "FSCALE",
+	FSIN:             "FSIN",
+	FSINCOS:          "FSINCOS",
+	FSQRT:            "FSQRT",
+	FST:              "FST",
+	FSTP:             "FSTP",
+	FSUB:             "FSUB",
+	FSUBP:            "FSUBP",
+	FSUBR:            "FSUBR",
+	FSUBRP:           "FSUBRP",
+	FTST:             "FTST",
+	FUCOM:            "FUCOM",
+	FUCOMI:           "FUCOMI",
+	FUCOMIP:          "FUCOMIP",
+	FUCOMP:           "FUCOMP",
+	FUCOMPP:          "FUCOMPP",
+	FWAIT:            "FWAIT",
+	FXAM:             "FXAM",
+	FXCH:             "FXCH",
+	FXRSTOR:          "FXRSTOR",
+	FXRSTOR64:        "FXRSTOR64",
+	FXSAVE:           "FXSAVE",
+	FXSAVE64:         "FXSAVE64",
+	FXTRACT:          "FXTRACT",
+	FYL2X:            "FYL2X",
+	FYL2XP1:          "FYL2XP1",
+	GF2P8AFFINEINVQB: "GF2P8AFFINEINVQB",
+	GF2P8AFFINEQB:    "GF2P8AFFINEQB",
+	GF2P8MULB:        "GF2P8MULB",
+	HADDPD:           "HADDPD",
+	HADDPS:           "HADDPS",
+	HLT:              "HLT",
+	HSUBPD:           "HSUBPD",
+	HSUBPS:           "HSUBPS",
+	ICEBP:            "ICEBP",
+	IDIV:             "IDIV",
+	IMUL:             "IMUL",
+	IN:               "IN",
+	INC:              "INC",
+	INCSSPD:          "INCSSPD",
+	INCSSPQ:          "INCSSPQ",
+	INSB:             "INSB",
+	INSD:             "INSD",
+	INSERTPS:         "INSERTPS",
+	INSW:             "INSW",
+	INT:              "INT",
+	INTO:             "INTO",
+	INVD:             "INVD",
+	INVLPG:           "INVLPG",
+	INVPCID:          "INVPCID",
+	IRET:             "IRET",
+	IRETD:            "IRETD",
+	IRETQ:            "IRETQ",
+	JA:               "JA",
+	JAE:              "JAE",
+	JB:               "JB",
+	JBE:              "JBE",
+	JCXZ:             "JCXZ",
+	JE:               "JE",
+	JECXZ:            "JECXZ",
+	JG:               "JG",
+	JGE:              "JGE",
+	JL:               "JL",
+	JLE:              "JLE",
+	JMP:              "JMP",
+	JNE:              "JNE",
+	JNO:              "JNO",
+	JNP:              "JNP",
+	JNS:              "JNS",
+	JO:               "JO",
+	JP:               "JP",
+	JRCXZ:            "JRCXZ",
+	JS:               "JS",
+	LAHF:             "LAHF",
+	LAR:              "LAR",
+	LCALL:            "LCALL",
+	LDDQU:            "LDDQU",
+	LDMXCSR:          "LDMXCSR",
+	LDS:              "LDS",
+	LEA:              "LEA",
+	LEAVE:            "LEAVE",
+	LES:              "LES",
+	LFENCE:           "LFENCE",
+	LFS:              "LFS",
+	LGDT:             "LGDT",
+	LGS:              "LGS",
+	LIDT:             "LIDT",
+	LJMP:             "LJMP",
+	LLDT:             "LLDT",
+	LMSW:             "LMSW",
+	LOADIWKEY:        "LOADIWKEY",
+	LODSB:            "LODSB",
+	LODSD:            "LODSD",
+	LODSQ:            "LODSQ",
+	LODSW:            "LODSW",
+	LOOP:             "LOOP",
+	LOOPE:            "LOOPE",
+	LOOPNE:           "LOOPNE",
+	LRET:             "LRET",
+	LSL:              "LSL",
+	LSS:              "LSS",
+	LTR:              "LTR",
+	LZCNT:            "LZCNT",
+	MASKMOVDQU:       "MASKMOVDQU",
+	MASKMOVQ:         "MASKMOVQ",
+	MAXPD:            "MAXPD",
+	MAXPS:            "MAXPS",
+	MAXSD:            "MAXSD",
+	MAXSS:            "MAXSS",
+	MFENCE:           "MFENCE",
+	MINPD:            "MINPD",
+	MINPS:            "MINPS",
+	MINSD:            "MINSD",
+	MINSS:            "MINSS",
+	MONITOR:          "MONITOR",
+	MOV:              "MOV",
+	MOVAPD:           "MOVAPD",
+	MOVAPS:           "MOVAPS",
+	MOVBE:            "MOVBE",
+	MOVD:             "MOVD",
+	MOVDDUP:          "MOVDDUP",
+	MOVDQ2Q:          "MOVDQ2Q",
+	MOVDQA:           "MOVDQA",
+	MOVDQU:           "MOVDQU",
+	MOVHLPS:          "MOVHLPS",
+	MOVHPD:           "MOVHPD",
+	MOVHPS:           "MOVHPS",
+	MOVLHPS:          "MOVLHPS",
+	MOVLPD:           "MOVLPD",
+	MOVLPS:           "MOVLPS",
+	MOVMSKPD:         "MOVMSKPD",
+	MOVMSKPS:         "MOVMSKPS",
+	MOVNTDQ:          "MOVNTDQ",
+	MOVNTDQA:         "MOVNTDQA",
+	MOVNTI:           "MOVNTI",
+	MOVNTPD:          "MOVNTPD",
+	MOVNTPS:          "MOVNTPS",
+	MOVNTQ:           "MOVNTQ",
+	MOVNTSD:          "MOVNTSD",
+	MOVNTSS:          "MOVNTSS",
+	MOVQ:             "MOVQ",
+	MOVQ2DQ:          "MOVQ2DQ",
+	MOVSB:            "MOVSB",
+	MOVSD:            "MOVSD",
+	MOVSD_XMM:        "MOVSD_XMM",
+	MOVSHDUP:         "MOVSHDUP",
+	MOVSLDUP:         "MOVSLDUP",
+	MOVSQ:            "MOVSQ",
+	MOVSS:            "MOVSS",
+	MOVSW:            "MOVSW",
+	MOVSX:            "MOVSX",
+	MOVSXD:           "MOVSXD",
+	MOVUPD:           "MOVUPD",
+	MOVUPS:           "MOVUPS",
+	MOVZX:            "MOVZX",
+	MPSADBW:          "MPSADBW",
+	MUL:              "MUL",
+	MULPD:            "MULPD",
+	MULPS:            "MULPS",
+	MULSD:            "MULSD",
+	MULSS:            "MULSS",
+	MWAIT:            "MWAIT",
+	NEG:              "NEG",
+	NOP:              "NOP",
+	NOT:              "NOT",
+	OR:               "OR",
+	ORPD:             "ORPD",
+	ORPS:             "ORPS",
+	OUT:              "OUT",
+	OUTSB:            "OUTSB",
+	OUTSD:            "OUTSD",
+	OUTSW:            "OUTSW",
+	PABSB:            "PABSB",
+	PABSD:            "PABSD",
+	PABSW:            "PABSW",
+	PACKSSDW:         "PACKSSDW",
+	PACKSSWB:         "PACKSSWB",
+	PACKUSDW:         "PACKUSDW",
+	PACKUSWB:         "PACKUSWB",
+	PADDB:            "PADDB",
+	PADDD:            "PADDD",
+	PADDQ:            "PADDQ",
+	PADDSB:           "PADDSB",
+	PADDSW:           "PADDSW",
+	PADDUSB:          "PADDUSB",
+	PADDUSW:          "PADDUSW",
+	PADDW:            "PADDW",
+	PALIGNR:          "PALIGNR",
+	PAND:             "PAND",
+	PANDN:            "PANDN",
+	PAUSE:            "PAUSE",
+	PAVGB:            "PAVGB",
+	PAVGW:            "PAVGW",
+	PBLENDVB:         "PBLENDVB",
+	PBLENDW:          "PBLENDW",
+	PCLMULQDQ:        "PCLMULQDQ",
+	PCMPEQB:          "PCMPEQB",
+	PCMPEQD:          "PCMPEQD",
+	PCMPEQQ:          "PCMPEQQ",
+	PCMPEQW:          "PCMPEQW",
+	PCMPESTRI:        "PCMPESTRI",
+	PCMPESTRM:        "PCMPESTRM",
+	PCMPGTB:          "PCMPGTB",
+	PCMPGTD:          "PCMPGTD",
+	PCMPGTQ:          "PCMPGTQ",
+	PCMPGTW:          "PCMPGTW",
+	PCMPISTRI:        "PCMPISTRI",
+	PCMPISTRM:        "PCMPISTRM",
+	PEXTRB:           "PEXTRB",
+	PEXTRD:           "PEXTRD",
+	PEXTRQ:           "PEXTRQ",
+	PEXTRW:           "PEXTRW",
+	PHADDD:           "PHADDD",
+	PHADDSW:          "PHADDSW",
+	PHADDW:           "PHADDW",
+	PHMINPOSUW:       "PHMINPOSUW",
+	PHSUBD:           "PHSUBD",
+	PHSUBSW:          "PHSUBSW",
+	PHSUBW:           "PHSUBW",
+	PINSRB:           "PINSRB",
+	PINSRD:           "PINSRD",
+	PINSRQ:           "PINSRQ",
+	PINSRW:           "PINSRW",
+	PMADDUBSW:        "PMADDUBSW",
+	PMADDWD:          "PMADDWD",
+	PMAXSB:           "PMAXSB",
+	PMAXSD:           "PMAXSD",
+	PMAXSW:           "PMAXSW",
+	PMAXUB:           "PMAXUB",
+	PMAXUD:           "PMAXUD",
+	PMAXUW:           "PMAXUW",
+	PMINSB:           "PMINSB",
+	PMINSD:           "PMINSD",
+	PMINSW:           "PMINSW",
+	PMINUB:           "PMINUB",
+	PMINUD:           "PMINUD",
+	PMINUW:           "PMINUW",
+	PMOVMSKB:         "PMOVMSKB",
+	PMOVSXBD:         "PMOVSXBD",
+	PMOVSXBQ:         "PMOVSXBQ",
+	PMOVSXBW:         "PMOVSXBW",
+	PMOVSXDQ:         "PMOVSXDQ",
+	PMOVSXWD:         "PMOVSXWD",
+	PMOVSXWQ:         "PMOVSXWQ",
+	PMOVZXBD:         "PMOVZXBD",
+	PMOVZXBQ:         "PMOVZXBQ",
+	PMOVZXBW:         "PMOVZXBW",
+	PMOVZXDQ:         "PMOVZXDQ",
+	PMOVZXWD:         "PMOVZXWD",
+	PMOVZXWQ:         "PMOVZXWQ",
+	PMULDQ:           "PMULDQ",
+	PMULHRSW:         "PMULHRSW",
+	PMULHUW:          "PMULHUW",
+	PMULHW:           "PMULHW",
+	PMULLD:           "PMULLD",
+	PMULLW:           "PMULLW",
+	PMULUDQ:          "PMULUDQ",
+	POP:              "POP",
+	POPA:             "POPA",
+	POPAD:            "POPAD",
+	POPCNT:           "POPCNT",
+	POPF:             "POPF",
+	POPFD:            "POPFD",
+	POPFQ:            "POPFQ",
+	POR:              "POR",
+	PREFETCHNTA:      "PREFETCHNTA",
+	PREFETCHT0:       "PREFETCHT0",
+	PREFETCHT1:       "PREFETCHT1",
+	PREFETCHT2:       "PREFETCHT2",
+	PREFETCHW:        "PREFETCHW",
+	PSADBW:           "PSADBW",
+	PSHUFB:           "PSHUFB",
+	PSHUFD:           "PSHUFD",
+	PSHUFHW:          "PSHUFHW",
+	PSHUFLW:          "PSHUFLW",
+	PSHUFW:           "PSHUFW",
+	PSIGNB:           "PSIGNB",
+	PSIGND:           "PSIGND",
+	PSIGNW:           "PSIGNW",
+	PSLLD:            "PSLLD",
+	PSLLDQ:           "PSLLDQ",
+	PSLLQ:            "PSLLQ",
+	PSLLW:            "PSLLW",
+	PSRAD:            "PSRAD",
+	PSRAW:            "PSRAW",
+	PSRLD:            "PSRLD",
+	PSRLDQ:           "PSRLDQ",
+	PSRLQ:            "PSRLQ",
+	PSRLW:            "PSRLW",
+	PSUBB:            "PSUBB",
+	PSUBD:            "PSUBD",
+	PSUBQ:            "PSUBQ",
+	PSUBSB:           "PSUBSB",
+	PSUBSW:           "PSUBSW",
+	PSUBUSB:          "PSUBUSB",
+	PSUBUSW:          "PSUBUSW",
+	PSUBW:            "PSUBW",
+	PTEST:            "PTEST",
+	PUNPCKHBW:        "PUNPCKHBW",
+	PUNPCKHDQ:        "PUNPCKHDQ",
+	PUNPCKHQDQ:       "PUNPCKHQDQ",
+	PUNPCKHWD:        "PUNPCKHWD",
+	PUNPCKLBW:        "PUNPCKLBW",
+	PUNPCKLDQ:        "PUNPCKLDQ",
+	PUNPCKLQDQ:       "PUNPCKLQDQ",
+	PUNPCKLWD:        "PUNPCKLWD",
+	PUSH:             "PUSH",
+	PUSHA:            "PUSHA",
+	PUSHAD:           "PUSHAD",
+	PUSHF:            "PUSHF",
+	PUSHFD:           "PUSHFD",
+	PUSHFQ:           "PUSHFQ",
+	PXOR:             "PXOR",
+	RCL:              "RCL",
+	RCPPS:            "RCPPS",
+	RCPSS:            "RCPSS",
+	RCR:              "RCR",
+	RDFSBASE:         "RDFSBASE",
+	RDGSBASE:         "RDGSBASE",
+	RDMSR:            "RDMSR",
+	RDPID:            "RDPID",
+	RDPMC:            "RDPMC",
+	RDRAND:           "RDRAND",
+	RDSSPD:           "RDSSPD",
+	RDSSPQ:           "RDSSPQ",
+	RDTSC:            "RDTSC",
+	RDTSCP:           "RDTSCP",
+	RET:              "RET",
+	ROL:              "ROL",
+	ROR:              "ROR",
+	ROUNDPD:          "ROUNDPD",
+	ROUNDPS:          "ROUNDPS",
+	ROUNDSD:          "ROUNDSD",
+	ROUNDSS:          "ROUNDSS",
+	RSM:              "RSM",
+	RSQRTPS:          "RSQRTPS",
+	RSQRTSS:          "RSQRTSS",
+	RSTORSSP:         "RSTORSSP",
+	SAHF:             "SAHF",
+	SAR:              "SAR",
+	SAVEPREVSSP:      "SAVEPREVSSP",
+	SBB:              "SBB",
+	SCASB:            "SCASB",
+	SCASD:            "SCASD",
+	SCASQ:            "SCASQ",
+	SCASW:            "SCASW",
+	SENDUIPI:         "SENDUIPI",
+	SERIALIZE:        "SERIALIZE",
+	SETA:             "SETA",
+	SETAE:            "SETAE",
+	SETB:             "SETB",
+	SETBE:            "SETBE",
+	SETE:             "SETE",
+	SETG:             "SETG",
+	SETGE:            "SETGE",
+	SETL:             "SETL",
+	SETLE:            "SETLE",
+	SETNE:            "SETNE",
+	SETNO:            "SETNO",
+	SETNP:            "SETNP",
+	SETNS:            "SETNS",
+	SETO:             "SETO",
+	SETP:             "SETP",
+	SETS:             "SETS",
+	SETSSBSY:         "SETSSBSY",
+	SFENCE:           "SFENCE",
+	SGDT:             "SGDT",
+	SHA1MSG1:         "SHA1MSG1",
+	SHA1MSG2:         "SHA1MSG2",
+	SHA1NEXTE:        "SHA1NEXTE",
+	SHA1RNDS4:        "SHA1RNDS4",
+	SHA256MSG1:       "SHA256MSG1",
+	SHA256MSG2:       "SHA256MSG2",
+	SHA256RNDS2:      "SHA256RNDS2",
+	SHL:              "SHL",
+	SHLD:             "SHLD",
+	SHR:              "SHR",
+	SHRD:             "SHRD",
+	SHUFPD:           "SHUFPD",
+	SHUFPS:           "SHUFPS",
+	SIDT:             "SIDT",
+	SLDT:             "SLDT",
+	SMSW:             "SMSW",
+	SQRTPD:           "SQRTPD",
+	SQRTPS:           "SQRTPS",
+	SQRTSD:           "SQRTSD",
+	SQRTSS:           "SQRTSS",
+	STC:              "STC",
+	STD:              "STD",
+	STI:              "STI",
+	STMXCSR:          "STMXCSR",
+	STOSB:            "STOSB",
+	STOSD:            "STOSD",
+	STOSQ:            "STOSQ",
+	STOSW:            "STOSW",
+	STR:              "STR",
+	STUI:             "STUI",
+	SUB:              "SUB",
+	SUBPD:            "SUBPD",
+	SUBPS:            "SUBPS",
+	SUBSD:            "SUBSD",
+	SUBSS:            "SUBSS",
+	SWAPGS:           "SWAPGS",
+	SYSCALL:          "SYSCALL",
+	SYSENTER:         "SYSENTER",
+	SYSEXIT:          "SYSEXIT",
+	SYSRET:           "SYSRET",
+	TEST:             "TEST",
+	TESTUI:           "TESTUI",
+	TPAUSE:           "TPAUSE",
+	TZCNT:            "TZCNT",
+	UCOMISD:          "UCOMISD",
+	UCOMISS:          "UCOMISS",
+	UD0:              "UD0",
+	UD1:              "UD1",
+	UD2:              "UD2",
+	UIRET:            "UIRET",
+	UMONITOR:         "UMONITOR",
+	UMWAIT:           "UMWAIT",
+	UNPCKHPD:         "UNPCKHPD",
+	UNPCKHPS:         "UNPCKHPS",
+	UNPCKLPD:         "UNPCKLPD",
+	UNPCKLPS:         "UNPCKLPS",
+	VERR:             "VERR",
+	VERW:             "VERW",
+	WBINVD:           "WBINVD",
+	WRFSBASE:         "WRFSBASE",
+	WRGSBASE:         "WRGSBASE",
+	WRMSR:            "WRMSR",
+	WRSSD:            "WRSSD",
+	WRSSQ:            "WRSSQ",
+	WRUSSD:           "WRUSSD",
+	WRUSSQ:           "WRUSSQ",
+	XABORT:           "XABORT",
+	XADD:             "XADD",
+	XBEGIN:           "XBEGIN",
+	XCHG:             "XCHG",
+	XEND:             "XEND",
+	XGETBV:           "XGETBV",
+	XLATB:            "XLATB",
+	XOR:              "XOR",
+	XORPD:            "XORPD",
+	XORPS:            "XORPS",
+	XRSTOR:           "XRSTOR",
+	XRSTOR64:         "XRSTOR64",
+	XRSTORS:          "XRSTORS",
+	XRSTORS64:        "XRSTORS64",
+	XSAVE:            "XSAVE",
+	XSAVE64:          "XSAVE64",
+	XSAVEC:           "XSAVEC",
+	XSAVEC64:         "XSAVEC64",
+	XSAVEOPT:         "XSAVEOPT",
+	XSAVEOPT64:       "XSAVEOPT64",
+	XSAVES:           "XSAVES",
+	XSAVES64:         "XSAVES64",
+	XSETBV:           "XSETBV",
+	XTEST:            "XTEST",
 }
@@ -185,7 +185,7 @@ func (p *Prog) walk(action, key, text, opcode string) *Prog {
 // add adds a single instructions to the tree rooted at root.
 // The string arguments match the CSV: instruction mnemonic,
 // opcode encoding, validity in 32- and 64-bit modes, CPUID
-// feature set (ignored), and additional tags.
+// feature set, and additional tags.
 //
 // In effect, add adds a new path through the tree leading to
 // the given instruction, but it reuses as much of the existing
@@ -367,6 +367,10 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 
 	walk("op", strings.Fields(text)[0])
 
+	if feats := cpuidFeatures(cpuid); len(feats) > 0 {
+		walk("cpuid", strings.Join(feats, ","))
+	}
+
 	if len(encoding) > 0 && strings.HasPrefix(encoding[0], "VEX") {
 		for _, field := range encoding[2:] {
 			walk("read", field)
@@ -408,6 +412,35 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 	walk("match", "!")
 }
 
+// cpuidFeatures returns the names of the x86asm Feature constants
+// for the CPUID feature column of an instruction.
+func cpuidFeatures(cpuid string) []string {
+	var names []string
+	switch {
+	case cpuid == "":
+		return nil
+	case strings.HasPrefix(cpuid, "Both "):
+		// Both AES and AVX flags
+		f := strings.Fields(cpuid)
+		names = []string{f[1], f[3]}
+	case strings.Contains(cpuid, " or "):
+		// HLE or RTM: either suffices; use the one still in production.
+		_, name, _ := strings.Cut(cpuid, " or ")
+		names = []string{name}
+	case cpuid == "AESKLEWIDE_KL":
+		names = []string{"AESKLE", "WIDE_KL"}
+	default:
+		names = []string{cpuid}
+	}
+	for i, name := range names {
+		if name == "CLMUL" {
+			name = "PCLMULQDQ"
+		}
+		names[i] = "Feature" + strings.ReplaceAll(name, "-", "_")
+	}
+	return names
+}
+
 // allKeys records the list of all possible child keys for actions that support "any".
 var allKeys = map[string][]string{
 	"is64":     {"0", "1"},
@@ -444,7 +477,7 @@ func check(p *Prog) {
 	}
 
 	switch p.Action {
-	case "op", "read", "arg":
+	case "op", "cpuid", "read", "arg":
 		if len(p.Child) > 1 {
 			log.Printf("%s: multiple children for action=%s: %v", p.Path, p.Action, p.keys())
 		}
@@ -794,7 +827,7 @@ func walkScanTree(p *Prog, is64 int) {
 			p.Child = map[string]*Prog{"/r": p.Child[keys[0]]}
 			return
 		}
-	case "op", "arg":
+	case "op", "cpuid", "arg":
 		// drop
 		*p = *p.Child[keys[0]]
 		return
@@ -1278,6 +1311,16 @@ func printDecoderPass(p *Prog, pc int, printing bool, ops map[string]bool) int {
 		next = p.Child[keys[0]]
 		pc += 2
 
+	case "cpuid":
+		// Record the CPUID features required.
+		if printing {
+			for i, feat := range strings.Split(keys[0], ",") {
+				fmt.Printf("/*%d*/\tuint16(xSetFeature), uint16(%s),\n", pc+2*i, feat)
+			}
+		}
+		next = p.Child[keys[0]]
+		pc += 2 * (strings.Count(keys[0], ",") + 1)
+
 	case "read":
 		// Read argument bytes.
 		if printing {